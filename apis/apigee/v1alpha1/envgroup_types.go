@@ -0,0 +1,97 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// EnvGroupParameters define the desired state of an Apigee environment
+// group.
+type EnvGroupParameters struct {
+	// Organization is the name of the organization the environment group
+	// belongs to.
+	// +immutable
+	// +optional
+	Organization *string `json:"organization,omitempty"`
+
+	// OrganizationRef references an Organization to retrieve its name.
+	// +optional
+	OrganizationRef *xpv1.Reference `json:"organizationRef,omitempty"`
+
+	// OrganizationSelector selects a reference to an Organization to
+	// retrieve its name.
+	// +optional
+	OrganizationSelector *xpv1.Selector `json:"organizationSelector,omitempty"`
+
+	// Hostnames the environment group serves API proxies on.
+	// +kubebuilder:validation:MinItems=1
+	Hostnames []string `json:"hostnames"`
+}
+
+// EnvGroupObservation is used to show the observed state of the EnvGroup.
+type EnvGroupObservation struct {
+	// Name is the relative resource name of the environment group, in the form
+	// "organizations/{organization}/envgroups/{envgroup}".
+	Name string `json:"name,omitempty"`
+
+	// State of the environment group.
+	State string `json:"state,omitempty"`
+}
+
+// EnvGroupSpec defines the desired state of an EnvGroup.
+type EnvGroupSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       EnvGroupParameters `json:"forProvider"`
+}
+
+// EnvGroupStatus represents the observed state of an EnvGroup.
+type EnvGroupStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          EnvGroupObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// EnvGroup is a managed resource that represents an Apigee environment
+// group.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="ORGANIZATION",type="string",JSONPath=".spec.forProvider.organization"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type EnvGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   EnvGroupSpec   `json:"spec"`
+	Status EnvGroupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// EnvGroupList contains a list of EnvGroup types
+type EnvGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []EnvGroup `json:"items"`
+}
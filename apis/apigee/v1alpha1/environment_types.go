@@ -0,0 +1,124 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// EnvironmentParameters define the desired state of an Apigee environment.
+type EnvironmentParameters struct {
+	// Organization is the name of the organization the environment belongs
+	// to.
+	// +immutable
+	// +optional
+	Organization *string `json:"organization,omitempty"`
+
+	// OrganizationRef references an Organization to retrieve its name.
+	// +optional
+	OrganizationRef *xpv1.Reference `json:"organizationRef,omitempty"`
+
+	// OrganizationSelector selects a reference to an Organization to
+	// retrieve its name.
+	// +optional
+	OrganizationSelector *xpv1.Selector `json:"organizationSelector,omitempty"`
+
+	// DisplayName of the environment.
+	// +optional
+	DisplayName *string `json:"displayName,omitempty"`
+
+	// Description of the environment.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// DeploymentType supported by the environment. Defaults to PROXY.
+	// +immutable
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="deploymentType is immutable"
+	// +kubebuilder:validation:Enum=PROXY;ARCHIVE
+	// +optional
+	DeploymentType *string `json:"deploymentType,omitempty"`
+
+	// APIProxyType supported by the environment.
+	// +immutable
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="apiProxyType is immutable"
+	// +kubebuilder:validation:Enum=PROGRAMMABLE;CONFIGURABLE
+	// +optional
+	APIProxyType *string `json:"apiProxyType,omitempty"`
+
+	// ForwardProxyURI is the URI of the HTTP proxy the runtime sends
+	// outbound requests of the environment through, e.g.
+	// "http://10.0.0.2:3128".
+	// +optional
+	ForwardProxyURI *string `json:"forwardProxyUri,omitempty"`
+
+	// Properties of the environment.
+	// +optional
+	Properties map[string]string `json:"properties,omitempty"`
+}
+
+// EnvironmentObservation is used to show the observed state of the
+// Environment.
+type EnvironmentObservation struct {
+	// Name is the relative resource name of the environment, in the form
+	// "organizations/{organization}/environments/{environment}".
+	Name string `json:"name,omitempty"`
+
+	// State of the environment.
+	State string `json:"state,omitempty"`
+}
+
+// EnvironmentSpec defines the desired state of an Environment.
+type EnvironmentSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       EnvironmentParameters `json:"forProvider"`
+}
+
+// EnvironmentStatus represents the observed state of an Environment.
+type EnvironmentStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          EnvironmentObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// Environment is a managed resource that represents an Apigee environment.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="ORGANIZATION",type="string",JSONPath=".spec.forProvider.organization"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type Environment struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   EnvironmentSpec   `json:"spec"`
+	Status EnvironmentStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// EnvironmentList contains a list of Environment types
+type EnvironmentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Environment `json:"items"`
+}
@@ -0,0 +1,155 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// InstanceParameters define the desired state of an Apigee runtime instance.
+type InstanceParameters struct {
+	// Organization is the name of the organization the instance belongs to.
+	// +immutable
+	// +optional
+	Organization *string `json:"organization,omitempty"`
+
+	// OrganizationRef references an Organization to retrieve its name.
+	// +optional
+	OrganizationRef *xpv1.Reference `json:"organizationRef,omitempty"`
+
+	// OrganizationSelector selects a reference to an Organization to
+	// retrieve its name.
+	// +optional
+	OrganizationSelector *xpv1.Selector `json:"organizationSelector,omitempty"`
+
+	// Location is the region the instance runs in, e.g. "us-central1".
+	// +immutable
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="location is immutable"
+	Location string `json:"location"`
+
+	// DisplayName of the instance.
+	// +immutable
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="displayName is immutable"
+	// +optional
+	DisplayName *string `json:"displayName,omitempty"`
+
+	// Description of the instance.
+	// +immutable
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="description is immutable"
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// IPRange is a comma-separated list of a /22 and a /28 CIDR block,
+	// e.g. "10.0.0.0/22,10.0.4.0/28", that the instance is created in. They
+	// must be part of a range allocated to the Service Networking
+	// connection of the authorized network of the organization. Apigee
+	// picks free blocks if omitted.
+	// +immutable
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="ipRange is immutable"
+	// +optional
+	IPRange *string `json:"ipRange,omitempty"`
+
+	// PeeringCIDRRange is the size of the CIDR block reserved by the
+	// instance. Defaults to SLASH_16 for paid organizations and must be
+	// SLASH_23 for evaluation organizations.
+	// +immutable
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="peeringCidrRange is immutable"
+	// +kubebuilder:validation:Enum=SLASH_16;SLASH_17;SLASH_18;SLASH_19;SLASH_20;SLASH_22;SLASH_23
+	// +optional
+	PeeringCIDRRange *string `json:"peeringCidrRange,omitempty"`
+
+	// DiskEncryptionKeyName is the Cloud KMS key used to encrypt the disks
+	// of the instance, in the form
+	// "projects/{project}/locations/{location}/keyRings/{keyRing}/cryptoKeys/{key}".
+	// +immutable
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="diskEncryptionKeyName is immutable"
+	// +optional
+	DiskEncryptionKeyName *string `json:"diskEncryptionKeyName,omitempty"`
+
+	// ConsumerAcceptList are the IDs or numbers of the projects that may
+	// connect privately to the service attachment of the instance. The
+	// project of the organization is always accepted.
+	// +optional
+	ConsumerAcceptList []string `json:"consumerAcceptList,omitempty"`
+}
+
+// InstanceObservation is used to show the observed state of the Instance.
+type InstanceObservation struct {
+	// Name is the relative resource name of the instance, in the form
+	// "organizations/{organization}/instances/{instance}".
+	Name string `json:"name,omitempty"`
+
+	// State of the instance.
+	State string `json:"state,omitempty"`
+
+	// Host is the internal IP address the instance serves requests on.
+	Host string `json:"host,omitempty"`
+
+	// Port the instance serves requests on.
+	Port string `json:"port,omitempty"`
+
+	// RuntimeVersion of the instance.
+	RuntimeVersion string `json:"runtimeVersion,omitempty"`
+
+	// ServiceAttachment is the resource name of the service attachment
+	// that consumers can connect to the instance through with Private
+	// Service Connect.
+	ServiceAttachment string `json:"serviceAttachment,omitempty"`
+}
+
+// InstanceSpec defines the desired state of an Instance.
+type InstanceSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       InstanceParameters `json:"forProvider"`
+}
+
+// InstanceStatus represents the observed state of an Instance.
+type InstanceStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          InstanceObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// Instance is a managed resource that represents an Apigee X runtime
+// instance. Its host and port are published as connection details.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="LOCATION",type="string",JSONPath=".spec.forProvider.location"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type Instance struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   InstanceSpec   `json:"spec"`
+	Status InstanceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// InstanceList contains a list of Instance types
+type InstanceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Instance `json:"items"`
+}
@@ -0,0 +1,161 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// States of Apigee resources.
+const (
+	StateCreating = "CREATING"
+	StateActive   = "ACTIVE"
+	StateDeleting = "DELETING"
+	StateUpdating = "UPDATING"
+)
+
+// OrganizationParameters define the desired state of an Apigee organization.
+type OrganizationParameters struct {
+	// RuntimeType of the organization. Defaults to CLOUD.
+	// +immutable
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="runtimeType is immutable"
+	// +kubebuilder:validation:Enum=CLOUD;HYBRID
+	// +optional
+	RuntimeType *string `json:"runtimeType,omitempty"`
+
+	// AnalyticsRegion is the region analytics data is stored in, e.g.
+	// "us-central1".
+	// +immutable
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="analyticsRegion is immutable"
+	AnalyticsRegion string `json:"analyticsRegion"`
+
+	// AuthorizedNetwork is the VPC network that is peered with the Apigee
+	// runtime instances through Service Networking, e.g. "default", or
+	// "projects/my-host-project/global/networks/my-network" for a Shared
+	// VPC network. A Service Networking connection must exist on it before
+	// the organization is created. Only valid for the CLOUD runtime type.
+	// +immutable
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="authorizedNetwork is immutable"
+	// +optional
+	AuthorizedNetwork *string `json:"authorizedNetwork,omitempty"`
+
+	// AuthorizedNetworkRef references a Network to retrieve its URL.
+	// +optional
+	AuthorizedNetworkRef *xpv1.Reference `json:"authorizedNetworkRef,omitempty"`
+
+	// AuthorizedNetworkSelector selects a reference to a Network to
+	// retrieve its URL.
+	// +optional
+	AuthorizedNetworkSelector *xpv1.Selector `json:"authorizedNetworkSelector,omitempty"`
+
+	// BillingType of the organization.
+	// +immutable
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="billingType is immutable"
+	// +kubebuilder:validation:Enum=SUBSCRIPTION;EVALUATION;PAYG
+	// +optional
+	BillingType *string `json:"billingType,omitempty"`
+
+	// RuntimeDatabaseEncryptionKeyName is the Cloud KMS key used to encrypt
+	// the runtime database, in the form
+	// "projects/{project}/locations/{location}/keyRings/{keyRing}/cryptoKeys/{key}".
+	// Only valid for the CLOUD runtime type.
+	// +immutable
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="runtimeDatabaseEncryptionKeyName is immutable"
+	// +optional
+	RuntimeDatabaseEncryptionKeyName *string `json:"runtimeDatabaseEncryptionKeyName,omitempty"`
+
+	// DisplayName of the organization.
+	// +optional
+	DisplayName *string `json:"displayName,omitempty"`
+
+	// Description of the organization.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Properties of the organization, e.g. features.hybrid.enabled.
+	// +optional
+	Properties map[string]string `json:"properties,omitempty"`
+}
+
+// OrganizationObservation is used to show the observed state of the
+// Organization.
+type OrganizationObservation struct {
+	// Name is the relative resource name of the organization, in the form
+	// "organizations/{organization}".
+	Name string `json:"name,omitempty"`
+
+	// State of the organization.
+	State string `json:"state,omitempty"`
+
+	// ApigeeProjectID is the ID of the project Apigee runs the runtime
+	// instances of the organization in.
+	ApigeeProjectID string `json:"apigeeProjectId,omitempty"`
+
+	// CACertificate is the base64 encoded public certificate of the root CA
+	// of the organization.
+	CACertificate string `json:"caCertificate,omitempty"`
+
+	// Environments of the organization.
+	Environments []string `json:"environments,omitempty"`
+
+	// SubscriptionType of the organization.
+	SubscriptionType string `json:"subscriptionType,omitempty"`
+}
+
+// OrganizationSpec defines the desired state of an Organization.
+type OrganizationSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       OrganizationParameters `json:"forProvider"`
+}
+
+// OrganizationStatus represents the observed state of an Organization.
+type OrganizationStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          OrganizationObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// Organization is a managed resource that represents an Apigee X
+// organization. An organization is named after the project it is created
+// in, which is the project of its ProviderConfig. The project ID is
+// written to the external name annotation.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type Organization struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OrganizationSpec   `json:"spec"`
+	Status OrganizationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// OrganizationList contains a list of Organization types
+type OrganizationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Organization `json:"items"`
+}
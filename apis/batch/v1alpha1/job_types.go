@@ -0,0 +1,472 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// States of a Job.
+const (
+	JobStateUnspecified        = "STATE_UNSPECIFIED"
+	JobStateQueued             = "QUEUED"
+	JobStateScheduled          = "SCHEDULED"
+	JobStateRunning            = "RUNNING"
+	JobStateSucceeded          = "SUCCEEDED"
+	JobStateFailed             = "FAILED"
+	JobStateDeletionInProgress = "DELETION_IN_PROGRESS"
+)
+
+// JobParameters defines parameters for a desired Cloud Batch Job. Batch jobs
+// can not be updated once they are created, so changes made to a Job's
+// parameters afterwards are not applied to it.
+type JobParameters struct {
+	// Location is the region the job runs in, e.g. "us-central1".
+	// +immutable
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="location is immutable"
+	Location string `json:"location"`
+
+	// Priority of the job, between 0 and 99. Jobs with a higher priority are
+	// scheduled before jobs with a lower priority in the same project.
+	// Defaults to 0.
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=99
+	Priority *int64 `json:"priority,omitempty"`
+
+	// Labels to apply to the job.
+	// +optional
+	// +immutable
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// TaskGroups describe the tasks the job runs. Cloud Batch currently
+	// supports exactly one task group per job.
+	// +immutable
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=1
+	TaskGroups []TaskGroup `json:"taskGroups"`
+
+	// AllocationPolicy describes the resources allocated to run the job's
+	// tasks.
+	// +optional
+	// +immutable
+	AllocationPolicy *AllocationPolicy `json:"allocationPolicy,omitempty"`
+
+	// LogsPolicy describes where the logs of the job's tasks are written.
+	// +optional
+	// +immutable
+	LogsPolicy *LogsPolicy `json:"logsPolicy,omitempty"`
+}
+
+// A TaskGroup is a number of identical tasks.
+type TaskGroup struct {
+	// TaskSpec describes each of the tasks of the group.
+	TaskSpec TaskSpec `json:"taskSpec"`
+
+	// TaskCount is the number of tasks in the group. Defaults to 1.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	TaskCount *int64 `json:"taskCount,omitempty"`
+
+	// Parallelism is the maximum number of tasks of the group that run at
+	// the same time. Defaults to 1.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	Parallelism *int64 `json:"parallelism,omitempty"`
+
+	// TaskCountPerNode is the number of tasks that run on each VM. It
+	// defaults to as many as the VM's resources allow.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	TaskCountPerNode *int64 `json:"taskCountPerNode,omitempty"`
+
+	// RequireHostsFile makes Cloud Batch write a hosts file listing the VMs
+	// of the job to each of them, for MPI and similar workloads.
+	// +optional
+	RequireHostsFile *bool `json:"requireHostsFile,omitempty"`
+}
+
+// A TaskSpec describes a task.
+type TaskSpec struct {
+	// Runnables are run one after the other to perform the task.
+	// +kubebuilder:validation:MinItems=1
+	Runnables []Runnable `json:"runnables"`
+
+	// ComputeResource is the amount of resources a task needs.
+	// +optional
+	ComputeResource *ComputeResource `json:"computeResource,omitempty"`
+
+	// MaxRunDuration is the maximum time a task may run for, e.g. "3600s".
+	// +optional
+	// +kubebuilder:validation:Pattern=`^[0-9]+(\.[0-9]+)?s$`
+	MaxRunDuration *string `json:"maxRunDuration,omitempty"`
+
+	// MaxRetryCount is the number of times a failed task is retried, between
+	// 0 and 10. Defaults to 0.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=10
+	MaxRetryCount *int64 `json:"maxRetryCount,omitempty"`
+
+	// Environment variables set for every runnable of the task.
+	// +optional
+	Environment map[string]string `json:"environment,omitempty"`
+
+	// Volumes mounted on the VMs running the task.
+	// +optional
+	Volumes []Volume `json:"volumes,omitempty"`
+}
+
+// A Runnable is a container or script run as part of a task. Exactly one of
+// container and script must be set.
+// +kubebuilder:validation:XValidation:rule="has(self.container) != has(self.script)",message="exactly one of container and script must be set"
+type Runnable struct {
+	// Container runs a container image.
+	// +optional
+	Container *Container `json:"container,omitempty"`
+
+	// Script runs a shell script.
+	// +optional
+	Script *Script `json:"script,omitempty"`
+
+	// Environment variables set for this runnable.
+	// +optional
+	Environment map[string]string `json:"environment,omitempty"`
+
+	// IgnoreExitStatus continues the task with the next runnable when this
+	// one fails.
+	// +optional
+	IgnoreExitStatus *bool `json:"ignoreExitStatus,omitempty"`
+
+	// Background runs the runnable in the background, without waiting for
+	// it to finish before running the next one.
+	// +optional
+	Background *bool `json:"background,omitempty"`
+
+	// AlwaysRun runs the runnable even if a previous runnable failed, for
+	// example to clean up.
+	// +optional
+	AlwaysRun *bool `json:"alwaysRun,omitempty"`
+
+	// Timeout is the maximum time the runnable may run for, e.g. "600s".
+	// +optional
+	// +kubebuilder:validation:Pattern=`^[0-9]+(\.[0-9]+)?s$`
+	Timeout *string `json:"timeout,omitempty"`
+}
+
+// A Container is a container image to run.
+type Container struct {
+	// ImageURI of the container image, e.g. "gcr.io/my-project/my-image".
+	ImageURI string `json:"imageUri"`
+
+	// Commands passed to the container's entrypoint.
+	// +optional
+	Commands []string `json:"commands,omitempty"`
+
+	// Entrypoint overrides the entrypoint of the container image.
+	// +optional
+	Entrypoint *string `json:"entrypoint,omitempty"`
+
+	// Volumes mounted into the container, in the format of the docker run
+	// --volume flag, e.g. "/mnt/disks/share:/mnt/share". The task's volumes
+	// are mounted at their mount paths if this is omitted.
+	// +optional
+	Volumes []string `json:"volumes,omitempty"`
+
+	// BlockExternalNetwork prevents the container from reaching the
+	// internet.
+	// +optional
+	BlockExternalNetwork *bool `json:"blockExternalNetwork,omitempty"`
+}
+
+// A Script is a shell script to run. Exactly one of path and text must be
+// set.
+// +kubebuilder:validation:XValidation:rule="has(self.path) != has(self.text)",message="exactly one of path and text must be set"
+type Script struct {
+	// Path of a script file on the VM running the task.
+	// +optional
+	Path *string `json:"path,omitempty"`
+
+	// Text of the script.
+	// +optional
+	Text *string `json:"text,omitempty"`
+}
+
+// ComputeResource is the amount of resources a task needs.
+type ComputeResource struct {
+	// CPUMilli is the number of milli CPUs a task needs, e.g. 2000 for two
+	// vCPUs.
+	// +optional
+	CPUMilli *int64 `json:"cpuMilli,omitempty"`
+
+	// MemoryMib is the memory in MiB a task needs.
+	// +optional
+	MemoryMib *int64 `json:"memoryMib,omitempty"`
+
+	// BootDiskMib is the size in MiB of the boot disk a task needs.
+	// +optional
+	BootDiskMib *int64 `json:"bootDiskMib,omitempty"`
+}
+
+// A Volume is mounted on the VMs running a task.
+type Volume struct {
+	// MountPath the volume is mounted at, e.g. "/mnt/disks/share".
+	MountPath string `json:"mountPath"`
+
+	// GCS mounts a Cloud Storage bucket.
+	GCS GCSVolume `json:"gcs"`
+
+	// MountOptions passed to gcsfuse when mounting the bucket.
+	// +optional
+	MountOptions []string `json:"mountOptions,omitempty"`
+}
+
+// A GCSVolume is a Cloud Storage bucket, or a path within one.
+type GCSVolume struct {
+	// Bucket to mount.
+	// +optional
+	Bucket *string `json:"bucket,omitempty"`
+
+	// BucketRef references a Bucket to retrieve its name.
+	// +optional
+	BucketRef *xpv1.Reference `json:"bucketRef,omitempty"`
+
+	// BucketSelector selects a reference to a Bucket to retrieve its name.
+	// +optional
+	BucketSelector *xpv1.Selector `json:"bucketSelector,omitempty"`
+
+	// Path within the bucket to mount, e.g. "inputs/". The whole bucket is
+	// mounted if it is omitted.
+	// +optional
+	Path *string `json:"path,omitempty"`
+}
+
+// AllocationPolicy describes the resources allocated to run a job's tasks.
+type AllocationPolicy struct {
+	// AllowedLocations the VMs may be created in, e.g. "zones/us-central1-a".
+	// Defaults to any zone of the job's location.
+	// +optional
+	AllowedLocations []string `json:"allowedLocations,omitempty"`
+
+	// Instances describe the VMs that run the tasks. Only one entry is
+	// currently supported.
+	// +optional
+	// +kubebuilder:validation:MaxItems=1
+	Instances []InstancePolicyOrTemplate `json:"instances,omitempty"`
+
+	// Network describes the network the VMs are connected to.
+	// +optional
+	Network *NetworkPolicy `json:"network,omitempty"`
+
+	// ServiceAccount the VMs run as.
+	// +optional
+	ServiceAccount *ServiceAccount `json:"serviceAccount,omitempty"`
+
+	// Labels to apply to the VMs and other resources created for the job.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// InstancePolicyOrTemplate describes the VMs that run a job's tasks, either
+// directly or using an instance template. Exactly one of policy and
+// instanceTemplate must be set.
+// +kubebuilder:validation:XValidation:rule="has(self.policy) != has(self.instanceTemplate)",message="exactly one of policy and instanceTemplate must be set"
+type InstancePolicyOrTemplate struct {
+	// Policy describes the VMs.
+	// +optional
+	Policy *InstancePolicy `json:"policy,omitempty"`
+
+	// InstanceTemplate is the name of an instance template the VMs are
+	// created from.
+	// +optional
+	InstanceTemplate *string `json:"instanceTemplate,omitempty"`
+
+	// InstallGPUDrivers installs GPU drivers on the VMs.
+	// +optional
+	InstallGPUDrivers *bool `json:"installGpuDrivers,omitempty"`
+}
+
+// InstancePolicy describes the VMs that run a job's tasks.
+type InstancePolicy struct {
+	// MachineType of the VMs, e.g. "e2-standard-4". Cloud Batch picks a
+	// machine type that fits the tasks if it is omitted.
+	// +optional
+	MachineType *string `json:"machineType,omitempty"`
+
+	// ProvisioningModel of the VMs. Defaults to STANDARD.
+	// +optional
+	// +kubebuilder:validation:Enum=STANDARD;SPOT;PREEMPTIBLE
+	ProvisioningModel *string `json:"provisioningModel,omitempty"`
+
+	// MinCPUPlatform of the VMs, e.g. "Intel Cascade Lake".
+	// +optional
+	MinCPUPlatform *string `json:"minCpuPlatform,omitempty"`
+}
+
+// NetworkPolicy describes the network the VMs running a job are connected
+// to.
+type NetworkPolicy struct {
+	// NetworkInterfaces of the VMs.
+	// +kubebuilder:validation:MinItems=1
+	NetworkInterfaces []NetworkInterface `json:"networkInterfaces"`
+}
+
+// A NetworkInterface of the VMs running a job.
+type NetworkInterface struct {
+	// Network is the URL of the network, e.g.
+	// "projects/my-project/global/networks/my-network".
+	// +optional
+	Network *string `json:"network,omitempty"`
+
+	// NetworkRef references a Network to retrieve its URL.
+	// +optional
+	NetworkRef *xpv1.Reference `json:"networkRef,omitempty"`
+
+	// NetworkSelector selects a reference to a Network to retrieve its URL.
+	// +optional
+	NetworkSelector *xpv1.Selector `json:"networkSelector,omitempty"`
+
+	// Subnetwork is the URL of the subnetwork, e.g.
+	// "projects/my-project/regions/us-central1/subnetworks/my-subnetwork".
+	// +optional
+	Subnetwork *string `json:"subnetwork,omitempty"`
+
+	// SubnetworkRef references a Subnetwork to retrieve its URL.
+	// +optional
+	SubnetworkRef *xpv1.Reference `json:"subnetworkRef,omitempty"`
+
+	// SubnetworkSelector selects a reference to a Subnetwork to retrieve its
+	// URL.
+	// +optional
+	SubnetworkSelector *xpv1.Selector `json:"subnetworkSelector,omitempty"`
+
+	// NoExternalIPAddress creates the VMs without external IP addresses.
+	// The network then needs Cloud NAT or Private Google Access for the VMs
+	// to pull images and reach Google APIs.
+	// +optional
+	NoExternalIPAddress *bool `json:"noExternalIpAddress,omitempty"`
+}
+
+// ServiceAccount the VMs running a job run as.
+type ServiceAccount struct {
+	// Email of the service account. The default Compute Engine service
+	// account is used if it is omitted.
+	// +optional
+	Email *string `json:"email,omitempty"`
+
+	// EmailRef references a ServiceAccount to retrieve its email.
+	// +optional
+	EmailRef *xpv1.Reference `json:"emailRef,omitempty"`
+
+	// EmailSelector selects a reference to a ServiceAccount to retrieve its
+	// email.
+	// +optional
+	EmailSelector *xpv1.Selector `json:"emailSelector,omitempty"`
+}
+
+// LogsPolicy describes where the logs of a job's tasks are written.
+type LogsPolicy struct {
+	// Destination of the logs. Logs are not kept if it is omitted.
+	// +kubebuilder:validation:Enum=CLOUD_LOGGING;PATH
+	Destination string `json:"destination"`
+
+	// LogsPath is the path logs are written to if the destination is PATH,
+	// for example within a mounted Cloud Storage volume.
+	// +optional
+	LogsPath *string `json:"logsPath,omitempty"`
+}
+
+// A JobStatusEvent is an event in the lifecycle of a job.
+type JobStatusEvent struct {
+	// Type of the event.
+	Type string `json:"type,omitempty"`
+
+	// Description of the event.
+	Description string `json:"description,omitempty"`
+
+	// EventTime is the time the event occurred.
+	EventTime string `json:"eventTime,omitempty"`
+}
+
+// JobObservation is used to show the observed state of the Job.
+type JobObservation struct {
+	// Name is the resource name of the job, e.g.
+	// "projects/my-project/locations/us-central1/jobs/my-job".
+	Name string `json:"name,omitempty"`
+
+	// UID is a unique identifier of the job generated by Cloud Batch.
+	UID string `json:"uid,omitempty"`
+
+	// State of the job.
+	State string `json:"state,omitempty"`
+
+	// RunDuration is how long the job has been running for.
+	RunDuration string `json:"runDuration,omitempty"`
+
+	// StatusEvents of the job.
+	StatusEvents []JobStatusEvent `json:"statusEvents,omitempty"`
+
+	// CreateTime is the time the job was created.
+	CreateTime string `json:"createTime,omitempty"`
+
+	// UpdateTime is the time the job was last updated.
+	UpdateTime string `json:"updateTime,omitempty"`
+}
+
+// JobSpec defines the desired state of a Job.
+type JobSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       JobParameters `json:"forProvider"`
+}
+
+// JobStatus represents the observed state of a Job.
+type JobStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          JobObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// Job is a managed resource that represents a Cloud Batch job, which runs
+// groups of tasks on Compute Engine VMs.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="NAME",type="string",JSONPath=".status.atProvider.name",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp},shortName=batchjob
+type Job struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   JobSpec   `json:"spec"`
+	Status JobStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// JobList contains a list of Job types
+type JobList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Job `json:"items"`
+}
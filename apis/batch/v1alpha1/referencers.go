@@ -0,0 +1,111 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+
+	computev1beta1 "github.com/crossplane-contrib/provider-gcp/apis/compute/v1beta1"
+	iamv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/iam/v1alpha1"
+	"github.com/crossplane-contrib/provider-gcp/apis/storage/v1alpha3"
+)
+
+// ResolveReferences of this Job
+func (mg *Job) ResolveReferences(ctx context.Context, c client.Reader) error { // nolint:gocyclo
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.taskGroups[*].taskSpec.volumes[*].gcs.bucket
+	for i := range mg.Spec.ForProvider.TaskGroups {
+		for j := range mg.Spec.ForProvider.TaskGroups[i].TaskSpec.Volumes {
+			v := &mg.Spec.ForProvider.TaskGroups[i].TaskSpec.Volumes[j].GCS
+			rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+				CurrentValue: reference.FromPtrValue(v.Bucket),
+				Reference:    v.BucketRef,
+				Selector:     v.BucketSelector,
+				To:           reference.To{Managed: &v1alpha3.Bucket{}, List: &v1alpha3.BucketList{}},
+				Extract:      reference.ExternalName(),
+			})
+			if err != nil {
+				return errors.Wrapf(err, "spec.forProvider.taskGroups[%d].taskSpec.volumes[%d].gcs.bucket", i, j)
+			}
+			v.Bucket = reference.ToPtrValue(rsp.ResolvedValue)
+			v.BucketRef = rsp.ResolvedReference
+		}
+	}
+
+	p := mg.Spec.ForProvider.AllocationPolicy
+	if p == nil {
+		return nil
+	}
+
+	if p.Network != nil {
+		for i := range p.Network.NetworkInterfaces {
+			ni := &p.Network.NetworkInterfaces[i]
+
+			// Resolve spec.forProvider.allocationPolicy.network.networkInterfaces[*].network
+			rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+				CurrentValue: reference.FromPtrValue(ni.Network),
+				Reference:    ni.NetworkRef,
+				Selector:     ni.NetworkSelector,
+				To:           reference.To{Managed: &computev1beta1.Network{}, List: &computev1beta1.NetworkList{}},
+				Extract:      computev1beta1.NetworkURL(),
+			})
+			if err != nil {
+				return errors.Wrapf(err, "spec.forProvider.allocationPolicy.network.networkInterfaces[%d].network", i)
+			}
+			ni.Network = reference.ToPtrValue(rsp.ResolvedValue)
+			ni.NetworkRef = rsp.ResolvedReference
+
+			// Resolve spec.forProvider.allocationPolicy.network.networkInterfaces[*].subnetwork
+			rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+				CurrentValue: reference.FromPtrValue(ni.Subnetwork),
+				Reference:    ni.SubnetworkRef,
+				Selector:     ni.SubnetworkSelector,
+				To:           reference.To{Managed: &computev1beta1.Subnetwork{}, List: &computev1beta1.SubnetworkList{}},
+				Extract:      computev1beta1.SubnetworkURL(),
+			})
+			if err != nil {
+				return errors.Wrapf(err, "spec.forProvider.allocationPolicy.network.networkInterfaces[%d].subnetwork", i)
+			}
+			ni.Subnetwork = reference.ToPtrValue(rsp.ResolvedValue)
+			ni.SubnetworkRef = rsp.ResolvedReference
+		}
+	}
+
+	// Resolve spec.forProvider.allocationPolicy.serviceAccount.email
+	if sa := p.ServiceAccount; sa != nil {
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(sa.Email),
+			Reference:    sa.EmailRef,
+			Selector:     sa.EmailSelector,
+			To:           reference.To{Managed: &iamv1alpha1.ServiceAccount{}, List: &iamv1alpha1.ServiceAccountList{}},
+			Extract:      iamv1alpha1.ServiceAccountEmail(),
+		})
+		if err != nil {
+			return errors.Wrap(err, "spec.forProvider.allocationPolicy.serviceAccount.email")
+		}
+		sa.Email = reference.ToPtrValue(rsp.ResolvedValue)
+		sa.EmailRef = rsp.ResolvedReference
+	}
+
+	return nil
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// DatasetParameters defines parameters for a desired BigQuery Dataset.
+type DatasetParameters struct {
+	// Location the dataset stores its data in, e.g. "US" or "us-central1".
+	// +immutable
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="location is immutable"
+	Location string `json:"location"`
+
+	// FriendlyName is a descriptive name for the dataset.
+	// +optional
+	FriendlyName *string `json:"friendlyName,omitempty"`
+
+	// Description of the dataset.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Labels to apply to the dataset.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// DefaultTableExpirationMs is the default lifetime of new tables in the
+	// dataset, in milliseconds. The minimum is one hour. Tables do not
+	// expire if it is omitted.
+	// +optional
+	// +kubebuilder:validation:Minimum=3600000
+	DefaultTableExpirationMs *int64 `json:"defaultTableExpirationMs,omitempty"`
+
+	// GKEUsageMetering prepares the dataset to receive the usage metering
+	// export of GKE clusters, i.e. a Cluster's
+	// resourceUsageExportConfig.bigqueryDestination.
+	// +optional
+	GKEUsageMetering *GKEUsageMetering `json:"gkeUsageMetering,omitempty"`
+}
+
+// GKEUsageMetering prepares a dataset to receive GKE usage metering exports.
+// GKE creates the usage and consumption tables itself once it may write to
+// the dataset.
+type GKEUsageMetering struct {
+	// GrantServiceAgentAccess grants the GKE service agent of the dataset's
+	// project, i.e. service-PROJECT_NUMBER@container-engine-robot.iam.gserviceaccount.com,
+	// WRITER access to the dataset. Defaults to true.
+	// +optional
+	GrantServiceAgentAccess *bool `json:"grantServiceAgentAccess,omitempty"`
+}
+
+// DatasetObservation is used to show the observed state of the Dataset.
+type DatasetObservation struct {
+	// ID is the fully qualified ID of the dataset, e.g.
+	// "my-project:my_dataset".
+	ID string `json:"id,omitempty"`
+
+	// SelfLink is the URL of the dataset.
+	SelfLink string `json:"selfLink,omitempty"`
+
+	// CreationTime of the dataset, in milliseconds since the epoch.
+	CreationTime int64 `json:"creationTime,omitempty"`
+
+	// LastModifiedTime of the dataset, in milliseconds since the epoch.
+	LastModifiedTime int64 `json:"lastModifiedTime,omitempty"`
+
+	// GKEServiceAgent is the GKE service agent that was granted access to
+	// the dataset for usage metering.
+	GKEServiceAgent string `json:"gkeServiceAgent,omitempty"`
+}
+
+// DatasetSpec defines the desired state of a Dataset.
+type DatasetSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       DatasetParameters `json:"forProvider"`
+}
+
+// DatasetStatus represents the observed state of a Dataset.
+type DatasetStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          DatasetObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// Dataset is a managed resource that represents a BigQuery dataset. Its
+// external name is the dataset ID, which may contain only letters, numbers
+// and underscores.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="LOCATION",type="string",JSONPath=".spec.forProvider.location"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".status.atProvider.id",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp},shortName=bqdataset
+type Dataset struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DatasetSpec   `json:"spec"`
+	Status DatasetStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DatasetList contains a list of Dataset types
+type DatasetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Dataset `json:"items"`
+}
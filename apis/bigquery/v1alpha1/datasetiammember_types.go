@@ -0,0 +1,110 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// DatasetIAMMemberParameters define the desired state of a member of the
+// access of a BigQuery dataset.
+type DatasetIAMMemberParameters struct {
+	// Dataset is the ID of the dataset the member is granted access to.
+	// +optional
+	// +immutable
+	Dataset *string `json:"dataset,omitempty"`
+
+	// DatasetRef references a Dataset and retrieves its ID.
+	// +optional
+	// +immutable
+	DatasetRef *xpv1.Reference `json:"datasetRef,omitempty"`
+
+	// DatasetSelector selects a reference to a Dataset.
+	// +optional
+	DatasetSelector *xpv1.Selector `json:"datasetSelector,omitempty"`
+
+	// Role that is granted to the member, either an IAM role such as
+	// "roles/bigquery.dataViewer", or one of the basic dataset roles READER,
+	// WRITER and OWNER. BigQuery reports the roles
+	// roles/bigquery.dataViewer, roles/bigquery.dataEditor and
+	// roles/bigquery.dataOwner as their basic equivalents.
+	// +immutable
+	Role string `json:"role"`
+
+	// Member that is granted the role, e.g. "user:alice@example.com",
+	// "group:admins@example.com", "serviceAccount:app@my-project.iam.gserviceaccount.com",
+	// "domain:example.com" or "allAuthenticatedUsers". Other IAM principals
+	// are granted access as IAM members.
+	// +optional
+	// +immutable
+	Member *string `json:"member,omitempty"`
+
+	// ServiceAccountMemberRef is reference to ServiceAccount used to set
+	// the Member.
+	// +optional
+	// +immutable
+	ServiceAccountMemberRef *xpv1.Reference `json:"serviceAccountMemberRef,omitempty"`
+
+	// ServiceAccountMemberSelector selects reference to ServiceAccount used
+	// to set the Member.
+	// +optional
+	// +immutable
+	ServiceAccountMemberSelector *xpv1.Selector `json:"serviceAccountMemberSelector,omitempty"`
+}
+
+// DatasetIAMMemberSpec defines the desired state of a DatasetIAMMember.
+type DatasetIAMMemberSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       DatasetIAMMemberParameters `json:"forProvider"`
+}
+
+// DatasetIAMMemberStatus represents the observed state of a
+// DatasetIAMMember.
+type DatasetIAMMemberStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+
+// DatasetIAMMember is a managed resource that grants a single member a role
+// on a BigQuery dataset, as an entry of the dataset's access. Other entries
+// of the access are left unchanged.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="DATASET",type="string",JSONPath=".spec.forProvider.dataset"
+// +kubebuilder:printcolumn:name="ROLE",type="string",JSONPath=".spec.forProvider.role"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type DatasetIAMMember struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DatasetIAMMemberSpec   `json:"spec"`
+	Status DatasetIAMMemberStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DatasetIAMMemberList contains a list of DatasetIAMMember.
+type DatasetIAMMemberList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DatasetIAMMember `json:"items"`
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+
+	iamv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/iam/v1alpha1"
+)
+
+// ResolveReferences of this DatasetIAMMember
+func (in *DatasetIAMMember) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, in)
+
+	// Resolve spec.forProvider.dataset
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(in.Spec.ForProvider.Dataset),
+		Reference:    in.Spec.ForProvider.DatasetRef,
+		Selector:     in.Spec.ForProvider.DatasetSelector,
+		To:           reference.To{Managed: &Dataset{}, List: &DatasetList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.dataset")
+	}
+	in.Spec.ForProvider.Dataset = reference.ToPtrValue(rsp.ResolvedValue)
+	in.Spec.ForProvider.DatasetRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.member
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(in.Spec.ForProvider.Member),
+		Reference:    in.Spec.ForProvider.ServiceAccountMemberRef,
+		Selector:     in.Spec.ForProvider.ServiceAccountMemberSelector,
+		To:           reference.To{Managed: &iamv1alpha1.ServiceAccount{}, List: &iamv1alpha1.ServiceAccountList{}},
+		Extract:      iamv1alpha1.ServiceAccountMemberName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.member")
+	}
+	in.Spec.ForProvider.Member = reference.ToPtrValue(rsp.ResolvedValue)
+	in.Spec.ForProvider.ServiceAccountMemberRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this TableIAMMember
+func (in *TableIAMMember) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, in)
+
+	// Resolve spec.forProvider.dataset
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(in.Spec.ForProvider.Dataset),
+		Reference:    in.Spec.ForProvider.DatasetRef,
+		Selector:     in.Spec.ForProvider.DatasetSelector,
+		To:           reference.To{Managed: &Dataset{}, List: &DatasetList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.dataset")
+	}
+	in.Spec.ForProvider.Dataset = reference.ToPtrValue(rsp.ResolvedValue)
+	in.Spec.ForProvider.DatasetRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.member
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(in.Spec.ForProvider.Member),
+		Reference:    in.Spec.ForProvider.ServiceAccountMemberRef,
+		Selector:     in.Spec.ForProvider.ServiceAccountMemberSelector,
+		To:           reference.To{Managed: &iamv1alpha1.ServiceAccount{}, List: &iamv1alpha1.ServiceAccountList{}},
+		Extract:      iamv1alpha1.ServiceAccountMemberName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.member")
+	}
+	in.Spec.ForProvider.Member = reference.ToPtrValue(rsp.ResolvedValue)
+	in.Spec.ForProvider.ServiceAccountMemberRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this RowAccessPolicy
+func (in *RowAccessPolicy) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, in)
+
+	// Resolve spec.forProvider.dataset
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(in.Spec.ForProvider.Dataset),
+		Reference:    in.Spec.ForProvider.DatasetRef,
+		Selector:     in.Spec.ForProvider.DatasetSelector,
+		To:           reference.To{Managed: &Dataset{}, List: &DatasetList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.dataset")
+	}
+	in.Spec.ForProvider.Dataset = reference.ToPtrValue(rsp.ResolvedValue)
+	in.Spec.ForProvider.DatasetRef = rsp.ResolvedReference
+
+	return nil
+}
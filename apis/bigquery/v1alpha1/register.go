@@ -0,0 +1,77 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+)
+
+// Package type metadata.
+const (
+	Group   = "bigquery.gcp.crossplane.io"
+	Version = "v1alpha1"
+)
+
+var (
+	// SchemeGroupVersion is group version used to register these objects
+	SchemeGroupVersion = schema.GroupVersion{Group: Group, Version: Version}
+
+	// SchemeBuilder is used to add go types to the GroupVersionKind scheme
+	SchemeBuilder = &scheme.Builder{GroupVersion: SchemeGroupVersion}
+)
+
+// Dataset type metadata.
+var (
+	DatasetKind             = reflect.TypeOf(Dataset{}).Name()
+	DatasetGroupKind        = schema.GroupKind{Group: Group, Kind: DatasetKind}.String()
+	DatasetKindAPIVersion   = DatasetKind + "." + SchemeGroupVersion.String()
+	DatasetGroupVersionKind = SchemeGroupVersion.WithKind(DatasetKind)
+)
+
+// DatasetIAMMember type metadata.
+var (
+	DatasetIAMMemberKind             = reflect.TypeOf(DatasetIAMMember{}).Name()
+	DatasetIAMMemberGroupKind        = schema.GroupKind{Group: Group, Kind: DatasetIAMMemberKind}.String()
+	DatasetIAMMemberKindAPIVersion   = DatasetIAMMemberKind + "." + SchemeGroupVersion.String()
+	DatasetIAMMemberGroupVersionKind = SchemeGroupVersion.WithKind(DatasetIAMMemberKind)
+)
+
+// TableIAMMember type metadata.
+var (
+	TableIAMMemberKind             = reflect.TypeOf(TableIAMMember{}).Name()
+	TableIAMMemberGroupKind        = schema.GroupKind{Group: Group, Kind: TableIAMMemberKind}.String()
+	TableIAMMemberKindAPIVersion   = TableIAMMemberKind + "." + SchemeGroupVersion.String()
+	TableIAMMemberGroupVersionKind = SchemeGroupVersion.WithKind(TableIAMMemberKind)
+)
+
+// RowAccessPolicy type metadata.
+var (
+	RowAccessPolicyKind             = reflect.TypeOf(RowAccessPolicy{}).Name()
+	RowAccessPolicyGroupKind        = schema.GroupKind{Group: Group, Kind: RowAccessPolicyKind}.String()
+	RowAccessPolicyKindAPIVersion   = RowAccessPolicyKind + "." + SchemeGroupVersion.String()
+	RowAccessPolicyGroupVersionKind = SchemeGroupVersion.WithKind(RowAccessPolicyKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Dataset{}, &DatasetList{})
+	SchemeBuilder.Register(&DatasetIAMMember{}, &DatasetIAMMemberList{})
+	SchemeBuilder.Register(&TableIAMMember{}, &TableIAMMemberList{})
+	SchemeBuilder.Register(&RowAccessPolicy{}, &RowAccessPolicyList{})
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// RowAccessPolicyParameters define the desired state of a BigQuery row
+// access policy.
+type RowAccessPolicyParameters struct {
+	// Dataset is the ID of the dataset of the table.
+	// +optional
+	// +immutable
+	Dataset *string `json:"dataset,omitempty"`
+
+	// DatasetRef references a Dataset and retrieves its ID.
+	// +optional
+	// +immutable
+	DatasetRef *xpv1.Reference `json:"datasetRef,omitempty"`
+
+	// DatasetSelector selects a reference to a Dataset.
+	// +optional
+	DatasetSelector *xpv1.Selector `json:"datasetSelector,omitempty"`
+
+	// Table is the ID of the table whose rows the policy filters.
+	// +immutable
+	Table string `json:"table"`
+
+	// Grantees are the principals that may read the rows the filter
+	// predicate matches, e.g. "user:alice@example.com",
+	// "group:sales@example.com" or "domain:example.com".
+	// +kubebuilder:validation:MinItems=1
+	// +listType=set
+	Grantees []string `json:"grantees"`
+
+	// FilterPredicate is a GoogleSQL boolean expression over the columns of
+	// the table, e.g. "region = 'EMEA'". The grantees may only read the rows
+	// for which it is true.
+	// +kubebuilder:validation:MinLength=1
+	FilterPredicate string `json:"filterPredicate"`
+}
+
+// RowAccessPolicyObservation is used to show the observed state of a
+// RowAccessPolicy.
+type RowAccessPolicyObservation struct {
+	// Name is the relative resource name of the policy, in the form
+	// "projects/{project}/datasets/{dataset}/tables/{table}/rowAccessPolicies/{policy}".
+	Name string `json:"name,omitempty"`
+
+	// CreationTime of the policy, in RFC3339 text format.
+	CreationTime string `json:"creationTime,omitempty"`
+
+	// LastModifiedTime of the policy, in RFC3339 text format.
+	LastModifiedTime string `json:"lastModifiedTime,omitempty"`
+}
+
+// RowAccessPolicySpec defines the desired state of a RowAccessPolicy.
+type RowAccessPolicySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       RowAccessPolicyParameters `json:"forProvider"`
+}
+
+// RowAccessPolicyStatus represents the observed state of a RowAccessPolicy.
+type RowAccessPolicyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          RowAccessPolicyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RowAccessPolicy is a managed resource that represents a BigQuery row-level
+// access policy of a table. Its external name is the ID of the policy, which
+// may contain only letters, numbers and underscores. BigQuery manages row
+// access policies with DDL statements, which are run as queries in the
+// project of the ProviderConfig.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="TABLE",type="string",JSONPath=".spec.forProvider.table"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type RowAccessPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RowAccessPolicySpec   `json:"spec"`
+	Status RowAccessPolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RowAccessPolicyList contains a list of RowAccessPolicy.
+type RowAccessPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RowAccessPolicy `json:"items"`
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// TableIAMMemberParameters define the desired state of a member of the IAM
+// policy of a BigQuery table or view.
+type TableIAMMemberParameters struct {
+	// Dataset is the ID of the dataset of the table.
+	// +optional
+	// +immutable
+	Dataset *string `json:"dataset,omitempty"`
+
+	// DatasetRef references a Dataset and retrieves its ID.
+	// +optional
+	// +immutable
+	DatasetRef *xpv1.Reference `json:"datasetRef,omitempty"`
+
+	// DatasetSelector selects a reference to a Dataset.
+	// +optional
+	DatasetSelector *xpv1.Selector `json:"datasetSelector,omitempty"`
+
+	// Table is the ID of the table or view.
+	// +immutable
+	Table string `json:"table"`
+
+	// Role that is assigned to the member, e.g. "roles/bigquery.dataViewer".
+	// +immutable
+	Role string `json:"role"`
+
+	// Member that is assigned the role, e.g. "user:alice@example.com",
+	// "group:admins@example.com" or
+	// "serviceAccount:app@my-project.iam.gserviceaccount.com".
+	// +optional
+	// +immutable
+	Member *string `json:"member,omitempty"`
+
+	// ServiceAccountMemberRef is reference to ServiceAccount used to set
+	// the Member.
+	// +optional
+	// +immutable
+	ServiceAccountMemberRef *xpv1.Reference `json:"serviceAccountMemberRef,omitempty"`
+
+	// ServiceAccountMemberSelector selects reference to ServiceAccount used
+	// to set the Member.
+	// +optional
+	// +immutable
+	ServiceAccountMemberSelector *xpv1.Selector `json:"serviceAccountMemberSelector,omitempty"`
+}
+
+// TableIAMMemberSpec defines the desired state of a TableIAMMember.
+type TableIAMMemberSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       TableIAMMemberParameters `json:"forProvider"`
+}
+
+// TableIAMMemberStatus represents the observed state of a TableIAMMember.
+type TableIAMMemberStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+
+// TableIAMMember is a managed resource that represents membership of the IAM
+// policy of a BigQuery table or view.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="TABLE",type="string",JSONPath=".spec.forProvider.table"
+// +kubebuilder:printcolumn:name="ROLE",type="string",JSONPath=".spec.forProvider.role"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type TableIAMMember struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TableIAMMemberSpec   `json:"spec"`
+	Status TableIAMMemberStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TableIAMMemberList contains a list of TableIAMMember.
+type TableIAMMemberList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TableIAMMember `json:"items"`
+}
@@ -0,0 +1,253 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// BudgetParameters define the desired state of a Cloud Billing Budget.
+type BudgetParameters struct {
+	// BillingAccount is the ID of the billing account the budget belongs
+	// to, e.g. "012345-567890-ABCDEF".
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="billingAccount is immutable"
+	BillingAccount string `json:"billingAccount"`
+
+	// DisplayName of the budget. Must be at most 60 characters long.
+	// +kubebuilder:validation:MaxLength=60
+	// +optional
+	DisplayName *string `json:"displayName,omitempty"`
+
+	// Amount is the budgeted amount for each usage period.
+	Amount BudgetAmount `json:"amount"`
+
+	// Filter limits the usage that is counted against the budget. All usage
+	// of the billing account is counted if omitted.
+	// +optional
+	Filter *BudgetFilter `json:"filter,omitempty"`
+
+	// ThresholdRules trigger alerts when spend exceeds a percentage of the
+	// budget. At least one is required for email notifications.
+	// +optional
+	ThresholdRules []ThresholdRule `json:"thresholdRules,omitempty"`
+
+	// NotificationsRule configures where alerts are sent.
+	// +optional
+	NotificationsRule *NotificationsRule `json:"notificationsRule,omitempty"`
+}
+
+// BudgetAmount is the budgeted amount for each usage period. Exactly one of
+// SpecifiedAmount and LastPeriodAmount must be set.
+// +kubebuilder:validation:XValidation:rule="has(self.specifiedAmount) != has(self.lastPeriodAmount)",message="exactly one of specifiedAmount and lastPeriodAmount must be set"
+type BudgetAmount struct {
+	// SpecifiedAmount is a fixed amount to budget.
+	// +optional
+	SpecifiedAmount *Money `json:"specifiedAmount,omitempty"`
+
+	// LastPeriodAmount budgets the actual spend of the previous period. It
+	// can only be used with a calendar period.
+	// +optional
+	LastPeriodAmount *bool `json:"lastPeriodAmount,omitempty"`
+}
+
+// Money is an amount of money in a currency.
+type Money struct {
+	// CurrencyCode is the three-letter ISO 4217 currency code. It must
+	// match the currency of the billing account, which is used if omitted.
+	// +optional
+	CurrencyCode *string `json:"currencyCode,omitempty"`
+
+	// Units are the whole units of the amount.
+	Units int64 `json:"units"`
+
+	// Nanos are the nano (10^-9) units of the amount.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=999999999
+	// +optional
+	Nanos *int64 `json:"nanos,omitempty"`
+}
+
+// BudgetFilter limits the usage that is counted against a budget.
+type BudgetFilter struct {
+	// Projects whose usage is counted, in the form
+	// "projects/{project_number}". Project numbers rather than IDs must be
+	// used, because they are what GCP reports back.
+	// +optional
+	Projects []string `json:"projects,omitempty"`
+
+	// Services whose usage is counted, in the form "services/{service_id}".
+	// +optional
+	Services []string `json:"services,omitempty"`
+
+	// Subaccounts whose usage is counted, in the form
+	// "billingAccounts/{account_id}".
+	// +optional
+	Subaccounts []string `json:"subaccounts,omitempty"`
+
+	// Labels limit the counted usage to resources with the label. Only a
+	// single label is supported.
+	// +kubebuilder:validation:MaxProperties=1
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// CreditTypesTreatment specifies how credits are applied to spend.
+	// Defaults to INCLUDE_ALL_CREDITS.
+	// +kubebuilder:validation:Enum=INCLUDE_ALL_CREDITS;EXCLUDE_ALL_CREDITS;INCLUDE_SPECIFIED_CREDITS
+	// +optional
+	CreditTypesTreatment *string `json:"creditTypesTreatment,omitempty"`
+
+	// CreditTypes subtracted from spend when CreditTypesTreatment is
+	// INCLUDE_SPECIFIED_CREDITS.
+	// +optional
+	CreditTypes []string `json:"creditTypes,omitempty"`
+
+	// CalendarPeriod is the recurring period usage is tracked for. Defaults
+	// to MONTH unless a CustomPeriod is set.
+	// +kubebuilder:validation:Enum=MONTH;QUARTER;YEAR
+	// +optional
+	CalendarPeriod *string `json:"calendarPeriod,omitempty"`
+
+	// CustomPeriod is a fixed period usage is tracked for.
+	// +optional
+	CustomPeriod *CustomPeriod `json:"customPeriod,omitempty"`
+}
+
+// CustomPeriod is a fixed period of time.
+type CustomPeriod struct {
+	// StartDate of the period. Must be after January 1, 2017.
+	StartDate Date `json:"startDate"`
+
+	// EndDate of the period. Usage is tracked indefinitely if omitted.
+	// +optional
+	EndDate *Date `json:"endDate,omitempty"`
+}
+
+// Date is a calendar date.
+type Date struct {
+	// +kubebuilder:validation:Minimum=2017
+	Year int64 `json:"year"`
+
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=12
+	Month int64 `json:"month"`
+
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=31
+	Day int64 `json:"day"`
+}
+
+// ThresholdRule triggers an alert when spend exceeds a percentage of the
+// budget.
+type ThresholdRule struct {
+	// Percent of the budget that spend must exceed to trigger an alert,
+	// e.g. 90. Values above 100 alert on overspend.
+	// +kubebuilder:validation:Minimum=0
+	Percent int64 `json:"percent"`
+
+	// SpendBasis is the spend compared with the threshold. Defaults to
+	// CURRENT_SPEND.
+	// +kubebuilder:validation:Enum=CURRENT_SPEND;FORECASTED_SPEND
+	// +optional
+	SpendBasis *string `json:"spendBasis,omitempty"`
+}
+
+// NotificationsRule configures where budget alerts are sent.
+type NotificationsRule struct {
+	// PubsubTopic that budget updates are published to, in the form
+	// "projects/{project_id}/topics/{topic_id}".
+	// +optional
+	PubsubTopic *string `json:"pubsubTopic,omitempty"`
+
+	// PubsubTopicRef references a Topic to set PubsubTopic.
+	// +optional
+	PubsubTopicRef *xpv1.Reference `json:"pubsubTopicRef,omitempty"`
+
+	// PubsubTopicSelector selects a reference to a Topic to set
+	// PubsubTopic.
+	// +optional
+	PubsubTopicSelector *xpv1.Selector `json:"pubsubTopicSelector,omitempty"`
+
+	// SchemaVersion of the notifications published to PubsubTopic. Only
+	// "1.0" is accepted, which is used if omitted.
+	// +optional
+	SchemaVersion *string `json:"schemaVersion,omitempty"`
+
+	// MonitoringNotificationChannels are up to five Cloud Monitoring email
+	// channels alerts are sent to, in the form
+	// "projects/{project_id}/notificationChannels/{channel_id}".
+	// +kubebuilder:validation:MaxItems=5
+	// +optional
+	MonitoringNotificationChannels []string `json:"monitoringNotificationChannels,omitempty"`
+
+	// DisableDefaultIAMRecipients stops alerts from being emailed to the
+	// billing administrators and users of the billing account.
+	// +optional
+	DisableDefaultIAMRecipients *bool `json:"disableDefaultIamRecipients,omitempty"`
+}
+
+// BudgetObservation is used to show the observed state of the Budget.
+type BudgetObservation struct {
+	// Name is the resource name of the budget, e.g.
+	// "billingAccounts/012345-567890-ABCDEF/budgets/f1b2c3...".
+	Name string `json:"name,omitempty"`
+
+	// Etag of the budget.
+	Etag string `json:"etag,omitempty"`
+}
+
+// BudgetSpec defines the desired state of a Budget.
+type BudgetSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       BudgetParameters `json:"forProvider"`
+}
+
+// BudgetStatus represents the observed state of a Budget.
+type BudgetStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          BudgetObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// Budget is a managed resource that represents a Cloud Billing budget. The
+// budget ID is assigned by Google and is written to the external name
+// annotation.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="BILLING-ACCOUNT",type="string",JSONPath=".spec.forProvider.billingAccount"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type Budget struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BudgetSpec   `json:"spec"`
+	Status BudgetStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BudgetList contains a list of Budget types
+type BudgetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Budget `json:"items"`
+}
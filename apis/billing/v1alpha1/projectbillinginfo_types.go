@@ -0,0 +1,92 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// ProjectBillingInfoParameters define the desired state of the billing
+// account link of a project.
+type ProjectBillingInfoParameters struct {
+	// Project is the ID of the project to link. Defaults to the project of
+	// the ProviderConfig.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="project is immutable"
+	// +optional
+	Project *string `json:"project,omitempty"`
+
+	// BillingAccount is the ID of the billing account the project is
+	// charged to, e.g. "012345-567890-ABCDEF".
+	BillingAccount string `json:"billingAccount"`
+}
+
+// ProjectBillingInfoObservation is used to show the observed state of the
+// ProjectBillingInfo.
+type ProjectBillingInfoObservation struct {
+	// Name is the resource name of the billing info, e.g.
+	// "projects/my-project/billingInfo".
+	Name string `json:"name,omitempty"`
+
+	// BillingEnabled is true if the project is linked to an open billing
+	// account.
+	BillingEnabled bool `json:"billingEnabled,omitempty"`
+}
+
+// ProjectBillingInfoSpec defines the desired state of a ProjectBillingInfo.
+type ProjectBillingInfoSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ProjectBillingInfoParameters `json:"forProvider"`
+}
+
+// ProjectBillingInfoStatus represents the observed state of a
+// ProjectBillingInfo.
+type ProjectBillingInfoStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ProjectBillingInfoObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ProjectBillingInfo is a managed resource that represents the link between
+// a project and the billing account it is charged to. Deleting it unlinks
+// the billing account, which disables billing and stops the paid services of
+// the project; use the Orphan deletion policy to keep the link.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="BILLING-ACCOUNT",type="string",JSONPath=".spec.forProvider.billingAccount"
+// +kubebuilder:printcolumn:name="ENABLED",type="boolean",JSONPath=".status.atProvider.billingEnabled"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type ProjectBillingInfo struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ProjectBillingInfoSpec   `json:"spec"`
+	Status ProjectBillingInfoStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ProjectBillingInfoList contains a list of ProjectBillingInfo types
+type ProjectBillingInfoList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ProjectBillingInfo `json:"items"`
+}
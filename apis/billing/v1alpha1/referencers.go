@@ -0,0 +1,53 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+
+	pubsubv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/pubsub/v1alpha1"
+)
+
+// ResolveReferences of this Budget.
+func (mg *Budget) ResolveReferences(ctx context.Context, c client.Reader) error {
+	nr := mg.Spec.ForProvider.NotificationsRule
+	if nr == nil {
+		return nil
+	}
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.notificationsRule.pubsubTopic
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(nr.PubsubTopic),
+		Reference:    nr.PubsubTopicRef,
+		Selector:     nr.PubsubTopicSelector,
+		To:           reference.To{Managed: &pubsubv1alpha1.Topic{}, List: &pubsubv1alpha1.TopicList{}},
+		Extract:      pubsubv1alpha1.TopicRRN(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.notificationsRule.pubsubTopic")
+	}
+	nr.PubsubTopic = reference.ToPtrValue(rsp.ResolvedValue)
+	nr.PubsubTopicRef = rsp.ResolvedReference
+
+	return nil
+}
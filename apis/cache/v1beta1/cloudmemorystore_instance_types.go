@@ -22,6 +22,14 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 )
 
+// Connection secret keys of a CloudMemorystoreInstance, published in addition
+// to the standard endpoint, port and password keys.
+const (
+	CloudMemorystoreSecretHostKey         = "host"
+	CloudMemorystoreSecretAuthStringKey   = "authString"
+	CloudMemorystoreSecretServerCACertKey = "serverCaCert"
+)
+
 // CloudMemorystoreInstanceParameters define the desired state of an Google
 // Cloud Memorystore instance. Most fields map directly to an Instance:
 // https://cloud.google.com/memorystore/docs/redis/reference/rest/v1/projects.locations.instances#Instance
@@ -256,10 +264,13 @@ type CloudMemorystoreInstanceStatus struct {
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
 // +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
 // +kubebuilder:printcolumn:name="VERSION",type="string",JSONPath=".spec.forProvider.redisVersion"
+// +kubebuilder:printcolumn:name="TIER",type="string",JSONPath=".spec.forProvider.tier",priority=1
+// +kubebuilder:printcolumn:name="HOST",type="string",JSONPath=".status.atProvider.host",priority=1
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
-// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp},shortName=redis
 type CloudMemorystoreInstance struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
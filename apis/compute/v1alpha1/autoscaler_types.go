@@ -0,0 +1,263 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// AutoscalerParameters define the desired state of a Google Compute Engine
+// regional Autoscaler.
+type AutoscalerParameters struct {
+	// Region: URL of the region where the autoscaler and the regional
+	// managed instance group it scales reside. Defaults to the default
+	// region of the ProviderConfig.
+	// +optional
+	// +immutable
+	Region string `json:"region,omitempty"`
+
+	// Target: URL of the managed instance group that this autoscaler will
+	// scale.
+	// +immutable
+	Target string `json:"target"`
+
+	// Description: An optional description of this resource.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// AutoscalingPolicy: The configuration parameters for the autoscaling
+	// algorithm. If none of cpuUtilization, customMetricUtilizations, and
+	// loadBalancingUtilization are specified, the default will be to
+	// autoscale based on cpuUtilization to 0.6 or 60%.
+	AutoscalingPolicy AutoscalingPolicy `json:"autoscalingPolicy"`
+}
+
+// AutoscalingPolicy is the configuration parameters for the autoscaling
+// algorithm.
+// +kubebuilder:validation:XValidation:rule="!has(self.minNumReplicas) || self.minNumReplicas <= self.maxNumReplicas",message="minNumReplicas must not exceed maxNumReplicas"
+type AutoscalingPolicy struct {
+	// MinNumReplicas: The minimum number of replicas that the autoscaler
+	// can scale in to.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	MinNumReplicas *int64 `json:"minNumReplicas,omitempty"`
+
+	// MaxNumReplicas: The maximum number of instances that the autoscaler
+	// can scale out to.
+	// +kubebuilder:validation:Minimum=0
+	MaxNumReplicas int64 `json:"maxNumReplicas"`
+
+	// CoolDownPeriodSec: The number of seconds that your application takes
+	// to initialize on a VM instance. The default is 60 seconds.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	CoolDownPeriodSec *int64 `json:"coolDownPeriodSec,omitempty"`
+
+	// Mode: Defines the operating mode for this policy.
+	// +optional
+	// +kubebuilder:validation:Enum=OFF;ON;ONLY_SCALE_OUT;ONLY_UP
+	Mode *string `json:"mode,omitempty"`
+
+	// CPUUtilization: Defines the CPU utilization policy that allows the
+	// autoscaler to scale based on the average CPU utilization of a
+	// managed instance group.
+	// +optional
+	CPUUtilization *AutoscalingPolicyCPUUtilization `json:"cpuUtilization,omitempty"`
+
+	// LoadBalancingUtilization: Configuration parameters of autoscaling
+	// based on load balancer.
+	// +optional
+	LoadBalancingUtilization *AutoscalingPolicyLoadBalancingUtilization `json:"loadBalancingUtilization,omitempty"`
+
+	// CustomMetricUtilizations: Configuration parameters of autoscaling
+	// based on a custom metric.
+	// +optional
+	CustomMetricUtilizations []AutoscalingPolicyCustomMetricUtilization `json:"customMetricUtilizations,omitempty"`
+
+	// ScaleInControl: Controls how far and how fast the autoscaler may
+	// scale in.
+	// +optional
+	ScaleInControl *AutoscalingPolicyScaleInControl `json:"scaleInControl,omitempty"`
+}
+
+// AutoscalingPolicyCPUUtilization defines the CPU utilization policy.
+type AutoscalingPolicyCPUUtilization struct {
+	// UtilizationTarget: The target CPU utilization that the autoscaler
+	// maintains, as a decimal string greater than 0.0 and at most 1.0, e.g.
+	// "0.6".
+	// +kubebuilder:validation:Pattern=`^(0?\.[0-9]*[1-9][0-9]*|1(\.0*)?)$`
+	UtilizationTarget string `json:"utilizationTarget"`
+
+	// PredictiveMethod: Indicates whether predictive autoscaling based on
+	// CPU metric is enabled.
+	// +optional
+	// +kubebuilder:validation:Enum=NONE;OPTIMIZE_AVAILABILITY
+	PredictiveMethod *string `json:"predictiveMethod,omitempty"`
+}
+
+// AutoscalingPolicyLoadBalancingUtilization defines autoscaling based on
+// load balancer serving capacity.
+type AutoscalingPolicyLoadBalancingUtilization struct {
+	// UtilizationTarget: Fraction of backend capacity utilization (set in
+	// HTTP(S) load balancing configuration) that the autoscaler maintains,
+	// as a decimal string greater than 0.0 and at most 1.0, e.g. "0.8".
+	// +kubebuilder:validation:Pattern=`^(0?\.[0-9]*[1-9][0-9]*|1(\.0*)?)$`
+	UtilizationTarget string `json:"utilizationTarget"`
+}
+
+// AutoscalingPolicyCustomMetricUtilization defines autoscaling based on a
+// Cloud Monitoring metric.
+type AutoscalingPolicyCustomMetricUtilization struct {
+	// Metric: The identifier (type) of the Cloud Monitoring metric.
+	Metric string `json:"metric"`
+
+	// Filter: A filter string, compatible with a Stackdriver Monitoring
+	// filter string for TimeSeries.list API call.
+	// +optional
+	Filter *string `json:"filter,omitempty"`
+
+	// UtilizationTarget: The target value of the metric that autoscaler
+	// maintains, as a decimal string, e.g. "100" or "0.5".
+	// +optional
+	// +kubebuilder:validation:Pattern=`^[0-9]+(\.[0-9]+)?$`
+	UtilizationTarget *string `json:"utilizationTarget,omitempty"`
+
+	// UtilizationTargetType: Defines how target utilization value is
+	// expressed for a Stackdriver Monitoring metric.
+	// +optional
+	// +kubebuilder:validation:Enum=DELTA_PER_MINUTE;DELTA_PER_SECOND;GAUGE
+	UtilizationTargetType *string `json:"utilizationTargetType,omitempty"`
+
+	// SingleInstanceAssignment: If scaling is based on a per-group metric
+	// value that represents the total amount of work to be done or resource
+	// usage, set this value to an amount assigned for a single instance of
+	// the scaled group, as a decimal string.
+	// +optional
+	// +kubebuilder:validation:Pattern=`^[0-9]+(\.[0-9]+)?$`
+	SingleInstanceAssignment *string `json:"singleInstanceAssignment,omitempty"`
+}
+
+// AutoscalingPolicyScaleInControl configures how far and how fast the
+// autoscaler may scale in.
+type AutoscalingPolicyScaleInControl struct {
+	// MaxScaledInReplicas: Maximum allowed number (or %) of VMs that can be
+	// deducted from the peak recommendation during the window.
+	// +optional
+	MaxScaledInReplicas *FixedOrPercent `json:"maxScaledInReplicas,omitempty"`
+
+	// TimeWindowSec: How far back autoscaling looks when computing
+	// recommendations to include directives regarding slower scale in.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	TimeWindowSec *int64 `json:"timeWindowSec,omitempty"`
+}
+
+// FixedOrPercent encapsulates numeric value that can be either absolute or
+// relative.
+// +kubebuilder:validation:XValidation:rule="!(has(self.fixed) && has(self.percent))",message="only one of fixed and percent may be set"
+type FixedOrPercent struct {
+	// Fixed: Specifies a fixed number of VM instances.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	Fixed *int64 `json:"fixed,omitempty"`
+
+	// Percent: Specifies a percentage of instances between 0 to 100%,
+	// inclusive.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	Percent *int64 `json:"percent,omitempty"`
+}
+
+// An AutoscalerStatusDetails is a status message reported by the
+// autoscaler.
+type AutoscalerStatusDetails struct {
+	// Message: The status message.
+	Message string `json:"message,omitempty"`
+
+	// Type: The type of error, warning, or notice returned.
+	Type string `json:"type,omitempty"`
+}
+
+// An AutoscalerObservation represents the observed state of a Google Compute
+// Engine Autoscaler.
+type AutoscalerObservation struct {
+	// CreationTimestamp: Creation timestamp in RFC3339 text format.
+	CreationTimestamp string `json:"creationTimestamp,omitempty"`
+
+	// ID: The unique identifier for the resource. This identifier is
+	// defined by the server.
+	ID uint64 `json:"id,omitempty"`
+
+	// SelfLink: Server-defined URL for the resource.
+	SelfLink string `json:"selfLink,omitempty"`
+
+	// Status: The status of the autoscaler configuration.
+	Status string `json:"status,omitempty"`
+
+	// StatusDetails: Human-readable details about the current state of the
+	// autoscaler.
+	StatusDetails []AutoscalerStatusDetails `json:"statusDetails,omitempty"`
+
+	// RecommendedSize: Target recommended MIG size (number of instances)
+	// computed by autoscaler.
+	RecommendedSize int64 `json:"recommendedSize,omitempty"`
+}
+
+// An AutoscalerSpec defines the desired state of an Autoscaler.
+type AutoscalerSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       AutoscalerParameters `json:"forProvider"`
+}
+
+// An AutoscalerStatus represents the observed state of an Autoscaler.
+type AutoscalerStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          AutoscalerObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An Autoscaler is a managed resource that represents a Google Compute Engine
+// regional autoscaler attached to a regional managed instance group.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="RECOMMENDED",type="integer",JSONPath=".status.atProvider.recommendedSize"
+// +kubebuilder:printcolumn:name="TARGET",type="string",JSONPath=".spec.forProvider.target",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp},shortName=as
+type Autoscaler struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AutoscalerSpec   `json:"spec"`
+	Status AutoscalerStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AutoscalerList contains a list of Autoscaler.
+type AutoscalerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Autoscaler `json:"items"`
+}
@@ -47,12 +47,16 @@ type FirewallParameters struct {
 	// +immutable
 	Network *string `json:"network,omitempty"`
 
-	// NetworkRef references a Network and retrieves its URI
+	// NetworkRef references a Network and retrieves its URI.
+	// Network takes precedence over NetworkRef, unless the resolve policy of
+	// NetworkRef is Always.
 	// +optional
 	// +immutable
 	NetworkRef *xpv1.Reference `json:"networkRef,omitempty"`
 
-	// NetworkSelector selects a reference to a Network
+	// NetworkSelector selects a reference to a Network.
+	// NetworkSelector is only used if neither Network nor NetworkRef is set,
+	// unless its resolve policy is Always.
 	// +optional
 	// +immutable
 	NetworkSelector *xpv1.Selector `json:"networkSelector,omitempty"`
@@ -234,6 +238,18 @@ type FirewallObservation struct {
 type FirewallSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       FirewallParameters `json:"forProvider"`
+
+	// UpdatePolicy controls how changes to the immutable fields of
+	// forProvider are applied. With the default Update policy they are sent
+	// to GCP like any other change, which rejects or ignores them. With
+	// ReplaceOnImmutableChange the Firewall is deleted and created again with the
+	// changed fields, but only once the replacement is confirmed by
+	// annotating this resource with gcp.crossplane.io/confirm-replace set to
+	// its current metadata.generation. Resources whose deletion policy is
+	// Orphan are never replaced.
+	// +optional
+	// +kubebuilder:validation:Enum=Update;ReplaceOnImmutableChange
+	UpdatePolicy string `json:"updatePolicy,omitempty"`
 }
 
 // A FirewallStatus represents the observed state of a Firewall.
@@ -245,10 +261,14 @@ type FirewallStatus struct {
 // +kubebuilder:object:root=true
 
 // A Firewall is a managed resource that represents a Google Compute Engine Firewall
+// +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
-// +kubebuilder:subresource:status
-// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="NETWORK",type="string",JSONPath=".spec.forProvider.network"
+// +kubebuilder:printcolumn:name="PRIORITY",type="integer",JSONPath=".spec.forProvider.priority",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp},shortName=fw
 type Firewall struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
@@ -0,0 +1,228 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// ForwardingRuleParameters define the desired state of a regional Google
+// Compute Engine ForwardingRule. Most fields map directly to a
+// ForwardingRule:
+// https://cloud.google.com/compute/docs/reference/rest/v1/forwardingRules
+type ForwardingRuleParameters struct {
+	// Description: An optional description of this resource.
+	// +optional
+	// +immutable
+	Description *string `json:"description,omitempty"`
+
+	// Region: URL of the region where the forwarding rule resides.
+	// Defaults to the default region of the ProviderConfig.
+	// +optional
+	// +immutable
+	Region string `json:"region,omitempty"`
+
+	// IPAddress: The IP address or the URL of the Address the forwarding
+	// rule serves. A Private Service Connect endpoint requires a reserved
+	// INTERNAL Address.
+	// +optional
+	// +immutable
+	IPAddress *string `json:"ipAddress,omitempty"`
+
+	// IPAddressRef references an Address and retrieves its URI.
+	// +optional
+	// +immutable
+	IPAddressRef *xpv1.Reference `json:"ipAddressRef,omitempty"`
+
+	// IPAddressSelector selects a reference to an Address.
+	// +optional
+	IPAddressSelector *xpv1.Selector `json:"ipAddressSelector,omitempty"`
+
+	// IPProtocol: The IP protocol to which this rule applies. Must be
+	// omitted for a Private Service Connect endpoint.
+	//
+	// Possible values:
+	//   "AH"
+	//   "ESP"
+	//   "ICMP"
+	//   "L3_DEFAULT"
+	//   "SCTP"
+	//   "TCP"
+	//   "UDP"
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:Enum=AH;ESP;ICMP;L3_DEFAULT;SCTP;TCP;UDP
+	IPProtocol *string `json:"ipProtocol,omitempty"`
+
+	// LoadBalancingScheme: The type of load balancing the forwarding rule
+	// is used for. Must be omitted for a Private Service Connect endpoint.
+	//
+	// Possible values:
+	//   "EXTERNAL"
+	//   "EXTERNAL_MANAGED"
+	//   "INTERNAL"
+	//   "INTERNAL_MANAGED"
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:Enum=EXTERNAL;EXTERNAL_MANAGED;INTERNAL;INTERNAL_MANAGED
+	LoadBalancingScheme *string `json:"loadBalancingScheme,omitempty"`
+
+	// BackendService: The URL of the regional backend service of an
+	// internal passthrough load balancer.
+	// +optional
+	// +immutable
+	BackendService *string `json:"backendService,omitempty"`
+
+	// Target: The URL of the target resource that receives the traffic.
+	// For a Private Service Connect endpoint this is the URL of the
+	// producer's ServiceAttachment.
+	// +optional
+	// +immutable
+	Target *string `json:"target,omitempty"`
+
+	// TargetServiceAttachmentRef references a ServiceAttachment and
+	// retrieves its URI.
+	// +optional
+	// +immutable
+	TargetServiceAttachmentRef *xpv1.Reference `json:"targetServiceAttachmentRef,omitempty"`
+
+	// TargetServiceAttachmentSelector selects a reference to a
+	// ServiceAttachment.
+	// +optional
+	TargetServiceAttachmentSelector *xpv1.Selector `json:"targetServiceAttachmentSelector,omitempty"`
+
+	// Network: The URL of the network the forwarding rule belongs to.
+	// +optional
+	// +immutable
+	Network *string `json:"network,omitempty"`
+
+	// NetworkRef references a Network and retrieves its URI.
+	// +optional
+	// +immutable
+	NetworkRef *xpv1.Reference `json:"networkRef,omitempty"`
+
+	// NetworkSelector selects a reference to a Network.
+	// +optional
+	NetworkSelector *xpv1.Selector `json:"networkSelector,omitempty"`
+
+	// Subnetwork: The URL of the subnetwork the IP address of an internal
+	// forwarding rule belongs to.
+	// +optional
+	// +immutable
+	Subnetwork *string `json:"subnetwork,omitempty"`
+
+	// SubnetworkRef references a Subnetwork and retrieves its URI.
+	// +optional
+	// +immutable
+	SubnetworkRef *xpv1.Reference `json:"subnetworkRef,omitempty"`
+
+	// SubnetworkSelector selects a reference to a Subnetwork.
+	// +optional
+	SubnetworkSelector *xpv1.Selector `json:"subnetworkSelector,omitempty"`
+
+	// Ports: Up to five ports of an internal passthrough load balancer.
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:MaxItems=5
+	Ports []string `json:"ports,omitempty"`
+
+	// PortRange: The port range of a proxy-based load balancer, e.g.
+	// "80-8080".
+	// +optional
+	// +immutable
+	PortRange *string `json:"portRange,omitempty"`
+
+	// AllPorts: Whether all ports are forwarded by an internal passthrough
+	// load balancer.
+	// +optional
+	// +immutable
+	AllPorts *bool `json:"allPorts,omitempty"`
+
+	// AllowGlobalAccess: Whether clients in any region can reach an
+	// internal forwarding rule.
+	// +optional
+	AllowGlobalAccess *bool `json:"allowGlobalAccess,omitempty"`
+}
+
+// A ForwardingRuleObservation represents the observed state of a Google
+// Compute Engine ForwardingRule.
+type ForwardingRuleObservation struct {
+	// CreationTimestamp: Creation timestamp in RFC3339 text format.
+	CreationTimestamp string `json:"creationTimestamp,omitempty"`
+
+	// ID: The unique identifier for the resource.
+	ID uint64 `json:"id,omitempty"`
+
+	// IPAddress: The IP address the forwarding rule serves.
+	IPAddress string `json:"ipAddress,omitempty"`
+
+	// PscConnectionID: The ID of the Private Service Connect connection of
+	// an endpoint.
+	PscConnectionID uint64 `json:"pscConnectionId,omitempty"`
+
+	// PscConnectionStatus: The status of the Private Service Connect
+	// connection of an endpoint, e.g. ACCEPTED, PENDING or REJECTED.
+	PscConnectionStatus string `json:"pscConnectionStatus,omitempty"`
+
+	// SelfLink: Server-defined URL for the resource.
+	SelfLink string `json:"selfLink,omitempty"`
+}
+
+// A ForwardingRuleSpec defines the desired state of a ForwardingRule.
+type ForwardingRuleSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ForwardingRuleParameters `json:"forProvider"`
+}
+
+// A ForwardingRuleStatus represents the observed state of a ForwardingRule.
+type ForwardingRuleStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ForwardingRuleObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ForwardingRule is a managed resource that represents a regional Google
+// Compute Engine forwarding rule, such as the frontend of an internal load
+// balancer or a Private Service Connect endpoint.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="REGION",type="string",JSONPath=".spec.forProvider.region"
+// +kubebuilder:printcolumn:name="IP",type="string",JSONPath=".status.atProvider.ipAddress"
+// +kubebuilder:printcolumn:name="PSC-STATUS",type="string",JSONPath=".status.atProvider.pscConnectionStatus",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type ForwardingRule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ForwardingRuleSpec   `json:"spec"`
+	Status ForwardingRuleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ForwardingRuleList contains a list of ForwardingRule.
+type ForwardingRuleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ForwardingRule `json:"items"`
+}
@@ -0,0 +1,168 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Known ImageImport statuses, i.e. statuses of the imported image.
+const (
+	ImageImportStatusPending  = "PENDING"
+	ImageImportStatusReady    = "READY"
+	ImageImportStatusFailed   = "FAILED"
+	ImageImportStatusDeleting = "DELETING"
+)
+
+// An ImageEncryptionKey is a Cloud KMS key used to encrypt an image.
+type ImageEncryptionKey struct {
+	// KMSKeyName: The name of the Cloud KMS key, e.g.
+	// "projects/my-project/locations/global/keyRings/my-ring/cryptoKeys/my-key".
+	// +immutable
+	KMSKeyName string `json:"kmsKeyName"`
+
+	// KMSKeyServiceAccount: The service account used to access the key. The
+	// Compute Engine default service account is used if it is omitted.
+	// +optional
+	// +immutable
+	KMSKeyServiceAccount *string `json:"kmsKeyServiceAccount,omitempty"`
+}
+
+// ImageImportParameters define the desired state of a Google Compute Engine
+// image imported from Cloud Storage. Most fields map directly to an Image:
+// https://cloud.google.com/compute/docs/reference/rest/v1/images
+type ImageImportParameters struct {
+	// Source: The Cloud Storage object to import, e.g.
+	// "gs://my-bucket/my-disk.tar.gz". It must be a gzip compressed tarball
+	// containing a single raw disk image named disk.raw. Both gs:// and
+	// https://storage.googleapis.com/ URLs are supported.
+	// +immutable
+	Source string `json:"source"`
+
+	// SHA1Checksum: The optional SHA1 checksum of the disk image, which is
+	// verified before the image is created.
+	// +optional
+	// +immutable
+	SHA1Checksum *string `json:"sha1Checksum,omitempty"`
+
+	// Description: An optional description of this resource.
+	// +optional
+	// +immutable
+	Description *string `json:"description,omitempty"`
+
+	// Family: The name of the image family the image belongs to.
+	// +optional
+	// +immutable
+	Family *string `json:"family,omitempty"`
+
+	// Architecture: The architecture of the image, i.e. X86_64 or ARM64.
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:Enum=X86_64;ARM64
+	Architecture *string `json:"architecture,omitempty"`
+
+	// GuestOSFeatures: Features of the guest operating system of the image,
+	// e.g. UEFI_COMPATIBLE or VIRTIO_SCSI_MULTIQUEUE.
+	// +optional
+	// +immutable
+	GuestOSFeatures []string `json:"guestOsFeatures,omitempty"`
+
+	// Licenses: URLs of the licenses that apply to the image.
+	// +optional
+	// +immutable
+	Licenses []string `json:"licenses,omitempty"`
+
+	// StorageLocations: The Cloud Storage multi-region or region the image
+	// is stored in.
+	// +optional
+	// +immutable
+	StorageLocations []string `json:"storageLocations,omitempty"`
+
+	// EncryptionKey: The Cloud KMS key the image is encrypted with. It is
+	// encrypted with a Google-managed key if it is omitted.
+	// +optional
+	// +immutable
+	EncryptionKey *ImageEncryptionKey `json:"encryptionKey,omitempty"`
+}
+
+// An ImageImportObservation represents the observed state of a Google Compute
+// Engine image imported from Cloud Storage.
+type ImageImportObservation struct {
+	// ArchiveSizeBytes: The size of the imported tarball in bytes.
+	ArchiveSizeBytes int64 `json:"archiveSizeBytes,omitempty"`
+
+	// CreationTimestamp: Creation timestamp in RFC3339 text format.
+	CreationTimestamp string `json:"creationTimestamp,omitempty"`
+
+	// DiskSizeGB: The size of the image when restored onto a disk, in GB.
+	DiskSizeGB int64 `json:"diskSizeGb,omitempty"`
+
+	// ID: The unique identifier for the resource.
+	ID uint64 `json:"id,omitempty"`
+
+	// SelfLink: Server-defined URL for the resource.
+	SelfLink string `json:"selfLink,omitempty"`
+
+	// Status: The status of the image, i.e. PENDING, READY, FAILED or
+	// DELETING. The image can be used once it is READY.
+	Status string `json:"status,omitempty"`
+}
+
+// An ImageImportSpec defines the desired state of an ImageImport.
+type ImageImportSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ImageImportParameters `json:"forProvider"`
+}
+
+// An ImageImportStatus represents the observed state of an ImageImport.
+type ImageImportStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ImageImportObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An ImageImport is a managed resource that imports a disk image from Cloud
+// Storage into a Google Compute Engine image, e.g. as a step of a
+// lift-and-shift pipeline. The imported image is deleted along with the
+// ImageImport unless its deletion policy is Orphan. Images cannot be changed
+// once they are imported.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type ImageImport struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ImageImportSpec   `json:"spec"`
+	Status ImageImportStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ImageImportList contains a list of ImageImport.
+type ImageImportList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ImageImport `json:"items"`
+}
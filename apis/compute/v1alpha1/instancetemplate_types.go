@@ -0,0 +1,137 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// A DiskInstantiationConfig specifies how a disk of the source instance is
+// instantiated by the instances created from an InstanceTemplate.
+type DiskInstantiationConfig struct {
+	// DeviceName: The device name of the source instance disk the
+	// configuration applies to.
+	// +immutable
+	DeviceName string `json:"deviceName"`
+
+	// InstantiateFrom: Whether to include the disk and which image to use.
+	// Defaults to DEFAULT, which creates a custom image from boot and
+	// read-write disks and attaches read-only disks as they are.
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:Enum=DEFAULT;SOURCE_IMAGE;SOURCE_IMAGE_FAMILY;CUSTOM_IMAGE;ATTACH_READ_ONLY;BLANK;DO_NOT_INCLUDE
+	InstantiateFrom *string `json:"instantiateFrom,omitempty"`
+
+	// CustomImage: The image used to restore the disk. Required when
+	// InstantiateFrom is CUSTOM_IMAGE.
+	// +optional
+	// +immutable
+	CustomImage *string `json:"customImage,omitempty"`
+
+	// AutoDelete: Whether the disk is deleted with the instance it is
+	// attached to.
+	// +optional
+	// +immutable
+	AutoDelete *bool `json:"autoDelete,omitempty"`
+}
+
+// InstanceTemplateParameters define the desired state of a Google Compute
+// Engine InstanceTemplate that is created from a running instance. Most fields
+// map directly to an InstanceTemplate:
+// https://cloud.google.com/compute/docs/reference/rest/v1/instanceTemplates
+type InstanceTemplateParameters struct {
+	// Description: An optional description of this resource.
+	// +optional
+	// +immutable
+	Description *string `json:"description,omitempty"`
+
+	// SourceInstance: The instance the template is created from, as a
+	// partial or full URL, e.g. "projects/my-project/zones/us-central1-a/instances/my-vm".
+	// +immutable
+	SourceInstance string `json:"sourceInstance"`
+
+	// DiskConfigs: How each disk of the source instance is instantiated.
+	// Disks that are not listed use the DEFAULT behaviour.
+	// +optional
+	// +immutable
+	DiskConfigs []DiskInstantiationConfig `json:"diskConfigs,omitempty"`
+}
+
+// An InstanceTemplateObservation represents the observed state of a Google
+// Compute Engine InstanceTemplate.
+type InstanceTemplateObservation struct {
+	// CreationTimestamp: Creation timestamp in RFC3339 text format.
+	CreationTimestamp string `json:"creationTimestamp,omitempty"`
+
+	// ID: The unique identifier for the resource.
+	ID uint64 `json:"id,omitempty"`
+
+	// MachineType: The machine type of the instances created from the
+	// template.
+	MachineType string `json:"machineType,omitempty"`
+
+	// SelfLink: Server-defined URL for the resource.
+	SelfLink string `json:"selfLink,omitempty"`
+
+	// SourceInstance: The URL of the instance the template was created from.
+	SourceInstance string `json:"sourceInstance,omitempty"`
+}
+
+// An InstanceTemplateSpec defines the desired state of an InstanceTemplate.
+type InstanceTemplateSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       InstanceTemplateParameters `json:"forProvider"`
+}
+
+// An InstanceTemplateStatus represents the observed state of an
+// InstanceTemplate.
+type InstanceTemplateStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          InstanceTemplateObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An InstanceTemplate is a managed resource that represents a Google Compute
+// Engine instance template created from a running instance. Managed instance
+// groups use it to create identical instances. Instance templates cannot be
+// changed once they are created.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="MACHINE-TYPE",type="string",JSONPath=".status.atProvider.machineType"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type InstanceTemplate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   InstanceTemplateSpec   `json:"spec"`
+	Status InstanceTemplateStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// InstanceTemplateList contains a list of InstanceTemplate.
+type InstanceTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []InstanceTemplate `json:"items"`
+}
@@ -0,0 +1,261 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Known InterconnectAttachment types.
+const (
+	InterconnectAttachmentTypeDedicated       = "DEDICATED"
+	InterconnectAttachmentTypePartner         = "PARTNER"
+	InterconnectAttachmentTypePartnerProvider = "PARTNER_PROVIDER"
+)
+
+// Known InterconnectAttachment states.
+const (
+	InterconnectAttachmentStateActive          = "ACTIVE"
+	InterconnectAttachmentStatePendingPartner  = "PENDING_PARTNER"
+	InterconnectAttachmentStatePendingCustomer = "PENDING_CUSTOMER"
+)
+
+// InterconnectAttachmentSecretPairingKey is the key of the connection detail
+// a PARTNER InterconnectAttachment publishes its pairing key under.
+const InterconnectAttachmentSecretPairingKey = "pairingKey"
+
+// InterconnectAttachmentParameters define the desired state of a Google
+// Compute Engine InterconnectAttachment. Most fields map directly to an
+// InterconnectAttachment:
+// https://cloud.google.com/compute/docs/reference/rest/v1/interconnectAttachments
+type InterconnectAttachmentParameters struct {
+	// Description: An optional description of this resource.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Region: URL of the region where the attachment resides. Defaults to
+	// the default region of the ProviderConfig.
+	// +optional
+	// +immutable
+	Region string `json:"region,omitempty"`
+
+	// Router: URL of the Cloud Router the attachment uses. The router must
+	// be in the same region as the attachment.
+	// +optional
+	// +immutable
+	Router *string `json:"router,omitempty"`
+
+	// RouterRef references a Router and retrieves its URI.
+	// +optional
+	// +immutable
+	RouterRef *xpv1.Reference `json:"routerRef,omitempty"`
+
+	// RouterSelector selects a reference to a Router.
+	// +optional
+	RouterSelector *xpv1.Selector `json:"routerSelector,omitempty"`
+
+	// Type: The type of attachment.
+	//
+	// Possible values:
+	//   "DEDICATED" - Attachment to a dedicated interconnect.
+	//   "PARTNER" - Attachment to a partner interconnect, created by the
+	// customer.
+	//   "PARTNER_PROVIDER" - Attachment to a partner interconnect, created
+	// by the partner.
+	// +kubebuilder:validation:Enum=DEDICATED;PARTNER;PARTNER_PROVIDER
+	// +immutable
+	Type string `json:"type"`
+
+	// AdminEnabled: Whether the attachment passes traffic. A PARTNER
+	// attachment that is created disabled must be enabled once the partner
+	// has provisioned it, i.e. once its state is PENDING_CUSTOMER.
+	// +optional
+	AdminEnabled *bool `json:"adminEnabled,omitempty"`
+
+	// EdgeAvailabilityDomain: The availability domain of a PARTNER
+	// attachment. Create a pair of attachments in different domains for
+	// redundancy.
+	// +kubebuilder:validation:Enum=AVAILABILITY_DOMAIN_ANY;AVAILABILITY_DOMAIN_1;AVAILABILITY_DOMAIN_2
+	// +optional
+	// +immutable
+	EdgeAvailabilityDomain *string `json:"edgeAvailabilityDomain,omitempty"`
+
+	// Interconnect: URL of the dedicated interconnect of a DEDICATED
+	// attachment.
+	// +optional
+	// +immutable
+	Interconnect *string `json:"interconnect,omitempty"`
+
+	// VlanTag8021q: The IEEE 802.1Q VLAN tag of a DEDICATED attachment.
+	// +kubebuilder:validation:Minimum=2
+	// +kubebuilder:validation:Maximum=4094
+	// +optional
+	// +immutable
+	VlanTag8021q *int64 `json:"vlanTag8021q,omitempty"`
+
+	// Bandwidth: The provisioned bandwidth of a DEDICATED attachment, e.g.
+	// BPS_10G. The bandwidth of a PARTNER attachment is set by the partner.
+	// +optional
+	Bandwidth *string `json:"bandwidth,omitempty"`
+
+	// CandidateSubnets: Up to 16 /29 link-local prefixes, one of which is
+	// used for the link between the Cloud Router and the customer router.
+	// +optional
+	// +immutable
+	CandidateSubnets []string `json:"candidateSubnets,omitempty"`
+
+	// Mtu: The maximum transmission unit of the attachment, either 1440 or
+	// 1500.
+	// +optional
+	Mtu *int64 `json:"mtu,omitempty"`
+
+	// Encryption: Whether traffic is carried in HA VPN over Cloud
+	// Interconnect.
+	// +kubebuilder:validation:Enum=NONE;IPSEC
+	// +optional
+	// +immutable
+	Encryption *string `json:"encryption,omitempty"`
+
+	// StackType: The IP stack of the attachment.
+	// +kubebuilder:validation:Enum=IPV4_ONLY;IPV4_IPV6
+	// +optional
+	StackType *string `json:"stackType,omitempty"`
+
+	// PartnerMetadata: Information about the partner interconnect, set by
+	// the partner on a PARTNER_PROVIDER attachment.
+	// +optional
+	PartnerMetadata *InterconnectAttachmentPartnerMetadata `json:"partnerMetadata,omitempty"`
+}
+
+// InterconnectAttachmentPartnerMetadata describes the partner interconnect
+// an attachment is provisioned on.
+type InterconnectAttachmentPartnerMetadata struct {
+	// InterconnectName: The name of the partner's interconnect, shown to
+	// the customer, e.g. "Chicago 1".
+	// +optional
+	InterconnectName string `json:"interconnectName,omitempty"`
+
+	// PartnerName: The name of the partner, e.g. "Example Networks".
+	// +optional
+	PartnerName string `json:"partnerName,omitempty"`
+
+	// PortalURL: URL of the partner's portal the customer completes the
+	// connection in.
+	// +optional
+	PortalURL string `json:"portalUrl,omitempty"`
+}
+
+// An InterconnectAttachmentObservation represents the observed state of a
+// Google Compute Engine InterconnectAttachment.
+type InterconnectAttachmentObservation struct {
+	// Bandwidth: The provisioned bandwidth of the attachment.
+	Bandwidth string `json:"bandwidth,omitempty"`
+
+	// CloudRouterIPAddress: The IPv4 address and prefix of the Cloud Router
+	// side of the link.
+	CloudRouterIPAddress string `json:"cloudRouterIpAddress,omitempty"`
+
+	// CustomerRouterIPAddress: The IPv4 address and prefix of the customer
+	// router side of the link.
+	CustomerRouterIPAddress string `json:"customerRouterIpAddress,omitempty"`
+
+	// CreationTimestamp: Creation timestamp in RFC3339 text format.
+	CreationTimestamp string `json:"creationTimestamp,omitempty"`
+
+	// GoogleReferenceID: The ID Google support uses to identify the
+	// attachment.
+	GoogleReferenceID string `json:"googleReferenceId,omitempty"`
+
+	// ID: The unique identifier for the resource.
+	ID uint64 `json:"id,omitempty"`
+
+	// Interconnect: URL of the interconnect the attachment is provisioned
+	// on. Set by the partner on PARTNER attachments.
+	Interconnect string `json:"interconnect,omitempty"`
+
+	// OperationalStatus: Whether the attachment is ready to use, i.e.
+	// OS_ACTIVE or OS_UNPROVISIONED.
+	OperationalStatus string `json:"operationalStatus,omitempty"`
+
+	// PairingKey: The opaque key of a PARTNER attachment that is given to
+	// the partner to provision the attachment. Also published to the
+	// connection secret.
+	PairingKey string `json:"pairingKey,omitempty"`
+
+	// PartnerASN: The BGP ASN of the partner, if it uses a Layer 3
+	// connection.
+	PartnerASN int64 `json:"partnerAsn,omitempty"`
+
+	// PartnerMetadata: Information about the partner interconnect.
+	PartnerMetadata *InterconnectAttachmentPartnerMetadata `json:"partnerMetadata,omitempty"`
+
+	// SelfLink: Server-defined URL for the resource.
+	SelfLink string `json:"selfLink,omitempty"`
+
+	// State: The provisioning state of the attachment, e.g. PENDING_PARTNER,
+	// PENDING_CUSTOMER or ACTIVE.
+	State string `json:"state,omitempty"`
+
+	// VlanTag8021q: The IEEE 802.1Q VLAN tag of the attachment.
+	VlanTag8021q int64 `json:"vlanTag8021q,omitempty"`
+}
+
+// An InterconnectAttachmentSpec defines the desired state of an
+// InterconnectAttachment.
+type InterconnectAttachmentSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       InterconnectAttachmentParameters `json:"forProvider"`
+}
+
+// An InterconnectAttachmentStatus represents the observed state of an
+// InterconnectAttachment.
+type InterconnectAttachmentStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          InterconnectAttachmentObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An InterconnectAttachment is a managed resource that represents a VLAN
+// attachment connecting a VPC network to an on-premises network through
+// Cloud Interconnect.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".spec.forProvider.type"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type InterconnectAttachment struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   InterconnectAttachmentSpec   `json:"spec"`
+	Status InterconnectAttachmentStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// InterconnectAttachmentList contains a list of InterconnectAttachment.
+type InterconnectAttachmentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []InterconnectAttachment `json:"items"`
+}
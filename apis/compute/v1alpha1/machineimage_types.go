@@ -0,0 +1,148 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Known MachineImage statuses.
+const (
+	MachineImageStatusCreating  = "CREATING"
+	MachineImageStatusUploading = "UPLOADING"
+	MachineImageStatusReady     = "READY"
+	MachineImageStatusDeleting  = "DELETING"
+	MachineImageStatusInvalid   = "INVALID"
+)
+
+// A MachineImageEncryptionKey is a Cloud KMS key used to encrypt a
+// MachineImage.
+type MachineImageEncryptionKey struct {
+	// KMSKeyName: The name of the Cloud KMS key, e.g.
+	// "projects/my-project/locations/global/keyRings/my-ring/cryptoKeys/my-key".
+	// +immutable
+	KMSKeyName string `json:"kmsKeyName"`
+
+	// KMSKeyServiceAccount: The service account used to access the key. The
+	// Compute Engine default service account is used if it is omitted.
+	// +optional
+	// +immutable
+	KMSKeyServiceAccount *string `json:"kmsKeyServiceAccount,omitempty"`
+}
+
+// MachineImageParameters define the desired state of a Google Compute Engine
+// MachineImage. Most fields map directly to a MachineImage:
+// https://cloud.google.com/compute/docs/reference/rest/v1/machineImages
+type MachineImageParameters struct {
+	// Description: An optional description of this resource.
+	// +optional
+	// +immutable
+	Description *string `json:"description,omitempty"`
+
+	// SourceInstance: The instance the machine image is captured from, as a
+	// partial or full URL, e.g. "projects/my-project/zones/us-central1-a/instances/my-vm".
+	// +immutable
+	SourceInstance string `json:"sourceInstance"`
+
+	// GuestFlush: Whether to flush the guest file system caches of the
+	// source instance before its disks are captured, so that the machine
+	// image is application consistent. Only supported by Windows instances.
+	// +optional
+	// +immutable
+	GuestFlush *bool `json:"guestFlush,omitempty"`
+
+	// StorageLocations: The Cloud Storage multi-region or region the machine
+	// image is stored in. Defaults to the multi-region nearest to the source
+	// instance.
+	// +optional
+	// +immutable
+	StorageLocations []string `json:"storageLocations,omitempty"`
+
+	// EncryptionKey: The Cloud KMS key the machine image is encrypted with.
+	// It is encrypted with a Google-managed key if it is omitted.
+	// +optional
+	// +immutable
+	EncryptionKey *MachineImageEncryptionKey `json:"encryptionKey,omitempty"`
+}
+
+// A MachineImageObservation represents the observed state of a Google Compute
+// Engine MachineImage.
+type MachineImageObservation struct {
+	// CreationTimestamp: Creation timestamp in RFC3339 text format.
+	CreationTimestamp string `json:"creationTimestamp,omitempty"`
+
+	// ID: The unique identifier for the resource.
+	ID uint64 `json:"id,omitempty"`
+
+	// SelfLink: Server-defined URL for the resource.
+	SelfLink string `json:"selfLink,omitempty"`
+
+	// SourceInstance: The URL of the instance the machine image was
+	// captured from.
+	SourceInstance string `json:"sourceInstance,omitempty"`
+
+	// Status: The status of the machine image, e.g. CREATING, UPLOADING,
+	// READY, DELETING or INVALID.
+	Status string `json:"status,omitempty"`
+
+	// TotalStorageBytes: The size in bytes of the machine image.
+	TotalStorageBytes int64 `json:"totalStorageBytes,omitempty"`
+}
+
+// A MachineImageSpec defines the desired state of a MachineImage.
+type MachineImageSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       MachineImageParameters `json:"forProvider"`
+}
+
+// A MachineImageStatus represents the observed state of a MachineImage.
+type MachineImageStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          MachineImageObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A MachineImage is a managed resource that represents a Google Compute Engine
+// machine image. It captures the configuration, metadata and disks of a
+// running instance, e.g. to roll a golden VM out to a managed instance group.
+// Machine images cannot be changed once they are created.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type MachineImage struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   MachineImageSpec   `json:"spec"`
+	Status MachineImageStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// MachineImageList contains a list of MachineImage.
+type MachineImageList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MachineImage `json:"items"`
+}
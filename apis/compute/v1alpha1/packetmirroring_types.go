@@ -0,0 +1,210 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// PacketMirroringParameters define the desired state of a Google Compute
+// Engine PacketMirroring policy. Most fields map directly to a
+// PacketMirroring:
+// https://cloud.google.com/compute/docs/reference/rest/v1/packetMirrorings
+type PacketMirroringParameters struct {
+	// Description: An optional description of this resource.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Region: URL of the region where the packet mirroring policy resides.
+	// Defaults to the default region of the ProviderConfig.
+	// +optional
+	// +immutable
+	Region string `json:"region,omitempty"`
+
+	// Network: The URL of the mirrored VPC network. All mirrored instances
+	// and subnetworks must belong to it.
+	// +optional
+	// +immutable
+	Network *string `json:"network,omitempty"`
+
+	// NetworkRef references a Network and retrieves its URI.
+	// +optional
+	// +immutable
+	NetworkRef *xpv1.Reference `json:"networkRef,omitempty"`
+
+	// NetworkSelector selects a reference to a Network.
+	// +optional
+	NetworkSelector *xpv1.Selector `json:"networkSelector,omitempty"`
+
+	// CollectorILB: The URL of the forwarding rule of the internal load
+	// balancer that receives the mirrored traffic. The forwarding rule must
+	// have isMirroringCollector set to true.
+	// +optional
+	CollectorILB *string `json:"collectorIlb,omitempty"`
+
+	// CollectorILBRef references a ForwardingRule and retrieves its URI.
+	// +optional
+	CollectorILBRef *xpv1.Reference `json:"collectorIlbRef,omitempty"`
+
+	// CollectorILBSelector selects a reference to a ForwardingRule.
+	// +optional
+	CollectorILBSelector *xpv1.Selector `json:"collectorIlbSelector,omitempty"`
+
+	// CollectorILBEndpointRef references a Cloud IDS Endpoint and retrieves
+	// the URI of the forwarding rule it receives traffic on.
+	// +optional
+	CollectorILBEndpointRef *xpv1.Reference `json:"collectorIlbEndpointRef,omitempty"`
+
+	// CollectorILBEndpointSelector selects a reference to a Cloud IDS
+	// Endpoint.
+	// +optional
+	CollectorILBEndpointSelector *xpv1.Selector `json:"collectorIlbEndpointSelector,omitempty"`
+
+	// MirroredResources: The instances, subnetworks and network tags whose
+	// traffic is mirrored.
+	MirroredResources PacketMirroringMirroredResources `json:"mirroredResources"`
+
+	// Filter: Filter for mirrored traffic. All traffic is mirrored if it is
+	// omitted.
+	// +optional
+	Filter *PacketMirroringFilter `json:"filter,omitempty"`
+
+	// Priority: The priority of the policy when more than one policy
+	// mirrors the traffic of an instance. The lowest value wins. Defaults
+	// to 1000.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=65535
+	Priority *int64 `json:"priority,omitempty"`
+
+	// Enable: Whether the policy takes effect. Defaults to TRUE.
+	//
+	// Possible values:
+	//   "FALSE"
+	//   "TRUE"
+	// +optional
+	// +kubebuilder:validation:Enum=FALSE;TRUE
+	Enable *string `json:"enable,omitempty"`
+}
+
+// PacketMirroringMirroredResources are the resources whose traffic a
+// PacketMirroring policy mirrors.
+type PacketMirroringMirroredResources struct {
+	// Instances: The URLs of up to 50 mirrored instances. They must live in
+	// the region of the policy.
+	// +optional
+	// +kubebuilder:validation:MaxItems=50
+	Instances []string `json:"instances,omitempty"`
+
+	// Subnetworks: The URLs of up to 5 subnetworks whose instances are
+	// mirrored. They must live in the region of the policy.
+	// +optional
+	// +kubebuilder:validation:MaxItems=5
+	Subnetworks []string `json:"subnetworks,omitempty"`
+
+	// SubnetworksRefs references Subnetworks and retrieves their URIs.
+	// +optional
+	SubnetworksRefs []xpv1.Reference `json:"subnetworksRefs,omitempty"`
+
+	// SubnetworksSelector selects references to Subnetworks.
+	// +optional
+	SubnetworksSelector *xpv1.Selector `json:"subnetworksSelector,omitempty"`
+
+	// Tags: The network tags of the mirrored instances.
+	// +optional
+	Tags []string `json:"tags,omitempty"`
+}
+
+// PacketMirroringFilter selects the mirrored traffic.
+type PacketMirroringFilter struct {
+	// IPProtocols: The protocols of the mirrored traffic, e.g. tcp.
+	// +optional
+	IPProtocols []string `json:"ipProtocols,omitempty"`
+
+	// CIDRRanges: The IPv4 CIDR ranges of the source of ingress and the
+	// destination of egress traffic that is mirrored.
+	// +optional
+	CIDRRanges []string `json:"cidrRanges,omitempty"`
+
+	// Direction: The direction of the mirrored traffic. Defaults to BOTH.
+	//
+	// Possible values:
+	//   "BOTH"
+	//   "EGRESS"
+	//   "INGRESS"
+	// +optional
+	// +kubebuilder:validation:Enum=BOTH;EGRESS;INGRESS
+	Direction *string `json:"direction,omitempty"`
+}
+
+// A PacketMirroringObservation represents the observed state of a Google
+// Compute Engine PacketMirroring policy.
+type PacketMirroringObservation struct {
+	// CreationTimestamp: Creation timestamp in RFC3339 text format.
+	CreationTimestamp string `json:"creationTimestamp,omitempty"`
+
+	// ID: The unique identifier for the resource.
+	ID uint64 `json:"id,omitempty"`
+
+	// SelfLink: Server-defined URL for the resource.
+	SelfLink string `json:"selfLink,omitempty"`
+}
+
+// A PacketMirroringSpec defines the desired state of a PacketMirroring.
+type PacketMirroringSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       PacketMirroringParameters `json:"forProvider"`
+}
+
+// A PacketMirroringStatus represents the observed state of a
+// PacketMirroring.
+type PacketMirroringStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          PacketMirroringObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A PacketMirroring is a managed resource that represents a Google Compute
+// Engine packet mirroring policy, which copies the traffic of instances to
+// the collector of an internal load balancer, such as a Cloud IDS Endpoint.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="REGION",type="string",JSONPath=".spec.forProvider.region"
+// +kubebuilder:printcolumn:name="ENABLED",type="string",JSONPath=".spec.forProvider.enable",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type PacketMirroring struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PacketMirroringSpec   `json:"spec"`
+	Status PacketMirroringStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PacketMirroringList contains a list of PacketMirroring.
+type PacketMirroringList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PacketMirroring `json:"items"`
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// ProjectSSHKeyParameters define the desired state of an SSH key in the
+// project-wide metadata of a Google Compute Engine project.
+type ProjectSSHKeyParameters struct {
+	// Username is the user on the VM instances that the key grants access
+	// to.
+	// +kubebuilder:validation:Pattern=`^[a-z_][a-z0-9_.-]*$`
+	// +immutable
+	Username string `json:"username"`
+
+	// PublicKey is the OpenSSH public key, e.g.
+	// "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAA... alice@example.com". A key
+	// in the metadata is considered the same if it has the same type and key
+	// material, regardless of its comment.
+	// +immutable
+	PublicKey string `json:"publicKey"`
+}
+
+// ProjectSSHKeySpec defines the desired state of a ProjectSSHKey.
+type ProjectSSHKeySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ProjectSSHKeyParameters `json:"forProvider"`
+}
+
+// ProjectSSHKeyStatus represents the observed state of a ProjectSSHKey.
+type ProjectSSHKeyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+
+// ProjectSSHKey is a managed resource that adds a single SSH key to the
+// ssh-keys item of the project-wide metadata of a Google Compute Engine
+// project. Other keys in the item, e.g. those managed by other teams or by
+// OS Login tooling, are left unchanged.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="USERNAME",type="string",JSONPath=".spec.forProvider.username"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type ProjectSSHKey struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ProjectSSHKeySpec   `json:"spec"`
+	Status ProjectSSHKeyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ProjectSSHKeyList contains a list of ProjectSSHKey.
+type ProjectSSHKeyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ProjectSSHKey `json:"items"`
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Known PublicAdvertisedPrefix statuses.
+const (
+	PublicAdvertisedPrefixStatusInitial                       = "INITIAL"
+	PublicAdvertisedPrefixStatusPTRConfigured                 = "PTR_CONFIGURED"
+	PublicAdvertisedPrefixStatusValidated                     = "VALIDATED"
+	PublicAdvertisedPrefixStatusReverseDNSLookupFailed        = "REVERSE_DNS_LOOKUP_FAILED"
+	PublicAdvertisedPrefixStatusPrefixConfigurationInProgress = "PREFIX_CONFIGURATION_IN_PROGRESS"
+	PublicAdvertisedPrefixStatusPrefixConfigurationComplete   = "PREFIX_CONFIGURATION_COMPLETE"
+	PublicAdvertisedPrefixStatusPrefixRemovalInProgress       = "PREFIX_REMOVAL_IN_PROGRESS"
+)
+
+// PublicAdvertisedPrefixParameters define the desired state of a Google
+// Compute Engine PublicAdvertisedPrefix. Most fields map directly to a
+// PublicAdvertisedPrefix:
+// https://cloud.google.com/compute/docs/reference/rest/v1/publicAdvertisedPrefixes
+type PublicAdvertisedPrefixParameters struct {
+	// Description: An optional description of this resource.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// IPCIDRRange: The IPv4 address range, in CIDR format, that you own and
+	// want to bring to Google Cloud, e.g. "203.0.113.0/24".
+	// +immutable
+	IPCIDRRange string `json:"ipCidrRange"`
+
+	// DNSVerificationIP: The IPv4 address within IPCIDRRange that is used
+	// for reverse DNS verification of the prefix.
+	// +immutable
+	DNSVerificationIP string `json:"dnsVerificationIp"`
+}
+
+// A PublicAdvertisedPrefixPublicDelegatedPrefix is a PublicDelegatedPrefix
+// that was carved out of a PublicAdvertisedPrefix.
+type PublicAdvertisedPrefixPublicDelegatedPrefix struct {
+	// IPRange: The IP address range of the public delegated prefix.
+	IPRange string `json:"ipRange,omitempty"`
+
+	// Name: The name of the public delegated prefix.
+	Name string `json:"name,omitempty"`
+
+	// Project: The project number of the public delegated prefix.
+	Project string `json:"project,omitempty"`
+
+	// Region: The region of the public delegated prefix if it is regional.
+	Region string `json:"region,omitempty"`
+
+	// Status: The status of the public delegated prefix.
+	Status string `json:"status,omitempty"`
+}
+
+// A PublicAdvertisedPrefixObservation represents the observed state of a
+// Google Compute Engine PublicAdvertisedPrefix.
+type PublicAdvertisedPrefixObservation struct {
+	// CreationTimestamp: Creation timestamp in RFC3339 text format.
+	CreationTimestamp string `json:"creationTimestamp,omitempty"`
+
+	// Fingerprint: Fingerprint of this resource, used for optimistic
+	// locking.
+	Fingerprint string `json:"fingerprint,omitempty"`
+
+	// ID: The unique identifier for the resource.
+	ID uint64 `json:"id,omitempty"`
+
+	// PublicDelegatedPrefixes: The public delegated prefixes that exist for
+	// this public advertised prefix.
+	PublicDelegatedPrefixes []PublicAdvertisedPrefixPublicDelegatedPrefix `json:"publicDelegatedPrefixes,omitempty"`
+
+	// SelfLink: Server-defined URL for the resource.
+	SelfLink string `json:"selfLink,omitempty"`
+
+	// SharedSecret: The shared secret that must be published in the PTR
+	// record of DNSVerificationIP for the prefix to pass reverse DNS
+	// verification.
+	SharedSecret string `json:"sharedSecret,omitempty"`
+
+	// Status: The status of the public advertised prefix, e.g. INITIAL,
+	// PTR_CONFIGURED, VALIDATED or PREFIX_CONFIGURATION_COMPLETE.
+	Status string `json:"status,omitempty"`
+}
+
+// A PublicAdvertisedPrefixSpec defines the desired state of a
+// PublicAdvertisedPrefix.
+type PublicAdvertisedPrefixSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       PublicAdvertisedPrefixParameters `json:"forProvider"`
+}
+
+// A PublicAdvertisedPrefixStatus represents the observed state of a
+// PublicAdvertisedPrefix.
+type PublicAdvertisedPrefixStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          PublicAdvertisedPrefixObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A PublicAdvertisedPrefix is a managed resource that represents an IPv4
+// range you own and bring to Google Cloud (BYOIP). PublicDelegatedPrefixes
+// are carved out of it to make the range usable in a region.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="RANGE",type="string",JSONPath=".spec.forProvider.ipCidrRange"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type PublicAdvertisedPrefix struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PublicAdvertisedPrefixSpec   `json:"spec"`
+	Status PublicAdvertisedPrefixStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PublicAdvertisedPrefixList contains a list of PublicAdvertisedPrefix.
+type PublicAdvertisedPrefixList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PublicAdvertisedPrefix `json:"items"`
+}
@@ -0,0 +1,172 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Known PublicDelegatedPrefix statuses.
+const (
+	PublicDelegatedPrefixStatusInitializing    = "INITIALIZING"
+	PublicDelegatedPrefixStatusReadyToAnnounce = "READY_TO_ANNOUNCE"
+	PublicDelegatedPrefixStatusAnnounced       = "ANNOUNCED"
+	PublicDelegatedPrefixStatusDeleting        = "DELETING"
+)
+
+// PublicDelegatedPrefixParameters define the desired state of a Google
+// Compute Engine PublicDelegatedPrefix. Most fields map directly to a
+// PublicDelegatedPrefix:
+// https://cloud.google.com/compute/docs/reference/rest/v1/publicDelegatedPrefixes
+type PublicDelegatedPrefixParameters struct {
+	// Description: An optional description of this resource.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Region: URL of the region where the public delegated prefix resides.
+	// Defaults to the default region of the ProviderConfig.
+	// +optional
+	// +immutable
+	Region string `json:"region,omitempty"`
+
+	// IPCIDRRange: The IPv4 address range, in CIDR format, represented by
+	// this public delegated prefix. It must be within the range of the
+	// parent prefix.
+	// +immutable
+	IPCIDRRange string `json:"ipCidrRange"`
+
+	// ParentPrefix: The URL of the parent prefix, either a
+	// PublicAdvertisedPrefix or a PublicDelegatedPrefix.
+	// +optional
+	// +immutable
+	ParentPrefix *string `json:"parentPrefix,omitempty"`
+
+	// ParentPrefixRef references a PublicAdvertisedPrefix and retrieves its
+	// URI.
+	// +optional
+	// +immutable
+	ParentPrefixRef *xpv1.Reference `json:"parentPrefixRef,omitempty"`
+
+	// ParentPrefixSelector selects a reference to a PublicAdvertisedPrefix.
+	// +optional
+	ParentPrefixSelector *xpv1.Selector `json:"parentPrefixSelector,omitempty"`
+
+	// IsLiveMigration: Whether the prefix is live migrated from another
+	// provider.
+	// +optional
+	// +immutable
+	IsLiveMigration *bool `json:"isLiveMigration,omitempty"`
+}
+
+// A PublicDelegatedSubPrefix is a part of a PublicDelegatedPrefix that was
+// delegated further, e.g. to another project.
+type PublicDelegatedSubPrefix struct {
+	// DelegateeProject: Name of the project scoping the sub prefix.
+	DelegateeProject string `json:"delegateeProject,omitempty"`
+
+	// Description: The description of the sub prefix.
+	Description string `json:"description,omitempty"`
+
+	// IPCIDRRange: The IPv4 address range, in CIDR format, represented by
+	// the sub prefix.
+	IPCIDRRange string `json:"ipCidrRange,omitempty"`
+
+	// IsAddress: Whether the sub prefix is delegated to create Address
+	// resources in the delegatee project.
+	IsAddress bool `json:"isAddress,omitempty"`
+
+	// Name: The name of the sub prefix.
+	Name string `json:"name,omitempty"`
+
+	// Region: The region of the sub prefix if it is regional.
+	Region string `json:"region,omitempty"`
+
+	// Status: The status of the sub prefix, either ACTIVE or INACTIVE.
+	Status string `json:"status,omitempty"`
+}
+
+// A PublicDelegatedPrefixObservation represents the observed state of a
+// Google Compute Engine PublicDelegatedPrefix.
+type PublicDelegatedPrefixObservation struct {
+	// CreationTimestamp: Creation timestamp in RFC3339 text format.
+	CreationTimestamp string `json:"creationTimestamp,omitempty"`
+
+	// Fingerprint: Fingerprint of this resource, used for optimistic
+	// locking.
+	Fingerprint string `json:"fingerprint,omitempty"`
+
+	// ID: The unique identifier for the resource.
+	ID uint64 `json:"id,omitempty"`
+
+	// PublicDelegatedSubPrefixes: The sub prefixes that exist for this
+	// public delegated prefix.
+	PublicDelegatedSubPrefixes []PublicDelegatedSubPrefix `json:"publicDelegatedSubPrefixes,omitempty"`
+
+	// SelfLink: Server-defined URL for the resource.
+	SelfLink string `json:"selfLink,omitempty"`
+
+	// Status: The status of the public delegated prefix, e.g. INITIALIZING
+	// or ANNOUNCED. Addresses can only be created from an announced prefix.
+	Status string `json:"status,omitempty"`
+}
+
+// A PublicDelegatedPrefixSpec defines the desired state of a
+// PublicDelegatedPrefix.
+type PublicDelegatedPrefixSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       PublicDelegatedPrefixParameters `json:"forProvider"`
+}
+
+// A PublicDelegatedPrefixStatus represents the observed state of a
+// PublicDelegatedPrefix.
+type PublicDelegatedPrefixStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          PublicDelegatedPrefixObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A PublicDelegatedPrefix is a managed resource that makes a part of a
+// PublicAdvertisedPrefix usable in a region. Addresses and ForwardingRules
+// use its range by setting an address within it.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="REGION",type="string",JSONPath=".spec.forProvider.region"
+// +kubebuilder:printcolumn:name="RANGE",type="string",JSONPath=".spec.forProvider.ipCidrRange"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type PublicDelegatedPrefix struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PublicDelegatedPrefixSpec   `json:"spec"`
+	Status PublicDelegatedPrefixStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PublicDelegatedPrefixList contains a list of PublicDelegatedPrefix.
+type PublicDelegatedPrefixList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PublicDelegatedPrefix `json:"items"`
+}
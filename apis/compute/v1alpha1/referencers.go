@@ -18,16 +18,66 @@ package v1alpha1
 
 import (
 	"context"
+	"strings"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/crossplane/crossplane-runtime/pkg/errors"
 
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1beta1"
+	idsv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/ids/v1alpha1"
 )
 
+// ServiceAttachmentURL extracts the partially qualified URL of a
+// ServiceAttachment.
+func ServiceAttachmentURL() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		sa, ok := mg.(*ServiceAttachment)
+		if !ok {
+			return ""
+		}
+		return strings.TrimPrefix(sa.Status.AtProvider.SelfLink, v1beta1.ComputeURIPrefix)
+	}
+}
+
+// ForwardingRuleURL extracts the partially qualified URL of a
+// ForwardingRule.
+func ForwardingRuleURL() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		fr, ok := mg.(*ForwardingRule)
+		if !ok {
+			return ""
+		}
+		return strings.TrimPrefix(fr.Status.AtProvider.SelfLink, v1beta1.ComputeURIPrefix)
+	}
+}
+
+// PublicAdvertisedPrefixURL extracts the partially qualified URL of a
+// PublicAdvertisedPrefix.
+func PublicAdvertisedPrefixURL() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		p, ok := mg.(*PublicAdvertisedPrefix)
+		if !ok {
+			return ""
+		}
+		return strings.TrimPrefix(p.Status.AtProvider.SelfLink, v1beta1.ComputeURIPrefix)
+	}
+}
+
+// RouterURL extracts the partially qualified URL of a Router.
+func RouterURL() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		r, ok := mg.(*Router)
+		if !ok {
+			return ""
+		}
+		return strings.TrimPrefix(r.Status.AtProvider.SelfLink, v1beta1.ComputeURIPrefix)
+	}
+}
+
 // ResolveReferences of this Firewall
 func (mg *Firewall) ResolveReferences(ctx context.Context, c client.Reader) error {
 	r := reference.NewAPIResolver(c, mg)
@@ -69,3 +119,227 @@ func (mg *Router) ResolveReferences(ctx context.Context, c client.Reader) error
 
 	return nil
 }
+
+// ResolveReferences of this ServiceAttachment
+func (mg *ServiceAttachment) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.targetService
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.TargetService),
+		Reference:    mg.Spec.ForProvider.TargetServiceRef,
+		Selector:     mg.Spec.ForProvider.TargetServiceSelector,
+		To:           reference.To{Managed: &ForwardingRule{}, List: &ForwardingRuleList{}},
+		Extract:      ForwardingRuleURL(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.targetService")
+	}
+	mg.Spec.ForProvider.TargetService = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.TargetServiceRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.natSubnets
+	mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.NatSubnets,
+		References:    mg.Spec.ForProvider.NatSubnetsRefs,
+		Selector:      mg.Spec.ForProvider.NatSubnetsSelector,
+		To:            reference.To{Managed: &v1beta1.Subnetwork{}, List: &v1beta1.SubnetworkList{}},
+		Extract:       v1beta1.SubnetworkURL(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.natSubnets")
+	}
+	mg.Spec.ForProvider.NatSubnets = mrsp.ResolvedValues
+	mg.Spec.ForProvider.NatSubnetsRefs = mrsp.ResolvedReferences
+
+	return nil
+}
+
+// ResolveReferences of this ForwardingRule
+func (mg *ForwardingRule) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.ipAddress
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.IPAddress),
+		Reference:    mg.Spec.ForProvider.IPAddressRef,
+		Selector:     mg.Spec.ForProvider.IPAddressSelector,
+		To:           reference.To{Managed: &v1beta1.Address{}, List: &v1beta1.AddressList{}},
+		Extract:      v1beta1.AddressURL(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.ipAddress")
+	}
+	mg.Spec.ForProvider.IPAddress = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.IPAddressRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.target
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Target),
+		Reference:    mg.Spec.ForProvider.TargetServiceAttachmentRef,
+		Selector:     mg.Spec.ForProvider.TargetServiceAttachmentSelector,
+		To:           reference.To{Managed: &ServiceAttachment{}, List: &ServiceAttachmentList{}},
+		Extract:      ServiceAttachmentURL(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.target")
+	}
+	mg.Spec.ForProvider.Target = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.TargetServiceAttachmentRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.network
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Network),
+		Reference:    mg.Spec.ForProvider.NetworkRef,
+		Selector:     mg.Spec.ForProvider.NetworkSelector,
+		To:           reference.To{Managed: &v1beta1.Network{}, List: &v1beta1.NetworkList{}},
+		Extract:      v1beta1.NetworkURL(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.network")
+	}
+	mg.Spec.ForProvider.Network = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.NetworkRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.subnetwork
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Subnetwork),
+		Reference:    mg.Spec.ForProvider.SubnetworkRef,
+		Selector:     mg.Spec.ForProvider.SubnetworkSelector,
+		To:           reference.To{Managed: &v1beta1.Subnetwork{}, List: &v1beta1.SubnetworkList{}},
+		Extract:      v1beta1.SubnetworkURL(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.subnetwork")
+	}
+	mg.Spec.ForProvider.Subnetwork = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.SubnetworkRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this PublicDelegatedPrefix
+func (mg *PublicDelegatedPrefix) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.parentPrefix
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.ParentPrefix),
+		Reference:    mg.Spec.ForProvider.ParentPrefixRef,
+		Selector:     mg.Spec.ForProvider.ParentPrefixSelector,
+		To:           reference.To{Managed: &PublicAdvertisedPrefix{}, List: &PublicAdvertisedPrefixList{}},
+		Extract:      PublicAdvertisedPrefixURL(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.parentPrefix")
+	}
+	mg.Spec.ForProvider.ParentPrefix = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.ParentPrefixRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this PacketMirroring
+func (mg *PacketMirroring) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.network
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Network),
+		Reference:    mg.Spec.ForProvider.NetworkRef,
+		Selector:     mg.Spec.ForProvider.NetworkSelector,
+		To:           reference.To{Managed: &v1beta1.Network{}, List: &v1beta1.NetworkList{}},
+		Extract:      v1beta1.NetworkURL(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.network")
+	}
+	mg.Spec.ForProvider.Network = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.NetworkRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.collectorIlb
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.CollectorILB),
+		Reference:    mg.Spec.ForProvider.CollectorILBRef,
+		Selector:     mg.Spec.ForProvider.CollectorILBSelector,
+		To:           reference.To{Managed: &ForwardingRule{}, List: &ForwardingRuleList{}},
+		Extract:      ForwardingRuleURL(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.collectorIlb")
+	}
+	mg.Spec.ForProvider.CollectorILB = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.CollectorILBRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.collectorIlb from a Cloud IDS Endpoint
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.CollectorILB),
+		Reference:    mg.Spec.ForProvider.CollectorILBEndpointRef,
+		Selector:     mg.Spec.ForProvider.CollectorILBEndpointSelector,
+		To:           reference.To{Managed: &idsv1alpha1.Endpoint{}, List: &idsv1alpha1.EndpointList{}},
+		Extract:      idsv1alpha1.EndpointForwardingRuleURL(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.collectorIlb")
+	}
+	mg.Spec.ForProvider.CollectorILB = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.CollectorILBEndpointRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.mirroredResources.subnetworks
+	mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.MirroredResources.Subnetworks,
+		References:    mg.Spec.ForProvider.MirroredResources.SubnetworksRefs,
+		Selector:      mg.Spec.ForProvider.MirroredResources.SubnetworksSelector,
+		To:            reference.To{Managed: &v1beta1.Subnetwork{}, List: &v1beta1.SubnetworkList{}},
+		Extract:       v1beta1.SubnetworkURL(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.mirroredResources.subnetworks")
+	}
+	mg.Spec.ForProvider.MirroredResources.Subnetworks = mrsp.ResolvedValues
+	mg.Spec.ForProvider.MirroredResources.SubnetworksRefs = mrsp.ResolvedReferences
+
+	return nil
+}
+
+// ResolveReferences of this UnmanagedInstanceGroup
+func (mg *UnmanagedInstanceGroup) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.network
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Network),
+		Reference:    mg.Spec.ForProvider.NetworkRef,
+		Selector:     mg.Spec.ForProvider.NetworkSelector,
+		To:           reference.To{Managed: &v1beta1.Network{}, List: &v1beta1.NetworkList{}},
+		Extract:      v1beta1.NetworkURL(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.network")
+	}
+	mg.Spec.ForProvider.Network = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.NetworkRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this InterconnectAttachment
+func (mg *InterconnectAttachment) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.router
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Router),
+		Reference:    mg.Spec.ForProvider.RouterRef,
+		Selector:     mg.Spec.ForProvider.RouterSelector,
+		To:           reference.To{Managed: &Router{}, List: &RouterList{}},
+		Extract:      RouterURL(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.router")
+	}
+	mg.Spec.ForProvider.Router = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.RouterRef = rsp.ResolvedReference
+
+	return nil
+}
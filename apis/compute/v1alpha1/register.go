@@ -53,7 +53,133 @@ var (
 	RouterGroupVersionKind = SchemeGroupVersion.WithKind(RouterKind)
 )
 
+// TargetTCPProxy type metadata.
+var (
+	TargetTCPProxyKind             = reflect.TypeOf(TargetTCPProxy{}).Name()
+	TargetTCPProxyGroupKind        = schema.GroupKind{Group: Group, Kind: TargetTCPProxyKind}.String()
+	TargetTCPProxyKindAPIVersion   = TargetTCPProxyKind + "." + SchemeGroupVersion.String()
+	TargetTCPProxyGroupVersionKind = SchemeGroupVersion.WithKind(TargetTCPProxyKind)
+)
+
+// TargetSSLProxy type metadata.
+var (
+	TargetSSLProxyKind             = reflect.TypeOf(TargetSSLProxy{}).Name()
+	TargetSSLProxyGroupKind        = schema.GroupKind{Group: Group, Kind: TargetSSLProxyKind}.String()
+	TargetSSLProxyKindAPIVersion   = TargetSSLProxyKind + "." + SchemeGroupVersion.String()
+	TargetSSLProxyGroupVersionKind = SchemeGroupVersion.WithKind(TargetSSLProxyKind)
+)
+
+// Autoscaler type metadata.
+var (
+	AutoscalerKind             = reflect.TypeOf(Autoscaler{}).Name()
+	AutoscalerGroupKind        = schema.GroupKind{Group: Group, Kind: AutoscalerKind}.String()
+	AutoscalerKindAPIVersion   = AutoscalerKind + "." + SchemeGroupVersion.String()
+	AutoscalerGroupVersionKind = SchemeGroupVersion.WithKind(AutoscalerKind)
+)
+
+// ServiceAttachment type metadata.
+var (
+	ServiceAttachmentKind             = reflect.TypeOf(ServiceAttachment{}).Name()
+	ServiceAttachmentGroupKind        = schema.GroupKind{Group: Group, Kind: ServiceAttachmentKind}.String()
+	ServiceAttachmentKindAPIVersion   = ServiceAttachmentKind + "." + SchemeGroupVersion.String()
+	ServiceAttachmentGroupVersionKind = SchemeGroupVersion.WithKind(ServiceAttachmentKind)
+)
+
+// ForwardingRule type metadata.
+var (
+	ForwardingRuleKind             = reflect.TypeOf(ForwardingRule{}).Name()
+	ForwardingRuleGroupKind        = schema.GroupKind{Group: Group, Kind: ForwardingRuleKind}.String()
+	ForwardingRuleKindAPIVersion   = ForwardingRuleKind + "." + SchemeGroupVersion.String()
+	ForwardingRuleGroupVersionKind = SchemeGroupVersion.WithKind(ForwardingRuleKind)
+)
+
+// PublicAdvertisedPrefix type metadata.
+var (
+	PublicAdvertisedPrefixKind             = reflect.TypeOf(PublicAdvertisedPrefix{}).Name()
+	PublicAdvertisedPrefixGroupKind        = schema.GroupKind{Group: Group, Kind: PublicAdvertisedPrefixKind}.String()
+	PublicAdvertisedPrefixKindAPIVersion   = PublicAdvertisedPrefixKind + "." + SchemeGroupVersion.String()
+	PublicAdvertisedPrefixGroupVersionKind = SchemeGroupVersion.WithKind(PublicAdvertisedPrefixKind)
+)
+
+// PublicDelegatedPrefix type metadata.
+var (
+	PublicDelegatedPrefixKind             = reflect.TypeOf(PublicDelegatedPrefix{}).Name()
+	PublicDelegatedPrefixGroupKind        = schema.GroupKind{Group: Group, Kind: PublicDelegatedPrefixKind}.String()
+	PublicDelegatedPrefixKindAPIVersion   = PublicDelegatedPrefixKind + "." + SchemeGroupVersion.String()
+	PublicDelegatedPrefixGroupVersionKind = SchemeGroupVersion.WithKind(PublicDelegatedPrefixKind)
+)
+
+// PacketMirroring type metadata.
+var (
+	PacketMirroringKind             = reflect.TypeOf(PacketMirroring{}).Name()
+	PacketMirroringGroupKind        = schema.GroupKind{Group: Group, Kind: PacketMirroringKind}.String()
+	PacketMirroringKindAPIVersion   = PacketMirroringKind + "." + SchemeGroupVersion.String()
+	PacketMirroringGroupVersionKind = SchemeGroupVersion.WithKind(PacketMirroringKind)
+)
+
+// MachineImage type metadata.
+var (
+	MachineImageKind             = reflect.TypeOf(MachineImage{}).Name()
+	MachineImageGroupKind        = schema.GroupKind{Group: Group, Kind: MachineImageKind}.String()
+	MachineImageKindAPIVersion   = MachineImageKind + "." + SchemeGroupVersion.String()
+	MachineImageGroupVersionKind = SchemeGroupVersion.WithKind(MachineImageKind)
+)
+
+// InstanceTemplate type metadata.
+var (
+	InstanceTemplateKind             = reflect.TypeOf(InstanceTemplate{}).Name()
+	InstanceTemplateGroupKind        = schema.GroupKind{Group: Group, Kind: InstanceTemplateKind}.String()
+	InstanceTemplateKindAPIVersion   = InstanceTemplateKind + "." + SchemeGroupVersion.String()
+	InstanceTemplateGroupVersionKind = SchemeGroupVersion.WithKind(InstanceTemplateKind)
+)
+
+// ImageImport type metadata.
+var (
+	ImageImportKind             = reflect.TypeOf(ImageImport{}).Name()
+	ImageImportGroupKind        = schema.GroupKind{Group: Group, Kind: ImageImportKind}.String()
+	ImageImportKindAPIVersion   = ImageImportKind + "." + SchemeGroupVersion.String()
+	ImageImportGroupVersionKind = SchemeGroupVersion.WithKind(ImageImportKind)
+)
+
+// UnmanagedInstanceGroup type metadata.
+var (
+	UnmanagedInstanceGroupKind             = reflect.TypeOf(UnmanagedInstanceGroup{}).Name()
+	UnmanagedInstanceGroupGroupKind        = schema.GroupKind{Group: Group, Kind: UnmanagedInstanceGroupKind}.String()
+	UnmanagedInstanceGroupKindAPIVersion   = UnmanagedInstanceGroupKind + "." + SchemeGroupVersion.String()
+	UnmanagedInstanceGroupGroupVersionKind = SchemeGroupVersion.WithKind(UnmanagedInstanceGroupKind)
+)
+
+// InterconnectAttachment type metadata.
+var (
+	InterconnectAttachmentKind             = reflect.TypeOf(InterconnectAttachment{}).Name()
+	InterconnectAttachmentGroupKind        = schema.GroupKind{Group: Group, Kind: InterconnectAttachmentKind}.String()
+	InterconnectAttachmentKindAPIVersion   = InterconnectAttachmentKind + "." + SchemeGroupVersion.String()
+	InterconnectAttachmentGroupVersionKind = SchemeGroupVersion.WithKind(InterconnectAttachmentKind)
+)
+
+// ProjectSSHKey type metadata.
+var (
+	ProjectSSHKeyKind             = reflect.TypeOf(ProjectSSHKey{}).Name()
+	ProjectSSHKeyGroupKind        = schema.GroupKind{Group: Group, Kind: ProjectSSHKeyKind}.String()
+	ProjectSSHKeyKindAPIVersion   = ProjectSSHKeyKind + "." + SchemeGroupVersion.String()
+	ProjectSSHKeyGroupVersionKind = SchemeGroupVersion.WithKind(ProjectSSHKeyKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&Firewall{}, &FirewallList{})
 	SchemeBuilder.Register(&Router{}, &RouterList{})
+	SchemeBuilder.Register(&TargetTCPProxy{}, &TargetTCPProxyList{})
+	SchemeBuilder.Register(&TargetSSLProxy{}, &TargetSSLProxyList{})
+	SchemeBuilder.Register(&Autoscaler{}, &AutoscalerList{})
+	SchemeBuilder.Register(&ServiceAttachment{}, &ServiceAttachmentList{})
+	SchemeBuilder.Register(&ForwardingRule{}, &ForwardingRuleList{})
+	SchemeBuilder.Register(&PublicAdvertisedPrefix{}, &PublicAdvertisedPrefixList{})
+	SchemeBuilder.Register(&PublicDelegatedPrefix{}, &PublicDelegatedPrefixList{})
+	SchemeBuilder.Register(&PacketMirroring{}, &PacketMirroringList{})
+	SchemeBuilder.Register(&MachineImage{}, &MachineImageList{})
+	SchemeBuilder.Register(&InstanceTemplate{}, &InstanceTemplateList{})
+	SchemeBuilder.Register(&ImageImport{}, &ImageImportList{})
+	SchemeBuilder.Register(&UnmanagedInstanceGroup{}, &UnmanagedInstanceGroupList{})
+	SchemeBuilder.Register(&InterconnectAttachment{}, &InterconnectAttachmentList{})
+	SchemeBuilder.Register(&ProjectSSHKey{}, &ProjectSSHKeyList{})
 }
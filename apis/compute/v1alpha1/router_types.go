@@ -29,21 +29,27 @@ type RouterParameters struct {
 	Description *string `json:"description,omitempty"`
 
 	// Region: URL of the region where the Subnetwork resides. This field
-	// can be set only at resource creation time.
+	// can be set only at resource creation time. Defaults to the default
+	// region of the ProviderConfig.
+	// +optional
 	// +immutable
-	Region string `json:"region"`
+	Region string `json:"region,omitempty"`
 
 	// Network: URI of the network to which this router belongs.
 	// +immutable
 	// +optional
 	Network *string `json:"network,omitempty"`
 
-	// NetworkRef references a Network and retrieves its URI
+	// NetworkRef references a Network and retrieves its URI.
+	// Network takes precedence over NetworkRef, unless the resolve policy of
+	// NetworkRef is Always.
 	// +optional
 	// +immutable
 	NetworkRef *xpv1.Reference `json:"networkRef,omitempty"`
 
-	// NetworkSelector selects a reference to a Network
+	// NetworkSelector selects a reference to a Network.
+	// NetworkSelector is only used if neither Network nor NetworkRef is set,
+	// unless its resolve policy is Always.
 	// +optional
 	// +immutable
 	NetworkSelector *xpv1.Selector `json:"networkSelector,omitempty"`
@@ -411,10 +417,14 @@ type RouterStatus struct {
 // +kubebuilder:object:root=true
 
 // A Router is a managed resource that represents a Google Compute Engine Router
+// +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
-// +kubebuilder:subresource:status
-// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="REGION",type="string",JSONPath=".spec.forProvider.region"
+// +kubebuilder:printcolumn:name="NETWORK",type="string",JSONPath=".spec.forProvider.network",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp},shortName=rtr
 type Router struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
@@ -0,0 +1,190 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// ServiceAttachmentParameters define the desired state of a Google Compute
+// Engine ServiceAttachment. Most fields map directly to a ServiceAttachment:
+// https://cloud.google.com/compute/docs/reference/rest/v1/serviceAttachments
+type ServiceAttachmentParameters struct {
+	// Description: An optional description of this resource.
+	// +optional
+	// +immutable
+	Description *string `json:"description,omitempty"`
+
+	// Region: URL of the region where the service attachment resides.
+	// Defaults to the default region of the ProviderConfig.
+	// +optional
+	// +immutable
+	Region string `json:"region,omitempty"`
+
+	// TargetService: The URL of the internal load balancer forwarding rule
+	// that serves the published service.
+	// +optional
+	// +immutable
+	TargetService *string `json:"targetService,omitempty"`
+
+	// TargetServiceRef references a ForwardingRule and retrieves its URI.
+	// +optional
+	// +immutable
+	TargetServiceRef *xpv1.Reference `json:"targetServiceRef,omitempty"`
+
+	// TargetServiceSelector selects a reference to a ForwardingRule.
+	// +optional
+	TargetServiceSelector *xpv1.Selector `json:"targetServiceSelector,omitempty"`
+
+	// ConnectionPreference: Whether connections from consumer projects are
+	// accepted automatically or only if the project is listed in
+	// ConsumerAcceptLists.
+	//
+	// Possible values:
+	//   "ACCEPT_AUTOMATIC"
+	//   "ACCEPT_MANUAL"
+	// +kubebuilder:validation:Enum=ACCEPT_AUTOMATIC;ACCEPT_MANUAL
+	ConnectionPreference string `json:"connectionPreference"`
+
+	// NatSubnets: The URLs of the PRIVATE_SERVICE_CONNECT subnetworks that
+	// provide the addresses used to NAT traffic from consumers.
+	// +optional
+	NatSubnets []string `json:"natSubnets,omitempty"`
+
+	// NatSubnetsRefs references Subnetworks and retrieves their URIs.
+	// +optional
+	NatSubnetsRefs []xpv1.Reference `json:"natSubnetsRefs,omitempty"`
+
+	// NatSubnetsSelector selects references to Subnetworks.
+	// +optional
+	NatSubnetsSelector *xpv1.Selector `json:"natSubnetsSelector,omitempty"`
+
+	// EnableProxyProtocol: Whether the PROXY protocol is used to pass the
+	// consumer's connection details to the producer.
+	// +optional
+	// +immutable
+	EnableProxyProtocol *bool `json:"enableProxyProtocol,omitempty"`
+
+	// ConsumerAcceptLists: The projects that may connect, and how many
+	// endpoints each of them may connect. Only used with ACCEPT_MANUAL.
+	// +optional
+	ConsumerAcceptLists []ServiceAttachmentConsumerProjectLimit `json:"consumerAcceptLists,omitempty"`
+
+	// ConsumerRejectLists: The IDs or numbers of the projects that may not
+	// connect.
+	// +optional
+	ConsumerRejectLists []string `json:"consumerRejectLists,omitempty"`
+
+	// DomainNames: The DNS domain names that consumers use to reach the
+	// published service, e.g. "p.mycompany.com.".
+	// +optional
+	// +immutable
+	DomainNames []string `json:"domainNames,omitempty"`
+}
+
+// A ServiceAttachmentConsumerProjectLimit allows a consumer project to
+// connect to a ServiceAttachment.
+type ServiceAttachmentConsumerProjectLimit struct {
+	// ProjectIDOrNum: The ID or number of the consumer project.
+	ProjectIDOrNum string `json:"projectIdOrNum"`
+
+	// ConnectionLimit: The number of consumer endpoints the project may
+	// connect.
+	// +kubebuilder:validation:Minimum=0
+	ConnectionLimit int64 `json:"connectionLimit"`
+}
+
+// A ServiceAttachmentConnectedEndpoint is a consumer endpoint that connected
+// to a ServiceAttachment.
+type ServiceAttachmentConnectedEndpoint struct {
+	// Endpoint: The URL of the consumer forwarding rule.
+	Endpoint string `json:"endpoint,omitempty"`
+
+	// PscConnectionID: The ID of the Private Service Connect connection.
+	PscConnectionID uint64 `json:"pscConnectionId,omitempty"`
+
+	// Status: The status of the connection, e.g. ACCEPTED, PENDING or
+	// REJECTED.
+	Status string `json:"status,omitempty"`
+}
+
+// A ServiceAttachmentObservation represents the observed state of a Google
+// Compute Engine ServiceAttachment.
+type ServiceAttachmentObservation struct {
+	// ConnectedEndpoints: The consumer endpoints that connected to the
+	// service attachment.
+	ConnectedEndpoints []ServiceAttachmentConnectedEndpoint `json:"connectedEndpoints,omitempty"`
+
+	// CreationTimestamp: Creation timestamp in RFC3339 text format.
+	CreationTimestamp string `json:"creationTimestamp,omitempty"`
+
+	// Fingerprint: Fingerprint of this resource, used for optimistic
+	// locking.
+	Fingerprint string `json:"fingerprint,omitempty"`
+
+	// ID: The unique identifier for the resource.
+	ID uint64 `json:"id,omitempty"`
+
+	// SelfLink: Server-defined URL for the resource. Consumers use it as
+	// the target of their Private Service Connect endpoint.
+	SelfLink string `json:"selfLink,omitempty"`
+}
+
+// A ServiceAttachmentSpec defines the desired state of a ServiceAttachment.
+type ServiceAttachmentSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ServiceAttachmentParameters `json:"forProvider"`
+}
+
+// A ServiceAttachmentStatus represents the observed state of a
+// ServiceAttachment.
+type ServiceAttachmentStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ServiceAttachmentObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ServiceAttachment is a managed resource that publishes a service behind
+// an internal load balancer to other VPC networks through Private Service
+// Connect.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="REGION",type="string",JSONPath=".spec.forProvider.region"
+// +kubebuilder:printcolumn:name="PREFERENCE",type="string",JSONPath=".spec.forProvider.connectionPreference"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type ServiceAttachment struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ServiceAttachmentSpec   `json:"spec"`
+	Status ServiceAttachmentStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ServiceAttachmentList contains a list of ServiceAttachment.
+type ServiceAttachmentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ServiceAttachment `json:"items"`
+}
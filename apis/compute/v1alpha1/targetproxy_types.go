@@ -0,0 +1,200 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Proxy header values supported by target TCP and SSL proxies.
+const (
+	ProxyHeaderNone    = "NONE"
+	ProxyHeaderProxyV1 = "PROXY_V1"
+)
+
+// TargetTCPProxyParameters define the desired state of a Google Compute
+// Engine target TCP proxy.
+type TargetTCPProxyParameters struct {
+	// Description: An optional description of this resource.
+	// +optional
+	// +immutable
+	Description *string `json:"description,omitempty"`
+
+	// Service: URL to the BackendService resource.
+	Service string `json:"service"`
+
+	// ProxyHeader: Specifies the type of proxy header to append before
+	// sending data to the backend.
+	// +optional
+	// +kubebuilder:validation:Enum=NONE;PROXY_V1
+	ProxyHeader *string `json:"proxyHeader,omitempty"`
+
+	// ProxyBind: This field only applies when the forwarding rule that
+	// references this target proxy has a loadBalancingScheme set to
+	// INTERNAL_SELF_MANAGED.
+	// +optional
+	// +immutable
+	ProxyBind *bool `json:"proxyBind,omitempty"`
+}
+
+// A TargetTCPProxyObservation represents the observed state of a Google
+// Compute Engine target TCP proxy.
+type TargetTCPProxyObservation struct {
+	// CreationTimestamp: Creation timestamp in RFC3339 text format.
+	CreationTimestamp string `json:"creationTimestamp,omitempty"`
+
+	// ID: The unique identifier for the resource. This identifier is
+	// defined by the server.
+	ID uint64 `json:"id,omitempty"`
+
+	// SelfLink: Server-defined URL for the resource.
+	SelfLink string `json:"selfLink,omitempty"`
+}
+
+// A TargetTCPProxySpec defines the desired state of a TargetTCPProxy.
+type TargetTCPProxySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       TargetTCPProxyParameters `json:"forProvider"`
+}
+
+// A TargetTCPProxyStatus represents the observed state of a TargetTCPProxy.
+type TargetTCPProxyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          TargetTCPProxyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A TargetTCPProxy is a managed resource that represents a Google Compute
+// Engine target TCP proxy, used by TCP proxy load balancers.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="SERVICE",type="string",JSONPath=".spec.forProvider.service",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp},shortName=tcpproxy
+type TargetTCPProxy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TargetTCPProxySpec   `json:"spec"`
+	Status TargetTCPProxyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TargetTCPProxyList contains a list of TargetTCPProxy.
+type TargetTCPProxyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TargetTCPProxy `json:"items"`
+}
+
+// TargetSSLProxyParameters define the desired state of a Google Compute
+// Engine target SSL proxy.
+type TargetSSLProxyParameters struct {
+	// Description: An optional description of this resource.
+	// +optional
+	// +immutable
+	Description *string `json:"description,omitempty"`
+
+	// Service: URL to the BackendService resource.
+	Service string `json:"service"`
+
+	// ProxyHeader: Specifies the type of proxy header to append before
+	// sending data to the backend.
+	// +optional
+	// +kubebuilder:validation:Enum=NONE;PROXY_V1
+	ProxyHeader *string `json:"proxyHeader,omitempty"`
+
+	// SslCertificates: URLs to SslCertificate resources that are used to
+	// authenticate connections to Backends. At least one SSL certificate
+	// must be specified unless a certificate map is set.
+	// +optional
+	// +kubebuilder:validation:MaxItems=15
+	SslCertificates []string `json:"sslCertificates,omitempty"`
+
+	// SslPolicy: URL of SslPolicy resource that will be associated with the
+	// TargetSslProxy resource. If not set, the TargetSslProxy resource will
+	// not have any SSL policy configured.
+	// +optional
+	SslPolicy *string `json:"sslPolicy,omitempty"`
+
+	// CertificateMap: URL of a certificate map that identifies a
+	// certificate map associated with the given target proxy. This field
+	// can only be set for global target proxies, in the form
+	// "//certificatemanager.googleapis.com/projects/{project}/locations/{location}/certificateMaps/{resourceName}".
+	// +optional
+	CertificateMap *string `json:"certificateMap,omitempty"`
+}
+
+// A TargetSSLProxyObservation represents the observed state of a Google
+// Compute Engine target SSL proxy.
+type TargetSSLProxyObservation struct {
+	// CreationTimestamp: Creation timestamp in RFC3339 text format.
+	CreationTimestamp string `json:"creationTimestamp,omitempty"`
+
+	// ID: The unique identifier for the resource. This identifier is
+	// defined by the server.
+	ID uint64 `json:"id,omitempty"`
+
+	// SelfLink: Server-defined URL for the resource.
+	SelfLink string `json:"selfLink,omitempty"`
+}
+
+// A TargetSSLProxySpec defines the desired state of a TargetSSLProxy.
+type TargetSSLProxySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       TargetSSLProxyParameters `json:"forProvider"`
+}
+
+// A TargetSSLProxyStatus represents the observed state of a TargetSSLProxy.
+type TargetSSLProxyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          TargetSSLProxyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A TargetSSLProxy is a managed resource that represents a Google Compute
+// Engine target SSL proxy, used by SSL proxy load balancers.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="SERVICE",type="string",JSONPath=".spec.forProvider.service",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp},shortName=sslproxy
+type TargetSSLProxy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TargetSSLProxySpec   `json:"spec"`
+	Status TargetSSLProxyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TargetSSLProxyList contains a list of TargetSSLProxy.
+type TargetSSLProxyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TargetSSLProxy `json:"items"`
+}
@@ -0,0 +1,159 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// UnmanagedInstanceGroupParameters define the desired state of a Google
+// Compute Engine unmanaged instance group.
+type UnmanagedInstanceGroupParameters struct {
+	// Zone: The name of the zone where the instance group and its member
+	// instances reside. Defaults to the default zone of the ProviderConfig.
+	// +optional
+	// +immutable
+	Zone string `json:"zone,omitempty"`
+
+	// Description: An optional description of this resource.
+	// +optional
+	// +immutable
+	Description *string `json:"description,omitempty"`
+
+	// Network: URI of the network to which all member instances must belong.
+	// If omitted, the network of the first instance added to the group is
+	// used.
+	// +optional
+	// +immutable
+	Network *string `json:"network,omitempty"`
+
+	// NetworkRef references a Network and retrieves its URI.
+	// Network takes precedence over NetworkRef, unless the resolve policy of
+	// NetworkRef is Always.
+	// +optional
+	// +immutable
+	NetworkRef *xpv1.Reference `json:"networkRef,omitempty"`
+
+	// NetworkSelector selects a reference to a Network.
+	// NetworkSelector is only used if neither Network nor NetworkRef is set,
+	// unless its resolve policy is Always.
+	// +optional
+	// +immutable
+	NetworkSelector *xpv1.Selector `json:"networkSelector,omitempty"`
+
+	// Instances: The member instances of the group, either as the name of an
+	// instance in the zone of the group or as its URL, e.g.
+	// "projects/my-project/zones/us-central1-a/instances/my-instance".
+	// Instances that are not listed are removed from the group.
+	// +optional
+	// +listType=set
+	Instances []string `json:"instances,omitempty"`
+
+	// NamedPorts: Assigns a name to a port number, e.g. {name: "http",
+	// port: 80}, so that load balancing backend services can refer to the
+	// port of the member instances by name.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	NamedPorts []NamedPort `json:"namedPorts,omitempty"`
+}
+
+// A NamedPort assigns a name to a port number of the member instances of an
+// instance group.
+type NamedPort struct {
+	// Name: The name for this named port. The name must be 1-63 characters
+	// long, and comply with RFC1035.
+	// +kubebuilder:validation:Pattern=`^[a-z]([-a-z0-9]{0,61}[a-z0-9])?$`
+	Name string `json:"name"`
+
+	// Port: The port number, which can be a value between 1 and 65535.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port int64 `json:"port"`
+}
+
+// An UnmanagedInstanceGroupObservation represents the observed state of a
+// Google Compute Engine unmanaged instance group.
+type UnmanagedInstanceGroupObservation struct {
+	// CreationTimestamp: Creation timestamp in RFC3339 text format.
+	CreationTimestamp string `json:"creationTimestamp,omitempty"`
+
+	// Fingerprint: The fingerprint of the named ports.
+	Fingerprint string `json:"fingerprint,omitempty"`
+
+	// ID: The unique identifier for the resource.
+	ID uint64 `json:"id,omitempty"`
+
+	// Network: The URL of the network to which all member instances belong.
+	Network string `json:"network,omitempty"`
+
+	// SelfLink: Server-defined URL for the resource.
+	SelfLink string `json:"selfLink,omitempty"`
+
+	// Size: The number of member instances of the group.
+	Size int64 `json:"size,omitempty"`
+
+	// Subnetwork: The URL of the subnetwork to which all member instances
+	// belong.
+	Subnetwork string `json:"subnetwork,omitempty"`
+}
+
+// An UnmanagedInstanceGroupSpec defines the desired state of an
+// UnmanagedInstanceGroup.
+type UnmanagedInstanceGroupSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       UnmanagedInstanceGroupParameters `json:"forProvider"`
+}
+
+// An UnmanagedInstanceGroupStatus represents the observed state of an
+// UnmanagedInstanceGroup.
+type UnmanagedInstanceGroupStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          UnmanagedInstanceGroupObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An UnmanagedInstanceGroup is a managed resource that represents a Google
+// Compute Engine zonal instance group whose member instances are managed
+// individually, e.g. to serve as a backend of a load balancer.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="SIZE",type="integer",JSONPath=".status.atProvider.size"
+// +kubebuilder:printcolumn:name="ZONE",type="string",JSONPath=".spec.forProvider.zone",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type UnmanagedInstanceGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   UnmanagedInstanceGroupSpec   `json:"spec"`
+	Status UnmanagedInstanceGroupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// UnmanagedInstanceGroupList contains a list of UnmanagedInstanceGroup.
+type UnmanagedInstanceGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []UnmanagedInstanceGroup `json:"items"`
+}
@@ -26,6 +26,332 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Autoscaler) DeepCopyInto(out *Autoscaler) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Autoscaler.
+func (in *Autoscaler) DeepCopy() *Autoscaler {
+	if in == nil {
+		return nil
+	}
+	out := new(Autoscaler)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Autoscaler) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoscalerList) DeepCopyInto(out *AutoscalerList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Autoscaler, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoscalerList.
+func (in *AutoscalerList) DeepCopy() *AutoscalerList {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoscalerList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AutoscalerList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoscalerObservation) DeepCopyInto(out *AutoscalerObservation) {
+	*out = *in
+	if in.StatusDetails != nil {
+		in, out := &in.StatusDetails, &out.StatusDetails
+		*out = make([]AutoscalerStatusDetails, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoscalerObservation.
+func (in *AutoscalerObservation) DeepCopy() *AutoscalerObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoscalerObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoscalerParameters) DeepCopyInto(out *AutoscalerParameters) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	in.AutoscalingPolicy.DeepCopyInto(&out.AutoscalingPolicy)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoscalerParameters.
+func (in *AutoscalerParameters) DeepCopy() *AutoscalerParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoscalerParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoscalerSpec) DeepCopyInto(out *AutoscalerSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoscalerSpec.
+func (in *AutoscalerSpec) DeepCopy() *AutoscalerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoscalerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoscalerStatus) DeepCopyInto(out *AutoscalerStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoscalerStatus.
+func (in *AutoscalerStatus) DeepCopy() *AutoscalerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoscalerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoscalerStatusDetails) DeepCopyInto(out *AutoscalerStatusDetails) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoscalerStatusDetails.
+func (in *AutoscalerStatusDetails) DeepCopy() *AutoscalerStatusDetails {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoscalerStatusDetails)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoscalingPolicy) DeepCopyInto(out *AutoscalingPolicy) {
+	*out = *in
+	if in.MinNumReplicas != nil {
+		in, out := &in.MinNumReplicas, &out.MinNumReplicas
+		*out = new(int64)
+		**out = **in
+	}
+	if in.CoolDownPeriodSec != nil {
+		in, out := &in.CoolDownPeriodSec, &out.CoolDownPeriodSec
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Mode != nil {
+		in, out := &in.Mode, &out.Mode
+		*out = new(string)
+		**out = **in
+	}
+	if in.CPUUtilization != nil {
+		in, out := &in.CPUUtilization, &out.CPUUtilization
+		*out = new(AutoscalingPolicyCPUUtilization)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LoadBalancingUtilization != nil {
+		in, out := &in.LoadBalancingUtilization, &out.LoadBalancingUtilization
+		*out = new(AutoscalingPolicyLoadBalancingUtilization)
+		**out = **in
+	}
+	if in.CustomMetricUtilizations != nil {
+		in, out := &in.CustomMetricUtilizations, &out.CustomMetricUtilizations
+		*out = make([]AutoscalingPolicyCustomMetricUtilization, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ScaleInControl != nil {
+		in, out := &in.ScaleInControl, &out.ScaleInControl
+		*out = new(AutoscalingPolicyScaleInControl)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoscalingPolicy.
+func (in *AutoscalingPolicy) DeepCopy() *AutoscalingPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoscalingPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoscalingPolicyCPUUtilization) DeepCopyInto(out *AutoscalingPolicyCPUUtilization) {
+	*out = *in
+	if in.PredictiveMethod != nil {
+		in, out := &in.PredictiveMethod, &out.PredictiveMethod
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoscalingPolicyCPUUtilization.
+func (in *AutoscalingPolicyCPUUtilization) DeepCopy() *AutoscalingPolicyCPUUtilization {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoscalingPolicyCPUUtilization)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoscalingPolicyCustomMetricUtilization) DeepCopyInto(out *AutoscalingPolicyCustomMetricUtilization) {
+	*out = *in
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = new(string)
+		**out = **in
+	}
+	if in.UtilizationTarget != nil {
+		in, out := &in.UtilizationTarget, &out.UtilizationTarget
+		*out = new(string)
+		**out = **in
+	}
+	if in.UtilizationTargetType != nil {
+		in, out := &in.UtilizationTargetType, &out.UtilizationTargetType
+		*out = new(string)
+		**out = **in
+	}
+	if in.SingleInstanceAssignment != nil {
+		in, out := &in.SingleInstanceAssignment, &out.SingleInstanceAssignment
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoscalingPolicyCustomMetricUtilization.
+func (in *AutoscalingPolicyCustomMetricUtilization) DeepCopy() *AutoscalingPolicyCustomMetricUtilization {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoscalingPolicyCustomMetricUtilization)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoscalingPolicyLoadBalancingUtilization) DeepCopyInto(out *AutoscalingPolicyLoadBalancingUtilization) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoscalingPolicyLoadBalancingUtilization.
+func (in *AutoscalingPolicyLoadBalancingUtilization) DeepCopy() *AutoscalingPolicyLoadBalancingUtilization {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoscalingPolicyLoadBalancingUtilization)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoscalingPolicyScaleInControl) DeepCopyInto(out *AutoscalingPolicyScaleInControl) {
+	*out = *in
+	if in.MaxScaledInReplicas != nil {
+		in, out := &in.MaxScaledInReplicas, &out.MaxScaledInReplicas
+		*out = new(FixedOrPercent)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TimeWindowSec != nil {
+		in, out := &in.TimeWindowSec, &out.TimeWindowSec
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoscalingPolicyScaleInControl.
+func (in *AutoscalingPolicyScaleInControl) DeepCopy() *AutoscalingPolicyScaleInControl {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoscalingPolicyScaleInControl)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskInstantiationConfig) DeepCopyInto(out *DiskInstantiationConfig) {
+	*out = *in
+	if in.InstantiateFrom != nil {
+		in, out := &in.InstantiateFrom, &out.InstantiateFrom
+		*out = new(string)
+		**out = **in
+	}
+	if in.CustomImage != nil {
+		in, out := &in.CustomImage, &out.CustomImage
+		*out = new(string)
+		**out = **in
+	}
+	if in.AutoDelete != nil {
+		in, out := &in.AutoDelete, &out.AutoDelete
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskInstantiationConfig.
+func (in *DiskInstantiationConfig) DeepCopy() *DiskInstantiationConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskInstantiationConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Firewall) DeepCopyInto(out *Firewall) {
 	*out = *in
@@ -297,7 +623,32 @@ func (in *FirewallStatus) DeepCopy() *FirewallStatus {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Router) DeepCopyInto(out *Router) {
+func (in *FixedOrPercent) DeepCopyInto(out *FixedOrPercent) {
+	*out = *in
+	if in.Fixed != nil {
+		in, out := &in.Fixed, &out.Fixed
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Percent != nil {
+		in, out := &in.Percent, &out.Percent
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FixedOrPercent.
+func (in *FixedOrPercent) DeepCopy() *FixedOrPercent {
+	if in == nil {
+		return nil
+	}
+	out := new(FixedOrPercent)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ForwardingRule) DeepCopyInto(out *ForwardingRule) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -305,18 +656,18 @@ func (in *Router) DeepCopyInto(out *Router) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Router.
-func (in *Router) DeepCopy() *Router {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ForwardingRule.
+func (in *ForwardingRule) DeepCopy() *ForwardingRule {
 	if in == nil {
 		return nil
 	}
-	out := new(Router)
+	out := new(ForwardingRule)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Router) DeepCopyObject() runtime.Object {
+func (in *ForwardingRule) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -324,178 +675,2469 @@ func (in *Router) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RouterAdvertisedIpRange) DeepCopyInto(out *RouterAdvertisedIpRange) {
+func (in *ForwardingRuleList) DeepCopyInto(out *ForwardingRuleList) {
 	*out = *in
-	if in.Description != nil {
-		in, out := &in.Description, &out.Description
-		*out = new(string)
-		**out = **in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ForwardingRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ForwardingRuleList.
+func (in *ForwardingRuleList) DeepCopy() *ForwardingRuleList {
+	if in == nil {
+		return nil
+	}
+	out := new(ForwardingRuleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ForwardingRuleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ForwardingRuleObservation) DeepCopyInto(out *ForwardingRuleObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ForwardingRuleObservation.
+func (in *ForwardingRuleObservation) DeepCopy() *ForwardingRuleObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ForwardingRuleObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ForwardingRuleParameters) DeepCopyInto(out *ForwardingRuleParameters) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.IPAddress != nil {
+		in, out := &in.IPAddress, &out.IPAddress
+		*out = new(string)
+		**out = **in
+	}
+	if in.IPAddressRef != nil {
+		in, out := &in.IPAddressRef, &out.IPAddressRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IPAddressSelector != nil {
+		in, out := &in.IPAddressSelector, &out.IPAddressSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IPProtocol != nil {
+		in, out := &in.IPProtocol, &out.IPProtocol
+		*out = new(string)
+		**out = **in
+	}
+	if in.LoadBalancingScheme != nil {
+		in, out := &in.LoadBalancingScheme, &out.LoadBalancingScheme
+		*out = new(string)
+		**out = **in
+	}
+	if in.BackendService != nil {
+		in, out := &in.BackendService, &out.BackendService
+		*out = new(string)
+		**out = **in
+	}
+	if in.Target != nil {
+		in, out := &in.Target, &out.Target
+		*out = new(string)
+		**out = **in
+	}
+	if in.TargetServiceAttachmentRef != nil {
+		in, out := &in.TargetServiceAttachmentRef, &out.TargetServiceAttachmentRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TargetServiceAttachmentSelector != nil {
+		in, out := &in.TargetServiceAttachmentSelector, &out.TargetServiceAttachmentSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Network != nil {
+		in, out := &in.Network, &out.Network
+		*out = new(string)
+		**out = **in
+	}
+	if in.NetworkRef != nil {
+		in, out := &in.NetworkRef, &out.NetworkRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NetworkSelector != nil {
+		in, out := &in.NetworkSelector, &out.NetworkSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Subnetwork != nil {
+		in, out := &in.Subnetwork, &out.Subnetwork
+		*out = new(string)
+		**out = **in
+	}
+	if in.SubnetworkRef != nil {
+		in, out := &in.SubnetworkRef, &out.SubnetworkRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SubnetworkSelector != nil {
+		in, out := &in.SubnetworkSelector, &out.SubnetworkSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Ports != nil {
+		in, out := &in.Ports, &out.Ports
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PortRange != nil {
+		in, out := &in.PortRange, &out.PortRange
+		*out = new(string)
+		**out = **in
+	}
+	if in.AllPorts != nil {
+		in, out := &in.AllPorts, &out.AllPorts
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AllowGlobalAccess != nil {
+		in, out := &in.AllowGlobalAccess, &out.AllowGlobalAccess
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ForwardingRuleParameters.
+func (in *ForwardingRuleParameters) DeepCopy() *ForwardingRuleParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ForwardingRuleParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ForwardingRuleSpec) DeepCopyInto(out *ForwardingRuleSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ForwardingRuleSpec.
+func (in *ForwardingRuleSpec) DeepCopy() *ForwardingRuleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ForwardingRuleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ForwardingRuleStatus) DeepCopyInto(out *ForwardingRuleStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ForwardingRuleStatus.
+func (in *ForwardingRuleStatus) DeepCopy() *ForwardingRuleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ForwardingRuleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageEncryptionKey) DeepCopyInto(out *ImageEncryptionKey) {
+	*out = *in
+	if in.KMSKeyServiceAccount != nil {
+		in, out := &in.KMSKeyServiceAccount, &out.KMSKeyServiceAccount
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageEncryptionKey.
+func (in *ImageEncryptionKey) DeepCopy() *ImageEncryptionKey {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageEncryptionKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageImport) DeepCopyInto(out *ImageImport) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageImport.
+func (in *ImageImport) DeepCopy() *ImageImport {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageImport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ImageImport) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageImportList) DeepCopyInto(out *ImageImportList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ImageImport, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageImportList.
+func (in *ImageImportList) DeepCopy() *ImageImportList {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageImportList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ImageImportList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageImportObservation) DeepCopyInto(out *ImageImportObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageImportObservation.
+func (in *ImageImportObservation) DeepCopy() *ImageImportObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageImportObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageImportParameters) DeepCopyInto(out *ImageImportParameters) {
+	*out = *in
+	if in.SHA1Checksum != nil {
+		in, out := &in.SHA1Checksum, &out.SHA1Checksum
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Family != nil {
+		in, out := &in.Family, &out.Family
+		*out = new(string)
+		**out = **in
+	}
+	if in.Architecture != nil {
+		in, out := &in.Architecture, &out.Architecture
+		*out = new(string)
+		**out = **in
+	}
+	if in.GuestOSFeatures != nil {
+		in, out := &in.GuestOSFeatures, &out.GuestOSFeatures
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Licenses != nil {
+		in, out := &in.Licenses, &out.Licenses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.StorageLocations != nil {
+		in, out := &in.StorageLocations, &out.StorageLocations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.EncryptionKey != nil {
+		in, out := &in.EncryptionKey, &out.EncryptionKey
+		*out = new(ImageEncryptionKey)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageImportParameters.
+func (in *ImageImportParameters) DeepCopy() *ImageImportParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageImportParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageImportSpec) DeepCopyInto(out *ImageImportSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageImportSpec.
+func (in *ImageImportSpec) DeepCopy() *ImageImportSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageImportSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageImportStatus) DeepCopyInto(out *ImageImportStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageImportStatus.
+func (in *ImageImportStatus) DeepCopy() *ImageImportStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageImportStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceTemplate) DeepCopyInto(out *InstanceTemplate) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceTemplate.
+func (in *InstanceTemplate) DeepCopy() *InstanceTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *InstanceTemplate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceTemplateList) DeepCopyInto(out *InstanceTemplateList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]InstanceTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceTemplateList.
+func (in *InstanceTemplateList) DeepCopy() *InstanceTemplateList {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceTemplateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *InstanceTemplateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceTemplateObservation) DeepCopyInto(out *InstanceTemplateObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceTemplateObservation.
+func (in *InstanceTemplateObservation) DeepCopy() *InstanceTemplateObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceTemplateObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceTemplateParameters) DeepCopyInto(out *InstanceTemplateParameters) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.DiskConfigs != nil {
+		in, out := &in.DiskConfigs, &out.DiskConfigs
+		*out = make([]DiskInstantiationConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceTemplateParameters.
+func (in *InstanceTemplateParameters) DeepCopy() *InstanceTemplateParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceTemplateParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceTemplateSpec) DeepCopyInto(out *InstanceTemplateSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceTemplateSpec.
+func (in *InstanceTemplateSpec) DeepCopy() *InstanceTemplateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceTemplateStatus) DeepCopyInto(out *InstanceTemplateStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceTemplateStatus.
+func (in *InstanceTemplateStatus) DeepCopy() *InstanceTemplateStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceTemplateStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterconnectAttachment) DeepCopyInto(out *InterconnectAttachment) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterconnectAttachment.
+func (in *InterconnectAttachment) DeepCopy() *InterconnectAttachment {
+	if in == nil {
+		return nil
+	}
+	out := new(InterconnectAttachment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *InterconnectAttachment) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterconnectAttachmentList) DeepCopyInto(out *InterconnectAttachmentList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]InterconnectAttachment, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterconnectAttachmentList.
+func (in *InterconnectAttachmentList) DeepCopy() *InterconnectAttachmentList {
+	if in == nil {
+		return nil
+	}
+	out := new(InterconnectAttachmentList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *InterconnectAttachmentList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterconnectAttachmentObservation) DeepCopyInto(out *InterconnectAttachmentObservation) {
+	*out = *in
+	if in.PartnerMetadata != nil {
+		in, out := &in.PartnerMetadata, &out.PartnerMetadata
+		*out = new(InterconnectAttachmentPartnerMetadata)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterconnectAttachmentObservation.
+func (in *InterconnectAttachmentObservation) DeepCopy() *InterconnectAttachmentObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(InterconnectAttachmentObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterconnectAttachmentParameters) DeepCopyInto(out *InterconnectAttachmentParameters) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Router != nil {
+		in, out := &in.Router, &out.Router
+		*out = new(string)
+		**out = **in
+	}
+	if in.RouterRef != nil {
+		in, out := &in.RouterRef, &out.RouterRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RouterSelector != nil {
+		in, out := &in.RouterSelector, &out.RouterSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdminEnabled != nil {
+		in, out := &in.AdminEnabled, &out.AdminEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EdgeAvailabilityDomain != nil {
+		in, out := &in.EdgeAvailabilityDomain, &out.EdgeAvailabilityDomain
+		*out = new(string)
+		**out = **in
+	}
+	if in.Interconnect != nil {
+		in, out := &in.Interconnect, &out.Interconnect
+		*out = new(string)
+		**out = **in
+	}
+	if in.VlanTag8021q != nil {
+		in, out := &in.VlanTag8021q, &out.VlanTag8021q
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Bandwidth != nil {
+		in, out := &in.Bandwidth, &out.Bandwidth
+		*out = new(string)
+		**out = **in
+	}
+	if in.CandidateSubnets != nil {
+		in, out := &in.CandidateSubnets, &out.CandidateSubnets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Mtu != nil {
+		in, out := &in.Mtu, &out.Mtu
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Encryption != nil {
+		in, out := &in.Encryption, &out.Encryption
+		*out = new(string)
+		**out = **in
+	}
+	if in.StackType != nil {
+		in, out := &in.StackType, &out.StackType
+		*out = new(string)
+		**out = **in
+	}
+	if in.PartnerMetadata != nil {
+		in, out := &in.PartnerMetadata, &out.PartnerMetadata
+		*out = new(InterconnectAttachmentPartnerMetadata)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterconnectAttachmentParameters.
+func (in *InterconnectAttachmentParameters) DeepCopy() *InterconnectAttachmentParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(InterconnectAttachmentParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterconnectAttachmentPartnerMetadata) DeepCopyInto(out *InterconnectAttachmentPartnerMetadata) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterconnectAttachmentPartnerMetadata.
+func (in *InterconnectAttachmentPartnerMetadata) DeepCopy() *InterconnectAttachmentPartnerMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(InterconnectAttachmentPartnerMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterconnectAttachmentSpec) DeepCopyInto(out *InterconnectAttachmentSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterconnectAttachmentSpec.
+func (in *InterconnectAttachmentSpec) DeepCopy() *InterconnectAttachmentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(InterconnectAttachmentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterconnectAttachmentStatus) DeepCopyInto(out *InterconnectAttachmentStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterconnectAttachmentStatus.
+func (in *InterconnectAttachmentStatus) DeepCopy() *InterconnectAttachmentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(InterconnectAttachmentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineImage) DeepCopyInto(out *MachineImage) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineImage.
+func (in *MachineImage) DeepCopy() *MachineImage {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineImage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MachineImage) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineImageEncryptionKey) DeepCopyInto(out *MachineImageEncryptionKey) {
+	*out = *in
+	if in.KMSKeyServiceAccount != nil {
+		in, out := &in.KMSKeyServiceAccount, &out.KMSKeyServiceAccount
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineImageEncryptionKey.
+func (in *MachineImageEncryptionKey) DeepCopy() *MachineImageEncryptionKey {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineImageEncryptionKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineImageList) DeepCopyInto(out *MachineImageList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MachineImage, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineImageList.
+func (in *MachineImageList) DeepCopy() *MachineImageList {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineImageList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MachineImageList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineImageObservation) DeepCopyInto(out *MachineImageObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineImageObservation.
+func (in *MachineImageObservation) DeepCopy() *MachineImageObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineImageObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineImageParameters) DeepCopyInto(out *MachineImageParameters) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.GuestFlush != nil {
+		in, out := &in.GuestFlush, &out.GuestFlush
+		*out = new(bool)
+		**out = **in
+	}
+	if in.StorageLocations != nil {
+		in, out := &in.StorageLocations, &out.StorageLocations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.EncryptionKey != nil {
+		in, out := &in.EncryptionKey, &out.EncryptionKey
+		*out = new(MachineImageEncryptionKey)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineImageParameters.
+func (in *MachineImageParameters) DeepCopy() *MachineImageParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineImageParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineImageSpec) DeepCopyInto(out *MachineImageSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineImageSpec.
+func (in *MachineImageSpec) DeepCopy() *MachineImageSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineImageSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineImageStatus) DeepCopyInto(out *MachineImageStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineImageStatus.
+func (in *MachineImageStatus) DeepCopy() *MachineImageStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineImageStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamedPort) DeepCopyInto(out *NamedPort) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamedPort.
+func (in *NamedPort) DeepCopy() *NamedPort {
+	if in == nil {
+		return nil
+	}
+	out := new(NamedPort)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PacketMirroring) DeepCopyInto(out *PacketMirroring) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PacketMirroring.
+func (in *PacketMirroring) DeepCopy() *PacketMirroring {
+	if in == nil {
+		return nil
+	}
+	out := new(PacketMirroring)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PacketMirroring) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PacketMirroringFilter) DeepCopyInto(out *PacketMirroringFilter) {
+	*out = *in
+	if in.IPProtocols != nil {
+		in, out := &in.IPProtocols, &out.IPProtocols
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CIDRRanges != nil {
+		in, out := &in.CIDRRanges, &out.CIDRRanges
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Direction != nil {
+		in, out := &in.Direction, &out.Direction
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PacketMirroringFilter.
+func (in *PacketMirroringFilter) DeepCopy() *PacketMirroringFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(PacketMirroringFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PacketMirroringList) DeepCopyInto(out *PacketMirroringList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PacketMirroring, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PacketMirroringList.
+func (in *PacketMirroringList) DeepCopy() *PacketMirroringList {
+	if in == nil {
+		return nil
+	}
+	out := new(PacketMirroringList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PacketMirroringList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PacketMirroringMirroredResources) DeepCopyInto(out *PacketMirroringMirroredResources) {
+	*out = *in
+	if in.Instances != nil {
+		in, out := &in.Instances, &out.Instances
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Subnetworks != nil {
+		in, out := &in.Subnetworks, &out.Subnetworks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SubnetworksRefs != nil {
+		in, out := &in.SubnetworksRefs, &out.SubnetworksRefs
+		*out = make([]v1.Reference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SubnetworksSelector != nil {
+		in, out := &in.SubnetworksSelector, &out.SubnetworksSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PacketMirroringMirroredResources.
+func (in *PacketMirroringMirroredResources) DeepCopy() *PacketMirroringMirroredResources {
+	if in == nil {
+		return nil
+	}
+	out := new(PacketMirroringMirroredResources)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PacketMirroringObservation) DeepCopyInto(out *PacketMirroringObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PacketMirroringObservation.
+func (in *PacketMirroringObservation) DeepCopy() *PacketMirroringObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(PacketMirroringObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PacketMirroringParameters) DeepCopyInto(out *PacketMirroringParameters) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Network != nil {
+		in, out := &in.Network, &out.Network
+		*out = new(string)
+		**out = **in
+	}
+	if in.NetworkRef != nil {
+		in, out := &in.NetworkRef, &out.NetworkRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NetworkSelector != nil {
+		in, out := &in.NetworkSelector, &out.NetworkSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CollectorILB != nil {
+		in, out := &in.CollectorILB, &out.CollectorILB
+		*out = new(string)
+		**out = **in
+	}
+	if in.CollectorILBRef != nil {
+		in, out := &in.CollectorILBRef, &out.CollectorILBRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CollectorILBSelector != nil {
+		in, out := &in.CollectorILBSelector, &out.CollectorILBSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CollectorILBEndpointRef != nil {
+		in, out := &in.CollectorILBEndpointRef, &out.CollectorILBEndpointRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CollectorILBEndpointSelector != nil {
+		in, out := &in.CollectorILBEndpointSelector, &out.CollectorILBEndpointSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	in.MirroredResources.DeepCopyInto(&out.MirroredResources)
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = new(PacketMirroringFilter)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Priority != nil {
+		in, out := &in.Priority, &out.Priority
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Enable != nil {
+		in, out := &in.Enable, &out.Enable
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PacketMirroringParameters.
+func (in *PacketMirroringParameters) DeepCopy() *PacketMirroringParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(PacketMirroringParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PacketMirroringSpec) DeepCopyInto(out *PacketMirroringSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PacketMirroringSpec.
+func (in *PacketMirroringSpec) DeepCopy() *PacketMirroringSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PacketMirroringSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PacketMirroringStatus) DeepCopyInto(out *PacketMirroringStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PacketMirroringStatus.
+func (in *PacketMirroringStatus) DeepCopy() *PacketMirroringStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PacketMirroringStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectSSHKey) DeepCopyInto(out *ProjectSSHKey) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectSSHKey.
+func (in *ProjectSSHKey) DeepCopy() *ProjectSSHKey {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectSSHKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProjectSSHKey) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectSSHKeyList) DeepCopyInto(out *ProjectSSHKeyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ProjectSSHKey, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectSSHKeyList.
+func (in *ProjectSSHKeyList) DeepCopy() *ProjectSSHKeyList {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectSSHKeyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProjectSSHKeyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectSSHKeyParameters) DeepCopyInto(out *ProjectSSHKeyParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectSSHKeyParameters.
+func (in *ProjectSSHKeyParameters) DeepCopy() *ProjectSSHKeyParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectSSHKeyParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectSSHKeySpec) DeepCopyInto(out *ProjectSSHKeySpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	out.ForProvider = in.ForProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectSSHKeySpec.
+func (in *ProjectSSHKeySpec) DeepCopy() *ProjectSSHKeySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectSSHKeySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectSSHKeyStatus) DeepCopyInto(out *ProjectSSHKeyStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectSSHKeyStatus.
+func (in *ProjectSSHKeyStatus) DeepCopy() *ProjectSSHKeyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectSSHKeyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PublicAdvertisedPrefix) DeepCopyInto(out *PublicAdvertisedPrefix) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublicAdvertisedPrefix.
+func (in *PublicAdvertisedPrefix) DeepCopy() *PublicAdvertisedPrefix {
+	if in == nil {
+		return nil
+	}
+	out := new(PublicAdvertisedPrefix)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PublicAdvertisedPrefix) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PublicAdvertisedPrefixList) DeepCopyInto(out *PublicAdvertisedPrefixList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PublicAdvertisedPrefix, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublicAdvertisedPrefixList.
+func (in *PublicAdvertisedPrefixList) DeepCopy() *PublicAdvertisedPrefixList {
+	if in == nil {
+		return nil
+	}
+	out := new(PublicAdvertisedPrefixList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PublicAdvertisedPrefixList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PublicAdvertisedPrefixObservation) DeepCopyInto(out *PublicAdvertisedPrefixObservation) {
+	*out = *in
+	if in.PublicDelegatedPrefixes != nil {
+		in, out := &in.PublicDelegatedPrefixes, &out.PublicDelegatedPrefixes
+		*out = make([]PublicAdvertisedPrefixPublicDelegatedPrefix, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublicAdvertisedPrefixObservation.
+func (in *PublicAdvertisedPrefixObservation) DeepCopy() *PublicAdvertisedPrefixObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(PublicAdvertisedPrefixObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PublicAdvertisedPrefixParameters) DeepCopyInto(out *PublicAdvertisedPrefixParameters) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublicAdvertisedPrefixParameters.
+func (in *PublicAdvertisedPrefixParameters) DeepCopy() *PublicAdvertisedPrefixParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(PublicAdvertisedPrefixParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PublicAdvertisedPrefixPublicDelegatedPrefix) DeepCopyInto(out *PublicAdvertisedPrefixPublicDelegatedPrefix) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublicAdvertisedPrefixPublicDelegatedPrefix.
+func (in *PublicAdvertisedPrefixPublicDelegatedPrefix) DeepCopy() *PublicAdvertisedPrefixPublicDelegatedPrefix {
+	if in == nil {
+		return nil
+	}
+	out := new(PublicAdvertisedPrefixPublicDelegatedPrefix)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PublicAdvertisedPrefixSpec) DeepCopyInto(out *PublicAdvertisedPrefixSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublicAdvertisedPrefixSpec.
+func (in *PublicAdvertisedPrefixSpec) DeepCopy() *PublicAdvertisedPrefixSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PublicAdvertisedPrefixSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PublicAdvertisedPrefixStatus) DeepCopyInto(out *PublicAdvertisedPrefixStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublicAdvertisedPrefixStatus.
+func (in *PublicAdvertisedPrefixStatus) DeepCopy() *PublicAdvertisedPrefixStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PublicAdvertisedPrefixStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PublicDelegatedPrefix) DeepCopyInto(out *PublicDelegatedPrefix) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublicDelegatedPrefix.
+func (in *PublicDelegatedPrefix) DeepCopy() *PublicDelegatedPrefix {
+	if in == nil {
+		return nil
+	}
+	out := new(PublicDelegatedPrefix)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PublicDelegatedPrefix) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PublicDelegatedPrefixList) DeepCopyInto(out *PublicDelegatedPrefixList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PublicDelegatedPrefix, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublicDelegatedPrefixList.
+func (in *PublicDelegatedPrefixList) DeepCopy() *PublicDelegatedPrefixList {
+	if in == nil {
+		return nil
+	}
+	out := new(PublicDelegatedPrefixList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PublicDelegatedPrefixList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PublicDelegatedPrefixObservation) DeepCopyInto(out *PublicDelegatedPrefixObservation) {
+	*out = *in
+	if in.PublicDelegatedSubPrefixes != nil {
+		in, out := &in.PublicDelegatedSubPrefixes, &out.PublicDelegatedSubPrefixes
+		*out = make([]PublicDelegatedSubPrefix, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublicDelegatedPrefixObservation.
+func (in *PublicDelegatedPrefixObservation) DeepCopy() *PublicDelegatedPrefixObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(PublicDelegatedPrefixObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PublicDelegatedPrefixParameters) DeepCopyInto(out *PublicDelegatedPrefixParameters) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.ParentPrefix != nil {
+		in, out := &in.ParentPrefix, &out.ParentPrefix
+		*out = new(string)
+		**out = **in
+	}
+	if in.ParentPrefixRef != nil {
+		in, out := &in.ParentPrefixRef, &out.ParentPrefixRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ParentPrefixSelector != nil {
+		in, out := &in.ParentPrefixSelector, &out.ParentPrefixSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IsLiveMigration != nil {
+		in, out := &in.IsLiveMigration, &out.IsLiveMigration
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublicDelegatedPrefixParameters.
+func (in *PublicDelegatedPrefixParameters) DeepCopy() *PublicDelegatedPrefixParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(PublicDelegatedPrefixParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PublicDelegatedPrefixSpec) DeepCopyInto(out *PublicDelegatedPrefixSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublicDelegatedPrefixSpec.
+func (in *PublicDelegatedPrefixSpec) DeepCopy() *PublicDelegatedPrefixSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PublicDelegatedPrefixSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PublicDelegatedPrefixStatus) DeepCopyInto(out *PublicDelegatedPrefixStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublicDelegatedPrefixStatus.
+func (in *PublicDelegatedPrefixStatus) DeepCopy() *PublicDelegatedPrefixStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PublicDelegatedPrefixStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PublicDelegatedSubPrefix) DeepCopyInto(out *PublicDelegatedSubPrefix) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublicDelegatedSubPrefix.
+func (in *PublicDelegatedSubPrefix) DeepCopy() *PublicDelegatedSubPrefix {
+	if in == nil {
+		return nil
+	}
+	out := new(PublicDelegatedSubPrefix)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Router) DeepCopyInto(out *Router) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Router.
+func (in *Router) DeepCopy() *Router {
+	if in == nil {
+		return nil
+	}
+	out := new(Router)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Router) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouterAdvertisedIpRange) DeepCopyInto(out *RouterAdvertisedIpRange) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouterAdvertisedIpRange.
+func (in *RouterAdvertisedIpRange) DeepCopy() *RouterAdvertisedIpRange {
+	if in == nil {
+		return nil
+	}
+	out := new(RouterAdvertisedIpRange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouterBgp) DeepCopyInto(out *RouterBgp) {
+	*out = *in
+	if in.AdvertiseMode != nil {
+		in, out := &in.AdvertiseMode, &out.AdvertiseMode
+		*out = new(string)
+		**out = **in
+	}
+	if in.AdvertisedGroups != nil {
+		in, out := &in.AdvertisedGroups, &out.AdvertisedGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AdvertisedIpRanges != nil {
+		in, out := &in.AdvertisedIpRanges, &out.AdvertisedIpRanges
+		*out = make([]*RouterAdvertisedIpRange, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(RouterAdvertisedIpRange)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.Asn != nil {
+		in, out := &in.Asn, &out.Asn
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouterBgp.
+func (in *RouterBgp) DeepCopy() *RouterBgp {
+	if in == nil {
+		return nil
+	}
+	out := new(RouterBgp)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouterBgpPeer) DeepCopyInto(out *RouterBgpPeer) {
+	*out = *in
+	if in.AdvertiseMode != nil {
+		in, out := &in.AdvertiseMode, &out.AdvertiseMode
+		*out = new(string)
+		**out = **in
+	}
+	if in.AdvertisedGroups != nil {
+		in, out := &in.AdvertisedGroups, &out.AdvertisedGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AdvertisedIpRanges != nil {
+		in, out := &in.AdvertisedIpRanges, &out.AdvertisedIpRanges
+		*out = make([]*RouterAdvertisedIpRange, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(RouterAdvertisedIpRange)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.AdvertisedRoutePriority != nil {
+		in, out := &in.AdvertisedRoutePriority, &out.AdvertisedRoutePriority
+		*out = new(int64)
+		**out = **in
+	}
+	if in.InterfaceName != nil {
+		in, out := &in.InterfaceName, &out.InterfaceName
+		*out = new(string)
+		**out = **in
+	}
+	if in.IpAddress != nil {
+		in, out := &in.IpAddress, &out.IpAddress
+		*out = new(string)
+		**out = **in
+	}
+	if in.PeerIpAddress != nil {
+		in, out := &in.PeerIpAddress, &out.PeerIpAddress
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouterBgpPeer.
+func (in *RouterBgpPeer) DeepCopy() *RouterBgpPeer {
+	if in == nil {
+		return nil
+	}
+	out := new(RouterBgpPeer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouterInterface) DeepCopyInto(out *RouterInterface) {
+	*out = *in
+	if in.IpRange != nil {
+		in, out := &in.IpRange, &out.IpRange
+		*out = new(string)
+		**out = **in
+	}
+	if in.LinkedInterconnectAttachment != nil {
+		in, out := &in.LinkedInterconnectAttachment, &out.LinkedInterconnectAttachment
+		*out = new(string)
+		**out = **in
+	}
+	if in.LinkedVpnTunnel != nil {
+		in, out := &in.LinkedVpnTunnel, &out.LinkedVpnTunnel
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouterInterface.
+func (in *RouterInterface) DeepCopy() *RouterInterface {
+	if in == nil {
+		return nil
+	}
+	out := new(RouterInterface)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouterList) DeepCopyInto(out *RouterList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Router, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouterList.
+func (in *RouterList) DeepCopy() *RouterList {
+	if in == nil {
+		return nil
+	}
+	out := new(RouterList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RouterList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouterNat) DeepCopyInto(out *RouterNat) {
+	*out = *in
+	if in.DrainNatIps != nil {
+		in, out := &in.DrainNatIps, &out.DrainNatIps
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.EnableEndpointIndependentMapping != nil {
+		in, out := &in.EnableEndpointIndependentMapping, &out.EnableEndpointIndependentMapping
+		*out = new(bool)
+		**out = **in
+	}
+	if in.IcmpIdleTimeoutSec != nil {
+		in, out := &in.IcmpIdleTimeoutSec, &out.IcmpIdleTimeoutSec
+		*out = new(int64)
+		**out = **in
+	}
+	if in.LogConfig != nil {
+		in, out := &in.LogConfig, &out.LogConfig
+		*out = new(RouterNatLogConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MinPortsPerVm != nil {
+		in, out := &in.MinPortsPerVm, &out.MinPortsPerVm
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.NatIps != nil {
+		in, out := &in.NatIps, &out.NatIps
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Subnetworks != nil {
+		in, out := &in.Subnetworks, &out.Subnetworks
+		*out = make([]*RouterNatSubnetworkToNat, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(RouterNatSubnetworkToNat)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.TcpEstablishedIdleTimeoutSec != nil {
+		in, out := &in.TcpEstablishedIdleTimeoutSec, &out.TcpEstablishedIdleTimeoutSec
+		*out = new(int64)
+		**out = **in
+	}
+	if in.TcpTransitoryIdleTimeoutSec != nil {
+		in, out := &in.TcpTransitoryIdleTimeoutSec, &out.TcpTransitoryIdleTimeoutSec
+		*out = new(int64)
+		**out = **in
+	}
+	if in.UdpIdleTimeoutSec != nil {
+		in, out := &in.UdpIdleTimeoutSec, &out.UdpIdleTimeoutSec
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouterNat.
+func (in *RouterNat) DeepCopy() *RouterNat {
+	if in == nil {
+		return nil
+	}
+	out := new(RouterNat)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouterNatLogConfig) DeepCopyInto(out *RouterNatLogConfig) {
+	*out = *in
+	if in.Enable != nil {
+		in, out := &in.Enable, &out.Enable
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouterNatLogConfig.
+func (in *RouterNatLogConfig) DeepCopy() *RouterNatLogConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(RouterNatLogConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouterNatSubnetworkToNat) DeepCopyInto(out *RouterNatSubnetworkToNat) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.SecondaryIpRangeNames != nil {
+		in, out := &in.SecondaryIpRangeNames, &out.SecondaryIpRangeNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SourceIpRangesToNat != nil {
+		in, out := &in.SourceIpRangesToNat, &out.SourceIpRangesToNat
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouterNatSubnetworkToNat.
+func (in *RouterNatSubnetworkToNat) DeepCopy() *RouterNatSubnetworkToNat {
+	if in == nil {
+		return nil
+	}
+	out := new(RouterNatSubnetworkToNat)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouterObservation) DeepCopyInto(out *RouterObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouterObservation.
+func (in *RouterObservation) DeepCopy() *RouterObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(RouterObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouterParameters) DeepCopyInto(out *RouterParameters) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Network != nil {
+		in, out := &in.Network, &out.Network
+		*out = new(string)
+		**out = **in
+	}
+	if in.NetworkRef != nil {
+		in, out := &in.NetworkRef, &out.NetworkRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NetworkSelector != nil {
+		in, out := &in.NetworkSelector, &out.NetworkSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Bgp != nil {
+		in, out := &in.Bgp, &out.Bgp
+		*out = new(RouterBgp)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BgpPeers != nil {
+		in, out := &in.BgpPeers, &out.BgpPeers
+		*out = make([]*RouterBgpPeer, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(RouterBgpPeer)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.EncryptedInterconnectRouter != nil {
+		in, out := &in.EncryptedInterconnectRouter, &out.EncryptedInterconnectRouter
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Interfaces != nil {
+		in, out := &in.Interfaces, &out.Interfaces
+		*out = make([]*RouterInterface, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(RouterInterface)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.Nats != nil {
+		in, out := &in.Nats, &out.Nats
+		*out = make([]*RouterNat, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(RouterNat)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouterParameters.
+func (in *RouterParameters) DeepCopy() *RouterParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(RouterParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouterSpec) DeepCopyInto(out *RouterSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouterSpec.
+func (in *RouterSpec) DeepCopy() *RouterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RouterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouterStatus) DeepCopyInto(out *RouterStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouterStatus.
+func (in *RouterStatus) DeepCopy() *RouterStatus {
+	if in == nil {
+		return nil
 	}
+	out := new(RouterStatus)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouterAdvertisedIpRange.
-func (in *RouterAdvertisedIpRange) DeepCopy() *RouterAdvertisedIpRange {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceAttachment) DeepCopyInto(out *ServiceAttachment) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAttachment.
+func (in *ServiceAttachment) DeepCopy() *ServiceAttachment {
 	if in == nil {
 		return nil
 	}
-	out := new(RouterAdvertisedIpRange)
+	out := new(ServiceAttachment)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ServiceAttachment) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RouterBgp) DeepCopyInto(out *RouterBgp) {
+func (in *ServiceAttachmentConnectedEndpoint) DeepCopyInto(out *ServiceAttachmentConnectedEndpoint) {
 	*out = *in
-	if in.AdvertiseMode != nil {
-		in, out := &in.AdvertiseMode, &out.AdvertiseMode
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAttachmentConnectedEndpoint.
+func (in *ServiceAttachmentConnectedEndpoint) DeepCopy() *ServiceAttachmentConnectedEndpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceAttachmentConnectedEndpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceAttachmentConsumerProjectLimit) DeepCopyInto(out *ServiceAttachmentConsumerProjectLimit) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAttachmentConsumerProjectLimit.
+func (in *ServiceAttachmentConsumerProjectLimit) DeepCopy() *ServiceAttachmentConsumerProjectLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceAttachmentConsumerProjectLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceAttachmentList) DeepCopyInto(out *ServiceAttachmentList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ServiceAttachment, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAttachmentList.
+func (in *ServiceAttachmentList) DeepCopy() *ServiceAttachmentList {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceAttachmentList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ServiceAttachmentList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceAttachmentObservation) DeepCopyInto(out *ServiceAttachmentObservation) {
+	*out = *in
+	if in.ConnectedEndpoints != nil {
+		in, out := &in.ConnectedEndpoints, &out.ConnectedEndpoints
+		*out = make([]ServiceAttachmentConnectedEndpoint, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAttachmentObservation.
+func (in *ServiceAttachmentObservation) DeepCopy() *ServiceAttachmentObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceAttachmentObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceAttachmentParameters) DeepCopyInto(out *ServiceAttachmentParameters) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
 		*out = new(string)
 		**out = **in
 	}
-	if in.AdvertisedGroups != nil {
-		in, out := &in.AdvertisedGroups, &out.AdvertisedGroups
+	if in.TargetService != nil {
+		in, out := &in.TargetService, &out.TargetService
+		*out = new(string)
+		**out = **in
+	}
+	if in.TargetServiceRef != nil {
+		in, out := &in.TargetServiceRef, &out.TargetServiceRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TargetServiceSelector != nil {
+		in, out := &in.TargetServiceSelector, &out.TargetServiceSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NatSubnets != nil {
+		in, out := &in.NatSubnets, &out.NatSubnets
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
-	if in.AdvertisedIpRanges != nil {
-		in, out := &in.AdvertisedIpRanges, &out.AdvertisedIpRanges
-		*out = make([]*RouterAdvertisedIpRange, len(*in))
+	if in.NatSubnetsRefs != nil {
+		in, out := &in.NatSubnetsRefs, &out.NatSubnetsRefs
+		*out = make([]v1.Reference, len(*in))
 		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(RouterAdvertisedIpRange)
-				(*in).DeepCopyInto(*out)
-			}
+			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.Asn != nil {
-		in, out := &in.Asn, &out.Asn
-		*out = new(int64)
+	if in.NatSubnetsSelector != nil {
+		in, out := &in.NatSubnetsSelector, &out.NatSubnetsSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EnableProxyProtocol != nil {
+		in, out := &in.EnableProxyProtocol, &out.EnableProxyProtocol
+		*out = new(bool)
 		**out = **in
 	}
+	if in.ConsumerAcceptLists != nil {
+		in, out := &in.ConsumerAcceptLists, &out.ConsumerAcceptLists
+		*out = make([]ServiceAttachmentConsumerProjectLimit, len(*in))
+		copy(*out, *in)
+	}
+	if in.ConsumerRejectLists != nil {
+		in, out := &in.ConsumerRejectLists, &out.ConsumerRejectLists
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DomainNames != nil {
+		in, out := &in.DomainNames, &out.DomainNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouterBgp.
-func (in *RouterBgp) DeepCopy() *RouterBgp {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAttachmentParameters.
+func (in *ServiceAttachmentParameters) DeepCopy() *ServiceAttachmentParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(RouterBgp)
+	out := new(ServiceAttachmentParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RouterBgpPeer) DeepCopyInto(out *RouterBgpPeer) {
+func (in *ServiceAttachmentSpec) DeepCopyInto(out *ServiceAttachmentSpec) {
 	*out = *in
-	if in.AdvertiseMode != nil {
-		in, out := &in.AdvertiseMode, &out.AdvertiseMode
-		*out = new(string)
-		**out = **in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAttachmentSpec.
+func (in *ServiceAttachmentSpec) DeepCopy() *ServiceAttachmentSpec {
+	if in == nil {
+		return nil
 	}
-	if in.AdvertisedGroups != nil {
-		in, out := &in.AdvertisedGroups, &out.AdvertisedGroups
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	out := new(ServiceAttachmentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceAttachmentStatus) DeepCopyInto(out *ServiceAttachmentStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAttachmentStatus.
+func (in *ServiceAttachmentStatus) DeepCopy() *ServiceAttachmentStatus {
+	if in == nil {
+		return nil
 	}
-	if in.AdvertisedIpRanges != nil {
-		in, out := &in.AdvertisedIpRanges, &out.AdvertisedIpRanges
-		*out = make([]*RouterAdvertisedIpRange, len(*in))
+	out := new(ServiceAttachmentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetSSLProxy) DeepCopyInto(out *TargetSSLProxy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetSSLProxy.
+func (in *TargetSSLProxy) DeepCopy() *TargetSSLProxy {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetSSLProxy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TargetSSLProxy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetSSLProxyList) DeepCopyInto(out *TargetSSLProxyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TargetSSLProxy, len(*in))
 		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(RouterAdvertisedIpRange)
-				(*in).DeepCopyInto(*out)
-			}
+			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.AdvertisedRoutePriority != nil {
-		in, out := &in.AdvertisedRoutePriority, &out.AdvertisedRoutePriority
-		*out = new(int64)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetSSLProxyList.
+func (in *TargetSSLProxyList) DeepCopy() *TargetSSLProxyList {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetSSLProxyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TargetSSLProxyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetSSLProxyObservation) DeepCopyInto(out *TargetSSLProxyObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetSSLProxyObservation.
+func (in *TargetSSLProxyObservation) DeepCopy() *TargetSSLProxyObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetSSLProxyObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetSSLProxyParameters) DeepCopyInto(out *TargetSSLProxyParameters) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
 		**out = **in
 	}
-	if in.InterfaceName != nil {
-		in, out := &in.InterfaceName, &out.InterfaceName
+	if in.ProxyHeader != nil {
+		in, out := &in.ProxyHeader, &out.ProxyHeader
 		*out = new(string)
 		**out = **in
 	}
-	if in.IpAddress != nil {
-		in, out := &in.IpAddress, &out.IpAddress
+	if in.SslCertificates != nil {
+		in, out := &in.SslCertificates, &out.SslCertificates
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SslPolicy != nil {
+		in, out := &in.SslPolicy, &out.SslPolicy
 		*out = new(string)
 		**out = **in
 	}
-	if in.PeerIpAddress != nil {
-		in, out := &in.PeerIpAddress, &out.PeerIpAddress
+	if in.CertificateMap != nil {
+		in, out := &in.CertificateMap, &out.CertificateMap
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouterBgpPeer.
-func (in *RouterBgpPeer) DeepCopy() *RouterBgpPeer {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetSSLProxyParameters.
+func (in *TargetSSLProxyParameters) DeepCopy() *TargetSSLProxyParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetSSLProxyParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetSSLProxySpec) DeepCopyInto(out *TargetSSLProxySpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetSSLProxySpec.
+func (in *TargetSSLProxySpec) DeepCopy() *TargetSSLProxySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetSSLProxySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetSSLProxyStatus) DeepCopyInto(out *TargetSSLProxyStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetSSLProxyStatus.
+func (in *TargetSSLProxyStatus) DeepCopy() *TargetSSLProxyStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(RouterBgpPeer)
+	out := new(TargetSSLProxyStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RouterInterface) DeepCopyInto(out *RouterInterface) {
+func (in *TargetTCPProxy) DeepCopyInto(out *TargetTCPProxy) {
 	*out = *in
-	if in.IpRange != nil {
-		in, out := &in.IpRange, &out.IpRange
-		*out = new(string)
-		**out = **in
-	}
-	if in.LinkedInterconnectAttachment != nil {
-		in, out := &in.LinkedInterconnectAttachment, &out.LinkedInterconnectAttachment
-		*out = new(string)
-		**out = **in
-	}
-	if in.LinkedVpnTunnel != nil {
-		in, out := &in.LinkedVpnTunnel, &out.LinkedVpnTunnel
-		*out = new(string)
-		**out = **in
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouterInterface.
-func (in *RouterInterface) DeepCopy() *RouterInterface {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetTCPProxy.
+func (in *TargetTCPProxy) DeepCopy() *TargetTCPProxy {
 	if in == nil {
 		return nil
 	}
-	out := new(RouterInterface)
+	out := new(TargetTCPProxy)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TargetTCPProxy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RouterList) DeepCopyInto(out *RouterList) {
+func (in *TargetTCPProxyList) DeepCopyInto(out *TargetTCPProxyList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Router, len(*in))
+		*out = make([]TargetTCPProxy, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouterList.
-func (in *RouterList) DeepCopy() *RouterList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetTCPProxyList.
+func (in *TargetTCPProxyList) DeepCopy() *TargetTCPProxyList {
 	if in == nil {
 		return nil
 	}
-	out := new(RouterList)
+	out := new(TargetTCPProxyList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *RouterList) DeepCopyObject() runtime.Object {
+func (in *TargetTCPProxyList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -503,153 +3145,160 @@ func (in *RouterList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RouterNat) DeepCopyInto(out *RouterNat) {
+func (in *TargetTCPProxyObservation) DeepCopyInto(out *TargetTCPProxyObservation) {
 	*out = *in
-	if in.DrainNatIps != nil {
-		in, out := &in.DrainNatIps, &out.DrainNatIps
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.EnableEndpointIndependentMapping != nil {
-		in, out := &in.EnableEndpointIndependentMapping, &out.EnableEndpointIndependentMapping
-		*out = new(bool)
-		**out = **in
-	}
-	if in.IcmpIdleTimeoutSec != nil {
-		in, out := &in.IcmpIdleTimeoutSec, &out.IcmpIdleTimeoutSec
-		*out = new(int64)
-		**out = **in
-	}
-	if in.LogConfig != nil {
-		in, out := &in.LogConfig, &out.LogConfig
-		*out = new(RouterNatLogConfig)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.MinPortsPerVm != nil {
-		in, out := &in.MinPortsPerVm, &out.MinPortsPerVm
-		*out = new(int64)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetTCPProxyObservation.
+func (in *TargetTCPProxyObservation) DeepCopy() *TargetTCPProxyObservation {
+	if in == nil {
+		return nil
 	}
-	if in.Name != nil {
-		in, out := &in.Name, &out.Name
+	out := new(TargetTCPProxyObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetTCPProxyParameters) DeepCopyInto(out *TargetTCPProxyParameters) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
 		*out = new(string)
 		**out = **in
 	}
-	if in.NatIps != nil {
-		in, out := &in.NatIps, &out.NatIps
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.Subnetworks != nil {
-		in, out := &in.Subnetworks, &out.Subnetworks
-		*out = make([]*RouterNatSubnetworkToNat, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(RouterNatSubnetworkToNat)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
-	if in.TcpEstablishedIdleTimeoutSec != nil {
-		in, out := &in.TcpEstablishedIdleTimeoutSec, &out.TcpEstablishedIdleTimeoutSec
-		*out = new(int64)
-		**out = **in
-	}
-	if in.TcpTransitoryIdleTimeoutSec != nil {
-		in, out := &in.TcpTransitoryIdleTimeoutSec, &out.TcpTransitoryIdleTimeoutSec
-		*out = new(int64)
+	if in.ProxyHeader != nil {
+		in, out := &in.ProxyHeader, &out.ProxyHeader
+		*out = new(string)
 		**out = **in
 	}
-	if in.UdpIdleTimeoutSec != nil {
-		in, out := &in.UdpIdleTimeoutSec, &out.UdpIdleTimeoutSec
-		*out = new(int64)
+	if in.ProxyBind != nil {
+		in, out := &in.ProxyBind, &out.ProxyBind
+		*out = new(bool)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouterNat.
-func (in *RouterNat) DeepCopy() *RouterNat {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetTCPProxyParameters.
+func (in *TargetTCPProxyParameters) DeepCopy() *TargetTCPProxyParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(RouterNat)
+	out := new(TargetTCPProxyParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RouterNatLogConfig) DeepCopyInto(out *RouterNatLogConfig) {
+func (in *TargetTCPProxySpec) DeepCopyInto(out *TargetTCPProxySpec) {
 	*out = *in
-	if in.Enable != nil {
-		in, out := &in.Enable, &out.Enable
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Filter != nil {
-		in, out := &in.Filter, &out.Filter
-		*out = new(string)
-		**out = **in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetTCPProxySpec.
+func (in *TargetTCPProxySpec) DeepCopy() *TargetTCPProxySpec {
+	if in == nil {
+		return nil
 	}
+	out := new(TargetTCPProxySpec)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouterNatLogConfig.
-func (in *RouterNatLogConfig) DeepCopy() *RouterNatLogConfig {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetTCPProxyStatus) DeepCopyInto(out *TargetTCPProxyStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetTCPProxyStatus.
+func (in *TargetTCPProxyStatus) DeepCopy() *TargetTCPProxyStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(RouterNatLogConfig)
+	out := new(TargetTCPProxyStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RouterNatSubnetworkToNat) DeepCopyInto(out *RouterNatSubnetworkToNat) {
+func (in *UnmanagedInstanceGroup) DeepCopyInto(out *UnmanagedInstanceGroup) {
 	*out = *in
-	if in.Name != nil {
-		in, out := &in.Name, &out.Name
-		*out = new(string)
-		**out = **in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UnmanagedInstanceGroup.
+func (in *UnmanagedInstanceGroup) DeepCopy() *UnmanagedInstanceGroup {
+	if in == nil {
+		return nil
 	}
-	if in.SecondaryIpRangeNames != nil {
-		in, out := &in.SecondaryIpRangeNames, &out.SecondaryIpRangeNames
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	out := new(UnmanagedInstanceGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *UnmanagedInstanceGroup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	if in.SourceIpRangesToNat != nil {
-		in, out := &in.SourceIpRangesToNat, &out.SourceIpRangesToNat
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UnmanagedInstanceGroupList) DeepCopyInto(out *UnmanagedInstanceGroupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]UnmanagedInstanceGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouterNatSubnetworkToNat.
-func (in *RouterNatSubnetworkToNat) DeepCopy() *RouterNatSubnetworkToNat {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UnmanagedInstanceGroupList.
+func (in *UnmanagedInstanceGroupList) DeepCopy() *UnmanagedInstanceGroupList {
 	if in == nil {
 		return nil
 	}
-	out := new(RouterNatSubnetworkToNat)
+	out := new(UnmanagedInstanceGroupList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *UnmanagedInstanceGroupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RouterObservation) DeepCopyInto(out *RouterObservation) {
+func (in *UnmanagedInstanceGroupObservation) DeepCopyInto(out *UnmanagedInstanceGroupObservation) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouterObservation.
-func (in *RouterObservation) DeepCopy() *RouterObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UnmanagedInstanceGroupObservation.
+func (in *UnmanagedInstanceGroupObservation) DeepCopy() *UnmanagedInstanceGroupObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(RouterObservation)
+	out := new(UnmanagedInstanceGroupObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RouterParameters) DeepCopyInto(out *RouterParameters) {
+func (in *UnmanagedInstanceGroupParameters) DeepCopyInto(out *UnmanagedInstanceGroupParameters) {
 	*out = *in
 	if in.Description != nil {
 		in, out := &in.Description, &out.Description
@@ -671,91 +3320,58 @@ func (in *RouterParameters) DeepCopyInto(out *RouterParameters) {
 		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Bgp != nil {
-		in, out := &in.Bgp, &out.Bgp
-		*out = new(RouterBgp)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.BgpPeers != nil {
-		in, out := &in.BgpPeers, &out.BgpPeers
-		*out = make([]*RouterBgpPeer, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(RouterBgpPeer)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
-	if in.EncryptedInterconnectRouter != nil {
-		in, out := &in.EncryptedInterconnectRouter, &out.EncryptedInterconnectRouter
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Interfaces != nil {
-		in, out := &in.Interfaces, &out.Interfaces
-		*out = make([]*RouterInterface, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(RouterInterface)
-				(*in).DeepCopyInto(*out)
-			}
-		}
+	if in.Instances != nil {
+		in, out := &in.Instances, &out.Instances
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	if in.Nats != nil {
-		in, out := &in.Nats, &out.Nats
-		*out = make([]*RouterNat, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(RouterNat)
-				(*in).DeepCopyInto(*out)
-			}
-		}
+	if in.NamedPorts != nil {
+		in, out := &in.NamedPorts, &out.NamedPorts
+		*out = make([]NamedPort, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouterParameters.
-func (in *RouterParameters) DeepCopy() *RouterParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UnmanagedInstanceGroupParameters.
+func (in *UnmanagedInstanceGroupParameters) DeepCopy() *UnmanagedInstanceGroupParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(RouterParameters)
+	out := new(UnmanagedInstanceGroupParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RouterSpec) DeepCopyInto(out *RouterSpec) {
+func (in *UnmanagedInstanceGroupSpec) DeepCopyInto(out *UnmanagedInstanceGroupSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouterSpec.
-func (in *RouterSpec) DeepCopy() *RouterSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UnmanagedInstanceGroupSpec.
+func (in *UnmanagedInstanceGroupSpec) DeepCopy() *UnmanagedInstanceGroupSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(RouterSpec)
+	out := new(UnmanagedInstanceGroupSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RouterStatus) DeepCopyInto(out *RouterStatus) {
+func (in *UnmanagedInstanceGroupStatus) DeepCopyInto(out *UnmanagedInstanceGroupStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
 	out.AtProvider = in.AtProvider
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouterStatus.
-func (in *RouterStatus) DeepCopy() *RouterStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UnmanagedInstanceGroupStatus.
+func (in *UnmanagedInstanceGroupStatus) DeepCopy() *UnmanagedInstanceGroupStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(RouterStatus)
+	out := new(UnmanagedInstanceGroupStatus)
 	in.DeepCopyInto(out)
 	return out
 }
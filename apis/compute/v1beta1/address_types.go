@@ -60,10 +60,11 @@ type AddressParameters struct {
 	// +kubebuilder:validation:Enum=IPV6;IPV4;UNSPECIFIED_VERSION
 	IPVersion *string `json:"ipVersion,omitempty"`
 
-	// Region: An optional region in which to create the address.
+	// Region: An optional region in which to create the address. Defaults
+	// to the default region of the ProviderConfig.
+	// +optional
 	// +immutable
-	// +kubebuilder:validation:Required
-	Region string `json:"region"`
+	Region string `json:"region,omitempty"`
 
 	// Network: The URL of the network in which to reserve the address. This
 	// field can only be used with INTERNAL type with the VPC_PEERING
@@ -82,6 +83,18 @@ type AddressParameters struct {
 	// +immutable
 	NetworkSelector *xpv1.Selector `json:"networkSelector,omitempty"`
 
+	// NetworkTier: The networking tier of an EXTERNAL address. If
+	// unspecified, defaults to the default network tier of the project,
+	// usually PREMIUM.
+	//
+	// Possible values:
+	//   "PREMIUM"
+	//   "STANDARD"
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:Enum=PREMIUM;STANDARD
+	NetworkTier *string `json:"networkTier,omitempty"`
+
 	// PrefixLength: The prefix length if the resource represents an IP
 	// range.
 	// +optional
@@ -180,13 +193,17 @@ type AddressStatus struct {
 }
 
 // An Address is a managed resource that represents a Google Compute Engine Address.
-// +kubebuilder:printcolumn:name="IP",type="string",JSONPath=".spec.forProvider.address"
-// +kubebuilder:printcolumn:name="REGION",type="string",JSONPath=".spec.forProvider.region"
-// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
-// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
-// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="IP",type="string",JSONPath=".spec.forProvider.address"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="REGION",type="string",JSONPath=".spec.forProvider.region"
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".spec.forProvider.addressType",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp},shortName=addr
 type Address struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
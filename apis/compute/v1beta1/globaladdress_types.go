@@ -99,15 +99,18 @@ type GlobalAddressParameters struct {
 	// networks.
 	// - `NAT_AUTO` for addresses that are external IP addresses
 	// automatically reserved for Cloud NAT.
+	// - `PRIVATE_SERVICE_CONNECT` for the internal address of a Private
+	// Service Connect endpoint for Google APIs.
 	//
 	// Possible values:
 	//   "DNS_RESOLVER"
 	//   "GCE_ENDPOINT"
 	//   "NAT_AUTO"
 	//   "VPC_PEERING"
+	//   "PRIVATE_SERVICE_CONNECT"
 	// +optional
 	// +immutable
-	// +kubebuilder:validation:Enum=DNS_RESOLVER;GCE_ENDPOINT;NAT_AUTO;VPC_PEERING
+	// +kubebuilder:validation:Enum=DNS_RESOLVER;GCE_ENDPOINT;NAT_AUTO;VPC_PEERING;PRIVATE_SERVICE_CONNECT
 	Purpose *string `json:"purpose,omitempty"`
 
 	// Subnetwork: The URL of the subnetwork in which to reserve the
@@ -170,12 +173,17 @@ type GlobalAddressStatus struct {
 
 // A GlobalAddress is a managed resource that represents a Google Compute Engine
 // Global Address.
-// +kubebuilder:printcolumn:name="IP",type="string",JSONPath=".spec.forProvider.address"
-// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
-// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
-// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="IP",type="string",JSONPath=".spec.forProvider.address"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".spec.forProvider.addressType",priority=1
+// +kubebuilder:printcolumn:name="PREFIX-LENGTH",type="integer",JSONPath=".spec.forProvider.prefixLength",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp},shortName=gaddr
 type GlobalAddress struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
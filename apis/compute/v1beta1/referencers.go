@@ -50,6 +50,17 @@ func SubnetworkURL() reference.ExtractValueFn {
 	}
 }
 
+// AddressURL extracts the partially qualified URL of an Address.
+func AddressURL() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		a, ok := mg.(*Address)
+		if !ok {
+			return ""
+		}
+		return strings.TrimPrefix(a.Status.AtProvider.SelfLink, ComputeURIPrefix)
+	}
+}
+
 // ResolveReferences of this GlobalAddress
 func (mg *GlobalAddress) ResolveReferences(ctx context.Context, c client.Reader) error {
 	r := reference.NewAPIResolver(c, mg)
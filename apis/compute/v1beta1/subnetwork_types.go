@@ -25,6 +25,7 @@ import (
 // SubnetworkParameters define the desired state of a Google Compute Engine VPC
 // Subnetwork. Most fields map directly to a Subnetwork:
 // https://cloud.google.com/compute/docs/reference/rest/v1/subnetworks
+// +kubebuilder:validation:XValidation:rule="!has(self.role) || (has(self.purpose) && (self.purpose == 'REGIONAL_MANAGED_PROXY' || self.purpose == 'INTERNAL_HTTPS_LOAD_BALANCER'))",message="role may only be set on proxy-only subnetworks"
 type SubnetworkParameters struct {
 	// IPCIDRRange: The range of internal addresses that are owned by this
 	// subnetwork. Provide this property when you create the subnetwork. For
@@ -42,21 +43,26 @@ type SubnetworkParameters struct {
 	// +immutable
 	Network *string `json:"network,omitempty"`
 
-	// NetworkRef references a Network and retrieves its URI
+	// NetworkRef references a Network and retrieves its URI.
+	// Network takes precedence over NetworkRef, unless the resolve policy of
+	// NetworkRef is Always.
 	// +optional
 	// +immutable
 	NetworkRef *xpv1.Reference `json:"networkRef,omitempty"`
 
-	// NetworkSelector selects a reference to a Network
+	// NetworkSelector selects a reference to a Network.
+	// NetworkSelector is only used if neither Network nor NetworkRef is set,
+	// unless its resolve policy is Always.
 	// +optional
 	// +immutable
 	NetworkSelector *xpv1.Selector `json:"networkSelector,omitempty"`
 
 	// Region: URL of the region where the Subnetwork resides. This field
-	// can be set only at resource creation time.
+	// can be set only at resource creation time. Defaults to the default
+	// region of the ProviderConfig.
 	// +optional
 	// +immutable
-	Region string `json:"region"`
+	Region string `json:"region,omitempty"`
 
 	// Description: An optional description of this resource. Provide this
 	// property when you create the resource. This field can be set only at
@@ -78,6 +84,39 @@ type SubnetworkParameters struct {
 	// +optional
 	PrivateIPGoogleAccess *bool `json:"privateIpGoogleAccess,omitempty"`
 
+	// Purpose: The purpose of the subnetwork. PRIVATE subnetworks hold VM
+	// instances. REGIONAL_MANAGED_PROXY and INTERNAL_HTTPS_LOAD_BALANCER
+	// subnetworks are proxy-only subnetworks reserved for Envoy-based regional
+	// load balancers. PRIVATE_SERVICE_CONNECT subnetworks provide the NAT
+	// addresses of a ServiceAttachment in the producer network. Flow logs
+	// and private Google access are only supported by PRIVATE subnetworks.
+	// Defaults to PRIVATE. This field can be set only at resource creation
+	// time.
+	//
+	// Possible values:
+	//   "INTERNAL_HTTPS_LOAD_BALANCER"
+	//   "PRIVATE"
+	//   "PRIVATE_RFC_1918"
+	//   "PRIVATE_SERVICE_CONNECT"
+	//   "REGIONAL_MANAGED_PROXY"
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:Enum=INTERNAL_HTTPS_LOAD_BALANCER;PRIVATE;PRIVATE_RFC_1918;PRIVATE_SERVICE_CONNECT;REGIONAL_MANAGED_PROXY
+	Purpose *string `json:"purpose,omitempty"`
+
+	// Role: The role of a proxy-only subnetwork. An ACTIVE subnetwork is
+	// used by the load balancers of its region, a BACKUP subnetwork is ready
+	// to be promoted to ACTIVE or is draining. Only one proxy-only
+	// subnetwork per region and network may be ACTIVE. This field can be
+	// updated with a patch request.
+	//
+	// Possible values:
+	//   "ACTIVE"
+	//   "BACKUP"
+	// +optional
+	// +kubebuilder:validation:Enum=ACTIVE;BACKUP
+	Role *string `json:"role,omitempty"`
+
 	// SecondaryIPRanges: An array of configurations for secondary IP ranges
 	// for VM instances contained in this subnetwork. The primary IP of such
 	// VM must belong to the primary ipCidrRange of the subnetwork. The
@@ -114,6 +153,12 @@ type SubnetworkObservation struct {
 
 	// SelfLink: Server-defined URL for the resource.
 	SelfLink string `json:"selfLink,omitempty"`
+
+	// State: The state of the subnetwork. Proxy-only subnetworks are
+	// DRAINING while connections to their load balancers are drained after
+	// they were demoted to BACKUP; they cannot be modified until they are
+	// READY again.
+	State string `json:"state,omitempty"`
 }
 
 // A SubnetworkSecondaryRange defines the state of a Google Compute Engine
@@ -137,6 +182,18 @@ type SubnetworkSecondaryRange struct {
 type SubnetworkSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       SubnetworkParameters `json:"forProvider"`
+
+	// UpdatePolicy controls how changes to the immutable fields of
+	// forProvider are applied. With the default Update policy they are sent
+	// to GCP like any other change, which rejects or ignores them. With
+	// ReplaceOnImmutableChange the Subnetwork is deleted and created again with the
+	// changed fields, but only once the replacement is confirmed by
+	// annotating this resource with gcp.crossplane.io/confirm-replace set to
+	// its current metadata.generation. Resources whose deletion policy is
+	// Orphan are never replaced.
+	// +optional
+	// +kubebuilder:validation:Enum=Update;ReplaceOnImmutableChange
+	UpdatePolicy string `json:"updatePolicy,omitempty"`
 }
 
 // A SubnetworkStatus represents the observed state of a Subnetwork.
@@ -149,10 +206,18 @@ type SubnetworkStatus struct {
 
 // A Subnetwork is a managed resource that represents a Google Compute Engine
 // VPC Subnetwork.
+// +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
-// +kubebuilder:subresource:status
-// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="CIDR",type="string",JSONPath=".spec.forProvider.ipCidrRange"
+// +kubebuilder:printcolumn:name="REGION",type="string",JSONPath=".spec.forProvider.region"
+// +kubebuilder:printcolumn:name="NETWORK",type="string",JSONPath=".spec.forProvider.network",priority=1
+// +kubebuilder:printcolumn:name="PURPOSE",type="string",JSONPath=".spec.forProvider.purpose",priority=1
+// +kubebuilder:printcolumn:name="GATEWAY",type="string",JSONPath=".status.atProvider.gatewayAddress",priority=1
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp},shortName=subnet
 type Subnetwork struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
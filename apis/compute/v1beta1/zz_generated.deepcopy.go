@@ -143,6 +143,11 @@ func (in *AddressParameters) DeepCopyInto(out *AddressParameters) {
 		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.NetworkTier != nil {
+		in, out := &in.NetworkTier, &out.NetworkTier
+		*out = new(string)
+		**out = **in
+	}
 	if in.PrefixLength != nil {
 		in, out := &in.PrefixLength, &out.PrefixLength
 		*out = new(int64)
@@ -693,6 +698,16 @@ func (in *SubnetworkParameters) DeepCopyInto(out *SubnetworkParameters) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Purpose != nil {
+		in, out := &in.Purpose, &out.Purpose
+		*out = new(string)
+		**out = **in
+	}
+	if in.Role != nil {
+		in, out := &in.Role, &out.Role
+		*out = new(string)
+		**out = **in
+	}
 	if in.SecondaryIPRanges != nil {
 		in, out := &in.SecondaryIPRanges, &out.SecondaryIPRanges
 		*out = make([]*SubnetworkSecondaryRange, len(*in))
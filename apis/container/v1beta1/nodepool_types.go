@@ -18,11 +18,13 @@ limitations under the License.
 package v1beta1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 
 	"github.com/crossplane-contrib/provider-gcp/apis/container/v1beta2"
+	gcpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
 )
 
 // NodePool states.
@@ -36,6 +38,123 @@ const (
 	NodePoolStateError        = "ERROR"
 )
 
+// TypeQuotaAvailable resources were found to have enough regional Compute
+// Engine quota to be created.
+const TypeQuotaAvailable xpv1.ConditionType = "QuotaAvailable"
+
+// Reasons a node pool does or does not have enough quota to be created.
+const (
+	ReasonQuotaSufficient   xpv1.ConditionReason = "QuotaSufficient"
+	ReasonInsufficientQuota xpv1.ConditionReason = "InsufficientQuota"
+)
+
+// QuotaSufficient returns a condition that indicates the node pool can be
+// created within the regional Compute Engine quota.
+func QuotaSufficient() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeQuotaAvailable,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonQuotaSufficient,
+	}
+}
+
+// TypeDrained resources have had their nodes cordoned and their pods evicted
+// before being deleted.
+const TypeDrained xpv1.ConditionType = "Drained"
+
+// Reasons a node pool is or is not drained.
+const (
+	ReasonDraining     xpv1.ConditionReason = "Draining"
+	ReasonDrained      xpv1.ConditionReason = "Drained"
+	ReasonDrainTimeout xpv1.ConditionReason = "DrainTimedOut"
+)
+
+// Draining returns a condition that indicates the nodes of the node pool are
+// being drained before it is deleted. The condition's transition time marks
+// when draining started.
+func Draining() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeDrained,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonDraining,
+	}
+}
+
+// Drained returns a condition that indicates the nodes of the node pool have
+// been drained and it can be deleted.
+func Drained() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeDrained,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonDrained,
+	}
+}
+
+// DrainTimedOut returns a condition that indicates the nodes of the node pool
+// could not be drained within the configured timeout. The supplied message
+// describes the pods that were not evicted.
+func DrainTimedOut(msg string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeDrained,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonDrainTimeout,
+		Message:            msg,
+	}
+}
+
+// TypeGCEResourcesObserved Node pools have had the Compute Engine resources
+// that GKE created for them observed.
+const TypeGCEResourcesObserved xpv1.ConditionType = "GCEResourcesObserved"
+
+// Reasons the Compute Engine resources of a node pool were or were not
+// observed.
+const (
+	ReasonGCEResourcesObserved      xpv1.ConditionReason = "Observed"
+	ReasonCannotObserveGCEResources xpv1.ConditionReason = "CannotObserve"
+)
+
+// GCEResourcesObserved returns a condition that indicates the Compute Engine
+// resources of the node pool were observed.
+func GCEResourcesObserved() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeGCEResourcesObserved,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonGCEResourcesObserved,
+	}
+}
+
+// CannotObserveGCEResources returns a condition that indicates the Compute
+// Engine resources of the node pool could not be observed, typically because
+// the provider lacks Compute Engine permissions, as described by the supplied
+// message.
+func CannotObserveGCEResources(msg string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeGCEResourcesObserved,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonCannotObserveGCEResources,
+		Message:            msg,
+	}
+}
+
+// InsufficientQuota returns a condition that indicates creation of the node
+// pool is pending until the regional Compute Engine quota described by the
+// supplied message is available.
+func InsufficientQuota(msg string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeQuotaAvailable,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonInsufficientQuota,
+		Message:            msg,
+	}
+}
+
 // NodePoolObservation is used to show the observed state of the GKE Node Pool
 // resource on GCP.
 type NodePoolObservation struct {
@@ -49,6 +168,26 @@ type NodePoolObservation struct {
 	// associated with this node pool.
 	InstanceGroupUrls []string `json:"instanceGroupUrls,omitempty"`
 
+	// InstanceGroupManagers: The names of the managed instance groups that
+	// GKE created for this node pool.
+	InstanceGroupManagers []string `json:"instanceGroupManagers,omitempty"`
+
+	// InstanceTemplates: The names of the instance templates that the
+	// managed instance groups of this node pool create nodes from.
+	InstanceTemplates []string `json:"instanceTemplates,omitempty"`
+
+	// Scaling: The observed size of the managed instance groups of this
+	// node pool, summed over all of its zones.
+	Scaling *NodePoolScalingStatus `json:"scaling,omitempty"`
+
+	// ServiceAccount: The Google Cloud Platform service account used by the
+	// nodes of this node pool.
+	ServiceAccount string `json:"serviceAccount,omitempty"`
+
+	// LocalStorage: The local SSDs attached to each node of this node pool,
+	// and where the nodes keep their ephemeral storage.
+	LocalStorage *LocalStorageStatus `json:"localStorage,omitempty"`
+
 	// PodIpv4CidrSize: The pod CIDR block size per node in
 	// this node pool.
 	PodIpv4CidrSize int64 `json:"podIpv4CidrSize,omitempty"`
@@ -107,12 +246,16 @@ type NodePoolParameters struct {
 
 	// ClusterRef sets the Cluster field by resolving the resource link of the
 	// referenced Crossplane GKECluster managed resource.
+	// Cluster takes precedence over ClusterRef, unless the resolve policy of
+	// ClusterRef is Always.
 	// +immutable
 	// +optional
 	ClusterRef *xpv1.Reference `json:"clusterRef,omitempty"`
 
 	// ClusterSelector selects a reference to resolve the resource link of the
 	// referenced Crossplane GKECluster managed resource.
+	// ClusterSelector is only used if neither Cluster nor ClusterRef is set,
+	// unless its resolve policy is Always.
 	// +immutable
 	// +optional
 	ClusterSelector *xpv1.Selector `json:"clusterSelector,omitempty"`
@@ -135,6 +278,15 @@ type NodePoolParameters struct {
 	// Config: The node configuration of the pool.
 	Config *NodeConfig `json:"config,omitempty"`
 
+	// DrainBeforeDelete configures the provider to cordon the nodes of this
+	// node pool and evict their pods before deleting it, so that workloads
+	// are rescheduled gracefully and their PodDisruptionBudgets are
+	// respected. The cluster is reached using the provider's GCP credentials,
+	// which must be allowed to cordon its nodes and evict its pods. Node pools
+	// are deleted without draining them if this is unset.
+	// +optional
+	DrainBeforeDelete *DrainBeforeDelete `json:"drainBeforeDelete,omitempty"`
+
 	// NOTE(hasheddan): InitialNodeCount is only reflected in the
 	// container.NodePool if it is specified on creation. If omitted at creation
 	// time, it will never be reflected in container.NodePool.
@@ -178,6 +330,25 @@ type NodePoolParameters struct {
 	Version *string `json:"version,omitempty"`
 }
 
+// DrainBeforeDelete controls how the nodes of a node pool are drained before
+// the node pool is deleted.
+type DrainBeforeDelete struct {
+	// Timeout is how long to wait for the nodes to be drained, for example
+	// "30m". Pods that can not be evicted within the timeout, typically
+	// because a PodDisruptionBudget does not allow it, are removed when the
+	// node pool is deleted. Defaults to 10 minutes.
+	// +optional
+	// +kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(s|m|h))+$`
+	Timeout *string `json:"timeout,omitempty"`
+
+	// GracePeriodSeconds is the time given to each evicted pod to terminate
+	// gracefully. The pod's own terminationGracePeriodSeconds is used if this
+	// is unset.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	GracePeriodSeconds *int64 `json:"gracePeriodSeconds,omitempty"`
+}
+
 // NodePoolAutoscaling contains information
 // required by cluster autoscaler to
 // adjust the size of the node pool to the current cluster usage.
@@ -203,7 +374,15 @@ type NodePoolAutoscaling struct {
 	MinNodeCount *int64 `json:"minNodeCount,omitempty"`
 }
 
-// NodeConfig is parameters that describe the nodes in a cluster.
+// NodeConfig is parameters that describe the nodes in a cluster. The number of
+// local SSDs is checked against the constraints that Compute Engine documents
+// for every machine type of a family, so that a node pool that GKE would fail
+// to create after a long wait is rejected up front. Limits that depend on the
+// number of vCPUs, and the fixed number of local SSDs of machine types such as
+// c3-standard-8-lssd, are left to GKE.
+// +kubebuilder:validation:XValidation:rule="!has(self.machineType) || !(self.machineType.startsWith('e2-') || self.machineType.startsWith('t2d-') || self.machineType.startsWith('t2a-')) || ((!has(self.localSsdCount) || self.localSsdCount == 0) && (!has(self.ephemeralStorageConfig) || !has(self.ephemeralStorageConfig.localSsdCount) || self.ephemeralStorageConfig.localSsdCount == 0))",message="E2, T2D and T2A machine types do not support local SSDs"
+// +kubebuilder:validation:XValidation:rule="!has(self.machineType) || !self.machineType.startsWith('n1-') || ((!has(self.localSsdCount) || self.localSsdCount <= 8 || self.localSsdCount in [16, 24]) && (!has(self.ephemeralStorageConfig) || !has(self.ephemeralStorageConfig.localSsdCount) || self.ephemeralStorageConfig.localSsdCount <= 8 || self.ephemeralStorageConfig.localSsdCount in [16, 24]))",message="N1 machine types support up to 8, 16 or 24 local SSDs"
+// +kubebuilder:validation:XValidation:rule="!has(self.machineType) || !(self.machineType.startsWith('n2-') || self.machineType.startsWith('n2d-') || self.machineType.startsWith('c2-') || self.machineType.startsWith('c2d-')) || ((!has(self.localSsdCount) || self.localSsdCount in [0, 1, 2, 4, 8, 16, 24]) && (!has(self.ephemeralStorageConfig) || !has(self.ephemeralStorageConfig.localSsdCount) || self.ephemeralStorageConfig.localSsdCount in [0, 1, 2, 4, 8, 16, 24]))",message="N2, N2D, C2 and C2D machine types support 1, 2, 4, 8, 16 or 24 local SSDs"
 type NodeConfig struct {
 	// Accelerators: A list of hardware accelerators to be attached to each
 	// node.
@@ -213,6 +392,11 @@ type NodeConfig struct {
 	// +immutable
 	Accelerators []*AcceleratorConfig `json:"accelerators,omitempty"`
 
+	// AdvancedMachineFeatures: Advanced features for the Compute Engine VM.
+	// +immutable
+	// +optional
+	AdvancedMachineFeatures *AdvancedMachineFeatures `json:"advancedMachineFeatures,omitempty"`
+
 	// BootDiskKmsKey:  The Customer Managed Encryption Key used to encrypt
 	// the boot disk attached to each node in the node pool. This should be
 	// of the form
@@ -241,6 +425,13 @@ type NodeConfig struct {
 	// +optional
 	DiskType *string `json:"diskType,omitempty"`
 
+	// EphemeralStorageConfig: Parameters for the ephemeral storage
+	// filesystem. This field is only supported by the GKE beta API, which
+	// must be enabled in the provider to use it.
+	// +immutable
+	// +optional
+	EphemeralStorageConfig *EphemeralStorageConfig `json:"ephemeralStorageConfig,omitempty"`
+
 	// ImageType: The image type to use for this node. Note that for a given
 	// image type,
 	// the latest version of it will be used.
@@ -284,6 +475,7 @@ type NodeConfig struct {
 	// for more information.
 	// +immutable
 	// +optional
+	// +kubebuilder:validation:Minimum=0
 	LocalSsdCount *int64 `json:"localSsdCount,omitempty"`
 
 	// MachineType: The name of a Google Compute Engine
@@ -445,6 +637,42 @@ type NodeConfig struct {
 	WorkloadMetadataConfig *WorkloadMetadataConfig `json:"workloadMetadataConfig,omitempty"`
 }
 
+// EphemeralStorageConfig contains configuration for the ephemeral storage
+// filesystem.
+type EphemeralStorageConfig struct {
+	// LocalSsdCount: Number of local SSDs to use to back ephemeral storage.
+	// Uses NVMe interfaces. Each local SSD is 375 GB in size. If zero, it
+	// means to disable using local SSDs as ephemeral storage.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	LocalSsdCount int64 `json:"localSsdCount,omitempty"`
+}
+
+// Where the nodes of a node pool keep their ephemeral storage, i.e. emptyDir
+// volumes, container writable layers, images and logs.
+const (
+	EphemeralStorageBootDisk = "BootDisk"
+	EphemeralStorageLocalSSD = "LocalSSD"
+)
+
+// LocalStorageStatus is the observed local storage of the nodes of a node
+// pool.
+type LocalStorageStatus struct {
+	// LocalSsdCount: The number of local SSDs attached to each node that
+	// are not used for ephemeral storage.
+	LocalSsdCount int64 `json:"localSsdCount,omitempty"`
+
+	// EphemeralStorageLocalSsdCount: The number of local SSDs attached to
+	// each node that back its ephemeral storage. It is only observed while
+	// the GKE beta API is enabled.
+	EphemeralStorageLocalSsdCount int64 `json:"ephemeralStorageLocalSsdCount,omitempty"`
+
+	// EphemeralStorage: Where each node keeps its ephemeral storage, either
+	// BootDisk or LocalSSD. It is only observed while the GKE beta API is
+	// enabled.
+	EphemeralStorage string `json:"ephemeralStorage,omitempty"`
+}
+
 // NodeKubeletConfig is configuration for the Node's Kubelet.
 type NodeKubeletConfig struct {
 	// CpuCfsQuota: Enable CPU CFS quota enforcement for containers that
@@ -524,6 +752,18 @@ type AcceleratorConfig struct {
 	AcceleratorType string `json:"acceleratorType,omitempty"`
 }
 
+// AdvancedMachineFeatures specifies options for controlling advanced machine
+// features.
+type AdvancedMachineFeatures struct {
+	// ThreadsPerCore: The number of threads per physical core. To disable
+	// simultaneous multithreading (SMT) set this to 1. If unset, the
+	// maximum number of threads supported per core by the underlying
+	// processor is assumed.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	ThreadsPerCore *int64 `json:"threadsPerCore,omitempty"`
+}
+
 // SandboxConfig contains configurations of the sandbox to use for the node.
 type SandboxConfig struct {
 	// Type: Type of the sandbox to use for the node.
@@ -615,6 +855,26 @@ type NodeManagementSpec struct {
 	AutoUpgrade *bool `json:"autoUpgrade,omitempty"`
 }
 
+// NodePoolScalingStatus is the observed size of a node pool and when it was
+// last scaled, either by the cluster autoscaler or by a resize.
+type NodePoolScalingStatus struct {
+	// CurrentNodeCount: The number of nodes that exist in the node pool,
+	// including nodes that are being deleted.
+	CurrentNodeCount int64 `json:"currentNodeCount"`
+
+	// TargetNodeCount: The number of nodes the node pool is being scaled
+	// to.
+	TargetNodeCount int64 `json:"targetNodeCount"`
+
+	// LastScaleUpTime: When the target node count was last observed to
+	// increase.
+	LastScaleUpTime *metav1.Time `json:"lastScaleUpTime,omitempty"`
+
+	// LastScaleDownTime: When the target node count was last observed to
+	// decrease.
+	LastScaleDownTime *metav1.Time `json:"lastScaleDownTime,omitempty"`
+}
+
 // NodeManagementStatus defines the observed set of node management services turned on
 // for the node pool.
 type NodeManagementStatus struct {
@@ -642,6 +902,10 @@ type AutoUpgradeOptions struct {
 type NodePoolSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       NodePoolParameters `json:"forProvider"`
+
+	// Timeouts override how long the GKE operations on the node pool may take.
+	// +optional
+	Timeouts *gcpv1beta1.Timeouts `json:"timeouts,omitempty"`
 }
 
 // A NodePoolStatus represents the observed state of a NodePool.
@@ -657,10 +921,13 @@ type NodePoolStatus struct {
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
 // +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.status"
 // +kubebuilder:printcolumn:name="CLUSTER-REF",type="string",JSONPath=".spec.forProvider.clusterRef.name"
+// +kubebuilder:printcolumn:name="VERSION",type="string",JSONPath=".spec.forProvider.version",priority=1
+// +kubebuilder:printcolumn:name="CLUSTER",type="string",JSONPath=".spec.forProvider.cluster",priority=1
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
-// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp},shortName=np
 type NodePool struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
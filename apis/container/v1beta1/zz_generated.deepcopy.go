@@ -23,6 +23,7 @@ package v1beta1
 
 import (
 	"github.com/crossplane-contrib/provider-gcp/apis/container/v1beta2"
+	apisv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
 	"github.com/crossplane/crossplane-runtime/apis/common/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
@@ -42,6 +43,26 @@ func (in *AcceleratorConfig) DeepCopy() *AcceleratorConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdvancedMachineFeatures) DeepCopyInto(out *AdvancedMachineFeatures) {
+	*out = *in
+	if in.ThreadsPerCore != nil {
+		in, out := &in.ThreadsPerCore, &out.ThreadsPerCore
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdvancedMachineFeatures.
+func (in *AdvancedMachineFeatures) DeepCopy() *AdvancedMachineFeatures {
+	if in == nil {
+		return nil
+	}
+	out := new(AdvancedMachineFeatures)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AutoUpgradeOptions) DeepCopyInto(out *AutoUpgradeOptions) {
 	*out = *in
@@ -57,6 +78,46 @@ func (in *AutoUpgradeOptions) DeepCopy() *AutoUpgradeOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DrainBeforeDelete) DeepCopyInto(out *DrainBeforeDelete) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(string)
+		**out = **in
+	}
+	if in.GracePeriodSeconds != nil {
+		in, out := &in.GracePeriodSeconds, &out.GracePeriodSeconds
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DrainBeforeDelete.
+func (in *DrainBeforeDelete) DeepCopy() *DrainBeforeDelete {
+	if in == nil {
+		return nil
+	}
+	out := new(DrainBeforeDelete)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EphemeralStorageConfig) DeepCopyInto(out *EphemeralStorageConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EphemeralStorageConfig.
+func (in *EphemeralStorageConfig) DeepCopy() *EphemeralStorageConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(EphemeralStorageConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LinuxNodeConfig) DeepCopyInto(out *LinuxNodeConfig) {
 	*out = *in
@@ -79,6 +140,21 @@ func (in *LinuxNodeConfig) DeepCopy() *LinuxNodeConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalStorageStatus) DeepCopyInto(out *LocalStorageStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalStorageStatus.
+func (in *LocalStorageStatus) DeepCopy() *LocalStorageStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalStorageStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NodeConfig) DeepCopyInto(out *NodeConfig) {
 	*out = *in
@@ -93,6 +169,11 @@ func (in *NodeConfig) DeepCopyInto(out *NodeConfig) {
 			}
 		}
 	}
+	if in.AdvancedMachineFeatures != nil {
+		in, out := &in.AdvancedMachineFeatures, &out.AdvancedMachineFeatures
+		*out = new(AdvancedMachineFeatures)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.BootDiskKmsKey != nil {
 		in, out := &in.BootDiskKmsKey, &out.BootDiskKmsKey
 		*out = new(string)
@@ -108,6 +189,11 @@ func (in *NodeConfig) DeepCopyInto(out *NodeConfig) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.EphemeralStorageConfig != nil {
+		in, out := &in.EphemeralStorageConfig, &out.EphemeralStorageConfig
+		*out = new(EphemeralStorageConfig)
+		**out = **in
+	}
 	if in.ImageType != nil {
 		in, out := &in.ImageType, &out.ImageType
 		*out = new(string)
@@ -408,6 +494,26 @@ func (in *NodePoolObservation) DeepCopyInto(out *NodePoolObservation) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.InstanceGroupManagers != nil {
+		in, out := &in.InstanceGroupManagers, &out.InstanceGroupManagers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.InstanceTemplates != nil {
+		in, out := &in.InstanceTemplates, &out.InstanceTemplates
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Scaling != nil {
+		in, out := &in.Scaling, &out.Scaling
+		*out = new(NodePoolScalingStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LocalStorage != nil {
+		in, out := &in.LocalStorage, &out.LocalStorage
+		*out = new(LocalStorageStatus)
+		**out = **in
+	}
 	if in.Management != nil {
 		in, out := &in.Management, &out.Management
 		*out = new(NodeManagementStatus)
@@ -448,6 +554,11 @@ func (in *NodePoolParameters) DeepCopyInto(out *NodePoolParameters) {
 		*out = new(NodeConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DrainBeforeDelete != nil {
+		in, out := &in.DrainBeforeDelete, &out.DrainBeforeDelete
+		*out = new(DrainBeforeDelete)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.InitialNodeCount != nil {
 		in, out := &in.InitialNodeCount, &out.InitialNodeCount
 		*out = new(int64)
@@ -490,11 +601,39 @@ func (in *NodePoolParameters) DeepCopy() *NodePoolParameters {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodePoolScalingStatus) DeepCopyInto(out *NodePoolScalingStatus) {
+	*out = *in
+	if in.LastScaleUpTime != nil {
+		in, out := &in.LastScaleUpTime, &out.LastScaleUpTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastScaleDownTime != nil {
+		in, out := &in.LastScaleDownTime, &out.LastScaleDownTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodePoolScalingStatus.
+func (in *NodePoolScalingStatus) DeepCopy() *NodePoolScalingStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NodePoolScalingStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NodePoolSpec) DeepCopyInto(out *NodePoolSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	if in.Timeouts != nil {
+		in, out := &in.Timeouts, &out.Timeouts
+		*out = new(apisv1beta1.Timeouts)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodePoolSpec.
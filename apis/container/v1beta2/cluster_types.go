@@ -18,9 +18,12 @@ limitations under the License.
 package v1beta2
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	gcpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
 )
 
 // Cluster states.
@@ -34,6 +37,141 @@ const (
 	ClusterStateDegraded     = "DEGRADED"
 )
 
+// TypeLocationAllowed resources were found to be in locations the resource
+// locations organization policy of their project allows.
+const TypeLocationAllowed xpv1.ConditionType = "LocationAllowed"
+
+// Reasons a cluster or node pool is or is not in an allowed location.
+const (
+	ReasonLocationAllowed xpv1.ConditionReason = "LocationAllowed"
+	ReasonLocationDenied  xpv1.ConditionReason = "LocationDenied"
+)
+
+// LocationAllowed returns a condition that indicates the resource locations
+// organization policy allows the cluster or node pool to be created.
+func LocationAllowed() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeLocationAllowed,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonLocationAllowed,
+	}
+}
+
+// LocationDenied returns a condition that indicates the resource locations
+// organization policy does not allow the locations described by the supplied
+// message.
+func LocationDenied(msg string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeLocationAllowed,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonLocationDenied,
+		Message:            msg,
+	}
+}
+
+// TypeVersionSupported clusters run a version that GKE still offers.
+const TypeVersionSupported xpv1.ConditionType = "VersionSupported"
+
+// Reasons a cluster's version is or is not supported.
+const (
+	ReasonVersionSupported           xpv1.ConditionReason = "VersionSupported"
+	ReasonVersionNearingEndOfSupport xpv1.ConditionReason = "VersionNearingEndOfSupport"
+	ReasonVersionEndOfSupport        xpv1.ConditionReason = "VersionEndOfSupport"
+)
+
+// VersionSupported returns a condition that indicates the cluster's version
+// is not among the oldest versions GKE offers.
+func VersionSupported() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeVersionSupported,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonVersionSupported,
+	}
+}
+
+// VersionNearingEndOfSupport returns a condition that indicates the cluster's
+// minor version is the oldest one GKE offers, and will soon be upgraded or
+// lose support, as described by the supplied message.
+func VersionNearingEndOfSupport(msg string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeVersionSupported,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonVersionNearingEndOfSupport,
+		Message:            msg,
+	}
+}
+
+// VersionEndOfSupport returns a condition that indicates the cluster's minor
+// version is older than any GKE offers, as described by the supplied message.
+func VersionEndOfSupport(msg string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeVersionSupported,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonVersionEndOfSupport,
+		Message:            msg,
+	}
+}
+
+// TypeGCEResourcesObserved Clusters have had the Compute Engine resources
+// that GKE created for them observed.
+const TypeGCEResourcesObserved xpv1.ConditionType = "GCEResourcesObserved"
+
+// Reasons the Compute Engine resources of a cluster were or were not
+// observed.
+const (
+	ReasonGCEResourcesObserved      xpv1.ConditionReason = "Observed"
+	ReasonCannotObserveGCEResources xpv1.ConditionReason = "CannotObserve"
+)
+
+// GCEResourcesObserved returns a condition that indicates the Compute Engine
+// resources of the cluster were observed.
+func GCEResourcesObserved() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeGCEResourcesObserved,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonGCEResourcesObserved,
+	}
+}
+
+// CannotObserveGCEResources returns a condition that indicates the Compute
+// Engine resources of the cluster could not be observed, typically because
+// the provider lacks Compute Engine permissions, as described by the supplied
+// message.
+func CannotObserveGCEResources(msg string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeGCEResourcesObserved,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonCannotObserveGCEResources,
+		Message:            msg,
+	}
+}
+
+// Connection secret keys of a cluster, in addition to the standard keys.
+const (
+	ClusterPrivateEndpointKey = "privateEndpoint"
+	ClusterPublicEndpointKey  = "publicEndpoint"
+)
+
+// Endpoint types that the kubeconfig of a cluster may use.
+const (
+	EndpointTypePrivate = "Private"
+	EndpointTypePublic  = "Public"
+)
+
+// Ways the kubeconfig of a cluster that issues a client certificate may
+// authenticate.
+const (
+	KubeconfigAuthenticationExec              = "Exec"
+	KubeconfigAuthenticationClientCertificate = "ClientCertificate"
+)
+
 // Defaults for GKE resources.
 const (
 	DefaultNumberOfNodes = int64(1)
@@ -42,6 +180,7 @@ const (
 // ClusterParameters define the desired state of a Google Kubernetes Engine
 // cluster. Most of its fields are direct mirror of GCP Cluster object.
 // See https://cloud.google.com/kubernetes-engine/docs/reference/rest/v1/projects.locations.clusters#Cluster
+// +kubebuilder:validation:XValidation:rule="!has(self.meshCertificates) || !has(self.meshCertificates.enableCertificates) || !self.meshCertificates.enableCertificates || (has(self.workloadIdentityConfig) && has(self.workloadIdentityConfig.workloadPool) && size(self.workloadIdentityConfig.workloadPool) > 0)",message="meshCertificates.enableCertificates requires workloadIdentityConfig.workloadPool"
 type ClusterParameters struct {
 	// NOTE(hasheddan): Location is labelled as Output Only by GCP but is required
 	// to create a cluster. It is not included in the actual cluster object
@@ -55,9 +194,11 @@ type ClusterParameters struct {
 	// or
 	// [region](https://cloud.google.com/compute/docs/regions-zones/regions-zones#available) in
 	// which
-	// the cluster resides.
+	// the cluster resides. Defaults to the default region, or the default
+	// zone, of the ProviderConfig.
+	// +optional
 	// +immutable
-	Location string `json:"location"`
+	Location string `json:"location,omitempty"`
 
 	// AddonsConfig: Configurations for the various addons available to run
 	// in the cluster.
@@ -67,7 +208,6 @@ type ClusterParameters struct {
 	// AuthenticatorGroupsConfig: Configuration controlling RBAC group
 	// membership information.
 	// +optional
-	// +immutable
 	AuthenticatorGroupsConfig *AuthenticatorGroupsConfig `json:"authenticatorGroupsConfig,omitempty"`
 
 	// Autopilot: Autopilot configuration for the cluster.
@@ -94,11 +234,23 @@ type ClusterParameters struct {
 	// +immutable
 	ClusterIpv4Cidr *string `json:"clusterIpv4Cidr,omitempty"`
 
+	// ClusterTelemetry: Telemetry integration for the cluster. This field
+	// is only supported by the GKE beta API, which must be enabled in the
+	// provider to use it.
+	// +optional
+	ClusterTelemetry *ClusterTelemetry `json:"clusterTelemetry,omitempty"`
+
 	// ConfidentialNodes: Configuration of Confidential Nodes
 	// +optional
 	// +immutable
 	ConfidentialNodes *ConfidentialNodes `json:"confidentialNodes,omitempty"`
 
+	// CostManagementConfig: Configuration for the fine-grained cost
+	// management feature, which allocates the cost of the cluster to
+	// namespaces and labels in the Cloud Billing export.
+	// +optional
+	CostManagementConfig *CostManagementConfig `json:"costManagementConfig,omitempty"`
+
 	// DatabaseEncryption: Configuration of etcd encryption.
 	// +optional
 	DatabaseEncryption *DatabaseEncryption `json:"databaseEncryption,omitempty"`
@@ -220,6 +372,11 @@ type ClusterParameters struct {
 	// +optional
 	MasterAuthorizedNetworksConfig *MasterAuthorizedNetworksConfig `json:"masterAuthorizedNetworksConfig,omitempty"`
 
+	// MeshCertificates: Configuration for issuance of mTLS keys and
+	// certificates to Kubernetes pods, e.g. for Anthos Service Mesh.
+	// +optional
+	MeshCertificates *MeshCertificates `json:"meshCertificates,omitempty"`
+
 	// MonitoringService: The monitoring service the cluster should use to
 	// write metrics.
 	// Currently available options:
@@ -242,12 +399,16 @@ type ClusterParameters struct {
 	// +immutable
 	Network *string `json:"network,omitempty"`
 
-	// NetworkRef references to a Network and retrieves its URI
+	// NetworkRef references to a Network and retrieves its URI.
+	// Network takes precedence over NetworkRef, unless the resolve policy of
+	// NetworkRef is Always.
 	// +optional
 	// +immutable
 	NetworkRef *xpv1.Reference `json:"networkRef,omitempty"`
 
-	// NetworkSelector selects a reference to a Network and retrieves its URI
+	// NetworkSelector selects a reference to a Network and retrieves its URI.
+	// NetworkSelector is only used if neither Network nor NetworkRef is set,
+	// unless its resolve policy is Always.
 	// +optional
 	// +immutable
 	NetworkSelector *xpv1.Selector `json:"networkSelector,omitempty"`
@@ -263,6 +424,12 @@ type ClusterParameters struct {
 	// +optional
 	NetworkPolicy *NetworkPolicy `json:"networkPolicy,omitempty"`
 
+	// NodePoolDefaults: Default NodePool settings for the entire cluster.
+	// These settings are overridden if specified on the specific NodePool
+	// object.
+	// +optional
+	NodePoolDefaults *NodePoolDefaults `json:"nodePoolDefaults,omitempty"`
+
 	// NotificationConfig: Notification configuration of the cluster.
 	NotificationConfig *NotificationConfig `json:"notificationConfig,omitempty"`
 
@@ -270,6 +437,12 @@ type ClusterParameters struct {
 	// +optional
 	PrivateClusterConfig *PrivateClusterConfigSpec `json:"privateClusterConfig,omitempty"`
 
+	// ProtectConfig: Configuration for the GKE security posture features.
+	// This field is only supported by the GKE beta API, which must be
+	// enabled in the provider to use it.
+	// +optional
+	ProtectConfig *ProtectConfig `json:"protectConfig,omitempty"`
+
 	// ReleaseChannel: Release channel configuration.
 	ReleaseChannel *ReleaseChannel `json:"releaseChannel,omitempty"`
 
@@ -296,13 +469,17 @@ type ClusterParameters struct {
 	// +immutable
 	Subnetwork *string `json:"subnetwork,omitempty"`
 
-	// SubnetworkRef references to a Subnetwork and retrieves its URI
+	// SubnetworkRef references to a Subnetwork and retrieves its URI.
+	// Subnetwork takes precedence over SubnetworkRef, unless the resolve policy of
+	// SubnetworkRef is Always.
 	// +optional
 	// +immutable
 	SubnetworkRef *xpv1.Reference `json:"subnetworkRef,omitempty"`
 
 	// SubnetworkSelector selects a reference to a Subnetwork and retrieves its
-	// URI
+	// URI.
+	// SubnetworkSelector is only used if neither Subnetwork nor SubnetworkRef is set,
+	// unless its resolve policy is Always.
 	// +optional
 	// +immutable
 	SubnetworkSelector *xpv1.Selector `json:"subnetworkSelector,omitempty"`
@@ -312,6 +489,12 @@ type ClusterParameters struct {
 	// +optional
 	VerticalPodAutoscaling *VerticalPodAutoscaling `json:"verticalPodAutoscaling,omitempty"`
 
+	// WorkloadCertificates: Configuration for issuing mTLS certificates to
+	// workloads. This field is only supported by the GKE beta API, which
+	// must be enabled in the provider to use it.
+	// +optional
+	WorkloadCertificates *WorkloadCertificates `json:"workloadCertificates,omitempty"`
+
 	// WorkloadIdentityConfig: Configuration for the use of Kubernetes
 	// Service Accounts in GCP IAM
 	// policies.
@@ -365,6 +548,9 @@ type ClusterObservation struct {
 	// format.
 	ExpireTime string `json:"expireTime,omitempty"`
 
+	// ID: Unique id for the cluster.
+	ID string `json:"id,omitempty"`
+
 	// Location: The name of the Google Compute
 	// Engine
 	// [zone](https://cloud.google.com/compute/docs/regions-zones/regions-zones#available)
@@ -401,6 +587,19 @@ type ClusterObservation struct {
 	// specified.
 	NodePools []*NodePoolClusterStatus `json:"nodePools,omitempty"`
 
+	// NodeServiceAccount: The Google Cloud Platform service account used by
+	// nodes that are not part of a NodePool with a service account of its
+	// own, including auto-provisioned nodes.
+	NodeServiceAccount string `json:"nodeServiceAccount,omitempty"`
+
+	// FirewallRules: The names of the firewall rules that GKE created in the
+	// cluster's network on behalf of this cluster.
+	FirewallRules []string `json:"firewallRules,omitempty"`
+
+	// ReleaseChannels: The default and available versions of each release
+	// channel in the cluster's location, so that upgrades can be planned.
+	ReleaseChannels []*ReleaseChannelStatus `json:"releaseChannels,omitempty"`
+
 	// SelfLink: Server-defined URL for the resource.
 	SelfLink string `json:"selfLink,omitempty"`
 
@@ -821,6 +1020,13 @@ type ConfidentialNodes struct {
 	Enabled bool `json:"enabled"`
 }
 
+// CostManagementConfig is configuration for the fine-grained cost
+// management feature.
+type CostManagementConfig struct {
+	// Enabled: Whether the feature is enabled or not.
+	Enabled bool `json:"enabled"`
+}
+
 // DatabaseEncryption is configuration of etcd encryption.
 type DatabaseEncryption struct {
 	// KeyName: Name of CloudKMS key to use for the encryption of secrets in
@@ -865,6 +1071,18 @@ type ReleaseChannel struct {
 	Channel string `json:"channel"`
 }
 
+// ReleaseChannelStatus shows the versions GKE offers on a release channel.
+type ReleaseChannelStatus struct {
+	// Channel: The release channel, e.g. "REGULAR".
+	Channel string `json:"channel,omitempty"`
+
+	// DefaultVersion: The version new clusters on the channel get by default.
+	DefaultVersion string `json:"defaultVersion,omitempty"`
+
+	// ValidVersions: The versions clusters on the channel can run.
+	ValidVersions []string `json:"validVersions,omitempty"`
+}
+
 // NotificationConfig is the configuration of notifications.
 type NotificationConfig struct {
 	// Pubsub: Notification config for Pub/Sub.
@@ -1139,6 +1357,17 @@ type MaintenancePolicyStatus struct {
 	// Window: Specifies the maintenance window in which maintenance may be
 	// performed.
 	Window MaintenanceWindowStatus `json:"window,omitempty"`
+
+	// NextMaintenanceStartTime: The earliest time at which GKE may start
+	// maintenance, in RFC3339 format. It is the start of the current or next
+	// maintenance window, deferred past any maintenance exclusions, and is
+	// in the past while a window is open. It is only reported for daily
+	// windows and for daily or weekly recurring windows.
+	NextMaintenanceStartTime string `json:"nextMaintenanceStartTime,omitempty"`
+
+	// ActiveMaintenanceExclusions: The names of the maintenance exclusions
+	// that are currently in effect.
+	ActiveMaintenanceExclusions []string `json:"activeMaintenanceExclusions,omitempty"`
 }
 
 // MaintenanceWindowStatus defines the maintenance window
@@ -1188,7 +1417,11 @@ type MasterAuth struct {
 // ClientCertificateConfig is configuration for client certificates on the
 // cluster.
 type ClientCertificateConfig struct {
-	// IssueClientCertificate: Issue a client certificate.
+	// IssueClientCertificate: Issue a client certificate. The certificate is
+	// a legacy authentication method that is not bound to any RBAC role, so
+	// a kubeconfig that uses it cannot do anything until it is bound
+	// explicitly. See
+	// https://cloud.google.com/kubernetes-engine/docs/how-to/api-server-authentication#legacy-auth
 	// +immutable
 	IssueClientCertificate bool `json:"issueClientCertificate"`
 }
@@ -1344,6 +1577,52 @@ type NetworkPolicy struct {
 	Provider *string `json:"provider,omitempty"`
 }
 
+// NodePoolDefaults is a subset of NodePool configuration that applies to
+// every node pool of a cluster unless overridden by the node pool.
+type NodePoolDefaults struct {
+	// NodeConfigDefaults: Subset of NodeConfig message that has defaults.
+	// +optional
+	NodeConfigDefaults *NodeConfigDefaults `json:"nodeConfigDefaults,omitempty"`
+}
+
+// NodeConfigDefaults is a subset of NodeConfig that has defaults.
+type NodeConfigDefaults struct {
+	// GcfsConfig: GCFS (Google Container File System, also known as
+	// Riptide) options.
+	// +optional
+	GcfsConfig *GcfsConfig `json:"gcfsConfig,omitempty"`
+
+	// LoggingConfig: Logging configuration for node pools.
+	// +optional
+	LoggingConfig *NodePoolLoggingConfig `json:"loggingConfig,omitempty"`
+}
+
+// GcfsConfig is configuration of the Google Container File System, which
+// enables image streaming.
+type GcfsConfig struct {
+	// Enabled: Whether to use GCFS.
+	Enabled bool `json:"enabled"`
+}
+
+// NodePoolLoggingConfig specifies logging configuration for node pools.
+type NodePoolLoggingConfig struct {
+	// VariantConfig: Logging variant configuration.
+	// +optional
+	VariantConfig *LoggingVariantConfig `json:"variantConfig,omitempty"`
+}
+
+// LoggingVariantConfig specifies the logging variant deployed on nodes.
+type LoggingVariantConfig struct {
+	// Variant: Logging variant deployed on nodes.
+	//
+	// Possible values:
+	//   "DEFAULT" - default logging variant.
+	//   "MAX_THROUGHPUT" - maximum logging throughput variant.
+	// +kubebuilder:validation:Enum=DEFAULT;MAX_THROUGHPUT
+	// +optional
+	Variant *string `json:"variant,omitempty"`
+}
+
 // PrivateClusterConfigSpec is configuration options for private clusters.
 type PrivateClusterConfigSpec struct {
 	// EnablePrivateEndpoint: Whether the master's internal IP address is
@@ -1417,7 +1696,16 @@ type ResourceUsageExportConfig struct {
 // of resource usage export.
 type BigQueryDestination struct {
 	// DatasetId: The ID of a BigQuery Dataset.
-	DatasetID string `json:"datasetId"`
+	// +optional
+	DatasetID string `json:"datasetId,omitempty"`
+
+	// DatasetIDRef references a Dataset to retrieve its ID.
+	// +optional
+	DatasetIDRef *xpv1.Reference `json:"datasetIdRef,omitempty"`
+
+	// DatasetIDSelector selects a reference to a Dataset to retrieve its ID.
+	// +optional
+	DatasetIDSelector *xpv1.Selector `json:"datasetIdSelector,omitempty"`
 }
 
 // ConsumptionMeteringConfig is parameters for controlling consumption
@@ -1440,6 +1728,18 @@ type VerticalPodAutoscaling struct {
 	Enabled bool `json:"enabled"`
 }
 
+// MeshCertificates is configuration for issuance of mTLS keys and
+// certificates to Kubernetes pods.
+type MeshCertificates struct {
+	// EnableCertificates: Controls issuance of workload mTLS certificates.
+	// If set, the GKE Workload Identity Certificates controller and node
+	// agent are deployed in the cluster, which can then be configured by
+	// creating a WorkloadCertificateConfig custom resource. Requires
+	// Workload Identity.
+	// +optional
+	EnableCertificates *bool `json:"enableCertificates,omitempty"`
+}
+
 // WorkloadIdentityConfig is configuration for the use of Kubernetes
 // Service Accounts in GCP IAM
 // policies.
@@ -1449,6 +1749,72 @@ type WorkloadIdentityConfig struct {
 	WorkloadPool string `json:"workloadPool,omitempty"`
 }
 
+// ClusterTelemetry is telemetry integration for the cluster.
+type ClusterTelemetry struct {
+	// Type: Type of the integration.
+	//
+	// Possible values:
+	//   "UNSPECIFIED" - Not set.
+	//   "DISABLED" - Monitoring integration is disabled.
+	//   "ENABLED" - Monitoring integration is enabled.
+	//   "SYSTEM_ONLY" - Only system components are monitored and logged.
+	// +kubebuilder:validation:Enum=UNSPECIFIED;DISABLED;ENABLED;SYSTEM_ONLY
+	Type string `json:"type"`
+}
+
+// ProtectConfig defines the flags needed to enable or disable GKE security
+// posture features.
+type ProtectConfig struct {
+	// WorkloadConfig: WorkloadConfig defines which actions are enabled for a
+	// cluster's workload configurations.
+	// +optional
+	WorkloadConfig *WorkloadConfig `json:"workloadConfig,omitempty"`
+
+	// WorkloadVulnerabilityMode: Sets which mode to use for Protect
+	// workload vulnerability scanning feature.
+	//
+	// Possible values:
+	//   "WORKLOAD_VULNERABILITY_MODE_UNSPECIFIED" - Default value not
+	// specified.
+	//   "DISABLED" - Disables Workload Vulnerability Scanning feature on
+	// the cluster.
+	//   "BASIC" - Applies basic vulnerability scanning settings for
+	// cluster workloads.
+	// +optional
+	// +kubebuilder:validation:Enum=WORKLOAD_VULNERABILITY_MODE_UNSPECIFIED;DISABLED;BASIC
+	WorkloadVulnerabilityMode *string `json:"workloadVulnerabilityMode,omitempty"`
+}
+
+// WorkloadConfig defines the flags to enable or disable the workload
+// configuration audit.
+type WorkloadConfig struct {
+	// AuditMode: Sets which mode of auditing should be used for the
+	// cluster's workloads.
+	//
+	// Possible values:
+	//   "MODE_UNSPECIFIED" - Default value meaning that no mode has been
+	// specified.
+	//   "DISABLED" - This disables Workload Configuration auditing on the
+	// cluster, meaning that nothing is surfaced.
+	//   "BASIC" - Applies the default set of policy auditing to a cluster's
+	// workloads.
+	//   "BASELINE" - Surfaces configurations that are not in line with the
+	// Pod Security Standard Baseline policy.
+	//   "RESTRICTED" - Surfaces configurations that are not in line with
+	// the Pod Security Standard Restricted policy.
+	// +kubebuilder:validation:Enum=MODE_UNSPECIFIED;DISABLED;BASIC;BASELINE;RESTRICTED
+	AuditMode string `json:"auditMode"`
+}
+
+// WorkloadCertificates is configuration for issuing mTLS certificates to
+// workloads.
+type WorkloadCertificates struct {
+	// EnableCertificates: enables workload certificates. If enabled,
+	// workload certificates are issued and rotated automatically for
+	// workloads that use mTLS.
+	EnableCertificates bool `json:"enableCertificates"`
+}
+
 // NOTE(hasheddan): the following structs are meant to be utilized to model Node
 // Pools in the status of Cluster objects. They are not to be used to define
 // configurable fields for NodePool objects.
@@ -1835,6 +2201,52 @@ type AutoUpgradeOptionsClusterStatus struct {
 type ClusterSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       ClusterParameters `json:"forProvider"`
+
+	// Timeouts override how long the GKE operations on the cluster may take.
+	// +optional
+	Timeouts *gcpv1beta1.Timeouts `json:"timeouts,omitempty"`
+
+	// Connection configures the connection details published for the
+	// cluster.
+	// +optional
+	Connection *ClusterConnection `json:"connection,omitempty"`
+
+	// WriteConnectionSecretToRefs specifies the namespaces and names of
+	// Secrets to which the connection details of the cluster are written,
+	// in addition to the Secret of WriteConnectionSecretToReference. It lets
+	// the kubeconfig be published to several namespaces, e.g. those of a
+	// platform team and of a tenant. A Secret is deleted when it is removed
+	// from the list, and when the cluster is deleted.
+	// +optional
+	WriteConnectionSecretToRefs []xpv1.SecretReference `json:"writeConnectionSecretToRefs,omitempty"`
+
+	// DefaultUpgradeSettings are the upgrade settings of NodePools that
+	// reference this cluster and don't specify their own. They are copied to
+	// a NodePool when it is created, so changing them doesn't affect existing
+	// node pools.
+	// +optional
+	DefaultUpgradeSettings *UpgradeSettings `json:"defaultUpgradeSettings,omitempty"`
+}
+
+// ClusterConnection configures the connection details of a cluster.
+type ClusterConnection struct {
+	// EndpointType is the endpoint of a private cluster that the endpoint
+	// and kubeconfig keys of the connection secret use. The cluster's
+	// default endpoint is used if it is omitted, or if the cluster has no
+	// such endpoint. Either endpoint is also published under its own key.
+	// +kubebuilder:validation:Enum=Private;Public
+	// +optional
+	EndpointType *string `json:"endpointType,omitempty"`
+
+	// KubeconfigAuthentication is how the kubeconfig key of the connection
+	// secret authenticates to a cluster that issues a client certificate.
+	// Exec runs gke-gcloud-auth-plugin, like the kubeconfig written by gcloud
+	// container clusters get-credentials. ClientCertificate uses the client
+	// certificate, which is not bound to any RBAC role. Defaults to Exec. The
+	// client certificate is published under its own key either way.
+	// +kubebuilder:validation:Enum=Exec;ClientCertificate
+	// +optional
+	KubeconfigAuthentication *string `json:"kubeconfigAuthentication,omitempty"`
 }
 
 // A ClusterStatus represents the observed state of a Cluster.
@@ -1850,11 +2262,14 @@ type ClusterStatus struct {
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
 // +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.status"
 // +kubebuilder:printcolumn:name="ENDPOINT",type="string",JSONPath=".status.atProvider.endpoint"
 // +kubebuilder:printcolumn:name="LOCATION",type="string",JSONPath=".spec.forProvider.location"
+// +kubebuilder:printcolumn:name="VERSION",type="string",JSONPath=".status.atProvider.currentMasterVersion",priority=1
+// +kubebuilder:printcolumn:name="NODES",type="integer",JSONPath=".status.atProvider.currentNodeCount",priority=1
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
-// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp},shortName=gke
 type Cluster struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
@@ -27,6 +27,7 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
 	resource "github.com/crossplane/crossplane-runtime/pkg/resource"
 
+	bigqueryv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/bigquery/v1alpha1"
 	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1beta1"
 )
 
@@ -73,5 +74,21 @@ func (mg *Cluster) ResolveReferences(ctx context.Context, c client.Reader) error
 	mg.Spec.ForProvider.Subnetwork = reference.ToPtrValue(rsp.ResolvedValue)
 	mg.Spec.ForProvider.SubnetworkRef = rsp.ResolvedReference
 
+	// Resolve spec.forProvider.resourceUsageExportConfig.bigqueryDestination.datasetId
+	if c := mg.Spec.ForProvider.ResourceUsageExportConfig; c != nil && c.BigqueryDestination != nil {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: c.BigqueryDestination.DatasetID,
+			Reference:    c.BigqueryDestination.DatasetIDRef,
+			Selector:     c.BigqueryDestination.DatasetIDSelector,
+			To:           reference.To{Managed: &bigqueryv1alpha1.Dataset{}, List: &bigqueryv1alpha1.DatasetList{}},
+			Extract:      reference.ExternalName(),
+		})
+		if err != nil {
+			return errors.Wrap(err, "spec.forProvider.resourceUsageExportConfig.bigqueryDestination.datasetId")
+		}
+		c.BigqueryDestination.DatasetID = rsp.ResolvedValue
+		c.BigqueryDestination.DatasetIDRef = rsp.ResolvedReference
+	}
+
 	return nil
 }
@@ -22,6 +22,7 @@ limitations under the License.
 package v1beta2
 
 import (
+	"github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
 	"github.com/crossplane/crossplane-runtime/apis/common/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
@@ -214,6 +215,16 @@ func (in *AutoprovisioningNodePoolDefaults) DeepCopy() *AutoprovisioningNodePool
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BigQueryDestination) DeepCopyInto(out *BigQueryDestination) {
 	*out = *in
+	if in.DatasetIDRef != nil {
+		in, out := &in.DatasetIDRef, &out.DatasetIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DatasetIDSelector != nil {
+		in, out := &in.DatasetIDSelector, &out.DatasetIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BigQueryDestination.
@@ -369,6 +380,31 @@ func (in *ClusterAutoscaling) DeepCopy() *ClusterAutoscaling {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterConnection) DeepCopyInto(out *ClusterConnection) {
+	*out = *in
+	if in.EndpointType != nil {
+		in, out := &in.EndpointType, &out.EndpointType
+		*out = new(string)
+		**out = **in
+	}
+	if in.KubeconfigAuthentication != nil {
+		in, out := &in.KubeconfigAuthentication, &out.KubeconfigAuthentication
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterConnection.
+func (in *ClusterConnection) DeepCopy() *ClusterConnection {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterConnection)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterList) DeepCopyInto(out *ClusterList) {
 	*out = *in
@@ -418,7 +454,7 @@ func (in *ClusterObservation) DeepCopyInto(out *ClusterObservation) {
 	if in.MaintenancePolicy != nil {
 		in, out := &in.MaintenancePolicy, &out.MaintenancePolicy
 		*out = new(MaintenancePolicyStatus)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.NetworkConfig != nil {
 		in, out := &in.NetworkConfig, &out.NetworkConfig
@@ -441,6 +477,22 @@ func (in *ClusterObservation) DeepCopyInto(out *ClusterObservation) {
 			}
 		}
 	}
+	if in.FirewallRules != nil {
+		in, out := &in.FirewallRules, &out.FirewallRules
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ReleaseChannels != nil {
+		in, out := &in.ReleaseChannels, &out.ReleaseChannels
+		*out = make([]*ReleaseChannelStatus, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(ReleaseChannelStatus)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterObservation.
@@ -486,11 +538,21 @@ func (in *ClusterParameters) DeepCopyInto(out *ClusterParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.ClusterTelemetry != nil {
+		in, out := &in.ClusterTelemetry, &out.ClusterTelemetry
+		*out = new(ClusterTelemetry)
+		**out = **in
+	}
 	if in.ConfidentialNodes != nil {
 		in, out := &in.ConfidentialNodes, &out.ConfidentialNodes
 		*out = new(ConfidentialNodes)
 		**out = **in
 	}
+	if in.CostManagementConfig != nil {
+		in, out := &in.CostManagementConfig, &out.CostManagementConfig
+		*out = new(CostManagementConfig)
+		**out = **in
+	}
 	if in.DatabaseEncryption != nil {
 		in, out := &in.DatabaseEncryption, &out.DatabaseEncryption
 		*out = new(DatabaseEncryption)
@@ -561,6 +623,11 @@ func (in *ClusterParameters) DeepCopyInto(out *ClusterParameters) {
 		*out = new(MasterAuthorizedNetworksConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.MeshCertificates != nil {
+		in, out := &in.MeshCertificates, &out.MeshCertificates
+		*out = new(MeshCertificates)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.MonitoringService != nil {
 		in, out := &in.MonitoringService, &out.MonitoringService
 		*out = new(string)
@@ -591,6 +658,11 @@ func (in *ClusterParameters) DeepCopyInto(out *ClusterParameters) {
 		*out = new(NetworkPolicy)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.NodePoolDefaults != nil {
+		in, out := &in.NodePoolDefaults, &out.NodePoolDefaults
+		*out = new(NodePoolDefaults)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.NotificationConfig != nil {
 		in, out := &in.NotificationConfig, &out.NotificationConfig
 		*out = new(NotificationConfig)
@@ -601,6 +673,11 @@ func (in *ClusterParameters) DeepCopyInto(out *ClusterParameters) {
 		*out = new(PrivateClusterConfigSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ProtectConfig != nil {
+		in, out := &in.ProtectConfig, &out.ProtectConfig
+		*out = new(ProtectConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ReleaseChannel != nil {
 		in, out := &in.ReleaseChannel, &out.ReleaseChannel
 		*out = new(ReleaseChannel)
@@ -638,6 +715,11 @@ func (in *ClusterParameters) DeepCopyInto(out *ClusterParameters) {
 		*out = new(VerticalPodAutoscaling)
 		**out = **in
 	}
+	if in.WorkloadCertificates != nil {
+		in, out := &in.WorkloadCertificates, &out.WorkloadCertificates
+		*out = new(WorkloadCertificates)
+		**out = **in
+	}
 	if in.WorkloadIdentityConfig != nil {
 		in, out := &in.WorkloadIdentityConfig, &out.WorkloadIdentityConfig
 		*out = new(WorkloadIdentityConfig)
@@ -660,6 +742,26 @@ func (in *ClusterSpec) DeepCopyInto(out *ClusterSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	if in.Timeouts != nil {
+		in, out := &in.Timeouts, &out.Timeouts
+		*out = new(v1beta1.Timeouts)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Connection != nil {
+		in, out := &in.Connection, &out.Connection
+		*out = new(ClusterConnection)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WriteConnectionSecretToRefs != nil {
+		in, out := &in.WriteConnectionSecretToRefs, &out.WriteConnectionSecretToRefs
+		*out = make([]v1.SecretReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.DefaultUpgradeSettings != nil {
+		in, out := &in.DefaultUpgradeSettings, &out.DefaultUpgradeSettings
+		*out = new(UpgradeSettings)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterSpec.
@@ -689,6 +791,21 @@ func (in *ClusterStatus) DeepCopy() *ClusterStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterTelemetry) DeepCopyInto(out *ClusterTelemetry) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTelemetry.
+func (in *ClusterTelemetry) DeepCopy() *ClusterTelemetry {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterTelemetry)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConfidentialNodes) DeepCopyInto(out *ConfidentialNodes) {
 	*out = *in
@@ -734,6 +851,21 @@ func (in *ConsumptionMeteringConfig) DeepCopy() *ConsumptionMeteringConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CostManagementConfig) DeepCopyInto(out *CostManagementConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CostManagementConfig.
+func (in *CostManagementConfig) DeepCopy() *CostManagementConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(CostManagementConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DNSCacheConfig) DeepCopyInto(out *DNSCacheConfig) {
 	*out = *in
@@ -864,6 +996,21 @@ func (in *GCEPersistentDiskCSIDriverConfig) DeepCopy() *GCEPersistentDiskCSIDriv
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GcfsConfig) DeepCopyInto(out *GcfsConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcfsConfig.
+func (in *GcfsConfig) DeepCopy() *GcfsConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(GcfsConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HTTPLoadBalancing) DeepCopyInto(out *HTTPLoadBalancing) {
 	*out = *in
@@ -989,6 +1136,26 @@ func (in *LegacyAbac) DeepCopy() *LegacyAbac {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoggingVariantConfig) DeepCopyInto(out *LoggingVariantConfig) {
+	*out = *in
+	if in.Variant != nil {
+		in, out := &in.Variant, &out.Variant
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoggingVariantConfig.
+func (in *LoggingVariantConfig) DeepCopy() *LoggingVariantConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(LoggingVariantConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MaintenancePolicySpec) DeepCopyInto(out *MaintenancePolicySpec) {
 	*out = *in
@@ -1009,6 +1176,11 @@ func (in *MaintenancePolicySpec) DeepCopy() *MaintenancePolicySpec {
 func (in *MaintenancePolicyStatus) DeepCopyInto(out *MaintenancePolicyStatus) {
 	*out = *in
 	out.Window = in.Window
+	if in.ActiveMaintenanceExclusions != nil {
+		in, out := &in.ActiveMaintenanceExclusions, &out.ActiveMaintenanceExclusions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenancePolicyStatus.
@@ -1140,6 +1312,26 @@ func (in *MaxPodsConstraint) DeepCopy() *MaxPodsConstraint {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MeshCertificates) DeepCopyInto(out *MeshCertificates) {
+	*out = *in
+	if in.EnableCertificates != nil {
+		in, out := &in.EnableCertificates, &out.EnableCertificates
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeshCertificates.
+func (in *MeshCertificates) DeepCopy() *MeshCertificates {
+	if in == nil {
+		return nil
+	}
+	out := new(MeshCertificates)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NetworkConfigSpec) DeepCopyInto(out *NetworkConfigSpec) {
 	*out = *in
@@ -1306,6 +1498,31 @@ func (in *NodeConfigClusterStatus) DeepCopy() *NodeConfigClusterStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeConfigDefaults) DeepCopyInto(out *NodeConfigDefaults) {
+	*out = *in
+	if in.GcfsConfig != nil {
+		in, out := &in.GcfsConfig, &out.GcfsConfig
+		*out = new(GcfsConfig)
+		**out = **in
+	}
+	if in.LoggingConfig != nil {
+		in, out := &in.LoggingConfig, &out.LoggingConfig
+		*out = new(NodePoolLoggingConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeConfigDefaults.
+func (in *NodeConfigDefaults) DeepCopy() *NodeConfigDefaults {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeConfigDefaults)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NodeManagement) DeepCopyInto(out *NodeManagement) {
 	*out = *in
@@ -1422,6 +1639,46 @@ func (in *NodePoolClusterStatus) DeepCopy() *NodePoolClusterStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodePoolDefaults) DeepCopyInto(out *NodePoolDefaults) {
+	*out = *in
+	if in.NodeConfigDefaults != nil {
+		in, out := &in.NodeConfigDefaults, &out.NodeConfigDefaults
+		*out = new(NodeConfigDefaults)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodePoolDefaults.
+func (in *NodePoolDefaults) DeepCopy() *NodePoolDefaults {
+	if in == nil {
+		return nil
+	}
+	out := new(NodePoolDefaults)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodePoolLoggingConfig) DeepCopyInto(out *NodePoolLoggingConfig) {
+	*out = *in
+	if in.VariantConfig != nil {
+		in, out := &in.VariantConfig, &out.VariantConfig
+		*out = new(LoggingVariantConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodePoolLoggingConfig.
+func (in *NodePoolLoggingConfig) DeepCopy() *NodePoolLoggingConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(NodePoolLoggingConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NodeTaintClusterStatus) DeepCopyInto(out *NodeTaintClusterStatus) {
 	*out = *in
@@ -1518,6 +1775,31 @@ func (in *PrivateClusterMasterGlobalAccessConfig) DeepCopy() *PrivateClusterMast
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProtectConfig) DeepCopyInto(out *ProtectConfig) {
+	*out = *in
+	if in.WorkloadConfig != nil {
+		in, out := &in.WorkloadConfig, &out.WorkloadConfig
+		*out = new(WorkloadConfig)
+		**out = **in
+	}
+	if in.WorkloadVulnerabilityMode != nil {
+		in, out := &in.WorkloadVulnerabilityMode, &out.WorkloadVulnerabilityMode
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProtectConfig.
+func (in *ProtectConfig) DeepCopy() *ProtectConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ProtectConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PubSub) DeepCopyInto(out *PubSub) {
 	*out = *in
@@ -1573,6 +1855,26 @@ func (in *ReleaseChannel) DeepCopy() *ReleaseChannel {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReleaseChannelStatus) DeepCopyInto(out *ReleaseChannelStatus) {
+	*out = *in
+	if in.ValidVersions != nil {
+		in, out := &in.ValidVersions, &out.ValidVersions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseChannelStatus.
+func (in *ReleaseChannelStatus) DeepCopy() *ReleaseChannelStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ReleaseChannelStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceLimit) DeepCopyInto(out *ResourceLimit) {
 	*out = *in
@@ -1609,7 +1911,7 @@ func (in *ResourceUsageExportConfig) DeepCopyInto(out *ResourceUsageExportConfig
 	if in.BigqueryDestination != nil {
 		in, out := &in.BigqueryDestination, &out.BigqueryDestination
 		*out = new(BigQueryDestination)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.ConsumptionMeteringConfig != nil {
 		in, out := &in.ConsumptionMeteringConfig, &out.ConsumptionMeteringConfig
@@ -1758,6 +2060,36 @@ func (in *VerticalPodAutoscaling) DeepCopy() *VerticalPodAutoscaling {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadCertificates) DeepCopyInto(out *WorkloadCertificates) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadCertificates.
+func (in *WorkloadCertificates) DeepCopy() *WorkloadCertificates {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadCertificates)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadConfig) DeepCopyInto(out *WorkloadConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadConfig.
+func (in *WorkloadConfig) DeepCopy() *WorkloadConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkloadIdentityConfig) DeepCopyInto(out *WorkloadIdentityConfig) {
 	*out = *in
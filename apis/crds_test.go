@@ -0,0 +1,127 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apis
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/yaml"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// crd is the part of a CustomResourceDefinition that determines how kubectl
+// lists its resources.
+type crd struct {
+	Spec struct {
+		Group string `json:"group"`
+		Names struct {
+			Kind       string   `json:"kind"`
+			Categories []string `json:"categories"`
+		} `json:"names"`
+		Versions []struct {
+			Name                     string `json:"name"`
+			AdditionalPrinterColumns []struct {
+				Name     string `json:"name"`
+				JSONPath string `json:"jsonPath"`
+			} `json:"additionalPrinterColumns"`
+		} `json:"versions"`
+	} `json:"spec"`
+}
+
+// TestManagedResourceCRDs checks that the CRD of every managed resource kind
+// can be listed with "kubectl get gcp" or "kubectl get managed", and that
+// its printer columns follow the standard: READY and SYNCED first, the state
+// of the external resource, if any, as STATE, and AGE last.
+func TestManagedResourceCRDs(t *testing.T) {
+	s := runtime.NewScheme()
+	if err := AddToScheme(s); err != nil {
+		t.Fatalf("cannot add APIs to scheme: %v", err)
+	}
+
+	files, err := filepath.Glob(filepath.Join("..", "package", "crds", "*.yaml"))
+	if err != nil {
+		t.Fatalf("cannot list CRDs: %v", err)
+	}
+	crds := map[string]crd{}
+	for _, f := range files {
+		b, err := os.ReadFile(filepath.Clean(f))
+		if err != nil {
+			t.Fatalf("cannot read %s: %v", f, err)
+		}
+		c := crd{}
+		if err := yaml.Unmarshal(b, &c); err != nil {
+			t.Fatalf("cannot parse %s: %v", f, err)
+		}
+		crds[c.Spec.Names.Kind+"."+c.Spec.Group] = c
+	}
+
+	for gvk := range s.AllKnownTypes() {
+		if strings.HasSuffix(gvk.Kind, "List") {
+			continue
+		}
+		o, err := s.New(gvk)
+		if err != nil {
+			continue
+		}
+		if _, ok := o.(resource.Managed); !ok {
+			continue
+		}
+		gk := gvk.GroupKind().String()
+		c, ok := crds[gk]
+		if !ok {
+			t.Errorf("%s: managed resource kind has no CRD", gk)
+			continue
+		}
+		for _, want := range []string{"crossplane", "managed", "gcp"} {
+			if !contains(c.Spec.Names.Categories, want) {
+				t.Errorf("%s: CRD is not in category %q", gk, want)
+			}
+		}
+		for _, v := range c.Spec.Versions {
+			if v.Name != gvk.Version {
+				continue
+			}
+			names := make([]string, 0, len(v.AdditionalPrinterColumns))
+			for _, col := range v.AdditionalPrinterColumns {
+				if contains(names, col.Name) {
+					t.Errorf("%s: printer column %s is defined more than once", gvk, col.Name)
+				}
+				names = append(names, col.Name)
+				if (col.JSONPath == ".status.atProvider.state" || col.JSONPath == ".status.atProvider.status") && col.Name != "STATE" {
+					t.Errorf("%s: printer column %s shows the state of the external resource, but is not named STATE", gvk, col.Name)
+				}
+			}
+			if len(names) < 3 || names[0] != "READY" || names[1] != "SYNCED" || names[len(names)-1] != "AGE" {
+				t.Errorf("%s: printer columns %v must start with READY and SYNCED, and end with AGE", gvk, names)
+			}
+		}
+	}
+}
+
+func contains(s []string, v string) bool {
+	for _, e := range s {
+		if e == v {
+			return true
+		}
+	}
+	return false
+}
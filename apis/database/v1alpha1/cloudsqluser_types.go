@@ -0,0 +1,123 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Types of a CloudSQLUser.
+const (
+	CloudSQLUserTypeIAMUser           = "CLOUD_IAM_USER"
+	CloudSQLUserTypeIAMServiceAccount = "CLOUD_IAM_SERVICE_ACCOUNT"
+)
+
+// CloudSQLUserParameters defines parameters for a desired user of a Cloud SQL
+// instance that logs in with IAM credentials. The instance must have
+// settings.iamAuthentication enabled. Users can not be changed once they are
+// created.
+type CloudSQLUserParameters struct {
+	// Instance is the name of the Cloud SQL instance the user belongs to.
+	// +optional
+	// +immutable
+	Instance *string `json:"instance,omitempty"`
+
+	// InstanceRef references a CloudSQLInstance to retrieve its name.
+	// +optional
+	// +immutable
+	InstanceRef *xpv1.Reference `json:"instanceRef,omitempty"`
+
+	// InstanceSelector selects a reference to a CloudSQLInstance to retrieve
+	// its name.
+	// +optional
+	InstanceSelector *xpv1.Selector `json:"instanceSelector,omitempty"`
+
+	// Type of the user, i.e. whether it is an IAM user or an IAM service
+	// account.
+	// +immutable
+	// +kubebuilder:validation:Enum=CLOUD_IAM_USER;CLOUD_IAM_SERVICE_ACCOUNT
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="type is immutable"
+	Type string `json:"type"`
+
+	// Email of the IAM user or service account, e.g.
+	// "my-sa@my-project.iam.gserviceaccount.com". The name of the user in
+	// the instance is derived from it.
+	// +optional
+	// +immutable
+	Email *string `json:"email,omitempty"`
+
+	// ServiceAccountRef references an IAM ServiceAccount to retrieve its
+	// email.
+	// +optional
+	// +immutable
+	ServiceAccountRef *xpv1.Reference `json:"serviceAccountRef,omitempty"`
+
+	// ServiceAccountSelector selects a reference to an IAM ServiceAccount
+	// to retrieve its email.
+	// +optional
+	ServiceAccountSelector *xpv1.Selector `json:"serviceAccountSelector,omitempty"`
+}
+
+// CloudSQLUserObservation is used to show the observed state of the
+// CloudSQLUser.
+type CloudSQLUserObservation struct {
+	// Name of the user in the instance. PostgreSQL instances name service
+	// accounts without their ".gserviceaccount.com" suffix.
+	Name string `json:"name,omitempty"`
+}
+
+// CloudSQLUserSpec defines the desired state of a CloudSQLUser.
+type CloudSQLUserSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       CloudSQLUserParameters `json:"forProvider"`
+}
+
+// CloudSQLUserStatus represents the observed state of a CloudSQLUser.
+type CloudSQLUserStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          CloudSQLUserObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A CloudSQLUser is a managed resource that represents an IAM user or service
+// account that can log in to a Cloud SQL instance without a password.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="INSTANCE",type="string",JSONPath=".spec.forProvider.instance"
+// +kubebuilder:printcolumn:name="USER",type="string",JSONPath=".status.atProvider.name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type CloudSQLUser struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CloudSQLUserSpec   `json:"spec"`
+	Status CloudSQLUserStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CloudSQLUserList contains a list of CloudSQLUser types
+type CloudSQLUserList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CloudSQLUser `json:"items"`
+}
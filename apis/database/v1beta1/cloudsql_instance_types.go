@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	gcpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
 )
 
 // CloudSQL instance states
@@ -27,6 +29,7 @@ const (
 	// StateRunnable represents a CloudSQL instance in a running, available, and ready state
 	StateRunnable       = "RUNNABLE"
 	StateCreating       = "PENDING_CREATE"
+	StateDeleting       = "PENDING_DELETE"
 	StateSuspended      = "SUSPENDED"
 	StateMaintenance    = "MAINTENANCE"
 	StateCreationFailed = "FAILED"
@@ -43,6 +46,12 @@ const (
 	CloudSQLSecretConnectionName = "connectionName"
 )
 
+// CloudSQL instance types.
+const (
+	InstanceTypeCloudSQL    = "CLOUD_SQL_INSTANCE"
+	InstanceTypeReadReplica = "READ_REPLICA_INSTANCE"
+)
+
 // CloudSQL version prefixes.
 const (
 	MysqlDBVersionPrefix = "MYSQL"
@@ -66,9 +75,11 @@ type CloudSQLInstanceParameters struct {
 	// instances only), us-central1 (SECOND_GEN instances only), asia-east1
 	// or europe-west1. Defaults to us-central or us-central1 depending on
 	// the instance type (First Generation or Second Generation). The region
-	// can not be changed after instance creation.
+	// can not be changed after instance creation. Defaults to the default
+	// region of the ProviderConfig.
+	// +optional
 	// +immutable
-	Region string `json:"region"`
+	Region string `json:"region,omitempty"`
 
 	// Settings: The user settings.
 	Settings Settings `json:"settings"`
@@ -135,6 +146,28 @@ type CloudSQLInstanceParameters struct {
 	// the suspension.
 	// +optional
 	SuspensionReason []string `json:"suspensionReason,omitempty"`
+
+	// FailoverTrigger: Setting this to a new, non-empty value initiates a
+	// manual failover of a high availability instance to its standby. The
+	// value itself is opaque, e.g. a timestamp or a change ticket, and is
+	// reported as status.atProvider.lastFailoverTrigger once the failover
+	// was started.
+	// +optional
+	FailoverTrigger *string `json:"failoverTrigger,omitempty"`
+
+	// PromoteReplica: Promotes a read replica to a standalone instance.
+	// Promotion cannot be undone; masterInstanceName is ignored once the
+	// replica is promoted.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="!oldSelf || self",message="promoteReplica cannot be reverted"
+	PromoteReplica *bool `json:"promoteReplica,omitempty"`
+
+	// MaintenanceVersion: The maintenance version to update the instance to,
+	// e.g. "POSTGRES_14_7.R20230316.01_03". Must be one of
+	// status.atProvider.availableMaintenanceVersions. The instance is left
+	// on the version Google schedules for it if this is omitted.
+	// +optional
+	MaintenanceVersion *string `json:"maintenanceVersion,omitempty"`
 }
 
 // Settings is Cloud SQL database instance settings.
@@ -161,6 +194,11 @@ type Settings struct {
 	// +optional
 	ActivationPolicy *string `json:"activationPolicy,omitempty"`
 
+	// ActiveDirectoryConfig: Active Directory configuration, relevant only
+	// for Cloud SQL for SQL Server.
+	// +optional
+	ActiveDirectoryConfig *ActiveDirectoryConfig `json:"activeDirectoryConfig,omitempty"`
+
 	// AuthorizedGaeApplications: The App Engine app IDs that can access
 	// this instance. First Generation instances only.
 	// +optional
@@ -217,10 +255,21 @@ type Settings struct {
 	UserLabels map[string]string `json:"userLabels,omitempty"`
 
 	// DatabaseFlags is the array of database flags passed to the instance at
-	// startup.
+	// startup. Flags are checked against the flags Cloud SQL supports for
+	// the databaseVersion before the instance is created or updated.
 	// +optional
 	DatabaseFlags []*DatabaseFlags `json:"databaseFlags,omitempty"`
 
+	// IAMAuthentication enables IAM database authentication, so that
+	// CloudSQLUsers of type CLOUD_IAM_USER or CLOUD_IAM_SERVICE_ACCOUNT can
+	// log in with IAM credentials rather than a password. It sets the
+	// cloudsql.iam_authentication flag of PostgreSQL instances, or the
+	// cloudsql_iam_authentication flag of MySQL instances, and takes
+	// precedence over that flag in databaseFlags. SQL Server instances do not
+	// support it.
+	// +optional
+	IAMAuthentication *bool `json:"iamAuthentication,omitempty"`
+
 	// BackupConfiguration is the daily backup configuration for the instance.
 	// +optional
 	BackupConfiguration *BackupConfiguration `json:"backupConfiguration,omitempty"`
@@ -267,6 +316,14 @@ type Settings struct {
 	StorageAutoResizeLimit *int64 `json:"storageAutoResizeLimit,omitempty"`
 }
 
+// ActiveDirectoryConfig is the Active Directory configuration of a Cloud SQL
+// for SQL Server instance.
+type ActiveDirectoryConfig struct {
+	// Domain: The name of the managed Active Directory domain the instance
+	// joins (e.g., mydomain.com).
+	Domain string `json:"domain"`
+}
+
 // LocationPreference is preferred location. This specifies where a Cloud
 // SQL instance should preferably be located, either in a specific
 // Compute Engine zone, or co-located with an App Engine application.
@@ -396,10 +453,14 @@ type IPConfiguration struct {
 
 	// PrivateNetworkRef sets the PrivateNetwork field by resolving the resource
 	// link of the referenced Crossplane Network managed resource.
+	// PrivateNetwork takes precedence over PrivateNetworkRef, unless the resolve policy of
+	// PrivateNetworkRef is Always.
 	// +optional
 	PrivateNetworkRef *xpv1.Reference `json:"privateNetworkRef,omitempty"`
 
 	// PrivateNetworkSelector selects a PrivateNetworkRef.
+	// PrivateNetworkSelector is only used if neither PrivateNetwork nor PrivateNetworkRef is set,
+	// unless its resolve policy is Always.
 	// +optional
 	PrivateNetworkSelector *xpv1.Selector `json:"privateNetworkSelector,omitempty"`
 
@@ -506,6 +567,29 @@ type CloudSQLInstanceObservation struct {
 	// properly. During update, use the most recent settingsVersion value
 	// for this instance and do not try to update this value.
 	SettingsVersion int64 `json:"settingsVersion,omitempty"`
+
+	// InstanceType: The instance type, e.g. READ_REPLICA_INSTANCE until a
+	// read replica is promoted to a standalone CLOUD_SQL_INSTANCE.
+	InstanceType string `json:"instanceType,omitempty"`
+
+	// MasterInstanceName: The name of the instance this instance replicates
+	// from, if it is a read replica.
+	MasterInstanceName string `json:"masterInstanceName,omitempty"`
+
+	// LastFailoverTrigger: The failoverTrigger for which a manual failover
+	// was last started.
+	LastFailoverTrigger string `json:"lastFailoverTrigger,omitempty"`
+
+	// MaintenanceVersion: The current software version of the instance.
+	MaintenanceVersion string `json:"maintenanceVersion,omitempty"`
+
+	// AvailableMaintenanceVersions: The maintenance versions the instance
+	// can be updated to.
+	AvailableMaintenanceVersions []string `json:"availableMaintenanceVersions,omitempty"`
+
+	// ScheduledMaintenance: The upcoming maintenance of the instance, if
+	// any.
+	ScheduledMaintenance *ScheduledMaintenance `json:"scheduledMaintenance,omitempty"`
 }
 
 // IPMapping is database instance IP Mapping.
@@ -558,10 +642,27 @@ type DatabaseInstanceFailoverReplicaStatus struct {
 	Available bool `json:"available"`
 }
 
+// ScheduledMaintenance is an upcoming maintenance of a Cloud SQL instance.
+type ScheduledMaintenance struct {
+	// StartTime: The time the maintenance starts, in RFC 3339 format.
+	StartTime string `json:"startTime,omitempty"`
+
+	// ScheduleDeadlineTime: The latest time the maintenance can be
+	// rescheduled to, in RFC 3339 format.
+	ScheduleDeadlineTime string `json:"scheduleDeadlineTime,omitempty"`
+
+	// CanReschedule: Whether the maintenance can be rescheduled.
+	CanReschedule bool `json:"canReschedule,omitempty"`
+}
+
 // A CloudSQLInstanceSpec defines the desired state of a CloudSQLInstance.
 type CloudSQLInstanceSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       CloudSQLInstanceParameters `json:"forProvider"`
+
+	// Timeouts override how long the Cloud SQL operations on the instance may take.
+	// +optional
+	Timeouts *gcpv1beta1.Timeouts `json:"timeouts,omitempty"`
 }
 
 // A CloudSQLInstanceStatus represents the observed state of a CloudSQLInstance.
@@ -577,10 +678,13 @@ type CloudSQLInstanceStatus struct {
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
 // +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
 // +kubebuilder:printcolumn:name="VERSION",type="string",JSONPath=".spec.forProvider.databaseVersion"
+// +kubebuilder:printcolumn:name="TIER",type="string",JSONPath=".spec.forProvider.settings.tier",priority=1
+// +kubebuilder:printcolumn:name="CONNECTION-NAME",type="string",JSONPath=".status.atProvider.connectionName",priority=1
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
-// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp},shortName=sql
 type CloudSQLInstance struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
@@ -24,6 +24,7 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/errors"
 
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1beta1"
 )
@@ -53,3 +54,20 @@ func (mg *CloudSQLInstance) ResolveReferences(ctx context.Context, c client.Read
 
 	return nil
 }
+
+// CloudSQLInstanceIP extracts the IP address of the supplied type, i.e.
+// PublicIPType or PrivateIPType, of a CloudSQLInstance.
+func CloudSQLInstanceIP(ipType string) reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		i, ok := mg.(*CloudSQLInstance)
+		if !ok {
+			return ""
+		}
+		for _, ip := range i.Status.AtProvider.IPAddresses {
+			if ip != nil && ip.Type == ipType {
+				return ip.IPAddress
+			}
+		}
+		return ""
+	}
+}
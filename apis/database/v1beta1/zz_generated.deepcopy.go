@@ -22,6 +22,7 @@ limitations under the License.
 package v1beta1
 
 import (
+	apisv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
 	"github.com/crossplane/crossplane-runtime/apis/common/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
@@ -56,6 +57,21 @@ func (in *ACLEntry) DeepCopy() *ACLEntry {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ActiveDirectoryConfig) DeepCopyInto(out *ActiveDirectoryConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ActiveDirectoryConfig.
+func (in *ActiveDirectoryConfig) DeepCopy() *ActiveDirectoryConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ActiveDirectoryConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BackupConfiguration) DeepCopyInto(out *BackupConfiguration) {
 	*out = *in
@@ -214,6 +230,16 @@ func (in *CloudSQLInstanceObservation) DeepCopyInto(out *CloudSQLInstanceObserva
 			}
 		}
 	}
+	if in.AvailableMaintenanceVersions != nil {
+		in, out := &in.AvailableMaintenanceVersions, &out.AvailableMaintenanceVersions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ScheduledMaintenance != nil {
+		in, out := &in.ScheduledMaintenance, &out.ScheduledMaintenance
+		*out = new(ScheduledMaintenance)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudSQLInstanceObservation.
@@ -280,6 +306,21 @@ func (in *CloudSQLInstanceParameters) DeepCopyInto(out *CloudSQLInstanceParamete
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.FailoverTrigger != nil {
+		in, out := &in.FailoverTrigger, &out.FailoverTrigger
+		*out = new(string)
+		**out = **in
+	}
+	if in.PromoteReplica != nil {
+		in, out := &in.PromoteReplica, &out.PromoteReplica
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MaintenanceVersion != nil {
+		in, out := &in.MaintenanceVersion, &out.MaintenanceVersion
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudSQLInstanceParameters.
@@ -297,6 +338,11 @@ func (in *CloudSQLInstanceSpec) DeepCopyInto(out *CloudSQLInstanceSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	if in.Timeouts != nil {
+		in, out := &in.Timeouts, &out.Timeouts
+		*out = new(apisv1beta1.Timeouts)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudSQLInstanceSpec.
@@ -537,6 +583,21 @@ func (in *OnPremisesConfiguration) DeepCopy() *OnPremisesConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScheduledMaintenance) DeepCopyInto(out *ScheduledMaintenance) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduledMaintenance.
+func (in *ScheduledMaintenance) DeepCopy() *ScheduledMaintenance {
+	if in == nil {
+		return nil
+	}
+	out := new(ScheduledMaintenance)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Settings) DeepCopyInto(out *Settings) {
 	*out = *in
@@ -545,6 +606,11 @@ func (in *Settings) DeepCopyInto(out *Settings) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.ActiveDirectoryConfig != nil {
+		in, out := &in.ActiveDirectoryConfig, &out.ActiveDirectoryConfig
+		*out = new(ActiveDirectoryConfig)
+		**out = **in
+	}
 	if in.AuthorizedGaeApplications != nil {
 		in, out := &in.AuthorizedGaeApplications, &out.AuthorizedGaeApplications
 		*out = make([]string, len(*in))
@@ -598,6 +664,11 @@ func (in *Settings) DeepCopyInto(out *Settings) {
 			}
 		}
 	}
+	if in.IAMAuthentication != nil {
+		in, out := &in.IAMAuthentication, &out.IAMAuthentication
+		*out = new(bool)
+		**out = **in
+	}
 	if in.BackupConfiguration != nil {
 		in, out := &in.BackupConfiguration, &out.BackupConfiguration
 		*out = new(BackupConfiguration)
@@ -0,0 +1,224 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// ConnectionProfileParameters defines parameters for a desired Datastream
+// ConnectionProfile. Exactly one of the MySQL, PostgreSQL, GCS and BigQuery
+// profiles must be set.
+// +kubebuilder:validation:XValidation:rule="[has(self.mysqlProfile), has(self.postgresqlProfile), has(self.gcsProfile), has(self.bigqueryProfile)].filter(x, x).size() == 1",message="exactly one of mysqlProfile, postgresqlProfile, gcsProfile and bigqueryProfile must be set"
+// +kubebuilder:validation:XValidation:rule="!(has(self.staticServiceIpConnectivity) && has(self.privateConnectivity))",message="only one of staticServiceIpConnectivity and privateConnectivity may be set"
+type ConnectionProfileParameters struct {
+	// Location is the region the connection profile lives in, e.g.
+	// "us-central1".
+	// +immutable
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="location is immutable"
+	Location string `json:"location"`
+
+	// DisplayName is the human readable name of the connection profile.
+	DisplayName string `json:"displayName"`
+
+	// Labels to apply to the connection profile.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// MySQLProfile configures a MySQL source database.
+	// +optional
+	MySQLProfile *MySQLProfile `json:"mysqlProfile,omitempty"`
+
+	// PostgreSQLProfile configures a PostgreSQL source database.
+	// +optional
+	PostgreSQLProfile *PostgreSQLProfile `json:"postgresqlProfile,omitempty"`
+
+	// GCSProfile configures a Cloud Storage destination.
+	// +optional
+	GCSProfile *GCSProfile `json:"gcsProfile,omitempty"`
+
+	// BigQueryProfile configures a BigQuery destination.
+	// +optional
+	BigQueryProfile *BigQueryProfile `json:"bigqueryProfile,omitempty"`
+
+	// StaticServiceIPConnectivity makes Datastream connect to the source
+	// database from a set of static IP addresses that must be allowed by the
+	// database.
+	// +optional
+	StaticServiceIPConnectivity *StaticServiceIPConnectivity `json:"staticServiceIpConnectivity,omitempty"`
+
+	// PrivateConnectivity makes Datastream connect to the source database
+	// through a private connection peered with its VPC network.
+	// +optional
+	PrivateConnectivity *PrivateConnectivity `json:"privateConnectivity,omitempty"`
+}
+
+// MySQLProfile specifies how to connect to a MySQL database.
+type MySQLProfile struct {
+	// Hostname is the IP address or hostname of the MySQL server.
+	// +optional
+	Hostname *string `json:"hostname,omitempty"`
+
+	// CloudSQLInstanceRef references a CloudSQLInstance to retrieve its IP
+	// address as the hostname. The private IP address is used when
+	// privateConnectivity is set, the public one otherwise.
+	// +optional
+	CloudSQLInstanceRef *xpv1.Reference `json:"cloudSQLInstanceRef,omitempty"`
+
+	// CloudSQLInstanceSelector selects a reference to a CloudSQLInstance to
+	// retrieve its IP address as the hostname.
+	// +optional
+	CloudSQLInstanceSelector *xpv1.Selector `json:"cloudSQLInstanceSelector,omitempty"`
+
+	// Port of the MySQL server. Defaults to 3306.
+	// +optional
+	Port *int64 `json:"port,omitempty"`
+
+	// Username Datastream connects to the MySQL server as.
+	Username string `json:"username"`
+
+	// PasswordSecretRef references the secret key that holds the password
+	// of the user.
+	PasswordSecretRef xpv1.SecretKeySelector `json:"passwordSecretRef"`
+}
+
+// PostgreSQLProfile specifies how to connect to a PostgreSQL database.
+type PostgreSQLProfile struct {
+	// Hostname is the IP address or hostname of the PostgreSQL server.
+	// +optional
+	Hostname *string `json:"hostname,omitempty"`
+
+	// CloudSQLInstanceRef references a CloudSQLInstance to retrieve its IP
+	// address as the hostname. The private IP address is used when
+	// privateConnectivity is set, the public one otherwise.
+	// +optional
+	CloudSQLInstanceRef *xpv1.Reference `json:"cloudSQLInstanceRef,omitempty"`
+
+	// CloudSQLInstanceSelector selects a reference to a CloudSQLInstance to
+	// retrieve its IP address as the hostname.
+	// +optional
+	CloudSQLInstanceSelector *xpv1.Selector `json:"cloudSQLInstanceSelector,omitempty"`
+
+	// Port of the PostgreSQL server. Defaults to 5432.
+	// +optional
+	Port *int64 `json:"port,omitempty"`
+
+	// Username Datastream connects to the PostgreSQL server as.
+	Username string `json:"username"`
+
+	// PasswordSecretRef references the secret key that holds the password
+	// of the user.
+	PasswordSecretRef xpv1.SecretKeySelector `json:"passwordSecretRef"`
+
+	// Database is the name of the database to replicate.
+	Database string `json:"database"`
+}
+
+// GCSProfile specifies a Cloud Storage bucket to write to.
+type GCSProfile struct {
+	// Bucket is the name of the Cloud Storage bucket.
+	// +optional
+	Bucket *string `json:"bucket,omitempty"`
+
+	// BucketRef references a Bucket to retrieve its name.
+	// +optional
+	BucketRef *xpv1.Reference `json:"bucketRef,omitempty"`
+
+	// BucketSelector selects a reference to a Bucket to retrieve its name.
+	// +optional
+	BucketSelector *xpv1.Selector `json:"bucketSelector,omitempty"`
+
+	// RootPath is the path prefix in the bucket that objects are written
+	// under.
+	// +optional
+	RootPath *string `json:"rootPath,omitempty"`
+}
+
+// BigQueryProfile specifies a BigQuery destination. It has no configuration;
+// datasets are chosen by the streams that write to it.
+type BigQueryProfile struct{}
+
+// StaticServiceIPConnectivity selects connectivity from Datastream's static
+// IP addresses.
+type StaticServiceIPConnectivity struct{}
+
+// PrivateConnectivity selects connectivity through a private connection.
+type PrivateConnectivity struct {
+	// PrivateConnection is the resource name of the Datastream private
+	// connection, e.g.
+	// "projects/my-project/locations/us-central1/privateConnections/my-connection".
+	PrivateConnection string `json:"privateConnection"`
+}
+
+// ConnectionProfileObservation is used to show the observed state of the
+// ConnectionProfile.
+type ConnectionProfileObservation struct {
+	// Name is the resource name of the connection profile, e.g.
+	// "projects/my-project/locations/us-central1/connectionProfiles/my-profile".
+	Name string `json:"name,omitempty"`
+
+	// CreateTime is the time the connection profile was created.
+	CreateTime string `json:"createTime,omitempty"`
+
+	// UpdateTime is the time the connection profile was last updated.
+	UpdateTime string `json:"updateTime,omitempty"`
+}
+
+// ConnectionProfileSpec defines the desired state of a ConnectionProfile.
+type ConnectionProfileSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ConnectionProfileParameters `json:"forProvider"`
+}
+
+// ConnectionProfileStatus represents the observed state of a
+// ConnectionProfile.
+type ConnectionProfileStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ConnectionProfileObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ConnectionProfile is a managed resource that represents a Datastream
+// connection profile, which describes how Datastream connects to a source
+// database or a destination.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="LOCATION",type="string",JSONPath=".spec.forProvider.location"
+// +kubebuilder:printcolumn:name="NAME",type="string",JSONPath=".status.atProvider.name",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp},shortName=connectionprofile
+type ConnectionProfile struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ConnectionProfileSpec   `json:"spec"`
+	Status ConnectionProfileStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ConnectionProfileList contains a list of ConnectionProfile types
+type ConnectionProfileList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ConnectionProfile `json:"items"`
+}
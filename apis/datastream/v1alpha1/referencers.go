@@ -0,0 +1,141 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	databasev1beta1 "github.com/crossplane-contrib/provider-gcp/apis/database/v1beta1"
+	"github.com/crossplane-contrib/provider-gcp/apis/storage/v1alpha3"
+)
+
+// ConnectionProfileName extracts the resource name of a ConnectionProfile.
+func ConnectionProfileName() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		cp, ok := mg.(*ConnectionProfile)
+		if !ok {
+			return ""
+		}
+		return cp.Status.AtProvider.Name
+	}
+}
+
+// ResolveReferences of this ConnectionProfile
+func (mg *ConnectionProfile) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Datastream reaches a Cloud SQL instance over its private IP address
+	// when it connects through a private connection.
+	ipType := databasev1beta1.PublicIPType
+	if mg.Spec.ForProvider.PrivateConnectivity != nil {
+		ipType = databasev1beta1.PrivateIPType
+	}
+
+	// Resolve spec.forProvider.mysqlProfile.hostname
+	if p := mg.Spec.ForProvider.MySQLProfile; p != nil {
+		hostname, ref, err := resolveCloudSQLInstanceIP(ctx, r, p.Hostname, p.CloudSQLInstanceRef, p.CloudSQLInstanceSelector, ipType)
+		if err != nil {
+			return errors.Wrap(err, "spec.forProvider.mysqlProfile.hostname")
+		}
+		p.Hostname = hostname
+		p.CloudSQLInstanceRef = ref
+	}
+
+	// Resolve spec.forProvider.postgresqlProfile.hostname
+	if p := mg.Spec.ForProvider.PostgreSQLProfile; p != nil {
+		hostname, ref, err := resolveCloudSQLInstanceIP(ctx, r, p.Hostname, p.CloudSQLInstanceRef, p.CloudSQLInstanceSelector, ipType)
+		if err != nil {
+			return errors.Wrap(err, "spec.forProvider.postgresqlProfile.hostname")
+		}
+		p.Hostname = hostname
+		p.CloudSQLInstanceRef = ref
+	}
+
+	// Resolve spec.forProvider.gcsProfile.bucket
+	if p := mg.Spec.ForProvider.GCSProfile; p != nil {
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(p.Bucket),
+			Reference:    p.BucketRef,
+			Selector:     p.BucketSelector,
+			To:           reference.To{Managed: &v1alpha3.Bucket{}, List: &v1alpha3.BucketList{}},
+			Extract:      reference.ExternalName(),
+		})
+		if err != nil {
+			return errors.Wrap(err, "spec.forProvider.gcsProfile.bucket")
+		}
+		p.Bucket = reference.ToPtrValue(rsp.ResolvedValue)
+		p.BucketRef = rsp.ResolvedReference
+	}
+
+	return nil
+}
+
+func resolveCloudSQLInstanceIP(ctx context.Context, r *reference.APIResolver, current *string, ref *xpv1.Reference, sel *xpv1.Selector, ipType string) (*string, *xpv1.Reference, error) {
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(current),
+		Reference:    ref,
+		Selector:     sel,
+		To:           reference.To{Managed: &databasev1beta1.CloudSQLInstance{}, List: &databasev1beta1.CloudSQLInstanceList{}},
+		Extract:      databasev1beta1.CloudSQLInstanceIP(ipType),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return reference.ToPtrValue(rsp.ResolvedValue), rsp.ResolvedReference, nil
+}
+
+// ResolveReferences of this Stream
+func (mg *Stream) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.sourceConfig.sourceConnectionProfile
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.SourceConfig.SourceConnectionProfile),
+		Reference:    mg.Spec.ForProvider.SourceConfig.SourceConnectionProfileRef,
+		Selector:     mg.Spec.ForProvider.SourceConfig.SourceConnectionProfileSelector,
+		To:           reference.To{Managed: &ConnectionProfile{}, List: &ConnectionProfileList{}},
+		Extract:      ConnectionProfileName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.sourceConfig.sourceConnectionProfile")
+	}
+	mg.Spec.ForProvider.SourceConfig.SourceConnectionProfile = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.SourceConfig.SourceConnectionProfileRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.destinationConfig.destinationConnectionProfile
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.DestinationConfig.DestinationConnectionProfile),
+		Reference:    mg.Spec.ForProvider.DestinationConfig.DestinationConnectionProfileRef,
+		Selector:     mg.Spec.ForProvider.DestinationConfig.DestinationConnectionProfileSelector,
+		To:           reference.To{Managed: &ConnectionProfile{}, List: &ConnectionProfileList{}},
+		Extract:      ConnectionProfileName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.destinationConfig.destinationConnectionProfile")
+	}
+	mg.Spec.ForProvider.DestinationConfig.DestinationConnectionProfile = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.DestinationConfig.DestinationConnectionProfileRef = rsp.ResolvedReference
+
+	return nil
+}
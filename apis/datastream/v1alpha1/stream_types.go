@@ -0,0 +1,404 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// States of a Stream.
+const (
+	StreamStateNotStarted        = "NOT_STARTED"
+	StreamStateRunning           = "RUNNING"
+	StreamStatePaused            = "PAUSED"
+	StreamStateMaintenance       = "MAINTENANCE"
+	StreamStateFailed            = "FAILED"
+	StreamStateFailedPermanently = "FAILED_PERMANENTLY"
+	StreamStateStarting          = "STARTING"
+	StreamStateDraining          = "DRAINING"
+)
+
+// StreamParameters defines parameters for a desired Datastream Stream.
+// +kubebuilder:validation:XValidation:rule="!(has(self.backfillAll) && has(self.backfillNone))",message="only one of backfillAll and backfillNone may be set"
+type StreamParameters struct {
+	// Location is the region the stream lives in, e.g. "us-central1". It
+	// must match the location of the connection profiles.
+	// +immutable
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="location is immutable"
+	Location string `json:"location"`
+
+	// DisplayName is the human readable name of the stream.
+	DisplayName string `json:"displayName"`
+
+	// Labels to apply to the stream.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// SourceConfig configures the database the stream reads from.
+	SourceConfig StreamSourceConfig `json:"sourceConfig"`
+
+	// DestinationConfig configures where the stream writes to.
+	DestinationConfig StreamDestinationConfig `json:"destinationConfig"`
+
+	// BackfillAll makes the stream backfill all existing data of the
+	// included objects, except for the excluded ones.
+	// +optional
+	BackfillAll *BackfillAllStrategy `json:"backfillAll,omitempty"`
+
+	// BackfillNone makes the stream only replicate changes made after it
+	// started.
+	// +optional
+	BackfillNone *BackfillNoneStrategy `json:"backfillNone,omitempty"`
+
+	// CustomerManagedEncryptionKey is the resource name of the Cloud KMS
+	// key used to encrypt data at rest, e.g.
+	// "projects/my-project/locations/us-central1/keyRings/my-ring/cryptoKeys/my-key".
+	// +optional
+	// +immutable
+	CustomerManagedEncryptionKey *string `json:"customerManagedEncryptionKey,omitempty"`
+
+	// DesiredState of the stream. Streams are created without being
+	// started; set this to RUNNING to start replicating and to PAUSED to
+	// pause a running stream.
+	// +optional
+	// +kubebuilder:validation:Enum=NOT_STARTED;RUNNING;PAUSED
+	DesiredState *string `json:"desiredState,omitempty"`
+}
+
+// StreamSourceConfig configures the source of a stream. Exactly one of the
+// MySQL and PostgreSQL source configs must be set, matching the type of the
+// source connection profile.
+// +kubebuilder:validation:XValidation:rule="has(self.mysqlSourceConfig) != has(self.postgresqlSourceConfig)",message="exactly one of mysqlSourceConfig and postgresqlSourceConfig must be set"
+type StreamSourceConfig struct {
+	// SourceConnectionProfile is the resource name of the source connection
+	// profile.
+	// +optional
+	SourceConnectionProfile *string `json:"sourceConnectionProfile,omitempty"`
+
+	// SourceConnectionProfileRef references a ConnectionProfile to retrieve
+	// its resource name.
+	// +optional
+	SourceConnectionProfileRef *xpv1.Reference `json:"sourceConnectionProfileRef,omitempty"`
+
+	// SourceConnectionProfileSelector selects a reference to a
+	// ConnectionProfile to retrieve its resource name.
+	// +optional
+	SourceConnectionProfileSelector *xpv1.Selector `json:"sourceConnectionProfileSelector,omitempty"`
+
+	// MySQLSourceConfig configures a MySQL source.
+	// +optional
+	MySQLSourceConfig *MySQLSourceConfig `json:"mysqlSourceConfig,omitempty"`
+
+	// PostgreSQLSourceConfig configures a PostgreSQL source.
+	// +optional
+	PostgreSQLSourceConfig *PostgreSQLSourceConfig `json:"postgresqlSourceConfig,omitempty"`
+}
+
+// MySQLSourceConfig configures which MySQL objects are replicated.
+type MySQLSourceConfig struct {
+	// IncludeObjects are the MySQL objects to replicate. All objects are
+	// replicated if it is omitted.
+	// +optional
+	IncludeObjects *MySQLRdbms `json:"includeObjects,omitempty"`
+
+	// ExcludeObjects are the MySQL objects to exclude from replication.
+	// +optional
+	ExcludeObjects *MySQLRdbms `json:"excludeObjects,omitempty"`
+
+	// MaxConcurrentCdcTasks is the maximum number of concurrent change data
+	// capture tasks.
+	// +optional
+	MaxConcurrentCdcTasks *int64 `json:"maxConcurrentCdcTasks,omitempty"`
+}
+
+// MySQLRdbms is a list of MySQL databases.
+type MySQLRdbms struct {
+	// MySQLDatabases in the MySQL server.
+	MySQLDatabases []MySQLDatabase `json:"mysqlDatabases"`
+}
+
+// MySQLDatabase is a MySQL database and, optionally, some of its tables.
+type MySQLDatabase struct {
+	// Database name.
+	Database string `json:"database"`
+
+	// MySQLTables in the database. All tables are matched if it is omitted.
+	// +optional
+	MySQLTables []MySQLTable `json:"mysqlTables,omitempty"`
+}
+
+// MySQLTable is a MySQL table.
+type MySQLTable struct {
+	// Table name.
+	Table string `json:"table"`
+}
+
+// PostgreSQLSourceConfig configures which PostgreSQL objects are replicated.
+type PostgreSQLSourceConfig struct {
+	// IncludeObjects are the PostgreSQL objects to replicate. All objects
+	// are replicated if it is omitted.
+	// +optional
+	IncludeObjects *PostgreSQLRdbms `json:"includeObjects,omitempty"`
+
+	// ExcludeObjects are the PostgreSQL objects to exclude from replication.
+	// +optional
+	ExcludeObjects *PostgreSQLRdbms `json:"excludeObjects,omitempty"`
+
+	// Publication is the name of the publication that includes the set of
+	// tables to replicate.
+	Publication string `json:"publication"`
+
+	// ReplicationSlot is the name of the logical replication slot used by
+	// the stream.
+	ReplicationSlot string `json:"replicationSlot"`
+}
+
+// PostgreSQLRdbms is a list of PostgreSQL schemas.
+type PostgreSQLRdbms struct {
+	// PostgreSQLSchemas in the PostgreSQL database.
+	PostgreSQLSchemas []PostgreSQLSchema `json:"postgresqlSchemas"`
+}
+
+// PostgreSQLSchema is a PostgreSQL schema and, optionally, some of its
+// tables.
+type PostgreSQLSchema struct {
+	// Schema name.
+	Schema string `json:"schema"`
+
+	// PostgreSQLTables in the schema. All tables are matched if it is
+	// omitted.
+	// +optional
+	PostgreSQLTables []PostgreSQLTable `json:"postgresqlTables,omitempty"`
+}
+
+// PostgreSQLTable is a PostgreSQL table.
+type PostgreSQLTable struct {
+	// Table name.
+	Table string `json:"table"`
+}
+
+// StreamDestinationConfig configures the destination of a stream. Exactly
+// one of the GCS and BigQuery destination configs must be set, matching the
+// type of the destination connection profile.
+// +kubebuilder:validation:XValidation:rule="has(self.gcsDestinationConfig) != has(self.bigqueryDestinationConfig)",message="exactly one of gcsDestinationConfig and bigqueryDestinationConfig must be set"
+type StreamDestinationConfig struct {
+	// DestinationConnectionProfile is the resource name of the destination
+	// connection profile.
+	// +optional
+	DestinationConnectionProfile *string `json:"destinationConnectionProfile,omitempty"`
+
+	// DestinationConnectionProfileRef references a ConnectionProfile to
+	// retrieve its resource name.
+	// +optional
+	DestinationConnectionProfileRef *xpv1.Reference `json:"destinationConnectionProfileRef,omitempty"`
+
+	// DestinationConnectionProfileSelector selects a reference to a
+	// ConnectionProfile to retrieve its resource name.
+	// +optional
+	DestinationConnectionProfileSelector *xpv1.Selector `json:"destinationConnectionProfileSelector,omitempty"`
+
+	// GCSDestinationConfig configures a Cloud Storage destination.
+	// +optional
+	GCSDestinationConfig *GCSDestinationConfig `json:"gcsDestinationConfig,omitempty"`
+
+	// BigQueryDestinationConfig configures a BigQuery destination.
+	// +optional
+	BigQueryDestinationConfig *BigQueryDestinationConfig `json:"bigqueryDestinationConfig,omitempty"`
+}
+
+// GCSDestinationConfig configures how a stream writes to Cloud Storage.
+// +kubebuilder:validation:XValidation:rule="!(has(self.jsonFileFormat) && has(self.avroFileFormat))",message="only one of jsonFileFormat and avroFileFormat may be set"
+type GCSDestinationConfig struct {
+	// Path is the path prefix, relative to the root path of the destination
+	// connection profile, that files are written under.
+	// +optional
+	Path *string `json:"path,omitempty"`
+
+	// FileRotationMb is the maximum size of a file in megabytes before it
+	// is rotated.
+	// +optional
+	FileRotationMb *int64 `json:"fileRotationMb,omitempty"`
+
+	// FileRotationInterval is the maximum duration a file is written to
+	// before it is rotated, e.g. "900s".
+	// +optional
+	FileRotationInterval *string `json:"fileRotationInterval,omitempty"`
+
+	// JSONFileFormat writes files as JSON.
+	// +optional
+	JSONFileFormat *JSONFileFormat `json:"jsonFileFormat,omitempty"`
+
+	// AvroFileFormat writes files as Avro.
+	// +optional
+	AvroFileFormat *AvroFileFormat `json:"avroFileFormat,omitempty"`
+}
+
+// JSONFileFormat configures JSON files.
+type JSONFileFormat struct {
+	// Compression of the written files.
+	// +optional
+	// +kubebuilder:validation:Enum=NO_COMPRESSION;GZIP
+	Compression *string `json:"compression,omitempty"`
+
+	// SchemaFileFormat is the format of the schema file written alongside
+	// the data files.
+	// +optional
+	// +kubebuilder:validation:Enum=NO_SCHEMA_FILE;AVRO_SCHEMA_FILE
+	SchemaFileFormat *string `json:"schemaFileFormat,omitempty"`
+}
+
+// AvroFileFormat configures Avro files. It has no configuration.
+type AvroFileFormat struct{}
+
+// BigQueryDestinationConfig configures how a stream writes to BigQuery.
+// +kubebuilder:validation:XValidation:rule="has(self.singleTargetDataset) != has(self.sourceHierarchyDatasets)",message="exactly one of singleTargetDataset and sourceHierarchyDatasets must be set"
+type BigQueryDestinationConfig struct {
+	// DataFreshness is the maximum staleness of the data in BigQuery, e.g.
+	// "900s". Lower values increase BigQuery costs.
+	// +optional
+	DataFreshness *string `json:"dataFreshness,omitempty"`
+
+	// SingleTargetDataset writes all source objects to a single dataset.
+	// +optional
+	SingleTargetDataset *SingleTargetDataset `json:"singleTargetDataset,omitempty"`
+
+	// SourceHierarchyDatasets writes each source schema or database to a
+	// dataset of its own.
+	// +optional
+	SourceHierarchyDatasets *SourceHierarchyDatasets `json:"sourceHierarchyDatasets,omitempty"`
+}
+
+// SingleTargetDataset is a single BigQuery dataset.
+type SingleTargetDataset struct {
+	// DatasetID of the dataset, e.g. "my-project:my_dataset".
+	DatasetID string `json:"datasetId"`
+}
+
+// SourceHierarchyDatasets creates a BigQuery dataset for every source schema
+// or database.
+type SourceHierarchyDatasets struct {
+	// DatasetTemplate is used to create the datasets.
+	DatasetTemplate DatasetTemplate `json:"datasetTemplate"`
+}
+
+// DatasetTemplate describes the BigQuery datasets created by a stream.
+type DatasetTemplate struct {
+	// Location of the datasets, e.g. "US".
+	Location string `json:"location"`
+
+	// DatasetIDPrefix is prepended to the name of every dataset.
+	// +optional
+	DatasetIDPrefix *string `json:"datasetIdPrefix,omitempty"`
+
+	// KMSKeyName is the resource name of the Cloud KMS key used to encrypt
+	// the datasets.
+	// +optional
+	KMSKeyName *string `json:"kmsKeyName,omitempty"`
+}
+
+// BackfillAllStrategy backfills all existing data of the replicated objects.
+type BackfillAllStrategy struct {
+	// MySQLExcludedObjects are the MySQL objects to exclude from the
+	// backfill.
+	// +optional
+	MySQLExcludedObjects *MySQLRdbms `json:"mysqlExcludedObjects,omitempty"`
+
+	// PostgreSQLExcludedObjects are the PostgreSQL objects to exclude from
+	// the backfill.
+	// +optional
+	PostgreSQLExcludedObjects *PostgreSQLRdbms `json:"postgresqlExcludedObjects,omitempty"`
+}
+
+// BackfillNoneStrategy disables backfilling. It has no configuration.
+type BackfillNoneStrategy struct{}
+
+// StreamError is an error reported by a stream.
+type StreamError struct {
+	// Reason is a short identifier of the error.
+	Reason string `json:"reason,omitempty"`
+
+	// Message is a human readable description of the error.
+	Message string `json:"message,omitempty"`
+
+	// ErrorTime is the time the error occurred.
+	ErrorTime string `json:"errorTime,omitempty"`
+
+	// ErrorUUID uniquely identifies the error.
+	ErrorUUID string `json:"errorUuid,omitempty"`
+}
+
+// StreamObservation is used to show the observed state of the Stream.
+type StreamObservation struct {
+	// Name is the resource name of the stream, e.g.
+	// "projects/my-project/locations/us-central1/streams/my-stream".
+	Name string `json:"name,omitempty"`
+
+	// State of the stream.
+	State string `json:"state,omitempty"`
+
+	// CreateTime is the time the stream was created.
+	CreateTime string `json:"createTime,omitempty"`
+
+	// UpdateTime is the time the stream was last updated.
+	UpdateTime string `json:"updateTime,omitempty"`
+
+	// Errors reported by the stream.
+	Errors []StreamError `json:"errors,omitempty"`
+}
+
+// StreamSpec defines the desired state of a Stream.
+type StreamSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       StreamParameters `json:"forProvider"`
+}
+
+// StreamStatus represents the observed state of a Stream.
+type StreamStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          StreamObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// Stream is a managed resource that represents a Datastream stream, which
+// replicates changes from a source database to a destination.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="NAME",type="string",JSONPath=".status.atProvider.name",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp},shortName=stream
+type Stream struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   StreamSpec   `json:"spec"`
+	Status StreamStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// StreamList contains a list of Stream types
+type StreamList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Stream `json:"items"`
+}
@@ -49,7 +49,11 @@ type ResourceRecordSetParameters struct {
 }
 
 // ResourceRecordSetObservation is used to show the observed state of the ResourceRecordSet
-type ResourceRecordSetObservation struct{}
+type ResourceRecordSetObservation struct {
+	// ID of the ResourceRecordSet, in the form
+	// "projects/{project}/managedZones/{managedZone}/rrsets/{name}/{type}".
+	ID string `json:"id,omitempty"`
+}
 
 // ResourceRecordSetSpec defines the desired state of a ResourceRecordSet.
 type ResourceRecordSetSpec struct {
@@ -69,7 +73,11 @@ type ResourceRecordSetStatus struct {
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
 // +kubebuilder:printcolumn:name="DNS NAME",type="string",JSONPath=".status.atProvider.name"
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".spec.forProvider.type",priority=1
+// +kubebuilder:printcolumn:name="TTL",type="integer",JSONPath=".spec.forProvider.ttl",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp},shortName=rrs
 type ResourceRecordSet struct {
 	metav1.TypeMeta   `json:",inline"`
@@ -20,20 +20,36 @@ package apis
 import (
 	"k8s.io/apimachinery/pkg/runtime"
 
+	apigeev1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/apigee/v1alpha1"
+	batchv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/batch/v1alpha1"
+	bigqueryv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/bigquery/v1alpha1"
+	billingv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/billing/v1alpha1"
 	cachev1beta1 "github.com/crossplane-contrib/provider-gcp/apis/cache/v1beta1"
 	computev1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
 	computev1beta1 "github.com/crossplane-contrib/provider-gcp/apis/compute/v1beta1"
 	containerv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/container/v1beta1"
 	containerv1beta2 "github.com/crossplane-contrib/provider-gcp/apis/container/v1beta2"
+	databasev1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/database/v1alpha1"
 	databasev1beta1 "github.com/crossplane-contrib/provider-gcp/apis/database/v1beta1"
+	datastreamv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/datastream/v1alpha1"
 	dnsv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/dns/v1alpha1"
+	healthcarev1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/healthcare/v1alpha1"
 	iam "github.com/crossplane-contrib/provider-gcp/apis/iam/v1alpha1"
+	identityplatformv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/identityplatform/v1alpha1"
+	idsv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/ids/v1alpha1"
 	kms "github.com/crossplane-contrib/provider-gcp/apis/kms/v1alpha1"
+	networksecurityv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/networksecurity/v1alpha1"
+	networkservicesv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/networkservices/v1alpha1"
+	osconfigv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/osconfig/v1alpha1"
+	privatecav1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/privateca/v1alpha1"
 	pubsub "github.com/crossplane-contrib/provider-gcp/apis/pubsub/v1alpha1"
+	recaptchaenterprisev1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/recaptchaenterprise/v1alpha1"
 	registry "github.com/crossplane-contrib/provider-gcp/apis/registry/v1alpha1"
+	securitycenterv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/securitycenter/v1alpha1"
 	servicenetworkingv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/servicenetworking/v1beta1"
 	storagev1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/storage/v1alpha1"
 	storagev1alpha3 "github.com/crossplane-contrib/provider-gcp/apis/storage/v1alpha3"
+	tpuv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/tpu/v1alpha1"
 	gcpv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
 	gcpv1alpha3 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha3"
 	gcpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
@@ -50,6 +66,7 @@ func init() {
 		computev1beta1.SchemeBuilder.AddToScheme,
 		containerv1beta2.SchemeBuilder.AddToScheme,
 		containerv1beta1.SchemeBuilder.AddToScheme,
+		databasev1alpha1.SchemeBuilder.AddToScheme,
 		databasev1beta1.SchemeBuilder.AddToScheme,
 		iam.SchemeBuilder.AddToScheme,
 		kms.SchemeBuilder.AddToScheme,
@@ -59,6 +76,21 @@ func init() {
 		storagev1alpha3.SchemeBuilder.AddToScheme,
 		dnsv1alpha1.SchemeBuilder.AddToScheme,
 		registry.SchemeBuilder.AddToScheme,
+		securitycenterv1alpha1.SchemeBuilder.AddToScheme,
+		osconfigv1alpha1.SchemeBuilder.AddToScheme,
+		datastreamv1alpha1.SchemeBuilder.AddToScheme,
+		bigqueryv1alpha1.SchemeBuilder.AddToScheme,
+		recaptchaenterprisev1alpha1.SchemeBuilder.AddToScheme,
+		identityplatformv1alpha1.SchemeBuilder.AddToScheme,
+		networkservicesv1alpha1.SchemeBuilder.AddToScheme,
+		batchv1alpha1.SchemeBuilder.AddToScheme,
+		tpuv1alpha1.SchemeBuilder.AddToScheme,
+		idsv1alpha1.SchemeBuilder.AddToScheme,
+		networksecurityv1alpha1.SchemeBuilder.AddToScheme,
+		billingv1alpha1.SchemeBuilder.AddToScheme,
+		apigeev1alpha1.SchemeBuilder.AddToScheme,
+		healthcarev1alpha1.SchemeBuilder.AddToScheme,
+		privatecav1alpha1.SchemeBuilder.AddToScheme,
 	)
 }
 
@@ -0,0 +1,83 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// DatasetParameters define the desired state of a Cloud Healthcare API
+// dataset.
+type DatasetParameters struct {
+	// Location is the region the dataset lives in, e.g. "us-central1".
+	// +immutable
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="location is immutable"
+	Location string `json:"location"`
+
+	// TimeZone is the default time zone of the dataset, used for parsing
+	// timestamps without one in the stores of the dataset, e.g.
+	// "America/New_York". Defaults to UTC.
+	// +optional
+	TimeZone *string `json:"timeZone,omitempty"`
+}
+
+// DatasetObservation is used to show the observed state of the Dataset.
+type DatasetObservation struct {
+	// Name is the resource name of the dataset, e.g.
+	// "projects/my-project/locations/us-central1/datasets/my-dataset".
+	Name string `json:"name,omitempty"`
+}
+
+// DatasetSpec defines the desired state of a Dataset.
+type DatasetSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       DatasetParameters `json:"forProvider"`
+}
+
+// DatasetStatus represents the observed state of a Dataset.
+type DatasetStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          DatasetObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// Dataset is a managed resource that represents a Cloud Healthcare API dataset.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type Dataset struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DatasetSpec   `json:"spec"`
+	Status DatasetStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DatasetList contains a list of Dataset types
+type DatasetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Dataset `json:"items"`
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// DICOMStoreParameters define the desired state of a Cloud Healthcare API
+// DICOM store.
+type DICOMStoreParameters struct {
+	// Dataset is the resource name of the dataset the DICOM store belongs to,
+	// e.g. "projects/my-project/locations/us-central1/datasets/my-dataset".
+	// +immutable
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="dataset is immutable"
+	// +optional
+	Dataset *string `json:"dataset,omitempty"`
+
+	// DatasetRef references a Dataset to retrieve its resource name.
+	// +optional
+	DatasetRef *xpv1.Reference `json:"datasetRef,omitempty"`
+
+	// DatasetSelector selects a reference to a Dataset to retrieve its
+	// resource name.
+	// +optional
+	DatasetSelector *xpv1.Selector `json:"datasetSelector,omitempty"`
+
+	// NotificationConfig configures where notifications of changes to the
+	// store are published.
+	// +optional
+	NotificationConfig *NotificationConfig `json:"notificationConfig,omitempty"`
+
+	// Labels of the store.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// DICOMStoreObservation is used to show the observed state of the DICOMStore.
+type DICOMStoreObservation struct {
+	// Name is the resource name of the DICOM store, e.g.
+	// "projects/my-project/locations/us-central1/datasets/my-dataset/dicomStores/my-store".
+	Name string `json:"name,omitempty"`
+}
+
+// DICOMStoreSpec defines the desired state of a DICOMStore.
+type DICOMStoreSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       DICOMStoreParameters `json:"forProvider"`
+}
+
+// DICOMStoreStatus represents the observed state of a DICOMStore.
+type DICOMStoreStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          DICOMStoreObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DICOMStore is a managed resource that represents a Cloud Healthcare API DICOM store.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type DICOMStore struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DICOMStoreSpec   `json:"spec"`
+	Status DICOMStoreStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DICOMStoreList contains a list of DICOMStore types
+type DICOMStoreList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DICOMStore `json:"items"`
+}
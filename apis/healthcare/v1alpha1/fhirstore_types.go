@@ -0,0 +1,151 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// NotificationConfig configures where notifications of changes to a store
+// are published.
+type NotificationConfig struct {
+	// PubsubTopic that notifications are published to, in the form
+	// "projects/{project_id}/topics/{topic_id}".
+	// +optional
+	PubsubTopic *string `json:"pubsubTopic,omitempty"`
+
+	// PubsubTopicRef references a Topic to set PubsubTopic.
+	// +optional
+	PubsubTopicRef *xpv1.Reference `json:"pubsubTopicRef,omitempty"`
+
+	// PubsubTopicSelector selects a reference to a Topic to set
+	// PubsubTopic.
+	// +optional
+	PubsubTopicSelector *xpv1.Selector `json:"pubsubTopicSelector,omitempty"`
+}
+
+// FHIRStoreParameters define the desired state of a Cloud Healthcare API
+// FHIR store.
+type FHIRStoreParameters struct {
+	// Dataset is the resource name of the dataset the FHIR store belongs to,
+	// e.g. "projects/my-project/locations/us-central1/datasets/my-dataset".
+	// +immutable
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="dataset is immutable"
+	// +optional
+	Dataset *string `json:"dataset,omitempty"`
+
+	// DatasetRef references a Dataset to retrieve its resource name.
+	// +optional
+	DatasetRef *xpv1.Reference `json:"datasetRef,omitempty"`
+
+	// DatasetSelector selects a reference to a Dataset to retrieve its
+	// resource name.
+	// +optional
+	DatasetSelector *xpv1.Selector `json:"datasetSelector,omitempty"`
+
+	// Version of the FHIR specification the store supports.
+	// +immutable
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="version is immutable"
+	// +kubebuilder:validation:Enum=DSTU2;STU3;R4
+	Version string `json:"version"`
+
+	// EnableUpdateCreate allows resources to be created by updating them
+	// with a client-assigned ID.
+	// +optional
+	EnableUpdateCreate *bool `json:"enableUpdateCreate,omitempty"`
+
+	// DisableReferentialIntegrity stops the store from checking that the
+	// resources referenced by a resource exist.
+	// +immutable
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="disableReferentialIntegrity is immutable"
+	// +optional
+	DisableReferentialIntegrity *bool `json:"disableReferentialIntegrity,omitempty"`
+
+	// DisableResourceVersioning stops the store from keeping the history of
+	// resources.
+	// +immutable
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="disableResourceVersioning is immutable"
+	// +optional
+	DisableResourceVersioning *bool `json:"disableResourceVersioning,omitempty"`
+
+	// DefaultSearchHandlingStrict makes searches fail on unknown or
+	// unsupported parameters unless the request asks for lenient handling.
+	// +optional
+	DefaultSearchHandlingStrict *bool `json:"defaultSearchHandlingStrict,omitempty"`
+
+	// ComplexDataTypeReferenceParsing enables parsing references within
+	// complex data types such as extensions.
+	// +kubebuilder:validation:Enum=ENABLED;DISABLED
+	// +optional
+	ComplexDataTypeReferenceParsing *string `json:"complexDataTypeReferenceParsing,omitempty"`
+
+	// NotificationConfig configures where notifications of changes to the
+	// store are published.
+	// +optional
+	NotificationConfig *NotificationConfig `json:"notificationConfig,omitempty"`
+
+	// Labels of the store.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// FHIRStoreObservation is used to show the observed state of the FHIRStore.
+type FHIRStoreObservation struct {
+	// Name is the resource name of the FHIR store, e.g.
+	// "projects/my-project/locations/us-central1/datasets/my-dataset/fhirStores/my-store".
+	Name string `json:"name,omitempty"`
+}
+
+// FHIRStoreSpec defines the desired state of a FHIRStore.
+type FHIRStoreSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       FHIRStoreParameters `json:"forProvider"`
+}
+
+// FHIRStoreStatus represents the observed state of a FHIRStore.
+type FHIRStoreStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          FHIRStoreObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// FHIRStore is a managed resource that represents a Cloud Healthcare API FHIR store.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type FHIRStore struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   FHIRStoreSpec   `json:"spec"`
+	Status FHIRStoreStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// FHIRStoreList contains a list of FHIRStore types
+type FHIRStoreList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []FHIRStore `json:"items"`
+}
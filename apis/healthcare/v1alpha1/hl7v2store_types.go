@@ -0,0 +1,150 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// HL7V2NotificationConfig configures where notifications of changes to the
+// messages of an HL7v2 store are published.
+type HL7V2NotificationConfig struct {
+	// PubsubTopic that notifications are published to, in the form
+	// "projects/{project_id}/topics/{topic_id}".
+	// +optional
+	PubsubTopic *string `json:"pubsubTopic,omitempty"`
+
+	// PubsubTopicRef references a Topic to set PubsubTopic.
+	// +optional
+	PubsubTopicRef *xpv1.Reference `json:"pubsubTopicRef,omitempty"`
+
+	// PubsubTopicSelector selects a reference to a Topic to set
+	// PubsubTopic.
+	// +optional
+	PubsubTopicSelector *xpv1.Selector `json:"pubsubTopicSelector,omitempty"`
+
+	// Filter limits the notifications to the messages that match it, e.g.
+	// 'messageType = "ADT"'. All messages match if omitted.
+	// +optional
+	Filter *string `json:"filter,omitempty"`
+}
+
+// ParserConfig configures how the messages of an HL7v2 store are parsed.
+type ParserConfig struct {
+	// AllowNullHeader allows messages without a header.
+	// +optional
+	AllowNullHeader *bool `json:"allowNullHeader,omitempty"`
+
+	// SegmentTerminator is the base64 encoded byte sequence that ends a
+	// segment. Defaults to "\r".
+	// +optional
+	SegmentTerminator *string `json:"segmentTerminator,omitempty"`
+
+	// Version of the parser. Defaults to V1.
+	// +kubebuilder:validation:Enum=V1;V2;V3
+	// +optional
+	Version *string `json:"version,omitempty"`
+}
+
+// HL7V2StoreParameters define the desired state of a Cloud Healthcare API
+// HL7v2 store.
+type HL7V2StoreParameters struct {
+	// Dataset is the resource name of the dataset the HL7v2 store belongs to,
+	// e.g. "projects/my-project/locations/us-central1/datasets/my-dataset".
+	// +immutable
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="dataset is immutable"
+	// +optional
+	Dataset *string `json:"dataset,omitempty"`
+
+	// DatasetRef references a Dataset to retrieve its resource name.
+	// +optional
+	DatasetRef *xpv1.Reference `json:"datasetRef,omitempty"`
+
+	// DatasetSelector selects a reference to a Dataset to retrieve its
+	// resource name.
+	// +optional
+	DatasetSelector *xpv1.Selector `json:"datasetSelector,omitempty"`
+
+	// ParserConfig configures how the messages of the store are parsed.
+	// +immutable
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="parserConfig is immutable"
+	// +optional
+	ParserConfig *ParserConfig `json:"parserConfig,omitempty"`
+
+	// RejectDuplicateMessage rejects messages that are identical to one in
+	// the store.
+	// +optional
+	RejectDuplicateMessage *bool `json:"rejectDuplicateMessage,omitempty"`
+
+	// NotificationConfigs configure where notifications of changes to the
+	// messages of the store are published. Each message is published at
+	// most once, to the first topic whose filter it matches.
+	// +kubebuilder:validation:MaxItems=10
+	// +optional
+	NotificationConfigs []HL7V2NotificationConfig `json:"notificationConfigs,omitempty"`
+
+	// Labels of the store.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// HL7V2StoreObservation is used to show the observed state of the HL7V2Store.
+type HL7V2StoreObservation struct {
+	// Name is the resource name of the HL7v2 store, e.g.
+	// "projects/my-project/locations/us-central1/datasets/my-dataset/hl7V2Stores/my-store".
+	Name string `json:"name,omitempty"`
+}
+
+// HL7V2StoreSpec defines the desired state of an HL7V2Store.
+type HL7V2StoreSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       HL7V2StoreParameters `json:"forProvider"`
+}
+
+// HL7V2StoreStatus represents the observed state of an HL7V2Store.
+type HL7V2StoreStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          HL7V2StoreObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// HL7V2Store is a managed resource that represents a Cloud Healthcare API HL7v2 store.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type HL7V2Store struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   HL7V2StoreSpec   `json:"spec"`
+	Status HL7V2StoreStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// HL7V2StoreList contains a list of HL7V2Store types
+type HL7V2StoreList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []HL7V2Store `json:"items"`
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	pubsubv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/pubsub/v1alpha1"
+)
+
+// DatasetRRN extracts the resource name of a Dataset.
+func DatasetRRN() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		ds, ok := mg.(*Dataset)
+		if !ok {
+			return ""
+		}
+		return ds.Status.AtProvider.Name
+	}
+}
+
+// ResolveReferences of this FHIRStore
+func (mg *FHIRStore) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.dataset
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Dataset),
+		Reference:    mg.Spec.ForProvider.DatasetRef,
+		Selector:     mg.Spec.ForProvider.DatasetSelector,
+		To:           reference.To{Managed: &Dataset{}, List: &DatasetList{}},
+		Extract:      DatasetRRN(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.dataset")
+	}
+	mg.Spec.ForProvider.Dataset = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.DatasetRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.notificationConfig.pubsubTopic
+	if nc := mg.Spec.ForProvider.NotificationConfig; nc != nil {
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(nc.PubsubTopic),
+			Reference:    nc.PubsubTopicRef,
+			Selector:     nc.PubsubTopicSelector,
+			To:           reference.To{Managed: &pubsubv1alpha1.Topic{}, List: &pubsubv1alpha1.TopicList{}},
+			Extract:      pubsubv1alpha1.TopicRRN(),
+		})
+		if err != nil {
+			return errors.Wrap(err, "spec.forProvider.notificationConfig.pubsubTopic")
+		}
+		nc.PubsubTopic = reference.ToPtrValue(rsp.ResolvedValue)
+		nc.PubsubTopicRef = rsp.ResolvedReference
+	}
+
+	return nil
+}
+
+// ResolveReferences of this DICOMStore
+func (mg *DICOMStore) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.dataset
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Dataset),
+		Reference:    mg.Spec.ForProvider.DatasetRef,
+		Selector:     mg.Spec.ForProvider.DatasetSelector,
+		To:           reference.To{Managed: &Dataset{}, List: &DatasetList{}},
+		Extract:      DatasetRRN(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.dataset")
+	}
+	mg.Spec.ForProvider.Dataset = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.DatasetRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.notificationConfig.pubsubTopic
+	if nc := mg.Spec.ForProvider.NotificationConfig; nc != nil {
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(nc.PubsubTopic),
+			Reference:    nc.PubsubTopicRef,
+			Selector:     nc.PubsubTopicSelector,
+			To:           reference.To{Managed: &pubsubv1alpha1.Topic{}, List: &pubsubv1alpha1.TopicList{}},
+			Extract:      pubsubv1alpha1.TopicRRN(),
+		})
+		if err != nil {
+			return errors.Wrap(err, "spec.forProvider.notificationConfig.pubsubTopic")
+		}
+		nc.PubsubTopic = reference.ToPtrValue(rsp.ResolvedValue)
+		nc.PubsubTopicRef = rsp.ResolvedReference
+	}
+
+	return nil
+}
+
+// ResolveReferences of this HL7V2Store
+func (mg *HL7V2Store) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.dataset
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Dataset),
+		Reference:    mg.Spec.ForProvider.DatasetRef,
+		Selector:     mg.Spec.ForProvider.DatasetSelector,
+		To:           reference.To{Managed: &Dataset{}, List: &DatasetList{}},
+		Extract:      DatasetRRN(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.dataset")
+	}
+	mg.Spec.ForProvider.Dataset = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.DatasetRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.notificationConfigs[*].pubsubTopic
+	for i := range mg.Spec.ForProvider.NotificationConfigs {
+		nc := &mg.Spec.ForProvider.NotificationConfigs[i]
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(nc.PubsubTopic),
+			Reference:    nc.PubsubTopicRef,
+			Selector:     nc.PubsubTopicSelector,
+			To:           reference.To{Managed: &pubsubv1alpha1.Topic{}, List: &pubsubv1alpha1.TopicList{}},
+			Extract:      pubsubv1alpha1.TopicRRN(),
+		})
+		if err != nil {
+			return errors.Wrapf(err, "spec.forProvider.notificationConfigs[%d].pubsubTopic", i)
+		}
+		nc.PubsubTopic = reference.ToPtrValue(rsp.ResolvedValue)
+		nc.PubsubTopicRef = rsp.ResolvedReference
+	}
+
+	return nil
+}
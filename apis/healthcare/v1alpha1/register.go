@@ -0,0 +1,77 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+)
+
+// Package type metadata.
+const (
+	Group   = "healthcare.gcp.crossplane.io"
+	Version = "v1alpha1"
+)
+
+var (
+	// SchemeGroupVersion is group version used to register these objects
+	SchemeGroupVersion = schema.GroupVersion{Group: Group, Version: Version}
+
+	// SchemeBuilder is used to add go types to the GroupVersionKind scheme
+	SchemeBuilder = &scheme.Builder{GroupVersion: SchemeGroupVersion}
+)
+
+// Dataset type metadata.
+var (
+	DatasetKind             = reflect.TypeOf(Dataset{}).Name()
+	DatasetGroupKind        = schema.GroupKind{Group: Group, Kind: DatasetKind}.String()
+	DatasetKindAPIVersion   = DatasetKind + "." + SchemeGroupVersion.String()
+	DatasetGroupVersionKind = SchemeGroupVersion.WithKind(DatasetKind)
+)
+
+// FHIRStore type metadata.
+var (
+	FHIRStoreKind             = reflect.TypeOf(FHIRStore{}).Name()
+	FHIRStoreGroupKind        = schema.GroupKind{Group: Group, Kind: FHIRStoreKind}.String()
+	FHIRStoreKindAPIVersion   = FHIRStoreKind + "." + SchemeGroupVersion.String()
+	FHIRStoreGroupVersionKind = SchemeGroupVersion.WithKind(FHIRStoreKind)
+)
+
+// DICOMStore type metadata.
+var (
+	DICOMStoreKind             = reflect.TypeOf(DICOMStore{}).Name()
+	DICOMStoreGroupKind        = schema.GroupKind{Group: Group, Kind: DICOMStoreKind}.String()
+	DICOMStoreKindAPIVersion   = DICOMStoreKind + "." + SchemeGroupVersion.String()
+	DICOMStoreGroupVersionKind = SchemeGroupVersion.WithKind(DICOMStoreKind)
+)
+
+// HL7V2Store type metadata.
+var (
+	HL7V2StoreKind             = reflect.TypeOf(HL7V2Store{}).Name()
+	HL7V2StoreGroupKind        = schema.GroupKind{Group: Group, Kind: HL7V2StoreKind}.String()
+	HL7V2StoreKindAPIVersion   = HL7V2StoreKind + "." + SchemeGroupVersion.String()
+	HL7V2StoreGroupVersionKind = SchemeGroupVersion.WithKind(HL7V2StoreKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Dataset{}, &DatasetList{})
+	SchemeBuilder.Register(&FHIRStore{}, &FHIRStoreList{})
+	SchemeBuilder.Register(&DICOMStore{}, &DICOMStoreList{})
+	SchemeBuilder.Register(&HL7V2Store{}, &HL7V2StoreList{})
+}
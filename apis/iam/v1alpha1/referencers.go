@@ -65,6 +65,17 @@ func ServiceAccountRRN() reference.ExtractValueFn {
 	}
 }
 
+// ServiceAccountEmail extracts the email address of a ServiceAccount.
+func ServiceAccountEmail() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		n, ok := mg.(*ServiceAccount)
+		if !ok {
+			return ""
+		}
+		return n.Status.AtProvider.Email
+	}
+}
+
 // ServiceAccountMemberName returns member name for a given ServiceAccount Object.
 func ServiceAccountMemberName() reference.ExtractValueFn {
 	return func(mg resource.Managed) string {
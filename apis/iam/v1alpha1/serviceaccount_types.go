@@ -22,6 +22,17 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 )
 
+// Connection details published for a ServiceAccount.
+const (
+	// ServiceAccountEmailKey is the connection detail key holding the email
+	// address of the service account.
+	ServiceAccountEmailKey = "email"
+
+	// ServiceAccountUniqueIDKey is the connection detail key holding the
+	// unique and stable id of the service account.
+	ServiceAccountUniqueIDKey = "uniqueId"
+)
+
 // ServiceAccountParameters defines parameters for a desired IAM ServiceAccount
 // https://cloud.google.com/iam/docs/reference/rest/v1/projects.serviceAccounts
 // The name of the service account (ie the `accountId` parameter of the Create
@@ -69,6 +80,37 @@ type ServiceAccountObservation struct {
 	// Disabled is a bool indicating if the service account is disabled.
 	// The field is currently in alpha phase.
 	Disabled bool `json:"disabled,omitempty"`
+
+	// Keys lists the user-managed keys that currently exist for the service
+	// account, whether or not they are managed by Crossplane.
+	// +optional
+	Keys []ServiceAccountKeyInfo `json:"keys,omitempty"`
+}
+
+// ServiceAccountKeyInfo is the observed state of a user-managed key of a
+// service account.
+type ServiceAccountKeyInfo struct {
+	// KeyID is the unique and stable id of the key.
+	KeyID string `json:"keyId"`
+
+	// KeyAlgorithm is the key algorithm & possibly key size used for public/private key pair generation.
+	KeyAlgorithm string `json:"keyAlgorithm,omitempty"`
+
+	// KeyOrigin is the origin of the key.
+	// Possible values:
+	//   "ORIGIN_UNSPECIFIED" - Unspecified key origin.
+	//   "USER_PROVIDED" - Key is provided by user.
+	//   "GOOGLE_PROVIDED" - Key is provided by Google.
+	KeyOrigin string `json:"keyOrigin,omitempty"`
+
+	// ValidAfterTime is the timestamp after which this key can be used in RFC3339 UTC "Zulu" format.
+	ValidAfterTime string `json:"validAfterTime,omitempty"`
+
+	// ValidBeforeTime is the timestamp before which this key can be used in RFC3339 UTC "Zulu" format.
+	ValidBeforeTime string `json:"validBeforeTime,omitempty"`
+
+	// Disabled is true if the key has been disabled.
+	Disabled bool `json:"disabled,omitempty"`
 }
 
 // ServiceAccountSpec defines the desired state of a
@@ -91,11 +133,12 @@ type ServiceAccountStatus struct {
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
 // +kubebuilder:printcolumn:name="DISPLAYNAME",type="string",JSONPath=".spec.forProvider.displayName"
 // +kubebuilder:printcolumn:name="EMAIL",type="string",JSONPath=".status.atProvider.email"
 // +kubebuilder:printcolumn:name="DISABLED",type="boolean",JSONPath=".status.atProvider.disabled"
-// +kubebuilder:resource:scope=Cluster
-// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp},shortName=gsa
 type ServiceAccount struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
@@ -230,6 +230,21 @@ func (in *ServiceAccountKey) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceAccountKeyInfo) DeepCopyInto(out *ServiceAccountKeyInfo) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccountKeyInfo.
+func (in *ServiceAccountKeyInfo) DeepCopy() *ServiceAccountKeyInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceAccountKeyInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceAccountKeyList) DeepCopyInto(out *ServiceAccountKeyList) {
 	*out = *in
@@ -377,6 +392,11 @@ func (in *ServiceAccountList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceAccountObservation) DeepCopyInto(out *ServiceAccountObservation) {
 	*out = *in
+	if in.Keys != nil {
+		in, out := &in.Keys, &out.Keys
+		*out = make([]ServiceAccountKeyInfo, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccountObservation.
@@ -574,7 +594,7 @@ func (in *ServiceAccountSpec) DeepCopy() *ServiceAccountSpec {
 func (in *ServiceAccountStatus) DeepCopyInto(out *ServiceAccountStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	out.AtProvider = in.AtProvider
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccountStatus.
@@ -0,0 +1,149 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// ConfigParameters defines parameters for the desired Identity Platform
+// Config of a project. Omitted fields are left as they are.
+type ConfigParameters struct {
+	// SignIn configures how users sign in.
+	// +optional
+	SignIn *SignInConfig `json:"signIn,omitempty"`
+
+	// AuthorizedDomains that may be used as redirect targets of OAuth
+	// sign-in flows, e.g. "app.example.com".
+	// +optional
+	AuthorizedDomains []string `json:"authorizedDomains,omitempty"`
+
+	// MFA configures multi-factor authentication for the project.
+	// +optional
+	MFA *MultiFactorAuthConfig `json:"mfa,omitempty"`
+
+	// AutodeleteAnonymousUsers deletes anonymous users that have been
+	// inactive for 30 days.
+	// +optional
+	AutodeleteAnonymousUsers *bool `json:"autodeleteAnonymousUsers,omitempty"`
+
+	// MultiTenant configures whether the project may have tenants.
+	// +optional
+	MultiTenant *MultiTenantConfig `json:"multiTenant,omitempty"`
+}
+
+// SignInConfig configures how users sign in.
+type SignInConfig struct {
+	// Email configures sign-in with email.
+	// +optional
+	Email *EmailSignInConfig `json:"email,omitempty"`
+
+	// PhoneNumber configures sign-in with a phone number.
+	// +optional
+	PhoneNumber *PhoneNumberSignInConfig `json:"phoneNumber,omitempty"`
+
+	// AnonymousEnabled enables anonymous sign-in.
+	// +optional
+	AnonymousEnabled *bool `json:"anonymousEnabled,omitempty"`
+
+	// AllowDuplicateEmails allows more than one account to use the same
+	// email address.
+	// +optional
+	AllowDuplicateEmails *bool `json:"allowDuplicateEmails,omitempty"`
+}
+
+// EmailSignInConfig configures sign-in with email.
+type EmailSignInConfig struct {
+	// Enabled enables sign-in with email.
+	Enabled bool `json:"enabled"`
+
+	// PasswordRequired requires a password. Users sign in with an email
+	// link otherwise.
+	// +optional
+	PasswordRequired *bool `json:"passwordRequired,omitempty"`
+}
+
+// PhoneNumberSignInConfig configures sign-in with a phone number.
+type PhoneNumberSignInConfig struct {
+	// Enabled enables sign-in with a phone number.
+	Enabled bool `json:"enabled"`
+
+	// TestPhoneNumbers maps phone numbers that can be used for testing to
+	// their verification codes, e.g. "+16505550101": "123456".
+	// +optional
+	TestPhoneNumbers map[string]string `json:"testPhoneNumbers,omitempty"`
+}
+
+// MultiTenantConfig configures whether a project may have tenants.
+type MultiTenantConfig struct {
+	// AllowTenants allows tenants to be created.
+	AllowTenants bool `json:"allowTenants"`
+}
+
+// ConfigObservation is used to show the observed state of the Config.
+type ConfigObservation struct {
+	// Name is the resource name of the config, e.g.
+	// "projects/my-project/config".
+	Name string `json:"name,omitempty"`
+
+	// Subtype of the project, either IDENTITY_PLATFORM or FIREBASE_AUTH.
+	Subtype string `json:"subtype,omitempty"`
+}
+
+// ConfigSpec defines the desired state of a Config.
+type ConfigSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ConfigParameters `json:"forProvider"`
+}
+
+// ConfigStatus represents the observed state of a Config.
+type ConfigStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ConfigObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// Config is a managed resource that represents the Identity Platform
+// configuration of the project of its ProviderConfig. Identity Platform is
+// initialized for the project if it is not yet. A project has exactly one
+// Config, which cannot be deleted; deleting the managed resource leaves the
+// configuration as it is.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="SUBTYPE",type="string",JSONPath=".status.atProvider.subtype"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp},shortName=idpconfig
+type Config struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ConfigSpec   `json:"spec"`
+	Status ConfigStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ConfigList contains a list of Config types
+type ConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Config `json:"items"`
+}
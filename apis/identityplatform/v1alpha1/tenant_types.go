@@ -0,0 +1,119 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// TenantParameters defines parameters for a desired Identity Platform
+// Tenant.
+type TenantParameters struct {
+	// DisplayName of the tenant. It must be 4 to 20 characters long, start
+	// with a letter and consist of letters, digits and hyphens.
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z][a-zA-Z0-9-]{3,19}$`
+	DisplayName string `json:"displayName"`
+
+	// AllowPasswordSignup enables sign-in with email and password.
+	// +optional
+	AllowPasswordSignup *bool `json:"allowPasswordSignup,omitempty"`
+
+	// EnableEmailLinkSignin enables passwordless sign-in with an email
+	// link.
+	// +optional
+	EnableEmailLinkSignin *bool `json:"enableEmailLinkSignin,omitempty"`
+
+	// EnableAnonymousUser enables anonymous sign-in.
+	// +optional
+	EnableAnonymousUser *bool `json:"enableAnonymousUser,omitempty"`
+
+	// DisableAuth disables all sign-ins to the tenant.
+	// +optional
+	DisableAuth *bool `json:"disableAuth,omitempty"`
+
+	// MFAConfig configures multi-factor authentication for the tenant.
+	// +optional
+	MFAConfig *MultiFactorAuthConfig `json:"mfaConfig,omitempty"`
+
+	// TestPhoneNumbers maps phone numbers that can be used for testing to
+	// their verification codes, e.g. "+16505550101": "123456".
+	// +optional
+	TestPhoneNumbers map[string]string `json:"testPhoneNumbers,omitempty"`
+}
+
+// MultiFactorAuthConfig configures multi-factor authentication.
+type MultiFactorAuthConfig struct {
+	// State of multi-factor authentication.
+	// +kubebuilder:validation:Enum=DISABLED;ENABLED;MANDATORY
+	State string `json:"state"`
+
+	// EnabledProviders are the second factors users can enroll. Only
+	// PHONE_SMS is supported.
+	// +optional
+	EnabledProviders []string `json:"enabledProviders,omitempty"`
+}
+
+// TenantObservation is used to show the observed state of the Tenant.
+type TenantObservation struct {
+	// Name is the resource name of the tenant, e.g.
+	// "projects/my-project/tenants/my-tenant-a1b2c".
+	Name string `json:"name,omitempty"`
+}
+
+// TenantSpec defines the desired state of a Tenant.
+type TenantSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       TenantParameters `json:"forProvider"`
+}
+
+// TenantStatus represents the observed state of a Tenant.
+type TenantStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          TenantObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// Tenant is a managed resource that represents an Identity Platform tenant,
+// an isolated group of users and sign-in configuration. Multi-tenancy must
+// be allowed by the project's Config. The tenant ID is assigned by Google
+// and is written to the external name annotation.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="DISPLAY-NAME",type="string",JSONPath=".spec.forProvider.displayName"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type Tenant struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TenantSpec   `json:"spec"`
+	Status TenantStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TenantList contains a list of Tenant types
+type TenantList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Tenant `json:"items"`
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// States of an Endpoint.
+const (
+	EndpointStateUnspecified = "STATE_UNSPECIFIED"
+	EndpointStateCreating    = "CREATING"
+	EndpointStateReady       = "READY"
+	EndpointStateDeleting    = "DELETING"
+	EndpointStateUpdating    = "UPDATING"
+)
+
+// EndpointParameters defines parameters for a desired Cloud IDS Endpoint.
+// Cloud IDS endpoints can not be updated once they are created, so changes
+// made to an Endpoint's parameters afterwards are not applied to it.
+type EndpointParameters struct {
+	// Location is the zone the endpoint lives in, e.g. "us-central1-a".
+	// +immutable
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="location is immutable"
+	Location string `json:"location"`
+
+	// Network is the URL of the network the endpoint is attached to, e.g.
+	// "projects/my-project/global/networks/my-network". The network must
+	// have private services access configured.
+	// +optional
+	// +immutable
+	Network *string `json:"network,omitempty"`
+
+	// NetworkRef references a Network to retrieve its URL.
+	// +optional
+	// +immutable
+	NetworkRef *xpv1.Reference `json:"networkRef,omitempty"`
+
+	// NetworkSelector selects a reference to a Network to retrieve its URL.
+	// +optional
+	NetworkSelector *xpv1.Selector `json:"networkSelector,omitempty"`
+
+	// Severity is the lowest threat severity the endpoint alerts on.
+	// +immutable
+	// +kubebuilder:validation:Enum=INFORMATIONAL;LOW;MEDIUM;HIGH;CRITICAL
+	Severity string `json:"severity"`
+
+	// Description of the endpoint.
+	// +optional
+	// +immutable
+	Description *string `json:"description,omitempty"`
+
+	// Labels to apply to the endpoint.
+	// +optional
+	// +immutable
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// TrafficLogs specifies whether the endpoint reports traffic logs in
+	// addition to threat logs.
+	// +optional
+	// +immutable
+	TrafficLogs *bool `json:"trafficLogs,omitempty"`
+}
+
+// EndpointObservation is used to show the observed state of the Endpoint.
+type EndpointObservation struct {
+	// Name is the resource name of the endpoint, e.g.
+	// "projects/my-project/locations/us-central1-a/endpoints/my-endpoint".
+	Name string `json:"name,omitempty"`
+
+	// State of the endpoint.
+	State string `json:"state,omitempty"`
+
+	// EndpointForwardingRule is the URL of the forwarding rule of the
+	// endpoint's internal load balancer. Packet mirroring policies send
+	// mirrored traffic to it.
+	EndpointForwardingRule string `json:"endpointForwardingRule,omitempty"`
+
+	// EndpointIP is the IP address of the endpoint's internal load
+	// balancer.
+	EndpointIP string `json:"endpointIp,omitempty"`
+
+	// CreateTime is the time the endpoint was created.
+	CreateTime string `json:"createTime,omitempty"`
+
+	// UpdateTime is the time the endpoint was last updated.
+	UpdateTime string `json:"updateTime,omitempty"`
+}
+
+// EndpointSpec defines the desired state of an Endpoint.
+type EndpointSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       EndpointParameters `json:"forProvider"`
+}
+
+// EndpointStatus represents the observed state of an Endpoint.
+type EndpointStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          EndpointObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// Endpoint is a managed resource that represents a Cloud IDS endpoint, which
+// inspects the traffic mirrored to it for threats.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="IP",type="string",JSONPath=".status.atProvider.endpointIp",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp},shortName=idsendpoint
+type Endpoint struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   EndpointSpec   `json:"spec"`
+	Status EndpointStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// EndpointList contains a list of Endpoint types
+type EndpointList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Endpoint `json:"items"`
+}
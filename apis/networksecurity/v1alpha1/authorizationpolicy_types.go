@@ -0,0 +1,172 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// AuthorizationPolicyParameters defines parameters for a desired Network
+// Security AuthorizationPolicy.
+type AuthorizationPolicyParameters struct {
+	// Location the policy lives in. Policies used by Traffic Director are
+	// global.
+	// +optional
+	// +immutable
+	// +kubebuilder:default=global
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="location is immutable"
+	Location string `json:"location"`
+
+	// Description of the policy.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Labels to apply to the policy.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Action taken on requests that match one of the rules.
+	// +kubebuilder:validation:Enum=ALLOW;DENY
+	Action string `json:"action"`
+
+	// Rules that requests are matched against. If no rules are given, the
+	// action applies to all requests.
+	// +optional
+	Rules []AuthorizationRule `json:"rules,omitempty"`
+}
+
+// AuthorizationRule matches requests from any of its sources to any of its
+// destinations.
+type AuthorizationRule struct {
+	// Sources of the request. Requests from any source match if it is
+	// omitted.
+	// +optional
+	Sources []AuthorizationSource `json:"sources,omitempty"`
+
+	// Destinations of the request. Requests to any destination match if it
+	// is omitted.
+	// +optional
+	Destinations []AuthorizationDestination `json:"destinations,omitempty"`
+}
+
+// AuthorizationSource matches the peer of a request.
+type AuthorizationSource struct {
+	// Principals the peer certificate is matched against, e.g.
+	// "spiffe://my-project.svc.id.goog/ns/default/sa/frontend". A trailing
+	// or leading "*" matches any suffix or prefix. Requires mTLS.
+	// +optional
+	Principals []string `json:"principals,omitempty"`
+
+	// IPBlocks the peer IP address is matched against, as IP addresses or
+	// CIDR ranges.
+	// +optional
+	IPBlocks []string `json:"ipBlocks,omitempty"`
+}
+
+// AuthorizationDestination matches the target of a request.
+type AuthorizationDestination struct {
+	// Hosts matched against the host header, e.g. "*.example.com".
+	// +kubebuilder:validation:MinItems=1
+	Hosts []string `json:"hosts"`
+
+	// Ports matched against the destination port.
+	// +kubebuilder:validation:MinItems=1
+	Ports []int64 `json:"ports"`
+
+	// Methods matched against the HTTP method, or against the method
+	// name of gRPC requests.
+	// +optional
+	Methods []string `json:"methods,omitempty"`
+
+	// HTTPHeaderMatch matches a header of the request.
+	// +optional
+	HTTPHeaderMatch *HTTPHeaderMatch `json:"httpHeaderMatch,omitempty"`
+}
+
+// HTTPHeaderMatch matches a header of a request against a regular
+// expression.
+type HTTPHeaderMatch struct {
+	// HeaderName of the matched header, e.g. "user-agent". The host is
+	// matched with ":authority".
+	HeaderName string `json:"headerName"`
+
+	// RegexMatch is the RE2 regular expression the value of the header
+	// must match.
+	RegexMatch string `json:"regexMatch"`
+}
+
+// AuthorizationPolicyObservation is used to show the observed state of the
+// AuthorizationPolicy.
+type AuthorizationPolicyObservation struct {
+	// Name is the resource name of the policy, e.g.
+	// "projects/my-project/locations/global/authorizationPolicies/my-policy".
+	// Target HTTPS proxies and endpoint policies refer to the policy by
+	// this name.
+	Name string `json:"name,omitempty"`
+
+	// CreateTime is the time the policy was created.
+	CreateTime string `json:"createTime,omitempty"`
+
+	// UpdateTime is the time the policy was last updated.
+	UpdateTime string `json:"updateTime,omitempty"`
+}
+
+// AuthorizationPolicySpec defines the desired state of an
+// AuthorizationPolicy.
+type AuthorizationPolicySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       AuthorizationPolicyParameters `json:"forProvider"`
+}
+
+// AuthorizationPolicyStatus represents the observed state of an
+// AuthorizationPolicy.
+type AuthorizationPolicyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          AuthorizationPolicyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AuthorizationPolicy is a managed resource that represents a Network
+// Security authorization policy. It allows or denies the requests that the
+// Traffic Director proxies and load balancers that use it receive.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="ACTION",type="string",JSONPath=".spec.forProvider.action"
+// +kubebuilder:printcolumn:name="NAME",type="string",JSONPath=".status.atProvider.name",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp},shortName=authzpolicy
+type AuthorizationPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AuthorizationPolicySpec   `json:"spec"`
+	Status AuthorizationPolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AuthorizationPolicyList contains a list of AuthorizationPolicy types
+type AuthorizationPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AuthorizationPolicy `json:"items"`
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// ClientTLSPolicyParameters defines parameters for a desired Network
+// Security ClientTlsPolicy.
+type ClientTLSPolicyParameters struct {
+	// Location the policy lives in. Policies used by Traffic Director are
+	// global.
+	// +optional
+	// +immutable
+	// +kubebuilder:default=global
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="location is immutable"
+	Location string `json:"location"`
+
+	// Description of the policy.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Labels to apply to the policy.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// SNI is the server name indication sent in the TLS handshake, e.g.
+	// "secure.example.com".
+	// +optional
+	SNI *string `json:"sni,omitempty"`
+
+	// ClientCertificate is where the client gets the certificate it
+	// presents to servers that require mTLS from.
+	// +optional
+	ClientCertificate *CertificateProvider `json:"clientCertificate,omitempty"`
+
+	// ServerValidationCA are the sources of the certificate authorities
+	// that server certificates are validated against. The server
+	// certificate is not validated if it is omitted.
+	// +optional
+	ServerValidationCA []CertificateProvider `json:"serverValidationCa,omitempty"`
+}
+
+// ClientTLSPolicyObservation is used to show the observed state of the
+// ClientTLSPolicy.
+type ClientTLSPolicyObservation struct {
+	// Name is the resource name of the policy, e.g.
+	// "projects/my-project/locations/global/clientTlsPolicies/my-policy".
+	// Backend services refer to the policy by this name.
+	Name string `json:"name,omitempty"`
+
+	// CreateTime is the time the policy was created.
+	CreateTime string `json:"createTime,omitempty"`
+
+	// UpdateTime is the time the policy was last updated.
+	UpdateTime string `json:"updateTime,omitempty"`
+}
+
+// ClientTLSPolicySpec defines the desired state of a ClientTLSPolicy.
+type ClientTLSPolicySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ClientTLSPolicyParameters `json:"forProvider"`
+}
+
+// ClientTLSPolicyStatus represents the observed state of a ClientTLSPolicy.
+type ClientTLSPolicyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ClientTLSPolicyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ClientTLSPolicy is a managed resource that represents a Network Security
+// client TLS policy. It specifies how the Traffic Director proxies that
+// connect to a backend service establish TLS and mTLS connections.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="NAME",type="string",JSONPath=".status.atProvider.name",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp},shortName=clienttls
+type ClientTLSPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ClientTLSPolicySpec   `json:"spec"`
+	Status ClientTLSPolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ClientTLSPolicyList contains a list of ClientTLSPolicy types
+type ClientTLSPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClientTLSPolicy `json:"items"`
+}
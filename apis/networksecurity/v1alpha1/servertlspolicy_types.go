@@ -0,0 +1,156 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// CertificateProvider specifies where the proxies get a certificate or
+// the certificate authorities used to validate a peer's certificate from.
+// +kubebuilder:validation:XValidation:rule="has(self.certificateProviderInstance) != has(self.grpcEndpoint)",message="exactly one of certificateProviderInstance and grpcEndpoint must be set"
+type CertificateProvider struct {
+	// CertificateProviderInstance is a certificate provider plugin
+	// instance the proxies are configured with. Used by proxyless gRPC
+	// clients and servers.
+	// +optional
+	CertificateProviderInstance *CertificateProviderInstance `json:"certificateProviderInstance,omitempty"`
+
+	// GRPCEndpoint is a gRPC endpoint, such as a local Unix domain socket,
+	// that serves certificates. Used by Envoy proxies.
+	// +optional
+	GRPCEndpoint *GRPCEndpoint `json:"grpcEndpoint,omitempty"`
+}
+
+// CertificateProviderInstance is a certificate provider plugin instance.
+type CertificateProviderInstance struct {
+	// PluginInstance is the name of the plugin instance, e.g.
+	// "google_cloud_private_spiffe", which uses the certificates of GKE
+	// workload identity.
+	PluginInstance string `json:"pluginInstance"`
+}
+
+// GRPCEndpoint is a gRPC endpoint that serves certificates.
+type GRPCEndpoint struct {
+	// TargetURI of the endpoint, which starts with "unix:" for Unix domain
+	// sockets.
+	TargetURI string `json:"targetUri"`
+}
+
+// MTLSPolicy specifies how a server validates the certificates of its
+// clients.
+type MTLSPolicy struct {
+	// ClientValidationCA are the sources of the certificate authorities
+	// that client certificates are validated against.
+	// +kubebuilder:validation:MinItems=1
+	ClientValidationCA []CertificateProvider `json:"clientValidationCa"`
+}
+
+// ServerTLSPolicyParameters defines parameters for a desired Network
+// Security ServerTlsPolicy.
+type ServerTLSPolicyParameters struct {
+	// Location the policy lives in. Policies used by Traffic Director are
+	// global.
+	// +optional
+	// +immutable
+	// +kubebuilder:default=global
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="location is immutable"
+	Location string `json:"location"`
+
+	// Description of the policy.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Labels to apply to the policy.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// AllowOpen allows plaintext connections in addition to TLS or mTLS
+	// ones. It is used to migrate to TLS.
+	// +optional
+	AllowOpen *bool `json:"allowOpen,omitempty"`
+
+	// ServerCertificate is where the server gets its certificate from. If
+	// it is omitted, only plaintext connections are allowed, which
+	// requires AllowOpen.
+	// +optional
+	ServerCertificate *CertificateProvider `json:"serverCertificate,omitempty"`
+
+	// MTLSPolicy requires clients to present a certificate that is
+	// validated against the configured certificate authorities. Requires
+	// ServerCertificate.
+	// +optional
+	MTLSPolicy *MTLSPolicy `json:"mtlsPolicy,omitempty"`
+}
+
+// ServerTLSPolicyObservation is used to show the observed state of the
+// ServerTLSPolicy.
+type ServerTLSPolicyObservation struct {
+	// Name is the resource name of the policy, e.g.
+	// "projects/my-project/locations/global/serverTlsPolicies/my-policy".
+	// Gateways and endpoint policies refer to the policy by this name.
+	Name string `json:"name,omitempty"`
+
+	// CreateTime is the time the policy was created.
+	CreateTime string `json:"createTime,omitempty"`
+
+	// UpdateTime is the time the policy was last updated.
+	UpdateTime string `json:"updateTime,omitempty"`
+}
+
+// ServerTLSPolicySpec defines the desired state of a ServerTLSPolicy.
+type ServerTLSPolicySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ServerTLSPolicyParameters `json:"forProvider"`
+}
+
+// ServerTLSPolicyStatus represents the observed state of a ServerTLSPolicy.
+type ServerTLSPolicyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ServerTLSPolicyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ServerTLSPolicy is a managed resource that represents a Network Security
+// server TLS policy. It specifies how the Traffic Director proxies and
+// gateways that use it terminate TLS and mTLS connections.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="NAME",type="string",JSONPath=".status.atProvider.name",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp},shortName=servertls
+type ServerTLSPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ServerTLSPolicySpec   `json:"spec"`
+	Status ServerTLSPolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ServerTLSPolicyList contains a list of ServerTLSPolicy types
+type ServerTLSPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ServerTLSPolicy `json:"items"`
+}
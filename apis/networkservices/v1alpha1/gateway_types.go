@@ -0,0 +1,136 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// GatewayParameters defines parameters for a desired Network Services
+// Gateway.
+type GatewayParameters struct {
+	// Location the gateway lives in. Gateways of type OPEN_MESH are global.
+	// +optional
+	// +immutable
+	// +kubebuilder:default=global
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="location is immutable"
+	Location string `json:"location"`
+
+	// Description of the gateway.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Labels to apply to the gateway.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Type of the gateway. OPEN_MESH gateways are served by Envoy proxies
+	// deployed by the user, SECURE_WEB_GATEWAY gateways by Google.
+	// +immutable
+	// +kubebuilder:validation:Enum=OPEN_MESH;SECURE_WEB_GATEWAY
+	Type string `json:"type"`
+
+	// Ports the gateway listens on. Only the first port is used by
+	// SECURE_WEB_GATEWAY gateways.
+	// +immutable
+	// +kubebuilder:validation:MinItems=1
+	Ports []int64 `json:"ports"`
+
+	// Scope selects the proxies that serve the gateway. Proxies deployed
+	// with the same scope share the configuration of all gateways in it.
+	// Required for OPEN_MESH gateways.
+	// +optional
+	// +immutable
+	Scope *string `json:"scope,omitempty"`
+
+	// ServerTLSPolicy is the resource name of a ServerTlsPolicy that
+	// terminates TLS on the gateway, e.g.
+	// "projects/my-project/locations/global/serverTlsPolicies/my-policy".
+	// +optional
+	ServerTLSPolicy *string `json:"serverTlsPolicy,omitempty"`
+
+	// ServerTLSPolicyRef references a ServerTLSPolicy to retrieve its
+	// resource name.
+	// +optional
+	ServerTLSPolicyRef *xpv1.Reference `json:"serverTlsPolicyRef,omitempty"`
+
+	// ServerTLSPolicySelector selects a reference to a ServerTLSPolicy to
+	// retrieve its resource name.
+	// +optional
+	ServerTLSPolicySelector *xpv1.Selector `json:"serverTlsPolicySelector,omitempty"`
+}
+
+// GatewayObservation is used to show the observed state of the Gateway.
+type GatewayObservation struct {
+	// Name is the resource name of the gateway, e.g.
+	// "projects/my-project/locations/global/gateways/my-gateway". Routes
+	// refer to the gateway by this name.
+	Name string `json:"name,omitempty"`
+
+	// SelfLink is the server-defined URL of the gateway.
+	SelfLink string `json:"selfLink,omitempty"`
+
+	// CreateTime is the time the gateway was created.
+	CreateTime string `json:"createTime,omitempty"`
+
+	// UpdateTime is the time the gateway was last updated.
+	UpdateTime string `json:"updateTime,omitempty"`
+}
+
+// GatewaySpec defines the desired state of a Gateway.
+type GatewaySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       GatewayParameters `json:"forProvider"`
+}
+
+// GatewayStatus represents the observed state of a Gateway.
+type GatewayStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          GatewayObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// Gateway is a managed resource that represents a Traffic Director gateway,
+// the ingress of traffic from outside a mesh. HTTPRoutes and GRPCRoutes that
+// name the gateway configure the proxies serving it.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".spec.forProvider.type"
+// +kubebuilder:printcolumn:name="NAME",type="string",JSONPath=".status.atProvider.name",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp},shortName=nsgateway
+type Gateway struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   GatewaySpec   `json:"spec"`
+	Status GatewayStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// GatewayList contains a list of Gateway types
+type GatewayList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Gateway `json:"items"`
+}
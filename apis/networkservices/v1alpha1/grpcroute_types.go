@@ -0,0 +1,224 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// GRPCRouteParameters defines parameters for a desired Network Services
+// GRPCRoute.
+type GRPCRouteParameters struct {
+	// Location the route lives in. Routes are global.
+	// +optional
+	// +immutable
+	// +kubebuilder:default=global
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="location is immutable"
+	Location string `json:"location"`
+
+	// Description of the route.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Labels to apply to the route.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Hostnames the route matches against the authority of a request,
+	// e.g. "example.com" or "*.example.com".
+	// +kubebuilder:validation:MinItems=1
+	Hostnames []string `json:"hostnames"`
+
+	// Meshes are the resource names of the meshes the route is attached
+	// to, e.g. "projects/my-project/locations/global/meshes/my-mesh".
+	// +optional
+	Meshes []string `json:"meshes,omitempty"`
+
+	// MeshesRefs references Meshes and retrieves their resource names.
+	// +optional
+	MeshesRefs []xpv1.Reference `json:"meshesRefs,omitempty"`
+
+	// MeshesSelector selects references to Meshes.
+	// +optional
+	MeshesSelector *xpv1.Selector `json:"meshesSelector,omitempty"`
+
+	// Gateways are the resource names of the gateways the route is
+	// attached to, e.g.
+	// "projects/my-project/locations/global/gateways/my-gateway".
+	// +optional
+	Gateways []string `json:"gateways,omitempty"`
+
+	// GatewaysRefs references Gateways and retrieves their resource names.
+	// +optional
+	GatewaysRefs []xpv1.Reference `json:"gatewaysRefs,omitempty"`
+
+	// GatewaysSelector selects references to Gateways.
+	// +optional
+	GatewaysSelector *xpv1.Selector `json:"gatewaysSelector,omitempty"`
+
+	// Rules that define how traffic is routed. The first rule whose
+	// matches match a request is applied.
+	// +kubebuilder:validation:MinItems=1
+	Rules []GRPCRouteRule `json:"rules"`
+}
+
+// GRPCRouteRule routes the requests that match any of its matches.
+type GRPCRouteRule struct {
+	// Matches a request must satisfy, any of which suffices. A rule
+	// without matches applies to all requests.
+	// +optional
+	Matches []GRPCRouteMatch `json:"matches,omitempty"`
+
+	// Action applied to the matching requests.
+	Action GRPCRouteAction `json:"action"`
+}
+
+// GRPCRouteMatch matches a request by its method and headers.
+type GRPCRouteMatch struct {
+	// Method the request calls.
+	// +optional
+	Method *GRPCRouteMethodMatch `json:"method,omitempty"`
+
+	// Headers the request must carry, all of which must match.
+	// +optional
+	Headers []GRPCRouteHeaderMatch `json:"headers,omitempty"`
+}
+
+// GRPCRouteMethodMatch matches the service and method a request calls.
+type GRPCRouteMethodMatch struct {
+	// Type of the match. The API defaults it to EXACT.
+	// +optional
+	// +kubebuilder:validation:Enum=EXACT;REGULAR_EXPRESSION
+	Type string `json:"type,omitempty"`
+
+	// GRPCService is the name of the service to match, e.g.
+	// "helloworld.Greeter".
+	GRPCService string `json:"grpcService"`
+
+	// GRPCMethod is the name of the method to match, e.g. "SayHello".
+	GRPCMethod string `json:"grpcMethod"`
+
+	// CaseSensitive makes the match case sensitive. The API defaults it to
+	// true.
+	// +optional
+	CaseSensitive *bool `json:"caseSensitive,omitempty"`
+}
+
+// GRPCRouteHeaderMatch matches a request header.
+type GRPCRouteHeaderMatch struct {
+	// Type of the match. The API defaults it to EXACT.
+	// +optional
+	// +kubebuilder:validation:Enum=EXACT;REGULAR_EXPRESSION
+	Type string `json:"type,omitempty"`
+
+	// Key is the name of the header to match.
+	Key string `json:"key"`
+
+	// Value the header must have.
+	Value string `json:"value"`
+}
+
+// GRPCRouteAction is applied to the requests matched by a rule.
+type GRPCRouteAction struct {
+	// Destinations the requests are sent to, weighted by their weights.
+	// +optional
+	Destinations []RouteDestination `json:"destinations,omitempty"`
+
+	// FaultInjectionPolicy injects faults into a share of the requests to
+	// test the resilience of clients.
+	// +optional
+	FaultInjectionPolicy *FaultInjectionPolicy `json:"faultInjectionPolicy,omitempty"`
+
+	// Timeout of a request, e.g. "30s".
+	// +optional
+	Timeout string `json:"timeout,omitempty"`
+
+	// RetryPolicy retries failed requests.
+	// +optional
+	RetryPolicy *GRPCRouteRetryPolicy `json:"retryPolicy,omitempty"`
+}
+
+// GRPCRouteRetryPolicy retries failed requests.
+type GRPCRouteRetryPolicy struct {
+	// RetryConditions under which a request is retried, e.g.
+	// "unavailable" or "resource-exhausted".
+	// +optional
+	RetryConditions []string `json:"retryConditions,omitempty"`
+
+	// NumRetries is the number of times a request is retried.
+	// +optional
+	NumRetries int64 `json:"numRetries,omitempty"`
+}
+
+// GRPCRouteObservation is used to show the observed state of the GRPCRoute.
+type GRPCRouteObservation struct {
+	// Name is the resource name of the route, e.g.
+	// "projects/my-project/locations/global/grpcRoutes/my-route".
+	Name string `json:"name,omitempty"`
+
+	// SelfLink is the server-defined URL of the route.
+	SelfLink string `json:"selfLink,omitempty"`
+
+	// CreateTime is the time the route was created.
+	CreateTime string `json:"createTime,omitempty"`
+
+	// UpdateTime is the time the route was last updated.
+	UpdateTime string `json:"updateTime,omitempty"`
+}
+
+// GRPCRouteSpec defines the desired state of a GRPCRoute.
+type GRPCRouteSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       GRPCRouteParameters `json:"forProvider"`
+}
+
+// GRPCRouteStatus represents the observed state of a GRPCRoute.
+type GRPCRouteStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          GRPCRouteObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// GRPCRoute is a managed resource that represents a Traffic Director gRPC
+// route, which routes gRPC traffic of the meshes and gateways it is attached
+// to.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="NAME",type="string",JSONPath=".status.atProvider.name",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp},shortName=nsgrpcroute
+type GRPCRoute struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   GRPCRouteSpec   `json:"spec"`
+	Status GRPCRouteStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// GRPCRouteList contains a list of GRPCRoute types
+type GRPCRouteList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GRPCRoute `json:"items"`
+}
@@ -0,0 +1,460 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// HTTPRouteParameters defines parameters for a desired Network Services
+// HTTPRoute.
+type HTTPRouteParameters struct {
+	// Location the route lives in. Routes are global.
+	// +optional
+	// +immutable
+	// +kubebuilder:default=global
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="location is immutable"
+	Location string `json:"location"`
+
+	// Description of the route.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Labels to apply to the route.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Hostnames the route matches against the host header of a request,
+	// e.g. "example.com" or "*.example.com".
+	// +kubebuilder:validation:MinItems=1
+	Hostnames []string `json:"hostnames"`
+
+	// Meshes are the resource names of the meshes the route is attached
+	// to, e.g. "projects/my-project/locations/global/meshes/my-mesh".
+	// +optional
+	Meshes []string `json:"meshes,omitempty"`
+
+	// MeshesRefs references Meshes and retrieves their resource names.
+	// +optional
+	MeshesRefs []xpv1.Reference `json:"meshesRefs,omitempty"`
+
+	// MeshesSelector selects references to Meshes.
+	// +optional
+	MeshesSelector *xpv1.Selector `json:"meshesSelector,omitempty"`
+
+	// Gateways are the resource names of the gateways the route is
+	// attached to, e.g.
+	// "projects/my-project/locations/global/gateways/my-gateway".
+	// +optional
+	Gateways []string `json:"gateways,omitempty"`
+
+	// GatewaysRefs references Gateways and retrieves their resource names.
+	// +optional
+	GatewaysRefs []xpv1.Reference `json:"gatewaysRefs,omitempty"`
+
+	// GatewaysSelector selects references to Gateways.
+	// +optional
+	GatewaysSelector *xpv1.Selector `json:"gatewaysSelector,omitempty"`
+
+	// Rules that define how traffic is routed. The first rule whose
+	// matches match a request is applied.
+	// +kubebuilder:validation:MinItems=1
+	Rules []HTTPRouteRule `json:"rules"`
+}
+
+// HTTPRouteRule routes the requests that match any of its matches.
+type HTTPRouteRule struct {
+	// Matches a request must satisfy, any of which suffices. A rule
+	// without matches applies to all requests.
+	// +optional
+	Matches []HTTPRouteMatch `json:"matches,omitempty"`
+
+	// Action applied to the matching requests.
+	Action HTTPRouteAction `json:"action"`
+}
+
+// HTTPRouteMatch matches a request by its path, headers and query
+// parameters. Only one of FullPathMatch, PrefixMatch and RegexMatch may be
+// set.
+type HTTPRouteMatch struct {
+	// FullPathMatch matches requests whose path equals the value.
+	// +optional
+	FullPathMatch string `json:"fullPathMatch,omitempty"`
+
+	// PrefixMatch matches requests whose path starts with the value.
+	// +optional
+	PrefixMatch string `json:"prefixMatch,omitempty"`
+
+	// RegexMatch matches requests whose path matches the RE2 regular
+	// expression.
+	// +optional
+	RegexMatch string `json:"regexMatch,omitempty"`
+
+	// IgnoreCase makes FullPathMatch and PrefixMatch case insensitive.
+	// +optional
+	IgnoreCase bool `json:"ignoreCase,omitempty"`
+
+	// Headers the request must carry, all of which must match.
+	// +optional
+	Headers []HTTPRouteHeaderMatch `json:"headers,omitempty"`
+
+	// QueryParameters the request must carry, all of which must match.
+	// +optional
+	QueryParameters []HTTPRouteQueryParameterMatch `json:"queryParameters,omitempty"`
+}
+
+// HTTPRouteHeaderMatch matches a request header. Only one of the match
+// fields may be set.
+type HTTPRouteHeaderMatch struct {
+	// Header is the name of the header to match.
+	Header string `json:"header"`
+
+	// ExactMatch matches a header whose value equals the value.
+	// +optional
+	ExactMatch string `json:"exactMatch,omitempty"`
+
+	// PrefixMatch matches a header whose value starts with the value.
+	// +optional
+	PrefixMatch string `json:"prefixMatch,omitempty"`
+
+	// SuffixMatch matches a header whose value ends with the value.
+	// +optional
+	SuffixMatch string `json:"suffixMatch,omitempty"`
+
+	// RegexMatch matches a header whose value matches the RE2 regular
+	// expression.
+	// +optional
+	RegexMatch string `json:"regexMatch,omitempty"`
+
+	// PresentMatch matches a header that is present, whatever its value.
+	// +optional
+	PresentMatch bool `json:"presentMatch,omitempty"`
+
+	// RangeMatch matches a header whose integer value is in the range.
+	// +optional
+	RangeMatch *HTTPRouteIntegerRange `json:"rangeMatch,omitempty"`
+
+	// InvertMatch inverts the result of the match.
+	// +optional
+	InvertMatch bool `json:"invertMatch,omitempty"`
+}
+
+// HTTPRouteIntegerRange is a range of integers, including Start and
+// excluding End.
+type HTTPRouteIntegerRange struct {
+	// Start of the range, inclusive.
+	Start int64 `json:"start"`
+
+	// End of the range, exclusive.
+	End int64 `json:"end"`
+}
+
+// HTTPRouteQueryParameterMatch matches a query parameter. Only one of the
+// match fields may be set.
+type HTTPRouteQueryParameterMatch struct {
+	// QueryParameter is the name of the query parameter to match.
+	QueryParameter string `json:"queryParameter"`
+
+	// ExactMatch matches a parameter whose value equals the value.
+	// +optional
+	ExactMatch string `json:"exactMatch,omitempty"`
+
+	// RegexMatch matches a parameter whose value matches the RE2 regular
+	// expression.
+	// +optional
+	RegexMatch string `json:"regexMatch,omitempty"`
+
+	// PresentMatch matches a parameter that is present, whatever its
+	// value.
+	// +optional
+	PresentMatch bool `json:"presentMatch,omitempty"`
+}
+
+// HTTPRouteAction is applied to the requests matched by a rule.
+type HTTPRouteAction struct {
+	// Destinations the requests are sent to, weighted by their weights.
+	// +optional
+	Destinations []RouteDestination `json:"destinations,omitempty"`
+
+	// Redirect answers the requests with a redirect instead of sending
+	// them to a destination.
+	// +optional
+	Redirect *HTTPRouteRedirect `json:"redirect,omitempty"`
+
+	// FaultInjectionPolicy injects faults into a share of the requests to
+	// test the resilience of clients.
+	// +optional
+	FaultInjectionPolicy *FaultInjectionPolicy `json:"faultInjectionPolicy,omitempty"`
+
+	// RequestHeaderModifier modifies the headers of the requests.
+	// +optional
+	RequestHeaderModifier *HTTPRouteHeaderModifier `json:"requestHeaderModifier,omitempty"`
+
+	// ResponseHeaderModifier modifies the headers of the responses.
+	// +optional
+	ResponseHeaderModifier *HTTPRouteHeaderModifier `json:"responseHeaderModifier,omitempty"`
+
+	// URLRewrite rewrites the host and path of the requests before they are
+	// sent to a destination.
+	// +optional
+	URLRewrite *HTTPRouteURLRewrite `json:"urlRewrite,omitempty"`
+
+	// Timeout of a request, e.g. "30s".
+	// +optional
+	Timeout string `json:"timeout,omitempty"`
+
+	// RetryPolicy retries failed requests.
+	// +optional
+	RetryPolicy *HTTPRouteRetryPolicy `json:"retryPolicy,omitempty"`
+
+	// RequestMirrorPolicy sends a copy of the requests to another
+	// destination, ignoring its responses.
+	// +optional
+	RequestMirrorPolicy *HTTPRouteRequestMirrorPolicy `json:"requestMirrorPolicy,omitempty"`
+
+	// CORSPolicy configures cross origin resource sharing.
+	// +optional
+	CORSPolicy *HTTPRouteCORSPolicy `json:"corsPolicy,omitempty"`
+}
+
+// RouteDestination is a backend service that receives routed traffic.
+type RouteDestination struct {
+	// ServiceName is the URL of the backend service, e.g.
+	// "projects/my-project/locations/global/backendServices/my-service".
+	ServiceName string `json:"serviceName"`
+
+	// Weight of the destination relative to the other destinations of the
+	// action. Traffic is split evenly if no weights are set.
+	// +optional
+	Weight int64 `json:"weight,omitempty"`
+}
+
+// FaultInjectionPolicy injects faults into a share of the requests.
+type FaultInjectionPolicy struct {
+	// Delay delays a share of the requests.
+	// +optional
+	Delay *FaultDelay `json:"delay,omitempty"`
+
+	// Abort aborts a share of the requests.
+	// +optional
+	Abort *FaultAbort `json:"abort,omitempty"`
+}
+
+// FaultDelay delays a share of the requests.
+type FaultDelay struct {
+	// FixedDelay the requests are delayed by, e.g. "2s".
+	FixedDelay string `json:"fixedDelay"`
+
+	// Percentage of requests to delay.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	Percentage int64 `json:"percentage"`
+}
+
+// FaultAbort aborts a share of the requests.
+type FaultAbort struct {
+	// HTTPStatus the aborted requests are answered with.
+	HTTPStatus int64 `json:"httpStatus"`
+
+	// Percentage of requests to abort.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	Percentage int64 `json:"percentage"`
+}
+
+// HTTPRouteRedirect answers requests with a redirect.
+type HTTPRouteRedirect struct {
+	// HostRedirect replaces the host of the redirect URL.
+	// +optional
+	HostRedirect string `json:"hostRedirect,omitempty"`
+
+	// PathRedirect replaces the path of the redirect URL. Only one of
+	// PathRedirect and PrefixRewrite may be set.
+	// +optional
+	PathRedirect string `json:"pathRedirect,omitempty"`
+
+	// PrefixRewrite replaces the matched prefix of the path of the redirect
+	// URL.
+	// +optional
+	PrefixRewrite string `json:"prefixRewrite,omitempty"`
+
+	// PortRedirect replaces the port of the redirect URL.
+	// +optional
+	PortRedirect int64 `json:"portRedirect,omitempty"`
+
+	// HTTPSRedirect sets the scheme of the redirect URL to https.
+	// +optional
+	HTTPSRedirect bool `json:"httpsRedirect,omitempty"`
+
+	// StripQuery removes the query of the redirect URL.
+	// +optional
+	StripQuery bool `json:"stripQuery,omitempty"`
+
+	// ResponseCode of the redirect. The API defaults it to
+	// MOVED_PERMANENTLY_DEFAULT.
+	// +optional
+	// +kubebuilder:validation:Enum=MOVED_PERMANENTLY_DEFAULT;FOUND;SEE_OTHER;TEMPORARY_REDIRECT;PERMANENT_REDIRECT
+	ResponseCode string `json:"responseCode,omitempty"`
+}
+
+// HTTPRouteHeaderModifier adds, sets and removes headers.
+type HTTPRouteHeaderModifier struct {
+	// Add the headers, keeping any existing values.
+	// +optional
+	Add map[string]string `json:"add,omitempty"`
+
+	// Set the headers, replacing any existing values.
+	// +optional
+	Set map[string]string `json:"set,omitempty"`
+
+	// Remove the named headers.
+	// +optional
+	Remove []string `json:"remove,omitempty"`
+}
+
+// HTTPRouteURLRewrite rewrites the host and path of a request.
+type HTTPRouteURLRewrite struct {
+	// HostRewrite replaces the host header.
+	// +optional
+	HostRewrite string `json:"hostRewrite,omitempty"`
+
+	// PathPrefixRewrite replaces the matched prefix of the path.
+	// +optional
+	PathPrefixRewrite string `json:"pathPrefixRewrite,omitempty"`
+}
+
+// HTTPRouteRetryPolicy retries failed requests.
+type HTTPRouteRetryPolicy struct {
+	// RetryConditions under which a request is retried, e.g. "5xx",
+	// "gateway-error" or "connect-failure".
+	// +optional
+	RetryConditions []string `json:"retryConditions,omitempty"`
+
+	// NumRetries is the number of times a request is retried.
+	// +optional
+	NumRetries int64 `json:"numRetries,omitempty"`
+
+	// PerTryTimeout is the timeout of each attempt, e.g. "5s".
+	// +optional
+	PerTryTimeout string `json:"perTryTimeout,omitempty"`
+}
+
+// HTTPRouteRequestMirrorPolicy sends a copy of the requests to a
+// destination.
+type HTTPRouteRequestMirrorPolicy struct {
+	// Destination the copies are sent to. Its weight is ignored.
+	Destination RouteDestination `json:"destination"`
+}
+
+// HTTPRouteCORSPolicy configures cross origin resource sharing.
+type HTTPRouteCORSPolicy struct {
+	// AllowOrigins that may make cross origin requests.
+	// +optional
+	AllowOrigins []string `json:"allowOrigins,omitempty"`
+
+	// AllowOriginRegexes match the origins that may make cross origin
+	// requests.
+	// +optional
+	AllowOriginRegexes []string `json:"allowOriginRegexes,omitempty"`
+
+	// AllowMethods is the content of the Access-Control-Allow-Methods
+	// header.
+	// +optional
+	AllowMethods []string `json:"allowMethods,omitempty"`
+
+	// AllowHeaders is the content of the Access-Control-Allow-Headers
+	// header.
+	// +optional
+	AllowHeaders []string `json:"allowHeaders,omitempty"`
+
+	// ExposeHeaders is the content of the Access-Control-Expose-Headers
+	// header.
+	// +optional
+	ExposeHeaders []string `json:"exposeHeaders,omitempty"`
+
+	// MaxAge is how long the result of a preflight request can be cached,
+	// e.g. "3600s".
+	// +optional
+	MaxAge string `json:"maxAge,omitempty"`
+
+	// AllowCredentials sets the Access-Control-Allow-Credentials header.
+	// +optional
+	AllowCredentials bool `json:"allowCredentials,omitempty"`
+
+	// Disabled turns the policy off.
+	// +optional
+	Disabled bool `json:"disabled,omitempty"`
+}
+
+// HTTPRouteObservation is used to show the observed state of the HTTPRoute.
+type HTTPRouteObservation struct {
+	// Name is the resource name of the route, e.g.
+	// "projects/my-project/locations/global/httpRoutes/my-route".
+	Name string `json:"name,omitempty"`
+
+	// SelfLink is the server-defined URL of the route.
+	SelfLink string `json:"selfLink,omitempty"`
+
+	// CreateTime is the time the route was created.
+	CreateTime string `json:"createTime,omitempty"`
+
+	// UpdateTime is the time the route was last updated.
+	UpdateTime string `json:"updateTime,omitempty"`
+}
+
+// HTTPRouteSpec defines the desired state of an HTTPRoute.
+type HTTPRouteSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       HTTPRouteParameters `json:"forProvider"`
+}
+
+// HTTPRouteStatus represents the observed state of an HTTPRoute.
+type HTTPRouteStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          HTTPRouteObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// HTTPRoute is a managed resource that represents a Traffic Director HTTP
+// route, which routes HTTP traffic of the meshes and gateways it is attached
+// to.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="NAME",type="string",JSONPath=".status.atProvider.name",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp},shortName=nshttproute
+type HTTPRoute struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   HTTPRouteSpec   `json:"spec"`
+	Status HTTPRouteStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// HTTPRouteList contains a list of HTTPRoute types
+type HTTPRouteList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []HTTPRoute `json:"items"`
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// MeshParameters defines parameters for a desired Network Services Mesh.
+type MeshParameters struct {
+	// Location the mesh lives in. Meshes are global.
+	// +optional
+	// +immutable
+	// +kubebuilder:default=global
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="location is immutable"
+	Location string `json:"location"`
+
+	// Description of the mesh.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Labels to apply to the mesh.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// InterceptionPort is the port the sidecar proxies listen on for
+	// intercepted outbound traffic. The API defaults it to 15001.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	InterceptionPort *int64 `json:"interceptionPort,omitempty"`
+}
+
+// MeshObservation is used to show the observed state of the Mesh.
+type MeshObservation struct {
+	// Name is the resource name of the mesh, e.g.
+	// "projects/my-project/locations/global/meshes/my-mesh". Routes refer to
+	// the mesh by this name.
+	Name string `json:"name,omitempty"`
+
+	// SelfLink is the server-defined URL of the mesh.
+	SelfLink string `json:"selfLink,omitempty"`
+
+	// CreateTime is the time the mesh was created.
+	CreateTime string `json:"createTime,omitempty"`
+
+	// UpdateTime is the time the mesh was last updated.
+	UpdateTime string `json:"updateTime,omitempty"`
+}
+
+// MeshSpec defines the desired state of a Mesh.
+type MeshSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       MeshParameters `json:"forProvider"`
+}
+
+// MeshStatus represents the observed state of a Mesh.
+type MeshStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          MeshObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// Mesh is a managed resource that represents a Traffic Director service
+// mesh. HTTPRoutes and GRPCRoutes that name the mesh configure the sidecar
+// proxies and proxyless gRPC clients in it.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="NAME",type="string",JSONPath=".status.atProvider.name",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp},shortName=nsmesh
+type Mesh struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   MeshSpec   `json:"spec"`
+	Status MeshStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// MeshList contains a list of Mesh types
+type MeshList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Mesh `json:"items"`
+}
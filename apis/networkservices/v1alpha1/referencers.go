@@ -0,0 +1,142 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	networksecurityv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/networksecurity/v1alpha1"
+)
+
+// MeshName extracts the resource name of a Mesh.
+func MeshName() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		m, ok := mg.(*Mesh)
+		if !ok {
+			return ""
+		}
+		return m.Status.AtProvider.Name
+	}
+}
+
+// GatewayName extracts the resource name of a Gateway.
+func GatewayName() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		g, ok := mg.(*Gateway)
+		if !ok {
+			return ""
+		}
+		return g.Status.AtProvider.Name
+	}
+}
+
+// ResolveReferences of this HTTPRoute
+func (mg *HTTPRoute) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.meshes
+	rsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.Meshes,
+		References:    mg.Spec.ForProvider.MeshesRefs,
+		Selector:      mg.Spec.ForProvider.MeshesSelector,
+		To:            reference.To{Managed: &Mesh{}, List: &MeshList{}},
+		Extract:       MeshName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.meshes")
+	}
+	mg.Spec.ForProvider.Meshes = rsp.ResolvedValues
+	mg.Spec.ForProvider.MeshesRefs = rsp.ResolvedReferences
+
+	// Resolve spec.forProvider.gateways
+	rsp, err = r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.Gateways,
+		References:    mg.Spec.ForProvider.GatewaysRefs,
+		Selector:      mg.Spec.ForProvider.GatewaysSelector,
+		To:            reference.To{Managed: &Gateway{}, List: &GatewayList{}},
+		Extract:       GatewayName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.gateways")
+	}
+	mg.Spec.ForProvider.Gateways = rsp.ResolvedValues
+	mg.Spec.ForProvider.GatewaysRefs = rsp.ResolvedReferences
+
+	return nil
+}
+
+// ResolveReferences of this GRPCRoute
+func (mg *GRPCRoute) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.meshes
+	rsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.Meshes,
+		References:    mg.Spec.ForProvider.MeshesRefs,
+		Selector:      mg.Spec.ForProvider.MeshesSelector,
+		To:            reference.To{Managed: &Mesh{}, List: &MeshList{}},
+		Extract:       MeshName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.meshes")
+	}
+	mg.Spec.ForProvider.Meshes = rsp.ResolvedValues
+	mg.Spec.ForProvider.MeshesRefs = rsp.ResolvedReferences
+
+	// Resolve spec.forProvider.gateways
+	rsp, err = r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.Gateways,
+		References:    mg.Spec.ForProvider.GatewaysRefs,
+		Selector:      mg.Spec.ForProvider.GatewaysSelector,
+		To:            reference.To{Managed: &Gateway{}, List: &GatewayList{}},
+		Extract:       GatewayName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.gateways")
+	}
+	mg.Spec.ForProvider.Gateways = rsp.ResolvedValues
+	mg.Spec.ForProvider.GatewaysRefs = rsp.ResolvedReferences
+
+	return nil
+}
+
+// ResolveReferences of this Gateway
+func (mg *Gateway) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.serverTlsPolicy
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.ServerTLSPolicy),
+		Reference:    mg.Spec.ForProvider.ServerTLSPolicyRef,
+		Selector:     mg.Spec.ForProvider.ServerTLSPolicySelector,
+		To:           reference.To{Managed: &networksecurityv1alpha1.ServerTLSPolicy{}, List: &networksecurityv1alpha1.ServerTLSPolicyList{}},
+		Extract:      networksecurityv1alpha1.ServerTLSPolicyName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.serverTlsPolicy")
+	}
+	mg.Spec.ForProvider.ServerTLSPolicy = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.ServerTLSPolicyRef = rsp.ResolvedReference
+
+	return nil
+}
@@ -0,0 +1,237 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// GuestPolicyParameters defines parameters for a desired OS Config
+// GuestPolicy.
+type GuestPolicyParameters struct {
+	// Description of the guest policy. Length of the description is limited
+	// to 1024 characters.
+	// +optional
+	// +kubebuilder:validation:MaxLength=1024
+	Description *string `json:"description,omitempty"`
+
+	// Assignment specifies the VM instances that are assigned to this
+	// policy.
+	Assignment Assignment `json:"assignment"`
+
+	// Packages is the list of packages to be managed on the assigned VM
+	// instances.
+	// +optional
+	Packages []Package `json:"packages,omitempty"`
+
+	// PackageRepositories is the list of package repositories to configure
+	// on the assigned VM instances.
+	// +optional
+	PackageRepositories []PackageRepository `json:"packageRepositories,omitempty"`
+}
+
+// Assignment specifies the VM instances a guest policy applies to. A VM
+// instance is assigned if it matches every non-empty criterion.
+type Assignment struct {
+	// GroupLabels targets VM instances matching at least one of these label
+	// sets.
+	// +optional
+	GroupLabels []GroupLabel `json:"groupLabels,omitempty"`
+
+	// Zones targets VM instances in any of these zones.
+	// +optional
+	Zones []string `json:"zones,omitempty"`
+
+	// Instances targets any of the VM instances specified, in the form
+	// "zones/{zone}/instances/{instance_name}".
+	// +optional
+	Instances []string `json:"instances,omitempty"`
+
+	// InstanceNamePrefixes targets VMs whose name starts with one of these
+	// prefixes.
+	// +optional
+	InstanceNamePrefixes []string `json:"instanceNamePrefixes,omitempty"`
+
+	// OsTypes targets VM instances matching at least one of these OS types.
+	// +optional
+	OsTypes []AssignmentOsType `json:"osTypes,omitempty"`
+}
+
+// AssignmentOsType defines the OS type a VM instance must have.
+type AssignmentOsType struct {
+	// OsShortName targets VM instances with this OS short name, e.g.
+	// "debian" or "windows".
+	// +optional
+	OsShortName *string `json:"osShortName,omitempty"`
+
+	// OsVersion targets VM instances with this OS version. A trailing "*"
+	// acts as a prefix match.
+	// +optional
+	OsVersion *string `json:"osVersion,omitempty"`
+
+	// OsArchitecture targets VM instances with this architecture, e.g.
+	// "x86_64".
+	// +optional
+	OsArchitecture *string `json:"osArchitecture,omitempty"`
+}
+
+// Package is a package managed by a guest policy.
+type Package struct {
+	// Name of the package.
+	Name string `json:"name"`
+
+	// DesiredState of the package.
+	// +optional
+	// +kubebuilder:validation:Enum=INSTALLED;UPDATED;REMOVED
+	DesiredState *string `json:"desiredState,omitempty"`
+
+	// Manager is the package manager to use. ANY uses the default package
+	// manager of the OS.
+	// +optional
+	// +kubebuilder:validation:Enum=ANY;APT;YUM;ZYPPER;GOO
+	Manager *string `json:"manager,omitempty"`
+}
+
+// PackageRepository is a package repository to configure. Exactly one of its
+// fields must be set.
+// +kubebuilder:validation:MaxProperties=1
+// +kubebuilder:validation:MinProperties=1
+type PackageRepository struct {
+	// Apt repository.
+	// +optional
+	Apt *AptRepository `json:"apt,omitempty"`
+
+	// Yum repository.
+	// +optional
+	Yum *YumRepository `json:"yum,omitempty"`
+
+	// Zypper repository.
+	// +optional
+	Zypper *YumRepository `json:"zypper,omitempty"`
+
+	// Goo repository.
+	// +optional
+	Goo *GooRepository `json:"goo,omitempty"`
+}
+
+// AptRepository is a single apt package repository.
+type AptRepository struct {
+	// ArchiveType of the repository. Defaults to DEB.
+	// +optional
+	// +kubebuilder:validation:Enum=DEB;DEB_SRC
+	ArchiveType *string `json:"archiveType,omitempty"`
+
+	// URI for this repository.
+	URI string `json:"uri"`
+
+	// Distribution of this repository.
+	Distribution string `json:"distribution"`
+
+	// Components is a list of components for this repository.
+	Components []string `json:"components"`
+
+	// GpgKey is the URI of the key file for this repository.
+	// +optional
+	GpgKey *string `json:"gpgKey,omitempty"`
+}
+
+// YumRepository is a single yum or zypper package repository.
+type YumRepository struct {
+	// ID is a one word, unique name for this repository.
+	ID string `json:"id"`
+
+	// DisplayName of the repository.
+	// +optional
+	DisplayName *string `json:"displayName,omitempty"`
+
+	// BaseURL is the location of the repository directory.
+	BaseURL string `json:"baseUrl"`
+
+	// GpgKeys are URIs of GPG keys.
+	// +optional
+	GpgKeys []string `json:"gpgKeys,omitempty"`
+}
+
+// GooRepository is a single goo package repository.
+type GooRepository struct {
+	// Name of the repository.
+	Name string `json:"name"`
+
+	// URL of the repository.
+	URL string `json:"url"`
+}
+
+// GuestPolicyObservation is used to show the observed state of the
+// GuestPolicy.
+type GuestPolicyObservation struct {
+	// Name is the resource name of the guest policy, e.g.
+	// "projects/my-project/guestPolicies/my-policy".
+	Name string `json:"name,omitempty"`
+
+	// CreateTime is the time the guest policy was created.
+	CreateTime string `json:"createTime,omitempty"`
+
+	// UpdateTime is the time the guest policy was last updated.
+	UpdateTime string `json:"updateTime,omitempty"`
+
+	// Etag is the etag of the guest policy, used for optimistic concurrency
+	// control on updates.
+	Etag string `json:"etag,omitempty"`
+}
+
+// GuestPolicySpec defines the desired state of a GuestPolicy.
+type GuestPolicySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       GuestPolicyParameters `json:"forProvider"`
+}
+
+// GuestPolicyStatus represents the observed state of a GuestPolicy.
+type GuestPolicyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          GuestPolicyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// GuestPolicy is a managed resource that represents an OS Config guest
+// policy, which manages packages and package repositories on the VM
+// instances it is assigned to.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="NAME",type="string",JSONPath=".status.atProvider.name",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp},shortName=guestpolicy
+type GuestPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   GuestPolicySpec   `json:"spec"`
+	Status GuestPolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// GuestPolicyList contains a list of GuestPolicy types
+type GuestPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GuestPolicy `json:"items"`
+}
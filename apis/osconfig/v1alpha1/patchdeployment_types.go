@@ -0,0 +1,417 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Patch deployment states.
+const (
+	PatchDeploymentStateActive = "ACTIVE"
+	PatchDeploymentStatePaused = "PAUSED"
+)
+
+// PatchDeploymentParameters defines parameters for a desired OS Config
+// PatchDeployment.
+// +kubebuilder:validation:XValidation:rule="has(self.oneTimeSchedule) != has(self.recurringSchedule)",message="exactly one of oneTimeSchedule and recurringSchedule must be set"
+type PatchDeploymentParameters struct {
+	// Description of the patch deployment. Length of the description is
+	// limited to 1024 characters.
+	// +optional
+	// +kubebuilder:validation:MaxLength=1024
+	Description *string `json:"description,omitempty"`
+
+	// InstanceFilter selects the VM instances to patch.
+	InstanceFilter PatchInstanceFilter `json:"instanceFilter"`
+
+	// PatchConfig is the patch configuration that is applied.
+	// +optional
+	PatchConfig *PatchConfig `json:"patchConfig,omitempty"`
+
+	// Duration of the patch. After the duration ends, the patch times out,
+	// e.g. "3600s".
+	// +optional
+	// +kubebuilder:validation:Pattern=`^[0-9]+(\.[0-9]{1,9})?s$`
+	Duration *string `json:"duration,omitempty"`
+
+	// OneTimeSchedule schedules the patch job to run once at the given time.
+	// +optional
+	// +immutable
+	OneTimeSchedule *OneTimeSchedule `json:"oneTimeSchedule,omitempty"`
+
+	// RecurringSchedule schedules the patch job to run on a recurring basis.
+	// +optional
+	RecurringSchedule *RecurringSchedule `json:"recurringSchedule,omitempty"`
+
+	// Rollout strategy of the patch job.
+	// +optional
+	Rollout *PatchRollout `json:"rollout,omitempty"`
+
+	// Paused pauses the patch deployment so that no new patch jobs are
+	// started until it is resumed by setting this field to false.
+	// +optional
+	Paused *bool `json:"paused,omitempty"`
+}
+
+// GroupLabel is a set of labels a VM instance must have all of to match.
+type GroupLabel struct {
+	// Labels that the VM instance must have to be targeted.
+	Labels map[string]string `json:"labels"`
+}
+
+// PatchInstanceFilter selects VM instances. A VM instance is targeted if it
+// matches every non-empty criterion.
+type PatchInstanceFilter struct {
+	// All targets all VM instances in the project. If true, no other
+	// criteria are permitted.
+	// +optional
+	All *bool `json:"all,omitempty"`
+
+	// GroupLabels targets VM instances matching at least one of these label
+	// sets.
+	// +optional
+	GroupLabels []GroupLabel `json:"groupLabels,omitempty"`
+
+	// Zones targets VM instances in any of these zones.
+	// +optional
+	Zones []string `json:"zones,omitempty"`
+
+	// Instances targets any of the VM instances specified, in the form
+	// "zones/{zone}/instances/{instance_name}" or a full URL.
+	// +optional
+	Instances []string `json:"instances,omitempty"`
+
+	// InstanceNamePrefixes targets VMs whose name starts with one of these
+	// prefixes.
+	// +optional
+	InstanceNamePrefixes []string `json:"instanceNamePrefixes,omitempty"`
+}
+
+// PatchConfig is the patch configuration specifications.
+type PatchConfig struct {
+	// RebootConfig sets the post-patch reboot behavior.
+	// +optional
+	// +kubebuilder:validation:Enum=DEFAULT;ALWAYS;NEVER
+	RebootConfig *string `json:"rebootConfig,omitempty"`
+
+	// Apt update settings. Use this setting to override the default apt
+	// patch rules.
+	// +optional
+	Apt *AptSettings `json:"apt,omitempty"`
+
+	// Yum update settings. Use this setting to override the default yum
+	// patch rules.
+	// +optional
+	Yum *YumSettings `json:"yum,omitempty"`
+
+	// Zypper update settings. Use this setting to override the default
+	// zypper patch rules.
+	// +optional
+	Zypper *ZypperSettings `json:"zypper,omitempty"`
+
+	// WindowsUpdate settings. Use this override the default Windows patch
+	// rules.
+	// +optional
+	WindowsUpdate *WindowsUpdateSettings `json:"windowsUpdate,omitempty"`
+
+	// MigInstancesAllowed allows the patch job to run on managed instance
+	// groups.
+	// +optional
+	MigInstancesAllowed *bool `json:"migInstancesAllowed,omitempty"`
+}
+
+// AptSettings control the apt patch rules.
+type AptSettings struct {
+	// Type of the upgrade to perform.
+	// +optional
+	// +kubebuilder:validation:Enum=DIST;UPGRADE
+	Type *string `json:"type,omitempty"`
+
+	// Excludes is a list of packages to exclude from update.
+	// +optional
+	Excludes []string `json:"excludes,omitempty"`
+
+	// ExclusivePackages is an exclusive list of packages to be updated.
+	// These are the only packages that will be updated.
+	// +optional
+	ExclusivePackages []string `json:"exclusivePackages,omitempty"`
+}
+
+// YumSettings control the yum patch rules.
+type YumSettings struct {
+	// Security adds the "--security" flag to yum update.
+	// +optional
+	Security *bool `json:"security,omitempty"`
+
+	// Minimal will cause the command to be "yum update-minimal" instead.
+	// +optional
+	Minimal *bool `json:"minimal,omitempty"`
+
+	// Excludes is a list of packages to exclude from update.
+	// +optional
+	Excludes []string `json:"excludes,omitempty"`
+
+	// ExclusivePackages is an exclusive list of packages to be updated.
+	// +optional
+	ExclusivePackages []string `json:"exclusivePackages,omitempty"`
+}
+
+// ZypperSettings control the zypper patch rules.
+type ZypperSettings struct {
+	// WithOptional adds the "--with-optional" flag to "zypper patch".
+	// +optional
+	WithOptional *bool `json:"withOptional,omitempty"`
+
+	// WithUpdate adds the "--with-update" flag to "zypper patch".
+	// +optional
+	WithUpdate *bool `json:"withUpdate,omitempty"`
+
+	// Categories of patches to install, e.g. "security", "recommended".
+	// +optional
+	Categories []string `json:"categories,omitempty"`
+
+	// Severities of patches to install, e.g. "critical", "important".
+	// +optional
+	Severities []string `json:"severities,omitempty"`
+
+	// Excludes is a list of patches to exclude from update.
+	// +optional
+	Excludes []string `json:"excludes,omitempty"`
+
+	// ExclusivePatches is an exclusive list of patches to be updated.
+	// +optional
+	ExclusivePatches []string `json:"exclusivePatches,omitempty"`
+}
+
+// WindowsUpdateSettings control the Windows Update patch rules.
+type WindowsUpdateSettings struct {
+	// Classifications to apply. Can only be specified if exclusivePatches
+	// is empty.
+	// +optional
+	Classifications []string `json:"classifications,omitempty"`
+
+	// Excludes is a list of KBs to exclude from update.
+	// +optional
+	Excludes []string `json:"excludes,omitempty"`
+
+	// ExclusivePatches is an exclusive list of KBs to be updated.
+	// +optional
+	ExclusivePatches []string `json:"exclusivePatches,omitempty"`
+}
+
+// OneTimeSchedule sets the time for a one time patch deployment.
+type OneTimeSchedule struct {
+	// ExecuteTime is the desired patch job execution time in RFC3339
+	// format, e.g. "2023-06-01T04:00:00Z".
+	ExecuteTime string `json:"executeTime"`
+}
+
+// RecurringSchedule sets the time for recurring patch deployments.
+type RecurringSchedule struct {
+	// TimeZone is the IANA time zone the time of day is evaluated in, e.g.
+	// "America/New_York".
+	TimeZone string `json:"timeZone"`
+
+	// StartTime is the time that the recurring schedule becomes effective in
+	// RFC3339 format. Defaults to the creation time of the patch
+	// deployment.
+	// +optional
+	StartTime *string `json:"startTime,omitempty"`
+
+	// EndTime is the time that the recurring schedule is no longer
+	// effective in RFC3339 format. If not specified, the schedule does not
+	// end.
+	// +optional
+	EndTime *string `json:"endTime,omitempty"`
+
+	// TimeOfDay is the time of the day to run a recurring deployment.
+	TimeOfDay TimeOfDay `json:"timeOfDay"`
+
+	// Frequency in which the patch job is executed.
+	// +kubebuilder:validation:Enum=DAILY;WEEKLY;MONTHLY
+	Frequency string `json:"frequency"`
+
+	// Weekly schedule, required if frequency is WEEKLY.
+	// +optional
+	Weekly *WeeklySchedule `json:"weekly,omitempty"`
+
+	// Monthly schedule, required if frequency is MONTHLY.
+	// +optional
+	Monthly *MonthlySchedule `json:"monthly,omitempty"`
+}
+
+// TimeOfDay represents a time of day.
+type TimeOfDay struct {
+	// Hours of day in 24 hour format.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=23
+	Hours *int64 `json:"hours,omitempty"`
+
+	// Minutes of hour of day.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=59
+	Minutes *int64 `json:"minutes,omitempty"`
+
+	// Seconds of minutes of the time.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=59
+	Seconds *int64 `json:"seconds,omitempty"`
+}
+
+// WeeklySchedule represents a weekly schedule.
+type WeeklySchedule struct {
+	// DayOfWeek is the day of the week.
+	// +kubebuilder:validation:Enum=MONDAY;TUESDAY;WEDNESDAY;THURSDAY;FRIDAY;SATURDAY;SUNDAY
+	DayOfWeek string `json:"dayOfWeek"`
+}
+
+// MonthlySchedule represents a monthly schedule. Exactly one of monthDay and
+// weekDayOfMonth must be set.
+// +kubebuilder:validation:XValidation:rule="has(self.monthDay) != has(self.weekDayOfMonth)",message="exactly one of monthDay and weekDayOfMonth must be set"
+type MonthlySchedule struct {
+	// MonthDay is the day of the month, between 1 and 31, or -1 for the last
+	// day of the month.
+	// +optional
+	// +kubebuilder:validation:Minimum=-1
+	// +kubebuilder:validation:Maximum=31
+	MonthDay *int64 `json:"monthDay,omitempty"`
+
+	// WeekDayOfMonth is a week day in a month, e.g. the second Tuesday.
+	// +optional
+	WeekDayOfMonth *WeekDayOfMonth `json:"weekDayOfMonth,omitempty"`
+}
+
+// WeekDayOfMonth represents one week day in a month.
+type WeekDayOfMonth struct {
+	// WeekOrdinal is the week number in a month, 1-4 or -1 for the last
+	// week of the month.
+	// +kubebuilder:validation:Minimum=-1
+	// +kubebuilder:validation:Maximum=4
+	WeekOrdinal int64 `json:"weekOrdinal"`
+
+	// DayOfWeek is the day of the week.
+	// +kubebuilder:validation:Enum=MONDAY;TUESDAY;WEDNESDAY;THURSDAY;FRIDAY;SATURDAY;SUNDAY
+	DayOfWeek string `json:"dayOfWeek"`
+
+	// DayOffset is the number of days before or after the day specified by
+	// weekOrdinal and dayOfWeek, between -30 and 30.
+	// +optional
+	// +kubebuilder:validation:Minimum=-30
+	// +kubebuilder:validation:Maximum=30
+	DayOffset *int64 `json:"dayOffset,omitempty"`
+}
+
+// PatchRollout is the rollout strategy of a patch job.
+type PatchRollout struct {
+	// Mode of the patch rollout.
+	// +kubebuilder:validation:Enum=ZONE_BY_ZONE;CONCURRENT_ZONES
+	Mode string `json:"mode"`
+
+	// DisruptionBudget is the maximum number (or percentage) of VMs per zone
+	// to disrupt at any given moment.
+	DisruptionBudget FixedOrPercent `json:"disruptionBudget"`
+}
+
+// FixedOrPercent is a number that can be either absolute or relative.
+// +kubebuilder:validation:XValidation:rule="has(self.fixed) != has(self.percent)",message="exactly one of fixed and percent must be set"
+type FixedOrPercent struct {
+	// Fixed specifies a fixed value.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	Fixed *int64 `json:"fixed,omitempty"`
+
+	// Percent specifies the relative value defined as a percentage, which
+	// will be multiplied by a reference value.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	Percent *int64 `json:"percent,omitempty"`
+}
+
+// PatchDeploymentObservation is used to show the observed state of the
+// PatchDeployment.
+type PatchDeploymentObservation struct {
+	// Name is the resource name of the patch deployment, e.g.
+	// "projects/my-project/patchDeployments/my-deployment".
+	Name string `json:"name,omitempty"`
+
+	// State of the patch deployment, either ACTIVE or PAUSED.
+	State string `json:"state,omitempty"`
+
+	// CreateTime is the time the patch deployment was created.
+	CreateTime string `json:"createTime,omitempty"`
+
+	// UpdateTime is the time the patch deployment was last updated.
+	UpdateTime string `json:"updateTime,omitempty"`
+
+	// LastExecuteTime is the last time a patch job was started by this
+	// deployment.
+	LastExecuteTime string `json:"lastExecuteTime,omitempty"`
+
+	// NextExecuteTime is the time the next patch job is scheduled to run,
+	// for recurring schedules.
+	NextExecuteTime string `json:"nextExecuteTime,omitempty"`
+}
+
+// PatchDeploymentSpec defines the desired state of a PatchDeployment.
+type PatchDeploymentSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       PatchDeploymentParameters `json:"forProvider"`
+}
+
+// PatchDeploymentStatus represents the observed state of a PatchDeployment.
+type PatchDeploymentStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          PatchDeploymentObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PatchDeployment is a managed resource that represents an OS Config patch
+// deployment, which runs OS patch jobs against a fleet of VM instances on a
+// schedule.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="NEXT-EXECUTION",type="string",JSONPath=".status.atProvider.nextExecuteTime"
+// +kubebuilder:printcolumn:name="LAST-EXECUTION",type="string",JSONPath=".status.atProvider.lastExecuteTime",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp},shortName=patchdeploy
+type PatchDeployment struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PatchDeploymentSpec   `json:"spec"`
+	Status PatchDeploymentStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PatchDeploymentList contains a list of PatchDeployment types
+type PatchDeploymentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PatchDeployment `json:"items"`
+}
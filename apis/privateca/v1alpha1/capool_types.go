@@ -0,0 +1,242 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// CaPoolParameters define the desired state of a Certificate Authority
+// Service CA pool.
+type CaPoolParameters struct {
+	// Location is the region the CA pool lives in, e.g. "us-central1".
+	// +immutable
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="location is immutable"
+	Location string `json:"location"`
+
+	// Tier of the CA pool. The ENTERPRISE tier supports long-lived
+	// certificates and revocation, the DEVOPS tier short-lived certificates
+	// at high volume.
+	// +immutable
+	// +kubebuilder:validation:Enum=ENTERPRISE;DEVOPS
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="tier is immutable"
+	Tier string `json:"tier"`
+
+	// IssuancePolicy controls how certificates are issued from the
+	// certificate authorities of the pool.
+	// +optional
+	IssuancePolicy *IssuancePolicy `json:"issuancePolicy,omitempty"`
+
+	// PublishingOptions controls whether the CA certificates and CRLs of the
+	// certificate authorities of the pool are published.
+	// +optional
+	PublishingOptions *PublishingOptions `json:"publishingOptions,omitempty"`
+
+	// Labels of the CA pool.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// IssuancePolicy controls how certificates are issued from a CA pool.
+type IssuancePolicy struct {
+	// MaximumLifetime is the maximum lifetime of issued certificates, in
+	// seconds with up to nine fractional digits and an "s" suffix, e.g.
+	// "2592000s". Longer requested lifetimes are truncated.
+	// +optional
+	MaximumLifetime *string `json:"maximumLifetime,omitempty"`
+
+	// AllowedIssuanceModes restricts how certificates may be requested.
+	// +optional
+	AllowedIssuanceModes *IssuanceModes `json:"allowedIssuanceModes,omitempty"`
+
+	// BaselineValues are X.509 values applied to every issued certificate,
+	// overriding the values in the certificate request.
+	// +optional
+	BaselineValues *X509Parameters `json:"baselineValues,omitempty"`
+
+	// IdentityConstraints restrict the subject and subject alternative names
+	// of issued certificates.
+	// +optional
+	IdentityConstraints *IdentityConstraints `json:"identityConstraints,omitempty"`
+}
+
+// IssuanceModes are the ways certificates may be requested from a CA pool.
+type IssuanceModes struct {
+	// AllowCSRBasedIssuance allows certificates to be requested with a
+	// PEM-encoded certificate signing request.
+	AllowCSRBasedIssuance bool `json:"allowCsrBasedIssuance"`
+
+	// AllowConfigBasedIssuance allows certificates to be requested with a
+	// certificate config.
+	AllowConfigBasedIssuance bool `json:"allowConfigBasedIssuance"`
+}
+
+// IdentityConstraints restrict the subject and subject alternative names of
+// issued certificates.
+type IdentityConstraints struct {
+	// AllowSubjectPassthrough copies the subject of the certificate request
+	// into the issued certificate.
+	AllowSubjectPassthrough bool `json:"allowSubjectPassthrough"`
+
+	// AllowSubjectAltNamesPassthrough copies the subject alternative names of
+	// the certificate request into the issued certificate.
+	AllowSubjectAltNamesPassthrough bool `json:"allowSubjectAltNamesPassthrough"`
+}
+
+// PublishingOptions controls the content a certificate authority publishes to
+// its Cloud Storage bucket.
+type PublishingOptions struct {
+	// PublishCACert publishes the CA certificate and includes its URL in the
+	// "Authority Information Access" extension of issued certificates.
+	// +optional
+	PublishCACert *bool `json:"publishCaCert,omitempty"`
+
+	// PublishCRL publishes the certificate revocation list and includes its
+	// URL in the "CRL Distribution Points" extension of issued certificates.
+	// +optional
+	PublishCRL *bool `json:"publishCrl,omitempty"`
+}
+
+// X509Parameters describe the X.509 extensions of a certificate.
+type X509Parameters struct {
+	// CAOptions describe the basic constraints extension of the
+	// certificate.
+	// +optional
+	CAOptions *CAOptions `json:"caOptions,omitempty"`
+
+	// KeyUsage describes the key usage and extended key usage extensions of
+	// the certificate.
+	// +optional
+	KeyUsage *KeyUsage `json:"keyUsage,omitempty"`
+
+	// AIAOCSPServers are the OCSP server URLs added to the "Authority
+	// Information Access" extension of the certificate.
+	// +optional
+	AIAOCSPServers []string `json:"aiaOcspServers,omitempty"`
+}
+
+// CAOptions describe the basic constraints extension of a certificate.
+type CAOptions struct {
+	// IsCA marks the certificate as the certificate of a certificate
+	// authority.
+	// +optional
+	IsCA *bool `json:"isCa,omitempty"`
+
+	// MaxIssuerPathLength is the number of subordinate CA certificates
+	// allowed below this certificate.
+	// +optional
+	MaxIssuerPathLength *int64 `json:"maxIssuerPathLength,omitempty"`
+}
+
+// KeyUsage describes the key usage and extended key usage extensions of a
+// certificate.
+type KeyUsage struct {
+	// BaseKeyUsage are the key usage bits of the certificate.
+	// +optional
+	BaseKeyUsage *BaseKeyUsage `json:"baseKeyUsage,omitempty"`
+
+	// ExtendedKeyUsage are the extended key usages of the certificate.
+	// +optional
+	ExtendedKeyUsage *ExtendedKeyUsage `json:"extendedKeyUsage,omitempty"`
+}
+
+// BaseKeyUsage are the key usage bits of a certificate.
+type BaseKeyUsage struct {
+	// +optional
+	DigitalSignature bool `json:"digitalSignature,omitempty"`
+	// +optional
+	ContentCommitment bool `json:"contentCommitment,omitempty"`
+	// +optional
+	KeyEncipherment bool `json:"keyEncipherment,omitempty"`
+	// +optional
+	DataEncipherment bool `json:"dataEncipherment,omitempty"`
+	// +optional
+	KeyAgreement bool `json:"keyAgreement,omitempty"`
+	// +optional
+	CertSign bool `json:"certSign,omitempty"`
+	// +optional
+	CRLSign bool `json:"crlSign,omitempty"`
+	// +optional
+	EncipherOnly bool `json:"encipherOnly,omitempty"`
+	// +optional
+	DecipherOnly bool `json:"decipherOnly,omitempty"`
+}
+
+// ExtendedKeyUsage are the extended key usages of a certificate.
+type ExtendedKeyUsage struct {
+	// +optional
+	ServerAuth bool `json:"serverAuth,omitempty"`
+	// +optional
+	ClientAuth bool `json:"clientAuth,omitempty"`
+	// +optional
+	CodeSigning bool `json:"codeSigning,omitempty"`
+	// +optional
+	EmailProtection bool `json:"emailProtection,omitempty"`
+	// +optional
+	TimeStamping bool `json:"timeStamping,omitempty"`
+	// +optional
+	OCSPSigning bool `json:"ocspSigning,omitempty"`
+}
+
+// CaPoolObservation is used to show the observed state of the CaPool.
+type CaPoolObservation struct {
+	// Name is the resource name of the CA pool, e.g.
+	// "projects/my-project/locations/us-central1/caPools/my-pool".
+	Name string `json:"name,omitempty"`
+}
+
+// CaPoolSpec defines the desired state of a CaPool.
+type CaPoolSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       CaPoolParameters `json:"forProvider"`
+}
+
+// CaPoolStatus represents the observed state of a CaPool.
+type CaPoolStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          CaPoolObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CaPool is a managed resource that represents a Certificate Authority Service
+// CA pool, a group of certificate authorities that share an issuance policy
+// and form a trust anchor.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type CaPool struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CaPoolSpec   `json:"spec"`
+	Status CaPoolStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CaPoolList contains a list of CaPool types
+type CaPoolList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CaPool `json:"items"`
+}
@@ -0,0 +1,266 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// States of a CertificateAuthority.
+const (
+	CertificateAuthorityStateUnspecified            = "STATE_UNSPECIFIED"
+	CertificateAuthorityStateEnabled                = "ENABLED"
+	CertificateAuthorityStateDisabled               = "DISABLED"
+	CertificateAuthorityStateStaged                 = "STAGED"
+	CertificateAuthorityStateAwaitingUserActivation = "AWAITING_USER_ACTIVATION"
+	CertificateAuthorityStateDeleted                = "DELETED"
+)
+
+// CertificateAuthoritySecretCACertificateKey is the key of the connection
+// secret that holds the PEM-encoded certificate chain of the certificate
+// authority, starting with its own certificate.
+const CertificateAuthoritySecretCACertificateKey = "caCertificate"
+
+// CertificateAuthorityParameters define the desired state of a Certificate
+// Authority Service certificate authority. Only the labels and the desired
+// state of a certificate authority can be changed once it is created.
+type CertificateAuthorityParameters struct {
+	// Location is the region the certificate authority lives in. It must be
+	// the location of its CA pool.
+	// +immutable
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="location is immutable"
+	Location string `json:"location"`
+
+	// CaPool is the name of the CA pool the certificate authority belongs
+	// to.
+	// +optional
+	// +immutable
+	CaPool *string `json:"caPool,omitempty"`
+
+	// CaPoolRef references a CaPool to retrieve its name.
+	// +optional
+	// +immutable
+	CaPoolRef *xpv1.Reference `json:"caPoolRef,omitempty"`
+
+	// CaPoolSelector selects a reference to a CaPool to retrieve its name.
+	// +optional
+	CaPoolSelector *xpv1.Selector `json:"caPoolSelector,omitempty"`
+
+	// Type of the certificate authority. Only self-signed root certificate
+	// authorities are supported, since subordinate ones must be activated
+	// with a certificate signed by their issuer.
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:Enum=SELF_SIGNED
+	// +kubebuilder:default=SELF_SIGNED
+	Type string `json:"type,omitempty"`
+
+	// Config describes the certificate of the certificate authority.
+	// +immutable
+	Config CertificateConfig `json:"config"`
+
+	// Lifetime is the validity of the certificate of the certificate
+	// authority, in seconds with up to nine fractional digits and an "s"
+	// suffix, e.g. "315360000s".
+	// +immutable
+	Lifetime string `json:"lifetime"`
+
+	// KeySpec describes the key the certificate authority signs
+	// certificates with.
+	// +immutable
+	KeySpec KeyVersionSpec `json:"keySpec"`
+
+	// GCSBucket is the name of a Cloud Storage bucket the CA certificate
+	// and CRLs are published to. A Google-managed bucket is used if it is
+	// omitted.
+	// +optional
+	// +immutable
+	GCSBucket *string `json:"gcsBucket,omitempty"`
+
+	// DesiredState of the certificate authority. A newly created
+	// certificate authority is staged, and is enabled unless it is desired
+	// to be DISABLED. Only enabled certificate authorities issue
+	// certificates requested from their CA pool.
+	// +optional
+	// +kubebuilder:validation:Enum=ENABLED;DISABLED
+	// +kubebuilder:default=ENABLED
+	DesiredState *string `json:"desiredState,omitempty"`
+
+	// SkipGracePeriod deletes the certificate authority at once, rather
+	// than after a grace period of 30 days during which it can be restored.
+	// +optional
+	SkipGracePeriod *bool `json:"skipGracePeriod,omitempty"`
+
+	// IgnoreActiveCertificates allows the certificate authority to be
+	// deleted while it has issued certificates that are neither revoked nor
+	// expired.
+	// +optional
+	IgnoreActiveCertificates *bool `json:"ignoreActiveCertificates,omitempty"`
+
+	// Labels of the certificate authority.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// CertificateConfig describes the certificate of a certificate authority.
+type CertificateConfig struct {
+	// SubjectConfig specifies the subject and subject alternative names of
+	// the certificate.
+	SubjectConfig SubjectConfig `json:"subjectConfig"`
+
+	// X509Config describes the X.509 extensions of the certificate.
+	X509Config X509Parameters `json:"x509Config"`
+}
+
+// SubjectConfig specifies the subject and subject alternative names of a
+// certificate.
+type SubjectConfig struct {
+	// Subject of the certificate.
+	Subject Subject `json:"subject"`
+
+	// SubjectAltName are the subject alternative names of the certificate.
+	// +optional
+	SubjectAltName *SubjectAltNames `json:"subjectAltName,omitempty"`
+}
+
+// Subject describes the subject of a certificate.
+type Subject struct {
+	// +optional
+	CommonName *string `json:"commonName,omitempty"`
+	// +optional
+	CountryCode *string `json:"countryCode,omitempty"`
+	// +optional
+	Organization *string `json:"organization,omitempty"`
+	// +optional
+	OrganizationalUnit *string `json:"organizationalUnit,omitempty"`
+	// +optional
+	Locality *string `json:"locality,omitempty"`
+	// +optional
+	Province *string `json:"province,omitempty"`
+	// +optional
+	StreetAddress *string `json:"streetAddress,omitempty"`
+	// +optional
+	PostalCode *string `json:"postalCode,omitempty"`
+}
+
+// SubjectAltNames are the subject alternative names of a certificate.
+type SubjectAltNames struct {
+	// +optional
+	DNSNames []string `json:"dnsNames,omitempty"`
+	// +optional
+	URIs []string `json:"uris,omitempty"`
+	// +optional
+	EmailAddresses []string `json:"emailAddresses,omitempty"`
+	// +optional
+	IPAddresses []string `json:"ipAddresses,omitempty"`
+}
+
+// KeyVersionSpec describes the key a certificate authority signs
+// certificates with. Exactly one of its fields must be set.
+type KeyVersionSpec struct {
+	// Algorithm of a Google-managed key to create for the certificate
+	// authority.
+	// +optional
+	// +kubebuilder:validation:Enum=RSA_PSS_2048_SHA256;RSA_PSS_3072_SHA256;RSA_PSS_4096_SHA256;RSA_PKCS1_2048_SHA256;RSA_PKCS1_3072_SHA256;RSA_PKCS1_4096_SHA256;EC_P256_SHA256;EC_P384_SHA384
+	Algorithm *string `json:"algorithm,omitempty"`
+
+	// CloudKMSKeyVersion is the resource name of an existing Cloud KMS
+	// crypto key version to sign with, e.g.
+	// "projects/my-project/locations/us-central1/keyRings/my-ring/cryptoKeys/my-key/cryptoKeyVersions/1".
+	// +optional
+	CloudKMSKeyVersion *string `json:"cloudKmsKeyVersion,omitempty"`
+}
+
+// CertificateAuthorityObservation is used to show the observed state of the
+// CertificateAuthority.
+type CertificateAuthorityObservation struct {
+	// Name is the resource name of the certificate authority, e.g.
+	// "projects/my-project/locations/us-central1/caPools/my-pool/certificateAuthorities/my-ca".
+	Name string `json:"name,omitempty"`
+
+	// State of the certificate authority.
+	State string `json:"state,omitempty"`
+
+	// Tier of the CA pool of the certificate authority.
+	Tier string `json:"tier,omitempty"`
+
+	// CACertificateAccessURL is the URL the CA certificate is published at.
+	CACertificateAccessURL string `json:"caCertificateAccessUrl,omitempty"`
+
+	// CRLAccessURLs are the URLs the CRLs of the certificate authority are
+	// published at.
+	CRLAccessURLs []string `json:"crlAccessUrls,omitempty"`
+
+	// CreateTime is the time the certificate authority was created.
+	CreateTime string `json:"createTime,omitempty"`
+
+	// UpdateTime is the time the certificate authority was last updated.
+	UpdateTime string `json:"updateTime,omitempty"`
+
+	// DeleteTime is the time the certificate authority was deleted, if it
+	// is in its grace period.
+	DeleteTime string `json:"deleteTime,omitempty"`
+
+	// ExpireTime is the time the certificate authority is permanently
+	// deleted, if it is in its grace period.
+	ExpireTime string `json:"expireTime,omitempty"`
+}
+
+// CertificateAuthoritySpec defines the desired state of a
+// CertificateAuthority.
+type CertificateAuthoritySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       CertificateAuthorityParameters `json:"forProvider"`
+}
+
+// CertificateAuthorityStatus represents the observed state of a
+// CertificateAuthority.
+type CertificateAuthorityStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          CertificateAuthorityObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CertificateAuthority is a managed resource that represents a Certificate
+// Authority Service certificate authority. Its PEM-encoded certificate chain
+// is published as a connection detail.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type CertificateAuthority struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CertificateAuthoritySpec   `json:"spec"`
+	Status CertificateAuthorityStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CertificateAuthorityList contains a list of CertificateAuthority types
+type CertificateAuthorityList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CertificateAuthority `json:"items"`
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// CertificateTemplateParameters define the desired state of a Certificate
+// Authority Service certificate template.
+type CertificateTemplateParameters struct {
+	// Location is the region the certificate template lives in. It can only
+	// be used by CA pools in the same location.
+	// +immutable
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="location is immutable"
+	Location string `json:"location"`
+
+	// Description of the certificate template.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// PredefinedValues are X.509 values applied to every certificate issued
+	// with the template, overriding the values in the certificate request.
+	// +optional
+	PredefinedValues *X509Parameters `json:"predefinedValues,omitempty"`
+
+	// IdentityConstraints restrict the subject and subject alternative names
+	// of certificates issued with the template.
+	// +optional
+	IdentityConstraints *IdentityConstraints `json:"identityConstraints,omitempty"`
+
+	// PassthroughExtensions are the X.509 extensions copied from the
+	// certificate request into certificates issued with the template.
+	// +optional
+	PassthroughExtensions *ExtensionConstraints `json:"passthroughExtensions,omitempty"`
+
+	// Labels of the certificate template.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// ExtensionConstraints select X.509 extensions of a certificate request.
+type ExtensionConstraints struct {
+	// KnownExtensions are the well-known extensions selected.
+	// +optional
+	KnownExtensions []string `json:"knownExtensions,omitempty"`
+}
+
+// CertificateTemplateObservation is used to show the observed state of the
+// CertificateTemplate.
+type CertificateTemplateObservation struct {
+	// Name is the resource name of the certificate template, e.g.
+	// "projects/my-project/locations/us-central1/certificateTemplates/my-template".
+	Name string `json:"name,omitempty"`
+
+	// CreateTime is the time the certificate template was created.
+	CreateTime string `json:"createTime,omitempty"`
+
+	// UpdateTime is the time the certificate template was last updated.
+	UpdateTime string `json:"updateTime,omitempty"`
+}
+
+// CertificateTemplateSpec defines the desired state of a
+// CertificateTemplate.
+type CertificateTemplateSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       CertificateTemplateParameters `json:"forProvider"`
+}
+
+// CertificateTemplateStatus represents the observed state of a
+// CertificateTemplate.
+type CertificateTemplateStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          CertificateTemplateObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CertificateTemplate is a managed resource that represents a Certificate
+// Authority Service certificate template, a reusable set of constraints and
+// values for certificates issued from any CA pool in its location.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type CertificateTemplate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CertificateTemplateSpec   `json:"spec"`
+	Status CertificateTemplateStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CertificateTemplateList contains a list of CertificateTemplate types
+type CertificateTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CertificateTemplate `json:"items"`
+}
@@ -23,6 +23,7 @@ import (
 )
 
 // SubscriptionParameters defines parameters for a desired Subscription.
+// +kubebuilder:validation:XValidation:rule="!has(oldSelf.enableMessageOrdering) || (has(self.enableMessageOrdering) && self.enableMessageOrdering == oldSelf.enableMessageOrdering)",message="enableMessageOrdering is immutable"
 type SubscriptionParameters struct {
 	// AckDeadlineSeconds is the approximate amount of time Pub/Sub waits for
 	// the subscriber to acknowledge receipt before resending the message.
@@ -48,14 +49,19 @@ type SubscriptionParameters struct {
 	// to subscribers. When it is true, messages published with the same
 	// `ordering_key` in `PubsubMessage` will be delivered to the subscribers
 	// in the order in which they are received by the Pub/Sub system.
-	// Otherwise, they may be delivered in any order.
+	// Otherwise, they may be delivered in any order. Ordering is only
+	// guaranteed for messages published to the same region, so publishers
+	// relying on it should use regional endpoints. This field cannot be
+	// changed once the subscription is created.
 	// +optional
-	EnableMessageOrdering bool `json:"enableMessageOrdering,omitempty"`
+	// +immutable
+	EnableMessageOrdering *bool `json:"enableMessageOrdering,omitempty"`
 
 	// ExpirationPolicy is the policy that specifies the conditions for this
 	// subscription's expiration. If `expiration_policy` is not set, a
 	// *default policy* with `ttl` of 31 days will be used. The minimum allowed value
-	// for `expiration_policy.ttl` is 1 day.
+	// for `expiration_policy.ttl` is 1 day. An `expirationPolicy` with an empty
+	// `ttl` means that the subscription never expires.
 	// +optional
 	ExpirationPolicy *ExpirationPolicy `json:"expirationPolicy,omitempty"`
 
@@ -76,9 +82,10 @@ type SubscriptionParameters struct {
 	// a message is published. If `retain_acked_messages` is true, then this also
 	// configures the retention of acknowledged messages, and thus
 	// configures how far back in time a `Seek` can be done. Defaults to 7
-	// days. Cannot be more than 7 days or less than 10 minutes.
+	// days. Cannot be more than 31 days or less than 10 minutes.
 	// +optional
-	// +kubebuilder:validation:Pattern=^[0-9]*s$
+	// +kubebuilder:validation:Pattern=^[0-9]+s$
+	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('600s') && duration(self) <= duration('2678400s')",message="messageRetentionDuration must be between 10 minutes and 31 days"
 	MessageRetentionDuration string `json:"messageRetentionDuration,omitempty"`
 
 	// PushConfig is a parameter which configures push delivery. An empty
@@ -123,7 +130,9 @@ type DeadLetterPolicy struct {
 type ExpirationPolicy struct {
 	// TTL is the duration of "time-to-live" for an associated resource.
 	// The resource expires if it is not active for a period of `ttl`.
-	// +kubebuilder:validation:Pattern=^[0-9]*s$
+	// If `ttl` is empty, the resource never expires.
+	// +optional
+	// +kubebuilder:validation:Pattern=^[0-9]+s$
 	TTL string `json:"ttl,omitempty"`
 }
 
@@ -167,6 +176,21 @@ type RetryPolicy struct {
 	MinimumBackoff string `json:"minimumBackoff,omitempty"`
 }
 
+// SubscriptionObservation is used to show the observed state of the
+// Subscription.
+type SubscriptionObservation struct {
+	// Name is the fully qualified name of the subscription, in the form
+	// "projects/{project}/subscriptions/{subscription}".
+	Name string `json:"name,omitempty"`
+
+	// TopicMessageRetentionDuration indicates the minimum duration for
+	// which a message is retained after it is published to the
+	// subscription's topic. If this field is set, messages published to the
+	// subscription's topic in the last `topicMessageRetentionDuration` are
+	// always available to subscribers.
+	TopicMessageRetentionDuration string `json:"topicMessageRetentionDuration,omitempty"`
+}
+
 // SubscriptionSpec defines the desired state of a Subscription.
 type SubscriptionSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
@@ -176,6 +200,7 @@ type SubscriptionSpec struct {
 // SubscriptionStatus represents the observed state of a Subscription.
 type SubscriptionStatus struct {
 	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          SubscriptionObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -184,7 +209,11 @@ type SubscriptionStatus struct {
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
-// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="TOPIC",type="string",JSONPath=".spec.forProvider.topic"
+// +kubebuilder:printcolumn:name="ACK-DEADLINE",type="integer",JSONPath=".spec.forProvider.ackDeadlineSeconds",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp},shortName=pssub
 type Subscription struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
@@ -91,12 +91,32 @@ type MessageStoragePolicy struct {
 type TopicSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       TopicParameters `json:"forProvider"`
+
+	// UpdatePolicy controls how changes to the immutable fields of
+	// forProvider are applied. With the default Update policy they are sent
+	// to GCP like any other change, which rejects or ignores them. With
+	// ReplaceOnImmutableChange the Topic is deleted and created again with the
+	// changed fields, but only once the replacement is confirmed by
+	// annotating this resource with gcp.crossplane.io/confirm-replace set to
+	// its current metadata.generation. Resources whose deletion policy is
+	// Orphan are never replaced.
+	// +optional
+	// +kubebuilder:validation:Enum=Update;ReplaceOnImmutableChange
+	UpdatePolicy string `json:"updatePolicy,omitempty"`
+}
+
+// TopicObservation is used to show the observed state of the Topic.
+type TopicObservation struct {
+	// Name is the fully qualified name of the topic, in the form
+	// "projects/{project}/topics/{topic}".
+	Name string `json:"name,omitempty"`
 }
 
 // TopicStatus represents the observed state of a
 // Topic.
 type TopicStatus struct {
 	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          TopicObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -105,7 +125,10 @@ type TopicStatus struct {
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
-// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="RETENTION",type="string",JSONPath=".spec.forProvider.messageRetentionDuration",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp},shortName=pstopic
 type Topic struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
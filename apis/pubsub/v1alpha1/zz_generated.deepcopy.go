@@ -192,6 +192,21 @@ func (in *SubscriptionList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubscriptionObservation) DeepCopyInto(out *SubscriptionObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubscriptionObservation.
+func (in *SubscriptionObservation) DeepCopy() *SubscriptionObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(SubscriptionObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SubscriptionParameters) DeepCopyInto(out *SubscriptionParameters) {
 	*out = *in
@@ -200,6 +215,11 @@ func (in *SubscriptionParameters) DeepCopyInto(out *SubscriptionParameters) {
 		*out = new(DeadLetterPolicy)
 		**out = **in
 	}
+	if in.EnableMessageOrdering != nil {
+		in, out := &in.EnableMessageOrdering, &out.EnableMessageOrdering
+		*out = new(bool)
+		**out = **in
+	}
 	if in.ExpirationPolicy != nil {
 		in, out := &in.ExpirationPolicy, &out.ExpirationPolicy
 		*out = new(ExpirationPolicy)
@@ -255,6 +275,7 @@ func (in *SubscriptionSpec) DeepCopy() *SubscriptionSpec {
 func (in *SubscriptionStatus) DeepCopyInto(out *SubscriptionStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubscriptionStatus.
@@ -326,6 +347,21 @@ func (in *TopicList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TopicObservation) DeepCopyInto(out *TopicObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TopicObservation.
+func (in *TopicObservation) DeepCopy() *TopicObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(TopicObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TopicParameters) DeepCopyInto(out *TopicParameters) {
 	*out = *in
@@ -394,6 +430,7 @@ func (in *TopicSpec) DeepCopy() *TopicSpec {
 func (in *TopicStatus) DeepCopyInto(out *TopicStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TopicStatus.
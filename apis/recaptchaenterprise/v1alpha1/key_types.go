@@ -0,0 +1,150 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// KeyParameters defines parameters for a desired reCAPTCHA Enterprise Key.
+// Exactly one of webSettings, androidSettings and iosSettings must be set.
+// +kubebuilder:validation:XValidation:rule="(has(self.webSettings) ? 1 : 0) + (has(self.androidSettings) ? 1 : 0) + (has(self.iosSettings) ? 1 : 0) == 1",message="exactly one of webSettings, androidSettings and iosSettings must be set"
+type KeyParameters struct {
+	// DisplayName of the key.
+	DisplayName string `json:"displayName"`
+
+	// Labels to attach to the key.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// WebSettings configure a key for websites.
+	// +optional
+	WebSettings *WebKeySettings `json:"webSettings,omitempty"`
+
+	// AndroidSettings configure a key for Android apps.
+	// +optional
+	AndroidSettings *AndroidKeySettings `json:"androidSettings,omitempty"`
+
+	// IOSSettings configure a key for iOS apps.
+	// +optional
+	IOSSettings *IOSKeySettings `json:"iosSettings,omitempty"`
+}
+
+// WebKeySettings configure a key for websites.
+type WebKeySettings struct {
+	// IntegrationType of the key.
+	// +immutable
+	// +kubebuilder:validation:Enum=SCORE;CHECKBOX;INVISIBLE
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="integrationType is immutable"
+	IntegrationType string `json:"integrationType"`
+
+	// AllowAllDomains disables the domain check.
+	// +optional
+	AllowAllDomains *bool `json:"allowAllDomains,omitempty"`
+
+	// AllowedDomains the key may be used on, e.g. "example.com". Subdomains
+	// are allowed as well.
+	// +optional
+	AllowedDomains []string `json:"allowedDomains,omitempty"`
+
+	// AllowAmpTraffic allows the key to be used on AMP pages.
+	// +optional
+	AllowAmpTraffic *bool `json:"allowAmpTraffic,omitempty"`
+
+	// ChallengeSecurityPreference trades off usability against security of
+	// challenges. Not used by SCORE keys.
+	// +optional
+	// +kubebuilder:validation:Enum=USABILITY;BALANCE;SECURITY
+	ChallengeSecurityPreference *string `json:"challengeSecurityPreference,omitempty"`
+}
+
+// AndroidKeySettings configure a key for Android apps.
+type AndroidKeySettings struct {
+	// AllowAllPackageNames disables the package name check.
+	// +optional
+	AllowAllPackageNames *bool `json:"allowAllPackageNames,omitempty"`
+
+	// AllowedPackageNames the key may be used in, e.g.
+	// "com.example.app".
+	// +optional
+	AllowedPackageNames []string `json:"allowedPackageNames,omitempty"`
+}
+
+// IOSKeySettings configure a key for iOS apps.
+type IOSKeySettings struct {
+	// AllowAllBundleIDs disables the bundle ID check.
+	// +optional
+	AllowAllBundleIDs *bool `json:"allowAllBundleIds,omitempty"`
+
+	// AllowedBundleIDs the key may be used in, e.g. "com.example.app".
+	// +optional
+	AllowedBundleIDs []string `json:"allowedBundleIds,omitempty"`
+}
+
+// KeyObservation is used to show the observed state of the Key.
+type KeyObservation struct {
+	// Name is the resource name of the key, e.g.
+	// "projects/my-project/keys/6LdFz...".
+	Name string `json:"name,omitempty"`
+
+	// CreateTime is the time the key was created.
+	CreateTime string `json:"createTime,omitempty"`
+}
+
+// KeySpec defines the desired state of a Key.
+type KeySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       KeyParameters `json:"forProvider"`
+}
+
+// KeyStatus represents the observed state of a Key.
+type KeyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          KeyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// Key is a managed resource that represents a reCAPTCHA Enterprise key. The
+// key ID, which is also the site key used by clients, is assigned by Google
+// and is written to the external name annotation and the siteKey connection
+// detail.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="DISPLAY-NAME",type="string",JSONPath=".spec.forProvider.displayName"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp},shortName=recaptchakey
+type Key struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   KeySpec   `json:"spec"`
+	Status KeyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// KeyList contains a list of Key types
+type KeyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Key `json:"items"`
+}
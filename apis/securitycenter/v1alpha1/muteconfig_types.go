@@ -0,0 +1,106 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// MuteConfigParameters defines parameters for a desired Security Command
+// Center MuteConfig.
+type MuteConfigParameters struct {
+	// Parent is the resource the mute config is created under. It must be of
+	// the form "organizations/[organization_id]", "folders/[folder_id]" or
+	// "projects/[project_id]". Defaults to the project of the
+	// ProviderConfig.
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:Pattern=`^(organizations|folders|projects)/[^/]+$`
+	Parent *string `json:"parent,omitempty"`
+
+	// Description of the mute config.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Filter is the expression that defines the filter to apply across
+	// create/update events of findings. Findings matching the filter are
+	// muted, e.g. 'category = "OPEN_FIREWALL"'.
+	// +kubebuilder:validation:MinLength=1
+	Filter string `json:"filter"`
+}
+
+// MuteConfigObservation is used to show the observed state of the
+// MuteConfig.
+type MuteConfigObservation struct {
+	// Name is the relative resource name of this mute config, e.g.
+	// "projects/my-project/muteConfigs/my-config".
+	Name string `json:"name,omitempty"`
+
+	// CreateTime is the time at which the mute config was created.
+	CreateTime string `json:"createTime,omitempty"`
+
+	// UpdateTime is the most recent time at which the mute config was
+	// updated.
+	UpdateTime string `json:"updateTime,omitempty"`
+
+	// MostRecentEditor is the email address of the user who last edited
+	// the mute config.
+	MostRecentEditor string `json:"mostRecentEditor,omitempty"`
+}
+
+// MuteConfigSpec defines the desired state of a MuteConfig.
+type MuteConfigSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       MuteConfigParameters `json:"forProvider"`
+}
+
+// MuteConfigStatus represents the observed state of a MuteConfig.
+type MuteConfigStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          MuteConfigObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// MuteConfig is a managed resource that represents a Security Command Center
+// mute config, which mutes findings matching a filter.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="FILTER",type="string",JSONPath=".spec.forProvider.filter",priority=1
+// +kubebuilder:printcolumn:name="NAME",type="string",JSONPath=".status.atProvider.name",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp},shortName=sccmute
+type MuteConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   MuteConfigSpec   `json:"spec"`
+	Status MuteConfigStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// MuteConfigList contains a list of MuteConfig types
+type MuteConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MuteConfig `json:"items"`
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// NotificationConfigParameters defines parameters for a desired Security
+// Command Center NotificationConfig.
+type NotificationConfigParameters struct {
+	// Parent is the resource the notification config is created under. It
+	// must be of the form "organizations/[organization_id]",
+	// "folders/[folder_id]" or "projects/[project_id]". Defaults to the
+	// project of the ProviderConfig.
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:Pattern=`^(organizations|folders|projects)/[^/]+$`
+	Parent *string `json:"parent,omitempty"`
+
+	// Description of the notification config. Must not exceed 1024
+	// characters.
+	// +optional
+	// +kubebuilder:validation:MaxLength=1024
+	Description *string `json:"description,omitempty"`
+
+	// PubsubTopic is the Pub/Sub topic to send notifications to. Its format
+	// is "projects/[project_id]/topics/[topic]".
+	// +kubebuilder:validation:Pattern=`^projects/[^/]+/topics/[^/]+$`
+	PubsubTopic string `json:"pubsubTopic"`
+
+	// StreamingConfig is the config for triggering streaming-based
+	// notifications.
+	// +optional
+	StreamingConfig *StreamingConfig `json:"streamingConfig,omitempty"`
+}
+
+// StreamingConfig is the config for streaming-based notifications, which
+// send each event as soon as it is detected.
+type StreamingConfig struct {
+	// Filter is the expression that defines the filter to apply across
+	// create/update events of findings, e.g. 'state = "ACTIVE"'. See
+	// https://cloud.google.com/security-command-center/docs/how-to-api-filter-notifications
+	// for the supported syntax.
+	// +optional
+	Filter string `json:"filter,omitempty"`
+}
+
+// NotificationConfigObservation is used to show the observed state of the
+// NotificationConfig.
+type NotificationConfigObservation struct {
+	// Name is the relative resource name of this notification config, e.g.
+	// "organizations/123/notificationConfigs/my-config".
+	Name string `json:"name,omitempty"`
+
+	// ServiceAccount is the service account that needs
+	// "pubsub.topics.publish" permission to publish to the Pub/Sub topic.
+	ServiceAccount string `json:"serviceAccount,omitempty"`
+}
+
+// NotificationConfigSpec defines the desired state of a NotificationConfig.
+type NotificationConfigSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       NotificationConfigParameters `json:"forProvider"`
+}
+
+// NotificationConfigStatus represents the observed state of a
+// NotificationConfig.
+type NotificationConfigStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          NotificationConfigObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NotificationConfig is a managed resource that represents a Security Command
+// Center notification config, which streams findings to a Pub/Sub topic.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="TOPIC",type="string",JSONPath=".spec.forProvider.pubsubTopic"
+// +kubebuilder:printcolumn:name="NAME",type="string",JSONPath=".status.atProvider.name",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp},shortName=sccnotification
+type NotificationConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NotificationConfigSpec   `json:"spec"`
+	Status NotificationConfigStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NotificationConfigList contains a list of NotificationConfig types
+type NotificationConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NotificationConfig `json:"items"`
+}
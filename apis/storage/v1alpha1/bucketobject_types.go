@@ -0,0 +1,143 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// BucketObjectParameters defines parameters for a desired Cloud Storage
+// object. The external name of a BucketObject is the object name, i.e. its
+// path within the bucket, e.g. ".well-known/openid-configuration".
+// +kubebuilder:validation:XValidation:rule="[has(self.content), has(self.contentSecretRef), has(self.contentConfigMapRef)].filter(x, x).size() == 1",message="exactly one of content, contentSecretRef and contentConfigMapRef must be set"
+type BucketObjectParameters struct {
+	// Bucket is the name of the bucket the object is uploaded to.
+	// +optional
+	// +immutable
+	Bucket *string `json:"bucket,omitempty"`
+
+	// BucketRef references a Bucket and retrieves its name.
+	// +optional
+	// +immutable
+	BucketRef *xpv1.Reference `json:"bucketRef,omitempty"`
+
+	// BucketSelector selects a reference to a Bucket.
+	// +optional
+	BucketSelector *xpv1.Selector `json:"bucketSelector,omitempty"`
+
+	// Content of the object.
+	// +optional
+	Content *string `json:"content,omitempty"`
+
+	// ContentSecretRef references a key of a Secret whose value is the
+	// content of the object.
+	// +optional
+	ContentSecretRef *xpv1.SecretKeySelector `json:"contentSecretRef,omitempty"`
+
+	// ContentConfigMapRef references a key of a ConfigMap whose value is the
+	// content of the object.
+	// +optional
+	ContentConfigMapRef *ConfigMapKeySelector `json:"contentConfigMapRef,omitempty"`
+
+	// ContentType of the object, e.g. "application/json". Cloud Storage
+	// detects the content type if it is omitted.
+	// +optional
+	ContentType *string `json:"contentType,omitempty"`
+
+	// CacheControl is the Cache-Control directive served with the object,
+	// e.g. "public, max-age=3600".
+	// +optional
+	CacheControl *string `json:"cacheControl,omitempty"`
+}
+
+// A ConfigMapKeySelector is a reference to a key of a ConfigMap in an
+// arbitrary namespace.
+type ConfigMapKeySelector struct {
+	// Name of the ConfigMap.
+	Name string `json:"name"`
+
+	// Namespace of the ConfigMap.
+	Namespace string `json:"namespace"`
+
+	// Key whose value is selected.
+	Key string `json:"key"`
+}
+
+// BucketObjectObservation is used to show the observed state of the
+// BucketObject.
+type BucketObjectObservation struct {
+	// Generation is the content generation of the object.
+	Generation int64 `json:"generation,omitempty"`
+
+	// MD5Hash is the base64 encoded MD5 hash of the content.
+	MD5Hash string `json:"md5Hash,omitempty"`
+
+	// Size of the content in bytes.
+	Size uint64 `json:"size,omitempty"`
+
+	// MediaLink is the URL the content can be downloaded from.
+	MediaLink string `json:"mediaLink,omitempty"`
+
+	// SelfLink is the URL of the object resource.
+	SelfLink string `json:"selfLink,omitempty"`
+
+	// Updated is the time the object metadata was last modified.
+	Updated string `json:"updated,omitempty"`
+}
+
+// BucketObjectSpec defines the desired state of a BucketObject.
+type BucketObjectSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       BucketObjectParameters `json:"forProvider"`
+}
+
+// BucketObjectStatus represents the observed state of a BucketObject.
+type BucketObjectStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          BucketObjectObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BucketObject is a managed resource that represents a Cloud Storage object,
+// such as a startup script or an OIDC discovery document, whose content is
+// given inline or read from a Secret or ConfigMap.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="BUCKET",type="string",JSONPath=".spec.forProvider.bucket"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp},shortName=bktobject
+type BucketObject struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BucketObjectSpec   `json:"spec"`
+	Status BucketObjectStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BucketObjectList contains a list of BucketObject types
+type BucketObjectList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BucketObject `json:"items"`
+}
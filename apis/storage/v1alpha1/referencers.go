@@ -99,3 +99,24 @@ func (in *BucketPolicyMember) ResolveReferences(ctx context.Context, c client.Re
 
 	return nil
 }
+
+// ResolveReferences of this BucketObject
+func (in *BucketObject) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, in)
+
+	// Resolve spec.forProvider.bucket
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(in.Spec.ForProvider.Bucket),
+		Reference:    in.Spec.ForProvider.BucketRef,
+		Selector:     in.Spec.ForProvider.BucketSelector,
+		To:           reference.To{Managed: &v1alpha3.Bucket{}, List: &v1alpha3.BucketList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.bucket")
+	}
+	in.Spec.ForProvider.Bucket = reference.ToPtrValue(rsp.ResolvedValue)
+	in.Spec.ForProvider.BucketRef = rsp.ResolvedReference
+
+	return nil
+}
@@ -53,6 +53,14 @@ var (
 	BucketPolicyMemberGroupVersionKind = SchemeGroupVersion.WithKind(BucketPolicyMemberKind)
 )
 
+// BucketObject type metadata.
+var (
+	BucketObjectKind             = reflect.TypeOf(BucketObject{}).Name()
+	BucketObjectGroupKind        = schema.GroupKind{Group: Group, Kind: BucketObjectKind}.String()
+	BucketObjectKindAPIVersion   = BucketObjectKind + "." + SchemeGroupVersion.String()
+	BucketObjectGroupVersionKind = SchemeGroupVersion.WithKind(BucketObjectKind)
+)
+
 func init() {
-	SchemeBuilder.Register(&BucketPolicy{}, &BucketPolicyList{}, &BucketPolicyMember{}, &BucketPolicyMemberList{})
+	SchemeBuilder.Register(&BucketPolicy{}, &BucketPolicyList{}, &BucketPolicyMember{}, &BucketPolicyMemberList{}, &BucketObject{}, &BucketObjectList{})
 }
@@ -17,14 +17,50 @@ limitations under the License.
 package v1alpha3
 
 import (
+	"fmt"
 	"time"
 
 	"cloud.google.com/go/storage"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 )
 
+// TypePerimeterAllowed buckets could be read and written without crossing the
+// boundary of a VPC Service Controls perimeter.
+const TypePerimeterAllowed xpv1.ConditionType = "PerimeterAllowed"
+
+// Reasons a bucket is or is not blocked by a VPC Service Controls perimeter.
+const (
+	ReasonPerimeterAllowed xpv1.ConditionReason = "PerimeterAllowed"
+	ReasonPerimeterBlocked xpv1.ConditionReason = "PerimeterBlocked"
+)
+
+// PerimeterAllowed returns a condition that indicates requests for the bucket
+// are no longer blocked by a VPC Service Controls perimeter.
+func PerimeterAllowed() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypePerimeterAllowed,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonPerimeterAllowed,
+	}
+}
+
+// PerimeterBlocked returns a condition that indicates a request for the
+// bucket was blocked by the VPC Service Controls perimeter described by the
+// supplied message.
+func PerimeterBlocked(msg string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypePerimeterAllowed,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonPerimeterBlocked,
+		Message:            msg,
+	}
+}
+
 // ProjectTeam is the project team associated with the entity, if any.
 type ProjectTeam struct {
 	// ProjectNumber is the number of the project.
@@ -423,8 +459,17 @@ func CopyToBucketLogging(l *BucketLogging) *storage.BucketLogging {
 type CORS struct {
 	// MaxAge is the value to return in the Access-Control-Max-Age
 	// header used in preflight responses.
+	//
+	// Deprecated: Use MaxAgeSeconds instead. MaxAge is ignored when
+	// MaxAgeSeconds is set.
 	MaxAge metav1.Duration `json:"maxAge,omitempty"`
 
+	// MaxAgeSeconds is the value, in seconds, to return in the
+	// Access-Control-Max-Age header used in preflight responses.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	MaxAgeSeconds *int64 `json:"maxAgeSeconds,omitempty"`
+
 	// Methods is the list of HTTP methods on which to include CORS response
 	// headers, (GET, OPTIONS, POST, etc) Note: "*" is permitted in the list
 	// of methods, and means "any method".
@@ -443,8 +488,9 @@ type CORS struct {
 
 // NewCORS creates a new instance of CORS from the storage counterpart
 func NewCORS(c storage.CORS) CORS {
+	maxAge := int64(c.MaxAge / time.Second)
 	return CORS{
-		MaxAge:          metav1.Duration{Duration: c.MaxAge},
+		MaxAgeSeconds:   &maxAge,
 		Methods:         c.Methods,
 		Origins:         c.Origins,
 		ResponseHeaders: c.ResponseHeaders,
@@ -453,8 +499,12 @@ func NewCORS(c storage.CORS) CORS {
 
 // CopyToCORS create a copy in storage format
 func CopyToCORS(c CORS) storage.CORS {
+	maxAge := c.MaxAge.Duration
+	if c.MaxAgeSeconds != nil {
+		maxAge = time.Duration(*c.MaxAgeSeconds) * time.Second
+	}
 	return storage.CORS{
-		MaxAge:          c.MaxAge.Duration,
+		MaxAge:          maxAge,
 		Methods:         c.Methods,
 		Origins:         c.Origins,
 		ResponseHeaders: c.ResponseHeaders,
@@ -759,6 +809,9 @@ type BucketOutputAttrs struct {
 	// Created is the creation time of the bucket.
 	Created *metav1.Time `json:"created,omitempty"`
 
+	// ID of the bucket. Cloud Storage uses the name of a bucket as its ID.
+	ID string `json:"id,omitempty"`
+
 	// Retention policy enforces a minimum retention time for all objects
 	// contained in the bucket. A RetentionPolicy of nil implies the bucket
 	// has no minimum data retention.
@@ -767,8 +820,15 @@ type BucketOutputAttrs struct {
 	// most customers. It might be changed in backwards-incompatible ways and is not
 	// subject to any SLA or deprecation policy.
 	RetentionPolicy *RetentionPolicyStatus `json:"retentionPolicy,omitempty"`
+
+	// SelfLink is the URI of the bucket in the Cloud Storage JSON API.
+	SelfLink string `json:"selfLink,omitempty"`
 }
 
+// bucketSelfLinkFmt is the format of the URI of a bucket in the Cloud Storage
+// JSON API. The client library does not expose it.
+const bucketSelfLinkFmt = "https://www.googleapis.com/storage/v1/b/%s"
+
 // NewBucketOutputAttrs creates new instance of BucketOutputAttrs from storage.BucketAttrs
 func NewBucketOutputAttrs(attrs *storage.BucketAttrs) BucketOutputAttrs {
 	if attrs == nil {
@@ -778,6 +838,10 @@ func NewBucketOutputAttrs(attrs *storage.BucketAttrs) BucketOutputAttrs {
 		BucketPolicyOnly: NewBucketPolicyOnly(attrs.BucketPolicyOnly),
 		RetentionPolicy:  NewRetentionPolicyStatus(attrs.RetentionPolicy),
 	}
+	if attrs.Name != "" {
+		ao.ID = attrs.Name
+		ao.SelfLink = fmt.Sprintf(bucketSelfLinkFmt, attrs.Name)
+	}
 	if !attrs.Created.IsZero() {
 		ao.Created = &metav1.Time{Time: attrs.Created}
 	}
@@ -810,10 +874,11 @@ type BucketStatus struct {
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
 // +kubebuilder:printcolumn:name="STORAGE_CLASS",type="string",JSONPath=".spec.storageClass"
 // +kubebuilder:printcolumn:name="LOCATION",type="string",JSONPath=".spec.location"
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
-// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp},shortName=bkt
 type Bucket struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
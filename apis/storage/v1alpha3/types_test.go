@@ -550,8 +550,10 @@ func TestCopyToBucketLogging(t *testing.T) {
 }
 
 var (
+	testCORSMaxAge = int64(60)
+
 	testCORS = CORS{
-		MaxAge:          metav1.Duration{Duration: 1 * time.Minute},
+		MaxAgeSeconds:   &testCORSMaxAge,
 		Methods:         []string{"GET", "POST"},
 		Origins:         []string{},
 		ResponseHeaders: nil,
@@ -590,6 +592,11 @@ func TestCopyToCORS(t *testing.T) {
 		want storage.CORS
 	}{
 		{"Test", testCORS, testStorageCORS},
+		{"DeprecatedMaxAge", CORS{
+			MaxAge:  metav1.Duration{Duration: 1 * time.Minute},
+			Methods: []string{"GET", "POST"},
+			Origins: []string{},
+		}, testStorageCORS},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -875,7 +882,9 @@ func TestCopyBucketSpecAttrs(t *testing.T) {
 var (
 	testBucketOutputAttrs = BucketOutputAttrs{
 		Created:         func() *metav1.Time { t := metav1.NewTime(now); return &t }(),
+		ID:              "test-name",
 		RetentionPolicy: testRetentionPolicyStatus,
+		SelfLink:        "https://www.googleapis.com/storage/v1/b/test-name",
 	}
 
 	testStorageBucketAttrs3 = &storage.BucketAttrs{
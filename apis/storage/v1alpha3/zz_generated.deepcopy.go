@@ -333,6 +333,11 @@ func (in *BucketWebsite) DeepCopy() *BucketWebsite {
 func (in *CORS) DeepCopyInto(out *CORS) {
 	*out = *in
 	out.MaxAge = in.MaxAge
+	if in.MaxAgeSeconds != nil {
+		in, out := &in.MaxAgeSeconds, &out.MaxAgeSeconds
+		*out = new(int64)
+		**out = **in
+	}
 	if in.Methods != nil {
 		in, out := &in.Methods, &out.Methods
 		*out = make([]string, len(*in))
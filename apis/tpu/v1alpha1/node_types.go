@@ -0,0 +1,300 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// States of a Node.
+const (
+	NodeStateCreating   = "CREATING"
+	NodeStateReady      = "READY"
+	NodeStateRestarting = "RESTARTING"
+	NodeStateReimaging  = "REIMAGING"
+	NodeStateDeleting   = "DELETING"
+	NodeStateRepairing  = "REPAIRING"
+	NodeStateStopped    = "STOPPED"
+	NodeStateStopping   = "STOPPING"
+	NodeStateStarting   = "STARTING"
+	NodeStatePreempted  = "PREEMPTED"
+	NodeStateTerminated = "TERMINATED"
+)
+
+// NodeParameters defines parameters for a desired Cloud TPU VM Node.
+type NodeParameters struct {
+	// Location is the zone the node lives in, e.g. "us-west4-a". Defaults
+	// to the default zone of the ProviderConfig.
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="location is immutable"
+	Location string `json:"location,omitempty"`
+
+	// AcceleratorType is the type and size of the TPU slice, e.g.
+	// "v5litepod-8" or "v4-32".
+	// +immutable
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="acceleratorType is immutable"
+	AcceleratorType string `json:"acceleratorType"`
+
+	// RuntimeVersion is the TPU software version the node runs, e.g.
+	// "tpu-ubuntu2204-base".
+	// +immutable
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="runtimeVersion is immutable"
+	RuntimeVersion string `json:"runtimeVersion"`
+
+	// Description of the node. Maximum of 512 characters.
+	// +optional
+	// +kubebuilder:validation:MaxLength=512
+	Description *string `json:"description,omitempty"`
+
+	// Labels to apply to the node.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Metadata applied to the TPU VMs, such as startup-script and
+	// shutdown-script.
+	// +optional
+	Metadata map[string]string `json:"metadata,omitempty"`
+
+	// Tags applied to the TPU VMs, used to identify them as sources or
+	// targets of firewall rules.
+	// +optional
+	Tags []string `json:"tags,omitempty"`
+
+	// CIDRBlock is the /29 CIDR block the node selects its IP address from.
+	// Only used by TPU nodes that are not TPU VMs.
+	// +optional
+	// +immutable
+	CIDRBlock *string `json:"cidrBlock,omitempty"`
+
+	// NetworkConfig describes the network the TPU VMs are connected to.
+	// +optional
+	// +immutable
+	NetworkConfig *NetworkConfig `json:"networkConfig,omitempty"`
+
+	// SchedulingConfig describes how the node is scheduled.
+	// +optional
+	// +immutable
+	SchedulingConfig *SchedulingConfig `json:"schedulingConfig,omitempty"`
+
+	// ServiceAccount the TPU VMs run as.
+	// +optional
+	// +immutable
+	ServiceAccount *ServiceAccount `json:"serviceAccount,omitempty"`
+
+	// ShieldedInstanceConfig describes the Shielded VM options of the TPU
+	// VMs.
+	// +optional
+	// +immutable
+	ShieldedInstanceConfig *ShieldedInstanceConfig `json:"shieldedInstanceConfig,omitempty"`
+
+	// DataDisks are existing persistent disks attached to the TPU VMs.
+	// +optional
+	// +immutable
+	DataDisks []AttachedDisk `json:"dataDisks,omitempty"`
+}
+
+// NetworkConfig describes the network the TPU VMs of a node are connected
+// to.
+type NetworkConfig struct {
+	// Network is the URL of the network, e.g.
+	// "projects/my-project/global/networks/my-network". The "default"
+	// network is used if it is omitted.
+	// +optional
+	Network *string `json:"network,omitempty"`
+
+	// NetworkRef references a Network to retrieve its URL.
+	// +optional
+	NetworkRef *xpv1.Reference `json:"networkRef,omitempty"`
+
+	// NetworkSelector selects a reference to a Network to retrieve its URL.
+	// +optional
+	NetworkSelector *xpv1.Selector `json:"networkSelector,omitempty"`
+
+	// Subnetwork is the URL of the subnetwork, e.g.
+	// "projects/my-project/regions/us-west4/subnetworks/my-subnetwork".
+	// +optional
+	Subnetwork *string `json:"subnetwork,omitempty"`
+
+	// SubnetworkRef references a Subnetwork to retrieve its URL.
+	// +optional
+	SubnetworkRef *xpv1.Reference `json:"subnetworkRef,omitempty"`
+
+	// SubnetworkSelector selects a reference to a Subnetwork to retrieve its
+	// URL.
+	// +optional
+	SubnetworkSelector *xpv1.Selector `json:"subnetworkSelector,omitempty"`
+
+	// EnableExternalIPs associates external IP addresses with the TPU VMs.
+	// The network needs Private Google Access enabled otherwise.
+	// +optional
+	EnableExternalIPs *bool `json:"enableExternalIps,omitempty"`
+
+	// CanIPForward allows the TPU VMs to send and receive packets with
+	// non-matching source or destination IPs.
+	// +optional
+	CanIPForward *bool `json:"canIpForward,omitempty"`
+}
+
+// SchedulingConfig describes how a node is scheduled.
+type SchedulingConfig struct {
+	// Preemptible nodes are cheaper, but may be stopped at any time.
+	// +optional
+	Preemptible *bool `json:"preemptible,omitempty"`
+
+	// Reserved nodes are created using reserved capacity.
+	// +optional
+	Reserved *bool `json:"reserved,omitempty"`
+}
+
+// ServiceAccount the TPU VMs of a node run as.
+type ServiceAccount struct {
+	// Email of the service account. The default Compute Engine service
+	// account is used if it is omitted.
+	// +optional
+	Email *string `json:"email,omitempty"`
+
+	// EmailRef references a ServiceAccount to retrieve its email.
+	// +optional
+	EmailRef *xpv1.Reference `json:"emailRef,omitempty"`
+
+	// EmailSelector selects a reference to a ServiceAccount to retrieve its
+	// email.
+	// +optional
+	EmailSelector *xpv1.Selector `json:"emailSelector,omitempty"`
+
+	// Scope is the list of OAuth scopes available to the service account.
+	// Access to all Cloud APIs is allowed if it is omitted.
+	// +optional
+	Scope []string `json:"scope,omitempty"`
+}
+
+// ShieldedInstanceConfig describes the Shielded VM options of TPU VMs.
+type ShieldedInstanceConfig struct {
+	// EnableSecureBoot enables Secure Boot on the TPU VMs.
+	EnableSecureBoot bool `json:"enableSecureBoot"`
+}
+
+// An AttachedDisk is a persistent disk attached to the TPU VMs.
+type AttachedDisk struct {
+	// SourceDisk is the full path of an existing disk, e.g.
+	// "projects/my-project/zones/us-west4-a/disks/my-disk".
+	SourceDisk string `json:"sourceDisk"`
+
+	// Mode the disk is attached in. Defaults to READ_WRITE.
+	// +optional
+	// +kubebuilder:validation:Enum=READ_WRITE;READ_ONLY
+	Mode *string `json:"mode,omitempty"`
+}
+
+// A NetworkEndpoint is where a TPU worker can be reached.
+type NetworkEndpoint struct {
+	// IPAddress is the internal IP address of the worker.
+	IPAddress string `json:"ipAddress,omitempty"`
+
+	// Port of the worker.
+	Port int64 `json:"port,omitempty"`
+
+	// ExternalIP is the external IP address of the worker, if any.
+	ExternalIP string `json:"externalIp,omitempty"`
+}
+
+// A Symptom is a problem that occurred on a node.
+type Symptom struct {
+	// SymptomType is the type of the symptom, e.g. "OUT_OF_MEMORY".
+	SymptomType string `json:"symptomType,omitempty"`
+
+	// Details of the symptom.
+	Details string `json:"details,omitempty"`
+
+	// WorkerID identifies the worker the symptom occurred on.
+	WorkerID string `json:"workerId,omitempty"`
+
+	// CreateTime is the time the symptom occurred.
+	CreateTime string `json:"createTime,omitempty"`
+}
+
+// NodeObservation is used to show the observed state of the Node.
+type NodeObservation struct {
+	// Name is the resource name of the node, e.g.
+	// "projects/my-project/locations/us-west4-a/nodes/my-node".
+	Name string `json:"name,omitempty"`
+
+	// ID is a unique identifier of the node generated by Cloud TPU.
+	ID int64 `json:"id,omitempty"`
+
+	// State of the node.
+	State string `json:"state,omitempty"`
+
+	// Health of the node.
+	Health string `json:"health,omitempty"`
+
+	// HealthDescription describes why the node is unhealthy.
+	HealthDescription string `json:"healthDescription,omitempty"`
+
+	// NetworkEndpoints where the TPU workers of the node can be reached.
+	NetworkEndpoints []NetworkEndpoint `json:"networkEndpoints,omitempty"`
+
+	// Symptoms that occurred on the node.
+	Symptoms []Symptom `json:"symptoms,omitempty"`
+
+	// CreateTime is the time the node was created.
+	CreateTime string `json:"createTime,omitempty"`
+}
+
+// NodeSpec defines the desired state of a Node.
+type NodeSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       NodeParameters `json:"forProvider"`
+}
+
+// NodeStatus represents the observed state of a Node.
+type NodeStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          NodeObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// Node is a managed resource that represents a Cloud TPU VM node, a slice
+// of TPU accelerators and the VMs attached to them.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="HEALTH",type="string",JSONPath=".status.atProvider.health",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp},shortName=tpunode
+type Node struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NodeSpec   `json:"spec"`
+	Status NodeStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NodeList contains a list of Node types
+type NodeList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Node `json:"items"`
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+
+	computev1beta1 "github.com/crossplane-contrib/provider-gcp/apis/compute/v1beta1"
+	iamv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/iam/v1alpha1"
+)
+
+// ResolveReferences of this Node
+func (mg *Node) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	if nc := mg.Spec.ForProvider.NetworkConfig; nc != nil {
+		// Resolve spec.forProvider.networkConfig.network
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(nc.Network),
+			Reference:    nc.NetworkRef,
+			Selector:     nc.NetworkSelector,
+			To:           reference.To{Managed: &computev1beta1.Network{}, List: &computev1beta1.NetworkList{}},
+			Extract:      computev1beta1.NetworkURL(),
+		})
+		if err != nil {
+			return errors.Wrap(err, "spec.forProvider.networkConfig.network")
+		}
+		nc.Network = reference.ToPtrValue(rsp.ResolvedValue)
+		nc.NetworkRef = rsp.ResolvedReference
+
+		// Resolve spec.forProvider.networkConfig.subnetwork
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(nc.Subnetwork),
+			Reference:    nc.SubnetworkRef,
+			Selector:     nc.SubnetworkSelector,
+			To:           reference.To{Managed: &computev1beta1.Subnetwork{}, List: &computev1beta1.SubnetworkList{}},
+			Extract:      computev1beta1.SubnetworkURL(),
+		})
+		if err != nil {
+			return errors.Wrap(err, "spec.forProvider.networkConfig.subnetwork")
+		}
+		nc.Subnetwork = reference.ToPtrValue(rsp.ResolvedValue)
+		nc.SubnetworkRef = rsp.ResolvedReference
+	}
+
+	// Resolve spec.forProvider.serviceAccount.email
+	if sa := mg.Spec.ForProvider.ServiceAccount; sa != nil {
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(sa.Email),
+			Reference:    sa.EmailRef,
+			Selector:     sa.EmailSelector,
+			To:           reference.To{Managed: &iamv1alpha1.ServiceAccount{}, List: &iamv1alpha1.ServiceAccountList{}},
+			Extract:      iamv1alpha1.ServiceAccountEmail(),
+		})
+		if err != nil {
+			return errors.Wrap(err, "spec.forProvider.serviceAccount.email")
+		}
+		sa.Email = reference.ToPtrValue(rsp.ResolvedValue)
+		sa.EmailRef = rsp.ResolvedReference
+	}
+
+	return nil
+}
@@ -30,18 +30,54 @@ type ProviderConfigSpec struct {
 	// ProjectID is the project name (not numerical ID) of this GCP ProviderConfig.
 	ProjectID string `json:"projectID"`
 
+	// DefaultRegion is the region, e.g. "us-central1", of the managed
+	// resources that use this ProviderConfig and do not specify their region.
+	// +optional
+	DefaultRegion string `json:"defaultRegion,omitempty"`
+
+	// DefaultZone is the zone, e.g. "us-central1-a", of the managed resources
+	// that use this ProviderConfig and do not specify their zone.
+	// +optional
+	DefaultZone string `json:"defaultZone,omitempty"`
+
 	// ClientOptions can override default Google API client options
 	//+optional
 	ClientOptions *ClientOptions `json:"clientOptions,omitempty"`
 }
 
+// CredentialsSourceSecretManager indicates that the provider credentials are
+// stored in GCP Secret Manager, and read using the provider's ambient
+// credentials.
+const CredentialsSourceSecretManager xpv1.CredentialsSource = "SecretManager"
+
 // ProviderCredentials required to authenticate.
 type ProviderCredentials struct {
 	// Source of the provider credentials.
-	// +kubebuilder:validation:Enum=None;Secret;InjectedIdentity;Environment;Filesystem
+	// +kubebuilder:validation:Enum=None;Secret;InjectedIdentity;Environment;Filesystem;SecretManager
 	Source xpv1.CredentialsSource `json:"source"`
 
 	xpv1.CommonCredentialSelectors `json:",inline"`
+
+	// SecretManagerRef selects the GCP Secret Manager secret version that
+	// holds the credentials. Required when the source is SecretManager.
+	// +optional
+	SecretManagerRef *SecretManagerSelector `json:"secretManagerRef,omitempty"`
+}
+
+// A SecretManagerSelector selects a secret version in GCP Secret Manager.
+type SecretManagerSelector struct {
+	// Project that holds the secret. Defaults to the project of the
+	// ProviderConfig.
+	// +optional
+	Project string `json:"project,omitempty"`
+
+	// Secret is the name of the secret.
+	Secret string `json:"secret"`
+
+	// Version of the secret, or latest.
+	// +optional
+	// +kubebuilder:default=latest
+	Version string `json:"version,omitempty"`
 }
 
 // ClientOptions are options for a Google API client.
@@ -64,6 +100,7 @@ type ProviderConfigStatus struct {
 // A ProviderConfig configures how GCP controller should connect to GCP API.
 // +kubebuilder:printcolumn:name="PROJECT-ID",type="string",JSONPath=".spec.projectID"
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="USERS",type="integer",JSONPath=".status.users"
 // +kubebuilder:printcolumn:name="SECRET-NAME",type="string",JSONPath=".spec.credentialsSecretRef.name",priority=1
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,provider,gcp}
 // +kubebuilder:subresource:status
@@ -0,0 +1,41 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+// Timeouts override how long the long-running GCP operations that create,
+// update or delete an external resource may take before the managed resource
+// reports that they timed out. Each is a positive duration such as "90m" or
+// "3h". Operations whose timeout is not set may take as long as they need.
+type Timeouts struct {
+	// Create is how long the external resource may take to be created.
+	// +optional
+	// +kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(s|m|h))+$`
+	// +kubebuilder:validation:XValidation:rule="duration(self) > duration('0s')",message="create must be a positive duration"
+	Create *string `json:"create,omitempty"`
+
+	// Update is how long an update of the external resource may take.
+	// +optional
+	// +kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(s|m|h))+$`
+	// +kubebuilder:validation:XValidation:rule="duration(self) > duration('0s')",message="update must be a positive duration"
+	Update *string `json:"update,omitempty"`
+
+	// Delete is how long the external resource may take to be deleted.
+	// +optional
+	// +kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(s|m|h))+$`
+	// +kubebuilder:validation:XValidation:rule="duration(self) > duration('0s')",message="delete must be a positive duration"
+	Delete *string `json:"delete,omitempty"`
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"gopkg.in/alecthomas/kingpin.v2"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+
+	_ "k8s.io/client-go/plugin/pkg/client/auth/gcp"
+
+	"github.com/crossplane-contrib/provider-gcp/apis"
+	"github.com/crossplane-contrib/provider-gcp/pkg/e2e"
+)
+
+func main() {
+	var (
+		app            = kingpin.New(filepath.Base(os.Args[0]), "Provisions and destroys a matrix of GCP managed resources in a sandbox project to smoke test a running provider.").DefaultEnvars()
+		debug          = app.Flag("debug", "Run with debug logging.").Short('d').Bool()
+		providerConfig = app.Flag("provider-config", "ProviderConfig of the sandbox project every resource is reconciled with.").Default("default").String()
+		zone           = app.Flag("zone", "Zone zonal resources are created in. Regional resources are created in its region.").Default("us-central1-a").String()
+		runID          = app.Flag("run-id", "ID appended to the name of every resource. Defaults to one derived from the current time.").Default(strconv.FormatInt(time.Now().Unix(), 36)).String()
+		cases          = app.Flag("case", "Case to run. May be repeated. Defaults to every case.").Strings()
+		junit          = app.Flag("junit", "Path to write a JUnit XML report to.").String()
+		readyTimeout   = app.Flag("ready-timeout", "How long to wait for a resource to become ready.").Default("30m").Duration()
+		deleteTimeout  = app.Flag("delete-timeout", "How long to wait for a resource to be deleted.").Default("30m").Duration()
+	)
+	kingpin.MustParse(app.Parse(os.Args[1:]))
+
+	zl := zap.New(zap.UseDevMode(*debug))
+	log := logging.NewLogrLogger(zl.WithName("e2e"))
+
+	cfg, err := ctrl.GetConfig()
+	kingpin.FatalIfError(err, "Cannot get API server rest config")
+
+	s := runtime.NewScheme()
+	kingpin.FatalIfError(apis.AddToScheme(s), "Cannot add GCP APIs to scheme")
+	kube, err := client.New(cfg, client.Options{Scheme: s})
+	kingpin.FatalIfError(err, "Cannot create API server client")
+
+	matrix := e2e.Matrix(e2e.MatrixOptions{RunID: *runID, ProviderConfig: *providerConfig, Zone: *zone})
+	if len(*cases) > 0 {
+		matrix, err = e2e.Select(matrix, *cases...)
+		kingpin.FatalIfError(err, "Cannot select cases")
+	}
+	log.Info("Starting run", "run", *runID, "cases", len(matrix))
+
+	// Resources created before an interrupt are still deleted, but a
+	// second interrupt exits immediately.
+	ctx := ctrl.SetupSignalHandler()
+	r := e2e.NewRunner(kube, e2e.WithLogger(log), e2e.WithTimeouts(*readyTimeout, *deleteTimeout))
+	results := make([]e2e.Result, len(matrix))
+	var wg sync.WaitGroup
+	for i := range matrix {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i] = r.Run(ctx, matrix[i])
+		}(i)
+	}
+	wg.Wait()
+
+	if *junit != "" {
+		f, err := os.Create(*junit)
+		kingpin.FatalIfError(err, "Cannot create JUnit report")
+		kingpin.FatalIfError(e2e.WriteJUnit(f, "provider-gcp-e2e", results), "Cannot write JUnit report")
+		kingpin.FatalIfError(f.Close(), "Cannot write JUnit report")
+	}
+
+	failed := 0
+	for _, res := range results {
+		if res.Err != nil {
+			failed++
+			log.Info("Case failed", "case", res.Case, "duration", res.Duration, "error", res.Err, "leaked", res.Leaked)
+			continue
+		}
+		log.Info("Case passed", "case", res.Case, "duration", res.Duration)
+	}
+	if failed > 0 {
+		kingpin.Fatalf("%d of %d cases failed", failed, len(results))
+	}
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/alecthomas/kingpin.v2"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/crossplane-contrib/provider-gcp/apis"
+	"github.com/crossplane-contrib/provider-gcp/pkg/export"
+)
+
+func main() {
+	var (
+		app     = kingpin.New(filepath.Base(os.Args[0]), "Renders the GCP API request body the provider sends to create each managed resource in a set of manifests.").DefaultEnvars()
+		project = app.Flag("project", "ID of the project the managed resources are created in.").Required().String()
+		files   = app.Arg("file", "Manifest to read managed resources from. Reads stdin if none are supplied.").ExistingFiles()
+	)
+	kingpin.MustParse(app.Parse(os.Args[1:]))
+
+	s := runtime.NewScheme()
+	kingpin.FatalIfError(apis.AddToScheme(s), "Cannot add GCP APIs to scheme")
+
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	write := func(name string, r io.Reader) {
+		mgs, err := export.Read(r, s)
+		kingpin.FatalIfError(err, "Cannot read managed resources from %s", name)
+		for _, mg := range mgs {
+			c, err := export.Export(*project, mg)
+			kingpin.FatalIfError(err, "Cannot export %s %s", mg.GetObjectKind().GroupVersionKind().Kind, mg.GetName())
+			kingpin.FatalIfError(enc.Encode(c), "Cannot write configuration")
+		}
+	}
+
+	if len(*files) == 0 {
+		write("stdin", os.Stdin)
+		return
+	}
+	for _, f := range *files {
+		b, err := os.ReadFile(filepath.Clean(f))
+		kingpin.FatalIfError(err, "Cannot read %s", f)
+		write(f, bytes.NewReader(b))
+	}
+}
@@ -0,0 +1,58 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/alecthomas/kingpin.v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+
+	_ "k8s.io/client-go/plugin/pkg/client/auth/gcp"
+
+	"github.com/crossplane-contrib/provider-gcp/pkg/migration"
+)
+
+func main() {
+	var (
+		app    = kingpin.New(filepath.Base(os.Args[0]), "Migrates persisted GCP managed resources to the storage version of their CRDs.").DefaultEnvars()
+		debug  = app.Flag("debug", "Run with debug logging.").Short('d').Bool()
+		groups = app.Flag("group", "API group whose custom resources should be migrated. May be repeated.").Default("container.gcp.crossplane.io").Strings()
+		dryRun = app.Flag("dry-run", "Report what would be migrated without writing anything.").Bool()
+	)
+	kingpin.MustParse(app.Parse(os.Args[1:]))
+
+	zl := zap.New(zap.UseDevMode(*debug))
+	log := logging.NewLogrLogger(zl.WithName("migrator"))
+
+	cfg, err := ctrl.GetConfig()
+	kingpin.FatalIfError(err, "Cannot get API server rest config")
+
+	kube, err := client.New(cfg, client.Options{})
+	kingpin.FatalIfError(err, "Cannot create API server client")
+
+	m := migration.NewMigrator(kube, migration.WithLogger(log), migration.WithDryRun(*dryRun))
+	for _, g := range *groups {
+		kingpin.FatalIfError(m.Migrate(context.Background(), g), "Cannot migrate API group %s", g)
+	}
+}
@@ -42,7 +42,10 @@ import (
 	"github.com/crossplane-contrib/provider-gcp/apis"
 	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
 	gcp "github.com/crossplane-contrib/provider-gcp/pkg/controller"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/setup"
 	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+	"github.com/crossplane-contrib/provider-gcp/pkg/priority"
+	"github.com/crossplane-contrib/provider-gcp/pkg/tracing"
 )
 
 func main() {
@@ -54,10 +57,25 @@ func main() {
 		syncInterval     = app.Flag("sync", "How often all resources will be double-checked for drift from the desired state.").Short('s').Default("1h").Duration()
 		pollInterval     = app.Flag("poll", "How often individual resources will be checked for drift from the desired state").Default("1m").Duration()
 		maxReconcileRate = app.Flag("max-reconcile-rate", "The global maximum rate per second at which resources may checked for drift from the desired state.").Default("10").Int()
+		priorityWeights  = app.Flag("reconcile-priority", "Weight of a kind of resource, e.g. CloudSQLInstance.database.gcp.crossplane.io=5. When reconciles are waiting for the max reconcile rate, those of heavier kinds are let through sooner. All other kinds have a weight of 1. May be repeated.").PlaceHolder("KIND=WEIGHT").StringMap()
 
 		namespace                  = app.Flag("namespace", "Namespace used to set as default scope in default secret store config.").Default("crossplane-system").Envar("POD_NAMESPACE").String()
 		enableExternalSecretStores = app.Flag("enable-external-secret-stores", "Enable support for ExternalSecretStores.").Default("false").Envar("ENABLE_EXTERNAL_SECRET_STORES").Bool()
 		essTLSCertsPath            = app.Flag("ess-tls-cert-dir", "Path of ESS TLS certificates.").Envar("ESS_TLS_CERTS_DIR").String()
+		enableExpectationCache     = app.Flag("enable-expectation-cache", "Skip reading unchanged resources from GCP until the poll interval has elapsed.").Default("false").Envar("ENABLE_EXPECTATION_CACHE").Bool()
+		enableQuotaPreflight       = app.Flag("enable-quota-preflight", "Check regional Compute Engine quota before creating GKE node pools.").Default("false").Envar("ENABLE_QUOTA_PREFLIGHT").Bool()
+		enablePermissionPreflight  = app.Flag("enable-permission-preflight", "Report the IAM permissions the provider credentials are missing for each kind of resource.").Default("false").Envar("ENABLE_PERMISSION_PREFLIGHT").Bool()
+		enableLocationPreflight    = app.Flag("enable-location-preflight", "Check GKE cluster and node pool locations against the resource locations organization policy before creating them.").Default("false").Envar("ENABLE_LOCATION_PREFLIGHT").Bool()
+		enableBatchObserve         = app.Flag("enable-batch-observe", "Observe Buckets and Topics from a periodic listing of their project.").Default("false").Envar("ENABLE_BATCH_OBSERVE").Bool()
+		batchObserveTTL            = app.Flag("batch-observe-ttl", "How long a listing of Buckets or Topics is used before the project is listed again.").Default("30s").Envar("BATCH_OBSERVE_TTL").Duration()
+		enableProjectStateCheck    = app.Flag("enable-project-state-check", "Stop calling GCP for resources whose project is pending deletion or has billing disabled, and let resources whose project is pending deletion be deleted.").Default("false").Envar("ENABLE_PROJECT_STATE_CHECK").Bool()
+		projectStateBackoff        = app.Flag("project-state-backoff", "How long GCP is not called for resources whose project was found to be pending deletion or to have billing disabled.").Default("15m").Envar("PROJECT_STATE_BACKOFF").Duration()
+		enableDiscovery            = app.Flag("enable-discovery", "Create observe-only managed resources for the existing resources in the project of each ProviderConfig annotated to discover them.").Default("false").Envar("ENABLE_DISCOVERY").Bool()
+		discoveryInterval          = app.Flag("discovery-interval", "How often the project of a ProviderConfig is listed again to discover new resources.").Default("10m").Envar("DISCOVERY_INTERVAL").Duration()
+		enableGKEBetaAPI           = app.Flag("enable-gke-beta-api", "Use the beta GKE API for Clusters and NodePools, which is required to configure beta-only fields.").Default("false").Envar("ENABLE_GKE_BETA_API").Bool()
+		dryRun                     = app.Flag("dry-run", "Observe resources in GCP but never create, update or delete them.").Default("false").Envar("DRY_RUN").Bool()
+		otlpEndpoint               = app.Flag("otlp-endpoint", "OTLP gRPC collector endpoint, e.g. otel-collector:4317, to which reconcile and GCP API spans are exported. Tracing is disabled if unset.").Envar("OTLP_ENDPOINT").String()
+		otlpInsecure               = app.Flag("otlp-insecure", "Export spans to the OTLP collector without TLS.").Default("false").Envar("OTLP_INSECURE").Bool()
 	)
 	kingpin.MustParse(app.Parse(os.Args[1:]))
 
@@ -92,12 +110,17 @@ func main() {
 	kingpin.FatalIfError(err, "Cannot create controller manager")
 	kingpin.FatalIfError(apis.AddToScheme(mgr.GetScheme()), "Cannot add GCP APIs to scheme")
 
-	o := controller.Options{
-		Logger:                  log,
-		MaxConcurrentReconciles: *maxReconcileRate,
-		PollInterval:            *pollInterval,
-		GlobalRateLimiter:       ratelimiter.NewGlobal(*maxReconcileRate),
-		Features:                &feature.Flags{},
+	weights, err := priority.ParseWeights(*priorityWeights)
+	kingpin.FatalIfError(err, "Cannot parse reconcile priorities")
+
+	o := setup.Options{
+		Options: controller.Options{
+			Logger:                  log,
+			MaxConcurrentReconciles: *maxReconcileRate,
+			PollInterval:            *pollInterval,
+			GlobalRateLimiter:       priority.NewRateLimiter(*maxReconcileRate, weights),
+			Features:                &feature.Flags{},
+		},
 	}
 
 	if *enableExternalSecretStores {
@@ -128,6 +151,67 @@ func main() {
 		})), "cannot create default store config")
 	}
 
+	if *enableExpectationCache {
+		o.Features.Enable(features.EnableAlphaExpectationCache)
+		log.Info("Alpha feature enabled", "flag", features.EnableAlphaExpectationCache)
+	}
+
+	if *enableQuotaPreflight {
+		o.Features.Enable(features.EnableAlphaQuotaPreflight)
+		log.Info("Alpha feature enabled", "flag", features.EnableAlphaQuotaPreflight)
+	}
+
+	if *enablePermissionPreflight {
+		o.Features.Enable(features.EnableAlphaPermissionPreflight)
+		log.Info("Alpha feature enabled", "flag", features.EnableAlphaPermissionPreflight)
+	}
+
+	if *enableLocationPreflight {
+		o.Features.Enable(features.EnableAlphaLocationPreflight)
+		log.Info("Alpha feature enabled", "flag", features.EnableAlphaLocationPreflight)
+	}
+
+	if *enableBatchObserve {
+		o.Features.Enable(features.EnableAlphaBatchObserve)
+		log.Info("Alpha feature enabled", "flag", features.EnableAlphaBatchObserve, "ttl", *batchObserveTTL)
+		o.BatchObserveTTL = *batchObserveTTL
+	}
+
+	if *enableProjectStateCheck {
+		o.Features.Enable(features.EnableAlphaProjectStateCheck)
+		log.Info("Alpha feature enabled", "flag", features.EnableAlphaProjectStateCheck, "backoff", *projectStateBackoff)
+		o.ProjectStateBackoff = *projectStateBackoff
+	}
+
+	if *enableDiscovery {
+		o.Features.Enable(features.EnableAlphaDiscovery)
+		log.Info("Alpha feature enabled", "flag", features.EnableAlphaDiscovery, "interval", *discoveryInterval)
+		o.DiscoveryInterval = *discoveryInterval
+	}
+
+	if *enableGKEBetaAPI {
+		o.Features.Enable(features.EnableBetaGKEAPI)
+		log.Info("Beta feature enabled", "flag", features.EnableBetaGKEAPI)
+	}
+
+	if *dryRun {
+		o.Features.Enable(features.EnableAlphaDryRun)
+		log.Info("Alpha feature enabled", "flag", features.EnableAlphaDryRun)
+	}
+
+	// Tracing must be set up before the controllers, which are only
+	// instrumented if it is enabled.
+	if *otlpEndpoint != "" {
+		shutdown, err := tracing.Setup(context.Background(), *otlpEndpoint, *otlpInsecure)
+		kingpin.FatalIfError(err, "Cannot setup tracing")
+		log.Info("Tracing enabled", "endpoint", *otlpEndpoint)
+		defer func() {
+			if err := shutdown(context.Background()); err != nil {
+				log.Info("Cannot flush spans", "error", err)
+			}
+		}()
+	}
+
 	kingpin.FatalIfError(gcp.Setup(mgr, o), "Cannot setup GCP controllers")
 	kingpin.FatalIfError(mgr.Start(ctrl.SetupSignalHandler()), "Cannot start controller manager")
 }
@@ -0,0 +1,101 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package apierror explains the errors that managed resource reconcilers get
+// from the Google APIs, using the class gcp.ClassifyError assigns them, so
+// that users can tell an error that will go away on its own from one that
+// they must act on.
+package apierror
+
+import (
+	"context"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	msgQuota            = "GCP quota or rate limit exceeded; the request will be retried with backoff"
+	msgRetryable        = "GCP failed temporarily; the request will be retried with backoff"
+	msgInvalid          = "GCP rejected the request as invalid; it will keep failing until the managed resource is changed"
+	msgPermissionDenied = "GCP denied permission for the request; it will keep failing until the ProviderConfig's credentials are granted it"
+	msgTerminal         = "GCP cannot serve the request; it will keep failing until the managed resource is changed"
+)
+
+// Explain wraps the supplied error with whether and when the request that
+// caused it may succeed, according to its class. Errors that did not come
+// from a Google API, and errors that controllers handle themselves, such as
+// not found and conflict errors, are returned unchanged.
+func Explain(err error) error {
+	switch gcp.ClassifyError(err) { //nolint:exhaustive
+	case gcp.ErrorClassQuota:
+		return errors.Wrap(err, msgQuota)
+	case gcp.ErrorClassRetryable:
+		return errors.Wrap(err, msgRetryable)
+	case gcp.ErrorClassInvalid:
+		return errors.Wrap(err, msgInvalid)
+	case gcp.ErrorClassPermissionDenied:
+		return errors.Wrap(err, msgPermissionDenied)
+	case gcp.ErrorClassTerminal:
+		return errors.Wrap(err, msgTerminal)
+	}
+	return err
+}
+
+// WithExplanations wraps the supplied ExternalConnecter so that the errors of
+// each observe, create, update and delete of an external resource are
+// explained. See Explain.
+func WithExplanations(c managed.ExternalConnecter) managed.ExternalConnecter {
+	return &connecter{connecter: c}
+}
+
+type connecter struct {
+	connecter managed.ExternalConnecter
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	ec, err := c.connecter.Connect(ctx, mg)
+	if err != nil {
+		return nil, Explain(err)
+	}
+	return &external{client: ec}, nil
+}
+
+type external struct {
+	client managed.ExternalClient
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	obs, err := e.client.Observe(ctx, mg)
+	return obs, Explain(err)
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cre, err := e.client.Create(ctx, mg)
+	return cre, Explain(err)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	upd, err := e.client.Update(ctx, mg)
+	return upd, Explain(err)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	return Explain(e.client.Delete(ctx, mg))
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apierror
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/pubsub/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+var errBoom = errors.New("boom")
+
+func TestExplain(t *testing.T) {
+	quota := &googleapi.Error{Code: http.StatusTooManyRequests}
+	unavailable := &googleapi.Error{Code: http.StatusServiceUnavailable}
+	invalid := &googleapi.Error{Code: http.StatusBadRequest}
+	forbidden := status.Error(codes.PermissionDenied, "boom")
+	gone := &googleapi.Error{Code: http.StatusGone}
+	notFound := &googleapi.Error{Code: http.StatusNotFound}
+
+	cases := map[string]struct {
+		reason string
+		err    error
+		want   error
+	}{
+		"Nil": {
+			reason: "A nil error should be returned unchanged.",
+		},
+		"NotGoogleAPI": {
+			reason: "An error that did not come from a Google API should be returned unchanged.",
+			err:    errBoom,
+			want:   errBoom,
+		},
+		"NotFound": {
+			reason: "A not found error should be left to the controller to handle.",
+			err:    notFound,
+			want:   notFound,
+		},
+		"Quota": {
+			reason: "A quota error should be explained as one that will be retried.",
+			err:    quota,
+			want:   errors.Wrap(quota, msgQuota),
+		},
+		"Retryable": {
+			reason: "A transient error should be explained as one that will be retried.",
+			err:    errors.Wrap(unavailable, "cannot get topic"),
+			want:   errors.Wrap(errors.Wrap(unavailable, "cannot get topic"), msgRetryable),
+		},
+		"Invalid": {
+			reason: "An invalid request should be explained as one that needs the managed resource to change.",
+			err:    invalid,
+			want:   errors.Wrap(invalid, msgInvalid),
+		},
+		"PermissionDenied": {
+			reason: "A denied request should be explained as one that needs permissions to be granted.",
+			err:    forbidden,
+			want:   errors.Wrap(forbidden, msgPermissionDenied),
+		},
+		"Terminal": {
+			reason: "A request that cannot be served should be explained as one that needs the managed resource to change.",
+			err:    gone,
+			want:   errors.Wrap(gone, msgTerminal),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := Explain(tc.err)
+			if diff := cmp.Diff(tc.want, got, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nExplain(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestWithExplanations(t *testing.T) {
+	quota := &googleapi.Error{Code: http.StatusTooManyRequests}
+	c := WithExplanations(managed.ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+		return &managed.ExternalClientFns{
+			ObserveFn: func(_ context.Context, _ resource.Managed) (managed.ExternalObservation, error) {
+				return managed.ExternalObservation{}, quota
+			},
+			DeleteFn: func(_ context.Context, _ resource.Managed) error {
+				return errBoom
+			},
+		}, nil
+	}))
+
+	ec, err := c.Connect(context.Background(), &v1alpha1.Topic{})
+	if err != nil {
+		t.Fatalf("Connect(...): %s", err)
+	}
+	_, err = ec.Observe(context.Background(), &v1alpha1.Topic{})
+	if diff := cmp.Diff(errors.Wrap(quota, msgQuota), err, test.EquateErrors()); diff != "" {
+		t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+	}
+	if diff := cmp.Diff(gcp.ErrorClassQuota, gcp.ClassifyError(err)); diff != "" {
+		t.Errorf("ClassifyError(...): -want class of explained error, +got:\n%s", diff)
+	}
+	err = ec.Delete(context.Background(), &v1alpha1.Topic{})
+	if diff := cmp.Diff(errBoom, err, test.EquateErrors()); diff != "" {
+		t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+	}
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package batch lets managed resource reconcilers observe many external
+// resources of the same kind with one periodic LIST call per project, rather
+// than a GET call per resource.
+package batch
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// A ListFn lists every external resource of a kind in a project, keyed by
+// the name it is observed by.
+type ListFn[T any] func(ctx context.Context) (map[string]T, error)
+
+// A GetFn gets one external resource.
+type GetFn[T any] func(ctx context.Context) (T, error)
+
+type listing[T any] struct {
+	mu      sync.Mutex
+	items   map[string]T
+	listed  time.Time
+	changed map[string]bool
+}
+
+// A Lister serves observations of external resources of one kind from a
+// listing of their project. Only resources found in a listing are served
+// from it; any other resource is read with its GetFn, so that a resource
+// that was created since the project was listed, or that lives in another
+// project, is never reported missing.
+type Lister[T any] struct {
+	ttl time.Duration
+	now func() time.Time
+
+	mu       sync.Mutex
+	listings map[string]*listing[T]
+}
+
+// NewLister returns a Lister that lists a project again once its listing is
+// older than the supplied TTL.
+func NewLister[T any](ttl time.Duration) *Lister[T] {
+	return &Lister[T]{ttl: ttl, now: time.Now, listings: map[string]*listing[T]{}}
+}
+
+func (l *Lister[T]) listing(project string) *listing[T] {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	lst, ok := l.listings[project]
+	if !ok {
+		lst = &listing[T]{}
+		l.listings[project] = lst
+	}
+	return lst
+}
+
+// Get returns the named external resource of the supplied project. It is
+// served from the project's listing if the resource was found in it and has
+// not changed since. The project is listed again if its listing is older
+// than the TTL. Concurrent callers wait for a single listing of the project.
+// The resource is read using the supplied GetFn if it cannot be served from
+// the listing.
+func (l *Lister[T]) Get(ctx context.Context, project, name string, list ListFn[T], get GetFn[T]) (T, error) {
+	lst := l.listing(project)
+	lst.mu.Lock()
+	if lst.items == nil || l.now().Sub(lst.listed) >= l.ttl {
+		// A project that cannot be listed is not listed again until the TTL
+		// elapses; its resources are all read using their GetFn meanwhile.
+		items, err := list(ctx)
+		if err != nil {
+			items = map[string]T{}
+		}
+		lst.items, lst.listed, lst.changed = items, l.now(), map[string]bool{}
+	}
+	item, ok := lst.items[name]
+	ok = ok && !lst.changed[name]
+	lst.mu.Unlock()
+	if !ok {
+		return get(ctx)
+	}
+	return item, nil
+}
+
+// Changed records that the named external resource of the supplied project
+// was created, updated or deleted, so that it is no longer served from the
+// project's current listing.
+func (l *Lister[T]) Changed(project, name string) {
+	lst := l.listing(project)
+	lst.mu.Lock()
+	defer lst.mu.Unlock()
+	if lst.changed != nil {
+		lst.changed[name] = true
+	}
+}
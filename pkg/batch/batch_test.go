@@ -0,0 +1,151 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package batch
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+var (
+	ttl     = time.Minute
+	t0      = time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+	errBoom = errors.New("boom")
+)
+
+func TestGet(t *testing.T) {
+	type want struct {
+		item  string
+		err   error
+		lists int
+		gets  int
+	}
+	cases := map[string]struct {
+		items   map[string]string
+		listErr error
+		elapsed time.Duration
+		changed string
+		name    string
+		want    want
+	}{
+		"Listed": {
+			items:   map[string]string{"a": "listed"},
+			elapsed: ttl / 2,
+			name:    "a",
+			want:    want{item: "listed", lists: 1},
+		},
+		"Expired": {
+			items:   map[string]string{"a": "listed"},
+			elapsed: ttl,
+			name:    "a",
+			want:    want{item: "listed", lists: 2},
+		},
+		"NotListed": {
+			items:   map[string]string{"a": "listed"},
+			elapsed: ttl / 2,
+			name:    "b",
+			want:    want{item: "got", lists: 1, gets: 2},
+		},
+		"Changed": {
+			items:   map[string]string{"a": "listed"},
+			elapsed: ttl / 2,
+			changed: "a",
+			name:    "a",
+			want:    want{item: "got", lists: 1, gets: 1},
+		},
+		"ChangedThenListed": {
+			items:   map[string]string{"a": "listed"},
+			elapsed: ttl,
+			changed: "a",
+			name:    "a",
+			want:    want{item: "listed", lists: 2},
+		},
+		"CannotList": {
+			listErr: errBoom,
+			elapsed: ttl / 2,
+			name:    "a",
+			want:    want{item: "got", lists: 1, gets: 2},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			now := t0
+			l := NewLister[string](ttl)
+			l.now = func() time.Time { return now }
+
+			lists, gets := 0, 0
+			list := func(_ context.Context) (map[string]string, error) {
+				lists++
+				return tc.items, tc.listErr
+			}
+			get := func(_ context.Context) (string, error) {
+				gets++
+				return "got", nil
+			}
+
+			// Prime the listing, then look up the resource again later.
+			if _, err := l.Get(context.Background(), "project", tc.name, list, get); err != nil {
+				t.Fatalf("Get(...): unexpected error: %v", err)
+			}
+			if tc.changed != "" {
+				l.Changed("project", tc.changed)
+			}
+			now = now.Add(tc.elapsed)
+			got, err := l.Get(context.Background(), "project", tc.name, list, get)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Get(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.item, got); diff != "" {
+				t.Errorf("Get(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.lists, lists); diff != "" {
+				t.Errorf("Get(...): -want lists, +got lists:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.gets, gets); diff != "" {
+				t.Errorf("Get(...): -want gets, +got gets:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetProjects(t *testing.T) {
+	l := NewLister[string](ttl)
+	listed := map[string]int{}
+	for _, project := range []string{"a", "b", "a"} {
+		project := project
+		list := func(_ context.Context) (map[string]string, error) {
+			listed[project]++
+			return map[string]string{"r": project}, nil
+		}
+		got, err := l.Get(context.Background(), project, "r", list, func(_ context.Context) (string, error) { return "", errBoom })
+		if err != nil {
+			t.Fatalf("Get(...): unexpected error: %v", err)
+		}
+		if diff := cmp.Diff(project, got); diff != "" {
+			t.Errorf("Get(...): -want, +got:\n%s", diff)
+		}
+	}
+	if diff := cmp.Diff(map[string]int{"a": 1, "b": 1}, listed); diff != "" {
+		t.Errorf("Get(...): -want lists, +got lists:\n%s", diff)
+	}
+}
@@ -38,6 +38,7 @@ func GenerateAddress(name string, in v1beta1.AddressParameters, address *compute
 	address.IpVersion = gcp.StringValue(in.IPVersion)
 	address.Name = name
 	address.Network = gcp.StringValue(in.Network)
+	address.NetworkTier = gcp.StringValue(in.NetworkTier)
 	address.PrefixLength = gcp.Int64Value(in.PrefixLength)
 	address.Purpose = gcp.StringValue(in.Purpose)
 	address.Subnetwork = gcp.StringValue(in.Subnetwork)
@@ -53,6 +54,7 @@ func LateInitializeSpec(p *v1beta1.AddressParameters, observed compute.Address)
 	p.Description = gcp.LateInitializeString(p.Description, observed.Description)
 	p.IPVersion = gcp.LateInitializeString(p.IPVersion, observed.IpVersion)
 	p.Network = gcp.LateInitializeString(p.Network, observed.Network)
+	p.NetworkTier = gcp.LateInitializeString(p.NetworkTier, observed.NetworkTier)
 	p.PrefixLength = gcp.LateInitializeInt64(p.PrefixLength, observed.PrefixLength)
 	p.Purpose = gcp.LateInitializeString(p.Purpose, observed.Purpose)
 	p.Subnetwork = gcp.LateInitializeString(p.Subnetwork, observed.Subnetwork)
@@ -32,6 +32,7 @@ var (
 	addressType        = "coolType"
 	ipVersion          = "coolVersion"
 	network            = "coolNetwork"
+	networkTier        = "STANDARD"
 	purpose            = "beingCool"
 	subnetwork         = "coolSubnet"
 	region             = "coolRegion"
@@ -50,6 +51,7 @@ func params(m ...func(*v1beta1.AddressParameters)) *v1beta1.AddressParameters {
 		Description:  &description,
 		IPVersion:    &ipVersion,
 		Network:      &network,
+		NetworkTier:  &networkTier,
 		PrefixLength: &prefixLength,
 		Purpose:      &purpose,
 		Subnetwork:   &subnetwork,
@@ -71,6 +73,7 @@ func address(m ...func(*compute.Address)) *compute.Address {
 		IpVersion:    ipVersion,
 		Name:         name,
 		Network:      network,
+		NetworkTier:  networkTier,
 		PrefixLength: prefixLength,
 		Purpose:      purpose,
 		Subnetwork:   subnetwork,
@@ -0,0 +1,65 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apigeeenvgroup
+
+import (
+	"fmt"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	apigee "google.golang.org/api/apigee/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/apigee/v1alpha1"
+)
+
+const (
+	parentFormat = "organizations/%s"
+	nameFormat   = "organizations/%s/envgroups/%s"
+)
+
+// GetParent returns the organization an EnvGroup belongs to.
+func GetParent(org string) string {
+	return fmt.Sprintf(parentFormat, org)
+}
+
+// GetFullyQualifiedName builds the relative resource name of an EnvGroup.
+func GetFullyQualifiedName(org, name string) string {
+	return fmt.Sprintf(nameFormat, org, name)
+}
+
+// GenerateEnvGroup produces an EnvironmentGroup that is configured via given
+// EnvGroupParameters.
+func GenerateEnvGroup(name string, p v1alpha1.EnvGroupParameters) *apigee.GoogleCloudApigeeV1EnvironmentGroup {
+	return &apigee.GoogleCloudApigeeV1EnvironmentGroup{
+		Name:      name,
+		Hostnames: p.Hostnames,
+	}
+}
+
+// GenerateObservation produces an EnvGroupObservation from the supplied
+// EnvironmentGroup.
+func GenerateObservation(g apigee.GoogleCloudApigeeV1EnvironmentGroup) v1alpha1.EnvGroupObservation {
+	return v1alpha1.EnvGroupObservation{
+		State: g.State,
+	}
+}
+
+// IsUpToDate checks whether EnvironmentGroup is configured with given
+// EnvGroupParameters. The order of the hostnames does not matter.
+func IsUpToDate(p v1alpha1.EnvGroupParameters, g apigee.GoogleCloudApigeeV1EnvironmentGroup) bool {
+	return cmp.Equal(p.Hostnames, g.Hostnames, cmpopts.EquateEmpty(), cmpopts.SortSlices(func(a, b string) bool { return a < b }))
+}
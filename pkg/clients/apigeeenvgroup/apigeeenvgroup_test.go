@@ -0,0 +1,85 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apigeeenvgroup
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	apigee "google.golang.org/api/apigee/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/apigee/v1alpha1"
+)
+
+func TestGetFullyQualifiedName(t *testing.T) {
+	if diff := cmp.Diff("organizations/fooproject", GetParent("fooproject")); diff != "" {
+		t.Errorf("GetParent(...): -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff("organizations/fooproject/envgroups/cool-group", GetFullyQualifiedName("fooproject", "cool-group")); diff != "" {
+		t.Errorf("GetFullyQualifiedName(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateEnvGroup(t *testing.T) {
+	want := &apigee.GoogleCloudApigeeV1EnvironmentGroup{
+		Name:      "cool-group",
+		Hostnames: []string{"a.example.com"},
+	}
+	got := GenerateEnvGroup("cool-group", v1alpha1.EnvGroupParameters{Hostnames: []string{"a.example.com"}})
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("GenerateEnvGroup(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    []string
+		g    []string
+		want bool
+	}{
+		"SameOrder": {
+			p:    []string{"a", "b"},
+			g:    []string{"a", "b"},
+			want: true,
+		},
+		"DifferentOrder": {
+			p:    []string{"b", "a"},
+			g:    []string{"a", "b"},
+			want: true,
+		},
+		"Empty": {
+			p:    []string{},
+			want: true,
+		},
+		"HostnameAdded": {
+			p: []string{"a", "b", "c"},
+			g: []string{"a", "b"},
+		},
+		"HostnameRemoved": {
+			p: []string{"a"},
+			g: []string{"a", "b"},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsUpToDate(v1alpha1.EnvGroupParameters{Hostnames: tc.p}, apigee.GoogleCloudApigeeV1EnvironmentGroup{Hostnames: tc.g})
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
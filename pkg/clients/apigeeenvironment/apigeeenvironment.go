@@ -0,0 +1,94 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apigeeenvironment
+
+import (
+	"fmt"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	apigee "google.golang.org/api/apigee/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/apigee/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/apigeeorganization"
+)
+
+const (
+	parentFormat = "organizations/%s"
+	nameFormat   = "organizations/%s/environments/%s"
+
+	apiProxyTypeUnspecified   = "API_PROXY_TYPE_UNSPECIFIED"
+	deploymentTypeUnspecified = "DEPLOYMENT_TYPE_UNSPECIFIED"
+)
+
+// GetParent returns the organization an Environment belongs to.
+func GetParent(org string) string {
+	return fmt.Sprintf(parentFormat, org)
+}
+
+// GetFullyQualifiedName builds the relative resource name of an
+// Environment.
+func GetFullyQualifiedName(org, name string) string {
+	return fmt.Sprintf(nameFormat, org, name)
+}
+
+// GenerateEnvironment produces an Environment that is configured via given
+// EnvironmentParameters.
+func GenerateEnvironment(name string, p v1alpha1.EnvironmentParameters) *apigee.GoogleCloudApigeeV1Environment {
+	return &apigee.GoogleCloudApigeeV1Environment{
+		Name:            name,
+		DisplayName:     gcp.StringValue(p.DisplayName),
+		Description:     gcp.StringValue(p.Description),
+		DeploymentType:  gcp.StringValue(p.DeploymentType),
+		ApiProxyType:    gcp.StringValue(p.APIProxyType),
+		ForwardProxyUri: gcp.StringValue(p.ForwardProxyURI),
+		Properties:      apigeeorganization.GenerateProperties(p.Properties),
+	}
+}
+
+// GenerateObservation produces an EnvironmentObservation from the supplied
+// Environment.
+func GenerateObservation(e apigee.GoogleCloudApigeeV1Environment) v1alpha1.EnvironmentObservation {
+	return v1alpha1.EnvironmentObservation{
+		State: e.State,
+	}
+}
+
+// LateInitialize fills the empty fields of EnvironmentParameters if the
+// corresponding fields are given in Environment.
+func LateInitialize(p *v1alpha1.EnvironmentParameters, e apigee.GoogleCloudApigeeV1Environment) {
+	p.DisplayName = gcp.LateInitializeString(p.DisplayName, e.DisplayName)
+	p.Description = gcp.LateInitializeString(p.Description, e.Description)
+	if e.DeploymentType != deploymentTypeUnspecified {
+		p.DeploymentType = gcp.LateInitializeString(p.DeploymentType, e.DeploymentType)
+	}
+	if e.ApiProxyType != apiProxyTypeUnspecified {
+		p.APIProxyType = gcp.LateInitializeString(p.APIProxyType, e.ApiProxyType)
+	}
+	p.Properties = gcp.LateInitializeStringMap(p.Properties, apigeeorganization.ParseProperties(e.Properties))
+}
+
+// IsUpToDate checks whether Environment is configured with given
+// EnvironmentParameters. Only the display name, description, forward proxy
+// and properties of an environment can be updated.
+func IsUpToDate(p v1alpha1.EnvironmentParameters, e apigee.GoogleCloudApigeeV1Environment) bool {
+	return gcp.StringValue(p.DisplayName) == e.DisplayName &&
+		gcp.StringValue(p.Description) == e.Description &&
+		gcp.StringValue(p.ForwardProxyURI) == e.ForwardProxyUri &&
+		cmp.Equal(p.Properties, apigeeorganization.ParseProperties(e.Properties), cmpopts.EquateEmpty())
+}
@@ -0,0 +1,156 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apigeeenvironment
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	apigee "google.golang.org/api/apigee/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/apigee/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+func params() *v1alpha1.EnvironmentParameters {
+	return &v1alpha1.EnvironmentParameters{
+		DisplayName:     gcp.StringPtr("Cool"),
+		Description:     gcp.StringPtr("A cool environment"),
+		DeploymentType:  gcp.StringPtr("PROXY"),
+		APIProxyType:    gcp.StringPtr("PROGRAMMABLE"),
+		ForwardProxyURI: gcp.StringPtr("http://10.0.0.2:3128"),
+		Properties:      map[string]string{"b": "2", "a": "1"},
+	}
+}
+
+func environment() *apigee.GoogleCloudApigeeV1Environment {
+	return &apigee.GoogleCloudApigeeV1Environment{
+		Name:            "cool-env",
+		DisplayName:     "Cool",
+		Description:     "A cool environment",
+		DeploymentType:  "PROXY",
+		ApiProxyType:    "PROGRAMMABLE",
+		ForwardProxyUri: "http://10.0.0.2:3128",
+		Properties: &apigee.GoogleCloudApigeeV1Properties{Property: []*apigee.GoogleCloudApigeeV1Property{
+			{Name: "a", Value: "1"},
+			{Name: "b", Value: "2"},
+		}},
+	}
+}
+
+func TestGenerateEnvironment(t *testing.T) {
+	got := GenerateEnvironment("cool-env", *params())
+	if diff := cmp.Diff(environment(), got); diff != "" {
+		t.Errorf("GenerateEnvironment(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitialize(t *testing.T) {
+	cases := map[string]struct {
+		p    *v1alpha1.EnvironmentParameters
+		e    *apigee.GoogleCloudApigeeV1Environment
+		want *v1alpha1.EnvironmentParameters
+	}{
+		"Empty": {
+			p: &v1alpha1.EnvironmentParameters{},
+			e: environment(),
+			want: func() *v1alpha1.EnvironmentParameters {
+				p := params()
+				// The forward proxy is not late-initialized, since
+				// removing it from the spec removes it from Apigee.
+				p.ForwardProxyURI = nil
+				return p
+			}(),
+		},
+		"Unspecified": {
+			p: &v1alpha1.EnvironmentParameters{},
+			e: &apigee.GoogleCloudApigeeV1Environment{
+				DeploymentType: deploymentTypeUnspecified,
+				ApiProxyType:   apiProxyTypeUnspecified,
+			},
+			want: &v1alpha1.EnvironmentParameters{},
+		},
+		"AlreadySet": {
+			p:    params(),
+			e:    &apigee.GoogleCloudApigeeV1Environment{DisplayName: "Other"},
+			want: params(),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitialize(tc.p, *tc.e)
+			if diff := cmp.Diff(tc.want, tc.p); diff != "" {
+				t.Errorf("LateInitialize(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    *v1alpha1.EnvironmentParameters
+		e    *apigee.GoogleCloudApigeeV1Environment
+		want bool
+	}{
+		"UpToDate": {
+			p:    params(),
+			e:    environment(),
+			want: true,
+		},
+		"DisplayNameChanged": {
+			p: func() *v1alpha1.EnvironmentParameters {
+				p := params()
+				p.DisplayName = gcp.StringPtr("Cooler")
+				return p
+			}(),
+			e: environment(),
+		},
+		"PropertyRemoved": {
+			p: func() *v1alpha1.EnvironmentParameters {
+				p := params()
+				delete(p.Properties, "b")
+				return p
+			}(),
+			e: environment(),
+		},
+		"ForwardProxyRemoved": {
+			p: func() *v1alpha1.EnvironmentParameters {
+				p := params()
+				p.ForwardProxyURI = nil
+				return p
+			}(),
+			e: environment(),
+		},
+		"ImmutableFieldsIgnored": {
+			p: func() *v1alpha1.EnvironmentParameters {
+				p := params()
+				p.DeploymentType = gcp.StringPtr("ARCHIVE")
+				return p
+			}(),
+			e:    environment(),
+			want: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsUpToDate(*tc.p, *tc.e)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apigeeinstance
+
+import (
+	"fmt"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	apigee "google.golang.org/api/apigee/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/apigee/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	parentFormat = "organizations/%s"
+	nameFormat   = "organizations/%s/instances/%s"
+
+	peeringCIDRRangeUnspecified = "CIDR_RANGE_UNSPECIFIED"
+)
+
+// GetParent returns the organization an Instance belongs to.
+func GetParent(org string) string {
+	return fmt.Sprintf(parentFormat, org)
+}
+
+// GetFullyQualifiedName builds the relative resource name of an Instance.
+func GetFullyQualifiedName(org, name string) string {
+	return fmt.Sprintf(nameFormat, org, name)
+}
+
+// GenerateInstance produces an Instance that is configured via given
+// InstanceParameters.
+func GenerateInstance(name string, p v1alpha1.InstanceParameters) *apigee.GoogleCloudApigeeV1Instance {
+	return &apigee.GoogleCloudApigeeV1Instance{
+		Name:                  name,
+		Location:              p.Location,
+		DisplayName:           gcp.StringValue(p.DisplayName),
+		Description:           gcp.StringValue(p.Description),
+		IpRange:               gcp.StringValue(p.IPRange),
+		PeeringCidrRange:      gcp.StringValue(p.PeeringCIDRRange),
+		DiskEncryptionKeyName: gcp.StringValue(p.DiskEncryptionKeyName),
+		ConsumerAcceptList:    p.ConsumerAcceptList,
+	}
+}
+
+// GenerateObservation produces an InstanceObservation from the supplied
+// Instance.
+func GenerateObservation(i apigee.GoogleCloudApigeeV1Instance) v1alpha1.InstanceObservation {
+	return v1alpha1.InstanceObservation{
+		State:             i.State,
+		Host:              i.Host,
+		Port:              i.Port,
+		RuntimeVersion:    i.RuntimeVersion,
+		ServiceAttachment: i.ServiceAttachment,
+	}
+}
+
+// GetConnectionDetails returns the host and port the supplied Instance
+// serves requests on.
+func GetConnectionDetails(i apigee.GoogleCloudApigeeV1Instance) managed.ConnectionDetails {
+	cd := managed.ConnectionDetails{}
+	if i.Host != "" {
+		cd[xpv1.ResourceCredentialsSecretEndpointKey] = []byte(i.Host)
+	}
+	if i.Port != "" {
+		cd[xpv1.ResourceCredentialsSecretPortKey] = []byte(i.Port)
+	}
+	return cd
+}
+
+// LateInitialize fills the empty fields of InstanceParameters if the
+// corresponding fields are given in Instance.
+func LateInitialize(p *v1alpha1.InstanceParameters, i apigee.GoogleCloudApigeeV1Instance) {
+	p.DisplayName = gcp.LateInitializeString(p.DisplayName, i.DisplayName)
+	p.Description = gcp.LateInitializeString(p.Description, i.Description)
+	if i.PeeringCidrRange != peeringCIDRRangeUnspecified {
+		p.PeeringCIDRRange = gcp.LateInitializeString(p.PeeringCIDRRange, i.PeeringCidrRange)
+	}
+	p.DiskEncryptionKeyName = gcp.LateInitializeString(p.DiskEncryptionKeyName, i.DiskEncryptionKeyName)
+	p.ConsumerAcceptList = gcp.LateInitializeStringSlice(p.ConsumerAcceptList, i.ConsumerAcceptList)
+}
+
+// IsUpToDate checks whether Instance is configured with given
+// InstanceParameters. Only the consumer accept list of an instance can be
+// updated.
+func IsUpToDate(p v1alpha1.InstanceParameters, i apigee.GoogleCloudApigeeV1Instance) bool {
+	return cmp.Equal(p.ConsumerAcceptList, i.ConsumerAcceptList, cmpopts.EquateEmpty(), cmpopts.SortSlices(func(a, b string) bool { return a < b }))
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apigeeinstance
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	apigee "google.golang.org/api/apigee/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/apigee/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+func TestLateInitialize(t *testing.T) {
+	cases := map[string]struct {
+		p    *v1alpha1.InstanceParameters
+		i    *apigee.GoogleCloudApigeeV1Instance
+		want *v1alpha1.InstanceParameters
+	}{
+		"Defaults": {
+			p: &v1alpha1.InstanceParameters{Location: "us-central1"},
+			i: &apigee.GoogleCloudApigeeV1Instance{
+				Location:           "us-central1",
+				PeeringCidrRange:   "SLASH_16",
+				ConsumerAcceptList: []string{"fooproject"},
+			},
+			want: &v1alpha1.InstanceParameters{
+				Location:           "us-central1",
+				PeeringCIDRRange:   gcp.StringPtr("SLASH_16"),
+				ConsumerAcceptList: []string{"fooproject"},
+			},
+		},
+		"UnspecifiedPeeringCIDRRange": {
+			p: &v1alpha1.InstanceParameters{Location: "us-central1"},
+			i: &apigee.GoogleCloudApigeeV1Instance{
+				Location:         "us-central1",
+				PeeringCidrRange: peeringCIDRRangeUnspecified,
+			},
+			want: &v1alpha1.InstanceParameters{Location: "us-central1"},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitialize(tc.p, *tc.i)
+			if diff := cmp.Diff(tc.want, tc.p); diff != "" {
+				t.Errorf("LateInitialize(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    []string
+		i    []string
+		want bool
+	}{
+		"SameOrder": {
+			p:    []string{"a", "b"},
+			i:    []string{"a", "b"},
+			want: true,
+		},
+		"DifferentOrder": {
+			p:    []string{"b", "a"},
+			i:    []string{"a", "b"},
+			want: true,
+		},
+		"ProjectAdded": {
+			p: []string{"a", "b", "c"},
+			i: []string{"a", "b"},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsUpToDate(v1alpha1.InstanceParameters{ConsumerAcceptList: tc.p}, apigee.GoogleCloudApigeeV1Instance{ConsumerAcceptList: tc.i})
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetConnectionDetails(t *testing.T) {
+	want := managed.ConnectionDetails{
+		xpv1.ResourceCredentialsSecretEndpointKey: []byte("10.0.0.2"),
+		xpv1.ResourceCredentialsSecretPortKey:     []byte("443"),
+	}
+	got := GetConnectionDetails(apigee.GoogleCloudApigeeV1Instance{Host: "10.0.0.2", Port: "443"})
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("GetConnectionDetails(...): -want, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apigeeorganization
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	apigee "google.golang.org/api/apigee/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/apigee/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	parentFormat = "projects/%s"
+	nameFormat   = "organizations/%s"
+)
+
+// GetParent returns the project an Organization is created in.
+func GetParent(project string) string {
+	return fmt.Sprintf(parentFormat, project)
+}
+
+// GetFullyQualifiedName builds the relative resource name of an
+// Organization.
+func GetFullyQualifiedName(org string) string {
+	return fmt.Sprintf(nameFormat, org)
+}
+
+// GenerateProperties produces the Apigee properties of an organization or
+// an environment from the supplied map, sorted by name.
+func GenerateProperties(m map[string]string) *apigee.GoogleCloudApigeeV1Properties {
+	if len(m) == 0 {
+		return nil
+	}
+	p := &apigee.GoogleCloudApigeeV1Properties{}
+	for k, v := range m {
+		p.Property = append(p.Property, &apigee.GoogleCloudApigeeV1Property{Name: k, Value: v})
+	}
+	sort.Slice(p.Property, func(i, j int) bool { return p.Property[i].Name < p.Property[j].Name })
+	return p
+}
+
+// ParseProperties returns the supplied Apigee properties as a map.
+func ParseProperties(p *apigee.GoogleCloudApigeeV1Properties) map[string]string {
+	if p == nil || len(p.Property) == 0 {
+		return nil
+	}
+	m := make(map[string]string, len(p.Property))
+	for _, pr := range p.Property {
+		m[pr.Name] = pr.Value
+	}
+	return m
+}
+
+// GenerateOrganization produces an Organization that is configured via
+// given OrganizationParameters.
+func GenerateOrganization(name string, p v1alpha1.OrganizationParameters) *apigee.GoogleCloudApigeeV1Organization {
+	return &apigee.GoogleCloudApigeeV1Organization{
+		Name:                             name,
+		RuntimeType:                      gcp.StringValue(p.RuntimeType),
+		AnalyticsRegion:                  p.AnalyticsRegion,
+		AuthorizedNetwork:                gcp.StringValue(p.AuthorizedNetwork),
+		BillingType:                      gcp.StringValue(p.BillingType),
+		RuntimeDatabaseEncryptionKeyName: gcp.StringValue(p.RuntimeDatabaseEncryptionKeyName),
+		DisplayName:                      gcp.StringValue(p.DisplayName),
+		Description:                      gcp.StringValue(p.Description),
+		Properties:                       GenerateProperties(p.Properties),
+	}
+}
+
+// GenerateObservation produces an OrganizationObservation from the supplied
+// Organization.
+func GenerateObservation(o apigee.GoogleCloudApigeeV1Organization) v1alpha1.OrganizationObservation {
+	return v1alpha1.OrganizationObservation{
+		State:            o.State,
+		ApigeeProjectID:  o.ApigeeProjectId,
+		CACertificate:    o.CaCertificate,
+		Environments:     o.Environments,
+		SubscriptionType: o.SubscriptionType,
+	}
+}
+
+// LateInitialize fills the empty fields of OrganizationParameters if the
+// corresponding fields are given in Organization.
+func LateInitialize(p *v1alpha1.OrganizationParameters, o apigee.GoogleCloudApigeeV1Organization) {
+	p.RuntimeType = gcp.LateInitializeString(p.RuntimeType, o.RuntimeType)
+	p.BillingType = gcp.LateInitializeString(p.BillingType, o.BillingType)
+	p.AuthorizedNetwork = gcp.LateInitializeString(p.AuthorizedNetwork, o.AuthorizedNetwork)
+	p.RuntimeDatabaseEncryptionKeyName = gcp.LateInitializeString(p.RuntimeDatabaseEncryptionKeyName, o.RuntimeDatabaseEncryptionKeyName)
+	p.DisplayName = gcp.LateInitializeString(p.DisplayName, o.DisplayName)
+	p.Description = gcp.LateInitializeString(p.Description, o.Description)
+	p.Properties = gcp.LateInitializeStringMap(p.Properties, ParseProperties(o.Properties))
+}
+
+// IsUpToDate checks whether Organization is configured with given
+// OrganizationParameters. Only the display name, description and properties
+// of an organization can be updated.
+func IsUpToDate(p v1alpha1.OrganizationParameters, o apigee.GoogleCloudApigeeV1Organization) bool {
+	return gcp.StringValue(p.DisplayName) == o.DisplayName &&
+		gcp.StringValue(p.Description) == o.Description &&
+		cmp.Equal(p.Properties, ParseProperties(o.Properties), cmpopts.EquateEmpty())
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apigeeorganization
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	apigee "google.golang.org/api/apigee/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/apigee/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+func params(m ...func(*v1alpha1.OrganizationParameters)) *v1alpha1.OrganizationParameters {
+	p := &v1alpha1.OrganizationParameters{
+		RuntimeType:       gcp.StringPtr("CLOUD"),
+		AnalyticsRegion:   "us-central1",
+		AuthorizedNetwork: gcp.StringPtr("default"),
+		DisplayName:       gcp.StringPtr("example"),
+		Properties:        map[string]string{"features.mart.connect.enabled": "true", "features.hybrid.enabled": "false"},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func observed(m ...func(*apigee.GoogleCloudApigeeV1Organization)) *apigee.GoogleCloudApigeeV1Organization {
+	o := &apigee.GoogleCloudApigeeV1Organization{
+		Name:              "fooproject",
+		RuntimeType:       "CLOUD",
+		AnalyticsRegion:   "us-central1",
+		AuthorizedNetwork: "default",
+		BillingType:       "EVALUATION",
+		DisplayName:       "example",
+		Properties: &apigee.GoogleCloudApigeeV1Properties{Property: []*apigee.GoogleCloudApigeeV1Property{
+			{Name: "features.hybrid.enabled", Value: "false"},
+			{Name: "features.mart.connect.enabled", Value: "true"},
+		}},
+		State: "ACTIVE",
+	}
+	for _, f := range m {
+		f(o)
+	}
+	return o
+}
+
+func TestGenerateOrganization(t *testing.T) {
+	want := observed(func(o *apigee.GoogleCloudApigeeV1Organization) {
+		o.BillingType = ""
+		o.State = ""
+	})
+	got := GenerateOrganization("fooproject", *params())
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("GenerateOrganization(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitialize(t *testing.T) {
+	got := params(func(p *v1alpha1.OrganizationParameters) {
+		p.RuntimeType = nil
+		p.Properties = nil
+	})
+	LateInitialize(got, *observed())
+	want := params(func(p *v1alpha1.OrganizationParameters) {
+		p.BillingType = gcp.StringPtr("EVALUATION")
+	})
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("LateInitialize(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    *v1alpha1.OrganizationParameters
+		o    *apigee.GoogleCloudApigeeV1Organization
+		want bool
+	}{
+		"UpToDate": {
+			p:    params(),
+			o:    observed(),
+			want: true,
+		},
+		"DisplayNameChanged": {
+			p: params(func(p *v1alpha1.OrganizationParameters) {
+				p.DisplayName = gcp.StringPtr("renamed")
+			}),
+			o: observed(),
+		},
+		"PropertyRemoved": {
+			p: params(func(p *v1alpha1.OrganizationParameters) {
+				delete(p.Properties, "features.hybrid.enabled")
+			}),
+			o: observed(),
+		},
+		"ImmutableFieldIgnored": {
+			p: params(func(p *v1alpha1.OrganizationParameters) {
+				p.AnalyticsRegion = "europe-west1"
+			}),
+			o:    observed(),
+			want: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsUpToDate(*tc.p, *tc.o)); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authorizationpolicy
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	networksecurity "google.golang.org/api/networksecurity/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/networksecurity/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	parentFormat = "projects/%s/locations/%s"
+	nameFormat   = "projects/%s/locations/%s/authorizationPolicies/%s"
+)
+
+// GetParent returns the location the AuthorizationPolicy lives under.
+func GetParent(projectID, location string) string {
+	return fmt.Sprintf(parentFormat, projectID, location)
+}
+
+// GetFullyQualifiedName builds the relative resource name of the
+// AuthorizationPolicy.
+func GetFullyQualifiedName(projectID, location, name string) string {
+	return fmt.Sprintf(nameFormat, projectID, location, name)
+}
+
+// GenerateAuthorizationPolicy produces an AuthorizationPolicy that is
+// configured via given AuthorizationPolicyParameters.
+func GenerateAuthorizationPolicy(name string, p v1alpha1.AuthorizationPolicyParameters) *networksecurity.AuthorizationPolicy {
+	a := &networksecurity.AuthorizationPolicy{
+		Name:        name,
+		Description: gcp.StringValue(p.Description),
+		Labels:      p.Labels,
+		Action:      p.Action,
+	}
+	for _, r := range p.Rules {
+		rule := &networksecurity.Rule{}
+		for _, s := range r.Sources {
+			rule.Sources = append(rule.Sources, &networksecurity.Source{
+				Principals: s.Principals,
+				IpBlocks:   s.IPBlocks,
+			})
+		}
+		for _, d := range r.Destinations {
+			dst := &networksecurity.Destination{
+				Hosts:   d.Hosts,
+				Ports:   d.Ports,
+				Methods: d.Methods,
+			}
+			if m := d.HTTPHeaderMatch; m != nil {
+				dst.HttpHeaderMatch = &networksecurity.HttpHeaderMatch{HeaderName: m.HeaderName, RegexMatch: m.RegexMatch}
+			}
+			rule.Destinations = append(rule.Destinations, dst)
+		}
+		a.Rules = append(a.Rules, rule)
+	}
+	return a
+}
+
+// GenerateObservation produces an AuthorizationPolicyObservation from the
+// supplied AuthorizationPolicy.
+func GenerateObservation(a networksecurity.AuthorizationPolicy) v1alpha1.AuthorizationPolicyObservation {
+	return v1alpha1.AuthorizationPolicyObservation{
+		Name:       a.Name,
+		CreateTime: a.CreateTime,
+		UpdateTime: a.UpdateTime,
+	}
+}
+
+// LateInitialize fills the empty fields of AuthorizationPolicyParameters if
+// the corresponding fields are given in AuthorizationPolicy.
+func LateInitialize(p *v1alpha1.AuthorizationPolicyParameters, a networksecurity.AuthorizationPolicy) {
+	p.Description = gcp.LateInitializeString(p.Description, a.Description)
+}
+
+// IsUpToDate checks whether AuthorizationPolicy is configured with given
+// AuthorizationPolicyParameters.
+func IsUpToDate(p v1alpha1.AuthorizationPolicyParameters, a networksecurity.AuthorizationPolicy) bool {
+	return GenerateUpdateMask(p, a) == ""
+}
+
+// GenerateUpdateMask returns the comma separated list of fields that differ
+// between AuthorizationPolicyParameters and AuthorizationPolicy.
+func GenerateUpdateMask(p v1alpha1.AuthorizationPolicyParameters, a networksecurity.AuthorizationPolicy) string {
+	desired := GenerateAuthorizationPolicy(a.Name, p)
+	mask := []string{}
+	if desired.Description != a.Description {
+		mask = append(mask, "description")
+	}
+	if !cmp.Equal(desired.Labels, a.Labels, cmpopts.EquateEmpty()) {
+		mask = append(mask, "labels")
+	}
+	if desired.Action != a.Action {
+		mask = append(mask, "action")
+	}
+	if !cmp.Equal(desired.Rules, a.Rules, cmpopts.EquateEmpty()) {
+		mask = append(mask, "rules")
+	}
+	return strings.Join(mask, ",")
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authorizationpolicy
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	networksecurity "google.golang.org/api/networksecurity/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/networksecurity/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const testName = "projects/foo/locations/global/authorizationPolicies/allow-web"
+
+func params(m ...func(*v1alpha1.AuthorizationPolicyParameters)) *v1alpha1.AuthorizationPolicyParameters {
+	p := &v1alpha1.AuthorizationPolicyParameters{
+		Location: "global",
+		Action:   "ALLOW",
+		Rules: []v1alpha1.AuthorizationRule{{
+			Sources: []v1alpha1.AuthorizationSource{{IPBlocks: []string{"10.0.0.0/8"}}},
+			Destinations: []v1alpha1.AuthorizationDestination{{
+				Hosts:           []string{"web.example.com"},
+				Ports:           []int64{443},
+				HTTPHeaderMatch: &v1alpha1.HTTPHeaderMatch{HeaderName: ":method", RegexMatch: "GET|HEAD"},
+			}},
+		}},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func policy() *networksecurity.AuthorizationPolicy {
+	return &networksecurity.AuthorizationPolicy{
+		Name:        testName,
+		Description: "web",
+		Action:      "ALLOW",
+		Rules: []*networksecurity.Rule{{
+			Sources: []*networksecurity.Source{{IpBlocks: []string{"10.0.0.0/8"}}},
+			Destinations: []*networksecurity.Destination{{
+				Hosts:           []string{"web.example.com"},
+				Ports:           []int64{443},
+				HttpHeaderMatch: &networksecurity.HttpHeaderMatch{HeaderName: ":method", RegexMatch: "GET|HEAD"},
+			}},
+		}},
+	}
+}
+
+func TestLateInitialize(t *testing.T) {
+	p := params()
+	LateInitialize(p, *policy())
+	want := params(func(p *v1alpha1.AuthorizationPolicyParameters) { p.Description = gcp.StringPtr("web") })
+	if diff := cmp.Diff(want, p); diff != "" {
+		t.Errorf("LateInitialize(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateUpdateMask(t *testing.T) {
+	cases := map[string]struct {
+		p    *v1alpha1.AuthorizationPolicyParameters
+		a    *networksecurity.AuthorizationPolicy
+		want string
+	}{
+		"UpToDate": {
+			p:    params(func(p *v1alpha1.AuthorizationPolicyParameters) { p.Description = gcp.StringPtr("web") }),
+			a:    policy(),
+			want: "",
+		},
+		"ActionChanged": {
+			p: params(func(p *v1alpha1.AuthorizationPolicyParameters) {
+				p.Description = gcp.StringPtr("web")
+				p.Action = "DENY"
+			}),
+			a:    policy(),
+			want: "action",
+		},
+		"RulesChanged": {
+			p: params(func(p *v1alpha1.AuthorizationPolicyParameters) {
+				p.Labels = map[string]string{"team": "platform"}
+				p.Rules[0].Destinations[0].Ports = []int64{80, 443}
+			}),
+			a:    policy(),
+			want: "description,labels,rules",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, GenerateUpdateMask(*tc.p, *tc.a)); diff != "" {
+				t.Errorf("GenerateUpdateMask(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
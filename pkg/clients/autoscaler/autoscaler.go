@@ -0,0 +1,226 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package autoscaler
+
+import (
+	"strconv"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/mitchellh/copystructure"
+	compute "google.golang.org/api/compute/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	errCheckUpToDate     = "unable to determine if external resource is up to date"
+	errParseUtilization  = "cannot parse utilization target"
+	errParseSingleAssign = "cannot parse single instance assignment"
+)
+
+// GenerateAutoscaler takes an AutoscalerParameters and fills the writable
+// fields of the given *compute.Autoscaler.
+func GenerateAutoscaler(name string, in v1alpha1.AutoscalerParameters, as *compute.Autoscaler) error {
+	as.Name = name
+	as.Description = gcp.StringValue(in.Description)
+	as.Target = in.Target
+
+	p, err := generatePolicy(in.AutoscalingPolicy)
+	if err != nil {
+		return err
+	}
+	as.AutoscalingPolicy = p
+	return nil
+}
+
+func generatePolicy(in v1alpha1.AutoscalingPolicy) (*compute.AutoscalingPolicy, error) { // nolint:gocyclo
+	p := &compute.AutoscalingPolicy{
+		MaxNumReplicas:    in.MaxNumReplicas,
+		MinNumReplicas:    gcp.Int64Value(in.MinNumReplicas),
+		CoolDownPeriodSec: gcp.Int64Value(in.CoolDownPeriodSec),
+		Mode:              gcp.StringValue(in.Mode),
+	}
+	if in.MinNumReplicas != nil {
+		p.ForceSendFields = append(p.ForceSendFields, "MinNumReplicas")
+	}
+	if in.CPUUtilization != nil {
+		t, err := strconv.ParseFloat(in.CPUUtilization.UtilizationTarget, 64)
+		if err != nil {
+			return nil, errors.Wrap(err, errParseUtilization)
+		}
+		p.CpuUtilization = &compute.AutoscalingPolicyCpuUtilization{
+			UtilizationTarget: t,
+			PredictiveMethod:  gcp.StringValue(in.CPUUtilization.PredictiveMethod),
+		}
+	}
+	if in.LoadBalancingUtilization != nil {
+		t, err := strconv.ParseFloat(in.LoadBalancingUtilization.UtilizationTarget, 64)
+		if err != nil {
+			return nil, errors.Wrap(err, errParseUtilization)
+		}
+		p.LoadBalancingUtilization = &compute.AutoscalingPolicyLoadBalancingUtilization{
+			UtilizationTarget: t,
+		}
+	}
+	if len(in.CustomMetricUtilizations) != 0 {
+		p.CustomMetricUtilizations = make([]*compute.AutoscalingPolicyCustomMetricUtilization, len(in.CustomMetricUtilizations))
+		for i, m := range in.CustomMetricUtilizations {
+			cm := &compute.AutoscalingPolicyCustomMetricUtilization{
+				Metric:                m.Metric,
+				Filter:                gcp.StringValue(m.Filter),
+				UtilizationTargetType: gcp.StringValue(m.UtilizationTargetType),
+			}
+			if m.UtilizationTarget != nil {
+				t, err := strconv.ParseFloat(*m.UtilizationTarget, 64)
+				if err != nil {
+					return nil, errors.Wrap(err, errParseUtilization)
+				}
+				cm.UtilizationTarget = t
+			}
+			if m.SingleInstanceAssignment != nil {
+				t, err := strconv.ParseFloat(*m.SingleInstanceAssignment, 64)
+				if err != nil {
+					return nil, errors.Wrap(err, errParseSingleAssign)
+				}
+				cm.SingleInstanceAssignment = t
+			}
+			p.CustomMetricUtilizations[i] = cm
+		}
+	}
+	if in.ScaleInControl != nil {
+		p.ScaleInControl = &compute.AutoscalingPolicyScaleInControl{
+			TimeWindowSec: gcp.Int64Value(in.ScaleInControl.TimeWindowSec),
+		}
+		if r := in.ScaleInControl.MaxScaledInReplicas; r != nil {
+			p.ScaleInControl.MaxScaledInReplicas = &compute.FixedOrPercent{
+				Fixed:   gcp.Int64Value(r.Fixed),
+				Percent: gcp.Int64Value(r.Percent),
+			}
+		}
+	}
+	return p, nil
+}
+
+// GenerateAutoscalerObservation takes a compute.Autoscaler and returns an
+// AutoscalerObservation.
+func GenerateAutoscalerObservation(in compute.Autoscaler) v1alpha1.AutoscalerObservation {
+	o := v1alpha1.AutoscalerObservation{
+		CreationTimestamp: in.CreationTimestamp,
+		ID:                in.Id,
+		SelfLink:          in.SelfLink,
+		Status:            in.Status,
+		RecommendedSize:   in.RecommendedSize,
+	}
+	for _, d := range in.StatusDetails {
+		if d == nil {
+			continue
+		}
+		o.StatusDetails = append(o.StatusDetails, v1alpha1.AutoscalerStatusDetails{
+			Message: d.Message,
+			Type:    d.Type,
+		})
+	}
+	return o
+}
+
+// LateInitializeSpec fills unassigned fields with the values in
+// compute.Autoscaler object.
+func LateInitializeSpec(spec *v1alpha1.AutoscalerParameters, in compute.Autoscaler) { // nolint:gocyclo
+	spec.Description = gcp.LateInitializeString(spec.Description, in.Description)
+	if in.AutoscalingPolicy == nil {
+		return
+	}
+	p := &spec.AutoscalingPolicy
+	o := in.AutoscalingPolicy
+	if p.MinNumReplicas == nil {
+		p.MinNumReplicas = gcp.Int64Ptr(o.MinNumReplicas)
+	}
+	p.CoolDownPeriodSec = gcp.LateInitializeInt64(p.CoolDownPeriodSec, o.CoolDownPeriodSec)
+	p.Mode = gcp.LateInitializeString(p.Mode, o.Mode)
+	if o.CpuUtilization != nil {
+		if p.CPUUtilization == nil {
+			p.CPUUtilization = &v1alpha1.AutoscalingPolicyCPUUtilization{
+				UtilizationTarget: formatFloat(o.CpuUtilization.UtilizationTarget),
+			}
+		}
+		p.CPUUtilization.PredictiveMethod = gcp.LateInitializeString(p.CPUUtilization.PredictiveMethod, o.CpuUtilization.PredictiveMethod)
+	}
+	if p.LoadBalancingUtilization == nil && o.LoadBalancingUtilization != nil {
+		p.LoadBalancingUtilization = &v1alpha1.AutoscalingPolicyLoadBalancingUtilization{
+			UtilizationTarget: formatFloat(o.LoadBalancingUtilization.UtilizationTarget),
+		}
+	}
+	if o.ScaleInControl != nil {
+		if p.ScaleInControl == nil {
+			p.ScaleInControl = &v1alpha1.AutoscalingPolicyScaleInControl{}
+		}
+		p.ScaleInControl.TimeWindowSec = gcp.LateInitializeInt64(p.ScaleInControl.TimeWindowSec, o.ScaleInControl.TimeWindowSec)
+		if r := o.ScaleInControl.MaxScaledInReplicas; r != nil && p.ScaleInControl.MaxScaledInReplicas == nil {
+			p.ScaleInControl.MaxScaledInReplicas = &v1alpha1.FixedOrPercent{
+				Fixed:   gcp.LateInitializeInt64(nil, r.Fixed),
+				Percent: gcp.LateInitializeInt64(nil, r.Percent),
+			}
+		}
+	}
+	if len(p.CustomMetricUtilizations) == 0 && len(o.CustomMetricUtilizations) != 0 {
+		p.CustomMetricUtilizations = make([]v1alpha1.AutoscalingPolicyCustomMetricUtilization, 0, len(o.CustomMetricUtilizations))
+		for _, m := range o.CustomMetricUtilizations {
+			cm := v1alpha1.AutoscalingPolicyCustomMetricUtilization{
+				Metric:                m.Metric,
+				Filter:                gcp.LateInitializeString(nil, m.Filter),
+				UtilizationTargetType: gcp.LateInitializeString(nil, m.UtilizationTargetType),
+			}
+			if m.UtilizationTarget != 0 {
+				cm.UtilizationTarget = gcp.StringPtr(formatFloat(m.UtilizationTarget))
+			}
+			if m.SingleInstanceAssignment != 0 {
+				cm.SingleInstanceAssignment = gcp.StringPtr(formatFloat(m.SingleInstanceAssignment))
+			}
+			p.CustomMetricUtilizations = append(p.CustomMetricUtilizations, cm)
+		}
+	}
+}
+
+// IsUpToDate checks whether current state is up-to-date compared to the given
+// set of parameters.
+func IsUpToDate(name string, in *v1alpha1.AutoscalerParameters, observed *compute.Autoscaler) (bool, error) {
+	generated, err := copystructure.Copy(observed)
+	if err != nil {
+		return true, errors.Wrap(err, errCheckUpToDate)
+	}
+	desired, ok := generated.(*compute.Autoscaler)
+	if !ok {
+		return true, errors.New(errCheckUpToDate)
+	}
+	if err := GenerateAutoscaler(name, *in, desired); err != nil {
+		return true, errors.Wrap(err, errCheckUpToDate)
+	}
+	return cmp.Equal(desired, observed,
+		cmpopts.EquateEmpty(),
+		gcp.EquateComputeURLs(),
+		cmpopts.IgnoreFields(compute.Autoscaler{}, "ForceSendFields"),
+		cmpopts.IgnoreFields(compute.AutoscalingPolicy{}, "ForceSendFields"),
+	), nil
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
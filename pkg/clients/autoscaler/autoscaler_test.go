@@ -0,0 +1,243 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package autoscaler
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	compute "google.golang.org/api/compute/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	testName   = "some-name"
+	testTarget = "https://www.googleapis.com/compute/v1/projects/foo/regions/us-west1/instanceGroupManagers/mig"
+)
+
+func params(m ...func(*v1alpha1.AutoscalerParameters)) *v1alpha1.AutoscalerParameters {
+	o := &v1alpha1.AutoscalerParameters{
+		Region:      "us-west1",
+		Target:      "regions/us-west1/instanceGroupManagers/mig",
+		Description: gcp.StringPtr("some desc"),
+		AutoscalingPolicy: v1alpha1.AutoscalingPolicy{
+			MinNumReplicas:    gcp.Int64Ptr(1),
+			MaxNumReplicas:    5,
+			CoolDownPeriodSec: gcp.Int64Ptr(60),
+			Mode:              gcp.StringPtr("ON"),
+			CPUUtilization: &v1alpha1.AutoscalingPolicyCPUUtilization{
+				UtilizationTarget: "0.6",
+				PredictiveMethod:  gcp.StringPtr("NONE"),
+			},
+		},
+	}
+	for _, f := range m {
+		f(o)
+	}
+	return o
+}
+
+func autoscaler(m ...func(*compute.Autoscaler)) *compute.Autoscaler {
+	o := &compute.Autoscaler{
+		Name:        testName,
+		Target:      testTarget,
+		Description: "some desc",
+		AutoscalingPolicy: &compute.AutoscalingPolicy{
+			MinNumReplicas:    1,
+			MaxNumReplicas:    5,
+			CoolDownPeriodSec: 60,
+			Mode:              "ON",
+			CpuUtilization: &compute.AutoscalingPolicyCpuUtilization{
+				UtilizationTarget: 0.6,
+				PredictiveMethod:  "NONE",
+			},
+		},
+	}
+	for _, f := range m {
+		f(o)
+	}
+	return o
+}
+
+func TestGenerateAutoscaler(t *testing.T) {
+	type want struct {
+		as  *compute.Autoscaler
+		err bool
+	}
+	cases := map[string]struct {
+		in   v1alpha1.AutoscalerParameters
+		want want
+	}{
+		"Full": {
+			in: *params(func(p *v1alpha1.AutoscalerParameters) {
+				p.Target = testTarget
+				p.AutoscalingPolicy.CustomMetricUtilizations = []v1alpha1.AutoscalingPolicyCustomMetricUtilization{{
+					Metric:                   "custom.googleapis.com/queue",
+					SingleInstanceAssignment: gcp.StringPtr("2.5"),
+				}}
+				p.AutoscalingPolicy.ScaleInControl = &v1alpha1.AutoscalingPolicyScaleInControl{
+					TimeWindowSec:       gcp.Int64Ptr(600),
+					MaxScaledInReplicas: &v1alpha1.FixedOrPercent{Percent: gcp.Int64Ptr(10)},
+				}
+			}),
+			want: want{
+				as: autoscaler(func(a *compute.Autoscaler) {
+					a.AutoscalingPolicy.ForceSendFields = []string{"MinNumReplicas"}
+					a.AutoscalingPolicy.CustomMetricUtilizations = []*compute.AutoscalingPolicyCustomMetricUtilization{{
+						Metric:                   "custom.googleapis.com/queue",
+						SingleInstanceAssignment: 2.5,
+					}}
+					a.AutoscalingPolicy.ScaleInControl = &compute.AutoscalingPolicyScaleInControl{
+						TimeWindowSec:       600,
+						MaxScaledInReplicas: &compute.FixedOrPercent{Percent: 10},
+					}
+				}),
+			},
+		},
+		"InvalidUtilization": {
+			in: *params(func(p *v1alpha1.AutoscalerParameters) {
+				p.AutoscalingPolicy.CPUUtilization.UtilizationTarget = "sixty"
+			}),
+			want: want{err: true},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := &compute.Autoscaler{}
+			err := GenerateAutoscaler(testName, tc.in, got)
+			if diff := cmp.Diff(tc.want.err, err != nil); diff != "" {
+				t.Fatalf("GenerateAutoscaler(...): -want error, +got error:\n%s", diff)
+			}
+			if tc.want.err {
+				return
+			}
+			if diff := cmp.Diff(tc.want.as, got); diff != "" {
+				t.Errorf("GenerateAutoscaler(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeSpec(t *testing.T) {
+	cases := map[string]struct {
+		spec     *v1alpha1.AutoscalerParameters
+		observed *compute.Autoscaler
+		want     *v1alpha1.AutoscalerParameters
+	}{
+		"ServerDefaults": {
+			spec: &v1alpha1.AutoscalerParameters{
+				Region: "us-west1",
+				Target: "regions/us-west1/instanceGroupManagers/mig",
+				AutoscalingPolicy: v1alpha1.AutoscalingPolicy{
+					MaxNumReplicas: 5,
+				},
+			},
+			observed: autoscaler(),
+			want:     params(),
+		},
+		"KeepsSpec": {
+			spec: params(func(p *v1alpha1.AutoscalerParameters) {
+				p.AutoscalingPolicy.Mode = gcp.StringPtr("ONLY_SCALE_OUT")
+			}),
+			observed: autoscaler(),
+			want: params(func(p *v1alpha1.AutoscalerParameters) {
+				p.AutoscalingPolicy.Mode = gcp.StringPtr("ONLY_SCALE_OUT")
+			}),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeSpec(tc.spec, *tc.observed)
+			if diff := cmp.Diff(tc.want, tc.spec, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("LateInitializeSpec(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		in       *v1alpha1.AutoscalerParameters
+		observed *compute.Autoscaler
+		want     bool
+	}{
+		"UpToDate": {
+			in: params(),
+			observed: autoscaler(func(a *compute.Autoscaler) {
+				a.Status = "ACTIVE"
+				a.RecommendedSize = 3
+			}),
+			want: true,
+		},
+		"MaxReplicasChanged": {
+			in: params(func(p *v1alpha1.AutoscalerParameters) {
+				p.AutoscalingPolicy.MaxNumReplicas = 10
+			}),
+			observed: autoscaler(),
+			want:     false,
+		},
+		"UtilizationChanged": {
+			in: params(func(p *v1alpha1.AutoscalerParameters) {
+				p.AutoscalingPolicy.CPUUtilization.UtilizationTarget = "0.75"
+			}),
+			observed: autoscaler(),
+			want:     false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := IsUpToDate(testName, tc.in, tc.observed)
+			if err != nil {
+				t.Fatalf("IsUpToDate(...): unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateAutoscalerObservation(t *testing.T) {
+	in := autoscaler(func(a *compute.Autoscaler) {
+		a.CreationTimestamp = "10/10/2023"
+		a.Id = 2029819203
+		a.SelfLink = "/link/to/self"
+		a.Status = "ERROR"
+		a.RecommendedSize = 5
+		a.StatusDetails = []*compute.AutoscalerStatusDetails{{
+			Message: "The target instance group does not exist",
+			Type:    "MISSING_LOAD_BALANCING_DATA_POINTS",
+		}}
+	})
+	want := v1alpha1.AutoscalerObservation{
+		CreationTimestamp: "10/10/2023",
+		ID:                2029819203,
+		SelfLink:          "/link/to/self",
+		Status:            "ERROR",
+		RecommendedSize:   5,
+		StatusDetails: []v1alpha1.AutoscalerStatusDetails{{
+			Message: "The target instance group does not exist",
+			Type:    "MISSING_LOAD_BALANCING_DATA_POINTS",
+		}},
+	}
+	if diff := cmp.Diff(want, GenerateAutoscalerObservation(*in)); diff != "" {
+		t.Errorf("GenerateAutoscalerObservation(...): -want, +got:\n%s", diff)
+	}
+}
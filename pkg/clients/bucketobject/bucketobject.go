@@ -0,0 +1,77 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucketobject
+
+import (
+	"crypto/md5" // nolint:gosec // Cloud Storage reports the MD5 hash of objects.
+	"encoding/base64"
+
+	"google.golang.org/api/storage/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/storage/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+// GenerateObject produces the metadata of an Object that is configured via
+// given BucketObjectParameters.
+func GenerateObject(name string, p v1alpha1.BucketObjectParameters) *storage.Object {
+	return &storage.Object{
+		Name:         name,
+		Bucket:       gcp.StringValue(p.Bucket),
+		ContentType:  gcp.StringValue(p.ContentType),
+		CacheControl: gcp.StringValue(p.CacheControl),
+	}
+}
+
+// GenerateObservation produces a BucketObjectObservation from the supplied
+// Object.
+func GenerateObservation(o storage.Object) v1alpha1.BucketObjectObservation {
+	return v1alpha1.BucketObjectObservation{
+		Generation: o.Generation,
+		MD5Hash:    o.Md5Hash,
+		Size:       o.Size,
+		MediaLink:  o.MediaLink,
+		SelfLink:   o.SelfLink,
+		Updated:    o.Updated,
+	}
+}
+
+// LateInitialize fills the empty fields of BucketObjectParameters if the
+// corresponding fields are given in Object.
+func LateInitialize(p *v1alpha1.BucketObjectParameters, o storage.Object) {
+	p.ContentType = gcp.LateInitializeString(p.ContentType, o.ContentType)
+}
+
+// IsContentUpToDate checks whether the content of Object is the given
+// content.
+func IsContentUpToDate(content []byte, o storage.Object) bool {
+	sum := md5.Sum(content) // nolint:gosec // See import.
+	return base64.StdEncoding.EncodeToString(sum[:]) == o.Md5Hash
+}
+
+// IsUpToDate checks whether Object is configured with given
+// BucketObjectParameters and has the given content.
+func IsUpToDate(p v1alpha1.BucketObjectParameters, content []byte, o storage.Object) bool {
+	desired := GenerateObject(o.Name, p)
+	if p.ContentType != nil && desired.ContentType != o.ContentType {
+		return false
+	}
+	if desired.CacheControl != o.CacheControl {
+		return false
+	}
+	return IsContentUpToDate(content, o)
+}
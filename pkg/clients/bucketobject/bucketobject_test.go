@@ -0,0 +1,101 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucketobject
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/storage/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/storage/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	testContent = "#!/bin/sh\necho hello\n"
+	// testMD5 is the base64 encoded MD5 hash of testContent.
+	testMD5 = "1gSiIHCKpZQzukEJhs1P+g=="
+)
+
+func object(m ...func(*storage.Object)) *storage.Object {
+	o := &storage.Object{
+		Name:         "scripts/startup.sh",
+		Bucket:       "bootstrap",
+		ContentType:  "text/x-sh",
+		CacheControl: "no-cache",
+		Md5Hash:      testMD5,
+	}
+	for _, f := range m {
+		f(o)
+	}
+	return o
+}
+
+func params(m ...func(*v1alpha1.BucketObjectParameters)) *v1alpha1.BucketObjectParameters {
+	p := &v1alpha1.BucketObjectParameters{
+		Bucket:       gcp.StringPtr("bootstrap"),
+		Content:      gcp.StringPtr(testContent),
+		ContentType:  gcp.StringPtr("text/x-sh"),
+		CacheControl: gcp.StringPtr("no-cache"),
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		params  *v1alpha1.BucketObjectParameters
+		content string
+		obs     *storage.Object
+		want    bool
+	}{
+		"UpToDate": {
+			params:  params(),
+			content: testContent,
+			obs:     object(),
+			want:    true,
+		},
+		"ContentTypeDetected": {
+			params:  params(func(p *v1alpha1.BucketObjectParameters) { p.ContentType = nil }),
+			content: testContent,
+			obs:     object(),
+			want:    true,
+		},
+		"ContentChanged": {
+			params:  params(),
+			content: "#!/bin/sh\necho bye\n",
+			obs:     object(),
+			want:    false,
+		},
+		"CacheControlRemoved": {
+			params:  params(func(p *v1alpha1.BucketObjectParameters) { p.CacheControl = nil }),
+			content: testContent,
+			obs:     object(),
+			want:    false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsUpToDate(*tc.params, []byte(tc.content), *tc.obs)); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
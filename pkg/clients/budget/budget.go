@@ -0,0 +1,201 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package budget
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	budgets "google.golang.org/api/billingbudgets/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/billing/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	parentFormat = "billingAccounts/%s"
+	nameFormat   = "billingAccounts/%s/budgets/%s"
+
+	calendarPeriodUnspecified       = "CALENDAR_PERIOD_UNSPECIFIED"
+	creditTypesTreatmentUnspecified = "CREDIT_TYPES_TREATMENT_UNSPECIFIED"
+)
+
+// GetParent returns the billing account the Budget lives under.
+func GetParent(billingAccount string) string {
+	return fmt.Sprintf(parentFormat, billingAccount)
+}
+
+// GetFullyQualifiedName builds the relative resource name of the Budget.
+func GetFullyQualifiedName(billingAccount, budgetID string) string {
+	return fmt.Sprintf(nameFormat, billingAccount, budgetID)
+}
+
+// ParseBudgetID returns the budget ID from the relative resource name of a
+// Budget.
+func ParseBudgetID(name string) string {
+	return path.Base(name)
+}
+
+func generateDate(d *v1alpha1.Date) *budgets.GoogleTypeDate {
+	if d == nil {
+		return nil
+	}
+	return &budgets.GoogleTypeDate{Year: d.Year, Month: d.Month, Day: d.Day}
+}
+
+// GenerateBudget produces a Budget that is configured via given
+// BudgetParameters.
+func GenerateBudget(name string, p v1alpha1.BudgetParameters) *budgets.GoogleCloudBillingBudgetsV1Budget { // nolint:gocyclo
+	b := &budgets.GoogleCloudBillingBudgetsV1Budget{
+		Name:        name,
+		DisplayName: gcp.StringValue(p.DisplayName),
+		Amount:      &budgets.GoogleCloudBillingBudgetsV1BudgetAmount{},
+	}
+	if m := p.Amount.SpecifiedAmount; m != nil {
+		b.Amount.SpecifiedAmount = &budgets.GoogleTypeMoney{
+			CurrencyCode: gcp.StringValue(m.CurrencyCode),
+			Units:        m.Units,
+			Nanos:        gcp.Int64Value(m.Nanos),
+		}
+	}
+	if gcp.BoolValue(p.Amount.LastPeriodAmount) {
+		b.Amount.LastPeriodAmount = &budgets.GoogleCloudBillingBudgetsV1LastPeriodAmount{}
+	}
+	if f := p.Filter; f != nil {
+		b.BudgetFilter = &budgets.GoogleCloudBillingBudgetsV1Filter{
+			Projects:             f.Projects,
+			Services:             f.Services,
+			Subaccounts:          f.Subaccounts,
+			CreditTypesTreatment: gcp.StringValue(f.CreditTypesTreatment),
+			CreditTypes:          f.CreditTypes,
+			CalendarPeriod:       gcp.StringValue(f.CalendarPeriod),
+		}
+		if len(f.Labels) > 0 {
+			b.BudgetFilter.Labels = make(map[string][]interface{}, len(f.Labels))
+			for k, v := range f.Labels {
+				b.BudgetFilter.Labels[k] = []interface{}{v}
+			}
+		}
+		if c := f.CustomPeriod; c != nil {
+			b.BudgetFilter.CustomPeriod = &budgets.GoogleCloudBillingBudgetsV1CustomPeriod{
+				StartDate: generateDate(&c.StartDate),
+				EndDate:   generateDate(c.EndDate),
+			}
+		}
+	}
+	for _, r := range p.ThresholdRules {
+		b.ThresholdRules = append(b.ThresholdRules, &budgets.GoogleCloudBillingBudgetsV1ThresholdRule{
+			ThresholdPercent: float64(r.Percent) / 100,
+			SpendBasis:       gcp.StringValue(r.SpendBasis),
+		})
+	}
+	if n := p.NotificationsRule; n != nil {
+		b.NotificationsRule = &budgets.GoogleCloudBillingBudgetsV1NotificationsRule{
+			PubsubTopic:                    gcp.StringValue(n.PubsubTopic),
+			SchemaVersion:                  gcp.StringValue(n.SchemaVersion),
+			MonitoringNotificationChannels: n.MonitoringNotificationChannels,
+			DisableDefaultIamRecipients:    gcp.BoolValue(n.DisableDefaultIAMRecipients),
+		}
+		if n.DisableDefaultIAMRecipients != nil {
+			b.NotificationsRule.ForceSendFields = []string{"DisableDefaultIamRecipients"}
+		}
+	}
+	return b
+}
+
+// GenerateObservation produces a BudgetObservation from the supplied Budget.
+func GenerateObservation(b budgets.GoogleCloudBillingBudgetsV1Budget) v1alpha1.BudgetObservation {
+	return v1alpha1.BudgetObservation{
+		Name: b.Name,
+		Etag: b.Etag,
+	}
+}
+
+// LateInitialize fills the empty fields of BudgetParameters if the
+// corresponding fields are given in Budget.
+func LateInitialize(p *v1alpha1.BudgetParameters, b budgets.GoogleCloudBillingBudgetsV1Budget) {
+	p.DisplayName = gcp.LateInitializeString(p.DisplayName, b.DisplayName)
+	if m := p.Amount.SpecifiedAmount; m != nil && b.Amount != nil && b.Amount.SpecifiedAmount != nil {
+		m.CurrencyCode = gcp.LateInitializeString(m.CurrencyCode, b.Amount.SpecifiedAmount.CurrencyCode)
+	}
+	if f := b.BudgetFilter; f != nil {
+		if p.Filter == nil {
+			p.Filter = &v1alpha1.BudgetFilter{}
+		}
+		if f.CreditTypesTreatment != creditTypesTreatmentUnspecified {
+			p.Filter.CreditTypesTreatment = gcp.LateInitializeString(p.Filter.CreditTypesTreatment, f.CreditTypesTreatment)
+		}
+		if f.CalendarPeriod != calendarPeriodUnspecified && p.Filter.CustomPeriod == nil {
+			p.Filter.CalendarPeriod = gcp.LateInitializeString(p.Filter.CalendarPeriod, f.CalendarPeriod)
+		}
+	}
+	if len(p.ThresholdRules) == len(b.ThresholdRules) {
+		for i := range p.ThresholdRules {
+			p.ThresholdRules[i].SpendBasis = gcp.LateInitializeString(p.ThresholdRules[i].SpendBasis, b.ThresholdRules[i].SpendBasis)
+		}
+	}
+	if n := p.NotificationsRule; n != nil && b.NotificationsRule != nil {
+		n.SchemaVersion = gcp.LateInitializeString(n.SchemaVersion, b.NotificationsRule.SchemaVersion)
+		n.DisableDefaultIAMRecipients = gcp.LateInitializeBool(n.DisableDefaultIAMRecipients, b.NotificationsRule.DisableDefaultIamRecipients)
+	}
+}
+
+// IsUpToDate checks whether Budget is configured with given BudgetParameters.
+func IsUpToDate(p v1alpha1.BudgetParameters, b budgets.GoogleCloudBillingBudgetsV1Budget) bool {
+	return GenerateUpdateMask(p, b) == ""
+}
+
+// GenerateUpdateMask returns the comma separated list of fields that differ
+// between BudgetParameters and Budget.
+func GenerateUpdateMask(p v1alpha1.BudgetParameters, b budgets.GoogleCloudBillingBudgetsV1Budget) string {
+	desired := GenerateBudget(b.Name, p)
+	// GCP reports unset enums of the filter as unspecified.
+	if f := b.BudgetFilter; f != nil {
+		o := *f
+		if o.CalendarPeriod == calendarPeriodUnspecified {
+			o.CalendarPeriod = ""
+		}
+		if o.CreditTypesTreatment == creditTypesTreatmentUnspecified {
+			o.CreditTypesTreatment = ""
+		}
+		b.BudgetFilter = &o
+	}
+	opts := []cmp.Option{
+		cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(budgets.GoogleCloudBillingBudgetsV1NotificationsRule{}, "ForceSendFields"),
+	}
+	mask := []string{}
+	if desired.DisplayName != b.DisplayName {
+		mask = append(mask, "displayName")
+	}
+	if !cmp.Equal(desired.Amount, b.Amount, opts...) {
+		mask = append(mask, "amount")
+	}
+	if !cmp.Equal(desired.BudgetFilter, b.BudgetFilter, opts...) {
+		mask = append(mask, "budgetFilter")
+	}
+	if !cmp.Equal(desired.ThresholdRules, b.ThresholdRules, opts...) {
+		mask = append(mask, "thresholdRules")
+	}
+	if !cmp.Equal(desired.NotificationsRule, b.NotificationsRule, opts...) {
+		mask = append(mask, "notificationsRule")
+	}
+	return strings.Join(mask, ",")
+}
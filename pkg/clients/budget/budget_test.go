@@ -0,0 +1,153 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package budget
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	budgets "google.golang.org/api/billingbudgets/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/billing/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	testAccount = "012345-567890-ABCDEF"
+	testName    = "billingAccounts/012345-567890-ABCDEF/budgets/f1b2c3"
+	testTopic   = "projects/foo/topics/budget"
+)
+
+func params(m ...func(*v1alpha1.BudgetParameters)) *v1alpha1.BudgetParameters {
+	p := &v1alpha1.BudgetParameters{
+		BillingAccount: testAccount,
+		DisplayName:    gcp.StringPtr("team"),
+		Amount: v1alpha1.BudgetAmount{
+			SpecifiedAmount: &v1alpha1.Money{Units: 1000},
+		},
+		Filter: &v1alpha1.BudgetFilter{
+			Projects: []string{"projects/123456"},
+			Labels:   map[string]string{"team": "platform"},
+		},
+		ThresholdRules: []v1alpha1.ThresholdRule{{Percent: 50}, {Percent: 90}},
+		NotificationsRule: &v1alpha1.NotificationsRule{
+			PubsubTopic: gcp.StringPtr(testTopic),
+		},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func observed() *budgets.GoogleCloudBillingBudgetsV1Budget {
+	return &budgets.GoogleCloudBillingBudgetsV1Budget{
+		Name:        testName,
+		DisplayName: "team",
+		Etag:        "abc",
+		Amount: &budgets.GoogleCloudBillingBudgetsV1BudgetAmount{
+			SpecifiedAmount: &budgets.GoogleTypeMoney{CurrencyCode: "USD", Units: 1000},
+		},
+		BudgetFilter: &budgets.GoogleCloudBillingBudgetsV1Filter{
+			Projects:             []string{"projects/123456"},
+			Labels:               map[string][]interface{}{"team": {"platform"}},
+			CreditTypesTreatment: "INCLUDE_ALL_CREDITS",
+			CalendarPeriod:       "MONTH",
+		},
+		ThresholdRules: []*budgets.GoogleCloudBillingBudgetsV1ThresholdRule{
+			{ThresholdPercent: 0.5, SpendBasis: "CURRENT_SPEND"},
+			{ThresholdPercent: 0.9, SpendBasis: "CURRENT_SPEND"},
+		},
+		NotificationsRule: &budgets.GoogleCloudBillingBudgetsV1NotificationsRule{
+			PubsubTopic:   testTopic,
+			SchemaVersion: "1.0",
+		},
+	}
+}
+
+// lateInitialized returns the parameters after they were late initialized
+// from the observed budget.
+func lateInitialized(m ...func(*v1alpha1.BudgetParameters)) *v1alpha1.BudgetParameters {
+	return params(append([]func(*v1alpha1.BudgetParameters){func(p *v1alpha1.BudgetParameters) {
+		p.Amount.SpecifiedAmount.CurrencyCode = gcp.StringPtr("USD")
+		p.Filter.CreditTypesTreatment = gcp.StringPtr("INCLUDE_ALL_CREDITS")
+		p.Filter.CalendarPeriod = gcp.StringPtr("MONTH")
+		p.ThresholdRules[0].SpendBasis = gcp.StringPtr("CURRENT_SPEND")
+		p.ThresholdRules[1].SpendBasis = gcp.StringPtr("CURRENT_SPEND")
+		p.NotificationsRule.SchemaVersion = gcp.StringPtr("1.0")
+	}}, m...)...)
+}
+
+func TestLateInitialize(t *testing.T) {
+	p := params()
+	LateInitialize(p, *observed())
+	if diff := cmp.Diff(lateInitialized(), p); diff != "" {
+		t.Errorf("LateInitialize(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateUpdateMask(t *testing.T) {
+	cases := map[string]struct {
+		p    *v1alpha1.BudgetParameters
+		b    *budgets.GoogleCloudBillingBudgetsV1Budget
+		want string
+	}{
+		"UpToDate": {
+			p:    lateInitialized(),
+			b:    observed(),
+			want: "",
+		},
+		"CustomPeriod": {
+			p: lateInitialized(func(p *v1alpha1.BudgetParameters) {
+				p.Filter.CalendarPeriod = nil
+				p.Filter.CustomPeriod = &v1alpha1.CustomPeriod{StartDate: v1alpha1.Date{Year: 2023, Month: 1, Day: 1}}
+			}),
+			b: func() *budgets.GoogleCloudBillingBudgetsV1Budget {
+				b := observed()
+				b.BudgetFilter.CalendarPeriod = "CALENDAR_PERIOD_UNSPECIFIED"
+				b.BudgetFilter.CustomPeriod = &budgets.GoogleCloudBillingBudgetsV1CustomPeriod{
+					StartDate: &budgets.GoogleTypeDate{Year: 2023, Month: 1, Day: 1},
+				}
+				return b
+			}(),
+			want: "",
+		},
+		"AmountAndThresholds": {
+			p: lateInitialized(func(p *v1alpha1.BudgetParameters) {
+				p.Amount.SpecifiedAmount.Units = 2000
+				p.ThresholdRules = append(p.ThresholdRules, v1alpha1.ThresholdRule{Percent: 120})
+			}),
+			b:    observed(),
+			want: "amount,thresholdRules",
+		},
+		"NotificationsRule": {
+			p: lateInitialized(func(p *v1alpha1.BudgetParameters) {
+				p.DisplayName = gcp.StringPtr("platform")
+				p.NotificationsRule.DisableDefaultIAMRecipients = gcp.BoolPtr(true)
+			}),
+			b:    observed(),
+			want: "displayName,notificationsRule",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, GenerateUpdateMask(*tc.p, *tc.b)); diff != "" {
+				t.Errorf("GenerateUpdateMask(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
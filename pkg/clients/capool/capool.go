@@ -0,0 +1,173 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package capool
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	privateca "google.golang.org/api/privateca/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/privateca/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	parentFormat = "projects/%s/locations/%s"
+	nameFormat   = "projects/%s/locations/%s/caPools/%s"
+)
+
+// GetParent returns the location the CaPool lives under.
+func GetParent(projectID, location string) string {
+	return fmt.Sprintf(parentFormat, projectID, location)
+}
+
+// GetFullyQualifiedName builds the relative resource name of the CaPool.
+func GetFullyQualifiedName(projectID, location, name string) string {
+	return fmt.Sprintf(nameFormat, projectID, location, name)
+}
+
+// GenerateCaPool produces a CaPool that is configured via given
+// CaPoolParameters.
+func GenerateCaPool(name string, p v1alpha1.CaPoolParameters) *privateca.CaPool {
+	c := &privateca.CaPool{
+		Name:   name,
+		Tier:   p.Tier,
+		Labels: p.Labels,
+	}
+	if ip := p.IssuancePolicy; ip != nil {
+		c.IssuancePolicy = &privateca.IssuancePolicy{
+			MaximumLifetime:     gcp.StringValue(ip.MaximumLifetime),
+			BaselineValues:      GenerateX509Parameters(ip.BaselineValues),
+			IdentityConstraints: GenerateIdentityConstraints(ip.IdentityConstraints),
+		}
+		if m := ip.AllowedIssuanceModes; m != nil {
+			c.IssuancePolicy.AllowedIssuanceModes = &privateca.IssuanceModes{
+				AllowCsrBasedIssuance:    m.AllowCSRBasedIssuance,
+				AllowConfigBasedIssuance: m.AllowConfigBasedIssuance,
+			}
+		}
+	}
+	if po := p.PublishingOptions; po != nil {
+		c.PublishingOptions = &privateca.PublishingOptions{
+			PublishCaCert: gcp.BoolValue(po.PublishCACert),
+			PublishCrl:    gcp.BoolValue(po.PublishCRL),
+		}
+	}
+	return c
+}
+
+// GenerateX509Parameters produces the X509Parameters described by the
+// supplied X509Parameters, or nil if they are nil. It is shared by the
+// resources of the Certificate Authority Service that describe certificates.
+func GenerateX509Parameters(in *v1alpha1.X509Parameters) *privateca.X509Parameters {
+	if in == nil {
+		return nil
+	}
+	x := &privateca.X509Parameters{
+		AiaOcspServers: in.AIAOCSPServers,
+	}
+	if o := in.CAOptions; o != nil {
+		x.CaOptions = &privateca.CaOptions{
+			IsCa:                gcp.BoolValue(o.IsCA),
+			MaxIssuerPathLength: gcp.Int64Value(o.MaxIssuerPathLength),
+		}
+	}
+	if ku := in.KeyUsage; ku != nil {
+		x.KeyUsage = &privateca.KeyUsage{}
+		if b := ku.BaseKeyUsage; b != nil {
+			x.KeyUsage.BaseKeyUsage = &privateca.KeyUsageOptions{
+				DigitalSignature:  b.DigitalSignature,
+				ContentCommitment: b.ContentCommitment,
+				KeyEncipherment:   b.KeyEncipherment,
+				DataEncipherment:  b.DataEncipherment,
+				KeyAgreement:      b.KeyAgreement,
+				CertSign:          b.CertSign,
+				CrlSign:           b.CRLSign,
+				EncipherOnly:      b.EncipherOnly,
+				DecipherOnly:      b.DecipherOnly,
+			}
+		}
+		if e := ku.ExtendedKeyUsage; e != nil {
+			x.KeyUsage.ExtendedKeyUsage = &privateca.ExtendedKeyUsageOptions{
+				ServerAuth:      e.ServerAuth,
+				ClientAuth:      e.ClientAuth,
+				CodeSigning:     e.CodeSigning,
+				EmailProtection: e.EmailProtection,
+				TimeStamping:    e.TimeStamping,
+				OcspSigning:     e.OCSPSigning,
+			}
+		}
+	}
+	return x
+}
+
+// GenerateIdentityConstraints produces the CertificateIdentityConstraints
+// described by the supplied IdentityConstraints, or nil if they are nil.
+func GenerateIdentityConstraints(in *v1alpha1.IdentityConstraints) *privateca.CertificateIdentityConstraints {
+	if in == nil {
+		return nil
+	}
+	return &privateca.CertificateIdentityConstraints{
+		AllowSubjectPassthrough:         in.AllowSubjectPassthrough,
+		AllowSubjectAltNamesPassthrough: in.AllowSubjectAltNamesPassthrough,
+	}
+}
+
+// GenerateObservation produces a CaPoolObservation from the supplied CaPool.
+func GenerateObservation(c privateca.CaPool) v1alpha1.CaPoolObservation {
+	return v1alpha1.CaPoolObservation{
+		Name: c.Name,
+	}
+}
+
+// LateInitialize fills the empty fields of CaPoolParameters if the
+// corresponding fields are given in CaPool.
+func LateInitialize(p *v1alpha1.CaPoolParameters, c privateca.CaPool) {
+	p.Labels = gcp.LateInitializeStringMap(p.Labels, c.Labels)
+	if p.PublishingOptions == nil && c.PublishingOptions != nil {
+		p.PublishingOptions = &v1alpha1.PublishingOptions{
+			PublishCACert: gcp.BoolPtr(c.PublishingOptions.PublishCaCert),
+			PublishCRL:    gcp.BoolPtr(c.PublishingOptions.PublishCrl),
+		}
+	}
+}
+
+// IsUpToDate checks whether CaPool is configured with given
+// CaPoolParameters.
+func IsUpToDate(p v1alpha1.CaPoolParameters, c privateca.CaPool) bool {
+	return GenerateUpdateMask(p, c) == ""
+}
+
+// GenerateUpdateMask returns the comma separated list of fields that differ
+// between CaPoolParameters and CaPool. The tier can not be updated.
+func GenerateUpdateMask(p v1alpha1.CaPoolParameters, c privateca.CaPool) string {
+	desired := GenerateCaPool(c.Name, p)
+	mask := []string{}
+	if !cmp.Equal(desired.IssuancePolicy, c.IssuancePolicy, cmpopts.EquateEmpty()) {
+		mask = append(mask, "issuance_policy")
+	}
+	if !cmp.Equal(desired.PublishingOptions, c.PublishingOptions, cmpopts.EquateEmpty()) {
+		mask = append(mask, "publishing_options")
+	}
+	if !cmp.Equal(desired.Labels, c.Labels, cmpopts.EquateEmpty()) {
+		mask = append(mask, "labels")
+	}
+	return strings.Join(mask, ",")
+}
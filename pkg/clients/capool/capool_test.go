@@ -0,0 +1,136 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package capool
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	privateca "google.golang.org/api/privateca/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/privateca/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const testName = "projects/foo/locations/us-central1/caPools/pool"
+
+func params(m ...func(*v1alpha1.CaPoolParameters)) *v1alpha1.CaPoolParameters {
+	p := &v1alpha1.CaPoolParameters{
+		Location: "us-central1",
+		Tier:     "DEVOPS",
+		IssuancePolicy: &v1alpha1.IssuancePolicy{
+			MaximumLifetime: gcp.StringPtr("2592000s"),
+			BaselineValues: &v1alpha1.X509Parameters{
+				CAOptions: &v1alpha1.CAOptions{IsCA: gcp.BoolPtr(false)},
+				KeyUsage: &v1alpha1.KeyUsage{
+					BaseKeyUsage:     &v1alpha1.BaseKeyUsage{DigitalSignature: true},
+					ExtendedKeyUsage: &v1alpha1.ExtendedKeyUsage{ServerAuth: true},
+				},
+			},
+		},
+		PublishingOptions: &v1alpha1.PublishingOptions{
+			PublishCACert: gcp.BoolPtr(true),
+			PublishCRL:    gcp.BoolPtr(false),
+		},
+		Labels: map[string]string{"team": "sec"},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func pool(m ...func(*privateca.CaPool)) *privateca.CaPool {
+	c := &privateca.CaPool{
+		Name: testName,
+		Tier: "DEVOPS",
+		IssuancePolicy: &privateca.IssuancePolicy{
+			MaximumLifetime: "2592000s",
+			BaselineValues: &privateca.X509Parameters{
+				CaOptions: &privateca.CaOptions{},
+				KeyUsage: &privateca.KeyUsage{
+					BaseKeyUsage:     &privateca.KeyUsageOptions{DigitalSignature: true},
+					ExtendedKeyUsage: &privateca.ExtendedKeyUsageOptions{ServerAuth: true},
+				},
+			},
+		},
+		PublishingOptions: &privateca.PublishingOptions{PublishCaCert: true},
+		Labels:            map[string]string{"team": "sec"},
+	}
+	for _, f := range m {
+		f(c)
+	}
+	return c
+}
+
+func TestGenerateCaPool(t *testing.T) {
+	if diff := cmp.Diff(pool(), GenerateCaPool(testName, *params())); diff != "" {
+		t.Errorf("GenerateCaPool(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitialize(t *testing.T) {
+	got := params(func(p *v1alpha1.CaPoolParameters) {
+		p.PublishingOptions = nil
+		p.Labels = nil
+	})
+	LateInitialize(got, *pool())
+	if diff := cmp.Diff(params(), got); diff != "" {
+		t.Errorf("LateInitialize(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateUpdateMask(t *testing.T) {
+	cases := map[string]struct {
+		p    *v1alpha1.CaPoolParameters
+		c    *privateca.CaPool
+		want string
+	}{
+		"UpToDate": {
+			p:    params(),
+			c:    pool(),
+			want: "",
+		},
+		"TierIsIgnored": {
+			p:    params(),
+			c:    pool(func(c *privateca.CaPool) { c.Tier = "ENTERPRISE" }),
+			want: "",
+		},
+		"IssuancePolicyAndLabels": {
+			p: params(func(p *v1alpha1.CaPoolParameters) {
+				p.IssuancePolicy.MaximumLifetime = gcp.StringPtr("86400s")
+				p.Labels = nil
+			}),
+			c:    pool(),
+			want: "issuance_policy,labels",
+		},
+		"PublishingOptions": {
+			p: params(func(p *v1alpha1.CaPoolParameters) {
+				p.PublishingOptions.PublishCRL = gcp.BoolPtr(true)
+			}),
+			c:    pool(),
+			want: "publishing_options",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, GenerateUpdateMask(*tc.p, *tc.c)); diff != "" {
+				t.Errorf("GenerateUpdateMask(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
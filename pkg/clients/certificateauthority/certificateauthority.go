@@ -0,0 +1,164 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificateauthority
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	privateca "google.golang.org/api/privateca/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/privateca/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/capool"
+)
+
+const (
+	nameFormat = "projects/%s/locations/%s/caPools/%s/certificateAuthorities/%s"
+)
+
+// GetParent returns the CA pool the CertificateAuthority belongs to.
+func GetParent(projectID string, p v1alpha1.CertificateAuthorityParameters) string {
+	return capool.GetFullyQualifiedName(projectID, p.Location, gcp.StringValue(p.CaPool))
+}
+
+// GetFullyQualifiedName builds the relative resource name of the
+// CertificateAuthority.
+func GetFullyQualifiedName(projectID string, p v1alpha1.CertificateAuthorityParameters, name string) string {
+	return fmt.Sprintf(nameFormat, projectID, p.Location, gcp.StringValue(p.CaPool), name)
+}
+
+// GenerateCertificateAuthority produces a CertificateAuthority that is
+// configured via given CertificateAuthorityParameters.
+func GenerateCertificateAuthority(name string, p v1alpha1.CertificateAuthorityParameters) *privateca.CertificateAuthority {
+	s := p.Config.SubjectConfig.Subject
+	ca := &privateca.CertificateAuthority{
+		Name:      name,
+		Type:      p.Type,
+		Lifetime:  p.Lifetime,
+		GcsBucket: gcp.StringValue(p.GCSBucket),
+		Labels:    p.Labels,
+		KeySpec: &privateca.KeyVersionSpec{
+			Algorithm:          gcp.StringValue(p.KeySpec.Algorithm),
+			CloudKmsKeyVersion: gcp.StringValue(p.KeySpec.CloudKMSKeyVersion),
+		},
+		Config: &privateca.CertificateConfig{
+			SubjectConfig: &privateca.SubjectConfig{
+				Subject: &privateca.Subject{
+					CommonName:         gcp.StringValue(s.CommonName),
+					CountryCode:        gcp.StringValue(s.CountryCode),
+					Organization:       gcp.StringValue(s.Organization),
+					OrganizationalUnit: gcp.StringValue(s.OrganizationalUnit),
+					Locality:           gcp.StringValue(s.Locality),
+					Province:           gcp.StringValue(s.Province),
+					StreetAddress:      gcp.StringValue(s.StreetAddress),
+					PostalCode:         gcp.StringValue(s.PostalCode),
+				},
+			},
+			X509Config: capool.GenerateX509Parameters(&p.Config.X509Config),
+		},
+	}
+	if san := p.Config.SubjectConfig.SubjectAltName; san != nil {
+		ca.Config.SubjectConfig.SubjectAltName = &privateca.SubjectAltNames{
+			DnsNames:       san.DNSNames,
+			Uris:           san.URIs,
+			EmailAddresses: san.EmailAddresses,
+			IpAddresses:    san.IPAddresses,
+		}
+	}
+	return ca
+}
+
+// GenerateObservation produces a CertificateAuthorityObservation from the
+// supplied CertificateAuthority.
+func GenerateObservation(ca privateca.CertificateAuthority) v1alpha1.CertificateAuthorityObservation {
+	o := v1alpha1.CertificateAuthorityObservation{
+		Name:       ca.Name,
+		State:      ca.State,
+		Tier:       ca.Tier,
+		CreateTime: ca.CreateTime,
+		UpdateTime: ca.UpdateTime,
+		DeleteTime: ca.DeleteTime,
+		ExpireTime: ca.ExpireTime,
+	}
+	if ca.AccessUrls != nil {
+		o.CACertificateAccessURL = ca.AccessUrls.CaCertificateAccessUrl
+		o.CRLAccessURLs = ca.AccessUrls.CrlAccessUrls
+	}
+	return o
+}
+
+// GetConnectionDetails returns the PEM-encoded certificate chain of the
+// supplied CertificateAuthority, if it has been issued.
+func GetConnectionDetails(ca privateca.CertificateAuthority) managed.ConnectionDetails {
+	if len(ca.PemCaCertificates) == 0 {
+		return nil
+	}
+	return managed.ConnectionDetails{
+		v1alpha1.CertificateAuthoritySecretCACertificateKey: []byte(strings.Join(ca.PemCaCertificates, "")),
+	}
+}
+
+// LateInitialize fills the empty fields of CertificateAuthorityParameters if
+// the corresponding fields are given in CertificateAuthority.
+func LateInitialize(p *v1alpha1.CertificateAuthorityParameters, ca privateca.CertificateAuthority) {
+	p.GCSBucket = gcp.LateInitializeString(p.GCSBucket, ca.GcsBucket)
+	p.Labels = gcp.LateInitializeStringMap(p.Labels, ca.Labels)
+}
+
+// IsUpToDate checks whether CertificateAuthority is configured with given
+// CertificateAuthorityParameters. Only its labels and whether it is enabled
+// are considered, since nothing else can be updated.
+func IsUpToDate(p v1alpha1.CertificateAuthorityParameters, ca privateca.CertificateAuthority) bool {
+	return LabelsUpToDate(p, ca) && NeedsStateChange(p, ca) == ""
+}
+
+// LabelsUpToDate checks whether CertificateAuthority has the labels given in
+// CertificateAuthorityParameters.
+func LabelsUpToDate(p v1alpha1.CertificateAuthorityParameters, ca privateca.CertificateAuthority) bool {
+	return cmp.Equal(p.Labels, ca.Labels, cmpopts.EquateEmpty())
+}
+
+// NeedsStateChange returns the state the CertificateAuthority must be moved
+// to in order to reach the desired state given in
+// CertificateAuthorityParameters, or an empty string if it need not be. A
+// certificate authority that is awaiting activation or deleted can be neither
+// enabled nor disabled.
+func NeedsStateChange(p v1alpha1.CertificateAuthorityParameters, ca privateca.CertificateAuthority) string {
+	desired := v1alpha1.CertificateAuthorityStateEnabled
+	if p.DesiredState != nil {
+		desired = *p.DesiredState
+	}
+	switch ca.State {
+	case v1alpha1.CertificateAuthorityStateStaged, v1alpha1.CertificateAuthorityStateDisabled:
+		if desired == v1alpha1.CertificateAuthorityStateEnabled {
+			return v1alpha1.CertificateAuthorityStateEnabled
+		}
+		if ca.State == v1alpha1.CertificateAuthorityStateStaged {
+			return v1alpha1.CertificateAuthorityStateDisabled
+		}
+	case v1alpha1.CertificateAuthorityStateEnabled:
+		if desired == v1alpha1.CertificateAuthorityStateDisabled {
+			return v1alpha1.CertificateAuthorityStateDisabled
+		}
+	}
+	return ""
+}
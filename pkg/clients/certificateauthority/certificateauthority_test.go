@@ -0,0 +1,155 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificateauthority
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	privateca "google.golang.org/api/privateca/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/privateca/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+func params(m ...func(*v1alpha1.CertificateAuthorityParameters)) *v1alpha1.CertificateAuthorityParameters {
+	p := &v1alpha1.CertificateAuthorityParameters{
+		Location: "us-central1",
+		CaPool:   gcp.StringPtr("pool"),
+		Type:     "SELF_SIGNED",
+		Lifetime: "315360000s",
+		KeySpec:  v1alpha1.KeyVersionSpec{Algorithm: gcp.StringPtr("EC_P256_SHA256")},
+		Config: v1alpha1.CertificateConfig{
+			SubjectConfig: v1alpha1.SubjectConfig{
+				Subject:        v1alpha1.Subject{CommonName: gcp.StringPtr("Example Root CA")},
+				SubjectAltName: &v1alpha1.SubjectAltNames{DNSNames: []string{"ca.example.com"}},
+			},
+			X509Config: v1alpha1.X509Parameters{
+				CAOptions: &v1alpha1.CAOptions{IsCA: gcp.BoolPtr(true)},
+			},
+		},
+		Labels: map[string]string{"team": "sec"},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func TestGetFullyQualifiedName(t *testing.T) {
+	want := "projects/foo/locations/us-central1/caPools/pool/certificateAuthorities/root"
+	if diff := cmp.Diff(want, GetFullyQualifiedName("foo", *params(), "root")); diff != "" {
+		t.Errorf("GetFullyQualifiedName(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateCertificateAuthority(t *testing.T) {
+	want := &privateca.CertificateAuthority{
+		Type:     "SELF_SIGNED",
+		Lifetime: "315360000s",
+		Labels:   map[string]string{"team": "sec"},
+		KeySpec:  &privateca.KeyVersionSpec{Algorithm: "EC_P256_SHA256"},
+		Config: &privateca.CertificateConfig{
+			SubjectConfig: &privateca.SubjectConfig{
+				Subject:        &privateca.Subject{CommonName: "Example Root CA"},
+				SubjectAltName: &privateca.SubjectAltNames{DnsNames: []string{"ca.example.com"}},
+			},
+			X509Config: &privateca.X509Parameters{
+				CaOptions: &privateca.CaOptions{IsCa: true},
+			},
+		},
+	}
+	if diff := cmp.Diff(want, GenerateCertificateAuthority("", *params())); diff != "" {
+		t.Errorf("GenerateCertificateAuthority(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGetConnectionDetails(t *testing.T) {
+	cases := map[string]struct {
+		ca   privateca.CertificateAuthority
+		want managed.ConnectionDetails
+	}{
+		"NotIssued": {},
+		"Chain": {
+			ca: privateca.CertificateAuthority{PemCaCertificates: []string{"leaf\n", "root\n"}},
+			want: managed.ConnectionDetails{
+				v1alpha1.CertificateAuthoritySecretCACertificateKey: []byte("leaf\nroot\n"),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, GetConnectionDetails(tc.ca)); diff != "" {
+				t.Errorf("GetConnectionDetails(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNeedsStateChange(t *testing.T) {
+	disabled := func(p *v1alpha1.CertificateAuthorityParameters) {
+		p.DesiredState = gcp.StringPtr(v1alpha1.CertificateAuthorityStateDisabled)
+	}
+	cases := map[string]struct {
+		p     *v1alpha1.CertificateAuthorityParameters
+		state string
+		want  string
+	}{
+		"StagedIsEnabledByDefault": {
+			p:     params(),
+			state: v1alpha1.CertificateAuthorityStateStaged,
+			want:  v1alpha1.CertificateAuthorityStateEnabled,
+		},
+		"StagedIsDisabled": {
+			p:     params(disabled),
+			state: v1alpha1.CertificateAuthorityStateStaged,
+			want:  v1alpha1.CertificateAuthorityStateDisabled,
+		},
+		"DisabledIsEnabled": {
+			p:     params(),
+			state: v1alpha1.CertificateAuthorityStateDisabled,
+			want:  v1alpha1.CertificateAuthorityStateEnabled,
+		},
+		"EnabledIsDisabled": {
+			p:     params(disabled),
+			state: v1alpha1.CertificateAuthorityStateEnabled,
+			want:  v1alpha1.CertificateAuthorityStateDisabled,
+		},
+		"Enabled": {
+			p:     params(),
+			state: v1alpha1.CertificateAuthorityStateEnabled,
+		},
+		"Disabled": {
+			p:     params(disabled),
+			state: v1alpha1.CertificateAuthorityStateDisabled,
+		},
+		"AwaitingUserActivation": {
+			p:     params(),
+			state: v1alpha1.CertificateAuthorityStateAwaitingUserActivation,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := NeedsStateChange(*tc.p, privateca.CertificateAuthority{State: tc.state})
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("NeedsStateChange(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificatetemplate
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	privateca "google.golang.org/api/privateca/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/privateca/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/capool"
+)
+
+const (
+	parentFormat = "projects/%s/locations/%s"
+	nameFormat   = "projects/%s/locations/%s/certificateTemplates/%s"
+)
+
+// GetParent returns the location the CertificateTemplate lives under.
+func GetParent(projectID, location string) string {
+	return fmt.Sprintf(parentFormat, projectID, location)
+}
+
+// GetFullyQualifiedName builds the relative resource name of the
+// CertificateTemplate.
+func GetFullyQualifiedName(projectID, location, name string) string {
+	return fmt.Sprintf(nameFormat, projectID, location, name)
+}
+
+// GenerateCertificateTemplate produces a CertificateTemplate that is
+// configured via given CertificateTemplateParameters.
+func GenerateCertificateTemplate(name string, p v1alpha1.CertificateTemplateParameters) *privateca.CertificateTemplate {
+	t := &privateca.CertificateTemplate{
+		Name:                name,
+		Description:         gcp.StringValue(p.Description),
+		Labels:              p.Labels,
+		PredefinedValues:    capool.GenerateX509Parameters(p.PredefinedValues),
+		IdentityConstraints: capool.GenerateIdentityConstraints(p.IdentityConstraints),
+	}
+	if pe := p.PassthroughExtensions; pe != nil {
+		t.PassthroughExtensions = &privateca.CertificateExtensionConstraints{
+			KnownExtensions: pe.KnownExtensions,
+		}
+	}
+	return t
+}
+
+// GenerateObservation produces a CertificateTemplateObservation from the
+// supplied CertificateTemplate.
+func GenerateObservation(t privateca.CertificateTemplate) v1alpha1.CertificateTemplateObservation {
+	return v1alpha1.CertificateTemplateObservation{
+		Name:       t.Name,
+		CreateTime: t.CreateTime,
+		UpdateTime: t.UpdateTime,
+	}
+}
+
+// LateInitialize fills the empty fields of CertificateTemplateParameters if
+// the corresponding fields are given in CertificateTemplate.
+func LateInitialize(p *v1alpha1.CertificateTemplateParameters, t privateca.CertificateTemplate) {
+	p.Description = gcp.LateInitializeString(p.Description, t.Description)
+	p.Labels = gcp.LateInitializeStringMap(p.Labels, t.Labels)
+}
+
+// IsUpToDate checks whether CertificateTemplate is configured with given
+// CertificateTemplateParameters.
+func IsUpToDate(p v1alpha1.CertificateTemplateParameters, t privateca.CertificateTemplate) bool {
+	return GenerateUpdateMask(p, t) == ""
+}
+
+// GenerateUpdateMask returns the comma separated list of fields that differ
+// between CertificateTemplateParameters and CertificateTemplate.
+func GenerateUpdateMask(p v1alpha1.CertificateTemplateParameters, t privateca.CertificateTemplate) string {
+	desired := GenerateCertificateTemplate(t.Name, p)
+	mask := []string{}
+	if desired.Description != t.Description {
+		mask = append(mask, "description")
+	}
+	if !cmp.Equal(desired.Labels, t.Labels, cmpopts.EquateEmpty()) {
+		mask = append(mask, "labels")
+	}
+	if !cmp.Equal(desired.PredefinedValues, t.PredefinedValues, cmpopts.EquateEmpty()) {
+		mask = append(mask, "predefined_values")
+	}
+	if !cmp.Equal(desired.IdentityConstraints, t.IdentityConstraints, cmpopts.EquateEmpty()) {
+		mask = append(mask, "identity_constraints")
+	}
+	if !cmp.Equal(desired.PassthroughExtensions, t.PassthroughExtensions, cmpopts.EquateEmpty()) {
+		mask = append(mask, "passthrough_extensions")
+	}
+	return strings.Join(mask, ",")
+}
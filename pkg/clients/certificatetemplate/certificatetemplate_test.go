@@ -0,0 +1,135 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificatetemplate
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	privateca "google.golang.org/api/privateca/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/privateca/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const testName = "projects/foo/locations/us-central1/certificateTemplates/server"
+
+func params(m ...func(*v1alpha1.CertificateTemplateParameters)) *v1alpha1.CertificateTemplateParameters {
+	p := &v1alpha1.CertificateTemplateParameters{
+		Location:    "us-central1",
+		Description: gcp.StringPtr("server certificates"),
+		PredefinedValues: &v1alpha1.X509Parameters{
+			KeyUsage: &v1alpha1.KeyUsage{
+				BaseKeyUsage:     &v1alpha1.BaseKeyUsage{DigitalSignature: true},
+				ExtendedKeyUsage: &v1alpha1.ExtendedKeyUsage{ServerAuth: true},
+			},
+		},
+		IdentityConstraints: &v1alpha1.IdentityConstraints{
+			AllowSubjectPassthrough:         true,
+			AllowSubjectAltNamesPassthrough: true,
+		},
+		PassthroughExtensions: &v1alpha1.ExtensionConstraints{KnownExtensions: []string{"EXTENDED_KEY_USAGE"}},
+		Labels:                map[string]string{"team": "sec"},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func template(m ...func(*privateca.CertificateTemplate)) *privateca.CertificateTemplate {
+	t := &privateca.CertificateTemplate{
+		Name:        testName,
+		Description: "server certificates",
+		PredefinedValues: &privateca.X509Parameters{
+			KeyUsage: &privateca.KeyUsage{
+				BaseKeyUsage:     &privateca.KeyUsageOptions{DigitalSignature: true},
+				ExtendedKeyUsage: &privateca.ExtendedKeyUsageOptions{ServerAuth: true},
+			},
+		},
+		IdentityConstraints: &privateca.CertificateIdentityConstraints{
+			AllowSubjectPassthrough:         true,
+			AllowSubjectAltNamesPassthrough: true,
+		},
+		PassthroughExtensions: &privateca.CertificateExtensionConstraints{KnownExtensions: []string{"EXTENDED_KEY_USAGE"}},
+		Labels:                map[string]string{"team": "sec"},
+	}
+	for _, f := range m {
+		f(t)
+	}
+	return t
+}
+
+func TestGenerateCertificateTemplate(t *testing.T) {
+	if diff := cmp.Diff(template(), GenerateCertificateTemplate(testName, *params())); diff != "" {
+		t.Errorf("GenerateCertificateTemplate(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitialize(t *testing.T) {
+	p := params(func(p *v1alpha1.CertificateTemplateParameters) {
+		p.Description = nil
+		p.Labels = nil
+	})
+	LateInitialize(p, *template())
+	if diff := cmp.Diff(params(), p); diff != "" {
+		t.Errorf("LateInitialize(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateUpdateMask(t *testing.T) {
+	cases := map[string]struct {
+		p    *v1alpha1.CertificateTemplateParameters
+		t    *privateca.CertificateTemplate
+		want string
+	}{
+		"UpToDate": {
+			p:    params(),
+			t:    template(),
+			want: "",
+		},
+		"EmptyLabels": {
+			p: params(func(p *v1alpha1.CertificateTemplateParameters) {
+				p.Labels = map[string]string{}
+			}),
+			t: template(func(t *privateca.CertificateTemplate) {
+				t.Labels = nil
+			}),
+			want: "",
+		},
+		"Outdated": {
+			p: params(func(p *v1alpha1.CertificateTemplateParameters) {
+				p.Description = gcp.StringPtr("client certificates")
+				p.PredefinedValues.KeyUsage.ExtendedKeyUsage = &v1alpha1.ExtendedKeyUsage{ClientAuth: true}
+				p.IdentityConstraints.AllowSubjectPassthrough = false
+				p.PassthroughExtensions = nil
+			}),
+			t:    template(),
+			want: "description,predefined_values,identity_constraints,passthrough_extensions",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, GenerateUpdateMask(*tc.p, *tc.t)); diff != "" {
+				t.Errorf("GenerateUpdateMask(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want == "", IsUpToDate(*tc.p, *tc.t)); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -18,6 +18,7 @@ package cloudmemorystore
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/go-cmp/cmp"
@@ -118,6 +119,21 @@ func GenerateAuthStringObservation(r redis.InstanceAuthString) string {
 	return r.AuthString
 }
 
+// GetServerCACertificate returns the PEM encoded CA certificates of the
+// instance, concatenated so that clients keep trusting the instance while its
+// certificate authority is rotated. It returns nil if the instance has no
+// in-transit encryption.
+func GetServerCACertificate(r redis.Instance) []byte {
+	var pem []byte
+	for _, c := range r.ServerCaCerts {
+		if c == nil || c.Cert == "" {
+			continue
+		}
+		pem = append(pem, strings.TrimRight(c.Cert, "\n")+"\n"...)
+	}
+	return pem
+}
+
 // LateInitializeSpec fills empty spec fields with the data retrieved from GCP.
 func LateInitializeSpec(spec *v1beta1.CloudMemorystoreInstanceParameters, r redis.Instance) {
 	if spec.Tier == "" {
@@ -17,6 +17,7 @@ limitations under the License.
 package cloudsql
 
 import (
+	"strconv"
 	"strings"
 
 	"github.com/crossplane/crossplane-runtime/pkg/errors"
@@ -29,7 +30,33 @@ import (
 	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
 )
 
-const errCheckUpToDate = "unable to determine if external resource is up to date"
+const (
+	errCheckUpToDate = "unable to determine if external resource is up to date"
+
+	errFmtFlagDuplicate   = "database flag %q is set more than once"
+	errFmtFlagUnsupported = "database flag %q is not supported for database version %s"
+	errFmtFlagNoValue     = "database flag %q does not take a value"
+	errFmtFlagBoolean     = "database flag %q must be either \"on\" or \"off\""
+	errFmtFlagInteger     = "database flag %q must be an integer"
+	errFmtFlagRange       = "database flag %q must be between %d and %d"
+	errFmtFlagAllowed     = "database flag %q must be one of %s"
+)
+
+// Database flags that enable IAM database authentication.
+const (
+	MysqlIAMAuthenticationFlag      = "cloudsql_iam_authentication"
+	PostgresqlIAMAuthenticationFlag = "cloudsql.iam_authentication"
+
+	sqlserverDBVersionPrefix = "SQLSERVER"
+)
+
+// Database flag types as reported by the Cloud SQL Admin API.
+const (
+	flagTypeBoolean = "BOOLEAN"
+	flagTypeString  = "STRING"
+	flagTypeInteger = "INTEGER"
+	flagTypeNone    = "NONE"
+)
 
 // Cyclomatic complexity test is disabled for translation methods
 // because all they do is simple comparison & assignment without
@@ -47,6 +74,10 @@ func GenerateDatabaseInstance(name string, in v1beta1.CloudSQLInstanceParameters
 	db.Region = in.Region
 	db.ReplicaNames = in.ReplicaNames
 	db.SuspensionReason = in.SuspensionReason
+	if gcp.BoolValue(in.PromoteReplica) {
+		db.InstanceType = v1beta1.InstanceTypeCloudSQL
+		db.MasterInstanceName = ""
+	}
 	if in.DiskEncryptionConfiguration != nil {
 		if db.DiskEncryptionConfiguration == nil {
 			db.DiskEncryptionConfiguration = &sqladmin.DiskEncryptionConfiguration{}
@@ -82,6 +113,12 @@ func GenerateDatabaseInstance(name string, in v1beta1.CloudSQLInstanceParameters
 	db.Settings.Tier = in.Settings.Tier
 	db.Settings.UserLabels = in.Settings.UserLabels
 
+	if in.Settings.ActiveDirectoryConfig != nil {
+		if db.Settings.ActiveDirectoryConfig == nil {
+			db.Settings.ActiveDirectoryConfig = &sqladmin.SqlActiveDirectoryConfig{}
+		}
+		db.Settings.ActiveDirectoryConfig.Domain = in.Settings.ActiveDirectoryConfig.Domain
+	}
 	if in.Settings.BackupConfiguration != nil {
 		if db.Settings.BackupConfiguration == nil {
 			db.Settings.BackupConfiguration = &sqladmin.BackupConfiguration{}
@@ -137,10 +174,11 @@ func GenerateDatabaseInstance(name string, in v1beta1.CloudSQLInstanceParameters
 		db.Settings.MaintenanceWindow.Hour = gcp.Int64Value(in.Settings.MaintenanceWindow.Hour)
 		db.Settings.MaintenanceWindow.UpdateTrack = gcp.StringValue(in.Settings.MaintenanceWindow.UpdateTrack)
 	}
-	if len(in.Settings.DatabaseFlags) > 0 {
-		db.Settings.DatabaseFlags = make([]*sqladmin.DatabaseFlags, len(in.Settings.DatabaseFlags))
+	flags := DatabaseFlags(in)
+	if len(flags) > 0 {
+		db.Settings.DatabaseFlags = make([]*sqladmin.DatabaseFlags, len(flags))
 	}
-	for i, val := range in.Settings.DatabaseFlags {
+	for i, val := range flags {
 		db.Settings.DatabaseFlags[i] = &sqladmin.DatabaseFlags{
 			Name:  val.Name,
 			Value: val.Value,
@@ -151,16 +189,27 @@ func GenerateDatabaseInstance(name string, in v1beta1.CloudSQLInstanceParameters
 // GenerateObservation produces CloudSQLInstanceObservation object from *sqladmin.DatabaseInstance object.
 func GenerateObservation(in sqladmin.DatabaseInstance) v1beta1.CloudSQLInstanceObservation { // nolint:gocyclo
 	o := v1beta1.CloudSQLInstanceObservation{
-		BackendType:                in.BackendType,
-		CurrentDiskSize:            in.CurrentDiskSize,
-		ConnectionName:             in.ConnectionName,
-		GceZone:                    in.GceZone,
-		IPv6Address:                in.Ipv6Address,
-		Project:                    in.Project,
-		SelfLink:                   in.SelfLink,
-		ServiceAccountEmailAddress: in.ServiceAccountEmailAddress,
-		State:                      in.State,
-		SettingsVersion:            in.Settings.SettingsVersion,
+		BackendType:                  in.BackendType,
+		CurrentDiskSize:              in.CurrentDiskSize,
+		ConnectionName:               in.ConnectionName,
+		GceZone:                      in.GceZone,
+		IPv6Address:                  in.Ipv6Address,
+		Project:                      in.Project,
+		SelfLink:                     in.SelfLink,
+		ServiceAccountEmailAddress:   in.ServiceAccountEmailAddress,
+		State:                        in.State,
+		SettingsVersion:              in.Settings.SettingsVersion,
+		InstanceType:                 in.InstanceType,
+		MasterInstanceName:           in.MasterInstanceName,
+		MaintenanceVersion:           in.MaintenanceVersion,
+		AvailableMaintenanceVersions: in.AvailableMaintenanceVersions,
+	}
+	if in.ScheduledMaintenance != nil {
+		o.ScheduledMaintenance = &v1beta1.ScheduledMaintenance{
+			StartTime:            in.ScheduledMaintenance.StartTime,
+			ScheduleDeadlineTime: in.ScheduledMaintenance.ScheduleDeadlineTime,
+			CanReschedule:        in.ScheduledMaintenance.CanReschedule,
+		}
 	}
 	if in.DiskEncryptionStatus != nil {
 		o.DiskEncryptionStatus = &v1beta1.DiskEncryptionStatus{
@@ -225,12 +274,25 @@ func LateInitializeSpec(spec *v1beta1.CloudSQLInstanceParameters, in sqladmin.Da
 			spec.Settings.DataDiskSizeGb = gcp.Int64Ptr(in.Settings.DataDiskSizeGb)
 		}
 		if len(spec.Settings.DatabaseFlags) == 0 && len(in.Settings.DatabaseFlags) != 0 {
-			spec.Settings.DatabaseFlags = make([]*v1beta1.DatabaseFlags, len(in.Settings.DatabaseFlags))
-			for i, val := range in.Settings.DatabaseFlags {
-				spec.Settings.DatabaseFlags[i] = &v1beta1.DatabaseFlags{
+			// The IAM database authentication flag is owned by
+			// iamAuthentication when that is set.
+			iam := ""
+			if spec.Settings.IAMAuthentication != nil {
+				iam = IAMAuthenticationFlag(gcp.StringValue(spec.DatabaseVersion))
+			}
+			for _, val := range in.Settings.DatabaseFlags {
+				if iam != "" && val.Name == iam {
+					continue
+				}
+				spec.Settings.DatabaseFlags = append(spec.Settings.DatabaseFlags, &v1beta1.DatabaseFlags{
 					Name:  val.Name,
 					Value: val.Value,
-				}
+				})
+			}
+		}
+		if in.Settings.ActiveDirectoryConfig != nil && spec.Settings.ActiveDirectoryConfig == nil {
+			spec.Settings.ActiveDirectoryConfig = &v1beta1.ActiveDirectoryConfig{
+				Domain: in.Settings.ActiveDirectoryConfig.Domain,
 			}
 		}
 		if in.Settings.BackupConfiguration != nil {
@@ -341,6 +403,36 @@ func IsUpToDate(name string, in *v1beta1.CloudSQLInstanceParameters, observed *s
 	return cmp.Equal(desired, observed, cmpopts.EquateEmpty(), cmpopts.IgnoreFields(sqladmin.DatabaseInstance{}, "Settings.IpConfiguration.ForceSendFields")), nil
 }
 
+// IsPromotionPending returns true if a read replica should be promoted to a
+// standalone instance.
+func IsPromotionPending(in v1beta1.CloudSQLInstanceParameters, o v1beta1.CloudSQLInstanceObservation) bool {
+	return gcp.BoolValue(in.PromoteReplica) && o.InstanceType == v1beta1.InstanceTypeReadReplica
+}
+
+// AnnotationKeyFailoverTrigger is set to the failoverTrigger of a
+// CloudSQLInstance before a manual failover is started for it. Unlike the
+// status of the instance it is persisted before the failover is started, so
+// that a failover is never started twice for the same trigger.
+const AnnotationKeyFailoverTrigger = "gcp.crossplane.io/failover-trigger"
+
+// IsFailoverPending returns true if a manual failover was requested that has
+// not been started yet.
+func IsFailoverPending(in v1beta1.CloudSQLInstanceParameters, o v1beta1.CloudSQLInstanceObservation) bool {
+	return gcp.StringValue(in.FailoverTrigger) != "" && gcp.StringValue(in.FailoverTrigger) != o.LastFailoverTrigger
+}
+
+// IsMaintenanceVersionPending returns true if the instance should be updated
+// to a maintenance version other than its current one.
+func IsMaintenanceVersionPending(in v1beta1.CloudSQLInstanceParameters, o v1beta1.CloudSQLInstanceObservation) bool {
+	return gcp.StringValue(in.MaintenanceVersion) != "" && gcp.StringValue(in.MaintenanceVersion) != o.MaintenanceVersion
+}
+
+// IsMaintenanceVersionAvailable returns true if the instance can be updated
+// to the requested maintenance version.
+func IsMaintenanceVersionAvailable(in v1beta1.CloudSQLInstanceParameters, o v1beta1.CloudSQLInstanceObservation) bool {
+	return containsString(o.AvailableMaintenanceVersions, gcp.StringValue(in.MaintenanceVersion))
+}
+
 // DatabaseUserName returns default database user name base on database version
 func DatabaseUserName(p v1beta1.CloudSQLInstanceParameters) string {
 	if strings.HasPrefix(gcp.StringValue(p.DatabaseVersion), v1beta1.PostgresqlDBVersionPrefix) {
@@ -365,3 +457,126 @@ func GetServerCACertificate(in sqladmin.DatabaseInstance) map[string][]byte {
 		v1beta1.CloudSQLSecretServerCACertificateSha1FingerprintKey:  []byte(in.ServerCaCert.Sha1Fingerprint),
 	}
 }
+
+// IAMAuthenticationFlag returns the name of the database flag that enables IAM
+// database authentication for the supplied database version, or an empty
+// string if the version does not support it. Versions other than PostgreSQL
+// and SQL Server, including the API default, are MySQL versions.
+func IAMAuthenticationFlag(version string) string {
+	switch {
+	case strings.HasPrefix(version, v1beta1.PostgresqlDBVersionPrefix):
+		return PostgresqlIAMAuthenticationFlag
+	case strings.HasPrefix(version, sqlserverDBVersionPrefix):
+		return ""
+	default:
+		return MysqlIAMAuthenticationFlag
+	}
+}
+
+// DatabaseFlags returns the database flags of the supplied parameters, with
+// the IAM database authentication flag set as iamAuthentication requests.
+func DatabaseFlags(in v1beta1.CloudSQLInstanceParameters) []*v1beta1.DatabaseFlags {
+	name := IAMAuthenticationFlag(gcp.StringValue(in.DatabaseVersion))
+	if in.Settings.IAMAuthentication == nil || name == "" {
+		return in.Settings.DatabaseFlags
+	}
+	value := "off"
+	if *in.Settings.IAMAuthentication {
+		value = "on"
+	}
+	flags := make([]*v1beta1.DatabaseFlags, 0, len(in.Settings.DatabaseFlags)+1)
+	set := false
+	for _, f := range in.Settings.DatabaseFlags {
+		if f != nil && f.Name == name {
+			if !set {
+				flags = append(flags, &v1beta1.DatabaseFlags{Name: name, Value: value})
+				set = true
+			}
+			continue
+		}
+		flags = append(flags, f)
+	}
+	if !set {
+		flags = append(flags, &v1beta1.DatabaseFlags{Name: name, Value: value})
+	}
+	return flags
+}
+
+// ValidateDatabaseFlags checks the supplied database flags against the flags
+// the Cloud SQL Admin API reports as supported for the database version. It
+// returns an error naming the first offending flag, so that invalid flags are
+// surfaced precisely instead of as an opaque instance update failure.
+func ValidateDatabaseFlags(version string, flags []*v1beta1.DatabaseFlags, supported []*sqladmin.Flag) error { // nolint:gocyclo
+	known := make(map[string]*sqladmin.Flag, len(supported))
+	for _, f := range supported {
+		known[f.Name] = f
+	}
+	seen := make(map[string]bool, len(flags))
+	for _, f := range flags {
+		if f == nil {
+			continue
+		}
+		if seen[f.Name] {
+			return errors.Errorf(errFmtFlagDuplicate, f.Name)
+		}
+		seen[f.Name] = true
+		s, ok := known[f.Name]
+		if !ok {
+			return errors.Errorf(errFmtFlagUnsupported, f.Name, version)
+		}
+		switch s.Type {
+		case flagTypeNone:
+			if f.Value != "" {
+				return errors.Errorf(errFmtFlagNoValue, f.Name)
+			}
+		case flagTypeBoolean:
+			if f.Value != "on" && f.Value != "off" {
+				return errors.Errorf(errFmtFlagBoolean, f.Name)
+			}
+		case flagTypeInteger:
+			if err := validateIntegerFlag(f, s); err != nil {
+				return err
+			}
+		case flagTypeString:
+			if len(s.AllowedStringValues) != 0 && !containsString(s.AllowedStringValues, f.Value) {
+				return errors.Errorf(errFmtFlagAllowed, f.Name, strings.Join(s.AllowedStringValues, ", "))
+			}
+		}
+	}
+	return nil
+}
+
+func validateIntegerFlag(f *v1beta1.DatabaseFlags, s *sqladmin.Flag) error {
+	v, err := strconv.ParseInt(f.Value, 10, 64)
+	if err != nil {
+		return errors.Errorf(errFmtFlagInteger, f.Name)
+	}
+	for _, a := range s.AllowedIntValues {
+		if v == a {
+			return nil
+		}
+	}
+	if s.MinValue == 0 && s.MaxValue == 0 {
+		if len(s.AllowedIntValues) == 0 {
+			return nil
+		}
+		allowed := make([]string, len(s.AllowedIntValues))
+		for i, a := range s.AllowedIntValues {
+			allowed[i] = strconv.FormatInt(a, 10)
+		}
+		return errors.Errorf(errFmtFlagAllowed, f.Name, strings.Join(allowed, ", "))
+	}
+	if v < s.MinValue || v > s.MaxValue {
+		return errors.Errorf(errFmtFlagRange, f.Name, s.MinValue, s.MaxValue)
+	}
+	return nil
+}
+
+func containsString(in []string, s string) bool {
+	for _, v := range in {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
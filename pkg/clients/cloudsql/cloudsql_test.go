@@ -127,13 +127,22 @@ func observation(m ...func(*v1beta1.CloudSQLInstanceObservation)) *v1beta1.Cloud
 		FailoverReplica: &v1beta1.DatabaseInstanceFailoverReplicaStatus{
 			Available: true,
 		},
-		IPv6Address:                "2.19sd920.2",
-		Project:                    "crossplane-eats-the-cloud",
-		ServiceAccountEmailAddress: "john@dontparseme.com",
-		GceZone:                    "us-west2",
-		State:                      "RUNNABLE",
-		SettingsVersion:            23142,
-		SelfLink:                   "/projects/crossplane-eats-the-cloud/database/test-sql",
+		IPv6Address:                  "2.19sd920.2",
+		Project:                      "crossplane-eats-the-cloud",
+		ServiceAccountEmailAddress:   "john@dontparseme.com",
+		GceZone:                      "us-west2",
+		State:                        "RUNNABLE",
+		SettingsVersion:              23142,
+		SelfLink:                     "/projects/crossplane-eats-the-cloud/database/test-sql",
+		InstanceType:                 "db-standard-1",
+		MasterInstanceName:           "myFunnyMaster",
+		MaintenanceVersion:           "MYSQL_8_0_31.R20230411.01_00",
+		AvailableMaintenanceVersions: []string{"MYSQL_8_0_31.R20230516.02_00"},
+		ScheduledMaintenance: &v1beta1.ScheduledMaintenance{
+			StartTime:            "2023-06-01T02:00:00Z",
+			ScheduleDeadlineTime: "2023-06-15T02:00:00Z",
+			CanReschedule:        true,
+		},
 	}
 	for _, f := range m {
 		f(o)
@@ -248,6 +257,13 @@ func addOutputFields(db *sqladmin.DatabaseInstance) {
 	db.ServiceAccountEmailAddress = "john@dontparseme.com"
 	db.State = "RUNNABLE"
 	db.Settings.SettingsVersion = 23142
+	db.MaintenanceVersion = "MYSQL_8_0_31.R20230411.01_00"
+	db.AvailableMaintenanceVersions = []string{"MYSQL_8_0_31.R20230516.02_00"}
+	db.ScheduledMaintenance = &sqladmin.SqlScheduledMaintenance{
+		StartTime:            "2023-06-01T02:00:00Z",
+		ScheduleDeadlineTime: "2023-06-15T02:00:00Z",
+		CanReschedule:        true,
+	}
 }
 
 func TestGenerateDatabaseInstance(t *testing.T) {
@@ -278,6 +294,16 @@ func TestGenerateDatabaseInstance(t *testing.T) {
 				db.GceZone = ""
 			})},
 		},
+		"ActiveDirectory": {
+			args: args{
+				name: name,
+				params: *params(func(p *v1beta1.CloudSQLInstanceParameters) {
+					p.Settings.ActiveDirectoryConfig = &v1beta1.ActiveDirectoryConfig{Domain: "ad.example.com"}
+				})},
+			want: want{db: db(func(db *sqladmin.DatabaseInstance) {
+				db.Settings.ActiveDirectoryConfig = &sqladmin.SqlActiveDirectoryConfig{Domain: "ad.example.com"}
+			})},
+		},
 	}
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
@@ -346,6 +372,20 @@ func TestLateInitializeSpec(t *testing.T) {
 				p.Settings.DataDiskSizeGb = gcp.Int64Ptr(30)
 			})},
 		},
+		"ActiveDirectory": {
+			args: args{
+				params: params(),
+				db: db(func(db *sqladmin.DatabaseInstance) {
+					db.Settings.ActiveDirectoryConfig = &sqladmin.SqlActiveDirectoryConfig{
+						Domain: "ad.example.com",
+						Kind:   "sql#activeDirectoryConfig",
+					}
+				}),
+			},
+			want: want{params: params(func(p *v1beta1.CloudSQLInstanceParameters) {
+				p.Settings.ActiveDirectoryConfig = &v1beta1.ActiveDirectoryConfig{Domain: "ad.example.com"}
+			})},
+		},
 		"AllFilledAlready": {
 			args: args{
 				params: params(),
@@ -498,6 +538,50 @@ func TestIsUpToDate(t *testing.T) {
 			},
 			want: want{upToDate: false, isErr: false},
 		},
+		"IsUpToDateActiveDirectory": {
+			args: args{
+				params: params(func(p *v1beta1.CloudSQLInstanceParameters) {
+					p.Settings.ActiveDirectoryConfig = &v1beta1.ActiveDirectoryConfig{Domain: "ad.example.com"}
+				}),
+				db: db(func(db *sqladmin.DatabaseInstance) {
+					db.Settings.ActiveDirectoryConfig = &sqladmin.SqlActiveDirectoryConfig{
+						Domain: "ad.example.com",
+						Kind:   "sql#activeDirectoryConfig",
+					}
+				}),
+			},
+			want: want{upToDate: true, isErr: false},
+		},
+		"NeedsActiveDirectoryUpdate": {
+			args: args{
+				params: params(func(p *v1beta1.CloudSQLInstanceParameters) {
+					p.Settings.ActiveDirectoryConfig = &v1beta1.ActiveDirectoryConfig{Domain: "ad.example.com"}
+				}),
+				db: db(),
+			},
+			want: want{upToDate: false, isErr: false},
+		},
+		"NeedsPromotion": {
+			args: args{
+				params: params(func(p *v1beta1.CloudSQLInstanceParameters) {
+					p.PromoteReplica = gcp.BoolPtr(true)
+				}),
+				db: db(),
+			},
+			want: want{upToDate: false, isErr: false},
+		},
+		"IsUpToDatePromoted": {
+			args: args{
+				params: params(func(p *v1beta1.CloudSQLInstanceParameters) {
+					p.PromoteReplica = gcp.BoolPtr(true)
+				}),
+				db: db(func(db *sqladmin.DatabaseInstance) {
+					db.InstanceType = v1beta1.InstanceTypeCloudSQL
+					db.MasterInstanceName = ""
+				}),
+			},
+			want: want{upToDate: true, isErr: false},
+		},
 	}
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
@@ -511,3 +595,192 @@ func TestIsUpToDate(t *testing.T) {
 		})
 	}
 }
+
+func TestIsFailoverPending(t *testing.T) {
+	cases := map[string]struct {
+		trigger *string
+		last    string
+		want    bool
+	}{
+		"NotRequested": {
+			want: false,
+		},
+		"Requested": {
+			trigger: gcp.StringPtr("2023-05-01"),
+			want:    true,
+		},
+		"AlreadyStarted": {
+			trigger: gcp.StringPtr("2023-05-01"),
+			last:    "2023-05-01",
+			want:    false,
+		},
+		"RequestedAgain": {
+			trigger: gcp.StringPtr("2023-05-02"),
+			last:    "2023-05-01",
+			want:    true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			p := params(func(p *v1beta1.CloudSQLInstanceParameters) { p.FailoverTrigger = tc.trigger })
+			o := observation(func(o *v1beta1.CloudSQLInstanceObservation) { o.LastFailoverTrigger = tc.last })
+			if diff := cmp.Diff(tc.want, IsFailoverPending(*p, *o)); diff != "" {
+				t.Errorf("IsFailoverPending(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestValidateDatabaseFlags(t *testing.T) {
+	supported := []*sqladmin.Flag{
+		{Name: "skip_show_database", Type: flagTypeNone},
+		{Name: "slow_query_log", Type: flagTypeBoolean},
+		{Name: "max_connections", Type: flagTypeInteger, MinValue: 10, MaxValue: 100000},
+		{Name: "innodb_page_size", Type: flagTypeInteger, AllowedIntValues: []int64{4096, 8192, 16384}},
+		{Name: "log_output", Type: flagTypeString, AllowedStringValues: []string{"FILE", "TABLE"}},
+		{Name: "init_connect", Type: flagTypeString},
+	}
+	type args struct {
+		flags []*v1beta1.DatabaseFlags
+	}
+	cases := map[string]struct {
+		args args
+		want string
+	}{
+		"Valid": {
+			args: args{flags: []*v1beta1.DatabaseFlags{
+				{Name: "skip_show_database"},
+				{Name: "slow_query_log", Value: "on"},
+				{Name: "max_connections", Value: "500"},
+				{Name: "innodb_page_size", Value: "8192"},
+				{Name: "log_output", Value: "TABLE"},
+				{Name: "init_connect", Value: "SET NAMES utf8mb4"},
+			}},
+		},
+		"Duplicate": {
+			args: args{flags: []*v1beta1.DatabaseFlags{{Name: "slow_query_log", Value: "on"}, {Name: "slow_query_log", Value: "off"}}},
+			want: `database flag "slow_query_log" is set more than once`,
+		},
+		"Unsupported": {
+			args: args{flags: []*v1beta1.DatabaseFlags{{Name: "slow-query-log", Value: "on"}}},
+			want: `database flag "slow-query-log" is not supported for database version MYSQL_8_0`,
+		},
+		"NoValue": {
+			args: args{flags: []*v1beta1.DatabaseFlags{{Name: "skip_show_database", Value: "on"}}},
+			want: `database flag "skip_show_database" does not take a value`,
+		},
+		"Boolean": {
+			args: args{flags: []*v1beta1.DatabaseFlags{{Name: "slow_query_log", Value: "true"}}},
+			want: `database flag "slow_query_log" must be either "on" or "off"`,
+		},
+		"NotInteger": {
+			args: args{flags: []*v1beta1.DatabaseFlags{{Name: "max_connections", Value: "many"}}},
+			want: `database flag "max_connections" must be an integer`,
+		},
+		"OutOfRange": {
+			args: args{flags: []*v1beta1.DatabaseFlags{{Name: "max_connections", Value: "5"}}},
+			want: `database flag "max_connections" must be between 10 and 100000`,
+		},
+		"NotAllowedInteger": {
+			args: args{flags: []*v1beta1.DatabaseFlags{{Name: "innodb_page_size", Value: "1024"}}},
+			want: `database flag "innodb_page_size" must be one of 4096, 8192, 16384`,
+		},
+		"NotAllowedString": {
+			args: args{flags: []*v1beta1.DatabaseFlags{{Name: "log_output", Value: "NONE"}}},
+			want: `database flag "log_output" must be one of FILE, TABLE`,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateDatabaseFlags("MYSQL_8_0", tc.args.flags, supported)
+			got := ""
+			if err != nil {
+				got = err.Error()
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("ValidateDatabaseFlags(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsMaintenanceVersionPending(t *testing.T) {
+	cases := map[string]struct {
+		version *string
+		current string
+		want    bool
+	}{
+		"NotRequested": {
+			current: "POSTGRES_14_7.R20230316.01_03",
+			want:    false,
+		},
+		"Current": {
+			version: gcp.StringPtr("POSTGRES_14_7.R20230316.01_03"),
+			current: "POSTGRES_14_7.R20230316.01_03",
+			want:    false,
+		},
+		"Requested": {
+			version: gcp.StringPtr("POSTGRES_14_7.R20230530.01_04"),
+			current: "POSTGRES_14_7.R20230316.01_03",
+			want:    true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			p := params(func(p *v1beta1.CloudSQLInstanceParameters) { p.MaintenanceVersion = tc.version })
+			o := observation(func(o *v1beta1.CloudSQLInstanceObservation) { o.MaintenanceVersion = tc.current })
+			if diff := cmp.Diff(tc.want, IsMaintenanceVersionPending(*p, *o)); diff != "" {
+				t.Errorf("IsMaintenanceVersionPending(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDatabaseFlags(t *testing.T) {
+	slowQueryLog := &v1beta1.DatabaseFlags{Name: "slow_query_log", Value: "on"}
+	cases := map[string]struct {
+		version *string
+		iam     *bool
+		flags   []*v1beta1.DatabaseFlags
+		want    []*v1beta1.DatabaseFlags
+	}{
+		"NotRequested": {
+			version: gcp.StringPtr("POSTGRES_15"),
+			flags:   []*v1beta1.DatabaseFlags{slowQueryLog},
+			want:    []*v1beta1.DatabaseFlags{slowQueryLog},
+		},
+		"Postgresql": {
+			version: gcp.StringPtr("POSTGRES_15"),
+			iam:     gcp.BoolPtr(true),
+			flags:   []*v1beta1.DatabaseFlags{slowQueryLog},
+			want:    []*v1beta1.DatabaseFlags{slowQueryLog, {Name: PostgresqlIAMAuthenticationFlag, Value: "on"}},
+		},
+		"MysqlByDefault": {
+			iam:  gcp.BoolPtr(true),
+			want: []*v1beta1.DatabaseFlags{{Name: MysqlIAMAuthenticationFlag, Value: "on"}},
+		},
+		"OverridesFlag": {
+			version: gcp.StringPtr("MYSQL_8_0"),
+			iam:     gcp.BoolPtr(false),
+			flags:   []*v1beta1.DatabaseFlags{{Name: MysqlIAMAuthenticationFlag, Value: "on"}, slowQueryLog},
+			want:    []*v1beta1.DatabaseFlags{{Name: MysqlIAMAuthenticationFlag, Value: "off"}, slowQueryLog},
+		},
+		"Unsupported": {
+			version: gcp.StringPtr("SQLSERVER_2019_STANDARD"),
+			iam:     gcp.BoolPtr(true),
+			flags:   []*v1beta1.DatabaseFlags{slowQueryLog},
+			want:    []*v1beta1.DatabaseFlags{slowQueryLog},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			p := v1beta1.CloudSQLInstanceParameters{
+				DatabaseVersion: tc.version,
+				Settings:        v1beta1.Settings{DatabaseFlags: tc.flags, IAMAuthentication: tc.iam},
+			}
+			if diff := cmp.Diff(tc.want, DatabaseFlags(p)); diff != "" {
+				t.Errorf("DatabaseFlags(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudsql
+
+import (
+	"testing"
+
+	fuzz "github.com/google/gofuzz"
+	sqladmin "google.golang.org/api/sqladmin/v1beta4"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/database/v1beta1"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/roundtrip"
+)
+
+func TestRoundTrip(t *testing.T) {
+	// Promoting a replica changes its instance type on purpose, so the
+	// parameters of a replica being promoted do not round trip.
+	f := roundtrip.NewFuzzer(1, func(p *v1beta1.CloudSQLInstanceParameters, c fuzz.Continue) {
+		c.FuzzNoCustom(p)
+		p.PromoteReplica = nil
+	})
+	roundtrip.Check(t, f, roundtrip.DefaultRuns, roundtrip.Funcs[v1beta1.CloudSQLInstanceParameters, sqladmin.DatabaseInstance]{
+		Generate: func(p v1beta1.CloudSQLInstanceParameters) *sqladmin.DatabaseInstance {
+			db := &sqladmin.DatabaseInstance{}
+			GenerateDatabaseInstance(name, p, db)
+			return db
+		},
+		LateInitialize: LateInitializeSpec,
+		IsUpToDate: func(p *v1beta1.CloudSQLInstanceParameters, db *sqladmin.DatabaseInstance) (bool, error) {
+			return IsUpToDate(name, p, db)
+		},
+	})
+}
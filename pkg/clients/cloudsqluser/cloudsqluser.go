@@ -0,0 +1,69 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudsqluser
+
+import (
+	"strings"
+
+	sqladmin "google.golang.org/api/sqladmin/v1beta4"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/database/v1alpha1"
+	"github.com/crossplane-contrib/provider-gcp/apis/database/v1beta1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+// serviceAccountSuffix is the domain of the emails of IAM service accounts
+// that PostgreSQL instances leave out of the names of their users.
+const serviceAccountSuffix = ".gserviceaccount.com"
+
+// Name returns the name of the user of an instance with the supplied database
+// version that the supplied CloudSQLUserParameters describe.
+func Name(version string, in v1alpha1.CloudSQLUserParameters) string {
+	email := gcp.StringValue(in.Email)
+	if in.Type == v1alpha1.CloudSQLUserTypeIAMServiceAccount && strings.HasPrefix(version, v1beta1.PostgresqlDBVersionPrefix) {
+		return strings.TrimSuffix(email, serviceAccountSuffix)
+	}
+	return email
+}
+
+// Find returns the user among the supplied users that the supplied
+// CloudSQLUserParameters describe, if any. The user may be named after its
+// email with or without the service account suffix, so that it is found
+// without knowing the database version of its instance.
+func Find(users []*sqladmin.User, in v1alpha1.CloudSQLUserParameters) *sqladmin.User {
+	email := gcp.StringValue(in.Email)
+	for _, u := range users {
+		if u == nil || u.Type != in.Type {
+			continue
+		}
+		if u.Name == email || u.Name == strings.TrimSuffix(email, serviceAccountSuffix) {
+			return u
+		}
+	}
+	return nil
+}
+
+// GenerateUser returns the user of an instance with the supplied database
+// version that the supplied CloudSQLUserParameters describe.
+func GenerateUser(version string, in v1alpha1.CloudSQLUserParameters) *sqladmin.User {
+	return &sqladmin.User{Name: Name(version, in), Type: in.Type}
+}
+
+// GenerateObservation returns the CloudSQLUserObservation of the supplied user.
+func GenerateObservation(in *sqladmin.User) v1alpha1.CloudSQLUserObservation {
+	return v1alpha1.CloudSQLUserObservation{Name: in.Name}
+}
@@ -0,0 +1,104 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudsqluser
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	sqladmin "google.golang.org/api/sqladmin/v1beta4"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/database/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	saEmail   = "app@my-project.iam.gserviceaccount.com"
+	userEmail = "alice@example.com"
+)
+
+func params(typ, email string) v1alpha1.CloudSQLUserParameters {
+	return v1alpha1.CloudSQLUserParameters{Type: typ, Email: gcp.StringPtr(email)}
+}
+
+func TestName(t *testing.T) {
+	cases := map[string]struct {
+		version string
+		in      v1alpha1.CloudSQLUserParameters
+		want    string
+	}{
+		"PostgresqlServiceAccount": {
+			version: "POSTGRES_14",
+			in:      params(v1alpha1.CloudSQLUserTypeIAMServiceAccount, saEmail),
+			want:    "app@my-project.iam",
+		},
+		"PostgresqlUser": {
+			version: "POSTGRES_14",
+			in:      params(v1alpha1.CloudSQLUserTypeIAMUser, userEmail),
+			want:    userEmail,
+		},
+		"MysqlServiceAccount": {
+			version: "MYSQL_8_0",
+			in:      params(v1alpha1.CloudSQLUserTypeIAMServiceAccount, saEmail),
+			want:    saEmail,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, Name(tc.version, tc.in)); diff != "" {
+				t.Errorf("Name(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFind(t *testing.T) {
+	pg := &sqladmin.User{Name: "app@my-project.iam", Type: v1alpha1.CloudSQLUserTypeIAMServiceAccount}
+	mysql := &sqladmin.User{Name: saEmail, Type: v1alpha1.CloudSQLUserTypeIAMServiceAccount}
+	builtIn := &sqladmin.User{Name: userEmail}
+
+	cases := map[string]struct {
+		users []*sqladmin.User
+		in    v1alpha1.CloudSQLUserParameters
+		want  *sqladmin.User
+	}{
+		"TrimmedName": {
+			users: []*sqladmin.User{builtIn, pg},
+			in:    params(v1alpha1.CloudSQLUserTypeIAMServiceAccount, saEmail),
+			want:  pg,
+		},
+		"FullName": {
+			users: []*sqladmin.User{mysql},
+			in:    params(v1alpha1.CloudSQLUserTypeIAMServiceAccount, saEmail),
+			want:  mysql,
+		},
+		"OtherType": {
+			users: []*sqladmin.User{builtIn},
+			in:    params(v1alpha1.CloudSQLUserTypeIAMUser, userEmail),
+		},
+		"NoUsers": {
+			in: params(v1alpha1.CloudSQLUserTypeIAMUser, userEmail),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, Find(tc.users, tc.in)); diff != "" {
+				t.Errorf("Find(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	container "google.golang.org/api/container/v1"
+	containerbeta "google.golang.org/api/container/v1beta1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/container/v1beta2"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	errConvertToBeta   = "cannot convert GKE cluster to its beta representation"
+	errConvertFromBeta = "cannot convert GKE cluster from its beta representation"
+)
+
+// BetaUpdateFn returns a function that updates a cluster using the GKE beta
+// API.
+type BetaUpdateFn func(context.Context, *containerbeta.Service, string) (*containerbeta.Operation, error)
+
+func noOpBetaUpdate(ctx context.Context, s *containerbeta.Service, name string) (*containerbeta.Operation, error) {
+	return nil, nil
+}
+
+// HasBetaFields returns true if the supplied parameters set any field that
+// is only supported by the GKE beta API.
+func HasBetaFields(in *v1beta2.ClusterParameters) bool {
+	return in.ClusterTelemetry != nil || in.ProtectConfig != nil || in.WorkloadCertificates != nil
+}
+
+// ToBeta converts the supplied cluster to its beta representation. The beta
+// API is a superset of the GA API, so no information is lost. Note that the
+// ForceSendFields of the supplied cluster are not carried over.
+func ToBeta(in *container.Cluster) (*containerbeta.Cluster, error) {
+	b, err := json.Marshal(in)
+	if err != nil {
+		return nil, errors.Wrap(err, errConvertToBeta)
+	}
+	out := &containerbeta.Cluster{}
+	return out, errors.Wrap(json.Unmarshal(b, out), errConvertToBeta)
+}
+
+// FromBeta converts the supplied beta cluster to its GA representation,
+// dropping all fields that are only supported by the GKE beta API.
+func FromBeta(in *containerbeta.Cluster) (*container.Cluster, error) {
+	b, err := json.Marshal(in)
+	if err != nil {
+		return nil, errors.Wrap(err, errConvertFromBeta)
+	}
+	out := &container.Cluster{}
+	return out, errors.Wrap(json.Unmarshal(b, out), errConvertFromBeta)
+}
+
+// GenerateBetaCluster sets the fields of the supplied beta cluster that are
+// only supported by the GKE beta API. All other fields are generated by
+// GenerateCluster.
+func GenerateBetaCluster(in v1beta2.ClusterParameters, cluster *containerbeta.Cluster) {
+	GenerateClusterTelemetry(in.ClusterTelemetry, cluster)
+	GenerateProtectConfig(in.ProtectConfig, cluster)
+	GenerateWorkloadCertificates(in.WorkloadCertificates, cluster)
+}
+
+// GenerateClusterTelemetry generates *containerbeta.ClusterTelemetry from *ClusterTelemetry.
+func GenerateClusterTelemetry(in *v1beta2.ClusterTelemetry, cluster *containerbeta.Cluster) {
+	if in != nil {
+		cluster.ClusterTelemetry = &containerbeta.ClusterTelemetry{
+			Type: in.Type,
+		}
+	}
+}
+
+// GenerateProtectConfig generates *containerbeta.ProtectConfig from *ProtectConfig.
+func GenerateProtectConfig(in *v1beta2.ProtectConfig, cluster *containerbeta.Cluster) {
+	if in != nil {
+		cluster.ProtectConfig = &containerbeta.ProtectConfig{
+			WorkloadVulnerabilityMode: gcp.StringValue(in.WorkloadVulnerabilityMode),
+		}
+		if in.WorkloadConfig != nil {
+			cluster.ProtectConfig.WorkloadConfig = &containerbeta.WorkloadConfig{
+				AuditMode: in.WorkloadConfig.AuditMode,
+			}
+		}
+	}
+}
+
+// GenerateWorkloadCertificates generates *containerbeta.WorkloadCertificates from *WorkloadCertificates.
+func GenerateWorkloadCertificates(in *v1beta2.WorkloadCertificates, cluster *containerbeta.Cluster) {
+	if in != nil {
+		cluster.WorkloadCertificates = &containerbeta.WorkloadCertificates{
+			EnableCertificates: in.EnableCertificates,
+			ForceSendFields:    []string{"EnableCertificates"},
+		}
+	}
+}
+
+// IsBetaUpToDate checks whether the fields of the observed cluster that are
+// only supported by the GKE beta API match the supplied parameters. Fields
+// that are not set in the parameters are ignored; they are not late
+// initialized, so that the beta API can be disabled again without the
+// parameters of existing clusters suddenly requiring it.
+func IsBetaUpToDate(in *v1beta2.ClusterParameters, observed *containerbeta.Cluster) (bool, BetaUpdateFn) {
+	desired := &containerbeta.Cluster{}
+	GenerateBetaCluster(*in, desired)
+	ignore := cmpopts.IgnoreFields(containerbeta.WorkloadCertificates{}, "ForceSendFields")
+	if in.ClusterTelemetry != nil && !cmp.Equal(desired.ClusterTelemetry, observed.ClusterTelemetry, cmpopts.EquateEmpty()) {
+		return false, newBetaUpdateFn(&containerbeta.ClusterUpdate{DesiredClusterTelemetry: desired.ClusterTelemetry})
+	}
+	if in.ProtectConfig != nil && !cmp.Equal(desired.ProtectConfig, observed.ProtectConfig, cmpopts.EquateEmpty(), equateZeroStructs()) {
+		return false, newBetaUpdateFn(&containerbeta.ClusterUpdate{DesiredProtectConfig: desired.ProtectConfig})
+	}
+	if in.WorkloadCertificates != nil && !cmp.Equal(desired.WorkloadCertificates, observed.WorkloadCertificates, cmpopts.EquateEmpty(), equateZeroStructs(), ignore) {
+		return false, newBetaUpdateFn(&containerbeta.ClusterUpdate{DesiredWorkloadCertificates: desired.WorkloadCertificates})
+	}
+	return true, noOpBetaUpdate
+}
+
+// newBetaUpdateFn returns a function that applies the supplied update to a
+// cluster using the GKE beta API.
+func newBetaUpdateFn(u *containerbeta.ClusterUpdate) BetaUpdateFn {
+	return func(ctx context.Context, s *containerbeta.Service, name string) (*containerbeta.Operation, error) {
+		return s.Projects.Locations.Clusters.Update(name, &containerbeta.UpdateClusterRequest{Update: u}).Context(ctx).Do()
+	}
+}
@@ -0,0 +1,224 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	container "google.golang.org/api/container/v1"
+	containerbeta "google.golang.org/api/container/v1beta1"
+	"google.golang.org/api/option"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/container/v1beta2"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+func TestBetaConversion(t *testing.T) {
+	in := cluster(func(c *container.Cluster) {
+		c.ReleaseChannel = &container.ReleaseChannel{Channel: "REGULAR"}
+	})
+	b, err := ToBeta(in)
+	if err != nil {
+		t.Fatalf("ToBeta(...): %s", err)
+	}
+	b.WorkloadCertificates = &containerbeta.WorkloadCertificates{EnableCertificates: true}
+	out, err := FromBeta(b)
+	if err != nil {
+		t.Fatalf("FromBeta(...): %s", err)
+	}
+	if diff := cmp.Diff(in, out); diff != "" {
+		t.Errorf("FromBeta(ToBeta(...)): -want, +got:\n%s", diff)
+	}
+}
+
+func TestHasBetaFields(t *testing.T) {
+	cases := map[string]struct {
+		params *v1beta2.ClusterParameters
+		want   bool
+	}{
+		"NoBetaFields": {
+			params: params(),
+			want:   false,
+		},
+		"ClusterTelemetry": {
+			params: params(func(p *v1beta2.ClusterParameters) {
+				p.ClusterTelemetry = &v1beta2.ClusterTelemetry{Type: "ENABLED"}
+			}),
+			want: true,
+		},
+		"ProtectConfig": {
+			params: params(func(p *v1beta2.ClusterParameters) {
+				p.ProtectConfig = &v1beta2.ProtectConfig{}
+			}),
+			want: true,
+		},
+		"WorkloadCertificates": {
+			params: params(func(p *v1beta2.ClusterParameters) {
+				p.WorkloadCertificates = &v1beta2.WorkloadCertificates{}
+			}),
+			want: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, HasBetaFields(tc.params)); diff != "" {
+				t.Errorf("HasBetaFields(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateBetaCluster(t *testing.T) {
+	p := params(func(p *v1beta2.ClusterParameters) {
+		p.ClusterTelemetry = &v1beta2.ClusterTelemetry{Type: "SYSTEM_ONLY"}
+		p.ProtectConfig = &v1beta2.ProtectConfig{
+			WorkloadConfig:            &v1beta2.WorkloadConfig{AuditMode: "BASIC"},
+			WorkloadVulnerabilityMode: gcp.StringPtr("DISABLED"),
+		}
+		p.WorkloadCertificates = &v1beta2.WorkloadCertificates{EnableCertificates: false}
+	})
+	want := &containerbeta.Cluster{
+		ClusterTelemetry: &containerbeta.ClusterTelemetry{Type: "SYSTEM_ONLY"},
+		ProtectConfig: &containerbeta.ProtectConfig{
+			WorkloadConfig:            &containerbeta.WorkloadConfig{AuditMode: "BASIC"},
+			WorkloadVulnerabilityMode: "DISABLED",
+		},
+		WorkloadCertificates: &containerbeta.WorkloadCertificates{
+			EnableCertificates: false,
+			ForceSendFields:    []string{"EnableCertificates"},
+		},
+	}
+	got := &containerbeta.Cluster{}
+	GenerateBetaCluster(*p, got)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("GenerateBetaCluster(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsBetaUpToDate(t *testing.T) {
+	type want struct {
+		upToDate bool
+		update   *containerbeta.ClusterUpdate
+	}
+	cases := map[string]struct {
+		params   *v1beta2.ClusterParameters
+		observed *containerbeta.Cluster
+		want     want
+	}{
+		"NoBetaFields": {
+			params: params(),
+			observed: &containerbeta.Cluster{
+				ClusterTelemetry: &containerbeta.ClusterTelemetry{Type: "ENABLED"},
+			},
+			want: want{upToDate: true},
+		},
+		"UpToDate": {
+			params: params(func(p *v1beta2.ClusterParameters) {
+				p.ClusterTelemetry = &v1beta2.ClusterTelemetry{Type: "ENABLED"}
+				p.ProtectConfig = &v1beta2.ProtectConfig{
+					WorkloadConfig: &v1beta2.WorkloadConfig{AuditMode: "BASIC"},
+				}
+				p.WorkloadCertificates = &v1beta2.WorkloadCertificates{EnableCertificates: false}
+			}),
+			observed: &containerbeta.Cluster{
+				ClusterTelemetry: &containerbeta.ClusterTelemetry{Type: "ENABLED"},
+				ProtectConfig: &containerbeta.ProtectConfig{
+					WorkloadConfig: &containerbeta.WorkloadConfig{AuditMode: "BASIC"},
+				},
+			},
+			want: want{upToDate: true},
+		},
+		"NeedsClusterTelemetryUpdate": {
+			params: params(func(p *v1beta2.ClusterParameters) {
+				p.ClusterTelemetry = &v1beta2.ClusterTelemetry{Type: "DISABLED"}
+			}),
+			observed: &containerbeta.Cluster{
+				ClusterTelemetry: &containerbeta.ClusterTelemetry{Type: "ENABLED"},
+			},
+			want: want{
+				upToDate: false,
+				update: &containerbeta.ClusterUpdate{
+					DesiredClusterTelemetry: &containerbeta.ClusterTelemetry{Type: "DISABLED"},
+				},
+			},
+		},
+		"NeedsProtectConfigUpdate": {
+			params: params(func(p *v1beta2.ClusterParameters) {
+				p.ProtectConfig = &v1beta2.ProtectConfig{
+					WorkloadVulnerabilityMode: gcp.StringPtr("BASIC"),
+				}
+			}),
+			observed: &containerbeta.Cluster{},
+			want: want{
+				upToDate: false,
+				update: &containerbeta.ClusterUpdate{
+					DesiredProtectConfig: &containerbeta.ProtectConfig{WorkloadVulnerabilityMode: "BASIC"},
+				},
+			},
+		},
+		"NeedsWorkloadCertificatesUpdate": {
+			params: params(func(p *v1beta2.ClusterParameters) {
+				p.WorkloadCertificates = &v1beta2.WorkloadCertificates{EnableCertificates: false}
+			}),
+			observed: &containerbeta.Cluster{
+				WorkloadCertificates: &containerbeta.WorkloadCertificates{EnableCertificates: true},
+			},
+			want: want{
+				upToDate: false,
+				update: &containerbeta.ClusterUpdate{
+					DesiredWorkloadCertificates: &containerbeta.WorkloadCertificates{
+						EnableCertificates: false,
+						ForceSendFields:    []string{"EnableCertificates"},
+					},
+				},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			u, fn := IsBetaUpToDate(tc.params, tc.observed)
+			if diff := cmp.Diff(tc.want.upToDate, u); diff != "" {
+				t.Errorf("IsBetaUpToDate(...): -want upToDate, +got upToDate:\n%s", diff)
+			}
+			if u {
+				return
+			}
+			var got containerbeta.UpdateClusterRequest
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+					t.Error(err)
+				}
+				_ = r.Body.Close()
+				_ = json.NewEncoder(w).Encode(&containerbeta.Operation{})
+			}))
+			defer server.Close()
+			s, _ := containerbeta.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			if _, err := fn(context.Background(), s, name); err != nil {
+				t.Errorf("IsBetaUpToDate(...): update: %s", err)
+			}
+			if diff := cmp.Diff(tc.want.update, got.Update, cmpopts.IgnoreFields(containerbeta.WorkloadCertificates{}, "ForceSendFields")); diff != "" {
+				t.Errorf("IsBetaUpToDate(...): -want update, +got update:\n%s", diff)
+			}
+		})
+	}
+}
@@ -20,10 +20,12 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
+	"strings"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/mitchellh/copystructure"
+	compute "google.golang.org/api/compute/v1"
 	container "google.golang.org/api/container/v1"
 	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 
@@ -31,6 +33,7 @@ import (
 
 	"github.com/crossplane-contrib/provider-gcp/apis/container/v1beta2"
 	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/immutable"
 )
 
 const (
@@ -46,6 +49,16 @@ const (
 
 	// ClusterNameFormat is the format for the fully qualified name of a cluster.
 	ClusterNameFormat = "projects/%s/locations/%s/clusters/%s"
+
+	// OperationNameFormat is the format for the fully qualified name of an
+	// operation.
+	OperationNameFormat = "projects/%s/locations/%s/operations/%s"
+
+	// AuthPluginCommand is the kubectl credential plugin that authenticates
+	// to GKE clusters with Google credentials.
+	AuthPluginCommand = "gke-gcloud-auth-plugin"
+
+	authPluginInstallHint = "Install gke-gcloud-auth-plugin for use with kubectl by following https://cloud.google.com/kubernetes-engine/docs/how-to/cluster-access-for-kubectl#install_plugin"
 )
 
 const (
@@ -84,6 +97,7 @@ func GenerateCluster(name string, in v1beta2.ClusterParameters, cluster *contain
 	GenerateAuthenticatorGroupsConfig(in.AuthenticatorGroupsConfig, cluster)
 	GenerateAutoscaling(in.Autoscaling, cluster)
 	GenerateConfidentialNodes(in.ConfidentialNodes, cluster)
+	GenerateCostManagementConfig(in.CostManagementConfig, cluster)
 	GenerateBinaryAuthorization(in.BinaryAuthorization, cluster)
 	GenerateDatabaseEncryption(in.DatabaseEncryption, cluster)
 	GenerateDefaultMaxPodsConstraint(in.DefaultMaxPodsConstraint, cluster)
@@ -92,8 +106,10 @@ func GenerateCluster(name string, in v1beta2.ClusterParameters, cluster *contain
 	GenerateMaintenancePolicy(in.MaintenancePolicy, cluster)
 	GenerateMasterAuth(in.MasterAuth, cluster)
 	GenerateMasterAuthorizedNetworksConfig(in.MasterAuthorizedNetworksConfig, cluster)
+	GenerateMeshCertificates(in.MeshCertificates, cluster)
 	GenerateNetworkConfig(in.NetworkConfig, cluster)
 	GenerateNetworkPolicy(in.NetworkPolicy, cluster)
+	GenerateNodePoolDefaults(in.NodePoolDefaults, cluster)
 	GenerateNotificationConfig(in.NotificationConfig, cluster)
 	GeneratePrivateClusterConfig(in.PrivateClusterConfig, cluster)
 	GenerateReleaseChannel(in.ReleaseChannel, cluster)
@@ -272,6 +288,17 @@ func GenerateConfidentialNodes(in *v1beta2.ConfidentialNodes, cluster *container
 	}
 }
 
+// GenerateCostManagementConfig generates *container.CostManagementConfig from *CostManagementConfig.
+func GenerateCostManagementConfig(in *v1beta2.CostManagementConfig, cluster *container.Cluster) {
+	if in != nil {
+		if cluster.CostManagementConfig == nil {
+			cluster.CostManagementConfig = &container.CostManagementConfig{}
+		}
+		cluster.CostManagementConfig.Enabled = in.Enabled
+		cluster.CostManagementConfig.ForceSendFields = []string{"Enabled"}
+	}
+}
+
 // GenerateDatabaseEncryption generates *container.DatabaseEncryption from *DatabaseEncryption.
 func GenerateDatabaseEncryption(in *v1beta2.DatabaseEncryption, cluster *container.Cluster) {
 	if in != nil {
@@ -402,6 +429,16 @@ func GenerateMasterAuthorizedNetworksConfig(in *v1beta2.MasterAuthorizedNetworks
 	}
 }
 
+// GenerateMeshCertificates generates *container.MeshCertificates from *MeshCertificates.
+func GenerateMeshCertificates(in *v1beta2.MeshCertificates, cluster *container.Cluster) {
+	if in != nil {
+		if cluster.MeshCertificates == nil {
+			cluster.MeshCertificates = &container.MeshCertificates{}
+		}
+		cluster.MeshCertificates.EnableCertificates = gcp.BoolValue(in.EnableCertificates)
+	}
+}
+
 // GenerateNetworkConfig generates *container.NetworkConfig from *NetworkConfig.
 func GenerateNetworkConfig(in *v1beta2.NetworkConfigSpec, cluster *container.Cluster) {
 	if in != nil {
@@ -439,6 +476,29 @@ func GenerateNetworkPolicy(in *v1beta2.NetworkPolicy, cluster *container.Cluster
 	}
 }
 
+// GenerateNodePoolDefaults generates *container.NodePoolDefaults from *NodePoolDefaults.
+func GenerateNodePoolDefaults(in *v1beta2.NodePoolDefaults, cluster *container.Cluster) {
+	if in != nil && in.NodeConfigDefaults != nil {
+		if cluster.NodePoolDefaults == nil {
+			cluster.NodePoolDefaults = &container.NodePoolDefaults{}
+		}
+		if cluster.NodePoolDefaults.NodeConfigDefaults == nil {
+			cluster.NodePoolDefaults.NodeConfigDefaults = &container.NodeConfigDefaults{}
+		}
+		out := cluster.NodePoolDefaults.NodeConfigDefaults
+		if in.NodeConfigDefaults.GcfsConfig != nil {
+			out.GcfsConfig = &container.GcfsConfig{Enabled: in.NodeConfigDefaults.GcfsConfig.Enabled}
+		}
+		if in.NodeConfigDefaults.LoggingConfig != nil && in.NodeConfigDefaults.LoggingConfig.VariantConfig != nil {
+			out.LoggingConfig = &container.NodePoolLoggingConfig{
+				VariantConfig: &container.LoggingVariantConfig{
+					Variant: gcp.StringValue(in.NodeConfigDefaults.LoggingConfig.VariantConfig.Variant),
+				},
+			}
+		}
+	}
+}
+
 // GenerateNotificationConfig generates *container.NotificationConfig from *NotificationConfig.
 func GenerateNotificationConfig(in *v1beta2.NotificationConfig, cluster *container.Cluster) {
 	if in != nil {
@@ -534,6 +594,7 @@ func GenerateObservation(in container.Cluster) v1beta2.ClusterObservation { // n
 		CurrentNodeVersion:   in.CurrentNodeVersion,
 		Endpoint:             in.Endpoint,
 		ExpireTime:           in.ExpireTime,
+		ID:                   in.Id,
 		Location:             in.Location,
 		NodeIpv4CidrSize:     in.NodeIpv4CidrSize,
 		SelfLink:             in.SelfLink,
@@ -544,6 +605,13 @@ func GenerateObservation(in container.Cluster) v1beta2.ClusterObservation { // n
 		Zone:                 in.Zone,
 	}
 
+	if in.NodeConfig != nil {
+		o.NodeServiceAccount = in.NodeConfig.ServiceAccount
+	}
+	if o.NodeServiceAccount == "" && in.Autoscaling != nil && in.Autoscaling.AutoprovisioningNodePoolDefaults != nil {
+		o.NodeServiceAccount = in.Autoscaling.AutoprovisioningNodePoolDefaults.ServiceAccount
+	}
+
 	if in.MaintenancePolicy != nil {
 		if in.MaintenancePolicy.Window != nil {
 			if in.MaintenancePolicy.Window.DailyMaintenanceWindow != nil {
@@ -666,10 +734,14 @@ func LateInitializeSpec(spec *v1beta2.ClusterParameters, in container.Cluster) {
 			spec.AddonsConfig = &v1beta2.AddonsConfig{}
 		}
 		if in.AddonsConfig.CloudRunConfig != nil {
-			spec.AddonsConfig.CloudRunConfig = &v1beta2.CloudRunConfig{
-				Disabled: in.AddonsConfig.CloudRunConfig.Disabled,
+			if spec.AddonsConfig.CloudRunConfig == nil {
+				spec.AddonsConfig.CloudRunConfig = &v1beta2.CloudRunConfig{
+					Disabled: in.AddonsConfig.CloudRunConfig.Disabled,
+				}
+			}
+			if lbt := in.AddonsConfig.CloudRunConfig.LoadBalancerType; lbt != cloudRunLoadBalancerTypeUnspecified {
+				spec.AddonsConfig.CloudRunConfig.LoadBalancerType = gcp.LateInitializeString(spec.AddonsConfig.CloudRunConfig.LoadBalancerType, lbt)
 			}
-			spec.AddonsConfig.CloudRunConfig.LoadBalancerType = gcp.LateInitializeString(spec.AddonsConfig.CloudRunConfig.LoadBalancerType, in.AddonsConfig.CloudRunConfig.LoadBalancerType)
 		}
 		if spec.AddonsConfig.ConfigConnectorConfig == nil && in.AddonsConfig.ConfigConnectorConfig != nil {
 			spec.AddonsConfig.ConfigConnectorConfig = &v1beta2.ConfigConnectorConfig{
@@ -708,12 +780,18 @@ func LateInitializeSpec(spec *v1beta2.ClusterParameters, in container.Cluster) {
 		}
 	}
 
-	if in.AuthenticatorGroupsConfig != nil {
+	// GKE reports an empty AuthenticatorGroupsConfig for clusters that never
+	// enabled Google Groups for RBAC. Only late initialize an enabled config,
+	// and never copy the security group into a config that was explicitly
+	// disabled, or disabling it would never converge.
+	if in.AuthenticatorGroupsConfig != nil && in.AuthenticatorGroupsConfig.Enabled {
 		if spec.AuthenticatorGroupsConfig == nil {
 			spec.AuthenticatorGroupsConfig = &v1beta2.AuthenticatorGroupsConfig{}
 		}
-		spec.AuthenticatorGroupsConfig.Enabled = gcp.LateInitializeBool(spec.AuthenticatorGroupsConfig.Enabled, in.AuthenticatorGroupsConfig.Enabled)
-		spec.AuthenticatorGroupsConfig.SecurityGroup = gcp.LateInitializeString(spec.AuthenticatorGroupsConfig.SecurityGroup, in.AuthenticatorGroupsConfig.SecurityGroup)
+		if spec.AuthenticatorGroupsConfig.Enabled == nil || *spec.AuthenticatorGroupsConfig.Enabled {
+			spec.AuthenticatorGroupsConfig.Enabled = gcp.LateInitializeBool(spec.AuthenticatorGroupsConfig.Enabled, in.AuthenticatorGroupsConfig.Enabled)
+			spec.AuthenticatorGroupsConfig.SecurityGroup = gcp.LateInitializeString(spec.AuthenticatorGroupsConfig.SecurityGroup, in.AuthenticatorGroupsConfig.SecurityGroup)
+		}
 	}
 
 	if in.Autoscaling != nil {
@@ -775,6 +853,12 @@ func LateInitializeSpec(spec *v1beta2.ClusterParameters, in container.Cluster) {
 
 	spec.ClusterIpv4Cidr = gcp.LateInitializeString(spec.ClusterIpv4Cidr, in.ClusterIpv4Cidr)
 
+	if spec.CostManagementConfig == nil && in.CostManagementConfig != nil {
+		spec.CostManagementConfig = &v1beta2.CostManagementConfig{
+			Enabled: in.CostManagementConfig.Enabled,
+		}
+	}
+
 	if in.DatabaseEncryption != nil {
 		if spec.DatabaseEncryption == nil {
 			spec.DatabaseEncryption = &v1beta2.DatabaseEncryption{}
@@ -879,6 +963,13 @@ func LateInitializeSpec(spec *v1beta2.ClusterParameters, in container.Cluster) {
 		spec.MasterAuthorizedNetworksConfig.Enabled = gcp.LateInitializeBool(spec.MasterAuthorizedNetworksConfig.Enabled, in.MasterAuthorizedNetworksConfig.Enabled)
 	}
 
+	if in.MeshCertificates != nil && in.MeshCertificates.EnableCertificates {
+		if spec.MeshCertificates == nil {
+			spec.MeshCertificates = &v1beta2.MeshCertificates{}
+		}
+		spec.MeshCertificates.EnableCertificates = gcp.LateInitializeBool(spec.MeshCertificates.EnableCertificates, in.MeshCertificates.EnableCertificates)
+	}
+
 	spec.MonitoringService = gcp.LateInitializeString(spec.MonitoringService, in.MonitoringService)
 	spec.Network = gcp.LateInitializeString(spec.Network, in.Network)
 
@@ -912,6 +1003,10 @@ func LateInitializeSpec(spec *v1beta2.ClusterParameters, in container.Cluster) {
 		spec.NetworkPolicy.Provider = gcp.LateInitializeString(spec.NetworkPolicy.Provider, in.NetworkPolicy.Provider)
 	}
 
+	if in.NodePoolDefaults != nil && in.NodePoolDefaults.NodeConfigDefaults != nil {
+		lateInitializeNodeConfigDefaults(spec, in.NodePoolDefaults.NodeConfigDefaults)
+	}
+
 	if in.PrivateClusterConfig != nil {
 		if spec.PrivateClusterConfig == nil {
 			spec.PrivateClusterConfig = &v1beta2.PrivateClusterConfigSpec{}
@@ -977,6 +1072,67 @@ func LateInitializeSpec(spec *v1beta2.ClusterParameters, in container.Cluster) {
 	}
 }
 
+// lateInitializeNodeConfigDefaults fills the unassigned node config defaults
+// of the supplied spec with the observed ones.
+func lateInitializeNodeConfigDefaults(spec *v1beta2.ClusterParameters, in *container.NodeConfigDefaults) {
+	variant := ""
+	if in.LoggingConfig != nil && in.LoggingConfig.VariantConfig != nil && in.LoggingConfig.VariantConfig.Variant != loggingVariantUnspecified {
+		variant = in.LoggingConfig.VariantConfig.Variant
+	}
+	if in.GcfsConfig == nil && variant == "" {
+		return
+	}
+	if spec.NodePoolDefaults == nil {
+		spec.NodePoolDefaults = &v1beta2.NodePoolDefaults{}
+	}
+	if spec.NodePoolDefaults.NodeConfigDefaults == nil {
+		spec.NodePoolDefaults.NodeConfigDefaults = &v1beta2.NodeConfigDefaults{}
+	}
+	out := spec.NodePoolDefaults.NodeConfigDefaults
+	if in.GcfsConfig != nil && out.GcfsConfig == nil {
+		out.GcfsConfig = &v1beta2.GcfsConfig{Enabled: in.GcfsConfig.Enabled}
+	}
+	if variant != "" {
+		if out.LoggingConfig == nil {
+			out.LoggingConfig = &v1beta2.NodePoolLoggingConfig{}
+		}
+		if out.LoggingConfig.VariantConfig == nil {
+			out.LoggingConfig.VariantConfig = &v1beta2.LoggingVariantConfig{}
+		}
+		out.LoggingConfig.VariantConfig.Variant = gcp.LateInitializeString(out.LoggingConfig.VariantConfig.Variant, variant)
+	}
+}
+
+// GetFirewallRuleFilter returns a Compute Engine list filter that matches the
+// firewall rules GKE creates for a cluster. Their names are made up of the
+// cluster name and the first eight characters of the cluster ID.
+func GetFirewallRuleFilter(in container.Cluster) string {
+	return fmt.Sprintf("name eq gke-%s-%s-.*", in.Name, in.Id[:8])
+}
+
+// ObserveFirewallRules returns the names of the firewall rules GKE created for
+// the supplied cluster. The rules live in the project that owns the
+// cluster's network, which differs from the cluster's own project when the
+// network is a shared VPC.
+func ObserveFirewallRules(ctx context.Context, s *compute.Service, projectID string, in container.Cluster) ([]string, error) {
+	if len(in.Id) < 8 {
+		return nil, nil
+	}
+	if in.NetworkConfig != nil {
+		if p := strings.Split(in.NetworkConfig.Network, "/"); len(p) > 1 && p[0] == "projects" {
+			projectID = p[1]
+		}
+	}
+	var names []string
+	err := s.Firewalls.List(projectID).Filter(GetFirewallRuleFilter(in)).Pages(ctx, func(l *compute.FirewallList) error {
+		for _, fw := range l.Items {
+			names = append(names, fw.Name)
+		}
+		return nil
+	})
+	return names, err
+}
+
 // newAddonsConfigUpdateFn returns a function that updates the AddonsConfig of a cluster.
 func newAddonsConfigUpdateFn(in *v1beta2.AddonsConfig) UpdateFn {
 	return func(ctx context.Context, s *container.Service, name string) (*container.Operation, error) {
@@ -991,6 +1147,24 @@ func newAddonsConfigUpdateFn(in *v1beta2.AddonsConfig) UpdateFn {
 	}
 }
 
+// newAuthenticatorGroupsConfigUpdateFn returns a function that updates the AuthenticatorGroupsConfig of a cluster.
+func newAuthenticatorGroupsConfigUpdateFn(in *v1beta2.AuthenticatorGroupsConfig) UpdateFn {
+	return func(ctx context.Context, s *container.Service, name string) (*container.Operation, error) {
+		out := &container.Cluster{}
+		GenerateAuthenticatorGroupsConfig(in, out)
+		if out.AuthenticatorGroupsConfig == nil {
+			out.AuthenticatorGroupsConfig = &container.AuthenticatorGroupsConfig{}
+		}
+		out.AuthenticatorGroupsConfig.ForceSendFields = []string{"Enabled"}
+		update := &container.UpdateClusterRequest{
+			Update: &container.ClusterUpdate{
+				DesiredAuthenticatorGroupsConfig: out.AuthenticatorGroupsConfig,
+			},
+		}
+		return s.Projects.Locations.Clusters.Update(name, update).Context(ctx).Do()
+	}
+}
+
 // newAutoscalingUpdateFn returns a function that updates the Autoscaling of a cluster.
 func newAutoscalingUpdateFn(in *v1beta2.ClusterAutoscaling) UpdateFn {
 	return func(ctx context.Context, s *container.Service, name string) (*container.Operation, error) {
@@ -1019,6 +1193,20 @@ func newBinaryAuthorizationUpdateFn(in *v1beta2.BinaryAuthorization) UpdateFn {
 	}
 }
 
+// newCostManagementConfigUpdateFn returns a function that updates the CostManagementConfig of a cluster.
+func newCostManagementConfigUpdateFn(in *v1beta2.CostManagementConfig) UpdateFn {
+	return func(ctx context.Context, s *container.Service, name string) (*container.Operation, error) {
+		out := &container.Cluster{}
+		GenerateCostManagementConfig(in, out)
+		update := &container.UpdateClusterRequest{
+			Update: &container.ClusterUpdate{
+				DesiredCostManagementConfig: out.CostManagementConfig,
+			},
+		}
+		return s.Projects.Locations.Clusters.Update(name, update).Context(ctx).Do()
+	}
+}
+
 // newDatabaseEncryptionUpdateFn returns a function that updates the DatabaseEncryption of a cluster.
 func newDatabaseEncryptionUpdateFn(in *v1beta2.DatabaseEncryption) UpdateFn {
 	return func(ctx context.Context, s *container.Service, name string) (*container.Operation, error) {
@@ -1095,6 +1283,21 @@ func newMasterAuthorizedNetworksConfigUpdateFn(in *v1beta2.MasterAuthorizedNetwo
 	}
 }
 
+// newMeshCertificatesUpdateFn returns a function that updates the MeshCertificates of a cluster.
+func newMeshCertificatesUpdateFn(in *v1beta2.MeshCertificates) UpdateFn {
+	return func(ctx context.Context, s *container.Service, name string) (*container.Operation, error) {
+		out := &container.Cluster{}
+		GenerateMeshCertificates(in, out)
+		out.MeshCertificates.ForceSendFields = []string{"EnableCertificates"}
+		update := &container.UpdateClusterRequest{
+			Update: &container.ClusterUpdate{
+				DesiredMeshCertificates: out.MeshCertificates,
+			},
+		}
+		return s.Projects.Locations.Clusters.Update(name, update).Context(ctx).Do()
+	}
+}
+
 // newMonitoringServiceUpdateFn returns a function that updates the MonitoringService of a cluster.
 func newMonitoringServiceUpdateFn(in *string) UpdateFn {
 	return func(ctx context.Context, s *container.Service, name string) (*container.Operation, error) {
@@ -1120,6 +1323,22 @@ func newDatapathProviderUpdateFn(in *string) UpdateFn {
 	}
 }
 
+// newDefaultSnatStatusUpdateFn returns a function that updates the
+// DefaultSnatStatus of a cluster.
+func newDefaultSnatStatusUpdateFn(in *v1beta2.DefaultSnatStatus) UpdateFn {
+	return func(ctx context.Context, s *container.Service, name string) (*container.Operation, error) {
+		update := &container.UpdateClusterRequest{
+			Update: &container.ClusterUpdate{
+				DesiredDefaultSnatStatus: &container.DefaultSnatStatus{
+					Disabled:        in.Disabled,
+					ForceSendFields: []string{"Disabled"},
+				},
+			},
+		}
+		return s.Projects.Locations.Clusters.Update(name, update).Context(ctx).Do()
+	}
+}
+
 // newDNSConfigUpdateFn returns a function that updates the DnsConfig of a cluster
 func newDNSConfigUpdateFn(in *v1beta2.NetworkConfigSpec) UpdateFn {
 	return func(ctx context.Context, s *container.Service, name string) (*container.Operation, error) {
@@ -1161,6 +1380,39 @@ func newNetworkPolicyUpdateFn(in *v1beta2.NetworkPolicy) UpdateFn {
 	}
 }
 
+// newGcfsConfigUpdateFn returns a function that updates the default GcfsConfig
+// of the node pools of a cluster.
+func newGcfsConfigUpdateFn(in *v1beta2.GcfsConfig) UpdateFn {
+	return func(ctx context.Context, s *container.Service, name string) (*container.Operation, error) {
+		update := &container.UpdateClusterRequest{
+			Update: &container.ClusterUpdate{
+				DesiredGcfsConfig: &container.GcfsConfig{
+					Enabled:         in.Enabled,
+					ForceSendFields: []string{"Enabled"},
+				},
+			},
+		}
+		return s.Projects.Locations.Clusters.Update(name, update).Context(ctx).Do()
+	}
+}
+
+// newNodePoolLoggingConfigUpdateFn returns a function that updates the
+// default logging config of the node pools of a cluster.
+func newNodePoolLoggingConfigUpdateFn(in *v1beta2.NodePoolLoggingConfig) UpdateFn {
+	return func(ctx context.Context, s *container.Service, name string) (*container.Operation, error) {
+		update := &container.UpdateClusterRequest{
+			Update: &container.ClusterUpdate{
+				DesiredNodePoolLoggingConfig: &container.NodePoolLoggingConfig{
+					VariantConfig: &container.LoggingVariantConfig{
+						Variant: gcp.StringValue(in.VariantConfig.Variant),
+					},
+				},
+			},
+		}
+		return s.Projects.Locations.Clusters.Update(name, update).Context(ctx).Do()
+	}
+}
+
 // newNotificationConfigUpdateFn returns a function that updates the NotificationConfig of a cluster.
 func newNotificationConfigUpdateFn(in *v1beta2.NotificationConfig) UpdateFn {
 	return func(ctx context.Context, s *container.Service, name string) (*container.Operation, error) {
@@ -1204,12 +1456,24 @@ func newReleaseChannelUpdateFn(in *v1beta2.ReleaseChannel) UpdateFn {
 }
 
 // newResourceLabelsUpdateFn returns a function that updates the ResourceLabels of a cluster.
+// GKE rejects label updates that do not carry the current label fingerprint,
+// so it is read from the cluster immediately before each attempt.
 func newResourceLabelsUpdateFn(in map[string]string) UpdateFn {
 	return func(ctx context.Context, s *container.Service, name string) (*container.Operation, error) {
-		update := &container.SetLabelsRequest{
-			ResourceLabels: in,
-		}
-		return s.Projects.Locations.Clusters.SetResourceLabels(name, update).Context(ctx).Do()
+		var op *container.Operation
+		err := gcp.RetryOnConflict(func() error {
+			cluster, err := s.Projects.Locations.Clusters.Get(name).Context(ctx).Do()
+			if err != nil {
+				return err
+			}
+			update := &container.SetLabelsRequest{
+				ResourceLabels:   in,
+				LabelFingerprint: cluster.LabelFingerprint,
+			}
+			op, err = s.Projects.Locations.Clusters.SetResourceLabels(name, update).Context(ctx).Do()
+			return err
+		})
+		return op, err
 	}
 }
 
@@ -1299,7 +1563,17 @@ func IsUpToDate(name string, in *v1beta2.ClusterParameters, observed *container.
 	if checkForBootstrapNodePool(observed) {
 		return false, deleteBootstrapNodePoolFn(), nil
 	}
-	if !cmp.Equal(desired.AddonsConfig, observed.AddonsConfig, cmpopts.EquateEmpty(),
+	generated, err = copystructure.Copy(observed)
+	if err != nil {
+		return true, noOpUpdate, errors.Wrap(err, errCheckUpToDate)
+	}
+	observed, ok = generated.(*container.Cluster)
+	if !ok {
+		return true, noOpUpdate, errors.New(errCheckUpToDate)
+	}
+	normalizeServerDefaults(desired, observed)
+	equateDefaults := cmp.Options{cmpopts.EquateEmpty(), equateZeroStructs()}
+	if !cmp.Equal(desired.AddonsConfig, observed.AddonsConfig, equateDefaults,
 		cmpopts.IgnoreFields(container.AddonsConfig{}, "CloudRunConfig.ForceSendFields"),
 		cmpopts.IgnoreFields(container.AddonsConfig{}, "ConfigConnectorConfig.ForceSendFields"),
 		cmpopts.IgnoreFields(container.AddonsConfig{}, "DnsCacheConfig.ForceSendFields"),
@@ -1310,75 +1584,150 @@ func IsUpToDate(name string, in *v1beta2.ClusterParameters, observed *container.
 		cmpopts.IgnoreFields(container.AddonsConfig{}, "NetworkPolicyConfig.ForceSendFields")) {
 		return false, newAddonsConfigUpdateFn(in.AddonsConfig), nil
 	}
-	if !cmp.Equal(desired.Autoscaling, observed.Autoscaling, cmpopts.EquateEmpty()) {
+	if !isAuthenticatorGroupsConfigUpToDate(desired.AuthenticatorGroupsConfig, observed.AuthenticatorGroupsConfig) {
+		return false, newAuthenticatorGroupsConfigUpdateFn(in.AuthenticatorGroupsConfig), nil
+	}
+	if !cmp.Equal(desired.Autoscaling, observed.Autoscaling, equateDefaults) {
 		return false, newAutoscalingUpdateFn(in.Autoscaling), nil
 	}
-	if !cmp.Equal(desired.BinaryAuthorization, observed.BinaryAuthorization, cmpopts.EquateEmpty()) {
+	if !cmp.Equal(desired.BinaryAuthorization, observed.BinaryAuthorization, equateDefaults) {
 		return false, newBinaryAuthorizationUpdateFn(in.BinaryAuthorization), nil
 	}
-	if !cmp.Equal(desired.DatabaseEncryption, observed.DatabaseEncryption, cmpopts.EquateEmpty()) {
+	// GKE omits the cost management config while it is disabled.
+	if in.CostManagementConfig != nil && in.CostManagementConfig.Enabled != (observed.CostManagementConfig != nil && observed.CostManagementConfig.Enabled) {
+		return false, newCostManagementConfigUpdateFn(in.CostManagementConfig), nil
+	}
+	if !cmp.Equal(desired.DatabaseEncryption, observed.DatabaseEncryption, equateDefaults) {
 		return false, newDatabaseEncryptionUpdateFn(in.DatabaseEncryption), nil
 	}
-	if !cmp.Equal(desired.LegacyAbac, observed.LegacyAbac, cmpopts.EquateEmpty()) {
+	if !cmp.Equal(desired.LegacyAbac, observed.LegacyAbac, equateDefaults) {
 		return false, newLegacyAbacUpdateFn(in.LegacyAbac), nil
 	}
-	if !cmp.Equal(desired.Locations, observed.Locations, cmpopts.EquateEmpty()) {
+	if !cmp.Equal(desired.Locations, observed.Locations, equateDefaults) {
 		return false, newLocationsUpdateFn(in.Locations), nil
 	}
-	if !cmp.Equal(desired.LoggingService, observed.LoggingService, cmpopts.EquateEmpty()) {
+	if !cmp.Equal(desired.LoggingService, observed.LoggingService, equateDefaults) {
 		return false, newLoggingServiceUpdateFn(in.LoggingService), nil
 	}
-	if !cmp.Equal(desired.MaintenancePolicy, observed.MaintenancePolicy, cmpopts.EquateEmpty()) {
+	if !cmp.Equal(desired.MaintenancePolicy, observed.MaintenancePolicy, equateDefaults) {
 		return false, newMaintenancePolicyUpdateFn(in.MaintenancePolicy), nil
 	}
-	if !cmp.Equal(desired.MasterAuthorizedNetworksConfig, observed.MasterAuthorizedNetworksConfig, cmpopts.EquateEmpty()) {
+	if !cmp.Equal(desired.MasterAuthorizedNetworksConfig, observed.MasterAuthorizedNetworksConfig, equateDefaults) {
 		return false, newMasterAuthorizedNetworksConfigUpdateFn(in.MasterAuthorizedNetworksConfig), nil
 	}
-	if !cmp.Equal(desired.MonitoringService, observed.MonitoringService, cmpopts.EquateEmpty()) {
+	if in.MeshCertificates != nil && gcp.BoolValue(in.MeshCertificates.EnableCertificates) != (observed.MeshCertificates != nil && observed.MeshCertificates.EnableCertificates) {
+		return false, newMeshCertificatesUpdateFn(in.MeshCertificates), nil
+	}
+	if !cmp.Equal(desired.MonitoringService, observed.MonitoringService, equateDefaults) {
 		return false, newMonitoringServiceUpdateFn(in.MonitoringService), nil
 	}
 	if desired.NetworkConfig != nil {
 		if observed.NetworkConfig == nil {
 			observed.NetworkConfig = &container.NetworkConfig{}
 		}
-		if !cmp.Equal(desired.NetworkConfig.EnableIntraNodeVisibility, observed.NetworkConfig.EnableIntraNodeVisibility, cmpopts.EquateEmpty()) {
+		if !cmp.Equal(desired.NetworkConfig.EnableIntraNodeVisibility, observed.NetworkConfig.EnableIntraNodeVisibility, equateDefaults) {
 			return false, newIntraNodeVisibilityConfigUpdateFn(in.NetworkConfig.EnableIntraNodeVisibility), nil
 		}
-		if !cmp.Equal(desired.NetworkConfig.DatapathProvider, observed.NetworkConfig.DatapathProvider, cmpopts.EquateEmpty()) {
+		if !cmp.Equal(desired.NetworkConfig.DatapathProvider, observed.NetworkConfig.DatapathProvider, equateDefaults) {
 			return false, newDatapathProviderUpdateFn(in.NetworkConfig.DatapathProvider), nil
 		}
-		if !cmp.Equal(desired.NetworkConfig.DnsConfig, observed.NetworkConfig.DnsConfig, cmpopts.EquateEmpty()) {
+		if !cmp.Equal(desired.NetworkConfig.DnsConfig, observed.NetworkConfig.DnsConfig, equateDefaults) {
 			return false, newDNSConfigUpdateFn(in.NetworkConfig), nil
 		}
+		// GKE omits defaultSnatStatus when default sNAT is enabled.
+		if in.NetworkConfig != nil && in.NetworkConfig.DefaultSnatStatus != nil && in.NetworkConfig.DefaultSnatStatus.Disabled != (observed.NetworkConfig.DefaultSnatStatus != nil && observed.NetworkConfig.DefaultSnatStatus.Disabled) {
+			return false, newDefaultSnatStatusUpdateFn(in.NetworkConfig.DefaultSnatStatus), nil
+		}
 	}
 
-	if !cmp.Equal(desired.NetworkPolicy, observed.NetworkPolicy, cmpopts.EquateEmpty()) {
+	if !cmp.Equal(desired.NetworkPolicy, observed.NetworkPolicy, equateDefaults) {
 		return false, newNetworkPolicyUpdateFn(in.NetworkPolicy), nil
 	}
-	if !cmp.Equal(desired.NotificationConfig, observed.NotificationConfig, cmpopts.EquateEmpty()) {
+	if in.NodePoolDefaults != nil && in.NodePoolDefaults.NodeConfigDefaults != nil {
+		if ok, fn := isNodeConfigDefaultsUpToDate(in.NodePoolDefaults.NodeConfigDefaults, observed.NodePoolDefaults); !ok {
+			return false, fn, nil
+		}
+	}
+	if !cmp.Equal(desired.NotificationConfig, observed.NotificationConfig, equateDefaults) {
 		return false, newNotificationConfigUpdateFn(in.NotificationConfig), nil
 	}
-	if !cmp.Equal(desired.PrivateClusterConfig, observed.PrivateClusterConfig, cmpopts.EquateEmpty()) {
+	if !cmp.Equal(desired.PrivateClusterConfig, observed.PrivateClusterConfig, equateDefaults) {
 		return false, newPrivateClusterConfigUpdateFn(in.PrivateClusterConfig), nil
 	}
-	if !cmp.Equal(desired.ReleaseChannel, observed.ReleaseChannel, cmpopts.EquateEmpty()) {
+	if !cmp.Equal(desired.ReleaseChannel, observed.ReleaseChannel, equateDefaults) {
 		return false, newReleaseChannelUpdateFn(in.ReleaseChannel), nil
 	}
-	if !cmp.Equal(desired.ResourceLabels, observed.ResourceLabels, cmpopts.EquateEmpty()) {
+	if !cmp.Equal(desired.ResourceLabels, observed.ResourceLabels, equateDefaults) {
 		return false, newResourceLabelsUpdateFn(in.ResourceLabels), nil
 	}
-	if !cmp.Equal(desired.ResourceUsageExportConfig, observed.ResourceUsageExportConfig, cmpopts.EquateEmpty()) {
+	if !cmp.Equal(desired.ResourceUsageExportConfig, observed.ResourceUsageExportConfig, equateDefaults) {
 		return false, newResourceUsageExportConfigUpdateFn(in.ResourceUsageExportConfig), nil
 	}
-	if !cmp.Equal(desired.VerticalPodAutoscaling, observed.VerticalPodAutoscaling, cmpopts.EquateEmpty()) {
+	if !cmp.Equal(desired.VerticalPodAutoscaling, observed.VerticalPodAutoscaling, equateDefaults) {
 		return false, newVerticalPodAutoscalingUpdateFn(in.VerticalPodAutoscaling), nil
 	}
-	if !cmp.Equal(desired.WorkloadIdentityConfig, observed.WorkloadIdentityConfig, cmpopts.EquateEmpty()) {
+	if !cmp.Equal(desired.WorkloadIdentityConfig, observed.WorkloadIdentityConfig, equateDefaults) {
 		return false, newWorkloadIdentityConfigUpdateFn(in.WorkloadIdentityConfig), nil
 	}
 	return true, noOpUpdate, nil
 }
 
+// isNodeConfigDefaultsUpToDate compares the desired and observed node config
+// defaults, and returns a function that updates the first one that differs.
+// GKE omits GCFS config while it is disabled, and may report the default
+// logging variant as unspecified.
+func isNodeConfigDefaultsUpToDate(in *v1beta2.NodeConfigDefaults, observed *container.NodePoolDefaults) (bool, UpdateFn) {
+	o := &container.NodeConfigDefaults{}
+	if observed != nil && observed.NodeConfigDefaults != nil {
+		o = observed.NodeConfigDefaults
+	}
+	if in.GcfsConfig != nil && in.GcfsConfig.Enabled != (o.GcfsConfig != nil && o.GcfsConfig.Enabled) {
+		return false, newGcfsConfigUpdateFn(in.GcfsConfig)
+	}
+	if in.LoggingConfig != nil && in.LoggingConfig.VariantConfig != nil && in.LoggingConfig.VariantConfig.Variant != nil {
+		variant := ""
+		if o.LoggingConfig != nil && o.LoggingConfig.VariantConfig != nil {
+			variant = o.LoggingConfig.VariantConfig.Variant
+		}
+		if normalizeLoggingVariant(*in.LoggingConfig.VariantConfig.Variant) != normalizeLoggingVariant(variant) {
+			return false, newNodePoolLoggingConfigUpdateFn(in.LoggingConfig)
+		}
+	}
+	return true, noOpUpdate
+}
+
+// isAuthenticatorGroupsConfigUpToDate compares the desired and observed
+// AuthenticatorGroupsConfig. The security group is irrelevant once the config
+// is disabled, and GKE may report a disabled config as absent.
+func isAuthenticatorGroupsConfigUpToDate(desired, observed *container.AuthenticatorGroupsConfig) bool {
+	if desired == nil {
+		return true
+	}
+	if observed == nil {
+		observed = &container.AuthenticatorGroupsConfig{}
+	}
+	if !desired.Enabled {
+		return !observed.Enabled
+	}
+	return observed.Enabled && desired.SecurityGroup == observed.SecurityGroup
+}
+
+// ImmutableFieldsChanged returns the paths of the fields of the supplied
+// ClusterParameters that can only be set when a cluster is created and that
+// differ from the observed cluster. GKE ignores changes to them on update.
+func ImmutableFieldsChanged(in *v1beta2.ClusterParameters, observed *container.Cluster) []string {
+	var confidential *bool
+	if in.ConfidentialNodes != nil {
+		confidential = &in.ConfidentialNodes.Enabled
+	}
+	return immutable.Changed(
+		immutable.Field{Path: "clusterIpv4Cidr", Desired: in.ClusterIpv4Cidr, Observed: observed.ClusterIpv4Cidr},
+		immutable.Field{Path: "confidentialNodes.enabled", Desired: confidential, Observed: observed.ConfidentialNodes != nil && observed.ConfidentialNodes.Enabled},
+		immutable.Field{Path: "enableKubernetesAlpha", Desired: in.EnableKubernetesAlpha, Observed: observed.EnableKubernetesAlpha},
+		immutable.Field{Path: "enableTpu", Desired: in.EnableTpu, Observed: observed.EnableTpu},
+	)
+}
+
 // GetFullyQualifiedParent builds the fully qualified name of the cluster
 // parent.
 func GetFullyQualifiedParent(project string, p v1beta2.ClusterParameters) string {
@@ -1390,12 +1739,38 @@ func GetFullyQualifiedName(project string, p v1beta2.ClusterParameters, name str
 	return fmt.Sprintf(ClusterNameFormat, project, p.Location, name)
 }
 
+// GetFullyQualifiedOperation builds the fully qualified name of an operation
+// on the cluster.
+func GetFullyQualifiedOperation(project string, p v1beta2.ClusterParameters, name string) string {
+	return fmt.Sprintf(OperationNameFormat, project, p.Location, name)
+}
+
 // GetFullyQualifiedBNP build the fully qualified name of the bootstrap node
 // pool.
 func GetFullyQualifiedBNP(clusterName string) string {
 	return fmt.Sprintf(BNPNameFormat, clusterName, BootstrapNodePoolName)
 }
 
+// GetEndpoint returns the endpoint of the supplied cluster of the supplied
+// type. The cluster's default endpoint is returned if no type is supplied, or
+// if the cluster has no endpoint of that type.
+func GetEndpoint(cluster *container.Cluster, endpointType *string) string {
+	if endpointType == nil || cluster.PrivateClusterConfig == nil {
+		return cluster.Endpoint
+	}
+	var e string
+	switch *endpointType {
+	case v1beta2.EndpointTypePrivate:
+		e = cluster.PrivateClusterConfig.PrivateEndpoint
+	case v1beta2.EndpointTypePublic:
+		e = cluster.PrivateClusterConfig.PublicEndpoint
+	}
+	if e == "" {
+		return cluster.Endpoint
+	}
+	return e
+}
+
 // GenerateClientConfig generates a clientcmdapi.Config that can be used by any
 // kubernetes client.
 func GenerateClientConfig(cluster *container.Cluster) (clientcmdapi.Config, error) {
@@ -1443,3 +1818,34 @@ func GenerateClientConfig(cluster *container.Cluster) (clientcmdapi.Config, erro
 
 	return c, nil
 }
+
+// IssuesClientCertificate returns whether the supplied cluster parameters
+// request a legacy client certificate.
+func IssuesClientCertificate(in *v1beta2.ClusterParameters) bool {
+	return in.MasterAuth != nil && in.MasterAuth.ClientCertificateConfig != nil && in.MasterAuth.ClientCertificateConfig.IssueClientCertificate
+}
+
+// UseExecAuthentication returns whether the kubeconfig of the supplied
+// cluster should authenticate with the GKE auth plugin rather than with the
+// client certificate the cluster issued. The plugin is preferred because the
+// client certificate is not bound to any RBAC role.
+func UseExecAuthentication(cluster *container.Cluster, c *v1beta2.ClusterConnection) bool {
+	if cluster.MasterAuth == nil || cluster.MasterAuth.ClientCertificate == "" {
+		return false
+	}
+	return c == nil || c.KubeconfigAuthentication == nil || *c.KubeconfigAuthentication == v1beta2.KubeconfigAuthenticationExec
+}
+
+// ExecAuthInfo returns kubeconfig credentials that run the GKE auth plugin,
+// like those written by gcloud container clusters get-credentials.
+func ExecAuthInfo() *clientcmdapi.AuthInfo {
+	return &clientcmdapi.AuthInfo{
+		Exec: &clientcmdapi.ExecConfig{
+			APIVersion:         "client.authentication.k8s.io/v1beta1",
+			Command:            AuthPluginCommand,
+			InstallHint:        authPluginInstallHint,
+			ProvideClusterInfo: true,
+			InteractiveMode:    clientcmdapi.IfAvailableExecInteractiveMode,
+		},
+	}
+}
@@ -17,12 +17,18 @@ limitations under the License.
 package cluster
 
 import (
+	"context"
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	compute "google.golang.org/api/compute/v1"
 	container "google.golang.org/api/container/v1"
+	"google.golang.org/api/option"
 	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 
 	"github.com/crossplane/crossplane-runtime/pkg/errors"
@@ -102,6 +108,7 @@ func observation(m ...func(*v1beta2.ClusterObservation)) *v1beta2.ClusterObserva
 		CurrentNodeVersion:   "1.16",
 		Endpoint:             "12.12.12.12",
 		ExpireTime:           "13:13",
+		ID:                   "f3b2a1c0d9e8",
 		Location:             "us-central1",
 		NodeIpv4CidrSize:     8,
 		SelfLink:             "/link/to/myself",
@@ -149,6 +156,7 @@ func addOutputFields(c *container.Cluster) {
 	c.CurrentNodeVersion = "1.16"
 	c.Endpoint = "12.12.12.12"
 	c.ExpireTime = "13:13"
+	c.Id = "f3b2a1c0d9e8"
 	c.Location = "us-central1"
 	c.NodeIpv4CidrSize = 8
 	c.SelfLink = "/link/to/myself"
@@ -230,6 +238,20 @@ func TestGenerateObservation(t *testing.T) {
 				p.NodePools = []*v1beta2.NodePoolClusterStatus{np}
 			}),
 		},
+		"AutoprovisionedNodeServiceAccount": {
+			args: args{
+				cluster(addOutputFields, func(c *container.Cluster) {
+					c.Autoscaling = &container.ClusterAutoscaling{
+						AutoprovisioningNodePoolDefaults: &container.AutoprovisioningNodePoolDefaults{
+							ServiceAccount: "nodes@cool-project.iam.gserviceaccount.com",
+						},
+					}
+				}),
+			},
+			want: observation(func(p *v1beta2.ClusterObservation) {
+				p.NodeServiceAccount = "nodes@cool-project.iam.gserviceaccount.com"
+			}),
+		},
 	}
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -1182,6 +1204,59 @@ func TestGenerateNotificationConfig(t *testing.T) {
 	}
 }
 
+func TestGenerateNodePoolDefaults(t *testing.T) {
+	type args struct {
+		cluster *container.Cluster
+		params  *v1beta2.ClusterParameters
+	}
+
+	tests := map[string]struct {
+		args args
+		want *container.Cluster
+	}{
+		"Successful": {
+			args: args{
+				cluster: cluster(),
+				params: params(func(p *v1beta2.ClusterParameters) {
+					p.NodePoolDefaults = &v1beta2.NodePoolDefaults{
+						NodeConfigDefaults: &v1beta2.NodeConfigDefaults{
+							GcfsConfig: &v1beta2.GcfsConfig{Enabled: true},
+							LoggingConfig: &v1beta2.NodePoolLoggingConfig{
+								VariantConfig: &v1beta2.LoggingVariantConfig{Variant: gcp.StringPtr("MAX_THROUGHPUT")},
+							},
+						},
+					}
+				}),
+			},
+			want: cluster(func(c *container.Cluster) {
+				c.NodePoolDefaults = &container.NodePoolDefaults{
+					NodeConfigDefaults: &container.NodeConfigDefaults{
+						GcfsConfig: &container.GcfsConfig{Enabled: true},
+						LoggingConfig: &container.NodePoolLoggingConfig{
+							VariantConfig: &container.LoggingVariantConfig{Variant: "MAX_THROUGHPUT"},
+						},
+					},
+				}
+			}),
+		},
+		"SuccessfulNil": {
+			args: args{
+				cluster: cluster(),
+				params:  params(),
+			},
+			want: cluster(),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			GenerateNodePoolDefaults(tc.args.params.NodePoolDefaults, tc.args.cluster)
+			if diff := cmp.Diff(tc.want.NodePoolDefaults, tc.args.cluster.NodePoolDefaults); diff != "" {
+				t.Errorf("GenerateNodePoolDefaults(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestGeneratePrivateClusterConfig(t *testing.T) {
 	type args struct {
 		cluster *container.Cluster
@@ -1542,6 +1617,115 @@ func TestLateInitializeSpec(t *testing.T) {
 				}),
 			},
 		},
+		"AuthenticatorGroupsConfigEnabled": {
+			args: args{
+				cluster: cluster(func(c *container.Cluster) {
+					c.AuthenticatorGroupsConfig = &container.AuthenticatorGroupsConfig{
+						Enabled:       true,
+						SecurityGroup: "gke-security-groups@example.com",
+					}
+				}),
+				params: params(),
+			},
+			want: want{
+				params: params(func(p *v1beta2.ClusterParameters) {
+					p.AuthenticatorGroupsConfig = &v1beta2.AuthenticatorGroupsConfig{
+						Enabled:       gcp.BoolPtr(true),
+						SecurityGroup: gcp.StringPtr("gke-security-groups@example.com"),
+					}
+				}),
+			},
+		},
+		"AuthenticatorGroupsConfigNeverEnabled": {
+			args: args{
+				cluster: cluster(func(c *container.Cluster) {
+					c.AuthenticatorGroupsConfig = &container.AuthenticatorGroupsConfig{}
+				}),
+				params: params(),
+			},
+			want: want{
+				params: params(),
+			},
+		},
+		"AuthenticatorGroupsConfigBeingDisabled": {
+			args: args{
+				cluster: cluster(func(c *container.Cluster) {
+					c.AuthenticatorGroupsConfig = &container.AuthenticatorGroupsConfig{
+						Enabled:       true,
+						SecurityGroup: "gke-security-groups@example.com",
+					}
+				}),
+				params: params(func(p *v1beta2.ClusterParameters) {
+					p.AuthenticatorGroupsConfig = &v1beta2.AuthenticatorGroupsConfig{
+						Enabled: gcp.BoolPtr(false),
+					}
+				}),
+			},
+			want: want{
+				params: params(func(p *v1beta2.ClusterParameters) {
+					p.AuthenticatorGroupsConfig = &v1beta2.AuthenticatorGroupsConfig{
+						Enabled: gcp.BoolPtr(false),
+					}
+				}),
+			},
+		},
+		"MeshCertificatesEnabled": {
+			args: args{
+				cluster: cluster(func(c *container.Cluster) {
+					c.MeshCertificates = &container.MeshCertificates{EnableCertificates: true}
+				}),
+				params: params(),
+			},
+			want: want{
+				params: params(func(p *v1beta2.ClusterParameters) {
+					p.MeshCertificates = &v1beta2.MeshCertificates{EnableCertificates: gcp.BoolPtr(true)}
+				}),
+			},
+		},
+		"NodeConfigDefaults": {
+			args: args{
+				cluster: cluster(func(c *container.Cluster) {
+					c.NodePoolDefaults = &container.NodePoolDefaults{
+						NodeConfigDefaults: &container.NodeConfigDefaults{
+							GcfsConfig: &container.GcfsConfig{Enabled: true},
+							LoggingConfig: &container.NodePoolLoggingConfig{
+								VariantConfig: &container.LoggingVariantConfig{Variant: "MAX_THROUGHPUT"},
+							},
+						},
+					}
+				}),
+				params: params(),
+			},
+			want: want{
+				params: params(func(p *v1beta2.ClusterParameters) {
+					p.NodePoolDefaults = &v1beta2.NodePoolDefaults{
+						NodeConfigDefaults: &v1beta2.NodeConfigDefaults{
+							GcfsConfig: &v1beta2.GcfsConfig{Enabled: true},
+							LoggingConfig: &v1beta2.NodePoolLoggingConfig{
+								VariantConfig: &v1beta2.LoggingVariantConfig{Variant: gcp.StringPtr("MAX_THROUGHPUT")},
+							},
+						},
+					}
+				}),
+			},
+		},
+		"NodeConfigDefaultsUnspecifiedVariant": {
+			args: args{
+				cluster: cluster(func(c *container.Cluster) {
+					c.NodePoolDefaults = &container.NodePoolDefaults{
+						NodeConfigDefaults: &container.NodeConfigDefaults{
+							LoggingConfig: &container.NodePoolLoggingConfig{
+								VariantConfig: &container.LoggingVariantConfig{Variant: "VARIANT_UNSPECIFIED"},
+							},
+						},
+					}
+				}),
+				params: params(),
+			},
+			want: want{
+				params: params(),
+			},
+		},
 		"NoneFilled": {
 			args: args{
 				cluster: cluster(),
@@ -1647,63 +1831,74 @@ func TestIsUpToDate(t *testing.T) {
 				isErr:    false,
 			},
 		},
-		"NoUpdateNotBootstrapNodePool": {
+		"NeedsUpdateAuthenticatorGroupsConfig": {
 			args: args{
 				name: name,
 				cluster: cluster(func(c *container.Cluster) {
-					sc := &container.StatusCondition{
-						Code:    "cool-code",
-						Message: "cool-message",
-					}
-					np := &container.NodePool{
-						Conditions: []*container.StatusCondition{sc},
-						Name:       "cool-node-pool",
+					c.AuthenticatorGroupsConfig = &container.AuthenticatorGroupsConfig{}
+				}),
+				params: params(func(p *v1beta2.ClusterParameters) {
+					p.AuthenticatorGroupsConfig = &v1beta2.AuthenticatorGroupsConfig{
+						Enabled:       gcp.BoolPtr(true),
+						SecurityGroup: gcp.StringPtr("gke-security-groups@example.com"),
 					}
-					c.NodePools = []*container.NodePool{np}
 				}),
-				params: params(),
 			},
 			want: want{
-				upToDate: true,
+				upToDate: false,
 				isErr:    false,
 			},
 		},
-		"NeedsUpdateBootstrapNodePool": {
+		"UpToDateAuthenticatorGroupsConfigDisabled": {
 			args: args{
 				name: name,
 				cluster: cluster(func(c *container.Cluster) {
-					sc := &container.StatusCondition{
-						Code:    "cool-code",
-						Message: "cool-message",
-					}
-					np := &container.NodePool{
-						Conditions: []*container.StatusCondition{sc},
-						Name:       BootstrapNodePoolName,
+					c.AuthenticatorGroupsConfig = nil
+				}),
+				params: params(func(p *v1beta2.ClusterParameters) {
+					p.AuthenticatorGroupsConfig = &v1beta2.AuthenticatorGroupsConfig{
+						Enabled:       gcp.BoolPtr(false),
+						SecurityGroup: gcp.StringPtr("gke-security-groups@example.com"),
 					}
-					c.NodePools = []*container.NodePool{np}
 				}),
-				params: params(),
+			},
+			want: want{
+				upToDate: true,
+				isErr:    false,
+			},
+		},
+		"NeedsUpdateMeshCertificates": {
+			args: args{
+				name:    name,
+				cluster: cluster(),
+				params: params(func(p *v1beta2.ClusterParameters) {
+					p.MeshCertificates = &v1beta2.MeshCertificates{EnableCertificates: gcp.BoolPtr(true)}
+				}),
 			},
 			want: want{
 				upToDate: false,
 				isErr:    false,
 			},
 		},
-		"NeedsUpdateDnsConfig": {
+		"UpToDateMeshCertificatesDisabled": {
 			args: args{
 				name:    name,
 				cluster: cluster(),
 				params: params(func(p *v1beta2.ClusterParameters) {
-					var clusterDNS = "CLOUD_DNS"
-					var clusterDNSDomain = "crossplane.io"
-					var clusterDNSScope = "VPC_SCOPE"
-					p.NetworkConfig = &v1beta2.NetworkConfigSpec{
-						DnsConfig: &v1beta2.DnsConfig{
-							ClusterDns:       &clusterDNS,
-							ClusterDnsDomain: &clusterDNSDomain,
-							ClusterDnsScope:  &clusterDNSScope,
-						},
-					}
+					p.MeshCertificates = &v1beta2.MeshCertificates{EnableCertificates: gcp.BoolPtr(false)}
+				}),
+			},
+			want: want{
+				upToDate: true,
+				isErr:    false,
+			},
+		},
+		"NeedsUpdateCostManagementConfig": {
+			args: args{
+				name:    name,
+				cluster: cluster(),
+				params: params(func(p *v1beta2.ClusterParameters) {
+					p.CostManagementConfig = &v1beta2.CostManagementConfig{Enabled: true}
 				}),
 			},
 			want: want{
@@ -1711,64 +1906,532 @@ func TestIsUpToDate(t *testing.T) {
 				isErr:    false,
 			},
 		},
-	}
-	for name, tc := range tests {
-		t.Run(name, func(t *testing.T) {
-			r, _, err := IsUpToDate(tc.args.name, tc.args.params, tc.args.cluster)
-			if err != nil && !tc.want.isErr {
-				t.Error("IsUpToDate(...) unexpected error")
-			}
-			if diff := cmp.Diff(tc.want.upToDate, r); diff != "" {
-				t.Errorf("IsUpToDate(...): -want upToDate, +got upToDate:\n%s", diff)
-			}
-		})
-	}
-}
-
-func TestGetFullyQualifiedParent(t *testing.T) {
-	type args struct {
-		project string
-		params  v1beta2.ClusterParameters
-	}
-	tests := map[string]struct {
-		args args
-		want string
-	}{
-		"Successful": {
+		"UpToDateCostManagementConfigDisabled": {
 			args: args{
-				project: project,
-				params:  *params(),
+				name:    name,
+				cluster: cluster(),
+				params: params(func(p *v1beta2.ClusterParameters) {
+					p.CostManagementConfig = &v1beta2.CostManagementConfig{Enabled: false}
+				}),
+			},
+			want: want{
+				upToDate: true,
+				isErr:    false,
 			},
-			want: fmt.Sprintf(ParentFormat, project, location),
 		},
-	}
-	for name, tc := range tests {
-		t.Run(name, func(t *testing.T) {
-			s := GetFullyQualifiedParent(tc.args.project, tc.args.params)
-			if diff := cmp.Diff(tc.want, s); diff != "" {
-				t.Errorf("GetFullyQualifiedParent(...): -want, +got:\n%s", diff)
-			}
-		})
-	}
-}
-
-func TestGetFullyQualifiedName(t *testing.T) {
-	type args struct {
-		project string
-		params  v1beta2.ClusterParameters
-		name    string
-	}
-	tests := map[string]struct {
-		args args
-		want string
-	}{
-		"Successful": {
+		"NeedsUpdateGcfsConfig": {
 			args: args{
-				project: project,
-				params:  *params(),
 				name:    name,
+				cluster: cluster(),
+				params: params(func(p *v1beta2.ClusterParameters) {
+					p.NodePoolDefaults = &v1beta2.NodePoolDefaults{
+						NodeConfigDefaults: &v1beta2.NodeConfigDefaults{GcfsConfig: &v1beta2.GcfsConfig{Enabled: true}},
+					}
+				}),
+			},
+			want: want{
+				upToDate: false,
+				isErr:    false,
+			},
+		},
+		"UpToDateGcfsConfigDisabled": {
+			args: args{
+				name:    name,
+				cluster: cluster(),
+				params: params(func(p *v1beta2.ClusterParameters) {
+					p.NodePoolDefaults = &v1beta2.NodePoolDefaults{
+						NodeConfigDefaults: &v1beta2.NodeConfigDefaults{GcfsConfig: &v1beta2.GcfsConfig{Enabled: false}},
+					}
+				}),
+			},
+			want: want{
+				upToDate: true,
+				isErr:    false,
+			},
+		},
+		"NeedsUpdateLoggingVariant": {
+			args: args{
+				name:    name,
+				cluster: cluster(),
+				params: params(func(p *v1beta2.ClusterParameters) {
+					p.NodePoolDefaults = &v1beta2.NodePoolDefaults{
+						NodeConfigDefaults: &v1beta2.NodeConfigDefaults{LoggingConfig: &v1beta2.NodePoolLoggingConfig{
+							VariantConfig: &v1beta2.LoggingVariantConfig{Variant: gcp.StringPtr("MAX_THROUGHPUT")},
+						}},
+					}
+				}),
+			},
+			want: want{
+				upToDate: false,
+				isErr:    false,
+			},
+		},
+		"UpToDateLoggingVariantDefault": {
+			args: args{
+				name: name,
+				cluster: cluster(func(c *container.Cluster) {
+					c.NodePoolDefaults = &container.NodePoolDefaults{
+						NodeConfigDefaults: &container.NodeConfigDefaults{
+							LoggingConfig: &container.NodePoolLoggingConfig{
+								VariantConfig: &container.LoggingVariantConfig{Variant: "VARIANT_UNSPECIFIED"},
+							},
+						},
+					}
+				}),
+				params: params(func(p *v1beta2.ClusterParameters) {
+					p.NodePoolDefaults = &v1beta2.NodePoolDefaults{
+						NodeConfigDefaults: &v1beta2.NodeConfigDefaults{LoggingConfig: &v1beta2.NodePoolLoggingConfig{
+							VariantConfig: &v1beta2.LoggingVariantConfig{Variant: gcp.StringPtr("DEFAULT")},
+						}},
+					}
+				}),
+			},
+			want: want{
+				upToDate: true,
+				isErr:    false,
+			},
+		},
+		"NeedsUpdateDefaultSnatStatus": {
+			args: args{
+				name:    name,
+				cluster: cluster(),
+				params: params(func(p *v1beta2.ClusterParameters) {
+					p.NetworkConfig = &v1beta2.NetworkConfigSpec{
+						DefaultSnatStatus: &v1beta2.DefaultSnatStatus{Disabled: true},
+					}
+				}),
+			},
+			want: want{
+				upToDate: false,
+				isErr:    false,
+			},
+		},
+		"UpToDateDefaultSnatStatusOmitted": {
+			args: args{
+				name:    name,
+				cluster: cluster(),
+				params: params(func(p *v1beta2.ClusterParameters) {
+					p.NetworkConfig = &v1beta2.NetworkConfigSpec{
+						DefaultSnatStatus: &v1beta2.DefaultSnatStatus{Disabled: false},
+					}
+				}),
+			},
+			want: want{
+				upToDate: true,
+				isErr:    false,
+			},
+		},
+		"NoUpdateNotBootstrapNodePool": {
+			args: args{
+				name: name,
+				cluster: cluster(func(c *container.Cluster) {
+					sc := &container.StatusCondition{
+						Code:    "cool-code",
+						Message: "cool-message",
+					}
+					np := &container.NodePool{
+						Conditions: []*container.StatusCondition{sc},
+						Name:       "cool-node-pool",
+					}
+					c.NodePools = []*container.NodePool{np}
+				}),
+				params: params(),
+			},
+			want: want{
+				upToDate: true,
+				isErr:    false,
+			},
+		},
+		"NeedsUpdateBootstrapNodePool": {
+			args: args{
+				name: name,
+				cluster: cluster(func(c *container.Cluster) {
+					sc := &container.StatusCondition{
+						Code:    "cool-code",
+						Message: "cool-message",
+					}
+					np := &container.NodePool{
+						Conditions: []*container.StatusCondition{sc},
+						Name:       BootstrapNodePoolName,
+					}
+					c.NodePools = []*container.NodePool{np}
+				}),
+				params: params(),
+			},
+			want: want{
+				upToDate: false,
+				isErr:    false,
+			},
+		},
+		"NeedsUpdateDnsConfig": {
+			args: args{
+				name:    name,
+				cluster: cluster(),
+				params: params(func(p *v1beta2.ClusterParameters) {
+					var clusterDNS = "CLOUD_DNS"
+					var clusterDNSDomain = "crossplane.io"
+					var clusterDNSScope = "VPC_SCOPE"
+					p.NetworkConfig = &v1beta2.NetworkConfigSpec{
+						DnsConfig: &v1beta2.DnsConfig{
+							ClusterDns:       &clusterDNS,
+							ClusterDnsDomain: &clusterDNSDomain,
+							ClusterDnsScope:  &clusterDNSScope,
+						},
+					}
+				}),
+			},
+			want: want{
+				upToDate: false,
+				isErr:    false,
+			},
+		},
+		"UpToDateCloudRunLoadBalancerTypeUnspecified": {
+			args: args{
+				name: name,
+				cluster: cluster(func(c *container.Cluster) {
+					c.AddonsConfig = &container.AddonsConfig{
+						CloudRunConfig: &container.CloudRunConfig{
+							LoadBalancerType: "LOAD_BALANCER_TYPE_UNSPECIFIED",
+						},
+					}
+				}),
+				params: params(func(p *v1beta2.ClusterParameters) {
+					p.AddonsConfig = &v1beta2.AddonsConfig{
+						CloudRunConfig: &v1beta2.CloudRunConfig{},
+					}
+				}),
+			},
+			want: want{
+				upToDate: true,
+				isErr:    false,
+			},
+		},
+		"UpToDateCloudRunDisabled": {
+			args: args{
+				name: name,
+				cluster: cluster(func(c *container.Cluster) {
+					c.AddonsConfig = &container.AddonsConfig{
+						CloudRunConfig: &container.CloudRunConfig{
+							Disabled: true,
+						},
+					}
+				}),
+				params: params(func(p *v1beta2.ClusterParameters) {
+					p.AddonsConfig = &v1beta2.AddonsConfig{
+						CloudRunConfig: &v1beta2.CloudRunConfig{
+							Disabled:         true,
+							LoadBalancerType: gcp.StringPtr("LOAD_BALANCER_TYPE_EXTERNAL"),
+						},
+					}
+				}),
+			},
+			want: want{
+				upToDate: true,
+				isErr:    false,
+			},
+		},
+		"NeedsUpdateCloudRunLoadBalancerType": {
+			args: args{
+				name: name,
+				cluster: cluster(func(c *container.Cluster) {
+					c.AddonsConfig = &container.AddonsConfig{
+						CloudRunConfig: &container.CloudRunConfig{
+							LoadBalancerType: "LOAD_BALANCER_TYPE_EXTERNAL",
+						},
+					}
+				}),
+				params: params(func(p *v1beta2.ClusterParameters) {
+					p.AddonsConfig = &v1beta2.AddonsConfig{
+						CloudRunConfig: &v1beta2.CloudRunConfig{
+							LoadBalancerType: gcp.StringPtr("LOAD_BALANCER_TYPE_INTERNAL"),
+						},
+					}
+				}),
+			},
+			want: want{
+				upToDate: false,
+				isErr:    false,
+			},
+		},
+		"UpToDateAutoprovisioningImageType": {
+			args: args{
+				name: name,
+				cluster: cluster(func(c *container.Cluster) {
+					c.Autoscaling = &container.ClusterAutoscaling{
+						EnableNodeAutoprovisioning: true,
+						AutoprovisioningNodePoolDefaults: &container.AutoprovisioningNodePoolDefaults{
+							ImageType: "COS_CONTAINERD",
+						},
+					}
+				}),
+				params: params(func(p *v1beta2.ClusterParameters) {
+					p.Autoscaling = &v1beta2.ClusterAutoscaling{
+						EnableNodeAutoprovisioning:       gcp.BoolPtr(true),
+						AutoprovisioningNodePoolDefaults: &v1beta2.AutoprovisioningNodePoolDefaults{},
+					}
+				}),
+			},
+			want: want{
+				upToDate: true,
+				isErr:    false,
+			},
+		},
+		"UpToDateResourceLimitsOrder": {
+			args: args{
+				name: name,
+				cluster: cluster(func(c *container.Cluster) {
+					c.Autoscaling = &container.ClusterAutoscaling{
+						EnableNodeAutoprovisioning: true,
+						ResourceLimits: []*container.ResourceLimit{
+							{ResourceType: "memory", Maximum: 64},
+							{ResourceType: "cpu", Maximum: 16},
+						},
+					}
+				}),
+				params: params(func(p *v1beta2.ClusterParameters) {
+					p.Autoscaling = &v1beta2.ClusterAutoscaling{
+						EnableNodeAutoprovisioning: gcp.BoolPtr(true),
+						ResourceLimits: []*v1beta2.ResourceLimit{
+							{ResourceType: gcp.StringPtr("cpu"), Maximum: gcp.Int64Ptr(16)},
+							{ResourceType: gcp.StringPtr("memory"), Maximum: gcp.Int64Ptr(64)},
+						},
+					}
+				}),
+			},
+			want: want{
+				upToDate: true,
+				isErr:    false,
+			},
+		},
+		"NeedsUpdateResourceLimits": {
+			args: args{
+				name: name,
+				cluster: cluster(func(c *container.Cluster) {
+					c.Autoscaling = &container.ClusterAutoscaling{
+						EnableNodeAutoprovisioning: true,
+						ResourceLimits: []*container.ResourceLimit{
+							{ResourceType: "cpu", Maximum: 8},
+						},
+					}
+				}),
+				params: params(func(p *v1beta2.ClusterParameters) {
+					p.Autoscaling = &v1beta2.ClusterAutoscaling{
+						EnableNodeAutoprovisioning: gcp.BoolPtr(true),
+						ResourceLimits: []*v1beta2.ResourceLimit{
+							{ResourceType: gcp.StringPtr("cpu"), Maximum: gcp.Int64Ptr(16)},
+						},
+					}
+				}),
+			},
+			want: want{
+				upToDate: false,
+				isErr:    false,
+			},
+		},
+		"UpToDateAutoprovisioningDisabled": {
+			args: args{
+				name: name,
+				cluster: cluster(func(c *container.Cluster) {
+					c.Autoscaling = &container.ClusterAutoscaling{}
+				}),
+				params: params(func(p *v1beta2.ClusterParameters) {
+					p.Autoscaling = &v1beta2.ClusterAutoscaling{
+						EnableNodeAutoprovisioning: gcp.BoolPtr(false),
+						AutoprovisioningLocations:  []string{"us-central1-a"},
+						AutoprovisioningNodePoolDefaults: &v1beta2.AutoprovisioningNodePoolDefaults{
+							ServiceAccount: gcp.StringPtr("nap@cool-project.iam.gserviceaccount.com"),
+						},
+					}
+				}),
+			},
+			want: want{
+				upToDate: true,
+				isErr:    false,
+			},
+		},
+		"UpToDateNetworkPolicyProviderUnspecified": {
+			args: args{
+				name: name,
+				cluster: cluster(func(c *container.Cluster) {
+					c.NetworkPolicy = &container.NetworkPolicy{
+						Provider: "PROVIDER_UNSPECIFIED",
+					}
+				}),
+				params: params(func(p *v1beta2.ClusterParameters) {
+					p.NetworkPolicy = &v1beta2.NetworkPolicy{
+						Enabled: gcp.BoolPtr(false),
+					}
+				}),
+			},
+			want: want{
+				upToDate: true,
+				isErr:    false,
+			},
+		},
+		"UpToDateNetworkPolicyDisabledOmitted": {
+			args: args{
+				name: name,
+				cluster: cluster(func(c *container.Cluster) {
+					c.NetworkPolicy = nil
+				}),
+				params: params(func(p *v1beta2.ClusterParameters) {
+					p.NetworkPolicy = &v1beta2.NetworkPolicy{
+						Enabled:  gcp.BoolPtr(false),
+						Provider: gcp.StringPtr("CALICO"),
+					}
+				}),
+			},
+			want: want{
+				upToDate: true,
+				isErr:    false,
+			},
+		},
+		"NeedsUpdateNetworkPolicyEnabled": {
+			args: args{
+				name: name,
+				cluster: cluster(func(c *container.Cluster) {
+					c.NetworkPolicy = nil
+				}),
+				params: params(func(p *v1beta2.ClusterParameters) {
+					p.NetworkPolicy = &v1beta2.NetworkPolicy{
+						Enabled:  gcp.BoolPtr(true),
+						Provider: gcp.StringPtr("CALICO"),
+					}
+				}),
+			},
+			want: want{
+				upToDate: false,
+				isErr:    false,
+			},
+		},
+		"UpToDateReleaseChannelUnspecified": {
+			args: args{
+				name: name,
+				cluster: cluster(func(c *container.Cluster) {
+					c.ReleaseChannel = &container.ReleaseChannel{
+						Channel: "UNSPECIFIED",
+					}
+				}),
+				params: params(func(p *v1beta2.ClusterParameters) {
+					p.ReleaseChannel = &v1beta2.ReleaseChannel{}
+				}),
+			},
+			want: want{
+				upToDate: true,
+				isErr:    false,
+			},
+		},
+		"NeedsUpdateReleaseChannel": {
+			args: args{
+				name: name,
+				cluster: cluster(func(c *container.Cluster) {
+					c.ReleaseChannel = &container.ReleaseChannel{
+						Channel: "UNSPECIFIED",
+					}
+				}),
+				params: params(func(p *v1beta2.ClusterParameters) {
+					p.ReleaseChannel = &v1beta2.ReleaseChannel{
+						Channel: "REGULAR",
+					}
+				}),
+			},
+			want: want{
+				upToDate: false,
+				isErr:    false,
+			},
+		},
+		"UpToDateBinaryAuthorizationDisabledOmitted": {
+			args: args{
+				name: name,
+				cluster: cluster(func(c *container.Cluster) {
+					c.BinaryAuthorization = nil
+				}),
+				params: params(func(p *v1beta2.ClusterParameters) {
+					p.BinaryAuthorization = &v1beta2.BinaryAuthorization{
+						Enabled: false,
+					}
+				}),
+			},
+			want: want{
+				upToDate: true,
+				isErr:    false,
+			},
+		},
+		"NeedsUpdateBinaryAuthorizationEnabled": {
+			args: args{
+				name: name,
+				cluster: cluster(func(c *container.Cluster) {
+					c.BinaryAuthorization = nil
+				}),
+				params: params(func(p *v1beta2.ClusterParameters) {
+					p.BinaryAuthorization = &v1beta2.BinaryAuthorization{
+						Enabled: true,
+					}
+				}),
+			},
+			want: want{
+				upToDate: false,
+				isErr:    false,
 			},
-			want: fmt.Sprintf(ClusterNameFormat, project, location, name),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			r, _, err := IsUpToDate(tc.args.name, tc.args.params, tc.args.cluster)
+			if err != nil && !tc.want.isErr {
+				t.Error("IsUpToDate(...) unexpected error")
+			}
+			if diff := cmp.Diff(tc.want.upToDate, r); diff != "" {
+				t.Errorf("IsUpToDate(...): -want upToDate, +got upToDate:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetFullyQualifiedParent(t *testing.T) {
+	type args struct {
+		project string
+		params  v1beta2.ClusterParameters
+	}
+	tests := map[string]struct {
+		args args
+		want string
+	}{
+		"Successful": {
+			args: args{
+				project: project,
+				params:  *params(),
+			},
+			want: fmt.Sprintf(ParentFormat, project, location),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			s := GetFullyQualifiedParent(tc.args.project, tc.args.params)
+			if diff := cmp.Diff(tc.want, s); diff != "" {
+				t.Errorf("GetFullyQualifiedParent(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetFullyQualifiedName(t *testing.T) {
+	type args struct {
+		project string
+		params  v1beta2.ClusterParameters
+		name    string
+	}
+	tests := map[string]struct {
+		args args
+		want string
+	}{
+		"Successful": {
+			args: args{
+				project: project,
+				params:  *params(),
+				name:    name,
+			},
+			want: fmt.Sprintf(ClusterNameFormat, project, location, name),
 		},
 	}
 	for name, tc := range tests {
@@ -1802,6 +2465,51 @@ func TestGetFullyQualifiedBNP(t *testing.T) {
 	}
 }
 
+func TestGetEndpoint(t *testing.T) {
+	private := &container.Cluster{
+		Endpoint: "10.0.0.2",
+		PrivateClusterConfig: &container.PrivateClusterConfig{
+			PrivateEndpoint: "10.0.0.2",
+			PublicEndpoint:  "203.0.113.2",
+		},
+	}
+
+	type args struct {
+		cluster      *container.Cluster
+		endpointType *string
+	}
+
+	cases := map[string]struct {
+		args args
+		want string
+	}{
+		"Default": {
+			args: args{cluster: private},
+			want: "10.0.0.2",
+		},
+		"Public": {
+			args: args{cluster: private, endpointType: gcp.StringPtr(v1beta2.EndpointTypePublic)},
+			want: "203.0.113.2",
+		},
+		"Private": {
+			args: args{cluster: private, endpointType: gcp.StringPtr(v1beta2.EndpointTypePrivate)},
+			want: "10.0.0.2",
+		},
+		"NotPrivate": {
+			args: args{cluster: &container.Cluster{Endpoint: "203.0.113.2"}, endpointType: gcp.StringPtr(v1beta2.EndpointTypePrivate)},
+			want: "203.0.113.2",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, GetEndpoint(tc.args.cluster, tc.args.endpointType)); diff != "" {
+				t.Errorf("GetEndpoint(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestGenerateClientConfig(t *testing.T) {
 	name := "gke-cluster"
 	endpoint := "endpoint"
@@ -1880,3 +2588,217 @@ func TestGenerateClientConfig(t *testing.T) {
 	}
 
 }
+
+func TestResourceLabelsUpdateFn(t *testing.T) {
+	fingerprints := []string{"stale", "current"}
+	var sent []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req := &container.SetLabelsRequest{}
+		_ = json.NewDecoder(r.Body).Decode(req)
+		_ = r.Body.Close()
+		if r.Method == http.MethodGet {
+			fp := fingerprints[0]
+			fingerprints = fingerprints[1:]
+			_ = json.NewEncoder(w).Encode(&container.Cluster{LabelFingerprint: fp})
+			return
+		}
+		sent = append(sent, req.LabelFingerprint)
+		if req.LabelFingerprint != "current" {
+			w.WriteHeader(http.StatusPreconditionFailed)
+			_ = json.NewEncoder(w).Encode(struct{}{})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(&container.Operation{Name: "op"})
+	}))
+	defer server.Close()
+	s, _ := container.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+
+	op, err := newResourceLabelsUpdateFn(resourceLabels)(context.Background(), s, name)
+	if err != nil {
+		t.Fatalf("newResourceLabelsUpdateFn(...): unexpected error: %v", err)
+	}
+	if diff := cmp.Diff("op", op.Name); diff != "" {
+		t.Errorf("newResourceLabelsUpdateFn(...): -want operation, +got operation:\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"stale", "current"}, sent); diff != "" {
+		t.Errorf("newResourceLabelsUpdateFn(...): -want fingerprints, +got fingerprints:\n%s", diff)
+	}
+}
+
+func TestAuthenticatorGroupsConfigUpdateFn(t *testing.T) {
+	var got map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req := map[string]map[string]interface{}{}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		_ = r.Body.Close()
+		got, _ = req["update"]["desiredAuthenticatorGroupsConfig"].(map[string]interface{})
+		_ = json.NewEncoder(w).Encode(&container.Operation{Name: "op"})
+	}))
+	defer server.Close()
+	s, _ := container.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+
+	in := &v1beta2.AuthenticatorGroupsConfig{Enabled: gcp.BoolPtr(false)}
+	if _, err := newAuthenticatorGroupsConfigUpdateFn(in)(context.Background(), s, name); err != nil {
+		t.Fatalf("newAuthenticatorGroupsConfigUpdateFn(...): unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(map[string]interface{}{"enabled": false}, got); diff != "" {
+		t.Errorf("newAuthenticatorGroupsConfigUpdateFn(...): -want config, +got config:\n%s", diff)
+	}
+}
+
+func TestDefaultSnatStatusUpdateFn(t *testing.T) {
+	var got map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req := map[string]map[string]interface{}{}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		_ = r.Body.Close()
+		got, _ = req["update"]["desiredDefaultSnatStatus"].(map[string]interface{})
+		_ = json.NewEncoder(w).Encode(&container.Operation{Name: "op"})
+	}))
+	defer server.Close()
+	s, _ := container.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+
+	if _, err := newDefaultSnatStatusUpdateFn(&v1beta2.DefaultSnatStatus{Disabled: false})(context.Background(), s, name); err != nil {
+		t.Fatalf("newDefaultSnatStatusUpdateFn(...): unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(map[string]interface{}{"disabled": false}, got); diff != "" {
+		t.Errorf("newDefaultSnatStatusUpdateFn(...): -want status, +got status:\n%s", diff)
+	}
+}
+
+func TestMeshCertificatesUpdateFn(t *testing.T) {
+	var got map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req := map[string]map[string]interface{}{}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		_ = r.Body.Close()
+		got, _ = req["update"]["desiredMeshCertificates"].(map[string]interface{})
+		_ = json.NewEncoder(w).Encode(&container.Operation{Name: "op"})
+	}))
+	defer server.Close()
+	s, _ := container.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+
+	if _, err := newMeshCertificatesUpdateFn(&v1beta2.MeshCertificates{EnableCertificates: gcp.BoolPtr(false)})(context.Background(), s, name); err != nil {
+		t.Fatalf("newMeshCertificatesUpdateFn(...): unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(map[string]interface{}{"enableCertificates": false}, got); diff != "" {
+		t.Errorf("newMeshCertificatesUpdateFn(...): -want certificates, +got certificates:\n%s", diff)
+	}
+}
+
+func TestCostManagementConfigUpdateFn(t *testing.T) {
+	var got map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req := map[string]map[string]interface{}{}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		_ = r.Body.Close()
+		got, _ = req["update"]["desiredCostManagementConfig"].(map[string]interface{})
+		_ = json.NewEncoder(w).Encode(&container.Operation{Name: "op"})
+	}))
+	defer server.Close()
+	s, _ := container.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+
+	if _, err := newCostManagementConfigUpdateFn(&v1beta2.CostManagementConfig{Enabled: false})(context.Background(), s, name); err != nil {
+		t.Fatalf("newCostManagementConfigUpdateFn(...): unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(map[string]interface{}{"enabled": false}, got); diff != "" {
+		t.Errorf("newCostManagementConfigUpdateFn(...): -want config, +got config:\n%s", diff)
+	}
+}
+
+func TestGcfsConfigUpdateFn(t *testing.T) {
+	var got map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req := map[string]map[string]interface{}{}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		_ = r.Body.Close()
+		got, _ = req["update"]["desiredGcfsConfig"].(map[string]interface{})
+		_ = json.NewEncoder(w).Encode(&container.Operation{Name: "op"})
+	}))
+	defer server.Close()
+	s, _ := container.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+
+	if _, err := newGcfsConfigUpdateFn(&v1beta2.GcfsConfig{Enabled: false})(context.Background(), s, name); err != nil {
+		t.Fatalf("newGcfsConfigUpdateFn(...): unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(map[string]interface{}{"enabled": false}, got); diff != "" {
+		t.Errorf("newGcfsConfigUpdateFn(...): -want config, +got config:\n%s", diff)
+	}
+}
+
+func TestObserveFirewallRules(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.Body.Close()
+		if diff := cmp.Diff("/projects/host-project/global/firewalls", r.URL.Path); diff != "" {
+			t.Errorf("r: -want, +got:\n%s", diff)
+		}
+		if diff := cmp.Diff("name eq gke-my-cool-cluster-0123abcd-.*", r.URL.Query().Get("filter")); diff != "" {
+			t.Errorf("filter: -want, +got:\n%s", diff)
+		}
+		_ = json.NewEncoder(w).Encode(&compute.FirewallList{Items: []*compute.Firewall{
+			{Name: "gke-my-cool-cluster-0123abcd-all"},
+			{Name: "gke-my-cool-cluster-0123abcd-ssh"},
+		}})
+	}))
+	defer server.Close()
+	s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+
+	c := cluster(func(c *container.Cluster) {
+		c.Id = "0123abcd4567ef"
+		c.NetworkConfig = &container.NetworkConfig{Network: "projects/host-project/global/networks/shared"}
+	})
+	got, err := ObserveFirewallRules(context.Background(), s, project, *c)
+	if err != nil {
+		t.Fatalf("ObserveFirewallRules(...): unexpected error: %v", err)
+	}
+	if diff := cmp.Diff([]string{"gke-my-cool-cluster-0123abcd-all", "gke-my-cool-cluster-0123abcd-ssh"}, got); diff != "" {
+		t.Errorf("ObserveFirewallRules(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestImmutableFieldsChanged(t *testing.T) {
+	cases := map[string]struct {
+		in      *v1beta2.ClusterParameters
+		current *container.Cluster
+		want    []string
+	}{
+		"Unchanged": {
+			in:      params(),
+			current: cluster(),
+			want:    []string{},
+		},
+		"MutableFieldChanged": {
+			in: params(),
+			current: cluster(func(c *container.Cluster) {
+				c.LoggingService = "none"
+			}),
+			want: []string{},
+		},
+		"ConfidentialNodesNotSet": {
+			in: params(),
+			current: cluster(func(c *container.Cluster) {
+				c.ConfidentialNodes = &container.ConfidentialNodes{Enabled: true}
+			}),
+			want: []string{},
+		},
+		"Changed": {
+			in: params(func(p *v1beta2.ClusterParameters) {
+				p.ConfidentialNodes = &v1beta2.ConfidentialNodes{Enabled: true}
+			}),
+			current: cluster(func(c *container.Cluster) {
+				c.ClusterIpv4Cidr = "10.96.0.0/14"
+				c.EnableTpu = false
+			}),
+			want: []string{"clusterIpv4Cidr", "confidentialNodes.enabled", "enableTpu"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := ImmutableFieldsChanged(tc.in, tc.current)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("ImmutableFieldsChanged(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,285 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	container "google.golang.org/api/container/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/container/v1beta2"
+)
+
+const (
+	// defaultDailyMaintenanceDuration is the duration of a daily
+	// maintenance window that GKE did not report the duration of.
+	defaultDailyMaintenanceDuration = 4 * time.Hour
+
+	// maxMaintenanceWindows bounds the number of windows that are searched
+	// for one that is not covered by maintenance exclusions.
+	maxMaintenanceWindows = 1000
+
+	// maxRecurrenceDays bounds the number of days that are searched for the
+	// next occurrence of a recurring maintenance window.
+	maxRecurrenceDays = 3660
+)
+
+var weekdays = map[string]time.Weekday{
+	"SU": time.Sunday,
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+}
+
+type timeWindow struct {
+	start time.Time
+	end   time.Time
+}
+
+// covers returns true if the supplied time is within the window.
+func (w timeWindow) covers(t time.Time) bool {
+	return !t.Before(w.start) && t.Before(w.end)
+}
+
+// windowFn returns the first maintenance window that ends after the supplied
+// time, if any.
+type windowFn func(after time.Time) (timeWindow, bool)
+
+// ObserveMaintenance reports the next maintenance start and the maintenance
+// exclusions that are active at the supplied time in the supplied
+// observation.
+func ObserveMaintenance(o *v1beta2.ClusterObservation, in *container.MaintenancePolicy, now time.Time) {
+	if in == nil || in.Window == nil {
+		return
+	}
+	active := ActiveMaintenanceExclusions(in.Window, now)
+	next := NextMaintenanceStart(in.Window, now)
+	if len(active) == 0 && next.IsZero() {
+		return
+	}
+	if o.MaintenancePolicy == nil {
+		o.MaintenancePolicy = &v1beta2.MaintenancePolicyStatus{}
+	}
+	o.MaintenancePolicy.ActiveMaintenanceExclusions = active
+	if !next.IsZero() {
+		o.MaintenancePolicy.NextMaintenanceStartTime = next.UTC().Format(time.RFC3339)
+	}
+}
+
+// ActiveMaintenanceExclusions returns the sorted names of the maintenance
+// exclusions of the supplied window that are in effect at the supplied time.
+func ActiveMaintenanceExclusions(in *container.MaintenanceWindow, now time.Time) []string {
+	var names []string
+	for name, e := range in.MaintenanceExclusions {
+		w, ok := parseTimeWindow(e)
+		if ok && w.covers(now) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// NextMaintenanceStart returns the earliest time at which GKE may start
+// maintenance according to the supplied window, i.e. the start of the current
+// or next maintenance window, deferred past any maintenance exclusions. While
+// a window is open the returned time may be in the past. The zero time is
+// returned if it cannot be determined, e.g. because no window is configured
+// or its recurrence is not supported.
+func NextMaintenanceStart(in *container.MaintenanceWindow, now time.Time) time.Time {
+	var next windowFn
+	switch {
+	case in.RecurringWindow != nil:
+		next = recurringWindows(in.RecurringWindow)
+	case in.DailyMaintenanceWindow != nil:
+		next = dailyWindows(in.DailyMaintenanceWindow)
+	}
+	if next == nil {
+		return time.Time{}
+	}
+
+	exclusions := make([]timeWindow, 0, len(in.MaintenanceExclusions))
+	for _, e := range in.MaintenanceExclusions {
+		if w, ok := parseTimeWindow(e); ok {
+			exclusions = append(exclusions, w)
+		}
+	}
+
+	after := now
+	for i := 0; i < maxMaintenanceWindows; i++ {
+		w, ok := next(after)
+		if !ok {
+			return time.Time{}
+		}
+		if t, ok := firstUnexcluded(w, exclusions); ok {
+			return t
+		}
+		after = w.end
+	}
+	return time.Time{}
+}
+
+// firstUnexcluded returns the first time within the supplied window that is
+// not covered by any of the supplied exclusions.
+func firstUnexcluded(w timeWindow, exclusions []timeWindow) (time.Time, bool) {
+	t := w.start
+	for moved := true; moved; {
+		moved = false
+		for _, e := range exclusions {
+			if e.covers(t) {
+				t = e.end
+				moved = true
+			}
+		}
+	}
+	return t, t.Before(w.end)
+}
+
+func dailyWindows(in *container.DailyMaintenanceWindow) windowFn {
+	start, err := time.Parse("15:04", in.StartTime)
+	if err != nil {
+		return nil
+	}
+	d := defaultDailyMaintenanceDuration
+	if pd, err := time.ParseDuration(strings.ToLower(strings.TrimPrefix(in.Duration, "PT"))); err == nil && pd > 0 {
+		d = pd
+	}
+	return func(after time.Time) (timeWindow, bool) {
+		after = after.UTC()
+		// Start a day early in case a window that started yesterday is
+		// still open.
+		s := time.Date(after.Year(), after.Month(), after.Day(), start.Hour(), start.Minute(), 0, 0, time.UTC).AddDate(0, 0, -1)
+		for !s.Add(d).After(after) {
+			s = s.AddDate(0, 0, 1)
+		}
+		return timeWindow{start: s, end: s.Add(d)}, true
+	}
+}
+
+func recurringWindows(in *container.RecurringTimeWindow) windowFn {
+	if in.Window == nil {
+		return nil
+	}
+	first, ok := parseTimeWindow(*in.Window)
+	if !ok {
+		return nil
+	}
+	r, ok := parseRecurrence(in.Recurrence, first.start)
+	if !ok {
+		return nil
+	}
+	d := first.end.Sub(first.start)
+	return func(after time.Time) (timeWindow, bool) {
+		// Occurrences start at the time of day of the first window, so
+		// skip straight to the day before the first one that may still be
+		// open.
+		s := first.start
+		if from := after.Add(-d); from.After(s) {
+			s = s.AddDate(0, 0, int(from.Sub(s).Hours()/24))
+		}
+		for i := 0; i < maxRecurrenceDays; i++ {
+			if r.matches(first.start, s) && s.Add(d).After(after) {
+				return timeWindow{start: s, end: s.Add(d)}, true
+			}
+			s = s.AddDate(0, 0, 1)
+		}
+		return timeWindow{}, false
+	}
+}
+
+// recurrence is the subset of RFC 5545 recurrence rules that GKE maintenance
+// windows commonly use: daily or weekly, optionally every n days or weeks and
+// on certain days of the week.
+type recurrence struct {
+	weekly   bool
+	interval int
+	days     map[time.Weekday]bool
+}
+
+func parseRecurrence(rule string, first time.Time) (recurrence, bool) {
+	r := recurrence{interval: 1, days: map[time.Weekday]bool{}}
+	freq := false
+	for _, part := range strings.Split(strings.TrimPrefix(rule, "RRULE:"), ";") {
+		k, v, _ := strings.Cut(part, "=")
+		switch k {
+		case "FREQ":
+			switch v {
+			case "DAILY":
+			case "WEEKLY":
+				r.weekly = true
+			default:
+				return recurrence{}, false
+			}
+			freq = true
+		case "INTERVAL":
+			n, err := strconv.Atoi(v)
+			if err != nil || n < 1 {
+				return recurrence{}, false
+			}
+			r.interval = n
+		case "BYDAY":
+			for _, day := range strings.Split(v, ",") {
+				wd, ok := weekdays[day]
+				if !ok {
+					return recurrence{}, false
+				}
+				r.days[wd] = true
+			}
+		default:
+			// Other parts, e.g. COUNT, UNTIL or BYSETPOS, are not
+			// supported.
+			return recurrence{}, false
+		}
+	}
+	if r.weekly && len(r.days) == 0 {
+		r.days[first.Weekday()] = true
+	}
+	return r, freq
+}
+
+// matches returns true if a window recurs on the day of t, which must be at
+// the time of day of the first window.
+func (r recurrence) matches(first, t time.Time) bool {
+	if t.Before(first) || (len(r.days) > 0 && !r.days[t.Weekday()]) {
+		return false
+	}
+	days := int(t.Sub(first).Hours() / 24)
+	if !r.weekly {
+		return days%r.interval == 0
+	}
+	// Weeks start on Monday, the RFC 5545 default.
+	sinceMonday := (int(first.Weekday()) + 6) % 7
+	return ((days+sinceMonday)/7)%r.interval == 0
+}
+
+func parseTimeWindow(in container.TimeWindow) (timeWindow, bool) {
+	start, err := time.Parse(time.RFC3339, in.StartTime)
+	if err != nil {
+		return timeWindow{}, false
+	}
+	end, err := time.Parse(time.RFC3339, in.EndTime)
+	if err != nil || !end.After(start) {
+		return timeWindow{}, false
+	}
+	return timeWindow{start: start, end: end}, true
+}
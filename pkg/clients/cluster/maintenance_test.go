@@ -0,0 +1,154 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	container "google.golang.org/api/container/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/container/v1beta2"
+)
+
+// testNow is a Wednesday.
+var testNow = time.Date(2023, 3, 15, 10, 0, 0, 0, time.UTC)
+
+func daily(start string) *container.MaintenanceWindow {
+	return &container.MaintenanceWindow{
+		DailyMaintenanceWindow: &container.DailyMaintenanceWindow{StartTime: start, Duration: "PT4H0M0S"},
+	}
+}
+
+func recurring(start, end, rule string) *container.MaintenanceWindow {
+	return &container.MaintenanceWindow{
+		RecurringWindow: &container.RecurringTimeWindow{
+			Window:     &container.TimeWindow{StartTime: start, EndTime: end},
+			Recurrence: rule,
+		},
+	}
+}
+
+func withExclusion(w *container.MaintenanceWindow, name, start, end string) *container.MaintenanceWindow {
+	if w.MaintenanceExclusions == nil {
+		w.MaintenanceExclusions = map[string]container.TimeWindow{}
+	}
+	w.MaintenanceExclusions[name] = container.TimeWindow{StartTime: start, EndTime: end}
+	return w
+}
+
+func TestNextMaintenanceStart(t *testing.T) {
+	cases := map[string]struct {
+		in   *container.MaintenanceWindow
+		want time.Time
+	}{
+		"NoWindow": {
+			in: &container.MaintenanceWindow{},
+		},
+		"Daily": {
+			in:   daily("03:00"),
+			want: time.Date(2023, 3, 16, 3, 0, 0, 0, time.UTC),
+		},
+		"DailyOpen": {
+			in:   daily("08:00"),
+			want: time.Date(2023, 3, 15, 8, 0, 0, 0, time.UTC),
+		},
+		"DailyExcluded": {
+			in:   withExclusion(daily("03:00"), "freeze", "2023-03-16T00:00:00Z", "2023-03-18T00:00:00Z"),
+			want: time.Date(2023, 3, 18, 3, 0, 0, 0, time.UTC),
+		},
+		"DailyPartiallyExcluded": {
+			in:   withExclusion(daily("03:00"), "freeze", "2023-03-16T00:00:00Z", "2023-03-16T05:00:00Z"),
+			want: time.Date(2023, 3, 16, 5, 0, 0, 0, time.UTC),
+		},
+		"Weekends": {
+			in:   recurring("2019-01-05T00:00:00Z", "2019-01-05T12:00:00Z", "FREQ=WEEKLY;BYDAY=SA,SU"),
+			want: time.Date(2023, 3, 18, 0, 0, 0, 0, time.UTC),
+		},
+		"EveryOtherWeek": {
+			in:   recurring("2023-03-01T00:00:00Z", "2023-03-01T06:00:00Z", "FREQ=WEEKLY;INTERVAL=2"),
+			want: time.Date(2023, 3, 29, 0, 0, 0, 0, time.UTC),
+		},
+		"DailyRecurringWithOffset": {
+			in:   recurring("2019-01-01T09:00:00-04:00", "2019-01-01T17:00:00-04:00", "FREQ=DAILY"),
+			want: time.Date(2023, 3, 15, 13, 0, 0, 0, time.UTC),
+		},
+		"UnsupportedRecurrence": {
+			in: recurring("2019-01-05T00:00:00Z", "2019-01-06T00:00:00Z", "FREQ=MONTHLY;BYSETPOS=1;BYDAY=SA"),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := NextMaintenanceStart(tc.in, testNow)
+			if !got.Equal(tc.want) {
+				t.Errorf("NextMaintenanceStart(...): want %s, got %s", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestObserveMaintenance(t *testing.T) {
+	cases := map[string]struct {
+		o    v1beta2.ClusterObservation
+		in   *container.MaintenancePolicy
+		want v1beta2.ClusterObservation
+	}{
+		"NoPolicy": {},
+		"Daily": {
+			o: v1beta2.ClusterObservation{
+				MaintenancePolicy: &v1beta2.MaintenancePolicyStatus{
+					Window: v1beta2.MaintenanceWindowStatus{
+						DailyMaintenanceWindow: v1beta2.DailyMaintenanceWindowStatus{Duration: "PT4H0M0S"},
+					},
+				},
+			},
+			in: &container.MaintenancePolicy{
+				Window: withExclusion(
+					withExclusion(daily("03:00"), "freeze", "2023-03-01T00:00:00Z", "2023-03-16T00:00:00Z"),
+					"later", "2023-04-01T00:00:00Z", "2023-04-02T00:00:00Z"),
+			},
+			want: v1beta2.ClusterObservation{
+				MaintenancePolicy: &v1beta2.MaintenancePolicyStatus{
+					Window: v1beta2.MaintenanceWindowStatus{
+						DailyMaintenanceWindow: v1beta2.DailyMaintenanceWindowStatus{Duration: "PT4H0M0S"},
+					},
+					NextMaintenanceStartTime:    "2023-03-16T03:00:00Z",
+					ActiveMaintenanceExclusions: []string{"freeze"},
+				},
+			},
+		},
+		"ExclusionsOnly": {
+			in: &container.MaintenancePolicy{
+				Window: withExclusion(&container.MaintenanceWindow{}, "freeze", "2023-03-01T00:00:00Z", "2023-03-16T00:00:00Z"),
+			},
+			want: v1beta2.ClusterObservation{
+				MaintenancePolicy: &v1beta2.MaintenancePolicyStatus{
+					ActiveMaintenanceExclusions: []string{"freeze"},
+				},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ObserveMaintenance(&tc.o, tc.in, testNow)
+			if diff := cmp.Diff(tc.want, tc.o); diff != "" {
+				t.Errorf("ObserveMaintenance(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,155 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"reflect"
+	"sort"
+
+	"github.com/google/go-cmp/cmp"
+	container "google.golang.org/api/container/v1"
+)
+
+// Values GKE reports for fields that were left unset.
+const (
+	cloudRunLoadBalancerTypeUnspecified = "LOAD_BALANCER_TYPE_UNSPECIFIED"
+	releaseChannelUnspecified           = "UNSPECIFIED"
+	networkPolicyProviderUnspecified    = "PROVIDER_UNSPECIFIED"
+	loggingVariantUnspecified           = "VARIANT_UNSPECIFIED"
+	loggingVariantDefault               = "DEFAULT"
+)
+
+// normalizeServerDefaults removes the differences between the desired and
+// observed cluster that are caused by GKE populating or omitting fields on
+// its own, rather than by the cluster having drifted from its desired state.
+// Both clusters are modified in place, so callers must pass copies.
+func normalizeServerDefaults(desired, observed *container.Cluster) {
+	for _, c := range []*container.Cluster{desired, observed} {
+		normalizeCloudRunConfig(c)
+		normalizeAutoscaling(c)
+		normalizeNetworkPolicy(c)
+		normalizeReleaseChannel(c)
+	}
+}
+
+// normalizeCloudRunConfig treats an unspecified Cloud Run load balancer type
+// like an omitted one. The load balancer type is irrelevant while Cloud Run
+// is disabled, and GKE stops reporting it.
+func normalizeCloudRunConfig(c *container.Cluster) {
+	if c.AddonsConfig == nil || c.AddonsConfig.CloudRunConfig == nil {
+		return
+	}
+	cr := c.AddonsConfig.CloudRunConfig
+	if cr.Disabled || cr.LoadBalancerType == cloudRunLoadBalancerTypeUnspecified {
+		cr.LoadBalancerType = ""
+	}
+}
+
+// normalizeAutoscaling ignores the image type GKE picks for auto-provisioned
+// node pools, which can not be configured, and the order GKE reports
+// resource limits in. The auto-provisioning defaults and locations are
+// ignored while node auto-provisioning is disabled, since GKE neither uses
+// nor reports them then.
+func normalizeAutoscaling(c *container.Cluster) {
+	a := c.Autoscaling
+	if a == nil {
+		return
+	}
+	if !a.EnableNodeAutoprovisioning {
+		a.AutoprovisioningNodePoolDefaults = nil
+		a.AutoprovisioningLocations = nil
+	}
+	if a.AutoprovisioningNodePoolDefaults != nil {
+		a.AutoprovisioningNodePoolDefaults.ImageType = ""
+	}
+	limits := make([]*container.ResourceLimit, 0, len(a.ResourceLimits))
+	for _, l := range a.ResourceLimits {
+		if l != nil {
+			limits = append(limits, l)
+		}
+	}
+	sort.SliceStable(limits, func(i, j int) bool { return limits[i].ResourceType < limits[j].ResourceType })
+	a.ResourceLimits = limits
+}
+
+// normalizeNetworkPolicy ignores the provider of a disabled network policy,
+// which GKE reports as unspecified or omits along with the whole policy.
+func normalizeNetworkPolicy(c *container.Cluster) {
+	if c.NetworkPolicy == nil {
+		return
+	}
+	if !c.NetworkPolicy.Enabled || c.NetworkPolicy.Provider == networkPolicyProviderUnspecified {
+		c.NetworkPolicy.Provider = ""
+	}
+}
+
+// normalizeReleaseChannel treats the unspecified release channel GKE reports
+// for clusters that are not enrolled in one like an omitted one.
+func normalizeReleaseChannel(c *container.Cluster) {
+	if c.ReleaseChannel != nil && c.ReleaseChannel.Channel == releaseChannelUnspecified {
+		c.ReleaseChannel.Channel = ""
+	}
+}
+
+// equateZeroStructs returns a cmp.Option that considers a nil pointer to a
+// struct equal to a pointer to the zero value of that struct. GKE omits
+// blocks such as binaryAuthorization or networkPolicy entirely when all of
+// their fields have their zero value, while the desired cluster contains an
+// empty block whenever it is present in the spec.
+func equateZeroStructs() cmp.Option {
+	return cmp.FilterValues(func(x, y interface{}) bool {
+		vx, vy := reflect.ValueOf(x), reflect.ValueOf(y)
+		if !vx.IsValid() || !vy.IsValid() || vx.Type() != vy.Type() {
+			return false
+		}
+		if vx.Kind() != reflect.Ptr || vx.Type().Elem().Kind() != reflect.Struct {
+			return false
+		}
+		switch {
+		case vx.IsNil() && !vy.IsNil():
+			return isZeroStruct(vy.Elem())
+		case !vx.IsNil() && vy.IsNil():
+			return isZeroStruct(vx.Elem())
+		}
+		return false
+	}, cmp.Comparer(func(_, _ interface{}) bool { return true }))
+}
+
+// isZeroStruct reports whether all fields of the supplied struct, other than
+// the ForceSendFields and NullFields of the Google API client, have their
+// zero value.
+func isZeroStruct(v reflect.Value) bool {
+	for i := 0; i < v.NumField(); i++ {
+		switch v.Type().Field(i).Name {
+		case "ForceSendFields", "NullFields":
+			continue
+		}
+		if !v.Field(i).IsZero() {
+			return false
+		}
+	}
+	return true
+}
+
+// normalizeLoggingVariant treats an unspecified or omitted logging variant
+// like the default one, which is what GKE deploys in that case.
+func normalizeLoggingVariant(v string) string {
+	if v == "" || v == loggingVariantUnspecified {
+		return loggingVariantDefault
+	}
+	return v
+}
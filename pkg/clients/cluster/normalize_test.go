@@ -0,0 +1,279 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	container "google.golang.org/api/container/v1"
+)
+
+func TestNormalizeServerDefaults(t *testing.T) {
+	desired := &container.Cluster{
+		AddonsConfig: &container.AddonsConfig{CloudRunConfig: &container.CloudRunConfig{Disabled: true}},
+		Autoscaling: &container.ClusterAutoscaling{
+			EnableNodeAutoprovisioning: true,
+			AutoprovisioningNodePoolDefaults: &container.AutoprovisioningNodePoolDefaults{
+				ServiceAccount: "default",
+			},
+			ResourceLimits: []*container.ResourceLimit{
+				{ResourceType: "memory", Maximum: 64},
+				{ResourceType: "cpu", Maximum: 16},
+			},
+		},
+		NetworkPolicy: &container.NetworkPolicy{},
+	}
+	observed := &container.Cluster{
+		AddonsConfig: &container.AddonsConfig{CloudRunConfig: &container.CloudRunConfig{Disabled: true, LoadBalancerType: "LOAD_BALANCER_TYPE_EXTERNAL"}},
+		Autoscaling: &container.ClusterAutoscaling{
+			EnableNodeAutoprovisioning: true,
+			AutoprovisioningNodePoolDefaults: &container.AutoprovisioningNodePoolDefaults{
+				ServiceAccount: "default",
+				ImageType:      "COS_CONTAINERD",
+			},
+			ResourceLimits: []*container.ResourceLimit{
+				{ResourceType: "cpu", Maximum: 16},
+				{ResourceType: "memory", Maximum: 64},
+			},
+		},
+		NetworkPolicy:  &container.NetworkPolicy{Provider: networkPolicyProviderUnspecified},
+		ReleaseChannel: &container.ReleaseChannel{Channel: releaseChannelUnspecified},
+	}
+	normalizeServerDefaults(desired, observed)
+	if diff := cmp.Diff(desired, observed, equateZeroStructs()); diff != "" {
+		t.Errorf("normalizeServerDefaults(...): -desired, +observed:\n%s", diff)
+	}
+}
+
+func TestNormalizeCloudRunConfig(t *testing.T) {
+	cases := map[string]struct {
+		in   *container.Cluster
+		want *container.Cluster
+	}{
+		"NoAddonsConfig": {
+			in:   &container.Cluster{},
+			want: &container.Cluster{},
+		},
+		"NoCloudRunConfig": {
+			in:   &container.Cluster{AddonsConfig: &container.AddonsConfig{}},
+			want: &container.Cluster{AddonsConfig: &container.AddonsConfig{}},
+		},
+		"Unspecified": {
+			in:   &container.Cluster{AddonsConfig: &container.AddonsConfig{CloudRunConfig: &container.CloudRunConfig{LoadBalancerType: cloudRunLoadBalancerTypeUnspecified}}},
+			want: &container.Cluster{AddonsConfig: &container.AddonsConfig{CloudRunConfig: &container.CloudRunConfig{}}},
+		},
+		"Disabled": {
+			in:   &container.Cluster{AddonsConfig: &container.AddonsConfig{CloudRunConfig: &container.CloudRunConfig{Disabled: true, LoadBalancerType: "LOAD_BALANCER_TYPE_INTERNAL"}}},
+			want: &container.Cluster{AddonsConfig: &container.AddonsConfig{CloudRunConfig: &container.CloudRunConfig{Disabled: true}}},
+		},
+		"Enabled": {
+			in:   &container.Cluster{AddonsConfig: &container.AddonsConfig{CloudRunConfig: &container.CloudRunConfig{LoadBalancerType: "LOAD_BALANCER_TYPE_INTERNAL"}}},
+			want: &container.Cluster{AddonsConfig: &container.AddonsConfig{CloudRunConfig: &container.CloudRunConfig{LoadBalancerType: "LOAD_BALANCER_TYPE_INTERNAL"}}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			normalizeCloudRunConfig(tc.in)
+			if diff := cmp.Diff(tc.want, tc.in); diff != "" {
+				t.Errorf("normalizeCloudRunConfig(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNormalizeAutoscaling(t *testing.T) {
+	cases := map[string]struct {
+		in   *container.Cluster
+		want *container.Cluster
+	}{
+		"NoAutoscaling": {
+			in:   &container.Cluster{},
+			want: &container.Cluster{},
+		},
+		"AutoprovisioningDisabled": {
+			in: &container.Cluster{Autoscaling: &container.ClusterAutoscaling{
+				AutoprovisioningNodePoolDefaults: &container.AutoprovisioningNodePoolDefaults{ServiceAccount: "default"},
+				AutoprovisioningLocations:        []string{"us-central1-a"},
+			}},
+			want: &container.Cluster{Autoscaling: &container.ClusterAutoscaling{
+				ResourceLimits: []*container.ResourceLimit{},
+			}},
+		},
+		"ImageType": {
+			in: &container.Cluster{Autoscaling: &container.ClusterAutoscaling{
+				EnableNodeAutoprovisioning:       true,
+				AutoprovisioningNodePoolDefaults: &container.AutoprovisioningNodePoolDefaults{ServiceAccount: "default", ImageType: "COS_CONTAINERD"},
+				AutoprovisioningLocations:        []string{"us-central1-a"},
+			}},
+			want: &container.Cluster{Autoscaling: &container.ClusterAutoscaling{
+				EnableNodeAutoprovisioning:       true,
+				AutoprovisioningNodePoolDefaults: &container.AutoprovisioningNodePoolDefaults{ServiceAccount: "default"},
+				AutoprovisioningLocations:        []string{"us-central1-a"},
+				ResourceLimits:                   []*container.ResourceLimit{},
+			}},
+		},
+		"ResourceLimitOrder": {
+			in: &container.Cluster{Autoscaling: &container.ClusterAutoscaling{
+				ResourceLimits: []*container.ResourceLimit{
+					{ResourceType: "nvidia-tesla-t4", Maximum: 4},
+					nil,
+					{ResourceType: "memory", Maximum: 64},
+					{ResourceType: "cpu", Maximum: 16},
+				},
+			}},
+			want: &container.Cluster{Autoscaling: &container.ClusterAutoscaling{
+				ResourceLimits: []*container.ResourceLimit{
+					{ResourceType: "cpu", Maximum: 16},
+					{ResourceType: "memory", Maximum: 64},
+					{ResourceType: "nvidia-tesla-t4", Maximum: 4},
+				},
+			}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			normalizeAutoscaling(tc.in)
+			if diff := cmp.Diff(tc.want, tc.in); diff != "" {
+				t.Errorf("normalizeAutoscaling(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNormalizeNetworkPolicy(t *testing.T) {
+	cases := map[string]struct {
+		in   *container.Cluster
+		want *container.Cluster
+	}{
+		"NoNetworkPolicy": {
+			in:   &container.Cluster{},
+			want: &container.Cluster{},
+		},
+		"Disabled": {
+			in:   &container.Cluster{NetworkPolicy: &container.NetworkPolicy{Provider: "CALICO"}},
+			want: &container.Cluster{NetworkPolicy: &container.NetworkPolicy{}},
+		},
+		"Unspecified": {
+			in:   &container.Cluster{NetworkPolicy: &container.NetworkPolicy{Enabled: true, Provider: networkPolicyProviderUnspecified}},
+			want: &container.Cluster{NetworkPolicy: &container.NetworkPolicy{Enabled: true}},
+		},
+		"Enabled": {
+			in:   &container.Cluster{NetworkPolicy: &container.NetworkPolicy{Enabled: true, Provider: "CALICO"}},
+			want: &container.Cluster{NetworkPolicy: &container.NetworkPolicy{Enabled: true, Provider: "CALICO"}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			normalizeNetworkPolicy(tc.in)
+			if diff := cmp.Diff(tc.want, tc.in); diff != "" {
+				t.Errorf("normalizeNetworkPolicy(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNormalizeReleaseChannel(t *testing.T) {
+	cases := map[string]struct {
+		in   *container.Cluster
+		want *container.Cluster
+	}{
+		"NoReleaseChannel": {
+			in:   &container.Cluster{},
+			want: &container.Cluster{},
+		},
+		"Unspecified": {
+			in:   &container.Cluster{ReleaseChannel: &container.ReleaseChannel{Channel: releaseChannelUnspecified}},
+			want: &container.Cluster{ReleaseChannel: &container.ReleaseChannel{}},
+		},
+		"Enrolled": {
+			in:   &container.Cluster{ReleaseChannel: &container.ReleaseChannel{Channel: "REGULAR"}},
+			want: &container.Cluster{ReleaseChannel: &container.ReleaseChannel{Channel: "REGULAR"}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			normalizeReleaseChannel(tc.in)
+			if diff := cmp.Diff(tc.want, tc.in); diff != "" {
+				t.Errorf("normalizeReleaseChannel(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestEquateZeroStructs(t *testing.T) {
+	cases := map[string]struct {
+		a, b *container.Cluster
+		want bool
+	}{
+		"OmittedAndEmpty": {
+			a:    &container.Cluster{BinaryAuthorization: &container.BinaryAuthorization{}},
+			b:    &container.Cluster{},
+			want: true,
+		},
+		"EmptyAndOmitted": {
+			a:    &container.Cluster{},
+			b:    &container.Cluster{NetworkPolicy: &container.NetworkPolicy{}},
+			want: true,
+		},
+		"OnlyForceSendFields": {
+			a:    &container.Cluster{LegacyAbac: &container.LegacyAbac{ForceSendFields: []string{"Enabled"}}},
+			b:    &container.Cluster{},
+			want: true,
+		},
+		"OmittedAndSet": {
+			a:    &container.Cluster{BinaryAuthorization: &container.BinaryAuthorization{Enabled: true}},
+			b:    &container.Cluster{},
+			want: false,
+		},
+		"BothSetDifferently": {
+			a:    &container.Cluster{LegacyAbac: &container.LegacyAbac{Enabled: true}},
+			b:    &container.Cluster{LegacyAbac: &container.LegacyAbac{}},
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := cmp.Equal(tc.a, tc.b, equateZeroStructs())
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("cmp.Equal(..., equateZeroStructs()): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNormalizeLoggingVariant(t *testing.T) {
+	cases := map[string]struct {
+		in   string
+		want string
+	}{
+		"Omitted":     {in: "", want: loggingVariantDefault},
+		"Unspecified": {in: loggingVariantUnspecified, want: loggingVariantDefault},
+		"Default":     {in: loggingVariantDefault, want: loggingVariantDefault},
+		"MaxThroughput": {
+			in:   "MAX_THROUGHPUT",
+			want: "MAX_THROUGHPUT",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, normalizeLoggingVariant(tc.in)); diff != "" {
+				t.Errorf("normalizeLoggingVariant(%q): -want, +got:\n%s", tc.in, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,122 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	container "google.golang.org/api/container/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/container/v1beta2"
+)
+
+// ObserveReleaseChannels returns the versions GKE offers on each release
+// channel according to the supplied server config.
+func ObserveReleaseChannels(cfg *container.ServerConfig) []*v1beta2.ReleaseChannelStatus {
+	if cfg == nil || len(cfg.Channels) == 0 {
+		return nil
+	}
+	out := make([]*v1beta2.ReleaseChannelStatus, 0, len(cfg.Channels))
+	for _, c := range cfg.Channels {
+		if c == nil {
+			continue
+		}
+		out = append(out, &v1beta2.ReleaseChannelStatus{
+			Channel:        c.Channel,
+			DefaultVersion: c.DefaultVersion,
+			ValidVersions:  c.ValidVersions,
+		})
+	}
+	return out
+}
+
+// minorVersion is the major and minor version of a GKE version, e.g. 1.27
+// of "1.27.3-gke.100".
+type minorVersion [2]int
+
+func (v minorVersion) less(o minorVersion) bool {
+	return v[0] < o[0] || (v[0] == o[0] && v[1] < o[1])
+}
+
+func (v minorVersion) String() string {
+	return fmt.Sprintf("%d.%d", v[0], v[1])
+}
+
+// parseMinorVersion returns the minor version of the supplied GKE version,
+// and false if it is not a version, e.g. an alias like "latest".
+func parseMinorVersion(version string) (minorVersion, bool) {
+	p := strings.SplitN(version, ".", 3)
+	if len(p) < 2 {
+		return minorVersion{}, false
+	}
+	major, err := strconv.Atoi(p[0])
+	if err != nil {
+		return minorVersion{}, false
+	}
+	minor, err := strconv.Atoi(strings.SplitN(p[1], "-", 2)[0])
+	if err != nil {
+		return minorVersion{}, false
+	}
+	return minorVersion{major, minor}, true
+}
+
+// VersionSupport returns a condition that indicates whether the master version
+// of the supplied cluster is nearing or past the end of its support, according
+// to the supplied server config. A version is nearing the end of its support
+// once its minor version is the oldest GKE offers on the cluster's release
+// channel, or to clusters on no channel. It returns false if the versions GKE
+// offers are unknown.
+func VersionSupport(in container.Cluster, cfg *container.ServerConfig) (xpv1.Condition, bool) {
+	current, ok := parseMinorVersion(in.CurrentMasterVersion)
+	if !ok || cfg == nil {
+		return xpv1.Condition{}, false
+	}
+	channel := releaseChannelUnspecified
+	if in.ReleaseChannel != nil && in.ReleaseChannel.Channel != "" {
+		channel = in.ReleaseChannel.Channel
+	}
+	valid := cfg.ValidMasterVersions
+	if channel != releaseChannelUnspecified {
+		valid = nil
+		for _, c := range cfg.Channels {
+			if c != nil && c.Channel == channel {
+				valid = c.ValidVersions
+			}
+		}
+	}
+	var oldest *minorVersion
+	for _, version := range valid {
+		v, ok := parseMinorVersion(version)
+		if ok && (oldest == nil || v.less(*oldest)) {
+			oldest = &v
+		}
+	}
+	if oldest == nil {
+		return xpv1.Condition{}, false
+	}
+	switch {
+	case current.less(*oldest):
+		return v1beta2.VersionEndOfSupport(fmt.Sprintf("version %s is older than %s, the oldest version GKE offers on release channel %s", current, oldest, channel)), true
+	case current == *oldest:
+		return v1beta2.VersionNearingEndOfSupport(fmt.Sprintf("version %s is the oldest version GKE offers on release channel %s", current, channel)), true
+	}
+	return v1beta2.VersionSupported(), true
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	container "google.golang.org/api/container/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/container/v1beta2"
+)
+
+func serverConfig() *container.ServerConfig {
+	return &container.ServerConfig{
+		ValidMasterVersions: []string{"1.27.3-gke.100", "1.26.5-gke.1200", "1.25.10-gke.2700"},
+		Channels: []*container.ReleaseChannelConfig{
+			{Channel: "RAPID", DefaultVersion: "1.27.3-gke.100", ValidVersions: []string{"1.27.3-gke.100"}},
+			{Channel: "REGULAR", DefaultVersion: "1.26.5-gke.1200", ValidVersions: []string{"1.27.2-gke.1200", "1.26.5-gke.1200"}},
+		},
+	}
+}
+
+func TestObserveReleaseChannels(t *testing.T) {
+	want := []*v1beta2.ReleaseChannelStatus{
+		{Channel: "RAPID", DefaultVersion: "1.27.3-gke.100", ValidVersions: []string{"1.27.3-gke.100"}},
+		{Channel: "REGULAR", DefaultVersion: "1.26.5-gke.1200", ValidVersions: []string{"1.27.2-gke.1200", "1.26.5-gke.1200"}},
+	}
+	if diff := cmp.Diff(want, ObserveReleaseChannels(serverConfig())); diff != "" {
+		t.Errorf("ObserveReleaseChannels(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestVersionSupport(t *testing.T) {
+	type want struct {
+		c  xpv1.Condition
+		ok bool
+	}
+	cases := map[string]struct {
+		in   container.Cluster
+		cfg  *container.ServerConfig
+		want want
+	}{
+		"Supported": {
+			in:   container.Cluster{CurrentMasterVersion: "1.27.2-gke.1200", ReleaseChannel: &container.ReleaseChannel{Channel: "REGULAR"}},
+			cfg:  serverConfig(),
+			want: want{c: v1beta2.VersionSupported(), ok: true},
+		},
+		"NearingEndOfSupport": {
+			in:   container.Cluster{CurrentMasterVersion: "1.26.4-gke.500", ReleaseChannel: &container.ReleaseChannel{Channel: "REGULAR"}},
+			cfg:  serverConfig(),
+			want: want{c: v1beta2.VersionNearingEndOfSupport("version 1.26 is the oldest version GKE offers on release channel REGULAR"), ok: true},
+		},
+		"EndOfSupport": {
+			in:   container.Cluster{CurrentMasterVersion: "1.26.5-gke.1200", ReleaseChannel: &container.ReleaseChannel{Channel: "RAPID"}},
+			cfg:  serverConfig(),
+			want: want{c: v1beta2.VersionEndOfSupport("version 1.26 is older than 1.27, the oldest version GKE offers on release channel RAPID"), ok: true},
+		},
+		"NoChannel": {
+			in:   container.Cluster{CurrentMasterVersion: "1.25.10-gke.2700"},
+			cfg:  serverConfig(),
+			want: want{c: v1beta2.VersionNearingEndOfSupport("version 1.25 is the oldest version GKE offers on release channel UNSPECIFIED"), ok: true},
+		},
+		"UnknownChannel": {
+			in:  container.Cluster{CurrentMasterVersion: "1.27.2-gke.1200", ReleaseChannel: &container.ReleaseChannel{Channel: "STABLE"}},
+			cfg: serverConfig(),
+		},
+		"NoVersion": {
+			cfg: serverConfig(),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c, ok := VersionSupport(tc.in, tc.cfg)
+			if diff := cmp.Diff(tc.want, want{c: c, ok: ok}, cmp.AllowUnexported(want{}), cmpopts.IgnoreFields(xpv1.Condition{}, "LastTransitionTime")); diff != "" {
+				t.Errorf("VersionSupport(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
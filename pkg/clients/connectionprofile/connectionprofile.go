@@ -0,0 +1,151 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package connectionprofile
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	datastream "google.golang.org/api/datastream/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/datastream/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	parentFormat = "projects/%s/locations/%s"
+	nameFormat   = "projects/%s/locations/%s/connectionProfiles/%s"
+)
+
+// GetParent returns the location the ConnectionProfile lives under.
+func GetParent(projectID, location string) string {
+	return fmt.Sprintf(parentFormat, projectID, location)
+}
+
+// GetFullyQualifiedName builds the relative resource name of the
+// ConnectionProfile.
+func GetFullyQualifiedName(projectID, location, name string) string {
+	return fmt.Sprintf(nameFormat, projectID, location, name)
+}
+
+// GenerateConnectionProfile produces a ConnectionProfile that is configured
+// via given ConnectionProfileParameters. The password is only used by MySQL
+// and PostgreSQL profiles.
+func GenerateConnectionProfile(name string, p v1alpha1.ConnectionProfileParameters, password string) *datastream.ConnectionProfile {
+	cp := &datastream.ConnectionProfile{
+		Name:        name,
+		DisplayName: p.DisplayName,
+		Labels:      p.Labels,
+	}
+	if p.MySQLProfile != nil {
+		cp.MysqlProfile = &datastream.MysqlProfile{
+			Hostname: gcp.StringValue(p.MySQLProfile.Hostname),
+			Port:     gcp.Int64Value(p.MySQLProfile.Port),
+			Username: p.MySQLProfile.Username,
+			Password: password,
+		}
+	}
+	if p.PostgreSQLProfile != nil {
+		cp.PostgresqlProfile = &datastream.PostgresqlProfile{
+			Hostname: gcp.StringValue(p.PostgreSQLProfile.Hostname),
+			Port:     gcp.Int64Value(p.PostgreSQLProfile.Port),
+			Username: p.PostgreSQLProfile.Username,
+			Password: password,
+			Database: p.PostgreSQLProfile.Database,
+		}
+	}
+	if p.GCSProfile != nil {
+		cp.GcsProfile = &datastream.GcsProfile{
+			Bucket:   gcp.StringValue(p.GCSProfile.Bucket),
+			RootPath: gcp.StringValue(p.GCSProfile.RootPath),
+		}
+	}
+	if p.BigQueryProfile != nil {
+		cp.BigqueryProfile = &datastream.BigQueryProfile{}
+	}
+	if p.StaticServiceIPConnectivity != nil {
+		cp.StaticServiceIpConnectivity = &datastream.StaticServiceIpConnectivity{}
+	}
+	if p.PrivateConnectivity != nil {
+		cp.PrivateConnectivity = &datastream.PrivateConnectivity{
+			PrivateConnection: p.PrivateConnectivity.PrivateConnection,
+		}
+	}
+	return cp
+}
+
+// GenerateObservation produces a ConnectionProfileObservation from the
+// supplied ConnectionProfile.
+func GenerateObservation(cp datastream.ConnectionProfile) v1alpha1.ConnectionProfileObservation {
+	return v1alpha1.ConnectionProfileObservation{
+		Name:       cp.Name,
+		CreateTime: cp.CreateTime,
+		UpdateTime: cp.UpdateTime,
+	}
+}
+
+// LateInitialize fills the empty fields of ConnectionProfileParameters if the
+// corresponding fields are given in ConnectionProfile.
+func LateInitialize(p *v1alpha1.ConnectionProfileParameters, cp datastream.ConnectionProfile) {
+	if p.MySQLProfile != nil && cp.MysqlProfile != nil {
+		p.MySQLProfile.Port = gcp.LateInitializeInt64(p.MySQLProfile.Port, cp.MysqlProfile.Port)
+	}
+	if p.PostgreSQLProfile != nil && cp.PostgresqlProfile != nil {
+		p.PostgreSQLProfile.Port = gcp.LateInitializeInt64(p.PostgreSQLProfile.Port, cp.PostgresqlProfile.Port)
+	}
+	if p.GCSProfile != nil && cp.GcsProfile != nil {
+		p.GCSProfile.RootPath = gcp.LateInitializeString(p.GCSProfile.RootPath, cp.GcsProfile.RootPath)
+	}
+}
+
+// IsUpToDate checks whether ConnectionProfile is configured with given
+// ConnectionProfileParameters.
+func IsUpToDate(p v1alpha1.ConnectionProfileParameters, cp datastream.ConnectionProfile) bool {
+	return GenerateUpdateMask(p, cp) == ""
+}
+
+// GenerateUpdateMask returns the comma separated list of fields that differ
+// between ConnectionProfileParameters and ConnectionProfile. Passwords are
+// never returned by the API, so they are not compared.
+func GenerateUpdateMask(p v1alpha1.ConnectionProfileParameters, cp datastream.ConnectionProfile) string {
+	desired := GenerateConnectionProfile(cp.Name, p, "")
+	mask := []string{}
+	if desired.DisplayName != cp.DisplayName {
+		mask = append(mask, "displayName")
+	}
+	if !cmp.Equal(desired.Labels, cp.Labels, cmpopts.EquateEmpty()) {
+		mask = append(mask, "labels")
+	}
+	if !cmp.Equal(desired.MysqlProfile, cp.MysqlProfile, cmpopts.IgnoreFields(datastream.MysqlProfile{}, "Password", "SslConfig")) {
+		mask = append(mask, "mysqlProfile")
+	}
+	if !cmp.Equal(desired.PostgresqlProfile, cp.PostgresqlProfile, cmpopts.IgnoreFields(datastream.PostgresqlProfile{}, "Password")) {
+		mask = append(mask, "postgresqlProfile")
+	}
+	if !cmp.Equal(desired.GcsProfile, cp.GcsProfile) {
+		mask = append(mask, "gcsProfile")
+	}
+	if !cmp.Equal(desired.StaticServiceIpConnectivity, cp.StaticServiceIpConnectivity) {
+		mask = append(mask, "staticServiceIpConnectivity")
+	}
+	if !cmp.Equal(desired.PrivateConnectivity, cp.PrivateConnectivity) {
+		mask = append(mask, "privateConnectivity")
+	}
+	return strings.Join(mask, ",")
+}
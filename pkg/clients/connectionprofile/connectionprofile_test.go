@@ -0,0 +1,130 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package connectionprofile
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	datastream "google.golang.org/api/datastream/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/datastream/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const testName = "projects/foo/locations/us-central1/connectionProfiles/orders"
+
+func params(m ...func(*v1alpha1.ConnectionProfileParameters)) *v1alpha1.ConnectionProfileParameters {
+	p := &v1alpha1.ConnectionProfileParameters{
+		Location:    "us-central1",
+		DisplayName: "orders",
+		MySQLProfile: &v1alpha1.MySQLProfile{
+			Hostname: gcp.StringPtr("10.0.0.3"),
+			Username: "datastream",
+			PasswordSecretRef: xpv1.SecretKeySelector{
+				SecretReference: xpv1.SecretReference{Name: "orders", Namespace: "crossplane-system"},
+				Key:             "password",
+			},
+		},
+		StaticServiceIPConnectivity: &v1alpha1.StaticServiceIPConnectivity{},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func connectionProfile(m ...func(*datastream.ConnectionProfile)) *datastream.ConnectionProfile {
+	cp := &datastream.ConnectionProfile{
+		Name:        testName,
+		DisplayName: "orders",
+		MysqlProfile: &datastream.MysqlProfile{
+			Hostname: "10.0.0.3",
+			Port:     3306,
+			Username: "datastream",
+		},
+		StaticServiceIpConnectivity: &datastream.StaticServiceIpConnectivity{},
+	}
+	for _, f := range m {
+		f(cp)
+	}
+	return cp
+}
+
+func TestGenerateConnectionProfile(t *testing.T) {
+	want := connectionProfile(func(cp *datastream.ConnectionProfile) {
+		cp.MysqlProfile.Port = 0
+		cp.MysqlProfile.Password = "s3cr3t"
+	})
+	if diff := cmp.Diff(want, GenerateConnectionProfile(testName, *params(), "s3cr3t")); diff != "" {
+		t.Errorf("GenerateConnectionProfile(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitialize(t *testing.T) {
+	p := params()
+	LateInitialize(p, *connectionProfile())
+	want := params(func(p *v1alpha1.ConnectionProfileParameters) {
+		p.MySQLProfile.Port = gcp.Int64Ptr(3306)
+	})
+	if diff := cmp.Diff(want, p); diff != "" {
+		t.Errorf("LateInitialize(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateUpdateMask(t *testing.T) {
+	cases := map[string]struct {
+		p    *v1alpha1.ConnectionProfileParameters
+		cp   *datastream.ConnectionProfile
+		want string
+	}{
+		"UpToDate": {
+			p: params(func(p *v1alpha1.ConnectionProfileParameters) {
+				p.MySQLProfile.Port = gcp.Int64Ptr(3306)
+			}),
+			cp:   connectionProfile(),
+			want: "",
+		},
+		"HostnameChanged": {
+			p: params(func(p *v1alpha1.ConnectionProfileParameters) {
+				p.MySQLProfile.Port = gcp.Int64Ptr(3306)
+				p.MySQLProfile.Hostname = gcp.StringPtr("10.0.0.4")
+			}),
+			cp:   connectionProfile(),
+			want: "mysqlProfile",
+		},
+		"ConnectivityAndLabelsChanged": {
+			p: params(func(p *v1alpha1.ConnectionProfileParameters) {
+				p.MySQLProfile.Port = gcp.Int64Ptr(3306)
+				p.Labels = map[string]string{"team": "orders"}
+				p.StaticServiceIPConnectivity = nil
+				p.PrivateConnectivity = &v1alpha1.PrivateConnectivity{PrivateConnection: "projects/foo/locations/us-central1/privateConnections/vpc"}
+			}),
+			cp:   connectionProfile(),
+			want: "labels,staticServiceIpConnectivity,privateConnectivity",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, GenerateUpdateMask(*tc.p, *tc.cp)); diff != "" {
+				t.Errorf("GenerateUpdateMask(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
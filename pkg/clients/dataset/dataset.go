@@ -0,0 +1,118 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dataset
+
+import (
+	"fmt"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	bigquery "google.golang.org/api/bigquery/v2"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/bigquery/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	serviceAgentFormat = "service-%d@container-engine-robot.iam.gserviceaccount.com"
+
+	// RoleReader may read the tables of a dataset.
+	RoleReader = "READER"
+	// RoleWriter may create tables in a dataset and write to them.
+	RoleWriter = "WRITER"
+	// RoleOwner may additionally manage the dataset itself.
+	RoleOwner = "OWNER"
+)
+
+// GenerateDataset produces a Dataset that is configured via given
+// DatasetParameters.
+func GenerateDataset(name string, p v1alpha1.DatasetParameters) *bigquery.Dataset {
+	return &bigquery.Dataset{
+		DatasetReference:         &bigquery.DatasetReference{DatasetId: name},
+		Location:                 p.Location,
+		FriendlyName:             gcp.StringValue(p.FriendlyName),
+		Description:              gcp.StringValue(p.Description),
+		Labels:                   p.Labels,
+		DefaultTableExpirationMs: gcp.Int64Value(p.DefaultTableExpirationMs),
+	}
+}
+
+// GenerateObservation produces a DatasetObservation from the supplied
+// Dataset.
+func GenerateObservation(ds bigquery.Dataset) v1alpha1.DatasetObservation {
+	return v1alpha1.DatasetObservation{
+		ID:               ds.Id,
+		SelfLink:         ds.SelfLink,
+		CreationTime:     ds.CreationTime,
+		LastModifiedTime: ds.LastModifiedTime,
+	}
+}
+
+// LateInitialize fills the empty fields of DatasetParameters if the
+// corresponding fields are given in Dataset.
+func LateInitialize(p *v1alpha1.DatasetParameters, ds bigquery.Dataset) {
+	p.FriendlyName = gcp.LateInitializeString(p.FriendlyName, ds.FriendlyName)
+	p.Description = gcp.LateInitializeString(p.Description, ds.Description)
+	p.Labels = gcp.LateInitializeStringMap(p.Labels, ds.Labels)
+	p.DefaultTableExpirationMs = gcp.LateInitializeInt64(p.DefaultTableExpirationMs, ds.DefaultTableExpirationMs)
+}
+
+// GrantsServiceAgentAccess returns true if the GKE service agent should be
+// granted access to a Dataset configured via the supplied DatasetParameters.
+func GrantsServiceAgentAccess(p v1alpha1.DatasetParameters) bool {
+	return p.GKEUsageMetering != nil && (p.GKEUsageMetering.GrantServiceAgentAccess == nil || *p.GKEUsageMetering.GrantServiceAgentAccess)
+}
+
+// ServiceAgent returns the email of the GKE service agent of the project with
+// the supplied number.
+func ServiceAgent(projectNumber int64) string {
+	return fmt.Sprintf(serviceAgentFormat, projectNumber)
+}
+
+// HasWriter returns true if the supplied email may write to a Dataset with
+// the supplied access.
+func HasWriter(access []*bigquery.DatasetAccess, email string) bool {
+	for _, a := range access {
+		if a != nil && a.UserByEmail == email && (a.Role == RoleWriter || a.Role == RoleOwner) {
+			return true
+		}
+	}
+	return false
+}
+
+// WithWriter returns the supplied access with WRITER access granted to the
+// supplied email.
+func WithWriter(access []*bigquery.DatasetAccess, email string) []*bigquery.DatasetAccess {
+	if HasWriter(access, email) {
+		return access
+	}
+	return append(access, &bigquery.DatasetAccess{Role: RoleWriter, UserByEmail: email})
+}
+
+// IsUpToDate checks whether Dataset is configured with given
+// DatasetParameters. The supplied service agent must be able to write to the
+// Dataset unless it is empty.
+func IsUpToDate(p v1alpha1.DatasetParameters, ds bigquery.Dataset, serviceAgent string) bool {
+	desired := GenerateDataset("", p)
+	if desired.FriendlyName != ds.FriendlyName || desired.Description != ds.Description || desired.DefaultTableExpirationMs != ds.DefaultTableExpirationMs {
+		return false
+	}
+	if !cmp.Equal(desired.Labels, ds.Labels, cmpopts.EquateEmpty()) {
+		return false
+	}
+	return serviceAgent == "" || HasWriter(ds.Access, serviceAgent)
+}
@@ -0,0 +1,177 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dataset
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	bigquery "google.golang.org/api/bigquery/v2"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/bigquery/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	testName  = "gke_usage"
+	testAgent = "service-123456789@container-engine-robot.iam.gserviceaccount.com"
+)
+
+func params(m ...func(*v1alpha1.DatasetParameters)) *v1alpha1.DatasetParameters {
+	p := &v1alpha1.DatasetParameters{
+		Location:    "US",
+		Description: gcp.StringPtr("GKE usage metering"),
+		Labels:      map[string]string{"team": "platform"},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func dataset(m ...func(*bigquery.Dataset)) *bigquery.Dataset {
+	ds := &bigquery.Dataset{
+		DatasetReference: &bigquery.DatasetReference{DatasetId: testName},
+		Location:         "US",
+		Description:      "GKE usage metering",
+		Labels:           map[string]string{"team": "platform"},
+		Access: []*bigquery.DatasetAccess{
+			{Role: RoleOwner, SpecialGroup: "projectOwners"},
+		},
+	}
+	for _, f := range m {
+		f(ds)
+	}
+	return ds
+}
+
+func TestGenerateDataset(t *testing.T) {
+	want := dataset(func(ds *bigquery.Dataset) {
+		ds.Access = nil
+	})
+	if diff := cmp.Diff(want, GenerateDataset(testName, *params())); diff != "" {
+		t.Errorf("GenerateDataset(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestServiceAgent(t *testing.T) {
+	if diff := cmp.Diff(testAgent, ServiceAgent(123456789)); diff != "" {
+		t.Errorf("ServiceAgent(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGrantsServiceAgentAccess(t *testing.T) {
+	cases := map[string]struct {
+		params *v1alpha1.DatasetParameters
+		want   bool
+	}{
+		"NoUsageMetering": {
+			params: params(),
+			want:   false,
+		},
+		"DefaultsToTrue": {
+			params: params(func(p *v1alpha1.DatasetParameters) {
+				p.GKEUsageMetering = &v1alpha1.GKEUsageMetering{}
+			}),
+			want: true,
+		},
+		"Disabled": {
+			params: params(func(p *v1alpha1.DatasetParameters) {
+				p.GKEUsageMetering = &v1alpha1.GKEUsageMetering{GrantServiceAgentAccess: gcp.BoolPtr(false)}
+			}),
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, GrantsServiceAgentAccess(*tc.params)); diff != "" {
+				t.Errorf("GrantsServiceAgentAccess(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestWithWriter(t *testing.T) {
+	writer := &bigquery.DatasetAccess{Role: RoleWriter, UserByEmail: testAgent}
+	cases := map[string]struct {
+		access []*bigquery.DatasetAccess
+		want   []*bigquery.DatasetAccess
+	}{
+		"Granted": {
+			access: dataset().Access,
+			want:   append(dataset().Access, writer),
+		},
+		"AlreadyGranted": {
+			access: append(dataset().Access, writer),
+			want:   append(dataset().Access, writer),
+		},
+		"AlreadyOwner": {
+			access: []*bigquery.DatasetAccess{{Role: RoleOwner, UserByEmail: testAgent}},
+			want:   []*bigquery.DatasetAccess{{Role: RoleOwner, UserByEmail: testAgent}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, WithWriter(tc.access, testAgent)); diff != "" {
+				t.Errorf("WithWriter(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		params *v1alpha1.DatasetParameters
+		obs    *bigquery.Dataset
+		agent  string
+		want   bool
+	}{
+		"UpToDate": {
+			params: params(),
+			obs:    dataset(),
+			want:   true,
+		},
+		"DescriptionChanged": {
+			params: params(func(p *v1alpha1.DatasetParameters) {
+				p.Description = gcp.StringPtr("usage")
+			}),
+			obs:  dataset(),
+			want: false,
+		},
+		"ServiceAgentMissing": {
+			params: params(),
+			obs:    dataset(),
+			agent:  testAgent,
+			want:   false,
+		},
+		"ServiceAgentGranted": {
+			params: params(),
+			obs: dataset(func(ds *bigquery.Dataset) {
+				ds.Access = WithWriter(ds.Access, testAgent)
+			}),
+			agent: testAgent,
+			want:  true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsUpToDate(*tc.params, *tc.obs, tc.agent)); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datasetiammember
+
+import (
+	"strings"
+
+	bigquery "google.golang.org/api/bigquery/v2"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/bigquery/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/dataset"
+)
+
+// BigQuery reports these IAM roles as their equivalent basic dataset roles.
+var basicRoles = map[string]string{
+	"roles/bigquery.dataViewer": dataset.RoleReader,
+	"roles/bigquery.dataEditor": dataset.RoleWriter,
+	"roles/bigquery.dataOwner":  dataset.RoleOwner,
+}
+
+// Members that are special groups of dataset access.
+var specialGroups = map[string]bool{
+	"allAuthenticatedUsers": true,
+	"projectOwners":         true,
+	"projectReaders":        true,
+	"projectWriters":        true,
+}
+
+// AccessEntry returns the dataset access entry that grants the role of the
+// supplied DatasetIAMMemberParameters to its member.
+func AccessEntry(in v1alpha1.DatasetIAMMemberParameters) *bigquery.DatasetAccess {
+	a := &bigquery.DatasetAccess{Role: in.Role}
+	if r, ok := basicRoles[in.Role]; ok {
+		a.Role = r
+	}
+	member := gcp.StringValue(in.Member)
+	kind, id, _ := strings.Cut(member, ":")
+	switch {
+	case kind == "user" || kind == "serviceAccount":
+		a.UserByEmail = id
+	case kind == "group":
+		a.GroupByEmail = id
+	case kind == "domain":
+		a.Domain = id
+	case specialGroups[member]:
+		a.SpecialGroup = member
+	default:
+		a.IamMember = member
+	}
+	return a
+}
+
+// sameEntry returns true if the supplied access entries grant the same role
+// to the same member. Email addresses are compared case insensitively, as
+// BigQuery may change their case.
+func sameEntry(a, b *bigquery.DatasetAccess) bool {
+	return a.Role == b.Role &&
+		strings.EqualFold(a.UserByEmail, b.UserByEmail) &&
+		strings.EqualFold(a.GroupByEmail, b.GroupByEmail) &&
+		a.Domain == b.Domain &&
+		a.SpecialGroup == b.SpecialGroup &&
+		a.IamMember == b.IamMember
+}
+
+// HasAccess returns true if the supplied access contains the supplied entry.
+func HasAccess(access []*bigquery.DatasetAccess, entry *bigquery.DatasetAccess) bool {
+	for _, a := range access {
+		if a != nil && sameEntry(a, entry) {
+			return true
+		}
+	}
+	return false
+}
+
+// WithAccess returns the supplied access with the supplied entry added.
+func WithAccess(access []*bigquery.DatasetAccess, entry *bigquery.DatasetAccess) []*bigquery.DatasetAccess {
+	if HasAccess(access, entry) {
+		return access
+	}
+	return append(access, entry)
+}
+
+// WithoutAccess returns the supplied access without the supplied entry, and
+// whether it contained the entry.
+func WithoutAccess(access []*bigquery.DatasetAccess, entry *bigquery.DatasetAccess) ([]*bigquery.DatasetAccess, bool) {
+	out := make([]*bigquery.DatasetAccess, 0, len(access))
+	for _, a := range access {
+		if a != nil && sameEntry(a, entry) {
+			continue
+		}
+		out = append(out, a)
+	}
+	return out, len(out) != len(access)
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datasetiammember
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	bigquery "google.golang.org/api/bigquery/v2"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/bigquery/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+func TestAccessEntry(t *testing.T) {
+	cases := map[string]struct {
+		in   v1alpha1.DatasetIAMMemberParameters
+		want *bigquery.DatasetAccess
+	}{
+		"User": {
+			in:   v1alpha1.DatasetIAMMemberParameters{Role: "READER", Member: gcp.StringPtr("user:alice@example.com")},
+			want: &bigquery.DatasetAccess{Role: "READER", UserByEmail: "alice@example.com"},
+		},
+		"ServiceAccount": {
+			in:   v1alpha1.DatasetIAMMemberParameters{Role: "roles/bigquery.dataEditor", Member: gcp.StringPtr("serviceAccount:app@my-project.iam.gserviceaccount.com")},
+			want: &bigquery.DatasetAccess{Role: "WRITER", UserByEmail: "app@my-project.iam.gserviceaccount.com"},
+		},
+		"Group": {
+			in:   v1alpha1.DatasetIAMMemberParameters{Role: "roles/bigquery.dataViewer", Member: gcp.StringPtr("group:analysts@example.com")},
+			want: &bigquery.DatasetAccess{Role: "READER", GroupByEmail: "analysts@example.com"},
+		},
+		"Domain": {
+			in:   v1alpha1.DatasetIAMMemberParameters{Role: "READER", Member: gcp.StringPtr("domain:example.com")},
+			want: &bigquery.DatasetAccess{Role: "READER", Domain: "example.com"},
+		},
+		"SpecialGroup": {
+			in:   v1alpha1.DatasetIAMMemberParameters{Role: "READER", Member: gcp.StringPtr("allAuthenticatedUsers")},
+			want: &bigquery.DatasetAccess{Role: "READER", SpecialGroup: "allAuthenticatedUsers"},
+		},
+		"IAMMember": {
+			in:   v1alpha1.DatasetIAMMemberParameters{Role: "roles/bigquery.metadataViewer", Member: gcp.StringPtr("principalSet://iam.googleapis.com/locations/global/workforcePools/pool/*")},
+			want: &bigquery.DatasetAccess{Role: "roles/bigquery.metadataViewer", IamMember: "principalSet://iam.googleapis.com/locations/global/workforcePools/pool/*"},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, AccessEntry(tc.in)); diff != "" {
+				t.Errorf("AccessEntry(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestWithoutAccess(t *testing.T) {
+	owner := &bigquery.DatasetAccess{Role: "OWNER", SpecialGroup: "projectOwners"}
+	reader := &bigquery.DatasetAccess{Role: "READER", UserByEmail: "Alice@example.com"}
+
+	type want struct {
+		access  []*bigquery.DatasetAccess
+		changed bool
+	}
+	cases := map[string]struct {
+		access []*bigquery.DatasetAccess
+		entry  *bigquery.DatasetAccess
+		want   want
+	}{
+		"Removed": {
+			access: []*bigquery.DatasetAccess{owner, reader},
+			entry:  &bigquery.DatasetAccess{Role: "READER", UserByEmail: "alice@example.com"},
+			want:   want{access: []*bigquery.DatasetAccess{owner}, changed: true},
+		},
+		"OtherRole": {
+			access: []*bigquery.DatasetAccess{owner, reader},
+			entry:  &bigquery.DatasetAccess{Role: "WRITER", UserByEmail: "alice@example.com"},
+			want:   want{access: []*bigquery.DatasetAccess{owner, reader}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			access, changed := WithoutAccess(tc.access, tc.entry)
+			if diff := cmp.Diff(tc.want.access, access); diff != "" {
+				t.Errorf("WithoutAccess(...): -want access, +got access:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.changed, changed); diff != "" {
+				t.Errorf("WithoutAccess(...): -want changed, +got changed:\n%s", diff)
+			}
+		})
+	}
+}
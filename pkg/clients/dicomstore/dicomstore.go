@@ -0,0 +1,84 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dicomstore
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	healthcare "google.golang.org/api/healthcare/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/healthcare/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/fhirstore"
+)
+
+const (
+	nameFormat = "%s/dicomStores/%s"
+)
+
+// GetFullyQualifiedName builds the relative resource name of a DICOMStore in
+// the supplied dataset.
+func GetFullyQualifiedName(dataset, name string) string {
+	return fmt.Sprintf(nameFormat, dataset, name)
+}
+
+// GenerateDICOMStore produces a DicomStore that is configured via given
+// DICOMStoreParameters.
+func GenerateDICOMStore(name string, p v1alpha1.DICOMStoreParameters) *healthcare.DicomStore {
+	return &healthcare.DicomStore{
+		Name:               name,
+		NotificationConfig: fhirstore.GenerateNotificationConfig(p.NotificationConfig),
+		Labels:             p.Labels,
+	}
+}
+
+// GenerateObservation produces a DICOMStoreObservation from the supplied
+// DicomStore.
+func GenerateObservation(s healthcare.DicomStore) v1alpha1.DICOMStoreObservation {
+	return v1alpha1.DICOMStoreObservation{
+		Name: s.Name,
+	}
+}
+
+// LateInitialize fills the empty fields of DICOMStoreParameters if the
+// corresponding fields are given in DicomStore.
+func LateInitialize(p *v1alpha1.DICOMStoreParameters, s healthcare.DicomStore) {
+	p.Labels = gcp.LateInitializeStringMap(p.Labels, s.Labels)
+}
+
+// IsUpToDate checks whether DicomStore is configured with given
+// DICOMStoreParameters.
+func IsUpToDate(p v1alpha1.DICOMStoreParameters, s healthcare.DicomStore) bool {
+	return GenerateUpdateMask(p, s) == ""
+}
+
+// GenerateUpdateMask returns the comma separated list of the updatable fields
+// that differ between DICOMStoreParameters and DicomStore.
+func GenerateUpdateMask(p v1alpha1.DICOMStoreParameters, s healthcare.DicomStore) string {
+	desired := GenerateDICOMStore(s.Name, p)
+	mask := []string{}
+	if !cmp.Equal(desired.NotificationConfig, s.NotificationConfig, cmpopts.EquateEmpty()) {
+		mask = append(mask, "notificationConfig")
+	}
+	if !cmp.Equal(desired.Labels, s.Labels, cmpopts.EquateEmpty()) {
+		mask = append(mask, "labels")
+	}
+	return strings.Join(mask, ",")
+}
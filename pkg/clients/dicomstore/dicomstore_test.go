@@ -0,0 +1,131 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dicomstore
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	healthcare "google.golang.org/api/healthcare/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/healthcare/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const storeName = "projects/fooproject/locations/us-central1/datasets/foo/dicomStores/bar"
+
+func params(m ...func(*v1alpha1.DICOMStoreParameters)) *v1alpha1.DICOMStoreParameters {
+	p := &v1alpha1.DICOMStoreParameters{
+		Dataset:            gcp.StringPtr("projects/fooproject/locations/us-central1/datasets/foo"),
+		NotificationConfig: &v1alpha1.NotificationConfig{PubsubTopic: gcp.StringPtr("projects/fooproject/topics/baz")},
+		Labels:             map[string]string{"foo": "bar"},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func observed(m ...func(*healthcare.DicomStore)) *healthcare.DicomStore {
+	s := &healthcare.DicomStore{
+		Name:               storeName,
+		NotificationConfig: &healthcare.NotificationConfig{PubsubTopic: "projects/fooproject/topics/baz"},
+		Labels:             map[string]string{"foo": "bar"},
+	}
+	for _, f := range m {
+		f(s)
+	}
+	return s
+}
+
+func TestGenerateDICOMStore(t *testing.T) {
+	got := GenerateDICOMStore(storeName, *params())
+	if diff := cmp.Diff(observed(), got); diff != "" {
+		t.Errorf("GenerateDICOMStore(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitialize(t *testing.T) {
+	cases := map[string]struct {
+		params   *v1alpha1.DICOMStoreParameters
+		observed *healthcare.DicomStore
+		want     *v1alpha1.DICOMStoreParameters
+	}{
+		"AllFilled": {
+			params:   params(),
+			observed: observed(),
+			want:     params(),
+		},
+		"LabelsEmpty": {
+			params: params(func(p *v1alpha1.DICOMStoreParameters) {
+				p.Labels = nil
+			}),
+			observed: observed(),
+			want:     params(),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitialize(tc.params, *tc.observed)
+			if diff := cmp.Diff(tc.want, tc.params); diff != "" {
+				t.Errorf("LateInitialize(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateUpdateMask(t *testing.T) {
+	cases := map[string]struct {
+		params   *v1alpha1.DICOMStoreParameters
+		observed *healthcare.DicomStore
+		want     string
+	}{
+		"UpToDate": {
+			params:   params(),
+			observed: observed(),
+			want:     "",
+		},
+		"EmptyLabels": {
+			params: params(func(p *v1alpha1.DICOMStoreParameters) {
+				p.Labels = map[string]string{}
+			}),
+			observed: observed(func(s *healthcare.DicomStore) {
+				s.Labels = nil
+			}),
+			want: "",
+		},
+		"Outdated": {
+			params: params(func(p *v1alpha1.DICOMStoreParameters) {
+				p.NotificationConfig = nil
+				p.Labels = map[string]string{"foo": "baz"}
+			}),
+			observed: observed(),
+			want:     "notificationConfig,labels",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateUpdateMask(*tc.params, *tc.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateUpdateMask(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want == "", IsUpToDate(*tc.params, *tc.observed)); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -18,6 +18,7 @@ package dns
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
@@ -44,6 +45,14 @@ func LateInitializeSpec(spec *v1alpha1.ResourceRecordSetParameters, external dns
 	}
 }
 
+// GenerateObservation produces a ResourceRecordSetObservation from the
+// supplied ResourceRecordSet of the supplied project and managed zone.
+func GenerateObservation(projectID, managedZone string, rrs dns.ResourceRecordSet) v1alpha1.ResourceRecordSetObservation {
+	return v1alpha1.ResourceRecordSetObservation{
+		ID: fmt.Sprintf("projects/%s/managedZones/%s/rrsets/%s/%s", projectID, managedZone, rrs.Name, rrs.Type),
+	}
+}
+
 // GenerateResourceRecordSet generates *dns.ResourceRecordSet instance from ResourceRecordSetParameters.
 func GenerateResourceRecordSet(name string, spec v1alpha1.ResourceRecordSetParameters, rrs *dns.ResourceRecordSet) {
 	rrs.Kind = "dns#resourceRecordSet" // This is the only valid value for this field
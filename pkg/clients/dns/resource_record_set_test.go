@@ -139,6 +139,14 @@ func TestGenerateResourceRecordSet(t *testing.T) {
 	}
 }
 
+func TestGenerateObservation(t *testing.T) {
+	want := v1alpha1.ResourceRecordSetObservation{ID: "projects/cool-project/managedZones/crossplane-zone/rrsets/test.rrs/A"}
+	got := GenerateObservation("cool-project", "crossplane-zone", *resourceRecordSet())
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("GenerateObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
 func TestLateInitializeSpec(t *testing.T) {
 	type args struct {
 		spec     *v1alpha1.ResourceRecordSetParameters
@@ -0,0 +1,337 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"strings"
+
+	"google.golang.org/api/googleapi"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+)
+
+// An ErrorClass describes how a controller should react to an error returned
+// by a Google API.
+type ErrorClass int
+
+// Error classes.
+const (
+	// ErrorClassNone is the class of a nil error.
+	ErrorClassNone ErrorClass = iota
+
+	// ErrorClassUnknown is the class of errors that did not come from a
+	// Google API, e.g. errors produced while building a request.
+	ErrorClassUnknown
+
+	// ErrorClassNotFound indicates the external resource does not exist.
+	ErrorClassNotFound
+
+	// ErrorClassAlreadyExists indicates a create was rejected because an
+	// external resource with the same name already exists.
+	ErrorClassAlreadyExists
+
+	// ErrorClassConflict indicates a write was rejected because the etag,
+	// fingerprint or metageneration it was conditioned on was stale, or
+	// because it raced with a concurrent write. It is safe to re-read the
+	// external resource and try again.
+	ErrorClassConflict
+
+	// ErrorClassQuota indicates a request was rejected because a quota or
+	// rate limit was exceeded. It is safe to try again after backing off.
+	ErrorClassQuota
+
+	// ErrorClassRetryable indicates a transient failure of the Google API,
+	// e.g. an internal error or a timeout.
+	ErrorClassRetryable
+
+	// ErrorClassTerminal indicates a request that will not succeed until it
+	// is changed, e.g. because the API does not implement it.
+	ErrorClassTerminal
+
+	// ErrorClassInvalid indicates a request was rejected because it is
+	// invalid, e.g. because a field is malformed or out of range. It will not
+	// succeed until it is changed.
+	ErrorClassInvalid
+
+	// ErrorClassPermissionDenied indicates a request was rejected because the
+	// caller is not permitted to make it. It will not succeed until the
+	// caller is granted the missing permission.
+	ErrorClassPermissionDenied
+)
+
+// String returns a human readable name of the error class.
+func (c ErrorClass) String() string {
+	switch c {
+	case ErrorClassNone:
+		return "None"
+	case ErrorClassNotFound:
+		return "NotFound"
+	case ErrorClassAlreadyExists:
+		return "AlreadyExists"
+	case ErrorClassConflict:
+		return "Conflict"
+	case ErrorClassQuota:
+		return "Quota"
+	case ErrorClassRetryable:
+		return "Retryable"
+	case ErrorClassTerminal:
+		return "Terminal"
+	case ErrorClassInvalid:
+		return "Invalid"
+	case ErrorClassPermissionDenied:
+		return "PermissionDenied"
+	case ErrorClassUnknown:
+	}
+	return "Unknown"
+}
+
+// Temporary returns true if a request that failed with an error of the class
+// may succeed when it is retried unchanged.
+func (c ErrorClass) Temporary() bool {
+	return c == ErrorClassConflict || c == ErrorClassQuota || c == ErrorClassRetryable
+}
+
+// Terminal returns true if a request that failed with an error of the class
+// will not succeed until it is changed.
+func (c ErrorClass) Terminal() bool {
+	return c == ErrorClassTerminal || c == ErrorClassInvalid || c == ErrorClassPermissionDenied
+}
+
+// ClassifyError returns the class of the supplied error. Both REST and gRPC
+// Google API clients are supported.
+//
+// The Google APIs use HTTP 409 both for creates of a resource that already
+// exists and for writes that were aborted by a concurrent change. The two are
+// told apart using the canonical status and error reasons of the response; a
+// 409 that carries neither is assumed to be an "already exists" response.
+func ClassifyError(err error) ErrorClass {
+	if err == nil {
+		return ErrorClassNone
+	}
+	var gErr *googleapi.Error
+	if errors.As(err, &gErr) {
+		return classifyHTTP(gErr)
+	}
+	var sErr interface{ GRPCStatus() *status.Status }
+	if errors.As(err, &sErr) {
+		return classifyGRPC(sErr.GRPCStatus().Code())
+	}
+	var nErr net.Error
+	if errors.Is(err, context.DeadlineExceeded) || (errors.As(err, &nErr) && nErr.Timeout()) {
+		return ErrorClassRetryable
+	}
+	return ErrorClassUnknown
+}
+
+func classifyHTTP(e *googleapi.Error) ErrorClass { //nolint:gocyclo
+	// NOTE: This is a flat mapping of status codes and reasons that reads
+	// best as a single switch.
+	s := apiStatus(e)
+	switch {
+	case e.Code == http.StatusNotFound:
+		return ErrorClassNotFound
+	case e.Code == http.StatusConflict:
+		if s == "ABORTED" || hasReason(e, "aborted") {
+			return ErrorClassConflict
+		}
+		return ErrorClassAlreadyExists
+	case e.Code == http.StatusPreconditionFailed:
+		return ErrorClassConflict
+	case e.Code == http.StatusTooManyRequests:
+		return ErrorClassQuota
+	case e.Code == http.StatusForbidden && (s == "RESOURCE_EXHAUSTED" || hasReason(e, "ratelimitexceeded", "userratelimitexceeded", "quotaexceeded")):
+		return ErrorClassQuota
+	case e.Code == http.StatusBadRequest:
+		return ErrorClassInvalid
+	case e.Code == http.StatusUnauthorized || e.Code == http.StatusForbidden:
+		return ErrorClassPermissionDenied
+	case e.Code == http.StatusRequestTimeout:
+		return ErrorClassRetryable
+	case e.Code == http.StatusNotImplemented:
+		return ErrorClassTerminal
+	case e.Code >= http.StatusInternalServerError:
+		return ErrorClassRetryable
+	case e.Code >= http.StatusBadRequest:
+		return ErrorClassTerminal
+	}
+	return ErrorClassUnknown
+}
+
+func classifyGRPC(c codes.Code) ErrorClass {
+	switch c { //nolint:exhaustive
+	case codes.OK, codes.Canceled:
+		return ErrorClassUnknown
+	case codes.NotFound:
+		return ErrorClassNotFound
+	case codes.AlreadyExists:
+		return ErrorClassAlreadyExists
+	case codes.Aborted:
+		return ErrorClassConflict
+	case codes.ResourceExhausted:
+		return ErrorClassQuota
+	case codes.Unavailable, codes.DeadlineExceeded, codes.Internal:
+		return ErrorClassRetryable
+	case codes.InvalidArgument, codes.OutOfRange, codes.FailedPrecondition:
+		return ErrorClassInvalid
+	case codes.PermissionDenied, codes.Unauthenticated:
+		return ErrorClassPermissionDenied
+	}
+	return ErrorClassTerminal
+}
+
+// apiStatus returns the canonical status, e.g. ALREADY_EXISTS, of the
+// supplied error. It is only reported in the response body.
+func apiStatus(e *googleapi.Error) string {
+	body := &struct {
+		Error struct {
+			Status string `json:"status"`
+		} `json:"error"`
+	}{}
+	if json.Unmarshal([]byte(e.Body), body) != nil {
+		return ""
+	}
+	return body.Error.Status
+}
+
+// hasReason returns true if any of the error items of the supplied error has
+// one of the supplied reasons. Reasons are compared case insensitively.
+func hasReason(e *googleapi.Error, reasons ...string) bool {
+	for _, item := range e.Errors {
+		for _, r := range reasons {
+			if strings.EqualFold(item.Reason, r) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// IsErrorNotFoundGRPC gets a value indicating whether the given error represents
+// a "not found" response from the Google API. It works only for the clients
+// that use gRPC as protocol.
+func IsErrorNotFoundGRPC(err error) bool {
+	var gErr interface{ GRPCStatus() *status.Status }
+	return errors.As(err, &gErr) && classifyGRPC(gErr.GRPCStatus().Code()) == ErrorClassNotFound
+}
+
+// IsErrorNotFound gets a value indicating whether the given error represents a "not found" response from the Google API
+func IsErrorNotFound(err error) bool {
+	return ClassifyError(err) == ErrorClassNotFound
+}
+
+// IsErrorAlreadyExists gets a value indicating whether the given error
+// represents an "already exists" response from the Google API. Writes that
+// were aborted by a concurrent change are not considered to be one.
+func IsErrorAlreadyExists(err error) bool {
+	return ClassifyError(err) == ErrorClassAlreadyExists
+}
+
+// IsErrorBadRequest gets a value indicating whether the given error represents
+// a "bad request" response from the Google API
+func IsErrorBadRequest(err error) bool {
+	return ClassifyError(err) == ErrorClassInvalid
+}
+
+// IsErrorForbidden gets a value indicating whether the given error represents a
+// "forbidden" response from the Google API
+func IsErrorForbidden(err error) bool {
+	return ClassifyError(err) == ErrorClassPermissionDenied
+}
+
+// IsErrorPreconditionFailed gets a value indicating whether the given error
+// represents a write that was rejected by the Google API because the etag,
+// fingerprint or metageneration it was conditioned on was stale.
+func IsErrorPreconditionFailed(err error) bool {
+	return ClassifyError(err) == ErrorClassConflict
+}
+
+// isProjectError returns true if the supplied error is an invalid request or
+// permission denied response whose error details carry one of the supplied
+// reasons, or whose message contains one of the supplied phrases. The Google
+// APIs do not report these errors consistently, so both are checked.
+func isProjectError(err error, reasons []string, phrases ...string) bool {
+	if c := ClassifyError(err); c != ErrorClassInvalid && c != ErrorClassPermissionDenied {
+		return false
+	}
+	var gErr *googleapi.Error
+	if !errors.As(err, &gErr) {
+		return false
+	}
+	for _, d := range gErr.Details {
+		m, ok := d.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for _, r := range reasons {
+			if m["reason"] == r {
+				return true
+			}
+		}
+	}
+	msg := strings.ToLower(gErr.Message)
+	for _, e := range gErr.Errors {
+		msg += "\n" + strings.ToLower(e.Message)
+	}
+	for _, p := range phrases {
+		if strings.Contains(msg, p) {
+			return true
+		}
+	}
+	return false
+}
+
+// vpcscIdentifier precedes the unique identifier of a VPC Service Controls
+// violation in the message of the error it caused.
+const vpcscIdentifier = "vpcServiceControlsUniqueIdentifier:"
+
+// IsErrorVPCServiceControls gets a value indicating whether the given error
+// represents a request that was rejected by the Google API because it crossed
+// the boundary of a VPC Service Controls perimeter.
+func IsErrorVPCServiceControls(err error) bool {
+	var gErr *googleapi.Error
+	if ClassifyError(err) != ErrorClassPermissionDenied || !errors.As(err, &gErr) {
+		return false
+	}
+	return hasReason(gErr, "vpcServiceControls") ||
+		isProjectError(err, []string{"SECURITY_POLICY_VIOLATED", "VPC_SERVICE_CONTROLS"}, strings.ToLower(vpcscIdentifier))
+}
+
+// VPCServiceControlsID returns the unique identifier of the VPC Service
+// Controls violation represented by the given error, if any. Perimeter
+// administrators can use it to find the violation in the Cloud Audit Logs.
+func VPCServiceControlsID(err error) string {
+	var gErr *googleapi.Error
+	if !IsErrorVPCServiceControls(err) || !errors.As(err, &gErr) {
+		return ""
+	}
+	msgs := []string{gErr.Message}
+	for _, e := range gErr.Errors {
+		msgs = append(msgs, e.Message)
+	}
+	for _, m := range msgs {
+		if _, id, ok := strings.Cut(m, vpcscIdentifier); ok && len(strings.Fields(id)) > 0 {
+			return strings.TrimRight(strings.Fields(id)[0], ".,")
+		}
+	}
+	return ""
+}
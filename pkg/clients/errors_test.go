@@ -0,0 +1,195 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+)
+
+func TestClassifyError(t *testing.T) {
+	body := func(s string) string {
+		return `{"error": {"status": "` + s + `"}}`
+	}
+	cases := map[string]struct {
+		err  error
+		want ErrorClass
+	}{
+		"Nil": {
+			want: ErrorClassNone,
+		},
+		"NotGoogleAPI": {
+			err:  errors.New("boom"),
+			want: ErrorClassUnknown,
+		},
+		"DeadlineExceeded": {
+			err:  errors.Wrap(context.DeadlineExceeded, "boom"),
+			want: ErrorClassRetryable,
+		},
+		"NotFound": {
+			err:  errors.Wrap(&googleapi.Error{Code: http.StatusNotFound}, "boom"),
+			want: ErrorClassNotFound,
+		},
+		"BareConflict": {
+			err:  &googleapi.Error{Code: http.StatusConflict},
+			want: ErrorClassAlreadyExists,
+		},
+		"AlreadyExists": {
+			err:  &googleapi.Error{Code: http.StatusConflict, Body: body("ALREADY_EXISTS")},
+			want: ErrorClassAlreadyExists,
+		},
+		"Aborted": {
+			err:  &googleapi.Error{Code: http.StatusConflict, Body: body("ABORTED")},
+			want: ErrorClassConflict,
+		},
+		"AbortedReason": {
+			err:  &googleapi.Error{Code: http.StatusConflict, Errors: []googleapi.ErrorItem{{Reason: "aborted"}}},
+			want: ErrorClassConflict,
+		},
+		"PreconditionFailed": {
+			err:  &googleapi.Error{Code: http.StatusPreconditionFailed},
+			want: ErrorClassConflict,
+		},
+		"TooManyRequests": {
+			err:  &googleapi.Error{Code: http.StatusTooManyRequests},
+			want: ErrorClassQuota,
+		},
+		"RateLimitExceeded": {
+			err:  &googleapi.Error{Code: http.StatusForbidden, Errors: []googleapi.ErrorItem{{Reason: "rateLimitExceeded"}}},
+			want: ErrorClassQuota,
+		},
+		"ResourceExhausted": {
+			err:  &googleapi.Error{Code: http.StatusForbidden, Body: body("RESOURCE_EXHAUSTED")},
+			want: ErrorClassQuota,
+		},
+		"Forbidden": {
+			err:  &googleapi.Error{Code: http.StatusForbidden, Errors: []googleapi.ErrorItem{{Reason: "forbidden"}}},
+			want: ErrorClassPermissionDenied,
+		},
+		"Unauthorized": {
+			err:  &googleapi.Error{Code: http.StatusUnauthorized},
+			want: ErrorClassPermissionDenied,
+		},
+		"BadRequest": {
+			err:  &googleapi.Error{Code: http.StatusBadRequest},
+			want: ErrorClassInvalid,
+		},
+		"Gone": {
+			err:  &googleapi.Error{Code: http.StatusGone},
+			want: ErrorClassTerminal,
+		},
+		"ServiceUnavailable": {
+			err:  &googleapi.Error{Code: http.StatusServiceUnavailable},
+			want: ErrorClassRetryable,
+		},
+		"NotImplemented": {
+			err:  &googleapi.Error{Code: http.StatusNotImplemented},
+			want: ErrorClassTerminal,
+		},
+		"GRPCNotFound": {
+			err:  status.Error(codes.NotFound, "boom"),
+			want: ErrorClassNotFound,
+		},
+		"GRPCAlreadyExists": {
+			err:  status.Error(codes.AlreadyExists, "boom"),
+			want: ErrorClassAlreadyExists,
+		},
+		"GRPCAborted": {
+			err:  errors.Wrap(status.Error(codes.Aborted, "boom"), "boom"),
+			want: ErrorClassConflict,
+		},
+		"GRPCResourceExhausted": {
+			err:  status.Error(codes.ResourceExhausted, "boom"),
+			want: ErrorClassQuota,
+		},
+		"GRPCUnavailable": {
+			err:  status.Error(codes.Unavailable, "boom"),
+			want: ErrorClassRetryable,
+		},
+		"GRPCInvalidArgument": {
+			err:  status.Error(codes.InvalidArgument, "boom"),
+			want: ErrorClassInvalid,
+		},
+		"GRPCPermissionDenied": {
+			err:  status.Error(codes.PermissionDenied, "boom"),
+			want: ErrorClassPermissionDenied,
+		},
+		"GRPCUnimplemented": {
+			err:  status.Error(codes.Unimplemented, "boom"),
+			want: ErrorClassTerminal,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := ClassifyError(tc.err)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("ClassifyError(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestVPCServiceControlsID(t *testing.T) {
+	msg := "Request is prohibited by organization's policy. vpcServiceControlsUniqueIdentifier: Ab1cD2."
+	cases := map[string]struct {
+		err     error
+		blocked bool
+		want    string
+	}{
+		"Nil": {},
+		"Forbidden": {
+			err: &googleapi.Error{Code: http.StatusForbidden, Message: "caller does not have permission"},
+		},
+		"Reason": {
+			err: errors.Wrap(&googleapi.Error{
+				Code:    http.StatusForbidden,
+				Message: msg,
+				Errors:  []googleapi.ErrorItem{{Reason: "vpcServiceControls", Message: msg}},
+			}, "boom"),
+			blocked: true,
+			want:    "Ab1cD2",
+		},
+		"Details": {
+			err: &googleapi.Error{
+				Code:    http.StatusForbidden,
+				Details: []interface{}{map[string]interface{}{"reason": "SECURITY_POLICY_VIOLATED"}},
+			},
+			blocked: true,
+		},
+		"NotForbidden": {
+			err: &googleapi.Error{Code: http.StatusBadRequest, Message: msg},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.blocked, IsErrorVPCServiceControls(tc.err)); diff != "" {
+				t.Errorf("IsErrorVPCServiceControls(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want, VPCServiceControlsID(tc.err)); diff != "" {
+				t.Errorf("VPCServiceControlsID(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fhirstore
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	healthcare "google.golang.org/api/healthcare/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/healthcare/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	nameFormat = "%s/fhirStores/%s"
+
+	complexDataTypeReferenceParsingUnspecified = "COMPLEX_DATA_TYPE_REFERENCE_PARSING_UNSPECIFIED"
+)
+
+// GetFullyQualifiedName builds the relative resource name of a FHIRStore in
+// the supplied dataset.
+func GetFullyQualifiedName(dataset, name string) string {
+	return fmt.Sprintf(nameFormat, dataset, name)
+}
+
+// GenerateNotificationConfig produces the NotificationConfig of a FHIR or
+// DICOM store from the supplied one.
+func GenerateNotificationConfig(nc *v1alpha1.NotificationConfig) *healthcare.NotificationConfig {
+	if nc == nil {
+		return nil
+	}
+	return &healthcare.NotificationConfig{PubsubTopic: gcp.StringValue(nc.PubsubTopic)}
+}
+
+// GenerateFHIRStore produces a FhirStore that is configured via given
+// FHIRStoreParameters.
+func GenerateFHIRStore(name string, p v1alpha1.FHIRStoreParameters) *healthcare.FhirStore {
+	s := &healthcare.FhirStore{
+		Name:                            name,
+		Version:                         p.Version,
+		EnableUpdateCreate:              gcp.BoolValue(p.EnableUpdateCreate),
+		DisableReferentialIntegrity:     gcp.BoolValue(p.DisableReferentialIntegrity),
+		DisableResourceVersioning:       gcp.BoolValue(p.DisableResourceVersioning),
+		DefaultSearchHandlingStrict:     gcp.BoolValue(p.DefaultSearchHandlingStrict),
+		ComplexDataTypeReferenceParsing: gcp.StringValue(p.ComplexDataTypeReferenceParsing),
+		NotificationConfig:              GenerateNotificationConfig(p.NotificationConfig),
+		Labels:                          p.Labels,
+	}
+	// Updating the store to false needs the field to be sent.
+	if p.EnableUpdateCreate != nil {
+		s.ForceSendFields = append(s.ForceSendFields, "EnableUpdateCreate")
+	}
+	if p.DefaultSearchHandlingStrict != nil {
+		s.ForceSendFields = append(s.ForceSendFields, "DefaultSearchHandlingStrict")
+	}
+	return s
+}
+
+// GenerateObservation produces a FHIRStoreObservation from the supplied
+// FhirStore.
+func GenerateObservation(s healthcare.FhirStore) v1alpha1.FHIRStoreObservation {
+	return v1alpha1.FHIRStoreObservation{
+		Name: s.Name,
+	}
+}
+
+// LateInitialize fills the empty fields of FHIRStoreParameters if the
+// corresponding fields are given in FhirStore.
+func LateInitialize(p *v1alpha1.FHIRStoreParameters, s healthcare.FhirStore) {
+	p.EnableUpdateCreate = gcp.LateInitializeBool(p.EnableUpdateCreate, s.EnableUpdateCreate)
+	p.DisableReferentialIntegrity = gcp.LateInitializeBool(p.DisableReferentialIntegrity, s.DisableReferentialIntegrity)
+	p.DisableResourceVersioning = gcp.LateInitializeBool(p.DisableResourceVersioning, s.DisableResourceVersioning)
+	p.DefaultSearchHandlingStrict = gcp.LateInitializeBool(p.DefaultSearchHandlingStrict, s.DefaultSearchHandlingStrict)
+	if s.ComplexDataTypeReferenceParsing != complexDataTypeReferenceParsingUnspecified {
+		p.ComplexDataTypeReferenceParsing = gcp.LateInitializeString(p.ComplexDataTypeReferenceParsing, s.ComplexDataTypeReferenceParsing)
+	}
+	p.Labels = gcp.LateInitializeStringMap(p.Labels, s.Labels)
+}
+
+// IsUpToDate checks whether FhirStore is configured with given
+// FHIRStoreParameters.
+func IsUpToDate(p v1alpha1.FHIRStoreParameters, s healthcare.FhirStore) bool {
+	return GenerateUpdateMask(p, s) == ""
+}
+
+// GenerateUpdateMask returns the comma separated list of the updatable fields
+// that differ between FHIRStoreParameters and FhirStore.
+func GenerateUpdateMask(p v1alpha1.FHIRStoreParameters, s healthcare.FhirStore) string {
+	desired := GenerateFHIRStore(s.Name, p)
+	if s.ComplexDataTypeReferenceParsing == complexDataTypeReferenceParsingUnspecified {
+		s.ComplexDataTypeReferenceParsing = ""
+	}
+	mask := []string{}
+	if desired.EnableUpdateCreate != s.EnableUpdateCreate {
+		mask = append(mask, "enableUpdateCreate")
+	}
+	if desired.DefaultSearchHandlingStrict != s.DefaultSearchHandlingStrict {
+		mask = append(mask, "defaultSearchHandlingStrict")
+	}
+	if desired.ComplexDataTypeReferenceParsing != s.ComplexDataTypeReferenceParsing {
+		mask = append(mask, "complexDataTypeReferenceParsing")
+	}
+	if !cmp.Equal(desired.NotificationConfig, s.NotificationConfig, cmpopts.EquateEmpty()) {
+		mask = append(mask, "notificationConfig")
+	}
+	if !cmp.Equal(desired.Labels, s.Labels, cmpopts.EquateEmpty()) {
+		mask = append(mask, "labels")
+	}
+	return strings.Join(mask, ",")
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fhirstore
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	healthcare "google.golang.org/api/healthcare/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/healthcare/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const storeName = "projects/fooproject/locations/us-central1/datasets/foo/fhirStores/bar"
+
+func params(m ...func(*v1alpha1.FHIRStoreParameters)) *v1alpha1.FHIRStoreParameters {
+	p := &v1alpha1.FHIRStoreParameters{
+		Dataset:                         gcp.StringPtr("projects/fooproject/locations/us-central1/datasets/foo"),
+		Version:                         "R4",
+		EnableUpdateCreate:              gcp.BoolPtr(true),
+		DisableReferentialIntegrity:     gcp.BoolPtr(false),
+		DisableResourceVersioning:       gcp.BoolPtr(false),
+		DefaultSearchHandlingStrict:     gcp.BoolPtr(false),
+		ComplexDataTypeReferenceParsing: gcp.StringPtr("ENABLED"),
+		NotificationConfig:              &v1alpha1.NotificationConfig{PubsubTopic: gcp.StringPtr("projects/fooproject/topics/baz")},
+		Labels:                          map[string]string{"foo": "bar"},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func observed(m ...func(*healthcare.FhirStore)) *healthcare.FhirStore {
+	s := &healthcare.FhirStore{
+		Name:                            storeName,
+		Version:                         "R4",
+		EnableUpdateCreate:              true,
+		ComplexDataTypeReferenceParsing: "ENABLED",
+		NotificationConfig:              &healthcare.NotificationConfig{PubsubTopic: "projects/fooproject/topics/baz"},
+		Labels:                          map[string]string{"foo": "bar"},
+	}
+	for _, f := range m {
+		f(s)
+	}
+	return s
+}
+
+func TestLateInitialize(t *testing.T) {
+	got := params(func(p *v1alpha1.FHIRStoreParameters) {
+		p.EnableUpdateCreate = nil
+		p.ComplexDataTypeReferenceParsing = nil
+		p.Labels = nil
+	})
+	LateInitialize(got, *observed())
+	if diff := cmp.Diff(params(), got); diff != "" {
+		t.Errorf("LateInitialize(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateUpdateMask(t *testing.T) {
+	cases := map[string]struct {
+		params   *v1alpha1.FHIRStoreParameters
+		observed *healthcare.FhirStore
+		want     string
+	}{
+		"UpToDate": {
+			params:   params(),
+			observed: observed(),
+			want:     "",
+		},
+		"UnspecifiedParsing": {
+			params: params(func(p *v1alpha1.FHIRStoreParameters) {
+				p.ComplexDataTypeReferenceParsing = nil
+			}),
+			observed: observed(func(s *healthcare.FhirStore) {
+				s.ComplexDataTypeReferenceParsing = complexDataTypeReferenceParsingUnspecified
+			}),
+			want: "",
+		},
+		"ImmutableFieldsIgnored": {
+			params: params(func(p *v1alpha1.FHIRStoreParameters) {
+				p.DisableReferentialIntegrity = gcp.BoolPtr(true)
+			}),
+			observed: observed(),
+			want:     "",
+		},
+		"Outdated": {
+			params: params(func(p *v1alpha1.FHIRStoreParameters) {
+				p.EnableUpdateCreate = gcp.BoolPtr(false)
+				p.NotificationConfig = nil
+				p.Labels = map[string]string{"foo": "baz"}
+			}),
+			observed: observed(),
+			want:     "enableUpdateCreate,notificationConfig,labels",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateUpdateMask(*tc.params, *tc.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateUpdateMask(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
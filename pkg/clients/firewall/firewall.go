@@ -26,6 +26,7 @@ import (
 
 	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
 	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/immutable"
 )
 
 const errCheckUpToDate = "unable to determine if external resource is up to date"
@@ -73,6 +74,28 @@ func GenerateFirewall(name string, in v1alpha1.FirewallParameters, firewall *com
 	}
 }
 
+// GenerateFirewallForUpdate returns a *compute.Firewall that patches a
+// firewall rule to match the supplied FirewallParameters. Unlike
+// GenerateFirewall it sends the fields that are set to their zero value, so
+// that a description can be cleared, a rule re-enabled or logging turned off.
+func GenerateFirewallForUpdate(name string, in v1alpha1.FirewallParameters) *compute.Firewall {
+	firewall := &compute.Firewall{}
+	GenerateFirewall(name, in, firewall)
+	if in.Description != nil {
+		firewall.ForceSendFields = append(firewall.ForceSendFields, "Description")
+	}
+	if in.Disabled != nil {
+		firewall.ForceSendFields = append(firewall.ForceSendFields, "Disabled")
+	}
+	if in.Priority != nil {
+		firewall.ForceSendFields = append(firewall.ForceSendFields, "Priority")
+	}
+	if firewall.LogConfig != nil {
+		firewall.LogConfig.ForceSendFields = []string{"Enable"}
+	}
+	return firewall
+}
+
 // GenerateFirewallObservation takes a compute.Firewall and returns *FirewallObservation.
 func GenerateFirewallObservation(in compute.Firewall) v1alpha1.FirewallObservation {
 	fw := v1alpha1.FirewallObservation{
@@ -138,3 +161,13 @@ func IsUpToDate(name string, in *v1alpha1.FirewallParameters, observed *compute.
 	GenerateFirewall(name, *in, desired)
 	return cmp.Equal(desired, observed, cmpopts.EquateEmpty(), gcp.EquateComputeURLs(), cmpopts.IgnoreFields(compute.Firewall{}, "ForceSendFields")), nil
 }
+
+// ImmutableFieldsChanged returns the names of the fields of the supplied
+// FirewallParameters that can only be set when a firewall rule is created and
+// that differ from the observed firewall rule.
+func ImmutableFieldsChanged(in *v1alpha1.FirewallParameters, observed *compute.Firewall) []string {
+	return immutable.Changed(
+		immutable.Field{Path: "network", Desired: in.Network, Observed: observed.Network, Options: []cmp.Option{gcp.EquateComputeURLs()}},
+		immutable.Field{Path: "direction", Desired: in.Direction, Observed: observed.Direction},
+	)
+}
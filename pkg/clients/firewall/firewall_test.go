@@ -162,6 +162,48 @@ func TestGenerateFirewall(t *testing.T) {
 	}
 }
 
+func TestGenerateFirewallForUpdate(t *testing.T) {
+	cases := map[string]struct {
+		in   v1alpha1.FirewallParameters
+		want *compute.Firewall
+	}{
+		"ZeroValuesAreSent": {
+			in: *params(func(p *v1alpha1.FirewallParameters) {
+				p.Description = new(string)
+				p.Disabled = &falseVal
+				p.LogConfig = &v1alpha1.FirewallLogConfig{Enable: false}
+			}),
+			want: firewall(func(n *compute.Firewall) {
+				n.Description = ""
+				n.Disabled = false
+				n.LogConfig = &compute.FirewallLogConfig{ForceSendFields: []string{"Enable"}}
+				n.ForceSendFields = []string{"Description", "Disabled", "Priority"}
+			}),
+		},
+		"UnsetFieldsAreOmitted": {
+			in: *params(func(p *v1alpha1.FirewallParameters) {
+				p.Description = nil
+				p.Disabled = nil
+				p.Priority = nil
+			}),
+			want: firewall(func(n *compute.Firewall) {
+				n.Description = ""
+				n.Disabled = false
+				n.Priority = 0
+			}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateFirewallForUpdate(testName, tc.in)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateFirewallForUpdate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestGenerateFirewallObservation(t *testing.T) {
 	cases := map[string]struct {
 		in  compute.Firewall
@@ -281,3 +323,41 @@ func TestIsUpToDate(t *testing.T) {
 		})
 	}
 }
+
+func TestImmutableFieldsChanged(t *testing.T) {
+	cases := map[string]struct {
+		in      *v1alpha1.FirewallParameters
+		current *compute.Firewall
+		want    []string
+	}{
+		"Unchanged": {
+			in:      params(),
+			current: firewall(),
+			want:    []string{},
+		},
+		"MutableFieldChanged": {
+			in: params(),
+			current: firewall(func(f *compute.Firewall) {
+				f.Priority = 1000
+			}),
+			want: []string{},
+		},
+		"Changed": {
+			in: params(),
+			current: firewall(func(f *compute.Firewall) {
+				f.Network = "other-network"
+				f.Direction = "EGRESS"
+			}),
+			want: []string{"network", "direction"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := ImmutableFieldsChanged(tc.in, tc.current)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("ImmutableFieldsChanged(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
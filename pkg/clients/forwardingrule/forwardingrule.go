@@ -0,0 +1,84 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package forwardingrule
+
+import (
+	compute "google.golang.org/api/compute/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+// GenerateForwardingRule takes a *ForwardingRuleParameters and fills
+// *compute.ForwardingRule. It assigns only the fields that are writable,
+// i.e. not labelled as [Output Only] in Google's reference.
+func GenerateForwardingRule(name string, in v1alpha1.ForwardingRuleParameters, fr *compute.ForwardingRule) {
+	fr.Name = name
+	fr.Description = gcp.StringValue(in.Description)
+	fr.IPAddress = gcp.StringValue(in.IPAddress)
+	fr.IPProtocol = gcp.StringValue(in.IPProtocol)
+	fr.LoadBalancingScheme = gcp.StringValue(in.LoadBalancingScheme)
+	fr.BackendService = gcp.StringValue(in.BackendService)
+	fr.Target = gcp.StringValue(in.Target)
+	fr.Network = gcp.StringValue(in.Network)
+	fr.Subnetwork = gcp.StringValue(in.Subnetwork)
+	fr.Ports = in.Ports
+	fr.PortRange = gcp.StringValue(in.PortRange)
+	fr.AllPorts = gcp.BoolValue(in.AllPorts)
+	fr.AllowGlobalAccess = gcp.BoolValue(in.AllowGlobalAccess)
+}
+
+// GenerateForwardingRuleForUpdate returns the patch for the only field of a
+// ForwardingRule that can be changed after creation.
+func GenerateForwardingRuleForUpdate(in v1alpha1.ForwardingRuleParameters) *compute.ForwardingRule {
+	return &compute.ForwardingRule{
+		AllowGlobalAccess: gcp.BoolValue(in.AllowGlobalAccess),
+		ForceSendFields:   []string{"AllowGlobalAccess"},
+	}
+}
+
+// GenerateForwardingRuleObservation takes a compute.ForwardingRule and returns
+// *ForwardingRuleObservation.
+func GenerateForwardingRuleObservation(in compute.ForwardingRule) v1alpha1.ForwardingRuleObservation {
+	return v1alpha1.ForwardingRuleObservation{
+		CreationTimestamp:   in.CreationTimestamp,
+		ID:                  in.Id,
+		IPAddress:           in.IPAddress,
+		PscConnectionID:     in.PscConnectionId,
+		PscConnectionStatus: in.PscConnectionStatus,
+		SelfLink:            in.SelfLink,
+	}
+}
+
+// LateInitializeSpec fills unassigned fields with the values in
+// compute.ForwardingRule object.
+func LateInitializeSpec(spec *v1alpha1.ForwardingRuleParameters, in compute.ForwardingRule) {
+	spec.Description = gcp.LateInitializeString(spec.Description, in.Description)
+	spec.IPProtocol = gcp.LateInitializeString(spec.IPProtocol, in.IPProtocol)
+	spec.Network = gcp.LateInitializeString(spec.Network, in.Network)
+	spec.Subnetwork = gcp.LateInitializeString(spec.Subnetwork, in.Subnetwork)
+	spec.PortRange = gcp.LateInitializeString(spec.PortRange, in.PortRange)
+	spec.AllowGlobalAccess = gcp.LateInitializeBool(spec.AllowGlobalAccess, in.AllowGlobalAccess)
+}
+
+// IsUpToDate checks whether current state is up-to-date compared to the given
+// set of parameters. Only AllowGlobalAccess is compared, since every other
+// field is immutable and the API reports some of them, such as the IP
+// address, in a different form than they were requested in.
+func IsUpToDate(in *v1alpha1.ForwardingRuleParameters, observed *compute.ForwardingRule) bool {
+	return gcp.BoolValue(in.AllowGlobalAccess) == observed.AllowGlobalAccess
+}
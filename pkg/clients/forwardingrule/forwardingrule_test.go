@@ -0,0 +1,125 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package forwardingrule
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/compute/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+)
+
+const (
+	testName       = "some-name"
+	testRegion     = "us-west1"
+	testAddress    = "projects/test/regions/us-west1/addresses/ep"
+	testAttachment = "projects/producer/regions/us-west1/serviceAttachments/svc"
+	testNetwork    = "projects/test/global/networks/vpc"
+)
+
+func pscParams(m ...func(*v1alpha1.ForwardingRuleParameters)) *v1alpha1.ForwardingRuleParameters {
+	address, target, network := testAddress, testAttachment, testNetwork
+	o := &v1alpha1.ForwardingRuleParameters{
+		Region:    testRegion,
+		IPAddress: &address,
+		Target:    &target,
+		Network:   &network,
+	}
+
+	for _, f := range m {
+		f(o)
+	}
+
+	return o
+}
+
+func TestGenerateForwardingRule(t *testing.T) {
+	type args struct {
+		name string
+		in   v1alpha1.ForwardingRuleParameters
+	}
+	cases := map[string]struct {
+		args args
+		want *compute.ForwardingRule
+	}{
+		"PrivateServiceConnectEndpoint": {
+			args: args{
+				name: testName,
+				in:   *pscParams(),
+			},
+			want: &compute.ForwardingRule{
+				Name:      testName,
+				IPAddress: testAddress,
+				Target:    testAttachment,
+				Network:   testNetwork,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			fr := &compute.ForwardingRule{}
+			GenerateForwardingRule(tc.args.name, tc.args.in, fr)
+			if diff := cmp.Diff(tc.want, fr); diff != "" {
+				t.Errorf("GenerateForwardingRule(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	type args struct {
+		in      *v1alpha1.ForwardingRuleParameters
+		current *compute.ForwardingRule
+	}
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"UpToDate": {
+			args: args{
+				in: pscParams(),
+				current: &compute.ForwardingRule{
+					Name:      testName,
+					IPAddress: "10.0.0.5",
+					Target:    "https://www.googleapis.com/compute/v1/" + testAttachment,
+				},
+			},
+			want: true,
+		},
+		"NotUpToDateGlobalAccess": {
+			args: args{
+				in: pscParams(func(p *v1alpha1.ForwardingRuleParameters) {
+					b := true
+					p.AllowGlobalAccess = &b
+				}),
+				current: &compute.ForwardingRule{Name: testName},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsUpToDate(tc.args.in, tc.args.current)); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gateway
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	networkservices "google.golang.org/api/networkservices/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/networkservices/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	parentFormat = "projects/%s/locations/%s"
+	nameFormat   = "projects/%s/locations/%s/gateways/%s"
+)
+
+// GetParent returns the location the Gateway lives under.
+func GetParent(projectID, location string) string {
+	return fmt.Sprintf(parentFormat, projectID, location)
+}
+
+// GetFullyQualifiedName builds the relative resource name of the Gateway.
+func GetFullyQualifiedName(projectID, location, name string) string {
+	return fmt.Sprintf(nameFormat, projectID, location, name)
+}
+
+// GenerateGateway produces a Gateway that is configured via given
+// GatewayParameters.
+func GenerateGateway(name string, p v1alpha1.GatewayParameters) *networkservices.Gateway {
+	return &networkservices.Gateway{
+		Name:            name,
+		Description:     gcp.StringValue(p.Description),
+		Labels:          p.Labels,
+		Type:            p.Type,
+		Ports:           p.Ports,
+		Scope:           gcp.StringValue(p.Scope),
+		ServerTlsPolicy: gcp.StringValue(p.ServerTLSPolicy),
+	}
+}
+
+// GenerateObservation produces a GatewayObservation from the supplied
+// Gateway.
+func GenerateObservation(g networkservices.Gateway) v1alpha1.GatewayObservation {
+	return v1alpha1.GatewayObservation{
+		Name:       g.Name,
+		SelfLink:   g.SelfLink,
+		CreateTime: g.CreateTime,
+		UpdateTime: g.UpdateTime,
+	}
+}
+
+// LateInitialize fills the empty fields of GatewayParameters if the
+// corresponding fields are given in Gateway.
+func LateInitialize(p *v1alpha1.GatewayParameters, g networkservices.Gateway) {
+	p.Description = gcp.LateInitializeString(p.Description, g.Description)
+	p.Scope = gcp.LateInitializeString(p.Scope, g.Scope)
+	p.ServerTLSPolicy = gcp.LateInitializeString(p.ServerTLSPolicy, g.ServerTlsPolicy)
+}
+
+// IsUpToDate checks whether Gateway is configured with given
+// GatewayParameters.
+func IsUpToDate(p v1alpha1.GatewayParameters, g networkservices.Gateway) bool {
+	return GenerateUpdateMask(p, g) == ""
+}
+
+// GenerateUpdateMask returns the comma separated list of fields that differ
+// between GatewayParameters and Gateway. The type, ports and scope of a
+// Gateway can't be updated.
+func GenerateUpdateMask(p v1alpha1.GatewayParameters, g networkservices.Gateway) string {
+	desired := GenerateGateway(g.Name, p)
+	mask := []string{}
+	if desired.Description != g.Description {
+		mask = append(mask, "description")
+	}
+	if !cmp.Equal(desired.Labels, g.Labels, cmpopts.EquateEmpty()) {
+		mask = append(mask, "labels")
+	}
+	if desired.ServerTlsPolicy != g.ServerTlsPolicy {
+		mask = append(mask, "serverTlsPolicy")
+	}
+	return strings.Join(mask, ",")
+}
@@ -0,0 +1,123 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gateway
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	networkservices "google.golang.org/api/networkservices/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/networkservices/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	testName   = "projects/foo/locations/us-central1/gateways/ingress"
+	testPolicy = "projects/foo/locations/us-central1/serverTlsPolicies/mtls"
+)
+
+func params(m ...func(*v1alpha1.GatewayParameters)) *v1alpha1.GatewayParameters {
+	p := &v1alpha1.GatewayParameters{
+		Location:        "us-central1",
+		Description:     gcp.StringPtr("ingress gateway"),
+		Labels:          map[string]string{"team": "platform"},
+		Type:            "OPEN_MESH",
+		Ports:           []int64{443},
+		Scope:           gcp.StringPtr("ingress"),
+		ServerTLSPolicy: gcp.StringPtr(testPolicy),
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func gateway(m ...func(*networkservices.Gateway)) *networkservices.Gateway {
+	g := &networkservices.Gateway{
+		Name:            testName,
+		Description:     "ingress gateway",
+		Labels:          map[string]string{"team": "platform"},
+		Type:            "OPEN_MESH",
+		Ports:           []int64{443},
+		Scope:           "ingress",
+		ServerTlsPolicy: testPolicy,
+	}
+	for _, f := range m {
+		f(g)
+	}
+	return g
+}
+
+func TestGenerateGateway(t *testing.T) {
+	if diff := cmp.Diff(gateway(), GenerateGateway(testName, *params())); diff != "" {
+		t.Errorf("GenerateGateway(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitialize(t *testing.T) {
+	p := params(func(p *v1alpha1.GatewayParameters) {
+		p.Description = nil
+		p.Scope = nil
+		p.ServerTLSPolicy = nil
+	})
+	LateInitialize(p, *gateway())
+	if diff := cmp.Diff(params(), p); diff != "" {
+		t.Errorf("LateInitialize(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateUpdateMask(t *testing.T) {
+	cases := map[string]struct {
+		p    *v1alpha1.GatewayParameters
+		g    *networkservices.Gateway
+		want string
+	}{
+		"UpToDate": {
+			p:    params(),
+			g:    gateway(),
+			want: "",
+		},
+		"ImmutableFieldsIgnored": {
+			p: params(func(p *v1alpha1.GatewayParameters) {
+				p.Ports = []int64{8443}
+				p.Scope = gcp.StringPtr("egress")
+			}),
+			g:    gateway(),
+			want: "",
+		},
+		"Outdated": {
+			p: params(func(p *v1alpha1.GatewayParameters) {
+				p.Description = gcp.StringPtr("gateway")
+				p.Labels = nil
+				p.ServerTLSPolicy = gcp.StringPtr("")
+			}),
+			g:    gateway(),
+			want: "description,labels,serverTlsPolicy",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, GenerateUpdateMask(*tc.p, *tc.g)); diff != "" {
+				t.Errorf("GenerateUpdateMask(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want == "", IsUpToDate(*tc.p, *tc.g)); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -19,31 +19,49 @@ package gcp
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"path"
+	"reflect"
 	"strings"
 
 	"github.com/google/go-cmp/cmp"
 	"golang.org/x/oauth2"
 	"golang.org/x/oauth2/google"
-	"google.golang.org/api/googleapi"
 	"google.golang.org/api/option"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
+	htransport "google.golang.org/api/transport/http"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/uuid"
+	"k8s.io/client-go/util/retry"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	cmpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/compute/v1beta1"
 	"github.com/crossplane-contrib/provider-gcp/apis/v1alpha3"
 	"github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
+	"github.com/crossplane-contrib/provider-gcp/pkg/tracing"
+	"github.com/crossplane-contrib/provider-gcp/pkg/version"
 )
 
-const scopeCloudPlatform = "https://www.googleapis.com/auth/cloud-platform"
+const (
+	scopeCloudPlatform = "https://www.googleapis.com/auth/cloud-platform"
+	userAgentProduct   = "crossplane-provider-gcp"
+)
+
+// ReasonOperationStarted is the event reason used to record the long-running
+// GCP operations started while reconciling a managed resource.
+const ReasonOperationStarted event.Reason = "StartedGCPOperation"
+
+const (
+	errTrackUsage        = "cannot track ProviderConfig usage"
+	errGetProviderConfig = "cannot get referenced ProviderConfig"
+	errNewTransport      = "cannot create HTTP transport"
+)
 
 // GetConnectionInfo returns the necessary connection information that is necessary
 // to use when the controller connects to GCP API in order to reconcile the managed
@@ -51,12 +69,99 @@ const scopeCloudPlatform = "https://www.googleapis.com/auth/cloud-platform"
 func GetConnectionInfo(ctx context.Context, c client.Client, mg resource.Managed) (projectID string, opts []option.ClientOption, err error) {
 	switch {
 	case mg.GetProviderConfigReference() != nil:
-		return UseProviderConfig(ctx, c, mg)
+		projectID, opts, err = UseProviderConfig(ctx, c, mg)
 	case mg.GetProviderReference() != nil:
-		return UseProvider(ctx, c, mg)
+		projectID, opts, err = UseProvider(ctx, c, mg)
 	default:
 		return "", nil, errors.New("neither providerConfigRef nor providerRef is given")
 	}
+	if err != nil {
+		return "", nil, err
+	}
+	if opts, err = withHTTPClient(ctx, mg, opts); err != nil {
+		return "", nil, err
+	}
+	return projectID, opts, nil
+}
+
+// GetProviderConfigConnectionInfo returns the connection information to use
+// when calling GCP with the ProviderConfig referenced by the supplied managed
+// resource. Unlike GetConnectionInfo it does not track the usage of the
+// ProviderConfig, which requires a managed resource that exists in the API
+// server. It is used to call GCP on behalf of a ProviderConfig, e.g. to
+// discover the external resources in its project.
+func GetProviderConfigConnectionInfo(ctx context.Context, c client.Client, mg resource.Managed) (projectID string, opts []option.ClientOption, err error) {
+	projectID, opts, err = providerConfigCredentials(ctx, c, mg.GetProviderConfigReference().Name)
+	if err != nil {
+		return "", nil, err
+	}
+	if opts, err = withHTTPClient(ctx, mg, opts); err != nil {
+		return "", nil, err
+	}
+	return projectID, opts, nil
+}
+
+// withHTTPClient appends an HTTP client that sends the supplied credentials,
+// and the user-agent and request reason of the supplied managed resource, to
+// the supplied client options.
+func withHTTPClient(ctx context.Context, mg resource.Managed, opts []option.ClientOption) ([]option.ClientOption, error) {
+	opts = append(opts, option.WithUserAgent(UserAgent(mg)))
+	// API clients are built anew for every reconcile, since they send the
+	// user-agent and request reason of the managed resource being reconciled.
+	// They share the token sources of their ProviderConfig and the pool of
+	// connections of the default transport, so that building them neither
+	// fetches an access token nor dials GCP. Credentials, the user-agent and
+	// the request reason are applied by the transport, since GCP clients
+	// ignore the former when supplied an HTTP client and reject the latter.
+	base := http.DefaultTransport
+	if tracing.Enabled() {
+		base = tracing.NewTransport(base)
+	}
+	t, err := htransport.NewTransport(ctx, base, append([]option.ClientOption{
+		option.WithScopes(scopeCloudPlatform),
+		option.WithRequestReason(RequestReason(mg, uuid.NewUUID())),
+	}, opts...)...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewTransport)
+	}
+	return append(opts, option.WithHTTPClient(&http.Client{Transport: t})), nil
+}
+
+// UserAgent returns the user-agent sent with every GCP API call made on behalf
+// of the supplied managed resource. It identifies the provider version and the
+// kind and UID of the managed resource so that mutations recorded in Cloud
+// Audit Logs can be traced back to it.
+func UserAgent(mg resource.Managed) string {
+	return fmt.Sprintf("%s/%s (%s; uid=%s)", userAgentProduct, version.Version, kindOf(mg), mg.GetUID())
+}
+
+// RequestReason returns the request reason sent with every GCP API call made
+// on behalf of the supplied managed resource. GCP records it in Cloud Audit
+// Logs, which allows all calls made during a single reconcile, identified by
+// the supplied request ID, to be correlated.
+func RequestReason(mg resource.Managed, requestID types.UID) string {
+	return fmt.Sprintf("crossplane %s/%s request-id=%s", kindOf(mg), mg.GetName(), requestID)
+}
+
+// kindOf returns the kind of the supplied managed resource. Objects read from
+// the API server cache usually have an empty TypeMeta so we fall back to the
+// name of the underlying Go type.
+func kindOf(mg resource.Managed) string {
+	if k := mg.GetObjectKind().GroupVersionKind().Kind; k != "" {
+		return k
+	}
+	return reflect.Indirect(reflect.ValueOf(mg)).Type().Name()
+}
+
+// RecordOperation emits an event on the supplied managed resource naming the
+// GCP operation that was started by the supplied verb, e.g. "create", so that
+// it can be looked up in Cloud Audit Logs. It is a no-op if no recorder is
+// supplied or the operation has no name.
+func RecordOperation(r event.Recorder, mg resource.Managed, verb, operation string) {
+	if r == nil || operation == "" {
+		return
+	}
+	r.Event(mg, event.Normal(ReasonOperationStarted, fmt.Sprintf("Started GCP %s operation %s", verb, operation)))
 }
 
 // UseProvider to return GCP authentication information.
@@ -81,15 +186,24 @@ func UseProvider(ctx context.Context, c client.Client, mg resource.Managed) (pro
 
 // UseProviderConfig to return GCP authentication information.
 func UseProviderConfig(ctx context.Context, c client.Client, mg resource.Managed) (projectID string, opts []option.ClientOption, err error) {
-	opts = make([]option.ClientOption, 0)
-
-	pc := &v1beta1.ProviderConfig{}
 	t := resource.NewProviderConfigUsageTracker(c, &v1beta1.ProviderConfigUsage{})
+	// NOTE: Every managed resource must be tracked before its ProviderConfig
+	// is used so that the ProviderConfig cannot be deleted while it still
+	// has users. See the ProviderConfig controller in pkg/controller/config.
 	if err := t.Track(ctx, mg); err != nil {
-		return "", nil, err
+		return "", nil, errors.Wrap(err, errTrackUsage)
 	}
-	if err := c.Get(ctx, types.NamespacedName{Name: mg.GetProviderConfigReference().Name}, pc); err != nil {
-		return "", nil, err
+	return providerConfigCredentials(ctx, c, mg.GetProviderConfigReference().Name)
+}
+
+// providerConfigCredentials returns the project and credentials of the named
+// ProviderConfig.
+func providerConfigCredentials(ctx context.Context, c client.Client, name string) (projectID string, opts []option.ClientOption, err error) {
+	opts = make([]option.ClientOption, 0)
+
+	pc := &v1beta1.ProviderConfig{}
+	if err := c.Get(ctx, types.NamespacedName{Name: name}, pc); err != nil {
+		return "", nil, errors.Wrap(err, errGetProviderConfig)
 	}
 
 	if pc.Spec.ClientOptions != nil {
@@ -98,18 +212,39 @@ func UseProviderConfig(ctx context.Context, c client.Client, mg resource.Managed
 
 	switch s := pc.Spec.Credentials.Source; s { //nolint:exhaustive
 	case xpv1.CredentialsSourceInjectedIdentity:
-		ts, err := google.DefaultTokenSource(ctx, scopeCloudPlatform)
+		ts, err := tokenSources.Get(pc.GetUID(), nil, []string{scopeCloudPlatform}, func() (oauth2.TokenSource, error) {
+			return google.DefaultTokenSource(context.Background(), scopeCloudPlatform)
+		})
 		if err != nil {
 			return "", nil, errors.Wrap(err, "cannot get application default credentials token")
 		}
 		opts = append(opts, option.WithTokenSource(ts))
 	default:
-		data, err := resource.CommonCredentialExtractor(ctx, pc.Spec.Credentials.Source, c, pc.Spec.Credentials.CommonCredentialSelectors)
+		var data []byte
+		var err error
+		if s == v1beta1.CredentialsSourceSecretManager {
+			if pc.Spec.Credentials.SecretManagerRef == nil {
+				return "", nil, errors.New(errNoSecretManagerRef)
+			}
+			data, err = secretManagerCredentials(ctx, *pc.Spec.Credentials.SecretManagerRef, pc.Spec.ProjectID)
+		} else {
+			data, err = resource.CommonCredentialExtractor(ctx, pc.Spec.Credentials.Source, c, pc.Spec.Credentials.CommonCredentialSelectors)
+		}
 		if err != nil {
 			return "", nil, errors.Wrap(err, "cannot get credentials")
 		}
 		if isJSON(data) {
-			opts = append(opts, option.WithCredentialsJSON(data))
+			ts, err := tokenSources.Get(pc.GetUID(), data, []string{scopeCloudPlatform}, func() (oauth2.TokenSource, error) {
+				creds, err := google.CredentialsFromJSON(context.Background(), data, scopeCloudPlatform)
+				if err != nil {
+					return nil, err
+				}
+				return creds.TokenSource, nil
+			})
+			if err != nil {
+				return "", nil, errors.Wrap(err, "cannot get credentials")
+			}
+			opts = append(opts, option.WithTokenSource(ts))
 			return pc.Spec.ProjectID, opts, nil
 		}
 		t := oauth2.Token{
@@ -130,54 +265,13 @@ func isJSON(b []byte) bool {
 	return json.Unmarshal(b, &js) == nil
 }
 
-// IsErrorNotFoundGRPC gets a value indicating whether the given error represents
-// a "not found" response from the Google API. It works only for the clients
-// that use gRPC as protocol.
-func IsErrorNotFoundGRPC(err error) bool {
-	if err == nil {
-		return false
-	}
-	var gErr interface{ GRPCStatus() *status.Status }
-	return errors.As(err, &gErr) && gErr.GRPCStatus().Code() == codes.NotFound
-}
-
-// IsErrorNotFound gets a value indicating whether the given error represents a "not found" response from the Google API
-func IsErrorNotFound(err error) bool {
-	if err == nil {
-		return false
-	}
-	var gErr *googleapi.Error
-	return errors.As(err, &gErr) && gErr.Code == http.StatusNotFound
-}
-
-// IsErrorAlreadyExists gets a value indicating whether the given error
-// represents a "conflict" response from the Google API
-func IsErrorAlreadyExists(err error) bool {
-	if err == nil {
-		return false
-	}
-	var gErr *googleapi.Error
-	return errors.As(err, &gErr) && gErr.Code == http.StatusConflict
-}
-
-// IsErrorBadRequest gets a value indicating whether the given error represents
-// a "bad request" response from the Google API
-func IsErrorBadRequest(err error) bool {
-	if err == nil {
-		return false
-	}
-	var gErr *googleapi.Error
-	return errors.As(err, &gErr) && gErr.Code == http.StatusBadRequest
-}
-
-// IsErrorForbidden gets a value indicating whether the given error represents a
-// "forbidden" response from the Google API
-func IsErrorForbidden(err error) bool {
-	if err == nil {
-		return false
-	}
-	var gErr *googleapi.Error
-	return errors.As(err, &gErr) && gErr.Code == http.StatusForbidden
+// RetryOnConflict calls the supplied function until it succeeds, returns an
+// error other than a stale precondition, or the retries are exhausted. The
+// function is expected to read the current etag, fingerprint or
+// metageneration of the external resource and send it back with its write,
+// so that concurrent changes made outside of Crossplane are never clobbered.
+func RetryOnConflict(fn func() error) error {
+	return retry.OnError(retry.DefaultRetry, IsErrorPreconditionFailed, fn)
 }
 
 // StringValue converts the supplied string pointer to a string, returning the
@@ -305,7 +399,7 @@ func addClientOptions(clientOptions *v1beta1.ClientOptions, opts *[]option.Clien
 		*opts = append(*opts, option.WithEndpoint(*clientOptions.Endpoint))
 	}
 
-	if *clientOptions.WithoutAuthentication {
+	if clientOptions.WithoutAuthentication != nil && *clientOptions.WithoutAuthentication {
 		*opts = append(*opts, option.WithoutAuthentication())
 	}
 }
@@ -0,0 +1,94 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/option"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
+)
+
+func TestAddClientOptions(t *testing.T) {
+	endpoint := "https://example.org"
+	cases := map[string]struct {
+		reason string
+		co     *v1beta1.ClientOptions
+		want   int
+	}{
+		"Empty": {
+			reason: "No options should be added if no client options are set.",
+			co:     &v1beta1.ClientOptions{},
+			want:   0,
+		},
+		"EndpointOnly": {
+			reason: "Only the endpoint option should be added if withoutAuthentication is not set.",
+			co:     &v1beta1.ClientOptions{Endpoint: &endpoint},
+			want:   1,
+		},
+		"WithoutAuthenticationFalse": {
+			reason: "No authentication option should be added if withoutAuthentication is false.",
+			co:     &v1beta1.ClientOptions{Endpoint: &endpoint, WithoutAuthentication: BoolPtr(false)},
+			want:   1,
+		},
+		"WithoutAuthentication": {
+			reason: "Both options should be added if withoutAuthentication is true.",
+			co:     &v1beta1.ClientOptions{Endpoint: &endpoint, WithoutAuthentication: BoolPtr(true)},
+			want:   2,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var opts []option.ClientOption
+			addClientOptions(tc.co, &opts)
+			if diff := cmp.Diff(tc.want, len(opts)); diff != "" {
+				t.Errorf("\n%s\naddClientOptions(...): -want options, +got options:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestUseProviderConfigNoSecretManagerRef(t *testing.T) {
+	kube := &test.MockClient{
+		MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+			switch o := obj.(type) {
+			case *v1beta1.ProviderConfig:
+				o.Spec.Credentials.Source = v1beta1.CredentialsSourceSecretManager
+			case *v1beta1.ProviderConfigUsage:
+				return kerrors.NewNotFound(schema.GroupResource{}, "")
+			}
+			return nil
+		}),
+		MockCreate: test.NewMockCreateFn(nil),
+	}
+	mg := &fake.Managed{ProviderConfigReferencer: fake.ProviderConfigReferencer{Ref: &xpv1.Reference{Name: "default"}}}
+	_, _, err := UseProviderConfig(context.Background(), kube, mg)
+	if diff := cmp.Diff(errors.New(errNoSecretManagerRef), err, test.EquateErrors()); diff != "" {
+		t.Errorf("UseProviderConfig(...): -want error, +got error:\n%s", diff)
+	}
+}
@@ -0,0 +1,185 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcroute
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	networkservices "google.golang.org/api/networkservices/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/networkservices/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	parentFormat = "projects/%s/locations/%s"
+	nameFormat   = "projects/%s/locations/%s/grpcRoutes/%s"
+)
+
+// GetParent returns the location the GRPCRoute lives under.
+func GetParent(projectID, location string) string {
+	return fmt.Sprintf(parentFormat, projectID, location)
+}
+
+// GetFullyQualifiedName builds the relative resource name of the GRPCRoute.
+func GetFullyQualifiedName(projectID, location, name string) string {
+	return fmt.Sprintf(nameFormat, projectID, location, name)
+}
+
+// GenerateGRPCRoute produces a GrpcRoute that is configured via given
+// GRPCRouteParameters.
+func GenerateGRPCRoute(name string, p v1alpha1.GRPCRouteParameters) *networkservices.GrpcRoute {
+	r := &networkservices.GrpcRoute{
+		Name:        name,
+		Description: gcp.StringValue(p.Description),
+		Labels:      p.Labels,
+		Hostnames:   p.Hostnames,
+		Meshes:      p.Meshes,
+		Gateways:    p.Gateways,
+	}
+	for _, rule := range p.Rules {
+		rr := &networkservices.GrpcRouteRouteRule{Action: generateAction(rule.Action)}
+		for _, m := range rule.Matches {
+			rr.Matches = append(rr.Matches, generateMatch(m))
+		}
+		r.Rules = append(r.Rules, rr)
+	}
+	return r
+}
+
+func generateMatch(in v1alpha1.GRPCRouteMatch) *networkservices.GrpcRouteRouteMatch {
+	m := &networkservices.GrpcRouteRouteMatch{}
+	if mm := in.Method; mm != nil {
+		m.Method = &networkservices.GrpcRouteMethodMatch{
+			Type:          mm.Type,
+			GrpcService:   mm.GRPCService,
+			GrpcMethod:    mm.GRPCMethod,
+			CaseSensitive: gcp.BoolValue(mm.CaseSensitive),
+		}
+		// Matches are case sensitive unless turned off explicitly.
+		if mm.CaseSensitive != nil {
+			m.Method.ForceSendFields = []string{"CaseSensitive"}
+		}
+	}
+	for _, h := range in.Headers {
+		m.Headers = append(m.Headers, &networkservices.GrpcRouteHeaderMatch{Type: h.Type, Key: h.Key, Value: h.Value})
+	}
+	return m
+}
+
+func generateAction(in v1alpha1.GRPCRouteAction) *networkservices.GrpcRouteRouteAction {
+	a := &networkservices.GrpcRouteRouteAction{Timeout: in.Timeout}
+	for _, d := range in.Destinations {
+		a.Destinations = append(a.Destinations, &networkservices.GrpcRouteDestination{ServiceName: d.ServiceName, Weight: d.Weight})
+	}
+	if f := in.FaultInjectionPolicy; f != nil {
+		a.FaultInjectionPolicy = &networkservices.GrpcRouteFaultInjectionPolicy{}
+		if f.Delay != nil {
+			a.FaultInjectionPolicy.Delay = &networkservices.GrpcRouteFaultInjectionPolicyDelay{FixedDelay: f.Delay.FixedDelay, Percentage: f.Delay.Percentage}
+		}
+		if f.Abort != nil {
+			a.FaultInjectionPolicy.Abort = &networkservices.GrpcRouteFaultInjectionPolicyAbort{HttpStatus: f.Abort.HTTPStatus, Percentage: f.Abort.Percentage}
+		}
+	}
+	if r := in.RetryPolicy; r != nil {
+		a.RetryPolicy = &networkservices.GrpcRouteRetryPolicy{RetryConditions: r.RetryConditions, NumRetries: r.NumRetries}
+	}
+	return a
+}
+
+// GenerateObservation produces a GRPCRouteObservation from the supplied
+// GrpcRoute.
+func GenerateObservation(r networkservices.GrpcRoute) v1alpha1.GRPCRouteObservation {
+	return v1alpha1.GRPCRouteObservation{
+		Name:       r.Name,
+		SelfLink:   r.SelfLink,
+		CreateTime: r.CreateTime,
+		UpdateTime: r.UpdateTime,
+	}
+}
+
+// LateInitialize fills the empty fields of GRPCRouteParameters if the
+// corresponding fields are given in GrpcRoute.
+func LateInitialize(p *v1alpha1.GRPCRouteParameters, r networkservices.GrpcRoute) {
+	p.Description = gcp.LateInitializeString(p.Description, r.Description)
+}
+
+// IsUpToDate checks whether GrpcRoute is configured with given
+// GRPCRouteParameters.
+func IsUpToDate(p v1alpha1.GRPCRouteParameters, r networkservices.GrpcRoute) bool {
+	return GenerateUpdateMask(p, r) == ""
+}
+
+// GenerateUpdateMask returns the comma separated list of fields that differ
+// between GRPCRouteParameters and GrpcRoute.
+func GenerateUpdateMask(p v1alpha1.GRPCRouteParameters, r networkservices.GrpcRoute) string {
+	desired := GenerateGRPCRoute(r.Name, p)
+	useObservedDefaults(desired.Rules, r.Rules)
+	mask := []string{}
+	if desired.Description != r.Description {
+		mask = append(mask, "description")
+	}
+	if !cmp.Equal(desired.Labels, r.Labels, cmpopts.EquateEmpty()) {
+		mask = append(mask, "labels")
+	}
+	if !cmp.Equal(desired.Hostnames, r.Hostnames, cmpopts.EquateEmpty()) {
+		mask = append(mask, "hostnames")
+	}
+	if !cmp.Equal(desired.Meshes, r.Meshes, cmpopts.EquateEmpty()) {
+		mask = append(mask, "meshes")
+	}
+	if !cmp.Equal(desired.Gateways, r.Gateways, cmpopts.EquateEmpty()) {
+		mask = append(mask, "gateways")
+	}
+	if !cmp.Equal(desired.Rules, r.Rules, cmpopts.EquateEmpty(), cmpopts.IgnoreFields(networkservices.GrpcRouteMethodMatch{}, "ForceSendFields")) {
+		mask = append(mask, "rules")
+	}
+	return strings.Join(mask, ",")
+}
+
+// useObservedDefaults copies the values the API defaults from the observed
+// rules into the desired rules in which they are unset, so that they don't
+// count as drift.
+func useObservedDefaults(desired, observed []*networkservices.GrpcRouteRouteRule) {
+	for i := range desired {
+		if i >= len(observed) {
+			continue
+		}
+		for j, m := range desired[i].Matches {
+			if j >= len(observed[i].Matches) {
+				continue
+			}
+			o := observed[i].Matches[j]
+			if m.Method != nil && o.Method != nil {
+				if m.Method.Type == "" {
+					m.Method.Type = o.Method.Type
+				}
+				if len(m.Method.ForceSendFields) == 0 {
+					m.Method.CaseSensitive = o.Method.CaseSensitive
+				}
+			}
+			for k, h := range m.Headers {
+				if k < len(o.Headers) && h.Type == "" {
+					h.Type = o.Headers[k].Type
+				}
+			}
+		}
+	}
+}
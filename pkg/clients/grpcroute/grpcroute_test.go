@@ -0,0 +1,129 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcroute
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	networkservices "google.golang.org/api/networkservices/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/networkservices/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	testName    = "projects/foo/locations/global/grpcRoutes/greeter"
+	testMesh    = "projects/foo/locations/global/meshes/default"
+	testService = "projects/foo/locations/global/backendServices/greeter"
+)
+
+func params(m ...func(*v1alpha1.GRPCRouteParameters)) *v1alpha1.GRPCRouteParameters {
+	p := &v1alpha1.GRPCRouteParameters{
+		Location:  "global",
+		Hostnames: []string{"greeter"},
+		Meshes:    []string{testMesh},
+		Rules: []v1alpha1.GRPCRouteRule{{
+			Matches: []v1alpha1.GRPCRouteMatch{{
+				Method: &v1alpha1.GRPCRouteMethodMatch{GRPCService: "helloworld.Greeter", GRPCMethod: "SayHello"},
+			}},
+			Action: v1alpha1.GRPCRouteAction{
+				Destinations: []v1alpha1.RouteDestination{{ServiceName: testService}},
+			},
+		}},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func route(m ...func(*networkservices.GrpcRoute)) *networkservices.GrpcRoute {
+	r := &networkservices.GrpcRoute{
+		Name:      testName,
+		Hostnames: []string{"greeter"},
+		Meshes:    []string{testMesh},
+		Rules: []*networkservices.GrpcRouteRouteRule{{
+			Matches: []*networkservices.GrpcRouteRouteMatch{{
+				Method: &networkservices.GrpcRouteMethodMatch{
+					Type:          "EXACT",
+					GrpcService:   "helloworld.Greeter",
+					GrpcMethod:    "SayHello",
+					CaseSensitive: true,
+				},
+			}},
+			Action: &networkservices.GrpcRouteRouteAction{
+				Destinations: []*networkservices.GrpcRouteDestination{{ServiceName: testService}},
+			},
+		}},
+	}
+	for _, f := range m {
+		f(r)
+	}
+	return r
+}
+
+func TestGenerateGRPCRoute(t *testing.T) {
+	p := params(func(p *v1alpha1.GRPCRouteParameters) {
+		p.Rules[0].Matches[0].Method.CaseSensitive = gcp.BoolPtr(false)
+	})
+	want := route(func(r *networkservices.GrpcRoute) {
+		r.Rules[0].Matches[0].Method = &networkservices.GrpcRouteMethodMatch{
+			GrpcService:     "helloworld.Greeter",
+			GrpcMethod:      "SayHello",
+			ForceSendFields: []string{"CaseSensitive"},
+		}
+	})
+	if diff := cmp.Diff(want, GenerateGRPCRoute(testName, *p)); diff != "" {
+		t.Errorf("GenerateGRPCRoute(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateUpdateMask(t *testing.T) {
+	cases := map[string]struct {
+		p    *v1alpha1.GRPCRouteParameters
+		r    *networkservices.GrpcRoute
+		want string
+	}{
+		"UpToDateWithDefaults": {
+			p:    params(),
+			r:    route(),
+			want: "",
+		},
+		"CaseInsensitive": {
+			p: params(func(p *v1alpha1.GRPCRouteParameters) {
+				p.Rules[0].Matches[0].Method.CaseSensitive = gcp.BoolPtr(false)
+			}),
+			r:    route(),
+			want: "rules",
+		},
+		"HostnameAdded": {
+			p: params(func(p *v1alpha1.GRPCRouteParameters) {
+				p.Hostnames = append(p.Hostnames, "greeter.example.com")
+			}),
+			r:    route(),
+			want: "hostnames",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, GenerateUpdateMask(*tc.p, *tc.r)); diff != "" {
+				t.Errorf("GenerateUpdateMask(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
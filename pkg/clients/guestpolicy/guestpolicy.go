@@ -0,0 +1,169 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package guestpolicy
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	osconfig "google.golang.org/api/osconfig/v1beta"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/osconfig/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	parentFormat = "projects/%s"
+	nameFormat   = "projects/%s/guestPolicies/%s"
+)
+
+// GetParent returns the project the GuestPolicy lives under.
+func GetParent(projectID string) string {
+	return fmt.Sprintf(parentFormat, projectID)
+}
+
+// GetFullyQualifiedName builds the relative resource name of the
+// GuestPolicy.
+func GetFullyQualifiedName(projectID, name string) string {
+	return fmt.Sprintf(nameFormat, projectID, name)
+}
+
+// GenerateGuestPolicy produces a GuestPolicy that is configured via given
+// GuestPolicyParameters.
+func GenerateGuestPolicy(name string, p v1alpha1.GuestPolicyParameters) *osconfig.GuestPolicy {
+	gp := &osconfig.GuestPolicy{
+		Name:        name,
+		Description: gcp.StringValue(p.Description),
+		Assignment: &osconfig.Assignment{
+			Zones:                p.Assignment.Zones,
+			Instances:            p.Assignment.Instances,
+			InstanceNamePrefixes: p.Assignment.InstanceNamePrefixes,
+		},
+	}
+	for _, gl := range p.Assignment.GroupLabels {
+		gp.Assignment.GroupLabels = append(gp.Assignment.GroupLabels, &osconfig.AssignmentGroupLabel{Labels: gl.Labels})
+	}
+	for _, t := range p.Assignment.OsTypes {
+		gp.Assignment.OsTypes = append(gp.Assignment.OsTypes, &osconfig.AssignmentOsType{
+			OsShortName:    gcp.StringValue(t.OsShortName),
+			OsVersion:      gcp.StringValue(t.OsVersion),
+			OsArchitecture: gcp.StringValue(t.OsArchitecture),
+		})
+	}
+	for _, pkg := range p.Packages {
+		gp.Packages = append(gp.Packages, &osconfig.Package{
+			Name:         pkg.Name,
+			DesiredState: gcp.StringValue(pkg.DesiredState),
+			Manager:      gcp.StringValue(pkg.Manager),
+		})
+	}
+	for _, r := range p.PackageRepositories {
+		gp.PackageRepositories = append(gp.PackageRepositories, generatePackageRepository(r))
+	}
+	return gp
+}
+
+func generatePackageRepository(in v1alpha1.PackageRepository) *osconfig.PackageRepository {
+	r := &osconfig.PackageRepository{}
+	if in.Apt != nil {
+		r.Apt = &osconfig.AptRepository{
+			ArchiveType:  gcp.StringValue(in.Apt.ArchiveType),
+			Uri:          in.Apt.URI,
+			Distribution: in.Apt.Distribution,
+			Components:   in.Apt.Components,
+			GpgKey:       gcp.StringValue(in.Apt.GpgKey),
+		}
+	}
+	if in.Yum != nil {
+		r.Yum = &osconfig.YumRepository{
+			Id:          in.Yum.ID,
+			DisplayName: gcp.StringValue(in.Yum.DisplayName),
+			BaseUrl:     in.Yum.BaseURL,
+			GpgKeys:     in.Yum.GpgKeys,
+		}
+	}
+	if in.Zypper != nil {
+		r.Zypper = &osconfig.ZypperRepository{
+			Id:          in.Zypper.ID,
+			DisplayName: gcp.StringValue(in.Zypper.DisplayName),
+			BaseUrl:     in.Zypper.BaseURL,
+			GpgKeys:     in.Zypper.GpgKeys,
+		}
+	}
+	if in.Goo != nil {
+		r.Goo = &osconfig.GooRepository{
+			Name: in.Goo.Name,
+			Url:  in.Goo.URL,
+		}
+	}
+	return r
+}
+
+// GenerateObservation produces a GuestPolicyObservation from the supplied
+// GuestPolicy.
+func GenerateObservation(gp osconfig.GuestPolicy) v1alpha1.GuestPolicyObservation {
+	return v1alpha1.GuestPolicyObservation{
+		Name:       gp.Name,
+		CreateTime: gp.CreateTime,
+		UpdateTime: gp.UpdateTime,
+		Etag:       gp.Etag,
+	}
+}
+
+// LateInitialize fills the empty fields of GuestPolicyParameters if the
+// corresponding fields are given in GuestPolicy.
+func LateInitialize(p *v1alpha1.GuestPolicyParameters, gp osconfig.GuestPolicy) {
+	p.Description = gcp.LateInitializeString(p.Description, gp.Description)
+	if len(p.Packages) != len(gp.Packages) {
+		return
+	}
+	for i, pkg := range gp.Packages {
+		if pkg == nil || p.Packages[i].Name != pkg.Name {
+			continue
+		}
+		p.Packages[i].DesiredState = gcp.LateInitializeString(p.Packages[i].DesiredState, pkg.DesiredState)
+		p.Packages[i].Manager = gcp.LateInitializeString(p.Packages[i].Manager, pkg.Manager)
+	}
+}
+
+// IsUpToDate checks whether GuestPolicy is configured with given
+// GuestPolicyParameters.
+func IsUpToDate(p v1alpha1.GuestPolicyParameters, gp osconfig.GuestPolicy) bool {
+	return GenerateUpdateMask(p, gp) == ""
+}
+
+// GenerateUpdateMask returns the comma separated list of fields that differ
+// between GuestPolicyParameters and GuestPolicy.
+func GenerateUpdateMask(p v1alpha1.GuestPolicyParameters, gp osconfig.GuestPolicy) string {
+	desired := GenerateGuestPolicy(gp.Name, p)
+	mask := []string{}
+	if desired.Description != gp.Description {
+		mask = append(mask, "description")
+	}
+	if !cmp.Equal(desired.Assignment, gp.Assignment, cmpopts.EquateEmpty()) {
+		mask = append(mask, "assignment")
+	}
+	if !cmp.Equal(desired.Packages, gp.Packages, cmpopts.EquateEmpty()) {
+		mask = append(mask, "packages")
+	}
+	if !cmp.Equal(desired.PackageRepositories, gp.PackageRepositories, cmpopts.EquateEmpty()) {
+		mask = append(mask, "packageRepositories")
+	}
+	return strings.Join(mask, ",")
+}
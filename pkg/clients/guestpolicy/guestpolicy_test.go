@@ -0,0 +1,122 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package guestpolicy
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	osconfig "google.golang.org/api/osconfig/v1beta"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/osconfig/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const testName = "projects/foo/guestPolicies/web"
+
+func params(m ...func(*v1alpha1.GuestPolicyParameters)) *v1alpha1.GuestPolicyParameters {
+	p := &v1alpha1.GuestPolicyParameters{
+		Assignment: v1alpha1.Assignment{
+			OsTypes: []v1alpha1.AssignmentOsType{{OsShortName: gcp.StringPtr("debian")}},
+		},
+		Packages: []v1alpha1.Package{{Name: "nginx", DesiredState: gcp.StringPtr("INSTALLED")}},
+		PackageRepositories: []v1alpha1.PackageRepository{{
+			Apt: &v1alpha1.AptRepository{
+				URI:          "https://nginx.org/packages/debian",
+				Distribution: "bullseye",
+				Components:   []string{"nginx"},
+			},
+		}},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func guestPolicy(m ...func(*osconfig.GuestPolicy)) *osconfig.GuestPolicy {
+	gp := &osconfig.GuestPolicy{
+		Name: testName,
+		Etag: "abc",
+		Assignment: &osconfig.Assignment{
+			OsTypes: []*osconfig.AssignmentOsType{{OsShortName: "debian"}},
+		},
+		Packages: []*osconfig.Package{{Name: "nginx", DesiredState: "INSTALLED", Manager: "ANY"}},
+		PackageRepositories: []*osconfig.PackageRepository{{
+			Apt: &osconfig.AptRepository{
+				Uri:          "https://nginx.org/packages/debian",
+				Distribution: "bullseye",
+				Components:   []string{"nginx"},
+			},
+		}},
+	}
+	for _, f := range m {
+		f(gp)
+	}
+	return gp
+}
+
+func TestLateInitialize(t *testing.T) {
+	p := params()
+	LateInitialize(p, *guestPolicy())
+	want := params(func(p *v1alpha1.GuestPolicyParameters) {
+		p.Packages[0].Manager = gcp.StringPtr("ANY")
+	})
+	if diff := cmp.Diff(want, p); diff != "" {
+		t.Errorf("LateInitialize(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateUpdateMask(t *testing.T) {
+	cases := map[string]struct {
+		p    *v1alpha1.GuestPolicyParameters
+		gp   *osconfig.GuestPolicy
+		want string
+	}{
+		"UpToDate": {
+			p: params(func(p *v1alpha1.GuestPolicyParameters) {
+				p.Packages[0].Manager = gcp.StringPtr("ANY")
+			}),
+			gp:   guestPolicy(),
+			want: "",
+		},
+		"PackageAdded": {
+			p: params(func(p *v1alpha1.GuestPolicyParameters) {
+				p.Packages[0].Manager = gcp.StringPtr("ANY")
+				p.Packages = append(p.Packages, v1alpha1.Package{Name: "telnet", DesiredState: gcp.StringPtr("REMOVED")})
+			}),
+			gp:   guestPolicy(),
+			want: "packages",
+		},
+		"AssignmentAndRepositoriesChanged": {
+			p: params(func(p *v1alpha1.GuestPolicyParameters) {
+				p.Packages[0].Manager = gcp.StringPtr("ANY")
+				p.Assignment.Zones = []string{"us-central1-a"}
+				p.PackageRepositories = nil
+			}),
+			gp:   guestPolicy(),
+			want: "assignment,packageRepositories",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, GenerateUpdateMask(*tc.p, *tc.gp)); diff != "" {
+				t.Errorf("GenerateUpdateMask(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package healthcaredataset
+
+import (
+	"fmt"
+
+	healthcare "google.golang.org/api/healthcare/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/healthcare/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	parentFormat = "projects/%s/locations/%s"
+	nameFormat   = "projects/%s/locations/%s/datasets/%s"
+)
+
+// GetParent returns the location a Dataset lives in.
+func GetParent(project, location string) string {
+	return fmt.Sprintf(parentFormat, project, location)
+}
+
+// GetFullyQualifiedName builds the relative resource name of a Dataset.
+func GetFullyQualifiedName(project, location, name string) string {
+	return fmt.Sprintf(nameFormat, project, location, name)
+}
+
+// GenerateDataset produces a Dataset that is configured via given
+// DatasetParameters.
+func GenerateDataset(name string, p v1alpha1.DatasetParameters) *healthcare.Dataset {
+	return &healthcare.Dataset{
+		Name:     name,
+		TimeZone: gcp.StringValue(p.TimeZone),
+	}
+}
+
+// GenerateObservation produces a DatasetObservation from the supplied
+// Dataset.
+func GenerateObservation(ds healthcare.Dataset) v1alpha1.DatasetObservation {
+	return v1alpha1.DatasetObservation{
+		Name: ds.Name,
+	}
+}
+
+// LateInitialize fills the empty fields of DatasetParameters if the
+// corresponding fields are given in Dataset.
+func LateInitialize(p *v1alpha1.DatasetParameters, ds healthcare.Dataset) {
+	p.TimeZone = gcp.LateInitializeString(p.TimeZone, ds.TimeZone)
+}
+
+// IsUpToDate checks whether Dataset is configured with given
+// DatasetParameters.
+func IsUpToDate(p v1alpha1.DatasetParameters, ds healthcare.Dataset) bool {
+	return gcp.StringValue(p.TimeZone) == ds.TimeZone
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package healthcaredataset
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	healthcare "google.golang.org/api/healthcare/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/healthcare/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+func TestGenerateDataset(t *testing.T) {
+	want := &healthcare.Dataset{
+		Name:     "projects/fooproject/locations/us-central1/datasets/foo",
+		TimeZone: "America/New_York",
+	}
+	got := GenerateDataset(GetFullyQualifiedName("fooproject", "us-central1", "foo"), v1alpha1.DatasetParameters{
+		Location: "us-central1",
+		TimeZone: gcp.StringPtr("America/New_York"),
+	})
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("GenerateDataset(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitialize(t *testing.T) {
+	cases := map[string]struct {
+		params   *v1alpha1.DatasetParameters
+		observed *healthcare.Dataset
+		want     *v1alpha1.DatasetParameters
+	}{
+		"TimeZoneEmpty": {
+			params:   &v1alpha1.DatasetParameters{Location: "us-central1"},
+			observed: &healthcare.Dataset{TimeZone: "UTC"},
+			want:     &v1alpha1.DatasetParameters{Location: "us-central1", TimeZone: gcp.StringPtr("UTC")},
+		},
+		"TimeZoneSet": {
+			params:   &v1alpha1.DatasetParameters{Location: "us-central1", TimeZone: gcp.StringPtr("America/New_York")},
+			observed: &healthcare.Dataset{TimeZone: "UTC"},
+			want:     &v1alpha1.DatasetParameters{Location: "us-central1", TimeZone: gcp.StringPtr("America/New_York")},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitialize(tc.params, *tc.observed)
+			if diff := cmp.Diff(tc.want, tc.params); diff != "" {
+				t.Errorf("LateInitialize(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		params   *v1alpha1.DatasetParameters
+		observed *healthcare.Dataset
+		want     bool
+	}{
+		"UpToDate": {
+			params:   &v1alpha1.DatasetParameters{TimeZone: gcp.StringPtr("UTC")},
+			observed: &healthcare.Dataset{TimeZone: "UTC"},
+			want:     true,
+		},
+		"TimeZoneChanged": {
+			params:   &v1alpha1.DatasetParameters{TimeZone: gcp.StringPtr("America/New_York")},
+			observed: &healthcare.Dataset{TimeZone: "UTC"},
+			want:     false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsUpToDate(*tc.params, *tc.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hl7v2store
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	healthcare "google.golang.org/api/healthcare/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/healthcare/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	nameFormat = "%s/hl7V2Stores/%s"
+
+	parserVersionUnspecified = "PARSER_VERSION_UNSPECIFIED"
+)
+
+// GetFullyQualifiedName builds the relative resource name of an HL7V2Store
+// in the supplied dataset.
+func GetFullyQualifiedName(dataset, name string) string {
+	return fmt.Sprintf(nameFormat, dataset, name)
+}
+
+// GenerateHL7V2Store produces an Hl7V2Store that is configured via given
+// HL7V2StoreParameters.
+func GenerateHL7V2Store(name string, p v1alpha1.HL7V2StoreParameters) *healthcare.Hl7V2Store {
+	s := &healthcare.Hl7V2Store{
+		Name:                   name,
+		RejectDuplicateMessage: gcp.BoolValue(p.RejectDuplicateMessage),
+		Labels:                 p.Labels,
+	}
+	// Updating the store to false needs the field to be sent.
+	if p.RejectDuplicateMessage != nil {
+		s.ForceSendFields = []string{"RejectDuplicateMessage"}
+	}
+	if pc := p.ParserConfig; pc != nil {
+		s.ParserConfig = &healthcare.ParserConfig{
+			AllowNullHeader:   gcp.BoolValue(pc.AllowNullHeader),
+			SegmentTerminator: gcp.StringValue(pc.SegmentTerminator),
+			Version:           gcp.StringValue(pc.Version),
+		}
+	}
+	for _, nc := range p.NotificationConfigs {
+		s.NotificationConfigs = append(s.NotificationConfigs, &healthcare.Hl7V2NotificationConfig{
+			PubsubTopic: gcp.StringValue(nc.PubsubTopic),
+			Filter:      gcp.StringValue(nc.Filter),
+		})
+	}
+	return s
+}
+
+// GenerateObservation produces an HL7V2StoreObservation from the supplied
+// Hl7V2Store.
+func GenerateObservation(s healthcare.Hl7V2Store) v1alpha1.HL7V2StoreObservation {
+	return v1alpha1.HL7V2StoreObservation{
+		Name: s.Name,
+	}
+}
+
+// LateInitialize fills the empty fields of HL7V2StoreParameters if the
+// corresponding fields are given in Hl7V2Store.
+func LateInitialize(p *v1alpha1.HL7V2StoreParameters, s healthcare.Hl7V2Store) {
+	p.RejectDuplicateMessage = gcp.LateInitializeBool(p.RejectDuplicateMessage, s.RejectDuplicateMessage)
+	if pc := s.ParserConfig; pc != nil {
+		if p.ParserConfig == nil {
+			p.ParserConfig = &v1alpha1.ParserConfig{}
+		}
+		p.ParserConfig.AllowNullHeader = gcp.LateInitializeBool(p.ParserConfig.AllowNullHeader, pc.AllowNullHeader)
+		p.ParserConfig.SegmentTerminator = gcp.LateInitializeString(p.ParserConfig.SegmentTerminator, pc.SegmentTerminator)
+		if pc.Version != parserVersionUnspecified {
+			p.ParserConfig.Version = gcp.LateInitializeString(p.ParserConfig.Version, pc.Version)
+		}
+	}
+	p.Labels = gcp.LateInitializeStringMap(p.Labels, s.Labels)
+}
+
+// IsUpToDate checks whether Hl7V2Store is configured with given
+// HL7V2StoreParameters.
+func IsUpToDate(p v1alpha1.HL7V2StoreParameters, s healthcare.Hl7V2Store) bool {
+	return GenerateUpdateMask(p, s) == ""
+}
+
+// GenerateUpdateMask returns the comma separated list of the updatable fields
+// that differ between HL7V2StoreParameters and Hl7V2Store. The parser
+// configuration cannot be updated.
+func GenerateUpdateMask(p v1alpha1.HL7V2StoreParameters, s healthcare.Hl7V2Store) string {
+	desired := GenerateHL7V2Store(s.Name, p)
+	mask := []string{}
+	if desired.RejectDuplicateMessage != s.RejectDuplicateMessage {
+		mask = append(mask, "rejectDuplicateMessage")
+	}
+	if !cmp.Equal(desired.NotificationConfigs, s.NotificationConfigs, cmpopts.EquateEmpty()) {
+		mask = append(mask, "notificationConfigs")
+	}
+	if !cmp.Equal(desired.Labels, s.Labels, cmpopts.EquateEmpty()) {
+		mask = append(mask, "labels")
+	}
+	return strings.Join(mask, ",")
+}
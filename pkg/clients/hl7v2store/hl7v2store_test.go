@@ -0,0 +1,145 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hl7v2store
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	healthcare "google.golang.org/api/healthcare/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/healthcare/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+func params(m ...func(*v1alpha1.HL7V2StoreParameters)) *v1alpha1.HL7V2StoreParameters {
+	p := &v1alpha1.HL7V2StoreParameters{
+		Dataset:                gcp.StringPtr("projects/fooproject/locations/us-central1/datasets/foo"),
+		RejectDuplicateMessage: gcp.BoolPtr(true),
+		ParserConfig: &v1alpha1.ParserConfig{
+			AllowNullHeader:   gcp.BoolPtr(false),
+			SegmentTerminator: gcp.StringPtr("DQ=="),
+			Version:           gcp.StringPtr("V1"),
+		},
+		NotificationConfigs: []v1alpha1.HL7V2NotificationConfig{
+			{PubsubTopic: gcp.StringPtr("projects/fooproject/topics/baz"), Filter: gcp.StringPtr(`messageType = "ADT"`)},
+		},
+		Labels: map[string]string{"foo": "bar"},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func observed(m ...func(*healthcare.Hl7V2Store)) *healthcare.Hl7V2Store {
+	s := &healthcare.Hl7V2Store{
+		Name:                   "projects/fooproject/locations/us-central1/datasets/foo/hl7V2Stores/bar",
+		RejectDuplicateMessage: true,
+		ParserConfig: &healthcare.ParserConfig{
+			SegmentTerminator: "DQ==",
+			Version:           "V1",
+		},
+		NotificationConfigs: []*healthcare.Hl7V2NotificationConfig{
+			{PubsubTopic: "projects/fooproject/topics/baz", Filter: `messageType = "ADT"`},
+		},
+		Labels: map[string]string{"foo": "bar"},
+	}
+	for _, f := range m {
+		f(s)
+	}
+	return s
+}
+
+func TestLateInitialize(t *testing.T) {
+	cases := map[string]struct {
+		params   *v1alpha1.HL7V2StoreParameters
+		observed *healthcare.Hl7V2Store
+		want     *v1alpha1.HL7V2StoreParameters
+	}{
+		"AllFilled": {
+			params:   params(),
+			observed: observed(),
+			want:     params(),
+		},
+		"ParserConfigEmpty": {
+			params: params(func(p *v1alpha1.HL7V2StoreParameters) {
+				p.ParserConfig = nil
+			}),
+			observed: observed(),
+			want: params(func(p *v1alpha1.HL7V2StoreParameters) {
+				p.ParserConfig.AllowNullHeader = nil
+			}),
+		},
+		"UnspecifiedParserVersion": {
+			params: params(func(p *v1alpha1.HL7V2StoreParameters) {
+				p.ParserConfig.Version = nil
+			}),
+			observed: observed(func(s *healthcare.Hl7V2Store) {
+				s.ParserConfig.Version = parserVersionUnspecified
+			}),
+			want: params(func(p *v1alpha1.HL7V2StoreParameters) {
+				p.ParserConfig.Version = nil
+			}),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitialize(tc.params, *tc.observed)
+			if diff := cmp.Diff(tc.want, tc.params); diff != "" {
+				t.Errorf("LateInitialize(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateUpdateMask(t *testing.T) {
+	cases := map[string]struct {
+		params   *v1alpha1.HL7V2StoreParameters
+		observed *healthcare.Hl7V2Store
+		want     string
+	}{
+		"UpToDate": {
+			params:   params(),
+			observed: observed(),
+			want:     "",
+		},
+		"ParserConfigIgnored": {
+			params: params(func(p *v1alpha1.HL7V2StoreParameters) {
+				p.ParserConfig.Version = gcp.StringPtr("V3")
+			}),
+			observed: observed(),
+			want:     "",
+		},
+		"Outdated": {
+			params: params(func(p *v1alpha1.HL7V2StoreParameters) {
+				p.RejectDuplicateMessage = gcp.BoolPtr(false)
+				p.NotificationConfigs[0].Filter = nil
+			}),
+			observed: observed(),
+			want:     "rejectDuplicateMessage,notificationConfigs",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateUpdateMask(*tc.params, *tc.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateUpdateMask(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
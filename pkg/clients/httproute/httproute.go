@@ -0,0 +1,231 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package httproute
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	networkservices "google.golang.org/api/networkservices/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/networkservices/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	parentFormat = "projects/%s/locations/%s"
+	nameFormat   = "projects/%s/locations/%s/httpRoutes/%s"
+)
+
+// GetParent returns the location the HTTPRoute lives under.
+func GetParent(projectID, location string) string {
+	return fmt.Sprintf(parentFormat, projectID, location)
+}
+
+// GetFullyQualifiedName builds the relative resource name of the HTTPRoute.
+func GetFullyQualifiedName(projectID, location, name string) string {
+	return fmt.Sprintf(nameFormat, projectID, location, name)
+}
+
+// GenerateHTTPRoute produces an HttpRoute that is configured via given
+// HTTPRouteParameters.
+func GenerateHTTPRoute(name string, p v1alpha1.HTTPRouteParameters) *networkservices.HttpRoute {
+	r := &networkservices.HttpRoute{
+		Name:        name,
+		Description: gcp.StringValue(p.Description),
+		Labels:      p.Labels,
+		Hostnames:   p.Hostnames,
+		Meshes:      p.Meshes,
+		Gateways:    p.Gateways,
+	}
+	for _, rule := range p.Rules {
+		rr := &networkservices.HttpRouteRouteRule{Action: generateAction(rule.Action)}
+		for _, m := range rule.Matches {
+			rr.Matches = append(rr.Matches, generateMatch(m))
+		}
+		r.Rules = append(r.Rules, rr)
+	}
+	return r
+}
+
+func generateMatch(in v1alpha1.HTTPRouteMatch) *networkservices.HttpRouteRouteMatch {
+	m := &networkservices.HttpRouteRouteMatch{
+		FullPathMatch: in.FullPathMatch,
+		PrefixMatch:   in.PrefixMatch,
+		RegexMatch:    in.RegexMatch,
+		IgnoreCase:    in.IgnoreCase,
+	}
+	for _, h := range in.Headers {
+		hm := &networkservices.HttpRouteHeaderMatch{
+			Header:       h.Header,
+			ExactMatch:   h.ExactMatch,
+			PrefixMatch:  h.PrefixMatch,
+			SuffixMatch:  h.SuffixMatch,
+			RegexMatch:   h.RegexMatch,
+			PresentMatch: h.PresentMatch,
+			InvertMatch:  h.InvertMatch,
+		}
+		if h.RangeMatch != nil {
+			hm.RangeMatch = &networkservices.HttpRouteHeaderMatchIntegerRange{Start: h.RangeMatch.Start, End: h.RangeMatch.End}
+		}
+		m.Headers = append(m.Headers, hm)
+	}
+	for _, q := range in.QueryParameters {
+		m.QueryParameters = append(m.QueryParameters, &networkservices.HttpRouteQueryParameterMatch{
+			QueryParameter: q.QueryParameter,
+			ExactMatch:     q.ExactMatch,
+			RegexMatch:     q.RegexMatch,
+			PresentMatch:   q.PresentMatch,
+		})
+	}
+	return m
+}
+
+func generateAction(in v1alpha1.HTTPRouteAction) *networkservices.HttpRouteRouteAction {
+	a := &networkservices.HttpRouteRouteAction{
+		Timeout:                in.Timeout,
+		RequestHeaderModifier:  generateHeaderModifier(in.RequestHeaderModifier),
+		ResponseHeaderModifier: generateHeaderModifier(in.ResponseHeaderModifier),
+	}
+	for _, d := range in.Destinations {
+		a.Destinations = append(a.Destinations, generateDestination(d))
+	}
+	if r := in.Redirect; r != nil {
+		a.Redirect = &networkservices.HttpRouteRedirect{
+			HostRedirect:  r.HostRedirect,
+			PathRedirect:  r.PathRedirect,
+			PrefixRewrite: r.PrefixRewrite,
+			PortRedirect:  r.PortRedirect,
+			HttpsRedirect: r.HTTPSRedirect,
+			StripQuery:    r.StripQuery,
+			ResponseCode:  r.ResponseCode,
+		}
+	}
+	if f := in.FaultInjectionPolicy; f != nil {
+		a.FaultInjectionPolicy = &networkservices.HttpRouteFaultInjectionPolicy{}
+		if f.Delay != nil {
+			a.FaultInjectionPolicy.Delay = &networkservices.HttpRouteFaultInjectionPolicyDelay{FixedDelay: f.Delay.FixedDelay, Percentage: f.Delay.Percentage}
+		}
+		if f.Abort != nil {
+			a.FaultInjectionPolicy.Abort = &networkservices.HttpRouteFaultInjectionPolicyAbort{HttpStatus: f.Abort.HTTPStatus, Percentage: f.Abort.Percentage}
+		}
+	}
+	if u := in.URLRewrite; u != nil {
+		a.UrlRewrite = &networkservices.HttpRouteURLRewrite{HostRewrite: u.HostRewrite, PathPrefixRewrite: u.PathPrefixRewrite}
+	}
+	if r := in.RetryPolicy; r != nil {
+		a.RetryPolicy = &networkservices.HttpRouteRetryPolicy{
+			RetryConditions: r.RetryConditions,
+			NumRetries:      r.NumRetries,
+			PerTryTimeout:   r.PerTryTimeout,
+		}
+	}
+	if m := in.RequestMirrorPolicy; m != nil {
+		a.RequestMirrorPolicy = &networkservices.HttpRouteRequestMirrorPolicy{Destination: generateDestination(m.Destination)}
+	}
+	if c := in.CORSPolicy; c != nil {
+		a.CorsPolicy = &networkservices.HttpRouteCorsPolicy{
+			AllowOrigins:       c.AllowOrigins,
+			AllowOriginRegexes: c.AllowOriginRegexes,
+			AllowMethods:       c.AllowMethods,
+			AllowHeaders:       c.AllowHeaders,
+			ExposeHeaders:      c.ExposeHeaders,
+			MaxAge:             c.MaxAge,
+			AllowCredentials:   c.AllowCredentials,
+			Disabled:           c.Disabled,
+		}
+	}
+	return a
+}
+
+func generateDestination(in v1alpha1.RouteDestination) *networkservices.HttpRouteDestination {
+	return &networkservices.HttpRouteDestination{ServiceName: in.ServiceName, Weight: in.Weight}
+}
+
+func generateHeaderModifier(in *v1alpha1.HTTPRouteHeaderModifier) *networkservices.HttpRouteHeaderModifier {
+	if in == nil {
+		return nil
+	}
+	return &networkservices.HttpRouteHeaderModifier{Add: in.Add, Set: in.Set, Remove: in.Remove}
+}
+
+// GenerateObservation produces an HTTPRouteObservation from the supplied
+// HttpRoute.
+func GenerateObservation(r networkservices.HttpRoute) v1alpha1.HTTPRouteObservation {
+	return v1alpha1.HTTPRouteObservation{
+		Name:       r.Name,
+		SelfLink:   r.SelfLink,
+		CreateTime: r.CreateTime,
+		UpdateTime: r.UpdateTime,
+	}
+}
+
+// LateInitialize fills the empty fields of HTTPRouteParameters if the
+// corresponding fields are given in HttpRoute.
+func LateInitialize(p *v1alpha1.HTTPRouteParameters, r networkservices.HttpRoute) {
+	p.Description = gcp.LateInitializeString(p.Description, r.Description)
+}
+
+// IsUpToDate checks whether HttpRoute is configured with given
+// HTTPRouteParameters.
+func IsUpToDate(p v1alpha1.HTTPRouteParameters, r networkservices.HttpRoute) bool {
+	return GenerateUpdateMask(p, r) == ""
+}
+
+// GenerateUpdateMask returns the comma separated list of fields that differ
+// between HTTPRouteParameters and HttpRoute.
+func GenerateUpdateMask(p v1alpha1.HTTPRouteParameters, r networkservices.HttpRoute) string {
+	desired := GenerateHTTPRoute(r.Name, p)
+	useObservedDefaults(desired.Rules, r.Rules)
+	mask := []string{}
+	if desired.Description != r.Description {
+		mask = append(mask, "description")
+	}
+	if !cmp.Equal(desired.Labels, r.Labels, cmpopts.EquateEmpty()) {
+		mask = append(mask, "labels")
+	}
+	if !cmp.Equal(desired.Hostnames, r.Hostnames, cmpopts.EquateEmpty()) {
+		mask = append(mask, "hostnames")
+	}
+	if !cmp.Equal(desired.Meshes, r.Meshes, cmpopts.EquateEmpty()) {
+		mask = append(mask, "meshes")
+	}
+	if !cmp.Equal(desired.Gateways, r.Gateways, cmpopts.EquateEmpty()) {
+		mask = append(mask, "gateways")
+	}
+	if !cmp.Equal(desired.Rules, r.Rules, cmpopts.EquateEmpty()) {
+		mask = append(mask, "rules")
+	}
+	return strings.Join(mask, ",")
+}
+
+// useObservedDefaults copies the values the API defaults from the observed
+// rules into the desired rules in which they are unset, so that they don't
+// count as drift.
+func useObservedDefaults(desired, observed []*networkservices.HttpRouteRouteRule) {
+	for i := range desired {
+		if i >= len(observed) || desired[i].Action == nil || observed[i].Action == nil {
+			continue
+		}
+		d, o := desired[i].Action.Redirect, observed[i].Action.Redirect
+		if d != nil && o != nil && d.ResponseCode == "" {
+			d.ResponseCode = o.ResponseCode
+		}
+	}
+}
@@ -0,0 +1,160 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package httproute
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	networkservices "google.golang.org/api/networkservices/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/networkservices/v1alpha1"
+)
+
+const (
+	testName    = "projects/foo/locations/global/httpRoutes/web"
+	testMesh    = "projects/foo/locations/global/meshes/default"
+	testService = "projects/foo/locations/global/backendServices/web"
+	testCanary  = "projects/foo/locations/global/backendServices/web-canary"
+)
+
+func params(m ...func(*v1alpha1.HTTPRouteParameters)) *v1alpha1.HTTPRouteParameters {
+	p := &v1alpha1.HTTPRouteParameters{
+		Location:  "global",
+		Hostnames: []string{"web.example.com"},
+		Meshes:    []string{testMesh},
+		Rules: []v1alpha1.HTTPRouteRule{
+			{
+				Matches: []v1alpha1.HTTPRouteMatch{{
+					PrefixMatch: "/api",
+					Headers:     []v1alpha1.HTTPRouteHeaderMatch{{Header: "x-canary", ExactMatch: "true"}},
+				}},
+				Action: v1alpha1.HTTPRouteAction{
+					Destinations: []v1alpha1.RouteDestination{{ServiceName: testCanary}},
+					Timeout:      "10s",
+					RetryPolicy:  &v1alpha1.HTTPRouteRetryPolicy{RetryConditions: []string{"5xx"}, NumRetries: 3},
+				},
+			},
+			{
+				Action: v1alpha1.HTTPRouteAction{
+					Destinations: []v1alpha1.RouteDestination{{ServiceName: testService}},
+				},
+			},
+		},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func route(m ...func(*networkservices.HttpRoute)) *networkservices.HttpRoute {
+	r := &networkservices.HttpRoute{
+		Name:      testName,
+		Hostnames: []string{"web.example.com"},
+		Meshes:    []string{testMesh},
+		Rules: []*networkservices.HttpRouteRouteRule{
+			{
+				Matches: []*networkservices.HttpRouteRouteMatch{{
+					PrefixMatch: "/api",
+					Headers:     []*networkservices.HttpRouteHeaderMatch{{Header: "x-canary", ExactMatch: "true"}},
+				}},
+				Action: &networkservices.HttpRouteRouteAction{
+					Destinations: []*networkservices.HttpRouteDestination{{ServiceName: testCanary}},
+					Timeout:      "10s",
+					RetryPolicy:  &networkservices.HttpRouteRetryPolicy{RetryConditions: []string{"5xx"}, NumRetries: 3},
+				},
+			},
+			{
+				Action: &networkservices.HttpRouteRouteAction{
+					Destinations: []*networkservices.HttpRouteDestination{{ServiceName: testService}},
+				},
+			},
+		},
+	}
+	for _, f := range m {
+		f(r)
+	}
+	return r
+}
+
+func TestGenerateHTTPRoute(t *testing.T) {
+	if diff := cmp.Diff(route(), GenerateHTTPRoute(testName, *params())); diff != "" {
+		t.Errorf("GenerateHTTPRoute(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateUpdateMask(t *testing.T) {
+	redirect := func(p *v1alpha1.HTTPRouteParameters) {
+		p.Rules[1].Action = v1alpha1.HTTPRouteAction{Redirect: &v1alpha1.HTTPRouteRedirect{HTTPSRedirect: true}}
+	}
+	cases := map[string]struct {
+		p    *v1alpha1.HTTPRouteParameters
+		r    *networkservices.HttpRoute
+		want string
+	}{
+		"UpToDate": {
+			p:    params(),
+			r:    route(),
+			want: "",
+		},
+		"GatewayAttached": {
+			p: params(func(p *v1alpha1.HTTPRouteParameters) {
+				p.Gateways = []string{"projects/foo/locations/global/gateways/ingress"}
+			}),
+			r:    route(),
+			want: "gateways",
+		},
+		"WeightChanged": {
+			p: params(func(p *v1alpha1.HTTPRouteParameters) {
+				p.Rules[0].Action.Destinations = []v1alpha1.RouteDestination{
+					{ServiceName: testCanary, Weight: 10},
+					{ServiceName: testService, Weight: 90},
+				}
+			}),
+			r:    route(),
+			want: "rules",
+		},
+		"DefaultResponseCode": {
+			p: params(redirect),
+			r: route(func(r *networkservices.HttpRoute) {
+				r.Rules[1].Action = &networkservices.HttpRouteRouteAction{
+					Redirect: &networkservices.HttpRouteRedirect{HttpsRedirect: true, ResponseCode: "MOVED_PERMANENTLY_DEFAULT"},
+				}
+			}),
+			want: "",
+		},
+		"ResponseCodeChanged": {
+			p: params(redirect, func(p *v1alpha1.HTTPRouteParameters) {
+				p.Rules[1].Action.Redirect.ResponseCode = "FOUND"
+			}),
+			r: route(func(r *networkservices.HttpRoute) {
+				r.Rules[1].Action = &networkservices.HttpRouteRouteAction{
+					Redirect: &networkservices.HttpRouteRedirect{HttpsRedirect: true, ResponseCode: "MOVED_PERMANENTLY_DEFAULT"},
+				}
+			}),
+			want: "rules",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, GenerateUpdateMask(*tc.p, *tc.r)); diff != "" {
+				t.Errorf("GenerateUpdateMask(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
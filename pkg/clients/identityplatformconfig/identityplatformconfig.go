@@ -0,0 +1,133 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package identityplatformconfig
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	identitytoolkit "google.golang.org/api/identitytoolkit/v2"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/identityplatform/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/tenant"
+)
+
+const (
+	projectFormat = "projects/%s"
+	nameFormat    = "projects/%s/config"
+)
+
+// GetProject returns the project Identity Platform is initialized for.
+func GetProject(projectID string) string {
+	return fmt.Sprintf(projectFormat, projectID)
+}
+
+// GetFullyQualifiedName builds the relative resource name of the Config of
+// a project.
+func GetFullyQualifiedName(projectID string) string {
+	return fmt.Sprintf(nameFormat, projectID)
+}
+
+// GenerateConfig produces a Config that is configured via given
+// ConfigParameters. Only the fields that are set in ConfigParameters are
+// filled.
+func GenerateConfig(name string, p v1alpha1.ConfigParameters) *identitytoolkit.GoogleCloudIdentitytoolkitAdminV2Config {
+	c := &identitytoolkit.GoogleCloudIdentitytoolkitAdminV2Config{
+		Name:                     name,
+		AuthorizedDomains:        p.AuthorizedDomains,
+		Mfa:                      tenant.GenerateMFAConfig(p.MFA),
+		AutodeleteAnonymousUsers: gcp.BoolValue(p.AutodeleteAnonymousUsers),
+	}
+	if s := p.SignIn; s != nil {
+		c.SignIn = &identitytoolkit.GoogleCloudIdentitytoolkitAdminV2SignInConfig{
+			AllowDuplicateEmails: gcp.BoolValue(s.AllowDuplicateEmails),
+			Anonymous:            &identitytoolkit.GoogleCloudIdentitytoolkitAdminV2Anonymous{Enabled: gcp.BoolValue(s.AnonymousEnabled)},
+		}
+		if s.Email != nil {
+			c.SignIn.Email = &identitytoolkit.GoogleCloudIdentitytoolkitAdminV2Email{
+				Enabled:          s.Email.Enabled,
+				PasswordRequired: gcp.BoolValue(s.Email.PasswordRequired),
+			}
+		}
+		if s.PhoneNumber != nil {
+			c.SignIn.PhoneNumber = &identitytoolkit.GoogleCloudIdentitytoolkitAdminV2PhoneNumber{
+				Enabled:          s.PhoneNumber.Enabled,
+				TestPhoneNumbers: s.PhoneNumber.TestPhoneNumbers,
+			}
+		}
+	}
+	if p.MultiTenant != nil {
+		c.MultiTenant = &identitytoolkit.GoogleCloudIdentitytoolkitAdminV2MultiTenantConfig{AllowTenants: p.MultiTenant.AllowTenants}
+	}
+	return c
+}
+
+// GenerateObservation produces a ConfigObservation from the supplied Config.
+func GenerateObservation(c identitytoolkit.GoogleCloudIdentitytoolkitAdminV2Config) v1alpha1.ConfigObservation {
+	return v1alpha1.ConfigObservation{
+		Name:    c.Name,
+		Subtype: c.Subtype,
+	}
+}
+
+// IsUpToDate checks whether Config is configured with given
+// ConfigParameters.
+func IsUpToDate(p v1alpha1.ConfigParameters, c identitytoolkit.GoogleCloudIdentitytoolkitAdminV2Config) bool {
+	return GenerateUpdateMask(p, c) == ""
+}
+
+// GenerateUpdateMask returns the comma separated list of fields that differ
+// between ConfigParameters and Config. Fields that are omitted from
+// ConfigParameters are never part of the mask.
+func GenerateUpdateMask(p v1alpha1.ConfigParameters, c identitytoolkit.GoogleCloudIdentitytoolkitAdminV2Config) string { // nolint:gocyclo
+	desired := GenerateConfig(c.Name, p)
+	mask := []string{}
+	if p.SignIn != nil {
+		observed := c.SignIn
+		if observed == nil {
+			observed = &identitytoolkit.GoogleCloudIdentitytoolkitAdminV2SignInConfig{}
+		}
+		if p.SignIn.AllowDuplicateEmails != nil && desired.SignIn.AllowDuplicateEmails != observed.AllowDuplicateEmails {
+			mask = append(mask, "signIn.allowDuplicateEmails")
+		}
+		if p.SignIn.AnonymousEnabled != nil && !cmp.Equal(desired.SignIn.Anonymous, observed.Anonymous, cmpopts.EquateEmpty()) {
+			mask = append(mask, "signIn.anonymous")
+		}
+		if p.SignIn.Email != nil && !cmp.Equal(desired.SignIn.Email, observed.Email, cmpopts.EquateEmpty()) {
+			mask = append(mask, "signIn.email")
+		}
+		if p.SignIn.PhoneNumber != nil && !cmp.Equal(desired.SignIn.PhoneNumber, observed.PhoneNumber, cmpopts.EquateEmpty()) {
+			mask = append(mask, "signIn.phoneNumber")
+		}
+	}
+	if p.AuthorizedDomains != nil && !cmp.Equal(desired.AuthorizedDomains, c.AuthorizedDomains, cmpopts.EquateEmpty()) {
+		mask = append(mask, "authorizedDomains")
+	}
+	if p.MFA != nil && !cmp.Equal(desired.Mfa, c.Mfa, cmpopts.EquateEmpty()) {
+		mask = append(mask, "mfa")
+	}
+	if p.AutodeleteAnonymousUsers != nil && desired.AutodeleteAnonymousUsers != c.AutodeleteAnonymousUsers {
+		mask = append(mask, "autodeleteAnonymousUsers")
+	}
+	if p.MultiTenant != nil && (c.MultiTenant == nil || desired.MultiTenant.AllowTenants != c.MultiTenant.AllowTenants) {
+		mask = append(mask, "multiTenant.allowTenants")
+	}
+	return strings.Join(mask, ",")
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package identityplatformconfig
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	identitytoolkit "google.golang.org/api/identitytoolkit/v2"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/identityplatform/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const testName = "projects/foo/config"
+
+func config(m ...func(*identitytoolkit.GoogleCloudIdentitytoolkitAdminV2Config)) *identitytoolkit.GoogleCloudIdentitytoolkitAdminV2Config {
+	c := &identitytoolkit.GoogleCloudIdentitytoolkitAdminV2Config{
+		Name:    testName,
+		Subtype: "IDENTITY_PLATFORM",
+		SignIn: &identitytoolkit.GoogleCloudIdentitytoolkitAdminV2SignInConfig{
+			Email:     &identitytoolkit.GoogleCloudIdentitytoolkitAdminV2Email{Enabled: true, PasswordRequired: true},
+			Anonymous: &identitytoolkit.GoogleCloudIdentitytoolkitAdminV2Anonymous{},
+		},
+		AuthorizedDomains: []string{"localhost", "foo.firebaseapp.com"},
+		MultiTenant:       &identitytoolkit.GoogleCloudIdentitytoolkitAdminV2MultiTenantConfig{AllowTenants: true},
+	}
+	for _, f := range m {
+		f(c)
+	}
+	return c
+}
+
+func TestGenerateUpdateMask(t *testing.T) {
+	cases := map[string]struct {
+		params v1alpha1.ConfigParameters
+		obs    *identitytoolkit.GoogleCloudIdentitytoolkitAdminV2Config
+		want   string
+	}{
+		"Unmanaged": {
+			params: v1alpha1.ConfigParameters{},
+			obs:    config(),
+			want:   "",
+		},
+		"UpToDate": {
+			params: v1alpha1.ConfigParameters{
+				SignIn: &v1alpha1.SignInConfig{
+					Email:            &v1alpha1.EmailSignInConfig{Enabled: true, PasswordRequired: gcp.BoolPtr(true)},
+					AnonymousEnabled: gcp.BoolPtr(false),
+				},
+				MultiTenant: &v1alpha1.MultiTenantConfig{AllowTenants: true},
+			},
+			obs:  config(),
+			want: "",
+		},
+		"PhoneEnabledAndDomainAdded": {
+			params: v1alpha1.ConfigParameters{
+				SignIn: &v1alpha1.SignInConfig{
+					PhoneNumber: &v1alpha1.PhoneNumberSignInConfig{Enabled: true},
+				},
+				AuthorizedDomains: []string{"localhost", "foo.firebaseapp.com", "example.com"},
+			},
+			obs:  config(),
+			want: "signIn.phoneNumber,authorizedDomains",
+		},
+		"SignInNotReturned": {
+			params: v1alpha1.ConfigParameters{
+				SignIn:      &v1alpha1.SignInConfig{AllowDuplicateEmails: gcp.BoolPtr(true)},
+				MultiTenant: &v1alpha1.MultiTenantConfig{AllowTenants: true},
+			},
+			obs:  &identitytoolkit.GoogleCloudIdentitytoolkitAdminV2Config{Name: testName},
+			want: "signIn.allowDuplicateEmails,multiTenant.allowTenants",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, GenerateUpdateMask(tc.params, *tc.obs)); diff != "" {
+				t.Errorf("GenerateUpdateMask(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package idsendpoint
+
+import (
+	"fmt"
+
+	ids "google.golang.org/api/ids/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/ids/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	parentFormat = "projects/%s/locations/%s"
+	nameFormat   = "projects/%s/locations/%s/endpoints/%s"
+)
+
+// GetParent returns the location the Endpoint lives under.
+func GetParent(projectID, location string) string {
+	return fmt.Sprintf(parentFormat, projectID, location)
+}
+
+// GetFullyQualifiedName builds the relative resource name of the Endpoint.
+func GetFullyQualifiedName(projectID, location, name string) string {
+	return fmt.Sprintf(nameFormat, projectID, location, name)
+}
+
+// GenerateEndpoint produces an Endpoint that is configured via given
+// EndpointParameters.
+func GenerateEndpoint(p v1alpha1.EndpointParameters) *ids.Endpoint {
+	return &ids.Endpoint{
+		Network:     gcp.StringValue(p.Network),
+		Severity:    p.Severity,
+		Description: gcp.StringValue(p.Description),
+		Labels:      p.Labels,
+		TrafficLogs: gcp.BoolValue(p.TrafficLogs),
+	}
+}
+
+// GenerateObservation produces an EndpointObservation from the supplied
+// Endpoint.
+func GenerateObservation(e ids.Endpoint) v1alpha1.EndpointObservation {
+	return v1alpha1.EndpointObservation{
+		Name:                   e.Name,
+		State:                  e.State,
+		EndpointForwardingRule: e.EndpointForwardingRule,
+		EndpointIP:             e.EndpointIp,
+		CreateTime:             e.CreateTime,
+		UpdateTime:             e.UpdateTime,
+	}
+}
+
+// LateInitialize fills the empty fields of EndpointParameters if the
+// corresponding fields are given in Endpoint.
+func LateInitialize(p *v1alpha1.EndpointParameters, e ids.Endpoint) {
+	p.Network = gcp.LateInitializeString(p.Network, e.Network)
+	p.Description = gcp.LateInitializeString(p.Description, e.Description)
+	p.Labels = gcp.LateInitializeStringMap(p.Labels, e.Labels)
+}
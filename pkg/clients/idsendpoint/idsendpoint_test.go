@@ -0,0 +1,97 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package idsendpoint
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	ids "google.golang.org/api/ids/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/ids/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	testName = "projects/foo/locations/us-central1-a/endpoints/ids"
+	network  = "projects/foo/global/networks/vpc"
+)
+
+func params(m ...func(*v1alpha1.EndpointParameters)) *v1alpha1.EndpointParameters {
+	p := &v1alpha1.EndpointParameters{
+		Location:    "us-central1-a",
+		Network:     gcp.StringPtr(network),
+		Severity:    "MEDIUM",
+		Labels:      map[string]string{"team": "sec"},
+		TrafficLogs: gcp.BoolPtr(true),
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func TestGenerateEndpoint(t *testing.T) {
+	want := &ids.Endpoint{
+		Network:     network,
+		Severity:    "MEDIUM",
+		Labels:      map[string]string{"team": "sec"},
+		TrafficLogs: true,
+	}
+	if diff := cmp.Diff(want, GenerateEndpoint(*params())); diff != "" {
+		t.Errorf("GenerateEndpoint(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateObservation(t *testing.T) {
+	e := ids.Endpoint{
+		Name:                   testName,
+		State:                  v1alpha1.EndpointStateReady,
+		EndpointForwardingRule: "https://www.googleapis.com/compute/v1/projects/tenant/regions/us-central1/forwardingRules/ids-fr",
+		EndpointIp:             "10.1.0.2",
+		CreateTime:             "2023-01-01T00:00:00Z",
+	}
+	want := v1alpha1.EndpointObservation{
+		Name:                   testName,
+		State:                  v1alpha1.EndpointStateReady,
+		EndpointForwardingRule: "https://www.googleapis.com/compute/v1/projects/tenant/regions/us-central1/forwardingRules/ids-fr",
+		EndpointIP:             "10.1.0.2",
+		CreateTime:             "2023-01-01T00:00:00Z",
+	}
+	if diff := cmp.Diff(want, GenerateObservation(e)); diff != "" {
+		t.Errorf("GenerateObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitialize(t *testing.T) {
+	e := ids.Endpoint{
+		Network:     network,
+		Severity:    "MEDIUM",
+		Description: "inspects web traffic",
+		Labels:      map[string]string{"goog-managed": "true"},
+	}
+	want := params(func(p *v1alpha1.EndpointParameters) {
+		p.Description = gcp.StringPtr("inspects web traffic")
+	})
+	got := params(func(p *v1alpha1.EndpointParameters) {
+		p.Network = nil
+	})
+	LateInitialize(got, e)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("LateInitialize(...): -want, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package imageimport
+
+import (
+	"strings"
+
+	compute "google.golang.org/api/compute/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	gsPrefix      = "gs://"
+	storagePrefix = "https://storage.googleapis.com/"
+)
+
+// SourceURL returns the https://storage.googleapis.com/ URL of the supplied
+// Cloud Storage object, which may be given as a gs:// URL. Compute Engine
+// only accepts the former.
+func SourceURL(source string) string {
+	if strings.HasPrefix(source, gsPrefix) {
+		return storagePrefix + strings.TrimPrefix(source, gsPrefix)
+	}
+	return source
+}
+
+// GenerateImage takes an ImageImportParameters and returns the
+// *compute.Image to insert. It assigns only the fields that are writable,
+// i.e. not labelled as [Output Only] in Google's reference.
+func GenerateImage(name string, in v1alpha1.ImageImportParameters) *compute.Image {
+	i := &compute.Image{
+		Name:             name,
+		Description:      gcp.StringValue(in.Description),
+		Family:           gcp.StringValue(in.Family),
+		Architecture:     gcp.StringValue(in.Architecture),
+		Licenses:         in.Licenses,
+		StorageLocations: in.StorageLocations,
+		RawDisk: &compute.ImageRawDisk{
+			Source:       SourceURL(in.Source),
+			Sha1Checksum: gcp.StringValue(in.SHA1Checksum),
+		},
+	}
+	for _, f := range in.GuestOSFeatures {
+		i.GuestOsFeatures = append(i.GuestOsFeatures, &compute.GuestOsFeature{Type: f})
+	}
+	if in.EncryptionKey != nil {
+		i.ImageEncryptionKey = &compute.CustomerEncryptionKey{
+			KmsKeyName:           in.EncryptionKey.KMSKeyName,
+			KmsKeyServiceAccount: gcp.StringValue(in.EncryptionKey.KMSKeyServiceAccount),
+		}
+	}
+	return i
+}
+
+// GenerateImageImportObservation takes a compute.Image and returns
+// *ImageImportObservation.
+func GenerateImageImportObservation(in compute.Image) v1alpha1.ImageImportObservation {
+	return v1alpha1.ImageImportObservation{
+		ArchiveSizeBytes:  in.ArchiveSizeBytes,
+		CreationTimestamp: in.CreationTimestamp,
+		DiskSizeGB:        in.DiskSizeGb,
+		ID:                in.Id,
+		SelfLink:          in.SelfLink,
+		Status:            in.Status,
+	}
+}
+
+// LateInitializeSpec fills unassigned fields with the values in compute.Image
+// object.
+func LateInitializeSpec(spec *v1alpha1.ImageImportParameters, in compute.Image) {
+	spec.Description = gcp.LateInitializeString(spec.Description, in.Description)
+	spec.Family = gcp.LateInitializeString(spec.Family, in.Family)
+	spec.Architecture = gcp.LateInitializeString(spec.Architecture, in.Architecture)
+	spec.StorageLocations = gcp.LateInitializeStringSlice(spec.StorageLocations, in.StorageLocations)
+	if len(spec.GuestOSFeatures) == 0 {
+		for _, f := range in.GuestOsFeatures {
+			spec.GuestOSFeatures = append(spec.GuestOSFeatures, f.Type)
+		}
+	}
+}
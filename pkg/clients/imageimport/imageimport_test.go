@@ -0,0 +1,124 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package imageimport
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/compute/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	testName       = "some-name"
+	testSource     = "gs://my-bucket/disk.tar.gz"
+	testSourceURL  = "https://storage.googleapis.com/my-bucket/disk.tar.gz"
+	testKMSKeyName = "projects/test/locations/us/keyRings/ring/cryptoKeys/key"
+)
+
+func TestGenerateImage(t *testing.T) {
+	cases := map[string]struct {
+		in   v1alpha1.ImageImportParameters
+		want *compute.Image
+	}{
+		"Minimal": {
+			in: v1alpha1.ImageImportParameters{Source: testSourceURL},
+			want: &compute.Image{
+				Name:    testName,
+				RawDisk: &compute.ImageRawDisk{Source: testSourceURL},
+			},
+		},
+		"AllFilled": {
+			in: v1alpha1.ImageImportParameters{
+				Source:           testSource,
+				SHA1Checksum:     gcp.StringPtr("da39a3ee5e6b4b0d3255bfef95601890afd80709"),
+				Description:      gcp.StringPtr("imported"),
+				Family:           gcp.StringPtr("legacy-app"),
+				Architecture:     gcp.StringPtr("X86_64"),
+				GuestOSFeatures:  []string{"UEFI_COMPATIBLE"},
+				Licenses:         []string{"projects/test/global/licenses/byol"},
+				StorageLocations: []string{"us"},
+				EncryptionKey: &v1alpha1.ImageEncryptionKey{
+					KMSKeyName: testKMSKeyName,
+				},
+			},
+			want: &compute.Image{
+				Name:             testName,
+				Description:      "imported",
+				Family:           "legacy-app",
+				Architecture:     "X86_64",
+				GuestOsFeatures:  []*compute.GuestOsFeature{{Type: "UEFI_COMPATIBLE"}},
+				Licenses:         []string{"projects/test/global/licenses/byol"},
+				StorageLocations: []string{"us"},
+				RawDisk: &compute.ImageRawDisk{
+					Source:       testSourceURL,
+					Sha1Checksum: "da39a3ee5e6b4b0d3255bfef95601890afd80709",
+				},
+				ImageEncryptionKey: &compute.CustomerEncryptionKey{KmsKeyName: testKMSKeyName},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateImage(testName, tc.in)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateImage(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeSpec(t *testing.T) {
+	cases := map[string]struct {
+		spec *v1alpha1.ImageImportParameters
+		in   compute.Image
+		want *v1alpha1.ImageImportParameters
+	}{
+		"FillsUnset": {
+			spec: &v1alpha1.ImageImportParameters{Source: testSource},
+			in: compute.Image{
+				Architecture:     "X86_64",
+				GuestOsFeatures:  []*compute.GuestOsFeature{{Type: "VIRTIO_SCSI_MULTIQUEUE"}},
+				StorageLocations: []string{"us"},
+			},
+			want: &v1alpha1.ImageImportParameters{
+				Source:           testSource,
+				Architecture:     gcp.StringPtr("X86_64"),
+				GuestOSFeatures:  []string{"VIRTIO_SCSI_MULTIQUEUE"},
+				StorageLocations: []string{"us"},
+			},
+		},
+		"KeepsSet": {
+			spec: &v1alpha1.ImageImportParameters{Source: testSource, GuestOSFeatures: []string{"UEFI_COMPATIBLE"}, StorageLocations: []string{"eu"}},
+			in:   compute.Image{GuestOsFeatures: []*compute.GuestOsFeature{{Type: "VIRTIO_SCSI_MULTIQUEUE"}}, StorageLocations: []string{"us"}},
+			want: &v1alpha1.ImageImportParameters{Source: testSource, GuestOSFeatures: []string{"UEFI_COMPATIBLE"}, StorageLocations: []string{"eu"}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeSpec(tc.spec, tc.in)
+			if diff := cmp.Diff(tc.want, tc.spec); diff != "" {
+				t.Errorf("LateInitializeSpec(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
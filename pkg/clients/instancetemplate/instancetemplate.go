@@ -0,0 +1,71 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instancetemplate
+
+import (
+	compute "google.golang.org/api/compute/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+// GenerateInstanceTemplate takes an InstanceTemplateParameters and returns
+// the *compute.InstanceTemplate to insert. The properties of the template are
+// derived from the source instance by GCP.
+func GenerateInstanceTemplate(name string, in v1alpha1.InstanceTemplateParameters) *compute.InstanceTemplate {
+	t := &compute.InstanceTemplate{
+		Name:           name,
+		Description:    gcp.StringValue(in.Description),
+		SourceInstance: in.SourceInstance,
+	}
+	if len(in.DiskConfigs) == 0 {
+		return t
+	}
+	t.SourceInstanceParams = &compute.SourceInstanceParams{
+		DiskConfigs: make([]*compute.DiskInstantiationConfig, len(in.DiskConfigs)),
+	}
+	for i, d := range in.DiskConfigs {
+		t.SourceInstanceParams.DiskConfigs[i] = &compute.DiskInstantiationConfig{
+			DeviceName:      d.DeviceName,
+			InstantiateFrom: gcp.StringValue(d.InstantiateFrom),
+			CustomImage:     gcp.StringValue(d.CustomImage),
+			AutoDelete:      gcp.BoolValue(d.AutoDelete),
+		}
+	}
+	return t
+}
+
+// GenerateInstanceTemplateObservation takes a compute.InstanceTemplate and
+// returns *InstanceTemplateObservation.
+func GenerateInstanceTemplateObservation(in compute.InstanceTemplate) v1alpha1.InstanceTemplateObservation {
+	o := v1alpha1.InstanceTemplateObservation{
+		CreationTimestamp: in.CreationTimestamp,
+		ID:                in.Id,
+		SelfLink:          in.SelfLink,
+		SourceInstance:    in.SourceInstance,
+	}
+	if in.Properties != nil {
+		o.MachineType = in.Properties.MachineType
+	}
+	return o
+}
+
+// LateInitializeSpec fills unassigned fields with the values in
+// compute.InstanceTemplate object.
+func LateInitializeSpec(spec *v1alpha1.InstanceTemplateParameters, in compute.InstanceTemplate) {
+	spec.Description = gcp.LateInitializeString(spec.Description, in.Description)
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instancetemplate
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/compute/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	testName           = "some-name"
+	testSourceInstance = "projects/test/zones/us-central1-a/instances/golden-vm"
+)
+
+func TestGenerateInstanceTemplate(t *testing.T) {
+	cases := map[string]struct {
+		in   v1alpha1.InstanceTemplateParameters
+		want *compute.InstanceTemplate
+	}{
+		"NoDiskConfigs": {
+			in: v1alpha1.InstanceTemplateParameters{
+				Description:    gcp.StringPtr("golden"),
+				SourceInstance: testSourceInstance,
+			},
+			want: &compute.InstanceTemplate{
+				Name:           testName,
+				Description:    "golden",
+				SourceInstance: testSourceInstance,
+			},
+		},
+		"DiskConfigs": {
+			in: v1alpha1.InstanceTemplateParameters{
+				SourceInstance: testSourceInstance,
+				DiskConfigs: []v1alpha1.DiskInstantiationConfig{
+					{DeviceName: "boot", InstantiateFrom: gcp.StringPtr("CUSTOM_IMAGE"), CustomImage: gcp.StringPtr("global/images/golden"), AutoDelete: gcp.BoolPtr(true)},
+					{DeviceName: "scratch", InstantiateFrom: gcp.StringPtr("DO_NOT_INCLUDE")},
+				},
+			},
+			want: &compute.InstanceTemplate{
+				Name:           testName,
+				SourceInstance: testSourceInstance,
+				SourceInstanceParams: &compute.SourceInstanceParams{
+					DiskConfigs: []*compute.DiskInstantiationConfig{
+						{DeviceName: "boot", InstantiateFrom: "CUSTOM_IMAGE", CustomImage: "global/images/golden", AutoDelete: true},
+						{DeviceName: "scratch", InstantiateFrom: "DO_NOT_INCLUDE"},
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateInstanceTemplate(testName, tc.in)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateInstanceTemplate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateInstanceTemplateObservation(t *testing.T) {
+	cases := map[string]struct {
+		in   compute.InstanceTemplate
+		want v1alpha1.InstanceTemplateObservation
+	}{
+		"NoProperties": {
+			in:   compute.InstanceTemplate{Id: 42, SelfLink: "/link/to/self"},
+			want: v1alpha1.InstanceTemplateObservation{ID: 42, SelfLink: "/link/to/self"},
+		},
+		"Properties": {
+			in: compute.InstanceTemplate{
+				CreationTimestamp: "10/10/2023",
+				Id:                42,
+				SelfLink:          "/link/to/self",
+				SourceInstance:    testSourceInstance,
+				Properties:        &compute.InstanceProperties{MachineType: "e2-medium"},
+			},
+			want: v1alpha1.InstanceTemplateObservation{
+				CreationTimestamp: "10/10/2023",
+				ID:                42,
+				MachineType:       "e2-medium",
+				SelfLink:          "/link/to/self",
+				SourceInstance:    testSourceInstance,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateInstanceTemplateObservation(tc.in)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateInstanceTemplateObservation(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,156 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package interconnectattachment
+
+import (
+	compute "google.golang.org/api/compute/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+// GenerateInterconnectAttachment takes a *InterconnectAttachmentParameters
+// and fills *compute.InterconnectAttachment. It assigns only the fields that
+// are writable, i.e. not labelled as [Output Only] in Google's reference.
+func GenerateInterconnectAttachment(name string, in v1alpha1.InterconnectAttachmentParameters, ia *compute.InterconnectAttachment) {
+	ia.Name = name
+	ia.Router = gcp.StringValue(in.Router)
+	ia.Type = in.Type
+	ia.EdgeAvailabilityDomain = gcp.StringValue(in.EdgeAvailabilityDomain)
+	ia.Interconnect = gcp.StringValue(in.Interconnect)
+	ia.VlanTag8021q = gcp.Int64Value(in.VlanTag8021q)
+	ia.CandidateSubnets = in.CandidateSubnets
+	ia.Encryption = gcp.StringValue(in.Encryption)
+	generateMutable(in, ia)
+}
+
+// GenerateInterconnectAttachmentForUpdate returns a
+// *compute.InterconnectAttachment that patches the fields of an attachment
+// that can be changed after it is created.
+func GenerateInterconnectAttachmentForUpdate(in v1alpha1.InterconnectAttachmentParameters) *compute.InterconnectAttachment {
+	ia := &compute.InterconnectAttachment{}
+	generateMutable(in, ia)
+	return ia
+}
+
+func generateMutable(in v1alpha1.InterconnectAttachmentParameters, ia *compute.InterconnectAttachment) {
+	ia.Description = gcp.StringValue(in.Description)
+	ia.Bandwidth = gcp.StringValue(in.Bandwidth)
+	ia.Mtu = gcp.Int64Value(in.Mtu)
+	ia.StackType = gcp.StringValue(in.StackType)
+	if in.AdminEnabled != nil {
+		ia.AdminEnabled = *in.AdminEnabled
+		// The API enables attachments it is not told to disable.
+		ia.ForceSendFields = append(ia.ForceSendFields, "AdminEnabled")
+	}
+	if in.PartnerMetadata != nil {
+		ia.PartnerMetadata = &compute.InterconnectAttachmentPartnerMetadata{
+			InterconnectName: in.PartnerMetadata.InterconnectName,
+			PartnerName:      in.PartnerMetadata.PartnerName,
+			PortalUrl:        in.PartnerMetadata.PortalURL,
+		}
+	}
+}
+
+// GenerateInterconnectAttachmentObservation takes a
+// compute.InterconnectAttachment and returns
+// *InterconnectAttachmentObservation.
+func GenerateInterconnectAttachmentObservation(in compute.InterconnectAttachment) v1alpha1.InterconnectAttachmentObservation {
+	o := v1alpha1.InterconnectAttachmentObservation{
+		Bandwidth:               in.Bandwidth,
+		CloudRouterIPAddress:    in.CloudRouterIpAddress,
+		CustomerRouterIPAddress: in.CustomerRouterIpAddress,
+		CreationTimestamp:       in.CreationTimestamp,
+		GoogleReferenceID:       in.GoogleReferenceId,
+		ID:                      in.Id,
+		Interconnect:            in.Interconnect,
+		OperationalStatus:       in.OperationalStatus,
+		PairingKey:              in.PairingKey,
+		PartnerASN:              in.PartnerAsn,
+		SelfLink:                in.SelfLink,
+		State:                   in.State,
+		VlanTag8021q:            in.VlanTag8021q,
+	}
+	if in.PartnerMetadata != nil {
+		o.PartnerMetadata = &v1alpha1.InterconnectAttachmentPartnerMetadata{
+			InterconnectName: in.PartnerMetadata.InterconnectName,
+			PartnerName:      in.PartnerMetadata.PartnerName,
+			PortalURL:        in.PartnerMetadata.PortalUrl,
+		}
+	}
+	return o
+}
+
+// GetConnectionDetails returns the pairing key of a PARTNER attachment, which
+// is handed to the partner to provision the attachment.
+func GetConnectionDetails(in compute.InterconnectAttachment) managed.ConnectionDetails {
+	if in.PairingKey == "" {
+		return nil
+	}
+	return managed.ConnectionDetails{
+		v1alpha1.InterconnectAttachmentSecretPairingKey: []byte(in.PairingKey),
+	}
+}
+
+// LateInitializeSpec fills unassigned fields with the values in
+// compute.InterconnectAttachment object.
+func LateInitializeSpec(spec *v1alpha1.InterconnectAttachmentParameters, in compute.InterconnectAttachment) {
+	spec.Description = gcp.LateInitializeString(spec.Description, in.Description)
+	spec.AdminEnabled = gcp.LateInitializeBool(spec.AdminEnabled, in.AdminEnabled)
+	spec.EdgeAvailabilityDomain = gcp.LateInitializeString(spec.EdgeAvailabilityDomain, in.EdgeAvailabilityDomain)
+	spec.Mtu = gcp.LateInitializeInt64(spec.Mtu, in.Mtu)
+	spec.Encryption = gcp.LateInitializeString(spec.Encryption, in.Encryption)
+	spec.StackType = gcp.LateInitializeString(spec.StackType, in.StackType)
+
+	// The bandwidth of a PARTNER attachment is chosen by the partner, so it
+	// is only adopted by attachments that choose their own.
+	if spec.Type == v1alpha1.InterconnectAttachmentTypeDedicated {
+		spec.Bandwidth = gcp.LateInitializeString(spec.Bandwidth, in.Bandwidth)
+	}
+}
+
+// IsUpToDate checks whether the fields of the observed attachment that can be
+// changed after it is created match the given set of parameters.
+func IsUpToDate(in v1alpha1.InterconnectAttachmentParameters, observed compute.InterconnectAttachment) bool {
+	switch {
+	case in.Description != nil && *in.Description != observed.Description,
+		in.Bandwidth != nil && *in.Bandwidth != observed.Bandwidth,
+		in.Mtu != nil && *in.Mtu != observed.Mtu,
+		in.StackType != nil && *in.StackType != observed.StackType:
+		return false
+	}
+
+	// A PARTNER attachment can only be enabled once the partner has
+	// provisioned it, so it is considered up to date until then.
+	pending := observed.Type == v1alpha1.InterconnectAttachmentTypePartner && observed.State == v1alpha1.InterconnectAttachmentStatePendingPartner
+	if in.AdminEnabled != nil && *in.AdminEnabled != observed.AdminEnabled && !pending {
+		return false
+	}
+
+	if in.PartnerMetadata != nil {
+		if observed.PartnerMetadata == nil {
+			return false
+		}
+		o := observed.PartnerMetadata
+		if in.PartnerMetadata.InterconnectName != o.InterconnectName || in.PartnerMetadata.PartnerName != o.PartnerName || in.PartnerMetadata.PortalURL != o.PortalUrl {
+			return false
+		}
+	}
+	return true
+}
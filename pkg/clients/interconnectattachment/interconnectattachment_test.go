@@ -0,0 +1,167 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package interconnectattachment
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/compute/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	testName   = "some-name"
+	testRouter = "projects/test/regions/us-west1/routers/partner"
+)
+
+func params(m ...func(*v1alpha1.InterconnectAttachmentParameters)) *v1alpha1.InterconnectAttachmentParameters {
+	o := &v1alpha1.InterconnectAttachmentParameters{
+		Description:            gcp.StringPtr("some desc"),
+		Region:                 "us-west1",
+		Router:                 gcp.StringPtr(testRouter),
+		Type:                   v1alpha1.InterconnectAttachmentTypePartner,
+		AdminEnabled:           gcp.BoolPtr(false),
+		EdgeAvailabilityDomain: gcp.StringPtr("AVAILABILITY_DOMAIN_1"),
+		Mtu:                    gcp.Int64Ptr(1500),
+	}
+	for _, f := range m {
+		f(o)
+	}
+	return o
+}
+
+func attachment(m ...func(*compute.InterconnectAttachment)) *compute.InterconnectAttachment {
+	o := &compute.InterconnectAttachment{
+		Name:                   testName,
+		Description:            "some desc",
+		Router:                 testRouter,
+		Type:                   v1alpha1.InterconnectAttachmentTypePartner,
+		EdgeAvailabilityDomain: "AVAILABILITY_DOMAIN_1",
+		Mtu:                    1500,
+		ForceSendFields:        []string{"AdminEnabled"},
+	}
+	for _, f := range m {
+		f(o)
+	}
+	return o
+}
+
+func TestGenerateInterconnectAttachment(t *testing.T) {
+	cases := map[string]struct {
+		in   v1alpha1.InterconnectAttachmentParameters
+		want *compute.InterconnectAttachment
+	}{
+		"Partner": {
+			in:   *params(),
+			want: attachment(),
+		},
+		"AdminEnabledUnset": {
+			in: *params(func(p *v1alpha1.InterconnectAttachmentParameters) {
+				p.AdminEnabled = nil
+			}),
+			want: attachment(func(ia *compute.InterconnectAttachment) {
+				ia.ForceSendFields = nil
+			}),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := &compute.InterconnectAttachment{}
+			GenerateInterconnectAttachment(testName, tc.in, got)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateInterconnectAttachment(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetConnectionDetails(t *testing.T) {
+	cases := map[string]struct {
+		in   compute.InterconnectAttachment
+		want managed.ConnectionDetails
+	}{
+		"Dedicated": {
+			in: compute.InterconnectAttachment{Type: v1alpha1.InterconnectAttachmentTypeDedicated},
+		},
+		"Partner": {
+			in:   compute.InterconnectAttachment{Type: v1alpha1.InterconnectAttachmentTypePartner, PairingKey: "7e51371e-72a3-40b5-b844-2e3efefaee59/us-west1/1"},
+			want: managed.ConnectionDetails{v1alpha1.InterconnectAttachmentSecretPairingKey: []byte("7e51371e-72a3-40b5-b844-2e3efefaee59/us-west1/1")},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, GetConnectionDetails(tc.in)); diff != "" {
+				t.Errorf("GetConnectionDetails(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	enabled := func(p *v1alpha1.InterconnectAttachmentParameters) { p.AdminEnabled = gcp.BoolPtr(true) }
+	cases := map[string]struct {
+		in       *v1alpha1.InterconnectAttachmentParameters
+		observed *compute.InterconnectAttachment
+		want     bool
+	}{
+		"UpToDate": {
+			in:       params(),
+			observed: attachment(),
+			want:     true,
+		},
+		"DescriptionChanged": {
+			in: params(func(p *v1alpha1.InterconnectAttachmentParameters) {
+				p.Description = gcp.StringPtr("other desc")
+			}),
+			observed: attachment(),
+			want:     false,
+		},
+		"EnabledWhilePendingPartner": {
+			in: params(enabled),
+			observed: attachment(func(ia *compute.InterconnectAttachment) {
+				ia.State = v1alpha1.InterconnectAttachmentStatePendingPartner
+			}),
+			want: true,
+		},
+		"EnabledOncePartnerProvisioned": {
+			in: params(enabled),
+			observed: attachment(func(ia *compute.InterconnectAttachment) {
+				ia.State = v1alpha1.InterconnectAttachmentStatePendingCustomer
+			}),
+			want: false,
+		},
+		"PartnerSetBandwidthIgnored": {
+			in: params(),
+			observed: attachment(func(ia *compute.InterconnectAttachment) {
+				ia.Bandwidth = "BPS_1G"
+			}),
+			want: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsUpToDate(*tc.in, *tc.observed)); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
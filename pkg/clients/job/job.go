@@ -0,0 +1,211 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package job
+
+import (
+	"fmt"
+	"strings"
+
+	batch "google.golang.org/api/batch/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/batch/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	parentFormat = "projects/%s/locations/%s"
+	nameFormat   = "projects/%s/locations/%s/jobs/%s"
+)
+
+// GetParent returns the location the Job lives under.
+func GetParent(projectID, location string) string {
+	return fmt.Sprintf(parentFormat, projectID, location)
+}
+
+// GetFullyQualifiedName builds the relative resource name of the Job.
+func GetFullyQualifiedName(projectID, location, name string) string {
+	return fmt.Sprintf(nameFormat, projectID, location, name)
+}
+
+// GenerateJob produces a Job that is configured via given JobParameters.
+func GenerateJob(name string, p v1alpha1.JobParameters) *batch.Job {
+	j := &batch.Job{
+		Name:     name,
+		Priority: gcp.Int64Value(p.Priority),
+		Labels:   p.Labels,
+	}
+	for _, tg := range p.TaskGroups {
+		j.TaskGroups = append(j.TaskGroups, &batch.TaskGroup{
+			TaskSpec:         generateTaskSpec(tg.TaskSpec),
+			TaskCount:        gcp.Int64Value(tg.TaskCount),
+			Parallelism:      gcp.Int64Value(tg.Parallelism),
+			TaskCountPerNode: gcp.Int64Value(tg.TaskCountPerNode),
+			RequireHostsFile: gcp.BoolValue(tg.RequireHostsFile),
+		})
+	}
+	j.AllocationPolicy = generateAllocationPolicy(p.AllocationPolicy)
+	if p.LogsPolicy != nil {
+		j.LogsPolicy = &batch.LogsPolicy{
+			Destination: p.LogsPolicy.Destination,
+			LogsPath:    gcp.StringValue(p.LogsPolicy.LogsPath),
+		}
+	}
+	return j
+}
+
+func generateTaskSpec(in v1alpha1.TaskSpec) *batch.TaskSpec {
+	ts := &batch.TaskSpec{
+		MaxRunDuration: gcp.StringValue(in.MaxRunDuration),
+		MaxRetryCount:  gcp.Int64Value(in.MaxRetryCount),
+		Environments:   in.Environment,
+	}
+	for _, r := range in.Runnables {
+		ts.Runnables = append(ts.Runnables, generateRunnable(r))
+	}
+	if c := in.ComputeResource; c != nil {
+		ts.ComputeResource = &batch.ComputeResource{
+			CpuMilli:    gcp.Int64Value(c.CPUMilli),
+			MemoryMib:   gcp.Int64Value(c.MemoryMib),
+			BootDiskMib: gcp.Int64Value(c.BootDiskMib),
+		}
+	}
+	for _, v := range in.Volumes {
+		ts.Volumes = append(ts.Volumes, &batch.Volume{
+			MountPath:    v.MountPath,
+			MountOptions: v.MountOptions,
+			Gcs:          &batch.GCS{RemotePath: GCSRemotePath(v.GCS)},
+		})
+	}
+	return ts
+}
+
+func generateRunnable(in v1alpha1.Runnable) *batch.Runnable {
+	r := &batch.Runnable{
+		IgnoreExitStatus: gcp.BoolValue(in.IgnoreExitStatus),
+		Background:       gcp.BoolValue(in.Background),
+		AlwaysRun:        gcp.BoolValue(in.AlwaysRun),
+		Timeout:          gcp.StringValue(in.Timeout),
+	}
+	if in.Environment != nil {
+		r.Environment = &batch.Environment{Variables: in.Environment}
+	}
+	if c := in.Container; c != nil {
+		r.Container = &batch.Container{
+			ImageUri:             c.ImageURI,
+			Commands:             c.Commands,
+			Entrypoint:           gcp.StringValue(c.Entrypoint),
+			Volumes:              c.Volumes,
+			BlockExternalNetwork: gcp.BoolValue(c.BlockExternalNetwork),
+		}
+	}
+	if s := in.Script; s != nil {
+		r.Script = &batch.Script{
+			Path: gcp.StringValue(s.Path),
+			Text: gcp.StringValue(s.Text),
+		}
+	}
+	return r
+}
+
+// GCSRemotePath returns the remote path of the supplied GCSVolume, which is
+// the bucket name optionally followed by a path within the bucket.
+func GCSRemotePath(v v1alpha1.GCSVolume) string {
+	p := strings.Trim(gcp.StringValue(v.Path), "/")
+	if p == "" {
+		return gcp.StringValue(v.Bucket)
+	}
+	return gcp.StringValue(v.Bucket) + "/" + p
+}
+
+func generateAllocationPolicy(in *v1alpha1.AllocationPolicy) *batch.AllocationPolicy {
+	if in == nil {
+		return nil
+	}
+	ap := &batch.AllocationPolicy{
+		Labels: in.Labels,
+	}
+	if len(in.AllowedLocations) > 0 {
+		ap.Location = &batch.LocationPolicy{AllowedLocations: in.AllowedLocations}
+	}
+	for _, i := range in.Instances {
+		it := &batch.InstancePolicyOrTemplate{
+			InstanceTemplate:  gcp.StringValue(i.InstanceTemplate),
+			InstallGpuDrivers: gcp.BoolValue(i.InstallGPUDrivers),
+		}
+		if p := i.Policy; p != nil {
+			it.Policy = &batch.InstancePolicy{
+				MachineType:       gcp.StringValue(p.MachineType),
+				ProvisioningModel: gcp.StringValue(p.ProvisioningModel),
+				MinCpuPlatform:    gcp.StringValue(p.MinCPUPlatform),
+			}
+		}
+		ap.Instances = append(ap.Instances, it)
+	}
+	if n := in.Network; n != nil {
+		ap.Network = &batch.NetworkPolicy{}
+		for _, ni := range n.NetworkInterfaces {
+			ap.Network.NetworkInterfaces = append(ap.Network.NetworkInterfaces, &batch.NetworkInterface{
+				Network:             gcp.StringValue(ni.Network),
+				Subnetwork:          gcp.StringValue(ni.Subnetwork),
+				NoExternalIpAddress: gcp.BoolValue(ni.NoExternalIPAddress),
+			})
+		}
+	}
+	if in.ServiceAccount != nil {
+		ap.ServiceAccount = &batch.ServiceAccount{Email: gcp.StringValue(in.ServiceAccount.Email)}
+	}
+	return ap
+}
+
+// GenerateObservation produces a JobObservation from the supplied Job.
+func GenerateObservation(j batch.Job) v1alpha1.JobObservation {
+	o := v1alpha1.JobObservation{
+		Name:       j.Name,
+		UID:        j.Uid,
+		CreateTime: j.CreateTime,
+		UpdateTime: j.UpdateTime,
+	}
+	if j.Status == nil {
+		return o
+	}
+	o.State = j.Status.State
+	o.RunDuration = j.Status.RunDuration
+	for _, e := range j.Status.StatusEvents {
+		if e == nil {
+			continue
+		}
+		o.StatusEvents = append(o.StatusEvents, v1alpha1.JobStatusEvent{
+			Type:        e.Type,
+			Description: e.Description,
+			EventTime:   e.EventTime,
+		})
+	}
+	return o
+}
+
+// LateInitialize fills the empty fields of JobParameters if the
+// corresponding fields are given in Job.
+func LateInitialize(p *v1alpha1.JobParameters, j batch.Job) {
+	p.Priority = gcp.LateInitializeInt64(p.Priority, j.Priority)
+	for i := range p.TaskGroups {
+		if i >= len(j.TaskGroups) || j.TaskGroups[i] == nil {
+			break
+		}
+		p.TaskGroups[i].TaskCount = gcp.LateInitializeInt64(p.TaskGroups[i].TaskCount, j.TaskGroups[i].TaskCount)
+		p.TaskGroups[i].Parallelism = gcp.LateInitializeInt64(p.TaskGroups[i].Parallelism, j.TaskGroups[i].Parallelism)
+	}
+}
@@ -0,0 +1,180 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package job
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	batch "google.golang.org/api/batch/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/batch/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	testName   = "projects/foo/locations/us-central1/jobs/render"
+	network    = "projects/foo/global/networks/hpc"
+	subnetwork = "projects/foo/regions/us-central1/subnetworks/hpc"
+)
+
+func params(m ...func(*v1alpha1.JobParameters)) *v1alpha1.JobParameters {
+	p := &v1alpha1.JobParameters{
+		Location: "us-central1",
+		TaskGroups: []v1alpha1.TaskGroup{{
+			TaskSpec: v1alpha1.TaskSpec{
+				Runnables: []v1alpha1.Runnable{{
+					Container: &v1alpha1.Container{
+						ImageURI: "gcr.io/foo/render",
+						Commands: []string{"--frames", "/mnt/share/frames"},
+					},
+				}},
+				ComputeResource: &v1alpha1.ComputeResource{CPUMilli: gcp.Int64Ptr(2000), MemoryMib: gcp.Int64Ptr(4096)},
+				Volumes: []v1alpha1.Volume{{
+					MountPath: "/mnt/share",
+					GCS:       v1alpha1.GCSVolume{Bucket: gcp.StringPtr("frames"), Path: gcp.StringPtr("/scene-1/")},
+				}},
+			},
+			TaskCount: gcp.Int64Ptr(8),
+		}},
+		AllocationPolicy: &v1alpha1.AllocationPolicy{
+			Instances: []v1alpha1.InstancePolicyOrTemplate{{
+				Policy: &v1alpha1.InstancePolicy{
+					MachineType:       gcp.StringPtr("c2-standard-8"),
+					ProvisioningModel: gcp.StringPtr("SPOT"),
+				},
+			}},
+			Network: &v1alpha1.NetworkPolicy{NetworkInterfaces: []v1alpha1.NetworkInterface{{
+				Network:             gcp.StringPtr(network),
+				Subnetwork:          gcp.StringPtr(subnetwork),
+				NoExternalIPAddress: gcp.BoolPtr(true),
+			}}},
+		},
+		LogsPolicy: &v1alpha1.LogsPolicy{Destination: "CLOUD_LOGGING"},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func job(m ...func(*batch.Job)) *batch.Job {
+	j := &batch.Job{
+		Name: testName,
+		TaskGroups: []*batch.TaskGroup{{
+			TaskSpec: &batch.TaskSpec{
+				Runnables: []*batch.Runnable{{
+					Container: &batch.Container{
+						ImageUri: "gcr.io/foo/render",
+						Commands: []string{"--frames", "/mnt/share/frames"},
+					},
+				}},
+				ComputeResource: &batch.ComputeResource{CpuMilli: 2000, MemoryMib: 4096},
+				Volumes: []*batch.Volume{{
+					MountPath: "/mnt/share",
+					Gcs:       &batch.GCS{RemotePath: "frames/scene-1"},
+				}},
+			},
+			TaskCount: 8,
+		}},
+		AllocationPolicy: &batch.AllocationPolicy{
+			Instances: []*batch.InstancePolicyOrTemplate{{
+				Policy: &batch.InstancePolicy{
+					MachineType:       "c2-standard-8",
+					ProvisioningModel: "SPOT",
+				},
+			}},
+			Network: &batch.NetworkPolicy{NetworkInterfaces: []*batch.NetworkInterface{{
+				Network:             network,
+				Subnetwork:          subnetwork,
+				NoExternalIpAddress: true,
+			}}},
+		},
+		LogsPolicy: &batch.LogsPolicy{Destination: "CLOUD_LOGGING"},
+	}
+	for _, f := range m {
+		f(j)
+	}
+	return j
+}
+
+func TestGenerateJob(t *testing.T) {
+	if diff := cmp.Diff(job(), GenerateJob(testName, *params())); diff != "" {
+		t.Errorf("GenerateJob(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGCSRemotePath(t *testing.T) {
+	cases := map[string]struct {
+		v    v1alpha1.GCSVolume
+		want string
+	}{
+		"BucketOnly": {
+			v:    v1alpha1.GCSVolume{Bucket: gcp.StringPtr("frames")},
+			want: "frames",
+		},
+		"BucketAndPath": {
+			v:    v1alpha1.GCSVolume{Bucket: gcp.StringPtr("frames"), Path: gcp.StringPtr("scene-1/shots")},
+			want: "frames/scene-1/shots",
+		},
+		"RootPath": {
+			v:    v1alpha1.GCSVolume{Bucket: gcp.StringPtr("frames"), Path: gcp.StringPtr("/")},
+			want: "frames",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, GCSRemotePath(tc.v)); diff != "" {
+				t.Errorf("GCSRemotePath(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateObservation(t *testing.T) {
+	j := job(func(j *batch.Job) {
+		j.Uid = "render-1234"
+		j.Status = &batch.JobStatus{
+			State:        v1alpha1.JobStateRunning,
+			RunDuration:  "120s",
+			StatusEvents: []*batch.StatusEvent{{Type: "STATUS_CHANGED", Description: "Job state is set from SCHEDULED to RUNNING"}},
+		}
+	})
+	want := v1alpha1.JobObservation{
+		Name:         testName,
+		UID:          "render-1234",
+		State:        v1alpha1.JobStateRunning,
+		RunDuration:  "120s",
+		StatusEvents: []v1alpha1.JobStatusEvent{{Type: "STATUS_CHANGED", Description: "Job state is set from SCHEDULED to RUNNING"}},
+	}
+	if diff := cmp.Diff(want, GenerateObservation(*j)); diff != "" {
+		t.Errorf("GenerateObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitialize(t *testing.T) {
+	p := params()
+	LateInitialize(p, *job(func(j *batch.Job) {
+		j.TaskGroups[0].Parallelism = 8
+	}))
+	want := params(func(p *v1alpha1.JobParameters) {
+		p.TaskGroups[0].Parallelism = gcp.Int64Ptr(8)
+	})
+	if diff := cmp.Diff(want, p); diff != "" {
+		t.Errorf("LateInitialize(...): -want, +got:\n%s", diff)
+	}
+}
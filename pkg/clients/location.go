@@ -0,0 +1,105 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
+)
+
+const (
+	errNoLocation     = "location is not set and the ProviderConfig has no default"
+	errUpdateLocation = "cannot update managed resource with the default location"
+)
+
+// A LocationScope determines which default location of a ProviderConfig
+// applies to a kind of managed resource.
+type LocationScope int
+
+// Location scopes.
+const (
+	// LocationScopeRegion applies the default region.
+	LocationScopeRegion LocationScope = iota
+	// LocationScopeZone applies the default zone.
+	LocationScopeZone
+	// LocationScopeRegionOrZone applies the default region, or the default
+	// zone if no default region is set.
+	LocationScopeRegionOrZone
+)
+
+func (s LocationScope) defaultOf(spec v1beta1.ProviderConfigSpec) string {
+	switch s {
+	case LocationScopeZone:
+		return spec.DefaultZone
+	case LocationScopeRegionOrZone:
+		if spec.DefaultRegion != "" {
+			return spec.DefaultRegion
+		}
+		return spec.DefaultZone
+	default:
+		return spec.DefaultRegion
+	}
+}
+
+// A LocationFn returns the location field of the supplied managed resource,
+// or nil if the managed resource is not of the expected kind.
+type LocationFn func(mg resource.Managed) *string
+
+// A LocationDefaulter sets the location of managed resources that do not
+// specify one to the default region or zone of their ProviderConfig. The
+// effective location is persisted in the spec of the managed resource so that
+// changing the ProviderConfig later does not move existing resources.
+type LocationDefaulter struct {
+	kube     client.Client
+	scope    LocationScope
+	location LocationFn
+}
+
+// NewLocationDefaulter returns a LocationDefaulter that defaults the location
+// field returned by the supplied function from the supplied scope.
+func NewLocationDefaulter(kube client.Client, scope LocationScope, fn LocationFn) *LocationDefaulter {
+	return &LocationDefaulter{kube: kube, scope: scope, location: fn}
+}
+
+// Initialize sets the location of the supplied managed resource if it is
+// empty.
+func (d *LocationDefaulter) Initialize(ctx context.Context, mg resource.Managed) error {
+	loc := d.location(mg)
+	if loc == nil || *loc != "" {
+		return nil
+	}
+	if mg.GetProviderConfigReference() == nil {
+		return errors.New(errNoLocation)
+	}
+	pc := &v1beta1.ProviderConfig{}
+	if err := d.kube.Get(ctx, types.NamespacedName{Name: mg.GetProviderConfigReference().Name}, pc); err != nil {
+		return errors.Wrap(err, errGetProviderConfig)
+	}
+	def := d.scope.defaultOf(pc.Spec)
+	if def == "" {
+		return errors.New(errNoLocation)
+	}
+	*loc = def
+	return errors.Wrap(d.kube.Update(ctx, mg), errUpdateLocation)
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	cmpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/compute/v1beta1"
+	"github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
+)
+
+func addressRegion(mg resource.Managed) *string {
+	return &mg.(*cmpv1beta1.Address).Spec.ForProvider.Region
+}
+
+func TestLocationDefaulter(t *testing.T) {
+	errBoom := errors.New("boom")
+	pc := func(spec v1beta1.ProviderConfigSpec) test.MockGetFn {
+		return func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+			obj.(*v1beta1.ProviderConfig).Spec = spec
+			return nil
+		}
+	}
+	address := func(region string) *cmpv1beta1.Address {
+		a := &cmpv1beta1.Address{}
+		a.SetProviderConfigReference(&xpv1.Reference{Name: "default"})
+		a.Spec.ForProvider.Region = region
+		return a
+	}
+	type want struct {
+		region string
+		err    error
+	}
+	cases := map[string]struct {
+		kube  client.Client
+		scope LocationScope
+		mg    *cmpv1beta1.Address
+		want  want
+	}{
+		"AlreadySet": {
+			kube: &test.MockClient{},
+			mg:   address("europe-west1"),
+			want: want{region: "europe-west1"},
+		},
+		"DefaultRegion": {
+			kube: &test.MockClient{
+				MockGet:    pc(v1beta1.ProviderConfigSpec{DefaultRegion: "us-central1", DefaultZone: "us-central1-a"}),
+				MockUpdate: test.NewMockUpdateFn(nil),
+			},
+			scope: LocationScopeRegion,
+			mg:    address(""),
+			want:  want{region: "us-central1"},
+		},
+		"DefaultZone": {
+			kube: &test.MockClient{
+				MockGet:    pc(v1beta1.ProviderConfigSpec{DefaultRegion: "us-central1", DefaultZone: "us-central1-a"}),
+				MockUpdate: test.NewMockUpdateFn(nil),
+			},
+			scope: LocationScopeZone,
+			mg:    address(""),
+			want:  want{region: "us-central1-a"},
+		},
+		"FallbackToZone": {
+			kube: &test.MockClient{
+				MockGet:    pc(v1beta1.ProviderConfigSpec{DefaultZone: "us-central1-a"}),
+				MockUpdate: test.NewMockUpdateFn(nil),
+			},
+			scope: LocationScopeRegionOrZone,
+			mg:    address(""),
+			want:  want{region: "us-central1-a"},
+		},
+		"NoDefault": {
+			kube:  &test.MockClient{MockGet: pc(v1beta1.ProviderConfigSpec{DefaultZone: "us-central1-a"})},
+			scope: LocationScopeRegion,
+			mg:    address(""),
+			want:  want{err: errors.New(errNoLocation)},
+		},
+		"GetProviderConfigFailed": {
+			kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+			mg:   address(""),
+			want: want{err: errors.Wrap(errBoom, errGetProviderConfig)},
+		},
+		"UpdateFailed": {
+			kube: &test.MockClient{
+				MockGet:    pc(v1beta1.ProviderConfigSpec{DefaultRegion: "us-central1"}),
+				MockUpdate: test.NewMockUpdateFn(errBoom),
+			},
+			mg:   address(""),
+			want: want{region: "us-central1", err: errors.Wrap(errBoom, errUpdateLocation)},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := NewLocationDefaulter(tc.kube, tc.scope, addressRegion).Initialize(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Initialize(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.region, tc.mg.Spec.ForProvider.Region); diff != "" {
+				t.Errorf("Initialize(...): -want region, +got region:\n%s", diff)
+			}
+		})
+	}
+}
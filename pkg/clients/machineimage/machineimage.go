@@ -0,0 +1,64 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machineimage
+
+import (
+	compute "google.golang.org/api/compute/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+// GenerateMachineImage takes a MachineImageParameters and returns the
+// *compute.MachineImage to insert. It assigns only the fields that are
+// writable, i.e. not labelled as [Output Only] in Google's reference.
+func GenerateMachineImage(name string, in v1alpha1.MachineImageParameters) *compute.MachineImage {
+	m := &compute.MachineImage{
+		Name:             name,
+		Description:      gcp.StringValue(in.Description),
+		SourceInstance:   in.SourceInstance,
+		GuestFlush:       gcp.BoolValue(in.GuestFlush),
+		StorageLocations: in.StorageLocations,
+	}
+	if in.EncryptionKey != nil {
+		m.MachineImageEncryptionKey = &compute.CustomerEncryptionKey{
+			KmsKeyName:           in.EncryptionKey.KMSKeyName,
+			KmsKeyServiceAccount: gcp.StringValue(in.EncryptionKey.KMSKeyServiceAccount),
+		}
+	}
+	return m
+}
+
+// GenerateMachineImageObservation takes a compute.MachineImage and returns
+// *MachineImageObservation.
+func GenerateMachineImageObservation(in compute.MachineImage) v1alpha1.MachineImageObservation {
+	return v1alpha1.MachineImageObservation{
+		CreationTimestamp: in.CreationTimestamp,
+		ID:                in.Id,
+		SelfLink:          in.SelfLink,
+		SourceInstance:    in.SourceInstance,
+		Status:            in.Status,
+		TotalStorageBytes: in.TotalStorageBytes,
+	}
+}
+
+// LateInitializeSpec fills unassigned fields with the values in
+// compute.MachineImage object.
+func LateInitializeSpec(spec *v1alpha1.MachineImageParameters, in compute.MachineImage) {
+	spec.Description = gcp.LateInitializeString(spec.Description, in.Description)
+	spec.StorageLocations = gcp.LateInitializeStringSlice(spec.StorageLocations, in.StorageLocations)
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machineimage
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/compute/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	testName           = "some-name"
+	testSourceInstance = "projects/test/zones/us-central1-a/instances/golden-vm"
+	testKMSKeyName     = "projects/test/locations/us/keyRings/ring/cryptoKeys/key"
+)
+
+func TestGenerateMachineImage(t *testing.T) {
+	cases := map[string]struct {
+		in   v1alpha1.MachineImageParameters
+		want *compute.MachineImage
+	}{
+		"Minimal": {
+			in: v1alpha1.MachineImageParameters{SourceInstance: testSourceInstance},
+			want: &compute.MachineImage{
+				Name:           testName,
+				SourceInstance: testSourceInstance,
+			},
+		},
+		"AllFilled": {
+			in: v1alpha1.MachineImageParameters{
+				Description:      gcp.StringPtr("golden"),
+				SourceInstance:   testSourceInstance,
+				GuestFlush:       gcp.BoolPtr(true),
+				StorageLocations: []string{"us"},
+				EncryptionKey: &v1alpha1.MachineImageEncryptionKey{
+					KMSKeyName:           testKMSKeyName,
+					KMSKeyServiceAccount: gcp.StringPtr("sa@test.iam.gserviceaccount.com"),
+				},
+			},
+			want: &compute.MachineImage{
+				Name:             testName,
+				Description:      "golden",
+				SourceInstance:   testSourceInstance,
+				GuestFlush:       true,
+				StorageLocations: []string{"us"},
+				MachineImageEncryptionKey: &compute.CustomerEncryptionKey{
+					KmsKeyName:           testKMSKeyName,
+					KmsKeyServiceAccount: "sa@test.iam.gserviceaccount.com",
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateMachineImage(testName, tc.in)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateMachineImage(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeSpec(t *testing.T) {
+	cases := map[string]struct {
+		spec *v1alpha1.MachineImageParameters
+		in   compute.MachineImage
+		want *v1alpha1.MachineImageParameters
+	}{
+		"FillsUnset": {
+			spec: &v1alpha1.MachineImageParameters{SourceInstance: testSourceInstance},
+			in:   compute.MachineImage{Description: "golden", StorageLocations: []string{"us"}},
+			want: &v1alpha1.MachineImageParameters{
+				Description:      gcp.StringPtr("golden"),
+				SourceInstance:   testSourceInstance,
+				StorageLocations: []string{"us"},
+			},
+		},
+		"KeepsSet": {
+			spec: &v1alpha1.MachineImageParameters{SourceInstance: testSourceInstance, StorageLocations: []string{"eu"}},
+			in:   compute.MachineImage{StorageLocations: []string{"us"}},
+			want: &v1alpha1.MachineImageParameters{SourceInstance: testSourceInstance, StorageLocations: []string{"eu"}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeSpec(tc.spec, tc.in)
+			if diff := cmp.Diff(tc.want, tc.spec); diff != "" {
+				t.Errorf("LateInitializeSpec(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
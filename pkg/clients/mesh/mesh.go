@@ -0,0 +1,93 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mesh
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	networkservices "google.golang.org/api/networkservices/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/networkservices/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	parentFormat = "projects/%s/locations/%s"
+	nameFormat   = "projects/%s/locations/%s/meshes/%s"
+)
+
+// GetParent returns the location the Mesh lives under.
+func GetParent(projectID, location string) string {
+	return fmt.Sprintf(parentFormat, projectID, location)
+}
+
+// GetFullyQualifiedName builds the relative resource name of the Mesh.
+func GetFullyQualifiedName(projectID, location, name string) string {
+	return fmt.Sprintf(nameFormat, projectID, location, name)
+}
+
+// GenerateMesh produces a Mesh that is configured via given MeshParameters.
+func GenerateMesh(name string, p v1alpha1.MeshParameters) *networkservices.Mesh {
+	return &networkservices.Mesh{
+		Name:             name,
+		Description:      gcp.StringValue(p.Description),
+		Labels:           p.Labels,
+		InterceptionPort: gcp.Int64Value(p.InterceptionPort),
+	}
+}
+
+// GenerateObservation produces a MeshObservation from the supplied Mesh.
+func GenerateObservation(m networkservices.Mesh) v1alpha1.MeshObservation {
+	return v1alpha1.MeshObservation{
+		Name:       m.Name,
+		SelfLink:   m.SelfLink,
+		CreateTime: m.CreateTime,
+		UpdateTime: m.UpdateTime,
+	}
+}
+
+// LateInitialize fills the empty fields of MeshParameters if the
+// corresponding fields are given in Mesh.
+func LateInitialize(p *v1alpha1.MeshParameters, m networkservices.Mesh) {
+	p.Description = gcp.LateInitializeString(p.Description, m.Description)
+	p.InterceptionPort = gcp.LateInitializeInt64(p.InterceptionPort, m.InterceptionPort)
+}
+
+// IsUpToDate checks whether Mesh is configured with given MeshParameters.
+func IsUpToDate(p v1alpha1.MeshParameters, m networkservices.Mesh) bool {
+	return GenerateUpdateMask(p, m) == ""
+}
+
+// GenerateUpdateMask returns the comma separated list of fields that differ
+// between MeshParameters and Mesh.
+func GenerateUpdateMask(p v1alpha1.MeshParameters, m networkservices.Mesh) string {
+	desired := GenerateMesh(m.Name, p)
+	mask := []string{}
+	if desired.Description != m.Description {
+		mask = append(mask, "description")
+	}
+	if !cmp.Equal(desired.Labels, m.Labels, cmpopts.EquateEmpty()) {
+		mask = append(mask, "labels")
+	}
+	if desired.InterceptionPort != m.InterceptionPort {
+		mask = append(mask, "interceptionPort")
+	}
+	return strings.Join(mask, ",")
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mesh
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	networkservices "google.golang.org/api/networkservices/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/networkservices/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const testName = "projects/foo/locations/global/meshes/default"
+
+func params(m ...func(*v1alpha1.MeshParameters)) *v1alpha1.MeshParameters {
+	p := &v1alpha1.MeshParameters{
+		Location:    "global",
+		Description: gcp.StringPtr("default mesh"),
+		Labels:      map[string]string{"team": "platform"},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func mesh(m ...func(*networkservices.Mesh)) *networkservices.Mesh {
+	o := &networkservices.Mesh{
+		Name:             testName,
+		Description:      "default mesh",
+		Labels:           map[string]string{"team": "platform"},
+		InterceptionPort: 15001,
+	}
+	for _, f := range m {
+		f(o)
+	}
+	return o
+}
+
+func TestLateInitialize(t *testing.T) {
+	p := params()
+	LateInitialize(p, *mesh())
+	want := params(func(p *v1alpha1.MeshParameters) { p.InterceptionPort = gcp.Int64Ptr(15001) })
+	if diff := cmp.Diff(want, p); diff != "" {
+		t.Errorf("LateInitialize(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateUpdateMask(t *testing.T) {
+	cases := map[string]struct {
+		p    *v1alpha1.MeshParameters
+		m    *networkservices.Mesh
+		want string
+	}{
+		"UpToDate": {
+			p:    params(func(p *v1alpha1.MeshParameters) { p.InterceptionPort = gcp.Int64Ptr(15001) }),
+			m:    mesh(),
+			want: "",
+		},
+		"LabelsRemoved": {
+			p: params(func(p *v1alpha1.MeshParameters) {
+				p.InterceptionPort = gcp.Int64Ptr(15001)
+				p.Labels = nil
+			}),
+			m:    mesh(),
+			want: "labels",
+		},
+		"PortChanged": {
+			p: params(func(p *v1alpha1.MeshParameters) {
+				p.Description = gcp.StringPtr("mesh")
+				p.InterceptionPort = gcp.Int64Ptr(15006)
+			}),
+			m:    mesh(),
+			want: "description,interceptionPort",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, GenerateUpdateMask(*tc.p, *tc.m)); diff != "" {
+				t.Errorf("GenerateUpdateMask(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
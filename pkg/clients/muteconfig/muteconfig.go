@@ -0,0 +1,92 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package muteconfig
+
+import (
+	"fmt"
+	"strings"
+
+	scc "google.golang.org/api/securitycenter/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/securitycenter/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	projectParentFormat = "projects/%s"
+	nameFormat          = "%s/muteConfigs/%s"
+)
+
+// GetParent returns the parent the MuteConfig lives under, defaulting to the
+// supplied project.
+func GetParent(projectID string, p v1alpha1.MuteConfigParameters) string {
+	if p.Parent != nil {
+		return *p.Parent
+	}
+	return fmt.Sprintf(projectParentFormat, projectID)
+}
+
+// GetFullyQualifiedName builds the relative resource name of the MuteConfig.
+func GetFullyQualifiedName(parent, name string) string {
+	return fmt.Sprintf(nameFormat, parent, name)
+}
+
+// GenerateMuteConfig produces a MuteConfig that is configured via given
+// MuteConfigParameters.
+func GenerateMuteConfig(name string, p v1alpha1.MuteConfigParameters) *scc.GoogleCloudSecuritycenterV1MuteConfig {
+	return &scc.GoogleCloudSecuritycenterV1MuteConfig{
+		Name:        name,
+		Description: gcp.StringValue(p.Description),
+		Filter:      p.Filter,
+	}
+}
+
+// GenerateObservation produces a MuteConfigObservation from the supplied
+// MuteConfig.
+func GenerateObservation(mc scc.GoogleCloudSecuritycenterV1MuteConfig) v1alpha1.MuteConfigObservation {
+	return v1alpha1.MuteConfigObservation{
+		Name:             mc.Name,
+		CreateTime:       mc.CreateTime,
+		UpdateTime:       mc.UpdateTime,
+		MostRecentEditor: mc.MostRecentEditor,
+	}
+}
+
+// LateInitialize fills the empty fields of MuteConfigParameters if the
+// corresponding fields are given in MuteConfig.
+func LateInitialize(p *v1alpha1.MuteConfigParameters, mc scc.GoogleCloudSecuritycenterV1MuteConfig) {
+	p.Description = gcp.LateInitializeString(p.Description, mc.Description)
+}
+
+// IsUpToDate checks whether MuteConfig is configured with given
+// MuteConfigParameters.
+func IsUpToDate(p v1alpha1.MuteConfigParameters, mc scc.GoogleCloudSecuritycenterV1MuteConfig) bool {
+	return len(GenerateUpdateMask(p, mc)) == 0
+}
+
+// GenerateUpdateMask returns the comma separated list of fields that differ
+// between MuteConfigParameters and MuteConfig.
+func GenerateUpdateMask(p v1alpha1.MuteConfigParameters, mc scc.GoogleCloudSecuritycenterV1MuteConfig) string {
+	mask := []string{}
+	if gcp.StringValue(p.Description) != mc.Description {
+		mask = append(mask, "description")
+	}
+	if p.Filter != mc.Filter {
+		mask = append(mask, "filter")
+	}
+	return strings.Join(mask, ",")
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package muteconfig
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	scc "google.golang.org/api/securitycenter/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/securitycenter/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	name   = "folders/123/muteConfigs/my-config"
+	filter = `category = "OPEN_FIREWALL"`
+)
+
+func TestGenerateMuteConfig(t *testing.T) {
+	cases := map[string]struct {
+		p   v1alpha1.MuteConfigParameters
+		out *scc.GoogleCloudSecuritycenterV1MuteConfig
+	}{
+		"Full": {
+			p: v1alpha1.MuteConfigParameters{
+				Parent:      gcp.StringPtr("folders/123"),
+				Description: gcp.StringPtr("mute"),
+				Filter:      filter,
+			},
+			out: &scc.GoogleCloudSecuritycenterV1MuteConfig{
+				Name:        name,
+				Description: "mute",
+				Filter:      filter,
+			},
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			got := GenerateMuteConfig(GetFullyQualifiedName(GetParent("my-project", tc.p), "my-config"), tc.p)
+			if diff := cmp.Diff(tc.out, got); diff != "" {
+				t.Errorf("GenerateMuteConfig(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateUpdateMask(t *testing.T) {
+	cases := map[string]struct {
+		p   v1alpha1.MuteConfigParameters
+		mc  scc.GoogleCloudSecuritycenterV1MuteConfig
+		out string
+	}{
+		"UpToDate": {
+			p:   v1alpha1.MuteConfigParameters{Filter: filter},
+			mc:  scc.GoogleCloudSecuritycenterV1MuteConfig{Name: name, Filter: filter, CreateTime: "now"},
+			out: "",
+		},
+		"FilterChanged": {
+			p:   v1alpha1.MuteConfigParameters{Filter: `category = "PUBLIC_BUCKET_ACL"`},
+			mc:  scc.GoogleCloudSecuritycenterV1MuteConfig{Name: name, Filter: filter},
+			out: "filter",
+		},
+		"DescriptionChanged": {
+			p:   v1alpha1.MuteConfigParameters{Description: gcp.StringPtr("new"), Filter: filter},
+			mc:  scc.GoogleCloudSecuritycenterV1MuteConfig{Name: name, Description: "old", Filter: filter},
+			out: "description",
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			got := GenerateUpdateMask(tc.p, tc.mc)
+			if diff := cmp.Diff(tc.out, got); diff != "" {
+				t.Errorf("GenerateUpdateMask(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.out == "", IsUpToDate(tc.p, tc.mc)); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodepool
+
+import (
+	"encoding/json"
+
+	container "google.golang.org/api/container/v1"
+	containerbeta "google.golang.org/api/container/v1beta1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/container/v1beta1"
+)
+
+const (
+	errConvertToBeta   = "cannot convert GKE node pool to its beta representation"
+	errConvertFromBeta = "cannot convert GKE node pool from its beta representation"
+)
+
+// HasBetaFields returns true if the supplied parameters set any field that
+// is only supported by the GKE beta API.
+func HasBetaFields(in *v1beta1.NodePoolParameters) bool {
+	return in.Config != nil && in.Config.EphemeralStorageConfig != nil
+}
+
+// ToBeta converts the supplied node pool to its beta representation. The
+// beta API is a superset of the GA API, so no information is lost. Note that
+// the ForceSendFields of the supplied node pool are not carried over.
+func ToBeta(in *container.NodePool) (*containerbeta.NodePool, error) {
+	b, err := json.Marshal(in)
+	if err != nil {
+		return nil, errors.Wrap(err, errConvertToBeta)
+	}
+	out := &containerbeta.NodePool{}
+	return out, errors.Wrap(json.Unmarshal(b, out), errConvertToBeta)
+}
+
+// FromBeta converts the supplied beta node pool to its GA representation.
+// Fields that are only supported by the GKE beta API are lost.
+func FromBeta(in *containerbeta.NodePool) (*container.NodePool, error) {
+	b, err := json.Marshal(in)
+	if err != nil {
+		return nil, errors.Wrap(err, errConvertFromBeta)
+	}
+	out := &container.NodePool{}
+	return out, errors.Wrap(json.Unmarshal(b, out), errConvertFromBeta)
+}
+
+// GenerateBetaNodePool sets the fields of the supplied beta node pool that
+// are only supported by the GKE beta API. All other fields are generated by
+// GenerateNodePool.
+func GenerateBetaNodePool(in v1beta1.NodePoolParameters, pool *containerbeta.NodePool) {
+	if in.Config == nil || in.Config.EphemeralStorageConfig == nil {
+		return
+	}
+	if pool.Config == nil {
+		pool.Config = &containerbeta.NodeConfig{}
+	}
+	pool.Config.EphemeralStorageConfig = &containerbeta.EphemeralStorageConfig{
+		LocalSsdCount: in.Config.EphemeralStorageConfig.LocalSsdCount,
+	}
+}
+
+// GenerateLocalStorageStatus returns the observed local storage of the nodes
+// of the supplied node pool. How the local SSDs are used is only known if the
+// beta representation of the node pool is supplied too.
+func GenerateLocalStorageStatus(in container.NodePool, beta *containerbeta.NodePool) *v1beta1.LocalStorageStatus {
+	if in.Config == nil {
+		return nil
+	}
+	o := &v1beta1.LocalStorageStatus{LocalSsdCount: in.Config.LocalSsdCount}
+	if beta == nil || beta.Config == nil {
+		return o
+	}
+	o.EphemeralStorage = v1beta1.EphemeralStorageBootDisk
+	if c := beta.Config.EphemeralStorageConfig; c != nil && c.LocalSsdCount > 0 {
+		o.EphemeralStorageLocalSsdCount = c.LocalSsdCount
+		o.EphemeralStorage = v1beta1.EphemeralStorageLocalSSD
+	}
+	return o
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodepool
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	container "google.golang.org/api/container/v1"
+	containerbeta "google.golang.org/api/container/v1beta1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/container/v1beta1"
+)
+
+func TestGenerateBetaNodePool(t *testing.T) {
+	type args struct {
+		params v1beta1.NodePoolParameters
+		pool   *container.NodePool
+	}
+	cases := map[string]struct {
+		args     args
+		wantBeta bool
+		want     *containerbeta.NodePool
+	}{
+		"NoBetaFields": {
+			args: args{
+				params: v1beta1.NodePoolParameters{},
+				pool:   &container.NodePool{Name: name},
+			},
+			want: &containerbeta.NodePool{Name: name},
+		},
+		"EphemeralStorageConfig": {
+			args: args{
+				params: v1beta1.NodePoolParameters{
+					Config: &v1beta1.NodeConfig{
+						EphemeralStorageConfig: &v1beta1.EphemeralStorageConfig{LocalSsdCount: 2},
+					},
+				},
+				pool: &container.NodePool{
+					Name:   name,
+					Config: &container.NodeConfig{MachineType: "n2-standard-8"},
+				},
+			},
+			wantBeta: true,
+			want: &containerbeta.NodePool{
+				Name: name,
+				Config: &containerbeta.NodeConfig{
+					MachineType:            "n2-standard-8",
+					EphemeralStorageConfig: &containerbeta.EphemeralStorageConfig{LocalSsdCount: 2},
+				},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.wantBeta, HasBetaFields(&tc.args.params)); diff != "" {
+				t.Errorf("HasBetaFields(...): -want, +got:\n%s", diff)
+			}
+			got, err := ToBeta(tc.args.pool)
+			if err != nil {
+				t.Fatalf("ToBeta(...): %s", err)
+			}
+			GenerateBetaNodePool(tc.args.params, got)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateBetaNodePool(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateLocalStorageStatus(t *testing.T) {
+	type args struct {
+		pool *container.NodePool
+		beta *containerbeta.NodePool
+	}
+	cases := map[string]struct {
+		args args
+		want *v1beta1.LocalStorageStatus
+	}{
+		"NoConfig": {
+			args: args{pool: &container.NodePool{}},
+		},
+		"BetaAPIDisabled": {
+			args: args{pool: &container.NodePool{Config: &container.NodeConfig{LocalSsdCount: 2}}},
+			want: &v1beta1.LocalStorageStatus{LocalSsdCount: 2},
+		},
+		"BootDisk": {
+			args: args{
+				pool: &container.NodePool{Config: &container.NodeConfig{LocalSsdCount: 2}},
+				beta: &containerbeta.NodePool{Config: &containerbeta.NodeConfig{LocalSsdCount: 2}},
+			},
+			want: &v1beta1.LocalStorageStatus{LocalSsdCount: 2, EphemeralStorage: v1beta1.EphemeralStorageBootDisk},
+		},
+		"LocalSSD": {
+			args: args{
+				pool: &container.NodePool{Config: &container.NodeConfig{}},
+				beta: &containerbeta.NodePool{Config: &containerbeta.NodeConfig{
+					EphemeralStorageConfig: &containerbeta.EphemeralStorageConfig{LocalSsdCount: 4},
+				}},
+			},
+			want: &v1beta1.LocalStorageStatus{EphemeralStorageLocalSsdCount: 4, EphemeralStorage: v1beta1.EphemeralStorageLocalSSD},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateLocalStorageStatus(*tc.args.pool, tc.args.beta)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateLocalStorageStatus(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
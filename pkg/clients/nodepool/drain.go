@@ -0,0 +1,172 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodepool
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"time"
+
+	"golang.org/x/oauth2"
+	container "google.golang.org/api/container/v1"
+	"google.golang.org/api/option"
+	"google.golang.org/api/transport"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/container/v1beta1"
+)
+
+const (
+	// NodePoolLabel is the label GKE sets on each node to the name of the
+	// node pool the node belongs to.
+	NodePoolLabel = "cloud.google.com/gke-nodepool"
+
+	// DefaultDrainTimeout is how long a node pool is drained for before it is
+	// deleted if no timeout is configured.
+	DefaultDrainTimeout = 10 * time.Minute
+
+	errNoMasterAuth   = "GKE cluster has no master auth"
+	errDecodeCA       = "cannot decode cluster CA certificate of GKE cluster"
+	errGetCredentials = "cannot get credentials for GKE cluster"
+	errNewKubeClient  = "cannot create Kubernetes client for GKE cluster"
+	errListNodes      = "cannot list nodes of GKE node pool"
+	errCordonNodeFmt  = "cannot cordon node %s"
+	errListPodsFmt    = "cannot list pods of node %s"
+	errEvictPodFmt    = "cannot evict pod %s"
+)
+
+// NewKubeClient returns a Kubernetes client for the supplied GKE cluster. The
+// client authenticates as the provider itself, by sending an OAuth2 access
+// token from the credentials of the supplied client options as a bearer token.
+// Unlike the client certificate and basic auth credentials of the cluster,
+// this works for clusters that were created without them.
+func NewKubeClient(ctx context.Context, c *container.Cluster, opts ...option.ClientOption) (kubernetes.Interface, error) {
+	if c.MasterAuth == nil {
+		return nil, errors.New(errNoMasterAuth)
+	}
+	ca, err := base64.StdEncoding.DecodeString(c.MasterAuth.ClusterCaCertificate)
+	if err != nil {
+		return nil, errors.Wrap(err, errDecodeCA)
+	}
+	creds, err := transport.Creds(ctx, append([]option.ClientOption{option.WithScopes(container.CloudPlatformScope)}, opts...)...)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetCredentials)
+	}
+	kc, err := kubernetes.NewForConfig(&rest.Config{
+		Host:            fmt.Sprintf("https://%s", c.Endpoint),
+		TLSClientConfig: rest.TLSClientConfig{CAData: ca},
+		WrapTransport: func(rt http.RoundTripper) http.RoundTripper {
+			return &oauth2.Transport{Source: creds.TokenSource, Base: rt}
+		},
+	})
+	return kc, errors.Wrap(err, errNewKubeClient)
+}
+
+// DrainTimeout returns how long the supplied DrainBeforeDelete allows the
+// nodes of a node pool to be drained for.
+func DrainTimeout(d *v1beta1.DrainBeforeDelete) time.Duration {
+	if d == nil || d.Timeout == nil {
+		return DefaultDrainTimeout
+	}
+	// The timeout is validated by a kubebuilder Pattern marker, so a parse
+	// error is not expected here.
+	t, err := time.ParseDuration(*d.Timeout)
+	if err != nil {
+		return DefaultDrainTimeout
+	}
+	return t
+}
+
+// Drain cordons the nodes of the named node pool and requests the eviction of
+// the pods running on them. Evictions that a PodDisruptionBudget does not
+// currently allow are retried by subsequent calls. Drain returns the
+// namespaced names of the pods that are still running on the nodes; the node
+// pool is drained once none remain. Mirror pods, pods managed by a DaemonSet
+// and pods that have already finished are left in place.
+func Drain(ctx context.Context, kube kubernetes.Interface, nodePool string, gracePeriod *int64) ([]string, error) { // nolint:gocyclo
+	nodes, err := kube.CoreV1().Nodes().List(ctx, metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(labels.Set{NodePoolLabel: nodePool}).String(),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, errListNodes)
+	}
+
+	remaining := []string{}
+	for i := range nodes.Items {
+		n := &nodes.Items[i]
+		if !n.Spec.Unschedulable {
+			n.Spec.Unschedulable = true
+			if _, err := kube.CoreV1().Nodes().Update(ctx, n, metav1.UpdateOptions{}); err != nil {
+				return nil, errors.Wrapf(err, errCordonNodeFmt, n.GetName())
+			}
+		}
+
+		pods, err := kube.CoreV1().Pods(metav1.NamespaceAll).List(ctx, metav1.ListOptions{
+			FieldSelector: fields.OneTermEqualSelector("spec.nodeName", n.GetName()).String(),
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, errListPodsFmt, n.GetName())
+		}
+
+		for j := range pods.Items {
+			p := &pods.Items[j]
+			if p.Spec.NodeName != n.GetName() || !isEvictable(p) {
+				continue
+			}
+			name := fmt.Sprintf("%s/%s", p.GetNamespace(), p.GetName())
+			remaining = append(remaining, name)
+
+			// The pod is already terminating.
+			if p.GetDeletionTimestamp() != nil {
+				continue
+			}
+			err := kube.CoreV1().Pods(p.GetNamespace()).EvictV1(ctx, &policyv1.Eviction{
+				ObjectMeta:    metav1.ObjectMeta{Namespace: p.GetNamespace(), Name: p.GetName()},
+				DeleteOptions: &metav1.DeleteOptions{GracePeriodSeconds: gracePeriod},
+			})
+			// An eviction is refused with 429 Too Many Requests while it
+			// would violate a PodDisruptionBudget.
+			if err != nil && !kerrors.IsNotFound(err) && !kerrors.IsTooManyRequests(err) {
+				return nil, errors.Wrapf(err, errEvictPodFmt, name)
+			}
+		}
+	}
+	return remaining, nil
+}
+
+// isEvictable returns true if the supplied pod has to be evicted for its node
+// to be drained.
+func isEvictable(p *corev1.Pod) bool {
+	if _, ok := p.GetAnnotations()[corev1.MirrorPodAnnotationKey]; ok {
+		return false
+	}
+	if c := metav1.GetControllerOf(p); c != nil && c.Kind == "DaemonSet" {
+		return false
+	}
+	return p.Status.Phase != corev1.PodSucceeded && p.Status.Phase != corev1.PodFailed
+}
@@ -0,0 +1,221 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodepool
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/oauth2"
+	container "google.golang.org/api/container/v1"
+	"google.golang.org/api/option"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/container/v1beta1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	drainNode = "my-node"
+	drainPool = "my-pool"
+)
+
+func node(pool string) *corev1.Node {
+	return &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: drainNode, Labels: map[string]string{NodePoolLabel: pool}},
+	}
+}
+
+func pod(name string, m ...func(*corev1.Pod)) *corev1.Pod {
+	p := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name},
+		Spec:       corev1.PodSpec{NodeName: drainNode},
+		Status:     corev1.PodStatus{Phase: corev1.PodRunning},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+// evictionReactor deletes evicted pods, or refuses to evict them with the
+// supplied error.
+func evictionReactor(c *fake.Clientset, refuse error) k8stesting.ReactionFunc {
+	return func(action k8stesting.Action) (bool, runtime.Object, error) {
+		if action.GetSubresource() != "eviction" {
+			return false, nil, nil
+		}
+		if refuse != nil {
+			return true, nil, refuse
+		}
+		name := action.(k8stesting.CreateAction).GetObject().(metav1.Object).GetName()
+		return true, nil, c.Tracker().Delete(schema.GroupVersionResource{Version: "v1", Resource: "pods"}, action.GetNamespace(), name)
+	}
+}
+
+func TestDrain(t *testing.T) {
+	daemon := true
+	type args struct {
+		objects []runtime.Object
+		refuse  error
+	}
+	type want struct {
+		remaining []string
+		err       error
+	}
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NoNodes": {
+			args: args{
+				objects: []runtime.Object{node("other-pool"), pod("pod")},
+			},
+			want: want{remaining: []string{}},
+		},
+		"Evicted": {
+			args: args{
+				objects: []runtime.Object{node(drainPool), pod("pod")},
+			},
+			want: want{remaining: []string{"default/pod"}},
+		},
+		"SkipsUnevictablePods": {
+			args: args{
+				objects: []runtime.Object{
+					node(drainPool),
+					pod("mirror", func(p *corev1.Pod) {
+						p.Annotations = map[string]string{corev1.MirrorPodAnnotationKey: "mirror"}
+					}),
+					pod("daemon", func(p *corev1.Pod) {
+						p.OwnerReferences = []metav1.OwnerReference{{Kind: "DaemonSet", Name: "daemon", Controller: &daemon}}
+					}),
+					pod("done", func(p *corev1.Pod) { p.Status.Phase = corev1.PodSucceeded }),
+					pod("elsewhere", func(p *corev1.Pod) { p.Spec.NodeName = "other-node" }),
+				},
+			},
+			want: want{remaining: []string{}},
+		},
+		"DisruptionBudgetRefused": {
+			args: args{
+				objects: []runtime.Object{node(drainPool), pod("pod")},
+				refuse:  kerrors.NewTooManyRequests("disruption budget", 10),
+			},
+			want: want{remaining: []string{"default/pod"}},
+		},
+		"EvictionFailed": {
+			args: args{
+				objects: []runtime.Object{node(drainPool), pod("pod")},
+				refuse:  kerrors.NewForbidden(schema.GroupResource{Resource: "pods"}, "pod", errors.New("boom")),
+			},
+			want: want{
+				err: errors.Wrapf(kerrors.NewForbidden(schema.GroupResource{Resource: "pods"}, "pod", errors.New("boom")), errEvictPodFmt, "default/pod"),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := fake.NewSimpleClientset(tc.args.objects...)
+			c.PrependReactor("create", "pods", evictionReactor(c, tc.args.refuse))
+
+			remaining, err := Drain(context.Background(), c, drainPool, nil)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Drain(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.remaining, remaining); diff != "" {
+				t.Errorf("Drain(...): -want, +got:\n%s", diff)
+			}
+			if tc.want.err != nil {
+				return
+			}
+			n, _ := c.CoreV1().Nodes().Get(context.Background(), drainNode, metav1.GetOptions{})
+			if diff := cmp.Diff(n.GetLabels()[NodePoolLabel] == drainPool, n.Spec.Unschedulable); diff != "" {
+				t.Errorf("Drain(...): -want cordoned, +got cordoned:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDrainTimeout(t *testing.T) {
+	cases := map[string]struct {
+		in   *v1beta1.DrainBeforeDelete
+		want time.Duration
+	}{
+		"Unset": {
+			in:   nil,
+			want: DefaultDrainTimeout,
+		},
+		"Default": {
+			in:   &v1beta1.DrainBeforeDelete{},
+			want: DefaultDrainTimeout,
+		},
+		"Configured": {
+			in:   &v1beta1.DrainBeforeDelete{Timeout: gcp.StringPtr("1h30m")},
+			want: 90 * time.Minute,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, DrainTimeout(tc.in)); diff != "" {
+				t.Errorf("DrainTimeout(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNewKubeClient(t *testing.T) {
+	var got string
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Get("Authorization")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"kind":"NodeList","apiVersion":"v1","items":[]}`))
+	}))
+	defer srv.Close()
+
+	ca := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw})
+	// The cluster has neither a client certificate nor basic auth
+	// credentials.
+	c := &container.Cluster{
+		Endpoint:   strings.TrimPrefix(srv.URL, "https://"),
+		MasterAuth: &container.MasterAuth{ClusterCaCertificate: base64.StdEncoding.EncodeToString(ca)},
+	}
+	kube, err := NewKubeClient(context.Background(), c, option.WithTokenSource(oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "cool-token"})))
+	if err != nil {
+		t.Fatalf("NewKubeClient(...): unexpected error: %v", err)
+	}
+	if _, err := kube.CoreV1().Nodes().List(context.Background(), metav1.ListOptions{}); err != nil {
+		t.Fatalf("List(...): unexpected error: %v", err)
+	}
+	if diff := cmp.Diff("Bearer cool-token", got); diff != "" {
+		t.Errorf("NewKubeClient(...): -want Authorization, +got Authorization:\n%s", diff)
+	}
+}
@@ -19,18 +19,22 @@ package nodepool
 import (
 	"context"
 	"fmt"
+	"path"
 	"strings"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/mitchellh/copystructure"
+	compute "google.golang.org/api/compute/v1"
 	container "google.golang.org/api/container/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/crossplane/crossplane-runtime/pkg/errors"
 
 	"github.com/crossplane-contrib/provider-gcp/apis/container/v1beta1"
 	"github.com/crossplane-contrib/provider-gcp/apis/container/v1beta2"
 	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/immutable"
 )
 
 const (
@@ -40,6 +44,13 @@ const (
 	errCheckUpToDate = "unable to determine if external resource is up to date"
 
 	runtimeKey = "sandbox.gke.io/runtime"
+
+	// defaultMachineType is the machine type GKE creates nodes with if none
+	// is specified.
+	defaultMachineType = "e2-medium"
+
+	quotaMetricCPUs           = "CPUS"
+	quotaMetricInUseAddresses = "IN_USE_ADDRESSES"
 )
 
 // GenerateNodePool generates *container.NodePool instance from NodePoolParameters.
@@ -110,6 +121,13 @@ func GenerateConfig(in *v1beta1.NodeConfig, pool *container.NodePool) { // nolin
 			}
 		}
 
+		if in.AdvancedMachineFeatures != nil {
+			if pool.Config.AdvancedMachineFeatures == nil {
+				pool.Config.AdvancedMachineFeatures = &container.AdvancedMachineFeatures{}
+			}
+			pool.Config.AdvancedMachineFeatures.ThreadsPerCore = gcp.Int64Value(in.AdvancedMachineFeatures.ThreadsPerCore)
+		}
+
 		if in.KubeletConfig != nil {
 			if pool.Config.KubeletConfig == nil {
 				pool.Config.KubeletConfig = &container.NodeKubeletConfig{}
@@ -214,6 +232,14 @@ func GenerateObservation(in container.NodePool) v1beta1.NodePoolObservation { //
 		StatusMessage:     in.StatusMessage,
 	}
 
+	for _, u := range in.InstanceGroupUrls {
+		o.InstanceGroupManagers = append(o.InstanceGroupManagers, path.Base(u))
+	}
+
+	if in.Config != nil {
+		o.ServiceAccount = in.Config.ServiceAccount
+	}
+
 	for _, condition := range in.Conditions {
 		if condition != nil {
 			o.Conditions = append(o.Conditions, &v1beta2.StatusCondition{
@@ -236,6 +262,184 @@ func GenerateObservation(in container.NodePool) v1beta1.NodePoolObservation { //
 
 }
 
+// InstanceGroupsObservation is the observed state of the managed instance
+// groups of a node pool.
+type InstanceGroupsObservation struct {
+	// InstanceTemplates are the names of the instance templates the groups
+	// create nodes from.
+	InstanceTemplates []string
+
+	// CurrentSize is the number of instances that exist in the groups.
+	CurrentSize int64
+
+	// TargetSize is the number of instances the groups are being scaled to.
+	TargetSize int64
+}
+
+// ObserveInstanceGroups observes the managed instance groups at the supplied
+// URLs.
+func ObserveInstanceGroups(ctx context.Context, s *compute.Service, urls []string) (InstanceGroupsObservation, error) {
+	o := InstanceGroupsObservation{}
+	seen := map[string]bool{}
+	for _, u := range urls {
+		project, zone, name := parseInstanceGroupManagerURL(u)
+		if name == "" {
+			continue
+		}
+		igm, err := s.InstanceGroupManagers.Get(project, zone, name).Context(ctx).Do()
+		if err != nil {
+			return InstanceGroupsObservation{}, err
+		}
+		o.CurrentSize += currentSize(igm.CurrentActions)
+		o.TargetSize += igm.TargetSize
+		t := path.Base(igm.InstanceTemplate)
+		if igm.InstanceTemplate == "" || seen[t] {
+			continue
+		}
+		seen[t] = true
+		o.InstanceTemplates = append(o.InstanceTemplates, t)
+	}
+	return o, nil
+}
+
+// currentSize returns the number of instances that exist in a managed
+// instance group. Every instance is counted against exactly one action, so
+// only those that are yet to be created are left out.
+func currentSize(a *compute.InstanceGroupManagerActionsSummary) int64 {
+	if a == nil {
+		return 0
+	}
+	return a.Abandoning + a.Deleting + a.None + a.Recreating + a.Refreshing + a.Restarting +
+		a.Resuming + a.Starting + a.Stopping + a.Suspending + a.Verifying
+}
+
+// GenerateScalingStatus returns the scaling status of a node pool whose
+// instance groups were observed at the supplied time. The last scale up and
+// down times are carried over from the previous status, and replaced when
+// the target size moved since it was recorded.
+func GenerateScalingStatus(last *v1beta1.NodePoolScalingStatus, o InstanceGroupsObservation, now metav1.Time) *v1beta1.NodePoolScalingStatus {
+	s := &v1beta1.NodePoolScalingStatus{
+		CurrentNodeCount: o.CurrentSize,
+		TargetNodeCount:  o.TargetSize,
+	}
+	if last == nil {
+		return s
+	}
+	s.LastScaleUpTime = last.LastScaleUpTime
+	s.LastScaleDownTime = last.LastScaleDownTime
+	switch {
+	case o.TargetSize > last.TargetNodeCount:
+		s.LastScaleUpTime = &now
+	case o.TargetSize < last.TargetNodeCount:
+		s.LastScaleDownTime = &now
+	}
+	return s
+}
+
+// parseInstanceGroupManagerURL returns the project, zone and name of the
+// managed instance group at the supplied URL, e.g.
+// https://www.googleapis.com/compute/v1/projects/p/zones/z/instanceGroupManagers/n
+func parseInstanceGroupManagerURL(u string) (project, zone, name string) {
+	p := strings.Split(u, "/")
+	for i := 0; i+1 < len(p); i++ {
+		switch p[i] {
+		case "projects":
+			project = p[i+1]
+		case "zones":
+			zone = p[i+1]
+		case "instanceGroupManagers":
+			name = p[i+1]
+		}
+	}
+	return project, zone, name
+}
+
+// CheckQuota returns a description of each regional Compute Engine quota that
+// is too small to create the supplied node pool in the supplied cluster. It
+// returns nothing if every quota suffices.
+func CheckQuota(ctx context.Context, s *compute.Service, clusterName string, cluster *container.Cluster, pool *container.NodePool) ([]string, error) {
+	zones := pool.Locations
+	if len(zones) == 0 {
+		zones = cluster.Locations
+	}
+	if pool.InitialNodeCount == 0 || len(zones) == 0 {
+		return nil, nil
+	}
+	project := ParseProject(clusterName)
+	machineType := defaultMachineType
+	if pool.Config != nil && pool.Config.MachineType != "" {
+		machineType = pool.Config.MachineType
+	}
+	mt, err := s.MachineTypes.Get(project, zones[0], machineType).Context(ctx).Do()
+	if err != nil {
+		return nil, err
+	}
+
+	// Node counts are per zone.
+	nodes := pool.InitialNodeCount * int64(len(zones))
+	required := map[string]float64{cpuQuotaMetric(machineType): float64(mt.GuestCpus * nodes)}
+	if !hasPrivateNodes(cluster, pool) {
+		required[quotaMetricInUseAddresses] = float64(nodes)
+	}
+
+	region := zoneRegion(zones[0])
+	r, err := s.Regions.Get(project, region).Context(ctx).Do()
+	if err != nil {
+		return nil, err
+	}
+	var short []string
+	for _, q := range r.Quotas {
+		n, ok := required[q.Metric]
+		if !ok || q.Usage+n <= q.Limit {
+			continue
+		}
+		short = append(short, fmt.Sprintf("%s in %s: %g required, %g of %g available", q.Metric, region, n, q.Limit-q.Usage, q.Limit))
+	}
+	return short, nil
+}
+
+// cpuQuotaMetric returns the regional quota metric that limits the vCPUs of
+// the supplied machine type. N1, E2 and shared-core machines count against
+// CPUS, every other family against its own metric, e.g. N2_CPUS.
+func cpuQuotaMetric(machineType string) string {
+	family := strings.SplitN(machineType, "-", 2)[0]
+	switch family {
+	case "n1", "e2", "f1", "g1", "custom":
+		return quotaMetricCPUs
+	}
+	return strings.ToUpper(family) + "_CPUS"
+}
+
+// hasPrivateNodes returns true if the nodes of the supplied node pool get no
+// external IP address.
+func hasPrivateNodes(cluster *container.Cluster, pool *container.NodePool) bool {
+	if pool.NetworkConfig != nil && pool.NetworkConfig.EnablePrivateNodes {
+		return true
+	}
+	return cluster.PrivateClusterConfig != nil && cluster.PrivateClusterConfig.EnablePrivateNodes
+}
+
+// zoneRegion returns the region of the supplied zone, e.g. us-central1 for
+// us-central1-a.
+func zoneRegion(zone string) string {
+	if i := strings.LastIndex(zone, "-"); i > 0 && strings.Count(zone, "-") == 2 {
+		return zone[:i]
+	}
+	return zone
+}
+
+// ParseProject returns the project of the supplied resource name, e.g.
+// projects/p/locations/l/clusters/c.
+func ParseProject(name string) string {
+	p := strings.Split(name, "/")
+	for i := 0; i+1 < len(p); i++ {
+		if p[i] == "projects" {
+			return p[i+1]
+		}
+	}
+	return ""
+}
+
 // GenerateNodePoolUpdate produces NodePoolObservation object from *container.NodePool object.
 func GenerateNodePoolUpdate(in *v1beta1.NodePoolParameters) *container.UpdateNodePoolRequest { // nolint:gocyclo
 	o := &container.UpdateNodePoolRequest{
@@ -284,6 +488,13 @@ func LateInitializeSpec(spec *v1beta1.NodePoolParameters, in container.NodePool)
 			}
 		}
 
+		if in.Config.AdvancedMachineFeatures != nil {
+			if spec.Config.AdvancedMachineFeatures == nil {
+				spec.Config.AdvancedMachineFeatures = &v1beta1.AdvancedMachineFeatures{}
+			}
+			spec.Config.AdvancedMachineFeatures.ThreadsPerCore = gcp.LateInitializeInt64(spec.Config.AdvancedMachineFeatures.ThreadsPerCore, in.Config.AdvancedMachineFeatures.ThreadsPerCore)
+		}
+
 		spec.Config.BootDiskKmsKey = gcp.LateInitializeString(spec.Config.BootDiskKmsKey, in.Config.BootDiskKmsKey)
 		spec.Config.DiskSizeGb = gcp.LateInitializeInt64(spec.Config.DiskSizeGb, in.Config.DiskSizeGb)
 		spec.Config.DiskType = gcp.LateInitializeString(spec.Config.DiskType, in.Config.DiskType)
@@ -455,6 +666,35 @@ func IsUpToDate(name string, in *v1beta1.NodePoolParameters, observed *container
 	return true, noOpUpdate, nil
 }
 
+// ImmutableFieldsChanged returns the paths of the fields of the supplied
+// NodePoolParameters that can only be set when a node pool is created and
+// that differ from the observed node pool. GKE ignores changes to them on
+// update.
+func ImmutableFieldsChanged(in *v1beta1.NodePoolParameters, observed *container.NodePool) []string {
+	if in.Config == nil {
+		return []string{}
+	}
+	o := &container.NodeConfig{}
+	if observed.Config != nil {
+		o = observed.Config
+	}
+	var threadsPerCore *int64
+	if in.Config.AdvancedMachineFeatures != nil {
+		threadsPerCore = in.Config.AdvancedMachineFeatures.ThreadsPerCore
+	}
+	var observedThreadsPerCore int64
+	if o.AdvancedMachineFeatures != nil {
+		observedThreadsPerCore = o.AdvancedMachineFeatures.ThreadsPerCore
+	}
+	return immutable.Changed(
+		immutable.Field{Path: "config.advancedMachineFeatures.threadsPerCore", Desired: threadsPerCore, Observed: observedThreadsPerCore},
+		immutable.Field{Path: "config.bootDiskKmsKey", Desired: in.Config.BootDiskKmsKey, Observed: o.BootDiskKmsKey},
+		immutable.Field{Path: "config.diskSizeGb", Desired: in.Config.DiskSizeGb, Observed: o.DiskSizeGb},
+		immutable.Field{Path: "config.diskType", Desired: in.Config.DiskType, Observed: o.DiskType},
+		immutable.Field{Path: "config.machineType", Desired: in.Config.MachineType, Observed: o.MachineType},
+	)
+}
+
 // GetFullyQualifiedName builds the fully qualified name of the cluster.
 func GetFullyQualifiedName(p v1beta1.NodePoolParameters, name string) string {
 	// Zonal clusters use /zones/ in their path instead of /locations/. We
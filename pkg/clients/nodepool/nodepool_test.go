@@ -17,11 +17,20 @@ limitations under the License.
 package nodepool
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
+	compute "google.golang.org/api/compute/v1"
 	container "google.golang.org/api/container/v1"
+	"google.golang.org/api/option"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/crossplane-contrib/provider-gcp/apis/container/v1beta1"
 	"github.com/crossplane-contrib/provider-gcp/apis/container/v1beta2"
@@ -70,6 +79,10 @@ func observation(m ...func(*v1beta1.NodePoolObservation)) *v1beta1.NodePoolObser
 			},
 		},
 		InstanceGroupUrls: []string{
+			"https://www.googleapis.com/compute/v1/projects/cool-project/zones/us-central1-a/instanceGroupManagers/cool-group-1",
+			"https://www.googleapis.com/compute/v1/projects/cool-project/zones/us-central1-b/instanceGroupManagers/cool-group-2",
+		},
+		InstanceGroupManagers: []string{
 			"cool-group-1",
 			"cool-group-2",
 		},
@@ -99,8 +112,8 @@ func addOutputFields(n *container.NodePool) {
 		},
 	}
 	n.InstanceGroupUrls = []string{
-		"cool-group-1",
-		"cool-group-2",
+		"https://www.googleapis.com/compute/v1/projects/cool-project/zones/us-central1-a/instanceGroupManagers/cool-group-1",
+		"https://www.googleapis.com/compute/v1/projects/cool-project/zones/us-central1-b/instanceGroupManagers/cool-group-2",
 	}
 	n.PodIpv4CidrSize = 24
 	n.SelfLink = "/link/to/myself"
@@ -139,6 +152,16 @@ func TestGenerateObservation(t *testing.T) {
 				p.PodIpv4CidrSize = 16
 			}),
 		},
+		"NodeServiceAccount": {
+			args: args{
+				nodePool: nodePool(addOutputFields, func(n *container.NodePool) {
+					n.Config = &container.NodeConfig{ServiceAccount: "nodes@cool-project.iam.gserviceaccount.com"}
+				}),
+			},
+			want: observation(func(p *v1beta1.NodePoolObservation) {
+				p.ServiceAccount = "nodes@cool-project.iam.gserviceaccount.com"
+			}),
+		},
 	}
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -150,6 +173,188 @@ func TestGenerateObservation(t *testing.T) {
 	}
 }
 
+func TestObserveInstanceGroups(t *testing.T) {
+	groups := map[string]*compute.InstanceGroupManager{
+		"/projects/cool-project/zones/us-central1-a/instanceGroupManagers/cool-group-1": {
+			InstanceTemplate: "https://www.googleapis.com/compute/v1/projects/cool-project/global/instanceTemplates/cool-template",
+			TargetSize:       3,
+			CurrentActions:   &compute.InstanceGroupManagerActionsSummary{None: 2, Creating: 1},
+		},
+		"/projects/cool-project/zones/us-central1-b/instanceGroupManagers/cool-group-2": {
+			InstanceTemplate: "https://www.googleapis.com/compute/v1/projects/cool-project/global/instanceTemplates/cool-template",
+			TargetSize:       1,
+			CurrentActions:   &compute.InstanceGroupManagerActionsSummary{None: 1, Deleting: 1},
+		},
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.Body.Close()
+		for suffix, igm := range groups {
+			if strings.HasSuffix(r.URL.Path, suffix) {
+				_ = json.NewEncoder(w).Encode(igm)
+				return
+			}
+		}
+		t.Errorf("unexpected request: %s", r.URL.Path)
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+	s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+
+	got, err := ObserveInstanceGroups(context.Background(), s, nodePool(addOutputFields).InstanceGroupUrls)
+	if err != nil {
+		t.Fatalf("ObserveInstanceGroups(...): unexpected error: %v", err)
+	}
+	want := InstanceGroupsObservation{
+		InstanceTemplates: []string{"cool-template"},
+		CurrentSize:       4,
+		TargetSize:        4,
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("ObserveInstanceGroups(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateScalingStatus(t *testing.T) {
+	earlier := metav1.NewTime(time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC))
+	now := metav1.NewTime(time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC))
+
+	type args struct {
+		last *v1beta1.NodePoolScalingStatus
+		o    InstanceGroupsObservation
+	}
+	cases := map[string]struct {
+		args args
+		want *v1beta1.NodePoolScalingStatus
+	}{
+		"FirstObservation": {
+			args: args{
+				o: InstanceGroupsObservation{CurrentSize: 2, TargetSize: 3},
+			},
+			want: &v1beta1.NodePoolScalingStatus{CurrentNodeCount: 2, TargetNodeCount: 3},
+		},
+		"Unchanged": {
+			args: args{
+				last: &v1beta1.NodePoolScalingStatus{CurrentNodeCount: 2, TargetNodeCount: 3, LastScaleUpTime: &earlier},
+				o:    InstanceGroupsObservation{CurrentSize: 3, TargetSize: 3},
+			},
+			want: &v1beta1.NodePoolScalingStatus{CurrentNodeCount: 3, TargetNodeCount: 3, LastScaleUpTime: &earlier},
+		},
+		"ScaledUp": {
+			args: args{
+				last: &v1beta1.NodePoolScalingStatus{CurrentNodeCount: 3, TargetNodeCount: 3, LastScaleDownTime: &earlier},
+				o:    InstanceGroupsObservation{CurrentSize: 3, TargetSize: 5},
+			},
+			want: &v1beta1.NodePoolScalingStatus{CurrentNodeCount: 3, TargetNodeCount: 5, LastScaleUpTime: &now, LastScaleDownTime: &earlier},
+		},
+		"ScaledDown": {
+			args: args{
+				last: &v1beta1.NodePoolScalingStatus{CurrentNodeCount: 3, TargetNodeCount: 3, LastScaleUpTime: &earlier},
+				o:    InstanceGroupsObservation{CurrentSize: 3, TargetSize: 1},
+			},
+			want: &v1beta1.NodePoolScalingStatus{CurrentNodeCount: 3, TargetNodeCount: 1, LastScaleUpTime: &earlier, LastScaleDownTime: &now},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateScalingStatus(tc.args.last, tc.args.o, now)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateScalingStatus(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCheckQuota(t *testing.T) {
+	clusterName := "projects/cool-project/locations/us-central1/clusters/cool-cluster"
+	quotas := []*compute.Quota{
+		{Metric: "CPUS", Limit: 24, Usage: 16},
+		{Metric: "N2_CPUS", Limit: 24, Usage: 0},
+		{Metric: "IN_USE_ADDRESSES", Limit: 8, Usage: 4},
+	}
+
+	type args struct {
+		cluster *container.Cluster
+		pool    *container.NodePool
+	}
+	cases := map[string]struct {
+		args args
+		want []string
+	}{
+		"Sufficient": {
+			args: args{
+				cluster: &container.Cluster{Locations: []string{"us-central1-a", "us-central1-b"}},
+				pool:    &container.NodePool{InitialNodeCount: 2},
+			},
+		},
+		"InsufficientCPUsAndAddresses": {
+			args: args{
+				cluster: &container.Cluster{Locations: []string{"us-central1-a", "us-central1-b"}},
+				pool:    &container.NodePool{InitialNodeCount: 3},
+			},
+			want: []string{
+				"CPUS in us-central1: 12 required, 8 of 24 available",
+				"IN_USE_ADDRESSES in us-central1: 6 required, 4 of 8 available",
+			},
+		},
+		"PrivateNodesNeedNoAddresses": {
+			args: args{
+				cluster: &container.Cluster{
+					Locations:            []string{"us-central1-a", "us-central1-b"},
+					PrivateClusterConfig: &container.PrivateClusterConfig{EnablePrivateNodes: true},
+				},
+				pool: &container.NodePool{InitialNodeCount: 3},
+			},
+			want: []string{"CPUS in us-central1: 12 required, 8 of 24 available"},
+		},
+		"MachineFamilyQuota": {
+			args: args{
+				cluster: &container.Cluster{Locations: []string{"us-central1-a"}},
+				pool: &container.NodePool{
+					InitialNodeCount: 5,
+					Locations:        []string{"us-central1-a", "us-central1-b", "us-central1-c"},
+					Config:           &container.NodeConfig{MachineType: "n2-standard-2"},
+					NetworkConfig:    &container.NodeNetworkConfig{EnablePrivateNodes: true},
+				},
+			},
+			want: []string{"N2_CPUS in us-central1: 30 required, 24 of 24 available"},
+		},
+		"NoNodes": {
+			args: args{
+				cluster: &container.Cluster{Locations: []string{"us-central1-a"}},
+				pool:    &container.NodePool{},
+			},
+		},
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.Body.Close()
+		switch r.URL.Path {
+		case "/projects/cool-project/zones/us-central1-a/machineTypes/e2-medium":
+			_ = json.NewEncoder(w).Encode(&compute.MachineType{GuestCpus: 2})
+		case "/projects/cool-project/zones/us-central1-a/machineTypes/n2-standard-2":
+			_ = json.NewEncoder(w).Encode(&compute.MachineType{GuestCpus: 2})
+		case "/projects/cool-project/regions/us-central1":
+			_ = json.NewEncoder(w).Encode(&compute.Region{Quotas: quotas})
+		default:
+			t.Errorf("unexpected request: %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := CheckQuota(context.Background(), s, clusterName, tc.args.cluster, tc.args.pool)
+			if err != nil {
+				t.Fatalf("CheckQuota(...): unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("CheckQuota(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestGenerateNodePool(t *testing.T) {
 	type args struct {
 		nodePool *container.NodePool
@@ -361,7 +566,10 @@ func TestGenerateConfig(t *testing.T) {
 				nodePool: &container.NodePool{},
 				params: params(func(p *v1beta1.NodePoolParameters) {
 					p.Config = &v1beta1.NodeConfig{
-						Accelerators:   []*v1beta1.AcceleratorConfig{accConf},
+						Accelerators: []*v1beta1.AcceleratorConfig{accConf},
+						AdvancedMachineFeatures: &v1beta1.AdvancedMachineFeatures{
+							ThreadsPerCore: gcp.Int64Ptr(1),
+						},
 						DiskSizeGb:     gcp.Int64Ptr(diskSizeGb),
 						DiskType:       gcp.StringPtr(diskType),
 						ImageType:      gcp.StringPtr(imageType),
@@ -388,7 +596,10 @@ func TestGenerateConfig(t *testing.T) {
 			},
 			want: nodePool(func(n *container.NodePool) {
 				n.Config = &container.NodeConfig{
-					Accelerators:   []*container.AcceleratorConfig{gcpAccConf},
+					Accelerators: []*container.AcceleratorConfig{gcpAccConf},
+					AdvancedMachineFeatures: &container.AdvancedMachineFeatures{
+						ThreadsPerCore: 1,
+					},
 					DiskSizeGb:     diskSizeGb,
 					DiskType:       diskType,
 					ImageType:      imageType,
@@ -724,3 +935,63 @@ func TestGetFullyQualifiedName(t *testing.T) {
 		})
 	}
 }
+
+func TestImmutableFieldsChanged(t *testing.T) {
+	key := "projects/cool-proj/locations/us-central1/keyRings/ring/cryptoKeys/key"
+	cases := map[string]struct {
+		in      *v1beta1.NodePoolParameters
+		current *container.NodePool
+		want    []string
+	}{
+		"NoConfig": {
+			in:      params(),
+			current: nodePool(),
+			want:    []string{},
+		},
+		"Unchanged": {
+			in: params(func(p *v1beta1.NodePoolParameters) {
+				p.Config = &v1beta1.NodeConfig{BootDiskKmsKey: &key, MachineType: gcp.StringPtr("e2-medium")}
+			}),
+			current: nodePool(func(n *container.NodePool) {
+				n.Config = &container.NodeConfig{BootDiskKmsKey: key, MachineType: "e2-medium", DiskSizeGb: 100}
+			}),
+			want: []string{},
+		},
+		"Changed": {
+			in: params(func(p *v1beta1.NodePoolParameters) {
+				p.Config = &v1beta1.NodeConfig{BootDiskKmsKey: &key, DiskType: gcp.StringPtr("pd-ssd")}
+			}),
+			current: nodePool(func(n *container.NodePool) {
+				n.Config = &container.NodeConfig{DiskType: "pd-standard"}
+			}),
+			want: []string{"config.bootDiskKmsKey", "config.diskType"},
+		},
+		"ThreadsPerCoreChanged": {
+			in: params(func(p *v1beta1.NodePoolParameters) {
+				p.Config = &v1beta1.NodeConfig{AdvancedMachineFeatures: &v1beta1.AdvancedMachineFeatures{ThreadsPerCore: gcp.Int64Ptr(1)}}
+			}),
+			current: nodePool(func(n *container.NodePool) {
+				n.Config = &container.NodeConfig{AdvancedMachineFeatures: &container.AdvancedMachineFeatures{ThreadsPerCore: 2}}
+			}),
+			want: []string{"config.advancedMachineFeatures.threadsPerCore"},
+		},
+		"ThreadsPerCoreNotObserved": {
+			in: params(func(p *v1beta1.NodePoolParameters) {
+				p.Config = &v1beta1.NodeConfig{AdvancedMachineFeatures: &v1beta1.AdvancedMachineFeatures{ThreadsPerCore: gcp.Int64Ptr(1)}}
+			}),
+			current: nodePool(func(n *container.NodePool) {
+				n.Config = &container.NodeConfig{}
+			}),
+			want: []string{"config.advancedMachineFeatures.threadsPerCore"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := ImmutableFieldsChanged(tc.in, tc.current)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("ImmutableFieldsChanged(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodepool
+
+import (
+	"testing"
+
+	container "google.golang.org/api/container/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/container/v1beta1"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/roundtrip"
+)
+
+func TestRoundTrip(t *testing.T) {
+	roundtrip.Check(t, roundtrip.NewFuzzer(1), roundtrip.DefaultRuns, roundtrip.Funcs[v1beta1.NodePoolParameters, container.NodePool]{
+		Generate: func(p v1beta1.NodePoolParameters) *container.NodePool {
+			np := &container.NodePool{}
+			GenerateNodePool(name, p, np)
+			return np
+		},
+		LateInitialize: LateInitializeSpec,
+		IsUpToDate: func(p *v1beta1.NodePoolParameters, np *container.NodePool) (bool, error) {
+			u, _, err := IsUpToDate(name, p, np)
+			return u, err
+		},
+	})
+}
@@ -0,0 +1,104 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notificationconfig
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	scc "google.golang.org/api/securitycenter/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/securitycenter/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	projectParentFormat = "projects/%s"
+	nameFormat          = "%s/notificationConfigs/%s"
+)
+
+// GetParent returns the parent the NotificationConfig lives under, defaulting
+// to the supplied project.
+func GetParent(projectID string, p v1alpha1.NotificationConfigParameters) string {
+	if p.Parent != nil {
+		return *p.Parent
+	}
+	return fmt.Sprintf(projectParentFormat, projectID)
+}
+
+// GetFullyQualifiedName builds the relative resource name of the
+// NotificationConfig.
+func GetFullyQualifiedName(parent, name string) string {
+	return fmt.Sprintf(nameFormat, parent, name)
+}
+
+// GenerateNotificationConfig produces a NotificationConfig that is configured
+// via given NotificationConfigParameters.
+func GenerateNotificationConfig(name string, p v1alpha1.NotificationConfigParameters) *scc.NotificationConfig {
+	nc := &scc.NotificationConfig{
+		Name:        name,
+		Description: gcp.StringValue(p.Description),
+		PubsubTopic: p.PubsubTopic,
+	}
+	if p.StreamingConfig != nil {
+		nc.StreamingConfig = &scc.StreamingConfig{Filter: p.StreamingConfig.Filter}
+	}
+	return nc
+}
+
+// GenerateObservation produces a NotificationConfigObservation from the
+// supplied NotificationConfig.
+func GenerateObservation(nc scc.NotificationConfig) v1alpha1.NotificationConfigObservation {
+	return v1alpha1.NotificationConfigObservation{
+		Name:           nc.Name,
+		ServiceAccount: nc.ServiceAccount,
+	}
+}
+
+// LateInitialize fills the empty fields of NotificationConfigParameters if the
+// corresponding fields are given in NotificationConfig.
+func LateInitialize(p *v1alpha1.NotificationConfigParameters, nc scc.NotificationConfig) {
+	p.Description = gcp.LateInitializeString(p.Description, nc.Description)
+	if p.StreamingConfig == nil && nc.StreamingConfig != nil {
+		p.StreamingConfig = &v1alpha1.StreamingConfig{Filter: nc.StreamingConfig.Filter}
+	}
+}
+
+// IsUpToDate checks whether NotificationConfig is configured with given
+// NotificationConfigParameters.
+func IsUpToDate(p v1alpha1.NotificationConfigParameters, nc scc.NotificationConfig) bool {
+	return len(GenerateUpdateMask(p, nc)) == 0
+}
+
+// GenerateUpdateMask returns the comma separated list of fields that differ
+// between NotificationConfigParameters and NotificationConfig.
+func GenerateUpdateMask(p v1alpha1.NotificationConfigParameters, nc scc.NotificationConfig) string {
+	observed := &v1alpha1.NotificationConfigParameters{PubsubTopic: nc.PubsubTopic}
+	LateInitialize(observed, nc)
+	mask := []string{}
+	if gcp.StringValue(p.Description) != gcp.StringValue(observed.Description) {
+		mask = append(mask, "description")
+	}
+	if p.PubsubTopic != observed.PubsubTopic {
+		mask = append(mask, "pubsubTopic")
+	}
+	if !cmp.Equal(p.StreamingConfig, observed.StreamingConfig) {
+		mask = append(mask, "streamingConfig.filter")
+	}
+	return strings.Join(mask, ",")
+}
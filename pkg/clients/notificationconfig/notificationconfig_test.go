@@ -0,0 +1,167 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notificationconfig
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	scc "google.golang.org/api/securitycenter/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/securitycenter/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	projectID   = "my-project"
+	name        = "projects/my-project/notificationConfigs/my-config"
+	pubsubTopic = "projects/my-project/topics/my-topic"
+	filter      = `state = "ACTIVE"`
+)
+
+func params() *v1alpha1.NotificationConfigParameters {
+	return &v1alpha1.NotificationConfigParameters{
+		Description:     gcp.StringPtr("findings"),
+		PubsubTopic:     pubsubTopic,
+		StreamingConfig: &v1alpha1.StreamingConfig{Filter: filter},
+	}
+}
+
+func notificationConfig() *scc.NotificationConfig {
+	return &scc.NotificationConfig{
+		Name:            name,
+		Description:     "findings",
+		PubsubTopic:     pubsubTopic,
+		StreamingConfig: &scc.StreamingConfig{Filter: filter},
+	}
+}
+
+func TestGetParent(t *testing.T) {
+	cases := map[string]struct {
+		p   v1alpha1.NotificationConfigParameters
+		out string
+	}{
+		"Default": {
+			p:   v1alpha1.NotificationConfigParameters{},
+			out: "projects/my-project",
+		},
+		"Organization": {
+			p:   v1alpha1.NotificationConfigParameters{Parent: gcp.StringPtr("organizations/123")},
+			out: "organizations/123",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GetParent(projectID, tc.p)
+			if diff := cmp.Diff(tc.out, got); diff != "" {
+				t.Errorf("GetParent(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateNotificationConfig(t *testing.T) {
+	cases := map[string]struct {
+		p   v1alpha1.NotificationConfigParameters
+		out *scc.NotificationConfig
+	}{
+		"Full": {
+			p:   *params(),
+			out: notificationConfig(),
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			got := GenerateNotificationConfig(name, tc.p)
+			if diff := cmp.Diff(tc.out, got); diff != "" {
+				t.Errorf("GenerateNotificationConfig(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitialize(t *testing.T) {
+	cases := map[string]struct {
+		p   *v1alpha1.NotificationConfigParameters
+		nc  scc.NotificationConfig
+		out *v1alpha1.NotificationConfigParameters
+	}{
+		"Empty": {
+			p:   &v1alpha1.NotificationConfigParameters{PubsubTopic: pubsubTopic},
+			nc:  *notificationConfig(),
+			out: params(),
+		},
+		"NoOverride": {
+			p: &v1alpha1.NotificationConfigParameters{
+				Description: gcp.StringPtr("mine"),
+				PubsubTopic: pubsubTopic,
+			},
+			nc: *notificationConfig(),
+			out: &v1alpha1.NotificationConfigParameters{
+				Description:     gcp.StringPtr("mine"),
+				PubsubTopic:     pubsubTopic,
+				StreamingConfig: &v1alpha1.StreamingConfig{Filter: filter},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitialize(tc.p, tc.nc)
+			if diff := cmp.Diff(tc.out, tc.p); diff != "" {
+				t.Errorf("LateInitialize(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateUpdateMask(t *testing.T) {
+	cases := map[string]struct {
+		p   v1alpha1.NotificationConfigParameters
+		nc  scc.NotificationConfig
+		out string
+	}{
+		"UpToDate": {
+			p:   *params(),
+			nc:  *notificationConfig(),
+			out: "",
+		},
+		"AllChanged": {
+			p: v1alpha1.NotificationConfigParameters{
+				Description:     gcp.StringPtr("other"),
+				PubsubTopic:     "projects/my-project/topics/other",
+				StreamingConfig: &v1alpha1.StreamingConfig{Filter: `state = "INACTIVE"`},
+			},
+			nc:  *notificationConfig(),
+			out: "description,pubsubTopic,streamingConfig.filter",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateUpdateMask(tc.p, tc.nc)
+			if diff := cmp.Diff(tc.out, got); diff != "" {
+				t.Errorf("GenerateUpdateMask(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.out == "", IsUpToDate(tc.p, tc.nc)); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package orgpolicy
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	crm "google.golang.org/api/cloudresourcemanager/v1"
+)
+
+// ConstraintResourceLocations is the organization policy constraint that
+// restricts the locations resources can be created in.
+const ConstraintResourceLocations = "constraints/gcp.resourceLocations"
+
+const (
+	allValuesDeny  = "DENY"
+	allValuesAllow = "ALLOW"
+
+	prefixIs = "is:"
+	prefixIn = "in:"
+
+	suffixLocations = "-locations"
+)
+
+// region matches the name of a single region, e.g. us-east1.
+var region = regexp.MustCompile(`^[a-z]+-[a-z]+[0-9]+$`)
+
+type match int
+
+const (
+	matchNo match = iota
+	matchYes
+	matchUnknown
+)
+
+// CheckLocations returns those of the supplied locations that the effective
+// resource locations policy of the supplied project does not allow.
+func CheckLocations(ctx context.Context, s *crm.Service, projectID string, locations ...string) ([]string, error) {
+	p, err := s.Projects.GetEffectiveOrgPolicy("projects/"+projectID, &crm.GetEffectiveOrgPolicyRequest{Constraint: ConstraintResourceLocations}).Context(ctx).Do()
+	if err != nil {
+		return nil, err
+	}
+	return DeniedLocations(p, locations...), nil
+}
+
+// DeniedLocations returns those of the supplied locations that the supplied
+// resource locations policy does not allow. Only single region value groups,
+// e.g. in:us-east1-locations, can be evaluated. A location is considered
+// allowed if the policy allows any value group that cannot be evaluated,
+// so that a resource is never wrongly refused.
+func DeniedLocations(p *crm.OrgPolicy, locations ...string) []string {
+	if p == nil || p.ListPolicy == nil {
+		return nil
+	}
+	var denied []string
+	for _, l := range locations {
+		if l != "" && isDenied(p.ListPolicy, l) {
+			denied = append(denied, l)
+		}
+	}
+	return denied
+}
+
+func isDenied(lp *crm.ListPolicy, location string) bool {
+	for _, v := range lp.DeniedValues {
+		if matches(v, location) == matchYes {
+			return true
+		}
+	}
+	switch lp.AllValues {
+	case allValuesDeny:
+		return true
+	case allValuesAllow:
+		return false
+	}
+	if len(lp.AllowedValues) == 0 {
+		return false
+	}
+	for _, v := range lp.AllowedValues {
+		if matches(v, location) != matchNo {
+			return false
+		}
+	}
+	return true
+}
+
+// matches returns whether the supplied policy value, e.g. us-east1 or
+// in:us-east1-locations, matches the supplied region or zone.
+func matches(value, location string) match {
+	value = strings.TrimPrefix(value, prefixIs)
+	if !strings.HasPrefix(value, prefixIn) {
+		if value == location {
+			return matchYes
+		}
+		return matchNo
+	}
+	group := strings.TrimSuffix(strings.TrimPrefix(value, prefixIn), suffixLocations)
+	if !region.MatchString(group) {
+		return matchUnknown
+	}
+	if location == group || strings.HasPrefix(location, group+"-") {
+		return matchYes
+	}
+	return matchNo
+}
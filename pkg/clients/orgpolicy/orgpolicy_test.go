@@ -0,0 +1,70 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package orgpolicy
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	crm "google.golang.org/api/cloudresourcemanager/v1"
+)
+
+func TestDeniedLocations(t *testing.T) {
+	locations := []string{"us-east1", "us-east1-b", "europe-west1", "europe-west1-d"}
+	cases := map[string]struct {
+		policy *crm.OrgPolicy
+		want   []string
+	}{
+		"NoPolicy": {
+			policy: &crm.OrgPolicy{},
+		},
+		"AllowAll": {
+			policy: &crm.OrgPolicy{ListPolicy: &crm.ListPolicy{AllValues: "ALLOW"}},
+		},
+		"DenyAll": {
+			policy: &crm.OrgPolicy{ListPolicy: &crm.ListPolicy{AllValues: "DENY"}},
+			want:   locations,
+		},
+		"AllowedRegionGroup": {
+			policy: &crm.OrgPolicy{ListPolicy: &crm.ListPolicy{AllowedValues: []string{"in:us-east1-locations"}}},
+			want:   []string{"europe-west1", "europe-west1-d"},
+		},
+		"AllowedRegionOnly": {
+			policy: &crm.OrgPolicy{ListPolicy: &crm.ListPolicy{AllowedValues: []string{"is:europe-west1"}}},
+			want:   []string{"us-east1", "us-east1-b", "europe-west1-d"},
+		},
+		"DeniedZone": {
+			policy: &crm.OrgPolicy{ListPolicy: &crm.ListPolicy{DeniedValues: []string{"europe-west1-d"}}},
+			want:   []string{"europe-west1-d"},
+		},
+		"UnknownGroupAllowed": {
+			policy: &crm.OrgPolicy{ListPolicy: &crm.ListPolicy{AllowedValues: []string{"in:us-east1-locations", "in:eu-locations"}}},
+		},
+		"UnknownGroupDenied": {
+			policy: &crm.OrgPolicy{ListPolicy: &crm.ListPolicy{AllowedValues: []string{"in:us-east1-locations"}, DeniedValues: []string{"in:eu-locations"}}},
+			want:   []string{"europe-west1", "europe-west1-d"},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := DeniedLocations(tc.policy, locations...)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("DeniedLocations(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package packetmirroring
+
+import (
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/mitchellh/copystructure"
+	"github.com/pkg/errors"
+	compute "google.golang.org/api/compute/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const errCheckUpToDate = "unable to determine if external resource is up to date"
+
+// GeneratePacketMirroring takes a *PacketMirroringParameters and fills
+// *compute.PacketMirroring. It assigns only the fields that are writable,
+// i.e. not labelled as [Output Only] in Google's reference.
+func GeneratePacketMirroring(name string, in v1alpha1.PacketMirroringParameters, pm *compute.PacketMirroring) {
+	pm.Name = name
+	pm.Description = gcp.StringValue(in.Description)
+	pm.Priority = gcp.Int64Value(in.Priority)
+	pm.Enable = gcp.StringValue(in.Enable)
+
+	pm.Network = nil
+	if in.Network != nil {
+		pm.Network = &compute.PacketMirroringNetworkInfo{Url: *in.Network}
+	}
+	pm.CollectorIlb = nil
+	if in.CollectorILB != nil {
+		pm.CollectorIlb = &compute.PacketMirroringForwardingRuleInfo{Url: *in.CollectorILB}
+	}
+
+	// Emptied lists must be sent explicitly, otherwise a patch leaves the
+	// previously mirrored resources in place.
+	pm.MirroredResources = &compute.PacketMirroringMirroredResourceInfo{
+		Tags:            in.MirroredResources.Tags,
+		ForceSendFields: []string{"Instances", "Subnetworks", "Tags"},
+	}
+	for _, i := range in.MirroredResources.Instances {
+		pm.MirroredResources.Instances = append(pm.MirroredResources.Instances, &compute.PacketMirroringMirroredResourceInfoInstanceInfo{Url: i})
+	}
+	for _, s := range in.MirroredResources.Subnetworks {
+		pm.MirroredResources.Subnetworks = append(pm.MirroredResources.Subnetworks, &compute.PacketMirroringMirroredResourceInfoSubnetInfo{Url: s})
+	}
+
+	pm.Filter = nil
+	if f := in.Filter; f != nil {
+		pm.Filter = &compute.PacketMirroringFilter{
+			IPProtocols:     f.IPProtocols,
+			CidrRanges:      f.CIDRRanges,
+			Direction:       gcp.StringValue(f.Direction),
+			ForceSendFields: []string{"IPProtocols", "CidrRanges"},
+		}
+	}
+
+	// Priority 0 is the highest priority, not the default.
+	pm.ForceSendFields = nil
+	if in.Priority != nil {
+		pm.ForceSendFields = []string{"Priority"}
+	}
+}
+
+// GeneratePacketMirroringObservation takes a compute.PacketMirroring and
+// returns *PacketMirroringObservation.
+func GeneratePacketMirroringObservation(in compute.PacketMirroring) v1alpha1.PacketMirroringObservation {
+	return v1alpha1.PacketMirroringObservation{
+		CreationTimestamp: in.CreationTimestamp,
+		ID:                in.Id,
+		SelfLink:          in.SelfLink,
+	}
+}
+
+// LateInitializeSpec fills unassigned fields with the values in
+// compute.PacketMirroring object.
+func LateInitializeSpec(spec *v1alpha1.PacketMirroringParameters, in compute.PacketMirroring) {
+	spec.Description = gcp.LateInitializeString(spec.Description, in.Description)
+	spec.Priority = gcp.LateInitializeInt64(spec.Priority, in.Priority)
+	spec.Enable = gcp.LateInitializeString(spec.Enable, in.Enable)
+	if in.Network != nil {
+		spec.Network = gcp.LateInitializeString(spec.Network, in.Network.Url)
+	}
+	if in.CollectorIlb != nil {
+		spec.CollectorILB = gcp.LateInitializeString(spec.CollectorILB, in.CollectorIlb.Url)
+	}
+	if in.Filter != nil {
+		if spec.Filter == nil {
+			spec.Filter = &v1alpha1.PacketMirroringFilter{}
+		}
+		spec.Filter.IPProtocols = gcp.LateInitializeStringSlice(spec.Filter.IPProtocols, in.Filter.IPProtocols)
+		spec.Filter.CIDRRanges = gcp.LateInitializeStringSlice(spec.Filter.CIDRRanges, in.Filter.CidrRanges)
+		spec.Filter.Direction = gcp.LateInitializeString(spec.Filter.Direction, in.Filter.Direction)
+	}
+}
+
+// IsUpToDate checks whether current state is up-to-date compared to the given
+// set of parameters.
+func IsUpToDate(name string, in *v1alpha1.PacketMirroringParameters, observed *compute.PacketMirroring) (bool, error) {
+	generated, err := copystructure.Copy(observed)
+	if err != nil {
+		return true, errors.Wrap(err, errCheckUpToDate)
+	}
+	desired, ok := generated.(*compute.PacketMirroring)
+	if !ok {
+		return true, errors.New(errCheckUpToDate)
+	}
+	GeneratePacketMirroring(name, *in, desired)
+	return cmp.Equal(desired, observed,
+		cmpopts.EquateEmpty(),
+		gcp.EquateComputeURLs(),
+		cmpopts.IgnoreFields(compute.PacketMirroring{}, "ForceSendFields"),
+		cmpopts.IgnoreFields(compute.PacketMirroringMirroredResourceInfo{}, "ForceSendFields"),
+		cmpopts.IgnoreFields(compute.PacketMirroringFilter{}, "ForceSendFields"),
+		cmpopts.IgnoreFields(compute.PacketMirroringNetworkInfo{}, "CanonicalUrl"),
+		cmpopts.IgnoreFields(compute.PacketMirroringForwardingRuleInfo{}, "CanonicalUrl"),
+		cmpopts.IgnoreFields(compute.PacketMirroringMirroredResourceInfoInstanceInfo{}, "CanonicalUrl"),
+		cmpopts.IgnoreFields(compute.PacketMirroringMirroredResourceInfoSubnetInfo{}, "CanonicalUrl"),
+	), nil
+}
@@ -0,0 +1,219 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package packetmirroring
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/compute/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	testName       = "some-name"
+	testRegion     = "us-central1"
+	testNetwork    = "projects/test/global/networks/vpc"
+	testCollector  = "projects/test/regions/us-central1/forwardingRules/ids"
+	testSubnetwork = "projects/test/regions/us-central1/subnetworks/web"
+	computeURL     = "https://www.googleapis.com/compute/v1/"
+)
+
+func params(m ...func(*v1alpha1.PacketMirroringParameters)) *v1alpha1.PacketMirroringParameters {
+	o := &v1alpha1.PacketMirroringParameters{
+		Region:       testRegion,
+		Network:      gcp.StringPtr(testNetwork),
+		CollectorILB: gcp.StringPtr(testCollector),
+		MirroredResources: v1alpha1.PacketMirroringMirroredResources{
+			Subnetworks: []string{testSubnetwork},
+		},
+	}
+
+	for _, f := range m {
+		f(o)
+	}
+
+	return o
+}
+
+func observed(m ...func(*compute.PacketMirroring)) *compute.PacketMirroring {
+	o := &compute.PacketMirroring{
+		Name:         testName,
+		Region:       computeURL + "projects/test/regions/us-central1",
+		Network:      &compute.PacketMirroringNetworkInfo{Url: computeURL + testNetwork, CanonicalUrl: "projects/123/global/networks/456"},
+		CollectorIlb: &compute.PacketMirroringForwardingRuleInfo{Url: computeURL + testCollector, CanonicalUrl: "projects/123/regions/us-central1/forwardingRules/789"},
+		MirroredResources: &compute.PacketMirroringMirroredResourceInfo{
+			Subnetworks: []*compute.PacketMirroringMirroredResourceInfoSubnetInfo{{Url: computeURL + testSubnetwork}},
+		},
+		Filter:   &compute.PacketMirroringFilter{Direction: "BOTH"},
+		Priority: 1000,
+		Enable:   "TRUE",
+	}
+
+	for _, f := range m {
+		f(o)
+	}
+
+	return o
+}
+
+func TestGeneratePacketMirroring(t *testing.T) {
+	type args struct {
+		name string
+		in   v1alpha1.PacketMirroringParameters
+	}
+	cases := map[string]struct {
+		args args
+		want *compute.PacketMirroring
+	}{
+		"Minimal": {
+			args: args{
+				name: testName,
+				in:   *params(),
+			},
+			want: &compute.PacketMirroring{
+				Name:         testName,
+				Network:      &compute.PacketMirroringNetworkInfo{Url: testNetwork},
+				CollectorIlb: &compute.PacketMirroringForwardingRuleInfo{Url: testCollector},
+				MirroredResources: &compute.PacketMirroringMirroredResourceInfo{
+					Subnetworks:     []*compute.PacketMirroringMirroredResourceInfoSubnetInfo{{Url: testSubnetwork}},
+					ForceSendFields: []string{"Instances", "Subnetworks", "Tags"},
+				},
+			},
+		},
+		"Full": {
+			args: args{
+				name: testName,
+				in: *params(func(p *v1alpha1.PacketMirroringParameters) {
+					p.Description = gcp.StringPtr("mirror web tier")
+					p.MirroredResources.Instances = []string{"projects/test/zones/us-central1-a/instances/vm"}
+					p.MirroredResources.Tags = []string{"web"}
+					p.Filter = &v1alpha1.PacketMirroringFilter{
+						IPProtocols: []string{"tcp"},
+						CIDRRanges:  []string{"10.0.0.0/8"},
+						Direction:   gcp.StringPtr("INGRESS"),
+					}
+					p.Priority = gcp.Int64Ptr(0)
+					p.Enable = gcp.StringPtr("FALSE")
+				}),
+			},
+			want: &compute.PacketMirroring{
+				Name:         testName,
+				Description:  "mirror web tier",
+				Network:      &compute.PacketMirroringNetworkInfo{Url: testNetwork},
+				CollectorIlb: &compute.PacketMirroringForwardingRuleInfo{Url: testCollector},
+				MirroredResources: &compute.PacketMirroringMirroredResourceInfo{
+					Instances:       []*compute.PacketMirroringMirroredResourceInfoInstanceInfo{{Url: "projects/test/zones/us-central1-a/instances/vm"}},
+					Subnetworks:     []*compute.PacketMirroringMirroredResourceInfoSubnetInfo{{Url: testSubnetwork}},
+					Tags:            []string{"web"},
+					ForceSendFields: []string{"Instances", "Subnetworks", "Tags"},
+				},
+				Filter: &compute.PacketMirroringFilter{
+					IPProtocols:     []string{"tcp"},
+					CidrRanges:      []string{"10.0.0.0/8"},
+					Direction:       "INGRESS",
+					ForceSendFields: []string{"IPProtocols", "CidrRanges"},
+				},
+				Enable:          "FALSE",
+				ForceSendFields: []string{"Priority"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			pm := &compute.PacketMirroring{}
+			GeneratePacketMirroring(tc.args.name, tc.args.in, pm)
+			if diff := cmp.Diff(tc.want, pm); diff != "" {
+				t.Errorf("GeneratePacketMirroring(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeSpec(t *testing.T) {
+	want := params(func(p *v1alpha1.PacketMirroringParameters) {
+		p.Filter = &v1alpha1.PacketMirroringFilter{Direction: gcp.StringPtr("BOTH")}
+		p.Priority = gcp.Int64Ptr(1000)
+		p.Enable = gcp.StringPtr("TRUE")
+	})
+	got := params()
+	LateInitializeSpec(got, *observed())
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("LateInitializeSpec(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	type args struct {
+		in      *v1alpha1.PacketMirroringParameters
+		current *compute.PacketMirroring
+	}
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"UpToDate": {
+			args: args{
+				in: params(func(p *v1alpha1.PacketMirroringParameters) {
+					p.Filter = &v1alpha1.PacketMirroringFilter{Direction: gcp.StringPtr("BOTH")}
+					p.Priority = gcp.Int64Ptr(1000)
+					p.Enable = gcp.StringPtr("TRUE")
+				}),
+				current: observed(),
+			},
+			want: true,
+		},
+		"NotUpToDateDisabled": {
+			args: args{
+				in: params(func(p *v1alpha1.PacketMirroringParameters) {
+					p.Filter = &v1alpha1.PacketMirroringFilter{Direction: gcp.StringPtr("BOTH")}
+					p.Priority = gcp.Int64Ptr(1000)
+					p.Enable = gcp.StringPtr("FALSE")
+				}),
+				current: observed(),
+			},
+			want: false,
+		},
+		"NotUpToDateSubnetworkRemoved": {
+			args: args{
+				in: params(func(p *v1alpha1.PacketMirroringParameters) {
+					p.MirroredResources = v1alpha1.PacketMirroringMirroredResources{Tags: []string{"web"}}
+					p.Filter = &v1alpha1.PacketMirroringFilter{Direction: gcp.StringPtr("BOTH")}
+					p.Priority = gcp.Int64Ptr(1000)
+					p.Enable = gcp.StringPtr("TRUE")
+				}),
+				current: observed(),
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := IsUpToDate(testName, tc.args.in, tc.args.current)
+			if err != nil {
+				t.Fatalf("IsUpToDate(...): unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,263 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package patchdeployment
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	osconfig "google.golang.org/api/osconfig/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/osconfig/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	parentFormat = "projects/%s"
+	nameFormat   = "projects/%s/patchDeployments/%s"
+)
+
+// GetParent returns the project the PatchDeployment lives under.
+func GetParent(projectID string) string {
+	return fmt.Sprintf(parentFormat, projectID)
+}
+
+// GetFullyQualifiedName builds the relative resource name of the
+// PatchDeployment.
+func GetFullyQualifiedName(projectID, name string) string {
+	return fmt.Sprintf(nameFormat, projectID, name)
+}
+
+// GeneratePatchDeployment produces a PatchDeployment that is configured via
+// given PatchDeploymentParameters.
+func GeneratePatchDeployment(name string, p v1alpha1.PatchDeploymentParameters) *osconfig.PatchDeployment {
+	pd := &osconfig.PatchDeployment{
+		Name:           name,
+		Description:    gcp.StringValue(p.Description),
+		Duration:       gcp.StringValue(p.Duration),
+		InstanceFilter: generateInstanceFilter(p.InstanceFilter),
+	}
+	if p.PatchConfig != nil {
+		pd.PatchConfig = generatePatchConfig(*p.PatchConfig)
+	}
+	if p.OneTimeSchedule != nil {
+		pd.OneTimeSchedule = &osconfig.OneTimeSchedule{ExecuteTime: p.OneTimeSchedule.ExecuteTime}
+	}
+	if p.RecurringSchedule != nil {
+		pd.RecurringSchedule = generateRecurringSchedule(*p.RecurringSchedule)
+	}
+	if p.Rollout != nil {
+		pd.Rollout = &osconfig.PatchRollout{
+			Mode: p.Rollout.Mode,
+			DisruptionBudget: &osconfig.FixedOrPercent{
+				Fixed:   gcp.Int64Value(p.Rollout.DisruptionBudget.Fixed),
+				Percent: gcp.Int64Value(p.Rollout.DisruptionBudget.Percent),
+			},
+		}
+	}
+	return pd
+}
+
+func generateInstanceFilter(in v1alpha1.PatchInstanceFilter) *osconfig.PatchInstanceFilter {
+	f := &osconfig.PatchInstanceFilter{
+		All:                  gcp.BoolValue(in.All),
+		Zones:                in.Zones,
+		Instances:            in.Instances,
+		InstanceNamePrefixes: in.InstanceNamePrefixes,
+	}
+	for _, gl := range in.GroupLabels {
+		f.GroupLabels = append(f.GroupLabels, &osconfig.PatchInstanceFilterGroupLabel{Labels: gl.Labels})
+	}
+	return f
+}
+
+func generatePatchConfig(in v1alpha1.PatchConfig) *osconfig.PatchConfig {
+	pc := &osconfig.PatchConfig{
+		RebootConfig:        gcp.StringValue(in.RebootConfig),
+		MigInstancesAllowed: gcp.BoolValue(in.MigInstancesAllowed),
+	}
+	if in.Apt != nil {
+		pc.Apt = &osconfig.AptSettings{
+			Type:              gcp.StringValue(in.Apt.Type),
+			Excludes:          in.Apt.Excludes,
+			ExclusivePackages: in.Apt.ExclusivePackages,
+		}
+	}
+	if in.Yum != nil {
+		pc.Yum = &osconfig.YumSettings{
+			Security:          gcp.BoolValue(in.Yum.Security),
+			Minimal:           gcp.BoolValue(in.Yum.Minimal),
+			Excludes:          in.Yum.Excludes,
+			ExclusivePackages: in.Yum.ExclusivePackages,
+		}
+	}
+	if in.Zypper != nil {
+		pc.Zypper = &osconfig.ZypperSettings{
+			WithOptional:     gcp.BoolValue(in.Zypper.WithOptional),
+			WithUpdate:       gcp.BoolValue(in.Zypper.WithUpdate),
+			Categories:       in.Zypper.Categories,
+			Severities:       in.Zypper.Severities,
+			Excludes:         in.Zypper.Excludes,
+			ExclusivePatches: in.Zypper.ExclusivePatches,
+		}
+	}
+	if in.WindowsUpdate != nil {
+		pc.WindowsUpdate = &osconfig.WindowsUpdateSettings{
+			Classifications:  in.WindowsUpdate.Classifications,
+			Excludes:         in.WindowsUpdate.Excludes,
+			ExclusivePatches: in.WindowsUpdate.ExclusivePatches,
+		}
+	}
+	return pc
+}
+
+func generateRecurringSchedule(in v1alpha1.RecurringSchedule) *osconfig.RecurringSchedule {
+	rs := &osconfig.RecurringSchedule{
+		TimeZone:  &osconfig.TimeZone{Id: in.TimeZone},
+		StartTime: gcp.StringValue(in.StartTime),
+		EndTime:   gcp.StringValue(in.EndTime),
+		TimeOfDay: &osconfig.TimeOfDay{
+			Hours:   gcp.Int64Value(in.TimeOfDay.Hours),
+			Minutes: gcp.Int64Value(in.TimeOfDay.Minutes),
+			Seconds: gcp.Int64Value(in.TimeOfDay.Seconds),
+		},
+		Frequency: in.Frequency,
+	}
+	if in.Weekly != nil {
+		rs.Weekly = &osconfig.WeeklySchedule{DayOfWeek: in.Weekly.DayOfWeek}
+	}
+	if in.Monthly != nil {
+		rs.Monthly = &osconfig.MonthlySchedule{MonthDay: gcp.Int64Value(in.Monthly.MonthDay)}
+		if w := in.Monthly.WeekDayOfMonth; w != nil {
+			rs.Monthly.WeekDayOfMonth = &osconfig.WeekDayOfMonth{
+				WeekOrdinal: w.WeekOrdinal,
+				DayOfWeek:   w.DayOfWeek,
+				DayOffset:   gcp.Int64Value(w.DayOffset),
+			}
+		}
+	}
+	return rs
+}
+
+// GenerateObservation produces a PatchDeploymentObservation from the
+// supplied PatchDeployment.
+func GenerateObservation(pd osconfig.PatchDeployment) v1alpha1.PatchDeploymentObservation {
+	o := v1alpha1.PatchDeploymentObservation{
+		Name:            pd.Name,
+		State:           pd.State,
+		CreateTime:      pd.CreateTime,
+		UpdateTime:      pd.UpdateTime,
+		LastExecuteTime: pd.LastExecuteTime,
+	}
+	if pd.RecurringSchedule != nil {
+		o.NextExecuteTime = pd.RecurringSchedule.NextExecuteTime
+	}
+	return o
+}
+
+// LateInitialize fills the empty fields of PatchDeploymentParameters if the
+// corresponding fields are given in PatchDeployment.
+func LateInitialize(p *v1alpha1.PatchDeploymentParameters, pd osconfig.PatchDeployment) {
+	p.Description = gcp.LateInitializeString(p.Description, pd.Description)
+	p.Duration = gcp.LateInitializeString(p.Duration, pd.Duration)
+	if p.Paused == nil && pd.State != "" {
+		p.Paused = gcp.BoolPtr(pd.State == v1alpha1.PatchDeploymentStatePaused)
+	}
+	if p.RecurringSchedule != nil && pd.RecurringSchedule != nil {
+		p.RecurringSchedule.StartTime = gcp.LateInitializeString(p.RecurringSchedule.StartTime, pd.RecurringSchedule.StartTime)
+	}
+	if p.PatchConfig != nil && pd.PatchConfig != nil {
+		p.PatchConfig.RebootConfig = gcp.LateInitializeString(p.PatchConfig.RebootConfig, pd.PatchConfig.RebootConfig)
+	}
+	if p.Rollout == nil && pd.Rollout != nil && pd.Rollout.DisruptionBudget != nil {
+		p.Rollout = &v1alpha1.PatchRollout{
+			Mode: pd.Rollout.Mode,
+			DisruptionBudget: v1alpha1.FixedOrPercent{
+				Fixed:   gcp.LateInitializeInt64(nil, pd.Rollout.DisruptionBudget.Fixed),
+				Percent: gcp.LateInitializeInt64(nil, pd.Rollout.DisruptionBudget.Percent),
+			},
+		}
+	}
+}
+
+// IsPausedUpToDate returns true if the observed state of the PatchDeployment
+// matches the desired paused setting.
+func IsPausedUpToDate(p v1alpha1.PatchDeploymentParameters, pd osconfig.PatchDeployment) bool {
+	if p.Paused == nil {
+		return true
+	}
+	return *p.Paused == (pd.State == v1alpha1.PatchDeploymentStatePaused)
+}
+
+// IsUpToDate checks whether PatchDeployment is configured with given
+// PatchDeploymentParameters.
+func IsUpToDate(p v1alpha1.PatchDeploymentParameters, pd osconfig.PatchDeployment) bool {
+	return GenerateUpdateMask(p, pd) == "" && IsPausedUpToDate(p, pd)
+}
+
+// GenerateUpdateMask returns the comma separated list of fields that differ
+// between PatchDeploymentParameters and PatchDeployment.
+func GenerateUpdateMask(p v1alpha1.PatchDeploymentParameters, pd osconfig.PatchDeployment) string {
+	desired := GeneratePatchDeployment(pd.Name, p)
+	observed := withoutOutputOnlyFields(pd)
+	// Pre and post patch steps and goo settings are not managed yet.
+	opts := []cmp.Option{
+		cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(osconfig.PatchConfig{}, "Goo", "PreStep", "PostStep"),
+	}
+
+	mask := []string{}
+	if desired.Description != observed.Description {
+		mask = append(mask, "description")
+	}
+	if desired.Duration != observed.Duration {
+		mask = append(mask, "duration")
+	}
+	if !cmp.Equal(desired.InstanceFilter, observed.InstanceFilter, opts...) {
+		mask = append(mask, "instanceFilter")
+	}
+	if p.PatchConfig != nil && !cmp.Equal(desired.PatchConfig, observed.PatchConfig, opts...) {
+		mask = append(mask, "patchConfig")
+	}
+	if !cmp.Equal(desired.OneTimeSchedule, observed.OneTimeSchedule, opts...) {
+		mask = append(mask, "oneTimeSchedule")
+	}
+	if !cmp.Equal(desired.RecurringSchedule, observed.RecurringSchedule, opts...) {
+		mask = append(mask, "recurringSchedule")
+	}
+	if p.Rollout != nil && !cmp.Equal(desired.Rollout, observed.Rollout, opts...) {
+		mask = append(mask, "rollout")
+	}
+	return strings.Join(mask, ",")
+}
+
+// withoutOutputOnlyFields returns a copy of the PatchDeployment with the
+// server populated fields of its nested objects cleared.
+func withoutOutputOnlyFields(pd osconfig.PatchDeployment) osconfig.PatchDeployment {
+	if pd.RecurringSchedule != nil {
+		rs := *pd.RecurringSchedule
+		rs.LastExecuteTime = ""
+		rs.NextExecuteTime = ""
+		if rs.TimeZone != nil {
+			rs.TimeZone = &osconfig.TimeZone{Id: rs.TimeZone.Id}
+		}
+		pd.RecurringSchedule = &rs
+	}
+	return pd
+}
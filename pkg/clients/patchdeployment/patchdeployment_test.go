@@ -0,0 +1,192 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package patchdeployment
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	osconfig "google.golang.org/api/osconfig/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/osconfig/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	testName      = "projects/foo/patchDeployments/weekly"
+	testStartTime = "2023-06-01T00:00:00Z"
+)
+
+func params(m ...func(*v1alpha1.PatchDeploymentParameters)) *v1alpha1.PatchDeploymentParameters {
+	p := &v1alpha1.PatchDeploymentParameters{
+		Description: gcp.StringPtr("weekly"),
+		InstanceFilter: v1alpha1.PatchInstanceFilter{
+			GroupLabels: []v1alpha1.GroupLabel{{Labels: map[string]string{"role": "web"}}},
+		},
+		RecurringSchedule: &v1alpha1.RecurringSchedule{
+			TimeZone:  "America/New_York",
+			StartTime: gcp.StringPtr(testStartTime),
+			TimeOfDay: v1alpha1.TimeOfDay{Hours: gcp.Int64Ptr(3)},
+			Frequency: "WEEKLY",
+			Weekly:    &v1alpha1.WeeklySchedule{DayOfWeek: "SUNDAY"},
+		},
+		Paused: gcp.BoolPtr(false),
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func patchDeployment(m ...func(*osconfig.PatchDeployment)) *osconfig.PatchDeployment {
+	pd := &osconfig.PatchDeployment{
+		Name:        testName,
+		Description: "weekly",
+		InstanceFilter: &osconfig.PatchInstanceFilter{
+			GroupLabels: []*osconfig.PatchInstanceFilterGroupLabel{{Labels: map[string]string{"role": "web"}}},
+		},
+		RecurringSchedule: &osconfig.RecurringSchedule{
+			TimeZone:        &osconfig.TimeZone{Id: "America/New_York", Version: "2023c"},
+			StartTime:       testStartTime,
+			TimeOfDay:       &osconfig.TimeOfDay{Hours: 3},
+			Frequency:       "WEEKLY",
+			Weekly:          &osconfig.WeeklySchedule{DayOfWeek: "SUNDAY"},
+			NextExecuteTime: "2023-06-04T07:00:00Z",
+		},
+		State: v1alpha1.PatchDeploymentStateActive,
+	}
+	for _, f := range m {
+		f(pd)
+	}
+	return pd
+}
+
+func TestLateInitialize(t *testing.T) {
+	cases := map[string]struct {
+		p    *v1alpha1.PatchDeploymentParameters
+		pd   *osconfig.PatchDeployment
+		want *v1alpha1.PatchDeploymentParameters
+	}{
+		"ServerDefaults": {
+			p: params(func(p *v1alpha1.PatchDeploymentParameters) {
+				p.RecurringSchedule.StartTime = nil
+				p.Paused = nil
+			}),
+			pd: patchDeployment(func(pd *osconfig.PatchDeployment) {
+				pd.Rollout = &osconfig.PatchRollout{Mode: "ZONE_BY_ZONE", DisruptionBudget: &osconfig.FixedOrPercent{Percent: 25}}
+			}),
+			want: params(func(p *v1alpha1.PatchDeploymentParameters) {
+				p.Rollout = &v1alpha1.PatchRollout{Mode: "ZONE_BY_ZONE", DisruptionBudget: v1alpha1.FixedOrPercent{Percent: gcp.Int64Ptr(25)}}
+			}),
+		},
+		"PausedFromState": {
+			p: params(func(p *v1alpha1.PatchDeploymentParameters) { p.Paused = nil }),
+			pd: patchDeployment(func(pd *osconfig.PatchDeployment) {
+				pd.State = v1alpha1.PatchDeploymentStatePaused
+			}),
+			want: params(func(p *v1alpha1.PatchDeploymentParameters) { p.Paused = gcp.BoolPtr(true) }),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitialize(tc.p, *tc.pd)
+			if diff := cmp.Diff(tc.want, tc.p); diff != "" {
+				t.Errorf("LateInitialize(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateUpdateMask(t *testing.T) {
+	cases := map[string]struct {
+		p    *v1alpha1.PatchDeploymentParameters
+		pd   *osconfig.PatchDeployment
+		want string
+	}{
+		"UpToDateIgnoringOutputOnlyFields": {
+			p:    params(),
+			pd:   patchDeployment(),
+			want: "",
+		},
+		"ScheduleChanged": {
+			p: params(func(p *v1alpha1.PatchDeploymentParameters) {
+				p.RecurringSchedule.Weekly.DayOfWeek = "SATURDAY"
+			}),
+			pd:   patchDeployment(),
+			want: "recurringSchedule",
+		},
+		"FilterAndDescriptionChanged": {
+			p: params(func(p *v1alpha1.PatchDeploymentParameters) {
+				p.Description = gcp.StringPtr("nightly")
+				p.InstanceFilter.Zones = []string{"us-central1-a"}
+			}),
+			pd:   patchDeployment(),
+			want: "description,instanceFilter",
+		},
+		"UnmanagedPatchConfigIgnored": {
+			p: params(),
+			pd: patchDeployment(func(pd *osconfig.PatchDeployment) {
+				pd.PatchConfig = &osconfig.PatchConfig{RebootConfig: "DEFAULT"}
+			}),
+			want: "",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, GenerateUpdateMask(*tc.p, *tc.pd)); diff != "" {
+				t.Errorf("GenerateUpdateMask(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    *v1alpha1.PatchDeploymentParameters
+		pd   *osconfig.PatchDeployment
+		want bool
+	}{
+		"UpToDate": {
+			p:    params(),
+			pd:   patchDeployment(),
+			want: true,
+		},
+		"NeedsPause": {
+			p:    params(func(p *v1alpha1.PatchDeploymentParameters) { p.Paused = gcp.BoolPtr(true) }),
+			pd:   patchDeployment(),
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsUpToDate(*tc.p, *tc.pd)); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateObservation(t *testing.T) {
+	want := v1alpha1.PatchDeploymentObservation{
+		Name:            testName,
+		State:           v1alpha1.PatchDeploymentStateActive,
+		NextExecuteTime: "2023-06-04T07:00:00Z",
+	}
+	if diff := cmp.Diff(want, GenerateObservation(*patchDeployment())); diff != "" {
+		t.Errorf("GenerateObservation(...): -want, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projectbillinginfo
+
+import (
+	"fmt"
+
+	cloudbilling "google.golang.org/api/cloudbilling/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/billing/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	nameFormat           = "projects/%s"
+	billingAccountFormat = "billingAccounts/%s"
+)
+
+// GetFullyQualifiedName builds the relative resource name of the project
+// whose billing info is managed.
+func GetFullyQualifiedName(projectID string) string {
+	return fmt.Sprintf(nameFormat, projectID)
+}
+
+// GenerateProjectBillingInfo produces a ProjectBillingInfo that is
+// configured via given ProjectBillingInfoParameters.
+func GenerateProjectBillingInfo(p v1alpha1.ProjectBillingInfoParameters) *cloudbilling.ProjectBillingInfo {
+	return &cloudbilling.ProjectBillingInfo{
+		BillingAccountName: fmt.Sprintf(billingAccountFormat, p.BillingAccount),
+	}
+}
+
+// GenerateObservation produces a ProjectBillingInfoObservation from the
+// supplied ProjectBillingInfo.
+func GenerateObservation(i cloudbilling.ProjectBillingInfo) v1alpha1.ProjectBillingInfoObservation {
+	return v1alpha1.ProjectBillingInfoObservation{
+		Name:           i.Name,
+		BillingEnabled: i.BillingEnabled,
+	}
+}
+
+// LateInitialize fills the project of ProjectBillingInfoParameters with the
+// project of the ProviderConfig if it is not given, so that it does not
+// change if the ProviderConfig does.
+func LateInitialize(p *v1alpha1.ProjectBillingInfoParameters, projectID string) {
+	p.Project = gcp.LateInitializeString(p.Project, projectID)
+}
+
+// IsLinked checks whether the project of ProjectBillingInfo is linked to any
+// billing account.
+func IsLinked(i cloudbilling.ProjectBillingInfo) bool {
+	return i.BillingAccountName != ""
+}
+
+// IsUpToDate checks whether ProjectBillingInfo is configured with given
+// ProjectBillingInfoParameters.
+func IsUpToDate(p v1alpha1.ProjectBillingInfoParameters, i cloudbilling.ProjectBillingInfo) bool {
+	return GenerateProjectBillingInfo(p).BillingAccountName == i.BillingAccountName
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projectbillinginfo
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	cloudbilling "google.golang.org/api/cloudbilling/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/billing/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	testProject = "fooproject"
+	testAccount = "012345-567890-ABCDEF"
+)
+
+func TestGetFullyQualifiedName(t *testing.T) {
+	if diff := cmp.Diff("projects/fooproject", GetFullyQualifiedName(testProject)); diff != "" {
+		t.Errorf("GetFullyQualifiedName(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateProjectBillingInfo(t *testing.T) {
+	want := &cloudbilling.ProjectBillingInfo{BillingAccountName: "billingAccounts/" + testAccount}
+	got := GenerateProjectBillingInfo(v1alpha1.ProjectBillingInfoParameters{BillingAccount: testAccount})
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("GenerateProjectBillingInfo(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateObservation(t *testing.T) {
+	in := cloudbilling.ProjectBillingInfo{
+		Name:               "projects/fooproject/billingInfo",
+		BillingAccountName: "billingAccounts/" + testAccount,
+		BillingEnabled:     true,
+	}
+	want := v1alpha1.ProjectBillingInfoObservation{
+		Name:           "projects/fooproject/billingInfo",
+		BillingEnabled: true,
+	}
+	if diff := cmp.Diff(want, GenerateObservation(in)); diff != "" {
+		t.Errorf("GenerateObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitialize(t *testing.T) {
+	cases := map[string]struct {
+		in   v1alpha1.ProjectBillingInfoParameters
+		want v1alpha1.ProjectBillingInfoParameters
+	}{
+		"Empty": {
+			in:   v1alpha1.ProjectBillingInfoParameters{BillingAccount: testAccount},
+			want: v1alpha1.ProjectBillingInfoParameters{BillingAccount: testAccount, Project: gcp.StringPtr(testProject)},
+		},
+		"AlreadySet": {
+			in:   v1alpha1.ProjectBillingInfoParameters{BillingAccount: testAccount, Project: gcp.StringPtr("other")},
+			want: v1alpha1.ProjectBillingInfoParameters{BillingAccount: testAccount, Project: gcp.StringPtr("other")},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitialize(&tc.in, testProject)
+			if diff := cmp.Diff(tc.want, tc.in); diff != "" {
+				t.Errorf("LateInitialize(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsLinked(t *testing.T) {
+	cases := map[string]struct {
+		in   cloudbilling.ProjectBillingInfo
+		want bool
+	}{
+		"Linked": {
+			in:   cloudbilling.ProjectBillingInfo{BillingAccountName: "billingAccounts/" + testAccount},
+			want: true,
+		},
+		"NotLinked": {
+			in:   cloudbilling.ProjectBillingInfo{Name: "projects/fooproject/billingInfo"},
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsLinked(tc.in)); diff != "" {
+				t.Errorf("IsLinked(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		in   cloudbilling.ProjectBillingInfo
+		want bool
+	}{
+		"SameAccount": {
+			in:   cloudbilling.ProjectBillingInfo{BillingAccountName: "billingAccounts/" + testAccount},
+			want: true,
+		},
+		"OtherAccount": {
+			in:   cloudbilling.ProjectBillingInfo{BillingAccountName: "billingAccounts/ABCDEF-567890-012345"},
+			want: false,
+		},
+		"NotLinked": {
+			in:   cloudbilling.ProjectBillingInfo{},
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsUpToDate(v1alpha1.ProjectBillingInfoParameters{BillingAccount: testAccount}, tc.in)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
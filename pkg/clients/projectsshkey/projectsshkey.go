@@ -0,0 +1,158 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projectsshkey
+
+import (
+	"strings"
+
+	compute "google.golang.org/api/compute/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+)
+
+// MetadataKey is the key of the project metadata item that holds the SSH keys
+// of a project, one "username:public-key" entry per line.
+const MetadataKey = "ssh-keys"
+
+// Entry returns the line of the ssh-keys metadata item that grants the
+// supplied ProjectSSHKeyParameters.
+func Entry(in v1alpha1.ProjectSSHKeyParameters) string {
+	return in.Username + ":" + strings.TrimSpace(in.PublicKey)
+}
+
+// sameEntry returns true if the supplied lines of the ssh-keys metadata item
+// grant the same key to the same user. Only the type and material of the keys
+// are compared, so that a key whose comment or expiry was changed outside of
+// Crossplane is not added twice.
+func sameEntry(a, b string) bool {
+	ua, ka, _ := strings.Cut(strings.TrimSpace(a), ":")
+	ub, kb, _ := strings.Cut(strings.TrimSpace(b), ":")
+	fa, fb := strings.Fields(ka), strings.Fields(kb)
+	if ua != ub || len(fa) < 2 || len(fb) < 2 {
+		return false
+	}
+	return fa[0] == fb[0] && fa[1] == fb[1]
+}
+
+// item returns the ssh-keys item of the supplied metadata, if any.
+func item(md *compute.Metadata) *compute.MetadataItems {
+	if md == nil {
+		return nil
+	}
+	for _, i := range md.Items {
+		if i != nil && i.Key == MetadataKey {
+			return i
+		}
+	}
+	return nil
+}
+
+// HasKey returns true if the ssh-keys item of the supplied metadata contains
+// the supplied entry.
+func HasKey(md *compute.Metadata, entry string) bool {
+	i := item(md)
+	if i == nil || i.Value == nil {
+		return false
+	}
+	for _, l := range strings.Split(*i.Value, "\n") {
+		if sameEntry(l, entry) {
+			return true
+		}
+	}
+	return false
+}
+
+// WithKey returns a copy of the supplied metadata with the supplied entry
+// appended to its ssh-keys item. The item is added if the metadata has none.
+// All other items and entries are left unchanged, including the fingerprint
+// that GCP uses to reject the write if the metadata changed since it was read.
+func WithKey(md *compute.Metadata, entry string) *compute.Metadata {
+	out := copyMetadata(md)
+	if HasKey(out, entry) {
+		return out
+	}
+	i := item(out)
+	if i == nil {
+		out.Items = append(out.Items, &compute.MetadataItems{Key: MetadataKey, Value: &entry})
+		return out
+	}
+	v := strings.TrimRight(*i.Value, "\n")
+	if v != "" {
+		v += "\n"
+	}
+	v += entry
+	i.Value = &v
+	return out
+}
+
+// WithoutKey returns a copy of the supplied metadata without the supplied entry
+// in its ssh-keys item, and whether the item contained the entry. The item is
+// removed once it holds no entries.
+func WithoutKey(md *compute.Metadata, entry string) (*compute.Metadata, bool) {
+	out := copyMetadata(md)
+	i := item(out)
+	if i == nil || i.Value == nil {
+		return out, false
+	}
+	lines := strings.Split(*i.Value, "\n")
+	kept := make([]string, 0, len(lines))
+	for _, l := range lines {
+		if sameEntry(l, entry) {
+			continue
+		}
+		kept = append(kept, l)
+	}
+	if len(kept) == len(lines) {
+		return out, false
+	}
+	v := strings.Join(kept, "\n")
+	if strings.TrimSpace(v) != "" {
+		i.Value = &v
+		return out, true
+	}
+	items := make([]*compute.MetadataItems, 0, len(out.Items))
+	for _, it := range out.Items {
+		if it != i {
+			items = append(items, it)
+		}
+	}
+	out.Items = items
+	return out, true
+}
+
+// copyMetadata returns a copy of the supplied metadata that can be written
+// back to GCP, i.e. with its fingerprint and items but without its kind.
+func copyMetadata(md *compute.Metadata) *compute.Metadata {
+	out := &compute.Metadata{}
+	if md == nil {
+		return out
+	}
+	out.Fingerprint = md.Fingerprint
+	out.Items = make([]*compute.MetadataItems, 0, len(md.Items))
+	for _, i := range md.Items {
+		if i == nil {
+			continue
+		}
+		c := &compute.MetadataItems{Key: i.Key}
+		if i.Value != nil {
+			v := *i.Value
+			c.Value = &v
+		}
+		out.Items = append(out.Items, c)
+	}
+	return out
+}
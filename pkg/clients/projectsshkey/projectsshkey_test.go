@@ -0,0 +1,97 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projectsshkey
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	compute "google.golang.org/api/compute/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const alice = "alice:ssh-ed25519 AAAAalice alice@example.com"
+
+func metadata(keys string) *compute.Metadata {
+	return &compute.Metadata{Fingerprint: "fp", Items: []*compute.MetadataItems{{Key: MetadataKey, Value: gcp.StringPtr(keys)}}}
+}
+
+func TestEntry(t *testing.T) {
+	got := Entry(v1alpha1.ProjectSSHKeyParameters{Username: "alice", PublicKey: "ssh-ed25519 AAAAalice alice@example.com\n"})
+	if diff := cmp.Diff(alice, got); diff != "" {
+		t.Errorf("Entry(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestHasKey(t *testing.T) {
+	cases := map[string]struct {
+		md   *compute.Metadata
+		want bool
+	}{
+		"NoMetadata": {},
+		"SameKey": {
+			md:   metadata("bob:ssh-rsa AAAAbob\n" + alice),
+			want: true,
+		},
+		"OtherComment": {
+			md:   metadata(`alice:ssh-ed25519 AAAAalice google-ssh {"userName":"alice@example.com","expireOn":"2030-01-01T00:00:00+0000"}`),
+			want: true,
+		},
+		"OtherUser": {
+			md: metadata("bob:ssh-ed25519 AAAAalice alice@example.com"),
+		},
+		"OtherKey": {
+			md: metadata("alice:ssh-ed25519 AAAAother alice@example.com"),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, HasKey(tc.md, alice)); diff != "" {
+				t.Errorf("HasKey(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestWithKey(t *testing.T) {
+	cases := map[string]struct {
+		md   *compute.Metadata
+		want *compute.Metadata
+	}{
+		"TrailingNewline": {
+			md:   metadata("bob:ssh-rsa AAAAbob\n"),
+			want: metadata("bob:ssh-rsa AAAAbob\n" + alice),
+		},
+		"EmptyItem": {
+			md:   metadata(""),
+			want: metadata(alice),
+		},
+		"AlreadyAdded": {
+			md:   metadata(alice),
+			want: metadata(alice),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, WithKey(tc.md, alice)); diff != "" {
+				t.Errorf("WithKey(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicadvertisedprefix
+
+import (
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/mitchellh/copystructure"
+	"github.com/pkg/errors"
+	compute "google.golang.org/api/compute/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const errCheckUpToDate = "unable to determine if external resource is up to date"
+
+// GeneratePublicAdvertisedPrefix takes a *PublicAdvertisedPrefixParameters
+// and fills *compute.PublicAdvertisedPrefix. It assigns only the fields that
+// are writable, i.e. not labelled as [Output Only] in Google's reference.
+func GeneratePublicAdvertisedPrefix(name string, in v1alpha1.PublicAdvertisedPrefixParameters, p *compute.PublicAdvertisedPrefix) {
+	p.Name = name
+	p.Description = gcp.StringValue(in.Description)
+	p.IpCidrRange = in.IPCIDRRange
+	p.DnsVerificationIp = in.DNSVerificationIP
+}
+
+// GeneratePublicAdvertisedPrefixObservation takes a
+// compute.PublicAdvertisedPrefix and returns
+// *PublicAdvertisedPrefixObservation.
+func GeneratePublicAdvertisedPrefixObservation(in compute.PublicAdvertisedPrefix) v1alpha1.PublicAdvertisedPrefixObservation {
+	o := v1alpha1.PublicAdvertisedPrefixObservation{
+		CreationTimestamp: in.CreationTimestamp,
+		Fingerprint:       in.Fingerprint,
+		ID:                in.Id,
+		SelfLink:          in.SelfLink,
+		SharedSecret:      in.SharedSecret,
+		Status:            in.Status,
+	}
+	for _, d := range in.PublicDelegatedPrefixs {
+		if d == nil {
+			continue
+		}
+		o.PublicDelegatedPrefixes = append(o.PublicDelegatedPrefixes, v1alpha1.PublicAdvertisedPrefixPublicDelegatedPrefix{
+			IPRange: d.IpRange,
+			Name:    d.Name,
+			Project: d.Project,
+			Region:  d.Region,
+			Status:  d.Status,
+		})
+	}
+	return o
+}
+
+// LateInitializeSpec fills unassigned fields with the values in
+// compute.PublicAdvertisedPrefix object.
+func LateInitializeSpec(spec *v1alpha1.PublicAdvertisedPrefixParameters, in compute.PublicAdvertisedPrefix) {
+	spec.Description = gcp.LateInitializeString(spec.Description, in.Description)
+}
+
+// IsUpToDate checks whether current state is up-to-date compared to the given
+// set of parameters.
+func IsUpToDate(name string, in *v1alpha1.PublicAdvertisedPrefixParameters, observed *compute.PublicAdvertisedPrefix) (bool, error) {
+	generated, err := copystructure.Copy(observed)
+	if err != nil {
+		return true, errors.Wrap(err, errCheckUpToDate)
+	}
+	desired, ok := generated.(*compute.PublicAdvertisedPrefix)
+	if !ok {
+		return true, errors.New(errCheckUpToDate)
+	}
+	GeneratePublicAdvertisedPrefix(name, *in, desired)
+	return cmp.Equal(desired, observed, cmpopts.EquateEmpty()), nil
+}
@@ -0,0 +1,201 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicadvertisedprefix
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/compute/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+)
+
+const (
+	testName              = "some-name"
+	testCreationTimestamp = "10/10/2023"
+	testSelfLink          = "/link/to/self"
+	testFingerprint       = "abc="
+	testRange             = "203.0.113.0/24"
+	testVerificationIP    = "203.0.113.1"
+)
+
+var testDescription = "some desc"
+
+func params(m ...func(*v1alpha1.PublicAdvertisedPrefixParameters)) *v1alpha1.PublicAdvertisedPrefixParameters {
+	o := &v1alpha1.PublicAdvertisedPrefixParameters{
+		Description:       &testDescription,
+		IPCIDRRange:       testRange,
+		DNSVerificationIP: testVerificationIP,
+	}
+
+	for _, f := range m {
+		f(o)
+	}
+
+	return o
+}
+
+func prefix(m ...func(*compute.PublicAdvertisedPrefix)) *compute.PublicAdvertisedPrefix {
+	o := &compute.PublicAdvertisedPrefix{
+		Name:              testName,
+		Description:       testDescription,
+		IpCidrRange:       testRange,
+		DnsVerificationIp: testVerificationIP,
+	}
+
+	for _, f := range m {
+		f(o)
+	}
+
+	return o
+}
+
+func addOutputFields(p *compute.PublicAdvertisedPrefix) {
+	p.CreationTimestamp = testCreationTimestamp
+	p.Fingerprint = testFingerprint
+	p.Id = 2029819203
+	p.SelfLink = testSelfLink
+	p.SharedSecret = "secret"
+	p.Status = v1alpha1.PublicAdvertisedPrefixStatusPrefixConfigurationComplete
+	p.PublicDelegatedPrefixs = []*compute.PublicAdvertisedPrefixPublicDelegatedPrefix{
+		{IpRange: "203.0.113.0/25", Name: "pdp", Project: "test", Region: "us-west1", Status: "ANNOUNCED"},
+	}
+}
+
+func TestGeneratePublicAdvertisedPrefix(t *testing.T) {
+	type args struct {
+		name string
+		in   v1alpha1.PublicAdvertisedPrefixParameters
+	}
+	cases := map[string]struct {
+		args args
+		want *compute.PublicAdvertisedPrefix
+	}{
+		"AllFilled": {
+			args: args{
+				name: testName,
+				in:   *params(),
+			},
+			want: prefix(),
+		},
+		"NoDescription": {
+			args: args{
+				name: testName,
+				in: *params(func(p *v1alpha1.PublicAdvertisedPrefixParameters) {
+					p.Description = nil
+				}),
+			},
+			want: prefix(func(p *compute.PublicAdvertisedPrefix) {
+				p.Description = ""
+			}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			p := &compute.PublicAdvertisedPrefix{}
+			GeneratePublicAdvertisedPrefix(tc.args.name, tc.args.in, p)
+			if diff := cmp.Diff(tc.want, p); diff != "" {
+				t.Errorf("GeneratePublicAdvertisedPrefix(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGeneratePublicAdvertisedPrefixObservation(t *testing.T) {
+	cases := map[string]struct {
+		in  compute.PublicAdvertisedPrefix
+		out v1alpha1.PublicAdvertisedPrefixObservation
+	}{
+		"AllFilled": {
+			in: *prefix(addOutputFields),
+			out: v1alpha1.PublicAdvertisedPrefixObservation{
+				CreationTimestamp: testCreationTimestamp,
+				Fingerprint:       testFingerprint,
+				ID:                2029819203,
+				SelfLink:          testSelfLink,
+				SharedSecret:      "secret",
+				Status:            v1alpha1.PublicAdvertisedPrefixStatusPrefixConfigurationComplete,
+				PublicDelegatedPrefixes: []v1alpha1.PublicAdvertisedPrefixPublicDelegatedPrefix{
+					{IPRange: "203.0.113.0/25", Name: "pdp", Project: "test", Region: "us-west1", Status: "ANNOUNCED"},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			o := GeneratePublicAdvertisedPrefixObservation(tc.in)
+			if diff := cmp.Diff(tc.out, o); diff != "" {
+				t.Errorf("GeneratePublicAdvertisedPrefixObservation(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	type args struct {
+		in      *v1alpha1.PublicAdvertisedPrefixParameters
+		current *compute.PublicAdvertisedPrefix
+	}
+	type want struct {
+		upToDate bool
+		isErr    bool
+	}
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"UpToDate": {
+			args: args{
+				in:      params(),
+				current: prefix(),
+			},
+			want: want{upToDate: true, isErr: false},
+		},
+		"UpToDateWithOutputFields": {
+			args: args{
+				in:      params(),
+				current: prefix(addOutputFields),
+			},
+			want: want{upToDate: true, isErr: false},
+		},
+		"NotUpToDateDescription": {
+			args: args{
+				in: params(func(p *v1alpha1.PublicAdvertisedPrefixParameters) {
+					d := "other desc"
+					p.Description = &d
+				}),
+				current: prefix(),
+			},
+			want: want{upToDate: false, isErr: false},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			u, err := IsUpToDate(testName, tc.args.in, tc.args.current)
+			if err != nil && !tc.want.isErr {
+				t.Error("IsUpToDate(...) unexpected error")
+			}
+			if diff := cmp.Diff(tc.want.upToDate, u); diff != "" {
+				t.Errorf("IsUpToDate(...) UpToDate: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
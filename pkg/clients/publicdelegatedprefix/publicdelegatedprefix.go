@@ -0,0 +1,92 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicdelegatedprefix
+
+import (
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/mitchellh/copystructure"
+	"github.com/pkg/errors"
+	compute "google.golang.org/api/compute/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const errCheckUpToDate = "unable to determine if external resource is up to date"
+
+// GeneratePublicDelegatedPrefix takes a *PublicDelegatedPrefixParameters
+// and fills *compute.PublicDelegatedPrefix. It assigns only the fields that
+// are writable, i.e. not labelled as [Output Only] in Google's reference.
+func GeneratePublicDelegatedPrefix(name string, in v1alpha1.PublicDelegatedPrefixParameters, p *compute.PublicDelegatedPrefix) {
+	p.Name = name
+	p.Description = gcp.StringValue(in.Description)
+	p.IpCidrRange = in.IPCIDRRange
+	p.ParentPrefix = gcp.StringValue(in.ParentPrefix)
+	p.IsLiveMigration = gcp.BoolValue(in.IsLiveMigration)
+}
+
+// GeneratePublicDelegatedPrefixObservation takes a
+// compute.PublicDelegatedPrefix and returns
+// *PublicDelegatedPrefixObservation.
+func GeneratePublicDelegatedPrefixObservation(in compute.PublicDelegatedPrefix) v1alpha1.PublicDelegatedPrefixObservation {
+	o := v1alpha1.PublicDelegatedPrefixObservation{
+		CreationTimestamp: in.CreationTimestamp,
+		Fingerprint:       in.Fingerprint,
+		ID:                in.Id,
+		SelfLink:          in.SelfLink,
+		Status:            in.Status,
+	}
+	for _, s := range in.PublicDelegatedSubPrefixs {
+		if s == nil {
+			continue
+		}
+		o.PublicDelegatedSubPrefixes = append(o.PublicDelegatedSubPrefixes, v1alpha1.PublicDelegatedSubPrefix{
+			DelegateeProject: s.DelegateeProject,
+			Description:      s.Description,
+			IPCIDRRange:      s.IpCidrRange,
+			IsAddress:        s.IsAddress,
+			Name:             s.Name,
+			Region:           s.Region,
+			Status:           s.Status,
+		})
+	}
+	return o
+}
+
+// LateInitializeSpec fills unassigned fields with the values in
+// compute.PublicDelegatedPrefix object.
+func LateInitializeSpec(spec *v1alpha1.PublicDelegatedPrefixParameters, in compute.PublicDelegatedPrefix) {
+	spec.Description = gcp.LateInitializeString(spec.Description, in.Description)
+	spec.ParentPrefix = gcp.LateInitializeString(spec.ParentPrefix, in.ParentPrefix)
+	spec.IsLiveMigration = gcp.LateInitializeBool(spec.IsLiveMigration, in.IsLiveMigration)
+}
+
+// IsUpToDate checks whether current state is up-to-date compared to the given
+// set of parameters.
+func IsUpToDate(name string, in *v1alpha1.PublicDelegatedPrefixParameters, observed *compute.PublicDelegatedPrefix) (bool, error) {
+	generated, err := copystructure.Copy(observed)
+	if err != nil {
+		return true, errors.Wrap(err, errCheckUpToDate)
+	}
+	desired, ok := generated.(*compute.PublicDelegatedPrefix)
+	if !ok {
+		return true, errors.New(errCheckUpToDate)
+	}
+	GeneratePublicDelegatedPrefix(name, *in, desired)
+	return cmp.Equal(desired, observed, cmpopts.EquateEmpty(), gcp.EquateComputeURLs()), nil
+}
@@ -0,0 +1,214 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicdelegatedprefix
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/compute/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+)
+
+const (
+	testName              = "some-name"
+	testCreationTimestamp = "10/10/2023"
+	testSelfLink          = "/link/to/self"
+	testRegion            = "us-west1"
+	testFingerprint       = "abc="
+	testRange             = "203.0.113.0/25"
+)
+
+var (
+	testDescription  = "some desc"
+	testParentPrefix = "projects/test/global/publicAdvertisedPrefixes/pap"
+)
+
+func params(m ...func(*v1alpha1.PublicDelegatedPrefixParameters)) *v1alpha1.PublicDelegatedPrefixParameters {
+	o := &v1alpha1.PublicDelegatedPrefixParameters{
+		Description:  &testDescription,
+		Region:       testRegion,
+		IPCIDRRange:  testRange,
+		ParentPrefix: &testParentPrefix,
+	}
+
+	for _, f := range m {
+		f(o)
+	}
+
+	return o
+}
+
+func prefix(m ...func(*compute.PublicDelegatedPrefix)) *compute.PublicDelegatedPrefix {
+	o := &compute.PublicDelegatedPrefix{
+		Name:         testName,
+		Description:  testDescription,
+		IpCidrRange:  testRange,
+		ParentPrefix: testParentPrefix,
+	}
+
+	for _, f := range m {
+		f(o)
+	}
+
+	return o
+}
+
+func addOutputFields(p *compute.PublicDelegatedPrefix) {
+	p.CreationTimestamp = testCreationTimestamp
+	p.Fingerprint = testFingerprint
+	p.Id = 2029819203
+	p.SelfLink = testSelfLink
+	p.Region = "https://www.googleapis.com/compute/v1/projects/test/regions/" + testRegion
+	p.Status = v1alpha1.PublicDelegatedPrefixStatusAnnounced
+	p.PublicDelegatedSubPrefixs = []*compute.PublicDelegatedPrefixPublicDelegatedSubPrefix{
+		{DelegateeProject: "consumer", IpCidrRange: "203.0.113.0/28", IsAddress: true, Name: "sub", Status: "ACTIVE"},
+	}
+}
+
+func TestGeneratePublicDelegatedPrefix(t *testing.T) {
+	type args struct {
+		name string
+		in   v1alpha1.PublicDelegatedPrefixParameters
+	}
+	cases := map[string]struct {
+		args args
+		want *compute.PublicDelegatedPrefix
+	}{
+		"AllFilled": {
+			args: args{
+				name: testName,
+				in:   *params(),
+			},
+			want: prefix(),
+		},
+		"LiveMigration": {
+			args: args{
+				name: testName,
+				in: *params(func(p *v1alpha1.PublicDelegatedPrefixParameters) {
+					b := true
+					p.IsLiveMigration = &b
+				}),
+			},
+			want: prefix(func(p *compute.PublicDelegatedPrefix) {
+				p.IsLiveMigration = true
+			}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			p := &compute.PublicDelegatedPrefix{}
+			GeneratePublicDelegatedPrefix(tc.args.name, tc.args.in, p)
+			if diff := cmp.Diff(tc.want, p); diff != "" {
+				t.Errorf("GeneratePublicDelegatedPrefix(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGeneratePublicDelegatedPrefixObservation(t *testing.T) {
+	cases := map[string]struct {
+		in  compute.PublicDelegatedPrefix
+		out v1alpha1.PublicDelegatedPrefixObservation
+	}{
+		"AllFilled": {
+			in: *prefix(addOutputFields),
+			out: v1alpha1.PublicDelegatedPrefixObservation{
+				CreationTimestamp: testCreationTimestamp,
+				Fingerprint:       testFingerprint,
+				ID:                2029819203,
+				SelfLink:          testSelfLink,
+				Status:            v1alpha1.PublicDelegatedPrefixStatusAnnounced,
+				PublicDelegatedSubPrefixes: []v1alpha1.PublicDelegatedSubPrefix{
+					{DelegateeProject: "consumer", IPCIDRRange: "203.0.113.0/28", IsAddress: true, Name: "sub", Status: "ACTIVE"},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			o := GeneratePublicDelegatedPrefixObservation(tc.in)
+			if diff := cmp.Diff(tc.out, o); diff != "" {
+				t.Errorf("GeneratePublicDelegatedPrefixObservation(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	type args struct {
+		in      *v1alpha1.PublicDelegatedPrefixParameters
+		current *compute.PublicDelegatedPrefix
+	}
+	type want struct {
+		upToDate bool
+		isErr    bool
+	}
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"UpToDate": {
+			args: args{
+				in:      params(),
+				current: prefix(),
+			},
+			want: want{upToDate: true, isErr: false},
+		},
+		"UpToDateWithOutputFields": {
+			args: args{
+				in:      params(),
+				current: prefix(addOutputFields),
+			},
+			want: want{upToDate: true, isErr: false},
+		},
+		"UpToDateFullyQualifiedURLs": {
+			args: args{
+				in: params(),
+				current: prefix(func(p *compute.PublicDelegatedPrefix) {
+					p.ParentPrefix = "https://www.googleapis.com/compute/v1/" + testParentPrefix
+				}),
+			},
+			want: want{upToDate: true, isErr: false},
+		},
+		"NotUpToDateDescription": {
+			args: args{
+				in: params(func(p *v1alpha1.PublicDelegatedPrefixParameters) {
+					d := "other desc"
+					p.Description = &d
+				}),
+				current: prefix(),
+			},
+			want: want{upToDate: false, isErr: false},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			u, err := IsUpToDate(testName, tc.args.in, tc.args.current)
+			if err != nil && !tc.want.isErr {
+				t.Error("IsUpToDate(...) unexpected error")
+			}
+			if diff := cmp.Diff(tc.want.upToDate, u); diff != "" {
+				t.Errorf("IsUpToDate(...) UpToDate: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recaptchakey
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	recaptcha "google.golang.org/api/recaptchaenterprise/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/recaptchaenterprise/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	parentFormat = "projects/%s"
+	nameFormat   = "projects/%s/keys/%s"
+)
+
+// GetParent returns the project the Key lives under.
+func GetParent(projectID string) string {
+	return fmt.Sprintf(parentFormat, projectID)
+}
+
+// GetFullyQualifiedName builds the relative resource name of the Key.
+func GetFullyQualifiedName(projectID, keyID string) string {
+	return fmt.Sprintf(nameFormat, projectID, keyID)
+}
+
+// ParseKeyID returns the key ID from the relative resource name of a Key.
+func ParseKeyID(name string) string {
+	return path.Base(name)
+}
+
+// GenerateKey produces a Key that is configured via given KeyParameters.
+func GenerateKey(name string, p v1alpha1.KeyParameters) *recaptcha.GoogleCloudRecaptchaenterpriseV1Key {
+	k := &recaptcha.GoogleCloudRecaptchaenterpriseV1Key{
+		Name:        name,
+		DisplayName: p.DisplayName,
+		Labels:      p.Labels,
+	}
+	if s := p.WebSettings; s != nil {
+		k.WebSettings = &recaptcha.GoogleCloudRecaptchaenterpriseV1WebKeySettings{
+			IntegrationType:             s.IntegrationType,
+			AllowAllDomains:             gcp.BoolValue(s.AllowAllDomains),
+			AllowedDomains:              s.AllowedDomains,
+			AllowAmpTraffic:             gcp.BoolValue(s.AllowAmpTraffic),
+			ChallengeSecurityPreference: gcp.StringValue(s.ChallengeSecurityPreference),
+		}
+	}
+	if s := p.AndroidSettings; s != nil {
+		k.AndroidSettings = &recaptcha.GoogleCloudRecaptchaenterpriseV1AndroidKeySettings{
+			AllowAllPackageNames: gcp.BoolValue(s.AllowAllPackageNames),
+			AllowedPackageNames:  s.AllowedPackageNames,
+		}
+	}
+	if s := p.IOSSettings; s != nil {
+		k.IosSettings = &recaptcha.GoogleCloudRecaptchaenterpriseV1IOSKeySettings{
+			AllowAllBundleIds: gcp.BoolValue(s.AllowAllBundleIDs),
+			AllowedBundleIds:  s.AllowedBundleIDs,
+		}
+	}
+	return k
+}
+
+// GenerateObservation produces a KeyObservation from the supplied Key.
+func GenerateObservation(k recaptcha.GoogleCloudRecaptchaenterpriseV1Key) v1alpha1.KeyObservation {
+	return v1alpha1.KeyObservation{
+		Name:       k.Name,
+		CreateTime: k.CreateTime,
+	}
+}
+
+// LateInitialize fills the empty fields of KeyParameters if the
+// corresponding fields are given in Key.
+func LateInitialize(p *v1alpha1.KeyParameters, k recaptcha.GoogleCloudRecaptchaenterpriseV1Key) {
+	p.Labels = gcp.LateInitializeStringMap(p.Labels, k.Labels)
+	if p.WebSettings != nil && k.WebSettings != nil {
+		p.WebSettings.ChallengeSecurityPreference = gcp.LateInitializeString(p.WebSettings.ChallengeSecurityPreference, k.WebSettings.ChallengeSecurityPreference)
+	}
+}
+
+// IsUpToDate checks whether Key is configured with given KeyParameters.
+func IsUpToDate(p v1alpha1.KeyParameters, k recaptcha.GoogleCloudRecaptchaenterpriseV1Key) bool {
+	return GenerateUpdateMask(p, k) == ""
+}
+
+// GenerateUpdateMask returns the comma separated list of fields that differ
+// between KeyParameters and Key.
+func GenerateUpdateMask(p v1alpha1.KeyParameters, k recaptcha.GoogleCloudRecaptchaenterpriseV1Key) string {
+	desired := GenerateKey(k.Name, p)
+	mask := []string{}
+	if desired.DisplayName != k.DisplayName {
+		mask = append(mask, "displayName")
+	}
+	if !cmp.Equal(desired.Labels, k.Labels, cmpopts.EquateEmpty()) {
+		mask = append(mask, "labels")
+	}
+	if !cmp.Equal(desired.WebSettings, k.WebSettings, cmpopts.EquateEmpty()) {
+		mask = append(mask, "webSettings")
+	}
+	if !cmp.Equal(desired.AndroidSettings, k.AndroidSettings, cmpopts.EquateEmpty()) {
+		mask = append(mask, "androidSettings")
+	}
+	if !cmp.Equal(desired.IosSettings, k.IosSettings, cmpopts.EquateEmpty()) {
+		mask = append(mask, "iosSettings")
+	}
+	return strings.Join(mask, ",")
+}
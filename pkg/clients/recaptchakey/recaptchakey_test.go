@@ -0,0 +1,121 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recaptchakey
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	recaptcha "google.golang.org/api/recaptchaenterprise/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/recaptchaenterprise/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const testName = "projects/foo/keys/6LeXXXX"
+
+func params(m ...func(*v1alpha1.KeyParameters)) *v1alpha1.KeyParameters {
+	p := &v1alpha1.KeyParameters{
+		DisplayName: "checkout",
+		Labels:      map[string]string{"team": "payments"},
+		WebSettings: &v1alpha1.WebKeySettings{
+			IntegrationType:             "SCORE",
+			AllowedDomains:              []string{"example.com"},
+			ChallengeSecurityPreference: gcp.StringPtr("USABILITY"),
+		},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func key(m ...func(*recaptcha.GoogleCloudRecaptchaenterpriseV1Key)) *recaptcha.GoogleCloudRecaptchaenterpriseV1Key {
+	k := &recaptcha.GoogleCloudRecaptchaenterpriseV1Key{
+		Name:        testName,
+		DisplayName: "checkout",
+		Labels:      map[string]string{"team": "payments"},
+		WebSettings: &recaptcha.GoogleCloudRecaptchaenterpriseV1WebKeySettings{
+			IntegrationType:             "SCORE",
+			AllowedDomains:              []string{"example.com"},
+			ChallengeSecurityPreference: "USABILITY",
+		},
+	}
+	for _, f := range m {
+		f(k)
+	}
+	return k
+}
+
+func TestParseKeyID(t *testing.T) {
+	if diff := cmp.Diff("6LeXXXX", ParseKeyID(testName)); diff != "" {
+		t.Errorf("ParseKeyID(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateKey(t *testing.T) {
+	if diff := cmp.Diff(key(), GenerateKey(testName, *params())); diff != "" {
+		t.Errorf("GenerateKey(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitialize(t *testing.T) {
+	got := params(func(p *v1alpha1.KeyParameters) {
+		p.Labels = nil
+		p.WebSettings.ChallengeSecurityPreference = nil
+	})
+	LateInitialize(got, *key())
+	if diff := cmp.Diff(params(), got); diff != "" {
+		t.Errorf("LateInitialize(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateUpdateMask(t *testing.T) {
+	cases := map[string]struct {
+		params *v1alpha1.KeyParameters
+		obs    *recaptcha.GoogleCloudRecaptchaenterpriseV1Key
+		want   string
+	}{
+		"UpToDate": {
+			params: params(),
+			obs:    key(),
+			want:   "",
+		},
+		"DomainAdded": {
+			params: params(func(p *v1alpha1.KeyParameters) {
+				p.WebSettings.AllowedDomains = append(p.WebSettings.AllowedDomains, "shop.example.com")
+			}),
+			obs:  key(),
+			want: "webSettings",
+		},
+		"RenamedAndRelabeled": {
+			params: params(func(p *v1alpha1.KeyParameters) {
+				p.DisplayName = "checkout-v2"
+				p.Labels = map[string]string{"team": "growth"}
+			}),
+			obs:  key(),
+			want: "displayName,labels",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, GenerateUpdateMask(*tc.params, *tc.obs)); diff != "" {
+				t.Errorf("GenerateUpdateMask(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,169 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package roundtrip checks that the functions a client uses to convert the
+// parameters of a managed resource to and from their GCP representation
+// agree with each other. Disagreement between them causes the provider to
+// reset fields the user has set, or to update a resource on every
+// reconcile.
+package roundtrip
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	fuzz "github.com/google/gofuzz"
+	"github.com/mitchellh/copystructure"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+)
+
+// DefaultRuns is the number of random parameters Check tries by default.
+const DefaultRuns = 500
+
+// maxReported is the number of failures that are reported in detail.
+const maxReported = 3
+
+// Funcs are the conversion functions of a client. P is the type of the
+// parameters of the managed resource, and O the type of its GCP
+// representation.
+type Funcs[P, O any] struct {
+	// Generate returns the GCP representation of the supplied parameters.
+	Generate func(p P) *O
+
+	// LateInitialize late initializes the supplied parameters from the
+	// supplied GCP representation.
+	LateInitialize func(p *P, o O)
+
+	// IsUpToDate returns true if the supplied GCP representation is up to
+	// date with the supplied parameters.
+	IsUpToDate func(p *P, o *O) (bool, error)
+}
+
+// NewFuzzer returns a fuzzer that fills parameters with random values from
+// the supplied seed. Cross resource references and selectors are left empty,
+// since they are resolved before parameters are converted. The supplied
+// custom fuzz functions may constrain fields to the values GCP accepts.
+func NewFuzzer(seed int64, fns ...interface{}) *fuzz.Fuzzer {
+	fns = append([]interface{}{
+		func(_ **xpv1.Reference, _ fuzz.Continue) {},
+		func(_ **xpv1.Selector, _ fuzz.Continue) {},
+	}, fns...)
+	return fuzz.NewWithSeed(seed).NilChance(0.3).NumElements(0, 3).Funcs(fns...)
+}
+
+// Check fills parameters with random values the supplied number of times and
+// asserts that, for each of them, the round trip through their own GCP
+// representation is idempotent:
+//
+//  1. Late initializing the parameters from it does not change them.
+//  2. It is up to date with the parameters.
+//
+// Parameters are compared treating nil and empty slices and maps as equal,
+// in addition to the supplied options.
+func Check[P, O any](t *testing.T, f *fuzz.Fuzzer, runs int, fns Funcs[P, O], opts ...cmp.Option) {
+	t.Helper()
+	opts = append(opts, cmpopts.EquateEmpty())
+	failed := 0
+	for i := 0; i < runs; i++ {
+		var p P
+		f.Fuzz(&p)
+		dropNilElements(reflect.ValueOf(&p))
+		if err := roundTrip(p, fns, opts); err != nil {
+			failed++
+			if failed <= maxReported {
+				t.Errorf("run %d: %s", i, err)
+			}
+		}
+	}
+	if failed > maxReported {
+		t.Errorf("%d more of %d runs failed", failed-maxReported, runs)
+	}
+}
+
+func roundTrip[P, O any](p P, fns Funcs[P, O], opts []cmp.Option) (err error) {
+	// A panic is a failure of the run, not of the whole check.
+	defer func() {
+		if r := recover(); r != nil {
+			err = errors.Errorf("panic: %v\nparameters: %+v", r, p)
+		}
+	}()
+
+	o := fns.Generate(p)
+
+	c, err := copystructure.Copy(p)
+	if err != nil {
+		return errors.Wrap(err, "cannot copy parameters")
+	}
+	got := c.(P)
+	fns.LateInitialize(&got, *o)
+	if diff := cmp.Diff(p, got, opts...); diff != "" {
+		return errors.Errorf("late initializing parameters from their own GCP representation changed them: -want, +got:\n%s", diff)
+	}
+
+	u, err := fns.IsUpToDate(&got, o)
+	if err != nil {
+		return errors.Wrap(err, "cannot check whether parameters are up to date")
+	}
+	if !u {
+		return errors.Errorf("parameters are not up to date with their own GCP representation: %+v", p)
+	}
+	return nil
+}
+
+// dropNilElements removes the nil elements the fuzzer puts into slices of
+// pointers. The API server does not admit them, so clients do not handle
+// them.
+func dropNilElements(v reflect.Value) {
+	switch v.Kind() { //nolint:exhaustive
+	case reflect.Ptr, reflect.Interface:
+		if !v.IsNil() {
+			dropNilElements(v.Elem())
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).IsExported() {
+				dropNilElements(v.Field(i))
+			}
+		}
+	case reflect.Map:
+		for _, k := range v.MapKeys() {
+			e := reflect.New(v.Type().Elem()).Elem()
+			e.Set(v.MapIndex(k))
+			dropNilElements(e)
+			v.SetMapIndex(k, e)
+		}
+	case reflect.Slice:
+		if v.Type().Elem().Kind() != reflect.Ptr {
+			for i := 0; i < v.Len(); i++ {
+				dropNilElements(v.Index(i))
+			}
+			return
+		}
+		n := 0
+		for i := 0; i < v.Len(); i++ {
+			if e := v.Index(i); !e.IsNil() {
+				dropNilElements(e)
+				v.Index(n).Set(e)
+				n++
+			}
+		}
+		v.SetLen(n)
+	}
+}
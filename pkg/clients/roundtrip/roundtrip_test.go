@@ -0,0 +1,187 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package roundtrip
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+)
+
+type params struct {
+	Name   *string
+	Labels map[string]string
+	Ref    *xpv1.Reference
+}
+
+type list struct {
+	Items []*item
+}
+
+type item struct {
+	Value string
+	Items []*item
+}
+
+type external struct {
+	Name   string
+	Labels map[string]string
+}
+
+func str(s string) *string { return &s }
+
+func generate(p params) *external {
+	e := &external{Labels: p.Labels}
+	if p.Name != nil {
+		e.Name = *p.Name
+	}
+	return e
+}
+
+func lateInitialize(p *params, e external) {
+	if p.Name == nil && e.Name != "" {
+		p.Name = &e.Name
+	}
+}
+
+func isUpToDate(p *params, e *external) (bool, error) {
+	return generate(*p).Name == e.Name, nil
+}
+
+func TestRoundTrip(t *testing.T) {
+	fns := Funcs[params, external]{Generate: generate, LateInitialize: lateInitialize, IsUpToDate: isUpToDate}
+
+	cases := map[string]struct {
+		reason string
+		p      params
+		fns    Funcs[params, external]
+		want   string
+	}{
+		"Agree": {
+			reason: "Functions that agree with each other should pass.",
+			p:      params{Name: str("foo"), Labels: map[string]string{"a": "b"}},
+			fns:    fns,
+		},
+		"EmptyStringLateInitialized": {
+			reason: "Late initializing an unset field from the zero value GCP returns for it should fail.",
+			p:      params{},
+			fns: Funcs[params, external]{
+				Generate:       generate,
+				LateInitialize: func(p *params, e external) { p.Name = &e.Name },
+				IsUpToDate:     isUpToDate,
+			},
+			want: "late initializing parameters",
+		},
+		"NotUpToDate": {
+			reason: "Parameters that are not up to date with their own GCP representation should fail.",
+			p:      params{Name: str("foo")},
+			fns: Funcs[params, external]{
+				Generate:       generate,
+				LateInitialize: lateInitialize,
+				IsUpToDate:     func(_ *params, _ *external) (bool, error) { return false, nil },
+			},
+			want: "not up to date",
+		},
+		"IsUpToDateFailed": {
+			reason: "Errors checking whether parameters are up to date should fail.",
+			p:      params{Name: str("foo")},
+			fns: Funcs[params, external]{
+				Generate:       generate,
+				LateInitialize: lateInitialize,
+				IsUpToDate:     func(_ *params, _ *external) (bool, error) { return false, errors.New("boom") },
+			},
+			want: "cannot check whether parameters are up to date",
+		},
+		"Panic": {
+			reason: "A panicking function should fail the run instead of the test binary.",
+			p:      params{},
+			fns: Funcs[params, external]{
+				Generate:       func(params) *external { panic("boom") },
+				LateInitialize: lateInitialize,
+				IsUpToDate:     isUpToDate,
+			},
+			want: "panic",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := roundTrip(tc.p, tc.fns, nil)
+			got := ""
+			if err != nil {
+				got = err.Error()
+			}
+			if tc.want == "" && err != nil {
+				t.Errorf("\n%s\nroundTrip(...): unexpected error: %s", tc.reason, got)
+			}
+			if !strings.Contains(got, tc.want) {
+				t.Errorf("\n%s\nroundTrip(...): want error containing %q, got %q", tc.reason, tc.want, got)
+			}
+		})
+	}
+}
+
+func TestDropNilElements(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		l      list
+		want   list
+	}{
+		"NoNils": {
+			reason: "Slices without nil elements should not change.",
+			l:      list{Items: []*item{{Value: "a"}, {Value: "b"}}},
+			want:   list{Items: []*item{{Value: "a"}, {Value: "b"}}},
+		},
+		"Nils": {
+			reason: "Nil elements should be dropped, keeping the order of the others.",
+			l:      list{Items: []*item{nil, {Value: "a"}, nil, {Value: "b"}}},
+			want:   list{Items: []*item{{Value: "a"}, {Value: "b"}}},
+		},
+		"Nested": {
+			reason: "Nil elements of nested slices should be dropped.",
+			l:      list{Items: []*item{{Value: "a", Items: []*item{nil, {Value: "b"}}}}},
+			want:   list{Items: []*item{{Value: "a", Items: []*item{{Value: "b"}}}}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			dropNilElements(reflect.ValueOf(&tc.l))
+			if diff := cmp.Diff(tc.want, tc.l); diff != "" {
+				t.Errorf("\n%s\ndropNilElements(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestNewFuzzer(t *testing.T) {
+	f := NewFuzzer(1)
+	for i := 0; i < DefaultRuns; i++ {
+		var p params
+		f.Fuzz(&p)
+		if p.Ref != nil {
+			t.Fatalf("NewFuzzer(...): run %d: want no reference, got %+v", i, p.Ref)
+		}
+	}
+}
+
+func TestCheck(t *testing.T) {
+	Check(t, NewFuzzer(1), DefaultRuns, Funcs[params, external]{Generate: generate, LateInitialize: lateInitialize, IsUpToDate: isUpToDate})
+}
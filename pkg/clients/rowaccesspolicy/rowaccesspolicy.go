@@ -0,0 +1,133 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rowaccesspolicy
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	bigquery "google.golang.org/api/bigquery/v2"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/bigquery/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	// RoleFilteredDataViewer is granted to the grantees of a row access
+	// policy.
+	RoleFilteredDataViewer = "roles/bigquery.filteredDataViewer"
+
+	policyResourceFormat = "projects/%s/datasets/%s/tables/%s/rowAccessPolicies/%s"
+
+	errInvalidIdentifierFmt = "invalid identifier %q: identifiers may not contain backticks or backslashes"
+)
+
+// PolicyResource returns the IAM resource name of the row access policy with
+// the supplied ID of the table of the supplied RowAccessPolicyParameters in
+// the supplied project.
+func PolicyResource(project, id string, in v1alpha1.RowAccessPolicyParameters) string {
+	return fmt.Sprintf(policyResourceFormat, project, gcp.StringValue(in.Dataset), in.Table, id)
+}
+
+// table returns the quoted GoogleSQL path of the table of the supplied
+// RowAccessPolicyParameters in the supplied project.
+func table(project string, in v1alpha1.RowAccessPolicyParameters) string {
+	return fmt.Sprintf("`%s.%s.%s`", project, gcp.StringValue(in.Dataset), in.Table)
+}
+
+// quote returns the supplied string as a GoogleSQL string literal.
+func quote(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(s) + `"`
+}
+
+// ValidateIdentifiers returns an error if the supplied project, policy ID or
+// the dataset or table of the supplied RowAccessPolicyParameters cannot be
+// quoted safely in a DDL statement.
+func ValidateIdentifiers(project, id string, in v1alpha1.RowAccessPolicyParameters) error {
+	for _, i := range []string{project, id, gcp.StringValue(in.Dataset), in.Table} {
+		if strings.ContainsAny(i, "`\\") {
+			return errors.Errorf(errInvalidIdentifierFmt, i)
+		}
+	}
+	return nil
+}
+
+// CreateStatement returns the DDL statement that creates, or replaces, the
+// row access policy with the supplied ID in the supplied project.
+func CreateStatement(project, id string, in v1alpha1.RowAccessPolicyParameters) string {
+	grantees := make([]string, len(in.Grantees))
+	for i, g := range in.Grantees {
+		grantees[i] = quote(g)
+	}
+	return fmt.Sprintf("CREATE OR REPLACE ROW ACCESS POLICY `%s` ON %s GRANT TO (%s) FILTER USING (%s)",
+		id, table(project, in), strings.Join(grantees, ", "), in.FilterPredicate)
+}
+
+// DropStatement returns the DDL statement that drops the row access policy
+// with the supplied ID in the supplied project.
+func DropStatement(project, id string, in v1alpha1.RowAccessPolicyParameters) string {
+	return fmt.Sprintf("DROP ROW ACCESS POLICY IF EXISTS `%s` ON %s", id, table(project, in))
+}
+
+// Grantees returns the members that the supplied IAM policy of a row access
+// policy grants access to the filtered rows.
+func Grantees(p *bigquery.Policy) []string {
+	var grantees []string
+	for _, b := range p.Bindings {
+		if b != nil && b.Role == RoleFilteredDataViewer {
+			grantees = append(grantees, b.Members...)
+		}
+	}
+	return grantees
+}
+
+// GenerateObservation produces a RowAccessPolicyObservation from the supplied
+// RowAccessPolicy.
+func GenerateObservation(in bigquery.RowAccessPolicy) v1alpha1.RowAccessPolicyObservation {
+	o := v1alpha1.RowAccessPolicyObservation{
+		CreationTime:     in.CreationTime,
+		LastModifiedTime: in.LastModifiedTime,
+	}
+	if r := in.RowAccessPolicyReference; r != nil {
+		o.Name = fmt.Sprintf("projects/%s/datasets/%s/tables/%s/rowAccessPolicies/%s", r.ProjectId, r.DatasetId, r.TableId, r.PolicyId)
+	}
+	return o
+}
+
+// IsUpToDate returns true if the supplied row access policy and its grantees
+// match the supplied RowAccessPolicyParameters.
+func IsUpToDate(in v1alpha1.RowAccessPolicyParameters, observed bigquery.RowAccessPolicy, grantees []string) bool {
+	if strings.TrimSpace(in.FilterPredicate) != strings.TrimSpace(observed.FilterPredicate) {
+		return false
+	}
+	return cmp.Equal(in.Grantees, grantees, cmpopts.EquateEmpty(), cmpopts.SortSlices(func(a, b string) bool { return a < b }))
+}
+
+// Find returns the row access policy with the supplied ID from the supplied
+// list, or nil if there is none.
+func Find(policies []*bigquery.RowAccessPolicy, id string) *bigquery.RowAccessPolicy {
+	for _, p := range policies {
+		if p != nil && p.RowAccessPolicyReference != nil && p.RowAccessPolicyReference.PolicyId == id {
+			return p
+		}
+	}
+	return nil
+}
@@ -0,0 +1,150 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rowaccesspolicy
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	bigquery "google.golang.org/api/bigquery/v2"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/bigquery/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	testProject = "my-project"
+	testID      = "emea_only"
+)
+
+var params = v1alpha1.RowAccessPolicyParameters{
+	Dataset:         gcp.StringPtr("sales"),
+	Table:           "orders",
+	Grantees:        []string{"group:emea@example.com", `user:o"brien@example.com`},
+	FilterPredicate: "region = 'EMEA'",
+}
+
+func TestCreateStatement(t *testing.T) {
+	want := "CREATE OR REPLACE ROW ACCESS POLICY `emea_only` ON `my-project.sales.orders` " +
+		`GRANT TO ("group:emea@example.com", "user:o\"brien@example.com") FILTER USING (region = 'EMEA')`
+	if diff := cmp.Diff(want, CreateStatement(testProject, testID, params)); diff != "" {
+		t.Errorf("CreateStatement(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestDropStatement(t *testing.T) {
+	want := "DROP ROW ACCESS POLICY IF EXISTS `emea_only` ON `my-project.sales.orders`"
+	if diff := cmp.Diff(want, DropStatement(testProject, testID, params)); diff != "" {
+		t.Errorf("DropStatement(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestValidateIdentifiers(t *testing.T) {
+	cases := map[string]struct {
+		id   string
+		want error
+	}{
+		"Valid": {
+			id: testID,
+		},
+		"Backtick": {
+			id:   "x` ON t; DROP TABLE `y",
+			want: errors.Errorf(errInvalidIdentifierFmt, "x` ON t; DROP TABLE `y"),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateIdentifiers(testProject, tc.id, params)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("ValidateIdentifiers(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	type args struct {
+		observed bigquery.RowAccessPolicy
+		grantees []string
+	}
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"UpToDate": {
+			args: args{
+				observed: bigquery.RowAccessPolicy{FilterPredicate: "region = 'EMEA'"},
+				grantees: []string{`user:o"brien@example.com`, "group:emea@example.com"},
+			},
+			want: true,
+		},
+		"FilterChanged": {
+			args: args{
+				observed: bigquery.RowAccessPolicy{FilterPredicate: "region = 'APAC'"},
+				grantees: []string{"group:emea@example.com", `user:o"brien@example.com`},
+			},
+			want: false,
+		},
+		"GranteesChanged": {
+			args: args{
+				observed: bigquery.RowAccessPolicy{FilterPredicate: "region = 'EMEA'"},
+				grantees: []string{"group:emea@example.com"},
+			},
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsUpToDate(params, tc.args.observed, tc.args.grantees)); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateObservation(t *testing.T) {
+	in := bigquery.RowAccessPolicy{
+		CreationTime: "2023-01-01T00:00:00Z",
+		RowAccessPolicyReference: &bigquery.RowAccessPolicyReference{
+			ProjectId: testProject,
+			DatasetId: "sales",
+			TableId:   "orders",
+			PolicyId:  testID,
+		},
+	}
+	want := v1alpha1.RowAccessPolicyObservation{
+		Name:         "projects/my-project/datasets/sales/tables/orders/rowAccessPolicies/emea_only",
+		CreationTime: "2023-01-01T00:00:00Z",
+	}
+	if diff := cmp.Diff(want, GenerateObservation(in)); diff != "" {
+		t.Errorf("GenerateObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGrantees(t *testing.T) {
+	p := &bigquery.Policy{Bindings: []*bigquery.Binding{
+		{Role: RoleFilteredDataViewer, Members: []string{"group:emea@example.com"}},
+		{Role: "roles/bigquery.admin", Members: []string{"user:admin@example.com"}},
+	}}
+	want := []string{"group:emea@example.com"}
+	if diff := cmp.Diff(want, Grantees(p)); diff != "" {
+		t.Errorf("Grantees(...): -want, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,159 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"sync"
+	"time"
+
+	"golang.org/x/oauth2/google"
+	"google.golang.org/api/option"
+	secretmanager "google.golang.org/api/secretmanager/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
+)
+
+const (
+	errNoSecretManagerRef  = "secretManagerRef must be set when the credentials source is SecretManager"
+	errNewSecretManager    = "cannot create new Secret Manager client"
+	errAccessSecretVersion = "cannot access Secret Manager secret version"
+	errDecodeSecretPayload = "cannot decode Secret Manager secret payload"
+	secretVersionNameFmt   = "projects/%s/secrets/%s/versions/%s"
+	defaultSecretVersion   = "latest"
+	secretVersionTTL       = 5 * time.Minute
+)
+
+// secretVersions caches the credentials read from Secret Manager, so that
+// every managed resource using a ProviderConfig does not access the secret
+// each time it is reconciled. A rotated latest version is picked up once
+// its cached value expires.
+var secretVersions = newSecretVersionCache(secretVersionTTL)
+
+type secretVersion struct {
+	mu      sync.Mutex
+	data    []byte
+	fetched time.Time
+}
+
+// A secretVersionCache serves the payloads of Secret Manager secret versions
+// until they are older than its TTL.
+type secretVersionCache struct {
+	ttl time.Duration
+	now func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]*secretVersion
+}
+
+func newSecretVersionCache(ttl time.Duration) *secretVersionCache {
+	return &secretVersionCache{ttl: ttl, now: time.Now, entries: map[string]*secretVersion{}}
+}
+
+// entry returns the cache entry of the named secret version. Expired entries
+// of other secret versions are evicted meanwhile, so that the cache does not
+// grow with every secret version that was ever used.
+func (c *secretVersionCache) entry(name string) *secretVersion {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := c.now()
+	for n, e := range c.entries {
+		// Entries that are being read are about to be refreshed.
+		if n == name || !e.mu.TryLock() {
+			continue
+		}
+		if now.Sub(e.fetched) >= c.ttl {
+			delete(c.entries, n)
+		}
+		e.mu.Unlock()
+	}
+	e, ok := c.entries[name]
+	if !ok {
+		e = &secretVersion{}
+		c.entries[name] = e
+	}
+	return e
+}
+
+// Get returns the payload of the named secret version. It is read using the
+// supplied function if it was never read or was read longer than the TTL
+// ago. Concurrent callers wait for a single read of the same secret version.
+// A payload that cannot be read is read again by the next caller.
+func (c *secretVersionCache) Get(ctx context.Context, name string, read func(ctx context.Context) ([]byte, error)) ([]byte, error) {
+	e := c.entry(name)
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if !e.fetched.IsZero() && c.now().Sub(e.fetched) < c.ttl {
+		return e.data, nil
+	}
+	data, err := read(ctx)
+	if err != nil {
+		return nil, err
+	}
+	e.data, e.fetched = data, c.now()
+	return data, nil
+}
+
+// SecretVersionName returns the resource name of the secret version selected
+// by the supplied selector. The project defaults to the supplied project.
+func SecretVersionName(sel v1beta1.SecretManagerSelector, defaultProject string) string {
+	project, version := sel.Project, sel.Version
+	if project == "" {
+		project = defaultProject
+	}
+	if version == "" {
+		version = defaultSecretVersion
+	}
+	return fmt.Sprintf(secretVersionNameFmt, project, sel.Secret, version)
+}
+
+// AccessSecretVersion reads the payload of the named secret version from
+// Secret Manager.
+func AccessSecretVersion(ctx context.Context, name string, opts ...option.ClientOption) ([]byte, error) {
+	s, err := secretmanager.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewSecretManager)
+	}
+	rsp, err := s.Projects.Secrets.Versions.Access(name).Context(ctx).Do()
+	if err != nil {
+		return nil, errors.Wrap(err, errAccessSecretVersion)
+	}
+	if rsp.Payload == nil {
+		return nil, nil
+	}
+	data, err := base64.StdEncoding.DecodeString(rsp.Payload.Data)
+	return data, errors.Wrap(err, errDecodeSecretPayload)
+}
+
+// secretManagerCredentials returns the credentials stored in the secret
+// version the supplied selector selects. The project defaults to the
+// supplied project. The secret is read using the provider's ambient
+// credentials if it is not cached.
+func secretManagerCredentials(ctx context.Context, sel v1beta1.SecretManagerSelector, project string) ([]byte, error) {
+	name := SecretVersionName(sel, project)
+	return secretVersions.Get(ctx, name, func(ctx context.Context) ([]byte, error) {
+		ts, err := google.DefaultTokenSource(ctx, scopeCloudPlatform)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot get application default credentials token")
+		}
+		return AccessSecretVersion(ctx, name, option.WithTokenSource(ts))
+	})
+}
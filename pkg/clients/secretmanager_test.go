@@ -0,0 +1,211 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/option"
+	secretmanager "google.golang.org/api/secretmanager/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
+)
+
+func TestSecretVersionName(t *testing.T) {
+	cases := map[string]struct {
+		sel  v1beta1.SecretManagerSelector
+		want string
+	}{
+		"Defaults": {
+			sel:  v1beta1.SecretManagerSelector{Secret: "creds"},
+			want: "projects/default-project/secrets/creds/versions/latest",
+		},
+		"Explicit": {
+			sel:  v1beta1.SecretManagerSelector{Project: "vault", Secret: "creds", Version: "3"},
+			want: "projects/vault/secrets/creds/versions/3",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, SecretVersionName(tc.sel, "default-project")); diff != "" {
+				t.Errorf("SecretVersionName(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAccessSecretVersion(t *testing.T) {
+	name := "projects/vault/secrets/creds/versions/latest"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/"+name+":access" {
+			http.NotFound(w, r)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(&secretmanager.AccessSecretVersionResponse{
+			Name:    name,
+			Payload: &secretmanager.SecretPayload{Data: "eyJ0eXBlIjoic2VydmljZV9hY2NvdW50In0="},
+		})
+	}))
+	defer server.Close()
+
+	got, err := AccessSecretVersion(context.Background(), name, option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	if err != nil {
+		t.Fatalf("AccessSecretVersion(...): unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(`{"type":"service_account"}`, string(got)); diff != "" {
+		t.Errorf("AccessSecretVersion(...): -want, +got:\n%s", diff)
+	}
+
+	if _, err := AccessSecretVersion(context.Background(), "projects/vault/secrets/missing/versions/1", option.WithEndpoint(server.URL), option.WithoutAuthentication()); !IsErrorNotFound(err) {
+		t.Errorf("AccessSecretVersion(...): want not found error, got %v", err)
+	}
+}
+
+func TestSecretVersionCacheGet(t *testing.T) {
+	const name = "projects/vault/secrets/creds/versions/latest"
+	errBoom := errors.New("boom")
+	now := time.Now()
+
+	type want struct {
+		data  string
+		err   error
+		reads int
+	}
+	cases := map[string]struct {
+		reason string
+		prime  bool
+		name   string
+		at     time.Time
+		err    error
+		want   want
+	}{
+		"FirstRead": {
+			reason: "A secret version that was never read should be read.",
+			name:   name,
+			at:     now,
+			want:   want{data: "1", reads: 1},
+		},
+		"Cached": {
+			reason: "A secret version should not be read again within the TTL.",
+			prime:  true,
+			name:   name,
+			at:     now.Add(30 * time.Second),
+			want:   want{data: "1", reads: 1},
+		},
+		"OtherVersion": {
+			reason: "Each secret version should be read separately.",
+			prime:  true,
+			name:   "projects/vault/secrets/creds/versions/3",
+			at:     now.Add(30 * time.Second),
+			want:   want{data: "2", reads: 2},
+		},
+		"Expired": {
+			reason: "A secret version should be read again once the TTL passed.",
+			prime:  true,
+			name:   name,
+			at:     now.Add(2 * time.Minute),
+			want:   want{data: "2", reads: 2},
+		},
+		"ReadFailed": {
+			reason: "Errors reading the secret version should be returned.",
+			name:   name,
+			at:     now,
+			err:    errBoom,
+			want:   want{err: errBoom, reads: 1},
+		},
+	}
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			c := newSecretVersionCache(time.Minute)
+			reads := 0
+			read := func(_ context.Context) ([]byte, error) {
+				reads++
+				if tc.err != nil {
+					return nil, tc.err
+				}
+				return []byte(strconv.Itoa(reads)), nil
+			}
+			c.now = func() time.Time { return now }
+			if tc.prime {
+				_, _ = c.Get(context.Background(), name, read)
+			}
+			c.now = func() time.Time { return tc.at }
+			data, err := c.Get(context.Background(), tc.name, read)
+			if diff := cmp.Diff(tc.want, want{data: string(data), err: err, reads: reads}, test.EquateErrors(), cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("\n%s\nGet(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestSecretVersionCacheEvict(t *testing.T) {
+	now := time.Now()
+	c := newSecretVersionCache(time.Minute)
+	c.now = func() time.Time { return now }
+	read := func(_ context.Context) ([]byte, error) { return []byte("creds"), nil }
+	_, _ = c.Get(context.Background(), "projects/vault/secrets/old/versions/1", read)
+
+	c.now = func() time.Time { return now.Add(2 * time.Minute) }
+	_, _ = c.Get(context.Background(), "projects/vault/secrets/new/versions/1", read)
+
+	want := []string{"projects/vault/secrets/new/versions/1"}
+	got := make([]string, 0, len(c.entries))
+	for n := range c.entries {
+		got = append(got, n)
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Get(...): expired secret versions should be evicted: -want, +got:\n%s", diff)
+	}
+}
+
+func TestSecretVersionCacheConcurrentReads(t *testing.T) {
+	const name = "projects/vault/secrets/creds/versions/latest"
+	c := newSecretVersionCache(time.Minute)
+	var reads int32
+	read := func(_ context.Context) ([]byte, error) {
+		atomic.AddInt32(&reads, 1)
+		time.Sleep(10 * time.Millisecond)
+		return []byte("creds"), nil
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := c.Get(context.Background(), name, read); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+	if diff := cmp.Diff(int32(1), atomic.LoadInt32(&reads)); diff != "" {
+		t.Errorf("Get(...): concurrent callers should wait for a single read: -want reads, +got reads:\n%s", diff)
+	}
+}
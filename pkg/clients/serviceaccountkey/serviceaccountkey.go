@@ -30,6 +30,7 @@ type Client interface {
 	Create(name string, createserviceaccountkeyrequest *iam.CreateServiceAccountKeyRequest) *iam.ProjectsServiceAccountsKeysCreateCall
 	Delete(name string) *iam.ProjectsServiceAccountsKeysDeleteCall
 	Get(name string) *iam.ProjectsServiceAccountsKeysGetCall
+	List(name string) *iam.ProjectsServiceAccountsKeysListCall
 }
 
 // ParseKeyIDFromRrn parses key id from Google Cloud API relative resource name (resource path) of
@@ -0,0 +1,102 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package serviceattachment
+
+import (
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/mitchellh/copystructure"
+	"github.com/pkg/errors"
+	compute "google.golang.org/api/compute/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const errCheckUpToDate = "unable to determine if external resource is up to date"
+
+// GenerateServiceAttachment takes a *ServiceAttachmentParameters and fills
+// *compute.ServiceAttachment. It assigns only the fields that are writable,
+// i.e. not labelled as [Output Only] in Google's reference.
+func GenerateServiceAttachment(name string, in v1alpha1.ServiceAttachmentParameters, sa *compute.ServiceAttachment) {
+	sa.Name = name
+	sa.Description = gcp.StringValue(in.Description)
+	sa.TargetService = gcp.StringValue(in.TargetService)
+	sa.ConnectionPreference = in.ConnectionPreference
+	sa.NatSubnets = in.NatSubnets
+	sa.EnableProxyProtocol = gcp.BoolValue(in.EnableProxyProtocol)
+	sa.ConsumerRejectLists = in.ConsumerRejectLists
+	sa.DomainNames = in.DomainNames
+
+	sa.ConsumerAcceptLists = nil
+	for _, l := range in.ConsumerAcceptLists {
+		sa.ConsumerAcceptLists = append(sa.ConsumerAcceptLists, &compute.ServiceAttachmentConsumerProjectLimit{
+			ProjectIdOrNum:  l.ProjectIDOrNum,
+			ConnectionLimit: l.ConnectionLimit,
+		})
+	}
+
+	// Emptied lists must be sent explicitly, otherwise a patch leaves the
+	// previous consumers in place.
+	sa.ForceSendFields = []string{"ConsumerAcceptLists", "ConsumerRejectLists"}
+}
+
+// GenerateServiceAttachmentObservation takes a compute.ServiceAttachment and
+// returns *ServiceAttachmentObservation.
+func GenerateServiceAttachmentObservation(in compute.ServiceAttachment) v1alpha1.ServiceAttachmentObservation {
+	o := v1alpha1.ServiceAttachmentObservation{
+		CreationTimestamp: in.CreationTimestamp,
+		Fingerprint:       in.Fingerprint,
+		ID:                in.Id,
+		SelfLink:          in.SelfLink,
+	}
+	for _, e := range in.ConnectedEndpoints {
+		if e == nil {
+			continue
+		}
+		o.ConnectedEndpoints = append(o.ConnectedEndpoints, v1alpha1.ServiceAttachmentConnectedEndpoint{
+			Endpoint:        e.Endpoint,
+			PscConnectionID: e.PscConnectionId,
+			Status:          e.Status,
+		})
+	}
+	return o
+}
+
+// LateInitializeSpec fills unassigned fields with the values in
+// compute.ServiceAttachment object.
+func LateInitializeSpec(spec *v1alpha1.ServiceAttachmentParameters, in compute.ServiceAttachment) {
+	spec.Description = gcp.LateInitializeString(spec.Description, in.Description)
+	spec.TargetService = gcp.LateInitializeString(spec.TargetService, in.TargetService)
+	spec.EnableProxyProtocol = gcp.LateInitializeBool(spec.EnableProxyProtocol, in.EnableProxyProtocol)
+	spec.DomainNames = gcp.LateInitializeStringSlice(spec.DomainNames, in.DomainNames)
+}
+
+// IsUpToDate checks whether current state is up-to-date compared to the given
+// set of parameters.
+func IsUpToDate(name string, in *v1alpha1.ServiceAttachmentParameters, observed *compute.ServiceAttachment) (bool, error) {
+	generated, err := copystructure.Copy(observed)
+	if err != nil {
+		return true, errors.Wrap(err, errCheckUpToDate)
+	}
+	desired, ok := generated.(*compute.ServiceAttachment)
+	if !ok {
+		return true, errors.New(errCheckUpToDate)
+	}
+	GenerateServiceAttachment(name, *in, desired)
+	return cmp.Equal(desired, observed, cmpopts.EquateEmpty(), gcp.EquateComputeURLs(), cmpopts.IgnoreFields(compute.ServiceAttachment{}, "ForceSendFields")), nil
+}
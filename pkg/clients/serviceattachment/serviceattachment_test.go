@@ -0,0 +1,230 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package serviceattachment
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/compute/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+)
+
+const (
+	testName              = "some-name"
+	testCreationTimestamp = "10/10/2023"
+	testSelfLink          = "/link/to/self"
+	testRegion            = "us-west1"
+	testFingerprint       = "abc="
+)
+
+var (
+	testDescription   = "some desc"
+	testTargetService = "projects/test/regions/us-west1/forwardingRules/ilb"
+	testNatSubnet     = "projects/test/regions/us-west1/subnetworks/psc"
+)
+
+func params(m ...func(*v1alpha1.ServiceAttachmentParameters)) *v1alpha1.ServiceAttachmentParameters {
+	o := &v1alpha1.ServiceAttachmentParameters{
+		Description:          &testDescription,
+		Region:               testRegion,
+		TargetService:        &testTargetService,
+		ConnectionPreference: "ACCEPT_MANUAL",
+		NatSubnets:           []string{testNatSubnet},
+		ConsumerAcceptLists: []v1alpha1.ServiceAttachmentConsumerProjectLimit{
+			{ProjectIDOrNum: "consumer", ConnectionLimit: 10},
+		},
+	}
+
+	for _, f := range m {
+		f(o)
+	}
+
+	return o
+}
+
+func serviceAttachment(m ...func(*compute.ServiceAttachment)) *compute.ServiceAttachment {
+	o := &compute.ServiceAttachment{
+		Name:                 testName,
+		Description:          testDescription,
+		TargetService:        testTargetService,
+		ConnectionPreference: "ACCEPT_MANUAL",
+		NatSubnets:           []string{testNatSubnet},
+		ConsumerAcceptLists: []*compute.ServiceAttachmentConsumerProjectLimit{
+			{ProjectIdOrNum: "consumer", ConnectionLimit: 10},
+		},
+		ForceSendFields: []string{"ConsumerAcceptLists", "ConsumerRejectLists"},
+	}
+
+	for _, f := range m {
+		f(o)
+	}
+
+	return o
+}
+
+func addOutputFields(sa *compute.ServiceAttachment) {
+	sa.CreationTimestamp = testCreationTimestamp
+	sa.Fingerprint = testFingerprint
+	sa.Id = 2029819203
+	sa.SelfLink = testSelfLink
+	sa.ConnectedEndpoints = []*compute.ServiceAttachmentConnectedEndpoint{
+		{Endpoint: "projects/consumer/regions/us-west1/forwardingRules/ep", PscConnectionId: 42, Status: "ACCEPTED"},
+	}
+}
+
+func TestGenerateServiceAttachment(t *testing.T) {
+	type args struct {
+		name string
+		in   v1alpha1.ServiceAttachmentParameters
+	}
+	cases := map[string]struct {
+		args args
+		want *compute.ServiceAttachment
+	}{
+		"AllFilled": {
+			args: args{
+				name: testName,
+				in:   *params(),
+			},
+			want: serviceAttachment(),
+		},
+		"AcceptAutomatic": {
+			args: args{
+				name: testName,
+				in: *params(func(p *v1alpha1.ServiceAttachmentParameters) {
+					p.ConnectionPreference = "ACCEPT_AUTOMATIC"
+					p.ConsumerAcceptLists = nil
+				}),
+			},
+			want: serviceAttachment(func(sa *compute.ServiceAttachment) {
+				sa.ConnectionPreference = "ACCEPT_AUTOMATIC"
+				sa.ConsumerAcceptLists = nil
+			}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			sa := &compute.ServiceAttachment{}
+			GenerateServiceAttachment(tc.args.name, tc.args.in, sa)
+			if diff := cmp.Diff(tc.want, sa); diff != "" {
+				t.Errorf("GenerateServiceAttachment(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateServiceAttachmentObservation(t *testing.T) {
+	cases := map[string]struct {
+		in  compute.ServiceAttachment
+		out v1alpha1.ServiceAttachmentObservation
+	}{
+		"AllFilled": {
+			in: *serviceAttachment(addOutputFields),
+			out: v1alpha1.ServiceAttachmentObservation{
+				CreationTimestamp: testCreationTimestamp,
+				Fingerprint:       testFingerprint,
+				ID:                2029819203,
+				SelfLink:          testSelfLink,
+				ConnectedEndpoints: []v1alpha1.ServiceAttachmentConnectedEndpoint{
+					{Endpoint: "projects/consumer/regions/us-west1/forwardingRules/ep", PscConnectionID: 42, Status: "ACCEPTED"},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			o := GenerateServiceAttachmentObservation(tc.in)
+			if diff := cmp.Diff(tc.out, o); diff != "" {
+				t.Errorf("GenerateServiceAttachmentObservation(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	type args struct {
+		in      *v1alpha1.ServiceAttachmentParameters
+		current *compute.ServiceAttachment
+	}
+	type want struct {
+		upToDate bool
+		isErr    bool
+	}
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"UpToDate": {
+			args: args{
+				in:      params(),
+				current: serviceAttachment(),
+			},
+			want: want{upToDate: true, isErr: false},
+		},
+		"UpToDateWithOutputFields": {
+			args: args{
+				in:      params(),
+				current: serviceAttachment(addOutputFields),
+			},
+			want: want{upToDate: true, isErr: false},
+		},
+		"UpToDateFullyQualifiedURLs": {
+			args: args{
+				in: params(),
+				current: serviceAttachment(func(sa *compute.ServiceAttachment) {
+					sa.TargetService = "https://www.googleapis.com/compute/v1/" + testTargetService
+					sa.NatSubnets = []string{"https://www.googleapis.com/compute/v1/" + testNatSubnet}
+				}),
+			},
+			want: want{upToDate: true, isErr: false},
+		},
+		"NotUpToDateAcceptList": {
+			args: args{
+				in: params(func(p *v1alpha1.ServiceAttachmentParameters) {
+					p.ConsumerAcceptLists[0].ConnectionLimit = 20
+				}),
+				current: serviceAttachment(),
+			},
+			want: want{upToDate: false, isErr: false},
+		},
+		"NotUpToDateRejectList": {
+			args: args{
+				in: params(func(p *v1alpha1.ServiceAttachmentParameters) {
+					p.ConsumerRejectLists = []string{"intruder"}
+				}),
+				current: serviceAttachment(),
+			},
+			want: want{upToDate: false, isErr: false},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			u, err := IsUpToDate(testName, tc.args.in, tc.args.current)
+			if err != nil && !tc.want.isErr {
+				t.Error("IsUpToDate(...) unexpected error")
+			}
+			if diff := cmp.Diff(tc.want.upToDate, u); diff != "" {
+				t.Errorf("IsUpToDate(...) UpToDate: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
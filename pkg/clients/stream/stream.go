@@ -0,0 +1,255 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stream
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	datastream "google.golang.org/api/datastream/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/datastream/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	parentFormat = "projects/%s/locations/%s"
+	nameFormat   = "projects/%s/locations/%s/streams/%s"
+)
+
+// GetParent returns the location the Stream lives under.
+func GetParent(projectID, location string) string {
+	return fmt.Sprintf(parentFormat, projectID, location)
+}
+
+// GetFullyQualifiedName builds the relative resource name of the Stream.
+func GetFullyQualifiedName(projectID, location, name string) string {
+	return fmt.Sprintf(nameFormat, projectID, location, name)
+}
+
+// GenerateStream produces a Stream that is configured via given
+// StreamParameters. The desired state is not part of the generated Stream,
+// since streams are always created without being started; it is only sent
+// when updating the state of a Stream.
+func GenerateStream(name string, p v1alpha1.StreamParameters) *datastream.Stream {
+	s := &datastream.Stream{
+		Name:                         name,
+		DisplayName:                  p.DisplayName,
+		Labels:                       p.Labels,
+		CustomerManagedEncryptionKey: gcp.StringValue(p.CustomerManagedEncryptionKey),
+		SourceConfig: &datastream.SourceConfig{
+			SourceConnectionProfile: gcp.StringValue(p.SourceConfig.SourceConnectionProfile),
+		},
+		DestinationConfig: &datastream.DestinationConfig{
+			DestinationConnectionProfile: gcp.StringValue(p.DestinationConfig.DestinationConnectionProfile),
+		},
+	}
+	if c := p.SourceConfig.MySQLSourceConfig; c != nil {
+		s.SourceConfig.MysqlSourceConfig = &datastream.MysqlSourceConfig{
+			IncludeObjects:        generateMysqlRdbms(c.IncludeObjects),
+			ExcludeObjects:        generateMysqlRdbms(c.ExcludeObjects),
+			MaxConcurrentCdcTasks: gcp.Int64Value(c.MaxConcurrentCdcTasks),
+		}
+	}
+	if c := p.SourceConfig.PostgreSQLSourceConfig; c != nil {
+		s.SourceConfig.PostgresqlSourceConfig = &datastream.PostgresqlSourceConfig{
+			IncludeObjects:  generatePostgresqlRdbms(c.IncludeObjects),
+			ExcludeObjects:  generatePostgresqlRdbms(c.ExcludeObjects),
+			Publication:     c.Publication,
+			ReplicationSlot: c.ReplicationSlot,
+		}
+	}
+	if c := p.DestinationConfig.GCSDestinationConfig; c != nil {
+		s.DestinationConfig.GcsDestinationConfig = &datastream.GcsDestinationConfig{
+			Path:                 gcp.StringValue(c.Path),
+			FileRotationMb:       gcp.Int64Value(c.FileRotationMb),
+			FileRotationInterval: gcp.StringValue(c.FileRotationInterval),
+		}
+		if c.JSONFileFormat != nil {
+			s.DestinationConfig.GcsDestinationConfig.JsonFileFormat = &datastream.JsonFileFormat{
+				Compression:      gcp.StringValue(c.JSONFileFormat.Compression),
+				SchemaFileFormat: gcp.StringValue(c.JSONFileFormat.SchemaFileFormat),
+			}
+		}
+		if c.AvroFileFormat != nil {
+			s.DestinationConfig.GcsDestinationConfig.AvroFileFormat = &datastream.AvroFileFormat{}
+		}
+	}
+	if c := p.DestinationConfig.BigQueryDestinationConfig; c != nil {
+		s.DestinationConfig.BigqueryDestinationConfig = &datastream.BigQueryDestinationConfig{
+			DataFreshness: gcp.StringValue(c.DataFreshness),
+		}
+		if c.SingleTargetDataset != nil {
+			s.DestinationConfig.BigqueryDestinationConfig.SingleTargetDataset = &datastream.SingleTargetDataset{
+				DatasetId: c.SingleTargetDataset.DatasetID,
+			}
+		}
+		if c.SourceHierarchyDatasets != nil {
+			s.DestinationConfig.BigqueryDestinationConfig.SourceHierarchyDatasets = &datastream.SourceHierarchyDatasets{
+				DatasetTemplate: &datastream.DatasetTemplate{
+					Location:        c.SourceHierarchyDatasets.DatasetTemplate.Location,
+					DatasetIdPrefix: gcp.StringValue(c.SourceHierarchyDatasets.DatasetTemplate.DatasetIDPrefix),
+					KmsKeyName:      gcp.StringValue(c.SourceHierarchyDatasets.DatasetTemplate.KMSKeyName),
+				},
+			}
+		}
+	}
+	if p.BackfillAll != nil {
+		s.BackfillAll = &datastream.BackfillAllStrategy{
+			MysqlExcludedObjects:      generateMysqlRdbms(p.BackfillAll.MySQLExcludedObjects),
+			PostgresqlExcludedObjects: generatePostgresqlRdbms(p.BackfillAll.PostgreSQLExcludedObjects),
+		}
+	}
+	if p.BackfillNone != nil {
+		s.BackfillNone = &datastream.BackfillNoneStrategy{}
+	}
+	return s
+}
+
+func generateMysqlRdbms(in *v1alpha1.MySQLRdbms) *datastream.MysqlRdbms {
+	if in == nil {
+		return nil
+	}
+	r := &datastream.MysqlRdbms{}
+	for _, db := range in.MySQLDatabases {
+		d := &datastream.MysqlDatabase{Database: db.Database}
+		for _, t := range db.MySQLTables {
+			d.MysqlTables = append(d.MysqlTables, &datastream.MysqlTable{Table: t.Table})
+		}
+		r.MysqlDatabases = append(r.MysqlDatabases, d)
+	}
+	return r
+}
+
+func generatePostgresqlRdbms(in *v1alpha1.PostgreSQLRdbms) *datastream.PostgresqlRdbms {
+	if in == nil {
+		return nil
+	}
+	r := &datastream.PostgresqlRdbms{}
+	for _, sc := range in.PostgreSQLSchemas {
+		s := &datastream.PostgresqlSchema{Schema: sc.Schema}
+		for _, t := range sc.PostgreSQLTables {
+			s.PostgresqlTables = append(s.PostgresqlTables, &datastream.PostgresqlTable{Table: t.Table})
+		}
+		r.PostgresqlSchemas = append(r.PostgresqlSchemas, s)
+	}
+	return r
+}
+
+// GenerateObservation produces a StreamObservation from the supplied Stream.
+func GenerateObservation(s datastream.Stream) v1alpha1.StreamObservation {
+	o := v1alpha1.StreamObservation{
+		Name:       s.Name,
+		State:      s.State,
+		CreateTime: s.CreateTime,
+		UpdateTime: s.UpdateTime,
+	}
+	for _, e := range s.Errors {
+		if e == nil {
+			continue
+		}
+		o.Errors = append(o.Errors, v1alpha1.StreamError{
+			Reason:    e.Reason,
+			Message:   e.Message,
+			ErrorTime: e.ErrorTime,
+			ErrorUUID: e.ErrorUuid,
+		})
+	}
+	return o
+}
+
+// LateInitialize fills the empty fields of StreamParameters if the
+// corresponding fields are given in Stream.
+func LateInitialize(p *v1alpha1.StreamParameters, s datastream.Stream) {
+	p.CustomerManagedEncryptionKey = gcp.LateInitializeString(p.CustomerManagedEncryptionKey, s.CustomerManagedEncryptionKey)
+	if p.BackfillAll == nil && p.BackfillNone == nil {
+		if s.BackfillNone != nil {
+			p.BackfillNone = &v1alpha1.BackfillNoneStrategy{}
+		}
+		if s.BackfillAll != nil && s.BackfillAll.MysqlExcludedObjects == nil && s.BackfillAll.PostgresqlExcludedObjects == nil {
+			p.BackfillAll = &v1alpha1.BackfillAllStrategy{}
+		}
+	}
+	if c := p.SourceConfig.MySQLSourceConfig; c != nil && s.SourceConfig != nil && s.SourceConfig.MysqlSourceConfig != nil {
+		c.MaxConcurrentCdcTasks = gcp.LateInitializeInt64(c.MaxConcurrentCdcTasks, s.SourceConfig.MysqlSourceConfig.MaxConcurrentCdcTasks)
+	}
+	if s.DestinationConfig == nil {
+		return
+	}
+	if c := p.DestinationConfig.GCSDestinationConfig; c != nil && s.DestinationConfig.GcsDestinationConfig != nil {
+		c.Path = gcp.LateInitializeString(c.Path, s.DestinationConfig.GcsDestinationConfig.Path)
+		c.FileRotationMb = gcp.LateInitializeInt64(c.FileRotationMb, s.DestinationConfig.GcsDestinationConfig.FileRotationMb)
+		c.FileRotationInterval = gcp.LateInitializeString(c.FileRotationInterval, s.DestinationConfig.GcsDestinationConfig.FileRotationInterval)
+	}
+	if c := p.DestinationConfig.BigQueryDestinationConfig; c != nil && s.DestinationConfig.BigqueryDestinationConfig != nil {
+		c.DataFreshness = gcp.LateInitializeString(c.DataFreshness, s.DestinationConfig.BigqueryDestinationConfig.DataFreshness)
+	}
+}
+
+// IsUpToDate checks whether Stream is configured with given StreamParameters.
+func IsUpToDate(p v1alpha1.StreamParameters, s datastream.Stream) bool {
+	return GenerateUpdateMask(p, s) == ""
+}
+
+// isStateUpToDate reports whether the Stream is in, or transitioning to, the
+// desired state of the supplied StreamParameters.
+func isStateUpToDate(p v1alpha1.StreamParameters, s datastream.Stream) bool {
+	switch gcp.StringValue(p.DesiredState) {
+	case "", s.State:
+		return true
+	case v1alpha1.StreamStateRunning:
+		return s.State == v1alpha1.StreamStateStarting
+	case v1alpha1.StreamStatePaused:
+		return s.State == v1alpha1.StreamStateDraining
+	}
+	return false
+}
+
+// GenerateUpdateMask returns the comma separated list of fields that differ
+// between StreamParameters and Stream. The state is part of the mask unless
+// the Stream is in, or transitioning to, the desired state.
+func GenerateUpdateMask(p v1alpha1.StreamParameters, s datastream.Stream) string {
+	desired := GenerateStream(s.Name, p)
+	mask := []string{}
+	if desired.DisplayName != s.DisplayName {
+		mask = append(mask, "displayName")
+	}
+	if !cmp.Equal(desired.Labels, s.Labels, cmpopts.EquateEmpty()) {
+		mask = append(mask, "labels")
+	}
+	if !cmp.Equal(desired.SourceConfig, s.SourceConfig, cmpopts.EquateEmpty()) {
+		mask = append(mask, "sourceConfig")
+	}
+	if !cmp.Equal(desired.DestinationConfig, s.DestinationConfig, cmpopts.EquateEmpty()) {
+		mask = append(mask, "destinationConfig")
+	}
+	// The API picks a backfill strategy if none was requested.
+	if p.BackfillAll != nil || p.BackfillNone != nil {
+		if !cmp.Equal(desired.BackfillAll, s.BackfillAll, cmpopts.EquateEmpty()) {
+			mask = append(mask, "backfillAll")
+		}
+		if !cmp.Equal(desired.BackfillNone, s.BackfillNone) {
+			mask = append(mask, "backfillNone")
+		}
+	}
+	if !isStateUpToDate(p, s) {
+		mask = append(mask, "state")
+	}
+	return strings.Join(mask, ",")
+}
@@ -0,0 +1,180 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stream
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	datastream "google.golang.org/api/datastream/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/datastream/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	testName           = "projects/foo/locations/us-central1/streams/orders"
+	sourceProfile      = "projects/foo/locations/us-central1/connectionProfiles/mysql"
+	destinationProfile = "projects/foo/locations/us-central1/connectionProfiles/gcs"
+)
+
+func params(m ...func(*v1alpha1.StreamParameters)) *v1alpha1.StreamParameters {
+	p := &v1alpha1.StreamParameters{
+		Location:    "us-central1",
+		DisplayName: "orders",
+		SourceConfig: v1alpha1.StreamSourceConfig{
+			SourceConnectionProfile: gcp.StringPtr(sourceProfile),
+			MySQLSourceConfig: &v1alpha1.MySQLSourceConfig{
+				IncludeObjects: &v1alpha1.MySQLRdbms{MySQLDatabases: []v1alpha1.MySQLDatabase{{
+					Database:    "shop",
+					MySQLTables: []v1alpha1.MySQLTable{{Table: "orders"}},
+				}}},
+			},
+		},
+		DestinationConfig: v1alpha1.StreamDestinationConfig{
+			DestinationConnectionProfile: gcp.StringPtr(destinationProfile),
+			GCSDestinationConfig: &v1alpha1.GCSDestinationConfig{
+				Path:           gcp.StringPtr("/orders"),
+				AvroFileFormat: &v1alpha1.AvroFileFormat{},
+			},
+		},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func stream(m ...func(*datastream.Stream)) *datastream.Stream {
+	s := &datastream.Stream{
+		Name:        testName,
+		DisplayName: "orders",
+		State:       v1alpha1.StreamStateNotStarted,
+		SourceConfig: &datastream.SourceConfig{
+			SourceConnectionProfile: sourceProfile,
+			MysqlSourceConfig: &datastream.MysqlSourceConfig{
+				IncludeObjects: &datastream.MysqlRdbms{MysqlDatabases: []*datastream.MysqlDatabase{{
+					Database:    "shop",
+					MysqlTables: []*datastream.MysqlTable{{Table: "orders"}},
+				}}},
+				MaxConcurrentCdcTasks: 5,
+			},
+		},
+		DestinationConfig: &datastream.DestinationConfig{
+			DestinationConnectionProfile: destinationProfile,
+			GcsDestinationConfig: &datastream.GcsDestinationConfig{
+				Path:                 "/orders",
+				FileRotationMb:       50,
+				FileRotationInterval: "900s",
+				AvroFileFormat:       &datastream.AvroFileFormat{},
+			},
+		},
+		BackfillAll: &datastream.BackfillAllStrategy{},
+	}
+	for _, f := range m {
+		f(s)
+	}
+	return s
+}
+
+func lateInitialized(p *v1alpha1.StreamParameters) {
+	p.SourceConfig.MySQLSourceConfig.MaxConcurrentCdcTasks = gcp.Int64Ptr(5)
+	p.DestinationConfig.GCSDestinationConfig.FileRotationMb = gcp.Int64Ptr(50)
+	p.DestinationConfig.GCSDestinationConfig.FileRotationInterval = gcp.StringPtr("900s")
+	p.BackfillAll = &v1alpha1.BackfillAllStrategy{}
+}
+
+func TestGenerateObservation(t *testing.T) {
+	s := stream(func(s *datastream.Stream) {
+		s.State = v1alpha1.StreamStateFailed
+		s.Errors = []*datastream.Error{{Reason: "BINLOG_DISABLED", Message: "binary logging is disabled", ErrorUuid: "abc"}}
+	})
+	want := v1alpha1.StreamObservation{
+		Name:   testName,
+		State:  v1alpha1.StreamStateFailed,
+		Errors: []v1alpha1.StreamError{{Reason: "BINLOG_DISABLED", Message: "binary logging is disabled", ErrorUUID: "abc"}},
+	}
+	if diff := cmp.Diff(want, GenerateObservation(*s)); diff != "" {
+		t.Errorf("GenerateObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitialize(t *testing.T) {
+	p := params()
+	LateInitialize(p, *stream())
+	if diff := cmp.Diff(params(lateInitialized), p); diff != "" {
+		t.Errorf("LateInitialize(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateUpdateMask(t *testing.T) {
+	cases := map[string]struct {
+		p    *v1alpha1.StreamParameters
+		s    *datastream.Stream
+		want string
+	}{
+		"UpToDate": {
+			p:    params(lateInitialized),
+			s:    stream(),
+			want: "",
+		},
+		"TableAdded": {
+			p: params(lateInitialized, func(p *v1alpha1.StreamParameters) {
+				db := &p.SourceConfig.MySQLSourceConfig.IncludeObjects.MySQLDatabases[0]
+				db.MySQLTables = append(db.MySQLTables, v1alpha1.MySQLTable{Table: "customers"})
+			}),
+			s:    stream(),
+			want: "sourceConfig",
+		},
+		"Start": {
+			p: params(lateInitialized, func(p *v1alpha1.StreamParameters) {
+				p.DesiredState = gcp.StringPtr(v1alpha1.StreamStateRunning)
+			}),
+			s:    stream(),
+			want: "state",
+		},
+		"Starting": {
+			p: params(lateInitialized, func(p *v1alpha1.StreamParameters) {
+				p.DesiredState = gcp.StringPtr(v1alpha1.StreamStateRunning)
+			}),
+			s:    stream(func(s *datastream.Stream) { s.State = v1alpha1.StreamStateStarting }),
+			want: "",
+		},
+		"BackfillNotRequested": {
+			p: params(lateInitialized, func(p *v1alpha1.StreamParameters) {
+				p.BackfillAll = nil
+			}),
+			s:    stream(),
+			want: "",
+		},
+		"BackfillDisabled": {
+			p: params(lateInitialized, func(p *v1alpha1.StreamParameters) {
+				p.BackfillAll = nil
+				p.BackfillNone = &v1alpha1.BackfillNoneStrategy{}
+			}),
+			s:    stream(),
+			want: "backfillAll,backfillNone",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, GenerateUpdateMask(*tc.p, *tc.s)); diff != "" {
+				t.Errorf("GenerateUpdateMask(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
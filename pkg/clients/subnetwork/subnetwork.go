@@ -26,6 +26,7 @@ import (
 
 	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1beta1"
 	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/immutable"
 )
 
 const errCheckUpToDate = "unable to determine if external resource is up to date"
@@ -39,7 +40,9 @@ func GenerateSubnetwork(name string, in v1beta1.SubnetworkParameters, subnet *co
 	subnet.IpCidrRange = in.IPCidrRange
 	subnet.Network = gcp.StringValue(in.Network)
 	subnet.PrivateIpGoogleAccess = gcp.BoolValue(in.PrivateIPGoogleAccess)
+	subnet.Purpose = gcp.StringValue(in.Purpose)
 	subnet.Region = in.Region
+	subnet.Role = gcp.StringValue(in.Role)
 
 	if len(in.SecondaryIPRanges) > 0 {
 		subnet.SecondaryIpRanges = make([]*compute.SubnetworkSecondaryRange, len(in.SecondaryIPRanges))
@@ -65,6 +68,7 @@ func GenerateSubnetworkForUpdate(s v1beta1.Subnetwork, name string) *compute.Sub
 		EnableFlowLogs:        gcp.BoolValue(s.Spec.ForProvider.EnableFlowLogs),
 		IpCidrRange:           s.Spec.ForProvider.IPCidrRange,
 		PrivateIpGoogleAccess: gcp.BoolValue(s.Spec.ForProvider.PrivateIPGoogleAccess),
+		Role:                  gcp.StringValue(s.Spec.ForProvider.Role),
 		Fingerprint:           s.Status.AtProvider.Fingerprint,
 	}
 	for _, val := range s.Spec.ForProvider.SecondaryIPRanges {
@@ -85,6 +89,7 @@ func GenerateSubnetworkObservation(in compute.Subnetwork) v1beta1.SubnetworkObse
 		GatewayAddress:    in.GatewayAddress,
 		ID:                in.Id,
 		SelfLink:          in.SelfLink,
+		State:             in.State,
 	}
 }
 
@@ -102,6 +107,8 @@ func LateInitializeSpec(spec *v1beta1.SubnetworkParameters, in compute.Subnetwor
 	spec.Description = gcp.LateInitializeString(spec.Description, in.Description)
 	spec.EnableFlowLogs = gcp.LateInitializeBool(spec.EnableFlowLogs, in.EnableFlowLogs)
 	spec.PrivateIPGoogleAccess = gcp.LateInitializeBool(spec.PrivateIPGoogleAccess, in.PrivateIpGoogleAccess)
+	spec.Purpose = gcp.LateInitializeString(spec.Purpose, in.Purpose)
+	spec.Role = gcp.LateInitializeString(spec.Role, in.Role)
 	if len(in.SecondaryIpRanges) != 0 && len(spec.SecondaryIPRanges) == 0 {
 		spec.SecondaryIPRanges = make([]*v1beta1.SubnetworkSecondaryRange, len(in.SecondaryIpRanges))
 		for i, r := range in.SecondaryIpRanges {
@@ -132,6 +139,18 @@ func IsUpToDate(name string, in *v1beta1.SubnetworkParameters, observed *compute
 	return cmp.Equal(desired, observed, cmpopts.EquateEmpty(), gcp.EquateComputeURLs(), equateSecondaryRanges()), false, nil
 }
 
+// ImmutableFieldsChanged returns the names of the fields of the supplied
+// SubnetworkParameters that can only be set when a subnetwork is created and
+// that differ from the observed subnetwork.
+func ImmutableFieldsChanged(in *v1beta1.SubnetworkParameters, observed *compute.Subnetwork) []string {
+	return immutable.Changed(
+		immutable.Field{Path: "ipCidrRange", Desired: in.IPCidrRange, Observed: observed.IpCidrRange},
+		immutable.Field{Path: "network", Desired: in.Network, Observed: observed.Network, Options: []cmp.Option{gcp.EquateComputeURLs()}},
+		immutable.Field{Path: "description", Desired: in.Description, Observed: observed.Description},
+		immutable.Field{Path: "purpose", Desired: in.Purpose, Observed: observed.Purpose},
+	)
+}
+
 // Two compute.Subnetworks with differently ordered but otherwise identical
 // arrays of secondary ranges should be considered equal.
 func equateSecondaryRanges() cmp.Option {
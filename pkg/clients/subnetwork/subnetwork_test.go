@@ -151,6 +151,25 @@ func TestGenerateSubnetwork(t *testing.T) {
 				s.SecondaryIpRanges = nil
 			}),
 		},
+		"ProxyOnly": {
+			args: args{
+				name: testName,
+				in: *params(func(p *v1beta1.SubnetworkParameters) {
+					p.EnableFlowLogs = nil
+					p.PrivateIPGoogleAccess = nil
+					p.SecondaryIPRanges = nil
+					p.Purpose = gcp.StringPtr("REGIONAL_MANAGED_PROXY")
+					p.Role = gcp.StringPtr("ACTIVE")
+				}),
+			},
+			want: subnetwork(func(s *compute.Subnetwork) {
+				s.EnableFlowLogs = false
+				s.PrivateIpGoogleAccess = false
+				s.SecondaryIpRanges = nil
+				s.Purpose = "REGIONAL_MANAGED_PROXY"
+				s.Role = "ACTIVE"
+			}),
+		},
 	}
 
 	for name, tc := range cases {
@@ -292,6 +311,20 @@ func TestIsUpToDate(t *testing.T) {
 			},
 			want: want{upToDate: false, privAcc: true},
 		},
+		"NotUpToDateRole": {
+			args: args{
+				name: testName,
+				in: params(func(p *v1beta1.SubnetworkParameters) {
+					p.Purpose = gcp.StringPtr("REGIONAL_MANAGED_PROXY")
+					p.Role = gcp.StringPtr("ACTIVE")
+				}),
+				current: subnetwork(func(s *compute.Subnetwork) {
+					s.Purpose = "REGIONAL_MANAGED_PROXY"
+					s.Role = "BACKUP"
+				}),
+			},
+			want: want{upToDate: false, privAcc: false},
+		},
 	}
 
 	for name, tc := range cases {
@@ -309,3 +342,44 @@ func TestIsUpToDate(t *testing.T) {
 		})
 	}
 }
+
+func TestImmutableFieldsChanged(t *testing.T) {
+	cases := map[string]struct {
+		in      *v1beta1.SubnetworkParameters
+		current *compute.Subnetwork
+		want    []string
+	}{
+		"Unchanged": {
+			in:      params(),
+			current: subnetwork(),
+			want:    []string{},
+		},
+		"MutableFieldChanged": {
+			in: params(),
+			current: subnetwork(func(s *compute.Subnetwork) {
+				s.PrivateIpGoogleAccess = false
+			}),
+			want: []string{},
+		},
+		"Changed": {
+			in: params(func(p *v1beta1.SubnetworkParameters) {
+				p.Purpose = gcp.StringPtr("PRIVATE")
+			}),
+			current: subnetwork(func(s *compute.Subnetwork) {
+				s.IpCidrRange = "10.128.0.0/9"
+				s.Network = v1beta1.ComputeURIPrefix + "other-network"
+				s.Purpose = "PRIVATE_SERVICE_CONNECT"
+			}),
+			want: []string{"ipCidrRange", "network", "purpose"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := ImmutableFieldsChanged(tc.in, tc.current)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("ImmutableFieldsChanged(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -19,11 +19,14 @@ package subscription
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/crossplane-contrib/provider-gcp/apis/pubsub/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
 	"github.com/crossplane-contrib/provider-gcp/pkg/clients/topic"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	pubsub "google.golang.org/api/pubsub/v1"
 )
 
@@ -41,7 +44,7 @@ func GenerateSubscription(projectID, name string, p v1alpha1.SubscriptionParamet
 	s := &pubsub.Subscription{
 		AckDeadlineSeconds:       p.AckDeadlineSeconds,
 		Detached:                 p.Detached,
-		EnableMessageOrdering:    p.EnableMessageOrdering,
+		EnableMessageOrdering:    gcp.BoolValue(p.EnableMessageOrdering),
 		Filter:                   p.Filter,
 		Labels:                   p.Labels,
 		MessageRetentionDuration: p.MessageRetentionDuration,
@@ -86,6 +89,9 @@ func setPushConfig(p v1alpha1.SubscriptionParameters, s *pubsub.Subscription) {
 }
 
 // setExpirationPolicy sets ExpirationPolicy of subscription based on SubscriptionParameters.
+// A policy with an empty TTL is sent as an empty object, which Pub/Sub
+// interprets as "never expire", while a nil policy leaves the Pub/Sub default
+// of 31 days in place.
 func setExpirationPolicy(p v1alpha1.SubscriptionParameters, s *pubsub.Subscription) {
 	if p.ExpirationPolicy != nil {
 		s.ExpirationPolicy = &pubsub.ExpirationPolicy{
@@ -104,6 +110,15 @@ func setDeadLetterPolicy(projectID string, p v1alpha1.SubscriptionParameters, s
 	}
 }
 
+// GenerateObservation produces SubscriptionObservation object from
+// Subscription object.
+func GenerateObservation(s pubsub.Subscription) v1alpha1.SubscriptionObservation {
+	return v1alpha1.SubscriptionObservation{
+		Name:                          s.Name,
+		TopicMessageRetentionDuration: s.TopicMessageRetentionDuration,
+	}
+}
+
 // LateInitialize fills the empty fields of SubscriptionParameters if the corresponding
 // fields are given in Subscription.
 func LateInitialize(p *v1alpha1.SubscriptionParameters, s pubsub.Subscription) { // nolint:gocyclo
@@ -115,8 +130,11 @@ func LateInitialize(p *v1alpha1.SubscriptionParameters, s pubsub.Subscription) {
 		p.Detached = s.Detached
 	}
 
-	if !p.EnableMessageOrdering && s.EnableMessageOrdering {
-		p.EnableMessageOrdering = s.EnableMessageOrdering
+	// Message ordering cannot be changed once a subscription is created, so
+	// it is late-initialized even when it is disabled. The managed resource
+	// cannot enable it afterwards.
+	if p.EnableMessageOrdering == nil {
+		p.EnableMessageOrdering = gcp.BoolPtr(s.EnableMessageOrdering)
 	}
 
 	if p.Filter == "" && s.Filter != "" {
@@ -149,6 +167,9 @@ func LateInitialize(p *v1alpha1.SubscriptionParameters, s pubsub.Subscription) {
 		}
 	}
 
+	// An observed policy with an empty TTL is a subscription that never
+	// expires, so it is late-initialized as such rather than being dropped in
+	// favour of the 31 day default.
 	if p.ExpirationPolicy == nil && s.ExpirationPolicy != nil {
 		p.ExpirationPolicy = &v1alpha1.ExpirationPolicy{
 			TTL: s.ExpirationPolicy.Ttl,
@@ -189,7 +210,51 @@ func IsUpToDate(projectID string, p v1alpha1.SubscriptionParameters, s pubsub.Su
 		p.DeadLetterPolicy.DeadLetterTopic = topic.GetFullyQualifiedName(projectID, p.DeadLetterPolicy.DeadLetterTopic)
 	}
 
-	return cmp.Equal(observed, &p)
+	if !isSameDuration(p.MessageRetentionDuration, observed.MessageRetentionDuration) {
+		return false
+	}
+
+	if !isExpirationPolicyUpToDate(p.ExpirationPolicy, observed.ExpirationPolicy) {
+		return false
+	}
+
+	if gcp.BoolValue(p.EnableMessageOrdering) != gcp.BoolValue(observed.EnableMessageOrdering) {
+		return false
+	}
+
+	return cmp.Equal(observed, &p, cmpopts.IgnoreFields(v1alpha1.SubscriptionParameters{}, "EnableMessageOrdering", "ExpirationPolicy", "MessageRetentionDuration"))
+}
+
+// isExpirationPolicyUpToDate checks whether the desired expiration policy
+// matches the observed one. An unset desired policy accepts whatever Pub/Sub
+// reports, typically its 31 day default, whereas a desired policy with an
+// empty TTL only matches a subscription that never expires.
+func isExpirationPolicyUpToDate(p, observed *v1alpha1.ExpirationPolicy) bool {
+	if p == nil {
+		return true
+	}
+	if observed == nil {
+		return false
+	}
+	return isSameDuration(p.TTL, observed.TTL)
+}
+
+// isSameDuration compares two duration strings by value so that equivalent
+// representations such as "86400s" and "86400.000s" are considered equal.
+// An empty duration is only equal to another empty duration.
+func isSameDuration(a, b string) bool {
+	if a == "" || b == "" {
+		return a == b
+	}
+	// Both durations come either from Pub/Sub or from fields that are
+	// validated by kubebuilder Pattern markers, so parse errors are not
+	// expected here and fall back to a plain string comparison.
+	da, errA := time.ParseDuration(a)
+	db, errB := time.ParseDuration(b)
+	if errA != nil || errB != nil {
+		return a == b
+	}
+	return da == db
 }
 
 // GenerateUpdateRequest produces an UpdateSubscriptionRequest with the difference
@@ -225,7 +290,7 @@ func GenerateUpdateRequest(name string, p v1alpha1.SubscriptionParameters, s pub
 		us.Subscription.Labels = p.Labels
 	}
 
-	if !cmp.Equal(p.MessageRetentionDuration, observed.MessageRetentionDuration) {
+	if !isSameDuration(p.MessageRetentionDuration, observed.MessageRetentionDuration) {
 		mask = append(mask, "messageRetentionDuration")
 		us.Subscription.MessageRetentionDuration = p.MessageRetentionDuration
 	}
@@ -235,7 +300,7 @@ func GenerateUpdateRequest(name string, p v1alpha1.SubscriptionParameters, s pub
 		us.Subscription.RetainAckedMessages = p.RetainAckedMessages
 	}
 
-	if !cmp.Equal(p.ExpirationPolicy, observed.ExpirationPolicy) {
+	if !isExpirationPolicyUpToDate(p.ExpirationPolicy, observed.ExpirationPolicy) {
 		mask = append(mask, "expirationPolicy")
 		setExpirationPolicy(p, us.Subscription)
 	}
@@ -23,6 +23,7 @@ import (
 	pubsub "google.golang.org/api/pubsub/v1"
 
 	"github.com/crossplane-contrib/provider-gcp/apis/pubsub/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
 )
 
 const (
@@ -40,7 +41,7 @@ func params() *v1alpha1.SubscriptionParameters {
 			MaxDeliveryAttempts: 5,
 		},
 		Detached:                 true,
-		EnableMessageOrdering:    true,
+		EnableMessageOrdering:    gcp.BoolPtr(true),
 		ExpirationPolicy:         &v1alpha1.ExpirationPolicy{TTL: "1296000s"},
 		Filter:                   "foo",
 		Labels:                   map[string]string{"example": "true"},
@@ -125,6 +126,31 @@ func TestGenerateSubscription(t *testing.T) {
 	}
 }
 
+func TestGenerateObservation(t *testing.T) {
+	cases := map[string]struct {
+		in  pubsub.Subscription
+		out v1alpha1.SubscriptionObservation
+	}{
+		"Empty": {
+			in:  pubsub.Subscription{},
+			out: v1alpha1.SubscriptionObservation{},
+		},
+		"Full": {
+			in:  pubsub.Subscription{Name: name, TopicMessageRetentionDuration: "604800s"},
+			out: v1alpha1.SubscriptionObservation{Name: name, TopicMessageRetentionDuration: "604800s"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateObservation(tc.in)
+			if diff := cmp.Diff(tc.out, got); diff != "" {
+				t.Errorf("GenerateObservation(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestLateInitialize(t *testing.T) {
 	type args struct {
 		obs   pubsub.Subscription
@@ -144,7 +170,7 @@ func TestLateInitialize(t *testing.T) {
 						MaxDeliveryAttempts: 5,
 					},
 					Detached:                 true,
-					EnableMessageOrdering:    true,
+					EnableMessageOrdering:    gcp.BoolPtr(true),
 					ExpirationPolicy:         &v1alpha1.ExpirationPolicy{TTL: "1296000s"},
 					Filter:                   "foo",
 					Labels:                   map[string]string{"example": "true"},
@@ -167,6 +193,27 @@ func TestLateInitialize(t *testing.T) {
 			},
 			out: params(),
 		},
+		"NeverExpire": {
+			args: args{
+				obs: pubsub.Subscription{
+					ExpirationPolicy: &pubsub.ExpirationPolicy{},
+				},
+				param: &v1alpha1.SubscriptionParameters{},
+			},
+			out: &v1alpha1.SubscriptionParameters{
+				EnableMessageOrdering: gcp.BoolPtr(false),
+				ExpirationPolicy:      &v1alpha1.ExpirationPolicy{},
+			},
+		},
+		"NoExpirationPolicy": {
+			args: args{
+				obs:   pubsub.Subscription{},
+				param: &v1alpha1.SubscriptionParameters{},
+			},
+			out: &v1alpha1.SubscriptionParameters{
+				EnableMessageOrdering: gcp.BoolPtr(false),
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -204,6 +251,85 @@ func TestIsUpToDate(t *testing.T) {
 			},
 			result: true,
 		},
+		"UnsetMessageOrderingUpToDate": {
+			args: args{
+				obs: func() pubsub.Subscription {
+					s := subscription()
+					s.EnableMessageOrdering = false
+					return *s
+				}(),
+				param: func() v1alpha1.SubscriptionParameters {
+					p := params()
+					p.EnableMessageOrdering = nil
+					return *p
+				}(),
+			},
+			result: true,
+		},
+		"MessageOrderingNotUpToDate": {
+			args: args{
+				obs: func() pubsub.Subscription {
+					s := subscription()
+					s.EnableMessageOrdering = false
+					return *s
+				}(),
+				param: *params(),
+			},
+			result: false,
+		},
+		"UnsetExpirationPolicyUpToDate": {
+			args: args{
+				obs: func() pubsub.Subscription {
+					s := subscription()
+					s.ExpirationPolicy = &pubsub.ExpirationPolicy{Ttl: "2678400s"}
+					return *s
+				}(),
+				param: func() v1alpha1.SubscriptionParameters {
+					p := params()
+					p.ExpirationPolicy = nil
+					return *p
+				}(),
+			},
+			result: true,
+		},
+		"NeverExpireNotUpToDate": {
+			args: args{
+				obs: *subscription(),
+				param: func() v1alpha1.SubscriptionParameters {
+					p := params()
+					p.ExpirationPolicy = &v1alpha1.ExpirationPolicy{}
+					return *p
+				}(),
+			},
+			result: false,
+		},
+		"NeverExpireUpToDate": {
+			args: args{
+				obs: func() pubsub.Subscription {
+					s := subscription()
+					s.ExpirationPolicy = &pubsub.ExpirationPolicy{}
+					return *s
+				}(),
+				param: func() v1alpha1.SubscriptionParameters {
+					p := params()
+					p.ExpirationPolicy = &v1alpha1.ExpirationPolicy{}
+					return *p
+				}(),
+			},
+			result: true,
+		},
+		"EquivalentDurationsUpToDate": {
+			args: args{
+				obs: func() pubsub.Subscription {
+					s := subscription()
+					s.ExpirationPolicy = &pubsub.ExpirationPolicy{Ttl: "1296000.000s"}
+					s.MessageRetentionDuration = "864000.0s"
+					return *s
+				}(),
+				param: *params(),
+			},
+			result: true,
+		},
 	}
 
 	IsUpToDate(projectID, *params(), *subscription())
@@ -246,6 +372,40 @@ func TestGenerateUpdateRequest(t *testing.T) {
 				UpdateMask:   "ackDeadlineSeconds,detached,filter,labels,messageRetentionDuration,retainAckedMessages,expirationPolicy,pushConfig,retryPolicy",
 			},
 		},
+		"NeverExpire": {
+			args: args{
+				projectID: projectID,
+				name:      name,
+				obs:       *subscription(),
+				param: func() v1alpha1.SubscriptionParameters {
+					p := params()
+					p.ExpirationPolicy = &v1alpha1.ExpirationPolicy{}
+					return *p
+				}(),
+			},
+			result: &pubsub.UpdateSubscriptionRequest{
+				Subscription: &pubsub.Subscription{
+					Name:             name,
+					ExpirationPolicy: &pubsub.ExpirationPolicy{},
+				},
+				UpdateMask: "expirationPolicy",
+			},
+		},
+		"UnsetExpirationPolicy": {
+			args: args{
+				projectID: projectID,
+				name:      name,
+				obs:       *subscription(),
+				param: func() v1alpha1.SubscriptionParameters {
+					p := params()
+					p.ExpirationPolicy = nil
+					return *p
+				}(),
+			},
+			result: &pubsub.UpdateSubscriptionRequest{
+				Subscription: &pubsub.Subscription{Name: name},
+			},
+		},
 	}
 
 	for name, tc := range cases {
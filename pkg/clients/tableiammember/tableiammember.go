@@ -0,0 +1,80 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tableiammember
+
+import (
+	"fmt"
+
+	bigquery "google.golang.org/api/bigquery/v2"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/bigquery/v1alpha1"
+	iamv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/iam/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const tableResourceFormat = "projects/%s/datasets/%s/tables/%s"
+
+// TableResource returns the IAM resource name of the table of the supplied
+// TableIAMMemberParameters in the supplied project.
+func TableResource(project string, in v1alpha1.TableIAMMemberParameters) string {
+	return fmt.Sprintf(tableResourceFormat, project, gcp.StringValue(in.Dataset), in.Table)
+}
+
+// BindRoleToMember binds the role of the supplied TableIAMMemberParameters to
+// its member in the supplied policy. It returns true if the policy changed.
+func BindRoleToMember(in v1alpha1.TableIAMMemberParameters, p *bigquery.Policy) bool {
+	p.Version = iamv1alpha1.PolicyVersion
+	member := gcp.StringValue(in.Member)
+	for _, b := range p.Bindings {
+		if b.Role != in.Role || b.Condition != nil {
+			continue
+		}
+		for _, m := range b.Members {
+			if m == member {
+				return false
+			}
+		}
+		b.Members = append(b.Members, member)
+		return true
+	}
+	p.Bindings = append(p.Bindings, &bigquery.Binding{Role: in.Role, Members: []string{member}})
+	return true
+}
+
+// UnbindRoleFromMember removes the member of the supplied
+// TableIAMMemberParameters from the binding of its role in the supplied
+// policy. It returns true if the policy changed.
+func UnbindRoleFromMember(in v1alpha1.TableIAMMemberParameters, p *bigquery.Policy) bool {
+	member := gcp.StringValue(in.Member)
+	for i, b := range p.Bindings {
+		if b.Role != in.Role || b.Condition != nil {
+			continue
+		}
+		for j, m := range b.Members {
+			if m != member {
+				continue
+			}
+			b.Members = append(b.Members[:j], b.Members[j+1:]...)
+			// A binding without members is invalid.
+			if len(b.Members) == 0 {
+				p.Bindings = append(p.Bindings[:i], p.Bindings[i+1:]...)
+			}
+			return true
+		}
+	}
+	return false
+}
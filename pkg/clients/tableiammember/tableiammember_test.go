@@ -0,0 +1,133 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tableiammember
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	bigquery "google.golang.org/api/bigquery/v2"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/bigquery/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	testRole   = "roles/bigquery.dataViewer"
+	testMember = "user:alice@example.com"
+	testOther  = "user:bob@example.com"
+)
+
+var params = v1alpha1.TableIAMMemberParameters{
+	Dataset: gcp.StringPtr("sales"),
+	Table:   "orders",
+	Role:    testRole,
+	Member:  gcp.StringPtr(testMember),
+}
+
+func TestTableResource(t *testing.T) {
+	want := "projects/my-project/datasets/sales/tables/orders"
+	if diff := cmp.Diff(want, TableResource("my-project", params)); diff != "" {
+		t.Errorf("TableResource(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestBindRoleToMember(t *testing.T) {
+	type want struct {
+		policy  *bigquery.Policy
+		changed bool
+	}
+	cases := map[string]struct {
+		policy *bigquery.Policy
+		want   want
+	}{
+		"NewBinding": {
+			policy: &bigquery.Policy{},
+			want: want{
+				policy:  &bigquery.Policy{Version: 3, Bindings: []*bigquery.Binding{{Role: testRole, Members: []string{testMember}}}},
+				changed: true,
+			},
+		},
+		"ExistingBinding": {
+			policy: &bigquery.Policy{Bindings: []*bigquery.Binding{{Role: testRole, Members: []string{testOther}}}},
+			want: want{
+				policy:  &bigquery.Policy{Version: 3, Bindings: []*bigquery.Binding{{Role: testRole, Members: []string{testOther, testMember}}}},
+				changed: true,
+			},
+		},
+		"AlreadyBound": {
+			policy: &bigquery.Policy{Bindings: []*bigquery.Binding{{Role: testRole, Members: []string{testMember}}}},
+			want: want{
+				policy: &bigquery.Policy{Version: 3, Bindings: []*bigquery.Binding{{Role: testRole, Members: []string{testMember}}}},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			changed := BindRoleToMember(params, tc.policy)
+			if diff := cmp.Diff(tc.want.policy, tc.policy); diff != "" {
+				t.Errorf("BindRoleToMember(...): -want policy, +got policy:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.changed, changed); diff != "" {
+				t.Errorf("BindRoleToMember(...): -want changed, +got changed:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUnbindRoleFromMember(t *testing.T) {
+	type want struct {
+		policy  *bigquery.Policy
+		changed bool
+	}
+	cases := map[string]struct {
+		policy *bigquery.Policy
+		want   want
+	}{
+		"OtherMembers": {
+			policy: &bigquery.Policy{Bindings: []*bigquery.Binding{{Role: testRole, Members: []string{testMember, testOther}}}},
+			want: want{
+				policy:  &bigquery.Policy{Bindings: []*bigquery.Binding{{Role: testRole, Members: []string{testOther}}}},
+				changed: true,
+			},
+		},
+		"LastMember": {
+			policy: &bigquery.Policy{Bindings: []*bigquery.Binding{{Role: testRole, Members: []string{testMember}}}},
+			want: want{
+				policy:  &bigquery.Policy{Bindings: []*bigquery.Binding{}},
+				changed: true,
+			},
+		},
+		"NotBound": {
+			policy: &bigquery.Policy{Bindings: []*bigquery.Binding{{Role: testRole, Members: []string{testOther}}}},
+			want: want{
+				policy: &bigquery.Policy{Bindings: []*bigquery.Binding{{Role: testRole, Members: []string{testOther}}}},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			changed := UnbindRoleFromMember(params, tc.policy)
+			if diff := cmp.Diff(tc.want.policy, tc.policy); diff != "" {
+				t.Errorf("UnbindRoleFromMember(...): -want policy, +got policy:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.changed, changed); diff != "" {
+				t.Errorf("UnbindRoleFromMember(...): -want changed, +got changed:\n%s", diff)
+			}
+		})
+	}
+}
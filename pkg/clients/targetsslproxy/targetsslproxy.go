@@ -0,0 +1,101 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package targetsslproxy
+
+import (
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	compute "google.golang.org/api/compute/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+// GenerateTargetSSLProxy takes a TargetSSLProxyParameters and returns a
+// *compute.TargetSslProxy. It assigns only the fields that are writable.
+func GenerateTargetSSLProxy(name string, in v1alpha1.TargetSSLProxyParameters) *compute.TargetSslProxy {
+	return &compute.TargetSslProxy{
+		Name:            name,
+		Description:     gcp.StringValue(in.Description),
+		Service:         in.Service,
+		ProxyHeader:     gcp.StringValue(in.ProxyHeader),
+		SslCertificates: in.SslCertificates,
+		SslPolicy:       gcp.StringValue(in.SslPolicy),
+		CertificateMap:  gcp.StringValue(in.CertificateMap),
+	}
+}
+
+// GenerateTargetSSLProxyObservation takes a compute.TargetSslProxy and
+// returns a TargetSSLProxyObservation.
+func GenerateTargetSSLProxyObservation(in compute.TargetSslProxy) v1alpha1.TargetSSLProxyObservation {
+	return v1alpha1.TargetSSLProxyObservation{
+		CreationTimestamp: in.CreationTimestamp,
+		ID:                in.Id,
+		SelfLink:          in.SelfLink,
+	}
+}
+
+// LateInitializeSpec fills unassigned fields with the values in
+// compute.TargetSslProxy object.
+func LateInitializeSpec(spec *v1alpha1.TargetSSLProxyParameters, in compute.TargetSslProxy) {
+	spec.Description = gcp.LateInitializeString(spec.Description, in.Description)
+	spec.ProxyHeader = gcp.LateInitializeString(spec.ProxyHeader, in.ProxyHeader)
+	spec.SslCertificates = gcp.LateInitializeStringSlice(spec.SslCertificates, in.SslCertificates)
+	spec.SslPolicy = gcp.LateInitializeString(spec.SslPolicy, in.SslPolicy)
+	spec.CertificateMap = gcp.LateInitializeString(spec.CertificateMap, in.CertificateMap)
+}
+
+// IsServiceUpToDate returns true if the observed backend service matches
+// the desired one.
+func IsServiceUpToDate(in v1alpha1.TargetSSLProxyParameters, observed compute.TargetSslProxy) bool {
+	return cmp.Equal(in.Service, observed.Service, gcp.EquateComputeURLs())
+}
+
+// IsProxyHeaderUpToDate returns true if the observed proxy header matches
+// the desired one.
+func IsProxyHeaderUpToDate(in v1alpha1.TargetSSLProxyParameters, observed compute.TargetSslProxy) bool {
+	return in.ProxyHeader == nil || *in.ProxyHeader == observed.ProxyHeader
+}
+
+// AreSSLCertificatesUpToDate returns true if the observed SSL certificates
+// match the desired ones.
+func AreSSLCertificatesUpToDate(in v1alpha1.TargetSSLProxyParameters, observed compute.TargetSslProxy) bool {
+	return cmp.Equal(in.SslCertificates, observed.SslCertificates, cmpopts.EquateEmpty(), gcp.EquateComputeURLs())
+}
+
+// IsSSLPolicyUpToDate returns true if the observed SSL policy matches the
+// desired one.
+func IsSSLPolicyUpToDate(in v1alpha1.TargetSSLProxyParameters, observed compute.TargetSslProxy) bool {
+	return cmp.Equal(gcp.StringValue(in.SslPolicy), observed.SslPolicy, gcp.EquateComputeURLs())
+}
+
+// IsCertificateMapUpToDate returns true if the observed certificate map
+// matches the desired one.
+func IsCertificateMapUpToDate(in v1alpha1.TargetSSLProxyParameters, observed compute.TargetSslProxy) bool {
+	return gcp.StringValue(in.CertificateMap) == observed.CertificateMap
+}
+
+// IsUpToDate checks whether the mutable fields of the observed
+// compute.TargetSslProxy match the given set of parameters. Description
+// cannot be changed after creation and is not considered.
+func IsUpToDate(in v1alpha1.TargetSSLProxyParameters, observed compute.TargetSslProxy) bool {
+	return IsServiceUpToDate(in, observed) &&
+		IsProxyHeaderUpToDate(in, observed) &&
+		AreSSLCertificatesUpToDate(in, observed) &&
+		IsSSLPolicyUpToDate(in, observed) &&
+		IsCertificateMapUpToDate(in, observed)
+}
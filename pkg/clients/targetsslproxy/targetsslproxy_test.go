@@ -0,0 +1,120 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package targetsslproxy
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	compute "google.golang.org/api/compute/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	testService = "https://www.googleapis.com/compute/v1/projects/foo/global/backendServices/backend"
+	testCert    = "https://www.googleapis.com/compute/v1/projects/foo/global/sslCertificates/cert"
+)
+
+func params(m ...func(*v1alpha1.TargetSSLProxyParameters)) *v1alpha1.TargetSSLProxyParameters {
+	o := &v1alpha1.TargetSSLProxyParameters{
+		Service:         "global/backendServices/backend",
+		ProxyHeader:     gcp.StringPtr(v1alpha1.ProxyHeaderNone),
+		SslCertificates: []string{"global/sslCertificates/cert"},
+	}
+	for _, f := range m {
+		f(o)
+	}
+	return o
+}
+
+func proxy(m ...func(*compute.TargetSslProxy)) *compute.TargetSslProxy {
+	o := &compute.TargetSslProxy{
+		Name:            "some-name",
+		Service:         testService,
+		ProxyHeader:     v1alpha1.ProxyHeaderNone,
+		SslCertificates: []string{testCert},
+	}
+	for _, f := range m {
+		f(o)
+	}
+	return o
+}
+
+func TestLateInitializeSpec(t *testing.T) {
+	spec := &v1alpha1.TargetSSLProxyParameters{Service: testService, SslCertificates: []string{testCert}}
+	LateInitializeSpec(spec, *proxy(func(p *compute.TargetSslProxy) { p.SslPolicy = "global/sslPolicies/modern" }))
+	want := &v1alpha1.TargetSSLProxyParameters{
+		Service:         testService,
+		ProxyHeader:     gcp.StringPtr(v1alpha1.ProxyHeaderNone),
+		SslCertificates: []string{testCert},
+		SslPolicy:       gcp.StringPtr("global/sslPolicies/modern"),
+	}
+	if diff := cmp.Diff(want, spec); diff != "" {
+		t.Errorf("LateInitializeSpec(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		in       *v1alpha1.TargetSSLProxyParameters
+		observed *compute.TargetSslProxy
+		want     bool
+	}{
+		"PartialURLsUpToDate": {
+			in:       params(),
+			observed: proxy(),
+			want:     true,
+		},
+		"ServiceChanged": {
+			in: params(func(p *v1alpha1.TargetSSLProxyParameters) {
+				p.Service = "global/backendServices/other"
+			}),
+			observed: proxy(),
+			want:     false,
+		},
+		"ProxyHeaderChanged": {
+			in: params(func(p *v1alpha1.TargetSSLProxyParameters) {
+				p.ProxyHeader = gcp.StringPtr(v1alpha1.ProxyHeaderProxyV1)
+			}),
+			observed: proxy(),
+			want:     false,
+		},
+		"CertificateAdded": {
+			in: params(func(p *v1alpha1.TargetSSLProxyParameters) {
+				p.SslCertificates = append(p.SslCertificates, "global/sslCertificates/other")
+			}),
+			observed: proxy(),
+			want:     false,
+		},
+		"SSLPolicySet": {
+			in: params(func(p *v1alpha1.TargetSSLProxyParameters) {
+				p.SslPolicy = gcp.StringPtr("global/sslPolicies/modern")
+			}),
+			observed: proxy(),
+			want:     false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsUpToDate(*tc.in, *tc.observed)); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
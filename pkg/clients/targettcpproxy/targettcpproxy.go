@@ -0,0 +1,74 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package targettcpproxy
+
+import (
+	"github.com/google/go-cmp/cmp"
+	compute "google.golang.org/api/compute/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+// GenerateTargetTCPProxy takes a TargetTCPProxyParameters and returns a
+// *compute.TargetTcpProxy. It assigns only the fields that are writable.
+func GenerateTargetTCPProxy(name string, in v1alpha1.TargetTCPProxyParameters) *compute.TargetTcpProxy {
+	return &compute.TargetTcpProxy{
+		Name:        name,
+		Description: gcp.StringValue(in.Description),
+		Service:     in.Service,
+		ProxyHeader: gcp.StringValue(in.ProxyHeader),
+		ProxyBind:   gcp.BoolValue(in.ProxyBind),
+	}
+}
+
+// GenerateTargetTCPProxyObservation takes a compute.TargetTcpProxy and
+// returns a TargetTCPProxyObservation.
+func GenerateTargetTCPProxyObservation(in compute.TargetTcpProxy) v1alpha1.TargetTCPProxyObservation {
+	return v1alpha1.TargetTCPProxyObservation{
+		CreationTimestamp: in.CreationTimestamp,
+		ID:                in.Id,
+		SelfLink:          in.SelfLink,
+	}
+}
+
+// LateInitializeSpec fills unassigned fields with the values in
+// compute.TargetTcpProxy object.
+func LateInitializeSpec(spec *v1alpha1.TargetTCPProxyParameters, in compute.TargetTcpProxy) {
+	spec.Description = gcp.LateInitializeString(spec.Description, in.Description)
+	spec.ProxyHeader = gcp.LateInitializeString(spec.ProxyHeader, in.ProxyHeader)
+	spec.ProxyBind = gcp.LateInitializeBool(spec.ProxyBind, in.ProxyBind)
+}
+
+// IsServiceUpToDate returns true if the observed backend service matches
+// the desired one.
+func IsServiceUpToDate(in v1alpha1.TargetTCPProxyParameters, observed compute.TargetTcpProxy) bool {
+	return cmp.Equal(in.Service, observed.Service, gcp.EquateComputeURLs())
+}
+
+// IsProxyHeaderUpToDate returns true if the observed proxy header matches
+// the desired one.
+func IsProxyHeaderUpToDate(in v1alpha1.TargetTCPProxyParameters, observed compute.TargetTcpProxy) bool {
+	return in.ProxyHeader == nil || *in.ProxyHeader == observed.ProxyHeader
+}
+
+// IsUpToDate checks whether the mutable fields of the observed
+// compute.TargetTcpProxy match the given set of parameters. Description and
+// ProxyBind cannot be changed after creation and are not considered.
+func IsUpToDate(in v1alpha1.TargetTCPProxyParameters, observed compute.TargetTcpProxy) bool {
+	return IsServiceUpToDate(in, observed) && IsProxyHeaderUpToDate(in, observed)
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package targettcpproxy
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	compute "google.golang.org/api/compute/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const testService = "https://www.googleapis.com/compute/v1/projects/foo/global/backendServices/backend"
+
+func params(m ...func(*v1alpha1.TargetTCPProxyParameters)) *v1alpha1.TargetTCPProxyParameters {
+	o := &v1alpha1.TargetTCPProxyParameters{
+		Description: gcp.StringPtr("some desc"),
+		Service:     "global/backendServices/backend",
+		ProxyHeader: gcp.StringPtr(v1alpha1.ProxyHeaderNone),
+		ProxyBind:   gcp.BoolPtr(true),
+	}
+	for _, f := range m {
+		f(o)
+	}
+	return o
+}
+
+func proxy(m ...func(*compute.TargetTcpProxy)) *compute.TargetTcpProxy {
+	o := &compute.TargetTcpProxy{
+		Name:        "some-name",
+		Description: "some desc",
+		Service:     testService,
+		ProxyHeader: v1alpha1.ProxyHeaderNone,
+		ProxyBind:   true,
+	}
+	for _, f := range m {
+		f(o)
+	}
+	return o
+}
+
+func TestGenerateTargetTCPProxy(t *testing.T) {
+	want := proxy(func(p *compute.TargetTcpProxy) { p.Service = "global/backendServices/backend" })
+	if diff := cmp.Diff(want, GenerateTargetTCPProxy("some-name", *params())); diff != "" {
+		t.Errorf("GenerateTargetTCPProxy(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeSpec(t *testing.T) {
+	spec := &v1alpha1.TargetTCPProxyParameters{Service: testService}
+	LateInitializeSpec(spec, *proxy())
+	want := params(func(p *v1alpha1.TargetTCPProxyParameters) { p.Service = testService })
+	if diff := cmp.Diff(want, spec); diff != "" {
+		t.Errorf("LateInitializeSpec(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		in       *v1alpha1.TargetTCPProxyParameters
+		observed *compute.TargetTcpProxy
+		want     bool
+	}{
+		"PartialURLsUpToDate": {
+			in:       params(),
+			observed: proxy(),
+			want:     true,
+		},
+		"ProxyHeaderUnset": {
+			in:       params(func(p *v1alpha1.TargetTCPProxyParameters) { p.ProxyHeader = nil }),
+			observed: proxy(func(p *compute.TargetTcpProxy) { p.ProxyHeader = v1alpha1.ProxyHeaderProxyV1 }),
+			want:     true,
+		},
+		"ImmutableFieldsIgnored": {
+			in: params(func(p *v1alpha1.TargetTCPProxyParameters) {
+				p.Description = gcp.StringPtr("other desc")
+				p.ProxyBind = gcp.BoolPtr(false)
+			}),
+			observed: proxy(),
+			want:     true,
+		},
+		"ServiceChanged": {
+			in:       params(func(p *v1alpha1.TargetTCPProxyParameters) { p.Service = "global/backendServices/other" }),
+			observed: proxy(),
+			want:     false,
+		},
+		"ProxyHeaderChanged": {
+			in:       params(func(p *v1alpha1.TargetTCPProxyParameters) { p.ProxyHeader = gcp.StringPtr(v1alpha1.ProxyHeaderProxyV1) }),
+			observed: proxy(),
+			want:     false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsUpToDate(*tc.in, *tc.observed)); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
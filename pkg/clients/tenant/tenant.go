@@ -0,0 +1,133 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tenant
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	identitytoolkit "google.golang.org/api/identitytoolkit/v2"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/identityplatform/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	parentFormat = "projects/%s"
+	nameFormat   = "projects/%s/tenants/%s"
+)
+
+// GetParent returns the project the Tenant lives under.
+func GetParent(projectID string) string {
+	return fmt.Sprintf(parentFormat, projectID)
+}
+
+// GetFullyQualifiedName builds the relative resource name of the Tenant.
+func GetFullyQualifiedName(projectID, tenantID string) string {
+	return fmt.Sprintf(nameFormat, projectID, tenantID)
+}
+
+// ParseTenantID returns the tenant ID from the relative resource name of a
+// Tenant.
+func ParseTenantID(name string) string {
+	return path.Base(name)
+}
+
+// GenerateMFAConfig produces a MultiFactorAuthConfig that is configured via
+// given MultiFactorAuthConfig parameters.
+func GenerateMFAConfig(c *v1alpha1.MultiFactorAuthConfig) *identitytoolkit.GoogleCloudIdentitytoolkitAdminV2MultiFactorAuthConfig {
+	if c == nil {
+		return nil
+	}
+	return &identitytoolkit.GoogleCloudIdentitytoolkitAdminV2MultiFactorAuthConfig{
+		State:            c.State,
+		EnabledProviders: c.EnabledProviders,
+	}
+}
+
+// GenerateTenant produces a Tenant that is configured via given
+// TenantParameters.
+func GenerateTenant(name string, p v1alpha1.TenantParameters) *identitytoolkit.GoogleCloudIdentitytoolkitAdminV2Tenant {
+	return &identitytoolkit.GoogleCloudIdentitytoolkitAdminV2Tenant{
+		Name:                  name,
+		DisplayName:           p.DisplayName,
+		AllowPasswordSignup:   gcp.BoolValue(p.AllowPasswordSignup),
+		EnableEmailLinkSignin: gcp.BoolValue(p.EnableEmailLinkSignin),
+		EnableAnonymousUser:   gcp.BoolValue(p.EnableAnonymousUser),
+		DisableAuth:           gcp.BoolValue(p.DisableAuth),
+		MfaConfig:             GenerateMFAConfig(p.MFAConfig),
+		TestPhoneNumbers:      p.TestPhoneNumbers,
+	}
+}
+
+// GenerateObservation produces a TenantObservation from the supplied Tenant.
+func GenerateObservation(t identitytoolkit.GoogleCloudIdentitytoolkitAdminV2Tenant) v1alpha1.TenantObservation {
+	return v1alpha1.TenantObservation{Name: t.Name}
+}
+
+// LateInitialize fills the empty fields of TenantParameters if the
+// corresponding fields are given in Tenant.
+func LateInitialize(p *v1alpha1.TenantParameters, t identitytoolkit.GoogleCloudIdentitytoolkitAdminV2Tenant) {
+	p.AllowPasswordSignup = gcp.LateInitializeBool(p.AllowPasswordSignup, t.AllowPasswordSignup)
+	p.EnableEmailLinkSignin = gcp.LateInitializeBool(p.EnableEmailLinkSignin, t.EnableEmailLinkSignin)
+	p.EnableAnonymousUser = gcp.LateInitializeBool(p.EnableAnonymousUser, t.EnableAnonymousUser)
+	p.DisableAuth = gcp.LateInitializeBool(p.DisableAuth, t.DisableAuth)
+	if p.MFAConfig == nil && t.MfaConfig != nil {
+		p.MFAConfig = &v1alpha1.MultiFactorAuthConfig{
+			State:            t.MfaConfig.State,
+			EnabledProviders: t.MfaConfig.EnabledProviders,
+		}
+	}
+}
+
+// IsUpToDate checks whether Tenant is configured with given
+// TenantParameters.
+func IsUpToDate(p v1alpha1.TenantParameters, t identitytoolkit.GoogleCloudIdentitytoolkitAdminV2Tenant) bool {
+	return GenerateUpdateMask(p, t) == ""
+}
+
+// GenerateUpdateMask returns the comma separated list of fields that differ
+// between TenantParameters and Tenant.
+func GenerateUpdateMask(p v1alpha1.TenantParameters, t identitytoolkit.GoogleCloudIdentitytoolkitAdminV2Tenant) string {
+	desired := GenerateTenant(t.Name, p)
+	mask := []string{}
+	if desired.DisplayName != t.DisplayName {
+		mask = append(mask, "displayName")
+	}
+	if desired.AllowPasswordSignup != t.AllowPasswordSignup {
+		mask = append(mask, "allowPasswordSignup")
+	}
+	if desired.EnableEmailLinkSignin != t.EnableEmailLinkSignin {
+		mask = append(mask, "enableEmailLinkSignin")
+	}
+	if desired.EnableAnonymousUser != t.EnableAnonymousUser {
+		mask = append(mask, "enableAnonymousUser")
+	}
+	if desired.DisableAuth != t.DisableAuth {
+		mask = append(mask, "disableAuth")
+	}
+	if !cmp.Equal(desired.MfaConfig, t.MfaConfig, cmpopts.EquateEmpty()) {
+		mask = append(mask, "mfaConfig")
+	}
+	if !cmp.Equal(desired.TestPhoneNumbers, t.TestPhoneNumbers, cmpopts.EquateEmpty()) {
+		mask = append(mask, "testPhoneNumbers")
+	}
+	return strings.Join(mask, ",")
+}
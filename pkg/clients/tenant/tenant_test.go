@@ -0,0 +1,119 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tenant
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	identitytoolkit "google.golang.org/api/identitytoolkit/v2"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/identityplatform/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const testName = "projects/foo/tenants/customers-x1y2z"
+
+func params(m ...func(*v1alpha1.TenantParameters)) *v1alpha1.TenantParameters {
+	p := &v1alpha1.TenantParameters{
+		DisplayName:           "customers",
+		AllowPasswordSignup:   gcp.BoolPtr(true),
+		EnableEmailLinkSignin: gcp.BoolPtr(false),
+		EnableAnonymousUser:   gcp.BoolPtr(false),
+		DisableAuth:           gcp.BoolPtr(false),
+		MFAConfig: &v1alpha1.MultiFactorAuthConfig{
+			State:            "ENABLED",
+			EnabledProviders: []string{"PHONE_SMS"},
+		},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func tenant(m ...func(*identitytoolkit.GoogleCloudIdentitytoolkitAdminV2Tenant)) *identitytoolkit.GoogleCloudIdentitytoolkitAdminV2Tenant {
+	t := &identitytoolkit.GoogleCloudIdentitytoolkitAdminV2Tenant{
+		Name:                testName,
+		DisplayName:         "customers",
+		AllowPasswordSignup: true,
+		MfaConfig: &identitytoolkit.GoogleCloudIdentitytoolkitAdminV2MultiFactorAuthConfig{
+			State:            "ENABLED",
+			EnabledProviders: []string{"PHONE_SMS"},
+		},
+	}
+	for _, f := range m {
+		f(t)
+	}
+	return t
+}
+
+func TestGenerateTenant(t *testing.T) {
+	if diff := cmp.Diff(tenant(), GenerateTenant(testName, *params())); diff != "" {
+		t.Errorf("GenerateTenant(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitialize(t *testing.T) {
+	got := &v1alpha1.TenantParameters{DisplayName: "customers"}
+	LateInitialize(got, *tenant())
+	want := params(func(p *v1alpha1.TenantParameters) {
+		p.EnableEmailLinkSignin = nil
+		p.EnableAnonymousUser = nil
+		p.DisableAuth = nil
+	})
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("LateInitialize(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateUpdateMask(t *testing.T) {
+	cases := map[string]struct {
+		params *v1alpha1.TenantParameters
+		obs    *identitytoolkit.GoogleCloudIdentitytoolkitAdminV2Tenant
+		want   string
+	}{
+		"UpToDate": {
+			params: params(),
+			obs:    tenant(),
+			want:   "",
+		},
+		"MFADisabled": {
+			params: params(func(p *v1alpha1.TenantParameters) {
+				p.MFAConfig = &v1alpha1.MultiFactorAuthConfig{State: "DISABLED"}
+			}),
+			obs:  tenant(),
+			want: "mfaConfig",
+		},
+		"SignInChanged": {
+			params: params(func(p *v1alpha1.TenantParameters) {
+				p.AllowPasswordSignup = gcp.BoolPtr(false)
+				p.EnableEmailLinkSignin = gcp.BoolPtr(true)
+				p.TestPhoneNumbers = map[string]string{"+15555550100": "123456"}
+			}),
+			obs:  tenant(),
+			want: "allowPasswordSignup,enableEmailLinkSignin,testPhoneNumbers",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, GenerateUpdateMask(*tc.params, *tc.obs)); diff != "" {
+				t.Errorf("GenerateUpdateMask(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,204 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package tlspolicy converts the ServerTLSPolicy and ClientTLSPolicy managed
+// resources, which share how certificates are provided, to and from their
+// Network Security representation.
+package tlspolicy
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	networksecurity "google.golang.org/api/networksecurity/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/networksecurity/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	parentFormat     = "projects/%s/locations/%s"
+	serverNameFormat = "projects/%s/locations/%s/serverTlsPolicies/%s"
+	clientNameFormat = "projects/%s/locations/%s/clientTlsPolicies/%s"
+)
+
+// GetParent returns the location a policy lives under.
+func GetParent(projectID, location string) string {
+	return fmt.Sprintf(parentFormat, projectID, location)
+}
+
+// GetServerTLSPolicyName builds the relative resource name of the
+// ServerTLSPolicy.
+func GetServerTLSPolicyName(projectID, location, name string) string {
+	return fmt.Sprintf(serverNameFormat, projectID, location, name)
+}
+
+// GetClientTLSPolicyName builds the relative resource name of the
+// ClientTLSPolicy.
+func GetClientTLSPolicyName(projectID, location, name string) string {
+	return fmt.Sprintf(clientNameFormat, projectID, location, name)
+}
+
+func generateCertificateProvider(in *v1alpha1.CertificateProvider) *networksecurity.GoogleCloudNetworksecurityV1CertificateProvider {
+	if in == nil {
+		return nil
+	}
+	out := &networksecurity.GoogleCloudNetworksecurityV1CertificateProvider{}
+	if i := in.CertificateProviderInstance; i != nil {
+		out.CertificateProviderInstance = &networksecurity.CertificateProviderInstance{PluginInstance: i.PluginInstance}
+	}
+	if e := in.GRPCEndpoint; e != nil {
+		out.GrpcEndpoint = &networksecurity.GoogleCloudNetworksecurityV1GrpcEndpoint{TargetUri: e.TargetURI}
+	}
+	return out
+}
+
+func generateValidationCA(in []v1alpha1.CertificateProvider) []*networksecurity.ValidationCA {
+	var out []*networksecurity.ValidationCA
+	for i := range in {
+		// A certificate provider and a validation CA have the same fields.
+		p := generateCertificateProvider(&in[i])
+		out = append(out, &networksecurity.ValidationCA{
+			CertificateProviderInstance: p.CertificateProviderInstance,
+			GrpcEndpoint:                p.GrpcEndpoint,
+		})
+	}
+	return out
+}
+
+// GenerateServerTLSPolicy produces a ServerTlsPolicy that is configured via
+// given ServerTLSPolicyParameters.
+func GenerateServerTLSPolicy(name string, p v1alpha1.ServerTLSPolicyParameters) *networksecurity.ServerTlsPolicy {
+	s := &networksecurity.ServerTlsPolicy{
+		Name:              name,
+		Description:       gcp.StringValue(p.Description),
+		Labels:            p.Labels,
+		AllowOpen:         gcp.BoolValue(p.AllowOpen),
+		ServerCertificate: generateCertificateProvider(p.ServerCertificate),
+	}
+	if p.MTLSPolicy != nil {
+		s.MtlsPolicy = &networksecurity.MTLSPolicy{ClientValidationCa: generateValidationCA(p.MTLSPolicy.ClientValidationCA)}
+	}
+	return s
+}
+
+// GenerateServerTLSPolicyObservation produces a ServerTLSPolicyObservation
+// from the supplied ServerTlsPolicy.
+func GenerateServerTLSPolicyObservation(s networksecurity.ServerTlsPolicy) v1alpha1.ServerTLSPolicyObservation {
+	return v1alpha1.ServerTLSPolicyObservation{
+		Name:       s.Name,
+		CreateTime: s.CreateTime,
+		UpdateTime: s.UpdateTime,
+	}
+}
+
+// LateInitializeServerTLSPolicy fills the empty fields of
+// ServerTLSPolicyParameters if the corresponding fields are given in
+// ServerTlsPolicy.
+func LateInitializeServerTLSPolicy(p *v1alpha1.ServerTLSPolicyParameters, s networksecurity.ServerTlsPolicy) {
+	p.Description = gcp.LateInitializeString(p.Description, s.Description)
+}
+
+// IsServerTLSPolicyUpToDate checks whether ServerTlsPolicy is configured
+// with given ServerTLSPolicyParameters.
+func IsServerTLSPolicyUpToDate(p v1alpha1.ServerTLSPolicyParameters, s networksecurity.ServerTlsPolicy) bool {
+	return GenerateServerTLSPolicyUpdateMask(p, s) == ""
+}
+
+// GenerateServerTLSPolicyUpdateMask returns the comma separated list of
+// fields that differ between ServerTLSPolicyParameters and ServerTlsPolicy.
+func GenerateServerTLSPolicyUpdateMask(p v1alpha1.ServerTLSPolicyParameters, s networksecurity.ServerTlsPolicy) string {
+	desired := GenerateServerTLSPolicy(s.Name, p)
+	mask := []string{}
+	if desired.Description != s.Description {
+		mask = append(mask, "description")
+	}
+	if !cmp.Equal(desired.Labels, s.Labels, cmpopts.EquateEmpty()) {
+		mask = append(mask, "labels")
+	}
+	if desired.AllowOpen != s.AllowOpen {
+		mask = append(mask, "allowOpen")
+	}
+	if !cmp.Equal(desired.ServerCertificate, s.ServerCertificate) {
+		mask = append(mask, "serverCertificate")
+	}
+	if !cmp.Equal(desired.MtlsPolicy, s.MtlsPolicy, cmpopts.EquateEmpty()) {
+		mask = append(mask, "mtlsPolicy")
+	}
+	return strings.Join(mask, ",")
+}
+
+// GenerateClientTLSPolicy produces a ClientTlsPolicy that is configured via
+// given ClientTLSPolicyParameters.
+func GenerateClientTLSPolicy(name string, p v1alpha1.ClientTLSPolicyParameters) *networksecurity.ClientTlsPolicy {
+	return &networksecurity.ClientTlsPolicy{
+		Name:               name,
+		Description:        gcp.StringValue(p.Description),
+		Labels:             p.Labels,
+		Sni:                gcp.StringValue(p.SNI),
+		ClientCertificate:  generateCertificateProvider(p.ClientCertificate),
+		ServerValidationCa: generateValidationCA(p.ServerValidationCA),
+	}
+}
+
+// GenerateClientTLSPolicyObservation produces a ClientTLSPolicyObservation
+// from the supplied ClientTlsPolicy.
+func GenerateClientTLSPolicyObservation(c networksecurity.ClientTlsPolicy) v1alpha1.ClientTLSPolicyObservation {
+	return v1alpha1.ClientTLSPolicyObservation{
+		Name:       c.Name,
+		CreateTime: c.CreateTime,
+		UpdateTime: c.UpdateTime,
+	}
+}
+
+// LateInitializeClientTLSPolicy fills the empty fields of
+// ClientTLSPolicyParameters if the corresponding fields are given in
+// ClientTlsPolicy.
+func LateInitializeClientTLSPolicy(p *v1alpha1.ClientTLSPolicyParameters, c networksecurity.ClientTlsPolicy) {
+	p.Description = gcp.LateInitializeString(p.Description, c.Description)
+	p.SNI = gcp.LateInitializeString(p.SNI, c.Sni)
+}
+
+// IsClientTLSPolicyUpToDate checks whether ClientTlsPolicy is configured
+// with given ClientTLSPolicyParameters.
+func IsClientTLSPolicyUpToDate(p v1alpha1.ClientTLSPolicyParameters, c networksecurity.ClientTlsPolicy) bool {
+	return GenerateClientTLSPolicyUpdateMask(p, c) == ""
+}
+
+// GenerateClientTLSPolicyUpdateMask returns the comma separated list of
+// fields that differ between ClientTLSPolicyParameters and ClientTlsPolicy.
+func GenerateClientTLSPolicyUpdateMask(p v1alpha1.ClientTLSPolicyParameters, c networksecurity.ClientTlsPolicy) string {
+	desired := GenerateClientTLSPolicy(c.Name, p)
+	mask := []string{}
+	if desired.Description != c.Description {
+		mask = append(mask, "description")
+	}
+	if !cmp.Equal(desired.Labels, c.Labels, cmpopts.EquateEmpty()) {
+		mask = append(mask, "labels")
+	}
+	if desired.Sni != c.Sni {
+		mask = append(mask, "sni")
+	}
+	if !cmp.Equal(desired.ClientCertificate, c.ClientCertificate) {
+		mask = append(mask, "clientCertificate")
+	}
+	if !cmp.Equal(desired.ServerValidationCa, c.ServerValidationCa, cmpopts.EquateEmpty()) {
+		mask = append(mask, "serverValidationCa")
+	}
+	return strings.Join(mask, ",")
+}
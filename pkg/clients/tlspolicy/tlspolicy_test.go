@@ -0,0 +1,183 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tlspolicy
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	networksecurity "google.golang.org/api/networksecurity/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/networksecurity/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	testServerName = "projects/foo/locations/global/serverTlsPolicies/mtls"
+	testClientName = "projects/foo/locations/global/clientTlsPolicies/mtls"
+	testPlugin     = "google_cloud_private_spiffe"
+)
+
+func serverParams(m ...func(*v1alpha1.ServerTLSPolicyParameters)) *v1alpha1.ServerTLSPolicyParameters {
+	p := &v1alpha1.ServerTLSPolicyParameters{
+		Location:    "global",
+		Description: gcp.StringPtr("mtls"),
+		ServerCertificate: &v1alpha1.CertificateProvider{
+			CertificateProviderInstance: &v1alpha1.CertificateProviderInstance{PluginInstance: testPlugin},
+		},
+		MTLSPolicy: &v1alpha1.MTLSPolicy{
+			ClientValidationCA: []v1alpha1.CertificateProvider{{
+				CertificateProviderInstance: &v1alpha1.CertificateProviderInstance{PluginInstance: testPlugin},
+			}},
+		},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func serverPolicy() *networksecurity.ServerTlsPolicy {
+	return &networksecurity.ServerTlsPolicy{
+		Name:        testServerName,
+		Description: "mtls",
+		ServerCertificate: &networksecurity.GoogleCloudNetworksecurityV1CertificateProvider{
+			CertificateProviderInstance: &networksecurity.CertificateProviderInstance{PluginInstance: testPlugin},
+		},
+		MtlsPolicy: &networksecurity.MTLSPolicy{
+			ClientValidationCa: []*networksecurity.ValidationCA{{
+				CertificateProviderInstance: &networksecurity.CertificateProviderInstance{PluginInstance: testPlugin},
+			}},
+		},
+	}
+}
+
+func clientParams(m ...func(*v1alpha1.ClientTLSPolicyParameters)) *v1alpha1.ClientTLSPolicyParameters {
+	p := &v1alpha1.ClientTLSPolicyParameters{
+		Location: "global",
+		ClientCertificate: &v1alpha1.CertificateProvider{
+			GRPCEndpoint: &v1alpha1.GRPCEndpoint{TargetURI: "unix:mypath"},
+		},
+		ServerValidationCA: []v1alpha1.CertificateProvider{{
+			GRPCEndpoint: &v1alpha1.GRPCEndpoint{TargetURI: "unix:mypath"},
+		}},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func clientPolicy() *networksecurity.ClientTlsPolicy {
+	return &networksecurity.ClientTlsPolicy{
+		Name: testClientName,
+		Sni:  "secure.example.com",
+		ClientCertificate: &networksecurity.GoogleCloudNetworksecurityV1CertificateProvider{
+			GrpcEndpoint: &networksecurity.GoogleCloudNetworksecurityV1GrpcEndpoint{TargetUri: "unix:mypath"},
+		},
+		ServerValidationCa: []*networksecurity.ValidationCA{{
+			GrpcEndpoint: &networksecurity.GoogleCloudNetworksecurityV1GrpcEndpoint{TargetUri: "unix:mypath"},
+		}},
+	}
+}
+
+func TestLateInitializeClientTLSPolicy(t *testing.T) {
+	p := clientParams()
+	LateInitializeClientTLSPolicy(p, *clientPolicy())
+	want := clientParams(func(p *v1alpha1.ClientTLSPolicyParameters) { p.SNI = gcp.StringPtr("secure.example.com") })
+	if diff := cmp.Diff(want, p); diff != "" {
+		t.Errorf("LateInitializeClientTLSPolicy(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateServerTLSPolicyUpdateMask(t *testing.T) {
+	cases := map[string]struct {
+		p    *v1alpha1.ServerTLSPolicyParameters
+		s    *networksecurity.ServerTlsPolicy
+		want string
+	}{
+		"UpToDate": {
+			p:    serverParams(),
+			s:    serverPolicy(),
+			want: "",
+		},
+		"AllowOpen": {
+			p:    serverParams(func(p *v1alpha1.ServerTLSPolicyParameters) { p.AllowOpen = gcp.BoolPtr(true) }),
+			s:    serverPolicy(),
+			want: "allowOpen",
+		},
+		"MTLSRemoved": {
+			p: serverParams(func(p *v1alpha1.ServerTLSPolicyParameters) {
+				p.Labels = map[string]string{"team": "platform"}
+				p.MTLSPolicy = nil
+			}),
+			s:    serverPolicy(),
+			want: "labels,mtlsPolicy",
+		},
+		"CertificateChanged": {
+			p: serverParams(func(p *v1alpha1.ServerTLSPolicyParameters) {
+				p.ServerCertificate = &v1alpha1.CertificateProvider{GRPCEndpoint: &v1alpha1.GRPCEndpoint{TargetURI: "unix:mypath"}}
+			}),
+			s:    serverPolicy(),
+			want: "serverCertificate",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, GenerateServerTLSPolicyUpdateMask(*tc.p, *tc.s)); diff != "" {
+				t.Errorf("GenerateServerTLSPolicyUpdateMask(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateClientTLSPolicyUpdateMask(t *testing.T) {
+	cases := map[string]struct {
+		p    *v1alpha1.ClientTLSPolicyParameters
+		c    *networksecurity.ClientTlsPolicy
+		want string
+	}{
+		"UpToDate": {
+			p:    clientParams(func(p *v1alpha1.ClientTLSPolicyParameters) { p.SNI = gcp.StringPtr("secure.example.com") }),
+			c:    clientPolicy(),
+			want: "",
+		},
+		"ValidationCARemoved": {
+			p: clientParams(func(p *v1alpha1.ClientTLSPolicyParameters) {
+				p.SNI = gcp.StringPtr("secure.example.com")
+				p.ServerValidationCA = nil
+			}),
+			c:    clientPolicy(),
+			want: "serverValidationCa",
+		},
+		"SNIAndDescription": {
+			p: clientParams(func(p *v1alpha1.ClientTLSPolicyParameters) {
+				p.Description = gcp.StringPtr("mtls")
+				p.SNI = gcp.StringPtr("other.example.com")
+			}),
+			c:    clientPolicy(),
+			want: "description,sni",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, GenerateClientTLSPolicyUpdateMask(*tc.p, *tc.c)); diff != "" {
+				t.Errorf("GenerateClientTLSPolicyUpdateMask(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
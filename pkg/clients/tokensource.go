@@ -0,0 +1,121 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"crypto/sha256"
+	"strings"
+	"sync"
+
+	"golang.org/x/oauth2"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// tokenSources is shared by all controllers, so that managed resources that
+// use the same ProviderConfig share its access tokens.
+var tokenSources = NewTokenSourceCache()
+
+type tokenSourceKey struct {
+	uid    types.UID
+	scopes string
+}
+
+type tokenSourceEntry struct {
+	fingerprint [sha256.Size]byte
+	ts          oauth2.TokenSource
+}
+
+// A TokenSourceCache memoizes the token sources built from the credentials
+// of ProviderConfigs. Building a token source is cheap, but every new token
+// source fetches a new access token the first time it is used. Sharing token
+// sources between reconciles avoids fetching a token for every API client
+// that is created, which at scale exhausts the quota of the token endpoint.
+// The cached token sources refresh their tokens when they expire.
+//
+// The API clients built from the token sources are not cached. Each of them
+// sends the user-agent and request reason of the reconcile it was built for,
+// and building one is cheap once its token source is cached, because all of
+// them share the connections of the default HTTP transport.
+type TokenSourceCache struct {
+	mu      sync.Mutex
+	entries map[tokenSourceKey]tokenSourceEntry
+}
+
+// NewTokenSourceCache returns an empty TokenSourceCache.
+func NewTokenSourceCache() *TokenSourceCache {
+	return &TokenSourceCache{entries: map[tokenSourceKey]tokenSourceEntry{}}
+}
+
+// Get returns the token source cached for the supplied ProviderConfig UID and
+// scopes. A new token source is built by calling fn if none is cached, or if
+// the cached one was built from credentials other than the supplied ones,
+// i.e. the credentials of the ProviderConfig have changed. The token source
+// built by fn outlives the reconcile it was built in, so it must not depend
+// on its context.
+func (c *TokenSourceCache) Get(uid types.UID, credentials []byte, scopes []string, fn func() (oauth2.TokenSource, error)) (oauth2.TokenSource, error) {
+	k := tokenSourceKey{uid: uid, scopes: strings.Join(scopes, " ")}
+	fp := sha256.Sum256(credentials)
+
+	if ts, ok := c.lookup(k, fp); ok {
+		return ts, nil
+	}
+
+	// Building a token source may call the metadata server or a token
+	// endpoint, so the cache is not locked meanwhile. If another caller
+	// cached a token source for the same credentials in the meantime it is
+	// used instead, so that they share its access tokens.
+	ts, err := fn()
+	if err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.entries[k]; ok && e.fingerprint == fp {
+		return e.ts, nil
+	}
+	ts = oauth2.ReuseTokenSource(nil, ts)
+	c.entries[k] = tokenSourceEntry{fingerprint: fp, ts: ts}
+	return ts, nil
+}
+
+func (c *TokenSourceCache) lookup(k tokenSourceKey, fp [sha256.Size]byte) (oauth2.TokenSource, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.entries[k]
+	if !ok || e.fingerprint != fp {
+		return nil, false
+	}
+	return e.ts, true
+}
+
+// Forget evicts the token sources cached for the supplied ProviderConfig UID,
+// e.g. because the ProviderConfig was deleted.
+func (c *TokenSourceCache) Forget(uid types.UID) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for k := range c.entries {
+		if k.uid == uid {
+			delete(c.entries, k)
+		}
+	}
+}
+
+// ForgetTokenSources evicts the token sources that all controllers share for
+// the supplied ProviderConfig UID.
+func ForgetTokenSources(uid types.UID) {
+	tokenSources.Forget(uid)
+}
@@ -0,0 +1,181 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/oauth2"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+func TestTokenSourceCache(t *testing.T) {
+	scopes := []string{scopeCloudPlatform}
+	errBoom := errors.New("boom")
+
+	type call struct {
+		uid         types.UID
+		credentials string
+		scopes      []string
+		err         error
+	}
+	type want struct {
+		built int
+		err   error
+	}
+
+	cases := map[string]struct {
+		reason string
+		calls  []call
+		want   want
+	}{
+		"Cached": {
+			reason: "A token source should only be built once for the same ProviderConfig, credentials and scopes.",
+			calls: []call{
+				{uid: "pc", credentials: "creds", scopes: scopes},
+				{uid: "pc", credentials: "creds", scopes: scopes},
+			},
+			want: want{built: 1},
+		},
+		"CredentialsChanged": {
+			reason: "A new token source should be built when the credentials of a ProviderConfig change.",
+			calls: []call{
+				{uid: "pc", credentials: "creds", scopes: scopes},
+				{uid: "pc", credentials: "rotated", scopes: scopes},
+				{uid: "pc", credentials: "rotated", scopes: scopes},
+			},
+			want: want{built: 2},
+		},
+		"DifferentProviderConfigs": {
+			reason: "ProviderConfigs with the same credentials should not share token sources.",
+			calls: []call{
+				{uid: "pc", credentials: "creds", scopes: scopes},
+				{uid: "other", credentials: "creds", scopes: scopes},
+			},
+			want: want{built: 2},
+		},
+		"DifferentScopes": {
+			reason: "Token sources with different scopes should not be shared.",
+			calls: []call{
+				{uid: "pc", credentials: "creds", scopes: scopes},
+				{uid: "pc", credentials: "creds", scopes: []string{"https://www.googleapis.com/auth/devstorage.read_only"}},
+			},
+			want: want{built: 2},
+		},
+		"ErrorNotCached": {
+			reason: "A token source that cannot be built should be built again on the next call.",
+			calls: []call{
+				{uid: "pc", credentials: "creds", scopes: scopes, err: errBoom},
+				{uid: "pc", credentials: "creds", scopes: scopes},
+			},
+			want: want{built: 2},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := NewTokenSourceCache()
+			built := 0
+			var err error
+			for _, call := range tc.calls {
+				_, err = c.Get(call.uid, []byte(call.credentials), call.scopes, func() (oauth2.TokenSource, error) {
+					built++
+					if call.err != nil {
+						return nil, call.err
+					}
+					return oauth2.StaticTokenSource(&oauth2.Token{AccessToken: call.credentials}), nil
+				})
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nGet(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.built, built); diff != "" {
+				t.Errorf("\n%s\nGet(...): -want built, +got built:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestTokenSourceCacheToken(t *testing.T) {
+	c := NewTokenSourceCache()
+	build := func(token string) func() (oauth2.TokenSource, error) {
+		return func() (oauth2.TokenSource, error) {
+			return oauth2.StaticTokenSource(&oauth2.Token{AccessToken: token}), nil
+		}
+	}
+	for _, creds := range []string{"creds", "creds", "rotated"} {
+		ts, err := c.Get("pc", []byte(creds), nil, build(creds))
+		if err != nil {
+			t.Fatalf("Get(...): %s", err)
+		}
+		tok, err := ts.Token()
+		if err != nil {
+			t.Fatalf("Token(): %s", err)
+		}
+		if diff := cmp.Diff(creds, tok.AccessToken); diff != "" {
+			t.Errorf("Token(): -want, +got:\n%s", diff)
+		}
+	}
+}
+
+func TestTokenSourceCacheForget(t *testing.T) {
+	c := NewTokenSourceCache()
+	built := 0
+	build := func() (oauth2.TokenSource, error) {
+		built++
+		return oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "token"}), nil
+	}
+	_, _ = c.Get("pc", []byte("creds"), nil, build)
+	_, _ = c.Get("other", []byte("creds"), nil, build)
+	c.Forget("pc")
+	_, _ = c.Get("pc", []byte("creds"), nil, build)
+	_, _ = c.Get("other", []byte("creds"), nil, build)
+	if diff := cmp.Diff(3, built); diff != "" {
+		t.Errorf("Get(...) after Forget(...): -want built, +got built:\n%s", diff)
+	}
+}
+
+func TestTokenSourceCacheBuildUnlocked(t *testing.T) {
+	c := NewTokenSourceCache()
+	build := func() (oauth2.TokenSource, error) {
+		return oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "token"}), nil
+	}
+	done := make(chan error)
+	go func() {
+		// Building a token source must not block the rest of the cache.
+		_, err := c.Get("pc", []byte("creds"), nil, func() (oauth2.TokenSource, error) {
+			if _, err := c.Get("other", []byte("creds"), nil, build); err != nil {
+				return nil, err
+			}
+			return build()
+		})
+		done <- err
+	}()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("Get(...): %s", err)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("Get(...): building a token source blocked the cache")
+	}
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package topic
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -38,6 +39,19 @@ func GetFullyQualifiedName(project string, name string) string {
 	return fmt.Sprintf(topicNameFormat, project, name)
 }
 
+// List returns every topic of the supplied project, keyed by its fully
+// qualified name.
+func List(ctx context.Context, s *pubsub.Service, project string) (map[string]*pubsub.Topic, error) {
+	topics := map[string]*pubsub.Topic{}
+	err := s.Projects.Topics.List("projects/"+project).Pages(ctx, func(r *pubsub.ListTopicsResponse) error {
+		for _, t := range r.Topics {
+			topics[t.Name] = t
+		}
+		return nil
+	})
+	return topics, err
+}
+
 // GenerateTopic produces a Topic that is configured via given TopicParameters.
 func GenerateTopic(name string, s v1alpha1.TopicParameters) *pubsub.Topic {
 	t := &pubsub.Topic{
@@ -56,6 +70,13 @@ func GenerateTopic(name string, s v1alpha1.TopicParameters) *pubsub.Topic {
 	return t
 }
 
+// GenerateObservation produces a TopicObservation from the supplied Topic.
+func GenerateObservation(t pubsub.Topic) v1alpha1.TopicObservation {
+	return v1alpha1.TopicObservation{
+		Name: t.Name,
+	}
+}
+
 // LateInitialize fills the empty fields of TopicParameters if the corresponding
 // fields are given in Topic.
 func LateInitialize(s *v1alpha1.TopicParameters, t pubsub.Topic) {
@@ -90,6 +111,17 @@ func IsUpToDate(s v1alpha1.TopicParameters, t pubsub.Topic) bool {
 	return cmp.Equal(observed, &s, cmpopts.IgnoreFields(v1alpha1.TopicParameters{}, "MessageRetentionDuration"))
 }
 
+// ImmutableFieldsChanged returns the names of the fields of the supplied
+// TopicParameters that can only be set when a topic is created and that
+// differ from the observed topic.
+func ImmutableFieldsChanged(s v1alpha1.TopicParameters, t pubsub.Topic) []string {
+	changed := []string{}
+	if s.KmsKeyName != nil && *s.KmsKeyName != t.KmsKeyName {
+		changed = append(changed, "kmsKeyName")
+	}
+	return changed
+}
+
 func convertDuration(duration *string) time.Duration {
 	if duration == nil {
 		return 0
@@ -119,7 +151,7 @@ func GenerateUpdateRequest(name string, s v1alpha1.TopicParameters, t pubsub.Top
 			}
 		}
 	}
-	if !cmp.Equal(s.MessageRetentionDuration, observed.MessageRetentionDuration) {
+	if convertDuration(s.MessageRetentionDuration) != convertDuration(observed.MessageRetentionDuration) {
 		mask = append(mask, "messageRetentionDuration")
 		if s.MessageRetentionDuration != nil {
 			ut.Topic.MessageRetentionDuration = gcp.StringValue(s.MessageRetentionDuration)
@@ -88,6 +88,27 @@ func TestGenerateTopic(t *testing.T) {
 	}
 }
 
+func TestGenerateObservation(t *testing.T) {
+	cases := map[string]struct {
+		in  pubsub.Topic
+		out v1alpha1.TopicObservation
+	}{
+		"Full": {
+			in:  pubsub.Topic{Name: GetFullyQualifiedName(projectID, name)},
+			out: v1alpha1.TopicObservation{Name: "projects/fooproject/topics/barname"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateObservation(tc.in)
+			if diff := cmp.Diff(tc.out, got); diff != "" {
+				t.Errorf("GenerateObservation(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestLateInitialize(t *testing.T) {
 	type args struct {
 		obs   pubsub.Topic
@@ -184,6 +205,26 @@ func TestGenerateUpdateRequest(t *testing.T) {
 				UpdateMask: "messageStoragePolicy,messageRetentionDuration,labels",
 			},
 		},
+		"EquivalentRetentionDuration": {
+			args: args{
+				projectID: projectID,
+				name:      name,
+				obs: func() pubsub.Topic {
+					t := topic()
+					t.MessageRetentionDuration = "600.000s"
+					t.Labels = nil
+					return *t
+				}(),
+				param: *params(),
+			},
+			result: &pubsub.UpdateTopicRequest{
+				Topic: &pubsub.Topic{
+					Name:   name,
+					Labels: map[string]string{"foo": "bar"},
+				},
+				UpdateMask: "labels",
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -0,0 +1,167 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tpunode
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	tpu "google.golang.org/api/tpu/v2"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/tpu/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	parentFormat = "projects/%s/locations/%s"
+	nameFormat   = "projects/%s/locations/%s/nodes/%s"
+)
+
+// GetParent returns the location the Node lives under.
+func GetParent(projectID, location string) string {
+	return fmt.Sprintf(parentFormat, projectID, location)
+}
+
+// GetFullyQualifiedName builds the relative resource name of the Node.
+func GetFullyQualifiedName(projectID, location, name string) string {
+	return fmt.Sprintf(nameFormat, projectID, location, name)
+}
+
+// GenerateNode produces a Node that is configured via given NodeParameters.
+func GenerateNode(name string, p v1alpha1.NodeParameters) *tpu.Node {
+	n := &tpu.Node{
+		Name:            name,
+		AcceleratorType: p.AcceleratorType,
+		RuntimeVersion:  p.RuntimeVersion,
+		Description:     gcp.StringValue(p.Description),
+		Labels:          p.Labels,
+		Metadata:        p.Metadata,
+		Tags:            p.Tags,
+		CidrBlock:       gcp.StringValue(p.CIDRBlock),
+	}
+	if c := p.NetworkConfig; c != nil {
+		n.NetworkConfig = &tpu.NetworkConfig{
+			Network:           gcp.StringValue(c.Network),
+			Subnetwork:        gcp.StringValue(c.Subnetwork),
+			EnableExternalIps: gcp.BoolValue(c.EnableExternalIPs),
+			CanIpForward:      gcp.BoolValue(c.CanIPForward),
+		}
+	}
+	if c := p.SchedulingConfig; c != nil {
+		n.SchedulingConfig = &tpu.SchedulingConfig{
+			Preemptible: gcp.BoolValue(c.Preemptible),
+			Reserved:    gcp.BoolValue(c.Reserved),
+		}
+	}
+	if sa := p.ServiceAccount; sa != nil {
+		n.ServiceAccount = &tpu.ServiceAccount{
+			Email: gcp.StringValue(sa.Email),
+			Scope: sa.Scope,
+		}
+	}
+	if c := p.ShieldedInstanceConfig; c != nil {
+		n.ShieldedInstanceConfig = &tpu.ShieldedInstanceConfig{EnableSecureBoot: c.EnableSecureBoot}
+	}
+	for _, d := range p.DataDisks {
+		n.DataDisks = append(n.DataDisks, &tpu.AttachedDisk{
+			SourceDisk: d.SourceDisk,
+			Mode:       gcp.StringValue(d.Mode),
+		})
+	}
+	return n
+}
+
+// GenerateObservation produces a NodeObservation from the supplied Node.
+func GenerateObservation(n tpu.Node) v1alpha1.NodeObservation {
+	o := v1alpha1.NodeObservation{
+		Name:              n.Name,
+		ID:                n.Id,
+		State:             n.State,
+		Health:            n.Health,
+		HealthDescription: n.HealthDescription,
+		CreateTime:        n.CreateTime,
+	}
+	for _, e := range n.NetworkEndpoints {
+		if e == nil {
+			continue
+		}
+		ne := v1alpha1.NetworkEndpoint{IPAddress: e.IpAddress, Port: e.Port}
+		if e.AccessConfig != nil {
+			ne.ExternalIP = e.AccessConfig.ExternalIp
+		}
+		o.NetworkEndpoints = append(o.NetworkEndpoints, ne)
+	}
+	for _, s := range n.Symptoms {
+		if s == nil {
+			continue
+		}
+		o.Symptoms = append(o.Symptoms, v1alpha1.Symptom{
+			SymptomType: s.SymptomType,
+			Details:     s.Details,
+			WorkerID:    s.WorkerId,
+			CreateTime:  s.CreateTime,
+		})
+	}
+	return o
+}
+
+// LateInitialize fills the empty fields of NodeParameters if the
+// corresponding fields are given in Node.
+func LateInitialize(p *v1alpha1.NodeParameters, n tpu.Node) {
+	p.CIDRBlock = gcp.LateInitializeString(p.CIDRBlock, n.CidrBlock)
+	if n.NetworkConfig != nil {
+		if p.NetworkConfig == nil {
+			p.NetworkConfig = &v1alpha1.NetworkConfig{}
+		}
+		p.NetworkConfig.Network = gcp.LateInitializeString(p.NetworkConfig.Network, n.NetworkConfig.Network)
+		p.NetworkConfig.Subnetwork = gcp.LateInitializeString(p.NetworkConfig.Subnetwork, n.NetworkConfig.Subnetwork)
+	}
+	if n.ServiceAccount != nil {
+		if p.ServiceAccount == nil {
+			p.ServiceAccount = &v1alpha1.ServiceAccount{}
+		}
+		p.ServiceAccount.Email = gcp.LateInitializeString(p.ServiceAccount.Email, n.ServiceAccount.Email)
+		p.ServiceAccount.Scope = gcp.LateInitializeStringSlice(p.ServiceAccount.Scope, n.ServiceAccount.Scope)
+	}
+}
+
+// IsUpToDate checks whether Node is configured with given NodeParameters.
+func IsUpToDate(p v1alpha1.NodeParameters, n tpu.Node) bool {
+	return GenerateUpdateMask(p, n) == ""
+}
+
+// GenerateUpdateMask returns the comma separated list of mutable fields that
+// differ between NodeParameters and Node.
+func GenerateUpdateMask(p v1alpha1.NodeParameters, n tpu.Node) string {
+	desired := GenerateNode(n.Name, p)
+	mask := []string{}
+	if desired.Description != n.Description {
+		mask = append(mask, "description")
+	}
+	if !cmp.Equal(desired.Labels, n.Labels, cmpopts.EquateEmpty()) {
+		mask = append(mask, "labels")
+	}
+	if !cmp.Equal(desired.Metadata, n.Metadata, cmpopts.EquateEmpty()) {
+		mask = append(mask, "metadata")
+	}
+	if !cmp.Equal(desired.Tags, n.Tags, cmpopts.EquateEmpty()) {
+		mask = append(mask, "tags")
+	}
+	return strings.Join(mask, ",")
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tpunode
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	tpu "google.golang.org/api/tpu/v2"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/tpu/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	testName   = "projects/foo/locations/us-west4-a/nodes/trainer"
+	network    = "projects/foo/global/networks/ml"
+	subnetwork = "projects/foo/regions/us-west4/subnetworks/ml"
+)
+
+func params(m ...func(*v1alpha1.NodeParameters)) *v1alpha1.NodeParameters {
+	p := &v1alpha1.NodeParameters{
+		Location:        "us-west4-a",
+		AcceleratorType: "v5litepod-8",
+		RuntimeVersion:  "tpu-ubuntu2204-base",
+		Labels:          map[string]string{"team": "ml"},
+		NetworkConfig: &v1alpha1.NetworkConfig{
+			Network:           gcp.StringPtr(network),
+			Subnetwork:        gcp.StringPtr(subnetwork),
+			EnableExternalIPs: gcp.BoolPtr(true),
+		},
+		SchedulingConfig: &v1alpha1.SchedulingConfig{Preemptible: gcp.BoolPtr(true)},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func node(m ...func(*tpu.Node)) *tpu.Node {
+	n := &tpu.Node{
+		Name:            testName,
+		AcceleratorType: "v5litepod-8",
+		RuntimeVersion:  "tpu-ubuntu2204-base",
+		Labels:          map[string]string{"team": "ml"},
+		NetworkConfig: &tpu.NetworkConfig{
+			Network:           network,
+			Subnetwork:        subnetwork,
+			EnableExternalIps: true,
+		},
+		SchedulingConfig: &tpu.SchedulingConfig{Preemptible: true},
+	}
+	for _, f := range m {
+		f(n)
+	}
+	return n
+}
+
+func TestGenerateNode(t *testing.T) {
+	if diff := cmp.Diff(node(), GenerateNode(testName, *params())); diff != "" {
+		t.Errorf("GenerateNode(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateObservation(t *testing.T) {
+	n := node(func(n *tpu.Node) {
+		n.Id = 42
+		n.State = v1alpha1.NodeStateReady
+		n.Health = "HEALTHY"
+		n.NetworkEndpoints = []*tpu.NetworkEndpoint{{IpAddress: "10.0.0.2", Port: 8470, AccessConfig: &tpu.AccessConfig{ExternalIp: "34.1.2.3"}}}
+	})
+	want := v1alpha1.NodeObservation{
+		Name:             testName,
+		ID:               42,
+		State:            v1alpha1.NodeStateReady,
+		Health:           "HEALTHY",
+		NetworkEndpoints: []v1alpha1.NetworkEndpoint{{IPAddress: "10.0.0.2", Port: 8470, ExternalIP: "34.1.2.3"}},
+	}
+	if diff := cmp.Diff(want, GenerateObservation(*n)); diff != "" {
+		t.Errorf("GenerateObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitialize(t *testing.T) {
+	p := params(func(p *v1alpha1.NodeParameters) {
+		p.NetworkConfig = nil
+	})
+	LateInitialize(p, *node(func(n *tpu.Node) {
+		n.NetworkConfig = &tpu.NetworkConfig{Network: "projects/foo/global/networks/default"}
+		n.ServiceAccount = &tpu.ServiceAccount{Email: "default", Scope: []string{"https://www.googleapis.com/auth/cloud-platform"}}
+	}))
+	want := params(func(p *v1alpha1.NodeParameters) {
+		p.NetworkConfig = &v1alpha1.NetworkConfig{Network: gcp.StringPtr("projects/foo/global/networks/default")}
+		p.ServiceAccount = &v1alpha1.ServiceAccount{Email: gcp.StringPtr("default"), Scope: []string{"https://www.googleapis.com/auth/cloud-platform"}}
+	})
+	if diff := cmp.Diff(want, p); diff != "" {
+		t.Errorf("LateInitialize(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateUpdateMask(t *testing.T) {
+	cases := map[string]struct {
+		p    *v1alpha1.NodeParameters
+		n    *tpu.Node
+		want string
+	}{
+		"UpToDate": {
+			p: params(),
+			n: node(),
+		},
+		"ImmutableFieldsIgnored": {
+			p: params(func(p *v1alpha1.NodeParameters) {
+				p.AcceleratorType = "v5litepod-16"
+			}),
+			n: node(),
+		},
+		"MutableFieldsChanged": {
+			p: params(func(p *v1alpha1.NodeParameters) {
+				p.Description = gcp.StringPtr("trainer")
+				p.Labels = map[string]string{"team": "research"}
+				p.Tags = []string{"ssh"}
+			}),
+			n:    node(),
+			want: "description,labels,tags",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, GenerateUpdateMask(*tc.p, *tc.n)); diff != "" {
+				t.Errorf("GenerateUpdateMask(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
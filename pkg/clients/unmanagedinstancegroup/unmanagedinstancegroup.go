@@ -0,0 +1,149 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package unmanagedinstancegroup
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	compute "google.golang.org/api/compute/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1beta1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const instanceURLFormat = "projects/%s/zones/%s/instances/%s"
+
+// InstanceURL returns the partially qualified URL of the supplied member
+// instance of a group in the supplied project and zone. Instances may be
+// given by name, or by their partially or fully qualified URL.
+func InstanceURL(project, zone, instance string) string {
+	instance = strings.TrimPrefix(instance, v1beta1.ComputeURIPrefix)
+	if !strings.Contains(instance, "/") {
+		return fmt.Sprintf(instanceURLFormat, project, zone, instance)
+	}
+	return instance
+}
+
+// GenerateInstanceGroup takes an UnmanagedInstanceGroupParameters and returns
+// the *compute.InstanceGroup to insert. Member instances cannot be supplied
+// when a group is inserted; they are added once it exists.
+func GenerateInstanceGroup(name string, in v1alpha1.UnmanagedInstanceGroupParameters) *compute.InstanceGroup {
+	return &compute.InstanceGroup{
+		Name:        name,
+		Description: gcp.StringValue(in.Description),
+		Network:     gcp.StringValue(in.Network),
+		NamedPorts:  GenerateNamedPorts(in.NamedPorts),
+	}
+}
+
+// GenerateNamedPorts returns the supplied named ports as compute API named
+// ports.
+func GenerateNamedPorts(in []v1alpha1.NamedPort) []*compute.NamedPort {
+	if len(in) == 0 {
+		return nil
+	}
+	out := make([]*compute.NamedPort, len(in))
+	for i, p := range in {
+		out[i] = &compute.NamedPort{Name: p.Name, Port: p.Port}
+	}
+	return out
+}
+
+// GenerateUnmanagedInstanceGroupObservation takes a compute.InstanceGroup and
+// returns an UnmanagedInstanceGroupObservation.
+func GenerateUnmanagedInstanceGroupObservation(in compute.InstanceGroup) v1alpha1.UnmanagedInstanceGroupObservation {
+	return v1alpha1.UnmanagedInstanceGroupObservation{
+		CreationTimestamp: in.CreationTimestamp,
+		Fingerprint:       in.Fingerprint,
+		ID:                in.Id,
+		Network:           in.Network,
+		SelfLink:          in.SelfLink,
+		Size:              in.Size,
+		Subnetwork:        in.Subnetwork,
+	}
+}
+
+// LateInitializeSpec fills unassigned fields with the values of the supplied
+// compute.InstanceGroup and the URLs of its member instances.
+func LateInitializeSpec(spec *v1alpha1.UnmanagedInstanceGroupParameters, in compute.InstanceGroup, members []string) {
+	spec.Description = gcp.LateInitializeString(spec.Description, in.Description)
+	spec.Network = gcp.LateInitializeString(spec.Network, in.Network)
+	if spec.NamedPorts == nil {
+		for _, p := range in.NamedPorts {
+			if p == nil {
+				continue
+			}
+			spec.NamedPorts = append(spec.NamedPorts, v1alpha1.NamedPort{Name: p.Name, Port: p.Port})
+		}
+	}
+	if spec.Instances == nil {
+		for _, m := range members {
+			spec.Instances = append(spec.Instances, strings.TrimPrefix(m, v1beta1.ComputeURIPrefix))
+		}
+	}
+}
+
+// IsNamedPortsUpToDate returns true if the supplied named ports are the same
+// as the observed named ports, regardless of their order.
+func IsNamedPortsUpToDate(in []v1alpha1.NamedPort, observed []*compute.NamedPort) bool {
+	return cmp.Equal(GenerateNamedPorts(in), observed,
+		cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(compute.NamedPort{}, "ForceSendFields", "NullFields"),
+		cmpopts.SortSlices(func(a, b *compute.NamedPort) bool { return a.Name < b.Name }))
+}
+
+// DiffInstances returns the partially qualified URLs of the desired member
+// instances of a group in the supplied project and zone that must be added
+// to it, and of the observed member instances that must be removed from it.
+func DiffInstances(project, zone string, desired, observed []string) (add, remove []string) {
+	want := make(map[string]bool, len(desired))
+	for _, i := range desired {
+		want[InstanceURL(project, zone, i)] = true
+	}
+	have := make(map[string]bool, len(observed))
+	for _, i := range observed {
+		have[InstanceURL(project, zone, i)] = true
+	}
+	for i := range want {
+		if !have[i] {
+			add = append(add, i)
+		}
+	}
+	for i := range have {
+		if !want[i] {
+			remove = append(remove, i)
+		}
+	}
+	sort.Strings(add)
+	sort.Strings(remove)
+	return add, remove
+}
+
+// InstanceReferences returns the supplied instance URLs as compute API
+// instance references.
+func InstanceReferences(urls []string) []*compute.InstanceReference {
+	refs := make([]*compute.InstanceReference, len(urls))
+	for i, u := range urls {
+		refs[i] = &compute.InstanceReference{Instance: u}
+	}
+	return refs
+}
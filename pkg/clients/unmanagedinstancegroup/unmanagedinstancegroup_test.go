@@ -0,0 +1,190 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package unmanagedinstancegroup
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/compute/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	testProject = "my-project"
+	testZone    = "us-central1-a"
+	testNetwork = "https://www.googleapis.com/compute/v1/projects/my-project/global/networks/default"
+)
+
+func TestInstanceURL(t *testing.T) {
+	want := "projects/my-project/zones/us-central1-a/instances/vm-1"
+	cases := map[string]string{
+		"Name":           "vm-1",
+		"PartialURL":     want,
+		"FullyQualified": "https://www.googleapis.com/compute/v1/" + want,
+	}
+	for name, in := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(want, InstanceURL(testProject, testZone, in)); diff != "" {
+				t.Errorf("InstanceURL(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiffInstances(t *testing.T) {
+	type args struct {
+		desired  []string
+		observed []string
+	}
+	type want struct {
+		add    []string
+		remove []string
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"UpToDate": {
+			args: args{
+				desired:  []string{"vm-1"},
+				observed: []string{"https://www.googleapis.com/compute/v1/projects/my-project/zones/us-central1-a/instances/vm-1"},
+			},
+			want: want{},
+		},
+		"AddAndRemove": {
+			args: args{
+				desired:  []string{"vm-1", "projects/my-project/zones/us-central1-a/instances/vm-2"},
+				observed: []string{"https://www.googleapis.com/compute/v1/projects/my-project/zones/us-central1-a/instances/vm-1", "https://www.googleapis.com/compute/v1/projects/my-project/zones/us-central1-a/instances/vm-3"},
+			},
+			want: want{
+				add:    []string{"projects/my-project/zones/us-central1-a/instances/vm-2"},
+				remove: []string{"projects/my-project/zones/us-central1-a/instances/vm-3"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			add, remove := DiffInstances(testProject, testZone, tc.args.desired, tc.args.observed)
+			if diff := cmp.Diff(tc.want.add, add); diff != "" {
+				t.Errorf("DiffInstances(...): -want add, +got add:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.remove, remove); diff != "" {
+				t.Errorf("DiffInstances(...): -want remove, +got remove:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsNamedPortsUpToDate(t *testing.T) {
+	type args struct {
+		in       []v1alpha1.NamedPort
+		observed []*compute.NamedPort
+	}
+
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"Empty": {
+			args: args{},
+			want: true,
+		},
+		"Reordered": {
+			args: args{
+				in:       []v1alpha1.NamedPort{{Name: "http", Port: 80}, {Name: "https", Port: 443}},
+				observed: []*compute.NamedPort{{Name: "https", Port: 443}, {Name: "http", Port: 80}},
+			},
+			want: true,
+		},
+		"PortChanged": {
+			args: args{
+				in:       []v1alpha1.NamedPort{{Name: "http", Port: 8080}},
+				observed: []*compute.NamedPort{{Name: "http", Port: 80}},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsNamedPortsUpToDate(tc.args.in, tc.args.observed)); diff != "" {
+				t.Errorf("IsNamedPortsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeSpec(t *testing.T) {
+	type args struct {
+		spec    *v1alpha1.UnmanagedInstanceGroupParameters
+		in      compute.InstanceGroup
+		members []string
+	}
+
+	cases := map[string]struct {
+		args args
+		want *v1alpha1.UnmanagedInstanceGroupParameters
+	}{
+		"Empty": {
+			args: args{
+				spec: &v1alpha1.UnmanagedInstanceGroupParameters{},
+				in: compute.InstanceGroup{
+					Description: "legacy backends",
+					Network:     testNetwork,
+					NamedPorts:  []*compute.NamedPort{{Name: "http", Port: 80}},
+				},
+				members: []string{"https://www.googleapis.com/compute/v1/projects/my-project/zones/us-central1-a/instances/vm-1"},
+			},
+			want: &v1alpha1.UnmanagedInstanceGroupParameters{
+				Description: gcp.StringPtr("legacy backends"),
+				Network:     gcp.StringPtr(testNetwork),
+				NamedPorts:  []v1alpha1.NamedPort{{Name: "http", Port: 80}},
+				Instances:   []string{"projects/my-project/zones/us-central1-a/instances/vm-1"},
+			},
+		},
+		"Set": {
+			args: args{
+				spec: &v1alpha1.UnmanagedInstanceGroupParameters{
+					Instances:  []string{},
+					NamedPorts: []v1alpha1.NamedPort{{Name: "https", Port: 443}},
+				},
+				in: compute.InstanceGroup{
+					NamedPorts: []*compute.NamedPort{{Name: "http", Port: 80}},
+				},
+				members: []string{"https://www.googleapis.com/compute/v1/projects/my-project/zones/us-central1-a/instances/vm-1"},
+			},
+			want: &v1alpha1.UnmanagedInstanceGroupParameters{
+				Instances:  []string{},
+				NamedPorts: []v1alpha1.NamedPort{{Name: "https", Port: 443}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeSpec(tc.args.spec, tc.args.in, tc.args.members)
+			if diff := cmp.Diff(tc.want, tc.args.spec); diff != "" {
+				t.Errorf("LateInitializeSpec(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
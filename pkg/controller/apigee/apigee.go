@@ -0,0 +1,89 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apigee
+
+import (
+	"context"
+
+	apigee "google.golang.org/api/apigee/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/apigee/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/operation"
+)
+
+const (
+	errNewClient          = "cannot create new Apigee client"
+	errGetCreateOperation = "cannot get operation creating Apigee resource"
+	errCreateOperationFmt = "operation %s creating Apigee resource failed: %s"
+
+	reasonCreateOperationFailed  event.Reason = "CreateOperationFailed"
+	reasonCannotPersistOperation event.Reason = "CannotPersistOperation"
+)
+
+// trackCreate forgets the persisted operation creating the supplied resource
+// once it is done, and reports it if it failed. Apigee resources take from
+// minutes to an hour to be created, and some cannot be observed until they
+// are, so they are not created again while their operation is running.
+func trackCreate(ctx context.Context, kube client.Client, record event.Recorder, ops *apigee.OrganizationsOperationsService, mg resource.Managed) error {
+	name := operation.CreateOperation(mg)
+	if name == "" {
+		return nil
+	}
+	op, err := ops.Get(name).Context(ctx).Do()
+	if resource.Ignore(gcp.IsErrorNotFound, err) != nil {
+		return errors.Wrap(err, errGetCreateOperation)
+	}
+	if op != nil && !op.Done {
+		return nil
+	}
+	if op != nil && op.Error != nil {
+		record.Event(mg, event.Warning(reasonCreateOperationFailed, errors.Errorf(errCreateOperationFmt, name, op.Error.Message)))
+	}
+	return operation.ForgetCreateOperation(ctx, kube, mg)
+}
+
+// persistCreateOperation records and persists the operation creating the
+// supplied resource. The creation does not fail if it cannot be persisted,
+// since the resource is being created regardless.
+func persistCreateOperation(ctx context.Context, kube client.Client, record event.Recorder, mg resource.Managed, op *apigee.GoogleLongrunningOperation) {
+	gcp.RecordOperation(record, mg, "create", op.Name)
+	if err := operation.PersistCreateOperation(ctx, kube, mg, op.Name); err != nil {
+		record.Event(mg, event.Warning(reasonCannotPersistOperation, err))
+	}
+}
+
+// setConditions sets the conditions of an Apigee resource in the supplied
+// state.
+func setConditions(mg resource.Managed, state string) {
+	switch state {
+	case v1alpha1.StateCreating:
+		mg.SetConditions(xpv1.Creating())
+	case v1alpha1.StateActive, v1alpha1.StateUpdating:
+		mg.SetConditions(xpv1.Available())
+	case v1alpha1.StateDeleting:
+		mg.SetConditions(xpv1.Deleting())
+	default:
+		mg.SetConditions(xpv1.Unavailable())
+	}
+}
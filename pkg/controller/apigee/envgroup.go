@@ -0,0 +1,182 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apigee
+
+import (
+	"context"
+
+	apigee "google.golang.org/api/apigee/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/apigee/v1alpha1"
+	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/apigeeenvgroup"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/setup"
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+	"github.com/crossplane-contrib/provider-gcp/pkg/operation"
+	"github.com/crossplane-contrib/provider-gcp/pkg/tracing"
+)
+
+const (
+	errNotEnvGroup    = "managed resource is not of type EnvGroup"
+	errGetEnvGroup    = "cannot get EnvGroup"
+	errCreateEnvGroup = "cannot create EnvGroup"
+	errUpdateEnvGroup = "cannot update EnvGroup"
+	errDeleteEnvGroup = "cannot delete EnvGroup"
+)
+
+// SetupEnvGroup adds a controller that reconciles EnvGroups.
+func SetupEnvGroup(mgr ctrl.Manager, o setup.Options) error {
+	name := managed.ControllerName(v1alpha1.EnvGroupGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
+	}
+
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.EnvGroupGroupVersionKind),
+		managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient()), operation.NewCreateResumer(mgr.GetClient())),
+		managed.WithExternalConnecter(setup.Connecter(mgr, o, v1alpha1.EnvGroupGroupKind, &envGroupConnector{client: mgr.GetClient(), record: recorder})),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(recorder),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.EnvGroup{}).
+		Complete(tracing.NewReconciler(name, ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter)))
+}
+
+type envGroupConnector struct {
+	client client.Client
+	record event.Recorder
+}
+
+// Connect returns an ExternalClient with necessary information to talk to GCP API.
+func (c *envGroupConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	_, opts, err := gcp.GetConnectionInfo(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := apigee.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &envGroupExternal{client: c.client, record: c.record, groups: s.Organizations.Envgroups, ops: s.Organizations.Operations}, nil
+}
+
+type envGroupExternal struct {
+	client client.Client
+	record event.Recorder
+	groups *apigee.OrganizationsEnvgroupsService
+	ops    *apigee.OrganizationsOperationsService
+}
+
+// Observe makes observation about the external resource.
+func (e *envGroupExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.EnvGroup)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotEnvGroup)
+	}
+	if err := trackCreate(ctx, e.client, e.record, e.ops, cr); err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	name := apigeeenvgroup.GetFullyQualifiedName(gcp.StringValue(cr.Spec.ForProvider.Organization), meta.GetExternalName(cr))
+	g, err := e.groups.Get(name).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetEnvGroup)
+	}
+	cr.Status.AtProvider = apigeeenvgroup.GenerateObservation(*g)
+	cr.Status.AtProvider.Name = name
+	setConditions(cr, g.State)
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: apigeeenvgroup.IsUpToDate(cr.Spec.ForProvider, *g),
+	}, nil
+}
+
+// Create initiates creation of external resource.
+func (e *envGroupExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.EnvGroup)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotEnvGroup)
+	}
+	cr.SetConditions(xpv1.Creating())
+	// Wait until the environment group is created if it is being created.
+	if operation.CreateOperation(cr) != "" {
+		return managed.ExternalCreation{}, nil
+	}
+	op, err := e.groups.Create(apigeeenvgroup.GetParent(gcp.StringValue(cr.Spec.ForProvider.Organization)), apigeeenvgroup.GenerateEnvGroup(meta.GetExternalName(cr), cr.Spec.ForProvider)).
+		Name(meta.GetExternalName(cr)).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateEnvGroup)
+	}
+	persistCreateOperation(ctx, e.client, e.record, cr, op)
+	return managed.ExternalCreation{}, nil
+}
+
+// Update initiates an update to the external resource. Only the hostnames of
+// an environment group can be updated.
+func (e *envGroupExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.EnvGroup)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotEnvGroup)
+	}
+	name := apigeeenvgroup.GetFullyQualifiedName(gcp.StringValue(cr.Spec.ForProvider.Organization), meta.GetExternalName(cr))
+	op, err := e.groups.Patch(name, apigeeenvgroup.GenerateEnvGroup(meta.GetExternalName(cr), cr.Spec.ForProvider)).
+		UpdateMask("hostnames").
+		Context(ctx).
+		Do()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateEnvGroup)
+	}
+	gcp.RecordOperation(e.record, cr, "update", op.Name)
+	return managed.ExternalUpdate{}, nil
+}
+
+// Delete initiates an deletion of the external resource.
+func (e *envGroupExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.EnvGroup)
+	if !ok {
+		return errors.New(errNotEnvGroup)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	op, err := e.groups.Delete(apigeeenvgroup.GetFullyQualifiedName(gcp.StringValue(cr.Spec.ForProvider.Organization), meta.GetExternalName(cr))).Context(ctx).Do()
+	if err != nil {
+		return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteEnvGroup)
+	}
+	gcp.RecordOperation(e.record, cr, "delete", op.Name)
+	return nil
+}
@@ -0,0 +1,270 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apigee
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	apigee "google.golang.org/api/apigee/v1"
+	"google.golang.org/api/option"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/apigee/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/operation"
+)
+
+const (
+	envGroupName     = "cool-group"
+	envGroupFullName = "organizations/fooproject/envgroups/cool-group"
+	envGroupPath     = "/v1/" + envGroupFullName
+)
+
+func newEnvGroup(m ...func(*v1alpha1.EnvGroup)) *v1alpha1.EnvGroup {
+	cr := &v1alpha1.EnvGroup{}
+	meta.SetExternalName(cr, envGroupName)
+	cr.Spec.ForProvider = v1alpha1.EnvGroupParameters{
+		Organization: gcp.StringPtr(projectID),
+		Hostnames:    []string{"a.example.com", "b.example.com"},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func envGroupExternalFor(t *testing.T, h http.Handler) (*envGroupExternal, func()) {
+	server := httptest.NewServer(h)
+	s, err := apigee.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	if err != nil {
+		t.Fatalf("NewService(...): %v", err)
+	}
+	return &envGroupExternal{
+		client: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil), MockGet: test.NewMockGetFn(nil)},
+		record: event.NewNopRecorder(),
+		groups: s.Organizations.Envgroups,
+		ops:    s.Organizations.Operations,
+	}, server.Close
+}
+
+func TestEnvGroupObserve(t *testing.T) {
+	type want struct {
+		eo   managed.ExternalObservation
+		cond xpv1.Condition
+		err  bool
+	}
+	cases := map[string]struct {
+		reason string
+		group  *apigee.GoogleCloudApigeeV1EnvironmentGroup
+		status int
+		want   want
+	}{
+		"NotFound": {
+			reason: "An environment group that cannot be found does not exist.",
+			status: http.StatusNotFound,
+		},
+		"GetFailed": {
+			reason: "Errors getting an environment group should be returned.",
+			status: http.StatusInternalServerError,
+			want: want{
+				err: true,
+			},
+		},
+		"UpToDate": {
+			reason: "An active environment group with the desired hostnames, in any order, should be available and up to date.",
+			group: &apigee.GoogleCloudApigeeV1EnvironmentGroup{
+				Name:      envGroupName,
+				Hostnames: []string{"b.example.com", "a.example.com"},
+				State:     v1alpha1.StateActive,
+			},
+			want: want{
+				eo:   managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				cond: xpv1.Available(),
+			},
+		},
+		"HostnameRemoved": {
+			reason: "An environment group that lacks a desired hostname should not be up to date.",
+			group: &apigee.GoogleCloudApigeeV1EnvironmentGroup{
+				Name:      envGroupName,
+				Hostnames: []string{"a.example.com"},
+				State:     v1alpha1.StateUpdating,
+			},
+			want: want{
+				eo:   managed.ExternalObservation{ResourceExists: true},
+				cond: xpv1.Available(),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e, done := envGroupExternalFor(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(envGroupPath, r.URL.Path); diff != "" {
+					t.Errorf("r: -want path, +got path:\n%s", diff)
+				}
+				if tc.group == nil {
+					w.WriteHeader(tc.status)
+					_ = json.NewEncoder(w).Encode(&apigee.GoogleRpcStatus{})
+					return
+				}
+				_ = json.NewEncoder(w).Encode(tc.group)
+			}))
+			defer done()
+			cr := newEnvGroup()
+			got, err := e.Observe(context.Background(), cr)
+			if diff := cmp.Diff(tc.want.err, err != nil); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.eo, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if tc.want.cond.Type == "" {
+				return
+			}
+			if diff := cmp.Diff(envGroupFullName, cr.Status.AtProvider.Name); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want name, +got name:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.cond, cr.GetCondition(xpv1.TypeReady), test.EquateConditions()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want condition, +got condition:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestEnvGroupCreate(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		cr     *v1alpha1.EnvGroup
+		called bool
+	}{
+		"Successful": {
+			reason: "The environment group should be created, and the operation creating it persisted.",
+			cr:     newEnvGroup(),
+			called: true,
+		},
+		"CreateOperationRunning": {
+			reason: "An environment group should not be created again while it is being created.",
+			cr: newEnvGroup(func(cr *v1alpha1.EnvGroup) {
+				meta.AddAnnotations(cr, map[string]string{operation.AnnotationKeyCreateOperation: testOperation})
+			}),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			called := false
+			e, done := envGroupExternalFor(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				called = true
+				g := &apigee.GoogleCloudApigeeV1EnvironmentGroup{}
+				_ = json.NewDecoder(r.Body).Decode(g)
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+					t.Errorf("r: -want method, +got method:\n%s", diff)
+				}
+				if diff := cmp.Diff(envGroupName, r.URL.Query().Get("name")); diff != "" {
+					t.Errorf("r: -want name, +got name:\n%s", diff)
+				}
+				if diff := cmp.Diff([]string{"a.example.com", "b.example.com"}, g.Hostnames); diff != "" {
+					t.Errorf("r: -want hostnames, +got hostnames:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(&apigee.GoogleLongrunningOperation{Name: testOperation})
+			}))
+			defer done()
+			if _, err := e.Create(context.Background(), tc.cr); err != nil {
+				t.Fatalf("\n%s\nCreate(...): unexpected error: %v", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.called, called); diff != "" {
+				t.Errorf("\n%s\nCreate(...): -want called, +got called:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(testOperation, operation.CreateOperation(tc.cr)); diff != "" {
+				t.Errorf("\n%s\nCreate(...): -want operation, +got operation:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestEnvGroupUpdate(t *testing.T) {
+	e, done := envGroupExternalFor(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.Body.Close()
+		if diff := cmp.Diff(http.MethodPatch, r.Method); diff != "" {
+			t.Errorf("r: -want method, +got method:\n%s", diff)
+		}
+		if diff := cmp.Diff(envGroupPath, r.URL.Path); diff != "" {
+			t.Errorf("r: -want path, +got path:\n%s", diff)
+		}
+		if diff := cmp.Diff("hostnames", r.URL.Query().Get("updateMask")); diff != "" {
+			t.Errorf("r: -want update mask, +got update mask:\n%s", diff)
+		}
+		_ = json.NewEncoder(w).Encode(&apigee.GoogleLongrunningOperation{Name: testOperation})
+	}))
+	defer done()
+	if _, err := e.Update(context.Background(), newEnvGroup()); err != nil {
+		t.Errorf("Update(...): unexpected error: %v", err)
+	}
+}
+
+func TestEnvGroupDelete(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		status int
+		err    bool
+	}{
+		"Successful": {
+			reason: "Deleting an environment group should not fail.",
+			status: http.StatusOK,
+		},
+		"NotFound": {
+			reason: "An environment group that is already gone should be considered deleted.",
+			status: http.StatusNotFound,
+		},
+		"DeleteFailed": {
+			reason: "Errors deleting an environment group should be returned.",
+			status: http.StatusInternalServerError,
+			err:    true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e, done := envGroupExternalFor(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodDelete, r.Method); diff != "" {
+					t.Errorf("r: -want method, +got method:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(&apigee.GoogleLongrunningOperation{Name: testOperation})
+			}))
+			defer done()
+			cr := newEnvGroup()
+			err := e.Delete(context.Background(), cr)
+			if diff := cmp.Diff(tc.err, err != nil); diff != "" {
+				t.Errorf("\n%s\nDelete(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(xpv1.Deleting(), cr.GetCondition(xpv1.TypeReady), test.EquateConditions()); diff != "" {
+				t.Errorf("\n%s\nDelete(...): -want condition, +got condition:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,183 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apigee
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	apigee "google.golang.org/api/apigee/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/apigee/v1alpha1"
+	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/apigeeenvironment"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/setup"
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+	"github.com/crossplane-contrib/provider-gcp/pkg/operation"
+	"github.com/crossplane-contrib/provider-gcp/pkg/tracing"
+)
+
+const (
+	errNotEnvironment        = "managed resource is not of type Environment"
+	errGetEnvironment        = "cannot get Environment"
+	errCreateEnvironment     = "cannot create Environment"
+	errUpdateEnvironment     = "cannot update Environment"
+	errDeleteEnvironment     = "cannot delete Environment"
+	errKubeUpdateEnvironment = "cannot update Environment custom resource"
+)
+
+// SetupEnvironment adds a controller that reconciles Environments.
+func SetupEnvironment(mgr ctrl.Manager, o setup.Options) error {
+	name := managed.ControllerName(v1alpha1.EnvironmentGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
+	}
+
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.EnvironmentGroupVersionKind),
+		managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient()), operation.NewCreateResumer(mgr.GetClient())),
+		managed.WithExternalConnecter(setup.Connecter(mgr, o, v1alpha1.EnvironmentGroupKind, &environmentConnector{client: mgr.GetClient(), record: recorder})),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(recorder),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.Environment{}).
+		Complete(tracing.NewReconciler(name, ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter)))
+}
+
+type environmentConnector struct {
+	client client.Client
+	record event.Recorder
+}
+
+// Connect returns an ExternalClient with necessary information to talk to GCP API.
+func (c *environmentConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	_, opts, err := gcp.GetConnectionInfo(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := apigee.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &environmentExternal{client: c.client, record: c.record, envs: s.Organizations.Environments, ops: s.Organizations.Operations}, nil
+}
+
+type environmentExternal struct {
+	client client.Client
+	record event.Recorder
+	envs   *apigee.OrganizationsEnvironmentsService
+	ops    *apigee.OrganizationsOperationsService
+}
+
+// Observe makes observation about the external resource.
+func (e *environmentExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Environment)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotEnvironment)
+	}
+	if err := trackCreate(ctx, e.client, e.record, e.ops, cr); err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	name := apigeeenvironment.GetFullyQualifiedName(gcp.StringValue(cr.Spec.ForProvider.Organization), meta.GetExternalName(cr))
+	env, err := e.envs.Get(name).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetEnvironment)
+	}
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	apigeeenvironment.LateInitialize(&cr.Spec.ForProvider, *env)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
+		if err := e.client.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errKubeUpdateEnvironment)
+		}
+	}
+	cr.Status.AtProvider = apigeeenvironment.GenerateObservation(*env)
+	cr.Status.AtProvider.Name = name
+	setConditions(cr, env.State)
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: apigeeenvironment.IsUpToDate(cr.Spec.ForProvider, *env),
+	}, nil
+}
+
+// Create initiates creation of external resource.
+func (e *environmentExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Environment)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotEnvironment)
+	}
+	cr.SetConditions(xpv1.Creating())
+	// Wait until the environment is created if it is being created.
+	if operation.CreateOperation(cr) != "" {
+		return managed.ExternalCreation{}, nil
+	}
+	op, err := e.envs.Create(apigeeenvironment.GetParent(gcp.StringValue(cr.Spec.ForProvider.Organization)), apigeeenvironment.GenerateEnvironment(meta.GetExternalName(cr), cr.Spec.ForProvider)).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateEnvironment)
+	}
+	persistCreateOperation(ctx, e.client, e.record, cr, op)
+	return managed.ExternalCreation{}, nil
+}
+
+// Update initiates an update to the external resource. The properties of an
+// environment that are omitted are removed.
+func (e *environmentExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Environment)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotEnvironment)
+	}
+	name := apigeeenvironment.GetFullyQualifiedName(gcp.StringValue(cr.Spec.ForProvider.Organization), meta.GetExternalName(cr))
+	_, err := e.envs.Update(name, apigeeenvironment.GenerateEnvironment(meta.GetExternalName(cr), cr.Spec.ForProvider)).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateEnvironment)
+}
+
+// Delete initiates an deletion of the external resource.
+func (e *environmentExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Environment)
+	if !ok {
+		return errors.New(errNotEnvironment)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	op, err := e.envs.Delete(apigeeenvironment.GetFullyQualifiedName(gcp.StringValue(cr.Spec.ForProvider.Organization), meta.GetExternalName(cr))).Context(ctx).Do()
+	if err != nil {
+		return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteEnvironment)
+	}
+	gcp.RecordOperation(e.record, cr, "delete", op.Name)
+	return nil
+}
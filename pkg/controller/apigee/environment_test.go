@@ -0,0 +1,293 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apigee
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	apigee "google.golang.org/api/apigee/v1"
+	"google.golang.org/api/option"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/apigee/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/operation"
+)
+
+const (
+	environmentName     = "cool-env"
+	environmentFullName = "organizations/fooproject/environments/cool-env"
+	environmentPath     = "/v1/" + environmentFullName
+)
+
+var errBoom = errors.New("boom")
+
+func newEnvironment(m ...func(*v1alpha1.Environment)) *v1alpha1.Environment {
+	cr := &v1alpha1.Environment{}
+	meta.SetExternalName(cr, environmentName)
+	cr.Spec.ForProvider = v1alpha1.EnvironmentParameters{
+		Organization:   gcp.StringPtr(projectID),
+		DisplayName:    gcp.StringPtr("Cool"),
+		DeploymentType: gcp.StringPtr("PROXY"),
+		Properties:     map[string]string{"a": "b"},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func environmentExternalFor(t *testing.T, kube client.Client, h http.Handler) (*environmentExternal, func()) {
+	server := httptest.NewServer(h)
+	s, err := apigee.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	if err != nil {
+		t.Fatalf("NewService(...): %v", err)
+	}
+	return &environmentExternal{
+		client: kube,
+		record: event.NewNopRecorder(),
+		envs:   s.Organizations.Environments,
+		ops:    s.Organizations.Operations,
+	}, server.Close
+}
+
+func TestEnvironmentObserve(t *testing.T) {
+	type want struct {
+		eo   managed.ExternalObservation
+		cond xpv1.Condition
+		spec v1alpha1.EnvironmentParameters
+		err  error
+	}
+	cases := map[string]struct {
+		reason string
+		env    *apigee.GoogleCloudApigeeV1Environment
+		kube   client.Client
+		want   want
+	}{
+		"NotFound": {
+			reason: "An environment that cannot be found does not exist.",
+			want: want{
+				spec: newEnvironment().Spec.ForProvider,
+			},
+		},
+		"UpToDate": {
+			reason: "An active environment that is configured as desired should be available and up to date.",
+			env: &apigee.GoogleCloudApigeeV1Environment{
+				Name:           environmentName,
+				DisplayName:    "Cool",
+				DeploymentType: "PROXY",
+				ApiProxyType:   "API_PROXY_TYPE_UNSPECIFIED",
+				Properties:     &apigee.GoogleCloudApigeeV1Properties{Property: []*apigee.GoogleCloudApigeeV1Property{{Name: "a", Value: "b"}}},
+				State:          v1alpha1.StateActive,
+			},
+			want: want{
+				eo:   managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				cond: xpv1.Available(),
+				spec: newEnvironment().Spec.ForProvider,
+			},
+		},
+		"LateInitialized": {
+			reason: "Fields set by Apigee should be late-initialized, and a changed property should not be up to date.",
+			env: &apigee.GoogleCloudApigeeV1Environment{
+				Name:           environmentName,
+				DisplayName:    "Cool",
+				Description:    "Set by Apigee",
+				DeploymentType: "PROXY",
+				ApiProxyType:   "PROGRAMMABLE",
+				Properties:     &apigee.GoogleCloudApigeeV1Properties{Property: []*apigee.GoogleCloudApigeeV1Property{{Name: "a", Value: "c"}}},
+				State:          v1alpha1.StateCreating,
+			},
+			kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+			want: want{
+				eo:   managed.ExternalObservation{ResourceExists: true},
+				cond: xpv1.Creating(),
+				spec: newEnvironment(func(cr *v1alpha1.Environment) {
+					cr.Spec.ForProvider.Description = gcp.StringPtr("Set by Apigee")
+					cr.Spec.ForProvider.APIProxyType = gcp.StringPtr("PROGRAMMABLE")
+				}).Spec.ForProvider,
+			},
+		},
+		"SpecUpdateFailed": {
+			reason: "Errors persisting late-initialized fields should be returned.",
+			env: &apigee.GoogleCloudApigeeV1Environment{
+				Name:        environmentName,
+				Description: "Set by Apigee",
+			},
+			kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			want: want{
+				spec: newEnvironment(func(cr *v1alpha1.Environment) {
+					cr.Spec.ForProvider.Description = gcp.StringPtr("Set by Apigee")
+				}).Spec.ForProvider,
+				err: errors.Wrap(errBoom, errKubeUpdateEnvironment),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e, done := environmentExternalFor(t, tc.kube, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(environmentPath, r.URL.Path); diff != "" {
+					t.Errorf("r: -want path, +got path:\n%s", diff)
+				}
+				if tc.env == nil {
+					w.WriteHeader(http.StatusNotFound)
+					_ = json.NewEncoder(w).Encode(&apigee.GoogleRpcStatus{})
+					return
+				}
+				_ = json.NewEncoder(w).Encode(tc.env)
+			}))
+			defer done()
+			cr := newEnvironment()
+			got, err := e.Observe(context.Background(), cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.eo, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.spec, cr.Spec.ForProvider); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want spec, +got spec:\n%s", tc.reason, diff)
+			}
+			if tc.want.cond.Type == "" {
+				return
+			}
+			if diff := cmp.Diff(tc.want.cond, cr.GetCondition(xpv1.TypeReady), test.EquateConditions()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want condition, +got condition:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestEnvironmentCreate(t *testing.T) {
+	e, done := environmentExternalFor(t, &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil), MockGet: test.NewMockGetFn(nil)}, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		env := &apigee.GoogleCloudApigeeV1Environment{}
+		_ = json.NewDecoder(r.Body).Decode(env)
+		_ = r.Body.Close()
+		if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+			t.Errorf("r: -want method, +got method:\n%s", diff)
+		}
+		if diff := cmp.Diff("/v1/organizations/fooproject/environments", r.URL.Path); diff != "" {
+			t.Errorf("r: -want path, +got path:\n%s", diff)
+		}
+		if diff := cmp.Diff(environmentName, env.Name); diff != "" {
+			t.Errorf("r: -want name, +got name:\n%s", diff)
+		}
+		_ = json.NewEncoder(w).Encode(&apigee.GoogleLongrunningOperation{Name: testOperation})
+	}))
+	defer done()
+	cr := newEnvironment()
+	if _, err := e.Create(context.Background(), cr); err != nil {
+		t.Fatalf("Create(...): unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(testOperation, operation.CreateOperation(cr)); diff != "" {
+		t.Errorf("Create(...): -want operation, +got operation:\n%s", diff)
+	}
+}
+
+func TestEnvironmentUpdate(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		status int
+		err    bool
+	}{
+		"Successful": {
+			reason: "The environment should be replaced with the desired one.",
+			status: http.StatusOK,
+		},
+		"UpdateFailed": {
+			reason: "Errors updating an environment should be returned.",
+			status: http.StatusBadRequest,
+			err:    true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e, done := environmentExternalFor(t, nil, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				env := &apigee.GoogleCloudApigeeV1Environment{}
+				_ = json.NewDecoder(r.Body).Decode(env)
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodPut, r.Method); diff != "" {
+					t.Errorf("r: -want method, +got method:\n%s", diff)
+				}
+				if diff := cmp.Diff(environmentPath, r.URL.Path); diff != "" {
+					t.Errorf("r: -want path, +got path:\n%s", diff)
+				}
+				if diff := cmp.Diff("Cool", env.DisplayName); diff != "" {
+					t.Errorf("r: -want display name, +got display name:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(env)
+			}))
+			defer done()
+			_, err := e.Update(context.Background(), newEnvironment())
+			if diff := cmp.Diff(tc.err, err != nil); diff != "" {
+				t.Errorf("\n%s\nUpdate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestEnvironmentDelete(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		status int
+		err    bool
+	}{
+		"Successful": {
+			reason: "Deleting an environment should not fail.",
+			status: http.StatusOK,
+		},
+		"NotFound": {
+			reason: "An environment that is already gone should be considered deleted.",
+			status: http.StatusNotFound,
+		},
+		"DeleteFailed": {
+			reason: "Errors deleting an environment should be returned.",
+			status: http.StatusInternalServerError,
+			err:    true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e, done := environmentExternalFor(t, nil, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodDelete, r.Method); diff != "" {
+					t.Errorf("r: -want method, +got method:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(&apigee.GoogleLongrunningOperation{Name: testOperation})
+			}))
+			defer done()
+			err := e.Delete(context.Background(), newEnvironment())
+			if diff := cmp.Diff(tc.err, err != nil); diff != "" {
+				t.Errorf("\n%s\nDelete(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
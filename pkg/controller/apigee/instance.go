@@ -0,0 +1,199 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apigee
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	apigee "google.golang.org/api/apigee/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/apigee/v1alpha1"
+	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/apigeeinstance"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/setup"
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+	"github.com/crossplane-contrib/provider-gcp/pkg/operation"
+	"github.com/crossplane-contrib/provider-gcp/pkg/tracing"
+)
+
+const (
+	errNotInstance        = "managed resource is not of type Instance"
+	errGetInstance        = "cannot get Instance"
+	errCreateInstance     = "cannot create Instance"
+	errUpdateInstance     = "cannot update Instance"
+	errDeleteInstance     = "cannot delete Instance"
+	errKubeUpdateInstance = "cannot update Instance custom resource"
+)
+
+// SetupInstance adds a controller that reconciles Instances.
+func SetupInstance(mgr ctrl.Manager, o setup.Options) error {
+	name := managed.ControllerName(v1alpha1.InstanceGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
+	}
+
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.InstanceGroupVersionKind),
+		managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient()), operation.NewCreateResumer(mgr.GetClient())),
+		managed.WithExternalConnecter(setup.Connecter(mgr, o, v1alpha1.InstanceGroupKind, &instanceConnector{client: mgr.GetClient(), record: recorder})),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(recorder),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.Instance{}).
+		Complete(tracing.NewReconciler(name, ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter)))
+}
+
+type instanceConnector struct {
+	client client.Client
+	record event.Recorder
+}
+
+// Connect returns an ExternalClient with necessary information to talk to GCP API.
+func (c *instanceConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	_, opts, err := gcp.GetConnectionInfo(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := apigee.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &instanceExternal{client: c.client, record: c.record, instances: s.Organizations.Instances, ops: s.Organizations.Operations}, nil
+}
+
+type instanceExternal struct {
+	client    client.Client
+	record    event.Recorder
+	instances *apigee.OrganizationsInstancesService
+	ops       *apigee.OrganizationsOperationsService
+}
+
+// Observe makes observation about the external resource.
+func (e *instanceExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Instance)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotInstance)
+	}
+	if err := trackCreate(ctx, e.client, e.record, e.ops, cr); err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	name := apigeeinstance.GetFullyQualifiedName(gcp.StringValue(cr.Spec.ForProvider.Organization), meta.GetExternalName(cr))
+	i, err := e.instances.Get(name).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetInstance)
+	}
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	apigeeinstance.LateInitialize(&cr.Spec.ForProvider, *i)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
+		if err := e.client.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errKubeUpdateInstance)
+		}
+	}
+	cr.Status.AtProvider = apigeeinstance.GenerateObservation(*i)
+	cr.Status.AtProvider.Name = name
+	setConditions(cr, i.State)
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  apigeeinstance.IsUpToDate(cr.Spec.ForProvider, *i),
+		ConnectionDetails: apigeeinstance.GetConnectionDetails(*i),
+	}, nil
+}
+
+// Create initiates creation of external resource.
+func (e *instanceExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Instance)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotInstance)
+	}
+	cr.SetConditions(xpv1.Creating())
+	// Wait until the instance is created if it is being created.
+	if operation.CreateOperation(cr) != "" {
+		return managed.ExternalCreation{}, nil
+	}
+	op, err := e.instances.Create(apigeeinstance.GetParent(gcp.StringValue(cr.Spec.ForProvider.Organization)), apigeeinstance.GenerateInstance(meta.GetExternalName(cr), cr.Spec.ForProvider)).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateInstance)
+	}
+	persistCreateOperation(ctx, e.client, e.record, cr, op)
+	return managed.ExternalCreation{}, nil
+}
+
+// Update initiates an update to the external resource. Only the consumer
+// accept list of an instance can be updated.
+func (e *instanceExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Instance)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotInstance)
+	}
+	// Do not issue another update until the instance finishes the previous
+	// one.
+	if cr.Status.AtProvider.State == v1alpha1.StateUpdating {
+		return managed.ExternalUpdate{}, nil
+	}
+	name := apigeeinstance.GetFullyQualifiedName(gcp.StringValue(cr.Spec.ForProvider.Organization), meta.GetExternalName(cr))
+	op, err := e.instances.Patch(name, apigeeinstance.GenerateInstance(meta.GetExternalName(cr), cr.Spec.ForProvider)).
+		UpdateMask("consumerAcceptList").
+		Context(ctx).
+		Do()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateInstance)
+	}
+	gcp.RecordOperation(e.record, cr, "update", op.Name)
+	return managed.ExternalUpdate{}, nil
+}
+
+// Delete initiates an deletion of the external resource.
+func (e *instanceExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Instance)
+	if !ok {
+		return errors.New(errNotInstance)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	if cr.Status.AtProvider.State == v1alpha1.StateDeleting {
+		return nil
+	}
+	op, err := e.instances.Delete(apigeeinstance.GetFullyQualifiedName(gcp.StringValue(cr.Spec.ForProvider.Organization), meta.GetExternalName(cr))).Context(ctx).Do()
+	if err != nil {
+		return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteInstance)
+	}
+	gcp.RecordOperation(e.record, cr, "delete", op.Name)
+	return nil
+}
@@ -0,0 +1,284 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apigee
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	apigee "google.golang.org/api/apigee/v1"
+	"google.golang.org/api/option"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/apigee/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/operation"
+)
+
+const (
+	instanceName = "cool-instance"
+	instancePath = "/v1/organizations/fooproject/instances/cool-instance"
+)
+
+func newInstance(m ...func(*v1alpha1.Instance)) *v1alpha1.Instance {
+	cr := &v1alpha1.Instance{}
+	meta.SetExternalName(cr, instanceName)
+	cr.Spec.ForProvider = v1alpha1.InstanceParameters{
+		Organization:       gcp.StringPtr(projectID),
+		Location:           "us-central1",
+		PeeringCIDRRange:   gcp.StringPtr("SLASH_22"),
+		ConsumerAcceptList: []string{"a", "b"},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func withInstanceState(s string) func(*v1alpha1.Instance) {
+	return func(cr *v1alpha1.Instance) { cr.Status.AtProvider.State = s }
+}
+
+func instanceExternalFor(t *testing.T, h http.Handler) (*instanceExternal, func()) {
+	server := httptest.NewServer(h)
+	s, err := apigee.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	if err != nil {
+		t.Fatalf("NewService(...): %v", err)
+	}
+	return &instanceExternal{
+		client:    &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil), MockGet: test.NewMockGetFn(nil)},
+		record:    event.NewNopRecorder(),
+		instances: s.Organizations.Instances,
+		ops:       s.Organizations.Operations,
+	}, server.Close
+}
+
+func TestInstanceObserve(t *testing.T) {
+	type want struct {
+		eo   managed.ExternalObservation
+		cond xpv1.Condition
+	}
+	cases := map[string]struct {
+		reason   string
+		instance *apigee.GoogleCloudApigeeV1Instance
+		want     want
+	}{
+		"NotFound": {
+			reason: "An instance that cannot be found does not exist.",
+		},
+		"Available": {
+			reason: "An active instance should be available, and publish its endpoint.",
+			instance: &apigee.GoogleCloudApigeeV1Instance{
+				Name:               instanceName,
+				Location:           "us-central1",
+				PeeringCidrRange:   "SLASH_22",
+				ConsumerAcceptList: []string{"b", "a"},
+				Host:               "10.0.0.2",
+				Port:               "443",
+				State:              v1alpha1.StateActive,
+			},
+			want: want{
+				eo: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretEndpointKey: []byte("10.0.0.2"),
+						xpv1.ResourceCredentialsSecretPortKey:     []byte("443"),
+					},
+				},
+				cond: xpv1.Available(),
+			},
+		},
+		"ProjectRemoved": {
+			reason: "An instance that accepts a project that is no longer desired should not be up to date.",
+			instance: &apigee.GoogleCloudApigeeV1Instance{
+				Name:               instanceName,
+				Location:           "us-central1",
+				PeeringCidrRange:   "SLASH_22",
+				ConsumerAcceptList: []string{"a", "b", "c"},
+				State:              v1alpha1.StateActive,
+			},
+			want: want{
+				eo: managed.ExternalObservation{
+					ResourceExists:    true,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				cond: xpv1.Available(),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e, done := instanceExternalFor(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(instancePath, r.URL.Path); diff != "" {
+					t.Errorf("r: -want path, +got path:\n%s", diff)
+				}
+				if tc.instance == nil {
+					w.WriteHeader(http.StatusNotFound)
+					_ = json.NewEncoder(w).Encode(&apigee.GoogleRpcStatus{})
+					return
+				}
+				_ = json.NewEncoder(w).Encode(tc.instance)
+			}))
+			defer done()
+			cr := newInstance()
+			got, err := e.Observe(context.Background(), cr)
+			if err != nil {
+				t.Fatalf("\n%s\nObserve(...): unexpected error: %v", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want.eo, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if tc.want.cond.Type == "" {
+				return
+			}
+			if diff := cmp.Diff(tc.want.cond, cr.GetCondition(xpv1.TypeReady), test.EquateConditions()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want condition, +got condition:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestInstanceCreate(t *testing.T) {
+	e, done := instanceExternalFor(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		i := &apigee.GoogleCloudApigeeV1Instance{}
+		_ = json.NewDecoder(r.Body).Decode(i)
+		_ = r.Body.Close()
+		if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+			t.Errorf("r: -want method, +got method:\n%s", diff)
+		}
+		if diff := cmp.Diff("us-central1", i.Location); diff != "" {
+			t.Errorf("r: -want location, +got location:\n%s", diff)
+		}
+		_ = json.NewEncoder(w).Encode(&apigee.GoogleLongrunningOperation{Name: testOperation})
+	}))
+	defer done()
+	cr := newInstance()
+	if _, err := e.Create(context.Background(), cr); err != nil {
+		t.Fatalf("Create(...): unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(testOperation, operation.CreateOperation(cr)); diff != "" {
+		t.Errorf("Create(...): -want operation, +got operation:\n%s", diff)
+	}
+}
+
+func TestInstanceUpdate(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		cr     *v1alpha1.Instance
+		called bool
+	}{
+		"Successful": {
+			reason: "The consumer accept list of an instance should be patched.",
+			cr:     newInstance(withInstanceState(v1alpha1.StateActive)),
+			called: true,
+		},
+		"Updating": {
+			reason: "An instance should not be patched while it is being updated.",
+			cr:     newInstance(withInstanceState(v1alpha1.StateUpdating)),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			called := false
+			e, done := instanceExternalFor(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				called = true
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodPatch, r.Method); diff != "" {
+					t.Errorf("r: -want method, +got method:\n%s", diff)
+				}
+				if diff := cmp.Diff("consumerAcceptList", r.URL.Query().Get("updateMask")); diff != "" {
+					t.Errorf("r: -want update mask, +got update mask:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(&apigee.GoogleLongrunningOperation{Name: testOperation})
+			}))
+			defer done()
+			if _, err := e.Update(context.Background(), tc.cr); err != nil {
+				t.Fatalf("\n%s\nUpdate(...): unexpected error: %v", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.called, called); diff != "" {
+				t.Errorf("\n%s\nUpdate(...): -want called, +got called:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestInstanceDelete(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		cr     *v1alpha1.Instance
+		status int
+		called bool
+		err    bool
+	}{
+		"Successful": {
+			reason: "Deleting an instance should not fail.",
+			cr:     newInstance(withInstanceState(v1alpha1.StateActive)),
+			status: http.StatusOK,
+			called: true,
+		},
+		"Deleting": {
+			reason: "An instance should not be deleted again while it is being deleted.",
+			cr:     newInstance(withInstanceState(v1alpha1.StateDeleting)),
+		},
+		"NotFound": {
+			reason: "An instance that is already gone should be considered deleted.",
+			cr:     newInstance(),
+			status: http.StatusNotFound,
+			called: true,
+		},
+		"DeleteFailed": {
+			reason: "Errors deleting an instance should be returned.",
+			cr:     newInstance(),
+			status: http.StatusInternalServerError,
+			called: true,
+			err:    true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			called := false
+			e, done := instanceExternalFor(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				called = true
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodDelete, r.Method); diff != "" {
+					t.Errorf("r: -want method, +got method:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(&apigee.GoogleLongrunningOperation{Name: testOperation})
+			}))
+			defer done()
+			err := e.Delete(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.err, err != nil); diff != "" {
+				t.Errorf("\n%s\nDelete(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.called, called); diff != "" {
+				t.Errorf("\n%s\nDelete(...): -want called, +got called:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
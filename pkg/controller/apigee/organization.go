@@ -0,0 +1,189 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apigee
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	apigee "google.golang.org/api/apigee/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/apigee/v1alpha1"
+	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/apigeeorganization"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/setup"
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+	"github.com/crossplane-contrib/provider-gcp/pkg/operation"
+	"github.com/crossplane-contrib/provider-gcp/pkg/tracing"
+)
+
+const (
+	errNotOrganization        = "managed resource is not of type Organization"
+	errGetOrganization        = "cannot get Organization"
+	errCreateOrganization     = "cannot create Organization"
+	errUpdateOrganization     = "cannot update Organization"
+	errDeleteOrganization     = "cannot delete Organization"
+	errKubeUpdateOrganization = "cannot update Organization custom resource"
+)
+
+// SetupOrganization adds a controller that reconciles Organizations.
+func SetupOrganization(mgr ctrl.Manager, o setup.Options) error {
+	name := managed.ControllerName(v1alpha1.OrganizationGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
+	}
+
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.OrganizationGroupVersionKind),
+		// An organization is named after its project when it is created.
+		managed.WithInitializers(operation.NewCreateResumer(mgr.GetClient())),
+		managed.WithExternalConnecter(setup.Connecter(mgr, o, v1alpha1.OrganizationGroupKind, &organizationConnector{client: mgr.GetClient(), record: recorder})),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(recorder),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.Organization{}).
+		Complete(tracing.NewReconciler(name, ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter)))
+}
+
+type organizationConnector struct {
+	client client.Client
+	record event.Recorder
+}
+
+// Connect returns an ExternalClient with necessary information to talk to GCP API.
+func (c *organizationConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetConnectionInfo(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := apigee.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &organizationExternal{projectID: projectID, client: c.client, record: c.record, orgs: s.Organizations, ops: s.Organizations.Operations}, nil
+}
+
+type organizationExternal struct {
+	projectID string
+	client    client.Client
+	record    event.Recorder
+	orgs      *apigee.OrganizationsService
+	ops       *apigee.OrganizationsOperationsService
+}
+
+// Observe makes observation about the external resource.
+func (e *organizationExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Organization)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotOrganization)
+	}
+	if err := trackCreate(ctx, e.client, e.record, e.ops, cr); err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	name := apigeeorganization.GetFullyQualifiedName(meta.GetExternalName(cr))
+	o, err := e.orgs.Get(name).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetOrganization)
+	}
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	apigeeorganization.LateInitialize(&cr.Spec.ForProvider, *o)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
+		if err := e.client.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errKubeUpdateOrganization)
+		}
+	}
+	cr.Status.AtProvider = apigeeorganization.GenerateObservation(*o)
+	cr.Status.AtProvider.Name = name
+	setConditions(cr, o.State)
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: apigeeorganization.IsUpToDate(cr.Spec.ForProvider, *o),
+	}, nil
+}
+
+// Create initiates creation of external resource.
+func (e *organizationExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Organization)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotOrganization)
+	}
+	cr.SetConditions(xpv1.Creating())
+	// Wait until the organization is created if it is being created.
+	if operation.CreateOperation(cr) != "" {
+		return managed.ExternalCreation{}, nil
+	}
+	op, err := e.orgs.Create(apigeeorganization.GenerateOrganization("", cr.Spec.ForProvider)).
+		Parent(apigeeorganization.GetParent(e.projectID)).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateOrganization)
+	}
+	meta.SetExternalName(cr, e.projectID)
+	persistCreateOperation(ctx, e.client, e.record, cr, op)
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+// Update initiates an update to the external resource.
+func (e *organizationExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Organization)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotOrganization)
+	}
+	name := apigeeorganization.GetFullyQualifiedName(meta.GetExternalName(cr))
+	_, err := e.orgs.Update(name, apigeeorganization.GenerateOrganization(meta.GetExternalName(cr), cr.Spec.ForProvider)).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateOrganization)
+}
+
+// Delete initiates an deletion of the external resource.
+func (e *organizationExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Organization)
+	if !ok {
+		return errors.New(errNotOrganization)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	op, err := e.orgs.Delete(apigeeorganization.GetFullyQualifiedName(meta.GetExternalName(cr))).Context(ctx).Do()
+	if err != nil {
+		return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteOrganization)
+	}
+	gcp.RecordOperation(e.record, cr, "delete", op.Name)
+	return nil
+}
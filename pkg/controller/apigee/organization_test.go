@@ -0,0 +1,238 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apigee
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	apigee "google.golang.org/api/apigee/v1"
+	"google.golang.org/api/option"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/apigee/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/operation"
+)
+
+const (
+	projectID     = "fooproject"
+	orgPath       = "/v1/organizations/fooproject"
+	testOperation = "organizations/fooproject/operations/abc"
+	operationPath = "/v1/" + testOperation
+)
+
+func newOrganization(m ...func(*v1alpha1.Organization)) *v1alpha1.Organization {
+	cr := &v1alpha1.Organization{}
+	cr.Spec.ForProvider = v1alpha1.OrganizationParameters{
+		RuntimeType:     gcp.StringPtr("CLOUD"),
+		BillingType:     gcp.StringPtr("EVALUATION"),
+		AnalyticsRegion: "us-central1",
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func withExternalName(n string) func(*v1alpha1.Organization) {
+	return func(cr *v1alpha1.Organization) { meta.SetExternalName(cr, n) }
+}
+
+func withCreateOperation(op string) func(*v1alpha1.Organization) {
+	return func(cr *v1alpha1.Organization) {
+		meta.AddAnnotations(cr, map[string]string{operation.AnnotationKeyCreateOperation: op})
+	}
+}
+
+func organizationExternalFor(t *testing.T, h http.Handler) (*organizationExternal, func()) {
+	server := httptest.NewServer(h)
+	s, err := apigee.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	if err != nil {
+		t.Fatalf("NewService(...): %v", err)
+	}
+	return &organizationExternal{
+		projectID: projectID,
+		client:    &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil), MockGet: test.NewMockGetFn(nil)},
+		record:    event.NewNopRecorder(),
+		orgs:      s.Organizations,
+		ops:       s.Organizations.Operations,
+	}, server.Close
+}
+
+func TestOrganizationObserve(t *testing.T) {
+	type want struct {
+		eo        managed.ExternalObservation
+		cond      xpv1.Condition
+		operation string
+	}
+	cases := map[string]struct {
+		reason string
+		cr     *v1alpha1.Organization
+		op     *apigee.GoogleLongrunningOperation
+		org    *apigee.GoogleCloudApigeeV1Organization
+		want   want
+	}{
+		"NoExternalName": {
+			reason: "An organization without an external name has not been created.",
+			cr:     newOrganization(),
+		},
+		"NotFound": {
+			reason: "An organization that cannot be found does not exist.",
+			cr:     newOrganization(withExternalName(projectID)),
+		},
+		"CreateOperationRunning": {
+			reason: "The operation creating an organization should be kept until it is done.",
+			cr:     newOrganization(withExternalName(projectID), withCreateOperation(testOperation)),
+			op:     &apigee.GoogleLongrunningOperation{Name: testOperation},
+			want: want{
+				operation: testOperation,
+			},
+		},
+		"CreateOperationDone": {
+			reason: "The operation creating an organization should be forgotten once it is done.",
+			cr:     newOrganization(withExternalName(projectID), withCreateOperation(testOperation)),
+			op:     &apigee.GoogleLongrunningOperation{Name: testOperation, Done: true},
+			org: &apigee.GoogleCloudApigeeV1Organization{
+				Name:            projectID,
+				RuntimeType:     "CLOUD",
+				BillingType:     "EVALUATION",
+				AnalyticsRegion: "us-central1",
+				State:           v1alpha1.StateActive,
+			},
+			want: want{
+				eo:   managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				cond: xpv1.Available(),
+			},
+		},
+		"Creating": {
+			reason: "An organization that is being created should not be available.",
+			cr:     newOrganization(withExternalName(projectID)),
+			org: &apigee.GoogleCloudApigeeV1Organization{
+				Name:  projectID,
+				State: v1alpha1.StateCreating,
+			},
+			want: want{
+				eo:   managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				cond: xpv1.Creating(),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e, done := organizationExternalFor(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				switch {
+				case r.URL.Path == orgPath && tc.org != nil:
+					_ = json.NewEncoder(w).Encode(tc.org)
+				case r.URL.Path == operationPath && tc.op != nil:
+					_ = json.NewEncoder(w).Encode(tc.op)
+				case r.URL.Path == orgPath, r.URL.Path == operationPath:
+					w.WriteHeader(http.StatusNotFound)
+					_ = json.NewEncoder(w).Encode(&apigee.GoogleRpcStatus{})
+				default:
+					t.Errorf("r: unexpected request to %s", r.URL.Path)
+				}
+			}))
+			defer done()
+			got, err := e.Observe(context.Background(), tc.cr)
+			if err != nil {
+				t.Fatalf("\n%s\nObserve(...): unexpected error: %v", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want.eo, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.operation, operation.CreateOperation(tc.cr)); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want operation, +got operation:\n%s", tc.reason, diff)
+			}
+			if tc.want.cond.Type == "" {
+				return
+			}
+			if diff := cmp.Diff(tc.want.cond, tc.cr.GetCondition(xpv1.TypeReady), test.EquateConditions()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want condition, +got condition:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestOrganizationCreate(t *testing.T) {
+	type want struct {
+		ec           managed.ExternalCreation
+		externalName string
+		operation    string
+	}
+	cases := map[string]struct {
+		reason string
+		cr     *v1alpha1.Organization
+		want   want
+	}{
+		"Successful": {
+			reason: "The organization should be named after the project, and the operation creating it persisted.",
+			cr:     newOrganization(),
+			want: want{
+				ec:           managed.ExternalCreation{ExternalNameAssigned: true},
+				externalName: projectID,
+				operation:    testOperation,
+			},
+		},
+		"CreateOperationRunning": {
+			reason: "An organization should not be created again while it is being created.",
+			cr:     newOrganization(withExternalName(projectID), withCreateOperation(testOperation)),
+			want: want{
+				externalName: projectID,
+				operation:    testOperation,
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e, done := organizationExternalFor(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if !tc.want.ec.ExternalNameAssigned {
+					t.Errorf("r: unexpected request to %s", r.URL.Path)
+				}
+				if diff := cmp.Diff("projects/"+projectID, r.URL.Query().Get("parent")); diff != "" {
+					t.Errorf("r: -want parent, +got parent:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(&apigee.GoogleLongrunningOperation{Name: testOperation})
+			}))
+			defer done()
+			got, err := e.Create(context.Background(), tc.cr)
+			if err != nil {
+				t.Fatalf("\n%s\nCreate(...): unexpected error: %v", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want.ec, got); diff != "" {
+				t.Errorf("\n%s\nCreate(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.externalName, meta.GetExternalName(tc.cr)); diff != "" {
+				t.Errorf("\n%s\nCreate(...): -want external name, +got external name:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.operation, operation.CreateOperation(tc.cr)); diff != "" {
+				t.Errorf("\n%s\nCreate(...): -want operation, +got operation:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
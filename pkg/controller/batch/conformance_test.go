@@ -0,0 +1,38 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package batch
+
+import (
+	"testing"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/batch/v1alpha1"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/conformance"
+)
+
+func TestConformance(t *testing.T) {
+	conformance.Run(t, []string{v1alpha1.Group}, map[string]conformance.Fixture{
+		v1alpha1.JobGroupKind: {
+			Managed:   func() resource.Managed { return &v1alpha1.Job{} },
+			Connecter: func(kube client.Client) managed.ExternalConnecter { return &jobConnector{client: kube} },
+		},
+	})
+}
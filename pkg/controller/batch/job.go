@@ -0,0 +1,177 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package batch
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	batch "google.golang.org/api/batch/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/batch/v1alpha1"
+	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/job"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/setup"
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+	"github.com/crossplane-contrib/provider-gcp/pkg/tracing"
+)
+
+const (
+	errNewClient     = "cannot create new Batch client"
+	errNotJob        = "managed resource is not of type Job"
+	errGetJob        = "cannot get Job"
+	errCreateJob     = "cannot create Job"
+	errDeleteJob     = "cannot delete Job"
+	errKubeUpdateJob = "cannot update Job custom resource"
+)
+
+// SetupJob adds a controller that reconciles Jobs.
+func SetupJob(mgr ctrl.Manager, o setup.Options) error {
+	name := managed.ControllerName(v1alpha1.JobGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
+	}
+
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.JobGroupVersionKind),
+		managed.WithExternalConnecter(setup.Connecter(mgr, o, v1alpha1.JobGroupKind, &jobConnector{client: mgr.GetClient(), record: recorder})),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(recorder),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.Job{}).
+		Complete(tracing.NewReconciler(name, ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter)))
+}
+
+type jobConnector struct {
+	client client.Client
+	record event.Recorder
+}
+
+// Connect returns an ExternalClient with necessary information to talk to GCP API.
+func (c *jobConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetConnectionInfo(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := batch.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &jobExternal{projectID: projectID, client: c.client, batch: s, record: c.record}, nil
+}
+
+type jobExternal struct {
+	projectID string
+	client    client.Client
+	batch     *batch.Service
+	record    event.Recorder
+}
+
+// Observe makes observation about the external resource.
+func (e *jobExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Job)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotJob)
+	}
+	name := job.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider.Location, meta.GetExternalName(cr))
+	j, err := e.batch.Projects.Locations.Jobs.Get(name).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetJob)
+	}
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	job.LateInitialize(&cr.Spec.ForProvider, *j)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
+		if err := e.client.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errKubeUpdateJob)
+		}
+	}
+	cr.Status.AtProvider = job.GenerateObservation(*j)
+	switch cr.Status.AtProvider.State {
+	case v1alpha1.JobStateQueued, v1alpha1.JobStateScheduled:
+		cr.SetConditions(xpv1.Creating())
+	case v1alpha1.JobStateFailed:
+		cr.SetConditions(xpv1.Unavailable())
+	case v1alpha1.JobStateDeletionInProgress:
+		cr.SetConditions(xpv1.Deleting())
+	default:
+		cr.SetConditions(xpv1.Available())
+	}
+	// Batch jobs can not be updated once they are created, so the Job is
+	// always considered up to date.
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: true,
+	}, nil
+}
+
+// Create initiates creation of external resource.
+func (e *jobExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Job)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotJob)
+	}
+	cr.SetConditions(xpv1.Creating())
+	_, err := e.batch.Projects.Locations.Jobs.Create(job.GetParent(e.projectID, cr.Spec.ForProvider.Location), job.GenerateJob("", cr.Spec.ForProvider)).
+		JobId(meta.GetExternalName(cr)).
+		Context(ctx).
+		Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateJob)
+}
+
+// Update is a no-op, since Batch jobs can not be updated.
+func (e *jobExternal) Update(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
+	return managed.ExternalUpdate{}, nil
+}
+
+// Delete initiates an deletion of the external resource. Nothing is done if
+// the job is already being deleted.
+func (e *jobExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Job)
+	if !ok {
+		return errors.New(errNotJob)
+	}
+	if cr.Status.AtProvider.State == v1alpha1.JobStateDeletionInProgress {
+		return nil
+	}
+	name := job.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider.Location, meta.GetExternalName(cr))
+	op, err := e.batch.Projects.Locations.Jobs.Delete(name).Context(ctx).Do()
+	if err != nil {
+		return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteJob)
+	}
+	gcp.RecordOperation(e.record, cr, "delete", op.Name)
+	return nil
+}
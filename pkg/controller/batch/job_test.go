@@ -0,0 +1,271 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package batch
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	batch "google.golang.org/api/batch/v1"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/batch/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	projectID = "fooproject"
+	jobName   = "render"
+	jobPath   = "/v1/projects/fooproject/locations/us-central1/jobs/render"
+)
+
+func gError(code int, message string) *googleapi.Error {
+	return &googleapi.Error{
+		Code:    code,
+		Body:    "{}\n",
+		Message: message,
+	}
+}
+
+func newJob(m ...func(*v1alpha1.Job)) *v1alpha1.Job {
+	j := &v1alpha1.Job{}
+	meta.SetExternalName(j, jobName)
+	j.Spec.ForProvider = v1alpha1.JobParameters{
+		Location: "us-central1",
+		TaskGroups: []v1alpha1.TaskGroup{{
+			TaskSpec: v1alpha1.TaskSpec{
+				Runnables: []v1alpha1.Runnable{{
+					Script: &v1alpha1.Script{Text: gcp.StringPtr("echo hello")},
+				}},
+			},
+			TaskCount:   gcp.Int64Ptr(4),
+			Parallelism: gcp.Int64Ptr(2),
+		}},
+	}
+	for _, f := range m {
+		f(j)
+	}
+	return j
+}
+
+func observedJob(state string) *batch.Job {
+	return &batch.Job{
+		Name: "projects/fooproject/locations/us-central1/jobs/render",
+		TaskGroups: []*batch.TaskGroup{{
+			TaskSpec: &batch.TaskSpec{
+				Runnables: []*batch.Runnable{{Script: &batch.Script{Text: "echo hello"}}},
+			},
+			TaskCount:   4,
+			Parallelism: 2,
+		}},
+		Status: &batch.JobStatus{State: state},
+	}
+}
+
+func TestJobObserve(t *testing.T) {
+	type want struct {
+		eo   managed.ExternalObservation
+		cond xpv1.Condition
+		err  error
+	}
+	cases := map[string]struct {
+		handler http.Handler
+		mg      *v1alpha1.Job
+		want    want
+	}{
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+			mg: newJob(),
+		},
+		"GetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+			mg:   newJob(),
+			want: want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetJob)},
+		},
+		"Queued": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(jobPath, r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(observedJob(v1alpha1.JobStateQueued))
+			}),
+			mg: newJob(),
+			want: want{
+				eo:   managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				cond: xpv1.Creating(),
+			},
+		},
+		"Succeeded": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(observedJob(v1alpha1.JobStateSucceeded))
+			}),
+			mg: newJob(),
+			want: want{
+				eo:   managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				cond: xpv1.Available(),
+			},
+		},
+		"Failed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(observedJob(v1alpha1.JobStateFailed))
+			}),
+			mg: newJob(),
+			want: want{
+				eo:   managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				cond: xpv1.Unavailable(),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := batch.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := jobExternal{projectID: projectID, batch: s}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.eo, got); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if tc.want.cond.Type == "" {
+				return
+			}
+			if diff := cmp.Diff(tc.want.cond, tc.mg.GetCondition(xpv1.TypeReady), test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want condition, +got condition:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestJobCreate(t *testing.T) {
+	var gotID string
+	got := &batch.Job{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer func() { _ = r.Body.Close() }()
+		gotID = r.URL.Query().Get("jobId")
+		_ = json.NewDecoder(r.Body).Decode(got)
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(&batch.Job{})
+	}))
+	defer server.Close()
+
+	s, _ := batch.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	e := jobExternal{projectID: projectID, batch: s}
+	if _, err := e.Create(context.Background(), newJob()); err != nil {
+		t.Fatalf("Create(...): unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(jobName, gotID); diff != "" {
+		t.Errorf("Create(...): -want job ID, +got job ID:\n%s", diff)
+	}
+	if diff := cmp.Diff(int64(4), got.TaskGroups[0].TaskCount); diff != "" {
+		t.Errorf("Create(...): -want task count, +got task count:\n%s", diff)
+	}
+}
+
+func TestJobDelete(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		mg      *v1alpha1.Job
+		called  bool
+		err     error
+	}{
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&batch.Operation{})
+			}),
+			mg:     newJob(),
+			called: true,
+		},
+		"AlreadyGone": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+			mg:     newJob(),
+			called: true,
+		},
+		"AlreadyDeleting": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&batch.Operation{})
+			}),
+			mg: newJob(func(j *v1alpha1.Job) {
+				j.Status.AtProvider.State = v1alpha1.JobStateDeletionInProgress
+			}),
+		},
+		"Failed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+			mg:     newJob(),
+			called: true,
+			err:    errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteJob),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			called := false
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				called = true
+				tc.handler.ServeHTTP(w, r)
+			}))
+			defer server.Close()
+			s, _ := batch.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := jobExternal{projectID: projectID, batch: s}
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.called, called); diff != "" {
+				t.Errorf("Delete(...): -want called, +got called:\n%s", diff)
+			}
+		})
+	}
+}
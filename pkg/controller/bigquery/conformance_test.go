@@ -0,0 +1,76 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bigquery
+
+import (
+	"testing"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/bigquery/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/conformance"
+)
+
+func TestConformance(t *testing.T) {
+	conformance.Run(t, []string{v1alpha1.Group}, map[string]conformance.Fixture{
+		v1alpha1.DatasetGroupKind: {
+			Managed:   func() resource.Managed { return &v1alpha1.Dataset{} },
+			Connecter: func(kube client.Client) managed.ExternalConnecter { return &datasetConnector{client: kube} },
+		},
+		v1alpha1.DatasetIAMMemberGroupKind: {
+			Managed: func() resource.Managed {
+				return &v1alpha1.DatasetIAMMember{Spec: v1alpha1.DatasetIAMMemberSpec{ForProvider: v1alpha1.DatasetIAMMemberParameters{
+					Dataset: gcp.StringPtr("dataset"),
+					Role:    "roles/bigquery.dataViewer",
+					Member:  gcp.StringPtr("user:alice@example.com"),
+				}}}
+			},
+			Connecter: func(kube client.Client) managed.ExternalConnecter { return &datasetIAMMemberConnector{client: kube} },
+			Identity:  "The member is an entry of the access of the dataset.",
+			Found:     `{"access":[{"role":"READER","userByEmail":"alice@example.com"}]}`,
+			NotFound:  `{}`,
+		},
+		v1alpha1.RowAccessPolicyGroupKind: {
+			Managed: func() resource.Managed {
+				return &v1alpha1.RowAccessPolicy{Spec: v1alpha1.RowAccessPolicySpec{ForProvider: v1alpha1.RowAccessPolicyParameters{
+					Dataset: gcp.StringPtr("dataset"),
+					Table:   "table",
+				}}}
+			},
+			Connecter: func(kube client.Client) managed.ExternalConnecter { return &rowAccessPolicyConnector{client: kube} },
+			Found:     `{"rowAccessPolicies":[{"rowAccessPolicyReference":{"policyId":"` + conformance.ExternalName + `"}}]}`,
+		},
+		v1alpha1.TableIAMMemberGroupKind: {
+			Managed: func() resource.Managed {
+				return &v1alpha1.TableIAMMember{Spec: v1alpha1.TableIAMMemberSpec{ForProvider: v1alpha1.TableIAMMemberParameters{
+					Dataset: gcp.StringPtr("dataset"),
+					Table:   "table",
+					Role:    "roles/bigquery.dataViewer",
+					Member:  gcp.StringPtr("user:alice@example.com"),
+				}}}
+			},
+			Connecter: func(kube client.Client) managed.ExternalConnecter { return &tableIAMMemberConnector{client: kube} },
+			Identity:  "The member is a binding of the IAM policy of the table.",
+			Found:     `{"bindings":[{"role":"roles/bigquery.dataViewer","members":["user:alice@example.com"]}]}`,
+			NotFound:  `{}`,
+		},
+	})
+}
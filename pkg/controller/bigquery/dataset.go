@@ -0,0 +1,203 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bigquery
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	bigquery "google.golang.org/api/bigquery/v2"
+	cloudresourcemanager "google.golang.org/api/cloudresourcemanager/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/bigquery/v1alpha1"
+	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/dataset"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/setup"
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+	"github.com/crossplane-contrib/provider-gcp/pkg/tracing"
+)
+
+const (
+	errNewClient                = "cannot create new BigQuery client"
+	errNewResourceManagerClient = "cannot create new Resource Manager client"
+
+	errNotDataset         = "managed resource is not of type Dataset"
+	errGetDataset         = "cannot get Dataset"
+	errCreateDataset      = "cannot create Dataset"
+	errUpdateDataset      = "cannot update Dataset"
+	errDeleteDataset      = "cannot delete Dataset"
+	errKubeUpdateDataset  = "cannot update Dataset custom resource"
+	errGetGKEServiceAgent = "cannot determine GKE service agent of project"
+)
+
+// SetupDataset adds a controller that reconciles Datasets.
+func SetupDataset(mgr ctrl.Manager, o setup.Options) error {
+	name := managed.ControllerName(v1alpha1.DatasetGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.DatasetGroupVersionKind),
+		managed.WithExternalConnecter(setup.Connecter(mgr, o, v1alpha1.DatasetGroupKind, &datasetConnector{client: mgr.GetClient()})),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.Dataset{}).
+		Complete(tracing.NewReconciler(name, ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter)))
+}
+
+type datasetConnector struct {
+	client client.Client
+}
+
+// Connect returns an ExternalClient with necessary information to talk to GCP API.
+func (c *datasetConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetConnectionInfo(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := bigquery.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	rm, err := cloudresourcemanager.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewResourceManagerClient)
+	}
+	return &datasetExternal{projectID: projectID, client: c.client, bigquery: s, resourcemanager: rm}, nil
+}
+
+type datasetExternal struct {
+	projectID       string
+	client          client.Client
+	bigquery        *bigquery.Service
+	resourcemanager *cloudresourcemanager.Service
+}
+
+// Observe makes observation about the external resource.
+func (e *datasetExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Dataset)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotDataset)
+	}
+	observed, err := e.bigquery.Datasets.Get(e.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetDataset)
+	}
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	dataset.LateInitialize(&cr.Spec.ForProvider, *observed)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
+		if err := e.client.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errKubeUpdateDataset)
+		}
+	}
+	agent, err := e.serviceAgent(ctx, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetGKEServiceAgent)
+	}
+	cr.Status.AtProvider = dataset.GenerateObservation(*observed)
+	if agent != "" && dataset.HasWriter(observed.Access, agent) {
+		cr.Status.AtProvider.GKEServiceAgent = agent
+	}
+	cr.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: dataset.IsUpToDate(cr.Spec.ForProvider, *observed, agent),
+	}, nil
+}
+
+// Create initiates creation of external resource.
+func (e *datasetExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Dataset)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotDataset)
+	}
+	cr.SetConditions(xpv1.Creating())
+	// Access is granted by a later update. BigQuery only adds the default
+	// owners and readers to a dataset that is created without any access.
+	_, err := e.bigquery.Datasets.Insert(e.projectID, dataset.GenerateDataset(meta.GetExternalName(cr), cr.Spec.ForProvider)).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateDataset)
+}
+
+// Update initiates an update to the external resource.
+func (e *datasetExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Dataset)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotDataset)
+	}
+	observed, err := e.bigquery.Datasets.Get(e.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetDataset)
+	}
+	agent, err := e.serviceAgent(ctx, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetGKEServiceAgent)
+	}
+	ds := dataset.GenerateDataset(meta.GetExternalName(cr), cr.Spec.ForProvider)
+	if agent != "" && !dataset.HasWriter(observed.Access, agent) {
+		ds.Access = dataset.WithWriter(observed.Access, agent)
+	}
+	call := e.bigquery.Datasets.Patch(e.projectID, meta.GetExternalName(cr), ds)
+	// Do not overwrite access that was granted since we read it.
+	call.Header().Set("If-Match", observed.Etag)
+	_, err = call.Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateDataset)
+}
+
+// Delete initiates an deletion of the external resource.
+func (e *datasetExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Dataset)
+	if !ok {
+		return errors.New(errNotDataset)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	err := e.bigquery.Datasets.Delete(e.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteDataset)
+}
+
+// serviceAgent returns the GKE service agent that must be able to write to a
+// Dataset configured via the supplied DatasetParameters, if any.
+func (e *datasetExternal) serviceAgent(ctx context.Context, p v1alpha1.DatasetParameters) (string, error) {
+	if !dataset.GrantsServiceAgentAccess(p) {
+		return "", nil
+	}
+	project, err := e.resourcemanager.Projects.Get(e.projectID).Context(ctx).Do()
+	if err != nil {
+		return "", err
+	}
+	return dataset.ServiceAgent(project.ProjectNumber), nil
+}
@@ -0,0 +1,225 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bigquery
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	bigquery "google.golang.org/api/bigquery/v2"
+	cloudresourcemanager "google.golang.org/api/cloudresourcemanager/v1"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/bigquery/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/dataset"
+)
+
+const (
+	projectID   = "fooproject"
+	datasetName = "gke_usage"
+	datasetPath = "/projects/fooproject/datasets/gke_usage"
+	projectPath = "/v1/projects/fooproject"
+	agent       = "service-123456789@container-engine-robot.iam.gserviceaccount.com"
+)
+
+func gError(code int, message string) *googleapi.Error {
+	return &googleapi.Error{
+		Code:    code,
+		Body:    "{}\n",
+		Message: message,
+	}
+}
+
+func newDataset(m ...func(*v1alpha1.Dataset)) *v1alpha1.Dataset {
+	ds := &v1alpha1.Dataset{}
+	meta.SetExternalName(ds, datasetName)
+	ds.Spec.ForProvider = v1alpha1.DatasetParameters{
+		Location:         "US",
+		FriendlyName:     gcp.StringPtr("GKE usage"),
+		Description:      gcp.StringPtr("GKE usage metering"),
+		Labels:           map[string]string{"team": "platform"},
+		GKEUsageMetering: &v1alpha1.GKEUsageMetering{},
+	}
+	for _, f := range m {
+		f(ds)
+	}
+	return ds
+}
+
+func observedDataset(access ...*bigquery.DatasetAccess) *bigquery.Dataset {
+	return &bigquery.Dataset{
+		Id:               projectID + ":" + datasetName,
+		DatasetReference: &bigquery.DatasetReference{ProjectId: projectID, DatasetId: datasetName},
+		Location:         "US",
+		FriendlyName:     "GKE usage",
+		Description:      "GKE usage metering",
+		Labels:           map[string]string{"team": "platform"},
+		Etag:             "etag",
+		Access: append([]*bigquery.DatasetAccess{
+			{Role: dataset.RoleOwner, SpecialGroup: "projectOwners"},
+		}, access...),
+	}
+}
+
+// handler serves the supplied dataset and the project that owns it.
+func handler(t *testing.T, ds *bigquery.Dataset, patched *bigquery.Dataset) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer func() { _ = r.Body.Close() }()
+		switch {
+		case r.URL.Path == projectPath:
+			_ = json.NewEncoder(w).Encode(&cloudresourcemanager.Project{ProjectId: projectID, ProjectNumber: 123456789})
+		case r.URL.Path == datasetPath && r.Method == http.MethodGet && ds == nil:
+			w.WriteHeader(http.StatusNotFound)
+			_ = json.NewEncoder(w).Encode(struct{}{})
+		case r.URL.Path == datasetPath && r.Method == http.MethodGet:
+			_ = json.NewEncoder(w).Encode(ds)
+		case r.URL.Path == datasetPath && r.Method == http.MethodPatch:
+			if diff := cmp.Diff(ds.Etag, r.Header.Get("If-Match")); diff != "" {
+				t.Errorf("If-Match: -want, +got:\n%s", diff)
+			}
+			if err := json.NewDecoder(r.Body).Decode(patched); err != nil {
+				t.Error(err)
+			}
+			_ = json.NewEncoder(w).Encode(patched)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+}
+
+func newExternal(t *testing.T, h http.Handler) (*datasetExternal, func()) {
+	server := httptest.NewServer(h)
+	s, err := bigquery.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	if err != nil {
+		t.Fatal(err)
+	}
+	rm, err := cloudresourcemanager.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &datasetExternal{projectID: projectID, bigquery: s, resourcemanager: rm}, server.Close
+}
+
+func TestDatasetObserve(t *testing.T) {
+	type want struct {
+		eo    managed.ExternalObservation
+		agent string
+		err   error
+	}
+	cases := map[string]struct {
+		ds   *bigquery.Dataset
+		mg   *v1alpha1.Dataset
+		want want
+	}{
+		"NotFound": {
+			mg: newDataset(),
+		},
+		"ServiceAgentMissing": {
+			ds:   observedDataset(),
+			mg:   newDataset(),
+			want: want{eo: managed.ExternalObservation{ResourceExists: true}},
+		},
+		"UpToDate": {
+			ds:   observedDataset(&bigquery.DatasetAccess{Role: dataset.RoleWriter, UserByEmail: agent}),
+			mg:   newDataset(),
+			want: want{eo: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, agent: agent},
+		},
+		"UsageMeteringNotConfigured": {
+			ds: observedDataset(),
+			mg: newDataset(func(ds *v1alpha1.Dataset) {
+				ds.Spec.ForProvider.GKEUsageMetering = nil
+			}),
+			want: want{eo: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e, done := newExternal(t, handler(t, tc.ds, nil))
+			defer done()
+			eo, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.eo, eo); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.agent, tc.mg.Status.AtProvider.GKEServiceAgent); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDatasetUpdate(t *testing.T) {
+	type want struct {
+		access []*bigquery.DatasetAccess
+		err    error
+	}
+	cases := map[string]struct {
+		ds   *bigquery.Dataset
+		mg   *v1alpha1.Dataset
+		want want
+	}{
+		"GrantsServiceAgentAccess": {
+			ds: observedDataset(),
+			mg: newDataset(),
+			want: want{access: []*bigquery.DatasetAccess{
+				{Role: dataset.RoleOwner, SpecialGroup: "projectOwners"},
+				{Role: dataset.RoleWriter, UserByEmail: agent},
+			}},
+		},
+		"LeavesAccessAlone": {
+			ds: observedDataset(&bigquery.DatasetAccess{Role: dataset.RoleWriter, UserByEmail: agent}),
+			mg: newDataset(func(ds *v1alpha1.Dataset) {
+				ds.Spec.ForProvider.Description = gcp.StringPtr("usage")
+			}),
+		},
+		"GetFailed": {
+			mg:   newDataset(),
+			want: want{err: errors.Wrap(gError(http.StatusNotFound, ""), errGetDataset)},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			patched := &bigquery.Dataset{}
+			e, done := newExternal(t, handler(t, tc.ds, patched))
+			defer done()
+			_, err := e.Update(context.Background(), tc.mg)
+			if tc.want.err != nil && err != nil {
+				if diff := cmp.Diff(tc.want.err.Error(), err.Error()); diff != "" {
+					t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+				}
+			} else if diff := cmp.Diff(tc.want.err, err); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.access, patched.Access); diff != "" {
+				t.Errorf("Update(...): -want access, +got access:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,173 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bigquery
+
+import (
+	"context"
+
+	bigquery "google.golang.org/api/bigquery/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/bigquery/v1alpha1"
+	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/datasetiammember"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/setup"
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+	"github.com/crossplane-contrib/provider-gcp/pkg/tracing"
+)
+
+const (
+	errNotDatasetIAMMember = "managed resource is not of type DatasetIAMMember"
+	errUpdateDatasetAccess = "cannot update access of Dataset"
+)
+
+// SetupDatasetIAMMember adds a controller that reconciles DatasetIAMMembers.
+func SetupDatasetIAMMember(mgr ctrl.Manager, o setup.Options) error {
+	name := managed.ControllerName(v1alpha1.DatasetIAMMemberGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.DatasetIAMMemberGroupVersionKind),
+		managed.WithExternalConnecter(setup.Connecter(mgr, o, v1alpha1.DatasetIAMMemberGroupKind, &datasetIAMMemberConnector{client: mgr.GetClient()})),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.DatasetIAMMember{}).
+		Complete(tracing.NewReconciler(name, ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter)))
+}
+
+type datasetIAMMemberConnector struct {
+	client client.Client
+}
+
+// Connect returns an ExternalClient with necessary information to talk to GCP API.
+func (c *datasetIAMMemberConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetConnectionInfo(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := bigquery.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &datasetIAMMemberExternal{projectID: projectID, bigquery: s}, nil
+}
+
+type datasetIAMMemberExternal struct {
+	projectID string
+	bigquery  *bigquery.Service
+}
+
+// Observe makes observation about the external resource.
+func (e *datasetIAMMemberExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.DatasetIAMMember)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotDatasetIAMMember)
+	}
+	observed, err := e.bigquery.Datasets.Get(e.projectID, gcp.StringValue(cr.Spec.ForProvider.Dataset)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetDataset)
+	}
+	if !datasetiammember.HasAccess(observed.Access, datasetiammember.AccessEntry(cr.Spec.ForProvider)) {
+		return managed.ExternalObservation{}, nil
+	}
+	cr.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+}
+
+// Create grants the role to the member in the access of the dataset.
+func (e *datasetIAMMemberExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.DatasetIAMMember)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotDatasetIAMMember)
+	}
+	entry := datasetiammember.AccessEntry(cr.Spec.ForProvider)
+	// Other members of the same dataset may be granted access concurrently,
+	// in which case the etag of the dataset read here is stale. Read it
+	// again and retry.
+	err := gcp.RetryOnConflict(func() error {
+		observed, err := e.bigquery.Datasets.Get(e.projectID, gcp.StringValue(cr.Spec.ForProvider.Dataset)).Context(ctx).Do()
+		if err != nil {
+			return errors.Wrap(err, errGetDataset)
+		}
+		if datasetiammember.HasAccess(observed.Access, entry) {
+			return nil
+		}
+		return e.patchAccess(ctx, cr, observed, datasetiammember.WithAccess(observed.Access, entry))
+	})
+	return managed.ExternalCreation{}, err
+}
+
+// Update grants the role to the member in the access of the dataset.
+func (e *datasetIAMMemberExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	_, err := e.Create(ctx, mg)
+	return managed.ExternalUpdate{}, err
+}
+
+// Delete revokes the role from the member in the access of the dataset.
+func (e *datasetIAMMemberExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.DatasetIAMMember)
+	if !ok {
+		return errors.New(errNotDatasetIAMMember)
+	}
+	entry := datasetiammember.AccessEntry(cr.Spec.ForProvider)
+	return gcp.RetryOnConflict(func() error {
+		observed, err := e.bigquery.Datasets.Get(e.projectID, gcp.StringValue(cr.Spec.ForProvider.Dataset)).Context(ctx).Do()
+		if gcp.IsErrorNotFound(err) {
+			return nil
+		}
+		if err != nil {
+			return errors.Wrap(err, errGetDataset)
+		}
+		access, changed := datasetiammember.WithoutAccess(observed.Access, entry)
+		if !changed {
+			return nil
+		}
+		return e.patchAccess(ctx, cr, observed, access)
+	})
+}
+
+// patchAccess replaces the access of the supplied observed dataset, unless it
+// was changed since it was observed.
+func (e *datasetIAMMemberExternal) patchAccess(ctx context.Context, cr *v1alpha1.DatasetIAMMember, observed *bigquery.Dataset, access []*bigquery.DatasetAccess) error {
+	call := e.bigquery.Datasets.Patch(e.projectID, gcp.StringValue(cr.Spec.ForProvider.Dataset), &bigquery.Dataset{
+		Access:          access,
+		ForceSendFields: []string{"Access"},
+	})
+	call.Header().Set("If-Match", observed.Etag)
+	_, err := call.Context(ctx).Do()
+	return errors.Wrap(err, errUpdateDatasetAccess)
+}
@@ -0,0 +1,172 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bigquery
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	bigquery "google.golang.org/api/bigquery/v2"
+	"google.golang.org/api/option"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/bigquery/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/dataset"
+)
+
+const member = "serviceAccount:reader@fooproject.iam.gserviceaccount.com"
+
+func newDatasetIAMMember() *v1alpha1.DatasetIAMMember {
+	return &v1alpha1.DatasetIAMMember{
+		Spec: v1alpha1.DatasetIAMMemberSpec{
+			ForProvider: v1alpha1.DatasetIAMMemberParameters{
+				Dataset: gcp.StringPtr(datasetName),
+				Role:    "roles/bigquery.dataViewer",
+				Member:  gcp.StringPtr(member),
+			},
+		},
+	}
+}
+
+func newDatasetIAMMemberExternal(t *testing.T, h http.Handler) (*datasetIAMMemberExternal, func()) {
+	server := httptest.NewServer(h)
+	s, err := bigquery.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &datasetIAMMemberExternal{projectID: projectID, bigquery: s}, server.Close
+}
+
+func TestDatasetIAMMemberObserve(t *testing.T) {
+	type want struct {
+		eo  managed.ExternalObservation
+		err error
+	}
+	cases := map[string]struct {
+		ds   *bigquery.Dataset
+		want want
+	}{
+		"DatasetNotFound": {},
+		"NotGranted": {
+			ds: observedDataset(),
+		},
+		"GrantedAsBasicRole": {
+			ds:   observedDataset(&bigquery.DatasetAccess{Role: dataset.RoleReader, UserByEmail: "Reader@fooproject.iam.gserviceaccount.com"}),
+			want: want{eo: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e, done := newDatasetIAMMemberExternal(t, handler(t, tc.ds, nil))
+			defer done()
+			cr := newDatasetIAMMember()
+			eo, err := e.Observe(context.Background(), cr)
+			if diff := cmp.Diff(tc.want.err, err); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.eo, eo); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if tc.want.eo.ResourceExists {
+				if diff := cmp.Diff(xpv1.Available(), cr.GetCondition(xpv1.TypeReady), cmp.Comparer(func(a, b xpv1.Condition) bool { return a.Equal(b) })); diff != "" {
+					t.Errorf("Observe(...): -want condition, +got condition:\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestDatasetIAMMemberCreate(t *testing.T) {
+	type want struct {
+		access []*bigquery.DatasetAccess
+		err    error
+	}
+	cases := map[string]struct {
+		ds   *bigquery.Dataset
+		want want
+	}{
+		"GrantsAccess": {
+			ds: observedDataset(),
+			want: want{access: []*bigquery.DatasetAccess{
+				{Role: dataset.RoleOwner, SpecialGroup: "projectOwners"},
+				{Role: dataset.RoleReader, UserByEmail: "reader@fooproject.iam.gserviceaccount.com"},
+			}},
+		},
+		"AlreadyGranted": {
+			ds: observedDataset(&bigquery.DatasetAccess{Role: dataset.RoleReader, UserByEmail: "reader@fooproject.iam.gserviceaccount.com"}),
+		},
+		"GetFailed": {
+			want: want{err: errors.Wrap(gError(http.StatusNotFound, ""), errGetDataset)},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			patched := &bigquery.Dataset{}
+			e, done := newDatasetIAMMemberExternal(t, handler(t, tc.ds, patched))
+			defer done()
+			_, err := e.Create(context.Background(), newDatasetIAMMember())
+			if tc.want.err != nil && err != nil {
+				if diff := cmp.Diff(tc.want.err.Error(), err.Error()); diff != "" {
+					t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+				}
+			} else if diff := cmp.Diff(tc.want.err, err); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.access, patched.Access); diff != "" {
+				t.Errorf("Create(...): -want access, +got access:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDatasetIAMMemberDelete(t *testing.T) {
+	cases := map[string]struct {
+		ds     *bigquery.Dataset
+		access []*bigquery.DatasetAccess
+	}{
+		"RevokesAccess": {
+			ds: observedDataset(&bigquery.DatasetAccess{Role: dataset.RoleReader, UserByEmail: "reader@fooproject.iam.gserviceaccount.com"}),
+			access: []*bigquery.DatasetAccess{
+				{Role: dataset.RoleOwner, SpecialGroup: "projectOwners"},
+			},
+		},
+		"NotGranted": {
+			ds: observedDataset(),
+		},
+		"DatasetNotFound": {},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			patched := &bigquery.Dataset{}
+			e, done := newDatasetIAMMemberExternal(t, handler(t, tc.ds, patched))
+			defer done()
+			if err := e.Delete(context.Background(), newDatasetIAMMember()); err != nil {
+				t.Errorf("Delete(...): %s", err)
+			}
+			if diff := cmp.Diff(tc.access, patched.Access); diff != "" {
+				t.Errorf("Delete(...): -want access, +got access:\n%s", diff)
+			}
+		})
+	}
+}
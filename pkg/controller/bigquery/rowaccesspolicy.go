@@ -0,0 +1,185 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bigquery
+
+import (
+	"context"
+
+	bigquery "google.golang.org/api/bigquery/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/bigquery/v1alpha1"
+	iamv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/iam/v1alpha1"
+	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/rowaccesspolicy"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/setup"
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+	"github.com/crossplane-contrib/provider-gcp/pkg/tracing"
+)
+
+const (
+	errNotRowAccessPolicy    = "managed resource is not of type RowAccessPolicy"
+	errListRowAccessPolicies = "cannot list row access policies of table"
+	errGetRowAccessGrantees  = "cannot get IAM policy of row access policy"
+	errCreateRowAccessPolicy = "cannot create or replace row access policy"
+	errDeleteRowAccessPolicy = "cannot drop row access policy"
+)
+
+// SetupRowAccessPolicy adds a controller that reconciles RowAccessPolicies.
+func SetupRowAccessPolicy(mgr ctrl.Manager, o setup.Options) error {
+	name := managed.ControllerName(v1alpha1.RowAccessPolicyGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.RowAccessPolicyGroupVersionKind),
+		managed.WithExternalConnecter(setup.Connecter(mgr, o, v1alpha1.RowAccessPolicyGroupKind, &rowAccessPolicyConnector{client: mgr.GetClient()})),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.RowAccessPolicy{}).
+		Complete(tracing.NewReconciler(name, ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter)))
+}
+
+type rowAccessPolicyConnector struct {
+	client client.Client
+}
+
+// Connect returns an ExternalClient with necessary information to talk to GCP API.
+func (c *rowAccessPolicyConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetConnectionInfo(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := bigquery.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &rowAccessPolicyExternal{projectID: projectID, bigquery: s}, nil
+}
+
+type rowAccessPolicyExternal struct {
+	projectID string
+	bigquery  *bigquery.Service
+}
+
+// Observe makes observation about the external resource. Row access policies
+// cannot be read individually; they are found among the policies of their
+// table, and their grantees are read from their IAM policy.
+func (e *rowAccessPolicyExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.RowAccessPolicy)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotRowAccessPolicy)
+	}
+	id := meta.GetExternalName(cr)
+	var observed *bigquery.RowAccessPolicy
+	err := e.bigquery.RowAccessPolicies.List(e.projectID, gcp.StringValue(cr.Spec.ForProvider.Dataset), cr.Spec.ForProvider.Table).
+		Pages(ctx, func(l *bigquery.ListRowAccessPoliciesResponse) error {
+			if p := rowaccesspolicy.Find(l.RowAccessPolicies, id); p != nil {
+				observed = p
+			}
+			return nil
+		})
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errListRowAccessPolicies)
+	}
+	if observed == nil {
+		return managed.ExternalObservation{}, nil
+	}
+	req := &bigquery.GetIamPolicyRequest{Options: &bigquery.GetPolicyOptions{RequestedPolicyVersion: iamv1alpha1.PolicyVersion}}
+	p, err := e.bigquery.RowAccessPolicies.GetIamPolicy(rowaccesspolicy.PolicyResource(e.projectID, id, cr.Spec.ForProvider), req).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetRowAccessGrantees)
+	}
+	cr.Status.AtProvider = rowaccesspolicy.GenerateObservation(*observed)
+	cr.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: rowaccesspolicy.IsUpToDate(cr.Spec.ForProvider, *observed, rowaccesspolicy.Grantees(p)),
+	}, nil
+}
+
+// Create creates the row access policy with a DDL statement.
+func (e *rowAccessPolicyExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.RowAccessPolicy)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotRowAccessPolicy)
+	}
+	cr.SetConditions(xpv1.Creating())
+	return managed.ExternalCreation{}, errors.Wrap(e.createOrReplace(ctx, cr), errCreateRowAccessPolicy)
+}
+
+// Update replaces the row access policy with a DDL statement.
+func (e *rowAccessPolicyExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.RowAccessPolicy)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotRowAccessPolicy)
+	}
+	return managed.ExternalUpdate{}, errors.Wrap(e.createOrReplace(ctx, cr), errCreateRowAccessPolicy)
+}
+
+// Delete drops the row access policy with a DDL statement.
+func (e *rowAccessPolicyExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.RowAccessPolicy)
+	if !ok {
+		return errors.New(errNotRowAccessPolicy)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	id := meta.GetExternalName(cr)
+	if err := rowaccesspolicy.ValidateIdentifiers(e.projectID, id, cr.Spec.ForProvider); err != nil {
+		return errors.Wrap(err, errDeleteRowAccessPolicy)
+	}
+	err := e.query(ctx, rowaccesspolicy.DropStatement(e.projectID, id, cr.Spec.ForProvider))
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteRowAccessPolicy)
+}
+
+func (e *rowAccessPolicyExternal) createOrReplace(ctx context.Context, cr *v1alpha1.RowAccessPolicy) error {
+	id := meta.GetExternalName(cr)
+	if err := rowaccesspolicy.ValidateIdentifiers(e.projectID, id, cr.Spec.ForProvider); err != nil {
+		return err
+	}
+	return e.query(ctx, rowaccesspolicy.CreateStatement(e.projectID, id, cr.Spec.ForProvider))
+}
+
+// query runs the supplied GoogleSQL statement. A statement that is still
+// running when the query returns is observed once it completes.
+func (e *rowAccessPolicyExternal) query(ctx context.Context, statement string) error {
+	_, err := e.bigquery.Jobs.Query(e.projectID, &bigquery.QueryRequest{
+		Query:        statement,
+		UseLegacySql: gcp.BoolPtr(false),
+	}).Context(ctx).Do()
+	return err
+}
@@ -0,0 +1,232 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bigquery
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	bigquery "google.golang.org/api/bigquery/v2"
+	"google.golang.org/api/option"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/bigquery/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/rowaccesspolicy"
+)
+
+const (
+	policyID       = "emea"
+	policiesPath   = datasetPath + "/tables/orders/rowAccessPolicies"
+	policyIAMPath  = policiesPath + "/" + policyID + ":getIamPolicy"
+	queriesPath    = "/projects/fooproject/queries"
+	policyCreated  = "2023-01-02T03:04:05Z"
+	policyModified = "2023-02-03T04:05:06Z"
+	policyName     = "projects/fooproject/datasets/gke_usage/tables/orders/rowAccessPolicies/emea"
+)
+
+func newRowAccessPolicy(m ...func(*v1alpha1.RowAccessPolicy)) *v1alpha1.RowAccessPolicy {
+	p := &v1alpha1.RowAccessPolicy{}
+	meta.SetExternalName(p, policyID)
+	p.Spec.ForProvider = v1alpha1.RowAccessPolicyParameters{
+		Dataset:         gcp.StringPtr(datasetName),
+		Table:           "orders",
+		Grantees:        []string{"group:sales-emea@example.com"},
+		FilterPredicate: "region = 'EMEA'",
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+// policyHandler serves the supplied row access policies of the orders table
+// and the supplied grantees of the emea policy, and records the statements
+// of the queries it is sent.
+func policyHandler(t *testing.T, policies []*bigquery.RowAccessPolicy, grantees []string, statements *[]string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer func() { _ = r.Body.Close() }()
+		switch {
+		case r.URL.Path == policiesPath && r.Method == http.MethodGet && policies == nil:
+			w.WriteHeader(http.StatusNotFound)
+			_ = json.NewEncoder(w).Encode(struct{}{})
+		case r.URL.Path == policiesPath && r.Method == http.MethodGet:
+			_ = json.NewEncoder(w).Encode(&bigquery.ListRowAccessPoliciesResponse{RowAccessPolicies: policies})
+		case r.URL.Path == policyIAMPath && r.Method == http.MethodPost:
+			_ = json.NewEncoder(w).Encode(&bigquery.Policy{Bindings: []*bigquery.Binding{
+				{Role: rowaccesspolicy.RoleFilteredDataViewer, Members: grantees},
+			}})
+		case r.URL.Path == queriesPath && r.Method == http.MethodPost:
+			q := &bigquery.QueryRequest{}
+			if err := json.NewDecoder(r.Body).Decode(q); err != nil {
+				t.Error(err)
+			}
+			if q.UseLegacySql == nil || *q.UseLegacySql {
+				t.Errorf("query %q uses legacy SQL", q.Query)
+			}
+			*statements = append(*statements, q.Query)
+			_ = json.NewEncoder(w).Encode(&bigquery.QueryResponse{JobComplete: true})
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+}
+
+func newRowAccessPolicyExternal(t *testing.T, h http.Handler) (*rowAccessPolicyExternal, func()) {
+	server := httptest.NewServer(h)
+	s, err := bigquery.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &rowAccessPolicyExternal{projectID: projectID, bigquery: s}, server.Close
+}
+
+func observedPolicy(predicate string) *bigquery.RowAccessPolicy {
+	return &bigquery.RowAccessPolicy{
+		RowAccessPolicyReference: &bigquery.RowAccessPolicyReference{
+			ProjectId: projectID,
+			DatasetId: datasetName,
+			TableId:   "orders",
+			PolicyId:  policyID,
+		},
+		FilterPredicate:  predicate,
+		CreationTime:     policyCreated,
+		LastModifiedTime: policyModified,
+	}
+}
+
+func TestRowAccessPolicyObserve(t *testing.T) {
+	type want struct {
+		eo  managed.ExternalObservation
+		obs v1alpha1.RowAccessPolicyObservation
+		err error
+	}
+	cases := map[string]struct {
+		policies []*bigquery.RowAccessPolicy
+		grantees []string
+		want     want
+	}{
+		"TableNotFound": {},
+		"PolicyNotFound": {
+			policies: []*bigquery.RowAccessPolicy{},
+		},
+		"UpToDate": {
+			policies: []*bigquery.RowAccessPolicy{observedPolicy("region = 'EMEA'")},
+			grantees: []string{"group:sales-emea@example.com"},
+			want: want{
+				eo:  managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				obs: v1alpha1.RowAccessPolicyObservation{Name: policyName, CreationTime: policyCreated, LastModifiedTime: policyModified},
+			},
+		},
+		"FilterPredicateChanged": {
+			policies: []*bigquery.RowAccessPolicy{observedPolicy("region = 'APAC'")},
+			grantees: []string{"group:sales-emea@example.com"},
+			want: want{
+				eo:  managed.ExternalObservation{ResourceExists: true},
+				obs: v1alpha1.RowAccessPolicyObservation{Name: policyName, CreationTime: policyCreated, LastModifiedTime: policyModified},
+			},
+		},
+		"GranteesChanged": {
+			policies: []*bigquery.RowAccessPolicy{observedPolicy("region = 'EMEA'")},
+			grantees: []string{"group:sales@example.com"},
+			want: want{
+				eo:  managed.ExternalObservation{ResourceExists: true},
+				obs: v1alpha1.RowAccessPolicyObservation{Name: policyName, CreationTime: policyCreated, LastModifiedTime: policyModified},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e, done := newRowAccessPolicyExternal(t, policyHandler(t, tc.policies, tc.grantees, nil))
+			defer done()
+			cr := newRowAccessPolicy()
+			eo, err := e.Observe(context.Background(), cr)
+			if diff := cmp.Diff(tc.want.err, err); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.eo, eo); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, cr.Status.AtProvider); diff != "" {
+				t.Errorf("Observe(...): -want observation, +got observation:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRowAccessPolicyCreate(t *testing.T) {
+	type want struct {
+		statements []string
+		err        error
+	}
+	cases := map[string]struct {
+		mg   *v1alpha1.RowAccessPolicy
+		want want
+	}{
+		"CreatesPolicy": {
+			mg: newRowAccessPolicy(),
+			want: want{statements: []string{
+				"CREATE OR REPLACE ROW ACCESS POLICY `emea` ON `fooproject.gke_usage.orders` GRANT TO (\"group:sales-emea@example.com\") FILTER USING (region = 'EMEA')",
+			}},
+		},
+		"InvalidTable": {
+			mg: newRowAccessPolicy(func(p *v1alpha1.RowAccessPolicy) {
+				p.Spec.ForProvider.Table = "orders` ON `other"
+			}),
+			want: want{err: errors.Wrap(errors.Errorf("invalid identifier %q: identifiers may not contain backticks or backslashes", "orders` ON `other"), errCreateRowAccessPolicy)},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var statements []string
+			e, done := newRowAccessPolicyExternal(t, policyHandler(t, nil, nil, &statements))
+			defer done()
+			_, err := e.Create(context.Background(), tc.mg)
+			if tc.want.err != nil && err != nil {
+				if diff := cmp.Diff(tc.want.err.Error(), err.Error()); diff != "" {
+					t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+				}
+			} else if diff := cmp.Diff(tc.want.err, err); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.statements, statements); diff != "" {
+				t.Errorf("Create(...): -want statements, +got statements:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRowAccessPolicyDelete(t *testing.T) {
+	var statements []string
+	e, done := newRowAccessPolicyExternal(t, policyHandler(t, nil, nil, &statements))
+	defer done()
+	if err := e.Delete(context.Background(), newRowAccessPolicy()); err != nil {
+		t.Errorf("Delete(...): %s", err)
+	}
+	want := []string{"DROP ROW ACCESS POLICY IF EXISTS `emea` ON `fooproject.gke_usage.orders`"}
+	if diff := cmp.Diff(want, statements); diff != "" {
+		t.Errorf("Delete(...): -want statements, +got statements:\n%s", diff)
+	}
+}
@@ -0,0 +1,169 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bigquery
+
+import (
+	"context"
+
+	bigquery "google.golang.org/api/bigquery/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/bigquery/v1alpha1"
+	iamv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/iam/v1alpha1"
+	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/tableiammember"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/setup"
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+	"github.com/crossplane-contrib/provider-gcp/pkg/tracing"
+)
+
+const (
+	errNotTableIAMMember = "managed resource is not of type TableIAMMember"
+	errGetTablePolicy    = "cannot get IAM policy of table"
+	errSetTablePolicy    = "cannot set IAM policy of table"
+)
+
+// SetupTableIAMMember adds a controller that reconciles TableIAMMembers.
+func SetupTableIAMMember(mgr ctrl.Manager, o setup.Options) error {
+	name := managed.ControllerName(v1alpha1.TableIAMMemberGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.TableIAMMemberGroupVersionKind),
+		managed.WithExternalConnecter(setup.Connecter(mgr, o, v1alpha1.TableIAMMemberGroupKind, &tableIAMMemberConnector{client: mgr.GetClient()})),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.TableIAMMember{}).
+		Complete(tracing.NewReconciler(name, ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter)))
+}
+
+type tableIAMMemberConnector struct {
+	client client.Client
+}
+
+// Connect returns an ExternalClient with necessary information to talk to GCP API.
+func (c *tableIAMMemberConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetConnectionInfo(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := bigquery.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &tableIAMMemberExternal{projectID: projectID, bigquery: s}, nil
+}
+
+type tableIAMMemberExternal struct {
+	projectID string
+	bigquery  *bigquery.Service
+}
+
+func (e *tableIAMMemberExternal) getPolicy(ctx context.Context, cr *v1alpha1.TableIAMMember) (*bigquery.Policy, error) {
+	req := &bigquery.GetIamPolicyRequest{Options: &bigquery.GetPolicyOptions{RequestedPolicyVersion: iamv1alpha1.PolicyVersion}}
+	return e.bigquery.Tables.GetIamPolicy(tableiammember.TableResource(e.projectID, cr.Spec.ForProvider), req).Context(ctx).Do()
+}
+
+func (e *tableIAMMemberExternal) setPolicy(ctx context.Context, cr *v1alpha1.TableIAMMember, p *bigquery.Policy) error {
+	_, err := e.bigquery.Tables.SetIamPolicy(tableiammember.TableResource(e.projectID, cr.Spec.ForProvider), &bigquery.SetIamPolicyRequest{Policy: p}).Context(ctx).Do()
+	return errors.Wrap(err, errSetTablePolicy)
+}
+
+// Observe makes observation about the external resource.
+func (e *tableIAMMemberExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.TableIAMMember)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotTableIAMMember)
+	}
+	p, err := e.getPolicy(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetTablePolicy)
+	}
+	if tableiammember.BindRoleToMember(cr.Spec.ForProvider, p) {
+		return managed.ExternalObservation{}, nil
+	}
+	cr.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+}
+
+// Create binds the role to the member in the IAM policy of the table.
+func (e *tableIAMMemberExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.TableIAMMember)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotTableIAMMember)
+	}
+	// Other members of the same policy may be bound concurrently, in which
+	// case the etag of the policy read here is stale. Read it again and retry.
+	err := gcp.RetryOnConflict(func() error {
+		p, err := e.getPolicy(ctx, cr)
+		if err != nil {
+			return errors.Wrap(err, errGetTablePolicy)
+		}
+		if !tableiammember.BindRoleToMember(cr.Spec.ForProvider, p) {
+			return nil
+		}
+		return e.setPolicy(ctx, cr, p)
+	})
+	return managed.ExternalCreation{}, err
+}
+
+// Update binds the role to the member in the IAM policy of the table.
+func (e *tableIAMMemberExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	_, err := e.Create(ctx, mg)
+	return managed.ExternalUpdate{}, err
+}
+
+// Delete unbinds the role from the member in the IAM policy of the table.
+func (e *tableIAMMemberExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.TableIAMMember)
+	if !ok {
+		return errors.New(errNotTableIAMMember)
+	}
+	return gcp.RetryOnConflict(func() error {
+		p, err := e.getPolicy(ctx, cr)
+		if gcp.IsErrorNotFound(err) {
+			return nil
+		}
+		if err != nil {
+			return errors.Wrap(err, errGetTablePolicy)
+		}
+		if !tableiammember.UnbindRoleFromMember(cr.Spec.ForProvider, p) {
+			return nil
+		}
+		return e.setPolicy(ctx, cr, p)
+	})
+}
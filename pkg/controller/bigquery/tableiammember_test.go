@@ -0,0 +1,250 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bigquery
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	bigquery "google.golang.org/api/bigquery/v2"
+	"google.golang.org/api/option"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/bigquery/v1alpha1"
+	iamv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/iam/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	tableName  = "events"
+	viewerRole = "roles/bigquery.dataViewer"
+)
+
+func newTableIAMMember() *v1alpha1.TableIAMMember {
+	return &v1alpha1.TableIAMMember{
+		Spec: v1alpha1.TableIAMMemberSpec{
+			ForProvider: v1alpha1.TableIAMMemberParameters{
+				Dataset: gcp.StringPtr(datasetName),
+				Table:   tableName,
+				Role:    viewerRole,
+				Member:  gcp.StringPtr(member),
+			},
+		},
+	}
+}
+
+func newTableIAMMemberExternal(t *testing.T, h http.Handler) (*tableIAMMemberExternal, func()) {
+	server := httptest.NewServer(h)
+	s, err := bigquery.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &tableIAMMemberExternal{projectID: projectID, bigquery: s}, server.Close
+}
+
+// iamPolicyHandler serves the supplied policy of the test table, or NotFound if
+// it is nil, and records every policy it is asked to set. The first conflicts
+// calls to setIamPolicy fail with PreconditionFailed.
+func iamPolicyHandler(t *testing.T, p *bigquery.Policy, conflicts int, set *[]*bigquery.Policy) http.Handler {
+	prefix := "/projects/" + projectID + "/datasets/" + datasetName + "/tables/" + tableName
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer func() { _ = r.Body.Close() }()
+		if r.Method != http.MethodPost || !strings.HasPrefix(r.URL.Path, prefix+":") {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if p == nil {
+			w.WriteHeader(http.StatusNotFound)
+			_ = json.NewEncoder(w).Encode(struct{}{})
+			return
+		}
+		switch strings.TrimPrefix(r.URL.Path, prefix) {
+		case ":getIamPolicy":
+			w.WriteHeader(http.StatusOK)
+			_ = json.NewEncoder(w).Encode(p)
+		case ":setIamPolicy":
+			req := &bigquery.SetIamPolicyRequest{}
+			if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+				t.Error(err)
+			}
+			*set = append(*set, req.Policy)
+			if len(*set) <= conflicts {
+				w.WriteHeader(http.StatusPreconditionFailed)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+			_ = json.NewEncoder(w).Encode(req.Policy)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	})
+}
+
+func observedIAMPolicy(b ...*bigquery.Binding) *bigquery.Policy {
+	return &bigquery.Policy{
+		Version: iamv1alpha1.PolicyVersion,
+		Etag:    "BwXqNtYmDvA=",
+		Bindings: append([]*bigquery.Binding{
+			{Role: "roles/bigquery.dataOwner", Members: []string{"user:owner@example.com"}},
+		}, b...),
+	}
+}
+
+func TestTableIAMMemberObserve(t *testing.T) {
+	type want struct {
+		eo  managed.ExternalObservation
+		err error
+	}
+	cases := map[string]struct {
+		p    *bigquery.Policy
+		want want
+	}{
+		"TableNotFound": {},
+		"NotBound": {
+			p: observedIAMPolicy(),
+		},
+		"BoundToOtherMember": {
+			p: observedIAMPolicy(&bigquery.Binding{Role: viewerRole, Members: []string{"user:someone@example.com"}}),
+		},
+		"Bound": {
+			p:    observedIAMPolicy(&bigquery.Binding{Role: viewerRole, Members: []string{member}}),
+			want: want{eo: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e, done := newTableIAMMemberExternal(t, iamPolicyHandler(t, tc.p, 0, nil))
+			defer done()
+			cr := newTableIAMMember()
+			eo, err := e.Observe(context.Background(), cr)
+			if diff := cmp.Diff(tc.want.err, err); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.eo, eo); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if tc.want.eo.ResourceExists {
+				if diff := cmp.Diff(xpv1.Available(), cr.GetCondition(xpv1.TypeReady), cmp.Comparer(func(a, b xpv1.Condition) bool { return a.Equal(b) })); diff != "" {
+					t.Errorf("Observe(...): -want condition, +got condition:\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestTableIAMMemberCreate(t *testing.T) {
+	type want struct {
+		set []*bigquery.Policy
+		err error
+	}
+	cases := map[string]struct {
+		p         *bigquery.Policy
+		conflicts int
+		want      want
+	}{
+		"AddsBinding": {
+			p: observedIAMPolicy(),
+			want: want{set: []*bigquery.Policy{
+				observedIAMPolicy(&bigquery.Binding{Role: viewerRole, Members: []string{member}}),
+			}},
+		},
+		"AddsMemberToBinding": {
+			p: observedIAMPolicy(&bigquery.Binding{Role: viewerRole, Members: []string{"user:someone@example.com"}}),
+			want: want{set: []*bigquery.Policy{
+				observedIAMPolicy(&bigquery.Binding{Role: viewerRole, Members: []string{"user:someone@example.com", member}}),
+			}},
+		},
+		"AlreadyBound": {
+			p: observedIAMPolicy(&bigquery.Binding{Role: viewerRole, Members: []string{member}}),
+		},
+		"RetriesOnConflict": {
+			p:         observedIAMPolicy(),
+			conflicts: 1,
+			want: want{set: []*bigquery.Policy{
+				observedIAMPolicy(&bigquery.Binding{Role: viewerRole, Members: []string{member}}),
+				observedIAMPolicy(&bigquery.Binding{Role: viewerRole, Members: []string{member}}),
+			}},
+		},
+		"TableNotFound": {
+			want: want{err: errors.Wrap(gError(http.StatusNotFound, ""), errGetTablePolicy)},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var set []*bigquery.Policy
+			e, done := newTableIAMMemberExternal(t, iamPolicyHandler(t, tc.p, tc.conflicts, &set))
+			defer done()
+			_, err := e.Create(context.Background(), newTableIAMMember())
+			if tc.want.err != nil && err != nil {
+				if diff := cmp.Diff(tc.want.err.Error(), err.Error()); diff != "" {
+					t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+				}
+			} else if diff := cmp.Diff(tc.want.err, err); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.set, set); diff != "" {
+				t.Errorf("Create(...): -want policy, +got policy:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestTableIAMMemberDelete(t *testing.T) {
+	cases := map[string]struct {
+		p   *bigquery.Policy
+		set []*bigquery.Policy
+	}{
+		"RemovesBinding": {
+			p:   observedIAMPolicy(&bigquery.Binding{Role: viewerRole, Members: []string{member}}),
+			set: []*bigquery.Policy{observedIAMPolicy()},
+		},
+		"RemovesMemberFromBinding": {
+			p: observedIAMPolicy(&bigquery.Binding{Role: viewerRole, Members: []string{"user:someone@example.com", member}}),
+			set: []*bigquery.Policy{
+				observedIAMPolicy(&bigquery.Binding{Role: viewerRole, Members: []string{"user:someone@example.com"}}),
+			},
+		},
+		"NotBound": {
+			p: observedIAMPolicy(),
+		},
+		"TableNotFound": {},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var set []*bigquery.Policy
+			e, done := newTableIAMMemberExternal(t, iamPolicyHandler(t, tc.p, 0, &set))
+			defer done()
+			if err := e.Delete(context.Background(), newTableIAMMember()); err != nil {
+				t.Errorf("Delete(...): %s", err)
+			}
+			if diff := cmp.Diff(tc.set, set); diff != "" {
+				t.Errorf("Delete(...): -want policy, +got policy:\n%s", diff)
+			}
+		})
+	}
+}
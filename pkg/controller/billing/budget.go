@@ -0,0 +1,178 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package billing
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	budgets "google.golang.org/api/billingbudgets/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/billing/v1alpha1"
+	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/budget"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/setup"
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+	"github.com/crossplane-contrib/provider-gcp/pkg/tracing"
+)
+
+const (
+	errNewBudgetsClient = "cannot create new Billing Budgets client"
+
+	errNotBudget        = "managed resource is not of type Budget"
+	errGetBudget        = "cannot get Budget"
+	errCreateBudget     = "cannot create Budget"
+	errUpdateBudget     = "cannot update Budget"
+	errDeleteBudget     = "cannot delete Budget"
+	errKubeUpdateBudget = "cannot update Budget custom resource"
+)
+
+// SetupBudget adds a controller that reconciles Budgets.
+func SetupBudget(mgr ctrl.Manager, o setup.Options) error {
+	name := managed.ControllerName(v1alpha1.BudgetGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.BudgetGroupVersionKind),
+		// The budget ID is assigned by Google when the budget is created.
+		managed.WithInitializers(),
+		managed.WithExternalConnecter(setup.Connecter(mgr, o, v1alpha1.BudgetGroupKind, &budgetConnector{client: mgr.GetClient()})),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.Budget{}).
+		Complete(tracing.NewReconciler(name, ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter)))
+}
+
+type budgetConnector struct {
+	client client.Client
+}
+
+// Connect returns an ExternalClient with necessary information to talk to GCP API.
+func (c *budgetConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	_, opts, err := gcp.GetConnectionInfo(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := budgets.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewBudgetsClient)
+	}
+	return &budgetExternal{client: c.client, budgets: s.BillingAccounts.Budgets}, nil
+}
+
+type budgetExternal struct {
+	client  client.Client
+	budgets *budgets.BillingAccountsBudgetsService
+}
+
+// Observe makes observation about the external resource.
+func (e *budgetExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Budget)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotBudget)
+	}
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	observed, err := e.budgets.Get(budget.GetFullyQualifiedName(cr.Spec.ForProvider.BillingAccount, meta.GetExternalName(cr))).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetBudget)
+	}
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	budget.LateInitialize(&cr.Spec.ForProvider, *observed)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
+		if err := e.client.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errKubeUpdateBudget)
+		}
+	}
+	cr.Status.AtProvider = budget.GenerateObservation(*observed)
+	cr.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: budget.IsUpToDate(cr.Spec.ForProvider, *observed),
+	}, nil
+}
+
+// Create initiates creation of external resource.
+func (e *budgetExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Budget)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotBudget)
+	}
+	cr.SetConditions(xpv1.Creating())
+	b, err := e.budgets.Create(budget.GetParent(cr.Spec.ForProvider.BillingAccount), budget.GenerateBudget("", cr.Spec.ForProvider)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateBudget)
+	}
+	meta.SetExternalName(cr, budget.ParseBudgetID(b.Name))
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+// Update initiates an update to the external resource.
+func (e *budgetExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Budget)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotBudget)
+	}
+	name := budget.GetFullyQualifiedName(cr.Spec.ForProvider.BillingAccount, meta.GetExternalName(cr))
+	observed, err := e.budgets.Get(name).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetBudget)
+	}
+	mask := budget.GenerateUpdateMask(cr.Spec.ForProvider, *observed)
+	if mask == "" {
+		return managed.ExternalUpdate{}, nil
+	}
+	b := budget.GenerateBudget(name, cr.Spec.ForProvider)
+	b.Etag = observed.Etag
+	_, err = e.budgets.Patch(name, b).UpdateMask(mask).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateBudget)
+}
+
+// Delete initiates an deletion of the external resource.
+func (e *budgetExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Budget)
+	if !ok {
+		return errors.New(errNotBudget)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.budgets.Delete(budget.GetFullyQualifiedName(cr.Spec.ForProvider.BillingAccount, meta.GetExternalName(cr))).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteBudget)
+}
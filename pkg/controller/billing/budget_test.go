@@ -0,0 +1,322 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package billing
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	budgets "google.golang.org/api/billingbudgets/v1"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/billing/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	budgetID   = "f1b2c3"
+	budgetPath = "/v1/billingAccounts/012345-567890-ABCDEF/budgets/f1b2c3"
+	budgetEtag = "etag"
+)
+
+var errBoom = errors.New("boom")
+
+func gError(code int, message string) *googleapi.Error {
+	return &googleapi.Error{
+		Code:    code,
+		Body:    "{}\n",
+		Message: message,
+	}
+}
+
+func newBudget(m ...func(*v1alpha1.Budget)) *v1alpha1.Budget {
+	cr := &v1alpha1.Budget{}
+	meta.SetExternalName(cr, budgetID)
+	cr.Spec.ForProvider = v1alpha1.BudgetParameters{
+		BillingAccount: testAccount,
+		DisplayName:    gcp.StringPtr("team"),
+		Amount: v1alpha1.BudgetAmount{
+			SpecifiedAmount: &v1alpha1.Money{CurrencyCode: gcp.StringPtr("USD"), Units: 1000},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func observedBudget() *budgets.GoogleCloudBillingBudgetsV1Budget {
+	return &budgets.GoogleCloudBillingBudgetsV1Budget{
+		Name:        "billingAccounts/" + testAccount + "/budgets/" + budgetID,
+		DisplayName: "team",
+		Etag:        budgetEtag,
+		Amount: &budgets.GoogleCloudBillingBudgetsV1BudgetAmount{
+			SpecifiedAmount: &budgets.GoogleTypeMoney{CurrencyCode: "USD", Units: 1000},
+		},
+	}
+}
+
+func TestBudgetObserve(t *testing.T) {
+	type args struct {
+		handler http.Handler
+		kube    client.Client
+		mg      *v1alpha1.Budget
+	}
+	type want struct {
+		eo  managed.ExternalObservation
+		err error
+	}
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NoExternalName": {
+			args: args{
+				handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					t.Errorf("unexpected request to %s", r.URL.Path)
+				}),
+				mg: newBudget(func(cr *v1alpha1.Budget) {
+					meta.SetExternalName(cr, "")
+				}),
+			},
+		},
+		"NotFound": {
+			args: args{
+				handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					_ = r.Body.Close()
+					w.WriteHeader(http.StatusNotFound)
+					_ = json.NewEncoder(w).Encode(struct{}{})
+				}),
+				mg: newBudget(),
+			},
+		},
+		"GetFailed": {
+			args: args{
+				handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					_ = r.Body.Close()
+					w.WriteHeader(http.StatusBadRequest)
+					_ = json.NewEncoder(w).Encode(struct{}{})
+				}),
+				mg: newBudget(),
+			},
+			want: want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetBudget)},
+		},
+		"SpecUpdateFailed": {
+			args: args{
+				handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					_ = r.Body.Close()
+					w.WriteHeader(http.StatusOK)
+					_ = json.NewEncoder(w).Encode(observedBudget())
+				}),
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+				mg: newBudget(func(cr *v1alpha1.Budget) {
+					cr.Spec.ForProvider.Amount.SpecifiedAmount.CurrencyCode = nil
+				}),
+			},
+			want: want{err: errors.Wrap(errBoom, errKubeUpdateBudget)},
+		},
+		"UpToDate": {
+			args: args{
+				handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					_ = r.Body.Close()
+					if diff := cmp.Diff(budgetPath, r.URL.Path); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					w.WriteHeader(http.StatusOK)
+					_ = json.NewEncoder(w).Encode(observedBudget())
+				}),
+				mg: newBudget(),
+			},
+			want: want{eo: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+		},
+		"NeedsUpdate": {
+			args: args{
+				handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					_ = r.Body.Close()
+					w.WriteHeader(http.StatusOK)
+					_ = json.NewEncoder(w).Encode(observedBudget())
+				}),
+				mg: newBudget(func(cr *v1alpha1.Budget) {
+					cr.Spec.ForProvider.Amount.SpecifiedAmount.Units = 2000
+				}),
+			},
+			want: want{eo: managed.ExternalObservation{ResourceExists: true}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.args.handler)
+			defer server.Close()
+			s, _ := budgets.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := budgetExternal{client: tc.args.kube, budgets: s.BillingAccounts.Budgets}
+			got, err := e.Observe(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.eo, got); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestBudgetCreate(t *testing.T) {
+	type want struct {
+		ec           managed.ExternalCreation
+		externalName string
+		err          error
+	}
+	cases := map[string]struct {
+		handler http.Handler
+		want    want
+	}{
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff("/v1/billingAccounts/012345-567890-ABCDEF/budgets", r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(observedBudget())
+			}),
+			want: want{
+				ec:           managed.ExternalCreation{ExternalNameAssigned: true},
+				externalName: budgetID,
+			},
+		},
+		"Failed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+			want: want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateBudget)},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := budgets.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := budgetExternal{budgets: s.BillingAccounts.Budgets}
+			cr := newBudget(func(cr *v1alpha1.Budget) {
+				meta.SetExternalName(cr, "")
+			})
+			got, err := e.Create(context.Background(), cr)
+			if diff := cmp.Diff(tc.want.ec, got); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.externalName, meta.GetExternalName(cr)); diff != "" {
+				t.Errorf("Create(...): -want external name, +got external name:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestBudgetUpdate(t *testing.T) {
+	cases := map[string]struct {
+		mg    *v1alpha1.Budget
+		calls []string
+	}{
+		"UpToDate": {
+			mg: newBudget(),
+		},
+		"AmountChanged": {
+			mg: newBudget(func(cr *v1alpha1.Budget) {
+				cr.Spec.ForProvider.Amount.SpecifiedAmount.Units = 2000
+			}),
+			calls: []string{"PATCH " + budgetPath + "?updateMask=amount etag=" + budgetEtag},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var calls []string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				b := &budgets.GoogleCloudBillingBudgetsV1Budget{}
+				_ = json.NewDecoder(r.Body).Decode(b)
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				if r.Method == http.MethodGet {
+					_ = json.NewEncoder(w).Encode(observedBudget())
+					return
+				}
+				calls = append(calls, r.Method+" "+r.URL.Path+"?updateMask="+r.URL.Query().Get("updateMask")+" etag="+b.Etag)
+				_ = json.NewEncoder(w).Encode(observedBudget())
+			}))
+			defer server.Close()
+			s, _ := budgets.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := budgetExternal{budgets: s.BillingAccounts.Budgets}
+			if _, err := e.Update(context.Background(), tc.mg); err != nil {
+				t.Fatalf("Update(...): unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.calls, calls); diff != "" {
+				t.Errorf("Update(...): -want calls, +got calls:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestBudgetDelete(t *testing.T) {
+	cases := map[string]struct {
+		status int
+		err    error
+	}{
+		"Successful": {
+			status: http.StatusOK,
+		},
+		"AlreadyGone": {
+			status: http.StatusNotFound,
+		},
+		"Failed": {
+			status: http.StatusBadRequest,
+			err:    errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteBudget),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodDelete, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}))
+			defer server.Close()
+			s, _ := budgets.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := budgetExternal{budgets: s.BillingAccounts.Budgets}
+			err := e.Delete(context.Background(), newBudget())
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,175 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package billing
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	cloudbilling "google.golang.org/api/cloudbilling/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/billing/v1alpha1"
+	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/projectbillinginfo"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/setup"
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+	"github.com/crossplane-contrib/provider-gcp/pkg/tracing"
+)
+
+const (
+	errNewBillingClient = "cannot create new Cloud Billing client"
+
+	errNotProjectBillingInfo        = "managed resource is not of type ProjectBillingInfo"
+	errGetProjectBillingInfo        = "cannot get ProjectBillingInfo"
+	errUpdateProjectBillingInfo     = "cannot update ProjectBillingInfo"
+	errDeleteProjectBillingInfo     = "cannot unlink the billing account of the project"
+	errKubeUpdateProjectBillingInfo = "cannot update ProjectBillingInfo custom resource"
+)
+
+// SetupProjectBillingInfo adds a controller that reconciles
+// ProjectBillingInfos.
+func SetupProjectBillingInfo(mgr ctrl.Manager, o setup.Options) error {
+	name := managed.ControllerName(v1alpha1.ProjectBillingInfoGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.ProjectBillingInfoGroupVersionKind),
+		// The billing info is identified by its project, not its name.
+		managed.WithInitializers(),
+		managed.WithExternalConnecter(setup.Connecter(mgr, o, v1alpha1.ProjectBillingInfoGroupKind, &projectBillingInfoConnector{client: mgr.GetClient()})),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.ProjectBillingInfo{}).
+		Complete(tracing.NewReconciler(name, ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter)))
+}
+
+type projectBillingInfoConnector struct {
+	client client.Client
+}
+
+// Connect returns an ExternalClient with necessary information to talk to GCP API.
+func (c *projectBillingInfoConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetConnectionInfo(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := cloudbilling.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewBillingClient)
+	}
+	return &projectBillingInfoExternal{projectID: projectID, client: c.client, projects: s.Projects}, nil
+}
+
+type projectBillingInfoExternal struct {
+	projectID string
+	client    client.Client
+	projects  *cloudbilling.ProjectsService
+}
+
+func (e *projectBillingInfoExternal) name(cr *v1alpha1.ProjectBillingInfo) string {
+	if p := gcp.StringValue(cr.Spec.ForProvider.Project); p != "" {
+		return projectbillinginfo.GetFullyQualifiedName(p)
+	}
+	return projectbillinginfo.GetFullyQualifiedName(e.projectID)
+}
+
+// Observe makes observation about the external resource. The link exists as
+// long as the project is linked to any billing account.
+func (e *projectBillingInfoExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ProjectBillingInfo)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotProjectBillingInfo)
+	}
+	observed, err := e.projects.GetBillingInfo(e.name(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetProjectBillingInfo)
+	}
+	if !projectbillinginfo.IsLinked(*observed) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	projectbillinginfo.LateInitialize(&cr.Spec.ForProvider, e.projectID)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
+		if err := e.client.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errKubeUpdateProjectBillingInfo)
+		}
+	}
+	cr.Status.AtProvider = projectbillinginfo.GenerateObservation(*observed)
+	if observed.BillingEnabled {
+		cr.SetConditions(xpv1.Available())
+	} else {
+		cr.SetConditions(xpv1.Unavailable())
+	}
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: projectbillinginfo.IsUpToDate(cr.Spec.ForProvider, *observed),
+	}, nil
+}
+
+// Create links the project to the billing account.
+func (e *projectBillingInfoExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ProjectBillingInfo)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotProjectBillingInfo)
+	}
+	cr.SetConditions(xpv1.Creating())
+	_, err := e.projects.UpdateBillingInfo(e.name(cr), projectbillinginfo.GenerateProjectBillingInfo(cr.Spec.ForProvider)).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errUpdateProjectBillingInfo)
+}
+
+// Update links the project to another billing account.
+func (e *projectBillingInfoExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.ProjectBillingInfo)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotProjectBillingInfo)
+	}
+	_, err := e.projects.UpdateBillingInfo(e.name(cr), projectbillinginfo.GenerateProjectBillingInfo(cr.Spec.ForProvider)).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateProjectBillingInfo)
+}
+
+// Delete unlinks the project from its billing account, which disables
+// billing for the project.
+func (e *projectBillingInfoExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.ProjectBillingInfo)
+	if !ok {
+		return errors.New(errNotProjectBillingInfo)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.projects.UpdateBillingInfo(e.name(cr), &cloudbilling.ProjectBillingInfo{ForceSendFields: []string{"BillingAccountName"}}).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteProjectBillingInfo)
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package billing
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	cloudbilling "google.golang.org/api/cloudbilling/v1"
+	"google.golang.org/api/option"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/billing/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	projectID   = "fooproject"
+	billingPath = "/v1/projects/fooproject/billingInfo"
+	testAccount = "012345-567890-ABCDEF"
+)
+
+func newProjectBillingInfo(m ...func(*v1alpha1.ProjectBillingInfo)) *v1alpha1.ProjectBillingInfo {
+	cr := &v1alpha1.ProjectBillingInfo{}
+	cr.Spec.ForProvider.BillingAccount = testAccount
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func TestProjectBillingInfoObserve(t *testing.T) {
+	type want struct {
+		eo      managed.ExternalObservation
+		cond    xpv1.Condition
+		project *string
+	}
+	cases := map[string]struct {
+		observed *cloudbilling.ProjectBillingInfo
+		want     want
+	}{
+		"NotLinked": {
+			observed: &cloudbilling.ProjectBillingInfo{Name: "projects/fooproject/billingInfo"},
+		},
+		"UpToDate": {
+			observed: &cloudbilling.ProjectBillingInfo{
+				Name:               "projects/fooproject/billingInfo",
+				BillingAccountName: "billingAccounts/" + testAccount,
+				BillingEnabled:     true,
+			},
+			want: want{
+				eo:      managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				cond:    xpv1.Available(),
+				project: gcp.StringPtr(projectID),
+			},
+		},
+		"LinkedToOtherAccount": {
+			observed: &cloudbilling.ProjectBillingInfo{
+				Name:               "projects/fooproject/billingInfo",
+				BillingAccountName: "billingAccounts/000000-000000-000000",
+			},
+			want: want{
+				eo:      managed.ExternalObservation{ResourceExists: true},
+				cond:    xpv1.Unavailable(),
+				project: gcp.StringPtr(projectID),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(billingPath, r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(tc.observed)
+			}))
+			defer server.Close()
+			s, _ := cloudbilling.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := projectBillingInfoExternal{projectID: projectID, client: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)}, projects: s.Projects}
+			cr := newProjectBillingInfo()
+			got, err := e.Observe(context.Background(), cr)
+			if err != nil {
+				t.Fatalf("Observe(...): unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want.eo, got); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.project, cr.Spec.ForProvider.Project); diff != "" {
+				t.Errorf("Observe(...): -want project, +got project:\n%s", diff)
+			}
+			if tc.want.cond.Type == "" {
+				return
+			}
+			if diff := cmp.Diff(tc.want.cond, cr.GetCondition(xpv1.TypeReady), test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want condition, +got condition:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestProjectBillingInfoDelete(t *testing.T) {
+	var got map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer func() { _ = r.Body.Close() }()
+		if diff := cmp.Diff(http.MethodPut, r.Method); diff != "" {
+			t.Errorf("r: -want method, +got method:\n%s", diff)
+		}
+		b, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(b, &got)
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(&cloudbilling.ProjectBillingInfo{})
+	}))
+	defer server.Close()
+
+	s, _ := cloudbilling.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	e := projectBillingInfoExternal{projectID: projectID, projects: s.Projects}
+	if err := e.Delete(context.Background(), newProjectBillingInfo()); err != nil {
+		t.Fatalf("Delete(...): unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(map[string]interface{}{"billingAccountName": ""}, got); diff != "" {
+		t.Errorf("Delete(...): -want body, +got body:\n%s", diff)
+	}
+}
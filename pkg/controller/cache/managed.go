@@ -28,7 +28,6 @@ import (
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/connection"
-	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	"github.com/crossplane/crossplane-runtime/pkg/errors"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
@@ -40,7 +39,9 @@ import (
 	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
 	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
 	"github.com/crossplane-contrib/provider-gcp/pkg/clients/cloudmemorystore"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/setup"
 	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+	"github.com/crossplane-contrib/provider-gcp/pkg/tracing"
 )
 
 // Error strings.
@@ -58,7 +59,7 @@ const (
 
 // SetupCloudMemorystoreInstance adds a controller that reconciles
 // CloudMemorystoreInstances.
-func SetupCloudMemorystoreInstance(mgr ctrl.Manager, o controller.Options) error {
+func SetupCloudMemorystoreInstance(mgr ctrl.Manager, o setup.Options) error {
 	name := managed.ControllerName(v1beta1.CloudMemorystoreInstanceGroupKind)
 
 	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
@@ -66,23 +67,25 @@ func SetupCloudMemorystoreInstance(mgr ctrl.Manager, o controller.Options) error
 		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
 	}
 
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(v1beta1.CloudMemorystoreInstanceGroupVersionKind),
-		managed.WithExternalConnecter(&connecter{client: mgr.GetClient()}),
+		managed.WithExternalConnecter(setup.Connecter(mgr, o, v1beta1.CloudMemorystoreInstanceGroupKind, &connecter{client: mgr.GetClient(), record: recorder})),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
-		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithRecorder(recorder),
 		managed.WithConnectionPublishers(cps...))
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(o.ForControllerRuntime()).
 		For(&v1beta1.CloudMemorystoreInstance{}).
-		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+		Complete(tracing.NewReconciler(name, ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter)))
 }
 
 type connecter struct {
 	client client.Client
+	record event.Recorder
 }
 
 func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
@@ -94,13 +97,14 @@ func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	if err != nil {
 		return nil, errors.Wrap(err, errNewClient)
 	}
-	return &external{cms: s, projectID: projectID, kube: c.client}, errors.Wrap(err, errNewClient)
+	return &external{cms: s, projectID: projectID, kube: c.client, record: c.record}, errors.Wrap(err, errNewClient)
 }
 
 type external struct {
 	kube      client.Client
 	cms       *redis.Service
 	projectID string
+	record    event.Recorder
 }
 
 func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) { // nolint:gocyclo
@@ -124,20 +128,21 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		}
 	}
 	cr.Status.AtProvider = cloudmemorystore.GenerateObservation(*existing)
-	conn := managed.ConnectionDetails{}
+	// The endpoint is published whenever the instance reports one, rather
+	// than only once it is ready, so that the connection secret follows the
+	// primary endpoint while the instance fails over or is under maintenance.
+	conn := getConnectionDetails(*existing)
 	switch cr.Status.AtProvider.State {
 	case cloudmemorystore.StateReady:
 		cr.Status.SetConditions(xpv1.Available())
-		conn[xpv1.ResourceCredentialsSecretEndpointKey] = []byte(cr.Status.AtProvider.Host)
-		conn[xpv1.ResourceCredentialsSecretPortKey] = []byte(strconv.Itoa(int(cr.Status.AtProvider.Port)))
-		if cr.Spec.ForProvider.AuthEnabled != nil {
-			if *cr.Spec.ForProvider.AuthEnabled {
-				existingAuthString, err := e.cms.Projects.Locations.Instances.GetAuthString(existing.Name).Context(ctx).Do()
-				if err != nil {
-					return managed.ExternalObservation{}, errors.Wrap(err, errAuthString)
-				}
-				conn[xpv1.ResourceCredentialsSecretPasswordKey] = []byte(cloudmemorystore.GenerateAuthStringObservation(*existingAuthString))
+		if existing.AuthEnabled {
+			existingAuthString, err := e.cms.Projects.Locations.Instances.GetAuthString(existing.Name).Context(ctx).Do()
+			if err != nil {
+				return managed.ExternalObservation{}, errors.Wrap(err, errAuthString)
 			}
+			authString := []byte(cloudmemorystore.GenerateAuthStringObservation(*existingAuthString))
+			conn[xpv1.ResourceCredentialsSecretPasswordKey] = authString
+			conn[v1beta1.CloudMemorystoreSecretAuthStringKey] = authString
 		}
 	case cloudmemorystore.StateCreating:
 		cr.Status.SetConditions(xpv1.Creating())
@@ -162,6 +167,20 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 
 }
 
+func getConnectionDetails(r redis.Instance) managed.ConnectionDetails {
+	conn := managed.ConnectionDetails{}
+	if r.Host == "" {
+		return conn
+	}
+	conn[xpv1.ResourceCredentialsSecretEndpointKey] = []byte(r.Host)
+	conn[v1beta1.CloudMemorystoreSecretHostKey] = []byte(r.Host)
+	conn[xpv1.ResourceCredentialsSecretPortKey] = []byte(strconv.Itoa(int(r.Port)))
+	if ca := cloudmemorystore.GetServerCACertificate(r); ca != nil {
+		conn[v1beta1.CloudMemorystoreSecretServerCACertKey] = ca
+	}
+	return conn
+}
+
 func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
 	i, ok := mg.(*v1beta1.CloudMemorystoreInstance)
 	if !ok {
@@ -174,8 +193,12 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 	instance := &redis.Instance{}
 	cloudmemorystore.GenerateRedisInstance(cloudmemorystore.GetFullyQualifiedName(e.projectID, i.Spec.ForProvider, meta.GetExternalName(i)), i.Spec.ForProvider, instance)
 
-	_, err := e.cms.Projects.Locations.Instances.Create(cloudmemorystore.GetFullyQualifiedParent(e.projectID, i.Spec.ForProvider), instance).InstanceId(meta.GetExternalName(i)).Context(ctx).Do()
-	return managed.ExternalCreation{}, errors.Wrap(err, errCreateInstance)
+	op, err := e.cms.Projects.Locations.Instances.Create(cloudmemorystore.GetFullyQualifiedParent(e.projectID, i.Spec.ForProvider), instance).InstanceId(meta.GetExternalName(i)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateInstance)
+	}
+	gcp.RecordOperation(e.record, i, "create", op.Name)
+	return managed.ExternalCreation{}, nil
 }
 
 func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
@@ -188,8 +211,12 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 	fqn := cloudmemorystore.GetFullyQualifiedName(e.projectID, i.Spec.ForProvider, meta.GetExternalName(i))
 	cloudmemorystore.GenerateRedisInstance(fqn, i.Spec.ForProvider, instance)
 	updateMask := strings.Join([]string{"display_name", "labels", "memory_size_gb", "redis_configs"}, ",")
-	_, err := e.cms.Projects.Locations.Instances.Patch(fqn, instance).UpdateMask(updateMask).Context(ctx).Do()
-	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateInstance)
+	op, err := e.cms.Projects.Locations.Instances.Patch(fqn, instance).UpdateMask(updateMask).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateInstance)
+	}
+	gcp.RecordOperation(e.record, i, "update", op.Name)
+	return managed.ExternalUpdate{}, nil
 }
 
 func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
@@ -199,6 +226,10 @@ func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
 	}
 	i.SetConditions(xpv1.Deleting())
 
-	_, err := e.cms.Projects.Locations.Instances.Delete(cloudmemorystore.GetFullyQualifiedName(e.projectID, i.Spec.ForProvider, meta.GetExternalName(i))).Context(ctx).Do()
-	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteInstance)
+	op, err := e.cms.Projects.Locations.Instances.Delete(cloudmemorystore.GetFullyQualifiedName(e.projectID, i.Spec.ForProvider, meta.GetExternalName(i))).Context(ctx).Do()
+	if err != nil {
+		return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteInstance)
+	}
+	gcp.RecordOperation(e.record, i, "delete", op.Name)
+	return nil
 }
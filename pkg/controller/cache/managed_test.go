@@ -29,16 +29,19 @@ import (
 	"google.golang.org/api/option"
 	redis "google.golang.org/api/redis/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 
 	"github.com/crossplane-contrib/provider-gcp/apis/cache/v1beta1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
 	"github.com/crossplane-contrib/provider-gcp/pkg/clients/cloudmemorystore"
 )
 
@@ -50,8 +53,11 @@ const (
 	qualifiedName = "projects/" + project + "/locations/" + region + "/instances/" + instanceName
 	memorySizeGB  = 1
 	host          = "172.16.0.1"
+	failoverHost  = "172.16.0.2"
+	serverCACert  = "-----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----"
 	port          = 6379
 	password      = "" // empty because AuthString generated by Google
+	operationName = "operation-cool"
 
 	connectionSecretName = "cool-connection-secret"
 )
@@ -97,6 +103,12 @@ func withPort(p int) instanceModifier {
 	return func(i *v1beta1.CloudMemorystoreInstance) { i.Status.AtProvider.Port = int64(p) }
 }
 
+func withServerCACert(cert string) instanceModifier {
+	return func(i *v1beta1.CloudMemorystoreInstance) {
+		i.Status.AtProvider.ServerCaCerts = append(i.Status.AtProvider.ServerCaCerts, v1beta1.ServerCACertsObservation{Cert: cert})
+	}
+}
+
 func instance(im ...instanceModifier) *v1beta1.CloudMemorystoreInstance {
 	i := &v1beta1.CloudMemorystoreInstance{
 		ObjectMeta: metav1.ObjectMeta{
@@ -184,9 +196,55 @@ func TestObserve(t *testing.T) {
 				observation: managed.ExternalObservation{
 					ResourceExists: true,
 					ConnectionDetails: managed.ConnectionDetails{
-						xpv1.ResourceCredentialsSecretEndpointKey: []byte(host),
-						xpv1.ResourceCredentialsSecretPasswordKey: []byte(password),
-						xpv1.ResourceCredentialsSecretPortKey:     []byte(strconv.Itoa(port)),
+						xpv1.ResourceCredentialsSecretEndpointKey:   []byte(host),
+						xpv1.ResourceCredentialsSecretPasswordKey:   []byte(password),
+						xpv1.ResourceCredentialsSecretPortKey:       []byte(strconv.Itoa(port)),
+						v1beta1.CloudMemorystoreSecretHostKey:       []byte(host),
+						v1beta1.CloudMemorystoreSecretAuthStringKey: []byte(password),
+					},
+				},
+			},
+		},
+		"ObservedInstanceFailingOver": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				if err := json.NewEncoder(w).Encode(&redis.Instance{
+					State:         cloudmemorystore.StateFailingOver,
+					Host:          failoverHost,
+					Port:          port,
+					Name:          qualifiedName,
+					AuthEnabled:   authEnabled,
+					ServerCaCerts: []*redis.TlsCertificate{{Cert: serverCACert}},
+				}); err != nil {
+					t.Error(err)
+				}
+			}),
+			kube: &test.MockClient{
+				MockUpdate: test.NewMockUpdateFn(nil),
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  instance(),
+			},
+			want: want{
+				mg: instance(
+					withConditions(xpv1.Unavailable()),
+					withState(cloudmemorystore.StateFailingOver),
+					withHost(failoverHost),
+					withPort(port),
+					withFullName(qualifiedName),
+					withServerCACert(serverCACert)),
+				observation: managed.ExternalObservation{
+					ResourceExists: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretEndpointKey:     []byte(failoverHost),
+						xpv1.ResourceCredentialsSecretPortKey:         []byte(strconv.Itoa(port)),
+						v1beta1.CloudMemorystoreSecretHostKey:         []byte(failoverHost),
+						v1beta1.CloudMemorystoreSecretServerCACertKey: []byte(serverCACert + "\n"),
 					},
 				},
 			},
@@ -541,8 +599,9 @@ func TestDelete(t *testing.T) {
 		mg  resource.Managed
 	}
 	type want struct {
-		mg  resource.Managed
-		err error
+		mg     resource.Managed
+		err    error
+		events []event.Event
 	}
 
 	cases := map[string]struct {
@@ -558,8 +617,8 @@ func TestDelete(t *testing.T) {
 					t.Errorf("r: -want, +got:\n%s", diff)
 				}
 				w.WriteHeader(http.StatusOK)
-				if err := json.NewEncoder(w).Encode(&redis.Instance{
-					Name: qualifiedName,
+				if err := json.NewEncoder(w).Encode(&redis.Operation{
+					Name: operationName,
 				}); err != nil {
 					t.Error(err)
 				}
@@ -569,7 +628,8 @@ func TestDelete(t *testing.T) {
 				mg:  instance(),
 			},
 			want: want{
-				mg: instance(withConditions(xpv1.Deleting())),
+				mg:     instance(withConditions(xpv1.Deleting())),
+				events: []event.Event{event.Normal(gcp.ReasonOperationStarted, "Started GCP delete operation "+operationName)},
 			},
 		},
 		"NotCloudMemorystoreInstance": {
@@ -606,12 +666,17 @@ func TestDelete(t *testing.T) {
 			server := httptest.NewServer(tc.handler)
 			defer server.Close()
 			s, _ := redis.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			r := &recorder{}
 			e := external{
 				kube:      tc.kube,
 				projectID: "cool-project",
 				cms:       s,
+				record:    r,
 			}
 			err := e.Delete(tc.args.ctx, tc.args.mg)
+			if diff := cmp.Diff(tc.want.events, r.events); diff != "" {
+				t.Errorf("Delete(...): -want events, +got events:\n%s", diff)
+			}
 
 			if tc.want.err != nil && err != nil {
 				// the case where our mock server returns error.
@@ -626,3 +691,11 @@ func TestDelete(t *testing.T) {
 		})
 	}
 }
+
+type recorder struct {
+	events []event.Event
+}
+
+func (r *recorder) Event(_ runtime.Object, e event.Event) { r.events = append(r.events, e) }
+
+func (r *recorder) WithAnnotations(_ ...string) event.Recorder { return r }
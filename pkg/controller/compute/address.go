@@ -26,7 +26,6 @@ import (
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/connection"
-	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	"github.com/crossplane/crossplane-runtime/pkg/errors"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
@@ -38,7 +37,9 @@ import (
 	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
 	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
 	"github.com/crossplane-contrib/provider-gcp/pkg/clients/address"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/setup"
 	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+	"github.com/crossplane-contrib/provider-gcp/pkg/tracing"
 )
 
 // Error strings.
@@ -51,7 +52,7 @@ const (
 )
 
 // SetupAddress adds a controller that reconciles Address managed resources.
-func SetupAddress(mgr ctrl.Manager, o controller.Options) error {
+func SetupAddress(mgr ctrl.Manager, o setup.Options) error {
 	name := managed.ControllerName(v1beta1.AddressGroupKind)
 
 	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
@@ -59,24 +60,38 @@ func SetupAddress(mgr ctrl.Manager, o controller.Options) error {
 		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
 	}
 
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(v1beta1.AddressGroupVersionKind),
-		managed.WithExternalConnecter(&addressConnector{kube: mgr.GetClient()}),
+		managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient()), gcp.NewLocationDefaulter(mgr.GetClient(), gcp.LocationScopeRegion, addressRegion)),
+		managed.WithExternalConnecter(setup.Connecter(mgr, o, v1beta1.AddressGroupKind, &addressConnector{kube: mgr.GetClient(), record: recorder})),
 		managed.WithConnectionPublishers(),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
-		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithRecorder(recorder),
 		managed.WithConnectionPublishers(cps...))
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(o.ForControllerRuntime()).
 		For(&v1beta1.Address{}).
-		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+		Complete(tracing.NewReconciler(name, ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter)))
+}
+
+// addressRegion returns the region of the supplied Address so that it can be
+// defaulted from its ProviderConfig.
+func addressRegion(mg resource.Managed) *string {
+	cr, ok := mg.(*v1beta1.Address)
+	if !ok {
+		return nil
+	}
+	return &cr.Spec.ForProvider.Region
 }
 
 type addressConnector struct {
-	kube client.Client
+	kube   client.Client
+	record event.Recorder
 }
 
 func (c *addressConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
@@ -88,13 +103,14 @@ func (c *addressConnector) Connect(ctx context.Context, mg resource.Managed) (ma
 	if err != nil {
 		return nil, errors.Wrap(err, errNewClient)
 	}
-	return &addressExternal{kube: c.kube, Service: s, projectID: projectID}, errors.Wrap(err, errNewClient)
+	return &addressExternal{kube: c.kube, Service: s, projectID: projectID, record: c.record}, errors.Wrap(err, errNewClient)
 }
 
 type addressExternal struct {
 	kube      client.Client
 	projectID string
 	*compute.Service
+	record event.Recorder
 }
 
 func (e *addressExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -136,8 +152,12 @@ func (e *addressExternal) Create(ctx context.Context, mg resource.Managed) (mana
 
 	addr := &compute.Address{}
 	address.GenerateAddress(meta.GetExternalName(cr), cr.Spec.ForProvider, addr)
-	_, err := e.Addresses.Insert(e.projectID, addr.Region, addr).Context(ctx).Do()
-	return managed.ExternalCreation{}, errors.Wrap(err, errCreateAddress)
+	op, err := e.Addresses.Insert(e.projectID, addr.Region, addr).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateAddress)
+	}
+	gcp.RecordOperation(e.record, cr, "create", op.Name)
+	return managed.ExternalCreation{}, nil
 }
 
 func (e *addressExternal) Update(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
@@ -151,6 +171,10 @@ func (e *addressExternal) Delete(ctx context.Context, mg resource.Managed) error
 		return errors.New(errNotAddress)
 	}
 
-	_, err := e.Addresses.Delete(e.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr)).Context(ctx).Do()
-	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteAddress)
+	op, err := e.Addresses.Delete(e.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr)).Context(ctx).Do()
+	if err != nil {
+		return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteAddress)
+	}
+	gcp.RecordOperation(e.record, cr, "delete", op.Name)
+	return nil
 }
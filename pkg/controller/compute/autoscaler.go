@@ -0,0 +1,204 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/compute/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/autoscaler"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/setup"
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+	"github.com/crossplane-contrib/provider-gcp/pkg/tracing"
+)
+
+const (
+	// Error strings.
+	errNotAutoscaler = "managed resource is not an Autoscaler resource"
+	errGetAutoscaler = "cannot get GCP Autoscaler"
+
+	errAutoscalerUpdateFailed  = "update of Autoscaler resource has failed"
+	errAutoscalerCreateFailed  = "creation of Autoscaler resource has failed"
+	errAutoscalerDeleteFailed  = "deletion of Autoscaler resource has failed"
+	errGenerateAutoscaler      = "cannot generate Autoscaler from spec"
+	errCheckAutoscalerUpToDate = "cannot determine if GCP Autoscaler is up to date"
+)
+
+// SetupAutoscaler adds a controller that reconciles Autoscaler managed
+// resources.
+func SetupAutoscaler(mgr ctrl.Manager, o setup.Options) error {
+	name := managed.ControllerName(v1alpha1.AutoscalerGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
+	}
+
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.AutoscalerGroupVersionKind),
+		managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient()), gcp.NewLocationDefaulter(mgr.GetClient(), gcp.LocationScopeRegion, autoscalerRegion)),
+		managed.WithExternalConnecter(setup.Connecter(mgr, o, v1alpha1.AutoscalerGroupKind, &autoscalerConnector{kube: mgr.GetClient(), record: recorder})),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(recorder),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.Autoscaler{}).
+		Complete(tracing.NewReconciler(name, ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter)))
+}
+
+// autoscalerRegion returns the region of the supplied Autoscaler so that it
+// can be defaulted from its ProviderConfig.
+func autoscalerRegion(mg resource.Managed) *string {
+	cr, ok := mg.(*v1alpha1.Autoscaler)
+	if !ok {
+		return nil
+	}
+	return &cr.Spec.ForProvider.Region
+}
+
+type autoscalerConnector struct {
+	kube   client.Client
+	record event.Recorder
+}
+
+func (c *autoscalerConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetConnectionInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := compute.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &autoscalerExternal{Service: s, kube: c.kube, projectID: projectID, record: c.record}, nil
+}
+
+type autoscalerExternal struct {
+	kube client.Client
+	*compute.Service
+	projectID string
+	record    event.Recorder
+}
+
+func (c *autoscalerExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Autoscaler)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotAutoscaler)
+	}
+	observed, err := c.RegionAutoscalers.Get(c.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetAutoscaler)
+	}
+
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	autoscaler.LateInitializeSpec(&cr.Spec.ForProvider, *observed)
+	lateInitialized := !cmp.Equal(currentSpec, &cr.Spec.ForProvider)
+
+	cr.Status.AtProvider = autoscaler.GenerateAutoscalerObservation(*observed)
+	cr.Status.SetConditions(xpv1.Available())
+
+	u, err := autoscaler.IsUpToDate(meta.GetExternalName(cr), &cr.Spec.ForProvider, observed)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errCheckAutoscalerUpToDate)
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceLateInitialized: lateInitialized,
+		ResourceUpToDate:        u,
+	}, nil
+}
+
+func (c *autoscalerExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Autoscaler)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotAutoscaler)
+	}
+
+	as := &compute.Autoscaler{}
+	if err := autoscaler.GenerateAutoscaler(meta.GetExternalName(cr), cr.Spec.ForProvider, as); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errGenerateAutoscaler)
+	}
+	op, err := c.RegionAutoscalers.Insert(c.projectID, cr.Spec.ForProvider.Region, as).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errAutoscalerCreateFailed)
+	}
+	gcp.RecordOperation(c.record, cr, "create", op.Name)
+	return managed.ExternalCreation{}, nil
+}
+
+func (c *autoscalerExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Autoscaler)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotAutoscaler)
+	}
+
+	as := &compute.Autoscaler{}
+	if err := autoscaler.GenerateAutoscaler(meta.GetExternalName(cr), cr.Spec.ForProvider, as); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGenerateAutoscaler)
+	}
+	op, err := c.RegionAutoscalers.Patch(c.projectID, cr.Spec.ForProvider.Region, as).
+		Autoscaler(meta.GetExternalName(cr)).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errAutoscalerUpdateFailed)
+	}
+	gcp.RecordOperation(c.record, cr, "update", op.Name)
+	return managed.ExternalUpdate{}, nil
+}
+
+func (c *autoscalerExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Autoscaler)
+	if !ok {
+		return errors.New(errNotAutoscaler)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+	op, err := c.RegionAutoscalers.Delete(c.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr)).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errAutoscalerDeleteFailed)
+	}
+	gcp.RecordOperation(c.record, cr, "delete", op.Name)
+	return nil
+}
@@ -0,0 +1,302 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	compute "google.golang.org/api/compute/v1"
+	"google.golang.org/api/option"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+var _ managed.ExternalConnecter = &autoscalerConnector{}
+var _ managed.ExternalClient = &autoscalerExternal{}
+
+const (
+	testAutoscalerName = "test-autoscaler"
+	testAutoscalerPath = "/projects/" + projectID + "/regions/us-west1/autoscalers"
+)
+
+func autoscalerObj(m ...func(*v1alpha1.Autoscaler)) *v1alpha1.Autoscaler {
+	a := &v1alpha1.Autoscaler{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: testAutoscalerName,
+			Annotations: map[string]string{
+				meta.AnnotationKeyExternalName: testAutoscalerName,
+			},
+		},
+		Spec: v1alpha1.AutoscalerSpec{
+			ForProvider: v1alpha1.AutoscalerParameters{
+				Region: "us-west1",
+				Target: "regions/us-west1/instanceGroupManagers/mig",
+				AutoscalingPolicy: v1alpha1.AutoscalingPolicy{
+					MinNumReplicas:    gcp.Int64Ptr(1),
+					MaxNumReplicas:    5,
+					CoolDownPeriodSec: gcp.Int64Ptr(60),
+					Mode:              gcp.StringPtr("ON"),
+					CPUUtilization: &v1alpha1.AutoscalingPolicyCPUUtilization{
+						UtilizationTarget: "0.6",
+						PredictiveMethod:  gcp.StringPtr("NONE"),
+					},
+				},
+			},
+		},
+	}
+	for _, f := range m {
+		f(a)
+	}
+	return a
+}
+
+func observedAutoscaler(m ...func(*compute.Autoscaler)) *compute.Autoscaler {
+	a := &compute.Autoscaler{
+		Name:   testAutoscalerName,
+		Target: "https://www.googleapis.com/compute/v1/projects/" + projectID + "/regions/us-west1/instanceGroupManagers/mig",
+		AutoscalingPolicy: &compute.AutoscalingPolicy{
+			MinNumReplicas:    1,
+			MaxNumReplicas:    5,
+			CoolDownPeriodSec: 60,
+			Mode:              "ON",
+			CpuUtilization: &compute.AutoscalingPolicyCpuUtilization{
+				UtilizationTarget: 0.6,
+				PredictiveMethod:  "NONE",
+			},
+		},
+	}
+	for _, f := range m {
+		f(a)
+	}
+	return a
+}
+
+func TestAutoscalerObserve(t *testing.T) {
+	type want struct {
+		eo  managed.ExternalObservation
+		err error
+	}
+	cases := map[string]struct {
+		handler http.Handler
+		want    want
+	}{
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+			}),
+		},
+		"GetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&compute.Autoscaler{})
+			}),
+			want: want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetAutoscaler)},
+		},
+		"NotUpToDate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(observedAutoscaler(func(a *compute.Autoscaler) {
+					a.AutoscalingPolicy.MaxNumReplicas = 10
+				}))
+			}),
+			want: want{eo: managed.ExternalObservation{ResourceExists: true}},
+		},
+		"UpToDate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(testAutoscalerPath+"/"+testAutoscalerName, r.URL.Path); diff != "" {
+					t.Errorf("r: -want path, +got path:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(observedAutoscaler())
+			}),
+			want: want{eo: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+		},
+		"LateInitialized": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(observedAutoscaler(func(a *compute.Autoscaler) {
+					a.Description = "some desc"
+				}))
+			}),
+			want: want{eo: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := autoscalerExternal{Service: s, projectID: projectID, record: event.NewNopRecorder()}
+			got, err := e.Observe(context.Background(), autoscalerObj())
+			if diff := cmp.Diff(tc.want.eo, got); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAutoscalerCreate(t *testing.T) {
+	_, errParse := strconv.ParseFloat("sixty", 64)
+
+	cases := map[string]struct {
+		mg     *v1alpha1.Autoscaler
+		status int
+		err    error
+	}{
+		"Successful": {
+			mg:     autoscalerObj(),
+			status: http.StatusOK,
+		},
+		"GenerateFailed": {
+			mg: autoscalerObj(func(a *v1alpha1.Autoscaler) {
+				a.Spec.ForProvider.AutoscalingPolicy.CPUUtilization.UtilizationTarget = "sixty"
+			}),
+			status: http.StatusOK,
+			err:    errors.Wrap(errors.Wrap(errParse, "cannot parse utilization target"), errGenerateAutoscaler),
+		},
+		"CreateFailed": {
+			mg:     autoscalerObj(),
+			status: http.StatusBadRequest,
+			err:    errors.Wrap(gError(http.StatusBadRequest, ""), errAutoscalerCreateFailed),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				a := &compute.Autoscaler{}
+				_ = json.NewDecoder(r.Body).Decode(a)
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+					t.Errorf("r: -want method, +got method:\n%s", diff)
+				}
+				if diff := cmp.Diff(testAutoscalerName, a.Name); diff != "" {
+					t.Errorf("r: -want name, +got name:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}))
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := autoscalerExternal{Service: s, projectID: projectID, record: event.NewNopRecorder()}
+			_, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAutoscalerUpdate(t *testing.T) {
+	cases := map[string]struct {
+		status int
+		err    error
+	}{
+		"Successful": {
+			status: http.StatusOK,
+		},
+		"UpdateFailed": {
+			status: http.StatusBadRequest,
+			err:    errors.Wrap(gError(http.StatusBadRequest, ""), errAutoscalerUpdateFailed),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodPatch, r.Method); diff != "" {
+					t.Errorf("r: -want method, +got method:\n%s", diff)
+				}
+				if diff := cmp.Diff(testAutoscalerName, r.URL.Query().Get("autoscaler")); diff != "" {
+					t.Errorf("r: -want autoscaler, +got autoscaler:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}))
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := autoscalerExternal{Service: s, projectID: projectID, record: event.NewNopRecorder()}
+			_, err := e.Update(context.Background(), autoscalerObj())
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAutoscalerDelete(t *testing.T) {
+	cases := map[string]struct {
+		status int
+		err    error
+	}{
+		"Successful": {
+			status: http.StatusOK,
+		},
+		"NotFound": {
+			status: http.StatusNotFound,
+		},
+		"DeleteFailed": {
+			status: http.StatusBadRequest,
+			err:    errors.Wrap(gError(http.StatusBadRequest, ""), errAutoscalerDeleteFailed),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodDelete, r.Method); diff != "" {
+					t.Errorf("r: -want method, +got method:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}))
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := autoscalerExternal{Service: s, projectID: projectID, record: event.NewNopRecorder()}
+			cr := autoscalerObj()
+			err := e.Delete(context.Background(), cr)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(xpv1.Deleting(), cr.GetCondition(xpv1.TypeReady), test.EquateConditions()); diff != "" {
+				t.Errorf("Delete(...): -want condition, +got condition:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"testing"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1beta1"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/conformance"
+)
+
+func TestConformance(t *testing.T) {
+	conformance.Run(t, []string{v1alpha1.Group, v1beta1.Group}, map[string]conformance.Fixture{
+		v1beta1.AddressGroupKind: {
+			Managed:   func() resource.Managed { return &v1beta1.Address{} },
+			Connecter: func(kube client.Client) managed.ExternalConnecter { return &addressConnector{kube: kube} },
+		},
+		v1alpha1.AutoscalerGroupKind: {
+			Managed:   func() resource.Managed { return &v1alpha1.Autoscaler{} },
+			Connecter: func(kube client.Client) managed.ExternalConnecter { return &autoscalerConnector{kube: kube} },
+		},
+		v1alpha1.FirewallGroupKind: {
+			Managed:   func() resource.Managed { return &v1alpha1.Firewall{} },
+			Connecter: func(kube client.Client) managed.ExternalConnecter { return &firewallConnector{kube: kube} },
+		},
+		v1alpha1.ForwardingRuleGroupKind: {
+			Managed:   func() resource.Managed { return &v1alpha1.ForwardingRule{} },
+			Connecter: func(kube client.Client) managed.ExternalConnecter { return &forwardingRuleConnector{kube: kube} },
+		},
+		v1beta1.GlobalAddressGroupKind: {
+			Managed:   func() resource.Managed { return &v1beta1.GlobalAddress{} },
+			Connecter: func(kube client.Client) managed.ExternalConnecter { return &gaConnector{kube: kube} },
+		},
+		v1alpha1.ImageImportGroupKind: {
+			Managed:   func() resource.Managed { return &v1alpha1.ImageImport{} },
+			Connecter: func(kube client.Client) managed.ExternalConnecter { return &imageImportConnector{kube: kube} },
+		},
+		v1alpha1.InstanceTemplateGroupKind: {
+			Managed:   func() resource.Managed { return &v1alpha1.InstanceTemplate{} },
+			Connecter: func(kube client.Client) managed.ExternalConnecter { return &instanceTemplateConnector{kube: kube} },
+		},
+		v1alpha1.InterconnectAttachmentGroupKind: {
+			Managed: func() resource.Managed { return &v1alpha1.InterconnectAttachment{} },
+			Connecter: func(kube client.Client) managed.ExternalConnecter {
+				return &interconnectAttachmentConnector{kube: kube}
+			},
+		},
+		v1alpha1.MachineImageGroupKind: {
+			Managed:   func() resource.Managed { return &v1alpha1.MachineImage{} },
+			Connecter: func(kube client.Client) managed.ExternalConnecter { return &machineImageConnector{kube: kube} },
+		},
+		v1beta1.NetworkGroupKind: {
+			Managed:   func() resource.Managed { return &v1beta1.Network{} },
+			Connecter: func(kube client.Client) managed.ExternalConnecter { return &networkConnector{kube: kube} },
+		},
+		v1alpha1.PacketMirroringGroupKind: {
+			Managed:   func() resource.Managed { return &v1alpha1.PacketMirroring{} },
+			Connecter: func(kube client.Client) managed.ExternalConnecter { return &packetMirroringConnector{kube: kube} },
+		},
+		v1alpha1.ProjectSSHKeyGroupKind: {
+			Managed: func() resource.Managed {
+				return &v1alpha1.ProjectSSHKey{Spec: v1alpha1.ProjectSSHKeySpec{ForProvider: v1alpha1.ProjectSSHKeyParameters{
+					Username:  "alice",
+					PublicKey: "ssh-ed25519 AAAAalice",
+				}}}
+			},
+			Connecter: func(kube client.Client) managed.ExternalConnecter { return &projectSSHKeyConnector{kube: kube} },
+			Identity:  "The key is an entry of the ssh-keys item of the project metadata.",
+			Found:     `{"commonInstanceMetadata":{"items":[{"key":"ssh-keys","value":"alice:ssh-ed25519 AAAAalice"}]}}`,
+			NotFound:  `{}`,
+		},
+		v1alpha1.PublicAdvertisedPrefixGroupKind: {
+			Managed: func() resource.Managed { return &v1alpha1.PublicAdvertisedPrefix{} },
+			Connecter: func(kube client.Client) managed.ExternalConnecter {
+				return &publicAdvertisedPrefixConnector{kube: kube}
+			},
+		},
+		v1alpha1.PublicDelegatedPrefixGroupKind: {
+			Managed:   func() resource.Managed { return &v1alpha1.PublicDelegatedPrefix{} },
+			Connecter: func(kube client.Client) managed.ExternalConnecter { return &publicDelegatedPrefixConnector{kube: kube} },
+		},
+		v1alpha1.RouterGroupKind: {
+			Managed:   func() resource.Managed { return &v1alpha1.Router{} },
+			Connecter: func(kube client.Client) managed.ExternalConnecter { return &routerConnector{kube: kube} },
+		},
+		v1alpha1.ServiceAttachmentGroupKind: {
+			Managed:   func() resource.Managed { return &v1alpha1.ServiceAttachment{} },
+			Connecter: func(kube client.Client) managed.ExternalConnecter { return &serviceAttachmentConnector{kube: kube} },
+		},
+		v1beta1.SubnetworkGroupKind: {
+			Managed:   func() resource.Managed { return &v1beta1.Subnetwork{} },
+			Connecter: func(kube client.Client) managed.ExternalConnecter { return &subnetworkConnector{kube: kube} },
+		},
+		v1alpha1.TargetSSLProxyGroupKind: {
+			Managed:   func() resource.Managed { return &v1alpha1.TargetSSLProxy{} },
+			Connecter: func(kube client.Client) managed.ExternalConnecter { return &targetSSLProxyConnector{kube: kube} },
+		},
+		v1alpha1.TargetTCPProxyGroupKind: {
+			Managed:   func() resource.Managed { return &v1alpha1.TargetTCPProxy{} },
+			Connecter: func(kube client.Client) managed.ExternalConnecter { return &targetTCPProxyConnector{kube: kube} },
+		},
+		v1alpha1.UnmanagedInstanceGroupGroupKind: {
+			Managed: func() resource.Managed { return &v1alpha1.UnmanagedInstanceGroup{} },
+			Connecter: func(kube client.Client) managed.ExternalConnecter {
+				return &unmanagedInstanceGroupConnector{kube: kube}
+			},
+		},
+	})
+}
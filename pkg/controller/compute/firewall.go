@@ -26,7 +26,6 @@ import (
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/connection"
-	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	"github.com/crossplane/crossplane-runtime/pkg/errors"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
@@ -38,7 +37,10 @@ import (
 	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
 	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
 	"github.com/crossplane-contrib/provider-gcp/pkg/clients/firewall"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/setup"
 	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+	"github.com/crossplane-contrib/provider-gcp/pkg/replace"
+	"github.com/crossplane-contrib/provider-gcp/pkg/tracing"
 )
 
 const (
@@ -54,7 +56,7 @@ const (
 
 // SetupFirewall adds a controller that reconciles Firewall managed
 // resources.
-func SetupFirewall(mgr ctrl.Manager, o controller.Options) error {
+func SetupFirewall(mgr ctrl.Manager, o setup.Options) error {
 	name := managed.ControllerName(v1alpha1.FirewallGroupKind)
 
 	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
@@ -62,23 +64,26 @@ func SetupFirewall(mgr ctrl.Manager, o controller.Options) error {
 		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
 	}
 
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(v1alpha1.FirewallGroupVersionKind),
-		managed.WithExternalConnecter(&firewallConnector{kube: mgr.GetClient()}),
+		managed.WithExternalConnecter(setup.Connecter(mgr, o, v1alpha1.FirewallGroupKind, &firewallConnector{kube: mgr.GetClient(), record: recorder})),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithPollInterval(o.PollInterval),
-		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithRecorder(recorder),
 		managed.WithConnectionPublishers(cps...))
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(o.ForControllerRuntime()).
 		For(&v1alpha1.Firewall{}).
-		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+		Complete(tracing.NewReconciler(name, ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter)))
 }
 
 type firewallConnector struct {
-	kube client.Client
+	kube   client.Client
+	record event.Recorder
 }
 
 func (c *firewallConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
@@ -90,13 +95,14 @@ func (c *firewallConnector) Connect(ctx context.Context, mg resource.Managed) (m
 	if err != nil {
 		return nil, errors.Wrap(err, errNewClient)
 	}
-	return &firewallExternal{Service: s, kube: c.kube, projectID: projectID}, nil
+	return &firewallExternal{Service: s, kube: c.kube, projectID: projectID, record: c.record}, nil
 }
 
 type firewallExternal struct {
 	kube client.Client
 	*compute.Service
 	projectID string
+	record    event.Recorder
 }
 
 func (c *firewallExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -140,10 +146,14 @@ func (c *firewallExternal) Create(ctx context.Context, mg resource.Managed) (man
 
 	fw := &compute.Firewall{}
 	firewall.GenerateFirewall(meta.GetExternalName(cr), cr.Spec.ForProvider, fw)
-	_, err := c.Firewalls.Insert(c.projectID, fw).
+	op, err := c.Firewalls.Insert(c.projectID, fw).
 		Context(ctx).
 		Do()
-	return managed.ExternalCreation{}, errors.Wrap(err, errFirewallCreateFailed)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errFirewallCreateFailed)
+	}
+	gcp.RecordOperation(c.record, cr, "create", op.Name)
+	return managed.ExternalCreation{}, nil
 }
 
 func (c *firewallExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
@@ -165,13 +175,24 @@ func (c *firewallExternal) Update(ctx context.Context, mg resource.Managed) (man
 		return managed.ExternalUpdate{}, nil
 	}
 
-	fw := &compute.Firewall{}
-	firewall.GenerateFirewall(meta.GetExternalName(cr), cr.Spec.ForProvider, fw)
+	changed := firewall.ImmutableFieldsChanged(&cr.Spec.ForProvider, observed)
+	replacing, err := replace.Check(cr, cr.Spec.UpdatePolicy, changed)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if replacing {
+		return managed.ExternalUpdate{}, replace.Delete(ctx, c.kube, c.record, cr, changed, c.Delete)
+	}
 
-	_, err = c.Firewalls.Patch(c.projectID, meta.GetExternalName(cr), fw).
+	fw := firewall.GenerateFirewallForUpdate(meta.GetExternalName(cr), cr.Spec.ForProvider)
+	op, err := c.Firewalls.Patch(c.projectID, meta.GetExternalName(cr), fw).
 		Context(ctx).
 		Do()
-	return managed.ExternalUpdate{}, errors.Wrap(err, errFirewallUpdateFailed)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errFirewallUpdateFailed)
+	}
+	gcp.RecordOperation(c.record, cr, "update", op.Name)
+	return managed.ExternalUpdate{}, nil
 }
 
 func (c *firewallExternal) Delete(ctx context.Context, mg resource.Managed) error {
@@ -181,8 +202,12 @@ func (c *firewallExternal) Delete(ctx context.Context, mg resource.Managed) erro
 	}
 
 	cr.Status.SetConditions(xpv1.Deleting())
-	_, err := c.Firewalls.Delete(c.projectID, meta.GetExternalName(cr)).
+	op, err := c.Firewalls.Delete(c.projectID, meta.GetExternalName(cr)).
 		Context(ctx).
 		Do()
-	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errFirewallDeleteFailed)
+	if err != nil {
+		return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errFirewallDeleteFailed)
+	}
+	gcp.RecordOperation(c.record, cr, "delete", op.Name)
+	return nil
 }
@@ -0,0 +1,209 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/api/compute/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/forwardingrule"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/setup"
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+	"github.com/crossplane-contrib/provider-gcp/pkg/tracing"
+)
+
+const (
+	// Error strings.
+	errNotForwardingRule           = "managed resource is not a ForwardingRule resource"
+	errGetForwardingRule           = "cannot get GCP ForwardingRule"
+	errManagedForwardingRuleUpdate = "unable to update ForwardingRule managed resource"
+
+	errForwardingRuleUpdateFailed = "update of ForwardingRule resource has failed"
+	errForwardingRuleCreateFailed = "creation of ForwardingRule resource has failed"
+	errForwardingRuleDeleteFailed = "deletion of ForwardingRule resource has failed"
+)
+
+// SetupForwardingRule adds a controller that reconciles ForwardingRule
+// managed resources.
+func SetupForwardingRule(mgr ctrl.Manager, o setup.Options) error {
+	name := managed.ControllerName(v1alpha1.ForwardingRuleGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
+	}
+
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.ForwardingRuleGroupVersionKind),
+		managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient()), gcp.NewLocationDefaulter(mgr.GetClient(), gcp.LocationScopeRegion, forwardingRuleRegion)),
+		managed.WithExternalConnecter(setup.Connecter(mgr, o, v1alpha1.ForwardingRuleGroupKind, &forwardingRuleConnector{kube: mgr.GetClient(), record: recorder})),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(recorder),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.ForwardingRule{}).
+		Complete(tracing.NewReconciler(name, ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter)))
+}
+
+// forwardingRuleRegion returns the region of the supplied ForwardingRule so
+// that it can be defaulted from its ProviderConfig.
+func forwardingRuleRegion(mg resource.Managed) *string {
+	cr, ok := mg.(*v1alpha1.ForwardingRule)
+	if !ok {
+		return nil
+	}
+	return &cr.Spec.ForProvider.Region
+}
+
+type forwardingRuleConnector struct {
+	kube   client.Client
+	record event.Recorder
+}
+
+func (c *forwardingRuleConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetConnectionInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := compute.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &forwardingRuleExternal{Service: s, kube: c.kube, projectID: projectID, record: c.record}, nil
+}
+
+type forwardingRuleExternal struct {
+	kube client.Client
+	*compute.Service
+	projectID string
+	record    event.Recorder
+}
+
+func (c *forwardingRuleExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ForwardingRule)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotForwardingRule)
+	}
+	observed, err := c.ForwardingRules.Get(c.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetForwardingRule)
+	}
+
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	forwardingrule.LateInitializeSpec(&cr.Spec.ForProvider, *observed)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
+		if err := c.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errManagedForwardingRuleUpdate)
+		}
+	}
+
+	cr.Status.AtProvider = forwardingrule.GenerateForwardingRuleObservation(*observed)
+
+	cr.Status.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: forwardingrule.IsUpToDate(&cr.Spec.ForProvider, observed),
+	}, nil
+}
+
+func (c *forwardingRuleExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ForwardingRule)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotForwardingRule)
+	}
+
+	fr := &compute.ForwardingRule{}
+	forwardingrule.GenerateForwardingRule(meta.GetExternalName(cr), cr.Spec.ForProvider, fr)
+	op, err := c.ForwardingRules.Insert(c.projectID, cr.Spec.ForProvider.Region, fr).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errForwardingRuleCreateFailed)
+	}
+	gcp.RecordOperation(c.record, cr, "create", op.Name)
+	return managed.ExternalCreation{}, nil
+}
+
+func (c *forwardingRuleExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.ForwardingRule)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotForwardingRule)
+	}
+
+	observed, err := c.ForwardingRules.Get(c.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetForwardingRule)
+	}
+
+	if forwardingrule.IsUpToDate(&cr.Spec.ForProvider, observed) {
+		return managed.ExternalUpdate{}, nil
+	}
+
+	// The API rejects patches that don't carry the current fingerprint.
+	fr := forwardingrule.GenerateForwardingRuleForUpdate(cr.Spec.ForProvider)
+	fr.Fingerprint = observed.Fingerprint
+
+	op, err := c.ForwardingRules.Patch(c.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr), fr).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errForwardingRuleUpdateFailed)
+	}
+	gcp.RecordOperation(c.record, cr, "update", op.Name)
+	return managed.ExternalUpdate{}, nil
+}
+
+func (c *forwardingRuleExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.ForwardingRule)
+	if !ok {
+		return errors.New(errNotForwardingRule)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+	op, err := c.ForwardingRules.Delete(c.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr)).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errForwardingRuleDeleteFailed)
+	}
+	gcp.RecordOperation(c.record, cr, "delete", op.Name)
+	return nil
+}
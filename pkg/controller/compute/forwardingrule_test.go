@@ -0,0 +1,330 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	compute "google.golang.org/api/compute/v1"
+	"google.golang.org/api/option"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+var _ managed.ExternalConnecter = &forwardingRuleConnector{}
+var _ managed.ExternalClient = &forwardingRuleExternal{}
+
+const (
+	testForwardingRuleName        = "test-forwarding-rule"
+	testForwardingRuleFingerprint = "fingerprint"
+)
+
+func forwardingRuleObj(m ...func(*v1alpha1.ForwardingRule)) *v1alpha1.ForwardingRule {
+	fr := &v1alpha1.ForwardingRule{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: testForwardingRuleName,
+			Annotations: map[string]string{
+				meta.AnnotationKeyExternalName: testForwardingRuleName,
+			},
+		},
+		Spec: v1alpha1.ForwardingRuleSpec{
+			ForProvider: v1alpha1.ForwardingRuleParameters{
+				Region:              "us-west1",
+				IPProtocol:          gcp.StringPtr("TCP"),
+				LoadBalancingScheme: gcp.StringPtr("INTERNAL"),
+				BackendService:      gcp.StringPtr("regions/us-west1/backendServices/backend"),
+				Ports:               []string{"80"},
+				AllowGlobalAccess:   gcp.BoolPtr(true),
+			},
+		},
+	}
+	for _, f := range m {
+		f(fr)
+	}
+	return fr
+}
+
+func observedForwardingRule(m ...func(*compute.ForwardingRule)) *compute.ForwardingRule {
+	fr := &compute.ForwardingRule{
+		Name:                testForwardingRuleName,
+		IPProtocol:          "TCP",
+		LoadBalancingScheme: "INTERNAL",
+		BackendService:      "https://www.googleapis.com/compute/v1/projects/" + projectID + "/regions/us-west1/backendServices/backend",
+		Ports:               []string{"80"},
+		AllowGlobalAccess:   true,
+		Fingerprint:         testForwardingRuleFingerprint,
+	}
+	for _, f := range m {
+		f(fr)
+	}
+	return fr
+}
+
+func TestForwardingRuleObserve(t *testing.T) {
+	type args struct {
+		kube    *test.MockClient
+		handler http.Handler
+	}
+	type want struct {
+		eo  managed.ExternalObservation
+		err error
+	}
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotFound": {
+			args: args{
+				handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					_ = r.Body.Close()
+					w.WriteHeader(http.StatusNotFound)
+				}),
+			},
+		},
+		"GetFailed": {
+			args: args{
+				handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					_ = r.Body.Close()
+					w.WriteHeader(http.StatusBadRequest)
+					_ = json.NewEncoder(w).Encode(&compute.ForwardingRule{})
+				}),
+			},
+			want: want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetForwardingRule)},
+		},
+		"LateInitUpdateFailed": {
+			args: args{
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+				handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					_ = r.Body.Close()
+					w.WriteHeader(http.StatusOK)
+					_ = json.NewEncoder(w).Encode(observedForwardingRule(func(fr *compute.ForwardingRule) {
+						fr.Network = "global/networks/default"
+					}))
+				}),
+			},
+			want: want{err: errors.Wrap(errBoom, errManagedForwardingRuleUpdate)},
+		},
+		"NotUpToDate": {
+			args: args{
+				handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					_ = r.Body.Close()
+					w.WriteHeader(http.StatusOK)
+					_ = json.NewEncoder(w).Encode(observedForwardingRule(func(fr *compute.ForwardingRule) {
+						fr.AllowGlobalAccess = false
+					}))
+				}),
+			},
+			want: want{eo: managed.ExternalObservation{ResourceExists: true}},
+		},
+		"UpToDate": {
+			args: args{
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					_ = r.Body.Close()
+					w.WriteHeader(http.StatusOK)
+					_ = json.NewEncoder(w).Encode(observedForwardingRule(func(fr *compute.ForwardingRule) {
+						fr.Network = "global/networks/default"
+					}))
+				}),
+			},
+			want: want{eo: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.args.handler)
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := forwardingRuleExternal{kube: tc.args.kube, Service: s, projectID: projectID, record: event.NewNopRecorder()}
+			got, err := e.Observe(context.Background(), forwardingRuleObj())
+			if diff := cmp.Diff(tc.want.eo, got); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestForwardingRuleCreate(t *testing.T) {
+	cases := map[string]struct {
+		status int
+		err    error
+	}{
+		"Successful": {
+			status: http.StatusOK,
+		},
+		"CreateFailed": {
+			status: http.StatusBadRequest,
+			err:    errors.Wrap(gError(http.StatusBadRequest, ""), errForwardingRuleCreateFailed),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				fr := &compute.ForwardingRule{}
+				_ = json.NewDecoder(r.Body).Decode(fr)
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+					t.Errorf("r: -want method, +got method:\n%s", diff)
+				}
+				if diff := cmp.Diff(testForwardingRuleName, fr.Name); diff != "" {
+					t.Errorf("r: -want name, +got name:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}))
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := forwardingRuleExternal{Service: s, projectID: projectID, record: event.NewNopRecorder()}
+			_, err := e.Create(context.Background(), forwardingRuleObj())
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestForwardingRuleUpdate(t *testing.T) {
+	type want struct {
+		patched bool
+		err     error
+	}
+	cases := map[string]struct {
+		getStatus   int
+		patchStatus int
+		observed    *compute.ForwardingRule
+		want        want
+	}{
+		"GetFailed": {
+			getStatus: http.StatusBadRequest,
+			observed:  &compute.ForwardingRule{},
+			want:      want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetForwardingRule)},
+		},
+		"AlreadyUpToDate": {
+			getStatus: http.StatusOK,
+			observed:  observedForwardingRule(),
+		},
+		"Patched": {
+			getStatus:   http.StatusOK,
+			patchStatus: http.StatusOK,
+			observed: observedForwardingRule(func(fr *compute.ForwardingRule) {
+				fr.AllowGlobalAccess = false
+			}),
+			want: want{patched: true},
+		},
+		"PatchFailed": {
+			getStatus:   http.StatusOK,
+			patchStatus: http.StatusBadRequest,
+			observed: observedForwardingRule(func(fr *compute.ForwardingRule) {
+				fr.AllowGlobalAccess = false
+			}),
+			want: want{patched: true, err: errors.Wrap(gError(http.StatusBadRequest, ""), errForwardingRuleUpdateFailed)},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			patched := false
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method == http.MethodGet {
+					_ = r.Body.Close()
+					w.WriteHeader(tc.getStatus)
+					_ = json.NewEncoder(w).Encode(tc.observed)
+					return
+				}
+				patched = true
+				fr := &compute.ForwardingRule{}
+				_ = json.NewDecoder(r.Body).Decode(fr)
+				_ = r.Body.Close()
+				if diff := cmp.Diff(testForwardingRuleFingerprint, fr.Fingerprint); diff != "" {
+					t.Errorf("r: -want fingerprint, +got fingerprint:\n%s", diff)
+				}
+				if !fr.AllowGlobalAccess {
+					t.Errorf("r: want AllowGlobalAccess to be patched to true")
+				}
+				w.WriteHeader(tc.patchStatus)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}))
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := forwardingRuleExternal{Service: s, projectID: projectID, record: event.NewNopRecorder()}
+			_, err := e.Update(context.Background(), forwardingRuleObj())
+			if diff := cmp.Diff(tc.want.patched, patched); diff != "" {
+				t.Errorf("Update(...): -want patched, +got patched:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestForwardingRuleDelete(t *testing.T) {
+	cases := map[string]struct {
+		status int
+		err    error
+	}{
+		"Successful": {
+			status: http.StatusOK,
+		},
+		"NotFound": {
+			status: http.StatusNotFound,
+		},
+		"DeleteFailed": {
+			status: http.StatusBadRequest,
+			err:    errors.Wrap(gError(http.StatusBadRequest, ""), errForwardingRuleDeleteFailed),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodDelete, r.Method); diff != "" {
+					t.Errorf("r: -want method, +got method:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}))
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := forwardingRuleExternal{Service: s, projectID: projectID, record: event.NewNopRecorder()}
+			cr := forwardingRuleObj()
+			err := e.Delete(context.Background(), cr)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(xpv1.Deleting(), cr.GetCondition(xpv1.TypeReady), test.EquateConditions()); diff != "" {
+				t.Errorf("Delete(...): -want condition, +got condition:\n%s", diff)
+			}
+		})
+	}
+}
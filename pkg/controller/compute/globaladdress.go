@@ -26,7 +26,6 @@ import (
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/connection"
-	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	"github.com/crossplane/crossplane-runtime/pkg/errors"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
@@ -38,7 +37,9 @@ import (
 	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
 	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
 	"github.com/crossplane-contrib/provider-gcp/pkg/clients/globaladdress"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/setup"
 	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+	"github.com/crossplane-contrib/provider-gcp/pkg/tracing"
 )
 
 // Error strings.
@@ -52,7 +53,7 @@ const (
 
 // SetupGlobalAddress adds a controller that reconciles
 // GlobalAddress managed resources.
-func SetupGlobalAddress(mgr ctrl.Manager, o controller.Options) error {
+func SetupGlobalAddress(mgr ctrl.Manager, o setup.Options) error {
 	name := managed.ControllerName(v1beta1.GlobalAddressGroupKind)
 
 	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
@@ -60,24 +61,27 @@ func SetupGlobalAddress(mgr ctrl.Manager, o controller.Options) error {
 		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
 	}
 
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(v1beta1.GlobalAddressGroupVersionKind),
-		managed.WithExternalConnecter(&gaConnector{kube: mgr.GetClient()}),
+		managed.WithExternalConnecter(setup.Connecter(mgr, o, v1beta1.GlobalAddressGroupKind, &gaConnector{kube: mgr.GetClient(), record: recorder})),
 		managed.WithConnectionPublishers(),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
-		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithRecorder(recorder),
 		managed.WithConnectionPublishers(cps...))
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(o.ForControllerRuntime()).
 		For(&v1beta1.GlobalAddress{}).
-		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+		Complete(tracing.NewReconciler(name, ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter)))
 }
 
 type gaConnector struct {
-	kube client.Client
+	kube   client.Client
+	record event.Recorder
 }
 
 func (c *gaConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
@@ -89,13 +93,14 @@ func (c *gaConnector) Connect(ctx context.Context, mg resource.Managed) (managed
 	if err != nil {
 		return nil, errors.Wrap(err, errNewClient)
 	}
-	return &gaExternal{kube: c.kube, Service: s, projectID: projectID}, errors.Wrap(err, errNewClient)
+	return &gaExternal{kube: c.kube, Service: s, projectID: projectID, record: c.record}, errors.Wrap(err, errNewClient)
 }
 
 type gaExternal struct {
 	kube      client.Client
 	projectID string
 	*compute.Service
+	record event.Recorder
 }
 
 func (e *gaExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -140,8 +145,12 @@ func (e *gaExternal) Create(ctx context.Context, mg resource.Managed) (managed.E
 	cr.Status.SetConditions(xpv1.Creating())
 	address := &compute.Address{}
 	globaladdress.GenerateGlobalAddress(meta.GetExternalName(cr), cr.Spec.ForProvider, address)
-	_, err := e.GlobalAddresses.Insert(e.projectID, address).Context(ctx).Do()
-	return managed.ExternalCreation{}, errors.Wrap(err, errCreateGlobalAddress)
+	op, err := e.GlobalAddresses.Insert(e.projectID, address).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateGlobalAddress)
+	}
+	gcp.RecordOperation(e.record, cr, "create", op.Name)
+	return managed.ExternalCreation{}, nil
 }
 
 func (e *gaExternal) Update(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
@@ -156,6 +165,10 @@ func (e *gaExternal) Delete(ctx context.Context, mg resource.Managed) error {
 	}
 
 	cr.Status.SetConditions(xpv1.Deleting())
-	_, err := e.GlobalAddresses.Delete(e.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
-	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteGlobalAddress)
+	op, err := e.GlobalAddresses.Delete(e.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
+	if err != nil {
+		return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteGlobalAddress)
+	}
+	gcp.RecordOperation(e.record, cr, "delete", op.Name)
+	return nil
 }
@@ -0,0 +1,178 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/compute/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/imageimport"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/setup"
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+	"github.com/crossplane-contrib/provider-gcp/pkg/tracing"
+)
+
+const (
+	// Error strings.
+	errNotImageImport          = "managed resource is not an ImageImport resource"
+	errGetImageImport          = "cannot get GCP image"
+	errImageImportCreateFailed = "import of GCP image has failed"
+	errImageImportDeleteFailed = "deletion of GCP image has failed"
+	errImageImportFailed       = "The image could not be imported. Check that the source is a gzip compressed tarball containing a disk.raw file."
+)
+
+// SetupImageImport adds a controller that reconciles ImageImport managed
+// resources.
+func SetupImageImport(mgr ctrl.Manager, o setup.Options) error {
+	name := managed.ControllerName(v1alpha1.ImageImportGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
+	}
+
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.ImageImportGroupVersionKind),
+		managed.WithExternalConnecter(setup.Connecter(mgr, o, v1alpha1.ImageImportGroupKind, &imageImportConnector{kube: mgr.GetClient(), record: recorder})),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(recorder),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.ImageImport{}).
+		Complete(tracing.NewReconciler(name, ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter)))
+}
+
+type imageImportConnector struct {
+	kube   client.Client
+	record event.Recorder
+}
+
+func (c *imageImportConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetConnectionInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := compute.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &imageImportExternal{Service: s, projectID: projectID, record: c.record}, nil
+}
+
+type imageImportExternal struct {
+	*compute.Service
+	projectID string
+	record    event.Recorder
+}
+
+func (c *imageImportExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ImageImport)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotImageImport)
+	}
+	observed, err := c.Images.Get(c.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetImageImport)
+	}
+
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	imageimport.LateInitializeSpec(&cr.Spec.ForProvider, *observed)
+
+	cr.Status.AtProvider = imageimport.GenerateImageImportObservation(*observed)
+
+	switch cr.Status.AtProvider.Status {
+	case v1alpha1.ImageImportStatusReady:
+		cr.Status.SetConditions(xpv1.Available())
+	case v1alpha1.ImageImportStatusPending:
+		cr.Status.SetConditions(xpv1.Creating())
+	case v1alpha1.ImageImportStatusDeleting:
+		cr.Status.SetConditions(xpv1.Deleting())
+	case v1alpha1.ImageImportStatusFailed:
+		cr.Status.SetConditions(xpv1.Unavailable().WithMessage(errImageImportFailed))
+	default:
+		cr.Status.SetConditions(xpv1.Unavailable())
+	}
+
+	// Images are immutable, so there is nothing to update.
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceLateInitialized: !cmp.Equal(currentSpec, &cr.Spec.ForProvider),
+		ResourceUpToDate:        true,
+	}, nil
+}
+
+func (c *imageImportExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ImageImport)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotImageImport)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+	op, err := c.Images.Insert(c.projectID, imageimport.GenerateImage(meta.GetExternalName(cr), cr.Spec.ForProvider)).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errImageImportCreateFailed)
+	}
+	gcp.RecordOperation(c.record, cr, "create", op.Name)
+	return managed.ExternalCreation{}, nil
+}
+
+func (c *imageImportExternal) Update(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
+	// Imported images are immutable. Their labels and deprecation status
+	// could be changed, but ImageImport exposes neither.
+	return managed.ExternalUpdate{}, nil
+}
+
+func (c *imageImportExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.ImageImport)
+	if !ok {
+		return errors.New(errNotImageImport)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+	op, err := c.Images.Delete(c.projectID, meta.GetExternalName(cr)).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errImageImportDeleteFailed)
+	}
+	gcp.RecordOperation(c.record, cr, "delete", op.Name)
+	return nil
+}
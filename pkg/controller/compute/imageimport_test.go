@@ -0,0 +1,221 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	compute "google.golang.org/api/compute/v1"
+	"google.golang.org/api/option"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+var _ managed.ExternalConnecter = &imageImportConnector{}
+var _ managed.ExternalClient = &imageImportExternal{}
+
+const (
+	testImageImportName = "test-image-import"
+	testImageSource     = "gs://my-bucket/disk.tar.gz"
+)
+
+func imageImportObj() *v1alpha1.ImageImport {
+	return &v1alpha1.ImageImport{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: testImageImportName,
+			Annotations: map[string]string{
+				meta.AnnotationKeyExternalName: testImageImportName,
+			},
+		},
+		Spec: v1alpha1.ImageImportSpec{
+			ForProvider: v1alpha1.ImageImportParameters{
+				Source:           testImageSource,
+				Architecture:     gcp.StringPtr("X86_64"),
+				StorageLocations: []string{"us"},
+			},
+		},
+	}
+}
+
+func TestImageImportObserve(t *testing.T) {
+	type want struct {
+		eo        managed.ExternalObservation
+		condition xpv1.Condition
+		err       error
+	}
+	cases := map[string]struct {
+		handler http.Handler
+		want    want
+	}{
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+			}),
+		},
+		"GetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&compute.Image{})
+			}),
+			want: want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetImageImport)},
+		},
+		"Pending": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&compute.Image{
+					Architecture:     "X86_64",
+					StorageLocations: []string{"us"},
+					Status:           v1alpha1.ImageImportStatusPending,
+				})
+			}),
+			want: want{
+				eo:        managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				condition: xpv1.Creating(),
+			},
+		},
+		"Ready": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&compute.Image{
+					Architecture:     "X86_64",
+					StorageLocations: []string{"us"},
+					Status:           v1alpha1.ImageImportStatusReady,
+				})
+			}),
+			want: want{
+				eo:        managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				condition: xpv1.Available(),
+			},
+		},
+		"Failed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&compute.Image{
+					Architecture:     "X86_64",
+					StorageLocations: []string{"us"},
+					Status:           v1alpha1.ImageImportStatusFailed,
+				})
+			}),
+			want: want{
+				eo:        managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				condition: xpv1.Unavailable().WithMessage(errImageImportFailed),
+			},
+		},
+		"LateInitialized": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&compute.Image{
+					Architecture:     "X86_64",
+					Family:           "legacy-app",
+					StorageLocations: []string{"us"},
+					Status:           v1alpha1.ImageImportStatusReady,
+				})
+			}),
+			want: want{
+				eo:        managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true},
+				condition: xpv1.Available(),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := imageImportExternal{Service: s, projectID: projectID, record: event.NewNopRecorder()}
+			cr := imageImportObj()
+			got, err := e.Observe(context.Background(), cr)
+			if diff := cmp.Diff(tc.want.eo, got); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if tc.want.condition.Type == "" {
+				return
+			}
+			if diff := cmp.Diff(tc.want.condition, cr.GetCondition(xpv1.TypeReady), test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want condition, +got condition:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestImageImportCreate(t *testing.T) {
+	want := &compute.Image{
+		Name:             testImageImportName,
+		Architecture:     "X86_64",
+		StorageLocations: []string{"us"},
+		RawDisk:          &compute.ImageRawDisk{Source: "https://storage.googleapis.com/my-bucket/disk.tar.gz"},
+	}
+	cases := map[string]struct {
+		status int
+		err    error
+	}{
+		"Created": {
+			status: http.StatusOK,
+		},
+		"AlreadyExists": {
+			status: http.StatusConflict,
+			err:    errors.Wrap(gError(http.StatusConflict, ""), errImageImportCreateFailed),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := &compute.Image{}
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = json.NewDecoder(r.Body).Decode(got)
+				_ = r.Body.Close()
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}))
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := imageImportExternal{Service: s, projectID: projectID, record: event.NewNopRecorder()}
+			_, err := e.Create(context.Background(), imageImportObj())
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(want, got); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
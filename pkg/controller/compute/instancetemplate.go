@@ -0,0 +1,167 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/compute/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/instancetemplate"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/setup"
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+	"github.com/crossplane-contrib/provider-gcp/pkg/tracing"
+)
+
+const (
+	// Error strings.
+	errNotInstanceTemplate          = "managed resource is not a InstanceTemplate resource"
+	errGetInstanceTemplate          = "cannot get GCP InstanceTemplate"
+	errInstanceTemplateCreateFailed = "creation of InstanceTemplate resource has failed"
+	errInstanceTemplateDeleteFailed = "deletion of InstanceTemplate resource has failed"
+)
+
+// SetupInstanceTemplate adds a controller that reconciles InstanceTemplate managed
+// resources.
+func SetupInstanceTemplate(mgr ctrl.Manager, o setup.Options) error {
+	name := managed.ControllerName(v1alpha1.InstanceTemplateGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
+	}
+
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.InstanceTemplateGroupVersionKind),
+		managed.WithExternalConnecter(setup.Connecter(mgr, o, v1alpha1.InstanceTemplateGroupKind, &instanceTemplateConnector{kube: mgr.GetClient(), record: recorder})),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(recorder),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.InstanceTemplate{}).
+		Complete(tracing.NewReconciler(name, ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter)))
+}
+
+type instanceTemplateConnector struct {
+	kube   client.Client
+	record event.Recorder
+}
+
+func (c *instanceTemplateConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetConnectionInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := compute.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &instanceTemplateExternal{Service: s, projectID: projectID, record: c.record}, nil
+}
+
+type instanceTemplateExternal struct {
+	*compute.Service
+	projectID string
+	record    event.Recorder
+}
+
+func (c *instanceTemplateExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.InstanceTemplate)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotInstanceTemplate)
+	}
+	observed, err := c.InstanceTemplates.Get(c.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetInstanceTemplate)
+	}
+
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	instancetemplate.LateInitializeSpec(&cr.Spec.ForProvider, *observed)
+
+	cr.Status.AtProvider = instancetemplate.GenerateInstanceTemplateObservation(*observed)
+
+	// Instance templates are ready to use as soon as they exist.
+	cr.Status.SetConditions(xpv1.Available())
+
+	// Instance templates are immutable, so there is nothing to update.
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceLateInitialized: !cmp.Equal(currentSpec, &cr.Spec.ForProvider),
+		ResourceUpToDate:        true,
+	}, nil
+}
+
+func (c *instanceTemplateExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.InstanceTemplate)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotInstanceTemplate)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+	op, err := c.InstanceTemplates.Insert(c.projectID, instancetemplate.GenerateInstanceTemplate(meta.GetExternalName(cr), cr.Spec.ForProvider)).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errInstanceTemplateCreateFailed)
+	}
+	gcp.RecordOperation(c.record, cr, "create", op.Name)
+	return managed.ExternalCreation{}, nil
+}
+
+func (c *instanceTemplateExternal) Update(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
+	// It is not possible to update instance templates, there is no "patch" method defined:
+	// https://cloud.google.com/compute/docs/reference/rest/v1/instanceTemplates
+	return managed.ExternalUpdate{}, nil
+}
+
+func (c *instanceTemplateExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.InstanceTemplate)
+	if !ok {
+		return errors.New(errNotInstanceTemplate)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+	op, err := c.InstanceTemplates.Delete(c.projectID, meta.GetExternalName(cr)).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errInstanceTemplateDeleteFailed)
+	}
+	gcp.RecordOperation(c.record, cr, "delete", op.Name)
+	return nil
+}
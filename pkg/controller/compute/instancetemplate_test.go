@@ -0,0 +1,235 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	compute "google.golang.org/api/compute/v1"
+	"google.golang.org/api/option"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+var _ managed.ExternalConnecter = &instanceTemplateConnector{}
+var _ managed.ExternalClient = &instanceTemplateExternal{}
+
+const testInstanceTemplateName = "test-template"
+
+func instanceTemplateObj(m ...func(*v1alpha1.InstanceTemplate)) *v1alpha1.InstanceTemplate {
+	it := &v1alpha1.InstanceTemplate{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: testInstanceTemplateName,
+			Annotations: map[string]string{
+				meta.AnnotationKeyExternalName: testInstanceTemplateName,
+			},
+		},
+		Spec: v1alpha1.InstanceTemplateSpec{
+			ForProvider: v1alpha1.InstanceTemplateParameters{
+				Description:    gcp.StringPtr("golden"),
+				SourceInstance: testSourceInstance,
+			},
+		},
+	}
+	for _, f := range m {
+		f(it)
+	}
+	return it
+}
+
+func TestInstanceTemplateObserve(t *testing.T) {
+	type want struct {
+		eo  managed.ExternalObservation
+		obs v1alpha1.InstanceTemplateObservation
+		err error
+	}
+	cases := map[string]struct {
+		handler http.Handler
+		mg      *v1alpha1.InstanceTemplate
+		want    want
+	}{
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+			}),
+			mg: instanceTemplateObj(),
+		},
+		"GetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&compute.InstanceTemplate{})
+			}),
+			mg:   instanceTemplateObj(),
+			want: want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetInstanceTemplate)},
+		},
+		"UpToDate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&compute.InstanceTemplate{
+					Name:           testInstanceTemplateName,
+					Description:    "golden",
+					SourceInstance: testSourceInstance,
+					Properties:     &compute.InstanceProperties{MachineType: "e2-medium"},
+					SelfLink:       "self",
+				})
+			}),
+			mg: instanceTemplateObj(),
+			want: want{
+				eo:  managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				obs: v1alpha1.InstanceTemplateObservation{MachineType: "e2-medium", SelfLink: "self", SourceInstance: testSourceInstance},
+			},
+		},
+		"LateInitialized": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&compute.InstanceTemplate{
+					Name:           testInstanceTemplateName,
+					Description:    "golden",
+					SourceInstance: testSourceInstance,
+				})
+			}),
+			mg: instanceTemplateObj(func(it *v1alpha1.InstanceTemplate) {
+				it.Spec.ForProvider.Description = nil
+			}),
+			want: want{
+				eo:  managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true},
+				obs: v1alpha1.InstanceTemplateObservation{SourceInstance: testSourceInstance},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := instanceTemplateExternal{Service: s, projectID: projectID, record: event.NewNopRecorder()}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.eo, got); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, tc.mg.Status.AtProvider); diff != "" {
+				t.Errorf("Observe(...): -want atProvider, +got atProvider:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestInstanceTemplateCreate(t *testing.T) {
+	cases := map[string]struct {
+		status int
+		err    error
+	}{
+		"Successful": {
+			status: http.StatusOK,
+		},
+		"CreateFailed": {
+			status: http.StatusBadRequest,
+			err:    errors.Wrap(gError(http.StatusBadRequest, ""), errInstanceTemplateCreateFailed),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				it := &compute.InstanceTemplate{}
+				_ = json.NewDecoder(r.Body).Decode(it)
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+					t.Errorf("r: -want method, +got method:\n%s", diff)
+				}
+				want := &compute.InstanceTemplate{Name: testInstanceTemplateName, Description: "golden", SourceInstance: testSourceInstance}
+				if diff := cmp.Diff(want, it); diff != "" {
+					t.Errorf("r: -want template, +got template:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}))
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := instanceTemplateExternal{Service: s, projectID: projectID, record: event.NewNopRecorder()}
+			cr := instanceTemplateObj()
+			_, err := e.Create(context.Background(), cr)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(xpv1.Creating(), cr.GetCondition(xpv1.TypeReady), test.EquateConditions()); diff != "" {
+				t.Errorf("Create(...): -want condition, +got condition:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestInstanceTemplateDelete(t *testing.T) {
+	cases := map[string]struct {
+		status int
+		err    error
+	}{
+		"Successful": {
+			status: http.StatusOK,
+		},
+		"NotFound": {
+			status: http.StatusNotFound,
+		},
+		"DeleteFailed": {
+			status: http.StatusBadRequest,
+			err:    errors.Wrap(gError(http.StatusBadRequest, ""), errInstanceTemplateDeleteFailed),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodDelete, r.Method); diff != "" {
+					t.Errorf("r: -want method, +got method:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}))
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := instanceTemplateExternal{Service: s, projectID: projectID, record: event.NewNopRecorder()}
+			cr := instanceTemplateObj()
+			err := e.Delete(context.Background(), cr)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(xpv1.Deleting(), cr.GetCondition(xpv1.TypeReady), test.EquateConditions()); diff != "" {
+				t.Errorf("Delete(...): -want condition, +got condition:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,205 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/api/compute/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/interconnectattachment"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/setup"
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+	"github.com/crossplane-contrib/provider-gcp/pkg/tracing"
+)
+
+const (
+	// Error strings.
+	errNotInterconnectAttachment           = "managed resource is not an InterconnectAttachment resource"
+	errGetInterconnectAttachment           = "cannot get GCP InterconnectAttachment"
+	errManagedInterconnectAttachmentUpdate = "unable to update InterconnectAttachment managed resource"
+
+	errInterconnectAttachmentUpdateFailed = "update of InterconnectAttachment resource has failed"
+	errInterconnectAttachmentCreateFailed = "creation of InterconnectAttachment resource has failed"
+	errInterconnectAttachmentDeleteFailed = "deletion of InterconnectAttachment resource has failed"
+)
+
+// SetupInterconnectAttachment adds a controller that reconciles
+// InterconnectAttachment managed resources.
+func SetupInterconnectAttachment(mgr ctrl.Manager, o setup.Options) error {
+	name := managed.ControllerName(v1alpha1.InterconnectAttachmentGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
+	}
+
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.InterconnectAttachmentGroupVersionKind),
+		managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient()), gcp.NewLocationDefaulter(mgr.GetClient(), gcp.LocationScopeRegion, interconnectAttachmentRegion)),
+		managed.WithExternalConnecter(setup.Connecter(mgr, o, v1alpha1.InterconnectAttachmentGroupKind, &interconnectAttachmentConnector{kube: mgr.GetClient(), record: recorder})),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(recorder),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.InterconnectAttachment{}).
+		Complete(tracing.NewReconciler(name, ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter)))
+}
+
+// interconnectAttachmentRegion returns the region of the supplied
+// InterconnectAttachment so that it can be defaulted from its ProviderConfig.
+func interconnectAttachmentRegion(mg resource.Managed) *string {
+	cr, ok := mg.(*v1alpha1.InterconnectAttachment)
+	if !ok {
+		return nil
+	}
+	return &cr.Spec.ForProvider.Region
+}
+
+type interconnectAttachmentConnector struct {
+	kube   client.Client
+	record event.Recorder
+}
+
+func (c *interconnectAttachmentConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetConnectionInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := compute.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &interconnectAttachmentExternal{Service: s, kube: c.kube, projectID: projectID, record: c.record}, nil
+}
+
+type interconnectAttachmentExternal struct {
+	kube client.Client
+	*compute.Service
+	projectID string
+	record    event.Recorder
+}
+
+func (c *interconnectAttachmentExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.InterconnectAttachment)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotInterconnectAttachment)
+	}
+	observed, err := c.InterconnectAttachments.Get(c.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetInterconnectAttachment)
+	}
+
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	interconnectattachment.LateInitializeSpec(&cr.Spec.ForProvider, *observed)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
+		if err := c.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errManagedInterconnectAttachmentUpdate)
+		}
+	}
+
+	cr.Status.AtProvider = interconnectattachment.GenerateInterconnectAttachmentObservation(*observed)
+
+	// A PARTNER attachment is pending until the partner has provisioned it
+	// with the pairing key, and until it is enabled after that.
+	switch cr.Status.AtProvider.State {
+	case v1alpha1.InterconnectAttachmentStateActive:
+		cr.Status.SetConditions(xpv1.Available())
+	default:
+		cr.Status.SetConditions(xpv1.Unavailable())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  interconnectattachment.IsUpToDate(cr.Spec.ForProvider, *observed),
+		ConnectionDetails: interconnectattachment.GetConnectionDetails(*observed),
+	}, nil
+}
+
+func (c *interconnectAttachmentExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.InterconnectAttachment)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotInterconnectAttachment)
+	}
+
+	ia := &compute.InterconnectAttachment{}
+	interconnectattachment.GenerateInterconnectAttachment(meta.GetExternalName(cr), cr.Spec.ForProvider, ia)
+	op, err := c.InterconnectAttachments.Insert(c.projectID, cr.Spec.ForProvider.Region, ia).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errInterconnectAttachmentCreateFailed)
+	}
+	gcp.RecordOperation(c.record, cr, "create", op.Name)
+	return managed.ExternalCreation{}, nil
+}
+
+func (c *interconnectAttachmentExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.InterconnectAttachment)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotInterconnectAttachment)
+	}
+
+	ia := interconnectattachment.GenerateInterconnectAttachmentForUpdate(cr.Spec.ForProvider)
+	op, err := c.InterconnectAttachments.Patch(c.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr), ia).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errInterconnectAttachmentUpdateFailed)
+	}
+	gcp.RecordOperation(c.record, cr, "update", op.Name)
+	return managed.ExternalUpdate{}, nil
+}
+
+func (c *interconnectAttachmentExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.InterconnectAttachment)
+	if !ok {
+		return errors.New(errNotInterconnectAttachment)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+	op, err := c.InterconnectAttachments.Delete(c.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr)).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errInterconnectAttachmentDeleteFailed)
+	}
+	gcp.RecordOperation(c.record, cr, "delete", op.Name)
+	return nil
+}
@@ -0,0 +1,174 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	compute "google.golang.org/api/compute/v1"
+	"google.golang.org/api/option"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+var _ managed.ExternalConnecter = &interconnectAttachmentConnector{}
+var _ managed.ExternalClient = &interconnectAttachmentExternal{}
+
+const testInterconnectAttachmentName = "test-interconnect-attachment"
+
+func interconnectAttachmentObj(m ...func(*v1alpha1.InterconnectAttachment)) *v1alpha1.InterconnectAttachment {
+	i := &v1alpha1.InterconnectAttachment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: testInterconnectAttachmentName,
+			Annotations: map[string]string{
+				meta.AnnotationKeyExternalName: testInterconnectAttachmentName,
+			},
+		},
+		Spec: v1alpha1.InterconnectAttachmentSpec{
+			ForProvider: v1alpha1.InterconnectAttachmentParameters{
+				Region:       "us-west1",
+				Type:         v1alpha1.InterconnectAttachmentTypePartner,
+				AdminEnabled: gcp.BoolPtr(true),
+			},
+		},
+	}
+	for _, f := range m {
+		f(i)
+	}
+	return i
+}
+
+func TestInterconnectAttachmentObserve(t *testing.T) {
+	type want struct {
+		obs  managed.ExternalObservation
+		cond xpv1.Condition
+	}
+	cases := map[string]struct {
+		observed compute.InterconnectAttachment
+		want     want
+	}{
+		"PendingPartner": {
+			observed: compute.InterconnectAttachment{
+				Type:         v1alpha1.InterconnectAttachmentTypePartner,
+				State:        v1alpha1.InterconnectAttachmentStatePendingPartner,
+				AdminEnabled: true,
+				PairingKey:   "key/us-west1/1",
+			},
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{v1alpha1.InterconnectAttachmentSecretPairingKey: []byte("key/us-west1/1")},
+				},
+				cond: xpv1.Unavailable(),
+			},
+		},
+		"PendingCustomer": {
+			observed: compute.InterconnectAttachment{
+				Type:       v1alpha1.InterconnectAttachmentTypePartner,
+				State:      v1alpha1.InterconnectAttachmentStatePendingCustomer,
+				PairingKey: "key/us-west1/1",
+			},
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: managed.ConnectionDetails{v1alpha1.InterconnectAttachmentSecretPairingKey: []byte("key/us-west1/1")},
+				},
+				cond: xpv1.Unavailable(),
+			},
+		},
+		"Active": {
+			observed: compute.InterconnectAttachment{
+				Type:         v1alpha1.InterconnectAttachmentTypePartner,
+				State:        v1alpha1.InterconnectAttachmentStateActive,
+				AdminEnabled: true,
+			},
+			want: want{
+				obs:  managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				cond: xpv1.Available(),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&tc.observed)
+			}))
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := interconnectAttachmentExternal{Service: s, kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)}, projectID: projectID}
+			cr := interconnectAttachmentObj()
+			got, err := e.Observe(context.Background(), cr)
+			if err != nil {
+				t.Fatalf("Observe(...): unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want.obs, got); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cond, cr.GetCondition(xpv1.TypeReady), test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want condition, +got condition:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestInterconnectAttachmentUpdate(t *testing.T) {
+	var got map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if diff := cmp.Diff(http.MethodPatch, r.Method); diff != "" {
+			t.Errorf("r: -want, +got:\n%s", diff)
+		}
+		b, _ := io.ReadAll(r.Body)
+		_ = r.Body.Close()
+		_ = json.Unmarshal(b, &got)
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(&compute.Operation{})
+	}))
+	defer server.Close()
+	s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	e := interconnectAttachmentExternal{Service: s, projectID: projectID, record: event.NewNopRecorder()}
+
+	if _, err := e.Update(context.Background(), interconnectAttachmentObj()); err != nil {
+		t.Fatalf("Update(...): unexpected error: %v", err)
+	}
+	// Only the fields that may change are patched, and enabling the
+	// attachment is always sent explicitly.
+	want := map[string]interface{}{"adminEnabled": true}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Update(...): -want body, +got body:\n%s", diff)
+	}
+}
@@ -0,0 +1,209 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	compute "google.golang.org/api/compute/v1"
+	"google.golang.org/api/option"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+)
+
+var _ managed.ExternalConnecter = &machineImageConnector{}
+var _ managed.ExternalClient = &machineImageExternal{}
+
+const (
+	testMachineImageName = "test-machine-image"
+	testSourceInstance   = "zones/us-central1-a/instances/golden-vm"
+)
+
+func machineImageObj() *v1alpha1.MachineImage {
+	return &v1alpha1.MachineImage{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: testMachineImageName,
+			Annotations: map[string]string{
+				meta.AnnotationKeyExternalName: testMachineImageName,
+			},
+		},
+		Spec: v1alpha1.MachineImageSpec{
+			ForProvider: v1alpha1.MachineImageParameters{
+				SourceInstance:   testSourceInstance,
+				StorageLocations: []string{"us-central1"},
+			},
+		},
+	}
+}
+
+func TestMachineImageObserve(t *testing.T) {
+	type want struct {
+		eo        managed.ExternalObservation
+		condition xpv1.Condition
+		err       error
+	}
+	cases := map[string]struct {
+		handler http.Handler
+		want    want
+	}{
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+			}),
+		},
+		"GetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&compute.MachineImage{})
+			}),
+			want: want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetMachineImage)},
+		},
+		"Uploading": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&compute.MachineImage{
+					SourceInstance:   testSourceInstance,
+					StorageLocations: []string{"us-central1"},
+					Status:           v1alpha1.MachineImageStatusUploading,
+				})
+			}),
+			want: want{
+				eo:        managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				condition: xpv1.Creating(),
+			},
+		},
+		"Ready": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&compute.MachineImage{
+					SourceInstance:   testSourceInstance,
+					StorageLocations: []string{"us-central1"},
+					Status:           v1alpha1.MachineImageStatusReady,
+				})
+			}),
+			want: want{
+				eo:        managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				condition: xpv1.Available(),
+			},
+		},
+		"LateInitialized": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&compute.MachineImage{
+					Description:      "golden",
+					SourceInstance:   testSourceInstance,
+					StorageLocations: []string{"us-central1"},
+					Status:           v1alpha1.MachineImageStatusInvalid,
+				})
+			}),
+			want: want{
+				eo:        managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true},
+				condition: xpv1.Unavailable(),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := machineImageExternal{Service: s, projectID: projectID, record: event.NewNopRecorder()}
+			cr := machineImageObj()
+			got, err := e.Observe(context.Background(), cr)
+			if diff := cmp.Diff(tc.want.eo, got); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if tc.want.condition.Type == "" {
+				return
+			}
+			if diff := cmp.Diff(tc.want.condition, cr.GetCondition(xpv1.TypeReady), test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want condition, +got condition:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMachineImageCreate(t *testing.T) {
+	cases := map[string]struct {
+		status int
+		want   *compute.MachineImage
+		err    error
+	}{
+		"Created": {
+			status: http.StatusOK,
+			want: &compute.MachineImage{
+				Name:             testMachineImageName,
+				SourceInstance:   testSourceInstance,
+				StorageLocations: []string{"us-central1"},
+			},
+		},
+		"AlreadyExists": {
+			status: http.StatusConflict,
+			want: &compute.MachineImage{
+				Name:             testMachineImageName,
+				SourceInstance:   testSourceInstance,
+				StorageLocations: []string{"us-central1"},
+			},
+			err: errors.Wrap(gError(http.StatusConflict, ""), errMachineImageCreateFailed),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := &compute.MachineImage{}
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = json.NewDecoder(r.Body).Decode(got)
+				_ = r.Body.Close()
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}))
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := machineImageExternal{Service: s, projectID: projectID, record: event.NewNopRecorder()}
+			_, err := e.Create(context.Background(), machineImageObj())
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
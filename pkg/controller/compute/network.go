@@ -26,7 +26,6 @@ import (
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/connection"
-	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	"github.com/crossplane/crossplane-runtime/pkg/errors"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
@@ -38,7 +37,9 @@ import (
 	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
 	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
 	"github.com/crossplane-contrib/provider-gcp/pkg/clients/network"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/setup"
 	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+	"github.com/crossplane-contrib/provider-gcp/pkg/tracing"
 )
 
 const (
@@ -56,7 +57,7 @@ const (
 
 // SetupNetwork adds a controller that reconciles Network managed
 // resources.
-func SetupNetwork(mgr ctrl.Manager, o controller.Options) error {
+func SetupNetwork(mgr ctrl.Manager, o setup.Options) error {
 	name := managed.ControllerName(v1beta1.NetworkGroupKind)
 
 	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
@@ -64,24 +65,27 @@ func SetupNetwork(mgr ctrl.Manager, o controller.Options) error {
 		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
 	}
 
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(v1beta1.NetworkGroupVersionKind),
-		managed.WithExternalConnecter(&networkConnector{kube: mgr.GetClient()}),
+		managed.WithExternalConnecter(setup.Connecter(mgr, o, v1beta1.NetworkGroupKind, &networkConnector{kube: mgr.GetClient(), record: recorder})),
 		managed.WithConnectionPublishers(),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
-		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithRecorder(recorder),
 		managed.WithConnectionPublishers(cps...))
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(o.ForControllerRuntime()).
 		For(&v1beta1.Network{}).
-		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+		Complete(tracing.NewReconciler(name, ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter)))
 }
 
 type networkConnector struct {
-	kube client.Client
+	kube   client.Client
+	record event.Recorder
 }
 
 func (c *networkConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
@@ -93,13 +97,14 @@ func (c *networkConnector) Connect(ctx context.Context, mg resource.Managed) (ma
 	if err != nil {
 		return nil, errors.Wrap(err, errNewClient)
 	}
-	return &networkExternal{Service: s, kube: c.kube, projectID: projectID}, nil
+	return &networkExternal{Service: s, kube: c.kube, projectID: projectID, record: c.record}, nil
 }
 
 type networkExternal struct {
 	kube client.Client
 	*compute.Service
 	projectID string
+	record    event.Recorder
 }
 
 func (c *networkExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -145,10 +150,14 @@ func (c *networkExternal) Create(ctx context.Context, mg resource.Managed) (mana
 
 	net := &compute.Network{}
 	network.GenerateNetwork(meta.GetExternalName(cr), cr.Spec.ForProvider, net)
-	_, err := c.Networks.Insert(c.projectID, net).
+	op, err := c.Networks.Insert(c.projectID, net).
 		Context(ctx).
 		Do()
-	return managed.ExternalCreation{}, errors.Wrap(err, errNetworkCreateFailed)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errNetworkCreateFailed)
+	}
+	gcp.RecordOperation(c.record, cr, "create", op.Name)
+	return managed.ExternalCreation{}, nil
 }
 
 func (c *networkExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
@@ -170,8 +179,12 @@ func (c *networkExternal) Update(ctx context.Context, mg resource.Managed) (mana
 		return managed.ExternalUpdate{}, nil
 	}
 	if switchToCustom {
-		_, err := c.Networks.SwitchToCustomMode(c.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
-		return managed.ExternalUpdate{}, errors.Wrap(err, errNetworkUpdateFailed)
+		op, err := c.Networks.SwitchToCustomMode(c.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errNetworkUpdateFailed)
+		}
+		gcp.RecordOperation(c.record, cr, "update", op.Name)
+		return managed.ExternalUpdate{}, nil
 	}
 
 	net := &compute.Network{}
@@ -179,10 +192,14 @@ func (c *networkExternal) Update(ctx context.Context, mg resource.Managed) (mana
 
 	// NOTE(muvaf): All parameters except routing config are
 	// immutable.
-	_, err = c.Networks.Patch(c.projectID, meta.GetExternalName(cr), net).
+	op, err := c.Networks.Patch(c.projectID, meta.GetExternalName(cr), net).
 		Context(ctx).
 		Do()
-	return managed.ExternalUpdate{}, errors.Wrap(err, errNetworkUpdateFailed)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errNetworkUpdateFailed)
+	}
+	gcp.RecordOperation(c.record, cr, "update", op.Name)
+	return managed.ExternalUpdate{}, nil
 }
 
 func (c *networkExternal) Delete(ctx context.Context, mg resource.Managed) error {
@@ -192,8 +209,12 @@ func (c *networkExternal) Delete(ctx context.Context, mg resource.Managed) error
 	}
 
 	cr.Status.SetConditions(xpv1.Deleting())
-	_, err := c.Networks.Delete(c.projectID, meta.GetExternalName(cr)).
+	op, err := c.Networks.Delete(c.projectID, meta.GetExternalName(cr)).
 		Context(ctx).
 		Do()
-	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errNetworkDeleteFailed)
+	if err != nil {
+		return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errNetworkDeleteFailed)
+	}
+	gcp.RecordOperation(c.record, cr, "delete", op.Name)
+	return nil
 }
@@ -0,0 +1,218 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/api/compute/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/packetmirroring"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/setup"
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+	"github.com/crossplane-contrib/provider-gcp/pkg/tracing"
+)
+
+const (
+	// Error strings.
+	errNotPacketMirroring           = "managed resource is not a PacketMirroring resource"
+	errGetPacketMirroring           = "cannot get GCP PacketMirroring"
+	errManagedPacketMirroringUpdate = "unable to update PacketMirroring managed resource"
+
+	errPacketMirroringUpdateFailed  = "update of PacketMirroring resource has failed"
+	errPacketMirroringCreateFailed  = "creation of PacketMirroring resource has failed"
+	errPacketMirroringDeleteFailed  = "deletion of PacketMirroring resource has failed"
+	errCheckPacketMirroringUpToDate = "cannot determine if GCP PacketMirroring is up to date"
+)
+
+// SetupPacketMirroring adds a controller that reconciles PacketMirroring
+// managed resources.
+func SetupPacketMirroring(mgr ctrl.Manager, o setup.Options) error {
+	name := managed.ControllerName(v1alpha1.PacketMirroringGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
+	}
+
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.PacketMirroringGroupVersionKind),
+		managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient()), gcp.NewLocationDefaulter(mgr.GetClient(), gcp.LocationScopeRegion, packetMirroringRegion)),
+		managed.WithExternalConnecter(setup.Connecter(mgr, o, v1alpha1.PacketMirroringGroupKind, &packetMirroringConnector{kube: mgr.GetClient(), record: recorder})),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(recorder),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.PacketMirroring{}).
+		Complete(tracing.NewReconciler(name, ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter)))
+}
+
+// packetMirroringRegion returns the region of the supplied PacketMirroring
+// so that it can be defaulted from its ProviderConfig.
+func packetMirroringRegion(mg resource.Managed) *string {
+	cr, ok := mg.(*v1alpha1.PacketMirroring)
+	if !ok {
+		return nil
+	}
+	return &cr.Spec.ForProvider.Region
+}
+
+type packetMirroringConnector struct {
+	kube   client.Client
+	record event.Recorder
+}
+
+func (c *packetMirroringConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetConnectionInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := compute.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &packetMirroringExternal{Service: s, kube: c.kube, projectID: projectID, record: c.record}, nil
+}
+
+type packetMirroringExternal struct {
+	kube client.Client
+	*compute.Service
+	projectID string
+	record    event.Recorder
+}
+
+func (c *packetMirroringExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.PacketMirroring)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotPacketMirroring)
+	}
+	observed, err := c.PacketMirrorings.Get(c.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetPacketMirroring)
+	}
+
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	packetmirroring.LateInitializeSpec(&cr.Spec.ForProvider, *observed)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
+		if err := c.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errManagedPacketMirroringUpdate)
+		}
+	}
+
+	cr.Status.AtProvider = packetmirroring.GeneratePacketMirroringObservation(*observed)
+
+	cr.Status.SetConditions(xpv1.Available())
+
+	u, err := packetmirroring.IsUpToDate(meta.GetExternalName(cr), &cr.Spec.ForProvider, observed)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errCheckPacketMirroringUpToDate)
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: u,
+	}, nil
+}
+
+func (c *packetMirroringExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.PacketMirroring)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotPacketMirroring)
+	}
+
+	pm := &compute.PacketMirroring{}
+	packetmirroring.GeneratePacketMirroring(meta.GetExternalName(cr), cr.Spec.ForProvider, pm)
+	op, err := c.PacketMirrorings.Insert(c.projectID, cr.Spec.ForProvider.Region, pm).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errPacketMirroringCreateFailed)
+	}
+	gcp.RecordOperation(c.record, cr, "create", op.Name)
+	return managed.ExternalCreation{}, nil
+}
+
+func (c *packetMirroringExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.PacketMirroring)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotPacketMirroring)
+	}
+
+	observed, err := c.PacketMirrorings.Get(c.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetPacketMirroring)
+	}
+
+	upToDate, err := packetmirroring.IsUpToDate(meta.GetExternalName(cr), &cr.Spec.ForProvider, observed)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errCheckPacketMirroringUpToDate)
+	}
+	if upToDate {
+		return managed.ExternalUpdate{}, nil
+	}
+
+	pm := &compute.PacketMirroring{}
+	packetmirroring.GeneratePacketMirroring(meta.GetExternalName(cr), cr.Spec.ForProvider, pm)
+
+	op, err := c.PacketMirrorings.Patch(c.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr), pm).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errPacketMirroringUpdateFailed)
+	}
+	gcp.RecordOperation(c.record, cr, "update", op.Name)
+	return managed.ExternalUpdate{}, nil
+}
+
+func (c *packetMirroringExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.PacketMirroring)
+	if !ok {
+		return errors.New(errNotPacketMirroring)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+	op, err := c.PacketMirrorings.Delete(c.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr)).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errPacketMirroringDeleteFailed)
+	}
+	gcp.RecordOperation(c.record, cr, "delete", op.Name)
+	return nil
+}
@@ -0,0 +1,335 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	compute "google.golang.org/api/compute/v1"
+	"google.golang.org/api/option"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+var _ managed.ExternalConnecter = &packetMirroringConnector{}
+var _ managed.ExternalClient = &packetMirroringExternal{}
+
+const (
+	testPacketMirroringName = "test-mirroring"
+	testMirroringNetwork    = "projects/" + projectID + "/global/networks/vpc"
+	testMirroringCollector  = "projects/" + projectID + "/regions/us-central1/forwardingRules/ids"
+	testMirroringSubnetwork = "projects/" + projectID + "/regions/us-central1/subnetworks/web"
+	testComputeURL          = "https://www.googleapis.com/compute/v1/"
+)
+
+func packetMirroringObj(m ...func(*v1alpha1.PacketMirroring)) *v1alpha1.PacketMirroring {
+	pm := &v1alpha1.PacketMirroring{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: testPacketMirroringName,
+			Annotations: map[string]string{
+				meta.AnnotationKeyExternalName: testPacketMirroringName,
+			},
+		},
+		Spec: v1alpha1.PacketMirroringSpec{
+			ForProvider: v1alpha1.PacketMirroringParameters{
+				Region:       "us-central1",
+				Network:      gcp.StringPtr(testMirroringNetwork),
+				CollectorILB: gcp.StringPtr(testMirroringCollector),
+				MirroredResources: v1alpha1.PacketMirroringMirroredResources{
+					Subnetworks: []string{testMirroringSubnetwork},
+				},
+				Filter:   &v1alpha1.PacketMirroringFilter{Direction: gcp.StringPtr("BOTH")},
+				Priority: gcp.Int64Ptr(1000),
+				Enable:   gcp.StringPtr("TRUE"),
+			},
+		},
+	}
+	for _, f := range m {
+		f(pm)
+	}
+	return pm
+}
+
+func observedPacketMirroring(m ...func(*compute.PacketMirroring)) *compute.PacketMirroring {
+	pm := &compute.PacketMirroring{
+		Name:         testPacketMirroringName,
+		Network:      &compute.PacketMirroringNetworkInfo{Url: testComputeURL + testMirroringNetwork},
+		CollectorIlb: &compute.PacketMirroringForwardingRuleInfo{Url: testComputeURL + testMirroringCollector},
+		MirroredResources: &compute.PacketMirroringMirroredResourceInfo{
+			Subnetworks: []*compute.PacketMirroringMirroredResourceInfoSubnetInfo{{Url: testComputeURL + testMirroringSubnetwork}},
+		},
+		Filter:   &compute.PacketMirroringFilter{Direction: "BOTH"},
+		Priority: 1000,
+		Enable:   "TRUE",
+	}
+	for _, f := range m {
+		f(pm)
+	}
+	return pm
+}
+
+func TestPacketMirroringObserve(t *testing.T) {
+	type args struct {
+		kube    *test.MockClient
+		handler http.Handler
+		mg      *v1alpha1.PacketMirroring
+	}
+	type want struct {
+		eo  managed.ExternalObservation
+		err error
+	}
+	observe := func(pm *compute.PacketMirroring) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_ = r.Body.Close()
+			w.WriteHeader(http.StatusOK)
+			_ = json.NewEncoder(w).Encode(pm)
+		})
+	}
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotFound": {
+			args: args{
+				mg: packetMirroringObj(),
+				handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					_ = r.Body.Close()
+					w.WriteHeader(http.StatusNotFound)
+				}),
+			},
+		},
+		"GetFailed": {
+			args: args{
+				mg: packetMirroringObj(),
+				handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					_ = r.Body.Close()
+					w.WriteHeader(http.StatusBadRequest)
+					_ = json.NewEncoder(w).Encode(&compute.PacketMirroring{})
+				}),
+			},
+			want: want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetPacketMirroring)},
+		},
+		"LateInitUpdateFailed": {
+			args: args{
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+				mg: packetMirroringObj(func(pm *v1alpha1.PacketMirroring) {
+					pm.Spec.ForProvider.Priority = nil
+				}),
+				handler: observe(observedPacketMirroring()),
+			},
+			want: want{err: errors.Wrap(errBoom, errManagedPacketMirroringUpdate)},
+		},
+		"UpToDate": {
+			args: args{
+				mg:      packetMirroringObj(),
+				handler: observe(observedPacketMirroring()),
+			},
+			want: want{eo: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+		},
+		"NotUpToDate": {
+			args: args{
+				mg: packetMirroringObj(),
+				handler: observe(observedPacketMirroring(func(pm *compute.PacketMirroring) {
+					pm.MirroredResources.Tags = []string{"web"}
+				})),
+			},
+			want: want{eo: managed.ExternalObservation{ResourceExists: true}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.args.handler)
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := packetMirroringExternal{kube: tc.args.kube, Service: s, projectID: projectID, record: event.NewNopRecorder()}
+			got, err := e.Observe(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.eo, got); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPacketMirroringCreate(t *testing.T) {
+	cases := map[string]struct {
+		status int
+		err    error
+	}{
+		"Successful": {
+			status: http.StatusOK,
+		},
+		"CreateFailed": {
+			status: http.StatusBadRequest,
+			err:    errors.Wrap(gError(http.StatusBadRequest, ""), errPacketMirroringCreateFailed),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				pm := &compute.PacketMirroring{}
+				_ = json.NewDecoder(r.Body).Decode(pm)
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+					t.Errorf("r: -want method, +got method:\n%s", diff)
+				}
+				if diff := cmp.Diff(testPacketMirroringName, pm.Name); diff != "" {
+					t.Errorf("r: -want name, +got name:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}))
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := packetMirroringExternal{Service: s, projectID: projectID, record: event.NewNopRecorder()}
+			_, err := e.Create(context.Background(), packetMirroringObj())
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPacketMirroringUpdate(t *testing.T) {
+	type want struct {
+		patched bool
+		err     error
+	}
+	cases := map[string]struct {
+		getStatus   int
+		patchStatus int
+		observed    *compute.PacketMirroring
+		want        want
+	}{
+		"GetFailed": {
+			getStatus: http.StatusBadRequest,
+			observed:  &compute.PacketMirroring{},
+			want:      want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetPacketMirroring)},
+		},
+		"AlreadyUpToDate": {
+			getStatus: http.StatusOK,
+			observed:  observedPacketMirroring(),
+		},
+		"Patched": {
+			getStatus:   http.StatusOK,
+			patchStatus: http.StatusOK,
+			observed: observedPacketMirroring(func(pm *compute.PacketMirroring) {
+				pm.MirroredResources.Tags = []string{"web"}
+			}),
+			want: want{patched: true},
+		},
+		"PatchFailed": {
+			getStatus:   http.StatusOK,
+			patchStatus: http.StatusBadRequest,
+			observed: observedPacketMirroring(func(pm *compute.PacketMirroring) {
+				pm.MirroredResources.Tags = []string{"web"}
+			}),
+			want: want{patched: true, err: errors.Wrap(gError(http.StatusBadRequest, ""), errPacketMirroringUpdateFailed)},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			patched := false
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method == http.MethodGet {
+					_ = r.Body.Close()
+					w.WriteHeader(tc.getStatus)
+					_ = json.NewEncoder(w).Encode(tc.observed)
+					return
+				}
+				patched = true
+				body := map[string]any{}
+				_ = json.NewDecoder(r.Body).Decode(&body)
+				_ = r.Body.Close()
+				// Emptied tag lists must be sent so the patch removes them.
+				mr, _ := body["mirroredResources"].(map[string]any)
+				if _, ok := mr["tags"]; !ok {
+					t.Errorf("r: want mirroredResources.tags to be sent, got %v", mr)
+				}
+				w.WriteHeader(tc.patchStatus)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}))
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := packetMirroringExternal{Service: s, projectID: projectID, record: event.NewNopRecorder()}
+			_, err := e.Update(context.Background(), packetMirroringObj())
+			if diff := cmp.Diff(tc.want.patched, patched); diff != "" {
+				t.Errorf("Update(...): -want patched, +got patched:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPacketMirroringDelete(t *testing.T) {
+	cases := map[string]struct {
+		status int
+		err    error
+	}{
+		"Successful": {
+			status: http.StatusOK,
+		},
+		"NotFound": {
+			status: http.StatusNotFound,
+		},
+		"DeleteFailed": {
+			status: http.StatusBadRequest,
+			err:    errors.Wrap(gError(http.StatusBadRequest, ""), errPacketMirroringDeleteFailed),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodDelete, r.Method); diff != "" {
+					t.Errorf("r: -want method, +got method:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}))
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := packetMirroringExternal{Service: s, projectID: projectID, record: event.NewNopRecorder()}
+			cr := packetMirroringObj()
+			err := e.Delete(context.Background(), cr)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(xpv1.Deleting(), cr.GetCondition(xpv1.TypeReady), test.EquateConditions()); diff != "" {
+				t.Errorf("Delete(...): -want condition, +got condition:\n%s", diff)
+			}
+		})
+	}
+}
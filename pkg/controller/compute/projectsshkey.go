@@ -0,0 +1,174 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+
+	"google.golang.org/api/compute/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/projectsshkey"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/setup"
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+	"github.com/crossplane-contrib/provider-gcp/pkg/tracing"
+)
+
+// Error strings.
+const (
+	errNotProjectSSHKey    = "managed resource is not a ProjectSSHKey"
+	errGetProject          = "cannot get project"
+	errUpdateProjectSSHKey = "cannot update project metadata"
+)
+
+// SetupProjectSSHKey adds a controller that reconciles ProjectSSHKey managed
+// resources.
+func SetupProjectSSHKey(mgr ctrl.Manager, o setup.Options) error {
+	name := managed.ControllerName(v1alpha1.ProjectSSHKeyGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
+	}
+
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.ProjectSSHKeyGroupVersionKind),
+		managed.WithExternalConnecter(setup.Connecter(mgr, o, v1alpha1.ProjectSSHKeyGroupKind, &projectSSHKeyConnector{kube: mgr.GetClient(), record: recorder})),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(recorder),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.ProjectSSHKey{}).
+		Complete(tracing.NewReconciler(name, ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter)))
+}
+
+type projectSSHKeyConnector struct {
+	kube   client.Client
+	record event.Recorder
+}
+
+func (c *projectSSHKeyConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetConnectionInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := compute.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &projectSSHKeyExternal{projects: s.Projects, projectID: projectID, record: c.record}, nil
+}
+
+type projectSSHKeyExternal struct {
+	projects  *compute.ProjectsService
+	projectID string
+	record    event.Recorder
+}
+
+func (e *projectSSHKeyExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ProjectSSHKey)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotProjectSSHKey)
+	}
+	observed, err := e.projects.Get(e.projectID).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetProject)
+	}
+	if !projectsshkey.HasKey(observed.CommonInstanceMetadata, projectsshkey.Entry(cr.Spec.ForProvider)) {
+		return managed.ExternalObservation{}, nil
+	}
+	cr.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+}
+
+// Create adds the key to the ssh-keys item of the project metadata.
+func (e *projectSSHKeyExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ProjectSSHKey)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotProjectSSHKey)
+	}
+	entry := projectsshkey.Entry(cr.Spec.ForProvider)
+	// Other keys, or other metadata items, may be changed concurrently, in
+	// which case the fingerprint of the metadata read here is stale. Read it
+	// again and retry.
+	err := gcp.RetryOnConflict(func() error {
+		observed, err := e.projects.Get(e.projectID).Context(ctx).Do()
+		if err != nil {
+			return errors.Wrap(err, errGetProject)
+		}
+		if projectsshkey.HasKey(observed.CommonInstanceMetadata, entry) {
+			return nil
+		}
+		return e.setMetadata(ctx, cr, "create", projectsshkey.WithKey(observed.CommonInstanceMetadata, entry))
+	})
+	return managed.ExternalCreation{}, err
+}
+
+// Update adds the key to the ssh-keys item of the project metadata.
+func (e *projectSSHKeyExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	_, err := e.Create(ctx, mg)
+	return managed.ExternalUpdate{}, err
+}
+
+// Delete removes the key from the ssh-keys item of the project metadata.
+func (e *projectSSHKeyExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.ProjectSSHKey)
+	if !ok {
+		return errors.New(errNotProjectSSHKey)
+	}
+	entry := projectsshkey.Entry(cr.Spec.ForProvider)
+	return gcp.RetryOnConflict(func() error {
+		observed, err := e.projects.Get(e.projectID).Context(ctx).Do()
+		if err != nil {
+			return errors.Wrap(err, errGetProject)
+		}
+		md, changed := projectsshkey.WithoutKey(observed.CommonInstanceMetadata, entry)
+		if !changed {
+			return nil
+		}
+		return e.setMetadata(ctx, cr, "delete", md)
+	})
+}
+
+// setMetadata replaces the project metadata, unless its fingerprint shows it
+// was changed since it was read.
+func (e *projectSSHKeyExternal) setMetadata(ctx context.Context, cr *v1alpha1.ProjectSSHKey, verb string, md *compute.Metadata) error {
+	op, err := e.projects.SetCommonInstanceMetadata(e.projectID, md).Context(ctx).Do()
+	if err != nil {
+		return errors.Wrap(err, errUpdateProjectSSHKey)
+	}
+	gcp.RecordOperation(e.record, cr, verb, op.Name)
+	return nil
+}
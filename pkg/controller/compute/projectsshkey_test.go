@@ -0,0 +1,199 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/compute/v1"
+	"google.golang.org/api/option"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	aliceKey = "alice:ssh-ed25519 AAAAalice alice@example.com"
+	bobKey   = "bob:ssh-rsa AAAAbob bob@example.com"
+)
+
+func newProjectSSHKey() *v1alpha1.ProjectSSHKey {
+	return &v1alpha1.ProjectSSHKey{
+		Spec: v1alpha1.ProjectSSHKeySpec{
+			ForProvider: v1alpha1.ProjectSSHKeyParameters{
+				Username:  "alice",
+				PublicKey: "ssh-ed25519 AAAAalice alice@example.com",
+			},
+		},
+	}
+}
+
+func projectWithSSHKeys(keys string) *compute.Project {
+	return &compute.Project{CommonInstanceMetadata: &compute.Metadata{
+		Fingerprint: "fp",
+		Items: []*compute.MetadataItems{
+			{Key: "enable-oslogin", Value: gcp.StringPtr("FALSE")},
+			{Key: "ssh-keys", Value: gcp.StringPtr(keys)},
+		},
+	}}
+}
+
+// projectHandler serves the supplied project and records the metadata it is
+// set to. The first conflicts requests to set the metadata fail as if the
+// fingerprint was stale.
+func projectHandler(t *testing.T, p *compute.Project, conflicts int, set *[]*compute.Metadata) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer func() { _ = r.Body.Close() }()
+		switch r.URL.Path {
+		case "/projects/" + projectID:
+			_ = json.NewEncoder(w).Encode(p)
+		case "/projects/" + projectID + "/setCommonInstanceMetadata":
+			if conflicts > 0 {
+				conflicts--
+				w.WriteHeader(http.StatusPreconditionFailed)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+				return
+			}
+			md := &compute.Metadata{}
+			_ = json.NewDecoder(r.Body).Decode(md)
+			*set = append(*set, md)
+			_ = json.NewEncoder(w).Encode(&compute.Operation{})
+		default:
+			t.Errorf("unexpected request: %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+}
+
+func newProjectSSHKeyExternal(t *testing.T, h http.Handler) *projectSSHKeyExternal {
+	t.Helper()
+	server := httptest.NewServer(h)
+	t.Cleanup(server.Close)
+	s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	return &projectSSHKeyExternal{projects: s.Projects, projectID: projectID}
+}
+
+func TestProjectSSHKeyObserve(t *testing.T) {
+	cases := map[string]struct {
+		project *compute.Project
+		want    managed.ExternalObservation
+	}{
+		"NoMetadata": {
+			project: &compute.Project{},
+		},
+		"NotAdded": {
+			project: projectWithSSHKeys(bobKey),
+		},
+		"AddedWithOtherComment": {
+			project: projectWithSSHKeys(bobKey + "\nalice:ssh-ed25519 AAAAalice alice@laptop"),
+			want:    managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := newProjectSSHKeyExternal(t, projectHandler(t, tc.project, 0, nil))
+			got, err := e.Observe(context.Background(), newProjectSSHKey())
+			if err != nil {
+				t.Fatalf("Observe(...): unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestProjectSSHKeyCreate(t *testing.T) {
+	cases := map[string]struct {
+		project   *compute.Project
+		conflicts int
+		want      []*compute.Metadata
+	}{
+		"AppendsToOtherKeys": {
+			project: projectWithSSHKeys(bobKey),
+			want:    []*compute.Metadata{projectWithSSHKeys(bobKey + "\n" + aliceKey).CommonInstanceMetadata},
+		},
+		"RetriesStaleFingerprint": {
+			project:   projectWithSSHKeys(bobKey),
+			conflicts: 1,
+			want:      []*compute.Metadata{projectWithSSHKeys(bobKey + "\n" + aliceKey).CommonInstanceMetadata},
+		},
+		"AddsItem": {
+			project: &compute.Project{CommonInstanceMetadata: &compute.Metadata{Fingerprint: "fp"}},
+			want: []*compute.Metadata{{
+				Fingerprint: "fp",
+				Items:       []*compute.MetadataItems{{Key: "ssh-keys", Value: gcp.StringPtr(aliceKey)}},
+			}},
+		},
+		"AlreadyAdded": {
+			project: projectWithSSHKeys(aliceKey),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var set []*compute.Metadata
+			e := newProjectSSHKeyExternal(t, projectHandler(t, tc.project, tc.conflicts, &set))
+			if _, err := e.Create(context.Background(), newProjectSSHKey()); err != nil {
+				t.Fatalf("Create(...): unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, set); diff != "" {
+				t.Errorf("Create(...): -want metadata, +got metadata:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestProjectSSHKeyDelete(t *testing.T) {
+	cases := map[string]struct {
+		project *compute.Project
+		want    []*compute.Metadata
+	}{
+		"KeepsOtherKeys": {
+			project: projectWithSSHKeys(bobKey + "\n" + aliceKey),
+			want:    []*compute.Metadata{projectWithSSHKeys(bobKey).CommonInstanceMetadata},
+		},
+		"RemovesEmptyItem": {
+			project: projectWithSSHKeys(aliceKey),
+			want: []*compute.Metadata{{
+				Fingerprint: "fp",
+				Items:       []*compute.MetadataItems{{Key: "enable-oslogin", Value: gcp.StringPtr("FALSE")}},
+			}},
+		},
+		"NotAdded": {
+			project: projectWithSSHKeys(bobKey),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var set []*compute.Metadata
+			e := newProjectSSHKeyExternal(t, projectHandler(t, tc.project, 0, &set))
+			if err := e.Delete(context.Background(), newProjectSSHKey()); err != nil {
+				t.Fatalf("Delete(...): unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, set); diff != "" {
+				t.Errorf("Delete(...): -want metadata, +got metadata:\n%s", diff)
+			}
+		})
+	}
+}
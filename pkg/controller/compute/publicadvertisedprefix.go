@@ -0,0 +1,218 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/api/compute/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/publicadvertisedprefix"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/setup"
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+	"github.com/crossplane-contrib/provider-gcp/pkg/tracing"
+)
+
+const (
+	// Error strings.
+	errNotPublicAdvertisedPrefix           = "managed resource is not a PublicAdvertisedPrefix resource"
+	errGetPublicAdvertisedPrefix           = "cannot get GCP PublicAdvertisedPrefix"
+	errManagedPublicAdvertisedPrefixUpdate = "unable to update PublicAdvertisedPrefix managed resource"
+
+	errPublicAdvertisedPrefixUpdateFailed  = "update of PublicAdvertisedPrefix resource has failed"
+	errPublicAdvertisedPrefixCreateFailed  = "creation of PublicAdvertisedPrefix resource has failed"
+	errPublicAdvertisedPrefixDeleteFailed  = "deletion of PublicAdvertisedPrefix resource has failed"
+	errCheckPublicAdvertisedPrefixUpToDate = "cannot determine if GCP PublicAdvertisedPrefix is up to date"
+)
+
+// SetupPublicAdvertisedPrefix adds a controller that reconciles
+// PublicAdvertisedPrefix managed resources.
+func SetupPublicAdvertisedPrefix(mgr ctrl.Manager, o setup.Options) error {
+	name := managed.ControllerName(v1alpha1.PublicAdvertisedPrefixGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
+	}
+
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.PublicAdvertisedPrefixGroupVersionKind),
+		managed.WithExternalConnecter(setup.Connecter(mgr, o, v1alpha1.PublicAdvertisedPrefixGroupKind, &publicAdvertisedPrefixConnector{kube: mgr.GetClient(), record: recorder})),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(recorder),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.PublicAdvertisedPrefix{}).
+		Complete(tracing.NewReconciler(name, ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter)))
+}
+
+type publicAdvertisedPrefixConnector struct {
+	kube   client.Client
+	record event.Recorder
+}
+
+func (c *publicAdvertisedPrefixConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetConnectionInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := compute.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &publicAdvertisedPrefixExternal{Service: s, kube: c.kube, projectID: projectID, record: c.record}, nil
+}
+
+type publicAdvertisedPrefixExternal struct {
+	kube client.Client
+	*compute.Service
+	projectID string
+	record    event.Recorder
+}
+
+func (c *publicAdvertisedPrefixExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.PublicAdvertisedPrefix)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotPublicAdvertisedPrefix)
+	}
+	observed, err := c.PublicAdvertisedPrefixes.Get(c.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetPublicAdvertisedPrefix)
+	}
+
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	publicadvertisedprefix.LateInitializeSpec(&cr.Spec.ForProvider, *observed)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
+		if err := c.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errManagedPublicAdvertisedPrefixUpdate)
+		}
+	}
+
+	cr.Status.AtProvider = publicadvertisedprefix.GeneratePublicAdvertisedPrefixObservation(*observed)
+
+	switch cr.Status.AtProvider.Status {
+	case v1alpha1.PublicAdvertisedPrefixStatusPrefixConfigurationComplete:
+		cr.Status.SetConditions(xpv1.Available())
+	case v1alpha1.PublicAdvertisedPrefixStatusPrefixRemovalInProgress:
+		cr.Status.SetConditions(xpv1.Deleting())
+	case v1alpha1.PublicAdvertisedPrefixStatusReverseDNSLookupFailed:
+		cr.Status.SetConditions(xpv1.Unavailable())
+	default:
+		// The prefix waits for the PTR record of its DNS verification
+		// address to carry the shared secret before it is configured.
+		cr.Status.SetConditions(xpv1.Creating())
+	}
+
+	u, err := publicadvertisedprefix.IsUpToDate(meta.GetExternalName(cr), &cr.Spec.ForProvider, observed)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errCheckPublicAdvertisedPrefixUpToDate)
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: u,
+	}, nil
+}
+
+func (c *publicAdvertisedPrefixExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.PublicAdvertisedPrefix)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotPublicAdvertisedPrefix)
+	}
+
+	p := &compute.PublicAdvertisedPrefix{}
+	publicadvertisedprefix.GeneratePublicAdvertisedPrefix(meta.GetExternalName(cr), cr.Spec.ForProvider, p)
+	op, err := c.PublicAdvertisedPrefixes.Insert(c.projectID, p).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errPublicAdvertisedPrefixCreateFailed)
+	}
+	gcp.RecordOperation(c.record, cr, "create", op.Name)
+	return managed.ExternalCreation{}, nil
+}
+
+func (c *publicAdvertisedPrefixExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.PublicAdvertisedPrefix)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotPublicAdvertisedPrefix)
+	}
+
+	observed, err := c.PublicAdvertisedPrefixes.Get(c.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetPublicAdvertisedPrefix)
+	}
+
+	upToDate, err := publicadvertisedprefix.IsUpToDate(meta.GetExternalName(cr), &cr.Spec.ForProvider, observed)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errCheckPublicAdvertisedPrefixUpToDate)
+	}
+	if upToDate {
+		return managed.ExternalUpdate{}, nil
+	}
+
+	// The API rejects patches that don't carry the current fingerprint.
+	p := &compute.PublicAdvertisedPrefix{Fingerprint: observed.Fingerprint}
+	publicadvertisedprefix.GeneratePublicAdvertisedPrefix(meta.GetExternalName(cr), cr.Spec.ForProvider, p)
+
+	op, err := c.PublicAdvertisedPrefixes.Patch(c.projectID, meta.GetExternalName(cr), p).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errPublicAdvertisedPrefixUpdateFailed)
+	}
+	gcp.RecordOperation(c.record, cr, "update", op.Name)
+	return managed.ExternalUpdate{}, nil
+}
+
+func (c *publicAdvertisedPrefixExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.PublicAdvertisedPrefix)
+	if !ok {
+		return errors.New(errNotPublicAdvertisedPrefix)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+	op, err := c.PublicAdvertisedPrefixes.Delete(c.projectID, meta.GetExternalName(cr)).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errPublicAdvertisedPrefixDeleteFailed)
+	}
+	gcp.RecordOperation(c.record, cr, "delete", op.Name)
+	return nil
+}
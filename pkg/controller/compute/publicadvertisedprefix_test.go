@@ -0,0 +1,373 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	compute "google.golang.org/api/compute/v1"
+	"google.golang.org/api/option"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+var _ managed.ExternalConnecter = &publicAdvertisedPrefixConnector{}
+var _ managed.ExternalClient = &publicAdvertisedPrefixExternal{}
+
+const (
+	testPublicAdvertisedPrefixName        = "test-pap"
+	testPublicAdvertisedPrefixFingerprint = "fingerprint"
+)
+
+func publicAdvertisedPrefixObj(m ...func(*v1alpha1.PublicAdvertisedPrefix)) *v1alpha1.PublicAdvertisedPrefix {
+	p := &v1alpha1.PublicAdvertisedPrefix{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: testPublicAdvertisedPrefixName,
+			Annotations: map[string]string{
+				meta.AnnotationKeyExternalName: testPublicAdvertisedPrefixName,
+			},
+		},
+		Spec: v1alpha1.PublicAdvertisedPrefixSpec{
+			ForProvider: v1alpha1.PublicAdvertisedPrefixParameters{
+				Description:       gcp.StringPtr("some desc"),
+				IPCIDRRange:       "203.0.113.0/24",
+				DNSVerificationIP: "203.0.113.1",
+			},
+		},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func observedPublicAdvertisedPrefix(m ...func(*compute.PublicAdvertisedPrefix)) *compute.PublicAdvertisedPrefix {
+	p := &compute.PublicAdvertisedPrefix{
+		Name:              testPublicAdvertisedPrefixName,
+		Description:       "some desc",
+		IpCidrRange:       "203.0.113.0/24",
+		DnsVerificationIp: "203.0.113.1",
+		Fingerprint:       testPublicAdvertisedPrefixFingerprint,
+		Status:            v1alpha1.PublicAdvertisedPrefixStatusPrefixConfigurationComplete,
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func TestPublicAdvertisedPrefixObserve(t *testing.T) {
+	type args struct {
+		kube    *test.MockClient
+		handler http.Handler
+		mg      *v1alpha1.PublicAdvertisedPrefix
+	}
+	type want struct {
+		eo   managed.ExternalObservation
+		cond xpv1.Condition
+		err  error
+	}
+	observe := func(p *compute.PublicAdvertisedPrefix) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_ = r.Body.Close()
+			w.WriteHeader(http.StatusOK)
+			_ = json.NewEncoder(w).Encode(p)
+		})
+	}
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotFound": {
+			args: args{
+				mg: publicAdvertisedPrefixObj(),
+				handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					_ = r.Body.Close()
+					w.WriteHeader(http.StatusNotFound)
+				}),
+			},
+		},
+		"GetFailed": {
+			args: args{
+				mg: publicAdvertisedPrefixObj(),
+				handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					_ = r.Body.Close()
+					w.WriteHeader(http.StatusBadRequest)
+					_ = json.NewEncoder(w).Encode(&compute.PublicAdvertisedPrefix{})
+				}),
+			},
+			want: want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetPublicAdvertisedPrefix)},
+		},
+		"LateInitUpdateFailed": {
+			args: args{
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+				mg: publicAdvertisedPrefixObj(func(p *v1alpha1.PublicAdvertisedPrefix) {
+					p.Spec.ForProvider.Description = nil
+				}),
+				handler: observe(observedPublicAdvertisedPrefix()),
+			},
+			want: want{err: errors.Wrap(errBoom, errManagedPublicAdvertisedPrefixUpdate)},
+		},
+		"Available": {
+			args: args{
+				mg:      publicAdvertisedPrefixObj(),
+				handler: observe(observedPublicAdvertisedPrefix()),
+			},
+			want: want{
+				eo:   managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				cond: xpv1.Available(),
+			},
+		},
+		"WaitingForPTRRecord": {
+			args: args{
+				mg: publicAdvertisedPrefixObj(),
+				handler: observe(observedPublicAdvertisedPrefix(func(p *compute.PublicAdvertisedPrefix) {
+					p.Status = v1alpha1.PublicAdvertisedPrefixStatusInitial
+				})),
+			},
+			want: want{
+				eo:   managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				cond: xpv1.Creating(),
+			},
+		},
+		"ReverseDNSLookupFailed": {
+			args: args{
+				mg: publicAdvertisedPrefixObj(),
+				handler: observe(observedPublicAdvertisedPrefix(func(p *compute.PublicAdvertisedPrefix) {
+					p.Status = v1alpha1.PublicAdvertisedPrefixStatusReverseDNSLookupFailed
+				})),
+			},
+			want: want{
+				eo:   managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				cond: xpv1.Unavailable(),
+			},
+		},
+		"RemovalInProgress": {
+			args: args{
+				mg: publicAdvertisedPrefixObj(),
+				handler: observe(observedPublicAdvertisedPrefix(func(p *compute.PublicAdvertisedPrefix) {
+					p.Status = v1alpha1.PublicAdvertisedPrefixStatusPrefixRemovalInProgress
+				})),
+			},
+			want: want{
+				eo:   managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				cond: xpv1.Deleting(),
+			},
+		},
+		"NotUpToDate": {
+			args: args{
+				mg: publicAdvertisedPrefixObj(func(p *v1alpha1.PublicAdvertisedPrefix) {
+					p.Spec.ForProvider.Description = gcp.StringPtr("other desc")
+				}),
+				handler: observe(observedPublicAdvertisedPrefix()),
+			},
+			want: want{
+				eo:   managed.ExternalObservation{ResourceExists: true},
+				cond: xpv1.Available(),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.args.handler)
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := publicAdvertisedPrefixExternal{kube: tc.args.kube, Service: s, projectID: projectID, record: event.NewNopRecorder()}
+			got, err := e.Observe(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.eo, got); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if tc.want.err != nil || !got.ResourceExists {
+				return
+			}
+			if diff := cmp.Diff(tc.want.cond, tc.args.mg.GetCondition(xpv1.TypeReady), test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want condition, +got condition:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPublicAdvertisedPrefixCreate(t *testing.T) {
+	cases := map[string]struct {
+		status int
+		err    error
+	}{
+		"Successful": {
+			status: http.StatusOK,
+		},
+		"CreateFailed": {
+			status: http.StatusBadRequest,
+			err:    errors.Wrap(gError(http.StatusBadRequest, ""), errPublicAdvertisedPrefixCreateFailed),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				p := &compute.PublicAdvertisedPrefix{}
+				_ = json.NewDecoder(r.Body).Decode(p)
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+					t.Errorf("r: -want method, +got method:\n%s", diff)
+				}
+				if diff := cmp.Diff(testPublicAdvertisedPrefixName, p.Name); diff != "" {
+					t.Errorf("r: -want name, +got name:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}))
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := publicAdvertisedPrefixExternal{Service: s, projectID: projectID, record: event.NewNopRecorder()}
+			_, err := e.Create(context.Background(), publicAdvertisedPrefixObj())
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPublicAdvertisedPrefixUpdate(t *testing.T) {
+	type want struct {
+		patched bool
+		err     error
+	}
+	cases := map[string]struct {
+		getStatus   int
+		patchStatus int
+		observed    *compute.PublicAdvertisedPrefix
+		want        want
+	}{
+		"GetFailed": {
+			getStatus: http.StatusBadRequest,
+			observed:  &compute.PublicAdvertisedPrefix{},
+			want:      want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetPublicAdvertisedPrefix)},
+		},
+		"AlreadyUpToDate": {
+			getStatus: http.StatusOK,
+			observed:  observedPublicAdvertisedPrefix(),
+		},
+		"Patched": {
+			getStatus:   http.StatusOK,
+			patchStatus: http.StatusOK,
+			observed: observedPublicAdvertisedPrefix(func(p *compute.PublicAdvertisedPrefix) {
+				p.Description = "other desc"
+			}),
+			want: want{patched: true},
+		},
+		"PatchFailed": {
+			getStatus:   http.StatusOK,
+			patchStatus: http.StatusBadRequest,
+			observed: observedPublicAdvertisedPrefix(func(p *compute.PublicAdvertisedPrefix) {
+				p.Description = "other desc"
+			}),
+			want: want{patched: true, err: errors.Wrap(gError(http.StatusBadRequest, ""), errPublicAdvertisedPrefixUpdateFailed)},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			patched := false
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method == http.MethodGet {
+					_ = r.Body.Close()
+					w.WriteHeader(tc.getStatus)
+					_ = json.NewEncoder(w).Encode(tc.observed)
+					return
+				}
+				patched = true
+				p := &compute.PublicAdvertisedPrefix{}
+				_ = json.NewDecoder(r.Body).Decode(p)
+				_ = r.Body.Close()
+				if diff := cmp.Diff(testPublicAdvertisedPrefixFingerprint, p.Fingerprint); diff != "" {
+					t.Errorf("r: -want fingerprint, +got fingerprint:\n%s", diff)
+				}
+				if diff := cmp.Diff("some desc", p.Description); diff != "" {
+					t.Errorf("r: -want description, +got description:\n%s", diff)
+				}
+				w.WriteHeader(tc.patchStatus)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}))
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := publicAdvertisedPrefixExternal{Service: s, projectID: projectID, record: event.NewNopRecorder()}
+			_, err := e.Update(context.Background(), publicAdvertisedPrefixObj())
+			if diff := cmp.Diff(tc.want.patched, patched); diff != "" {
+				t.Errorf("Update(...): -want patched, +got patched:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPublicAdvertisedPrefixDelete(t *testing.T) {
+	cases := map[string]struct {
+		status int
+		err    error
+	}{
+		"Successful": {
+			status: http.StatusOK,
+		},
+		"NotFound": {
+			status: http.StatusNotFound,
+		},
+		"DeleteFailed": {
+			status: http.StatusBadRequest,
+			err:    errors.Wrap(gError(http.StatusBadRequest, ""), errPublicAdvertisedPrefixDeleteFailed),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodDelete, r.Method); diff != "" {
+					t.Errorf("r: -want method, +got method:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}))
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := publicAdvertisedPrefixExternal{Service: s, projectID: projectID, record: event.NewNopRecorder()}
+			cr := publicAdvertisedPrefixObj()
+			err := e.Delete(context.Background(), cr)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(xpv1.Deleting(), cr.GetCondition(xpv1.TypeReady), test.EquateConditions()); diff != "" {
+				t.Errorf("Delete(...): -want condition, +got condition:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,226 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/api/compute/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/publicdelegatedprefix"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/setup"
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+	"github.com/crossplane-contrib/provider-gcp/pkg/tracing"
+)
+
+const (
+	// Error strings.
+	errNotPublicDelegatedPrefix           = "managed resource is not a PublicDelegatedPrefix resource"
+	errGetPublicDelegatedPrefix           = "cannot get GCP PublicDelegatedPrefix"
+	errManagedPublicDelegatedPrefixUpdate = "unable to update PublicDelegatedPrefix managed resource"
+
+	errPublicDelegatedPrefixUpdateFailed  = "update of PublicDelegatedPrefix resource has failed"
+	errPublicDelegatedPrefixCreateFailed  = "creation of PublicDelegatedPrefix resource has failed"
+	errPublicDelegatedPrefixDeleteFailed  = "deletion of PublicDelegatedPrefix resource has failed"
+	errCheckPublicDelegatedPrefixUpToDate = "cannot determine if GCP PublicDelegatedPrefix is up to date"
+)
+
+// SetupPublicDelegatedPrefix adds a controller that reconciles
+// PublicDelegatedPrefix managed resources.
+func SetupPublicDelegatedPrefix(mgr ctrl.Manager, o setup.Options) error {
+	name := managed.ControllerName(v1alpha1.PublicDelegatedPrefixGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
+	}
+
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.PublicDelegatedPrefixGroupVersionKind),
+		managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient()), gcp.NewLocationDefaulter(mgr.GetClient(), gcp.LocationScopeRegion, publicDelegatedPrefixRegion)),
+		managed.WithExternalConnecter(setup.Connecter(mgr, o, v1alpha1.PublicDelegatedPrefixGroupKind, &publicDelegatedPrefixConnector{kube: mgr.GetClient(), record: recorder})),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(recorder),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.PublicDelegatedPrefix{}).
+		Complete(tracing.NewReconciler(name, ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter)))
+}
+
+// publicDelegatedPrefixRegion returns the region of the supplied
+// PublicDelegatedPrefix so that it can be defaulted from its ProviderConfig.
+func publicDelegatedPrefixRegion(mg resource.Managed) *string {
+	cr, ok := mg.(*v1alpha1.PublicDelegatedPrefix)
+	if !ok {
+		return nil
+	}
+	return &cr.Spec.ForProvider.Region
+}
+
+type publicDelegatedPrefixConnector struct {
+	kube   client.Client
+	record event.Recorder
+}
+
+func (c *publicDelegatedPrefixConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetConnectionInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := compute.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &publicDelegatedPrefixExternal{Service: s, kube: c.kube, projectID: projectID, record: c.record}, nil
+}
+
+type publicDelegatedPrefixExternal struct {
+	kube client.Client
+	*compute.Service
+	projectID string
+	record    event.Recorder
+}
+
+func (c *publicDelegatedPrefixExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.PublicDelegatedPrefix)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotPublicDelegatedPrefix)
+	}
+	observed, err := c.PublicDelegatedPrefixes.Get(c.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetPublicDelegatedPrefix)
+	}
+
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	publicdelegatedprefix.LateInitializeSpec(&cr.Spec.ForProvider, *observed)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
+		if err := c.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errManagedPublicDelegatedPrefixUpdate)
+		}
+	}
+
+	cr.Status.AtProvider = publicdelegatedprefix.GeneratePublicDelegatedPrefixObservation(*observed)
+
+	switch cr.Status.AtProvider.Status {
+	case v1alpha1.PublicDelegatedPrefixStatusAnnounced, v1alpha1.PublicDelegatedPrefixStatusReadyToAnnounce:
+		cr.Status.SetConditions(xpv1.Available())
+	case v1alpha1.PublicDelegatedPrefixStatusInitializing:
+		cr.Status.SetConditions(xpv1.Creating())
+	case v1alpha1.PublicDelegatedPrefixStatusDeleting:
+		cr.Status.SetConditions(xpv1.Deleting())
+	}
+
+	u, err := publicdelegatedprefix.IsUpToDate(meta.GetExternalName(cr), &cr.Spec.ForProvider, observed)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errCheckPublicDelegatedPrefixUpToDate)
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: u,
+	}, nil
+}
+
+func (c *publicDelegatedPrefixExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.PublicDelegatedPrefix)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotPublicDelegatedPrefix)
+	}
+
+	p := &compute.PublicDelegatedPrefix{}
+	publicdelegatedprefix.GeneratePublicDelegatedPrefix(meta.GetExternalName(cr), cr.Spec.ForProvider, p)
+	op, err := c.PublicDelegatedPrefixes.Insert(c.projectID, cr.Spec.ForProvider.Region, p).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errPublicDelegatedPrefixCreateFailed)
+	}
+	gcp.RecordOperation(c.record, cr, "create", op.Name)
+	return managed.ExternalCreation{}, nil
+}
+
+func (c *publicDelegatedPrefixExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.PublicDelegatedPrefix)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotPublicDelegatedPrefix)
+	}
+
+	observed, err := c.PublicDelegatedPrefixes.Get(c.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetPublicDelegatedPrefix)
+	}
+
+	upToDate, err := publicdelegatedprefix.IsUpToDate(meta.GetExternalName(cr), &cr.Spec.ForProvider, observed)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errCheckPublicDelegatedPrefixUpToDate)
+	}
+	if upToDate {
+		return managed.ExternalUpdate{}, nil
+	}
+
+	// The API rejects patches that don't carry the current fingerprint.
+	p := &compute.PublicDelegatedPrefix{Fingerprint: observed.Fingerprint}
+	publicdelegatedprefix.GeneratePublicDelegatedPrefix(meta.GetExternalName(cr), cr.Spec.ForProvider, p)
+
+	op, err := c.PublicDelegatedPrefixes.Patch(c.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr), p).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errPublicDelegatedPrefixUpdateFailed)
+	}
+	gcp.RecordOperation(c.record, cr, "update", op.Name)
+	return managed.ExternalUpdate{}, nil
+}
+
+func (c *publicDelegatedPrefixExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.PublicDelegatedPrefix)
+	if !ok {
+		return errors.New(errNotPublicDelegatedPrefix)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+	op, err := c.PublicDelegatedPrefixes.Delete(c.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr)).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errPublicDelegatedPrefixDeleteFailed)
+	}
+	gcp.RecordOperation(c.record, cr, "delete", op.Name)
+	return nil
+}
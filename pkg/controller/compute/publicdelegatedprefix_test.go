@@ -0,0 +1,287 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	compute "google.golang.org/api/compute/v1"
+	"google.golang.org/api/option"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1beta1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+var _ managed.ExternalConnecter = &publicDelegatedPrefixConnector{}
+var _ managed.ExternalClient = &publicDelegatedPrefixExternal{}
+
+const (
+	testPublicDelegatedPrefixName = "test-public-delegated-prefix"
+	testPublicDelegatedPrefixDesc = "some desc"
+)
+
+type publicDelegatedPrefixModifier func(*v1alpha1.PublicDelegatedPrefix)
+
+func publicDelegatedPrefixWithConditions(c ...xpv1.Condition) publicDelegatedPrefixModifier {
+	return func(i *v1alpha1.PublicDelegatedPrefix) { i.Status.SetConditions(c...) }
+}
+
+func publicDelegatedPrefixWithAtProvider(o v1alpha1.PublicDelegatedPrefixObservation) publicDelegatedPrefixModifier {
+	return func(i *v1alpha1.PublicDelegatedPrefix) { i.Status.AtProvider = o }
+}
+
+func publicDelegatedPrefixObj(im ...publicDelegatedPrefixModifier) *v1alpha1.PublicDelegatedPrefix {
+	i := &v1alpha1.PublicDelegatedPrefix{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       testPublicDelegatedPrefixName,
+			Finalizers: []string{},
+			Annotations: map[string]string{
+				meta.AnnotationKeyExternalName: testPublicDelegatedPrefixName,
+			},
+		},
+		Spec: v1alpha1.PublicDelegatedPrefixSpec{
+			ForProvider: v1alpha1.PublicDelegatedPrefixParameters{
+				Description: gcp.StringPtr(testPublicDelegatedPrefixDesc),
+			},
+		},
+	}
+
+	for _, m := range im {
+		m(i)
+	}
+
+	return i
+}
+
+func TestPublicDelegatedPrefixObserve(t *testing.T) {
+	type args struct {
+		mg resource.Managed
+	}
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		kube    client.Client
+		args    args
+		want    want
+	}{
+		"NotPublicDelegatedPrefix": {
+			handler: nil,
+			args: args{
+				mg: &v1beta1.Subnetwork{},
+			},
+			want: want{
+				mg:  &v1beta1.Subnetwork{},
+				err: errors.New(errNotPublicDelegatedPrefix),
+			},
+		},
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+				if err := json.NewEncoder(w).Encode(&compute.PublicDelegatedPrefix{}); err != nil {
+					t.Error(err)
+				}
+			}),
+			args: args{
+				mg: publicDelegatedPrefixObj(),
+			},
+			want: want{
+				mg: publicDelegatedPrefixObj(),
+			},
+		},
+		"NotUpToDate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				p := &compute.PublicDelegatedPrefix{
+					Name:        testPublicDelegatedPrefixName,
+					Description: "old desc",
+					Fingerprint: testFingerprint,
+					Status:      v1alpha1.PublicDelegatedPrefixStatusInitializing,
+				}
+				if err := json.NewEncoder(w).Encode(p); err != nil {
+					t.Error(err)
+				}
+			}),
+			args: args{
+				mg: publicDelegatedPrefixObj(),
+			},
+			want: want{
+				mg: publicDelegatedPrefixObj(
+					publicDelegatedPrefixWithConditions(xpv1.Creating()),
+					publicDelegatedPrefixWithAtProvider(v1alpha1.PublicDelegatedPrefixObservation{Fingerprint: testFingerprint, Status: v1alpha1.PublicDelegatedPrefixStatusInitializing}),
+				),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := publicDelegatedPrefixExternal{
+				kube:      tc.kube,
+				projectID: projectID,
+				Service:   s,
+			}
+			obs, err := e.Observe(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPublicDelegatedPrefixUpdate(t *testing.T) {
+	type args struct {
+		mg resource.Managed
+	}
+	type want struct {
+		mg  resource.Managed
+		upd managed.ExternalUpdate
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		args    args
+		want    want
+	}{
+		"NotPublicDelegatedPrefix": {
+			handler: nil,
+			args: args{
+				mg: &v1beta1.Subnetwork{},
+			},
+			want: want{
+				mg:  &v1beta1.Subnetwork{},
+				err: errors.New(errNotPublicDelegatedPrefix),
+			},
+		},
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.Method {
+				case http.MethodGet:
+					_ = r.Body.Close()
+					w.WriteHeader(http.StatusOK)
+					p := &compute.PublicDelegatedPrefix{Description: "old desc", Fingerprint: testFingerprint}
+					if err := json.NewEncoder(w).Encode(p); err != nil {
+						t.Error(err)
+					}
+				case http.MethodPatch:
+					p := &compute.PublicDelegatedPrefix{}
+					if err := json.NewDecoder(r.Body).Decode(p); err != nil {
+						t.Error(err)
+					}
+					_ = r.Body.Close()
+					if diff := cmp.Diff(testFingerprint, p.Fingerprint); diff != "" {
+						t.Errorf("r: -want fingerprint, +got fingerprint:\n%s", diff)
+					}
+					if diff := cmp.Diff(testPublicDelegatedPrefixDesc, p.Description); diff != "" {
+						t.Errorf("r: -want description, +got description:\n%s", diff)
+					}
+					w.WriteHeader(http.StatusOK)
+					if err := json.NewEncoder(w).Encode(&compute.Operation{}); err != nil {
+						t.Error(err)
+					}
+				default:
+					_ = r.Body.Close()
+					w.WriteHeader(http.StatusBadRequest)
+				}
+			}),
+			args: args{
+				mg: publicDelegatedPrefixObj(),
+			},
+			want: want{
+				mg: publicDelegatedPrefixObj(),
+			},
+		},
+		"UpdateFails": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				switch r.Method {
+				case http.MethodGet:
+					w.WriteHeader(http.StatusOK)
+					if err := json.NewEncoder(w).Encode(&compute.PublicDelegatedPrefix{Description: "old desc"}); err != nil {
+						t.Error(err)
+					}
+				default:
+					w.WriteHeader(http.StatusBadRequest)
+					if err := json.NewEncoder(w).Encode(&compute.Operation{}); err != nil {
+						t.Error(err)
+					}
+				}
+			}),
+			args: args{
+				mg: publicDelegatedPrefixObj(),
+			},
+			want: want{
+				mg:  publicDelegatedPrefixObj(),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errPublicDelegatedPrefixUpdateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := publicDelegatedPrefixExternal{
+				projectID: projectID,
+				Service:   s,
+			}
+			upd, err := e.Update(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg); diff != "" {
+				t.Errorf("Update(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.upd, upd); diff != "" {
+				t.Errorf("Update(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
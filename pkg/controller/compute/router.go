@@ -24,7 +24,6 @@ import (
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/connection"
-	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
@@ -35,7 +34,9 @@ import (
 	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
 	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
 	"github.com/crossplane-contrib/provider-gcp/pkg/clients/router"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/setup"
 	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+	"github.com/crossplane-contrib/provider-gcp/pkg/tracing"
 )
 
 const (
@@ -52,7 +53,7 @@ const (
 
 // SetupRouter adds a controller that reconciles Router managed
 // resources.
-func SetupRouter(mgr ctrl.Manager, o controller.Options) error {
+func SetupRouter(mgr ctrl.Manager, o setup.Options) error {
 	name := managed.ControllerName(v1alpha1.RouterGroupKind)
 
 	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
@@ -60,24 +61,38 @@ func SetupRouter(mgr ctrl.Manager, o controller.Options) error {
 		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
 	}
 
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(v1alpha1.RouterGroupVersionKind),
-		managed.WithExternalConnecter(&routerConnector{kube: mgr.GetClient()}),
+		managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient()), gcp.NewLocationDefaulter(mgr.GetClient(), gcp.LocationScopeRegion, routerRegion)),
+		managed.WithExternalConnecter(setup.Connecter(mgr, o, v1alpha1.RouterGroupKind, &routerConnector{kube: mgr.GetClient(), record: recorder})),
 		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithPollInterval(o.PollInterval),
-		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithRecorder(recorder),
 		managed.WithConnectionPublishers(cps...))
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(o.ForControllerRuntime()).
 		For(&v1alpha1.Router{}).
-		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+		Complete(tracing.NewReconciler(name, ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter)))
+}
+
+// routerRegion returns the region of the supplied Router so that it can be
+// defaulted from its ProviderConfig.
+func routerRegion(mg resource.Managed) *string {
+	cr, ok := mg.(*v1alpha1.Router)
+	if !ok {
+		return nil
+	}
+	return &cr.Spec.ForProvider.Region
 }
 
 type routerConnector struct {
-	kube client.Client
+	kube   client.Client
+	record event.Recorder
 }
 
 func (c *routerConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
@@ -89,13 +104,14 @@ func (c *routerConnector) Connect(ctx context.Context, mg resource.Managed) (man
 	if err != nil {
 		return nil, errors.Wrap(err, errNewClient)
 	}
-	return &routerExternal{Service: s, kube: c.kube, projectID: projectID}, nil
+	return &routerExternal{Service: s, kube: c.kube, projectID: projectID, record: c.record}, nil
 }
 
 type routerExternal struct {
 	kube client.Client
 	*compute.Service
 	projectID string
+	record    event.Recorder
 }
 
 func (c *routerExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -139,10 +155,14 @@ func (c *routerExternal) Create(ctx context.Context, mg resource.Managed) (manag
 
 	rt := &compute.Router{}
 	router.GenerateRouter(meta.GetExternalName(cr), cr.Spec.ForProvider, rt)
-	_, err := c.Routers.Insert(c.projectID, cr.Spec.ForProvider.Region, rt).
+	op, err := c.Routers.Insert(c.projectID, cr.Spec.ForProvider.Region, rt).
 		Context(ctx).
 		Do()
-	return managed.ExternalCreation{}, errors.Wrap(err, errRouterCreateFailed)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errRouterCreateFailed)
+	}
+	gcp.RecordOperation(c.record, cr, "create", op.Name)
+	return managed.ExternalCreation{}, nil
 }
 
 func (c *routerExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
@@ -167,10 +187,14 @@ func (c *routerExternal) Update(ctx context.Context, mg resource.Managed) (manag
 	rt := &compute.Router{}
 	router.GenerateRouter(meta.GetExternalName(cr), cr.Spec.ForProvider, rt)
 
-	_, err = c.Routers.Patch(c.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr), rt).
+	op, err := c.Routers.Patch(c.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr), rt).
 		Context(ctx).
 		Do()
-	return managed.ExternalUpdate{}, errors.Wrap(err, errRouterUpdateFailed)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errRouterUpdateFailed)
+	}
+	gcp.RecordOperation(c.record, cr, "update", op.Name)
+	return managed.ExternalUpdate{}, nil
 }
 
 func (c *routerExternal) Delete(ctx context.Context, mg resource.Managed) error {
@@ -180,8 +204,12 @@ func (c *routerExternal) Delete(ctx context.Context, mg resource.Managed) error
 	}
 
 	cr.Status.SetConditions(xpv1.Deleting())
-	_, err := c.Routers.Delete(c.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr)).
+	op, err := c.Routers.Delete(c.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr)).
 		Context(ctx).
 		Do()
-	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errRouterDeleteFailed)
+	if err != nil {
+		return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errRouterDeleteFailed)
+	}
+	gcp.RecordOperation(c.record, cr, "delete", op.Name)
+	return nil
 }
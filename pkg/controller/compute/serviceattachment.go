@@ -0,0 +1,219 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/api/compute/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/serviceattachment"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/setup"
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+	"github.com/crossplane-contrib/provider-gcp/pkg/tracing"
+)
+
+const (
+	// Error strings.
+	errNotServiceAttachment           = "managed resource is not a ServiceAttachment resource"
+	errGetServiceAttachment           = "cannot get GCP ServiceAttachment"
+	errManagedServiceAttachmentUpdate = "unable to update ServiceAttachment managed resource"
+
+	errServiceAttachmentUpdateFailed  = "update of ServiceAttachment resource has failed"
+	errServiceAttachmentCreateFailed  = "creation of ServiceAttachment resource has failed"
+	errServiceAttachmentDeleteFailed  = "deletion of ServiceAttachment resource has failed"
+	errCheckServiceAttachmentUpToDate = "cannot determine if GCP ServiceAttachment is up to date"
+)
+
+// SetupServiceAttachment adds a controller that reconciles ServiceAttachment
+// managed resources.
+func SetupServiceAttachment(mgr ctrl.Manager, o setup.Options) error {
+	name := managed.ControllerName(v1alpha1.ServiceAttachmentGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
+	}
+
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.ServiceAttachmentGroupVersionKind),
+		managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient()), gcp.NewLocationDefaulter(mgr.GetClient(), gcp.LocationScopeRegion, serviceAttachmentRegion)),
+		managed.WithExternalConnecter(setup.Connecter(mgr, o, v1alpha1.ServiceAttachmentGroupKind, &serviceAttachmentConnector{kube: mgr.GetClient(), record: recorder})),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(recorder),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.ServiceAttachment{}).
+		Complete(tracing.NewReconciler(name, ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter)))
+}
+
+// serviceAttachmentRegion returns the region of the supplied
+// ServiceAttachment so that it can be defaulted from its ProviderConfig.
+func serviceAttachmentRegion(mg resource.Managed) *string {
+	cr, ok := mg.(*v1alpha1.ServiceAttachment)
+	if !ok {
+		return nil
+	}
+	return &cr.Spec.ForProvider.Region
+}
+
+type serviceAttachmentConnector struct {
+	kube   client.Client
+	record event.Recorder
+}
+
+func (c *serviceAttachmentConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetConnectionInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := compute.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &serviceAttachmentExternal{Service: s, kube: c.kube, projectID: projectID, record: c.record}, nil
+}
+
+type serviceAttachmentExternal struct {
+	kube client.Client
+	*compute.Service
+	projectID string
+	record    event.Recorder
+}
+
+func (c *serviceAttachmentExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ServiceAttachment)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotServiceAttachment)
+	}
+	observed, err := c.ServiceAttachments.Get(c.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetServiceAttachment)
+	}
+
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	serviceattachment.LateInitializeSpec(&cr.Spec.ForProvider, *observed)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
+		if err := c.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errManagedServiceAttachmentUpdate)
+		}
+	}
+
+	cr.Status.AtProvider = serviceattachment.GenerateServiceAttachmentObservation(*observed)
+
+	cr.Status.SetConditions(xpv1.Available())
+
+	u, err := serviceattachment.IsUpToDate(meta.GetExternalName(cr), &cr.Spec.ForProvider, observed)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errCheckServiceAttachmentUpToDate)
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: u,
+	}, nil
+}
+
+func (c *serviceAttachmentExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ServiceAttachment)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotServiceAttachment)
+	}
+
+	sa := &compute.ServiceAttachment{}
+	serviceattachment.GenerateServiceAttachment(meta.GetExternalName(cr), cr.Spec.ForProvider, sa)
+	op, err := c.ServiceAttachments.Insert(c.projectID, cr.Spec.ForProvider.Region, sa).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errServiceAttachmentCreateFailed)
+	}
+	gcp.RecordOperation(c.record, cr, "create", op.Name)
+	return managed.ExternalCreation{}, nil
+}
+
+func (c *serviceAttachmentExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.ServiceAttachment)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotServiceAttachment)
+	}
+
+	observed, err := c.ServiceAttachments.Get(c.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetServiceAttachment)
+	}
+
+	upToDate, err := serviceattachment.IsUpToDate(meta.GetExternalName(cr), &cr.Spec.ForProvider, observed)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errCheckServiceAttachmentUpToDate)
+	}
+	if upToDate {
+		return managed.ExternalUpdate{}, nil
+	}
+
+	// The API rejects patches that don't carry the current fingerprint.
+	sa := &compute.ServiceAttachment{Fingerprint: observed.Fingerprint}
+	serviceattachment.GenerateServiceAttachment(meta.GetExternalName(cr), cr.Spec.ForProvider, sa)
+
+	op, err := c.ServiceAttachments.Patch(c.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr), sa).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errServiceAttachmentUpdateFailed)
+	}
+	gcp.RecordOperation(c.record, cr, "update", op.Name)
+	return managed.ExternalUpdate{}, nil
+}
+
+func (c *serviceAttachmentExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.ServiceAttachment)
+	if !ok {
+		return errors.New(errNotServiceAttachment)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+	op, err := c.ServiceAttachments.Delete(c.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr)).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errServiceAttachmentDeleteFailed)
+	}
+	gcp.RecordOperation(c.record, cr, "delete", op.Name)
+	return nil
+}
@@ -26,7 +26,6 @@ import (
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/connection"
-	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	"github.com/crossplane/crossplane-runtime/pkg/errors"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
@@ -38,7 +37,10 @@ import (
 	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
 	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
 	"github.com/crossplane-contrib/provider-gcp/pkg/clients/subnetwork"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/setup"
 	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+	"github.com/crossplane-contrib/provider-gcp/pkg/replace"
+	"github.com/crossplane-contrib/provider-gcp/pkg/tracing"
 )
 
 const (
@@ -56,7 +58,7 @@ const (
 
 // SetupSubnetwork adds a controller that reconciles Subnetwork
 // managed resources.
-func SetupSubnetwork(mgr ctrl.Manager, o controller.Options) error {
+func SetupSubnetwork(mgr ctrl.Manager, o setup.Options) error {
 	name := managed.ControllerName(v1beta1.SubnetworkGroupKind)
 
 	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
@@ -64,24 +66,38 @@ func SetupSubnetwork(mgr ctrl.Manager, o controller.Options) error {
 		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
 	}
 
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(v1beta1.SubnetworkGroupVersionKind),
-		managed.WithExternalConnecter(&subnetworkConnector{kube: mgr.GetClient()}),
+		managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient()), gcp.NewLocationDefaulter(mgr.GetClient(), gcp.LocationScopeRegion, subnetworkRegion)),
+		managed.WithExternalConnecter(setup.Connecter(mgr, o, v1beta1.SubnetworkGroupKind, &subnetworkConnector{kube: mgr.GetClient(), record: recorder})),
 		managed.WithConnectionPublishers(),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
-		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithRecorder(recorder),
 		managed.WithConnectionPublishers(cps...))
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(o.ForControllerRuntime()).
 		For(&v1beta1.Subnetwork{}).
-		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+		Complete(tracing.NewReconciler(name, ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter)))
+}
+
+// subnetworkRegion returns the region of the supplied Subnetwork so that it
+// can be defaulted from its ProviderConfig.
+func subnetworkRegion(mg resource.Managed) *string {
+	cr, ok := mg.(*v1beta1.Subnetwork)
+	if !ok {
+		return nil
+	}
+	return &cr.Spec.ForProvider.Region
 }
 
 type subnetworkConnector struct {
-	kube client.Client
+	kube   client.Client
+	record event.Recorder
 }
 
 func (c *subnetworkConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
@@ -93,13 +109,14 @@ func (c *subnetworkConnector) Connect(ctx context.Context, mg resource.Managed)
 	if err != nil {
 		return nil, errors.Wrap(err, errNewClient)
 	}
-	return &subnetworkExternal{Service: s, kube: c.kube, projectID: projectID}, nil
+	return &subnetworkExternal{Service: s, kube: c.kube, projectID: projectID, record: c.record}, nil
 }
 
 type subnetworkExternal struct {
 	kube client.Client
 	*googlecompute.Service
 	projectID string
+	record    event.Recorder
 }
 
 func (c *subnetworkExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -144,10 +161,14 @@ func (c *subnetworkExternal) Create(ctx context.Context, mg resource.Managed) (m
 
 	subnet := &googlecompute.Subnetwork{}
 	subnetwork.GenerateSubnetwork(meta.GetExternalName(cr), cr.Spec.ForProvider, subnet)
-	_, err := c.Subnetworks.Insert(c.projectID, cr.Spec.ForProvider.Region, subnet).
+	op, err := c.Subnetworks.Insert(c.projectID, cr.Spec.ForProvider.Region, subnet).
 		Context(ctx).
 		Do()
-	return managed.ExternalCreation{}, errors.Wrap(err, errCreateSubnetworkFailed)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateSubnetworkFailed)
+	}
+	gcp.RecordOperation(c.record, cr, "create", op.Name)
+	return managed.ExternalCreation{}, nil
 }
 
 func (c *subnetworkExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
@@ -168,15 +189,46 @@ func (c *subnetworkExternal) Update(ctx context.Context, mg resource.Managed) (m
 	if upToDate {
 		return managed.ExternalUpdate{}, nil
 	}
+
+	changed := subnetwork.ImmutableFieldsChanged(&cr.Spec.ForProvider, observed)
+	replacing, err := replace.Check(cr, cr.Spec.UpdatePolicy, changed)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if replacing {
+		return managed.ExternalUpdate{}, replace.Delete(ctx, c.kube, c.record, cr, changed, c.Delete)
+	}
+
 	if privateAccess {
 		update := &googlecompute.SubnetworksSetPrivateIpGoogleAccessRequest{PrivateIpGoogleAccess: *cr.Spec.ForProvider.PrivateIPGoogleAccess}
-		_, err = c.Subnetworks.SetPrivateIpGoogleAccess(c.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr), update).Context(ctx).Do()
-		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateSubnetworkPAFailed)
+		op, err := c.Subnetworks.SetPrivateIpGoogleAccess(c.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr), update).Context(ctx).Do()
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateSubnetworkPAFailed)
+		}
+		gcp.RecordOperation(c.record, cr, "update", op.Name)
+		return managed.ExternalUpdate{}, nil
 	}
 
-	subnetUpdate := subnetwork.GenerateSubnetworkForUpdate(*cr, meta.GetExternalName(cr))
-	_, err = c.Subnetworks.Patch(c.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr), subnetUpdate).Context(ctx).Do()
-	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateSubnetworkFailed)
+	// The patch must carry the subnetwork's current fingerprint. The one just
+	// observed is used first, and it is read again if it turns out to be stale.
+	var op *googlecompute.Operation
+	err = gcp.RetryOnConflict(func() error {
+		if observed == nil {
+			if observed, err = c.Subnetworks.Get(c.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr)).Context(ctx).Do(); err != nil {
+				return err
+			}
+		}
+		subnetUpdate := subnetwork.GenerateSubnetworkForUpdate(*cr, meta.GetExternalName(cr))
+		subnetUpdate.Fingerprint = observed.Fingerprint
+		op, err = c.Subnetworks.Patch(c.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr), subnetUpdate).Context(ctx).Do()
+		observed = nil
+		return err
+	})
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateSubnetworkFailed)
+	}
+	gcp.RecordOperation(c.record, cr, "update", op.Name)
+	return managed.ExternalUpdate{}, nil
 }
 
 func (c *subnetworkExternal) Delete(ctx context.Context, mg resource.Managed) error {
@@ -186,6 +238,10 @@ func (c *subnetworkExternal) Delete(ctx context.Context, mg resource.Managed) er
 	}
 
 	cr.Status.SetConditions(xpv1.Deleting())
-	_, err := c.Subnetworks.Delete(c.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr)).Context(ctx).Do()
-	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteSubnetworkFailed)
+	op, err := c.Subnetworks.Delete(c.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr)).Context(ctx).Do()
+	if err != nil {
+		return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteSubnetworkFailed)
+	}
+	gcp.RecordOperation(c.record, cr, "delete", op.Name)
+	return nil
 }
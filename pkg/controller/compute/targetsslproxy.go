@@ -0,0 +1,231 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/compute/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/targetsslproxy"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/setup"
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+	"github.com/crossplane-contrib/provider-gcp/pkg/tracing"
+)
+
+const (
+	// Error strings.
+	errNotTargetSSLProxy = "managed resource is not a TargetSSLProxy resource"
+	errGetTargetSSLProxy = "cannot get GCP TargetSSLProxy"
+
+	errTargetSSLProxyCreateFailed     = "creation of TargetSSLProxy resource has failed"
+	errTargetSSLProxyDeleteFailed     = "deletion of TargetSSLProxy resource has failed"
+	errTargetSSLProxySetService       = "cannot set backend service of TargetSSLProxy"
+	errTargetSSLProxySetHeader        = "cannot set proxy header of TargetSSLProxy"
+	errTargetSSLProxySetCertificates  = "cannot set SSL certificates of TargetSSLProxy"
+	errTargetSSLProxySetPolicy        = "cannot set SSL policy of TargetSSLProxy"
+	errTargetSSLProxySetCertificateMp = "cannot set certificate map of TargetSSLProxy"
+)
+
+// SetupTargetSSLProxy adds a controller that reconciles TargetSSLProxy
+// managed resources.
+func SetupTargetSSLProxy(mgr ctrl.Manager, o setup.Options) error {
+	name := managed.ControllerName(v1alpha1.TargetSSLProxyGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
+	}
+
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.TargetSSLProxyGroupVersionKind),
+		managed.WithExternalConnecter(setup.Connecter(mgr, o, v1alpha1.TargetSSLProxyGroupKind, &targetSSLProxyConnector{kube: mgr.GetClient(), record: recorder})),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(recorder),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.TargetSSLProxy{}).
+		Complete(tracing.NewReconciler(name, ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter)))
+}
+
+type targetSSLProxyConnector struct {
+	kube   client.Client
+	record event.Recorder
+}
+
+func (c *targetSSLProxyConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetConnectionInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := compute.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &targetSSLProxyExternal{Service: s, kube: c.kube, projectID: projectID, record: c.record}, nil
+}
+
+type targetSSLProxyExternal struct {
+	kube client.Client
+	*compute.Service
+	projectID string
+	record    event.Recorder
+}
+
+func (c *targetSSLProxyExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.TargetSSLProxy)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotTargetSSLProxy)
+	}
+	observed, err := c.TargetSslProxies.Get(c.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetTargetSSLProxy)
+	}
+
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	targetsslproxy.LateInitializeSpec(&cr.Spec.ForProvider, *observed)
+
+	cr.Status.AtProvider = targetsslproxy.GenerateTargetSSLProxyObservation(*observed)
+	cr.Status.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceLateInitialized: !cmp.Equal(currentSpec, &cr.Spec.ForProvider),
+		ResourceUpToDate:        targetsslproxy.IsUpToDate(cr.Spec.ForProvider, *observed),
+	}, nil
+}
+
+func (c *targetSSLProxyExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.TargetSSLProxy)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotTargetSSLProxy)
+	}
+
+	op, err := c.TargetSslProxies.Insert(c.projectID, targetsslproxy.GenerateTargetSSLProxy(meta.GetExternalName(cr), cr.Spec.ForProvider)).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errTargetSSLProxyCreateFailed)
+	}
+	gcp.RecordOperation(c.record, cr, "create", op.Name)
+	return managed.ExternalCreation{}, nil
+}
+
+// Update applies the changes through the dedicated setter of each mutable
+// field, as target SSL proxies do not support patching.
+func (c *targetSSLProxyExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) { // nolint:gocyclo
+	cr, ok := mg.(*v1alpha1.TargetSSLProxy)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotTargetSSLProxy)
+	}
+	name := meta.GetExternalName(cr)
+	in := cr.Spec.ForProvider
+
+	observed, err := c.TargetSslProxies.Get(c.projectID, name).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetTargetSSLProxy)
+	}
+
+	var ops []*compute.Operation
+	if !targetsslproxy.IsServiceUpToDate(in, *observed) {
+		op, err := c.TargetSslProxies.SetBackendService(c.projectID, name, &compute.TargetSslProxiesSetBackendServiceRequest{
+			Service: in.Service,
+		}).Context(ctx).Do()
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errTargetSSLProxySetService)
+		}
+		ops = append(ops, op)
+	}
+	if !targetsslproxy.IsProxyHeaderUpToDate(in, *observed) {
+		op, err := c.TargetSslProxies.SetProxyHeader(c.projectID, name, &compute.TargetSslProxiesSetProxyHeaderRequest{
+			ProxyHeader: gcp.StringValue(in.ProxyHeader),
+		}).Context(ctx).Do()
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errTargetSSLProxySetHeader)
+		}
+		ops = append(ops, op)
+	}
+	if !targetsslproxy.AreSSLCertificatesUpToDate(in, *observed) {
+		op, err := c.TargetSslProxies.SetSslCertificates(c.projectID, name, &compute.TargetSslProxiesSetSslCertificatesRequest{
+			SslCertificates: in.SslCertificates,
+		}).Context(ctx).Do()
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errTargetSSLProxySetCertificates)
+		}
+		ops = append(ops, op)
+	}
+	if !targetsslproxy.IsSSLPolicyUpToDate(in, *observed) {
+		op, err := c.TargetSslProxies.SetSslPolicy(c.projectID, name, &compute.SslPolicyReference{
+			SslPolicy: gcp.StringValue(in.SslPolicy),
+		}).Context(ctx).Do()
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errTargetSSLProxySetPolicy)
+		}
+		ops = append(ops, op)
+	}
+	if !targetsslproxy.IsCertificateMapUpToDate(in, *observed) {
+		op, err := c.TargetSslProxies.SetCertificateMap(c.projectID, name, &compute.TargetSslProxiesSetCertificateMapRequest{
+			CertificateMap: gcp.StringValue(in.CertificateMap),
+		}).Context(ctx).Do()
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errTargetSSLProxySetCertificateMp)
+		}
+		ops = append(ops, op)
+	}
+	for _, op := range ops {
+		gcp.RecordOperation(c.record, cr, "update", op.Name)
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (c *targetSSLProxyExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.TargetSSLProxy)
+	if !ok {
+		return errors.New(errNotTargetSSLProxy)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+	op, err := c.TargetSslProxies.Delete(c.projectID, meta.GetExternalName(cr)).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errTargetSSLProxyDeleteFailed)
+	}
+	gcp.RecordOperation(c.record, cr, "delete", op.Name)
+	return nil
+}
@@ -0,0 +1,272 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	compute "google.golang.org/api/compute/v1"
+	"google.golang.org/api/option"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+var _ managed.ExternalConnecter = &targetSSLProxyConnector{}
+var _ managed.ExternalClient = &targetSSLProxyExternal{}
+
+const (
+	testTargetSSLProxyName = "test-ssl-proxy"
+	testSSLCertificate     = "global/sslCertificates/cert"
+)
+
+func targetSSLProxyObj(m ...func(*v1alpha1.TargetSSLProxy)) *v1alpha1.TargetSSLProxy {
+	p := &v1alpha1.TargetSSLProxy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: testTargetSSLProxyName,
+			Annotations: map[string]string{
+				meta.AnnotationKeyExternalName: testTargetSSLProxyName,
+			},
+		},
+		Spec: v1alpha1.TargetSSLProxySpec{
+			ForProvider: v1alpha1.TargetSSLProxyParameters{
+				Service:         testBackendService,
+				ProxyHeader:     gcp.StringPtr(v1alpha1.ProxyHeaderNone),
+				SslCertificates: []string{testSSLCertificate},
+			},
+		},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func TestTargetSSLProxyObserve(t *testing.T) {
+	type want struct {
+		eo  managed.ExternalObservation
+		err error
+	}
+	cases := map[string]struct {
+		handler http.Handler
+		want    want
+	}{
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+			}),
+		},
+		"GetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&compute.TargetSslProxy{})
+			}),
+			want: want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetTargetSSLProxy)},
+		},
+		"NotUpToDate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&compute.TargetSslProxy{
+					Service:         "https://www.googleapis.com/compute/v1/projects/foo/" + testBackendService,
+					ProxyHeader:     v1alpha1.ProxyHeaderNone,
+					SslCertificates: []string{"https://www.googleapis.com/compute/v1/projects/foo/global/sslCertificates/other"},
+				})
+			}),
+			want: want{eo: managed.ExternalObservation{ResourceExists: true}},
+		},
+		"UpToDate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&compute.TargetSslProxy{
+					Service:         "https://www.googleapis.com/compute/v1/projects/foo/" + testBackendService,
+					ProxyHeader:     v1alpha1.ProxyHeaderNone,
+					SslCertificates: []string{"https://www.googleapis.com/compute/v1/projects/foo/" + testSSLCertificate},
+				})
+			}),
+			want: want{eo: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+		},
+		"LateInitialized": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&compute.TargetSslProxy{
+					Service:         "https://www.googleapis.com/compute/v1/projects/foo/" + testBackendService,
+					ProxyHeader:     v1alpha1.ProxyHeaderNone,
+					SslCertificates: []string{"https://www.googleapis.com/compute/v1/projects/foo/" + testSSLCertificate},
+					SslPolicy:       "global/sslPolicies/modern",
+				})
+			}),
+			want: want{eo: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := targetSSLProxyExternal{Service: s, projectID: projectID, record: event.NewNopRecorder()}
+			got, err := e.Observe(context.Background(), targetSSLProxyObj())
+			if diff := cmp.Diff(tc.want.eo, got); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestTargetSSLProxyCreate(t *testing.T) {
+	cases := map[string]struct {
+		status int
+		err    error
+	}{
+		"Successful": {
+			status: http.StatusOK,
+		},
+		"CreateFailed": {
+			status: http.StatusBadRequest,
+			err:    errors.Wrap(gError(http.StatusBadRequest, ""), errTargetSSLProxyCreateFailed),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				p := &compute.TargetSslProxy{}
+				_ = json.NewDecoder(r.Body).Decode(p)
+				_ = r.Body.Close()
+				if diff := cmp.Diff(testTargetSSLProxyName, p.Name); diff != "" {
+					t.Errorf("r: -want name, +got name:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}))
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := targetSSLProxyExternal{Service: s, projectID: projectID, record: event.NewNopRecorder()}
+			_, err := e.Create(context.Background(), targetSSLProxyObj())
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestTargetSSLProxyUpdate(t *testing.T) {
+	cases := map[string]struct {
+		observed *compute.TargetSslProxy
+		mg       *v1alpha1.TargetSSLProxy
+		calls    []string
+	}{
+		"SetCertificatesOnly": {
+			observed: &compute.TargetSslProxy{Service: testBackendService, ProxyHeader: v1alpha1.ProxyHeaderNone, SslCertificates: []string{"global/sslCertificates/other"}},
+			mg:       targetSSLProxyObj(),
+			calls:    []string{"setSslCertificates"},
+		},
+		"SetAll": {
+			observed: &compute.TargetSslProxy{Service: "global/backendServices/other", ProxyHeader: v1alpha1.ProxyHeaderProxyV1},
+			mg: targetSSLProxyObj(func(p *v1alpha1.TargetSSLProxy) {
+				p.Spec.ForProvider.SslPolicy = gcp.StringPtr("global/sslPolicies/modern")
+				p.Spec.ForProvider.CertificateMap = gcp.StringPtr("//certificatemanager.googleapis.com/projects/foo/locations/global/certificateMaps/map")
+			}),
+			calls: []string{"setBackendService", "setProxyHeader", "setSslCertificates", "setSslPolicy", "setCertificateMap"},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var calls []string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				if r.Method == http.MethodGet {
+					_ = json.NewEncoder(w).Encode(tc.observed)
+					return
+				}
+				calls = append(calls, r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:])
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}))
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := targetSSLProxyExternal{Service: s, projectID: projectID, record: event.NewNopRecorder()}
+			if _, err := e.Update(context.Background(), tc.mg); err != nil {
+				t.Fatalf("Update(...): unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.calls, calls); diff != "" {
+				t.Errorf("Update(...): -want calls, +got calls:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestTargetSSLProxyDelete(t *testing.T) {
+	cases := map[string]struct {
+		status int
+		err    error
+	}{
+		"Successful": {
+			status: http.StatusOK,
+		},
+		"NotFound": {
+			status: http.StatusNotFound,
+		},
+		"DeleteFailed": {
+			status: http.StatusBadRequest,
+			err:    errors.Wrap(gError(http.StatusBadRequest, ""), errTargetSSLProxyDeleteFailed),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodDelete, r.Method); diff != "" {
+					t.Errorf("r: -want method, +got method:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}))
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := targetSSLProxyExternal{Service: s, projectID: projectID, record: event.NewNopRecorder()}
+			cr := targetSSLProxyObj()
+			err := e.Delete(context.Background(), cr)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(xpv1.Deleting(), cr.GetCondition(xpv1.TypeReady), test.EquateConditions()); diff != "" {
+				t.Errorf("Delete(...): -want condition, +got condition:\n%s", diff)
+			}
+		})
+	}
+}
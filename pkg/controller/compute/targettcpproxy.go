@@ -0,0 +1,197 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/compute/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/targettcpproxy"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/setup"
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+	"github.com/crossplane-contrib/provider-gcp/pkg/tracing"
+)
+
+const (
+	// Error strings.
+	errNotTargetTCPProxy = "managed resource is not a TargetTCPProxy resource"
+	errGetTargetTCPProxy = "cannot get GCP TargetTCPProxy"
+
+	errTargetTCPProxyCreateFailed = "creation of TargetTCPProxy resource has failed"
+	errTargetTCPProxyDeleteFailed = "deletion of TargetTCPProxy resource has failed"
+	errTargetTCPProxySetService   = "cannot set backend service of TargetTCPProxy"
+	errTargetTCPProxySetHeader    = "cannot set proxy header of TargetTCPProxy"
+)
+
+// SetupTargetTCPProxy adds a controller that reconciles TargetTCPProxy
+// managed resources.
+func SetupTargetTCPProxy(mgr ctrl.Manager, o setup.Options) error {
+	name := managed.ControllerName(v1alpha1.TargetTCPProxyGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
+	}
+
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.TargetTCPProxyGroupVersionKind),
+		managed.WithExternalConnecter(setup.Connecter(mgr, o, v1alpha1.TargetTCPProxyGroupKind, &targetTCPProxyConnector{kube: mgr.GetClient(), record: recorder})),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(recorder),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.TargetTCPProxy{}).
+		Complete(tracing.NewReconciler(name, ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter)))
+}
+
+type targetTCPProxyConnector struct {
+	kube   client.Client
+	record event.Recorder
+}
+
+func (c *targetTCPProxyConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetConnectionInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := compute.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &targetTCPProxyExternal{Service: s, kube: c.kube, projectID: projectID, record: c.record}, nil
+}
+
+type targetTCPProxyExternal struct {
+	kube client.Client
+	*compute.Service
+	projectID string
+	record    event.Recorder
+}
+
+func (c *targetTCPProxyExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.TargetTCPProxy)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotTargetTCPProxy)
+	}
+	observed, err := c.TargetTcpProxies.Get(c.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetTargetTCPProxy)
+	}
+
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	targettcpproxy.LateInitializeSpec(&cr.Spec.ForProvider, *observed)
+
+	cr.Status.AtProvider = targettcpproxy.GenerateTargetTCPProxyObservation(*observed)
+	cr.Status.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceLateInitialized: !cmp.Equal(currentSpec, &cr.Spec.ForProvider),
+		ResourceUpToDate:        targettcpproxy.IsUpToDate(cr.Spec.ForProvider, *observed),
+	}, nil
+}
+
+func (c *targetTCPProxyExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.TargetTCPProxy)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotTargetTCPProxy)
+	}
+
+	op, err := c.TargetTcpProxies.Insert(c.projectID, targettcpproxy.GenerateTargetTCPProxy(meta.GetExternalName(cr), cr.Spec.ForProvider)).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errTargetTCPProxyCreateFailed)
+	}
+	gcp.RecordOperation(c.record, cr, "create", op.Name)
+	return managed.ExternalCreation{}, nil
+}
+
+// Update applies the changes through the dedicated setter of each mutable
+// field, as target TCP proxies do not support patching.
+func (c *targetTCPProxyExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.TargetTCPProxy)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotTargetTCPProxy)
+	}
+	name := meta.GetExternalName(cr)
+
+	observed, err := c.TargetTcpProxies.Get(c.projectID, name).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetTargetTCPProxy)
+	}
+
+	if !targettcpproxy.IsServiceUpToDate(cr.Spec.ForProvider, *observed) {
+		op, err := c.TargetTcpProxies.SetBackendService(c.projectID, name, &compute.TargetTcpProxiesSetBackendServiceRequest{
+			Service: cr.Spec.ForProvider.Service,
+		}).Context(ctx).Do()
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errTargetTCPProxySetService)
+		}
+		gcp.RecordOperation(c.record, cr, "update", op.Name)
+	}
+
+	if !targettcpproxy.IsProxyHeaderUpToDate(cr.Spec.ForProvider, *observed) {
+		op, err := c.TargetTcpProxies.SetProxyHeader(c.projectID, name, &compute.TargetTcpProxiesSetProxyHeaderRequest{
+			ProxyHeader: gcp.StringValue(cr.Spec.ForProvider.ProxyHeader),
+		}).Context(ctx).Do()
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errTargetTCPProxySetHeader)
+		}
+		gcp.RecordOperation(c.record, cr, "update", op.Name)
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (c *targetTCPProxyExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.TargetTCPProxy)
+	if !ok {
+		return errors.New(errNotTargetTCPProxy)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+	op, err := c.TargetTcpProxies.Delete(c.projectID, meta.GetExternalName(cr)).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errTargetTCPProxyDeleteFailed)
+	}
+	gcp.RecordOperation(c.record, cr, "delete", op.Name)
+	return nil
+}
@@ -0,0 +1,174 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	compute "google.golang.org/api/compute/v1"
+	"google.golang.org/api/option"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+var _ managed.ExternalConnecter = &targetTCPProxyConnector{}
+var _ managed.ExternalClient = &targetTCPProxyExternal{}
+
+const (
+	testTargetTCPProxyName = "test-tcp-proxy"
+	testBackendService     = "global/backendServices/backend"
+)
+
+func targetTCPProxyObj(m ...func(*v1alpha1.TargetTCPProxy)) *v1alpha1.TargetTCPProxy {
+	p := &v1alpha1.TargetTCPProxy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: testTargetTCPProxyName,
+			Annotations: map[string]string{
+				meta.AnnotationKeyExternalName: testTargetTCPProxyName,
+			},
+		},
+		Spec: v1alpha1.TargetTCPProxySpec{
+			ForProvider: v1alpha1.TargetTCPProxyParameters{
+				Service:     testBackendService,
+				ProxyHeader: gcp.StringPtr(v1alpha1.ProxyHeaderNone),
+			},
+		},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func TestTargetTCPProxyObserve(t *testing.T) {
+	type want struct {
+		eo  managed.ExternalObservation
+		err error
+	}
+	cases := map[string]struct {
+		handler http.Handler
+		want    want
+	}{
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+			}),
+		},
+		"GetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&compute.TargetTcpProxy{})
+			}),
+			want: want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetTargetTCPProxy)},
+		},
+		"NotUpToDate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&compute.TargetTcpProxy{
+					Service:     "https://www.googleapis.com/compute/v1/projects/foo/global/backendServices/other",
+					ProxyHeader: v1alpha1.ProxyHeaderNone,
+				})
+			}),
+			want: want{eo: managed.ExternalObservation{ResourceExists: true}},
+		},
+		"UpToDate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&compute.TargetTcpProxy{
+					Service:     "https://www.googleapis.com/compute/v1/projects/foo/" + testBackendService,
+					ProxyHeader: v1alpha1.ProxyHeaderNone,
+				})
+			}),
+			want: want{eo: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := targetTCPProxyExternal{Service: s, projectID: projectID, record: event.NewNopRecorder()}
+			got, err := e.Observe(context.Background(), targetTCPProxyObj())
+			if diff := cmp.Diff(tc.want.eo, got); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestTargetTCPProxyUpdate(t *testing.T) {
+	cases := map[string]struct {
+		observed *compute.TargetTcpProxy
+		calls    []string
+		err      error
+	}{
+		"SetBackendServiceOnly": {
+			observed: &compute.TargetTcpProxy{Service: "global/backendServices/other", ProxyHeader: v1alpha1.ProxyHeaderNone},
+			calls:    []string{"setBackendService"},
+		},
+		"SetBoth": {
+			observed: &compute.TargetTcpProxy{Service: "global/backendServices/other", ProxyHeader: v1alpha1.ProxyHeaderProxyV1},
+			calls:    []string{"setBackendService", "setProxyHeader"},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var calls []string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				if r.Method == http.MethodGet {
+					_ = json.NewEncoder(w).Encode(tc.observed)
+					return
+				}
+				calls = append(calls, r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:])
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}))
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := targetTCPProxyExternal{Service: s, projectID: projectID, record: event.NewNopRecorder()}
+			_, err := e.Update(context.Background(), targetTCPProxyObj())
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.calls, calls); diff != "" {
+				t.Errorf("Update(...): -want calls, +got calls:\n%s", diff)
+			}
+		})
+	}
+}
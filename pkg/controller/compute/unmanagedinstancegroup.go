@@ -0,0 +1,243 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/compute/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	uig "github.com/crossplane-contrib/provider-gcp/pkg/clients/unmanagedinstancegroup"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/setup"
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+	"github.com/crossplane-contrib/provider-gcp/pkg/tracing"
+)
+
+const (
+	// Error strings.
+	errNotUnmanagedInstanceGroup          = "managed resource is not an UnmanagedInstanceGroup resource"
+	errGetUnmanagedInstanceGroup          = "cannot get GCP instance group"
+	errListUnmanagedInstanceGroupMembers  = "cannot list member instances of GCP instance group"
+	errUnmanagedInstanceGroupCreateFailed = "creation of GCP instance group has failed"
+	errUnmanagedInstanceGroupDeleteFailed = "deletion of GCP instance group has failed"
+	errSetNamedPorts                      = "cannot set named ports of GCP instance group"
+	errAddInstances                       = "cannot add member instances to GCP instance group"
+	errRemoveInstances                    = "cannot remove member instances from GCP instance group"
+)
+
+// SetupUnmanagedInstanceGroup adds a controller that reconciles
+// UnmanagedInstanceGroup managed resources.
+func SetupUnmanagedInstanceGroup(mgr ctrl.Manager, o setup.Options) error {
+	name := managed.ControllerName(v1alpha1.UnmanagedInstanceGroupGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
+	}
+
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.UnmanagedInstanceGroupGroupVersionKind),
+		managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient()), gcp.NewLocationDefaulter(mgr.GetClient(), gcp.LocationScopeZone, unmanagedInstanceGroupZone)),
+		managed.WithExternalConnecter(setup.Connecter(mgr, o, v1alpha1.UnmanagedInstanceGroupGroupKind, &unmanagedInstanceGroupConnector{kube: mgr.GetClient(), record: recorder})),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(recorder),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.UnmanagedInstanceGroup{}).
+		Complete(tracing.NewReconciler(name, ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter)))
+}
+
+// unmanagedInstanceGroupZone returns the zone of the supplied
+// UnmanagedInstanceGroup so that it can be defaulted from its ProviderConfig.
+func unmanagedInstanceGroupZone(mg resource.Managed) *string {
+	cr, ok := mg.(*v1alpha1.UnmanagedInstanceGroup)
+	if !ok {
+		return nil
+	}
+	return &cr.Spec.ForProvider.Zone
+}
+
+type unmanagedInstanceGroupConnector struct {
+	kube   client.Client
+	record event.Recorder
+}
+
+func (c *unmanagedInstanceGroupConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetConnectionInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := compute.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &unmanagedInstanceGroupExternal{Service: s, projectID: projectID, record: c.record}, nil
+}
+
+type unmanagedInstanceGroupExternal struct {
+	*compute.Service
+	projectID string
+	record    event.Recorder
+}
+
+// members returns the URLs of the member instances of the supplied group.
+func (c *unmanagedInstanceGroupExternal) members(ctx context.Context, cr *v1alpha1.UnmanagedInstanceGroup) ([]string, error) {
+	var urls []string
+	err := c.InstanceGroups.ListInstances(c.projectID, cr.Spec.ForProvider.Zone, meta.GetExternalName(cr), &compute.InstanceGroupsListInstancesRequest{InstanceState: "ALL"}).
+		Pages(ctx, func(l *compute.InstanceGroupsListInstances) error {
+			for _, i := range l.Items {
+				if i != nil {
+					urls = append(urls, i.Instance)
+				}
+			}
+			return nil
+		})
+	return urls, errors.Wrap(err, errListUnmanagedInstanceGroupMembers)
+}
+
+func (c *unmanagedInstanceGroupExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.UnmanagedInstanceGroup)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotUnmanagedInstanceGroup)
+	}
+	observed, err := c.InstanceGroups.Get(c.projectID, cr.Spec.ForProvider.Zone, meta.GetExternalName(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetUnmanagedInstanceGroup)
+	}
+	members, err := c.members(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	uig.LateInitializeSpec(&cr.Spec.ForProvider, *observed, members)
+	lateInitialized := !cmp.Equal(currentSpec, &cr.Spec.ForProvider)
+
+	cr.Status.AtProvider = uig.GenerateUnmanagedInstanceGroupObservation(*observed)
+	cr.Status.SetConditions(xpv1.Available())
+
+	add, remove := uig.DiffInstances(c.projectID, cr.Spec.ForProvider.Zone, cr.Spec.ForProvider.Instances, members)
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceLateInitialized: lateInitialized,
+		ResourceUpToDate:        len(add) == 0 && len(remove) == 0 && uig.IsNamedPortsUpToDate(cr.Spec.ForProvider.NamedPorts, observed.NamedPorts),
+	}, nil
+}
+
+func (c *unmanagedInstanceGroupExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.UnmanagedInstanceGroup)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotUnmanagedInstanceGroup)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+	op, err := c.InstanceGroups.Insert(c.projectID, cr.Spec.ForProvider.Zone, uig.GenerateInstanceGroup(meta.GetExternalName(cr), cr.Spec.ForProvider)).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errUnmanagedInstanceGroupCreateFailed)
+	}
+	gcp.RecordOperation(c.record, cr, "create", op.Name)
+	return managed.ExternalCreation{}, nil
+}
+
+// Update sets the named ports of the group and adds and removes its member
+// instances. The description and network of a group cannot be updated.
+func (c *unmanagedInstanceGroupExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.UnmanagedInstanceGroup)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotUnmanagedInstanceGroup)
+	}
+	name, zone := meta.GetExternalName(cr), cr.Spec.ForProvider.Zone
+
+	observed, err := c.InstanceGroups.Get(c.projectID, zone, name).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetUnmanagedInstanceGroup)
+	}
+	if !uig.IsNamedPortsUpToDate(cr.Spec.ForProvider.NamedPorts, observed.NamedPorts) {
+		req := &compute.InstanceGroupsSetNamedPortsRequest{
+			Fingerprint:     observed.Fingerprint,
+			NamedPorts:      uig.GenerateNamedPorts(cr.Spec.ForProvider.NamedPorts),
+			ForceSendFields: []string{"NamedPorts"},
+		}
+		op, err := c.InstanceGroups.SetNamedPorts(c.projectID, zone, name, req).Context(ctx).Do()
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errSetNamedPorts)
+		}
+		gcp.RecordOperation(c.record, cr, "update", op.Name)
+	}
+
+	members, err := c.members(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	add, remove := uig.DiffInstances(c.projectID, zone, cr.Spec.ForProvider.Instances, members)
+	if len(remove) > 0 {
+		op, err := c.InstanceGroups.RemoveInstances(c.projectID, zone, name, &compute.InstanceGroupsRemoveInstancesRequest{Instances: uig.InstanceReferences(remove)}).Context(ctx).Do()
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errRemoveInstances)
+		}
+		gcp.RecordOperation(c.record, cr, "update", op.Name)
+	}
+	if len(add) > 0 {
+		op, err := c.InstanceGroups.AddInstances(c.projectID, zone, name, &compute.InstanceGroupsAddInstancesRequest{Instances: uig.InstanceReferences(add)}).Context(ctx).Do()
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errAddInstances)
+		}
+		gcp.RecordOperation(c.record, cr, "update", op.Name)
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (c *unmanagedInstanceGroupExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.UnmanagedInstanceGroup)
+	if !ok {
+		return errors.New(errNotUnmanagedInstanceGroup)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+	op, err := c.InstanceGroups.Delete(c.projectID, cr.Spec.ForProvider.Zone, meta.GetExternalName(cr)).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errUnmanagedInstanceGroupDeleteFailed)
+	}
+	gcp.RecordOperation(c.record, cr, "delete", op.Name)
+	return nil
+}
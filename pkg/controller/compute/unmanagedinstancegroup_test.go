@@ -0,0 +1,232 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	compute "google.golang.org/api/compute/v1"
+	"google.golang.org/api/option"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+)
+
+var _ managed.ExternalConnecter = &unmanagedInstanceGroupConnector{}
+var _ managed.ExternalClient = &unmanagedInstanceGroupExternal{}
+
+const (
+	testUnmanagedInstanceGroupName = "test-instance-group"
+	testInstanceGroupZone          = "us-central1-a"
+	testInstanceURL                = "https://www.googleapis.com/compute/v1/projects/myproject-id-1234/zones/us-central1-a/instances/"
+)
+
+func unmanagedInstanceGroupObj() *v1alpha1.UnmanagedInstanceGroup {
+	return &v1alpha1.UnmanagedInstanceGroup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: testUnmanagedInstanceGroupName,
+			Annotations: map[string]string{
+				meta.AnnotationKeyExternalName: testUnmanagedInstanceGroupName,
+			},
+		},
+		Spec: v1alpha1.UnmanagedInstanceGroupSpec{
+			ForProvider: v1alpha1.UnmanagedInstanceGroupParameters{
+				Zone:       testInstanceGroupZone,
+				Instances:  []string{"vm-1", "vm-2"},
+				NamedPorts: []v1alpha1.NamedPort{{Name: "http", Port: 80}},
+			},
+		},
+	}
+}
+
+// instanceGroupHandler serves the supplied instance group and member
+// instances, and records the instance group methods that were called.
+func instanceGroupHandler(ig *compute.InstanceGroup, members []string, called map[string]interface{}) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer func() { _ = r.Body.Close() }()
+		method := path.Base(r.URL.Path)
+		switch method {
+		case testUnmanagedInstanceGroupName:
+			_ = json.NewEncoder(w).Encode(ig)
+		case "listInstances":
+			l := &compute.InstanceGroupsListInstances{}
+			for _, m := range members {
+				l.Items = append(l.Items, &compute.InstanceWithNamedPorts{Instance: testInstanceURL + m})
+			}
+			_ = json.NewEncoder(w).Encode(l)
+		default:
+			var body map[string]interface{}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			called[method] = body
+			_ = json.NewEncoder(w).Encode(&compute.Operation{})
+		}
+	})
+}
+
+func TestUnmanagedInstanceGroupObserve(t *testing.T) {
+	upToDate := &compute.InstanceGroup{
+		Name:       testUnmanagedInstanceGroupName,
+		NamedPorts: []*compute.NamedPort{{Name: "http", Port: 80}},
+	}
+	type args struct {
+		handler http.Handler
+	}
+	type want struct {
+		eo  managed.ExternalObservation
+		err error
+	}
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotFound": {
+			args: args{
+				handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					_ = r.Body.Close()
+					w.WriteHeader(http.StatusNotFound)
+				}),
+			},
+		},
+		"GetFailed": {
+			args: args{
+				handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					_ = r.Body.Close()
+					w.WriteHeader(http.StatusBadRequest)
+					_ = json.NewEncoder(w).Encode(&compute.InstanceGroup{})
+				}),
+			},
+			want: want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetUnmanagedInstanceGroup)},
+		},
+		"UpToDate": {
+			args: args{handler: instanceGroupHandler(upToDate, []string{"vm-2", "vm-1"}, nil)},
+			want: want{eo: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+		},
+		"MembersChanged": {
+			args: args{handler: instanceGroupHandler(upToDate, []string{"vm-1", "vm-3"}, nil)},
+			want: want{eo: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}},
+		},
+		"NamedPortsChanged": {
+			args: args{handler: instanceGroupHandler(&compute.InstanceGroup{
+				Name:       testUnmanagedInstanceGroupName,
+				NamedPorts: []*compute.NamedPort{{Name: "http", Port: 8080}},
+			}, []string{"vm-1", "vm-2"}, nil)},
+			want: want{eo: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.args.handler)
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := unmanagedInstanceGroupExternal{Service: s, projectID: projectID, record: event.NewNopRecorder()}
+			got, err := e.Observe(context.Background(), unmanagedInstanceGroupObj())
+			if diff := cmp.Diff(tc.want.eo, got); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUnmanagedInstanceGroupCreate(t *testing.T) {
+	want := &compute.InstanceGroup{
+		Name:       testUnmanagedInstanceGroupName,
+		NamedPorts: []*compute.NamedPort{{Name: "http", Port: 80}},
+	}
+	got := &compute.InstanceGroup{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewDecoder(r.Body).Decode(got)
+		_ = r.Body.Close()
+		_ = json.NewEncoder(w).Encode(&compute.Operation{})
+	}))
+	defer server.Close()
+	s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	e := unmanagedInstanceGroupExternal{Service: s, projectID: projectID, record: event.NewNopRecorder()}
+	if _, err := e.Create(context.Background(), unmanagedInstanceGroupObj()); err != nil {
+		t.Fatalf("Create(...): %s", err)
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Create(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestUnmanagedInstanceGroupUpdate(t *testing.T) {
+	prefix := "projects/" + projectID + "/zones/" + testInstanceGroupZone + "/instances/"
+	type args struct {
+		ig      *compute.InstanceGroup
+		members []string
+	}
+	cases := map[string]struct {
+		args args
+		want map[string]interface{}
+	}{
+		"UpToDate": {
+			args: args{
+				ig:      &compute.InstanceGroup{NamedPorts: []*compute.NamedPort{{Name: "http", Port: 80}}},
+				members: []string{"vm-1", "vm-2"},
+			},
+			want: map[string]interface{}{},
+		},
+		"Changed": {
+			args: args{
+				ig:      &compute.InstanceGroup{Fingerprint: "fp", NamedPorts: []*compute.NamedPort{{Name: "http", Port: 8080}}},
+				members: []string{"vm-1", "vm-3"},
+			},
+			want: map[string]interface{}{
+				"setNamedPorts": map[string]interface{}{
+					"fingerprint": "fp",
+					"namedPorts":  []interface{}{map[string]interface{}{"name": "http", "port": float64(80)}},
+				},
+				"removeInstances": map[string]interface{}{
+					"instances": []interface{}{map[string]interface{}{"instance": prefix + "vm-3"}},
+				},
+				"addInstances": map[string]interface{}{
+					"instances": []interface{}{map[string]interface{}{"instance": prefix + "vm-2"}},
+				},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			called := map[string]interface{}{}
+			server := httptest.NewServer(instanceGroupHandler(tc.args.ig, tc.args.members, called))
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := unmanagedInstanceGroupExternal{Service: s, projectID: projectID, record: event.NewNopRecorder()}
+			if _, err := e.Update(context.Background(), unmanagedInstanceGroupObj()); err != nil {
+				t.Fatalf("Update(...): %s", err)
+			}
+			if diff := cmp.Diff(tc.want, called); diff != "" {
+				t.Errorf("Update(...): -want calls, +got calls:\n%s", diff)
+			}
+		})
+	}
+}
@@ -17,23 +17,42 @@ limitations under the License.
 package config
 
 import (
+	"context"
+
+	toolscache "k8s.io/client-go/tools/cache"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/providerconfig"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	"github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	errGetInformer     = "cannot get ProviderConfig informer"
+	errAddEventHandler = "cannot add ProviderConfig event handler"
 )
 
 // Setup adds a controller that reconciles ProviderConfigs by accounting for
-// their current usage.
+// their current usage. The token sources cached for a ProviderConfig are
+// evicted once it is deleted.
 func Setup(mgr ctrl.Manager, o controller.Options) error {
 	name := providerconfig.ControllerName(v1beta1.ProviderConfigGroupKind)
 
+	i, err := mgr.GetCache().GetInformer(context.Background(), &v1beta1.ProviderConfig{})
+	if err != nil {
+		return errors.Wrap(err, errGetInformer)
+	}
+	if _, err := i.AddEventHandler(toolscache.ResourceEventHandlerFuncs{DeleteFunc: forgetTokenSources}); err != nil {
+		return errors.Wrap(err, errAddEventHandler)
+	}
+
 	of := resource.ProviderConfigKinds{
 		Config:    v1beta1.ProviderConfigGroupVersionKind,
 		UsageList: v1beta1.ProviderConfigUsageListGroupVersionKind,
@@ -50,3 +69,12 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 		Watches(&source.Kind{Type: &v1beta1.ProviderConfigUsage{}}, &resource.EnqueueRequestForProviderConfig{}).
 		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
 }
+
+func forgetTokenSources(obj interface{}) {
+	if d, ok := obj.(toolscache.DeletedFinalStateUnknown); ok {
+		obj = d.Obj
+	}
+	if pc, ok := obj.(*v1beta1.ProviderConfig); ok {
+		gcp.ForgetTokenSources(pc.GetUID())
+	}
+}
@@ -0,0 +1,436 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package conformance checks that the controllers of all kinds of managed
+// resource agree on how they address, adopt and delete external resources.
+// Every controller package registers a fixture for each kind it reconciles
+// and runs the suite against it, so that a kind that is added without a
+// fixture, or that behaves differently from the others, fails CI.
+package conformance
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	rfake "github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+
+	"github.com/crossplane-contrib/provider-gcp/apis"
+	"github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
+)
+
+const (
+	// ExternalName is the external name of every managed resource the suite
+	// reconciles. It is unusual enough to be found in the requests that
+	// address the external resource.
+	ExternalName = "conformance-external-name"
+
+	// ProjectID is the project of the ProviderConfig of every managed
+	// resource the suite reconciles.
+	ProjectID = "conformance-project"
+
+	name               = "conformance"
+	providerConfigName = "conformance"
+	secretNamespace    = "crossplane-system"
+	secretName         = "conformance"
+	secretKey          = "token"
+	finalizer          = "finalizer.managedresource.crossplane.io"
+)
+
+// A Fixture describes how the suite reconciles a kind of managed resource.
+type Fixture struct {
+	// Managed returns a new managed resource of the kind, with the
+	// parameters its controller needs to address the external resource
+	// set. Its name, external name and ProviderConfig are set by the suite.
+	Managed func() resource.Managed
+
+	// Connecter returns the external connecter of the kind's controller,
+	// which must read its ProviderConfig using the supplied client.
+	Connecter func(kube client.Client) managed.ExternalConnecter
+
+	// Identity, if set, explains why the kind's external resource is not
+	// addressed by its external name, e.g. because it is an entry of another
+	// resource. The suite does not look for the external name in requests.
+	Identity string
+
+	// Retained, if set, explains why the kind's external resource is kept
+	// when its managed resource is deleted, e.g. because GCP cannot delete
+	// it. The suite expects the Delete policy to behave like Orphan.
+	Retained string
+
+	// Found, if set, is the JSON the fake API serves while the external
+	// resource exists, rather than an empty object.
+	Found string
+
+	// NotFound, if set, is the JSON the fake API serves while the external
+	// resource does not exist, rather than a 404 error. It suits kinds whose
+	// external resource is an entry of a parent resource that still exists.
+	NotFound string
+
+	// Skip, if set, explains why the kind is not checked by the suite.
+	Skip string
+}
+
+// Run checks the managed resource kinds of the supplied API groups using the
+// supplied fixtures, keyed by group kind, e.g. "Address.compute.gcp.crossplane.io".
+// Every managed resource kind of the groups must have a fixture.
+func Run(t *testing.T, groups []string, fixtures map[string]Fixture) {
+	t.Helper()
+	s := runtime.NewScheme()
+	if err := apis.AddToScheme(s); err != nil {
+		t.Fatalf("cannot add APIs to scheme: %v", err)
+	}
+	if err := corev1.AddToScheme(s); err != nil {
+		t.Fatalf("cannot add core APIs to scheme: %v", err)
+	}
+
+	for _, gk := range managedKinds(s, groups) {
+		if _, ok := fixtures[gk]; !ok {
+			t.Errorf("%s: managed resource kind has no conformance fixture", gk)
+		}
+	}
+
+	for gk, f := range fixtures {
+		f := f
+		t.Run(gk, func(t *testing.T) {
+			if f.Skip != "" {
+				t.Skip(f.Skip)
+			}
+			run(t, s, f)
+		})
+	}
+}
+
+// managedKinds returns the sorted group kinds of the managed resources of the
+// supplied groups in the supplied scheme.
+func managedKinds(s *runtime.Scheme, groups []string) []string {
+	in := map[string]bool{}
+	for _, g := range groups {
+		in[g] = true
+	}
+	seen := map[string]bool{}
+	for gvk := range s.AllKnownTypes() {
+		if !in[gvk.Group] || strings.HasSuffix(gvk.Kind, "List") {
+			continue
+		}
+		o, err := s.New(gvk)
+		if err != nil {
+			continue
+		}
+		if _, ok := o.(resource.Managed); ok {
+			seen[gvk.GroupKind().String()] = true
+		}
+	}
+	gks := make([]string, 0, len(seen))
+	for gk := range seen {
+		gks = append(gks, gk)
+	}
+	sort.Strings(gks)
+	return gks
+}
+
+func run(t *testing.T, s *runtime.Scheme, f Fixture) {
+	t.Run("ObserveNotFound", func(t *testing.T) {
+		e, mg := connect(t, s, f, newAPI(t, f, false))
+		obs, err := e.Observe(context.Background(), mg)
+		if err != nil {
+			t.Fatalf("Observe(...): an external resource that is not found must not be an error: %v", err)
+		}
+		if obs.ResourceExists {
+			t.Errorf("Observe(...): an external resource that is not found must not exist")
+		}
+	})
+
+	t.Run("DeleteNotFound", func(t *testing.T) {
+		e, mg := connect(t, s, f, newAPI(t, f, false))
+		if err := e.Delete(context.Background(), mg); err != nil {
+			t.Errorf("Delete(...): deleting an external resource that is not found must not be an error: %v", err)
+		}
+	})
+
+	t.Run("AdoptExisting", func(t *testing.T) {
+		api := newAPI(t, f, true)
+		e, mg := connect(t, s, f, api)
+		obs, err := e.Observe(context.Background(), mg)
+		if err != nil {
+			t.Fatalf("Observe(...): %v", err)
+		}
+		if !obs.ResourceExists {
+			t.Errorf("Observe(...): an external resource that already exists must be adopted rather than created")
+		}
+		if got := meta.GetExternalName(mg); got != ExternalName {
+			t.Errorf("Observe(...): the external name must be kept: want %q, got %q", ExternalName, got)
+		}
+		if f.Identity != "" {
+			return
+		}
+		if !api.addressed(ExternalName) {
+			t.Errorf("Observe(...): no request addresses the external name %q: %v", ExternalName, api.requests())
+		}
+	})
+
+	t.Run("OrphanPolicy", func(t *testing.T) {
+		api := newAPI(t, f, true)
+		kube := reconcileDeleted(t, s, f, api, xpv1.DeletionOrphan)
+		if m := api.mutations(); len(m) > 0 {
+			t.Errorf("Reconcile(...): an orphaned external resource must not be changed: %v", m)
+		}
+		if !finalized(t, kube, f) {
+			t.Errorf("Reconcile(...): an orphaned managed resource must be finalized")
+		}
+	})
+
+	t.Run("DeletePolicy", func(t *testing.T) {
+		api := newAPI(t, f, true)
+		kube := reconcileDeleted(t, s, f, api, xpv1.DeletionDelete)
+		if f.Retained != "" {
+			if m := api.mutations(); len(m) > 0 {
+				t.Errorf("Reconcile(...): a retained external resource must not be changed: %v", m)
+			}
+			if !finalized(t, kube, f) {
+				t.Errorf("Reconcile(...): a managed resource whose external resource is retained must be finalized")
+			}
+			return
+		}
+		if len(api.mutations()) == 0 {
+			t.Errorf("Reconcile(...): deleting a managed resource must delete its external resource: %v", api.requests())
+		}
+		if finalized(t, kube, f) {
+			t.Errorf("Reconcile(...): a managed resource must not be finalized until its external resource is gone")
+		}
+	})
+
+	t.Run("DeletePolicyNotFound", func(t *testing.T) {
+		api := newAPI(t, f, false)
+		kube := reconcileDeleted(t, s, f, api, xpv1.DeletionDelete)
+		if m := api.mutations(); len(m) > 0 {
+			t.Errorf("Reconcile(...): an external resource that is not found must not be deleted: %v", m)
+		}
+		if !finalized(t, kube, f) {
+			t.Errorf("Reconcile(...): a managed resource whose external resource is gone must be finalized")
+		}
+	})
+}
+
+// newManaged returns a managed resource of the supplied fixture that uses the
+// suite's ProviderConfig, optionally with a deletion timestamp.
+func newManaged(f Fixture, p xpv1.DeletionPolicy, deleted bool) resource.Managed {
+	mg := f.Managed()
+	mg.SetName(name)
+	mg.SetUID(types.UID("conformance-uid"))
+	meta.SetExternalName(mg, ExternalName)
+	mg.SetProviderConfigReference(&xpv1.Reference{Name: providerConfigName})
+	mg.SetDeletionPolicy(p)
+	if deleted {
+		now := metav1.NewTime(time.Now())
+		mg.SetDeletionTimestamp(&now)
+		mg.SetFinalizers([]string{finalizer})
+	}
+	return mg
+}
+
+// newKube returns a client that serves the suite's ProviderConfig, which
+// points at the supplied fake API, and the supplied objects.
+func newKube(s *runtime.Scheme, api *api, objs ...client.Object) client.Client {
+	pc := &v1beta1.ProviderConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: providerConfigName},
+		Spec: v1beta1.ProviderConfigSpec{
+			ProjectID: ProjectID,
+			Credentials: v1beta1.ProviderCredentials{
+				Source: xpv1.CredentialsSourceSecret,
+				CommonCredentialSelectors: xpv1.CommonCredentialSelectors{
+					SecretRef: &xpv1.SecretKeySelector{
+						SecretReference: xpv1.SecretReference{Namespace: secretNamespace, Name: secretName},
+						Key:             secretKey,
+					},
+				},
+			},
+			ClientOptions: &v1beta1.ClientOptions{Endpoint: &api.server.URL},
+		},
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: secretNamespace, Name: secretName},
+		Data:       map[string][]byte{secretKey: []byte("conformance-token")},
+	}
+	return fake.NewClientBuilder().WithScheme(s).WithObjects(append([]client.Object{pc, secret}, objs...)...).Build()
+}
+
+// connect connects to the supplied fake API on behalf of a managed resource of
+// the supplied fixture, and returns the managed resource as read from the
+// client the connecter uses.
+func connect(t *testing.T, s *runtime.Scheme, f Fixture, api *api) (managed.ExternalClient, resource.Managed) {
+	t.Helper()
+	kube := newKube(s, api, newManaged(f, xpv1.DeletionDelete, false))
+	mg := f.Managed()
+	if err := kube.Get(context.Background(), types.NamespacedName{Name: name}, mg); err != nil {
+		t.Fatalf("cannot get managed resource: %v", err)
+	}
+	e, err := f.Connecter(kube).Connect(context.Background(), mg)
+	if err != nil {
+		t.Fatalf("Connect(...): %v", err)
+	}
+	return e, mg
+}
+
+// reconcileDeleted reconciles a managed resource of the supplied fixture that
+// was deleted with the supplied deletion policy, and returns the client that
+// holds it.
+func reconcileDeleted(t *testing.T, s *runtime.Scheme, f Fixture, api *api, p xpv1.DeletionPolicy) client.Client {
+	t.Helper()
+	mg := newManaged(f, p, true)
+	gvk, err := apiutil.GVKForObject(mg, s)
+	if err != nil {
+		t.Fatalf("cannot determine kind of managed resource: %v", err)
+	}
+	kube := newKube(s, api, mg)
+	r := managed.NewReconciler(&rfake.Manager{Client: kube, Scheme: s},
+		resource.ManagedKind(gvk),
+		managed.WithExternalConnecter(f.Connecter(kube)),
+		managed.WithInitializers())
+	if _, err := r.Reconcile(context.Background(), reconcile.Request{NamespacedName: types.NamespacedName{Name: mg.GetName()}}); err != nil {
+		t.Fatalf("Reconcile(...): %v", err)
+	}
+	got := f.Managed()
+	if err := kube.Get(context.Background(), types.NamespacedName{Name: mg.GetName()}, got); err == nil {
+		if c := got.GetCondition(xpv1.TypeSynced); c.Reason == xpv1.ReasonReconcileError {
+			t.Errorf("Reconcile(...): %s", c.Message)
+		}
+	}
+	return kube
+}
+
+// finalized returns true if the managed resource of the supplied fixture was
+// finalized, i.e. it is gone or has no finalizer left.
+func finalized(t *testing.T, kube client.Client, f Fixture) bool {
+	t.Helper()
+	mg := f.Managed()
+	err := kube.Get(context.Background(), types.NamespacedName{Name: name}, mg)
+	if kerrors.IsNotFound(err) {
+		return true
+	}
+	if err != nil {
+		t.Fatalf("cannot get managed resource: %v", err)
+	}
+	return !meta.FinalizerExists(mg, finalizer)
+}
+
+// An api is a fake GCP API in which every external resource either exists or
+// is not found.
+type api struct {
+	server *httptest.Server
+	exists bool
+	found  string
+	absent string
+
+	mu   sync.Mutex
+	reqs []string
+}
+
+func newAPI(t *testing.T, f Fixture, exists bool) *api {
+	a := &api{exists: exists, found: f.Found, absent: f.NotFound}
+	if a.found == "" {
+		a.found = "{}"
+	}
+	a.server = httptest.NewServer(http.HandlerFunc(a.serve))
+	t.Cleanup(a.server.Close)
+	return a
+}
+
+func (a *api) serve(w http.ResponseWriter, r *http.Request) {
+	_ = r.Body.Close()
+	a.mu.Lock()
+	a.reqs = append(a.reqs, r.Method+" "+r.URL.RequestURI())
+	a.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	switch {
+	case a.exists:
+		_, _ = w.Write([]byte(a.found))
+	case a.absent != "":
+		_, _ = w.Write([]byte(a.absent))
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"error":{"code":404,"message":"conformance: not found","status":"NOT_FOUND"}}`))
+	}
+}
+
+// requests returns the requests the API served, as "METHOD URI".
+func (a *api) requests() []string {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return append([]string{}, a.reqs...)
+}
+
+// mutations returns the requests the API served that may change an external
+// resource, i.e. all but GETs and custom methods that read, like the POST of
+// getIamPolicy.
+func (a *api) mutations() []string {
+	var m []string
+	for _, r := range a.requests() {
+		if !read(r) {
+			m = append(m, r)
+		}
+	}
+	return m
+}
+
+func read(r string) bool {
+	if strings.HasPrefix(r, http.MethodGet+" ") {
+		return true
+	}
+	path := strings.SplitN(r, "?", 2)[0]
+	i := strings.LastIndex(path, ":")
+	if i < 0 {
+		return false
+	}
+	verb := path[i+1:]
+	for _, p := range []string{"get", "list", "search", "testIamPermissions"} {
+		if strings.HasPrefix(verb, p) {
+			return true
+		}
+	}
+	return false
+}
+
+// addressed returns true if a request the API served contains the supplied
+// name in its URI.
+func (a *api) addressed(name string) bool {
+	for _, r := range a.requests() {
+		if strings.Contains(r, name) {
+			return true
+		}
+	}
+	return false
+}
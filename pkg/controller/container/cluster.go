@@ -18,16 +18,21 @@ package container
 
 import (
 	"context"
+	"fmt"
+	"strings"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
+	crm "google.golang.org/api/cloudresourcemanager/v1"
+	compute "google.golang.org/api/compute/v1"
 	container "google.golang.org/api/container/v1"
+	containerbeta "google.golang.org/api/container/v1beta1"
 	"k8s.io/client-go/tools/clientcmd"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/connection"
-	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	"github.com/crossplane/crossplane-runtime/pkg/errors"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
@@ -39,12 +44,20 @@ import (
 	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
 	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
 	gke "github.com/crossplane-contrib/provider-gcp/pkg/clients/cluster"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/orgpolicy"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/setup"
 	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+	"github.com/crossplane-contrib/provider-gcp/pkg/immutable"
+	"github.com/crossplane-contrib/provider-gcp/pkg/operation"
+	"github.com/crossplane-contrib/provider-gcp/pkg/tracing"
 )
 
 // Error strings.
 const (
 	errNewClient            = "cannot create new GKE container client"
+	errNewBetaClient        = "cannot create new GKE beta container client"
+	errBetaAPIDisabled      = "spec.forProvider sets fields that are only supported by the GKE beta API, which is not enabled"
+	errNewComputeClient     = "cannot create new Compute client"
 	errManagedUpdateFailed  = "cannot update Cluster custom resource"
 	errNotCluster           = "managed resource is not a Cluster"
 	errGetCluster           = "cannot get GKE cluster"
@@ -52,35 +65,67 @@ const (
 	errUpdateCluster        = "cannot update GKE cluster"
 	errDeleteCluster        = "cannot delete GKE cluster"
 	errCheckClusterUpToDate = "cannot determine if GKE cluster is up to date"
+	errListFirewallRules    = "cannot list firewall rules created for GKE cluster"
+	errGetServerConfig      = "cannot get GKE server config"
+	errNewOrgPolicyClient   = "cannot create new Resource Manager client"
+	errCheckLocations       = "cannot check resource locations organization policy"
+	errLocationsDeniedFmt   = "resource locations organization policy of project %s does not allow %s"
+	errGetCreateOperation   = "cannot get operation creating GKE cluster"
+	errCreateOperationFmt   = "operation %s creating GKE cluster failed: %s"
+	errClientCertificate    = "spec.forProvider.masterAuth.clientCertificateConfig.issueClientCertificate is true, but the issued client certificate is not bound to any RBAC role; see https://cloud.google.com/kubernetes-engine/docs/how-to/api-server-authentication#legacy-auth"
+
+	reasonCannotObserveVersions   event.Reason = "CannotObserveVersions"
+	reasonCannotCheckLocations    event.Reason = "CannotCheckLocations"
+	reasonCreateOperationFailed   event.Reason = "CreateOperationFailed"
+	reasonCannotPersistOperation  event.Reason = "CannotPersistOperation"
+	reasonClientCertificateIssued event.Reason = "ClientCertificateIssued"
 )
 
 // SetupCluster adds a controller that reconciles Cluster
 // managed resources.
-func SetupCluster(mgr ctrl.Manager, o controller.Options) error {
+func SetupCluster(mgr ctrl.Manager, o setup.Options) error {
 	name := managed.ControllerName(v1beta2.ClusterGroupKind)
 
-	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme()), newClusterSecretsPublisher(mgr.GetClient(), mgr.GetScheme())}
 	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
 		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
 	}
 
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(v1beta2.ClusterGroupVersionKind),
-		managed.WithExternalConnecter(&clusterConnector{kube: mgr.GetClient()}),
+		managed.WithExternalConnecter(setup.Connecter(mgr, o, v1beta2.ClusterGroupKind, &clusterConnector{kube: mgr.GetClient(), record: recorder, gce: newGCERefresher(gceRefreshInterval), versions: newServerConfigCache(serverConfigTTL), locationPreflight: o.Features.Enabled(features.EnableAlphaLocationPreflight), betaAPI: o.Features.Enabled(features.EnableBetaGKEAPI)})),
+		managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient()), gcp.NewLocationDefaulter(mgr.GetClient(), gcp.LocationScopeRegionOrZone, clusterLocation), operation.NewCreateResumer(mgr.GetClient())),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
-		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithRecorder(recorder),
 		managed.WithConnectionPublishers(cps...))
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(o.ForControllerRuntime()).
 		For(&v1beta2.Cluster{}).
-		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+		Complete(tracing.NewReconciler(name, ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter)))
+}
+
+// clusterLocation returns the location of the supplied Cluster so that it
+// can be defaulted from its ProviderConfig.
+func clusterLocation(mg resource.Managed) *string {
+	cr, ok := mg.(*v1beta2.Cluster)
+	if !ok {
+		return nil
+	}
+	return &cr.Spec.ForProvider.Location
 }
 
 type clusterConnector struct {
-	kube client.Client
+	kube              client.Client
+	record            event.Recorder
+	gce               *gceRefresher
+	versions          *serverConfigCache
+	locationPreflight bool
+	betaAPI           bool
 }
 
 func (c *clusterConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
@@ -92,13 +137,62 @@ func (c *clusterConnector) Connect(ctx context.Context, mg resource.Managed) (ma
 	if err != nil {
 		return nil, errors.Wrap(err, errNewClient)
 	}
-	return &clusterExternal{cluster: s, projectID: projectID, kube: c.kube}, errors.Wrap(err, errNewClient)
+	cs, err := compute.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewComputeClient)
+	}
+	e := &clusterExternal{cluster: s, compute: cs, projectID: projectID, kube: c.kube, record: c.record, gce: c.gce, versions: c.versions}
+	if c.locationPreflight {
+		if e.orgPolicy, err = crm.NewService(ctx, opts...); err != nil {
+			return nil, errors.Wrap(err, errNewOrgPolicyClient)
+		}
+	}
+	if c.betaAPI {
+		if e.beta, err = containerbeta.NewService(ctx, opts...); err != nil {
+			return nil, errors.Wrap(err, errNewBetaClient)
+		}
+	}
+	return e, nil
 }
 
 type clusterExternal struct {
 	kube      client.Client
 	cluster   *container.Service
+	compute   *compute.Service
 	projectID string
+	record    event.Recorder
+
+	// gce decides when the firewall rules GKE created for the cluster are
+	// observed.
+	gce *gceRefresher
+
+	// versions serves the server config the versions GKE offers are
+	// observed from.
+	versions *serverConfigCache
+
+	// orgPolicy is used to check the cluster's locations before creating it,
+	// if set.
+	orgPolicy *crm.Service
+
+	// beta is used to observe the cluster, and to create and update it when
+	// fields only supported by the GKE beta API are set, if set.
+	beta *containerbeta.Service
+}
+
+// get returns the cluster with the supplied name. If the GKE beta API is
+// enabled the cluster is read from it, and its beta representation is
+// returned too.
+func (e *clusterExternal) get(ctx context.Context, name string) (*container.Cluster, *containerbeta.Cluster, error) {
+	if e.beta == nil {
+		c, err := e.cluster.Projects.Locations.Clusters.Get(name).Context(ctx).Do()
+		return c, nil, err
+	}
+	b, err := e.beta.Projects.Locations.Clusters.Get(name).Context(ctx).Do()
+	if err != nil {
+		return nil, nil, err
+	}
+	c, err := gke.FromBeta(b)
+	return c, b, err
 }
 
 func (e *clusterExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) { // nolint:gocyclo
@@ -107,12 +201,25 @@ func (e *clusterExternal) Observe(ctx context.Context, mg resource.Managed) (man
 		return managed.ExternalObservation{}, errors.New(errNotCluster)
 	}
 
-	existing, err := e.cluster.Projects.Locations.Clusters.Get(gke.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr))).Context(ctx).Do()
+	if err := e.trackCreate(ctx, cr); err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	existing, existingBeta, err := e.get(ctx, gke.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr)))
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetCluster)
 	}
 
+	fw := cr.Status.AtProvider.FirewallRules
 	cr.Status.AtProvider = gke.GenerateObservation(*existing)
+	gke.ObserveMaintenance(&cr.Status.AtProvider, existing.MaintenancePolicy, time.Now())
+	if cr.Status.AtProvider.Status == v1beta2.ClusterStateRunning {
+		cr.Status.AtProvider.FirewallRules = fw
+		if e.gce.Due(cr.GetUID(), existing.SelfLink, time.Now()) {
+			e.observeFirewallRules(ctx, cr, *existing)
+		}
+		e.observeVersions(ctx, cr, *existing)
+	}
 	currentSpec := cr.Spec.ForProvider.DeepCopy()
 	gke.LateInitializeSpec(&cr.Spec.ForProvider, *existing)
 	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
@@ -129,19 +236,98 @@ func (e *clusterExternal) Observe(ctx context.Context, mg resource.Managed) (man
 	case v1beta2.ClusterStateUnspecified, v1beta2.ClusterStateDegraded, v1beta2.ClusterStateError:
 		cr.Status.SetConditions(xpv1.Unavailable())
 	}
+	if err := operation.Check(ctx, e.kube, cr, cr.Spec.Timeouts, clusterOperation(cr)); err != nil {
+		return managed.ExternalObservation{}, err
+	}
 
 	u, _, err := gke.IsUpToDate(meta.GetExternalName(cr), &cr.Spec.ForProvider, existing)
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(err, errCheckClusterUpToDate)
 	}
+	// Changes to immutable fields are reported as not up to date, so that
+	// Update can tell the user they cannot be applied.
+	u = u && len(gke.ImmutableFieldsChanged(&cr.Spec.ForProvider, existing)) == 0
+	if u {
+		// Fields only supported by the GKE beta API are reported as not up
+		// to date while the beta API is disabled, so that Update can tell
+		// the user to enable it.
+		u, _ = isBetaUpToDate(&cr.Spec.ForProvider, existingBeta)
+	}
 
 	return managed.ExternalObservation{
 		ResourceExists:    true,
 		ResourceUpToDate:  u,
-		ConnectionDetails: connectionDetails(existing),
+		ConnectionDetails: connectionDetails(existing, cr.Spec.Connection),
 	}, nil
 }
 
+// observeFirewallRules reports the firewall rules GKE created for the
+// supplied cluster. Listing them needs Compute Engine permissions that the
+// provider may not have been granted. That must not stop the cluster itself
+// from being reconciled, so it is only reported using a condition.
+func (e *clusterExternal) observeFirewallRules(ctx context.Context, cr *v1beta2.Cluster, existing container.Cluster) {
+	fw, err := gke.ObserveFirewallRules(ctx, e.compute, e.projectID, existing)
+	cr.Status.AtProvider.FirewallRules = fw
+	if err != nil {
+		cr.SetConditions(v1beta2.CannotObserveGCEResources(errors.Wrap(err, errListFirewallRules).Error()))
+		return
+	}
+	cr.SetConditions(v1beta2.GCEResourcesObserved())
+}
+
+// observeVersions reports the versions GKE offers on each release channel,
+// and whether the supplied cluster's version is nearing the end of its
+// support. They are only informational, so failing to get them must not stop
+// the cluster from being reconciled.
+func (e *clusterExternal) observeVersions(ctx context.Context, cr *v1beta2.Cluster, existing container.Cluster) {
+	parent := gke.GetFullyQualifiedParent(e.projectID, cr.Spec.ForProvider)
+	cfg, err := e.versions.Get(ctx, parent, func(ctx context.Context) (*container.ServerConfig, error) {
+		return e.cluster.Projects.Locations.GetServerConfig(parent).Context(ctx).Do()
+	})
+	if err != nil {
+		e.record.Event(cr, event.Warning(reasonCannotObserveVersions, errors.Wrap(err, errGetServerConfig)))
+		return
+	}
+	cr.Status.AtProvider.ReleaseChannels = gke.ObserveReleaseChannels(cfg)
+	if c, ok := gke.VersionSupport(existing, cfg); ok {
+		cr.Status.SetConditions(c)
+	}
+}
+
+// clusterOperation returns the verb of the GKE operation that is running on
+// the supplied cluster, if any.
+func clusterOperation(cr *v1beta2.Cluster) operation.Verb {
+	switch cr.Status.AtProvider.Status {
+	case v1beta2.ClusterStateProvisioning:
+		return operation.VerbCreate
+	case v1beta2.ClusterStateReconciling:
+		return operation.VerbUpdate
+	case v1beta2.ClusterStateStopping:
+		return operation.VerbDelete
+	}
+	return operation.VerbNone
+}
+
+// trackCreate forgets the persisted operation creating the supplied cluster
+// once it is done, and reports it if it failed.
+func (e *clusterExternal) trackCreate(ctx context.Context, cr *v1beta2.Cluster) error {
+	name := operation.CreateOperation(cr)
+	if name == "" {
+		return nil
+	}
+	op, err := e.cluster.Projects.Locations.Operations.Get(name).Context(ctx).Do()
+	if resource.Ignore(gcp.IsErrorNotFound, err) != nil {
+		return errors.Wrap(err, errGetCreateOperation)
+	}
+	if op != nil && op.Status != operation.StatusDone {
+		return nil
+	}
+	if op != nil && op.Error != nil {
+		e.record.Event(cr, event.Warning(reasonCreateOperationFailed, errors.Errorf(errCreateOperationFmt, name, op.Error.Message)))
+	}
+	return operation.ForgetCreateOperation(ctx, e.kube, cr)
+}
+
 func (e *clusterExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
 	cr, ok := mg.(*v1beta2.Cluster)
 	if !ok {
@@ -149,15 +335,33 @@ func (e *clusterExternal) Create(ctx context.Context, mg resource.Managed) (mana
 	}
 	cr.SetConditions(xpv1.Creating())
 
-	// Wait until creation is complete if already provisioning.
-	if cr.Status.AtProvider.Status == v1beta2.ClusterStateProvisioning {
+	// Wait until creation is complete if already provisioning, or if a
+	// create operation that was started before is still running.
+	if cr.Status.AtProvider.Status == v1beta2.ClusterStateProvisioning || operation.CreateOperation(cr) != "" {
 		return managed.ExternalCreation{}, nil
 	}
 
+	if gke.HasBetaFields(&cr.Spec.ForProvider) && e.beta == nil {
+		return managed.ExternalCreation{}, errors.New(errBetaAPIDisabled)
+	}
+
+	if gke.IssuesClientCertificate(&cr.Spec.ForProvider) {
+		e.record.Event(cr, event.Warning(reasonClientCertificateIssued, errors.New(errClientCertificate)))
+	}
+
 	// Generate GKE cluster from resource spec.
 	cluster := &container.Cluster{}
 	gke.GenerateCluster(meta.GetExternalName(cr), cr.Spec.ForProvider, cluster)
 
+	// GKE only reports a location the organization policy does not allow
+	// once it has spent a long time creating the cluster, so we check the
+	// locations up front.
+	if e.orgPolicy != nil {
+		if err := checkLocations(ctx, e.orgPolicy, e.record, cr, e.projectID, append([]string{cr.Spec.ForProvider.Location}, cr.Spec.ForProvider.Locations...)); err != nil {
+			return managed.ExternalCreation{}, err
+		}
+	}
+
 	// When autopilot is enabled, node pools cannot be specified.
 	if cluster.Autopilot == nil || !cluster.Autopilot.Enabled {
 		// Insert default node pool for bootstrapping cluster. This is required
@@ -167,12 +371,50 @@ func (e *clusterExternal) Create(ctx context.Context, mg resource.Managed) (mana
 		gke.AddNodePoolForCreate(cluster)
 	}
 
+	if gke.HasBetaFields(&cr.Spec.ForProvider) {
+		return managed.ExternalCreation{}, e.createBeta(ctx, cr, cluster)
+	}
+
 	create := &container.CreateClusterRequest{
 		Cluster: cluster,
 	}
 
-	_, err := e.cluster.Projects.Locations.Clusters.Create(gke.GetFullyQualifiedParent(e.projectID, cr.Spec.ForProvider), create).Context(ctx).Do()
-	return managed.ExternalCreation{}, errors.Wrap(err, errCreateCluster)
+	op, err := e.cluster.Projects.Locations.Clusters.Create(gke.GetFullyQualifiedParent(e.projectID, cr.Spec.ForProvider), create).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateCluster)
+	}
+	gcp.RecordOperation(e.record, cr, "create", op.Name)
+	e.persistCreateOperation(ctx, cr, op.Name)
+	return managed.ExternalCreation{}, nil
+}
+
+// persistCreateOperation persists the operation creating the supplied cluster.
+// The creation does not fail if it cannot be persisted, since the cluster is
+// being created regardless; only resuming an interrupted creation needs it.
+func (e *clusterExternal) persistCreateOperation(ctx context.Context, cr *v1beta2.Cluster, name string) {
+	if err := operation.PersistCreateOperation(ctx, e.kube, cr, gke.GetFullyQualifiedOperation(e.projectID, cr.Spec.ForProvider, name)); err != nil {
+		e.record.Event(cr, event.Warning(reasonCannotPersistOperation, err))
+	}
+}
+
+// createBeta creates the supplied cluster, extended with the fields only
+// supported by the GKE beta API, using the beta API.
+func (e *clusterExternal) createBeta(ctx context.Context, cr *v1beta2.Cluster, cluster *container.Cluster) error {
+	b, err := gke.ToBeta(cluster)
+	if err != nil {
+		return errors.Wrap(err, errCreateCluster)
+	}
+	gke.GenerateBetaCluster(cr.Spec.ForProvider, b)
+	create := &containerbeta.CreateClusterRequest{
+		Cluster: b,
+	}
+	op, err := e.beta.Projects.Locations.Clusters.Create(gke.GetFullyQualifiedParent(e.projectID, cr.Spec.ForProvider), create).Context(ctx).Do()
+	if err != nil {
+		return errors.Wrap(err, errCreateCluster)
+	}
+	gcp.RecordOperation(e.record, cr, "create", op.Name)
+	e.persistCreateOperation(ctx, cr, op.Name)
+	return nil
 }
 
 func (e *clusterExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
@@ -185,17 +427,21 @@ func (e *clusterExternal) Update(ctx context.Context, mg resource.Managed) (mana
 		return managed.ExternalUpdate{}, nil
 	}
 	// We have to get the cluster again here to determine how to update.
-	existing, err := e.cluster.Projects.Locations.Clusters.Get(gke.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr))).Context(ctx).Do()
+	existing, existingBeta, err := e.get(ctx, gke.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr)))
 	if err != nil {
 		return managed.ExternalUpdate{}, errors.Wrap(err, errGetCluster)
 	}
+	if changed := gke.ImmutableFieldsChanged(&cr.Spec.ForProvider, existing); len(changed) > 0 {
+		e.record.Event(cr, immutable.Changing(changed))
+		return managed.ExternalUpdate{}, immutable.Error(changed)
+	}
 
 	u, fn, err := gke.IsUpToDate(meta.GetExternalName(cr), &cr.Spec.ForProvider, existing)
 	if err != nil {
 		return managed.ExternalUpdate{}, errors.Wrap(err, errCheckClusterUpToDate)
 	}
 	if u {
-		return managed.ExternalUpdate{}, nil
+		return managed.ExternalUpdate{}, e.updateBeta(ctx, cr, existingBeta)
 	}
 
 	// GKE uses different update methods depending on the field that is being
@@ -203,8 +449,30 @@ func (e *clusterExternal) Update(ctx context.Context, mg resource.Managed) (mana
 	// the difference in the desired and existing spec. Only one field can be
 	// updated at a time, so if there are multiple diffs, the next one will be
 	// handled after the current one is completed.
-	_, err = fn(ctx, e.cluster, gke.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr)))
-	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateCluster)
+	op, err := fn(ctx, e.cluster, gke.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr)))
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateCluster)
+	}
+	gcp.RecordOperation(e.record, cr, "update", op.Name)
+	return managed.ExternalUpdate{}, nil
+}
+
+// updateBeta updates the fields of the cluster that are only supported by
+// the GKE beta API. Like Update, it changes one field at a time.
+func (e *clusterExternal) updateBeta(ctx context.Context, cr *v1beta2.Cluster, existing *containerbeta.Cluster) error {
+	if gke.HasBetaFields(&cr.Spec.ForProvider) && e.beta == nil {
+		return errors.New(errBetaAPIDisabled)
+	}
+	u, fn := isBetaUpToDate(&cr.Spec.ForProvider, existing)
+	if u {
+		return nil
+	}
+	op, err := fn(ctx, e.beta, gke.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr)))
+	if err != nil {
+		return errors.Wrap(err, errUpdateCluster)
+	}
+	gcp.RecordOperation(e.record, cr, "update", op.Name)
+	return nil
 }
 
 func (e *clusterExternal) Delete(ctx context.Context, mg resource.Managed) error {
@@ -213,33 +481,89 @@ func (e *clusterExternal) Delete(ctx context.Context, mg resource.Managed) error
 		return errors.New(errNotCluster)
 	}
 	cr.SetConditions(xpv1.Deleting())
+	e.gce.Forget(cr.GetUID())
 	// Wait until delete is complete if already deleting.
 	if cr.Status.AtProvider.Status == v1beta2.ClusterStateStopping {
 		return nil
 	}
 
-	_, err := e.cluster.Projects.Locations.Clusters.Delete(gke.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr))).Context(ctx).Do()
-	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteCluster)
+	op, err := e.cluster.Projects.Locations.Clusters.Delete(gke.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr))).Context(ctx).Do()
+	if err != nil {
+		return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteCluster)
+	}
+	gcp.RecordOperation(e.record, cr, "delete", op.Name)
+	return nil
 }
 
-// connectionSecret return secret object for cluster instance
-func connectionDetails(cluster *container.Cluster) managed.ConnectionDetails {
+// isBetaUpToDate returns whether the fields of the supplied cluster that are
+// only supported by the GKE beta API are up to date. Clusters that were not
+// read from the beta API are only up to date if no such field is set.
+func isBetaUpToDate(in *v1beta2.ClusterParameters, existing *containerbeta.Cluster) (bool, gke.BetaUpdateFn) {
+	if existing == nil {
+		return !gke.HasBetaFields(in), nil
+	}
+	return gke.IsBetaUpToDate(in, existing)
+}
+
+// connectionDetails returns the connection details of the supplied cluster.
+// The endpoint and kubeconfig use the endpoint type of the supplied connection
+// configuration, if any. The kubeconfig of a cluster that issued a client
+// certificate authenticates with the GKE auth plugin unless the connection
+// configuration asks for the certificate.
+func connectionDetails(cluster *container.Cluster, c *v1beta2.ClusterConnection) managed.ConnectionDetails {
 	config, err := gke.GenerateClientConfig(cluster)
 	if err != nil {
 		return nil
 	}
+	var endpointType *string
+	if c != nil {
+		endpointType = c.EndpointType
+	}
+	config.Clusters[cluster.Name].Server = fmt.Sprintf("https://%s", gke.GetEndpoint(cluster, endpointType))
+	auth := config.AuthInfos[cluster.Name]
+	if gke.UseExecAuthentication(cluster, c) {
+		config.AuthInfos[cluster.Name] = gke.ExecAuthInfo()
+	}
 	rawConfig, err := clientcmd.Write(config)
 	if err != nil {
 		return nil
 	}
 	cd := managed.ConnectionDetails{
 		xpv1.ResourceCredentialsSecretEndpointKey:   []byte(config.Clusters[cluster.Name].Server),
-		xpv1.ResourceCredentialsSecretUserKey:       []byte(config.AuthInfos[cluster.Name].Username),
-		xpv1.ResourceCredentialsSecretPasswordKey:   []byte(config.AuthInfos[cluster.Name].Password),
+		xpv1.ResourceCredentialsSecretUserKey:       []byte(auth.Username),
+		xpv1.ResourceCredentialsSecretPasswordKey:   []byte(auth.Password),
 		xpv1.ResourceCredentialsSecretCAKey:         config.Clusters[cluster.Name].CertificateAuthorityData,
-		xpv1.ResourceCredentialsSecretClientCertKey: config.AuthInfos[cluster.Name].ClientCertificateData,
-		xpv1.ResourceCredentialsSecretClientKeyKey:  config.AuthInfos[cluster.Name].ClientKeyData,
+		xpv1.ResourceCredentialsSecretClientCertKey: auth.ClientCertificateData,
+		xpv1.ResourceCredentialsSecretClientKeyKey:  auth.ClientKeyData,
 		xpv1.ResourceCredentialsSecretKubeconfigKey: rawConfig,
 	}
+	if p := cluster.PrivateClusterConfig; p != nil {
+		if p.PrivateEndpoint != "" {
+			cd[v1beta2.ClusterPrivateEndpointKey] = []byte(p.PrivateEndpoint)
+		}
+		if p.PublicEndpoint != "" {
+			cd[v1beta2.ClusterPublicEndpointKey] = []byte(p.PublicEndpoint)
+		}
+	}
 	return cd
 }
+
+// checkLocations returns an error and sets the LocationAllowed condition of
+// the supplied resource if the resource locations organization policy of the
+// supplied project does not allow any of the supplied locations. The policy
+// is advisory; a policy that cannot be read is reported but does not prevent
+// the resource from being created.
+func checkLocations(ctx context.Context, s *crm.Service, record event.Recorder, mg resource.Managed, projectID string, locations []string) error {
+	denied, err := orgpolicy.CheckLocations(ctx, s, projectID, locations...)
+	if err != nil {
+		record.Event(mg, event.Warning(reasonCannotCheckLocations, errors.Wrap(err, errCheckLocations)))
+		return nil
+	}
+	if len(denied) > 0 {
+		msg := fmt.Sprintf(errLocationsDeniedFmt, projectID, strings.Join(denied, ", "))
+		mg.SetConditions(v1beta2.LocationDenied(msg))
+		return errors.New(msg)
+	}
+	mg.SetConditions(v1beta2.LocationAllowed())
+	return nil
+}
@@ -24,10 +24,15 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
+	crm "google.golang.org/api/cloudresourcemanager/v1"
+	compute "google.golang.org/api/compute/v1"
 	container "google.golang.org/api/container/v1"
+	containerbeta "google.golang.org/api/container/v1beta1"
 	"google.golang.org/api/googleapi"
 	"google.golang.org/api/option"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -35,13 +40,18 @@ import (
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 
 	"github.com/crossplane-contrib/provider-gcp/apis/container/v1beta2"
+	gcpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
 	gke "github.com/crossplane-contrib/provider-gcp/pkg/clients/cluster"
+	"github.com/crossplane-contrib/provider-gcp/pkg/immutable"
+	"github.com/crossplane-contrib/provider-gcp/pkg/operation"
 )
 
 const (
@@ -74,10 +84,30 @@ func withProviderStatus(s string) clusterModifier {
 	return func(i *v1beta2.Cluster) { i.Status.AtProvider.Status = s }
 }
 
+func withID(id string) clusterModifier {
+	return func(i *v1beta2.Cluster) { i.Status.AtProvider.ID = id }
+}
+
 func withLocations(l []string) clusterModifier {
 	return func(i *v1beta2.Cluster) { i.Spec.ForProvider.Locations = l }
 }
 
+func withCreateOperation(op string) clusterModifier {
+	return func(i *v1beta2.Cluster) {
+		meta.AddAnnotations(i, map[string]string{operation.AnnotationKeyCreateOperation: op})
+	}
+}
+
+func withOperationStarted(v operation.Verb, t time.Time) clusterModifier {
+	return func(i *v1beta2.Cluster) {
+		meta.AddAnnotations(i, map[string]string{operation.AnnotationKeyStarted(v): t.UTC().Format(time.RFC3339)})
+	}
+}
+
+func withTimeouts(t *gcpv1beta1.Timeouts) clusterModifier {
+	return func(i *v1beta2.Cluster) { i.Spec.Timeouts = t }
+}
+
 func withUsername(u string) clusterModifier {
 	return func(i *v1beta2.Cluster) {
 		i.Spec.ForProvider.MasterAuth = &v1beta2.MasterAuth{
@@ -108,6 +138,7 @@ func cluster(im ...clusterModifier) *v1beta2.Cluster {
 }
 
 func TestObserve(t *testing.T) {
+	now := time.Now()
 	type args struct {
 		mg resource.Managed
 	}
@@ -205,7 +236,7 @@ func TestObserve(t *testing.T) {
 				}
 			}),
 			args: args{
-				mg: cluster(withUsername("admin")),
+				mg: cluster(withUsername("admin"), withOperationStarted(operation.VerbCreate, now)),
 			},
 			want: want{
 				obs: managed.ExternalObservation{
@@ -217,9 +248,34 @@ func TestObserve(t *testing.T) {
 							Username: "admin",
 							Password: "admin",
 						},
-					}),
+					}, nil),
 				},
-				mg: cluster(withUsername("admin"), withProviderStatus(v1beta2.ClusterStateProvisioning), withConditions(xpv1.Creating())),
+				mg: cluster(withUsername("admin"), withOperationStarted(operation.VerbCreate, now), withProviderStatus(v1beta2.ClusterStateProvisioning), withConditions(xpv1.Creating())),
+			},
+		},
+		"CreateTimedOut": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				c := &container.Cluster{}
+				gke.GenerateCluster(name, cluster().Spec.ForProvider, c)
+				c.Status = v1beta2.ClusterStateProvisioning
+				if err := json.NewEncoder(w).Encode(c); err != nil {
+					t.Error(err)
+				}
+			}),
+			args: args{
+				mg: cluster(
+					withTimeouts(&gcpv1beta1.Timeouts{Create: gcp.StringPtr("1h")}),
+					withOperationStarted(operation.VerbCreate, now.Add(-2*time.Hour))),
+			},
+			want: want{
+				mg: cluster(
+					withTimeouts(&gcpv1beta1.Timeouts{Create: gcp.StringPtr("1h")}),
+					withOperationStarted(operation.VerbCreate, now.Add(-2*time.Hour)),
+					withProviderStatus(v1beta2.ClusterStateProvisioning),
+					withConditions(operation.TimedOut(operation.VerbCreate, time.Hour))),
+				err: errors.New("create operation did not complete within 1h0m0s"),
 			},
 		},
 		"Unavailable": {
@@ -243,7 +299,7 @@ func TestObserve(t *testing.T) {
 				obs: managed.ExternalObservation{
 					ResourceExists:    true,
 					ResourceUpToDate:  true,
-					ConnectionDetails: connectionDetails(&container.Cluster{}),
+					ConnectionDetails: connectionDetails(&container.Cluster{}, nil),
 				},
 				mg: cluster(withProviderStatus(v1beta2.ClusterStateError), withConditions(xpv1.Unavailable())),
 			},
@@ -272,11 +328,47 @@ func TestObserve(t *testing.T) {
 				obs: managed.ExternalObservation{
 					ResourceExists:    true,
 					ResourceUpToDate:  true,
-					ConnectionDetails: connectionDetails(&container.Cluster{}),
+					ConnectionDetails: connectionDetails(&container.Cluster{}, nil),
 				},
 				mg: cluster(
 					withProviderStatus(v1beta2.ClusterStateRunning),
-					withConditions(xpv1.Available())),
+					withConditions(v1beta2.GCEResourcesObserved(), xpv1.Available())),
+			},
+		},
+		"CannotListFirewallRules": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if strings.HasSuffix(r.URL.Path, "/firewalls") {
+					w.WriteHeader(http.StatusForbidden)
+					return
+				}
+				w.WriteHeader(http.StatusOK)
+				c := &container.Cluster{}
+				gke.GenerateCluster(name, cluster().Spec.ForProvider, c)
+				c.Id = "0123456789abcdef"
+				c.Status = v1beta2.ClusterStateRunning
+				if err := json.NewEncoder(w).Encode(c); err != nil {
+					t.Error(err)
+				}
+			}),
+			kube: &test.MockClient{
+				MockGet: test.NewMockGetFn(nil),
+			},
+			args: args{
+				mg: cluster(),
+			},
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: connectionDetails(&container.Cluster{}, nil),
+				},
+				mg: cluster(
+					withProviderStatus(v1beta2.ClusterStateRunning),
+					withID("0123456789abcdef"),
+					withConditions(
+						v1beta2.CannotObserveGCEResources(errors.Wrap(&googleapi.Error{Code: http.StatusForbidden}, errListFirewallRules).Error()),
+						xpv1.Available())),
 			},
 		},
 		"BoundUnavailable": {
@@ -306,7 +398,7 @@ func TestObserve(t *testing.T) {
 				obs: managed.ExternalObservation{
 					ResourceExists:    true,
 					ResourceUpToDate:  true,
-					ConnectionDetails: connectionDetails(&container.Cluster{}),
+					ConnectionDetails: connectionDetails(&container.Cluster{}, nil),
 				},
 				mg: cluster(
 					withProviderStatus(v1beta2.ClusterStateError),
@@ -320,10 +412,12 @@ func TestObserve(t *testing.T) {
 			server := httptest.NewServer(tc.handler)
 			defer server.Close()
 			s, _ := container.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			cs, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
 			e := clusterExternal{
 				kube:      tc.kube,
 				projectID: projectID,
 				cluster:   s,
+				compute:   cs,
 			}
 			obs, err := e.Observe(context.Background(), tc.args.mg)
 			if tc.want.err != nil && err != nil {
@@ -381,15 +475,19 @@ func TestCreate(t *testing.T) {
 				}
 				w.WriteHeader(http.StatusOK)
 				_ = r.Body.Close()
-				if err := json.NewEncoder(w).Encode(&container.Operation{}); err != nil {
+				if err := json.NewEncoder(w).Encode(&container.Operation{Name: "op"}); err != nil {
 					t.Error(err)
 				}
 			}),
+			kube: &test.MockClient{
+				MockGet:    test.NewMockGetFn(nil),
+				MockUpdate: test.NewMockUpdateFn(nil),
+			},
 			args: args{
 				mg: cluster(),
 			},
 			want: want{
-				mg: cluster(withConditions(xpv1.Creating())),
+				mg: cluster(withConditions(xpv1.Creating()), withCreateOperation("projects/"+projectID+"/locations//operations/op")),
 				cre: managed.ExternalCreation{ConnectionDetails: managed.ConnectionDetails{
 					xpv1.ResourceCredentialsSecretPasswordKey: []byte(wantRandom),
 				}},
@@ -430,6 +528,21 @@ func TestCreate(t *testing.T) {
 				err: nil,
 			},
 		},
+		"SkipCreateOperationRunning": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				t.Errorf("r: unexpected %s request to %s", r.Method, r.URL.Path)
+			}),
+			args: args{
+				mg: cluster(withCreateOperation("projects/" + projectID + "/locations//operations/op")),
+			},
+			want: want{
+				mg: cluster(
+					withConditions(xpv1.Creating()),
+					withCreateOperation("projects/"+projectID+"/locations//operations/op"),
+				),
+			},
+		},
 		"AlreadyExists": {
 			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 				_ = r.Body.Close()
@@ -498,6 +611,174 @@ func TestCreate(t *testing.T) {
 	}
 }
 
+func TestCreateLocationPreflight(t *testing.T) {
+	type want struct {
+		mg      resource.Managed
+		err     error
+		created bool
+	}
+
+	policy := &crm.OrgPolicy{ListPolicy: &crm.ListPolicy{AllowedValues: []string{"in:europe-west1-locations"}}}
+
+	cases := map[string]struct {
+		policy *crm.OrgPolicy
+		mg     resource.Managed
+		want   want
+	}{
+		"LocationAllowed": {
+			policy: policy,
+			mg:     cluster(withLocations([]string{"europe-west1-b"})),
+			want: want{
+				mg:      cluster(withLocations([]string{"europe-west1-b"}), withConditions(xpv1.Creating(), v1beta2.LocationAllowed()), withCreateOperation("projects/"+projectID+"/locations//operations/op")),
+				created: true,
+			},
+		},
+		"LocationDenied": {
+			policy: policy,
+			mg:     cluster(withLocations([]string{"europe-west1-b", "us-east1-b"})),
+			want: want{
+				mg:  cluster(withLocations([]string{"europe-west1-b", "us-east1-b"}), withConditions(xpv1.Creating(), v1beta2.LocationDenied(fmt.Sprintf(errLocationsDeniedFmt, projectID, "us-east1-b")))),
+				err: errors.Errorf(errLocationsDeniedFmt, projectID, "us-east1-b"),
+			},
+		},
+		"PolicyUnreadable": {
+			mg: cluster(withLocations([]string{"us-east1-b"})),
+			want: want{
+				mg:      cluster(withLocations([]string{"us-east1-b"}), withConditions(xpv1.Creating()), withCreateOperation("projects/"+projectID+"/locations//operations/op")),
+				created: true,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			created := false
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if r.URL.Path == "/v1/projects/"+projectID+":getEffectiveOrgPolicy" {
+					if tc.policy == nil {
+						w.WriteHeader(http.StatusForbidden)
+						return
+					}
+					_ = json.NewEncoder(w).Encode(tc.policy)
+					return
+				}
+				created = true
+				_ = json.NewEncoder(w).Encode(&container.Operation{Name: "op"})
+			}))
+			defer server.Close()
+			s, _ := container.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			ps, _ := crm.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := clusterExternal{
+				kube:      &test.MockClient{MockGet: test.NewMockGetFn(nil), MockUpdate: test.NewMockUpdateFn(nil)},
+				projectID: projectID,
+				cluster:   s,
+				orgPolicy: ps,
+				record:    event.NewNopRecorder(),
+			}
+			_, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.created, created); diff != "" {
+				t.Errorf("Create(...): -want created, +got created:\n%s", diff)
+			}
+		})
+	}
+}
+
+func withClusterTelemetry(t string) clusterModifier {
+	return func(i *v1beta2.Cluster) {
+		i.Spec.ForProvider.ClusterTelemetry = &v1beta2.ClusterTelemetry{Type: t}
+	}
+}
+
+func TestCreateBetaAPI(t *testing.T) {
+	type want struct {
+		err  error
+		path string
+		body *containerbeta.Cluster
+	}
+
+	cases := map[string]struct {
+		betaAPI bool
+		mg      resource.Managed
+		want    want
+	}{
+		"GAFieldsOnly": {
+			betaAPI: true,
+			mg:      cluster(),
+			want: want{
+				path: "/v1/projects/" + projectID + "/locations//clusters",
+				body: &containerbeta.Cluster{Name: name},
+			},
+		},
+		"BetaFields": {
+			betaAPI: true,
+			mg:      cluster(withClusterTelemetry("ENABLED")),
+			want: want{
+				path: "/v1beta1/projects/" + projectID + "/locations//clusters",
+				body: &containerbeta.Cluster{
+					Name:             name,
+					ClusterTelemetry: &containerbeta.ClusterTelemetry{Type: "ENABLED"},
+				},
+			},
+		},
+		"BetaAPIDisabled": {
+			mg: cluster(withClusterTelemetry("ENABLED")),
+			want: want{
+				err: errors.New(errBetaAPIDisabled),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var path string
+			var body *containerbeta.Cluster
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				path = r.URL.Path
+				req := &containerbeta.CreateClusterRequest{}
+				if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+					t.Error(err)
+				}
+				_ = r.Body.Close()
+				body = req.Cluster
+				_ = json.NewEncoder(w).Encode(&container.Operation{})
+			}))
+			defer server.Close()
+			s, _ := container.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := clusterExternal{
+				kube:      &test.MockClient{MockGet: test.NewMockGetFn(nil), MockUpdate: test.NewMockUpdateFn(nil)},
+				projectID: projectID,
+				cluster:   s,
+				record:    event.NewNopRecorder(),
+			}
+			if tc.betaAPI {
+				e.beta, _ = containerbeta.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			}
+			_, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.path, path); diff != "" {
+				t.Errorf("Create(...): -want path, +got path:\n%s", diff)
+			}
+			// Only the fields under test are compared; the bootstrap node pool
+			// and defaults are covered by TestCreate.
+			if body != nil {
+				body = &containerbeta.Cluster{Name: body.Name, ClusterTelemetry: body.ClusterTelemetry}
+			}
+			if diff := cmp.Diff(tc.want.body, body); diff != "" {
+				t.Errorf("Create(...): -want body, +got body:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestDelete(t *testing.T) {
 	type args struct {
 		mg resource.Managed
@@ -857,6 +1138,35 @@ func TestUpdate(t *testing.T) {
 				err: errors.Wrap(gError(http.StatusBadRequest, ""), errUpdateCluster),
 			},
 		},
+		"ImmutableFieldChanged": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				switch r.Method {
+				case http.MethodGet:
+					w.WriteHeader(http.StatusOK)
+					if err := json.NewEncoder(w).Encode(&container.Cluster{}); err != nil {
+						t.Error(err)
+					}
+				default:
+					// GKE would ignore the change, so no update may be
+					// sent.
+					t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+					w.WriteHeader(http.StatusBadRequest)
+				}
+			}),
+			kube: &test.MockClient{
+				MockGet: test.NewMockGetFn(nil),
+			},
+			args: args{
+				mg: cluster(withLocations([]string{"loc-1"}), func(c *v1beta2.Cluster) {
+					c.Spec.ForProvider.ConfidentialNodes = &v1beta2.ConfidentialNodes{Enabled: true}
+					c.Spec.ForProvider.EnableTpu = gcp.BoolPtr(true)
+				}),
+			},
+			want: want{
+				err: immutable.Error([]string{"confidentialNodes.enabled", "enableTpu"}),
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -868,6 +1178,7 @@ func TestUpdate(t *testing.T) {
 				kube:      tc.kube,
 				projectID: projectID,
 				cluster:   s,
+				record:    event.NewNopRecorder(),
 			}
 			upd, err := e.Update(context.Background(), tc.args.mg)
 			if tc.want.err != nil && err != nil {
@@ -893,9 +1204,157 @@ func TestUpdate(t *testing.T) {
 	}
 }
 
+func TestUpdateBetaAPI(t *testing.T) {
+	type want struct {
+		err    error
+		update *containerbeta.ClusterUpdate
+	}
+
+	cases := map[string]struct {
+		betaAPI  bool
+		observed string
+		mg       resource.Managed
+		want     want
+	}{
+		"UpToDate": {
+			betaAPI:  true,
+			observed: "ENABLED",
+			mg:       cluster(withClusterTelemetry("ENABLED")),
+			want:     want{},
+		},
+		"NeedsUpdate": {
+			betaAPI:  true,
+			observed: "ENABLED",
+			mg:       cluster(withClusterTelemetry("DISABLED")),
+			want: want{
+				update: &containerbeta.ClusterUpdate{
+					DesiredClusterTelemetry: &containerbeta.ClusterTelemetry{Type: "DISABLED"},
+				},
+			},
+		},
+		"BetaAPIDisabled": {
+			mg: cluster(withClusterTelemetry("DISABLED")),
+			want: want{
+				err: errors.New(errBetaAPIDisabled),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var update *containerbeta.ClusterUpdate
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method == http.MethodPut {
+					req := &containerbeta.UpdateClusterRequest{}
+					if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+						t.Error(err)
+					}
+					_ = r.Body.Close()
+					update = req.Update
+					_ = json.NewEncoder(w).Encode(&containerbeta.Operation{})
+					return
+				}
+				_ = r.Body.Close()
+				c := &container.Cluster{}
+				gke.GenerateCluster(name, cluster().Spec.ForProvider, c)
+				b, err := gke.ToBeta(c)
+				if err != nil {
+					t.Error(err)
+				}
+				b.ClusterTelemetry = &containerbeta.ClusterTelemetry{Type: tc.observed}
+				_ = json.NewEncoder(w).Encode(b)
+			}))
+			defer server.Close()
+			s, _ := container.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := clusterExternal{
+				projectID: projectID,
+				cluster:   s,
+				record:    event.NewNopRecorder(),
+			}
+			if tc.betaAPI {
+				e.beta, _ = containerbeta.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			}
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.update, update); diff != "" {
+				t.Errorf("Update(...): -want update, +got update:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserveBetaAPIDisabled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.Body.Close()
+		c := &container.Cluster{}
+		gke.GenerateCluster(name, cluster().Spec.ForProvider, c)
+		c.Status = v1beta2.ClusterStateRunning
+		_ = json.NewEncoder(w).Encode(c)
+	}))
+	defer server.Close()
+	s, _ := container.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	cs, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	e := clusterExternal{
+		kube:      &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+		projectID: projectID,
+		cluster:   s,
+		compute:   cs,
+		record:    event.NewNopRecorder(),
+	}
+	obs, err := e.Observe(context.Background(), cluster(withClusterTelemetry("ENABLED")))
+	if err != nil {
+		t.Fatalf("Observe(...): %s", err)
+	}
+	if obs.ResourceUpToDate {
+		t.Errorf("Observe(...): want a cluster that sets beta-only fields to be reported as not up to date while the beta API is disabled")
+	}
+}
+
+func TestObserveVersions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.Body.Close()
+		if strings.HasSuffix(r.URL.Path, "/serverConfig") {
+			_ = json.NewEncoder(w).Encode(&container.ServerConfig{Channels: []*container.ReleaseChannelConfig{
+				{Channel: "REGULAR", DefaultVersion: "1.27.3-gke.100", ValidVersions: []string{"1.27.3-gke.100", "1.26.5-gke.1200"}},
+			}})
+			return
+		}
+		c := &container.Cluster{}
+		gke.GenerateCluster(name, cluster().Spec.ForProvider, c)
+		c.Status = v1beta2.ClusterStateRunning
+		c.CurrentMasterVersion = "1.26.5-gke.1200"
+		c.ReleaseChannel = &container.ReleaseChannel{Channel: "REGULAR"}
+		_ = json.NewEncoder(w).Encode(c)
+	}))
+	defer server.Close()
+	s, _ := container.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	cs, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	e := clusterExternal{
+		kube:      &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+		projectID: projectID,
+		cluster:   s,
+		compute:   cs,
+		record:    event.NewNopRecorder(),
+	}
+	cr := cluster()
+	if _, err := e.Observe(context.Background(), cr); err != nil {
+		t.Fatalf("Observe(...): %s", err)
+	}
+	want := []*v1beta2.ReleaseChannelStatus{{Channel: "REGULAR", DefaultVersion: "1.27.3-gke.100", ValidVersions: []string{"1.27.3-gke.100", "1.26.5-gke.1200"}}}
+	if diff := cmp.Diff(want, cr.Status.AtProvider.ReleaseChannels); diff != "" {
+		t.Errorf("Observe(...): -want release channels, +got release channels:\n%s", diff)
+	}
+	if diff := cmp.Diff(v1beta2.ReasonVersionNearingEndOfSupport, cr.GetCondition(v1beta2.TypeVersionSupported).Reason); diff != "" {
+		t.Errorf("Observe(...): -want reason, +got reason:\n%s", diff)
+	}
+}
+
 func TestConnectionDetails(t *testing.T) {
 	name := "gke-cluster"
 	endpoint := "endpoint"
+	privateEndpoint := "private-endpoint"
 	username := "username"
 	password := "password"
 	clusterCA, _ := base64.StdEncoding.DecodeString("clusterCA")
@@ -925,21 +1384,85 @@ users:
     password: password
     username: username
 `
+	execConfig :=
+		`apiVersion: v1
+clusters:
+- cluster:
+    certificate-authority-data: clusterC
+    server: https://endpoint
+  name: gke-cluster
+contexts:
+- context:
+    cluster: gke-cluster
+    user: gke-cluster
+  name: gke-cluster
+current-context: gke-cluster
+kind: Config
+preferences: {}
+users:
+- name: gke-cluster
+  user:
+    exec:
+      apiVersion: client.authentication.k8s.io/v1beta1
+      args: null
+      command: gke-gcloud-auth-plugin
+      env: null
+      installHint: Install gke-gcloud-auth-plugin for use with kubectl by following
+        https://cloud.google.com/kubernetes-engine/docs/how-to/cluster-access-for-kubectl#install_plugin
+      interactiveMode: IfAvailable
+      provideClusterInfo: true
+`
+
+	masterAuth := &container.MasterAuth{
+		Username:             username,
+		Password:             password,
+		ClusterCaCertificate: base64.StdEncoding.EncodeToString(clusterCA),
+		ClientCertificate:    base64.StdEncoding.EncodeToString(clientCert),
+		ClientKey:            base64.StdEncoding.EncodeToString(clientKey),
+	}
+
+	type args struct {
+		cluster    *container.Cluster
+		connection *v1beta2.ClusterConnection
+	}
 
 	cases := map[string]struct {
-		args *container.Cluster
+		args args
 		want managed.ConnectionDetails
 	}{
 		"Full": {
-			args: &container.Cluster{
-				Name:     name,
-				Endpoint: endpoint,
-				MasterAuth: &container.MasterAuth{
-					Username:             username,
-					Password:             password,
-					ClusterCaCertificate: base64.StdEncoding.EncodeToString(clusterCA),
-					ClientCertificate:    base64.StdEncoding.EncodeToString(clientCert),
-					ClientKey:            base64.StdEncoding.EncodeToString(clientKey),
+			args: args{
+				cluster: &container.Cluster{
+					Name:       name,
+					Endpoint:   endpoint,
+					MasterAuth: masterAuth,
+				},
+				connection: &v1beta2.ClusterConnection{KubeconfigAuthentication: gcp.StringPtr(v1beta2.KubeconfigAuthenticationClientCertificate)},
+			},
+			want: map[string][]byte{
+				xpv1.ResourceCredentialsSecretEndpointKey:   []byte(server),
+				xpv1.ResourceCredentialsSecretUserKey:       []byte(username),
+				xpv1.ResourceCredentialsSecretPasswordKey:   []byte(password),
+				xpv1.ResourceCredentialsSecretCAKey:         clusterCA,
+				xpv1.ResourceCredentialsSecretClientCertKey: clientCert,
+				xpv1.ResourceCredentialsSecretClientKeyKey:  clientKey,
+				xpv1.ResourceCredentialsSecretKubeconfigKey: []byte(rawConfig),
+			},
+		},
+		"PublicEndpoint": {
+			args: args{
+				cluster: &container.Cluster{
+					Name:       name,
+					Endpoint:   privateEndpoint,
+					MasterAuth: masterAuth,
+					PrivateClusterConfig: &container.PrivateClusterConfig{
+						PrivateEndpoint: privateEndpoint,
+						PublicEndpoint:  endpoint,
+					},
+				},
+				connection: &v1beta2.ClusterConnection{
+					EndpointType:             gcp.StringPtr(v1beta2.EndpointTypePublic),
+					KubeconfigAuthentication: gcp.StringPtr(v1beta2.KubeconfigAuthenticationClientCertificate),
 				},
 			},
 			want: map[string][]byte{
@@ -950,20 +1473,132 @@ users:
 				xpv1.ResourceCredentialsSecretClientCertKey: clientCert,
 				xpv1.ResourceCredentialsSecretClientKeyKey:  clientKey,
 				xpv1.ResourceCredentialsSecretKubeconfigKey: []byte(rawConfig),
+				v1beta2.ClusterPrivateEndpointKey:           []byte(privateEndpoint),
+				v1beta2.ClusterPublicEndpointKey:            []byte(endpoint),
+			},
+		},
+		"ExecPreferredOverClientCertificate": {
+			args: args{
+				cluster: &container.Cluster{
+					Name:       name,
+					Endpoint:   endpoint,
+					MasterAuth: masterAuth,
+				},
+			},
+			want: map[string][]byte{
+				xpv1.ResourceCredentialsSecretEndpointKey:   []byte(server),
+				xpv1.ResourceCredentialsSecretUserKey:       []byte(username),
+				xpv1.ResourceCredentialsSecretPasswordKey:   []byte(password),
+				xpv1.ResourceCredentialsSecretCAKey:         clusterCA,
+				xpv1.ResourceCredentialsSecretClientCertKey: clientCert,
+				xpv1.ResourceCredentialsSecretClientKeyKey:  clientKey,
+				xpv1.ResourceCredentialsSecretKubeconfigKey: []byte(execConfig),
 			},
 		},
 		"Empty": {
-			args: &container.Cluster{},
+			args: args{cluster: &container.Cluster{}},
 			want: nil,
 		},
 	}
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			d := connectionDetails(tc.args)
+			d := connectionDetails(tc.args.cluster, tc.args.connection)
 			if diff := cmp.Diff(tc.want, d); diff != "" {
 				t.Errorf("connectionDetails(...): -want, +got:\n%s", diff)
 			}
 		})
 	}
 }
+
+func TestTrackCreate(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		reason  string
+		handler http.Handler
+		mg      *v1beta2.Cluster
+		want    want
+	}{
+		"NoCreateOperation": {
+			reason: "Nothing should be tracked if no create operation was persisted.",
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				t.Errorf("r: unexpected request to %s", r.URL.Path)
+			}),
+			mg:   cluster(),
+			want: want{mg: cluster()},
+		},
+		"Running": {
+			reason: "A running create operation should not be forgotten.",
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if diff := cmp.Diff("/v1/op", r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = r.Body.Close()
+				_ = json.NewEncoder(w).Encode(&container.Operation{Name: "op", Status: "RUNNING"})
+			}),
+			mg:   cluster(withCreateOperation("op")),
+			want: want{mg: cluster(withCreateOperation("op"))},
+		},
+		"Done": {
+			reason: "A create operation that is done should be forgotten, whether or not it failed.",
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				_ = json.NewEncoder(w).Encode(&container.Operation{
+					Name:   "op",
+					Status: operation.StatusDone,
+					Error:  &container.Status{Message: "boom"},
+				})
+			}),
+			mg:   cluster(withCreateOperation("op")),
+			want: want{mg: cluster()},
+		},
+		"NotFound": {
+			reason: "A create operation that no longer exists should be forgotten.",
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&container.Operation{})
+			}),
+			mg:   cluster(withCreateOperation("op")),
+			want: want{mg: cluster()},
+		},
+		"GetFailed": {
+			reason: "Errors getting the create operation should be returned.",
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&container.Operation{})
+			}),
+			mg: cluster(withCreateOperation("op")),
+			want: want{
+				mg:  cluster(withCreateOperation("op")),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetCreateOperation),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := container.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := clusterExternal{
+				kube:      &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				projectID: projectID,
+				cluster:   s,
+				record:    event.NewNopRecorder(),
+			}
+			err := e.trackCreate(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ntrackCreate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg); diff != "" {
+				t.Errorf("\n%s\ntrackCreate(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package container
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/container/v1beta2"
+)
+
+const (
+	errWriteConnectionSecretFmt  = "cannot write connection secret %s/%s"
+	errListConnectionSecrets     = "cannot list connection secrets"
+	errDeleteConnectionSecretFmt = "cannot delete connection secret %s/%s"
+)
+
+// labelKeyCluster labels the Secrets written by the clusterSecretsPublisher
+// with the UID of their Cluster, so that they can be found once they are no
+// longer referenced.
+const labelKeyCluster = "container.gcp.crossplane.io/cluster-uid"
+
+// clusterSecretsPublisher writes the connection details of a Cluster to the
+// Secrets of its spec.writeConnectionSecretToRefs. The Secret of its
+// spec.writeConnectionSecretToRef is written by the standard publisher.
+type clusterSecretsPublisher struct {
+	client client.Client
+	secret resource.Applicator
+	typer  runtime.ObjectTyper
+}
+
+func newClusterSecretsPublisher(c client.Client, ot runtime.ObjectTyper) *clusterSecretsPublisher {
+	return &clusterSecretsPublisher{client: c, secret: resource.NewAPIPatchingApplicator(c), typer: ot}
+}
+
+// PublishConnection writes the supplied connection details to every Secret
+// of the Cluster's spec.writeConnectionSecretToRefs. Like the standard
+// publisher, it does not write to Secrets that another resource controls.
+// Secrets it wrote earlier that are no longer referenced are deleted.
+func (p *clusterSecretsPublisher) PublishConnection(ctx context.Context, o resource.ConnectionSecretOwner, c managed.ConnectionDetails) (bool, error) {
+	cr, ok := o.(*v1beta2.Cluster)
+	if !ok {
+		return false, nil
+	}
+	owner := meta.AsController(meta.TypedReferenceTo(cr, resource.MustGetKind(cr, p.typer)))
+	published := false
+	for _, ref := range cr.Spec.WriteConnectionSecretToRefs {
+		s := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:       ref.Namespace,
+				Name:            ref.Name,
+				Labels:          map[string]string{labelKeyCluster: string(cr.GetUID())},
+				OwnerReferences: []metav1.OwnerReference{owner},
+			},
+			Type: resource.SecretTypeConnection,
+			Data: c,
+		}
+		err := p.secret.Apply(ctx, s,
+			resource.ConnectionSecretMustBeControllableBy(cr.GetUID()),
+			resource.AllowUpdateIf(func(current, desired runtime.Object) bool {
+				cs, ds := current.(*corev1.Secret), desired.(*corev1.Secret)
+				return cs.GetLabels()[labelKeyCluster] != ds.GetLabels()[labelKeyCluster] ||
+					!cmp.Equal(cs.Data, ds.Data, cmpopts.EquateEmpty())
+			}),
+		)
+		if resource.IsNotAllowed(err) {
+			continue
+		}
+		if err != nil {
+			return published, errors.Wrapf(err, errWriteConnectionSecretFmt, ref.Namespace, ref.Name)
+		}
+		published = true
+	}
+	keep := cr.Spec.WriteConnectionSecretToRefs
+	if ref := cr.GetWriteConnectionSecretToReference(); ref != nil {
+		// The standard publisher writes this Secret.
+		keep = append([]xpv1.SecretReference{*ref}, keep...)
+	}
+	return published, p.delete(ctx, cr, keep)
+}
+
+// UnpublishConnection deletes every Secret written by PublishConnection.
+func (p *clusterSecretsPublisher) UnpublishConnection(ctx context.Context, o resource.ConnectionSecretOwner, _ managed.ConnectionDetails) error {
+	cr, ok := o.(*v1beta2.Cluster)
+	if !ok {
+		return nil
+	}
+	return p.delete(ctx, cr, nil)
+}
+
+// delete deletes the Secrets written for the supplied Cluster, except those
+// of the supplied references.
+func (p *clusterSecretsPublisher) delete(ctx context.Context, cr *v1beta2.Cluster, keep []xpv1.SecretReference) error {
+	l := &corev1.SecretList{}
+	if err := p.client.List(ctx, l, client.MatchingLabels{labelKeyCluster: string(cr.GetUID())}); err != nil {
+		return errors.Wrap(err, errListConnectionSecrets)
+	}
+	for i := range l.Items {
+		s := &l.Items[i]
+		if !metav1.IsControlledBy(s, cr) || referenced(s, keep) {
+			continue
+		}
+		if err := p.client.Delete(ctx, s); resource.IgnoreNotFound(err) != nil {
+			return errors.Wrapf(err, errDeleteConnectionSecretFmt, s.GetNamespace(), s.GetName())
+		}
+	}
+	return nil
+}
+
+func referenced(s *corev1.Secret, refs []xpv1.SecretReference) bool {
+	for _, ref := range refs {
+		if ref.Namespace == s.GetNamespace() && ref.Name == s.GetName() {
+			return true
+		}
+	}
+	return false
+}
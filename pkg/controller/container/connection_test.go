@@ -0,0 +1,187 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package container
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/container/v1beta2"
+)
+
+func TestClusterSecretsPublisher(t *testing.T) {
+	s := runtime.NewScheme()
+	_ = corev1.AddToScheme(s)
+	_ = v1beta2.SchemeBuilder.AddToScheme(s)
+
+	details := managed.ConnectionDetails{xpv1.ResourceCredentialsSecretKubeconfigKey: []byte("kubeconfig")}
+	platform := xpv1.SecretReference{Namespace: "platform", Name: "gke"}
+	tenant := xpv1.SecretReference{Namespace: "tenant", Name: "gke"}
+	other := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:       tenant.Namespace,
+			Name:            tenant.Name,
+			OwnerReferences: []metav1.OwnerReference{meta.AsController(&xpv1.TypedReference{UID: "other"})},
+		},
+		Data: map[string][]byte{"other": []byte("data")},
+	}
+	removed := xpv1.SecretReference{Namespace: "removed", Name: "gke"}
+	stale := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:       removed.Namespace,
+			Name:            removed.Name,
+			Labels:          map[string]string{labelKeyCluster: "cluster-uid"},
+			OwnerReferences: []metav1.OwnerReference{meta.AsController(&xpv1.TypedReference{UID: "cluster-uid"})},
+		},
+		Data: details,
+	}
+	foreign := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:       "foreign",
+			Name:            "gke",
+			Labels:          map[string]string{labelKeyCluster: "cluster-uid"},
+			OwnerReferences: []metav1.OwnerReference{meta.AsController(&xpv1.TypedReference{UID: "other"})},
+		},
+		Data: other.Data,
+	}
+
+	type want struct {
+		published bool
+		secrets   map[xpv1.SecretReference]map[string][]byte
+		deleted   []xpv1.SecretReference
+		err       bool
+	}
+	cases := map[string]struct {
+		refs []xpv1.SecretReference
+		objs []client.Object
+		want want
+	}{
+		"NoRefs": {},
+		"PublishesToEveryRef": {
+			refs: []xpv1.SecretReference{platform, tenant},
+			want: want{
+				published: true,
+				secrets: map[xpv1.SecretReference]map[string][]byte{
+					platform: details,
+					tenant:   details,
+				},
+			},
+		},
+		"ControlledByOther": {
+			refs: []xpv1.SecretReference{tenant},
+			objs: []client.Object{other},
+			want: want{
+				err:     true,
+				secrets: map[xpv1.SecretReference]map[string][]byte{tenant: other.Data},
+			},
+		},
+		"RemovedRefIsDeleted": {
+			refs: []xpv1.SecretReference{platform},
+			objs: []client.Object{stale, foreign},
+			want: want{
+				published: true,
+				secrets: map[xpv1.SecretReference]map[string][]byte{
+					platform: details,
+					{Namespace: foreign.Namespace, Name: foreign.Name}: foreign.Data,
+				},
+				deleted: []xpv1.SecretReference{removed},
+			},
+		},
+		"AllRefsRemoved": {
+			objs: []client.Object{stale},
+			want: want{
+				deleted: []xpv1.SecretReference{removed},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			kube := fake.NewClientBuilder().WithScheme(s).WithObjects(tc.objs...).Build()
+			cr := &v1beta2.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "cluster", UID: "cluster-uid"}}
+			cr.Spec.WriteConnectionSecretToRefs = tc.refs
+			meta.SetExternalName(cr, "cluster")
+
+			published, err := newClusterSecretsPublisher(kube, s).PublishConnection(context.Background(), cr, details)
+			if diff := cmp.Diff(tc.want.err, err != nil); diff != "" {
+				t.Errorf("PublishConnection(...): -want error, +got error:\n%s\n%v", diff, err)
+			}
+			if diff := cmp.Diff(tc.want.published, published); diff != "" {
+				t.Errorf("PublishConnection(...): -want published, +got published:\n%s", diff)
+			}
+			for ref, data := range tc.want.secrets {
+				got := &corev1.Secret{}
+				if err := kube.Get(context.Background(), types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, got); err != nil {
+					t.Fatalf("cannot get secret %s/%s: %v", ref.Namespace, ref.Name, err)
+				}
+				if diff := cmp.Diff(data, got.Data); diff != "" {
+					t.Errorf("PublishConnection(...): secret %s/%s: -want data, +got data:\n%s", ref.Namespace, ref.Name, diff)
+				}
+			}
+			for _, ref := range tc.want.deleted {
+				err := kube.Get(context.Background(), types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, &corev1.Secret{})
+				if !kerrors.IsNotFound(err) {
+					t.Errorf("PublishConnection(...): secret %s/%s: want not found, got %v", ref.Namespace, ref.Name, err)
+				}
+			}
+		})
+	}
+}
+
+func TestClusterSecretsUnpublisher(t *testing.T) {
+	s := runtime.NewScheme()
+	_ = corev1.AddToScheme(s)
+	_ = v1beta2.SchemeBuilder.AddToScheme(s)
+
+	platform := xpv1.SecretReference{Namespace: "platform", Name: "gke"}
+	tenant := xpv1.SecretReference{Namespace: "tenant", Name: "gke"}
+	controlled := func(ref xpv1.SecretReference, uid string) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:       ref.Namespace,
+				Name:            ref.Name,
+				Labels:          map[string]string{labelKeyCluster: "cluster-uid"},
+				OwnerReferences: []metav1.OwnerReference{meta.AsController(&xpv1.TypedReference{UID: types.UID(uid)})},
+			},
+		}
+	}
+	kube := fake.NewClientBuilder().WithScheme(s).WithObjects(controlled(platform, "cluster-uid"), controlled(tenant, "other")).Build()
+	cr := &v1beta2.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "cluster", UID: "cluster-uid"}}
+	cr.Spec.WriteConnectionSecretToRefs = []xpv1.SecretReference{platform, tenant}
+
+	if err := newClusterSecretsPublisher(kube, s).UnpublishConnection(context.Background(), cr, nil); err != nil {
+		t.Fatalf("UnpublishConnection(...): %v", err)
+	}
+	if err := kube.Get(context.Background(), types.NamespacedName{Namespace: platform.Namespace, Name: platform.Name}, &corev1.Secret{}); !kerrors.IsNotFound(err) {
+		t.Errorf("UnpublishConnection(...): secret %s/%s: want not found, got %v", platform.Namespace, platform.Name, err)
+	}
+	if err := kube.Get(context.Background(), types.NamespacedName{Namespace: tenant.Namespace, Name: tenant.Name}, &corev1.Secret{}); err != nil {
+		t.Errorf("UnpublishConnection(...): secret %s/%s controlled by another resource should not be deleted: %v", tenant.Namespace, tenant.Name, err)
+	}
+}
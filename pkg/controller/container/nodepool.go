@@ -18,15 +18,23 @@ package container
 
 import (
 	"context"
+	"fmt"
+	"strings"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
+	crm "google.golang.org/api/cloudresourcemanager/v1"
+	compute "google.golang.org/api/compute/v1"
 	container "google.golang.org/api/container/v1"
+	containerbeta "google.golang.org/api/container/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/connection"
-	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	"github.com/crossplane/crossplane-runtime/pkg/errors"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
@@ -35,10 +43,15 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	"github.com/crossplane-contrib/provider-gcp/apis/container/v1beta1"
+	"github.com/crossplane-contrib/provider-gcp/apis/container/v1beta2"
 	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
 	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
 	np "github.com/crossplane-contrib/provider-gcp/pkg/clients/nodepool"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/setup"
 	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+	"github.com/crossplane-contrib/provider-gcp/pkg/immutable"
+	"github.com/crossplane-contrib/provider-gcp/pkg/operation"
+	"github.com/crossplane-contrib/provider-gcp/pkg/tracing"
 )
 
 // Error strings.
@@ -50,11 +63,20 @@ const (
 	errUpdateNodePool              = "cannot update GKE node pool"
 	errDeleteNodePool              = "cannot delete GKE node pool"
 	errCheckNodePoolUpToDate       = "cannot determine if GKE node pool is up to date"
+	errGetInstanceGroups           = "cannot get managed instance groups of GKE node pool"
+	errCheckQuota                  = "cannot check regional quota for GKE node pool"
+	errInsufficientQuota           = "insufficient regional quota to create GKE node pool"
+	errDrainNodePool               = "cannot drain GKE node pool"
+	errDrainTimedOut               = "timed out draining GKE node pool, pods not evicted"
+	errGetReferencedCluster        = "cannot get Cluster referenced by NodePool"
+
+	reasonDrainTimedOut event.Reason = "DrainTimedOut"
+	reasonScaled        event.Reason = "ScaledNodePool"
 )
 
 // SetupNodePool adds a controller that reconciles NodePool managed
 // resources.
-func SetupNodePool(mgr ctrl.Manager, o controller.Options) error {
+func SetupNodePool(mgr ctrl.Manager, o setup.Options) error {
 	name := managed.ControllerName(v1beta1.NodePoolGroupKind)
 
 	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
@@ -62,23 +84,30 @@ func SetupNodePool(mgr ctrl.Manager, o controller.Options) error {
 		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
 	}
 
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(v1beta1.NodePoolGroupVersionKind),
-		managed.WithExternalConnecter(&nodePoolConnector{kube: mgr.GetClient()}),
+		managed.WithExternalConnecter(setup.Connecter(mgr, o, v1beta1.NodePoolGroupKind, &nodePoolConnector{kube: mgr.GetClient(), record: recorder, gce: newGCERefresher(gceRefreshInterval), quotaPreflight: o.Features.Enabled(features.EnableAlphaQuotaPreflight), locationPreflight: o.Features.Enabled(features.EnableAlphaLocationPreflight), betaAPI: o.Features.Enabled(features.EnableBetaGKEAPI)})),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
-		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithRecorder(recorder),
 		managed.WithConnectionPublishers(cps...))
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(o.ForControllerRuntime()).
 		For(&v1beta1.NodePool{}).
-		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+		Complete(tracing.NewReconciler(name, ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter)))
 }
 
 type nodePoolConnector struct {
-	kube client.Client
+	kube              client.Client
+	record            event.Recorder
+	gce               *gceRefresher
+	quotaPreflight    bool
+	locationPreflight bool
+	betaAPI           bool
 }
 
 func (c *nodePoolConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
@@ -90,13 +119,68 @@ func (c *nodePoolConnector) Connect(ctx context.Context, mg resource.Managed) (m
 	if err != nil {
 		return nil, errors.Wrap(err, errNewClient)
 	}
-	return &nodePoolExternal{container: s, projectID: projectID, kube: c.kube}, errors.Wrap(err, errNewClient)
+	cs, err := compute.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewComputeClient)
+	}
+	e := &nodePoolExternal{container: s, compute: cs, projectID: projectID, kube: c.kube, record: c.record, gce: c.gce, quotaPreflight: c.quotaPreflight}
+	e.newKubeClient = func(ctx context.Context, c *container.Cluster) (kubernetes.Interface, error) {
+		return np.NewKubeClient(ctx, c, opts...)
+	}
+	if c.locationPreflight {
+		if e.orgPolicy, err = crm.NewService(ctx, opts...); err != nil {
+			return nil, errors.Wrap(err, errNewOrgPolicyClient)
+		}
+	}
+	if c.betaAPI {
+		if e.beta, err = containerbeta.NewService(ctx, opts...); err != nil {
+			return nil, errors.Wrap(err, errNewBetaClient)
+		}
+	}
+	return e, nil
 }
 
 type nodePoolExternal struct {
 	kube      client.Client
 	container *container.Service
+	compute   *compute.Service
 	projectID string
+	record    event.Recorder
+
+	// gce decides when the managed instance groups GKE created for the node
+	// pool are observed.
+	gce *gceRefresher
+
+	// quotaPreflight checks regional quota before creating a node pool.
+	quotaPreflight bool
+
+	// orgPolicy is used to check the node pool's locations before creating
+	// it, if set.
+	orgPolicy *crm.Service
+
+	// beta is used to observe node pools, and to create node pools that set
+	// fields only supported by the GKE beta API, if set.
+	beta *containerbeta.Service
+
+	// newKubeClient returns a client for the cluster of a node pool that is
+	// drained before it is deleted.
+	newKubeClient func(ctx context.Context, c *container.Cluster) (kubernetes.Interface, error)
+}
+
+// get returns the node pool with the supplied name. If the GKE beta API is
+// enabled the node pool is read from it, and its beta representation is
+// returned too.
+func (e *nodePoolExternal) get(ctx context.Context, name string) (*container.NodePool, *containerbeta.NodePool, error) {
+	if e.beta == nil {
+		p, err := e.container.Projects.Locations.Clusters.NodePools.Get(name).Context(ctx).Do()
+		return p, nil, err
+	}
+	b, err := e.beta.Projects.Locations.Clusters.NodePools.Get(name).Context(ctx).Do()
+	if err != nil {
+		return nil, nil, err
+	}
+	p, err := np.FromBeta(b)
+	return p, b, err
 }
 
 func (e *nodePoolExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) { // nolint:gocyclo
@@ -105,12 +189,21 @@ func (e *nodePoolExternal) Observe(ctx context.Context, mg resource.Managed) (ma
 		return managed.ExternalObservation{}, errors.New(errNotNodePool)
 	}
 
-	existing, err := e.container.Projects.Locations.Clusters.NodePools.Get(np.GetFullyQualifiedName(cr.Spec.ForProvider, meta.GetExternalName(cr))).Context(ctx).Do()
+	existing, existingBeta, err := e.get(ctx, np.GetFullyQualifiedName(cr.Spec.ForProvider, meta.GetExternalName(cr)))
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetNodePool)
 	}
 
+	last := cr.Status.AtProvider
 	cr.Status.AtProvider = np.GenerateObservation(*existing)
+	cr.Status.AtProvider.Scaling = last.Scaling
+	cr.Status.AtProvider.LocalStorage = np.GenerateLocalStorageStatus(*existing, existingBeta)
+	if cr.Status.AtProvider.Status == v1beta1.NodePoolStateRunning {
+		cr.Status.AtProvider.InstanceTemplates = last.InstanceTemplates
+		if e.gce.Due(cr.GetUID(), strings.Join(existing.InstanceGroupUrls, ","), time.Now()) {
+			e.observeInstanceGroups(ctx, cr, existing.InstanceGroupUrls)
+		}
+	}
 	currentSpec := cr.Spec.ForProvider.DeepCopy()
 	np.LateInitializeSpec(&cr.Spec.ForProvider, *existing)
 	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
@@ -127,11 +220,17 @@ func (e *nodePoolExternal) Observe(ctx context.Context, mg resource.Managed) (ma
 	case v1beta1.NodePoolStateUnspecified, v1beta1.NodePoolStateRunningError, v1beta1.NodePoolStateError:
 		cr.Status.SetConditions(xpv1.Unavailable())
 	}
+	if err := operation.Check(ctx, e.kube, cr, cr.Spec.Timeouts, nodePoolOperation(cr)); err != nil {
+		return managed.ExternalObservation{}, err
+	}
 
 	u, _, err := np.IsUpToDate(meta.GetExternalName(cr), &cr.Spec.ForProvider, existing)
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(err, errCheckNodePoolUpToDate)
 	}
+	// Changes to immutable fields are reported as not up to date, so that
+	// Update can tell the user they cannot be applied.
+	u = u && len(np.ImmutableFieldsChanged(&cr.Spec.ForProvider, existing)) == 0
 
 	return managed.ExternalObservation{
 		ResourceExists:   true,
@@ -139,6 +238,43 @@ func (e *nodePoolExternal) Observe(ctx context.Context, mg resource.Managed) (ma
 	}, nil
 }
 
+// observeInstanceGroups reports the instance templates and scaling of the
+// supplied managed instance groups that GKE created for the supplied node
+// pool. Reading them needs Compute Engine permissions that the provider may
+// not have been granted. That must not stop the node pool itself from being
+// reconciled, so it is only reported using a condition.
+func (e *nodePoolExternal) observeInstanceGroups(ctx context.Context, cr *v1beta1.NodePool, urls []string) {
+	last := cr.Status.AtProvider.Scaling
+	ig, err := np.ObserveInstanceGroups(ctx, e.compute, urls)
+	cr.Status.AtProvider.InstanceTemplates = ig.InstanceTemplates
+	if err != nil {
+		cr.SetConditions(v1beta1.CannotObserveGCEResources(errors.Wrap(err, errGetInstanceGroups).Error()))
+		return
+	}
+	cr.SetConditions(v1beta1.GCEResourcesObserved())
+	if len(urls) == 0 {
+		return
+	}
+	cr.Status.AtProvider.Scaling = np.GenerateScalingStatus(last, ig, metav1.Now())
+	if last != nil && last.TargetNodeCount != ig.TargetSize {
+		e.record.Event(cr, event.Normal(reasonScaled, fmt.Sprintf("Target node count changed from %d to %d", last.TargetNodeCount, ig.TargetSize)))
+	}
+}
+
+// nodePoolOperation returns the verb of the GKE operation that is running on
+// the supplied node pool, if any.
+func nodePoolOperation(cr *v1beta1.NodePool) operation.Verb {
+	switch cr.Status.AtProvider.Status {
+	case v1beta1.NodePoolStateProvisioning:
+		return operation.VerbCreate
+	case v1beta1.NodePoolStateReconciling:
+		return operation.VerbUpdate
+	case v1beta1.NodePoolStateStopping:
+		return operation.VerbDelete
+	}
+	return operation.VerbNone
+}
+
 func (e *nodePoolExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
 	cr, ok := mg.(*v1beta1.NodePool)
 	if !ok {
@@ -151,16 +287,92 @@ func (e *nodePoolExternal) Create(ctx context.Context, mg resource.Managed) (man
 		return managed.ExternalCreation{}, nil
 	}
 
+	if np.HasBetaFields(&cr.Spec.ForProvider) && e.beta == nil {
+		return managed.ExternalCreation{}, errors.New(errBetaAPIDisabled)
+	}
+
+	if err := e.inheritUpgradeSettings(ctx, cr); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
 	// Generate GKE node pool from resource spec.
 	pool := &container.NodePool{}
 	np.GenerateNodePool(meta.GetExternalName(cr), cr.Spec.ForProvider, pool)
 
+	if e.orgPolicy != nil && len(pool.Locations) > 0 {
+		if err := checkLocations(ctx, e.orgPolicy, e.record, cr, np.ParseProject(cr.Spec.ForProvider.Cluster), pool.Locations); err != nil {
+			return managed.ExternalCreation{}, err
+		}
+	}
+
+	// GKE only reports exhausted quota once it is part way through creating
+	// the nodes, so we check it up front and wait until there is enough.
+	if e.quotaPreflight {
+		cluster, err := e.container.Projects.Locations.Clusters.Get(cr.Spec.ForProvider.Cluster).Context(ctx).Do()
+		if err != nil {
+			return managed.ExternalCreation{}, errors.Wrap(err, errGetCluster)
+		}
+		short, err := np.CheckQuota(ctx, e.compute, cr.Spec.ForProvider.Cluster, cluster, pool)
+		if err != nil {
+			return managed.ExternalCreation{}, errors.Wrap(err, errCheckQuota)
+		}
+		if len(short) > 0 {
+			msg := strings.Join(short, "; ")
+			cr.SetConditions(v1beta1.InsufficientQuota(msg))
+			return managed.ExternalCreation{}, errors.Errorf("%s: %s", errInsufficientQuota, msg)
+		}
+		cr.SetConditions(v1beta1.QuotaSufficient())
+	}
+
+	if np.HasBetaFields(&cr.Spec.ForProvider) {
+		return managed.ExternalCreation{}, e.createBeta(ctx, cr, pool)
+	}
+
 	create := &container.CreateNodePoolRequest{
 		NodePool: pool,
 	}
 
-	_, err := e.container.Projects.Locations.Clusters.NodePools.Create(cr.Spec.ForProvider.Cluster, create).Context(ctx).Do()
-	return managed.ExternalCreation{}, errors.Wrap(err, errCreateNodePool)
+	op, err := e.container.Projects.Locations.Clusters.NodePools.Create(cr.Spec.ForProvider.Cluster, create).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateNodePool)
+	}
+	gcp.RecordOperation(e.record, cr, "create", op.Name)
+	return managed.ExternalCreation{}, nil
+}
+
+// inheritUpgradeSettings sets the upgrade settings of a node pool that doesn't
+// specify any to the default upgrade settings of the Cluster it references.
+// They are late initialized once the node pool exists, so later changes to the
+// defaults don't affect it.
+func (e *nodePoolExternal) inheritUpgradeSettings(ctx context.Context, cr *v1beta1.NodePool) error {
+	if cr.Spec.ForProvider.UpgradeSettings != nil || cr.Spec.ForProvider.ClusterRef == nil {
+		return nil
+	}
+	c := &v1beta2.Cluster{}
+	if err := e.kube.Get(ctx, types.NamespacedName{Name: cr.Spec.ForProvider.ClusterRef.Name}, c); err != nil {
+		return errors.Wrap(err, errGetReferencedCluster)
+	}
+	cr.Spec.ForProvider.UpgradeSettings = c.Spec.DefaultUpgradeSettings.DeepCopy()
+	return nil
+}
+
+// createBeta creates the supplied node pool, extended with the fields only
+// supported by the GKE beta API, using the beta API.
+func (e *nodePoolExternal) createBeta(ctx context.Context, cr *v1beta1.NodePool, pool *container.NodePool) error {
+	b, err := np.ToBeta(pool)
+	if err != nil {
+		return errors.Wrap(err, errCreateNodePool)
+	}
+	np.GenerateBetaNodePool(cr.Spec.ForProvider, b)
+	create := &containerbeta.CreateNodePoolRequest{
+		NodePool: b,
+	}
+	op, err := e.beta.Projects.Locations.Clusters.NodePools.Create(cr.Spec.ForProvider.Cluster, create).Context(ctx).Do()
+	if err != nil {
+		return errors.Wrap(err, errCreateNodePool)
+	}
+	gcp.RecordOperation(e.record, cr, "create", op.Name)
+	return nil
 }
 
 func (e *nodePoolExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
@@ -179,6 +391,10 @@ func (e *nodePoolExternal) Update(ctx context.Context, mg resource.Managed) (man
 	if err != nil {
 		return managed.ExternalUpdate{}, errors.Wrap(err, errGetNodePool)
 	}
+	if changed := np.ImmutableFieldsChanged(&cr.Spec.ForProvider, existing); len(changed) > 0 {
+		e.record.Event(cr, immutable.Changing(changed))
+		return managed.ExternalUpdate{}, immutable.Error(changed)
+	}
 
 	u, fn, err := np.IsUpToDate(meta.GetExternalName(cr), &cr.Spec.ForProvider, existing)
 	if err != nil {
@@ -193,8 +409,12 @@ func (e *nodePoolExternal) Update(ctx context.Context, mg resource.Managed) (man
 	// the difference in the desired and existing spec. If it is a specialized
 	// update, only one can be performed at a time. If it is not, then updates
 	// can be mass applied.
-	_, err = fn(ctx, e.container, np.GetFullyQualifiedName(cr.Spec.ForProvider, meta.GetExternalName(cr)))
-	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateNodePool)
+	op, err := fn(ctx, e.container, np.GetFullyQualifiedName(cr.Spec.ForProvider, meta.GetExternalName(cr)))
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateNodePool)
+	}
+	gcp.RecordOperation(e.record, cr, "update", op.Name)
+	return managed.ExternalUpdate{}, nil
 }
 
 func (e *nodePoolExternal) Delete(ctx context.Context, mg resource.Managed) error {
@@ -203,11 +423,76 @@ func (e *nodePoolExternal) Delete(ctx context.Context, mg resource.Managed) erro
 		return errors.New(errNotNodePool)
 	}
 	cr.SetConditions(xpv1.Deleting())
+	e.gce.Forget(cr.GetUID())
 	// Wait until deletion is complete if already stopping.
 	if cr.Status.AtProvider.Status == v1beta1.NodePoolStateStopping {
 		return nil
 	}
 
-	_, err := e.container.Projects.Locations.Clusters.NodePools.Delete(np.GetFullyQualifiedName(cr.Spec.ForProvider, meta.GetExternalName(cr))).Context(ctx).Do()
-	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteNodePool)
+	if cr.Spec.ForProvider.DrainBeforeDelete != nil {
+		drained, err := e.drain(ctx, cr)
+		if err != nil || !drained {
+			return err
+		}
+	}
+
+	op, err := e.container.Projects.Locations.Clusters.NodePools.Delete(np.GetFullyQualifiedName(cr.Spec.ForProvider, meta.GetExternalName(cr))).Context(ctx).Do()
+	if err != nil {
+		return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteNodePool)
+	}
+	gcp.RecordOperation(e.record, cr, "delete", op.Name)
+	return nil
+}
+
+// drain cordons the nodes of the supplied node pool and evicts their pods. It
+// returns true once the node pool is drained, or once the drain timeout has
+// elapsed, at which point the node pool may be deleted. The Drained condition
+// records when draining started so that the timeout spans reconciles.
+func (e *nodePoolExternal) drain(ctx context.Context, cr *v1beta1.NodePool) (bool, error) {
+	c := cr.GetCondition(v1beta1.TypeDrained)
+	switch c.Reason {
+	case v1beta1.ReasonDrained, v1beta1.ReasonDrainTimeout:
+		return true, nil
+	case v1beta1.ReasonDraining:
+	default:
+		cr.SetConditions(v1beta1.Draining())
+		c = cr.GetCondition(v1beta1.TypeDrained)
+	}
+	timedOut := time.Since(c.LastTransitionTime.Time) >= np.DrainTimeout(cr.Spec.ForProvider.DrainBeforeDelete)
+
+	remaining, err := e.drainNodes(ctx, cr)
+	switch {
+	case gcp.IsErrorNotFound(err):
+		// There are no nodes left to drain if the cluster is gone.
+		cr.SetConditions(v1beta1.Drained())
+		return true, nil
+	case err != nil && !timedOut:
+		return false, errors.Wrap(err, errDrainNodePool)
+	case err != nil:
+		cr.SetConditions(v1beta1.DrainTimedOut(err.Error()))
+		e.record.Event(cr, event.Warning(reasonDrainTimedOut, errors.Wrap(err, errDrainNodePool)))
+		return true, nil
+	case len(remaining) == 0:
+		cr.SetConditions(v1beta1.Drained())
+		return true, nil
+	case !timedOut:
+		return false, nil
+	}
+
+	msg := strings.Join(remaining, ", ")
+	cr.SetConditions(v1beta1.DrainTimedOut(msg))
+	e.record.Event(cr, event.Warning(reasonDrainTimedOut, errors.Errorf("%s: %s", errDrainTimedOut, msg)))
+	return true, nil
+}
+
+func (e *nodePoolExternal) drainNodes(ctx context.Context, cr *v1beta1.NodePool) ([]string, error) {
+	cluster, err := e.container.Projects.Locations.Clusters.Get(cr.Spec.ForProvider.Cluster).Context(ctx).Do()
+	if err != nil {
+		return nil, err
+	}
+	kube, err := e.newKubeClient(ctx, cluster)
+	if err != nil {
+		return nil, err
+	}
+	return np.Drain(ctx, kube, meta.GetExternalName(cr), cr.Spec.ForProvider.DrainBeforeDelete.GracePeriodSeconds)
 }
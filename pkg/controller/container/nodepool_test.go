@@ -23,22 +23,33 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
+	compute "google.golang.org/api/compute/v1"
 	container "google.golang.org/api/container/v1"
 	"google.golang.org/api/option"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 
 	"github.com/crossplane-contrib/provider-gcp/apis/container/v1beta1"
+	"github.com/crossplane-contrib/provider-gcp/apis/container/v1beta2"
+	gcpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
 	np "github.com/crossplane-contrib/provider-gcp/pkg/clients/nodepool"
+	"github.com/crossplane-contrib/provider-gcp/pkg/operation"
 )
 
 type nodePoolModifier func(*v1beta1.NodePool)
@@ -55,6 +66,70 @@ func npWithLocations(l []string) nodePoolModifier {
 	return func(i *v1beta1.NodePool) { i.Spec.ForProvider.Locations = l }
 }
 
+func npWithCluster(c string) nodePoolModifier {
+	return func(i *v1beta1.NodePool) { i.Spec.ForProvider.Cluster = c }
+}
+
+func npWithClusterRef(name string) nodePoolModifier {
+	return func(i *v1beta1.NodePool) { i.Spec.ForProvider.ClusterRef = &xpv1.Reference{Name: name} }
+}
+
+func npWithUpgradeSettings(u *v1beta2.UpgradeSettings) nodePoolModifier {
+	return func(i *v1beta1.NodePool) { i.Spec.ForProvider.UpgradeSettings = u }
+}
+
+func npWithInitialNodeCount(n int64) nodePoolModifier {
+	return func(i *v1beta1.NodePool) { i.Spec.ForProvider.InitialNodeCount = &n }
+}
+
+func npWithDrainBeforeDelete() nodePoolModifier {
+	return func(i *v1beta1.NodePool) { i.Spec.ForProvider.DrainBeforeDelete = &v1beta1.DrainBeforeDelete{} }
+}
+
+func npWithDrainingSince(t time.Time) nodePoolModifier {
+	return func(i *v1beta1.NodePool) {
+		c := v1beta1.Draining()
+		c.LastTransitionTime = metav1.NewTime(t)
+		i.Status.SetConditions(c)
+	}
+}
+
+func npWithOperationStarted(v operation.Verb, t time.Time) nodePoolModifier {
+	return func(i *v1beta1.NodePool) {
+		meta.AddAnnotations(i, map[string]string{operation.AnnotationKeyStarted(v): t.UTC().Format(time.RFC3339)})
+	}
+}
+
+func npWithTimeouts(t *gcpv1beta1.Timeouts) nodePoolModifier {
+	return func(i *v1beta1.NodePool) { i.Spec.Timeouts = t }
+}
+
+// drainHandler serves the cluster of a node pool that is being drained and
+// fails the test if the node pool is deleted when it should not be.
+func drainHandler(t *testing.T, allowDelete bool) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.Body.Close()
+		var res interface{} = &container.Cluster{}
+		if r.Method == http.MethodDelete {
+			if !allowDelete {
+				t.Errorf("r: unexpected deletion of node pool before it is drained")
+			}
+			res = &container.Operation{}
+		}
+		w.WriteHeader(http.StatusOK)
+		if err := json.NewEncoder(w).Encode(res); err != nil {
+			t.Error(err)
+		}
+	})
+}
+
+func drainObjects() []runtime.Object {
+	return []runtime.Object{
+		&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node", Labels: map[string]string{np.NodePoolLabel: name}}},
+		&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "pod"}, Spec: corev1.PodSpec{NodeName: "node"}},
+	}
+}
+
 func nodePool(im ...nodePoolModifier) *v1beta1.NodePool {
 	i := &v1beta1.NodePool{
 		ObjectMeta: metav1.ObjectMeta{
@@ -80,6 +155,7 @@ func nodePool(im ...nodePoolModifier) *v1beta1.NodePool {
 }
 
 func TestNodePoolObserve(t *testing.T) {
+	now := time.Now()
 	type args struct {
 		mg resource.Managed
 	}
@@ -174,14 +250,39 @@ func TestNodePoolObserve(t *testing.T) {
 				}
 			}),
 			args: args{
-				mg: nodePool(),
+				mg: nodePool(npWithOperationStarted(operation.VerbCreate, now)),
 			},
 			want: want{
 				obs: managed.ExternalObservation{
 					ResourceExists:   true,
 					ResourceUpToDate: true,
 				},
-				mg: nodePool(npWithProviderStatus(v1beta1.NodePoolStateProvisioning), npWithConditions(xpv1.Creating())),
+				mg: nodePool(npWithOperationStarted(operation.VerbCreate, now), npWithProviderStatus(v1beta1.NodePoolStateProvisioning), npWithConditions(xpv1.Creating())),
+			},
+		},
+		"UpdateTimedOut": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				n := &container.NodePool{}
+				np.GenerateNodePool(name, nodePool().Spec.ForProvider, n)
+				n.Status = v1beta1.NodePoolStateReconciling
+				if err := json.NewEncoder(w).Encode(n); err != nil {
+					t.Error(err)
+				}
+			}),
+			args: args{
+				mg: nodePool(
+					npWithTimeouts(&gcpv1beta1.Timeouts{Update: gcp.StringPtr("30m")}),
+					npWithOperationStarted(operation.VerbUpdate, now.Add(-45*time.Minute))),
+			},
+			want: want{
+				mg: nodePool(
+					npWithTimeouts(&gcpv1beta1.Timeouts{Update: gcp.StringPtr("30m")}),
+					npWithOperationStarted(operation.VerbUpdate, now.Add(-45*time.Minute)),
+					npWithProviderStatus(v1beta1.NodePoolStateReconciling),
+					npWithConditions(operation.TimedOut(operation.VerbUpdate, 30*time.Minute))),
+				err: errors.New("update operation did not complete within 30m0s"),
 			},
 		},
 		"Unavailable": {
@@ -236,7 +337,7 @@ func TestNodePoolObserve(t *testing.T) {
 				},
 				mg: nodePool(
 					npWithProviderStatus(v1beta1.NodePoolStateRunning),
-					npWithConditions(xpv1.Available())),
+					npWithConditions(v1beta1.GCEResourcesObserved(), xpv1.Available())),
 			},
 		},
 		"BoundUnavailable": {
@@ -279,10 +380,12 @@ func TestNodePoolObserve(t *testing.T) {
 			server := httptest.NewServer(tc.handler)
 			defer server.Close()
 			s, _ := container.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			cs, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
 			e := nodePoolExternal{
 				kube:      tc.kube,
 				projectID: projectID,
 				container: s,
+				compute:   cs,
 			}
 			obs, err := e.Observe(context.Background(), tc.args.mg)
 			if tc.want.err != nil && err != nil {
@@ -316,11 +419,35 @@ func TestNodePoolCreate(t *testing.T) {
 		err error
 	}
 
+	clusterName := "projects/cool-project/locations/us-central1/clusters/cool-cluster"
+	quotaHandler := func(cpuUsage float64) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_ = r.Body.Close()
+			switch r.URL.Path {
+			case "/v1/" + clusterName:
+				_ = json.NewEncoder(w).Encode(&container.Cluster{Locations: []string{"us-central1-a"}})
+			case "/projects/cool-project/zones/us-central1-a/machineTypes/e2-medium":
+				_ = json.NewEncoder(w).Encode(&compute.MachineType{GuestCpus: 2})
+			case "/projects/cool-project/regions/us-central1":
+				_ = json.NewEncoder(w).Encode(&compute.Region{Quotas: []*compute.Quota{{Metric: "CPUS", Limit: 24, Usage: cpuUsage}}})
+			case "/v1/" + clusterName + "/nodePools":
+				if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(&container.Operation{})
+			default:
+				t.Errorf("unexpected request: %s", r.URL.Path)
+				w.WriteHeader(http.StatusNotFound)
+			}
+		})
+	}
+
 	cases := map[string]struct {
-		handler http.Handler
-		kube    client.Client
-		args    args
-		want    want
+		handler        http.Handler
+		kube           client.Client
+		quotaPreflight bool
+		args           args
+		want           want
 	}{
 		"Successful": {
 			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -423,6 +550,80 @@ func TestNodePoolCreate(t *testing.T) {
 				err: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateNodePool),
 			},
 		},
+		"InheritUpgradeSettings": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				i := &container.CreateNodePoolRequest{}
+				_ = json.NewDecoder(r.Body).Decode(i)
+				_ = r.Body.Close()
+				if diff := cmp.Diff(&container.UpgradeSettings{MaxSurge: 3, MaxUnavailable: 1}, i.NodePool.UpgradeSettings); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&container.Operation{})
+			}),
+			kube: &test.MockClient{
+				MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+					c := obj.(*v1beta2.Cluster)
+					c.Spec.DefaultUpgradeSettings = &v1beta2.UpgradeSettings{MaxSurge: gcp.Int64Ptr(3), MaxUnavailable: gcp.Int64Ptr(1)}
+					return nil
+				}),
+			},
+			args: args{
+				mg: nodePool(npWithClusterRef("cool-cluster")),
+			},
+			want: want{
+				mg: nodePool(
+					npWithClusterRef("cool-cluster"),
+					npWithUpgradeSettings(&v1beta2.UpgradeSettings{MaxSurge: gcp.Int64Ptr(3), MaxUnavailable: gcp.Int64Ptr(1)}),
+					npWithConditions(xpv1.Creating()),
+				),
+			},
+		},
+		"GetReferencedClusterFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				t.Errorf("unexpected request: %s", r.URL.Path)
+			}),
+			kube: &test.MockClient{
+				MockGet: test.NewMockGetFn(errBoom),
+			},
+			args: args{
+				mg: nodePool(npWithClusterRef("cool-cluster")),
+			},
+			want: want{
+				mg:  nodePool(npWithClusterRef("cool-cluster"), npWithConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errGetReferencedCluster),
+			},
+		},
+		"QuotaSufficient": {
+			handler:        quotaHandler(0),
+			quotaPreflight: true,
+			args: args{
+				mg: nodePool(npWithCluster(clusterName), npWithInitialNodeCount(3)),
+			},
+			want: want{
+				mg: nodePool(
+					npWithCluster(clusterName),
+					npWithInitialNodeCount(3),
+					npWithConditions(xpv1.Creating(), v1beta1.QuotaSufficient()),
+				),
+			},
+		},
+		"InsufficientQuota": {
+			handler:        quotaHandler(20),
+			quotaPreflight: true,
+			args: args{
+				mg: nodePool(npWithCluster(clusterName), npWithInitialNodeCount(3)),
+			},
+			want: want{
+				mg: nodePool(
+					npWithCluster(clusterName),
+					npWithInitialNodeCount(3),
+					npWithConditions(xpv1.Creating(), v1beta1.InsufficientQuota("CPUS in us-central1: 6 required, 4 of 24 available")),
+				),
+				err: errors.Errorf("%s: %s", errInsufficientQuota, "CPUS in us-central1: 6 required, 4 of 24 available"),
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -430,10 +631,13 @@ func TestNodePoolCreate(t *testing.T) {
 			server := httptest.NewServer(tc.handler)
 			defer server.Close()
 			s, _ := container.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			cs, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
 			e := nodePoolExternal{
-				kube:      tc.kube,
-				projectID: projectID,
-				container: s,
+				kube:           tc.kube,
+				projectID:      projectID,
+				container:      s,
+				compute:        cs,
+				quotaPreflight: tc.quotaPreflight,
 			}
 			_, err := e.Create(tc.args.ctx, tc.args.mg)
 			if tc.want.err != nil && err != nil {
@@ -463,10 +667,11 @@ func TestNodePoolDelete(t *testing.T) {
 	}
 
 	cases := map[string]struct {
-		handler http.Handler
-		kube    client.Client
-		args    args
-		want    want
+		handler    http.Handler
+		kube       client.Client
+		kubeClient kubernetes.Interface
+		args       args
+		want       want
 	}{
 		"Successful": {
 			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -549,6 +754,48 @@ func TestNodePoolDelete(t *testing.T) {
 				err: errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteNodePool),
 			},
 		},
+		"DrainPending": {
+			handler:    drainHandler(t, false),
+			kubeClient: fake.NewSimpleClientset(drainObjects()...),
+			args: args{
+				mg: nodePool(npWithDrainBeforeDelete()),
+			},
+			want: want{
+				mg: nodePool(
+					npWithDrainBeforeDelete(),
+					npWithConditions(xpv1.Deleting(), v1beta1.Draining()),
+				),
+			},
+		},
+		"Drained": {
+			handler:    drainHandler(t, true),
+			kubeClient: fake.NewSimpleClientset(drainObjects()[0]),
+			args: args{
+				mg: nodePool(npWithDrainBeforeDelete()),
+			},
+			want: want{
+				mg: nodePool(
+					npWithDrainBeforeDelete(),
+					npWithConditions(xpv1.Deleting(), v1beta1.Drained()),
+				),
+			},
+		},
+		"DrainTimedOut": {
+			handler:    drainHandler(t, true),
+			kubeClient: fake.NewSimpleClientset(drainObjects()...),
+			args: args{
+				mg: nodePool(
+					npWithDrainBeforeDelete(),
+					npWithDrainingSince(time.Now().Add(-np.DefaultDrainTimeout)),
+				),
+			},
+			want: want{
+				mg: nodePool(
+					npWithDrainBeforeDelete(),
+					npWithConditions(v1beta1.DrainTimedOut("default/pod"), xpv1.Deleting()),
+				),
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -560,6 +807,10 @@ func TestNodePoolDelete(t *testing.T) {
 				kube:      tc.kube,
 				projectID: projectID,
 				container: s,
+				record:    event.NewNopRecorder(),
+				newKubeClient: func(_ context.Context, _ *container.Cluster) (kubernetes.Interface, error) {
+					return tc.kubeClient, nil
+				},
 			}
 			err := e.Delete(context.Background(), tc.args.mg)
 			if tc.want.err != nil && err != nil {
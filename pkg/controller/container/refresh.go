@@ -0,0 +1,76 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package container
+
+import (
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// gceRefreshInterval is how often the Compute Engine resources that GKE
+// created for a cluster or node pool are observed while they are unchanged.
+const gceRefreshInterval = 5 * time.Minute
+
+// A gceRefresher decides when the Compute Engine resources that GKE created
+// for a managed resource should be observed again. They are observed when the
+// GKE resources that they were created for change, and otherwise at most once
+// per interval, rather than on every poll. A nil gceRefresher always refreshes.
+type gceRefresher struct {
+	interval time.Duration
+
+	mu   sync.Mutex
+	last map[types.UID]gceRefresh
+}
+
+type gceRefresh struct {
+	key string
+	at  time.Time
+}
+
+func newGCERefresher(interval time.Duration) *gceRefresher {
+	return &gceRefresher{interval: interval, last: map[types.UID]gceRefresh{}}
+}
+
+// Due returns true if the Compute Engine resources of the managed resource
+// with the supplied UID should be observed, because the supplied key that
+// identifies the GKE resources they were created for changed or the interval
+// passed. It assumes they are observed when it returns true.
+func (r *gceRefresher) Due(uid types.UID, key string, now time.Time) bool {
+	if r == nil {
+		return true
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if l, ok := r.last[uid]; ok && l.key == key && now.Sub(l.at) < r.interval {
+		return false
+	}
+	r.last[uid] = gceRefresh{key: key, at: now}
+	return true
+}
+
+// Forget forgets when the Compute Engine resources of the managed resource
+// with the supplied UID were last observed.
+func (r *gceRefresher) Forget(uid types.UID) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.last, uid)
+}
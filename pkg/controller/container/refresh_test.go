@@ -0,0 +1,100 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package container
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestGCERefresherDue(t *testing.T) {
+	const uid = types.UID("cool-uid")
+	now := time.Now()
+
+	cases := map[string]struct {
+		reason string
+		r      *gceRefresher
+		prime  bool
+		key    string
+		at     time.Time
+		want   bool
+	}{
+		"Nil": {
+			reason: "A nil refresher should always refresh.",
+			key:    "a",
+			at:     now,
+			want:   true,
+		},
+		"FirstObservation": {
+			reason: "Resources that were never observed should be observed.",
+			r:      newGCERefresher(time.Minute),
+			key:    "a",
+			at:     now,
+			want:   true,
+		},
+		"Unchanged": {
+			reason: "Unchanged resources should not be observed again within the interval.",
+			r:      newGCERefresher(time.Minute),
+			prime:  true,
+			key:    "a",
+			at:     now.Add(30 * time.Second),
+			want:   false,
+		},
+		"Changed": {
+			reason: "Resources should be observed again once the resources they were created for change.",
+			r:      newGCERefresher(time.Minute),
+			prime:  true,
+			key:    "b",
+			at:     now.Add(30 * time.Second),
+			want:   true,
+		},
+		"IntervalPassed": {
+			reason: "Unchanged resources should be observed again once the interval passed.",
+			r:      newGCERefresher(time.Minute),
+			prime:  true,
+			key:    "a",
+			at:     now.Add(2 * time.Minute),
+			want:   true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if tc.prime {
+				tc.r.Due(uid, "a", now)
+			}
+			got := tc.r.Due(uid, tc.key, tc.at)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nDue(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestGCERefresherForget(t *testing.T) {
+	const uid = types.UID("cool-uid")
+	now := time.Now()
+	r := newGCERefresher(time.Minute)
+	r.Due(uid, "a", now)
+	r.Forget(uid)
+	if !r.Due(uid, "a", now) {
+		t.Errorf("Due(...): forgotten resources should be observed")
+	}
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package container
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	container "google.golang.org/api/container/v1"
+)
+
+// serverConfigTTL is how long the server config of a GKE location is used
+// before it is read again. The versions it lists change a few times a week
+// at most.
+const serverConfigTTL = 30 * time.Minute
+
+// A readServerConfigFn reads the server config of a GKE location.
+type readServerConfigFn func(ctx context.Context) (*container.ServerConfig, error)
+
+// A serverConfigCache serves the server config of a GKE location, which is
+// the same for every cluster in the location, so that it is read at most
+// once per TTL rather than on every poll of every cluster. A nil
+// serverConfigCache always reads the server config.
+type serverConfigCache struct {
+	ttl time.Duration
+	now func() time.Time
+
+	mu      sync.Mutex
+	configs map[string]*serverConfig
+}
+
+type serverConfig struct {
+	mu   sync.Mutex
+	cfg  *container.ServerConfig
+	read time.Time
+}
+
+func newServerConfigCache(ttl time.Duration) *serverConfigCache {
+	return &serverConfigCache{ttl: ttl, now: time.Now, configs: map[string]*serverConfig{}}
+}
+
+func (c *serverConfigCache) config(parent string) *serverConfig {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	sc, ok := c.configs[parent]
+	if !ok {
+		sc = &serverConfig{}
+		c.configs[parent] = sc
+	}
+	return sc
+}
+
+// Get returns the server config of the supplied parent, in the form
+// projects/*/locations/*. It is read using the supplied readServerConfigFn
+// if it was never read or was read longer than the TTL ago. Concurrent
+// callers wait for a single read of the same parent. A server config that
+// cannot be read is read again by the next caller.
+func (c *serverConfigCache) Get(ctx context.Context, parent string, read readServerConfigFn) (*container.ServerConfig, error) {
+	if c == nil {
+		return read(ctx)
+	}
+	sc := c.config(parent)
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+	if sc.cfg != nil && c.now().Sub(sc.read) < c.ttl {
+		return sc.cfg, nil
+	}
+	cfg, err := read(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sc.cfg, sc.read = cfg, c.now()
+	return cfg, nil
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package container
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	container "google.golang.org/api/container/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+func TestServerConfigCacheGet(t *testing.T) {
+	const parent = "projects/cool-project/locations/us-central1"
+	errBoom := errors.New("boom")
+	now := time.Now()
+
+	type want struct {
+		cfg   *container.ServerConfig
+		err   error
+		reads int
+	}
+	cases := map[string]struct {
+		reason string
+		c      *serverConfigCache
+		prime  bool
+		parent string
+		at     time.Time
+		err    error
+		want   want
+	}{
+		"Nil": {
+			reason: "A nil cache should always read the server config.",
+			parent: parent,
+			at:     now,
+			prime:  true,
+			want:   want{cfg: &container.ServerConfig{DefaultClusterVersion: "2"}, reads: 2},
+		},
+		"FirstRead": {
+			reason: "A server config that was never read should be read.",
+			c:      newServerConfigCache(time.Minute),
+			parent: parent,
+			at:     now,
+			want:   want{cfg: &container.ServerConfig{DefaultClusterVersion: "1"}, reads: 1},
+		},
+		"Cached": {
+			reason: "A server config should not be read again within the TTL.",
+			c:      newServerConfigCache(time.Minute),
+			prime:  true,
+			parent: parent,
+			at:     now.Add(30 * time.Second),
+			want:   want{cfg: &container.ServerConfig{DefaultClusterVersion: "1"}, reads: 1},
+		},
+		"OtherLocation": {
+			reason: "The server config of each location should be read separately.",
+			c:      newServerConfigCache(time.Minute),
+			prime:  true,
+			parent: "projects/cool-project/locations/europe-west1",
+			at:     now.Add(30 * time.Second),
+			want:   want{cfg: &container.ServerConfig{DefaultClusterVersion: "2"}, reads: 2},
+		},
+		"Expired": {
+			reason: "A server config should be read again once the TTL passed.",
+			c:      newServerConfigCache(time.Minute),
+			prime:  true,
+			parent: parent,
+			at:     now.Add(2 * time.Minute),
+			want:   want{cfg: &container.ServerConfig{DefaultClusterVersion: "2"}, reads: 2},
+		},
+		"ReadFailed": {
+			reason: "Errors reading the server config should be returned.",
+			c:      newServerConfigCache(time.Minute),
+			parent: parent,
+			at:     now,
+			err:    errBoom,
+			want:   want{err: errBoom, reads: 1},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			reads := 0
+			read := func(_ context.Context) (*container.ServerConfig, error) {
+				reads++
+				if tc.err != nil {
+					return nil, tc.err
+				}
+				return &container.ServerConfig{DefaultClusterVersion: strconv.Itoa(reads)}, nil
+			}
+			if tc.c != nil {
+				tc.c.now = func() time.Time { return now }
+			}
+			if tc.prime {
+				_, _ = tc.c.Get(context.Background(), parent, read)
+			}
+			if tc.c != nil {
+				tc.c.now = func() time.Time { return tc.at }
+			}
+			cfg, err := tc.c.Get(context.Background(), tc.parent, read)
+			if diff := cmp.Diff(tc.want, want{cfg: cfg, err: err, reads: reads}, test.EquateErrors(), cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("\n%s\nGet(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestServerConfigCacheReadFailed(t *testing.T) {
+	const parent = "projects/cool-project/locations/us-central1"
+	c := newServerConfigCache(time.Minute)
+	_, _ = c.Get(context.Background(), parent, func(_ context.Context) (*container.ServerConfig, error) {
+		return nil, errors.New("boom")
+	})
+	want := &container.ServerConfig{DefaultClusterVersion: "1.27"}
+	got, err := c.Get(context.Background(), parent, func(_ context.Context) (*container.ServerConfig, error) {
+		return want, nil
+	})
+	if err != nil {
+		t.Fatalf("Get(...): %s", err)
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Get(...): a server config that could not be read should be read again: -want, +got:\n%s", diff)
+	}
+}
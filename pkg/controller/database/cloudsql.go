@@ -27,7 +27,6 @@ import (
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/connection"
-	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	"github.com/crossplane/crossplane-runtime/pkg/errors"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
@@ -40,26 +39,43 @@ import (
 	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
 	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
 	"github.com/crossplane-contrib/provider-gcp/pkg/clients/cloudsql"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/setup"
 	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+	"github.com/crossplane-contrib/provider-gcp/pkg/operation"
+	"github.com/crossplane-contrib/provider-gcp/pkg/tracing"
 )
 
 const (
 	errNotCloudSQL         = "managed resource is not a CloudSQLInstance custom resource"
 	errManagedUpdateFailed = "cannot update CloudSQLInstance custom resource"
 
-	errNewClient        = "cannot create new Sqladmin Service"
-	errCreateFailed     = "cannot create new CloudSQL instance"
-	errNameInUse        = "cannot create new CloudSQL instance, resource name is unavailable because it is in use or was used recently"
-	errDeleteFailed     = "cannot delete the CloudSQL instance"
-	errUpdateFailed     = "cannot update the CloudSQL instance"
-	errGetFailed        = "cannot get the CloudSQL instance"
-	errGeneratePassword = "cannot generate root password"
-	errCheckUpToDate    = "cannot determine if CloudSQL instance is up to date"
+	errNewClient          = "cannot create new Sqladmin Service"
+	errCreateFailed       = "cannot create new CloudSQL instance"
+	errNameInUse          = "cannot create new CloudSQL instance, resource name is unavailable because it is in use or was used recently"
+	errDeleteFailed       = "cannot delete the CloudSQL instance"
+	errUpdateFailed       = "cannot update the CloudSQL instance"
+	errGetFailed          = "cannot get the CloudSQL instance"
+	errGeneratePassword   = "cannot generate root password"
+	errCheckUpToDate      = "cannot determine if CloudSQL instance is up to date"
+	errListFlags          = "cannot list supported CloudSQL database flags"
+	errInvalidFlags       = "invalid database flags"
+	errIAMAuthFmt         = "IAM database authentication is not supported for database version %s"
+	errPromoteReplica     = "cannot promote the CloudSQL read replica"
+	errFailover           = "cannot fail over the CloudSQL instance"
+	errPersistFailover    = "cannot persist the failover trigger of the CloudSQL instance"
+	errMaintenanceVersion = "cannot update the maintenance version of the CloudSQL instance"
+	errUnavailableVersion = "maintenance version %q is not available, available versions are %v"
+	errGetCreateOperation = "cannot get operation creating the CloudSQL instance"
+	errCreateOperationFmt = "operation %s creating the CloudSQL instance failed: %s"
+
+	reasonCreateOperationFailed  event.Reason = "CreateOperationFailed"
+	reasonCannotPersistOperation event.Reason = "CannotPersistOperation"
+	reasonCannotPersistFailover  event.Reason = "CannotPersistFailover"
 )
 
 // SetupCloudSQLInstance adds a controller that reconciles
 // CloudSQLInstance managed resources.
-func SetupCloudSQLInstance(mgr ctrl.Manager, o controller.Options) error {
+func SetupCloudSQLInstance(mgr ctrl.Manager, o setup.Options) error {
 	name := managed.ControllerName(v1beta1.CloudSQLInstanceGroupKind)
 
 	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
@@ -67,24 +83,37 @@ func SetupCloudSQLInstance(mgr ctrl.Manager, o controller.Options) error {
 		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
 	}
 
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(v1beta1.CloudSQLInstanceGroupVersionKind),
-		managed.WithExternalConnecter(&cloudsqlConnector{kube: mgr.GetClient()}),
-		managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient()), &cloudsqlTagger{kube: mgr.GetClient()}),
+		managed.WithExternalConnecter(setup.Connecter(mgr, o, v1beta1.CloudSQLInstanceGroupKind, &cloudsqlConnector{kube: mgr.GetClient(), record: recorder})),
+		managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient()), gcp.NewLocationDefaulter(mgr.GetClient(), gcp.LocationScopeRegion, cloudsqlRegion), &cloudsqlTagger{kube: mgr.GetClient()}, operation.NewCreateResumer(mgr.GetClient())),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
-		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithRecorder(recorder),
 		managed.WithConnectionPublishers(cps...))
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(o.ForControllerRuntime()).
 		For(&v1beta1.CloudSQLInstance{}).
-		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+		Complete(tracing.NewReconciler(name, ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter)))
+}
+
+// cloudsqlRegion returns the region of the supplied CloudSQLInstance so that
+// it can be defaulted from its ProviderConfig.
+func cloudsqlRegion(mg resource.Managed) *string {
+	cr, ok := mg.(*v1beta1.CloudSQLInstance)
+	if !ok {
+		return nil
+	}
+	return &cr.Spec.ForProvider.Region
 }
 
 type cloudsqlConnector struct {
-	kube client.Client
+	kube   client.Client
+	record event.Recorder
 }
 
 func (c *cloudsqlConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
@@ -96,13 +125,16 @@ func (c *cloudsqlConnector) Connect(ctx context.Context, mg resource.Managed) (m
 	if err != nil {
 		return nil, errors.Wrap(err, errNewClient)
 	}
-	return &cloudsqlExternal{kube: c.kube, db: s.Instances, projectID: projectID}, nil
+	return &cloudsqlExternal{kube: c.kube, db: s.Instances, flags: s.Flags, ops: s.Operations, projectID: projectID, record: c.record}, nil
 }
 
 type cloudsqlExternal struct {
 	kube      client.Client
 	db        *sqladmin.InstancesService
+	flags     *sqladmin.FlagsService
+	ops       *sqladmin.OperationsService
 	projectID string
+	record    event.Recorder
 }
 
 func (c *cloudsqlExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -110,6 +142,9 @@ func (c *cloudsqlExternal) Observe(ctx context.Context, mg resource.Managed) (ma
 	if !ok {
 		return managed.ExternalObservation{}, errors.New(errNotCloudSQL)
 	}
+	if err := c.trackCreate(ctx, cr); err != nil {
+		return managed.ExternalObservation{}, err
+	}
 	instance, err := c.db.Get(c.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetFailed)
@@ -123,7 +158,12 @@ func (c *cloudsqlExternal) Observe(ctx context.Context, mg resource.Managed) (ma
 			return managed.ExternalObservation{}, errors.Wrap(err, errManagedUpdateFailed)
 		}
 	}
+	lastFailoverTrigger := cr.Status.AtProvider.LastFailoverTrigger
+	if t, ok := cr.GetAnnotations()[cloudsql.AnnotationKeyFailoverTrigger]; ok {
+		lastFailoverTrigger = t
+	}
 	cr.Status.AtProvider = cloudsql.GenerateObservation(*instance)
+	cr.Status.AtProvider.LastFailoverTrigger = lastFailoverTrigger
 	switch cr.Status.AtProvider.State {
 	case v1beta1.StateRunnable:
 		cr.Status.SetConditions(xpv1.Available())
@@ -132,6 +172,9 @@ func (c *cloudsqlExternal) Observe(ctx context.Context, mg resource.Managed) (ma
 	case v1beta1.StateCreationFailed, v1beta1.StateSuspended, v1beta1.StateMaintenance, v1beta1.StateUnknownState:
 		cr.Status.SetConditions(xpv1.Unavailable())
 	}
+	if err := operation.Check(ctx, c.kube, cr, cr.Spec.Timeouts, cloudsqlOperation(cr)); err != nil {
+		return managed.ExternalObservation{}, err
+	}
 
 	upToDate, err := cloudsql.IsUpToDate(meta.GetExternalName(cr), &cr.Spec.ForProvider, instance)
 	if err != nil {
@@ -139,16 +182,59 @@ func (c *cloudsqlExternal) Observe(ctx context.Context, mg resource.Managed) (ma
 	}
 	return managed.ExternalObservation{
 		ResourceExists:    true,
-		ResourceUpToDate:  upToDate,
+		ResourceUpToDate:  upToDate && !cloudsql.IsFailoverPending(cr.Spec.ForProvider, cr.Status.AtProvider) && !cloudsql.IsMaintenanceVersionPending(cr.Spec.ForProvider, cr.Status.AtProvider),
 		ConnectionDetails: getConnectionDetails(cr, instance),
 	}, nil
 }
 
+// cloudsqlOperation returns the verb of the Cloud SQL operation that is
+// running on the supplied instance, if any. Updates that restart the instance
+// put it into maintenance while they run.
+func cloudsqlOperation(cr *v1beta1.CloudSQLInstance) operation.Verb {
+	switch cr.Status.AtProvider.State {
+	case v1beta1.StateCreating:
+		return operation.VerbCreate
+	case v1beta1.StateMaintenance:
+		return operation.VerbUpdate
+	case v1beta1.StateDeleting:
+		return operation.VerbDelete
+	}
+	return operation.VerbNone
+}
+
+// trackCreate forgets the persisted operation creating the supplied instance
+// once it is done, and reports it if it failed.
+func (c *cloudsqlExternal) trackCreate(ctx context.Context, cr *v1beta1.CloudSQLInstance) error {
+	name := operation.CreateOperation(cr)
+	if name == "" {
+		return nil
+	}
+	op, err := c.ops.Get(c.projectID, name).Context(ctx).Do()
+	if resource.Ignore(gcp.IsErrorNotFound, err) != nil {
+		return errors.Wrap(err, errGetCreateOperation)
+	}
+	if op != nil && op.Status != operation.StatusDone {
+		return nil
+	}
+	if op != nil && op.Error != nil && len(op.Error.Errors) > 0 {
+		e := op.Error.Errors[0]
+		c.record.Event(cr, event.Warning(reasonCreateOperationFailed, errors.Errorf(errCreateOperationFmt, name, e.Message)))
+	}
+	return operation.ForgetCreateOperation(ctx, c.kube, cr)
+}
+
 func (c *cloudsqlExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
 	cr, ok := mg.(*v1beta1.CloudSQLInstance)
 	if !ok {
 		return managed.ExternalCreation{}, errors.New(errNotCloudSQL)
 	}
+	// Wait until a create operation that was started before is done.
+	if operation.CreateOperation(cr) != "" {
+		return managed.ExternalCreation{}, nil
+	}
+	if err := c.validateFlags(ctx, cr.Spec.ForProvider); err != nil {
+		return managed.ExternalCreation{}, err
+	}
 	cr.SetConditions(xpv1.Creating())
 	instance := &sqladmin.DatabaseInstance{}
 	cloudsql.GenerateDatabaseInstance(meta.GetExternalName(cr), cr.Spec.ForProvider, instance)
@@ -158,7 +244,8 @@ func (c *cloudsqlExternal) Create(ctx context.Context, mg resource.Managed) (man
 	}
 
 	instance.RootPassword = pw
-	if _, err := c.db.Insert(c.projectID, instance).Context(ctx).Do(); err != nil {
+	op, err := c.db.Insert(c.projectID, instance).Context(ctx).Do()
+	if err != nil {
 		// We don't want to return (and thus publish) our randomly generated
 		// password if we didn't actually successfully create a new instance.
 		if gcp.IsErrorAlreadyExists(err) {
@@ -166,6 +253,14 @@ func (c *cloudsqlExternal) Create(ctx context.Context, mg resource.Managed) (man
 		}
 		return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
 	}
+	gcp.RecordOperation(c.record, cr, "create", op.Name)
+
+	// The creation does not fail if its operation cannot be persisted, lest
+	// the generated password of the instance being created is lost; only
+	// resuming an interrupted creation needs it.
+	if err := operation.PersistCreateOperation(ctx, c.kube, cr, op.Name); err != nil {
+		c.record.Event(cr, event.Warning(reasonCannotPersistOperation, err))
+	}
 
 	cd := managed.ConnectionDetails{
 		xpv1.ResourceCredentialsSecretPasswordKey: []byte(pw),
@@ -181,12 +276,72 @@ func (c *cloudsqlExternal) Update(ctx context.Context, mg resource.Managed) (man
 	if cr.Status.AtProvider.State == v1beta1.StateCreating {
 		return managed.ExternalUpdate{}, nil
 	}
+	// Promotion and failover are operations of their own rather than changes
+	// to the instance, so run them before patching any other settings.
+	if cloudsql.IsPromotionPending(cr.Spec.ForProvider, cr.Status.AtProvider) {
+		op, err := c.db.PromoteReplica(c.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errPromoteReplica)
+		}
+		gcp.RecordOperation(c.record, cr, "promoteReplica", op.Name)
+		return managed.ExternalUpdate{}, nil
+	}
+	if cloudsql.IsFailoverPending(cr.Spec.ForProvider, cr.Status.AtProvider) {
+		return managed.ExternalUpdate{}, c.failover(ctx, cr)
+	}
+	// A maintenance version update cannot be combined with other changes.
+	if cloudsql.IsMaintenanceVersionPending(cr.Spec.ForProvider, cr.Status.AtProvider) {
+		v := gcp.StringValue(cr.Spec.ForProvider.MaintenanceVersion)
+		if !cloudsql.IsMaintenanceVersionAvailable(cr.Spec.ForProvider, cr.Status.AtProvider) {
+			return managed.ExternalUpdate{}, errors.Errorf(errUnavailableVersion, v, cr.Status.AtProvider.AvailableMaintenanceVersions)
+		}
+		op, err := c.db.Patch(c.projectID, meta.GetExternalName(cr), &sqladmin.DatabaseInstance{MaintenanceVersion: v}).Context(ctx).Do()
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errMaintenanceVersion)
+		}
+		gcp.RecordOperation(c.record, cr, "update", op.Name)
+		return managed.ExternalUpdate{}, nil
+	}
+	if err := c.validateFlags(ctx, cr.Spec.ForProvider); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
 	instance := &sqladmin.DatabaseInstance{}
 	cloudsql.GenerateDatabaseInstance(meta.GetExternalName(cr), cr.Spec.ForProvider, instance)
 	// TODO(muvaf): the returned operation handle could help us not to send Patch
 	// request aggressively.
-	_, err := c.db.Patch(c.projectID, meta.GetExternalName(cr), instance).Context(ctx).Do()
-	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
+	op, err := c.db.Patch(c.projectID, meta.GetExternalName(cr), instance).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
+	}
+	gcp.RecordOperation(c.record, cr, "update", op.Name)
+	return managed.ExternalUpdate{}, nil
+}
+
+// failover starts a manual failover of the supplied instance. The trigger it
+// is started for is persisted first, so that it is not started again if the
+// status of the instance cannot be updated afterwards. The previous trigger
+// is persisted again if the failover cannot be started.
+func (c *cloudsqlExternal) failover(ctx context.Context, cr *v1beta1.CloudSQLInstance) error {
+	last := cr.Status.AtProvider.LastFailoverTrigger
+	trigger := gcp.StringValue(cr.Spec.ForProvider.FailoverTrigger)
+	req := &sqladmin.InstancesFailoverRequest{
+		FailoverContext: &sqladmin.FailoverContext{SettingsVersion: cr.Status.AtProvider.SettingsVersion},
+	}
+	meta.AddAnnotations(cr, map[string]string{cloudsql.AnnotationKeyFailoverTrigger: trigger})
+	if err := managed.NewRetryingCriticalAnnotationUpdater(c.kube).UpdateCriticalAnnotations(ctx, cr); err != nil {
+		return errors.Wrap(err, errPersistFailover)
+	}
+	op, err := c.db.Failover(c.projectID, meta.GetExternalName(cr), req).Context(ctx).Do()
+	if err != nil {
+		meta.AddAnnotations(cr, map[string]string{cloudsql.AnnotationKeyFailoverTrigger: last})
+		if uerr := managed.NewRetryingCriticalAnnotationUpdater(c.kube).UpdateCriticalAnnotations(ctx, cr); uerr != nil {
+			c.record.Event(cr, event.Warning(reasonCannotPersistFailover, errors.Wrap(uerr, errPersistFailover)))
+		}
+		return errors.Wrap(err, errFailover)
+	}
+	cr.Status.AtProvider.LastFailoverTrigger = trigger
+	gcp.RecordOperation(c.record, cr, "failover", op.Name)
+	return nil
 }
 
 func (c *cloudsqlExternal) Delete(ctx context.Context, mg resource.Managed) error {
@@ -195,11 +350,34 @@ func (c *cloudsqlExternal) Delete(ctx context.Context, mg resource.Managed) erro
 		return errors.New(errNotCloudSQL)
 	}
 	cr.SetConditions(xpv1.Deleting())
-	_, err := c.db.Delete(c.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
+	op, err := c.db.Delete(c.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
 	if gcp.IsErrorNotFound(err) {
 		return nil
 	}
-	return errors.Wrap(err, errDeleteFailed)
+	if err != nil {
+		return errors.Wrap(err, errDeleteFailed)
+	}
+	gcp.RecordOperation(c.record, cr, "delete", op.Name)
+	return nil
+}
+
+// validateFlags checks the desired database flags against the flags Cloud SQL
+// supports for the desired database version. Validation is skipped when no
+// flags are set, or when the database version is left to the API default.
+// IAM database authentication is rejected for versions that do not support it.
+func (c *cloudsqlExternal) validateFlags(ctx context.Context, p v1beta1.CloudSQLInstanceParameters) error {
+	if p.Settings.IAMAuthentication != nil && cloudsql.IAMAuthenticationFlag(gcp.StringValue(p.DatabaseVersion)) == "" {
+		return errors.Errorf(errIAMAuthFmt, gcp.StringValue(p.DatabaseVersion))
+	}
+	flags := cloudsql.DatabaseFlags(p)
+	if c.flags == nil || len(flags) == 0 || p.DatabaseVersion == nil {
+		return nil
+	}
+	supported, err := c.flags.List().DatabaseVersion(*p.DatabaseVersion).Context(ctx).Do()
+	if err != nil {
+		return errors.Wrap(err, errListFlags)
+	}
+	return errors.Wrap(cloudsql.ValidateDatabaseFlags(*p.DatabaseVersion, flags, supported.Items), errInvalidFlags)
 }
 
 func getConnectionDetails(cr *v1beta1.CloudSQLInstance, instance *sqladmin.DatabaseInstance) managed.ConnectionDetails {
@@ -23,6 +23,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"google.golang.org/api/googleapi"
@@ -33,13 +34,16 @@ import (
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 
 	"github.com/crossplane-contrib/provider-gcp/apis/database/v1beta1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
 	"github.com/crossplane-contrib/provider-gcp/pkg/clients/cloudsql"
+	"github.com/crossplane-contrib/provider-gcp/pkg/operation"
 )
 
 const (
@@ -94,6 +98,54 @@ func withBackupConfigurationStartTime(h string) instanceModifier {
 	}
 }
 
+func withDatabaseFlags(version string, f ...*v1beta1.DatabaseFlags) instanceModifier {
+	return func(i *v1beta1.CloudSQLInstance) {
+		i.Spec.ForProvider.DatabaseVersion = &version
+		i.Spec.ForProvider.Settings.DatabaseFlags = f
+	}
+}
+
+func withPromoteReplica(instanceType string) instanceModifier {
+	return func(i *v1beta1.CloudSQLInstance) {
+		i.Spec.ForProvider.PromoteReplica = gcp.BoolPtr(true)
+		i.Status.AtProvider.InstanceType = instanceType
+	}
+}
+
+func withFailover(trigger, last string) instanceModifier {
+	return func(i *v1beta1.CloudSQLInstance) {
+		i.Spec.ForProvider.FailoverTrigger = &trigger
+		i.Status.AtProvider.LastFailoverTrigger = last
+		i.Status.AtProvider.SettingsVersion = 7
+	}
+}
+
+func withFailoverStarted(trigger string) instanceModifier {
+	return func(i *v1beta1.CloudSQLInstance) {
+		meta.AddAnnotations(i, map[string]string{cloudsql.AnnotationKeyFailoverTrigger: trigger})
+	}
+}
+
+func withCreateOperation(op string) instanceModifier {
+	return func(i *v1beta1.CloudSQLInstance) {
+		meta.AddAnnotations(i, map[string]string{operation.AnnotationKeyCreateOperation: op})
+	}
+}
+
+func withOperationStarted(v operation.Verb, t time.Time) instanceModifier {
+	return func(i *v1beta1.CloudSQLInstance) {
+		meta.AddAnnotations(i, map[string]string{operation.AnnotationKeyStarted(v): t.UTC().Format(time.RFC3339)})
+	}
+}
+
+func withMaintenanceVersion(want, current string, available ...string) instanceModifier {
+	return func(i *v1beta1.CloudSQLInstance) {
+		i.Spec.ForProvider.MaintenanceVersion = &want
+		i.Status.AtProvider.MaintenanceVersion = current
+		i.Status.AtProvider.AvailableMaintenanceVersions = available
+	}
+}
+
 func instance(im ...instanceModifier) *v1beta1.CloudSQLInstance {
 	i := &v1beta1.CloudSQLInstance{
 		ObjectMeta: metav1.ObjectMeta{
@@ -148,6 +200,7 @@ var _ managed.ExternalConnecter = &cloudsqlConnector{}
 var _ managed.ExternalClient = &cloudsqlExternal{}
 
 func TestObserve(t *testing.T) {
+	now := time.Now()
 	type args struct {
 		mg resource.Managed
 	}
@@ -242,7 +295,7 @@ func TestObserve(t *testing.T) {
 				}
 			}),
 			args: args{
-				mg: instance(),
+				mg: instance(withOperationStarted(operation.VerbCreate, now)),
 			},
 			want: want{
 				obs: managed.ExternalObservation{
@@ -250,7 +303,7 @@ func TestObserve(t *testing.T) {
 					ResourceUpToDate:  true,
 					ConnectionDetails: connDetails("", ""),
 				},
-				mg: instance(withProviderState(v1beta1.StateCreating), withConditions(xpv1.Creating())),
+				mg: instance(withOperationStarted(operation.VerbCreate, now), withProviderState(v1beta1.StateCreating), withConditions(xpv1.Creating())),
 			},
 		},
 		"Unavailable": {
@@ -268,7 +321,7 @@ func TestObserve(t *testing.T) {
 				}
 			}),
 			args: args{
-				mg: instance(),
+				mg: instance(withOperationStarted(operation.VerbUpdate, now)),
 			},
 			want: want{
 				obs: managed.ExternalObservation{
@@ -276,7 +329,7 @@ func TestObserve(t *testing.T) {
 					ResourceUpToDate:  true,
 					ConnectionDetails: connDetails("", ""),
 				},
-				mg: instance(withProviderState(v1beta1.StateMaintenance), withConditions(xpv1.Unavailable())),
+				mg: instance(withOperationStarted(operation.VerbUpdate, now), withProviderState(v1beta1.StateMaintenance), withConditions(xpv1.Unavailable())),
 			},
 		},
 		"RunnableUnbound": {
@@ -312,6 +365,37 @@ func TestObserve(t *testing.T) {
 					withConnectionName(connectionName)),
 			},
 		},
+		"FailoverStarted": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				db := &sqladmin.DatabaseInstance{}
+				cloudsql.GenerateDatabaseInstance(meta.GetExternalName(instance()), instance().Spec.ForProvider, db)
+				db.State = v1beta1.StateRunnable
+				_ = json.NewEncoder(w).Encode(db)
+			}),
+			kube: &test.MockClient{
+				MockGet: test.NewMockGetFn(nil),
+			},
+			args: args{
+				// The failover was started, but the status recording it
+				// could not be updated.
+				mg: instance(withFailover("2023-05-01", ""), withFailoverStarted("2023-05-01")),
+			},
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: connDetails("", ""),
+				},
+				mg: instance(
+					withFailover("2023-05-01", "2023-05-01"),
+					withFailoverStarted("2023-05-01"),
+					func(i *v1beta1.CloudSQLInstance) { i.Status.AtProvider.SettingsVersion = 0 },
+					withProviderState(v1beta1.StateRunnable),
+					withConditions(xpv1.Available())),
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -383,21 +467,37 @@ func TestCreate(t *testing.T) {
 				}
 				w.WriteHeader(http.StatusOK)
 				_ = r.Body.Close()
-				if err := json.NewEncoder(w).Encode(&sqladmin.Operation{}); err != nil {
+				if err := json.NewEncoder(w).Encode(&sqladmin.Operation{Name: "op"}); err != nil {
 					t.Error(err)
 				}
 			}),
+			kube: &test.MockClient{
+				MockGet:    test.NewMockGetFn(nil),
+				MockUpdate: test.NewMockUpdateFn(nil),
+			},
 			args: args{
 				mg: instance(),
 			},
 			want: want{
-				mg: instance(withConditions(xpv1.Creating())),
+				mg: instance(withConditions(xpv1.Creating()), withCreateOperation("op")),
 				cre: managed.ExternalCreation{ConnectionDetails: managed.ConnectionDetails{
 					xpv1.ResourceCredentialsSecretPasswordKey: []byte(wantRandom),
 				}},
 				err: nil,
 			},
 		},
+		"SkipCreateOperationRunning": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				t.Errorf("r: unexpected %s request to %s", r.Method, r.URL.Path)
+			}),
+			args: args{
+				mg: instance(withCreateOperation("op")),
+			},
+			want: want{
+				mg: instance(withCreateOperation("op")),
+			},
+		},
 		"AlreadyExists": {
 			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 				_ = r.Body.Close()
@@ -664,6 +764,147 @@ func TestUpdate(t *testing.T) {
 				err: errors.Wrap(gError(http.StatusBadRequest, ""), errUpdateFailed),
 			},
 		},
+		"PromoteReplica": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff("/sql/v1beta4/projects/"+projectID+"/instances/"+name+"/promoteReplica", r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&sqladmin.Operation{})
+			}),
+			args: args{
+				mg: instance(withPromoteReplica(v1beta1.InstanceTypeReadReplica)),
+			},
+			want: want{
+				mg: instance(withPromoteReplica(v1beta1.InstanceTypeReadReplica)),
+			},
+		},
+		"PromoteReplicaFails": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&sqladmin.Operation{})
+			}),
+			args: args{
+				mg: instance(withPromoteReplica(v1beta1.InstanceTypeReadReplica)),
+			},
+			want: want{
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errPromoteReplica),
+			},
+		},
+		"Failover": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if diff := cmp.Diff("/sql/v1beta4/projects/"+projectID+"/instances/"+name+"/failover", r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				req := &sqladmin.InstancesFailoverRequest{}
+				_ = json.NewDecoder(r.Body).Decode(req)
+				_ = r.Body.Close()
+				if diff := cmp.Diff(int64(7), req.FailoverContext.SettingsVersion); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&sqladmin.Operation{})
+			}),
+			kube: &test.MockClient{
+				MockGet:    test.NewMockGetFn(nil),
+				MockUpdate: test.NewMockUpdateFn(nil),
+			},
+			args: args{
+				mg: instance(withFailover("2023-05-01", "")),
+			},
+			want: want{
+				mg: instance(withFailover("2023-05-01", "2023-05-01"), withFailoverStarted("2023-05-01")),
+			},
+		},
+		"FailoverNotPersisted": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+				w.WriteHeader(http.StatusBadRequest)
+			}),
+			kube: &test.MockClient{
+				MockGet:    test.NewMockGetFn(nil),
+				MockUpdate: test.NewMockUpdateFn(errBoom),
+			},
+			args: args{
+				mg: instance(withFailover("2023-05-01", "")),
+			},
+			want: want{
+				err: errors.Wrap(errors.Wrap(errBoom, "cannot update critical annotations"), errPersistFailover),
+			},
+		},
+		"FailoverFails": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&sqladmin.Operation{})
+			}),
+			kube: &test.MockClient{
+				MockGet:    test.NewMockGetFn(nil),
+				MockUpdate: test.NewMockUpdateFn(nil),
+			},
+			args: args{
+				mg: instance(withFailover("2023-05-01", "")),
+			},
+			want: want{
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errFailover),
+			},
+		},
+		"MaintenanceVersion": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if diff := cmp.Diff(http.MethodPatch, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				req := map[string]interface{}{}
+				_ = json.NewDecoder(r.Body).Decode(&req)
+				_ = r.Body.Close()
+				if diff := cmp.Diff(map[string]interface{}{"maintenanceVersion": "MYSQL_8_0_31.R20230516.02_00"}, req); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&sqladmin.Operation{})
+			}),
+			args: args{
+				mg: instance(withMaintenanceVersion("MYSQL_8_0_31.R20230516.02_00", "MYSQL_8_0_31.R20230411.01_00", "MYSQL_8_0_31.R20230516.02_00")),
+			},
+			want: want{
+				mg: instance(withMaintenanceVersion("MYSQL_8_0_31.R20230516.02_00", "MYSQL_8_0_31.R20230411.01_00", "MYSQL_8_0_31.R20230516.02_00")),
+			},
+		},
+		"MaintenanceVersionUnavailable": {
+			args: args{
+				mg: instance(withMaintenanceVersion("MYSQL_8_0_31.R20230516.02_00", "MYSQL_8_0_31.R20230411.01_00")),
+			},
+			want: want{
+				err: errors.Errorf(errUnavailableVersion, "MYSQL_8_0_31.R20230516.02_00", []string(nil)),
+			},
+		},
+		"InvalidFlags": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff("MYSQL_8_0", r.URL.Query().Get("databaseVersion")); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				if err := json.NewEncoder(w).Encode(&sqladmin.FlagsListResponse{
+					Items: []*sqladmin.Flag{{Name: "slow_query_log", Type: "BOOLEAN"}},
+				}); err != nil {
+					t.Error(err)
+				}
+			}),
+			args: args{
+				mg: instance(withDatabaseFlags("MYSQL_8_0", &v1beta1.DatabaseFlags{Name: "slow_query_log", Value: "yes"})),
+			},
+			want: want{
+				mg:  instance(withDatabaseFlags("MYSQL_8_0", &v1beta1.DatabaseFlags{Name: "slow_query_log", Value: "yes"})),
+				err: errors.Wrap(errors.New(`database flag "slow_query_log" must be either "on" or "off"`), errInvalidFlags),
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -675,6 +916,8 @@ func TestUpdate(t *testing.T) {
 				kube:      tc.kube,
 				projectID: projectID,
 				db:        s.Instances,
+				flags:     s.Flags,
+				record:    event.NewNopRecorder(),
 			}
 			upd, err := e.Update(context.Background(), tc.args.mg)
 			if tc.want.err != nil && err != nil {
@@ -0,0 +1,170 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"context"
+
+	sqladmin "google.golang.org/api/sqladmin/v1beta4"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/database/v1alpha1"
+	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/cloudsqluser"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/setup"
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+	"github.com/crossplane-contrib/provider-gcp/pkg/tracing"
+)
+
+// Error strings.
+const (
+	errNotCloudSQLUser = "managed resource is not a CloudSQLUser"
+	errListUsers       = "cannot list the users of the CloudSQL instance"
+	errCreateUser      = "cannot create the CloudSQL user"
+	errDeleteUser      = "cannot delete the CloudSQL user"
+)
+
+// SetupCloudSQLUser adds a controller that reconciles CloudSQLUser managed
+// resources.
+func SetupCloudSQLUser(mgr ctrl.Manager, o setup.Options) error {
+	name := managed.ControllerName(v1alpha1.CloudSQLUserGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
+	}
+
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.CloudSQLUserGroupVersionKind),
+		managed.WithExternalConnecter(setup.Connecter(mgr, o, v1alpha1.CloudSQLUserGroupKind, &cloudsqlUserConnector{kube: mgr.GetClient(), record: recorder})),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(recorder),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.CloudSQLUser{}).
+		Complete(tracing.NewReconciler(name, ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter)))
+}
+
+type cloudsqlUserConnector struct {
+	kube   client.Client
+	record event.Recorder
+}
+
+func (c *cloudsqlUserConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetConnectionInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := sqladmin.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &cloudsqlUserExternal{users: s.Users, instances: s.Instances, projectID: projectID, record: c.record}, nil
+}
+
+type cloudsqlUserExternal struct {
+	users     *sqladmin.UsersService
+	instances *sqladmin.InstancesService
+	projectID string
+	record    event.Recorder
+}
+
+// find returns the user the supplied CloudSQLUser describes, or nil if it, or
+// its instance, does not exist.
+func (e *cloudsqlUserExternal) find(ctx context.Context, cr *v1alpha1.CloudSQLUser) (*sqladmin.User, error) {
+	users, err := e.users.List(e.projectID, gcp.StringValue(cr.Spec.ForProvider.Instance)).Context(ctx).Do()
+	if gcp.IsErrorNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, errListUsers)
+	}
+	return cloudsqluser.Find(users.Items, cr.Spec.ForProvider), nil
+}
+
+func (e *cloudsqlUserExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.CloudSQLUser)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotCloudSQLUser)
+	}
+	u, err := e.find(ctx, cr)
+	if err != nil || u == nil {
+		return managed.ExternalObservation{}, err
+	}
+	cr.Status.AtProvider = cloudsqluser.GenerateObservation(u)
+	cr.SetConditions(xpv1.Available())
+	// Users can not be changed once they are created.
+	return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+}
+
+// Create adds the user to the instance. The user is named after its email in
+// the way the database version of the instance requires.
+func (e *cloudsqlUserExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.CloudSQLUser)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotCloudSQLUser)
+	}
+	instance := gcp.StringValue(cr.Spec.ForProvider.Instance)
+	in, err := e.instances.Get(e.projectID, instance).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errGetFailed)
+	}
+	op, err := e.users.Insert(e.projectID, instance, cloudsqluser.GenerateUser(in.DatabaseVersion, cr.Spec.ForProvider)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateUser)
+	}
+	gcp.RecordOperation(e.record, cr, "create", op.Name)
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *cloudsqlUserExternal) Update(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *cloudsqlUserExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.CloudSQLUser)
+	if !ok {
+		return errors.New(errNotCloudSQLUser)
+	}
+	u, err := e.find(ctx, cr)
+	if err != nil || u == nil {
+		return err
+	}
+	op, err := e.users.Delete(e.projectID, gcp.StringValue(cr.Spec.ForProvider.Instance)).Name(u.Name).Context(ctx).Do()
+	if err != nil {
+		return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteUser)
+	}
+	gcp.RecordOperation(e.record, cr, "delete", op.Name)
+	return nil
+}
@@ -0,0 +1,140 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/option"
+	sqladmin "google.golang.org/api/sqladmin/v1beta4"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/database/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	userProject  = "my-project"
+	userInstance = "my-instance"
+	userSA       = "app@my-project.iam.gserviceaccount.com"
+)
+
+func newCloudSQLUser() *v1alpha1.CloudSQLUser {
+	return &v1alpha1.CloudSQLUser{Spec: v1alpha1.CloudSQLUserSpec{ForProvider: v1alpha1.CloudSQLUserParameters{
+		Instance: gcp.StringPtr(userInstance),
+		Type:     v1alpha1.CloudSQLUserTypeIAMServiceAccount,
+		Email:    gcp.StringPtr(userSA),
+	}}}
+}
+
+// usersHandler serves an instance with the supplied database version and the
+// supplied users, and records the users that are inserted.
+func usersHandler(t *testing.T, version string, users []*sqladmin.User, inserted *[]*sqladmin.User) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer func() { _ = r.Body.Close() }()
+		base := "/sql/v1beta4/projects/" + userProject + "/instances/" + userInstance
+		switch {
+		case r.URL.Path == base:
+			_ = json.NewEncoder(w).Encode(&sqladmin.DatabaseInstance{DatabaseVersion: version})
+		case r.URL.Path == base+"/users" && r.Method == http.MethodGet:
+			_ = json.NewEncoder(w).Encode(&sqladmin.UsersListResponse{Items: users})
+		case r.URL.Path == base+"/users" && r.Method == http.MethodPost:
+			u := &sqladmin.User{}
+			_ = json.NewDecoder(r.Body).Decode(u)
+			*inserted = append(*inserted, u)
+			_ = json.NewEncoder(w).Encode(&sqladmin.Operation{})
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+}
+
+func newCloudSQLUserExternal(t *testing.T, h http.Handler) *cloudsqlUserExternal {
+	t.Helper()
+	server := httptest.NewServer(h)
+	t.Cleanup(server.Close)
+	s, _ := sqladmin.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	return &cloudsqlUserExternal{users: s.Users, instances: s.Instances, projectID: userProject}
+}
+
+func TestCloudSQLUserObserve(t *testing.T) {
+	cases := map[string]struct {
+		users []*sqladmin.User
+		want  managed.ExternalObservation
+		name  string
+	}{
+		"NotFound": {
+			users: []*sqladmin.User{{Name: "root"}},
+		},
+		"Found": {
+			users: []*sqladmin.User{{Name: "root"}, {Name: "app@my-project.iam", Type: v1alpha1.CloudSQLUserTypeIAMServiceAccount}},
+			want:  managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			name:  "app@my-project.iam",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := newCloudSQLUser()
+			e := newCloudSQLUserExternal(t, usersHandler(t, "POSTGRES_14", tc.users, nil))
+			got, err := e.Observe(context.Background(), cr)
+			if err != nil {
+				t.Fatalf("Observe(...): unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.name, cr.Status.AtProvider.Name); diff != "" {
+				t.Errorf("Observe(...): -want name, +got name:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCloudSQLUserCreate(t *testing.T) {
+	cases := map[string]struct {
+		version string
+		want    []*sqladmin.User
+	}{
+		"Postgresql": {
+			version: "POSTGRES_14",
+			want:    []*sqladmin.User{{Name: "app@my-project.iam", Type: v1alpha1.CloudSQLUserTypeIAMServiceAccount}},
+		},
+		"Mysql": {
+			version: "MYSQL_8_0",
+			want:    []*sqladmin.User{{Name: userSA, Type: v1alpha1.CloudSQLUserTypeIAMServiceAccount}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var inserted []*sqladmin.User
+			e := newCloudSQLUserExternal(t, usersHandler(t, tc.version, nil, &inserted))
+			if _, err := e.Create(context.Background(), newCloudSQLUser()); err != nil {
+				t.Fatalf("Create(...): unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, inserted); diff != "" {
+				t.Errorf("Create(...): -want users, +got users:\n%s", diff)
+			}
+		})
+	}
+}
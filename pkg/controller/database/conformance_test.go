@@ -0,0 +1,54 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"testing"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/database/v1alpha1"
+	"github.com/crossplane-contrib/provider-gcp/apis/database/v1beta1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/conformance"
+)
+
+func TestConformance(t *testing.T) {
+	conformance.Run(t, []string{v1beta1.Group}, map[string]conformance.Fixture{
+		v1beta1.CloudSQLInstanceGroupKind: {
+			Managed:   func() resource.Managed { return &v1beta1.CloudSQLInstance{} },
+			Connecter: func(kube client.Client) managed.ExternalConnecter { return &cloudsqlConnector{kube: kube} },
+			Found:     `{"settings":{}}`,
+		},
+		v1alpha1.CloudSQLUserGroupKind: {
+			Managed: func() resource.Managed {
+				return &v1alpha1.CloudSQLUser{Spec: v1alpha1.CloudSQLUserSpec{ForProvider: v1alpha1.CloudSQLUserParameters{
+					Instance: gcp.StringPtr("conformance-instance"),
+					Type:     v1alpha1.CloudSQLUserTypeIAMUser,
+					Email:    gcp.StringPtr("alice@example.com"),
+				}}}
+			},
+			Connecter: func(kube client.Client) managed.ExternalConnecter { return &cloudsqlUserConnector{kube: kube} },
+			Identity:  "The user is named after its IAM principal.",
+			Found:     `{"items":[{"name":"alice@example.com","type":"CLOUD_IAM_USER"}]}`,
+			NotFound:  `{}`,
+		},
+	})
+}
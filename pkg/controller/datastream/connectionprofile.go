@@ -0,0 +1,216 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datastream
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	datastream "google.golang.org/api/datastream/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/datastream/v1alpha1"
+	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/connectionprofile"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/setup"
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+	"github.com/crossplane-contrib/provider-gcp/pkg/tracing"
+)
+
+const (
+	errNewClient = "cannot create new Datastream client"
+
+	errNotConnectionProfile        = "managed resource is not of type ConnectionProfile"
+	errGetConnectionProfile        = "cannot get ConnectionProfile"
+	errCreateConnectionProfile     = "cannot create ConnectionProfile"
+	errUpdateConnectionProfile     = "cannot update ConnectionProfile"
+	errDeleteConnectionProfile     = "cannot delete ConnectionProfile"
+	errKubeUpdateConnectionProfile = "cannot update ConnectionProfile custom resource"
+	errGetPasswordSecret           = "cannot get password secret"
+)
+
+// SetupConnectionProfile adds a controller that reconciles
+// ConnectionProfiles.
+func SetupConnectionProfile(mgr ctrl.Manager, o setup.Options) error {
+	name := managed.ControllerName(v1alpha1.ConnectionProfileGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
+	}
+
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.ConnectionProfileGroupVersionKind),
+		managed.WithExternalConnecter(setup.Connecter(mgr, o, v1alpha1.ConnectionProfileGroupKind, &connectionProfileConnector{client: mgr.GetClient(), record: recorder})),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(recorder),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.ConnectionProfile{}).
+		Complete(tracing.NewReconciler(name, ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter)))
+}
+
+type connectionProfileConnector struct {
+	client client.Client
+	record event.Recorder
+}
+
+// Connect returns an ExternalClient with necessary information to talk to GCP API.
+func (c *connectionProfileConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetConnectionInfo(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := datastream.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &connectionProfileExternal{projectID: projectID, client: c.client, datastream: s, record: c.record}, nil
+}
+
+type connectionProfileExternal struct {
+	projectID  string
+	client     client.Client
+	datastream *datastream.Service
+	record     event.Recorder
+}
+
+// Observe makes observation about the external resource.
+func (e *connectionProfileExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ConnectionProfile)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotConnectionProfile)
+	}
+	name := connectionprofile.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider.Location, meta.GetExternalName(cr))
+	cp, err := e.datastream.Projects.Locations.ConnectionProfiles.Get(name).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetConnectionProfile)
+	}
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	connectionprofile.LateInitialize(&cr.Spec.ForProvider, *cp)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
+		if err := e.client.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errKubeUpdateConnectionProfile)
+		}
+	}
+	cr.Status.AtProvider = connectionprofile.GenerateObservation(*cp)
+	cr.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: connectionprofile.IsUpToDate(cr.Spec.ForProvider, *cp),
+	}, nil
+}
+
+// Create initiates creation of external resource.
+func (e *connectionProfileExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ConnectionProfile)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotConnectionProfile)
+	}
+	cr.SetConditions(xpv1.Creating())
+	pw, err := e.password(ctx, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	op, err := e.datastream.Projects.Locations.ConnectionProfiles.Create(connectionprofile.GetParent(e.projectID, cr.Spec.ForProvider.Location), connectionprofile.GenerateConnectionProfile("", cr.Spec.ForProvider, pw)).
+		ConnectionProfileId(meta.GetExternalName(cr)).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateConnectionProfile)
+	}
+	gcp.RecordOperation(e.record, cr, "create", op.Name)
+	return managed.ExternalCreation{}, nil
+}
+
+// Update initiates an update to the external resource.
+func (e *connectionProfileExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.ConnectionProfile)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotConnectionProfile)
+	}
+	name := connectionprofile.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider.Location, meta.GetExternalName(cr))
+	cp, err := e.datastream.Projects.Locations.ConnectionProfiles.Get(name).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetConnectionProfile)
+	}
+	pw, err := e.password(ctx, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	op, err := e.datastream.Projects.Locations.ConnectionProfiles.Patch(name, connectionprofile.GenerateConnectionProfile(name, cr.Spec.ForProvider, pw)).
+		UpdateMask(connectionprofile.GenerateUpdateMask(cr.Spec.ForProvider, *cp)).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateConnectionProfile)
+	}
+	gcp.RecordOperation(e.record, cr, "update", op.Name)
+	return managed.ExternalUpdate{}, nil
+}
+
+// Delete initiates an deletion of the external resource.
+func (e *connectionProfileExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.ConnectionProfile)
+	if !ok {
+		return errors.New(errNotConnectionProfile)
+	}
+	name := connectionprofile.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider.Location, meta.GetExternalName(cr))
+	op, err := e.datastream.Projects.Locations.ConnectionProfiles.Delete(name).Context(ctx).Do()
+	if err != nil {
+		return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteConnectionProfile)
+	}
+	gcp.RecordOperation(e.record, cr, "delete", op.Name)
+	return nil
+}
+
+// password returns the database password of a MySQL or PostgreSQL profile,
+// or an empty string for other profiles.
+func (e *connectionProfileExternal) password(ctx context.Context, p v1alpha1.ConnectionProfileParameters) (string, error) {
+	var ref xpv1.SecretKeySelector
+	switch {
+	case p.MySQLProfile != nil:
+		ref = p.MySQLProfile.PasswordSecretRef
+	case p.PostgreSQLProfile != nil:
+		ref = p.PostgreSQLProfile.PasswordSecretRef
+	default:
+		return "", nil
+	}
+	s := &corev1.Secret{}
+	if err := e.client.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s); err != nil {
+		return "", errors.Wrap(err, errGetPasswordSecret)
+	}
+	return string(s.Data[ref.Key]), nil
+}
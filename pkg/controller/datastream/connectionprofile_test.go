@@ -0,0 +1,320 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datastream
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	datastream "google.golang.org/api/datastream/v1"
+	"google.golang.org/api/option"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/datastream/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	profileName     = "mysql"
+	profilePath     = "/v1/projects/fooproject/locations/us-central1/connectionProfiles/mysql"
+	profilePassword = "s3cr3t"
+)
+
+var errBoom = errors.New("boom")
+
+func newConnectionProfile(m ...func(*v1alpha1.ConnectionProfile)) *v1alpha1.ConnectionProfile {
+	cp := &v1alpha1.ConnectionProfile{}
+	meta.SetExternalName(cp, profileName)
+	cp.Spec.ForProvider = v1alpha1.ConnectionProfileParameters{
+		Location:    "us-central1",
+		DisplayName: "mysql",
+		MySQLProfile: &v1alpha1.MySQLProfile{
+			Hostname: gcp.StringPtr("10.0.0.2"),
+			Port:     gcp.Int64Ptr(3306),
+			Username: "datastream",
+			PasswordSecretRef: xpv1.SecretKeySelector{
+				SecretReference: xpv1.SecretReference{Namespace: "default", Name: "mysql"},
+				Key:             "password",
+			},
+		},
+	}
+	for _, f := range m {
+		f(cp)
+	}
+	return cp
+}
+
+func observedConnectionProfile(m ...func(*datastream.ConnectionProfile)) *datastream.ConnectionProfile {
+	cp := &datastream.ConnectionProfile{
+		Name:        "projects/fooproject/locations/us-central1/connectionProfiles/mysql",
+		DisplayName: "mysql",
+		MysqlProfile: &datastream.MysqlProfile{
+			Hostname: "10.0.0.2",
+			Port:     3306,
+			Username: "datastream",
+		},
+	}
+	for _, f := range m {
+		f(cp)
+	}
+	return cp
+}
+
+func passwordSecret() *test.MockClient {
+	return &test.MockClient{
+		MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+			s := obj.(*corev1.Secret)
+			s.Data = map[string][]byte{"password": []byte(profilePassword)}
+			return nil
+		}),
+	}
+}
+
+func TestConnectionProfileObserve(t *testing.T) {
+	type args struct {
+		handler http.Handler
+		kube    client.Client
+		mg      *v1alpha1.ConnectionProfile
+	}
+	type want struct {
+		eo  managed.ExternalObservation
+		err error
+	}
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotFound": {
+			args: args{
+				handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					_ = r.Body.Close()
+					w.WriteHeader(http.StatusNotFound)
+					_ = json.NewEncoder(w).Encode(struct{}{})
+				}),
+				mg: newConnectionProfile(),
+			},
+		},
+		"GetFailed": {
+			args: args{
+				handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					_ = r.Body.Close()
+					w.WriteHeader(http.StatusBadRequest)
+					_ = json.NewEncoder(w).Encode(struct{}{})
+				}),
+				mg: newConnectionProfile(),
+			},
+			want: want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetConnectionProfile)},
+		},
+		"SpecUpdateFailed": {
+			args: args{
+				handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					_ = r.Body.Close()
+					w.WriteHeader(http.StatusOK)
+					_ = json.NewEncoder(w).Encode(observedConnectionProfile())
+				}),
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+				mg: newConnectionProfile(func(cp *v1alpha1.ConnectionProfile) {
+					cp.Spec.ForProvider.MySQLProfile.Port = nil
+				}),
+			},
+			want: want{err: errors.Wrap(errBoom, errKubeUpdateConnectionProfile)},
+		},
+		"UpToDate": {
+			args: args{
+				handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					_ = r.Body.Close()
+					if diff := cmp.Diff(profilePath, r.URL.Path); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					w.WriteHeader(http.StatusOK)
+					_ = json.NewEncoder(w).Encode(observedConnectionProfile())
+				}),
+				mg: newConnectionProfile(),
+			},
+			want: want{eo: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+		},
+		"NotUpToDate": {
+			args: args{
+				handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					_ = r.Body.Close()
+					w.WriteHeader(http.StatusOK)
+					_ = json.NewEncoder(w).Encode(observedConnectionProfile(func(cp *datastream.ConnectionProfile) {
+						cp.MysqlProfile.Hostname = "10.0.0.3"
+					}))
+				}),
+				mg: newConnectionProfile(),
+			},
+			want: want{eo: managed.ExternalObservation{ResourceExists: true}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.args.handler)
+			defer server.Close()
+			s, _ := datastream.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := connectionProfileExternal{projectID: projectID, client: tc.args.kube, datastream: s, record: event.NewNopRecorder()}
+			got, err := e.Observe(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.eo, got); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestConnectionProfileCreate(t *testing.T) {
+	cases := map[string]struct {
+		kube    client.Client
+		handler http.Handler
+		err     error
+	}{
+		"Successful": {
+			kube: passwordSecret(),
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				cp := &datastream.ConnectionProfile{}
+				_ = json.NewDecoder(r.Body).Decode(cp)
+				_ = r.Body.Close()
+				if diff := cmp.Diff("/v1/projects/fooproject/locations/us-central1/connectionProfiles", r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff(profileName, r.URL.Query().Get("connectionProfileId")); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff(profilePassword, cp.MysqlProfile.Password); diff != "" {
+					t.Errorf("r: -want password, +got password:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&datastream.Operation{})
+			}),
+		},
+		"PasswordSecretFailed": {
+			kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				t.Errorf("r: unexpected request %s %s", r.Method, r.URL.Path)
+			}),
+			err: errors.Wrap(errBoom, errGetPasswordSecret),
+		},
+		"CreateFailed": {
+			kube: passwordSecret(),
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+			err: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateConnectionProfile),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := datastream.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := connectionProfileExternal{projectID: projectID, client: tc.kube, datastream: s, record: event.NewNopRecorder()}
+			_, err := e.Create(context.Background(), newConnectionProfile())
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestConnectionProfileUpdate(t *testing.T) {
+	var gotMask string
+	got := &datastream.ConnectionProfile{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer func() { _ = r.Body.Close() }()
+		w.WriteHeader(http.StatusOK)
+		if r.Method == http.MethodGet {
+			_ = json.NewEncoder(w).Encode(observedConnectionProfile(func(cp *datastream.ConnectionProfile) {
+				cp.DisplayName = "old"
+			}))
+			return
+		}
+		gotMask = r.URL.Query().Get("updateMask")
+		_ = json.NewDecoder(r.Body).Decode(got)
+		_ = json.NewEncoder(w).Encode(&datastream.Operation{})
+	}))
+	defer server.Close()
+
+	s, _ := datastream.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	e := connectionProfileExternal{projectID: projectID, client: passwordSecret(), datastream: s, record: event.NewNopRecorder()}
+	if _, err := e.Update(context.Background(), newConnectionProfile()); err != nil {
+		t.Fatalf("Update(...): unexpected error: %v", err)
+	}
+	if diff := cmp.Diff("displayName", gotMask); diff != "" {
+		t.Errorf("Update(...): -want mask, +got mask:\n%s", diff)
+	}
+	if diff := cmp.Diff(profilePassword, got.MysqlProfile.Password); diff != "" {
+		t.Errorf("Update(...): -want password, +got password:\n%s", diff)
+	}
+}
+
+func TestConnectionProfileDelete(t *testing.T) {
+	cases := map[string]struct {
+		status int
+		err    error
+	}{
+		"Successful": {
+			status: http.StatusOK,
+		},
+		"AlreadyGone": {
+			status: http.StatusNotFound,
+		},
+		"Failed": {
+			status: http.StatusBadRequest,
+			err:    errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteConnectionProfile),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodDelete, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				if tc.status == http.StatusOK {
+					_ = json.NewEncoder(w).Encode(&datastream.Operation{})
+					return
+				}
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}))
+			defer server.Close()
+			s, _ := datastream.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := connectionProfileExternal{projectID: projectID, datastream: s, record: event.NewNopRecorder()}
+			err := e.Delete(context.Background(), newConnectionProfile())
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,193 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datastream
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	datastream "google.golang.org/api/datastream/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/datastream/v1alpha1"
+	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/stream"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/setup"
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+	"github.com/crossplane-contrib/provider-gcp/pkg/tracing"
+)
+
+const (
+	errNotStream        = "managed resource is not of type Stream"
+	errGetStream        = "cannot get Stream"
+	errCreateStream     = "cannot create Stream"
+	errUpdateStream     = "cannot update Stream"
+	errDeleteStream     = "cannot delete Stream"
+	errKubeUpdateStream = "cannot update Stream custom resource"
+)
+
+// SetupStream adds a controller that reconciles Streams.
+func SetupStream(mgr ctrl.Manager, o setup.Options) error {
+	name := managed.ControllerName(v1alpha1.StreamGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
+	}
+
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.StreamGroupVersionKind),
+		managed.WithExternalConnecter(setup.Connecter(mgr, o, v1alpha1.StreamGroupKind, &streamConnector{client: mgr.GetClient(), record: recorder})),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(recorder),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.Stream{}).
+		Complete(tracing.NewReconciler(name, ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter)))
+}
+
+type streamConnector struct {
+	client client.Client
+	record event.Recorder
+}
+
+// Connect returns an ExternalClient with necessary information to talk to GCP API.
+func (c *streamConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetConnectionInfo(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := datastream.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &streamExternal{projectID: projectID, client: c.client, datastream: s, record: c.record}, nil
+}
+
+type streamExternal struct {
+	projectID  string
+	client     client.Client
+	datastream *datastream.Service
+	record     event.Recorder
+}
+
+// Observe makes observation about the external resource.
+func (e *streamExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Stream)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotStream)
+	}
+	name := stream.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider.Location, meta.GetExternalName(cr))
+	s, err := e.datastream.Projects.Locations.Streams.Get(name).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetStream)
+	}
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	stream.LateInitialize(&cr.Spec.ForProvider, *s)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
+		if err := e.client.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errKubeUpdateStream)
+		}
+	}
+	cr.Status.AtProvider = stream.GenerateObservation(*s)
+	switch s.State {
+	case v1alpha1.StreamStateStarting:
+		cr.SetConditions(xpv1.Creating())
+	case v1alpha1.StreamStateFailed, v1alpha1.StreamStateFailedPermanently:
+		cr.SetConditions(xpv1.Unavailable())
+	default:
+		cr.SetConditions(xpv1.Available())
+	}
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: stream.IsUpToDate(cr.Spec.ForProvider, *s),
+	}, nil
+}
+
+// Create initiates creation of external resource.
+func (e *streamExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Stream)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotStream)
+	}
+	cr.SetConditions(xpv1.Creating())
+	op, err := e.datastream.Projects.Locations.Streams.Create(stream.GetParent(e.projectID, cr.Spec.ForProvider.Location), stream.GenerateStream("", cr.Spec.ForProvider)).
+		StreamId(meta.GetExternalName(cr)).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateStream)
+	}
+	gcp.RecordOperation(e.record, cr, "create", op.Name)
+	return managed.ExternalCreation{}, nil
+}
+
+// Update initiates an update to the external resource. A change of the
+// desired state starts, pauses or resumes the stream.
+func (e *streamExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Stream)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotStream)
+	}
+	name := stream.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider.Location, meta.GetExternalName(cr))
+	s, err := e.datastream.Projects.Locations.Streams.Get(name).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetStream)
+	}
+	desired := stream.GenerateStream(name, cr.Spec.ForProvider)
+	desired.State = gcp.StringValue(cr.Spec.ForProvider.DesiredState)
+	op, err := e.datastream.Projects.Locations.Streams.Patch(name, desired).
+		UpdateMask(stream.GenerateUpdateMask(cr.Spec.ForProvider, *s)).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateStream)
+	}
+	gcp.RecordOperation(e.record, cr, "update", op.Name)
+	return managed.ExternalUpdate{}, nil
+}
+
+// Delete initiates an deletion of the external resource.
+func (e *streamExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Stream)
+	if !ok {
+		return errors.New(errNotStream)
+	}
+	name := stream.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider.Location, meta.GetExternalName(cr))
+	op, err := e.datastream.Projects.Locations.Streams.Delete(name).Context(ctx).Do()
+	if err != nil {
+		return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteStream)
+	}
+	gcp.RecordOperation(e.record, cr, "delete", op.Name)
+	return nil
+}
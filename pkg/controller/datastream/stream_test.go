@@ -0,0 +1,205 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datastream
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	datastream "google.golang.org/api/datastream/v1"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/datastream/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	projectID  = "fooproject"
+	streamName = "orders"
+	streamPath = "/v1/projects/fooproject/locations/us-central1/streams/orders"
+)
+
+func gError(code int, message string) *googleapi.Error {
+	return &googleapi.Error{
+		Code:    code,
+		Body:    "{}\n",
+		Message: message,
+	}
+}
+
+func newStream(m ...func(*v1alpha1.Stream)) *v1alpha1.Stream {
+	s := &v1alpha1.Stream{}
+	meta.SetExternalName(s, streamName)
+	s.Spec.ForProvider = v1alpha1.StreamParameters{
+		Location:    "us-central1",
+		DisplayName: "orders",
+		SourceConfig: v1alpha1.StreamSourceConfig{
+			SourceConnectionProfile: gcp.StringPtr("projects/fooproject/locations/us-central1/connectionProfiles/mysql"),
+			MySQLSourceConfig:       &v1alpha1.MySQLSourceConfig{},
+		},
+		DestinationConfig: v1alpha1.StreamDestinationConfig{
+			DestinationConnectionProfile: gcp.StringPtr("projects/fooproject/locations/us-central1/connectionProfiles/gcs"),
+			GCSDestinationConfig:         &v1alpha1.GCSDestinationConfig{},
+		},
+	}
+	for _, f := range m {
+		f(s)
+	}
+	return s
+}
+
+func observedStream(state string) *datastream.Stream {
+	return &datastream.Stream{
+		Name:        "projects/fooproject/locations/us-central1/streams/orders",
+		DisplayName: "orders",
+		State:       state,
+		SourceConfig: &datastream.SourceConfig{
+			SourceConnectionProfile: "projects/fooproject/locations/us-central1/connectionProfiles/mysql",
+			MysqlSourceConfig:       &datastream.MysqlSourceConfig{},
+		},
+		DestinationConfig: &datastream.DestinationConfig{
+			DestinationConnectionProfile: "projects/fooproject/locations/us-central1/connectionProfiles/gcs",
+			GcsDestinationConfig:         &datastream.GcsDestinationConfig{},
+		},
+	}
+}
+
+func TestStreamObserve(t *testing.T) {
+	type want struct {
+		eo   managed.ExternalObservation
+		cond xpv1.Condition
+		err  error
+	}
+	cases := map[string]struct {
+		handler http.Handler
+		mg      *v1alpha1.Stream
+		want    want
+	}{
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+			mg: newStream(),
+		},
+		"GetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+			mg:   newStream(),
+			want: want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetStream)},
+		},
+		"NeedsStart": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(streamPath, r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(observedStream(v1alpha1.StreamStateNotStarted))
+			}),
+			mg: newStream(func(s *v1alpha1.Stream) {
+				s.Spec.ForProvider.DesiredState = gcp.StringPtr(v1alpha1.StreamStateRunning)
+			}),
+			want: want{
+				eo:   managed.ExternalObservation{ResourceExists: true},
+				cond: xpv1.Available(),
+			},
+		},
+		"Failed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(observedStream(v1alpha1.StreamStateFailed))
+			}),
+			mg: newStream(),
+			want: want{
+				eo:   managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				cond: xpv1.Unavailable(),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := datastream.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := streamExternal{projectID: projectID, datastream: s}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.eo, got); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if tc.want.cond.Type == "" {
+				return
+			}
+			if diff := cmp.Diff(tc.want.cond, tc.mg.GetCondition(xpv1.TypeReady), test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want condition, +got condition:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestStreamUpdate(t *testing.T) {
+	var gotMask string
+	got := &datastream.Stream{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer func() { _ = r.Body.Close() }()
+		w.WriteHeader(http.StatusOK)
+		if r.Method == http.MethodGet {
+			_ = json.NewEncoder(w).Encode(observedStream(v1alpha1.StreamStateNotStarted))
+			return
+		}
+		gotMask = r.URL.Query().Get("updateMask")
+		b, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(b, got)
+		_ = json.NewEncoder(w).Encode(&datastream.Operation{})
+	}))
+	defer server.Close()
+
+	s, _ := datastream.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	e := streamExternal{projectID: projectID, datastream: s}
+	mg := newStream(func(s *v1alpha1.Stream) {
+		s.Spec.ForProvider.DesiredState = gcp.StringPtr(v1alpha1.StreamStateRunning)
+	})
+	if _, err := e.Update(context.Background(), mg); err != nil {
+		t.Fatalf("Update(...): unexpected error: %v", err)
+	}
+	if diff := cmp.Diff("state", gotMask); diff != "" {
+		t.Errorf("Update(...): -want mask, +got mask:\n%s", diff)
+	}
+	if diff := cmp.Diff(v1alpha1.StreamStateRunning, got.State); diff != "" {
+		t.Errorf("Update(...): -want state, +got state:\n%s", diff)
+	}
+}
@@ -19,7 +19,6 @@ package dns
 import (
 	"context"
 
-	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
 	"github.com/google/go-cmp/cmp"
 	dns "google.golang.org/api/dns/v1"
@@ -38,7 +37,9 @@ import (
 	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
 	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
 	rrsclient "github.com/crossplane-contrib/provider-gcp/pkg/clients/dns"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/setup"
 	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+	"github.com/crossplane-contrib/provider-gcp/pkg/tracing"
 )
 
 const (
@@ -53,7 +54,7 @@ const (
 
 // SetupResourceRecordSet adds a controller that reconciles
 // ResourceRecordSet managed resources.
-func SetupResourceRecordSet(mgr ctrl.Manager, o controller.Options) error {
+func SetupResourceRecordSet(mgr ctrl.Manager, o setup.Options) error {
 	name := managed.ControllerName(v1alpha1.ResourceRecordSetGroupKind)
 
 	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
@@ -63,7 +64,7 @@ func SetupResourceRecordSet(mgr ctrl.Manager, o controller.Options) error {
 
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(v1alpha1.ResourceRecordSetGroupVersionKind),
-		managed.WithExternalConnecter(&connector{kube: mgr.GetClient()}),
+		managed.WithExternalConnecter(setup.Connecter(mgr, o, v1alpha1.ResourceRecordSetGroupKind, &connector{kube: mgr.GetClient()})),
 		managed.WithInitializers(rrsclient.NewCustomNameAsExternalName(mgr.GetClient())),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
@@ -74,7 +75,7 @@ func SetupResourceRecordSet(mgr ctrl.Manager, o controller.Options) error {
 		Named(name).
 		WithOptions(o.ForControllerRuntime()).
 		For(&v1alpha1.ResourceRecordSet{}).
-		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+		Complete(tracing.NewReconciler(name, ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter)))
 }
 
 type connector struct {
@@ -131,6 +132,7 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		}
 		lateInit = true
 	}
+	cr.Status.AtProvider = rrsclient.GenerateObservation(e.projectID, cr.Spec.ForProvider.ManagedZone, *rrs)
 	cr.SetConditions(xpv1.Available())
 
 	upToDate, err := rrsclient.IsUpToDate(meta.GetExternalName(cr), &cr.Spec.ForProvider, rrs)
@@ -19,26 +19,41 @@ package controller
 import (
 	ctrl "sigs.k8s.io/controller-runtime"
 
-	"github.com/crossplane/crossplane-runtime/pkg/controller"
-
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/apigee"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/batch"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/bigquery"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/billing"
 	"github.com/crossplane-contrib/provider-gcp/pkg/controller/cache"
 	"github.com/crossplane-contrib/provider-gcp/pkg/controller/compute"
 	"github.com/crossplane-contrib/provider-gcp/pkg/controller/config"
 	"github.com/crossplane-contrib/provider-gcp/pkg/controller/container"
 	"github.com/crossplane-contrib/provider-gcp/pkg/controller/database"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/datastream"
 	"github.com/crossplane-contrib/provider-gcp/pkg/controller/dns"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/healthcare"
 	"github.com/crossplane-contrib/provider-gcp/pkg/controller/iam"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/identityplatform"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/ids"
 	"github.com/crossplane-contrib/provider-gcp/pkg/controller/kms"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/networksecurity"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/networkservices"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/osconfig"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/privateca"
 	"github.com/crossplane-contrib/provider-gcp/pkg/controller/pubsub"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/recaptchaenterprise"
 	"github.com/crossplane-contrib/provider-gcp/pkg/controller/registry"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/securitycenter"
 	"github.com/crossplane-contrib/provider-gcp/pkg/controller/servicenetworking"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/setup"
 	"github.com/crossplane-contrib/provider-gcp/pkg/controller/storage"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/tpu"
+	"github.com/crossplane-contrib/provider-gcp/pkg/discovery"
 )
 
 // Setup creates all GCP controllers with the supplied logger and adds them to
 // the supplied manager.
-func Setup(mgr ctrl.Manager, o controller.Options) error {
-	for _, setup := range []func(ctrl.Manager, controller.Options) error{
+func Setup(mgr ctrl.Manager, o setup.Options) error {
+	for _, fn := range []func(ctrl.Manager, setup.Options) error{
 		cache.SetupCloudMemorystoreInstance,
 		compute.SetupGlobalAddress,
 		compute.SetupAddress,
@@ -46,9 +61,23 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 		compute.SetupSubnetwork,
 		compute.SetupFirewall,
 		compute.SetupRouter,
+		compute.SetupTargetTCPProxy,
+		compute.SetupTargetSSLProxy,
+		compute.SetupAutoscaler,
+		compute.SetupServiceAttachment,
+		compute.SetupInterconnectAttachment,
+		compute.SetupProjectSSHKey,
+		compute.SetupForwardingRule,
+		compute.SetupPublicAdvertisedPrefix,
+		compute.SetupPublicDelegatedPrefix,
+		compute.SetupMachineImage,
+		compute.SetupImageImport,
+		compute.SetupInstanceTemplate,
+		compute.SetupUnmanagedInstanceGroup,
 		container.SetupCluster,
 		container.SetupNodePool,
 		database.SetupCloudSQLInstance,
+		database.SetupCloudSQLUser,
 		dns.SetupPolicy,
 		dns.SetupResourceRecordSet,
 		iam.SetupServiceAccount,
@@ -63,11 +92,50 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 		storage.SetupBucket,
 		storage.SetupBucketPolicy,
 		storage.SetupBucketPolicyMember,
+		storage.SetupBucketObject,
 		registry.SetupContainerRegistry,
+		securitycenter.SetupNotificationConfig,
+		securitycenter.SetupMuteConfig,
+		osconfig.SetupPatchDeployment,
+		osconfig.SetupGuestPolicy,
+		datastream.SetupConnectionProfile,
+		datastream.SetupStream,
+		bigquery.SetupDataset,
+		bigquery.SetupDatasetIAMMember,
+		bigquery.SetupTableIAMMember,
+		bigquery.SetupRowAccessPolicy,
+		recaptchaenterprise.SetupKey,
+		identityplatform.SetupTenant,
+		identityplatform.SetupConfig,
+		networkservices.SetupMesh,
+		networkservices.SetupGateway,
+		networkservices.SetupHTTPRoute,
+		networkservices.SetupGRPCRoute,
+		batch.SetupJob,
+		tpu.SetupNode,
+		compute.SetupPacketMirroring,
+		ids.SetupEndpoint,
+		networksecurity.SetupServerTLSPolicy,
+		networksecurity.SetupClientTLSPolicy,
+		networksecurity.SetupAuthorizationPolicy,
+		billing.SetupBudget,
+		billing.SetupProjectBillingInfo,
+		apigee.SetupOrganization,
+		apigee.SetupEnvironment,
+		apigee.SetupEnvGroup,
+		apigee.SetupInstance,
+		healthcare.SetupDataset,
+		healthcare.SetupFHIRStore,
+		healthcare.SetupDICOMStore,
+		healthcare.SetupHL7V2Store,
+		privateca.SetupCaPool,
+		privateca.SetupCertificateAuthority,
+		privateca.SetupCertificateTemplate,
+		discovery.Setup,
 	} {
-		if err := setup(mgr, o); err != nil {
+		if err := fn(mgr, o); err != nil {
 			return err
 		}
 	}
-	return config.Setup(mgr, o)
+	return config.Setup(mgr, o.Options)
 }
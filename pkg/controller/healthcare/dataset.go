@@ -0,0 +1,175 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package healthcare
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	healthcare "google.golang.org/api/healthcare/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/healthcare/v1alpha1"
+	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/healthcaredataset"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/setup"
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+	"github.com/crossplane-contrib/provider-gcp/pkg/tracing"
+)
+
+const (
+	errNewClient = "cannot create new Cloud Healthcare API client"
+
+	errNotDataset        = "managed resource is not of type Dataset"
+	errGetDataset        = "cannot get Dataset"
+	errCreateDataset     = "cannot create Dataset"
+	errUpdateDataset     = "cannot update Dataset"
+	errDeleteDataset     = "cannot delete Dataset"
+	errKubeUpdateDataset = "cannot update Dataset custom resource"
+)
+
+// SetupDataset adds a controller that reconciles Datasets.
+func SetupDataset(mgr ctrl.Manager, o setup.Options) error {
+	name := managed.ControllerName(v1alpha1.DatasetGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
+	}
+
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.DatasetGroupVersionKind),
+		managed.WithExternalConnecter(setup.Connecter(mgr, o, v1alpha1.DatasetGroupKind, &datasetConnector{client: mgr.GetClient(), record: recorder})),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(recorder),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.Dataset{}).
+		Complete(tracing.NewReconciler(name, ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter)))
+}
+
+type datasetConnector struct {
+	client client.Client
+	record event.Recorder
+}
+
+// Connect returns an ExternalClient with necessary information to talk to GCP API.
+func (c *datasetConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetConnectionInfo(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := healthcare.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &datasetExternal{projectID: projectID, client: c.client, datasets: s.Projects.Locations.Datasets, record: c.record}, nil
+}
+
+type datasetExternal struct {
+	projectID string
+	client    client.Client
+	datasets  *healthcare.ProjectsLocationsDatasetsService
+	record    event.Recorder
+}
+
+// Observe makes observation about the external resource.
+func (e *datasetExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Dataset)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotDataset)
+	}
+	ds, err := e.datasets.Get(healthcaredataset.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider.Location, meta.GetExternalName(cr))).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetDataset)
+	}
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	healthcaredataset.LateInitialize(&cr.Spec.ForProvider, *ds)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
+		if err := e.client.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errKubeUpdateDataset)
+		}
+	}
+	cr.Status.AtProvider = healthcaredataset.GenerateObservation(*ds)
+	cr.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: healthcaredataset.IsUpToDate(cr.Spec.ForProvider, *ds),
+	}, nil
+}
+
+// Create initiates creation of external resource.
+func (e *datasetExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Dataset)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotDataset)
+	}
+	cr.SetConditions(xpv1.Creating())
+	op, err := e.datasets.Create(healthcaredataset.GetParent(e.projectID, cr.Spec.ForProvider.Location), healthcaredataset.GenerateDataset("", cr.Spec.ForProvider)).
+		DatasetId(meta.GetExternalName(cr)).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateDataset)
+	}
+	gcp.RecordOperation(e.record, cr, "create", op.Name)
+	return managed.ExternalCreation{}, nil
+}
+
+// Update initiates an update to the external resource. Only the time zone of
+// a dataset can be updated.
+func (e *datasetExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Dataset)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotDataset)
+	}
+	name := healthcaredataset.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider.Location, meta.GetExternalName(cr))
+	_, err := e.datasets.Patch(name, healthcaredataset.GenerateDataset(name, cr.Spec.ForProvider)).
+		UpdateMask("timeZone").
+		Context(ctx).
+		Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateDataset)
+}
+
+// Delete initiates an deletion of the external resource. Deleting a dataset
+// deletes all of its stores and their data.
+func (e *datasetExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Dataset)
+	if !ok {
+		return errors.New(errNotDataset)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.datasets.Delete(healthcaredataset.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider.Location, meta.GetExternalName(cr))).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteDataset)
+}
@@ -0,0 +1,248 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package healthcare
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	healthcare "google.golang.org/api/healthcare/v1"
+	"google.golang.org/api/option"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/healthcare/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	datasetPath = "/v1/" + datasetName
+)
+
+var errBoom = errors.New("boom")
+
+func newDataset(m ...func(*v1alpha1.Dataset)) *v1alpha1.Dataset {
+	ds := &v1alpha1.Dataset{}
+	meta.SetExternalName(ds, "foo")
+	ds.Spec.ForProvider = v1alpha1.DatasetParameters{
+		Location: "us-central1",
+		TimeZone: gcp.StringPtr("UTC"),
+	}
+	for _, f := range m {
+		f(ds)
+	}
+	return ds
+}
+
+func TestDatasetObserve(t *testing.T) {
+	type want struct {
+		eo   managed.ExternalObservation
+		cond xpv1.Condition
+		err  error
+	}
+	cases := map[string]struct {
+		handler http.Handler
+		kube    *test.MockClient
+		mg      *v1alpha1.Dataset
+		want    want
+	}{
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+			mg: newDataset(),
+		},
+		"GetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+			mg:   newDataset(),
+			want: want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetDataset)},
+		},
+		"UpToDate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(datasetPath, r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&healthcare.Dataset{Name: datasetName, TimeZone: "UTC"})
+			}),
+			mg: newDataset(),
+			want: want{
+				eo:   managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				cond: xpv1.Available(),
+			},
+		},
+		"NeedsUpdate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&healthcare.Dataset{Name: datasetName, TimeZone: "America/New_York"})
+			}),
+			mg: newDataset(),
+			want: want{
+				eo:   managed.ExternalObservation{ResourceExists: true},
+				cond: xpv1.Available(),
+			},
+		},
+		"LateInitFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&healthcare.Dataset{Name: datasetName, TimeZone: "UTC"})
+			}),
+			kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			mg: newDataset(func(ds *v1alpha1.Dataset) {
+				ds.Spec.ForProvider.TimeZone = nil
+			}),
+			want: want{err: errors.Wrap(errBoom, errKubeUpdateDataset)},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := healthcare.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := datasetExternal{projectID: "fooproject", client: tc.kube, datasets: s.Projects.Locations.Datasets}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.eo, got); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if tc.want.cond.Type == "" {
+				return
+			}
+			if diff := cmp.Diff(tc.want.cond, tc.mg.GetCondition(xpv1.TypeReady), test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want condition, +got condition:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDatasetCreate(t *testing.T) {
+	var gotID string
+	got := &healthcare.Dataset{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer func() { _ = r.Body.Close() }()
+		if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+			t.Errorf("r: -want method, +got method:\n%s", diff)
+		}
+		if diff := cmp.Diff("/v1/projects/fooproject/locations/us-central1/datasets", r.URL.Path); diff != "" {
+			t.Errorf("r: -want path, +got path:\n%s", diff)
+		}
+		gotID = r.URL.Query().Get("datasetId")
+		_ = json.NewDecoder(r.Body).Decode(got)
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(&healthcare.Operation{Name: "op"})
+	}))
+	defer server.Close()
+
+	s, _ := healthcare.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	e := datasetExternal{projectID: "fooproject", datasets: s.Projects.Locations.Datasets, record: event.NewNopRecorder()}
+	mg := newDataset()
+	if _, err := e.Create(context.Background(), mg); err != nil {
+		t.Fatalf("Create(...): unexpected error: %v", err)
+	}
+	if diff := cmp.Diff("foo", gotID); diff != "" {
+		t.Errorf("Create(...): -want ID, +got ID:\n%s", diff)
+	}
+	if diff := cmp.Diff("UTC", got.TimeZone); diff != "" {
+		t.Errorf("Create(...): -want time zone, +got time zone:\n%s", diff)
+	}
+	if diff := cmp.Diff(xpv1.Creating(), mg.GetCondition(xpv1.TypeReady), test.EquateConditions()); diff != "" {
+		t.Errorf("Create(...): -want condition, +got condition:\n%s", diff)
+	}
+}
+
+func TestDatasetUpdate(t *testing.T) {
+	var gotMask string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.Body.Close()
+		if diff := cmp.Diff(http.MethodPatch, r.Method); diff != "" {
+			t.Errorf("r: -want method, +got method:\n%s", diff)
+		}
+		if diff := cmp.Diff(datasetPath, r.URL.Path); diff != "" {
+			t.Errorf("r: -want path, +got path:\n%s", diff)
+		}
+		gotMask = r.URL.Query().Get("updateMask")
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(&healthcare.Dataset{})
+	}))
+	defer server.Close()
+
+	s, _ := healthcare.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	e := datasetExternal{projectID: "fooproject", datasets: s.Projects.Locations.Datasets}
+	if _, err := e.Update(context.Background(), newDataset()); err != nil {
+		t.Fatalf("Update(...): unexpected error: %v", err)
+	}
+	if diff := cmp.Diff("timeZone", gotMask); diff != "" {
+		t.Errorf("Update(...): -want mask, +got mask:\n%s", diff)
+	}
+}
+
+func TestDatasetDelete(t *testing.T) {
+	cases := map[string]struct {
+		status int
+		err    error
+	}{
+		"Successful": {
+			status: http.StatusOK,
+		},
+		"NotFound": {
+			status: http.StatusNotFound,
+		},
+		"DeleteFailed": {
+			status: http.StatusBadRequest,
+			err:    errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteDataset),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodDelete, r.Method); diff != "" {
+					t.Errorf("r: -want method, +got method:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}))
+			defer server.Close()
+			s, _ := healthcare.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := datasetExternal{projectID: "fooproject", datasets: s.Projects.Locations.Datasets}
+			err := e.Delete(context.Background(), newDataset())
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
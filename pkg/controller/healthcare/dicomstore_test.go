@@ -0,0 +1,241 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package healthcare
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	healthcare "google.golang.org/api/healthcare/v1"
+	"google.golang.org/api/option"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/healthcare/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	dicomStoreName = "projects/fooproject/locations/us-central1/datasets/foo/dicomStores/bar"
+	dicomStorePath = "/v1/" + dicomStoreName
+)
+
+func newDICOMStore(m ...func(*v1alpha1.DICOMStore)) *v1alpha1.DICOMStore {
+	s := &v1alpha1.DICOMStore{}
+	meta.SetExternalName(s, "bar")
+	s.Spec.ForProvider = v1alpha1.DICOMStoreParameters{
+		Dataset: gcp.StringPtr(datasetName),
+		Labels:  map[string]string{"team": "imaging"},
+	}
+	for _, f := range m {
+		f(s)
+	}
+	return s
+}
+
+func observedDICOMStore() *healthcare.DicomStore {
+	return &healthcare.DicomStore{
+		Name:   dicomStoreName,
+		Labels: map[string]string{"team": "imaging"},
+	}
+}
+
+func TestDICOMStoreObserve(t *testing.T) {
+	type want struct {
+		eo   managed.ExternalObservation
+		cond xpv1.Condition
+		err  error
+	}
+	cases := map[string]struct {
+		handler http.Handler
+		mg      *v1alpha1.DICOMStore
+		want    want
+	}{
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+			mg: newDICOMStore(),
+		},
+		"GetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+			mg:   newDICOMStore(),
+			want: want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetDICOMStore)},
+		},
+		"UpToDate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(dicomStorePath, r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(observedDICOMStore())
+			}),
+			mg: newDICOMStore(),
+			want: want{
+				eo:   managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				cond: xpv1.Available(),
+			},
+		},
+		"NeedsUpdate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(observedDICOMStore())
+			}),
+			mg: newDICOMStore(func(s *v1alpha1.DICOMStore) {
+				s.Spec.ForProvider.NotificationConfig = &v1alpha1.NotificationConfig{PubsubTopic: gcp.StringPtr("projects/fooproject/topics/baz")}
+			}),
+			want: want{
+				eo:   managed.ExternalObservation{ResourceExists: true},
+				cond: xpv1.Available(),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := healthcare.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := dicomStoreExternal{client: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)}, stores: s.Projects.Locations.Datasets.DicomStores}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.eo, got); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if tc.want.cond.Type == "" {
+				return
+			}
+			if diff := cmp.Diff(tc.want.cond, tc.mg.GetCondition(xpv1.TypeReady), test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want condition, +got condition:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDICOMStoreCreate(t *testing.T) {
+	var gotID string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.Body.Close()
+		if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+			t.Errorf("r: -want method, +got method:\n%s", diff)
+		}
+		if diff := cmp.Diff("/v1/"+datasetName+"/dicomStores", r.URL.Path); diff != "" {
+			t.Errorf("r: -want path, +got path:\n%s", diff)
+		}
+		gotID = r.URL.Query().Get("dicomStoreId")
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(observedDICOMStore())
+	}))
+	defer server.Close()
+
+	s, _ := healthcare.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	e := dicomStoreExternal{stores: s.Projects.Locations.Datasets.DicomStores}
+	if _, err := e.Create(context.Background(), newDICOMStore()); err != nil {
+		t.Fatalf("Create(...): unexpected error: %v", err)
+	}
+	if diff := cmp.Diff("bar", gotID); diff != "" {
+		t.Errorf("Create(...): -want ID, +got ID:\n%s", diff)
+	}
+}
+
+func TestDICOMStoreUpdate(t *testing.T) {
+	var gotMask string
+	got := &healthcare.DicomStore{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer func() { _ = r.Body.Close() }()
+		w.WriteHeader(http.StatusOK)
+		if r.Method == http.MethodGet {
+			_ = json.NewEncoder(w).Encode(observedDICOMStore())
+			return
+		}
+		gotMask = r.URL.Query().Get("updateMask")
+		b, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(b, got)
+		_ = json.NewEncoder(w).Encode(got)
+	}))
+	defer server.Close()
+
+	s, _ := healthcare.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	e := dicomStoreExternal{stores: s.Projects.Locations.Datasets.DicomStores}
+	mg := newDICOMStore(func(s *v1alpha1.DICOMStore) {
+		s.Spec.ForProvider.Labels = map[string]string{"team": "radiology"}
+	})
+	if _, err := e.Update(context.Background(), mg); err != nil {
+		t.Fatalf("Update(...): unexpected error: %v", err)
+	}
+	if diff := cmp.Diff("labels", gotMask); diff != "" {
+		t.Errorf("Update(...): -want mask, +got mask:\n%s", diff)
+	}
+	if diff := cmp.Diff(map[string]string{"team": "radiology"}, got.Labels); diff != "" {
+		t.Errorf("Update(...): -want labels, +got labels:\n%s", diff)
+	}
+}
+
+func TestDICOMStoreDelete(t *testing.T) {
+	cases := map[string]struct {
+		status int
+		err    error
+	}{
+		"Successful": {
+			status: http.StatusOK,
+		},
+		"NotFound": {
+			status: http.StatusNotFound,
+		},
+		"DeleteFailed": {
+			status: http.StatusBadRequest,
+			err:    errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteDICOMStore),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(dicomStorePath, r.URL.Path); diff != "" {
+					t.Errorf("r: -want path, +got path:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}))
+			defer server.Close()
+			s, _ := healthcare.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := dicomStoreExternal{stores: s.Projects.Locations.Datasets.DicomStores}
+			err := e.Delete(context.Background(), newDICOMStore())
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
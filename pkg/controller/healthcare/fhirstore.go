@@ -0,0 +1,172 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package healthcare
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	healthcare "google.golang.org/api/healthcare/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/healthcare/v1alpha1"
+	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/fhirstore"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/setup"
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+	"github.com/crossplane-contrib/provider-gcp/pkg/tracing"
+)
+
+const (
+	errNotFHIRStore        = "managed resource is not of type FHIRStore"
+	errGetFHIRStore        = "cannot get FHIRStore"
+	errCreateFHIRStore     = "cannot create FHIRStore"
+	errUpdateFHIRStore     = "cannot update FHIRStore"
+	errDeleteFHIRStore     = "cannot delete FHIRStore"
+	errKubeUpdateFHIRStore = "cannot update FHIRStore custom resource"
+)
+
+// SetupFHIRStore adds a controller that reconciles FHIRStores.
+func SetupFHIRStore(mgr ctrl.Manager, o setup.Options) error {
+	name := managed.ControllerName(v1alpha1.FHIRStoreGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.FHIRStoreGroupVersionKind),
+		managed.WithExternalConnecter(setup.Connecter(mgr, o, v1alpha1.FHIRStoreGroupKind, &fhirStoreConnector{client: mgr.GetClient()})),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.FHIRStore{}).
+		Complete(tracing.NewReconciler(name, ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter)))
+}
+
+type fhirStoreConnector struct {
+	client client.Client
+}
+
+// Connect returns an ExternalClient with necessary information to talk to GCP API.
+func (c *fhirStoreConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	_, opts, err := gcp.GetConnectionInfo(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := healthcare.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &fhirStoreExternal{client: c.client, stores: s.Projects.Locations.Datasets.FhirStores}, nil
+}
+
+type fhirStoreExternal struct {
+	client client.Client
+	stores *healthcare.ProjectsLocationsDatasetsFhirStoresService
+}
+
+// Observe makes observation about the external resource.
+func (e *fhirStoreExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.FHIRStore)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotFHIRStore)
+	}
+	s, err := e.stores.Get(fhirstore.GetFullyQualifiedName(gcp.StringValue(cr.Spec.ForProvider.Dataset), meta.GetExternalName(cr))).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetFHIRStore)
+	}
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	fhirstore.LateInitialize(&cr.Spec.ForProvider, *s)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
+		if err := e.client.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errKubeUpdateFHIRStore)
+		}
+	}
+	cr.Status.AtProvider = fhirstore.GenerateObservation(*s)
+	cr.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: fhirstore.IsUpToDate(cr.Spec.ForProvider, *s),
+	}, nil
+}
+
+// Create initiates creation of external resource.
+func (e *fhirStoreExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.FHIRStore)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotFHIRStore)
+	}
+	cr.SetConditions(xpv1.Creating())
+	_, err := e.stores.Create(gcp.StringValue(cr.Spec.ForProvider.Dataset), fhirstore.GenerateFHIRStore("", cr.Spec.ForProvider)).
+		FhirStoreId(meta.GetExternalName(cr)).
+		Context(ctx).
+		Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateFHIRStore)
+}
+
+// Update initiates an update to the external resource.
+func (e *fhirStoreExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.FHIRStore)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotFHIRStore)
+	}
+	name := fhirstore.GetFullyQualifiedName(gcp.StringValue(cr.Spec.ForProvider.Dataset), meta.GetExternalName(cr))
+	s, err := e.stores.Get(name).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetFHIRStore)
+	}
+	mask := fhirstore.GenerateUpdateMask(cr.Spec.ForProvider, *s)
+	if mask == "" {
+		return managed.ExternalUpdate{}, nil
+	}
+	_, err = e.stores.Patch(name, fhirstore.GenerateFHIRStore(name, cr.Spec.ForProvider)).
+		UpdateMask(mask).
+		Context(ctx).
+		Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFHIRStore)
+}
+
+// Delete initiates an deletion of the external resource. Deleting a store
+// deletes all of its data.
+func (e *fhirStoreExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.FHIRStore)
+	if !ok {
+		return errors.New(errNotFHIRStore)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.stores.Delete(fhirstore.GetFullyQualifiedName(gcp.StringValue(cr.Spec.ForProvider.Dataset), meta.GetExternalName(cr))).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteFHIRStore)
+}
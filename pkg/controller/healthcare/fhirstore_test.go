@@ -0,0 +1,196 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package healthcare
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/googleapi"
+	healthcare "google.golang.org/api/healthcare/v1"
+	"google.golang.org/api/option"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/healthcare/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	datasetName   = "projects/fooproject/locations/us-central1/datasets/foo"
+	fhirStoreName = "projects/fooproject/locations/us-central1/datasets/foo/fhirStores/bar"
+	fhirStorePath = "/v1/" + fhirStoreName
+)
+
+func gError(code int, message string) *googleapi.Error {
+	return &googleapi.Error{
+		Code:    code,
+		Body:    "{}\n",
+		Message: message,
+	}
+}
+
+func newFHIRStore(m ...func(*v1alpha1.FHIRStore)) *v1alpha1.FHIRStore {
+	s := &v1alpha1.FHIRStore{}
+	meta.SetExternalName(s, "bar")
+	s.Spec.ForProvider = v1alpha1.FHIRStoreParameters{
+		Dataset:                         gcp.StringPtr(datasetName),
+		Version:                         "R4",
+		EnableUpdateCreate:              gcp.BoolPtr(true),
+		DisableReferentialIntegrity:     gcp.BoolPtr(false),
+		DisableResourceVersioning:       gcp.BoolPtr(false),
+		DefaultSearchHandlingStrict:     gcp.BoolPtr(false),
+		ComplexDataTypeReferenceParsing: gcp.StringPtr("DISABLED"),
+	}
+	for _, f := range m {
+		f(s)
+	}
+	return s
+}
+
+func observedFHIRStore() *healthcare.FhirStore {
+	return &healthcare.FhirStore{
+		Name:                            fhirStoreName,
+		Version:                         "R4",
+		EnableUpdateCreate:              true,
+		ComplexDataTypeReferenceParsing: "DISABLED",
+	}
+}
+
+func TestFHIRStoreObserve(t *testing.T) {
+	type want struct {
+		eo   managed.ExternalObservation
+		cond xpv1.Condition
+		err  error
+	}
+	cases := map[string]struct {
+		handler http.Handler
+		mg      *v1alpha1.FHIRStore
+		want    want
+	}{
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+			mg: newFHIRStore(),
+		},
+		"GetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+			mg:   newFHIRStore(),
+			want: want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetFHIRStore)},
+		},
+		"UpToDate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(fhirStorePath, r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(observedFHIRStore())
+			}),
+			mg: newFHIRStore(),
+			want: want{
+				eo:   managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				cond: xpv1.Available(),
+			},
+		},
+		"NeedsUpdate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(observedFHIRStore())
+			}),
+			mg: newFHIRStore(func(s *v1alpha1.FHIRStore) {
+				s.Spec.ForProvider.EnableUpdateCreate = gcp.BoolPtr(false)
+			}),
+			want: want{
+				eo:   managed.ExternalObservation{ResourceExists: true},
+				cond: xpv1.Available(),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := healthcare.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := fhirStoreExternal{client: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)}, stores: s.Projects.Locations.Datasets.FhirStores}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.eo, got); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if tc.want.cond.Type == "" {
+				return
+			}
+			if diff := cmp.Diff(tc.want.cond, tc.mg.GetCondition(xpv1.TypeReady), test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want condition, +got condition:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFHIRStoreUpdate(t *testing.T) {
+	var gotMask string
+	got := &healthcare.FhirStore{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer func() { _ = r.Body.Close() }()
+		w.WriteHeader(http.StatusOK)
+		if r.Method == http.MethodGet {
+			_ = json.NewEncoder(w).Encode(observedFHIRStore())
+			return
+		}
+		gotMask = r.URL.Query().Get("updateMask")
+		b, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(b, got)
+		_ = json.NewEncoder(w).Encode(got)
+	}))
+	defer server.Close()
+
+	s, _ := healthcare.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	e := fhirStoreExternal{stores: s.Projects.Locations.Datasets.FhirStores}
+	mg := newFHIRStore(func(s *v1alpha1.FHIRStore) {
+		s.Spec.ForProvider.EnableUpdateCreate = gcp.BoolPtr(false)
+		s.Spec.ForProvider.Labels = map[string]string{"team": "clinical"}
+	})
+	if _, err := e.Update(context.Background(), mg); err != nil {
+		t.Fatalf("Update(...): unexpected error: %v", err)
+	}
+	if diff := cmp.Diff("enableUpdateCreate,labels", gotMask); diff != "" {
+		t.Errorf("Update(...): -want mask, +got mask:\n%s", diff)
+	}
+	if diff := cmp.Diff(map[string]string{"team": "clinical"}, got.Labels); diff != "" {
+		t.Errorf("Update(...): -want labels, +got labels:\n%s", diff)
+	}
+}
@@ -0,0 +1,172 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package healthcare
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	healthcare "google.golang.org/api/healthcare/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/healthcare/v1alpha1"
+	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/hl7v2store"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/setup"
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+	"github.com/crossplane-contrib/provider-gcp/pkg/tracing"
+)
+
+const (
+	errNotHL7V2Store        = "managed resource is not of type HL7V2Store"
+	errGetHL7V2Store        = "cannot get HL7V2Store"
+	errCreateHL7V2Store     = "cannot create HL7V2Store"
+	errUpdateHL7V2Store     = "cannot update HL7V2Store"
+	errDeleteHL7V2Store     = "cannot delete HL7V2Store"
+	errKubeUpdateHL7V2Store = "cannot update HL7V2Store custom resource"
+)
+
+// SetupHL7V2Store adds a controller that reconciles HL7V2Stores.
+func SetupHL7V2Store(mgr ctrl.Manager, o setup.Options) error {
+	name := managed.ControllerName(v1alpha1.HL7V2StoreGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.HL7V2StoreGroupVersionKind),
+		managed.WithExternalConnecter(setup.Connecter(mgr, o, v1alpha1.HL7V2StoreGroupKind, &hl7V2StoreConnector{client: mgr.GetClient()})),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.HL7V2Store{}).
+		Complete(tracing.NewReconciler(name, ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter)))
+}
+
+type hl7V2StoreConnector struct {
+	client client.Client
+}
+
+// Connect returns an ExternalClient with necessary information to talk to GCP API.
+func (c *hl7V2StoreConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	_, opts, err := gcp.GetConnectionInfo(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := healthcare.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &hl7V2StoreExternal{client: c.client, stores: s.Projects.Locations.Datasets.Hl7V2Stores}, nil
+}
+
+type hl7V2StoreExternal struct {
+	client client.Client
+	stores *healthcare.ProjectsLocationsDatasetsHl7V2StoresService
+}
+
+// Observe makes observation about the external resource.
+func (e *hl7V2StoreExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.HL7V2Store)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotHL7V2Store)
+	}
+	s, err := e.stores.Get(hl7v2store.GetFullyQualifiedName(gcp.StringValue(cr.Spec.ForProvider.Dataset), meta.GetExternalName(cr))).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetHL7V2Store)
+	}
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	hl7v2store.LateInitialize(&cr.Spec.ForProvider, *s)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
+		if err := e.client.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errKubeUpdateHL7V2Store)
+		}
+	}
+	cr.Status.AtProvider = hl7v2store.GenerateObservation(*s)
+	cr.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: hl7v2store.IsUpToDate(cr.Spec.ForProvider, *s),
+	}, nil
+}
+
+// Create initiates creation of external resource.
+func (e *hl7V2StoreExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.HL7V2Store)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotHL7V2Store)
+	}
+	cr.SetConditions(xpv1.Creating())
+	_, err := e.stores.Create(gcp.StringValue(cr.Spec.ForProvider.Dataset), hl7v2store.GenerateHL7V2Store("", cr.Spec.ForProvider)).
+		Hl7V2StoreId(meta.GetExternalName(cr)).
+		Context(ctx).
+		Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateHL7V2Store)
+}
+
+// Update initiates an update to the external resource.
+func (e *hl7V2StoreExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.HL7V2Store)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotHL7V2Store)
+	}
+	name := hl7v2store.GetFullyQualifiedName(gcp.StringValue(cr.Spec.ForProvider.Dataset), meta.GetExternalName(cr))
+	s, err := e.stores.Get(name).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetHL7V2Store)
+	}
+	mask := hl7v2store.GenerateUpdateMask(cr.Spec.ForProvider, *s)
+	if mask == "" {
+		return managed.ExternalUpdate{}, nil
+	}
+	_, err = e.stores.Patch(name, hl7v2store.GenerateHL7V2Store(name, cr.Spec.ForProvider)).
+		UpdateMask(mask).
+		Context(ctx).
+		Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateHL7V2Store)
+}
+
+// Delete initiates an deletion of the external resource. Deleting a store
+// deletes all of its data.
+func (e *hl7V2StoreExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.HL7V2Store)
+	if !ok {
+		return errors.New(errNotHL7V2Store)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.stores.Delete(hl7v2store.GetFullyQualifiedName(gcp.StringValue(cr.Spec.ForProvider.Dataset), meta.GetExternalName(cr))).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteHL7V2Store)
+}
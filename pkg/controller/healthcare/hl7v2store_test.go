@@ -0,0 +1,241 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package healthcare
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	healthcare "google.golang.org/api/healthcare/v1"
+	"google.golang.org/api/option"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/healthcare/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	hl7V2StoreName = "projects/fooproject/locations/us-central1/datasets/foo/hl7V2Stores/bar"
+	hl7V2StorePath = "/v1/" + hl7V2StoreName
+)
+
+func newHL7V2Store(m ...func(*v1alpha1.HL7V2Store)) *v1alpha1.HL7V2Store {
+	s := &v1alpha1.HL7V2Store{}
+	meta.SetExternalName(s, "bar")
+	s.Spec.ForProvider = v1alpha1.HL7V2StoreParameters{
+		Dataset: gcp.StringPtr(datasetName),
+		Labels:  map[string]string{"team": "lab"},
+	}
+	for _, f := range m {
+		f(s)
+	}
+	return s
+}
+
+func observedHL7V2Store() *healthcare.Hl7V2Store {
+	return &healthcare.Hl7V2Store{
+		Name:   hl7V2StoreName,
+		Labels: map[string]string{"team": "lab"},
+	}
+}
+
+func TestHL7V2StoreObserve(t *testing.T) {
+	type want struct {
+		eo   managed.ExternalObservation
+		cond xpv1.Condition
+		err  error
+	}
+	cases := map[string]struct {
+		handler http.Handler
+		mg      *v1alpha1.HL7V2Store
+		want    want
+	}{
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+			mg: newHL7V2Store(),
+		},
+		"GetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+			mg:   newHL7V2Store(),
+			want: want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetHL7V2Store)},
+		},
+		"UpToDate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(hl7V2StorePath, r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(observedHL7V2Store())
+			}),
+			mg: newHL7V2Store(),
+			want: want{
+				eo:   managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				cond: xpv1.Available(),
+			},
+		},
+		"NeedsUpdate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(observedHL7V2Store())
+			}),
+			mg: newHL7V2Store(func(s *v1alpha1.HL7V2Store) {
+				s.Spec.ForProvider.RejectDuplicateMessage = gcp.BoolPtr(true)
+			}),
+			want: want{
+				eo:   managed.ExternalObservation{ResourceExists: true},
+				cond: xpv1.Available(),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := healthcare.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := hl7V2StoreExternal{client: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)}, stores: s.Projects.Locations.Datasets.Hl7V2Stores}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.eo, got); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if tc.want.cond.Type == "" {
+				return
+			}
+			if diff := cmp.Diff(tc.want.cond, tc.mg.GetCondition(xpv1.TypeReady), test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want condition, +got condition:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestHL7V2StoreCreate(t *testing.T) {
+	var gotID string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.Body.Close()
+		if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+			t.Errorf("r: -want method, +got method:\n%s", diff)
+		}
+		if diff := cmp.Diff("/v1/"+datasetName+"/hl7V2Stores", r.URL.Path); diff != "" {
+			t.Errorf("r: -want path, +got path:\n%s", diff)
+		}
+		gotID = r.URL.Query().Get("hl7V2StoreId")
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(observedHL7V2Store())
+	}))
+	defer server.Close()
+
+	s, _ := healthcare.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	e := hl7V2StoreExternal{stores: s.Projects.Locations.Datasets.Hl7V2Stores}
+	if _, err := e.Create(context.Background(), newHL7V2Store()); err != nil {
+		t.Fatalf("Create(...): unexpected error: %v", err)
+	}
+	if diff := cmp.Diff("bar", gotID); diff != "" {
+		t.Errorf("Create(...): -want ID, +got ID:\n%s", diff)
+	}
+}
+
+func TestHL7V2StoreUpdate(t *testing.T) {
+	var gotMask string
+	got := &healthcare.Hl7V2Store{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer func() { _ = r.Body.Close() }()
+		w.WriteHeader(http.StatusOK)
+		if r.Method == http.MethodGet {
+			_ = json.NewEncoder(w).Encode(observedHL7V2Store())
+			return
+		}
+		gotMask = r.URL.Query().Get("updateMask")
+		b, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(b, got)
+		_ = json.NewEncoder(w).Encode(got)
+	}))
+	defer server.Close()
+
+	s, _ := healthcare.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	e := hl7V2StoreExternal{stores: s.Projects.Locations.Datasets.Hl7V2Stores}
+	mg := newHL7V2Store(func(s *v1alpha1.HL7V2Store) {
+		s.Spec.ForProvider.Labels = map[string]string{"team": "pathology"}
+	})
+	if _, err := e.Update(context.Background(), mg); err != nil {
+		t.Fatalf("Update(...): unexpected error: %v", err)
+	}
+	if diff := cmp.Diff("labels", gotMask); diff != "" {
+		t.Errorf("Update(...): -want mask, +got mask:\n%s", diff)
+	}
+	if diff := cmp.Diff(map[string]string{"team": "pathology"}, got.Labels); diff != "" {
+		t.Errorf("Update(...): -want labels, +got labels:\n%s", diff)
+	}
+}
+
+func TestHL7V2StoreDelete(t *testing.T) {
+	cases := map[string]struct {
+		status int
+		err    error
+	}{
+		"Successful": {
+			status: http.StatusOK,
+		},
+		"NotFound": {
+			status: http.StatusNotFound,
+		},
+		"DeleteFailed": {
+			status: http.StatusBadRequest,
+			err:    errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteHL7V2Store),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(hl7V2StorePath, r.URL.Path); diff != "" {
+					t.Errorf("r: -want path, +got path:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}))
+			defer server.Close()
+			s, _ := healthcare.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := hl7V2StoreExternal{stores: s.Projects.Locations.Datasets.Hl7V2Stores}
+			err := e.Delete(context.Background(), newHL7V2Store())
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"testing"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/iam/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/conformance"
+)
+
+func TestConformance(t *testing.T) {
+	conformance.Run(t, []string{v1alpha1.Group}, map[string]conformance.Fixture{
+		v1alpha1.ServiceAccountGroupKind: {
+			Managed:   func() resource.Managed { return &v1alpha1.ServiceAccount{} },
+			Connecter: func(kube client.Client) managed.ExternalConnecter { return &connecter{client: kube} },
+		},
+		v1alpha1.ServiceAccountKeyGroupKind: {
+			Managed: func() resource.Managed { return &v1alpha1.ServiceAccountKey{} },
+			Connecter: func(kube client.Client) managed.ExternalConnecter {
+				return &serviceAccountKeyServiceConnector{client: kube}
+			},
+		},
+		v1alpha1.ServiceAccountPolicyGroupKind: {
+			Managed: func() resource.Managed {
+				return &v1alpha1.ServiceAccountPolicy{Spec: v1alpha1.ServiceAccountPolicySpec{ForProvider: v1alpha1.ServiceAccountPolicyParameters{
+					ServiceAccountReferer: v1alpha1.ServiceAccountReferer{ServiceAccount: gcp.StringPtr("projects/project/serviceAccounts/sa@project.iam.gserviceaccount.com")},
+				}}}
+			},
+			Connecter: func(kube client.Client) managed.ExternalConnecter {
+				return &serviceAccountPolicyConnecter{client: kube}
+			},
+			Identity: "The policy is the IAM policy of the service account.",
+			Found:    `{"bindings":[{"role":"roles/viewer","members":["user:alice@example.com"]}]}`,
+		},
+	})
+}
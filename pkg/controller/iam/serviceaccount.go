@@ -19,6 +19,7 @@ package iam
 import (
 	"context"
 	"fmt"
+	"sort"
 
 	iamv1 "google.golang.org/api/iam/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -26,7 +27,6 @@ import (
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/connection"
-	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	"github.com/crossplane/crossplane-runtime/pkg/errors"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
@@ -38,7 +38,10 @@ import (
 	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
 	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
 	"github.com/crossplane-contrib/provider-gcp/pkg/clients/serviceaccount"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/serviceaccountkey"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/setup"
 	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+	"github.com/crossplane-contrib/provider-gcp/pkg/tracing"
 )
 
 // Error strings.
@@ -46,13 +49,14 @@ const (
 	errNewClient         = "cannot create new GCP IAM API client"
 	errNotServiceAccount = "managed resource is not a GCP ServiceAccount"
 	errGet               = "cannot get GCP ServiceAccount object via IAM API"
+	errListKeys          = "cannot list keys of GCP ServiceAccount object via IAM API"
 	errCreate            = "cannot create GCP ServiceAccount object via IAM API"
 	errUpdate            = "cannot update GCP ServiceAccount object via IAM API"
 	errDelete            = "cannot delete GCP ServiceAccount object via IAM API"
 )
 
 // SetupServiceAccount adds a controller that reconciles ServiceAccounts.
-func SetupServiceAccount(mgr ctrl.Manager, o controller.Options) error {
+func SetupServiceAccount(mgr ctrl.Manager, o setup.Options) error {
 	name := managed.ControllerName(v1alpha1.ServiceAccountGroupKind)
 
 	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
@@ -62,7 +66,7 @@ func SetupServiceAccount(mgr ctrl.Manager, o controller.Options) error {
 
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(v1alpha1.ServiceAccountGroupVersionKind),
-		managed.WithExternalConnecter(&connecter{client: mgr.GetClient()}),
+		managed.WithExternalConnecter(setup.Connecter(mgr, o, v1alpha1.ServiceAccountGroupKind, &connecter{client: mgr.GetClient()})),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
@@ -72,7 +76,7 @@ func SetupServiceAccount(mgr ctrl.Manager, o controller.Options) error {
 		Named(name).
 		WithOptions(o.ForControllerRuntime()).
 		For(&v1alpha1.ServiceAccount{}).
-		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+		Complete(tracing.NewReconciler(name, ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter)))
 }
 
 type connecter struct {
@@ -90,11 +94,12 @@ func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.E
 		return nil, errors.Wrap(err, errNewClient)
 	}
 	rrn := NewRelativeResourceNamer(projectID)
-	return &external{serviceAccounts: s.Projects.ServiceAccounts, rrn: rrn}, errors.Wrap(err, errNewClient)
+	return &external{serviceAccounts: s.Projects.ServiceAccounts, keys: s.Projects.ServiceAccounts.Keys, rrn: rrn}, errors.Wrap(err, errNewClient)
 }
 
 type external struct {
 	serviceAccounts serviceaccount.Client
+	keys            serviceaccountkey.Client
 	rrn             RelativeResourceNamer
 }
 
@@ -114,13 +119,22 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	}
 
 	populateCRFromProvider(cr, fromProvider)
+
+	// Only user-managed keys are listed. System-managed keys are rotated by
+	// Google and can not be used outside of GCP.
+	keys, err := e.keys.List(e.rrn.ResourceName(cr)).KeyTypes("USER_MANAGED").Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errListKeys)
+	}
+	populateKeysFromProvider(cr, keys.Keys)
+
 	if fromProvider.Email != "" {
 		cr.Status.SetConditions(xpv1.Available())
 	}
 	return managed.ExternalObservation{
 		ResourceExists:    true,
 		ResourceUpToDate:  isUpToDate(&cr.Spec.ForProvider, fromProvider),
-		ConnectionDetails: managed.ConnectionDetails{},
+		ConnectionDetails: serviceAccountConnectionDetails(fromProvider),
 	}, nil
 }
 
@@ -212,6 +226,48 @@ func populateCRFromProvider(cr *v1alpha1.ServiceAccount, fromProvider *iamv1.Ser
 	cr.Status.AtProvider.Name = fromProvider.Name
 }
 
+// populateKeysFromProvider records the supplied keys in the status of the
+// ServiceAccount, ordered by their id so that the status does not change
+// when GCP returns the same keys in a different order.
+func populateKeysFromProvider(cr *v1alpha1.ServiceAccount, fromProvider []*iamv1.ServiceAccountKey) {
+	keys := make([]v1alpha1.ServiceAccountKeyInfo, 0, len(fromProvider))
+	for _, k := range fromProvider {
+		if k == nil {
+			continue
+		}
+		id, err := serviceaccountkey.ParseKeyIDFromRrn(k.Name)
+		if err != nil {
+			id = k.Name
+		}
+		keys = append(keys, v1alpha1.ServiceAccountKeyInfo{
+			KeyID:           id,
+			KeyAlgorithm:    k.KeyAlgorithm,
+			KeyOrigin:       k.KeyOrigin,
+			ValidAfterTime:  k.ValidAfterTime,
+			ValidBeforeTime: k.ValidBeforeTime,
+			Disabled:        k.Disabled,
+		})
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].KeyID < keys[j].KeyID })
+	if len(keys) == 0 {
+		keys = nil
+	}
+	cr.Status.AtProvider.Keys = keys
+}
+
+// serviceAccountConnectionDetails returns the email and unique id of the
+// supplied service account, which are commonly needed to bind IAM roles to it.
+func serviceAccountConnectionDetails(sa *iamv1.ServiceAccount) managed.ConnectionDetails {
+	cd := managed.ConnectionDetails{}
+	if sa.Email != "" {
+		cd[v1alpha1.ServiceAccountEmailKey] = []byte(sa.Email)
+	}
+	if sa.UniqueId != "" {
+		cd[v1alpha1.ServiceAccountUniqueIDKey] = []byte(sa.UniqueId)
+	}
+	return cd
+}
+
 func populateProviderFromCR(forProvider *iamv1.ServiceAccount, cr *v1alpha1.ServiceAccount) {
 	forProvider.DisplayName = gcp.StringValue(cr.Spec.ForProvider.DisplayName)
 	forProvider.Description = gcp.StringValue(cr.Spec.ForProvider.Description)
@@ -23,6 +23,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -56,6 +57,8 @@ var (
 	description = "A perfect description"
 	fqName      = fmt.Sprintf("projects/%s/serviceAccounts/%s", project, accountEmail)
 	uniqueID    = fqName
+	keyName1    = fmt.Sprintf("%s/keys/%s", fqName, "a1b2c3")
+	keyName2    = fmt.Sprintf("%s/keys/%s", fqName, "d4e5f6")
 )
 
 type strange struct {
@@ -92,6 +95,10 @@ func withDisabled(b bool) valueModifier {
 	return func(i *v1alpha1.ServiceAccount) { i.Status.AtProvider.Disabled = b }
 }
 
+func withKeys(k ...v1alpha1.ServiceAccountKeyInfo) valueModifier {
+	return func(i *v1alpha1.ServiceAccount) { i.Status.AtProvider.Keys = k }
+}
+
 func withCondition(condition xpv1.Condition) valueModifier {
 	return func(i *v1alpha1.ServiceAccount) { i.SetConditions(condition) }
 }
@@ -209,6 +216,15 @@ func TestObserve(t *testing.T) {
 					t.Errorf("r: -want, +got:\n%s", diff)
 				}
 				w.WriteHeader(http.StatusOK)
+				if strings.HasSuffix(r.URL.Path, "/keys") {
+					if diff := cmp.Diff("USER_MANAGED", r.URL.Query().Get("keyTypes")); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if err := json.NewEncoder(w).Encode(&iamv1.ListServiceAccountKeysResponse{}); err != nil {
+						t.Error(err)
+					}
+					return
+				}
 				sa := &iamv1.ServiceAccount{
 					Name:        fqName,
 					UniqueId:    uniqueID,
@@ -236,12 +252,132 @@ func TestObserve(t *testing.T) {
 					withCondition(xpv1.Available()),
 					withDisabled(false)),
 				observation: managed.ExternalObservation{
-					ResourceExists:    true,
-					ResourceUpToDate:  true,
-					ConnectionDetails: managed.ConnectionDetails{},
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						v1alpha1.ServiceAccountEmailKey:    []byte(accountEmail),
+						v1alpha1.ServiceAccountUniqueIDKey: []byte(uniqueID),
+					},
 				},
 			},
 		},
+		"ObservedAccountKeys": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				if strings.HasSuffix(r.URL.Path, "/keys") {
+					keys := &iamv1.ListServiceAccountKeysResponse{
+						Keys: []*iamv1.ServiceAccountKey{
+							{
+								Name:            keyName2,
+								KeyAlgorithm:    "KEY_ALG_RSA_2048",
+								KeyOrigin:       "GOOGLE_PROVIDED",
+								ValidAfterTime:  "2023-02-01T00:00:00Z",
+								ValidBeforeTime: "9999-12-31T23:59:59Z",
+							},
+							{
+								Name:            keyName1,
+								KeyAlgorithm:    "KEY_ALG_RSA_2048",
+								KeyOrigin:       "GOOGLE_PROVIDED",
+								ValidAfterTime:  "2023-01-01T00:00:00Z",
+								ValidBeforeTime: "9999-12-31T23:59:59Z",
+								Disabled:        true,
+							},
+						},
+					}
+					if err := json.NewEncoder(w).Encode(keys); err != nil {
+						t.Error(err)
+					}
+					return
+				}
+				sa := &iamv1.ServiceAccount{
+					Name:        fqName,
+					UniqueId:    uniqueID,
+					Email:       accountEmail,
+					DisplayName: displayName,
+				}
+				if err := json.NewEncoder(w).Encode(sa); err != nil {
+					t.Error(err)
+				}
+			}),
+			args: args{
+				ctx: context.Background(),
+				mg: serviceAccount(
+					withName(fqName),
+					withExternalNameAnnotation(fqName),
+				),
+			},
+			want: want{
+				mg: serviceAccount(
+					withName(fqName),
+					withUniqueID(uniqueID),
+					withEmail(accountEmail),
+					withDisplayName(displayName),
+					withExternalNameAnnotation(fqName),
+					withCondition(xpv1.Available()),
+					withKeys(
+						v1alpha1.ServiceAccountKeyInfo{
+							KeyID:           "a1b2c3",
+							KeyAlgorithm:    "KEY_ALG_RSA_2048",
+							KeyOrigin:       "GOOGLE_PROVIDED",
+							ValidAfterTime:  "2023-01-01T00:00:00Z",
+							ValidBeforeTime: "9999-12-31T23:59:59Z",
+							Disabled:        true,
+						},
+						v1alpha1.ServiceAccountKeyInfo{
+							KeyID:           "d4e5f6",
+							KeyAlgorithm:    "KEY_ALG_RSA_2048",
+							KeyOrigin:       "GOOGLE_PROVIDED",
+							ValidAfterTime:  "2023-02-01T00:00:00Z",
+							ValidBeforeTime: "9999-12-31T23:59:59Z",
+						},
+					)),
+				observation: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						v1alpha1.ServiceAccountEmailKey:    []byte(accountEmail),
+						v1alpha1.ServiceAccountUniqueIDKey: []byte(uniqueID),
+					},
+				},
+			},
+		},
+		"ListKeysFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if strings.HasSuffix(r.URL.Path, "/keys") {
+					w.WriteHeader(http.StatusInternalServerError)
+					_ = json.NewEncoder(w).Encode(struct{}{})
+					return
+				}
+				w.WriteHeader(http.StatusOK)
+				sa := &iamv1.ServiceAccount{
+					Name:        fqName,
+					UniqueId:    uniqueID,
+					Email:       accountEmail,
+					DisplayName: displayName,
+				}
+				if err := json.NewEncoder(w).Encode(sa); err != nil {
+					t.Error(err)
+				}
+			}),
+			args: args{
+				ctx: context.Background(),
+				mg: serviceAccount(
+					withName(fqName),
+					withExternalNameAnnotation(fqName),
+				),
+			},
+			want: want{
+				mg: serviceAccount(
+					withName(fqName),
+					withUniqueID(uniqueID),
+					withEmail(accountEmail),
+					withDisplayName(displayName),
+					withExternalNameAnnotation(fqName)),
+				err: errors.Wrap(err500, errListKeys),
+			},
+		},
 		"ObservedServiceAccountDoesNotExist": {
 			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 				r.Body.Close()
@@ -275,7 +411,7 @@ func TestObserve(t *testing.T) {
 			s, _ := iamv1.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
 			serviceAccounts := iamv1.NewProjectsService(s).ServiceAccounts
 			rrn := NewRelativeResourceNamer("perfect-project")
-			e := &external{serviceAccounts: serviceAccounts, rrn: rrn}
+			e := &external{serviceAccounts: serviceAccounts, keys: serviceAccounts.Keys, rrn: rrn}
 			obs, err := e.Observe(context.Background(), tc.args.mg)
 
 			if err != nil {
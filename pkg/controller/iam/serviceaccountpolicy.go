@@ -25,7 +25,6 @@ import (
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/connection"
-	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	"github.com/crossplane/crossplane-runtime/pkg/errors"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
@@ -36,7 +35,9 @@ import (
 	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
 	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
 	"github.com/crossplane-contrib/provider-gcp/pkg/clients/serviceaccountpolicy"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/setup"
 	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+	"github.com/crossplane-contrib/provider-gcp/pkg/tracing"
 )
 
 const (
@@ -48,7 +49,7 @@ const (
 )
 
 // SetupServiceAccountPolicy adds a controller that reconciles ServiceAccountPolicys.
-func SetupServiceAccountPolicy(mgr ctrl.Manager, o controller.Options) error {
+func SetupServiceAccountPolicy(mgr ctrl.Manager, o setup.Options) error {
 	name := managed.ControllerName(v1alpha1.ServiceAccountPolicyGroupKind)
 
 	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
@@ -58,7 +59,7 @@ func SetupServiceAccountPolicy(mgr ctrl.Manager, o controller.Options) error {
 
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(v1alpha1.ServiceAccountPolicyGroupVersionKind),
-		managed.WithExternalConnecter(&serviceAccountPolicyConnecter{client: mgr.GetClient()}),
+		managed.WithExternalConnecter(setup.Connecter(mgr, o, v1alpha1.ServiceAccountPolicyGroupKind, &serviceAccountPolicyConnecter{client: mgr.GetClient()})),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
@@ -68,7 +69,7 @@ func SetupServiceAccountPolicy(mgr ctrl.Manager, o controller.Options) error {
 		Named(name).
 		WithOptions(o.ForControllerRuntime()).
 		For(&v1alpha1.ServiceAccountPolicy{}).
-		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+		Complete(tracing.NewReconciler(name, ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter)))
 }
 
 type serviceAccountPolicyConnecter struct {
@@ -145,28 +146,28 @@ func (e *serviceAccountPolicyExternal) Update(ctx context.Context, mg resource.M
 	if !ok {
 		return managed.ExternalUpdate{}, errors.New(errNotServiceAccountPolicy)
 	}
-	instance, err := e.serviceaccountspolicy.GetIamPolicy(gcp.StringValue(cr.Spec.ForProvider.ServiceAccount)).OptionsRequestedPolicyVersion(v1alpha1.PolicyVersion).Context(ctx).Do()
-	if err != nil {
-		return managed.ExternalUpdate{}, errors.Wrap(err, errGetPolicy)
-	}
-
-	u, err := serviceaccountpolicy.IsUpToDate(&cr.Spec.ForProvider, instance)
-	if err != nil {
-		return managed.ExternalUpdate{}, errors.Wrap(err, errCheckUpToDate)
-	}
-	if u {
-		return managed.ExternalUpdate{}, nil
-	}
-
-	serviceaccountpolicy.GenerateServiceAccountPolicyInstance(cr.Spec.ForProvider, instance)
-	req := &iamv1.SetIamPolicyRequest{Policy: instance}
-
-	if _, err := e.serviceaccountspolicy.SetIamPolicy(gcp.StringValue(cr.Spec.ForProvider.ServiceAccount), req).
-		Context(ctx).Do(); err != nil {
-		return managed.ExternalUpdate{}, errors.Wrap(err, errSetPolicy)
-	}
-
-	return managed.ExternalUpdate{}, nil
+	// The policy read here carries an etag, so the write is rejected if
+	// somebody else changed the policy in between. Read it again and retry.
+	err := gcp.RetryOnConflict(func() error {
+		instance, err := e.serviceaccountspolicy.GetIamPolicy(gcp.StringValue(cr.Spec.ForProvider.ServiceAccount)).OptionsRequestedPolicyVersion(v1alpha1.PolicyVersion).Context(ctx).Do()
+		if err != nil {
+			return errors.Wrap(err, errGetPolicy)
+		}
+
+		u, err := serviceaccountpolicy.IsUpToDate(&cr.Spec.ForProvider, instance)
+		if err != nil {
+			return errors.Wrap(err, errCheckUpToDate)
+		}
+		if u {
+			return nil
+		}
+
+		serviceaccountpolicy.GenerateServiceAccountPolicyInstance(cr.Spec.ForProvider, instance)
+		req := &iamv1.SetIamPolicyRequest{Policy: instance}
+		_, err = e.serviceaccountspolicy.SetIamPolicy(gcp.StringValue(cr.Spec.ForProvider.ServiceAccount), req).Context(ctx).Do()
+		return errors.Wrap(err, errSetPolicy)
+	})
+	return managed.ExternalUpdate{}, err
 }
 
 func (e *serviceAccountPolicyExternal) Delete(ctx context.Context, mg resource.Managed) error {
@@ -175,9 +176,6 @@ func (e *serviceAccountPolicyExternal) Delete(ctx context.Context, mg resource.M
 		return errors.New(errNotServiceAccountPolicy)
 	}
 	req := &iamv1.SetIamPolicyRequest{Policy: &iamv1.Policy{}}
-	if _, err := e.serviceaccountspolicy.SetIamPolicy(gcp.StringValue(cr.Spec.ForProvider.ServiceAccount), req).
-		Context(ctx).Do(); err != nil {
-		return errors.Wrap(err, errSetPolicy)
-	}
-	return nil
+	_, err := e.serviceaccountspolicy.SetIamPolicy(gcp.StringValue(cr.Spec.ForProvider.ServiceAccount), req).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errSetPolicy)
 }
@@ -693,6 +693,25 @@ func TestServiceAccountPolicyDelete(t *testing.T) {
 					sapWithExternalNameAnnotation(sapMetadataName)),
 			},
 		},
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNotFound)
+				if err := json.NewEncoder(w).Encode(&iamv1.Policy{}); err != nil {
+					t.Error(err)
+				}
+			}),
+			args: args{
+				ctx: context.Background(),
+				mg: ServiceAccountPolicy(
+					sapWithName(sapMetadataName),
+					sapWithExternalNameAnnotation(sapMetadataName)),
+			},
+			want: want{
+				mg: ServiceAccountPolicy(
+					sapWithName(sapMetadataName),
+					sapWithExternalNameAnnotation(sapMetadataName)),
+			},
+		},
 		"CreateFailed": {
 			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 				w.WriteHeader(http.StatusInternalServerError)
@@ -0,0 +1,154 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package identityplatform
+
+import (
+	"context"
+
+	identitytoolkit "google.golang.org/api/identitytoolkit/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/identityplatform/v1alpha1"
+	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/identityplatformconfig"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/setup"
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+	"github.com/crossplane-contrib/provider-gcp/pkg/tracing"
+)
+
+const (
+	errNotConfig        = "managed resource is not of type Config"
+	errGetConfig        = "cannot get Config"
+	errInitializeConfig = "cannot initialize Identity Platform"
+	errUpdateConfig     = "cannot update Config"
+)
+
+// SetupConfig adds a controller that reconciles Configs.
+func SetupConfig(mgr ctrl.Manager, o setup.Options) error {
+	name := managed.ControllerName(v1alpha1.ConfigGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.ConfigGroupVersionKind),
+		managed.WithExternalConnecter(setup.Connecter(mgr, o, v1alpha1.ConfigGroupKind, &configConnector{client: mgr.GetClient()})),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.Config{}).
+		Complete(tracing.NewReconciler(name, ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter)))
+}
+
+type configConnector struct {
+	client client.Client
+}
+
+// Connect returns an ExternalClient with necessary information to talk to GCP API.
+func (c *configConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetConnectionInfo(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := identitytoolkit.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &configExternal{projectID: projectID, projects: s.Projects}, nil
+}
+
+type configExternal struct {
+	projectID string
+	projects  *identitytoolkit.ProjectsService
+}
+
+// Observe makes observation about the external resource.
+func (e *configExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Config)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotConfig)
+	}
+	// Identity Platform cannot be turned off once it is initialized, so the
+	// Config is released rather than deleted.
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	observed, err := e.projects.GetConfig(identityplatformconfig.GetFullyQualifiedName(e.projectID)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetConfig)
+	}
+	cr.Status.AtProvider = identityplatformconfig.GenerateObservation(*observed)
+	cr.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: identityplatformconfig.IsUpToDate(cr.Spec.ForProvider, *observed),
+	}, nil
+}
+
+// Create initializes Identity Platform for the project. The desired
+// configuration is applied by the following update.
+func (e *configExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Config)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotConfig)
+	}
+	cr.SetConditions(xpv1.Creating())
+	_, err := e.projects.IdentityPlatform.InitializeAuth(identityplatformconfig.GetProject(e.projectID), &identitytoolkit.GoogleCloudIdentitytoolkitAdminV2InitializeIdentityPlatformRequest{}).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errInitializeConfig)
+}
+
+// Update initiates an update to the external resource.
+func (e *configExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Config)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotConfig)
+	}
+	name := identityplatformconfig.GetFullyQualifiedName(e.projectID)
+	observed, err := e.projects.GetConfig(name).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetConfig)
+	}
+	mask := identityplatformconfig.GenerateUpdateMask(cr.Spec.ForProvider, *observed)
+	if mask == "" {
+		return managed.ExternalUpdate{}, nil
+	}
+	_, err = e.projects.UpdateConfig(name, identityplatformconfig.GenerateConfig(name, cr.Spec.ForProvider)).UpdateMask(mask).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateConfig)
+}
+
+// Delete is a no-op as Identity Platform cannot be deinitialized.
+func (e *configExternal) Delete(_ context.Context, _ resource.Managed) error {
+	return nil
+}
@@ -0,0 +1,150 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package identityplatform
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	identitytoolkit "google.golang.org/api/identitytoolkit/v2"
+	"google.golang.org/api/option"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/identityplatform/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const projectID = "fooproject"
+
+func newConfig(m ...func(*v1alpha1.Config)) *v1alpha1.Config {
+	c := &v1alpha1.Config{}
+	c.Spec.ForProvider = v1alpha1.ConfigParameters{
+		MultiTenant: &v1alpha1.MultiTenantConfig{AllowTenants: true},
+		SignIn: &v1alpha1.SignInConfig{
+			Email: &v1alpha1.EmailSignInConfig{Enabled: true, PasswordRequired: gcp.BoolPtr(true)},
+		},
+	}
+	for _, f := range m {
+		f(c)
+	}
+	return c
+}
+
+func observedConfig() *identitytoolkit.GoogleCloudIdentitytoolkitAdminV2Config {
+	return &identitytoolkit.GoogleCloudIdentitytoolkitAdminV2Config{
+		Name: "projects/fooproject/config",
+		SignIn: &identitytoolkit.GoogleCloudIdentitytoolkitAdminV2SignInConfig{
+			Email: &identitytoolkit.GoogleCloudIdentitytoolkitAdminV2Email{Enabled: true, PasswordRequired: true},
+		},
+		MultiTenant: &identitytoolkit.GoogleCloudIdentitytoolkitAdminV2MultiTenantConfig{AllowTenants: true},
+	}
+}
+
+func TestConfigObserve(t *testing.T) {
+	type want struct {
+		eo  managed.ExternalObservation
+		err error
+	}
+	cases := map[string]struct {
+		handler http.Handler
+		mg      *v1alpha1.Config
+		want    want
+	}{
+		"Deleted": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				t.Errorf("unexpected request to %s", r.URL.Path)
+			}),
+			mg: newConfig(func(c *v1alpha1.Config) {
+				now := metav1.Now()
+				c.SetDeletionTimestamp(&now)
+			}),
+		},
+		"NotInitialized": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+			mg: newConfig(),
+		},
+		"UpToDate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff("/v2/projects/fooproject/config", r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(observedConfig())
+			}),
+			mg:   newConfig(),
+			want: want{eo: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+		},
+		"NeedsUpdate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(observedConfig())
+			}),
+			mg: newConfig(func(c *v1alpha1.Config) {
+				c.Spec.ForProvider.AuthorizedDomains = []string{"example.com"}
+			}),
+			want: want{eo: managed.ExternalObservation{ResourceExists: true}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := identitytoolkit.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := configExternal{projectID: projectID, projects: s.Projects}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.eo, got); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestConfigCreate(t *testing.T) {
+	var gotPath string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.Body.Close()
+		gotPath = r.URL.Path
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(struct{}{})
+	}))
+	defer server.Close()
+
+	s, _ := identitytoolkit.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	e := configExternal{projectID: projectID, projects: s.Projects}
+	if _, err := e.Create(context.Background(), newConfig()); err != nil {
+		t.Fatalf("Create(...): unexpected error: %v", err)
+	}
+	if diff := cmp.Diff("/v2/projects/fooproject/identityPlatform:initializeAuth", gotPath); diff != "" {
+		t.Errorf("Create(...): -want path, +got path:\n%s", diff)
+	}
+}
@@ -0,0 +1,176 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package identityplatform
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	identitytoolkit "google.golang.org/api/identitytoolkit/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/identityplatform/v1alpha1"
+	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/tenant"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/setup"
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+	"github.com/crossplane-contrib/provider-gcp/pkg/tracing"
+)
+
+const (
+	errNewClient = "cannot create new Identity Platform client"
+
+	errNotTenant        = "managed resource is not of type Tenant"
+	errGetTenant        = "cannot get Tenant"
+	errCreateTenant     = "cannot create Tenant"
+	errUpdateTenant     = "cannot update Tenant"
+	errDeleteTenant     = "cannot delete Tenant"
+	errKubeUpdateTenant = "cannot update Tenant custom resource"
+)
+
+// SetupTenant adds a controller that reconciles Tenants.
+func SetupTenant(mgr ctrl.Manager, o setup.Options) error {
+	name := managed.ControllerName(v1alpha1.TenantGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.TenantGroupVersionKind),
+		// The tenant ID is assigned by Google when the tenant is created.
+		managed.WithInitializers(),
+		managed.WithExternalConnecter(setup.Connecter(mgr, o, v1alpha1.TenantGroupKind, &tenantConnector{client: mgr.GetClient()})),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.Tenant{}).
+		Complete(tracing.NewReconciler(name, ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter)))
+}
+
+type tenantConnector struct {
+	client client.Client
+}
+
+// Connect returns an ExternalClient with necessary information to talk to GCP API.
+func (c *tenantConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetConnectionInfo(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := identitytoolkit.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &tenantExternal{projectID: projectID, client: c.client, tenants: s.Projects.Tenants}, nil
+}
+
+type tenantExternal struct {
+	projectID string
+	client    client.Client
+	tenants   *identitytoolkit.ProjectsTenantsService
+}
+
+// Observe makes observation about the external resource.
+func (e *tenantExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Tenant)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotTenant)
+	}
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	observed, err := e.tenants.Get(tenant.GetFullyQualifiedName(e.projectID, meta.GetExternalName(cr))).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetTenant)
+	}
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	tenant.LateInitialize(&cr.Spec.ForProvider, *observed)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
+		if err := e.client.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errKubeUpdateTenant)
+		}
+	}
+	cr.Status.AtProvider = tenant.GenerateObservation(*observed)
+	cr.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: tenant.IsUpToDate(cr.Spec.ForProvider, *observed),
+	}, nil
+}
+
+// Create initiates creation of external resource.
+func (e *tenantExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Tenant)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotTenant)
+	}
+	cr.SetConditions(xpv1.Creating())
+	t, err := e.tenants.Create(tenant.GetParent(e.projectID), tenant.GenerateTenant("", cr.Spec.ForProvider)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateTenant)
+	}
+	meta.SetExternalName(cr, tenant.ParseTenantID(t.Name))
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+// Update initiates an update to the external resource.
+func (e *tenantExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Tenant)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotTenant)
+	}
+	name := tenant.GetFullyQualifiedName(e.projectID, meta.GetExternalName(cr))
+	observed, err := e.tenants.Get(name).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetTenant)
+	}
+	mask := tenant.GenerateUpdateMask(cr.Spec.ForProvider, *observed)
+	if mask == "" {
+		return managed.ExternalUpdate{}, nil
+	}
+	_, err = e.tenants.Patch(name, tenant.GenerateTenant(name, cr.Spec.ForProvider)).UpdateMask(mask).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateTenant)
+}
+
+// Delete initiates an deletion of the external resource.
+func (e *tenantExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Tenant)
+	if !ok {
+		return errors.New(errNotTenant)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.tenants.Delete(tenant.GetFullyQualifiedName(e.projectID, meta.GetExternalName(cr))).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteTenant)
+}
@@ -0,0 +1,313 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package identityplatform
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/googleapi"
+	identitytoolkit "google.golang.org/api/identitytoolkit/v2"
+	"google.golang.org/api/option"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/identityplatform/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	tenantID   = "tenant-1a2b3"
+	tenantPath = "/v2/projects/fooproject/tenants/tenant-1a2b3"
+)
+
+var errBoom = errors.New("boom")
+
+func gError(code int, message string) *googleapi.Error {
+	return &googleapi.Error{
+		Code:    code,
+		Body:    "{}\n",
+		Message: message,
+	}
+}
+
+func newTenant(m ...func(*v1alpha1.Tenant)) *v1alpha1.Tenant {
+	t := &v1alpha1.Tenant{}
+	meta.SetExternalName(t, tenantID)
+	t.Spec.ForProvider = v1alpha1.TenantParameters{
+		DisplayName:         "customer-a",
+		AllowPasswordSignup: gcp.BoolPtr(true),
+	}
+	for _, f := range m {
+		f(t)
+	}
+	return t
+}
+
+func observedTenant() *identitytoolkit.GoogleCloudIdentitytoolkitAdminV2Tenant {
+	return &identitytoolkit.GoogleCloudIdentitytoolkitAdminV2Tenant{
+		Name:                "projects/fooproject/tenants/" + tenantID,
+		DisplayName:         "customer-a",
+		AllowPasswordSignup: true,
+	}
+}
+
+func TestTenantObserve(t *testing.T) {
+	type args struct {
+		handler http.Handler
+		kube    client.Client
+		mg      *v1alpha1.Tenant
+	}
+	type want struct {
+		eo  managed.ExternalObservation
+		err error
+	}
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NoExternalName": {
+			args: args{
+				handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					t.Errorf("unexpected request to %s", r.URL.Path)
+				}),
+				mg: newTenant(func(t *v1alpha1.Tenant) {
+					meta.SetExternalName(t, "")
+				}),
+			},
+		},
+		"NotFound": {
+			args: args{
+				handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					_ = r.Body.Close()
+					w.WriteHeader(http.StatusNotFound)
+					_ = json.NewEncoder(w).Encode(struct{}{})
+				}),
+				mg: newTenant(),
+			},
+		},
+		"GetFailed": {
+			args: args{
+				handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					_ = r.Body.Close()
+					w.WriteHeader(http.StatusBadRequest)
+					_ = json.NewEncoder(w).Encode(struct{}{})
+				}),
+				mg: newTenant(),
+			},
+			want: want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetTenant)},
+		},
+		"SpecUpdateFailed": {
+			args: args{
+				handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					_ = r.Body.Close()
+					w.WriteHeader(http.StatusOK)
+					_ = json.NewEncoder(w).Encode(observedTenant())
+				}),
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+				mg: newTenant(func(t *v1alpha1.Tenant) {
+					t.Spec.ForProvider.AllowPasswordSignup = nil
+				}),
+			},
+			want: want{err: errors.Wrap(errBoom, errKubeUpdateTenant)},
+		},
+		"UpToDate": {
+			args: args{
+				handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					_ = r.Body.Close()
+					if diff := cmp.Diff(tenantPath, r.URL.Path); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					w.WriteHeader(http.StatusOK)
+					_ = json.NewEncoder(w).Encode(observedTenant())
+				}),
+				mg: newTenant(),
+			},
+			want: want{eo: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+		},
+		"NeedsUpdate": {
+			args: args{
+				handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					_ = r.Body.Close()
+					w.WriteHeader(http.StatusOK)
+					_ = json.NewEncoder(w).Encode(observedTenant())
+				}),
+				mg: newTenant(func(t *v1alpha1.Tenant) {
+					t.Spec.ForProvider.DisplayName = "customer-b"
+				}),
+			},
+			want: want{eo: managed.ExternalObservation{ResourceExists: true}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.args.handler)
+			defer server.Close()
+			s, _ := identitytoolkit.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := tenantExternal{projectID: projectID, client: tc.args.kube, tenants: s.Projects.Tenants}
+			got, err := e.Observe(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.eo, got); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestTenantCreate(t *testing.T) {
+	type want struct {
+		ec           managed.ExternalCreation
+		externalName string
+		err          error
+	}
+	cases := map[string]struct {
+		handler http.Handler
+		want    want
+	}{
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff("/v2/projects/fooproject/tenants", r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(observedTenant())
+			}),
+			want: want{
+				ec:           managed.ExternalCreation{ExternalNameAssigned: true},
+				externalName: tenantID,
+			},
+		},
+		"Failed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+			want: want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateTenant)},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := identitytoolkit.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := tenantExternal{projectID: projectID, tenants: s.Projects.Tenants}
+			cr := newTenant(func(t *v1alpha1.Tenant) {
+				meta.SetExternalName(t, "")
+			})
+			got, err := e.Create(context.Background(), cr)
+			if diff := cmp.Diff(tc.want.ec, got); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.externalName, meta.GetExternalName(cr)); diff != "" {
+				t.Errorf("Create(...): -want external name, +got external name:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestTenantUpdate(t *testing.T) {
+	cases := map[string]struct {
+		mg    *v1alpha1.Tenant
+		calls []string
+	}{
+		"UpToDate": {
+			mg: newTenant(),
+		},
+		"DisplayNameChanged": {
+			mg: newTenant(func(t *v1alpha1.Tenant) {
+				t.Spec.ForProvider.DisplayName = "customer-b"
+			}),
+			calls: []string{"PATCH " + tenantPath + "?updateMask=displayName"},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var calls []string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				if r.Method == http.MethodGet {
+					_ = json.NewEncoder(w).Encode(observedTenant())
+					return
+				}
+				calls = append(calls, r.Method+" "+r.URL.Path+"?updateMask="+r.URL.Query().Get("updateMask"))
+				_ = json.NewEncoder(w).Encode(observedTenant())
+			}))
+			defer server.Close()
+			s, _ := identitytoolkit.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := tenantExternal{projectID: projectID, tenants: s.Projects.Tenants}
+			if _, err := e.Update(context.Background(), tc.mg); err != nil {
+				t.Fatalf("Update(...): unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.calls, calls); diff != "" {
+				t.Errorf("Update(...): -want calls, +got calls:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestTenantDelete(t *testing.T) {
+	cases := map[string]struct {
+		status int
+		err    error
+	}{
+		"Successful": {
+			status: http.StatusOK,
+		},
+		"AlreadyGone": {
+			status: http.StatusNotFound,
+		},
+		"Failed": {
+			status: http.StatusBadRequest,
+			err:    errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteTenant),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodDelete, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}))
+			defer server.Close()
+			s, _ := identitytoolkit.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := tenantExternal{projectID: projectID, tenants: s.Projects.Tenants}
+			err := e.Delete(context.Background(), newTenant())
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,181 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ids
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	ids "google.golang.org/api/ids/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/ids/v1alpha1"
+	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/idsendpoint"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/setup"
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+	"github.com/crossplane-contrib/provider-gcp/pkg/tracing"
+)
+
+const (
+	errNewClient          = "cannot create new Cloud IDS client"
+	errNotEndpoint        = "managed resource is not of type Endpoint"
+	errGetEndpoint        = "cannot get Endpoint"
+	errCreateEndpoint     = "cannot create Endpoint"
+	errDeleteEndpoint     = "cannot delete Endpoint"
+	errKubeUpdateEndpoint = "cannot update Endpoint custom resource"
+)
+
+// SetupEndpoint adds a controller that reconciles Endpoints.
+func SetupEndpoint(mgr ctrl.Manager, o setup.Options) error {
+	name := managed.ControllerName(v1alpha1.EndpointGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
+	}
+
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.EndpointGroupVersionKind),
+		managed.WithExternalConnecter(setup.Connecter(mgr, o, v1alpha1.EndpointGroupKind, &endpointConnector{client: mgr.GetClient(), record: recorder})),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(recorder),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.Endpoint{}).
+		Complete(tracing.NewReconciler(name, ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter)))
+}
+
+type endpointConnector struct {
+	client client.Client
+	record event.Recorder
+}
+
+// Connect returns an ExternalClient with necessary information to talk to GCP API.
+func (c *endpointConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetConnectionInfo(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := ids.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &endpointExternal{projectID: projectID, client: c.client, ids: s, record: c.record}, nil
+}
+
+type endpointExternal struct {
+	projectID string
+	client    client.Client
+	ids       *ids.Service
+	record    event.Recorder
+}
+
+// Observe makes observation about the external resource.
+func (e *endpointExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Endpoint)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotEndpoint)
+	}
+	name := idsendpoint.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider.Location, meta.GetExternalName(cr))
+	ep, err := e.ids.Projects.Locations.Endpoints.Get(name).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetEndpoint)
+	}
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	idsendpoint.LateInitialize(&cr.Spec.ForProvider, *ep)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
+		if err := e.client.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errKubeUpdateEndpoint)
+		}
+	}
+	cr.Status.AtProvider = idsendpoint.GenerateObservation(*ep)
+	switch ep.State {
+	case v1alpha1.EndpointStateCreating:
+		cr.SetConditions(xpv1.Creating())
+	case v1alpha1.EndpointStateReady, v1alpha1.EndpointStateUpdating:
+		cr.SetConditions(xpv1.Available())
+	case v1alpha1.EndpointStateDeleting:
+		cr.SetConditions(xpv1.Deleting())
+	default:
+		cr.SetConditions(xpv1.Unavailable())
+	}
+	// Cloud IDS endpoints can not be updated once they are created, so the
+	// Endpoint is always considered up to date.
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: true,
+	}, nil
+}
+
+// Create initiates creation of external resource.
+func (e *endpointExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Endpoint)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotEndpoint)
+	}
+	cr.SetConditions(xpv1.Creating())
+	op, err := e.ids.Projects.Locations.Endpoints.Create(idsendpoint.GetParent(e.projectID, cr.Spec.ForProvider.Location), idsendpoint.GenerateEndpoint(cr.Spec.ForProvider)).
+		EndpointId(meta.GetExternalName(cr)).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateEndpoint)
+	}
+	gcp.RecordOperation(e.record, cr, "create", op.Name)
+	return managed.ExternalCreation{}, nil
+}
+
+// Update is a no-op, since Cloud IDS endpoints can not be updated.
+func (e *endpointExternal) Update(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
+	return managed.ExternalUpdate{}, nil
+}
+
+// Delete initiates an deletion of the external resource. Nothing is done if
+// the endpoint is already being deleted.
+func (e *endpointExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Endpoint)
+	if !ok {
+		return errors.New(errNotEndpoint)
+	}
+	if cr.Status.AtProvider.State == v1alpha1.EndpointStateDeleting {
+		return nil
+	}
+	name := idsendpoint.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider.Location, meta.GetExternalName(cr))
+	op, err := e.ids.Projects.Locations.Endpoints.Delete(name).Context(ctx).Do()
+	if err != nil {
+		return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteEndpoint)
+	}
+	gcp.RecordOperation(e.record, cr, "delete", op.Name)
+	return nil
+}
@@ -0,0 +1,199 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ids
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/googleapi"
+	ids "google.golang.org/api/ids/v1"
+	"google.golang.org/api/option"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/ids/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	projectID      = "fooproject"
+	endpointName   = "web"
+	endpointPath   = "/v1/projects/fooproject/locations/us-central1-a/endpoints/web"
+	forwardingRule = "https://www.googleapis.com/compute/v1/projects/tenant/regions/us-central1/forwardingRules/ids-fr"
+)
+
+func gError(code int, message string) *googleapi.Error {
+	return &googleapi.Error{
+		Code:    code,
+		Body:    "{}\n",
+		Message: message,
+	}
+}
+
+func newEndpoint(m ...func(*v1alpha1.Endpoint)) *v1alpha1.Endpoint {
+	e := &v1alpha1.Endpoint{}
+	meta.SetExternalName(e, endpointName)
+	e.Spec.ForProvider = v1alpha1.EndpointParameters{
+		Location: "us-central1-a",
+		Network:  gcp.StringPtr("projects/fooproject/global/networks/vpc"),
+		Severity: "MEDIUM",
+	}
+	for _, f := range m {
+		f(e)
+	}
+	return e
+}
+
+func observedEndpoint(state string) *ids.Endpoint {
+	return &ids.Endpoint{
+		Name:                   "projects/fooproject/locations/us-central1-a/endpoints/web",
+		Network:                "projects/fooproject/global/networks/vpc",
+		Severity:               "MEDIUM",
+		State:                  state,
+		EndpointForwardingRule: forwardingRule,
+	}
+}
+
+func TestEndpointObserve(t *testing.T) {
+	type want struct {
+		eo   managed.ExternalObservation
+		cond xpv1.Condition
+		err  error
+	}
+	cases := map[string]struct {
+		handler http.Handler
+		mg      *v1alpha1.Endpoint
+		want    want
+	}{
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+			mg: newEndpoint(),
+		},
+		"GetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+			mg:   newEndpoint(),
+			want: want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetEndpoint)},
+		},
+		"Creating": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(endpointPath, r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(observedEndpoint(v1alpha1.EndpointStateCreating))
+			}),
+			mg: newEndpoint(),
+			want: want{
+				eo:   managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				cond: xpv1.Creating(),
+			},
+		},
+		"Ready": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(observedEndpoint(v1alpha1.EndpointStateReady))
+			}),
+			mg: newEndpoint(),
+			want: want{
+				eo:   managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				cond: xpv1.Available(),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := ids.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := endpointExternal{projectID: projectID, ids: s}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.eo, got); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if tc.want.cond.Type == "" {
+				return
+			}
+			if diff := cmp.Diff(tc.want.cond, tc.mg.GetCondition(xpv1.TypeReady), test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want condition, +got condition:\n%s", diff)
+			}
+			if diff := cmp.Diff(forwardingRule, tc.mg.Status.AtProvider.EndpointForwardingRule); diff != "" {
+				t.Errorf("Observe(...): -want forwarding rule, +got forwarding rule:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestEndpointDelete(t *testing.T) {
+	cases := map[string]struct {
+		mg      *v1alpha1.Endpoint
+		deleted bool
+	}{
+		"Ready": {
+			mg:      newEndpoint(),
+			deleted: true,
+		},
+		"AlreadyDeleting": {
+			mg: newEndpoint(func(e *v1alpha1.Endpoint) {
+				e.Status.AtProvider.State = v1alpha1.EndpointStateDeleting
+			}),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			deleted := false
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if r.Method == http.MethodDelete && r.URL.Path == endpointPath {
+					deleted = true
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&ids.Operation{})
+			}))
+			defer server.Close()
+			s, _ := ids.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := endpointExternal{projectID: projectID, ids: s}
+			if err := e.Delete(context.Background(), tc.mg); err != nil {
+				t.Fatalf("Delete(...): unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.deleted, deleted); diff != "" {
+				t.Errorf("Delete(...): -want deleted, +got deleted:\n%s", diff)
+			}
+		})
+	}
+}
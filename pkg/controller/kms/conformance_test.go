@@ -0,0 +1,55 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kms
+
+import (
+	"testing"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/kms/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/conformance"
+)
+
+func TestConformance(t *testing.T) {
+	conformance.Run(t, []string{v1alpha1.Group}, map[string]conformance.Fixture{
+		v1alpha1.CryptoKeyGroupKind: {
+			Managed:   func() resource.Managed { return &v1alpha1.CryptoKey{} },
+			Connecter: func(kube client.Client) managed.ExternalConnecter { return &cryptoKeyConnecter{client: kube} },
+			Retained:  "GCP cannot delete crypto keys.",
+		},
+		v1alpha1.CryptoKeyPolicyGroupKind: {
+			Managed: func() resource.Managed {
+				return &v1alpha1.CryptoKeyPolicy{Spec: v1alpha1.CryptoKeyPolicySpec{ForProvider: v1alpha1.CryptoKeyPolicyParameters{
+					CryptoKey: gcp.StringPtr("projects/project/locations/global/keyRings/ring/cryptoKeys/key"),
+				}}}
+			},
+			Connecter: func(kube client.Client) managed.ExternalConnecter { return &cryptoKeyPolicyConnecter{client: kube} },
+			Identity:  "The policy is the IAM policy of the crypto key.",
+			Found:     `{"bindings":[{"role":"roles/viewer","members":["user:alice@example.com"]}]}`,
+		},
+		v1alpha1.KeyRingGroupKind: {
+			Managed:   func() resource.Managed { return &v1alpha1.KeyRing{} },
+			Connecter: func(kube client.Client) managed.ExternalConnecter { return &keyRingConnecter{client: kube} },
+			Retained:  "GCP cannot delete key rings.",
+		},
+	})
+}
@@ -25,7 +25,6 @@ import (
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/connection"
-	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	"github.com/crossplane/crossplane-runtime/pkg/errors"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
@@ -37,7 +36,9 @@ import (
 	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
 	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
 	"github.com/crossplane-contrib/provider-gcp/pkg/clients/cryptokeypolicy"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/setup"
 	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+	"github.com/crossplane-contrib/provider-gcp/pkg/tracing"
 )
 
 const (
@@ -47,7 +48,7 @@ const (
 )
 
 // SetupCryptoKeyPolicy adds a controller that reconciles CryptoKeyPolicys.
-func SetupCryptoKeyPolicy(mgr ctrl.Manager, o controller.Options) error {
+func SetupCryptoKeyPolicy(mgr ctrl.Manager, o setup.Options) error {
 	name := managed.ControllerName(v1alpha1.CryptoKeyPolicyGroupKind)
 
 	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
@@ -57,7 +58,7 @@ func SetupCryptoKeyPolicy(mgr ctrl.Manager, o controller.Options) error {
 
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(v1alpha1.CryptoKeyPolicyGroupVersionKind),
-		managed.WithExternalConnecter(&cryptoKeyPolicyConnecter{client: mgr.GetClient()}),
+		managed.WithExternalConnecter(setup.Connecter(mgr, o, v1alpha1.CryptoKeyPolicyGroupKind, &cryptoKeyPolicyConnecter{client: mgr.GetClient()})),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
@@ -67,7 +68,7 @@ func SetupCryptoKeyPolicy(mgr ctrl.Manager, o controller.Options) error {
 		Named(name).
 		WithOptions(o.ForControllerRuntime()).
 		For(&v1alpha1.CryptoKeyPolicy{}).
-		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+		Complete(tracing.NewReconciler(name, ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter)))
 }
 
 type cryptoKeyPolicyConnecter struct {
@@ -145,28 +146,28 @@ func (e *cryptoKeyPolicyExternal) Update(ctx context.Context, mg resource.Manage
 	if !ok {
 		return managed.ExternalUpdate{}, errors.New(errNotCryptoKeyPolicy)
 	}
-	instance, err := e.cryptokeyspolicy.GetIamPolicy(gcp.StringValue(cr.Spec.ForProvider.CryptoKey)).OptionsRequestedPolicyVersion(iamv1alpha1.PolicyVersion).Context(ctx).Do()
-	if err != nil {
-		return managed.ExternalUpdate{}, errors.Wrap(err, errGetPolicy)
-	}
-
-	u, err := cryptokeypolicy.IsUpToDate(&cr.Spec.ForProvider, instance)
-	if err != nil {
-		return managed.ExternalUpdate{}, errors.Wrap(err, errCheckUpToDate)
-	}
-	if u {
-		return managed.ExternalUpdate{}, nil
-	}
-
-	cryptokeypolicy.GenerateCryptoKeyPolicyInstance(cr.Spec.ForProvider, instance)
-	req := &kmsv1.SetIamPolicyRequest{Policy: instance}
-
-	if _, err := e.cryptokeyspolicy.SetIamPolicy(gcp.StringValue(cr.Spec.ForProvider.CryptoKey), req).
-		Context(ctx).Do(); err != nil {
-		return managed.ExternalUpdate{}, errors.Wrap(err, errSetPolicy)
-	}
-
-	return managed.ExternalUpdate{}, nil
+	// The policy read here carries an etag, so the write is rejected if
+	// somebody else changed the policy in between. Read it again and retry.
+	err := gcp.RetryOnConflict(func() error {
+		instance, err := e.cryptokeyspolicy.GetIamPolicy(gcp.StringValue(cr.Spec.ForProvider.CryptoKey)).OptionsRequestedPolicyVersion(iamv1alpha1.PolicyVersion).Context(ctx).Do()
+		if err != nil {
+			return errors.Wrap(err, errGetPolicy)
+		}
+
+		u, err := cryptokeypolicy.IsUpToDate(&cr.Spec.ForProvider, instance)
+		if err != nil {
+			return errors.Wrap(err, errCheckUpToDate)
+		}
+		if u {
+			return nil
+		}
+
+		cryptokeypolicy.GenerateCryptoKeyPolicyInstance(cr.Spec.ForProvider, instance)
+		req := &kmsv1.SetIamPolicyRequest{Policy: instance}
+		_, err = e.cryptokeyspolicy.SetIamPolicy(gcp.StringValue(cr.Spec.ForProvider.CryptoKey), req).Context(ctx).Do()
+		return errors.Wrap(err, errSetPolicy)
+	})
+	return managed.ExternalUpdate{}, err
 }
 
 func (e *cryptoKeyPolicyExternal) Delete(ctx context.Context, mg resource.Managed) error {
@@ -175,9 +176,6 @@ func (e *cryptoKeyPolicyExternal) Delete(ctx context.Context, mg resource.Manage
 		return errors.New(errNotCryptoKeyPolicy)
 	}
 	req := &kmsv1.SetIamPolicyRequest{Policy: &kmsv1.Policy{}}
-	if _, err := e.cryptokeyspolicy.SetIamPolicy(gcp.StringValue(cr.Spec.ForProvider.CryptoKey), req).
-		Context(ctx).Do(); err != nil {
-		return errors.Wrap(err, errSetPolicy)
-	}
-	return nil
+	_, err := e.cryptokeyspolicy.SetIamPolicy(gcp.StringValue(cr.Spec.ForProvider.CryptoKey), req).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errSetPolicy)
 }
@@ -682,6 +682,25 @@ func TestCryptoKeyPolicyDelete(t *testing.T) {
 					ckpWithExternalNameAnnotation(ckpMetadataName)),
 			},
 		},
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNotFound)
+				if err := json.NewEncoder(w).Encode(&kmsv1.Policy{}); err != nil {
+					t.Error(err)
+				}
+			}),
+			args: args{
+				ctx: context.Background(),
+				mg: CryptoKeyPolicy(
+					ckpWithName(ckpMetadataName),
+					ckpWithExternalNameAnnotation(ckpMetadataName)),
+			},
+			want: want{
+				mg: CryptoKeyPolicy(
+					ckpWithName(ckpMetadataName),
+					ckpWithExternalNameAnnotation(ckpMetadataName)),
+			},
+		},
 		"CreateFailed": {
 			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 				w.WriteHeader(http.StatusInternalServerError)
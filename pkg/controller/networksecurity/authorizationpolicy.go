@@ -0,0 +1,183 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networksecurity
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	networksecurity "google.golang.org/api/networksecurity/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/networksecurity/v1alpha1"
+	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/authorizationpolicy"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/setup"
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+	"github.com/crossplane-contrib/provider-gcp/pkg/tracing"
+)
+
+const (
+	errNotAuthorizationPolicy        = "managed resource is not of type AuthorizationPolicy"
+	errGetAuthorizationPolicy        = "cannot get AuthorizationPolicy"
+	errCreateAuthorizationPolicy     = "cannot create AuthorizationPolicy"
+	errUpdateAuthorizationPolicy     = "cannot update AuthorizationPolicy"
+	errDeleteAuthorizationPolicy     = "cannot delete AuthorizationPolicy"
+	errKubeUpdateAuthorizationPolicy = "cannot update AuthorizationPolicy custom resource"
+)
+
+// SetupAuthorizationPolicy adds a controller that reconciles AuthorizationPolicies.
+func SetupAuthorizationPolicy(mgr ctrl.Manager, o setup.Options) error {
+	name := managed.ControllerName(v1alpha1.AuthorizationPolicyGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
+	}
+
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.AuthorizationPolicyGroupVersionKind),
+		managed.WithExternalConnecter(setup.Connecter(mgr, o, v1alpha1.AuthorizationPolicyGroupKind, &authorizationPolicyConnector{client: mgr.GetClient(), record: recorder})),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(recorder),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.AuthorizationPolicy{}).
+		Complete(tracing.NewReconciler(name, ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter)))
+}
+
+type authorizationPolicyConnector struct {
+	client client.Client
+	record event.Recorder
+}
+
+// Connect returns an ExternalClient with necessary information to talk to GCP API.
+func (c *authorizationPolicyConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetConnectionInfo(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := networksecurity.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &authorizationPolicyExternal{projectID: projectID, client: c.client, networksecurity: s, record: c.record}, nil
+}
+
+type authorizationPolicyExternal struct {
+	projectID       string
+	client          client.Client
+	networksecurity *networksecurity.Service
+	record          event.Recorder
+}
+
+// Observe makes observation about the external resource.
+func (e *authorizationPolicyExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.AuthorizationPolicy)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotAuthorizationPolicy)
+	}
+	name := authorizationpolicy.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider.Location, meta.GetExternalName(cr))
+	obs, err := e.networksecurity.Projects.Locations.AuthorizationPolicies.Get(name).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetAuthorizationPolicy)
+	}
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	authorizationpolicy.LateInitialize(&cr.Spec.ForProvider, *obs)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
+		if err := e.client.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errKubeUpdateAuthorizationPolicy)
+		}
+	}
+	cr.Status.AtProvider = authorizationpolicy.GenerateObservation(*obs)
+	cr.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: authorizationpolicy.IsUpToDate(cr.Spec.ForProvider, *obs),
+	}, nil
+}
+
+// Create initiates creation of external resource.
+func (e *authorizationPolicyExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.AuthorizationPolicy)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotAuthorizationPolicy)
+	}
+	cr.SetConditions(xpv1.Creating())
+	op, err := e.networksecurity.Projects.Locations.AuthorizationPolicies.Create(authorizationpolicy.GetParent(e.projectID, cr.Spec.ForProvider.Location), authorizationpolicy.GenerateAuthorizationPolicy("", cr.Spec.ForProvider)).
+		AuthorizationPolicyId(meta.GetExternalName(cr)).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateAuthorizationPolicy)
+	}
+	gcp.RecordOperation(e.record, cr, "create", op.Name)
+	return managed.ExternalCreation{}, nil
+}
+
+// Update initiates an update to the external resource.
+func (e *authorizationPolicyExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.AuthorizationPolicy)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotAuthorizationPolicy)
+	}
+	name := authorizationpolicy.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider.Location, meta.GetExternalName(cr))
+	obs, err := e.networksecurity.Projects.Locations.AuthorizationPolicies.Get(name).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetAuthorizationPolicy)
+	}
+	op, err := e.networksecurity.Projects.Locations.AuthorizationPolicies.Patch(name, authorizationpolicy.GenerateAuthorizationPolicy(name, cr.Spec.ForProvider)).
+		UpdateMask(authorizationpolicy.GenerateUpdateMask(cr.Spec.ForProvider, *obs)).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateAuthorizationPolicy)
+	}
+	gcp.RecordOperation(e.record, cr, "update", op.Name)
+	return managed.ExternalUpdate{}, nil
+}
+
+// Delete initiates an deletion of the external resource.
+func (e *authorizationPolicyExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.AuthorizationPolicy)
+	if !ok {
+		return errors.New(errNotAuthorizationPolicy)
+	}
+	name := authorizationpolicy.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider.Location, meta.GetExternalName(cr))
+	op, err := e.networksecurity.Projects.Locations.AuthorizationPolicies.Delete(name).Context(ctx).Do()
+	if err != nil {
+		return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteAuthorizationPolicy)
+	}
+	gcp.RecordOperation(e.record, cr, "delete", op.Name)
+	return nil
+}
@@ -0,0 +1,198 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networksecurity
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/googleapi"
+	networksecurity "google.golang.org/api/networksecurity/v1"
+	"google.golang.org/api/option"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/networksecurity/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	projectID  = "fooproject"
+	policyName = "allow-web"
+	policyPath = "/v1/projects/fooproject/locations/global/authorizationPolicies/allow-web"
+)
+
+func gError(code int, message string) *googleapi.Error {
+	return &googleapi.Error{
+		Code:    code,
+		Body:    "{}\n",
+		Message: message,
+	}
+}
+
+func newAuthorizationPolicy(m ...func(*v1alpha1.AuthorizationPolicy)) *v1alpha1.AuthorizationPolicy {
+	a := &v1alpha1.AuthorizationPolicy{}
+	meta.SetExternalName(a, policyName)
+	a.Spec.ForProvider = v1alpha1.AuthorizationPolicyParameters{
+		Location:    "global",
+		Description: gcp.StringPtr("web"),
+		Action:      "ALLOW",
+		Rules: []v1alpha1.AuthorizationRule{{
+			Sources:      []v1alpha1.AuthorizationSource{{Principals: []string{"spiffe://example/ns/web/sa/frontend"}}},
+			Destinations: []v1alpha1.AuthorizationDestination{{Hosts: []string{"web.example.com"}, Ports: []int64{443}}},
+		}},
+	}
+	for _, f := range m {
+		f(a)
+	}
+	return a
+}
+
+func observedAuthorizationPolicy() *networksecurity.AuthorizationPolicy {
+	return &networksecurity.AuthorizationPolicy{
+		Name:        "projects/fooproject/locations/global/authorizationPolicies/allow-web",
+		Description: "web",
+		Action:      "ALLOW",
+		Rules: []*networksecurity.Rule{{
+			Sources:      []*networksecurity.Source{{Principals: []string{"spiffe://example/ns/web/sa/frontend"}}},
+			Destinations: []*networksecurity.Destination{{Hosts: []string{"web.example.com"}, Ports: []int64{443}}},
+		}},
+	}
+}
+
+func TestAuthorizationPolicyObserve(t *testing.T) {
+	type want struct {
+		eo   managed.ExternalObservation
+		cond xpv1.Condition
+		err  error
+	}
+	cases := map[string]struct {
+		handler http.Handler
+		mg      *v1alpha1.AuthorizationPolicy
+		want    want
+	}{
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+			mg: newAuthorizationPolicy(),
+		},
+		"GetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+			mg:   newAuthorizationPolicy(),
+			want: want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetAuthorizationPolicy)},
+		},
+		"UpToDate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(policyPath, r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(observedAuthorizationPolicy())
+			}),
+			mg: newAuthorizationPolicy(),
+			want: want{
+				eo:   managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				cond: xpv1.Available(),
+			},
+		},
+		"NeedsUpdate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(observedAuthorizationPolicy())
+			}),
+			mg: newAuthorizationPolicy(func(a *v1alpha1.AuthorizationPolicy) {
+				a.Spec.ForProvider.Action = "DENY"
+			}),
+			want: want{
+				eo:   managed.ExternalObservation{ResourceExists: true},
+				cond: xpv1.Available(),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := networksecurity.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := authorizationPolicyExternal{projectID: projectID, networksecurity: s}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.eo, got); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if tc.want.cond.Type == "" {
+				return
+			}
+			if diff := cmp.Diff(tc.want.cond, tc.mg.GetCondition(xpv1.TypeReady), test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want condition, +got condition:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAuthorizationPolicyUpdate(t *testing.T) {
+	var gotMask string
+	got := &networksecurity.AuthorizationPolicy{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer func() { _ = r.Body.Close() }()
+		w.WriteHeader(http.StatusOK)
+		if r.Method == http.MethodGet {
+			_ = json.NewEncoder(w).Encode(observedAuthorizationPolicy())
+			return
+		}
+		gotMask = r.URL.Query().Get("updateMask")
+		b, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(b, got)
+		_ = json.NewEncoder(w).Encode(&networksecurity.Operation{})
+	}))
+	defer server.Close()
+
+	s, _ := networksecurity.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	e := authorizationPolicyExternal{projectID: projectID, networksecurity: s}
+	mg := newAuthorizationPolicy(func(a *v1alpha1.AuthorizationPolicy) {
+		a.Spec.ForProvider.Rules[0].Destinations[0].Methods = []string{"GET"}
+	})
+	if _, err := e.Update(context.Background(), mg); err != nil {
+		t.Fatalf("Update(...): unexpected error: %v", err)
+	}
+	if diff := cmp.Diff("rules", gotMask); diff != "" {
+		t.Errorf("Update(...): -want mask, +got mask:\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"GET"}, got.Rules[0].Destinations[0].Methods); diff != "" {
+		t.Errorf("Update(...): -want methods, +got methods:\n%s", diff)
+	}
+}
@@ -0,0 +1,183 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networksecurity
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	networksecurity "google.golang.org/api/networksecurity/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/networksecurity/v1alpha1"
+	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/tlspolicy"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/setup"
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+	"github.com/crossplane-contrib/provider-gcp/pkg/tracing"
+)
+
+const (
+	errNotClientTLSPolicy        = "managed resource is not of type ClientTLSPolicy"
+	errGetClientTLSPolicy        = "cannot get ClientTLSPolicy"
+	errCreateClientTLSPolicy     = "cannot create ClientTLSPolicy"
+	errUpdateClientTLSPolicy     = "cannot update ClientTLSPolicy"
+	errDeleteClientTLSPolicy     = "cannot delete ClientTLSPolicy"
+	errKubeUpdateClientTLSPolicy = "cannot update ClientTLSPolicy custom resource"
+)
+
+// SetupClientTLSPolicy adds a controller that reconciles ClientTLSPolicies.
+func SetupClientTLSPolicy(mgr ctrl.Manager, o setup.Options) error {
+	name := managed.ControllerName(v1alpha1.ClientTLSPolicyGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
+	}
+
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.ClientTLSPolicyGroupVersionKind),
+		managed.WithExternalConnecter(setup.Connecter(mgr, o, v1alpha1.ClientTLSPolicyGroupKind, &clientTLSPolicyConnector{client: mgr.GetClient(), record: recorder})),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(recorder),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.ClientTLSPolicy{}).
+		Complete(tracing.NewReconciler(name, ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter)))
+}
+
+type clientTLSPolicyConnector struct {
+	client client.Client
+	record event.Recorder
+}
+
+// Connect returns an ExternalClient with necessary information to talk to GCP API.
+func (c *clientTLSPolicyConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetConnectionInfo(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := networksecurity.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &clientTLSPolicyExternal{projectID: projectID, client: c.client, networksecurity: s, record: c.record}, nil
+}
+
+type clientTLSPolicyExternal struct {
+	projectID       string
+	client          client.Client
+	networksecurity *networksecurity.Service
+	record          event.Recorder
+}
+
+// Observe makes observation about the external resource.
+func (e *clientTLSPolicyExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ClientTLSPolicy)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotClientTLSPolicy)
+	}
+	name := tlspolicy.GetClientTLSPolicyName(e.projectID, cr.Spec.ForProvider.Location, meta.GetExternalName(cr))
+	obs, err := e.networksecurity.Projects.Locations.ClientTlsPolicies.Get(name).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetClientTLSPolicy)
+	}
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	tlspolicy.LateInitializeClientTLSPolicy(&cr.Spec.ForProvider, *obs)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
+		if err := e.client.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errKubeUpdateClientTLSPolicy)
+		}
+	}
+	cr.Status.AtProvider = tlspolicy.GenerateClientTLSPolicyObservation(*obs)
+	cr.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: tlspolicy.IsClientTLSPolicyUpToDate(cr.Spec.ForProvider, *obs),
+	}, nil
+}
+
+// Create initiates creation of external resource.
+func (e *clientTLSPolicyExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ClientTLSPolicy)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotClientTLSPolicy)
+	}
+	cr.SetConditions(xpv1.Creating())
+	op, err := e.networksecurity.Projects.Locations.ClientTlsPolicies.Create(tlspolicy.GetParent(e.projectID, cr.Spec.ForProvider.Location), tlspolicy.GenerateClientTLSPolicy("", cr.Spec.ForProvider)).
+		ClientTlsPolicyId(meta.GetExternalName(cr)).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateClientTLSPolicy)
+	}
+	gcp.RecordOperation(e.record, cr, "create", op.Name)
+	return managed.ExternalCreation{}, nil
+}
+
+// Update initiates an update to the external resource.
+func (e *clientTLSPolicyExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.ClientTLSPolicy)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotClientTLSPolicy)
+	}
+	name := tlspolicy.GetClientTLSPolicyName(e.projectID, cr.Spec.ForProvider.Location, meta.GetExternalName(cr))
+	obs, err := e.networksecurity.Projects.Locations.ClientTlsPolicies.Get(name).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetClientTLSPolicy)
+	}
+	op, err := e.networksecurity.Projects.Locations.ClientTlsPolicies.Patch(name, tlspolicy.GenerateClientTLSPolicy(name, cr.Spec.ForProvider)).
+		UpdateMask(tlspolicy.GenerateClientTLSPolicyUpdateMask(cr.Spec.ForProvider, *obs)).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateClientTLSPolicy)
+	}
+	gcp.RecordOperation(e.record, cr, "update", op.Name)
+	return managed.ExternalUpdate{}, nil
+}
+
+// Delete initiates an deletion of the external resource.
+func (e *clientTLSPolicyExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.ClientTLSPolicy)
+	if !ok {
+		return errors.New(errNotClientTLSPolicy)
+	}
+	name := tlspolicy.GetClientTLSPolicyName(e.projectID, cr.Spec.ForProvider.Location, meta.GetExternalName(cr))
+	op, err := e.networksecurity.Projects.Locations.ClientTlsPolicies.Delete(name).Context(ctx).Do()
+	if err != nil {
+		return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteClientTLSPolicy)
+	}
+	gcp.RecordOperation(e.record, cr, "delete", op.Name)
+	return nil
+}
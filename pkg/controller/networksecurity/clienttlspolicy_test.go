@@ -0,0 +1,252 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networksecurity
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	networksecurity "google.golang.org/api/networksecurity/v1"
+	"google.golang.org/api/option"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/networksecurity/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	clientTLSPolicyPath  = "/v1/projects/fooproject/locations/global/clientTlsPolicies/mtls"
+	clientTLSPoliciesURL = "/v1/projects/fooproject/locations/global/clientTlsPolicies"
+)
+
+func newClientTLSPolicy(m ...func(*v1alpha1.ClientTLSPolicy)) *v1alpha1.ClientTLSPolicy {
+	c := &v1alpha1.ClientTLSPolicy{}
+	meta.SetExternalName(c, tlsPolicyName)
+	c.Spec.ForProvider = v1alpha1.ClientTLSPolicyParameters{
+		Location: "global",
+		SNI:      gcp.StringPtr("secure.example.com"),
+		ClientCertificate: &v1alpha1.CertificateProvider{
+			CertificateProviderInstance: &v1alpha1.CertificateProviderInstance{PluginInstance: testPlugin},
+		},
+	}
+	for _, f := range m {
+		f(c)
+	}
+	return c
+}
+
+func observedClientTLSPolicy() *networksecurity.ClientTlsPolicy {
+	return &networksecurity.ClientTlsPolicy{
+		Name: "projects/fooproject/locations/global/clientTlsPolicies/mtls",
+		Sni:  "secure.example.com",
+		ClientCertificate: &networksecurity.GoogleCloudNetworksecurityV1CertificateProvider{
+			CertificateProviderInstance: &networksecurity.CertificateProviderInstance{PluginInstance: testPlugin},
+		},
+	}
+}
+
+func TestClientTLSPolicyObserve(t *testing.T) {
+	type want struct {
+		eo   managed.ExternalObservation
+		cond xpv1.Condition
+		err  error
+	}
+	cases := map[string]struct {
+		handler http.Handler
+		mg      *v1alpha1.ClientTLSPolicy
+		want    want
+	}{
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+			mg: newClientTLSPolicy(),
+		},
+		"GetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+			mg:   newClientTLSPolicy(),
+			want: want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetClientTLSPolicy)},
+		},
+		"UpToDate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(clientTLSPolicyPath, r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(observedClientTLSPolicy())
+			}),
+			mg: newClientTLSPolicy(),
+			want: want{
+				eo:   managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				cond: xpv1.Available(),
+			},
+		},
+		"NeedsUpdate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(observedClientTLSPolicy())
+			}),
+			mg: newClientTLSPolicy(func(c *v1alpha1.ClientTLSPolicy) {
+				c.Spec.ForProvider.SNI = gcp.StringPtr("other.example.com")
+			}),
+			want: want{
+				eo:   managed.ExternalObservation{ResourceExists: true},
+				cond: xpv1.Available(),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := networksecurity.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := clientTLSPolicyExternal{projectID: projectID, client: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)}, networksecurity: s}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.eo, got); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if tc.want.cond.Type == "" {
+				return
+			}
+			if diff := cmp.Diff(tc.want.cond, tc.mg.GetCondition(xpv1.TypeReady), test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want condition, +got condition:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestClientTLSPolicyCreate(t *testing.T) {
+	var gotID string
+	got := &networksecurity.ClientTlsPolicy{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer func() { _ = r.Body.Close() }()
+		if diff := cmp.Diff(clientTLSPoliciesURL, r.URL.Path); diff != "" {
+			t.Errorf("r: -want path, +got path:\n%s", diff)
+		}
+		gotID = r.URL.Query().Get("clientTlsPolicyId")
+		b, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(b, got)
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(&networksecurity.Operation{})
+	}))
+	defer server.Close()
+
+	s, _ := networksecurity.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	e := clientTLSPolicyExternal{projectID: projectID, networksecurity: s}
+	if _, err := e.Create(context.Background(), newClientTLSPolicy()); err != nil {
+		t.Fatalf("Create(...): unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(tlsPolicyName, gotID); diff != "" {
+		t.Errorf("Create(...): -want ID, +got ID:\n%s", diff)
+	}
+	if diff := cmp.Diff("secure.example.com", got.Sni); diff != "" {
+		t.Errorf("Create(...): -want SNI, +got SNI:\n%s", diff)
+	}
+}
+
+func TestClientTLSPolicyUpdate(t *testing.T) {
+	var gotMask string
+	got := &networksecurity.ClientTlsPolicy{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer func() { _ = r.Body.Close() }()
+		w.WriteHeader(http.StatusOK)
+		if r.Method == http.MethodGet {
+			_ = json.NewEncoder(w).Encode(observedClientTLSPolicy())
+			return
+		}
+		gotMask = r.URL.Query().Get("updateMask")
+		b, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(b, got)
+		_ = json.NewEncoder(w).Encode(&networksecurity.Operation{})
+	}))
+	defer server.Close()
+
+	s, _ := networksecurity.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	e := clientTLSPolicyExternal{projectID: projectID, networksecurity: s}
+	mg := newClientTLSPolicy(func(c *v1alpha1.ClientTLSPolicy) {
+		c.Spec.ForProvider.ServerValidationCA = []v1alpha1.CertificateProvider{{
+			GRPCEndpoint: &v1alpha1.GRPCEndpoint{TargetURI: "unix:mypath"},
+		}}
+	})
+	if _, err := e.Update(context.Background(), mg); err != nil {
+		t.Fatalf("Update(...): unexpected error: %v", err)
+	}
+	if diff := cmp.Diff("serverValidationCa", gotMask); diff != "" {
+		t.Errorf("Update(...): -want mask, +got mask:\n%s", diff)
+	}
+	if diff := cmp.Diff(1, len(got.ServerValidationCa)); diff != "" {
+		t.Errorf("Update(...): -want validation CAs, +got validation CAs:\n%s", diff)
+	}
+}
+
+func TestClientTLSPolicyDelete(t *testing.T) {
+	cases := map[string]struct {
+		status int
+		err    error
+	}{
+		"Successful": {
+			status: http.StatusOK,
+		},
+		"NotFound": {
+			status: http.StatusNotFound,
+		},
+		"DeleteFailed": {
+			status: http.StatusBadRequest,
+			err:    errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteClientTLSPolicy),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(clientTLSPolicyPath, r.URL.Path); diff != "" {
+					t.Errorf("r: -want path, +got path:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(&networksecurity.Operation{})
+			}))
+			defer server.Close()
+			s, _ := networksecurity.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := clientTLSPolicyExternal{projectID: projectID, networksecurity: s}
+			err := e.Delete(context.Background(), newClientTLSPolicy())
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
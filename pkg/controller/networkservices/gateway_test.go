@@ -0,0 +1,244 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networkservices
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	networkservices "google.golang.org/api/networkservices/v1"
+	"google.golang.org/api/option"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/networkservices/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	gatewayName = "ingress"
+	gatewayPath = "/v1/projects/fooproject/locations/us-central1/gateways/ingress"
+)
+
+func newGateway(m ...func(*v1alpha1.Gateway)) *v1alpha1.Gateway {
+	g := &v1alpha1.Gateway{}
+	meta.SetExternalName(g, gatewayName)
+	g.Spec.ForProvider = v1alpha1.GatewayParameters{
+		Location:    "us-central1",
+		Description: gcp.StringPtr("ingress"),
+		Type:        "OPEN_MESH",
+		Ports:       []int64{443},
+		Scope:       gcp.StringPtr("ingress"),
+	}
+	for _, f := range m {
+		f(g)
+	}
+	return g
+}
+
+func observedGateway() *networkservices.Gateway {
+	return &networkservices.Gateway{
+		Name:        "projects/fooproject/locations/us-central1/gateways/ingress",
+		Description: "ingress",
+		Type:        "OPEN_MESH",
+		Ports:       []int64{443},
+		Scope:       "ingress",
+	}
+}
+
+func TestGatewayObserve(t *testing.T) {
+	type want struct {
+		eo   managed.ExternalObservation
+		cond xpv1.Condition
+		err  error
+	}
+	cases := map[string]struct {
+		handler http.Handler
+		mg      *v1alpha1.Gateway
+		want    want
+	}{
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+			mg: newGateway(),
+		},
+		"GetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+			mg:   newGateway(),
+			want: want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetGateway)},
+		},
+		"UpToDate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(gatewayPath, r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(observedGateway())
+			}),
+			mg: newGateway(),
+			want: want{
+				eo:   managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				cond: xpv1.Available(),
+			},
+		},
+		"NeedsUpdate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(observedGateway())
+			}),
+			mg: newGateway(func(g *v1alpha1.Gateway) {
+				g.Spec.ForProvider.Labels = map[string]string{"team": "platform"}
+			}),
+			want: want{
+				eo:   managed.ExternalObservation{ResourceExists: true},
+				cond: xpv1.Available(),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := networkservices.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := gatewayExternal{projectID: projectID, client: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)}, networkservices: s}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.eo, got); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if tc.want.cond.Type == "" {
+				return
+			}
+			if diff := cmp.Diff(tc.want.cond, tc.mg.GetCondition(xpv1.TypeReady), test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want condition, +got condition:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGatewayCreate(t *testing.T) {
+	var gotID string
+	got := &networkservices.Gateway{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer func() { _ = r.Body.Close() }()
+		if diff := cmp.Diff("/v1/projects/fooproject/locations/us-central1/gateways", r.URL.Path); diff != "" {
+			t.Errorf("r: -want path, +got path:\n%s", diff)
+		}
+		gotID = r.URL.Query().Get("gatewayId")
+		b, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(b, got)
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(&networkservices.Operation{})
+	}))
+	defer server.Close()
+
+	s, _ := networkservices.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	e := gatewayExternal{projectID: projectID, networkservices: s}
+	if _, err := e.Create(context.Background(), newGateway()); err != nil {
+		t.Fatalf("Create(...): unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(gatewayName, gotID); diff != "" {
+		t.Errorf("Create(...): -want ID, +got ID:\n%s", diff)
+	}
+	if diff := cmp.Diff([]int64{443}, got.Ports); diff != "" {
+		t.Errorf("Create(...): -want ports, +got ports:\n%s", diff)
+	}
+}
+
+func TestGatewayUpdate(t *testing.T) {
+	var gotMask string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.Body.Close()
+		w.WriteHeader(http.StatusOK)
+		if r.Method == http.MethodGet {
+			_ = json.NewEncoder(w).Encode(observedGateway())
+			return
+		}
+		gotMask = r.URL.Query().Get("updateMask")
+		_ = json.NewEncoder(w).Encode(&networkservices.Operation{})
+	}))
+	defer server.Close()
+
+	s, _ := networkservices.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	e := gatewayExternal{projectID: projectID, networkservices: s}
+	mg := newGateway(func(g *v1alpha1.Gateway) {
+		g.Spec.ForProvider.Description = gcp.StringPtr("public ingress")
+	})
+	if _, err := e.Update(context.Background(), mg); err != nil {
+		t.Fatalf("Update(...): unexpected error: %v", err)
+	}
+	if diff := cmp.Diff("description", gotMask); diff != "" {
+		t.Errorf("Update(...): -want mask, +got mask:\n%s", diff)
+	}
+}
+
+func TestGatewayDelete(t *testing.T) {
+	cases := map[string]struct {
+		status int
+		err    error
+	}{
+		"Successful": {
+			status: http.StatusOK,
+		},
+		"NotFound": {
+			status: http.StatusNotFound,
+		},
+		"DeleteFailed": {
+			status: http.StatusBadRequest,
+			err:    errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteGateway),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(gatewayPath, r.URL.Path); diff != "" {
+					t.Errorf("r: -want path, +got path:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(&networkservices.Operation{})
+			}))
+			defer server.Close()
+			s, _ := networkservices.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := gatewayExternal{projectID: projectID, networkservices: s}
+			err := e.Delete(context.Background(), newGateway())
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,184 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networkservices
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	networkservices "google.golang.org/api/networkservices/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/networkservices/v1alpha1"
+	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/grpcroute"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/setup"
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+	"github.com/crossplane-contrib/provider-gcp/pkg/tracing"
+)
+
+const (
+	errNotGRPCRoute        = "managed resource is not of type GRPCRoute"
+	errGetGRPCRoute        = "cannot get GRPCRoute"
+	errCreateGRPCRoute     = "cannot create GRPCRoute"
+	errUpdateGRPCRoute     = "cannot update GRPCRoute"
+	errDeleteGRPCRoute     = "cannot delete GRPCRoute"
+	errKubeUpdateGRPCRoute = "cannot update GRPCRoute custom resource"
+)
+
+// SetupGRPCRoute adds a controller that reconciles GRPCRoutes.
+func SetupGRPCRoute(mgr ctrl.Manager, o setup.Options) error {
+	name := managed.ControllerName(v1alpha1.GRPCRouteGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
+	}
+
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.GRPCRouteGroupVersionKind),
+		managed.WithExternalConnecter(setup.Connecter(mgr, o, v1alpha1.GRPCRouteGroupKind, &grpcRouteConnector{client: mgr.GetClient(), record: recorder})),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(recorder),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.GRPCRoute{}).
+		Complete(tracing.NewReconciler(name, ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter)))
+}
+
+type grpcRouteConnector struct {
+	client client.Client
+	record event.Recorder
+}
+
+// Connect returns an ExternalClient with necessary information to talk to GCP API.
+func (c *grpcRouteConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetConnectionInfo(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := networkservices.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &grpcRouteExternal{projectID: projectID, client: c.client, networkservices: s, record: c.record}, nil
+}
+
+type grpcRouteExternal struct {
+	projectID       string
+	client          client.Client
+	networkservices *networkservices.Service
+	record          event.Recorder
+}
+
+// Observe makes observation about the external resource.
+func (e *grpcRouteExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.GRPCRoute)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotGRPCRoute)
+	}
+	name := grpcroute.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider.Location, meta.GetExternalName(cr))
+	obs, err := e.networkservices.Projects.Locations.GrpcRoutes.Get(name).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetGRPCRoute)
+	}
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	grpcroute.LateInitialize(&cr.Spec.ForProvider, *obs)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
+		if err := e.client.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errKubeUpdateGRPCRoute)
+		}
+	}
+	cr.Status.AtProvider = grpcroute.GenerateObservation(*obs)
+	cr.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: grpcroute.IsUpToDate(cr.Spec.ForProvider, *obs),
+	}, nil
+}
+
+// Create initiates creation of external resource.
+func (e *grpcRouteExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.GRPCRoute)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotGRPCRoute)
+	}
+	cr.SetConditions(xpv1.Creating())
+	op, err := e.networkservices.Projects.Locations.GrpcRoutes.Create(grpcroute.GetParent(e.projectID, cr.Spec.ForProvider.Location), grpcroute.GenerateGRPCRoute("", cr.Spec.ForProvider)).
+		GrpcRouteId(meta.GetExternalName(cr)).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateGRPCRoute)
+	}
+	gcp.RecordOperation(e.record, cr, "create", op.Name)
+	return managed.ExternalCreation{}, nil
+}
+
+// Update initiates an update to the external resource.
+func (e *grpcRouteExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.GRPCRoute)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotGRPCRoute)
+	}
+	name := grpcroute.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider.Location, meta.GetExternalName(cr))
+	obs, err := e.networkservices.Projects.Locations.GrpcRoutes.Get(name).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetGRPCRoute)
+	}
+	op, err := e.networkservices.Projects.Locations.GrpcRoutes.Patch(name, grpcroute.GenerateGRPCRoute(name, cr.Spec.ForProvider)).
+		UpdateMask(grpcroute.GenerateUpdateMask(cr.Spec.ForProvider, *obs)).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateGRPCRoute)
+	}
+	gcp.RecordOperation(e.record, cr, "update", op.Name)
+	return managed.ExternalUpdate{}, nil
+}
+
+// Delete initiates an deletion of the external resource.
+func (e *grpcRouteExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.GRPCRoute)
+	if !ok {
+		return errors.New(errNotGRPCRoute)
+	}
+	name := grpcroute.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider.Location, meta.GetExternalName(cr))
+	op, err := e.networkservices.Projects.Locations.GrpcRoutes.Delete(name).Context(ctx).Do()
+	if err != nil {
+		return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteGRPCRoute)
+	}
+	gcp.RecordOperation(e.record, cr, "delete", op.Name)
+	return nil
+}
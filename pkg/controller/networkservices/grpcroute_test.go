@@ -0,0 +1,260 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networkservices
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	networkservices "google.golang.org/api/networkservices/v1"
+	"google.golang.org/api/option"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/networkservices/v1alpha1"
+)
+
+const (
+	grpcRouteName = "greeter"
+	grpcRoutePath = "/v1/projects/fooproject/locations/global/grpcRoutes/greeter"
+)
+
+func newGRPCRoute(m ...func(*v1alpha1.GRPCRoute)) *v1alpha1.GRPCRoute {
+	r := &v1alpha1.GRPCRoute{}
+	meta.SetExternalName(r, grpcRouteName)
+	r.Spec.ForProvider = v1alpha1.GRPCRouteParameters{
+		Location:  "global",
+		Hostnames: []string{"greeter"},
+		Meshes:    []string{testMesh},
+		Rules: []v1alpha1.GRPCRouteRule{{
+			Matches: []v1alpha1.GRPCRouteMatch{{
+				Method: &v1alpha1.GRPCRouteMethodMatch{GRPCService: "helloworld.Greeter", GRPCMethod: "SayHello"},
+			}},
+			Action: v1alpha1.GRPCRouteAction{
+				Destinations: []v1alpha1.RouteDestination{{ServiceName: testService}},
+			},
+		}},
+	}
+	for _, f := range m {
+		f(r)
+	}
+	return r
+}
+
+func observedGRPCRoute() *networkservices.GrpcRoute {
+	return &networkservices.GrpcRoute{
+		Name:      "projects/fooproject/locations/global/grpcRoutes/greeter",
+		Hostnames: []string{"greeter"},
+		Meshes:    []string{testMesh},
+		Rules: []*networkservices.GrpcRouteRouteRule{{
+			Matches: []*networkservices.GrpcRouteRouteMatch{{
+				Method: &networkservices.GrpcRouteMethodMatch{
+					Type:          "EXACT",
+					GrpcService:   "helloworld.Greeter",
+					GrpcMethod:    "SayHello",
+					CaseSensitive: true,
+				},
+			}},
+			Action: &networkservices.GrpcRouteRouteAction{
+				Destinations: []*networkservices.GrpcRouteDestination{{ServiceName: testService}},
+			},
+		}},
+	}
+}
+
+func TestGRPCRouteObserve(t *testing.T) {
+	type want struct {
+		eo   managed.ExternalObservation
+		cond xpv1.Condition
+		err  error
+	}
+	cases := map[string]struct {
+		handler http.Handler
+		mg      *v1alpha1.GRPCRoute
+		want    want
+	}{
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+			mg: newGRPCRoute(),
+		},
+		"GetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+			mg:   newGRPCRoute(),
+			want: want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetGRPCRoute)},
+		},
+		"UpToDate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(grpcRoutePath, r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(observedGRPCRoute())
+			}),
+			mg: newGRPCRoute(),
+			want: want{
+				eo:   managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				cond: xpv1.Available(),
+			},
+		},
+		"NeedsUpdate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(observedGRPCRoute())
+			}),
+			mg: newGRPCRoute(func(r *v1alpha1.GRPCRoute) {
+				r.Spec.ForProvider.Hostnames = []string{"greeter.example.com"}
+			}),
+			want: want{
+				eo:   managed.ExternalObservation{ResourceExists: true},
+				cond: xpv1.Available(),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := networkservices.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := grpcRouteExternal{projectID: projectID, client: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)}, networkservices: s}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.eo, got); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if tc.want.cond.Type == "" {
+				return
+			}
+			if diff := cmp.Diff(tc.want.cond, tc.mg.GetCondition(xpv1.TypeReady), test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want condition, +got condition:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGRPCRouteCreate(t *testing.T) {
+	var gotID string
+	got := &networkservices.GrpcRoute{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer func() { _ = r.Body.Close() }()
+		if diff := cmp.Diff("/v1/projects/fooproject/locations/global/grpcRoutes", r.URL.Path); diff != "" {
+			t.Errorf("r: -want path, +got path:\n%s", diff)
+		}
+		gotID = r.URL.Query().Get("grpcRouteId")
+		b, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(b, got)
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(&networkservices.Operation{})
+	}))
+	defer server.Close()
+
+	s, _ := networkservices.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	e := grpcRouteExternal{projectID: projectID, networkservices: s}
+	if _, err := e.Create(context.Background(), newGRPCRoute()); err != nil {
+		t.Fatalf("Create(...): unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(grpcRouteName, gotID); diff != "" {
+		t.Errorf("Create(...): -want ID, +got ID:\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"greeter"}, got.Hostnames); diff != "" {
+		t.Errorf("Create(...): -want hostnames, +got hostnames:\n%s", diff)
+	}
+}
+
+func TestGRPCRouteUpdate(t *testing.T) {
+	var gotMask string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.Body.Close()
+		w.WriteHeader(http.StatusOK)
+		if r.Method == http.MethodGet {
+			_ = json.NewEncoder(w).Encode(observedGRPCRoute())
+			return
+		}
+		gotMask = r.URL.Query().Get("updateMask")
+		_ = json.NewEncoder(w).Encode(&networkservices.Operation{})
+	}))
+	defer server.Close()
+
+	s, _ := networkservices.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	e := grpcRouteExternal{projectID: projectID, networkservices: s}
+	mg := newGRPCRoute(func(r *v1alpha1.GRPCRoute) {
+		r.Spec.ForProvider.Hostnames = []string{"greeter.example.com"}
+	})
+	if _, err := e.Update(context.Background(), mg); err != nil {
+		t.Fatalf("Update(...): unexpected error: %v", err)
+	}
+	if diff := cmp.Diff("hostnames", gotMask); diff != "" {
+		t.Errorf("Update(...): -want mask, +got mask:\n%s", diff)
+	}
+}
+
+func TestGRPCRouteDelete(t *testing.T) {
+	cases := map[string]struct {
+		status int
+		err    error
+	}{
+		"Successful": {
+			status: http.StatusOK,
+		},
+		"NotFound": {
+			status: http.StatusNotFound,
+		},
+		"DeleteFailed": {
+			status: http.StatusBadRequest,
+			err:    errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteGRPCRoute),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(grpcRoutePath, r.URL.Path); diff != "" {
+					t.Errorf("r: -want path, +got path:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(&networkservices.Operation{})
+			}))
+			defer server.Close()
+			s, _ := networkservices.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := grpcRouteExternal{projectID: projectID, networkservices: s}
+			err := e.Delete(context.Background(), newGRPCRoute())
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,204 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networkservices
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/googleapi"
+	networkservices "google.golang.org/api/networkservices/v1"
+	"google.golang.org/api/option"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/networkservices/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	projectID     = "fooproject"
+	httpRouteName = "web"
+	httpRoutePath = "/v1/projects/fooproject/locations/global/httpRoutes/web"
+	testMesh      = "projects/fooproject/locations/global/meshes/default"
+	testService   = "projects/fooproject/locations/global/backendServices/web"
+)
+
+func gError(code int, message string) *googleapi.Error {
+	return &googleapi.Error{
+		Code:    code,
+		Body:    "{}\n",
+		Message: message,
+	}
+}
+
+func newHTTPRoute(m ...func(*v1alpha1.HTTPRoute)) *v1alpha1.HTTPRoute {
+	r := &v1alpha1.HTTPRoute{}
+	meta.SetExternalName(r, httpRouteName)
+	r.Spec.ForProvider = v1alpha1.HTTPRouteParameters{
+		Location:    "global",
+		Description: gcp.StringPtr("web"),
+		Hostnames:   []string{"web.example.com"},
+		Meshes:      []string{testMesh},
+		Rules: []v1alpha1.HTTPRouteRule{{
+			Action: v1alpha1.HTTPRouteAction{
+				Destinations: []v1alpha1.RouteDestination{{ServiceName: testService}},
+			},
+		}},
+	}
+	for _, f := range m {
+		f(r)
+	}
+	return r
+}
+
+func observedHTTPRoute() *networkservices.HttpRoute {
+	return &networkservices.HttpRoute{
+		Name:        "projects/fooproject/locations/global/httpRoutes/web",
+		Description: "web",
+		Hostnames:   []string{"web.example.com"},
+		Meshes:      []string{testMesh},
+		Rules: []*networkservices.HttpRouteRouteRule{{
+			Action: &networkservices.HttpRouteRouteAction{
+				Destinations: []*networkservices.HttpRouteDestination{{ServiceName: testService}},
+			},
+		}},
+	}
+}
+
+func TestHTTPRouteObserve(t *testing.T) {
+	type want struct {
+		eo   managed.ExternalObservation
+		cond xpv1.Condition
+		err  error
+	}
+	cases := map[string]struct {
+		handler http.Handler
+		mg      *v1alpha1.HTTPRoute
+		want    want
+	}{
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+			mg: newHTTPRoute(),
+		},
+		"GetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+			mg:   newHTTPRoute(),
+			want: want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetHTTPRoute)},
+		},
+		"UpToDate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(httpRoutePath, r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(observedHTTPRoute())
+			}),
+			mg: newHTTPRoute(),
+			want: want{
+				eo:   managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				cond: xpv1.Available(),
+			},
+		},
+		"NeedsUpdate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(observedHTTPRoute())
+			}),
+			mg: newHTTPRoute(func(r *v1alpha1.HTTPRoute) {
+				r.Spec.ForProvider.Hostnames = []string{"www.example.com"}
+			}),
+			want: want{
+				eo:   managed.ExternalObservation{ResourceExists: true},
+				cond: xpv1.Available(),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := networkservices.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := httpRouteExternal{projectID: projectID, networkservices: s}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.eo, got); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if tc.want.cond.Type == "" {
+				return
+			}
+			if diff := cmp.Diff(tc.want.cond, tc.mg.GetCondition(xpv1.TypeReady), test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want condition, +got condition:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestHTTPRouteUpdate(t *testing.T) {
+	var gotMask string
+	got := &networkservices.HttpRoute{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer func() { _ = r.Body.Close() }()
+		w.WriteHeader(http.StatusOK)
+		if r.Method == http.MethodGet {
+			_ = json.NewEncoder(w).Encode(observedHTTPRoute())
+			return
+		}
+		gotMask = r.URL.Query().Get("updateMask")
+		b, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(b, got)
+		_ = json.NewEncoder(w).Encode(&networkservices.Operation{})
+	}))
+	defer server.Close()
+
+	s, _ := networkservices.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	e := httpRouteExternal{projectID: projectID, networkservices: s}
+	mg := newHTTPRoute(func(r *v1alpha1.HTTPRoute) {
+		r.Spec.ForProvider.Rules[0].Action.Timeout = "30s"
+	})
+	if _, err := e.Update(context.Background(), mg); err != nil {
+		t.Fatalf("Update(...): unexpected error: %v", err)
+	}
+	if diff := cmp.Diff("rules", gotMask); diff != "" {
+		t.Errorf("Update(...): -want mask, +got mask:\n%s", diff)
+	}
+	if diff := cmp.Diff("30s", got.Rules[0].Action.Timeout); diff != "" {
+		t.Errorf("Update(...): -want timeout, +got timeout:\n%s", diff)
+	}
+}
@@ -0,0 +1,240 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networkservices
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	networkservices "google.golang.org/api/networkservices/v1"
+	"google.golang.org/api/option"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/networkservices/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	meshName = "default"
+	meshPath = "/v1/projects/fooproject/locations/global/meshes/default"
+)
+
+func newMesh(m ...func(*v1alpha1.Mesh)) *v1alpha1.Mesh {
+	ms := &v1alpha1.Mesh{}
+	meta.SetExternalName(ms, meshName)
+	ms.Spec.ForProvider = v1alpha1.MeshParameters{
+		Location:         "global",
+		Description:      gcp.StringPtr("default"),
+		InterceptionPort: gcp.Int64Ptr(15001),
+	}
+	for _, f := range m {
+		f(ms)
+	}
+	return ms
+}
+
+func observedMesh() *networkservices.Mesh {
+	return &networkservices.Mesh{
+		Name:             "projects/fooproject/locations/global/meshes/default",
+		Description:      "default",
+		InterceptionPort: 15001,
+	}
+}
+
+func TestMeshObserve(t *testing.T) {
+	type want struct {
+		eo   managed.ExternalObservation
+		cond xpv1.Condition
+		err  error
+	}
+	cases := map[string]struct {
+		handler http.Handler
+		mg      *v1alpha1.Mesh
+		want    want
+	}{
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+			mg: newMesh(),
+		},
+		"GetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+			mg:   newMesh(),
+			want: want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetMesh)},
+		},
+		"UpToDate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(meshPath, r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(observedMesh())
+			}),
+			mg: newMesh(),
+			want: want{
+				eo:   managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				cond: xpv1.Available(),
+			},
+		},
+		"NeedsUpdate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(observedMesh())
+			}),
+			mg: newMesh(func(m *v1alpha1.Mesh) {
+				m.Spec.ForProvider.Labels = map[string]string{"team": "platform"}
+			}),
+			want: want{
+				eo:   managed.ExternalObservation{ResourceExists: true},
+				cond: xpv1.Available(),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := networkservices.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := meshExternal{projectID: projectID, client: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)}, networkservices: s}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.eo, got); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if tc.want.cond.Type == "" {
+				return
+			}
+			if diff := cmp.Diff(tc.want.cond, tc.mg.GetCondition(xpv1.TypeReady), test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want condition, +got condition:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMeshCreate(t *testing.T) {
+	var gotID string
+	got := &networkservices.Mesh{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer func() { _ = r.Body.Close() }()
+		if diff := cmp.Diff("/v1/projects/fooproject/locations/global/meshes", r.URL.Path); diff != "" {
+			t.Errorf("r: -want path, +got path:\n%s", diff)
+		}
+		gotID = r.URL.Query().Get("meshId")
+		b, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(b, got)
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(&networkservices.Operation{})
+	}))
+	defer server.Close()
+
+	s, _ := networkservices.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	e := meshExternal{projectID: projectID, networkservices: s}
+	if _, err := e.Create(context.Background(), newMesh()); err != nil {
+		t.Fatalf("Create(...): unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(meshName, gotID); diff != "" {
+		t.Errorf("Create(...): -want ID, +got ID:\n%s", diff)
+	}
+	if diff := cmp.Diff(int64(15001), got.InterceptionPort); diff != "" {
+		t.Errorf("Create(...): -want port, +got port:\n%s", diff)
+	}
+}
+
+func TestMeshUpdate(t *testing.T) {
+	var gotMask string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.Body.Close()
+		w.WriteHeader(http.StatusOK)
+		if r.Method == http.MethodGet {
+			_ = json.NewEncoder(w).Encode(observedMesh())
+			return
+		}
+		gotMask = r.URL.Query().Get("updateMask")
+		_ = json.NewEncoder(w).Encode(&networkservices.Operation{})
+	}))
+	defer server.Close()
+
+	s, _ := networkservices.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	e := meshExternal{projectID: projectID, networkservices: s}
+	mg := newMesh(func(m *v1alpha1.Mesh) {
+		m.Spec.ForProvider.Description = gcp.StringPtr("sidecar mesh")
+	})
+	if _, err := e.Update(context.Background(), mg); err != nil {
+		t.Fatalf("Update(...): unexpected error: %v", err)
+	}
+	if diff := cmp.Diff("description", gotMask); diff != "" {
+		t.Errorf("Update(...): -want mask, +got mask:\n%s", diff)
+	}
+}
+
+func TestMeshDelete(t *testing.T) {
+	cases := map[string]struct {
+		status int
+		err    error
+	}{
+		"Successful": {
+			status: http.StatusOK,
+		},
+		"NotFound": {
+			status: http.StatusNotFound,
+		},
+		"DeleteFailed": {
+			status: http.StatusBadRequest,
+			err:    errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteMesh),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(meshPath, r.URL.Path); diff != "" {
+					t.Errorf("r: -want path, +got path:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(&networkservices.Operation{})
+			}))
+			defer server.Close()
+			s, _ := networkservices.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := meshExternal{projectID: projectID, networkservices: s}
+			err := e.Delete(context.Background(), newMesh())
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
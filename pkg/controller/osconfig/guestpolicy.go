@@ -0,0 +1,169 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package osconfig
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	osconfig "google.golang.org/api/osconfig/v1beta"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/osconfig/v1alpha1"
+	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/guestpolicy"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/setup"
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+	"github.com/crossplane-contrib/provider-gcp/pkg/tracing"
+)
+
+const (
+	errNotGuestPolicy        = "managed resource is not of type GuestPolicy"
+	errGetGuestPolicy        = "cannot get GuestPolicy"
+	errCreateGuestPolicy     = "cannot create GuestPolicy"
+	errUpdateGuestPolicy     = "cannot update GuestPolicy"
+	errDeleteGuestPolicy     = "cannot delete GuestPolicy"
+	errKubeUpdateGuestPolicy = "cannot update GuestPolicy custom resource"
+)
+
+// SetupGuestPolicy adds a controller that reconciles GuestPolicies.
+func SetupGuestPolicy(mgr ctrl.Manager, o setup.Options) error {
+	name := managed.ControllerName(v1alpha1.GuestPolicyGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.GuestPolicyGroupVersionKind),
+		managed.WithExternalConnecter(setup.Connecter(mgr, o, v1alpha1.GuestPolicyGroupKind, &guestPolicyConnector{client: mgr.GetClient()})),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.GuestPolicy{}).
+		Complete(tracing.NewReconciler(name, ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter)))
+}
+
+type guestPolicyConnector struct {
+	client client.Client
+}
+
+// Connect returns an ExternalClient with necessary information to talk to GCP API.
+func (c *guestPolicyConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetConnectionInfo(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := osconfig.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &guestPolicyExternal{projectID: projectID, client: c.client, osconfig: s}, nil
+}
+
+type guestPolicyExternal struct {
+	projectID string
+	client    client.Client
+	osconfig  *osconfig.Service
+}
+
+// Observe makes observation about the external resource.
+func (e *guestPolicyExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.GuestPolicy)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotGuestPolicy)
+	}
+	gp, err := e.osconfig.Projects.GuestPolicies.Get(guestpolicy.GetFullyQualifiedName(e.projectID, meta.GetExternalName(cr))).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetGuestPolicy)
+	}
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	guestpolicy.LateInitialize(&cr.Spec.ForProvider, *gp)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
+		if err := e.client.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errKubeUpdateGuestPolicy)
+		}
+	}
+	cr.Status.AtProvider = guestpolicy.GenerateObservation(*gp)
+	cr.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: guestpolicy.IsUpToDate(cr.Spec.ForProvider, *gp),
+	}, nil
+}
+
+// Create initiates creation of external resource.
+func (e *guestPolicyExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.GuestPolicy)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotGuestPolicy)
+	}
+	cr.SetConditions(xpv1.Creating())
+	_, err := e.osconfig.Projects.GuestPolicies.Create(guestpolicy.GetParent(e.projectID), guestpolicy.GenerateGuestPolicy("", cr.Spec.ForProvider)).
+		GuestPolicyId(meta.GetExternalName(cr)).
+		Context(ctx).
+		Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateGuestPolicy)
+}
+
+// Update initiates an update to the external resource. The etag of the
+// observed policy is sent along so that concurrent changes are rejected.
+func (e *guestPolicyExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.GuestPolicy)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotGuestPolicy)
+	}
+	name := guestpolicy.GetFullyQualifiedName(e.projectID, meta.GetExternalName(cr))
+	gp, err := e.osconfig.Projects.GuestPolicies.Get(name).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetGuestPolicy)
+	}
+	desired := guestpolicy.GenerateGuestPolicy(name, cr.Spec.ForProvider)
+	desired.Etag = gp.Etag
+	_, err = e.osconfig.Projects.GuestPolicies.Patch(name, desired).
+		UpdateMask(guestpolicy.GenerateUpdateMask(cr.Spec.ForProvider, *gp)).
+		Context(ctx).
+		Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateGuestPolicy)
+}
+
+// Delete initiates an deletion of the external resource.
+func (e *guestPolicyExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.GuestPolicy)
+	if !ok {
+		return errors.New(errNotGuestPolicy)
+	}
+	_, err := e.osconfig.Projects.GuestPolicies.Delete(guestpolicy.GetFullyQualifiedName(e.projectID, meta.GetExternalName(cr))).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteGuestPolicy)
+}
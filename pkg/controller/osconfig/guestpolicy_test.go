@@ -0,0 +1,301 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package osconfig
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/option"
+	osconfigbeta "google.golang.org/api/osconfig/v1beta"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/osconfig/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	policyName = "nginx"
+	policyPath = "/v1beta/projects/fooproject/guestPolicies/nginx"
+	policyEtag = "etag"
+)
+
+var errBoom = errors.New("boom")
+
+func newGuestPolicy(m ...func(*v1alpha1.GuestPolicy)) *v1alpha1.GuestPolicy {
+	gp := &v1alpha1.GuestPolicy{}
+	meta.SetExternalName(gp, policyName)
+	gp.Spec.ForProvider.Assignment.Zones = []string{"us-central1-a"}
+	gp.Spec.ForProvider.Packages = []v1alpha1.Package{{Name: "nginx", DesiredState: gcp.StringPtr("INSTALLED")}}
+	for _, f := range m {
+		f(gp)
+	}
+	return gp
+}
+
+func observedGuestPolicy(m ...func(*osconfigbeta.GuestPolicy)) *osconfigbeta.GuestPolicy {
+	gp := &osconfigbeta.GuestPolicy{
+		Assignment: &osconfigbeta.Assignment{Zones: []string{"us-central1-a"}},
+		Packages:   []*osconfigbeta.Package{{Name: "nginx", DesiredState: "INSTALLED"}},
+		Etag:       policyEtag,
+	}
+	for _, f := range m {
+		f(gp)
+	}
+	return gp
+}
+
+func TestGuestPolicyObserve(t *testing.T) {
+	type args struct {
+		handler http.Handler
+		kube    client.Client
+		mg      resource.Managed
+	}
+	type want struct {
+		eo  managed.ExternalObservation
+		err error
+	}
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotFound": {
+			args: args{
+				handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					_ = r.Body.Close()
+					w.WriteHeader(http.StatusNotFound)
+					_ = json.NewEncoder(w).Encode(struct{}{})
+				}),
+				mg: newGuestPolicy(),
+			},
+		},
+		"GetFailed": {
+			args: args{
+				handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					_ = r.Body.Close()
+					w.WriteHeader(http.StatusBadRequest)
+					_ = json.NewEncoder(w).Encode(struct{}{})
+				}),
+				mg: newGuestPolicy(),
+			},
+			want: want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetGuestPolicy)},
+		},
+		"SpecUpdateFailed": {
+			args: args{
+				handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					_ = r.Body.Close()
+					w.WriteHeader(http.StatusOK)
+					_ = json.NewEncoder(w).Encode(observedGuestPolicy(func(gp *osconfigbeta.GuestPolicy) {
+						gp.Packages[0].Manager = "APT"
+					}))
+				}),
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+				mg:   newGuestPolicy(),
+			},
+			want: want{err: errors.Wrap(errBoom, errKubeUpdateGuestPolicy)},
+		},
+		"UpToDate": {
+			args: args{
+				handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					_ = r.Body.Close()
+					if diff := cmp.Diff(policyPath, r.URL.Path); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					w.WriteHeader(http.StatusOK)
+					_ = json.NewEncoder(w).Encode(observedGuestPolicy())
+				}),
+				mg: newGuestPolicy(),
+			},
+			want: want{eo: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+		},
+		"NotUpToDate": {
+			args: args{
+				handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					_ = r.Body.Close()
+					w.WriteHeader(http.StatusOK)
+					_ = json.NewEncoder(w).Encode(observedGuestPolicy(func(gp *osconfigbeta.GuestPolicy) {
+						gp.Assignment.Zones = []string{"us-east1-b"}
+					}))
+				}),
+				mg: newGuestPolicy(),
+			},
+			want: want{eo: managed.ExternalObservation{ResourceExists: true}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.args.handler)
+			defer server.Close()
+			s, _ := osconfigbeta.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := guestPolicyExternal{projectID: projectID, client: tc.args.kube, osconfig: s}
+			got, err := e.Observe(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.eo, got); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGuestPolicyCreate(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		err     error
+	}{
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gp := &osconfigbeta.GuestPolicy{}
+				_ = json.NewDecoder(r.Body).Decode(gp)
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff("/v1beta/projects/fooproject/guestPolicies", r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff(policyName, r.URL.Query().Get("guestPolicyId")); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff("", gp.Name); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&osconfigbeta.GuestPolicy{})
+			}),
+		},
+		"Failed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+			err: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateGuestPolicy),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := osconfigbeta.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := guestPolicyExternal{projectID: projectID, osconfig: s}
+			_, err := e.Create(context.Background(), newGuestPolicy())
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGuestPolicyUpdate(t *testing.T) {
+	cases := map[string]struct {
+		observed *osconfigbeta.GuestPolicy
+		mg       *v1alpha1.GuestPolicy
+		calls    []string
+	}{
+		"AssignmentChanged": {
+			observed: observedGuestPolicy(func(gp *osconfigbeta.GuestPolicy) {
+				gp.Assignment.Zones = []string{"us-east1-b"}
+			}),
+			mg:    newGuestPolicy(),
+			calls: []string{"PATCH " + policyPath + "?updateMask=assignment etag=" + policyEtag},
+		},
+		"DescriptionAndPackagesChanged": {
+			observed: observedGuestPolicy(func(gp *osconfigbeta.GuestPolicy) {
+				gp.Packages[0].DesiredState = "REMOVED"
+			}),
+			mg: newGuestPolicy(func(gp *v1alpha1.GuestPolicy) {
+				gp.Spec.ForProvider.Description = gcp.StringPtr("nginx everywhere")
+			}),
+			calls: []string{"PATCH " + policyPath + "?updateMask=description,packages etag=" + policyEtag},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var calls []string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gp := &osconfigbeta.GuestPolicy{}
+				_ = json.NewDecoder(r.Body).Decode(gp)
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				if r.Method == http.MethodGet {
+					_ = json.NewEncoder(w).Encode(tc.observed)
+					return
+				}
+				calls = append(calls, r.Method+" "+r.URL.Path+"?updateMask="+r.URL.Query().Get("updateMask")+" etag="+gp.Etag)
+				_ = json.NewEncoder(w).Encode(&osconfigbeta.GuestPolicy{})
+			}))
+			defer server.Close()
+			s, _ := osconfigbeta.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := guestPolicyExternal{projectID: projectID, osconfig: s}
+			if _, err := e.Update(context.Background(), tc.mg); err != nil {
+				t.Fatalf("Update(...): unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.calls, calls); diff != "" {
+				t.Errorf("Update(...): -want calls, +got calls:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGuestPolicyDelete(t *testing.T) {
+	cases := map[string]struct {
+		status int
+		err    error
+	}{
+		"Successful": {
+			status: http.StatusOK,
+		},
+		"AlreadyGone": {
+			status: http.StatusNotFound,
+		},
+		"Failed": {
+			status: http.StatusBadRequest,
+			err:    errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteGuestPolicy),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodDelete, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}))
+			defer server.Close()
+			s, _ := osconfigbeta.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := guestPolicyExternal{projectID: projectID, osconfig: s}
+			err := e.Delete(context.Background(), newGuestPolicy())
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
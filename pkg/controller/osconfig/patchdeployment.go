@@ -0,0 +1,182 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package osconfig
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	osconfig "google.golang.org/api/osconfig/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/osconfig/v1alpha1"
+	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/patchdeployment"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/setup"
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+	"github.com/crossplane-contrib/provider-gcp/pkg/tracing"
+)
+
+const (
+	errNewClient                 = "cannot create new OS Config client"
+	errNotPatchDeployment        = "managed resource is not of type PatchDeployment"
+	errGetPatchDeployment        = "cannot get PatchDeployment"
+	errCreatePatchDeployment     = "cannot create PatchDeployment"
+	errUpdatePatchDeployment     = "cannot update PatchDeployment"
+	errPausePatchDeployment      = "cannot pause PatchDeployment"
+	errResumePatchDeployment     = "cannot resume PatchDeployment"
+	errDeletePatchDeployment     = "cannot delete PatchDeployment"
+	errKubeUpdatePatchDeployment = "cannot update PatchDeployment custom resource"
+)
+
+// SetupPatchDeployment adds a controller that reconciles PatchDeployments.
+func SetupPatchDeployment(mgr ctrl.Manager, o setup.Options) error {
+	name := managed.ControllerName(v1alpha1.PatchDeploymentGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.PatchDeploymentGroupVersionKind),
+		managed.WithExternalConnecter(setup.Connecter(mgr, o, v1alpha1.PatchDeploymentGroupKind, &patchDeploymentConnector{client: mgr.GetClient()})),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.PatchDeployment{}).
+		Complete(tracing.NewReconciler(name, ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter)))
+}
+
+type patchDeploymentConnector struct {
+	client client.Client
+}
+
+// Connect returns an ExternalClient with necessary information to talk to GCP API.
+func (c *patchDeploymentConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetConnectionInfo(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := osconfig.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &patchDeploymentExternal{projectID: projectID, client: c.client, osconfig: s}, nil
+}
+
+type patchDeploymentExternal struct {
+	projectID string
+	client    client.Client
+	osconfig  *osconfig.Service
+}
+
+// Observe makes observation about the external resource.
+func (e *patchDeploymentExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.PatchDeployment)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotPatchDeployment)
+	}
+	pd, err := e.osconfig.Projects.PatchDeployments.Get(patchdeployment.GetFullyQualifiedName(e.projectID, meta.GetExternalName(cr))).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetPatchDeployment)
+	}
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	patchdeployment.LateInitialize(&cr.Spec.ForProvider, *pd)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
+		if err := e.client.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errKubeUpdatePatchDeployment)
+		}
+	}
+	cr.Status.AtProvider = patchdeployment.GenerateObservation(*pd)
+	cr.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: patchdeployment.IsUpToDate(cr.Spec.ForProvider, *pd),
+	}, nil
+}
+
+// Create initiates creation of external resource.
+func (e *patchDeploymentExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.PatchDeployment)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotPatchDeployment)
+	}
+	cr.SetConditions(xpv1.Creating())
+	_, err := e.osconfig.Projects.PatchDeployments.Create(patchdeployment.GetParent(e.projectID), patchdeployment.GeneratePatchDeployment("", cr.Spec.ForProvider)).
+		PatchDeploymentId(meta.GetExternalName(cr)).
+		Context(ctx).
+		Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreatePatchDeployment)
+}
+
+// Update initiates an update to the external resource. Pausing and resuming
+// are done through dedicated calls rather than the patch request.
+func (e *patchDeploymentExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.PatchDeployment)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotPatchDeployment)
+	}
+	name := patchdeployment.GetFullyQualifiedName(e.projectID, meta.GetExternalName(cr))
+	pd, err := e.osconfig.Projects.PatchDeployments.Get(name).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetPatchDeployment)
+	}
+	if mask := patchdeployment.GenerateUpdateMask(cr.Spec.ForProvider, *pd); mask != "" {
+		if _, err := e.osconfig.Projects.PatchDeployments.Patch(name, patchdeployment.GeneratePatchDeployment(name, cr.Spec.ForProvider)).
+			UpdateMask(mask).
+			Context(ctx).
+			Do(); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdatePatchDeployment)
+		}
+	}
+	if patchdeployment.IsPausedUpToDate(cr.Spec.ForProvider, *pd) {
+		return managed.ExternalUpdate{}, nil
+	}
+	if gcp.BoolValue(cr.Spec.ForProvider.Paused) {
+		_, err = e.osconfig.Projects.PatchDeployments.Pause(name, &osconfig.PausePatchDeploymentRequest{}).Context(ctx).Do()
+		return managed.ExternalUpdate{}, errors.Wrap(err, errPausePatchDeployment)
+	}
+	_, err = e.osconfig.Projects.PatchDeployments.Resume(name, &osconfig.ResumePatchDeploymentRequest{}).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errResumePatchDeployment)
+}
+
+// Delete initiates an deletion of the external resource.
+func (e *patchDeploymentExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.PatchDeployment)
+	if !ok {
+		return errors.New(errNotPatchDeployment)
+	}
+	_, err := e.osconfig.Projects.PatchDeployments.Delete(patchdeployment.GetFullyQualifiedName(e.projectID, meta.GetExternalName(cr))).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeletePatchDeployment)
+}
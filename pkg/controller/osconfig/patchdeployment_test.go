@@ -0,0 +1,189 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package osconfig
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+	osconfig "google.golang.org/api/osconfig/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/osconfig/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	projectID      = "fooproject"
+	deploymentName = "weekly"
+)
+
+func gError(code int, message string) *googleapi.Error {
+	return &googleapi.Error{
+		Code:    code,
+		Body:    "{}\n",
+		Message: message,
+	}
+}
+
+func newPatchDeployment(m ...func(*v1alpha1.PatchDeployment)) *v1alpha1.PatchDeployment {
+	pd := &v1alpha1.PatchDeployment{}
+	meta.SetExternalName(pd, deploymentName)
+	pd.Spec.ForProvider.InstanceFilter.All = gcp.BoolPtr(true)
+	pd.Spec.ForProvider.OneTimeSchedule = &v1alpha1.OneTimeSchedule{ExecuteTime: "2023-06-01T04:00:00Z"}
+	for _, f := range m {
+		f(pd)
+	}
+	return pd
+}
+
+func TestPatchDeploymentObserve(t *testing.T) {
+	type want struct {
+		eo  managed.ExternalObservation
+		err error
+	}
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+			mg: newPatchDeployment(),
+		},
+		"GetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+			mg:   newPatchDeployment(),
+			want: want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetPatchDeployment)},
+		},
+		"NeedsPause": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff("/v1/projects/fooproject/patchDeployments/weekly", r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&osconfig.PatchDeployment{
+					InstanceFilter:  &osconfig.PatchInstanceFilter{All: true},
+					OneTimeSchedule: &osconfig.OneTimeSchedule{ExecuteTime: "2023-06-01T04:00:00Z"},
+					State:           v1alpha1.PatchDeploymentStateActive,
+				})
+			}),
+			mg: newPatchDeployment(func(pd *v1alpha1.PatchDeployment) {
+				pd.Spec.ForProvider.Paused = gcp.BoolPtr(true)
+			}),
+			want: want{eo: managed.ExternalObservation{ResourceExists: true}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := osconfig.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := patchDeploymentExternal{projectID: projectID, osconfig: s}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.eo, got); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPatchDeploymentUpdate(t *testing.T) {
+	cases := map[string]struct {
+		observed *osconfig.PatchDeployment
+		mg       *v1alpha1.PatchDeployment
+		calls    []string
+	}{
+		"PauseOnly": {
+			observed: &osconfig.PatchDeployment{
+				InstanceFilter:  &osconfig.PatchInstanceFilter{All: true},
+				OneTimeSchedule: &osconfig.OneTimeSchedule{ExecuteTime: "2023-06-01T04:00:00Z"},
+				State:           v1alpha1.PatchDeploymentStateActive,
+			},
+			mg: newPatchDeployment(func(pd *v1alpha1.PatchDeployment) {
+				pd.Spec.ForProvider.Paused = gcp.BoolPtr(true)
+			}),
+			calls: []string{"POST /v1/projects/fooproject/patchDeployments/weekly:pause"},
+		},
+		"PatchAndResume": {
+			observed: &osconfig.PatchDeployment{
+				Description:     "old",
+				InstanceFilter:  &osconfig.PatchInstanceFilter{All: true},
+				OneTimeSchedule: &osconfig.OneTimeSchedule{ExecuteTime: "2023-06-01T04:00:00Z"},
+				State:           v1alpha1.PatchDeploymentStatePaused,
+			},
+			mg: newPatchDeployment(func(pd *v1alpha1.PatchDeployment) {
+				pd.Spec.ForProvider.Paused = gcp.BoolPtr(false)
+			}),
+			calls: []string{
+				"PATCH /v1/projects/fooproject/patchDeployments/weekly?updateMask=description",
+				"POST /v1/projects/fooproject/patchDeployments/weekly:resume",
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var calls []string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				if r.Method == http.MethodGet {
+					_ = json.NewEncoder(w).Encode(tc.observed)
+					return
+				}
+				call := r.Method + " " + r.URL.Path
+				if m := r.URL.Query().Get("updateMask"); m != "" {
+					call += "?updateMask=" + m
+				}
+				calls = append(calls, call)
+				_ = json.NewEncoder(w).Encode(&osconfig.PatchDeployment{})
+			}))
+			defer server.Close()
+			s, _ := osconfig.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := patchDeploymentExternal{projectID: projectID, osconfig: s}
+			if _, err := e.Update(context.Background(), tc.mg); err != nil {
+				t.Fatalf("Update(...): unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.calls, calls); diff != "" {
+				t.Errorf("Update(...): -want calls, +got calls:\n%s", diff)
+			}
+		})
+	}
+}
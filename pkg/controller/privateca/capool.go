@@ -0,0 +1,195 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package privateca
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	privateca "google.golang.org/api/privateca/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/privateca/v1alpha1"
+	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/capool"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/setup"
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+	"github.com/crossplane-contrib/provider-gcp/pkg/operation"
+	"github.com/crossplane-contrib/provider-gcp/pkg/tracing"
+)
+
+const (
+	errNotCaPool        = "managed resource is not of type CaPool"
+	errGetCaPool        = "cannot get CaPool"
+	errCreateCaPool     = "cannot create CaPool"
+	errUpdateCaPool     = "cannot update CaPool"
+	errDeleteCaPool     = "cannot delete CaPool"
+	errKubeUpdateCaPool = "cannot update CaPool custom resource"
+)
+
+// SetupCaPool adds a controller that reconciles CaPools.
+func SetupCaPool(mgr ctrl.Manager, o setup.Options) error {
+	name := managed.ControllerName(v1alpha1.CaPoolGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
+	}
+
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.CaPoolGroupVersionKind),
+		managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient()), operation.NewCreateResumer(mgr.GetClient())),
+		managed.WithExternalConnecter(setup.Connecter(mgr, o, v1alpha1.CaPoolGroupKind, &caPoolConnector{client: mgr.GetClient(), record: recorder})),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(recorder),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.CaPool{}).
+		Complete(tracing.NewReconciler(name, ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter)))
+}
+
+type caPoolConnector struct {
+	client client.Client
+	record event.Recorder
+}
+
+// Connect returns an ExternalClient with necessary information to talk to GCP API.
+func (c *caPoolConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetConnectionInfo(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := privateca.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &caPoolExternal{projectID: projectID, client: c.client, record: c.record, pools: s.Projects.Locations.CaPools, ops: s.Projects.Locations.Operations}, nil
+}
+
+type caPoolExternal struct {
+	projectID string
+	client    client.Client
+	record    event.Recorder
+	pools     *privateca.ProjectsLocationsCaPoolsService
+	ops       *privateca.ProjectsLocationsOperationsService
+}
+
+// Observe makes observation about the external resource.
+func (e *caPoolExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.CaPool)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotCaPool)
+	}
+	if err := trackCreate(ctx, e.client, e.record, e.ops, cr); err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	name := capool.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider.Location, meta.GetExternalName(cr))
+	obs, err := e.pools.Get(name).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetCaPool)
+	}
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	capool.LateInitialize(&cr.Spec.ForProvider, *obs)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
+		if err := e.client.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errKubeUpdateCaPool)
+		}
+	}
+	cr.Status.AtProvider = capool.GenerateObservation(*obs)
+	cr.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: capool.IsUpToDate(cr.Spec.ForProvider, *obs),
+	}, nil
+}
+
+// Create initiates creation of external resource.
+func (e *caPoolExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.CaPool)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotCaPool)
+	}
+	cr.SetConditions(xpv1.Creating())
+	// Wait until the CA pool is created if it is being created.
+	if operation.CreateOperation(cr) != "" {
+		return managed.ExternalCreation{}, nil
+	}
+	op, err := e.pools.Create(capool.GetParent(e.projectID, cr.Spec.ForProvider.Location), capool.GenerateCaPool("", cr.Spec.ForProvider)).
+		CaPoolId(meta.GetExternalName(cr)).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateCaPool)
+	}
+	persistCreateOperation(ctx, e.client, e.record, cr, op)
+	return managed.ExternalCreation{}, nil
+}
+
+// Update initiates an update to the external resource.
+func (e *caPoolExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.CaPool)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotCaPool)
+	}
+	name := capool.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider.Location, meta.GetExternalName(cr))
+	obs, err := e.pools.Get(name).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetCaPool)
+	}
+	op, err := e.pools.Patch(name, capool.GenerateCaPool(name, cr.Spec.ForProvider)).
+		UpdateMask(capool.GenerateUpdateMask(cr.Spec.ForProvider, *obs)).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateCaPool)
+	}
+	gcp.RecordOperation(e.record, cr, "update", op.Name)
+	return managed.ExternalUpdate{}, nil
+}
+
+// Delete initiates an deletion of the external resource. A CA pool can only
+// be deleted once all of its certificate authorities are permanently deleted.
+func (e *caPoolExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.CaPool)
+	if !ok {
+		return errors.New(errNotCaPool)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	name := capool.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider.Location, meta.GetExternalName(cr))
+	op, err := e.pools.Delete(name).Context(ctx).Do()
+	if err != nil {
+		return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteCaPool)
+	}
+	gcp.RecordOperation(e.record, cr, "delete", op.Name)
+	return nil
+}
@@ -0,0 +1,259 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package privateca
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/option"
+	privateca "google.golang.org/api/privateca/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/privateca/v1alpha1"
+	"github.com/crossplane-contrib/provider-gcp/pkg/operation"
+)
+
+const (
+	poolPath      = "/v1/projects/fooproject/locations/us-central1/caPools/pool"
+	testOperation = "projects/fooproject/locations/us-central1/operations/op"
+)
+
+func newCaPool(m ...func(*v1alpha1.CaPool)) *v1alpha1.CaPool {
+	p := &v1alpha1.CaPool{}
+	meta.SetExternalName(p, "pool")
+	p.Spec.ForProvider = v1alpha1.CaPoolParameters{
+		Location: "us-central1",
+		Tier:     "DEVOPS",
+		Labels:   map[string]string{"team": "sec"},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func withCreateOperation(o metav1.Object) {
+	meta.AddAnnotations(o, map[string]string{operation.AnnotationKeyCreateOperation: testOperation})
+}
+
+func privatecaService(t *testing.T, h http.Handler) *privateca.Service {
+	t.Helper()
+	server := httptest.NewServer(h)
+	t.Cleanup(server.Close)
+	s, _ := privateca.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	return s
+}
+
+func TestCaPoolObserve(t *testing.T) {
+	type want struct {
+		eo        managed.ExternalObservation
+		cond      xpv1.Condition
+		operation string
+	}
+	cases := map[string]struct {
+		mg       *v1alpha1.CaPool
+		op       *privateca.Operation
+		observed *privateca.CaPool
+		want     want
+	}{
+		"NotFound": {
+			mg: newCaPool(),
+		},
+		"UpToDate": {
+			mg:       newCaPool(),
+			observed: &privateca.CaPool{Tier: "DEVOPS", Labels: map[string]string{"team": "sec"}},
+			want: want{
+				eo:   managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				cond: xpv1.Available(),
+			},
+		},
+		"NeedsUpdate": {
+			mg:       newCaPool(),
+			observed: &privateca.CaPool{Tier: "DEVOPS", Labels: map[string]string{"team": "platform"}},
+			want: want{
+				eo:   managed.ExternalObservation{ResourceExists: true},
+				cond: xpv1.Available(),
+			},
+		},
+		"CreateOperationRunning": {
+			mg: newCaPool(func(p *v1alpha1.CaPool) { withCreateOperation(p) }),
+			op: &privateca.Operation{Name: testOperation},
+			want: want{
+				operation: testOperation,
+			},
+		},
+		"CreateOperationDone": {
+			mg:       newCaPool(func(p *v1alpha1.CaPool) { withCreateOperation(p) }),
+			op:       &privateca.Operation{Name: testOperation, Done: true},
+			observed: &privateca.CaPool{Tier: "DEVOPS", Labels: map[string]string{"team": "sec"}},
+			want: want{
+				eo:   managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				cond: xpv1.Available(),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := privatecaService(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if strings.HasSuffix(r.URL.Path, testOperation) {
+					_ = json.NewEncoder(w).Encode(tc.op)
+					return
+				}
+				if diff := cmp.Diff(poolPath, r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				if tc.observed == nil {
+					w.WriteHeader(http.StatusNotFound)
+					_ = json.NewEncoder(w).Encode(struct{}{})
+					return
+				}
+				_ = json.NewEncoder(w).Encode(tc.observed)
+			}))
+			e := caPoolExternal{
+				projectID: projectID,
+				client:    &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				record:    event.NewNopRecorder(),
+				pools:     s.Projects.Locations.CaPools,
+				ops:       s.Projects.Locations.Operations,
+			}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if err != nil {
+				t.Fatalf("Observe(...): unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want.eo, got); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.operation, operation.CreateOperation(tc.mg)); diff != "" {
+				t.Errorf("Observe(...): -want operation, +got operation:\n%s", diff)
+			}
+			if tc.want.cond.Type == "" {
+				return
+			}
+			if diff := cmp.Diff(tc.want.cond, tc.mg.GetCondition(xpv1.TypeReady), test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want condition, +got condition:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCaPoolCreate(t *testing.T) {
+	cases := map[string]struct {
+		mg   *v1alpha1.CaPool
+		want []string
+	}{
+		"Successful": {
+			mg:   newCaPool(),
+			want: []string{http.MethodPost + " /v1/projects/fooproject/locations/us-central1/caPools"},
+		},
+		"CreateOperationRunning": {
+			mg: newCaPool(func(p *v1alpha1.CaPool) { withCreateOperation(p) }),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got []string
+			s := privatecaService(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				got = append(got, r.Method+" "+r.URL.Path)
+				_ = json.NewEncoder(w).Encode(&privateca.Operation{Name: testOperation})
+			}))
+			e := caPoolExternal{
+				projectID: projectID,
+				client:    &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil), MockGet: test.NewMockGetFn(nil)},
+				record:    event.NewNopRecorder(),
+				pools:     s.Projects.Locations.CaPools,
+			}
+			if _, err := e.Create(context.Background(), tc.mg); err != nil {
+				t.Fatalf("Create(...): unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Create(...): -want requests, +got requests:\n%s", diff)
+			}
+			if diff := cmp.Diff(testOperation, operation.CreateOperation(tc.mg)); diff != "" {
+				t.Errorf("Create(...): -want operation, +got operation:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCaPoolUpdate(t *testing.T) {
+	var gotMask string
+	s := privatecaService(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.Body.Close()
+		if r.Method == http.MethodGet {
+			_ = json.NewEncoder(w).Encode(&privateca.CaPool{Tier: "DEVOPS", Labels: map[string]string{"team": "platform"}})
+			return
+		}
+		gotMask = r.URL.Query().Get("updateMask")
+		_ = json.NewEncoder(w).Encode(&privateca.Operation{})
+	}))
+	e := caPoolExternal{projectID: projectID, pools: s.Projects.Locations.CaPools}
+	if _, err := e.Update(context.Background(), newCaPool()); err != nil {
+		t.Fatalf("Update(...): unexpected error: %v", err)
+	}
+	if diff := cmp.Diff("labels", gotMask); diff != "" {
+		t.Errorf("Update(...): -want mask, +got mask:\n%s", diff)
+	}
+}
+
+func TestCaPoolDelete(t *testing.T) {
+	cases := map[string]struct {
+		status int
+		err    bool
+	}{
+		"Successful": {
+			status: http.StatusOK,
+		},
+		"NotFound": {
+			status: http.StatusNotFound,
+		},
+		"DeleteFailed": {
+			status: http.StatusBadRequest,
+			err:    true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := privatecaService(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodDelete+" "+poolPath, r.Method+" "+r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(&privateca.Operation{})
+			}))
+			e := caPoolExternal{projectID: projectID, pools: s.Projects.Locations.CaPools}
+			err := e.Delete(context.Background(), newCaPool())
+			if diff := cmp.Diff(tc.err, err != nil); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
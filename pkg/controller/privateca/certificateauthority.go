@@ -0,0 +1,238 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package privateca
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	privateca "google.golang.org/api/privateca/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/privateca/v1alpha1"
+	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/certificateauthority"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/setup"
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+	"github.com/crossplane-contrib/provider-gcp/pkg/operation"
+	"github.com/crossplane-contrib/provider-gcp/pkg/tracing"
+)
+
+const (
+	errNotCertificateAuthority        = "managed resource is not of type CertificateAuthority"
+	errGetCertificateAuthority        = "cannot get CertificateAuthority"
+	errCreateCertificateAuthority     = "cannot create CertificateAuthority"
+	errUpdateCertificateAuthority     = "cannot update CertificateAuthority"
+	errEnableCertificateAuthority     = "cannot enable CertificateAuthority"
+	errDisableCertificateAuthority    = "cannot disable CertificateAuthority"
+	errDeleteCertificateAuthority     = "cannot delete CertificateAuthority"
+	errKubeUpdateCertificateAuthority = "cannot update CertificateAuthority custom resource"
+)
+
+// SetupCertificateAuthority adds a controller that reconciles
+// CertificateAuthorities.
+func SetupCertificateAuthority(mgr ctrl.Manager, o setup.Options) error {
+	name := managed.ControllerName(v1alpha1.CertificateAuthorityGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
+	}
+
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.CertificateAuthorityGroupVersionKind),
+		managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient()), operation.NewCreateResumer(mgr.GetClient())),
+		managed.WithExternalConnecter(setup.Connecter(mgr, o, v1alpha1.CertificateAuthorityGroupKind, &certificateAuthorityConnector{client: mgr.GetClient(), record: recorder})),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(recorder),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.CertificateAuthority{}).
+		Complete(tracing.NewReconciler(name, ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter)))
+}
+
+type certificateAuthorityConnector struct {
+	client client.Client
+	record event.Recorder
+}
+
+// Connect returns an ExternalClient with necessary information to talk to GCP API.
+func (c *certificateAuthorityConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetConnectionInfo(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := privateca.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &certificateAuthorityExternal{projectID: projectID, client: c.client, record: c.record, cas: s.Projects.Locations.CaPools.CertificateAuthorities, ops: s.Projects.Locations.Operations}, nil
+}
+
+type certificateAuthorityExternal struct {
+	projectID string
+	client    client.Client
+	record    event.Recorder
+	cas       *privateca.ProjectsLocationsCaPoolsCertificateAuthoritiesService
+	ops       *privateca.ProjectsLocationsOperationsService
+}
+
+// Observe makes observation about the external resource. A certificate
+// authority that is deleted but can still be restored is considered not to
+// exist.
+func (e *certificateAuthorityExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.CertificateAuthority)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotCertificateAuthority)
+	}
+	if err := trackCreate(ctx, e.client, e.record, e.ops, cr); err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	name := certificateauthority.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr))
+	obs, err := e.cas.Get(name).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetCertificateAuthority)
+	}
+	cr.Status.AtProvider = certificateauthority.GenerateObservation(*obs)
+	if obs.State == v1alpha1.CertificateAuthorityStateDeleted {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	certificateauthority.LateInitialize(&cr.Spec.ForProvider, *obs)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
+		if err := e.client.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errKubeUpdateCertificateAuthority)
+		}
+	}
+	switch obs.State {
+	case v1alpha1.CertificateAuthorityStateEnabled:
+		cr.SetConditions(xpv1.Available())
+	default:
+		cr.SetConditions(xpv1.Unavailable())
+	}
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  certificateauthority.IsUpToDate(cr.Spec.ForProvider, *obs),
+		ConnectionDetails: certificateauthority.GetConnectionDetails(*obs),
+	}, nil
+}
+
+// Create initiates creation of external resource.
+func (e *certificateAuthorityExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.CertificateAuthority)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotCertificateAuthority)
+	}
+	cr.SetConditions(xpv1.Creating())
+	// Wait until the certificate authority is created if it is being
+	// created.
+	if operation.CreateOperation(cr) != "" {
+		return managed.ExternalCreation{}, nil
+	}
+	op, err := e.cas.Create(certificateauthority.GetParent(e.projectID, cr.Spec.ForProvider), certificateauthority.GenerateCertificateAuthority("", cr.Spec.ForProvider)).
+		CertificateAuthorityId(meta.GetExternalName(cr)).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateCertificateAuthority)
+	}
+	persistCreateOperation(ctx, e.client, e.record, cr, op)
+	return managed.ExternalCreation{}, nil
+}
+
+// Update initiates an update to the external resource. The labels of the
+// certificate authority are patched, and it is enabled or disabled as
+// desired.
+func (e *certificateAuthorityExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.CertificateAuthority)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotCertificateAuthority)
+	}
+	name := certificateauthority.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr))
+	obs, err := e.cas.Get(name).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetCertificateAuthority)
+	}
+	if !certificateauthority.LabelsUpToDate(cr.Spec.ForProvider, *obs) {
+		op, err := e.cas.Patch(name, &privateca.CertificateAuthority{Labels: cr.Spec.ForProvider.Labels}).UpdateMask("labels").Context(ctx).Do()
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateCertificateAuthority)
+		}
+		gcp.RecordOperation(e.record, cr, "update", op.Name)
+	}
+	switch certificateauthority.NeedsStateChange(cr.Spec.ForProvider, *obs) {
+	case v1alpha1.CertificateAuthorityStateEnabled:
+		op, err := e.cas.Enable(name, &privateca.EnableCertificateAuthorityRequest{}).Context(ctx).Do()
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errEnableCertificateAuthority)
+		}
+		gcp.RecordOperation(e.record, cr, "enable", op.Name)
+	case v1alpha1.CertificateAuthorityStateDisabled:
+		op, err := e.cas.Disable(name, &privateca.DisableCertificateAuthorityRequest{}).Context(ctx).Do()
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errDisableCertificateAuthority)
+		}
+		gcp.RecordOperation(e.record, cr, "disable", op.Name)
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+// Delete initiates an deletion of the external resource. An enabled
+// certificate authority can not be deleted, so it is disabled first.
+func (e *certificateAuthorityExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.CertificateAuthority)
+	if !ok {
+		return errors.New(errNotCertificateAuthority)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	name := certificateauthority.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr))
+	if cr.Status.AtProvider.State == v1alpha1.CertificateAuthorityStateEnabled {
+		op, err := e.cas.Disable(name, &privateca.DisableCertificateAuthorityRequest{}).Context(ctx).Do()
+		if err != nil {
+			return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDisableCertificateAuthority)
+		}
+		gcp.RecordOperation(e.record, cr, "disable", op.Name)
+		return nil
+	}
+	op, err := e.cas.Delete(name).
+		SkipGracePeriod(gcp.BoolValue(cr.Spec.ForProvider.SkipGracePeriod)).
+		IgnoreActiveCertificates(gcp.BoolValue(cr.Spec.ForProvider.IgnoreActiveCertificates)).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteCertificateAuthority)
+	}
+	gcp.RecordOperation(e.record, cr, "delete", op.Name)
+	return nil
+}
@@ -0,0 +1,215 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package privateca
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/option"
+	privateca "google.golang.org/api/privateca/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/privateca/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	projectID = "fooproject"
+	caPath    = "/v1/projects/fooproject/locations/us-central1/caPools/pool/certificateAuthorities/root"
+)
+
+func newCertificateAuthority(m ...func(*v1alpha1.CertificateAuthority)) *v1alpha1.CertificateAuthority {
+	ca := &v1alpha1.CertificateAuthority{}
+	meta.SetExternalName(ca, "root")
+	ca.Spec.ForProvider = v1alpha1.CertificateAuthorityParameters{
+		Location:  "us-central1",
+		CaPool:    gcp.StringPtr("pool"),
+		Type:      "SELF_SIGNED",
+		Lifetime:  "315360000s",
+		GCSBucket: gcp.StringPtr("bucket"),
+	}
+	for _, f := range m {
+		f(ca)
+	}
+	return ca
+}
+
+func casService(t *testing.T, h http.Handler) *privateca.ProjectsLocationsCaPoolsCertificateAuthoritiesService {
+	t.Helper()
+	server := httptest.NewServer(h)
+	t.Cleanup(server.Close)
+	s, _ := privateca.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	return s.Projects.Locations.CaPools.CertificateAuthorities
+}
+
+func TestCertificateAuthorityObserve(t *testing.T) {
+	type want struct {
+		eo   managed.ExternalObservation
+		cond xpv1.Condition
+	}
+	cases := map[string]struct {
+		observed *privateca.CertificateAuthority
+		want     want
+	}{
+		"Enabled": {
+			observed: &privateca.CertificateAuthority{State: v1alpha1.CertificateAuthorityStateEnabled, GcsBucket: "bucket", PemCaCertificates: []string{"root\n"}},
+			want: want{
+				eo: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{v1alpha1.CertificateAuthoritySecretCACertificateKey: []byte("root\n")},
+				},
+				cond: xpv1.Available(),
+			},
+		},
+		"Staged": {
+			observed: &privateca.CertificateAuthority{State: v1alpha1.CertificateAuthorityStateStaged, GcsBucket: "bucket"},
+			want: want{
+				eo:   managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				cond: xpv1.Unavailable(),
+			},
+		},
+		"Deleted": {
+			observed: &privateca.CertificateAuthority{State: v1alpha1.CertificateAuthorityStateDeleted, GcsBucket: "bucket"},
+			want: want{
+				eo: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cas := casService(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(caPath, r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(tc.observed)
+			}))
+			e := certificateAuthorityExternal{projectID: projectID, cas: cas}
+			cr := newCertificateAuthority()
+			got, err := e.Observe(context.Background(), cr)
+			if err != nil {
+				t.Fatalf("Observe(...): unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want.eo, got); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if tc.want.cond.Type == "" {
+				return
+			}
+			if diff := cmp.Diff(tc.want.cond, cr.GetCondition(xpv1.TypeReady), test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want condition, +got condition:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCertificateAuthorityUpdate(t *testing.T) {
+	cases := map[string]struct {
+		mg       *v1alpha1.CertificateAuthority
+		observed *privateca.CertificateAuthority
+		want     []string
+	}{
+		"EnableStaged": {
+			mg:       newCertificateAuthority(),
+			observed: &privateca.CertificateAuthority{State: v1alpha1.CertificateAuthorityStateStaged},
+			want:     []string{http.MethodGet + " " + caPath, http.MethodPost + " " + caPath + ":enable"},
+		},
+		"PatchLabelsAndDisable": {
+			mg: newCertificateAuthority(func(ca *v1alpha1.CertificateAuthority) {
+				ca.Spec.ForProvider.Labels = map[string]string{"team": "sec"}
+				ca.Spec.ForProvider.DesiredState = gcp.StringPtr(v1alpha1.CertificateAuthorityStateDisabled)
+			}),
+			observed: &privateca.CertificateAuthority{State: v1alpha1.CertificateAuthorityStateEnabled},
+			want: []string{
+				http.MethodGet + " " + caPath,
+				http.MethodPatch + " " + caPath,
+				http.MethodPost + " " + caPath + ":disable",
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got []string
+			cas := casService(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				got = append(got, r.Method+" "+r.URL.Path)
+				w.WriteHeader(http.StatusOK)
+				if r.Method == http.MethodGet {
+					_ = json.NewEncoder(w).Encode(tc.observed)
+					return
+				}
+				_ = json.NewEncoder(w).Encode(&privateca.Operation{})
+			}))
+			e := certificateAuthorityExternal{projectID: projectID, cas: cas}
+			if _, err := e.Update(context.Background(), tc.mg); err != nil {
+				t.Fatalf("Update(...): unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Update(...): -want requests, +got requests:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCertificateAuthorityDelete(t *testing.T) {
+	cases := map[string]struct {
+		mg   *v1alpha1.CertificateAuthority
+		want []string
+	}{
+		"EnabledIsDisabledFirst": {
+			mg: newCertificateAuthority(func(ca *v1alpha1.CertificateAuthority) {
+				ca.Status.AtProvider.State = v1alpha1.CertificateAuthorityStateEnabled
+			}),
+			want: []string{http.MethodPost + " " + caPath + ":disable"},
+		},
+		"Disabled": {
+			mg: newCertificateAuthority(func(ca *v1alpha1.CertificateAuthority) {
+				ca.Status.AtProvider.State = v1alpha1.CertificateAuthorityStateDisabled
+			}),
+			want: []string{http.MethodDelete + " " + caPath},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got []string
+			cas := casService(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				got = append(got, r.Method+" "+r.URL.Path)
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&privateca.Operation{})
+			}))
+			e := certificateAuthorityExternal{projectID: projectID, cas: cas}
+			if err := e.Delete(context.Background(), tc.mg); err != nil {
+				t.Fatalf("Delete(...): unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Delete(...): -want requests, +got requests:\n%s", diff)
+			}
+		})
+	}
+}
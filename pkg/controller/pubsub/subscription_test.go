@@ -132,7 +132,8 @@ func TestSubscriptionObserve(t *testing.T) {
 						t.Error(err)
 					}
 				}),
-				mg: newSubscription(),
+				mg:   newSubscription(),
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
 			},
 			want: want{
 				eo: managed.ExternalObservation{
@@ -26,7 +26,6 @@ import (
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/connection"
-	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	"github.com/crossplane/crossplane-runtime/pkg/errors"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
@@ -36,9 +35,13 @@ import (
 
 	"github.com/crossplane-contrib/provider-gcp/apis/pubsub/v1alpha1"
 	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-gcp/pkg/batch"
 	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
 	"github.com/crossplane-contrib/provider-gcp/pkg/clients/topic"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/setup"
 	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+	"github.com/crossplane-contrib/provider-gcp/pkg/replace"
+	"github.com/crossplane-contrib/provider-gcp/pkg/tracing"
 )
 
 const (
@@ -52,7 +55,7 @@ const (
 )
 
 // SetupTopic adds a controller that reconciles Topics.
-func SetupTopic(mgr ctrl.Manager, o controller.Options) error {
+func SetupTopic(mgr ctrl.Manager, o setup.Options) error {
 	name := managed.ControllerName(v1alpha1.TopicGroupKind)
 
 	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
@@ -60,23 +63,31 @@ func SetupTopic(mgr ctrl.Manager, o controller.Options) error {
 		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
 	}
 
+	var topics *batch.Lister[*pubsub.Topic]
+	if o.Features.Enabled(features.EnableAlphaBatchObserve) {
+		topics = batch.NewLister[*pubsub.Topic](o.BatchObserveTTL)
+	}
+
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(v1alpha1.TopicGroupVersionKind),
-		managed.WithExternalConnecter(&connector{client: mgr.GetClient()}),
+		managed.WithExternalConnecter(setup.Connecter(mgr, o, v1alpha1.TopicGroupKind, &connector{client: mgr.GetClient(), record: recorder, topics: topics})),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
-		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithRecorder(recorder),
 		managed.WithConnectionPublishers(cps...))
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(o.ForControllerRuntime()).
 		For(&v1alpha1.Topic{}).
-		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+		Complete(tracing.NewReconciler(name, ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter)))
 }
 
 type connector struct {
 	client client.Client
+	record event.Recorder
+	topics *batch.Lister[*pubsub.Topic]
 }
 
 // Connect returns an ExternalClient with necessary information to talk to GCP API.
@@ -89,13 +100,36 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	if err != nil {
 		return nil, errors.Wrap(err, errNewClient)
 	}
-	return &external{projectID: projectID, client: c.client, ps: s}, nil
+	return &external{projectID: projectID, client: c.client, record: c.record, ps: s, topics: c.topics}, nil
 }
 
 type external struct {
 	projectID string
 	client    client.Client
+	record    event.Recorder
 	ps        *pubsub.Service
+
+	// topics serves topics from a listing of the project, if set.
+	topics *batch.Lister[*pubsub.Topic]
+}
+
+func (e *external) get(ctx context.Context, name string) (*pubsub.Topic, error) {
+	get := func(ctx context.Context) (*pubsub.Topic, error) {
+		return e.ps.Projects.Topics.Get(name).Context(ctx).Do()
+	}
+	if e.topics == nil {
+		return get(ctx)
+	}
+	list := func(ctx context.Context) (map[string]*pubsub.Topic, error) {
+		return topic.List(ctx, e.ps, e.projectID)
+	}
+	return e.topics.Get(ctx, e.projectID, name, list, get)
+}
+
+func (e *external) changed(name string) {
+	if e.topics != nil {
+		e.topics.Changed(e.projectID, name)
+	}
 }
 
 // Observe makes observation about the external resource.
@@ -104,7 +138,7 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	if !ok {
 		return managed.ExternalObservation{}, errors.New(errNotTopic)
 	}
-	t, err := e.ps.Projects.Topics.Get(topic.GetFullyQualifiedName(e.projectID, meta.GetExternalName(cr))).Context(ctx).Do()
+	t, err := e.get(ctx, topic.GetFullyQualifiedName(e.projectID, meta.GetExternalName(cr)))
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetTopic)
 	}
@@ -115,6 +149,7 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 			return managed.ExternalObservation{}, errors.Wrap(err, errKubeUpdateTopic)
 		}
 	}
+	cr.Status.AtProvider = topic.GenerateObservation(*t)
 	cr.SetConditions(xpv1.Available())
 	return managed.ExternalObservation{
 		ResourceExists:   true,
@@ -133,6 +168,7 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalCreation{}, errors.New(errNotTopic)
 	}
 	cr.SetConditions(xpv1.Creating())
+	e.changed(topic.GetFullyQualifiedName(e.projectID, meta.GetExternalName(cr)))
 	_, err := e.ps.Projects.Topics.Create(topic.GetFullyQualifiedName(e.projectID, meta.GetExternalName(cr)), topic.GenerateTopic(meta.GetExternalName(cr), cr.Spec.ForProvider)).Context(ctx).Do()
 	return managed.ExternalCreation{}, errors.Wrap(err, errCreateTopic)
 }
@@ -148,6 +184,19 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 	if err != nil {
 		return managed.ExternalUpdate{}, errors.Wrap(err, errGetTopic)
 	}
+
+	// Deleting a topic detaches its subscriptions, which have to be deleted
+	// and created again to be attached to the replacement topic.
+	changed := topic.ImmutableFieldsChanged(cr.Spec.ForProvider, *t)
+	replacing, err := replace.Check(cr, cr.Spec.UpdatePolicy, changed)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if replacing {
+		return managed.ExternalUpdate{}, replace.Delete(ctx, e.client, e.record, cr, changed, e.Delete)
+	}
+
+	e.changed(topic.GetFullyQualifiedName(e.projectID, meta.GetExternalName(cr)))
 	_, err = e.ps.Projects.Topics.Patch(topic.GetFullyQualifiedName(e.projectID, meta.GetExternalName(cr)), topic.GenerateUpdateRequest(meta.GetExternalName(cr), cr.Spec.ForProvider, *t)).Context(ctx).Do()
 	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateTopic)
 }
@@ -158,6 +207,7 @@ func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
 	if !ok {
 		return errors.New(errNotTopic)
 	}
+	e.changed(topic.GetFullyQualifiedName(e.projectID, meta.GetExternalName(cr)))
 	_, err := e.ps.Projects.Topics.Delete(topic.GetFullyQualifiedName(e.projectID, meta.GetExternalName(cr))).Context(ctx).Do()
 	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteTopic)
 }
@@ -28,14 +28,19 @@ import (
 	pubsub "google.golang.org/api/pubsub/v1"
 
 	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 
 	"github.com/crossplane-contrib/provider-gcp/apis/pubsub/v1alpha1"
+	"github.com/crossplane-contrib/provider-gcp/pkg/replace"
 )
 
 const (
@@ -57,6 +62,28 @@ func newTopic(opts ...TopicOption) *v1alpha1.Topic {
 	return t
 }
 
+func withKmsKeyName(k string) TopicOption {
+	return func(t *v1alpha1.Topic) { t.Spec.ForProvider.KmsKeyName = &k }
+}
+
+func withReplaceOnImmutableChange(confirmed bool) TopicOption {
+	return func(t *v1alpha1.Topic) {
+		t.Spec.UpdatePolicy = replace.PolicyReplaceOnImmutableChange
+		t.SetGeneration(2)
+		if confirmed {
+			t.SetAnnotations(map[string]string{replace.AnnotationKeyConfirm: "2"})
+		}
+	}
+}
+
+func withDeletionPolicy(p xpv1.DeletionPolicy) TopicOption {
+	return func(t *v1alpha1.Topic) { t.SetDeletionPolicy(p) }
+}
+
+func withAnnotations(a map[string]string) TopicOption {
+	return func(t *v1alpha1.Topic) { meta.AddAnnotations(t, a) }
+}
+
 func gError(code int, message string) *googleapi.Error {
 	return &googleapi.Error{
 		Code:    code,
@@ -263,8 +290,9 @@ func TestUpdate(t *testing.T) {
 	}
 
 	type want struct {
-		eo  managed.ExternalUpdate
-		err error
+		eo     managed.ExternalUpdate
+		err    error
+		events []event.Event
 	}
 
 	cases := map[string]struct {
@@ -325,6 +353,96 @@ func TestUpdate(t *testing.T) {
 				mg: newTopic(),
 			},
 		},
+		"ReplaceNotConfirmed": {
+			reason: "Should return an error and not change the topic if replacing it was not confirmed",
+			args: args{
+				handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					_ = r.Body.Close()
+					if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					w.WriteHeader(http.StatusOK)
+					if err := json.NewEncoder(w).Encode(&pubsub.Topic{
+						KmsKeyName: "cool-key",
+					}); err != nil {
+						t.Error(err)
+					}
+				}),
+				mg: newTopic(withKmsKeyName("new-key"), withReplaceOnImmutableChange(false)),
+			},
+			want: want{
+				err: errors.Errorf("immutable fields changed (kmsKeyName): annotate the resource with %s=2 to confirm the external resource may be deleted and created again", replace.AnnotationKeyConfirm),
+			},
+		},
+		"Replace": {
+			reason: "Should delete the topic so that it is created again if replacing it was confirmed",
+			args: args{
+				handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					_ = r.Body.Close()
+					if r.Method == http.MethodPatch {
+						t.Errorf("r: unexpected patch of a topic that is replaced")
+					}
+					w.WriteHeader(http.StatusOK)
+					if err := json.NewEncoder(w).Encode(&pubsub.Topic{
+						KmsKeyName: "cool-key",
+					}); err != nil {
+						t.Error(err)
+					}
+				}),
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil),
+					MockUpdate: test.NewMockUpdateFn(nil, func(obj client.Object) error {
+						if diff := cmp.Diff("2", obj.GetAnnotations()[replace.AnnotationKeyDeleting]); diff != "" {
+							t.Errorf("Update(...): -want deleting annotation, +got deleting annotation:\n%s", diff)
+						}
+						return nil
+					}),
+				},
+				mg: newTopic(withKmsKeyName("new-key"), withReplaceOnImmutableChange(true)),
+			},
+			want: want{
+				events: []event.Event{replace.Replacing([]string{"kmsKeyName"})},
+			},
+		},
+		"ReplacePending": {
+			reason: "Should not delete the topic again while deleting it to replace it is pending",
+			args: args{
+				handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					_ = r.Body.Close()
+					if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					w.WriteHeader(http.StatusOK)
+					if err := json.NewEncoder(w).Encode(&pubsub.Topic{
+						KmsKeyName: "cool-key",
+					}); err != nil {
+						t.Error(err)
+					}
+				}),
+				mg: newTopic(withKmsKeyName("new-key"), withReplaceOnImmutableChange(true), withAnnotations(map[string]string{replace.AnnotationKeyDeleting: "2"})),
+			},
+		},
+		"ReplaceOrphan": {
+			reason: "Should return an error and not delete the topic if its deletion policy is Orphan",
+			args: args{
+				handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					_ = r.Body.Close()
+					if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					w.WriteHeader(http.StatusOK)
+					if err := json.NewEncoder(w).Encode(&pubsub.Topic{
+						KmsKeyName: "cool-key",
+					}); err != nil {
+						t.Error(err)
+					}
+				}),
+				mg: newTopic(withKmsKeyName("new-key"), withReplaceOnImmutableChange(true), withDeletionPolicy(xpv1.DeletionOrphan)),
+			},
+			want: want{
+				err: errors.New("immutable fields changed (kmsKeyName): the external resource cannot be replaced because the deletion policy of the resource is Orphan"),
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -332,12 +450,17 @@ func TestUpdate(t *testing.T) {
 			server := httptest.NewServer(tc.args.handler)
 			defer server.Close()
 			s, _ := pubsub.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			r := &recorder{}
 			e := external{
 				client:    tc.args.kube,
 				projectID: projectID,
+				record:    r,
 				ps:        s,
 			}
 			got, err := e.Update(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.events, r.events); diff != "" {
+				t.Errorf("Update(...): -want events, +got events:\n%s", diff)
+			}
 			if diff := cmp.Diff(tc.want.eo, got); diff != "" {
 				t.Errorf("Update(...): -want, +got:\n%s", diff)
 			}
@@ -431,3 +554,11 @@ func TestDelete(t *testing.T) {
 		})
 	}
 }
+
+type recorder struct {
+	events []event.Event
+}
+
+func (r *recorder) Event(_ runtime.Object, e event.Event) { r.events = append(r.events, e) }
+
+func (r *recorder) WithAnnotations(_ ...string) event.Recorder { return r }
@@ -0,0 +1,183 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recaptchaenterprise
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	recaptcha "google.golang.org/api/recaptchaenterprise/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/recaptchaenterprise/v1alpha1"
+	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/recaptchakey"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/setup"
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+	"github.com/crossplane-contrib/provider-gcp/pkg/tracing"
+)
+
+const (
+	errNewClient = "cannot create new reCAPTCHA Enterprise client"
+
+	errNotKey        = "managed resource is not of type Key"
+	errGetKey        = "cannot get Key"
+	errCreateKey     = "cannot create Key"
+	errUpdateKey     = "cannot update Key"
+	errDeleteKey     = "cannot delete Key"
+	errKubeUpdateKey = "cannot update Key custom resource"
+
+	// keySiteKey is the connection detail clients use to embed the key.
+	keySiteKey = "siteKey"
+)
+
+// SetupKey adds a controller that reconciles Keys.
+func SetupKey(mgr ctrl.Manager, o setup.Options) error {
+	name := managed.ControllerName(v1alpha1.KeyGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.KeyGroupVersionKind),
+		// The key ID is assigned by Google when the key is created.
+		managed.WithInitializers(),
+		managed.WithExternalConnecter(setup.Connecter(mgr, o, v1alpha1.KeyGroupKind, &keyConnector{client: mgr.GetClient()})),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.Key{}).
+		Complete(tracing.NewReconciler(name, ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter)))
+}
+
+type keyConnector struct {
+	client client.Client
+}
+
+// Connect returns an ExternalClient with necessary information to talk to GCP API.
+func (c *keyConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetConnectionInfo(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := recaptcha.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &keyExternal{projectID: projectID, client: c.client, keys: s.Projects.Keys}, nil
+}
+
+type keyExternal struct {
+	projectID string
+	client    client.Client
+	keys      *recaptcha.ProjectsKeysService
+}
+
+// Observe makes observation about the external resource.
+func (e *keyExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Key)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotKey)
+	}
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	observed, err := e.keys.Get(recaptchakey.GetFullyQualifiedName(e.projectID, meta.GetExternalName(cr))).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetKey)
+	}
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	recaptchakey.LateInitialize(&cr.Spec.ForProvider, *observed)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
+		if err := e.client.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errKubeUpdateKey)
+		}
+	}
+	cr.Status.AtProvider = recaptchakey.GenerateObservation(*observed)
+	cr.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  recaptchakey.IsUpToDate(cr.Spec.ForProvider, *observed),
+		ConnectionDetails: managed.ConnectionDetails{keySiteKey: []byte(meta.GetExternalName(cr))},
+	}, nil
+}
+
+// Create initiates creation of external resource.
+func (e *keyExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Key)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotKey)
+	}
+	cr.SetConditions(xpv1.Creating())
+	k, err := e.keys.Create(recaptchakey.GetParent(e.projectID), recaptchakey.GenerateKey("", cr.Spec.ForProvider)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateKey)
+	}
+	meta.SetExternalName(cr, recaptchakey.ParseKeyID(k.Name))
+	return managed.ExternalCreation{
+		ExternalNameAssigned: true,
+		ConnectionDetails:    managed.ConnectionDetails{keySiteKey: []byte(meta.GetExternalName(cr))},
+	}, nil
+}
+
+// Update initiates an update to the external resource.
+func (e *keyExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Key)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotKey)
+	}
+	name := recaptchakey.GetFullyQualifiedName(e.projectID, meta.GetExternalName(cr))
+	observed, err := e.keys.Get(name).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetKey)
+	}
+	mask := recaptchakey.GenerateUpdateMask(cr.Spec.ForProvider, *observed)
+	if mask == "" {
+		return managed.ExternalUpdate{}, nil
+	}
+	_, err = e.keys.Patch(name, recaptchakey.GenerateKey(name, cr.Spec.ForProvider)).UpdateMask(mask).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateKey)
+}
+
+// Delete initiates an deletion of the external resource.
+func (e *keyExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Key)
+	if !ok {
+		return errors.New(errNotKey)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.keys.Delete(recaptchakey.GetFullyQualifiedName(e.projectID, meta.GetExternalName(cr))).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteKey)
+}
@@ -0,0 +1,182 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recaptchaenterprise
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+	recaptcha "google.golang.org/api/recaptchaenterprise/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/recaptchaenterprise/v1alpha1"
+)
+
+const (
+	projectID = "fooproject"
+	keyID     = "6LeXXXX"
+	keyPath   = "/v1/projects/fooproject/keys/6LeXXXX"
+)
+
+func gError(code int, message string) *googleapi.Error {
+	return &googleapi.Error{
+		Code:    code,
+		Body:    "{}\n",
+		Message: message,
+	}
+}
+
+func newKey(m ...func(*v1alpha1.Key)) *v1alpha1.Key {
+	k := &v1alpha1.Key{}
+	meta.SetExternalName(k, keyID)
+	k.Spec.ForProvider = v1alpha1.KeyParameters{
+		DisplayName: "checkout",
+		AndroidSettings: &v1alpha1.AndroidKeySettings{
+			AllowedPackageNames: []string{"com.example.shop"},
+		},
+	}
+	for _, f := range m {
+		f(k)
+	}
+	return k
+}
+
+func observedKey() *recaptcha.GoogleCloudRecaptchaenterpriseV1Key {
+	return &recaptcha.GoogleCloudRecaptchaenterpriseV1Key{
+		Name:        "projects/fooproject/keys/6LeXXXX",
+		DisplayName: "checkout",
+		AndroidSettings: &recaptcha.GoogleCloudRecaptchaenterpriseV1AndroidKeySettings{
+			AllowedPackageNames: []string{"com.example.shop"},
+		},
+	}
+}
+
+func TestKeyObserve(t *testing.T) {
+	type want struct {
+		eo  managed.ExternalObservation
+		err error
+	}
+	cases := map[string]struct {
+		handler http.Handler
+		mg      *v1alpha1.Key
+		want    want
+	}{
+		"NotCreatedYet": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				t.Errorf("unexpected request to %s", r.URL.Path)
+			}),
+			mg: newKey(func(k *v1alpha1.Key) { meta.SetExternalName(k, "") }),
+		},
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+			mg: newKey(),
+		},
+		"GetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+			mg:   newKey(),
+			want: want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetKey)},
+		},
+		"UpToDate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(keyPath, r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(observedKey())
+			}),
+			mg: newKey(),
+			want: want{eo: managed.ExternalObservation{
+				ResourceExists:    true,
+				ResourceUpToDate:  true,
+				ConnectionDetails: managed.ConnectionDetails{keySiteKey: []byte(keyID)},
+			}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := recaptcha.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := keyExternal{projectID: projectID, keys: s.Projects.Keys}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.eo, got); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestKeyCreate(t *testing.T) {
+	got := &recaptcha.GoogleCloudRecaptchaenterpriseV1Key{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer func() { _ = r.Body.Close() }()
+		if diff := cmp.Diff("/v1/projects/fooproject/keys", r.URL.Path); diff != "" {
+			t.Errorf("r: -want, +got:\n%s", diff)
+		}
+		b, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(b, got)
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(observedKey())
+	}))
+	defer server.Close()
+
+	s, _ := recaptcha.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	e := keyExternal{projectID: projectID, keys: s.Projects.Keys}
+	mg := newKey(func(k *v1alpha1.Key) { meta.SetExternalName(k, "") })
+	ec, err := e.Create(context.Background(), mg)
+	if err != nil {
+		t.Fatalf("Create(...): unexpected error: %v", err)
+	}
+	want := observedKey()
+	want.Name = ""
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Create(...): -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(keyID, meta.GetExternalName(mg)); diff != "" {
+		t.Errorf("Create(...): -want external name, +got external name:\n%s", diff)
+	}
+	wantEC := managed.ExternalCreation{
+		ExternalNameAssigned: true,
+		ConnectionDetails:    managed.ConnectionDetails{keySiteKey: []byte(keyID)},
+	}
+	if diff := cmp.Diff(wantEC, ec); diff != "" {
+		t.Errorf("Create(...): -want, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registry
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	storage "google.golang.org/api/storage/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis"
+	"github.com/crossplane-contrib/provider-gcp/apis/registry/v1alpha1"
+	"github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
+)
+
+const (
+	projectID = "my-project"
+	token     = "my-token"
+)
+
+func TestConnect(t *testing.T) {
+	var got http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.Body.Close()
+		got = r.Header
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(&storage.Bucket{Id: "artifacts." + projectID + ".appspot.com"})
+	}))
+	defer server.Close()
+
+	s := runtime.NewScheme()
+	if err := apis.AddToScheme(s); err != nil {
+		t.Fatalf("cannot add APIs to scheme: %v", err)
+	}
+	if err := corev1.AddToScheme(s); err != nil {
+		t.Fatalf("cannot add core APIs to scheme: %v", err)
+	}
+	pc := &v1beta1.ProviderConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "default"},
+		Spec: v1beta1.ProviderConfigSpec{
+			ProjectID: projectID,
+			Credentials: v1beta1.ProviderCredentials{
+				Source: xpv1.CredentialsSourceSecret,
+				CommonCredentialSelectors: xpv1.CommonCredentialSelectors{
+					SecretRef: &xpv1.SecretKeySelector{
+						SecretReference: xpv1.SecretReference{Namespace: "crossplane-system", Name: "gcp-creds"},
+						Key:             "token",
+					},
+				},
+			},
+			ClientOptions: &v1beta1.ClientOptions{Endpoint: &server.URL},
+		},
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "crossplane-system", Name: "gcp-creds"},
+		Data:       map[string][]byte{"token": []byte(token)},
+	}
+	cr := &v1alpha1.ContainerRegistry{ObjectMeta: metav1.ObjectMeta{Name: "registry", UID: "registry-uid"}}
+	cr.SetProviderConfigReference(&xpv1.Reference{Name: pc.GetName()})
+	kube := fake.NewClientBuilder().WithScheme(s).WithObjects(pc, secret, cr).Build()
+	if err := kube.Get(context.Background(), client.ObjectKeyFromObject(cr), cr); err != nil {
+		t.Fatalf("cannot get ContainerRegistry: %v", err)
+	}
+
+	// The registry must be observed with the endpoint and credentials of its
+	// ProviderConfig.
+	e, err := (&connecter{client: kube}).Connect(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Connect(...): %v", err)
+	}
+	if _, err := e.Observe(context.Background(), cr); err != nil {
+		t.Fatalf("Observe(...): %v", err)
+	}
+	if diff := cmp.Diff("Bearer "+token, got.Get("Authorization")); diff != "" {
+		t.Errorf("Observe(...): -want authorization, +got authorization:\n%s", diff)
+	}
+}
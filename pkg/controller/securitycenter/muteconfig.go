@@ -0,0 +1,168 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package securitycenter
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	scc "google.golang.org/api/securitycenter/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/securitycenter/v1alpha1"
+	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/muteconfig"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/setup"
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+	"github.com/crossplane-contrib/provider-gcp/pkg/tracing"
+)
+
+const (
+	errNotMuteConfig        = "managed resource is not of type MuteConfig"
+	errGetMuteConfig        = "cannot get MuteConfig"
+	errCreateMuteConfig     = "cannot create MuteConfig"
+	errUpdateMuteConfig     = "cannot update MuteConfig"
+	errDeleteMuteConfig     = "cannot delete MuteConfig"
+	errKubeUpdateMuteConfig = "cannot update MuteConfig custom resource"
+)
+
+// SetupMuteConfig adds a controller that reconciles MuteConfigs.
+func SetupMuteConfig(mgr ctrl.Manager, o setup.Options) error {
+	name := managed.ControllerName(v1alpha1.MuteConfigGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.MuteConfigGroupVersionKind),
+		managed.WithExternalConnecter(setup.Connecter(mgr, o, v1alpha1.MuteConfigGroupKind, &muteConfigConnector{client: mgr.GetClient()})),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.MuteConfig{}).
+		Complete(tracing.NewReconciler(name, ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter)))
+}
+
+type muteConfigConnector struct {
+	client client.Client
+}
+
+// Connect returns an ExternalClient with necessary information to talk to GCP API.
+func (c *muteConfigConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetConnectionInfo(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := scc.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &muteConfigExternal{projectID: projectID, client: c.client, scc: s}, nil
+}
+
+type muteConfigExternal struct {
+	projectID string
+	client    client.Client
+	scc       *scc.Service
+}
+
+// Observe makes observation about the external resource.
+func (e *muteConfigExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.MuteConfig)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotMuteConfig)
+	}
+	name := muteconfig.GetFullyQualifiedName(muteconfig.GetParent(e.projectID, cr.Spec.ForProvider), meta.GetExternalName(cr))
+	mc, err := e.scc.Projects.MuteConfigs.Get(name).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetMuteConfig)
+	}
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	muteconfig.LateInitialize(&cr.Spec.ForProvider, *mc)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
+		if err := e.client.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errKubeUpdateMuteConfig)
+		}
+	}
+	cr.Status.AtProvider = muteconfig.GenerateObservation(*mc)
+	cr.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: muteconfig.IsUpToDate(cr.Spec.ForProvider, *mc),
+	}, nil
+}
+
+// Create initiates creation of external resource.
+func (e *muteConfigExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.MuteConfig)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotMuteConfig)
+	}
+	cr.SetConditions(xpv1.Creating())
+	_, err := e.scc.Projects.MuteConfigs.Create(muteconfig.GetParent(e.projectID, cr.Spec.ForProvider), muteconfig.GenerateMuteConfig("", cr.Spec.ForProvider)).
+		MuteConfigId(meta.GetExternalName(cr)).
+		Context(ctx).
+		Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateMuteConfig)
+}
+
+// Update initiates an update to the external resource.
+func (e *muteConfigExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.MuteConfig)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotMuteConfig)
+	}
+	name := muteconfig.GetFullyQualifiedName(muteconfig.GetParent(e.projectID, cr.Spec.ForProvider), meta.GetExternalName(cr))
+	mc, err := e.scc.Projects.MuteConfigs.Get(name).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetMuteConfig)
+	}
+	_, err = e.scc.Projects.MuteConfigs.Patch(name, muteconfig.GenerateMuteConfig(name, cr.Spec.ForProvider)).
+		UpdateMask(muteconfig.GenerateUpdateMask(cr.Spec.ForProvider, *mc)).
+		Context(ctx).
+		Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateMuteConfig)
+}
+
+// Delete initiates an deletion of the external resource.
+func (e *muteConfigExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.MuteConfig)
+	if !ok {
+		return errors.New(errNotMuteConfig)
+	}
+	name := muteconfig.GetFullyQualifiedName(muteconfig.GetParent(e.projectID, cr.Spec.ForProvider), meta.GetExternalName(cr))
+	_, err := e.scc.Projects.MuteConfigs.Delete(name).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteMuteConfig)
+}
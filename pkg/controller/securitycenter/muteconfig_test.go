@@ -0,0 +1,361 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package securitycenter
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/option"
+	scc "google.golang.org/api/securitycenter/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/securitycenter/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	muteConfigName   = "my-mute-config"
+	muteConfigFilter = `category="OPEN_FIREWALL"`
+)
+
+type muteConfigOption func(*v1alpha1.MuteConfig)
+
+func newMuteConfig(opts ...muteConfigOption) *v1alpha1.MuteConfig {
+	mc := &v1alpha1.MuteConfig{}
+	meta.SetExternalName(mc, muteConfigName)
+	mc.Spec.ForProvider.Filter = muteConfigFilter
+	for _, f := range opts {
+		f(mc)
+	}
+	return mc
+}
+
+func TestMuteConfigObserve(t *testing.T) {
+	type args struct {
+		handler http.Handler
+		kube    client.Client
+		mg      resource.Managed
+	}
+
+	type want struct {
+		eo  managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"GetFailed": {
+			reason: "Should return error if getting the MuteConfig fails",
+			args: args{
+				handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					_ = r.Body.Close()
+					w.WriteHeader(http.StatusBadRequest)
+				}),
+				mg: newMuteConfig(),
+			},
+			want: want{
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetMuteConfig),
+			},
+		},
+		"NotFound": {
+			reason: "Should not return error if MuteConfig is not found",
+			args: args{
+				handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					_ = r.Body.Close()
+					w.WriteHeader(http.StatusNotFound)
+				}),
+				mg: newMuteConfig(),
+			},
+		},
+		"SpecUpdateFailed": {
+			reason: "Should fail if late initialized spec cannot be saved",
+			args: args{
+				handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					_ = r.Body.Close()
+					w.WriteHeader(http.StatusOK)
+					_ = json.NewEncoder(w).Encode(&scc.GoogleCloudSecuritycenterV1MuteConfig{
+						Description: "firewalls",
+						Filter:      muteConfigFilter,
+					})
+				}),
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(errBoom),
+				},
+				mg: newMuteConfig(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errKubeUpdateMuteConfig),
+			},
+		},
+		"Success": {
+			reason: "Should use the project of the ProviderConfig as default parent",
+			args: args{
+				handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					_ = r.Body.Close()
+					if diff := cmp.Diff("/v1/projects/fooproject/muteConfigs/my-mute-config", r.URL.Path); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					w.WriteHeader(http.StatusOK)
+					_ = json.NewEncoder(w).Encode(&scc.GoogleCloudSecuritycenterV1MuteConfig{Filter: muteConfigFilter})
+				}),
+				mg: newMuteConfig(),
+			},
+			want: want{
+				eo: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"OrganizationParent": {
+			reason: "Should look the MuteConfig up under the configured parent",
+			args: args{
+				handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					_ = r.Body.Close()
+					if diff := cmp.Diff("/v1/organizations/1234/muteConfigs/my-mute-config", r.URL.Path); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					w.WriteHeader(http.StatusOK)
+					_ = json.NewEncoder(w).Encode(&scc.GoogleCloudSecuritycenterV1MuteConfig{Filter: "severity=\"LOW\""})
+				}),
+				mg: newMuteConfig(func(mc *v1alpha1.MuteConfig) {
+					mc.Spec.ForProvider.Parent = gcp.StringPtr("organizations/1234")
+				}),
+			},
+			want: want{
+				eo: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.args.handler)
+			defer server.Close()
+			s, _ := scc.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := muteConfigExternal{
+				client:    tc.args.kube,
+				projectID: projectID,
+				scc:       s,
+			}
+			got, err := e.Observe(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.eo, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestMuteConfigCreate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		reason  string
+		handler http.Handler
+		want    want
+	}{
+		"Successful": {
+			reason: "Should pass the external name as mute config ID",
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				mc := &scc.GoogleCloudSecuritycenterV1MuteConfig{}
+				_ = json.NewDecoder(r.Body).Decode(mc)
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff("/v1/projects/fooproject/muteConfigs", r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff(muteConfigName, r.URL.Query().Get("muteConfigId")); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff(muteConfigFilter, mc.Filter); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&scc.GoogleCloudSecuritycenterV1MuteConfig{})
+			}),
+		},
+		"Failed": {
+			reason: "Should return an error if create fails",
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+			}),
+			want: want{
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateMuteConfig),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := scc.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := muteConfigExternal{
+				projectID: projectID,
+				scc:       s,
+			}
+			_, err := e.Create(context.Background(), newMuteConfig())
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nCreate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestMuteConfigUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		reason  string
+		handler http.Handler
+		want    want
+	}{
+		"Successful": {
+			reason: "Should patch only the fields that differ",
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				switch r.Method {
+				case http.MethodGet:
+					w.WriteHeader(http.StatusOK)
+					_ = json.NewEncoder(w).Encode(&scc.GoogleCloudSecuritycenterV1MuteConfig{Filter: "severity=\"LOW\""})
+				case http.MethodPatch:
+					if diff := cmp.Diff("filter", r.URL.Query().Get("updateMask")); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					w.WriteHeader(http.StatusOK)
+					_ = json.NewEncoder(w).Encode(&scc.GoogleCloudSecuritycenterV1MuteConfig{})
+				}
+			}),
+		},
+		"GetFailed": {
+			reason: "Should return an error if the MuteConfig cannot be fetched",
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+			}),
+			want: want{
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetMuteConfig),
+			},
+		},
+		"PatchFailed": {
+			reason: "Should return an error if patch fails",
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if r.Method == http.MethodGet {
+					w.WriteHeader(http.StatusOK)
+					_ = json.NewEncoder(w).Encode(&scc.GoogleCloudSecuritycenterV1MuteConfig{})
+					return
+				}
+				w.WriteHeader(http.StatusBadRequest)
+			}),
+			want: want{
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errUpdateMuteConfig),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := scc.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := muteConfigExternal{
+				projectID: projectID,
+				scc:       s,
+			}
+			_, err := e.Update(context.Background(), newMuteConfig())
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nUpdate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestMuteConfigDelete(t *testing.T) {
+	cases := map[string]struct {
+		reason  string
+		handler http.Handler
+		err     error
+	}{
+		"Successful": {
+			reason: "Should not return an error if delete succeeds",
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&scc.Empty{})
+			}),
+		},
+		"AlreadyGone": {
+			reason: "Should not return an error if the MuteConfig is already gone",
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+			}),
+		},
+		"Failed": {
+			reason: "Should return an error if delete fails",
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+			}),
+			err: errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteMuteConfig),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := scc.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := muteConfigExternal{
+				projectID: projectID,
+				scc:       s,
+			}
+			err := e.Delete(context.Background(), newMuteConfig())
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nDelete(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
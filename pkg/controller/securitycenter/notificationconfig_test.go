@@ -0,0 +1,273 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package securitycenter
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+	scc "google.golang.org/api/securitycenter/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/securitycenter/v1alpha1"
+)
+
+const (
+	projectID   = "fooproject"
+	configName  = "my-config"
+	pubsubTopic = "projects/fooproject/topics/my-topic"
+)
+
+var (
+	errBoom = errors.New("boom")
+)
+
+func gError(code int, message string) *googleapi.Error {
+	return &googleapi.Error{
+		Code:    code,
+		Body:    "",
+		Message: message,
+	}
+}
+
+type notificationConfigOption func(*v1alpha1.NotificationConfig)
+
+func newNotificationConfig(opts ...notificationConfigOption) *v1alpha1.NotificationConfig {
+	nc := &v1alpha1.NotificationConfig{}
+	meta.SetExternalName(nc, configName)
+	nc.Spec.ForProvider.PubsubTopic = pubsubTopic
+	for _, f := range opts {
+		f(nc)
+	}
+	return nc
+}
+
+func TestNotificationConfigObserve(t *testing.T) {
+	type args struct {
+		handler http.Handler
+		kube    client.Client
+		mg      resource.Managed
+	}
+
+	type want struct {
+		eo  managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"GetFailed": {
+			reason: "Should return error if getting the NotificationConfig fails",
+			args: args{
+				handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					_ = r.Body.Close()
+					w.WriteHeader(http.StatusBadRequest)
+				}),
+				mg: newNotificationConfig(),
+			},
+			want: want{
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetNotificationConfig),
+			},
+		},
+		"NotFound": {
+			reason: "Should not return error if NotificationConfig is not found",
+			args: args{
+				handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					_ = r.Body.Close()
+					w.WriteHeader(http.StatusNotFound)
+				}),
+				mg: newNotificationConfig(),
+			},
+		},
+		"SpecUpdateFailed": {
+			reason: "Should fail if late initialized spec cannot be saved",
+			args: args{
+				handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					_ = r.Body.Close()
+					w.WriteHeader(http.StatusOK)
+					_ = json.NewEncoder(w).Encode(&scc.NotificationConfig{
+						Description: "findings",
+						PubsubTopic: pubsubTopic,
+					})
+				}),
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(errBoom),
+				},
+				mg: newNotificationConfig(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errKubeUpdateNotificationConfig),
+			},
+		},
+		"Success": {
+			reason: "Should use the project of the ProviderConfig as default parent",
+			args: args{
+				handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					_ = r.Body.Close()
+					if diff := cmp.Diff("/v1/projects/fooproject/notificationConfigs/my-config", r.URL.Path); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					w.WriteHeader(http.StatusOK)
+					_ = json.NewEncoder(w).Encode(&scc.NotificationConfig{PubsubTopic: pubsubTopic})
+				}),
+				mg: newNotificationConfig(),
+			},
+			want: want{
+				eo: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.args.handler)
+			defer server.Close()
+			s, _ := scc.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := notificationConfigExternal{
+				client:    tc.args.kube,
+				projectID: projectID,
+				scc:       s,
+			}
+			got, err := e.Observe(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.eo, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestNotificationConfigCreate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		reason  string
+		handler http.Handler
+		want    want
+	}{
+		"Successful": {
+			reason: "Should pass the external name as config ID",
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff(configName, r.URL.Query().Get("configId")); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&scc.NotificationConfig{})
+			}),
+		},
+		"Failed": {
+			reason: "Should return an error if create fails",
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+			}),
+			want: want{
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateNotificationConfig),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := scc.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := notificationConfigExternal{
+				projectID: projectID,
+				scc:       s,
+			}
+			_, err := e.Create(context.Background(), newNotificationConfig())
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nCreate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestNotificationConfigDelete(t *testing.T) {
+	cases := map[string]struct {
+		reason  string
+		handler http.Handler
+		err     error
+	}{
+		"Successful": {
+			reason: "Should not return an error if delete succeeds",
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&scc.Empty{})
+			}),
+		},
+		"AlreadyGone": {
+			reason: "Should not return an error if the NotificationConfig is already gone",
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+			}),
+		},
+		"Failed": {
+			reason: "Should return an error if delete fails",
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+			}),
+			err: errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteNotificationConfig),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := scc.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := notificationConfigExternal{
+				projectID: projectID,
+				scc:       s,
+			}
+			err := e.Delete(context.Background(), newNotificationConfig())
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nDelete(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
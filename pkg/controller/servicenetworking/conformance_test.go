@@ -0,0 +1,48 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicenetworking
+
+import (
+	"testing"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/servicenetworking/v1beta1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/connection"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/conformance"
+)
+
+func TestConformance(t *testing.T) {
+	conformance.Run(t, []string{v1beta1.Group}, map[string]conformance.Fixture{
+		v1beta1.ConnectionGroupKind: {
+			Managed: func() resource.Managed {
+				return &v1beta1.Connection{Spec: v1beta1.ConnectionSpec{ForProvider: v1beta1.ConnectionParameters{
+					Parent:  "services/servicenetworking.googleapis.com",
+					Network: gcp.StringPtr("projects/project/global/networks/network"),
+				}}}
+			},
+			Connecter: func(kube client.Client) managed.ExternalConnecter { return &connector{client: kube} },
+			Identity:  "The connection is the peering of the network with the service producer.",
+			Found:     `{"connections":[{"peering":"` + connection.PeeringName + `"}]}`,
+			NotFound:  `{"connections":[]}`,
+		},
+	})
+}
@@ -22,6 +22,7 @@ import (
 	"path"
 
 	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/setup"
 	"github.com/crossplane-contrib/provider-gcp/pkg/features"
 
 	compute "google.golang.org/api/compute/v1"
@@ -31,7 +32,6 @@ import (
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	xpconnection "github.com/crossplane/crossplane-runtime/pkg/connection"
-	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	"github.com/crossplane/crossplane-runtime/pkg/errors"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
@@ -41,6 +41,7 @@ import (
 	"github.com/crossplane-contrib/provider-gcp/apis/servicenetworking/v1beta1"
 	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
 	"github.com/crossplane-contrib/provider-gcp/pkg/clients/connection"
+	"github.com/crossplane-contrib/provider-gcp/pkg/tracing"
 )
 
 // Error strings.
@@ -76,7 +77,7 @@ const (
 
 // SetupConnection adds a controller that reconciles Connection
 // managed resources.
-func SetupConnection(mgr ctrl.Manager, o controller.Options) error {
+func SetupConnection(mgr ctrl.Manager, o setup.Options) error {
 	name := managed.ControllerName(v1beta1.ConnectionGroupKind)
 
 	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
@@ -84,24 +85,26 @@ func SetupConnection(mgr ctrl.Manager, o controller.Options) error {
 		cps = append(cps, xpconnection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, xpconnection.WithTLSConfig(o.ESSOptions.TLSConfig)))
 	}
 
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(v1beta1.ConnectionGroupVersionKind),
-		managed.WithExternalConnecter(&connector{client: mgr.GetClient()}),
+		managed.WithExternalConnecter(setup.Connecter(mgr, o, v1beta1.ConnectionGroupKind, &connector{client: mgr.GetClient(), record: recorder})),
 		managed.WithConnectionPublishers(),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
-		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithRecorder(recorder),
 		managed.WithConnectionPublishers(cps...))
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(o.ForControllerRuntime()).
 		For(&v1beta1.Connection{}).
-		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+		Complete(tracing.NewReconciler(name, ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter)))
 }
 
 type connector struct {
 	client client.Client
+	record event.Recorder
 }
 
 func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
@@ -116,13 +119,14 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	}
 
 	sn, err := servicenetworking.NewService(ctx, opts...)
-	return &external{sn: sn, compute: cmp, projectID: projectID}, errors.Wrap(err, errNewClient)
+	return &external{sn: sn, compute: cmp, projectID: projectID, record: c.record}, errors.Wrap(err, errNewClient)
 }
 
 type external struct {
 	compute   *compute.Service
 	sn        *servicenetworking.APIService
 	projectID string
+	record    event.Recorder
 }
 
 func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -175,8 +179,15 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 	// if we're creating a connection in a VPC whose name had been used
 	// before. It doesn't return error either, so, we just use this hack
 	// found in https://github.com/terraform-providers/terraform-provider-google-beta/blob/67b258a/google-beta/resource_service_networking_connection.go#L86
-	_, err := e.sn.Services.Connections.Patch(cn.Spec.ForProvider.Parent+"/connections/-", conn).UpdateMask("reservedPeeringRanges").Force(true).Context(ctx).Do()
-	return managed.ExternalCreation{}, errors.Wrap(resource.Ignore(gcp.IsErrorAlreadyExists, err), errCreateConnection)
+	// A conflict is reported rather than ignored, like it is for every other
+	// managed resource. If the connection is ours it will be observed on the
+	// next reconcile.
+	op, err := e.sn.Services.Connections.Patch(cn.Spec.ForProvider.Parent+"/connections/-", conn).UpdateMask("reservedPeeringRanges").Force(true).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateConnection)
+	}
+	gcp.RecordOperation(e.record, cn, "create", op.Name)
+	return managed.ExternalCreation{}, nil
 }
 
 func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
@@ -187,8 +198,12 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 
 	name := fmt.Sprintf("%s/connections/%s", cn.Spec.ForProvider.Parent, connection.PeeringName)
 	conn := connection.FromParameters(cn.Spec.ForProvider)
-	_, err := e.sn.Services.Connections.Patch(name, conn).Context(ctx).Do()
-	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateConnection)
+	op, err := e.sn.Services.Connections.Patch(name, conn).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateConnection)
+	}
+	gcp.RecordOperation(e.record, cn, "update", op.Name)
+	return managed.ExternalUpdate{}, nil
 }
 
 func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
@@ -199,6 +214,10 @@ func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
 
 	cn.Status.SetConditions(xpv1.Deleting())
 	rm := &compute.NetworksRemovePeeringRequest{Name: cn.Status.AtProvider.Peering}
-	_, err := e.compute.Networks.RemovePeering(e.projectID, path.Base(gcp.StringValue(cn.Spec.ForProvider.Network)), rm).Context(ctx).Do()
-	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteConnection)
+	op, err := e.compute.Networks.RemovePeering(e.projectID, path.Base(gcp.StringValue(cn.Spec.ForProvider.Network)), rm).Context(ctx).Do()
+	if err != nil {
+		return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteConnection)
+	}
+	gcp.RecordOperation(e.record, cn, "delete", op.Name)
+	return nil
 }
@@ -0,0 +1,61 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package setup contains helpers shared by the setup functions of GCP managed
+// resource controllers.
+package setup
+
+import (
+	"time"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane-contrib/provider-gcp/pkg/apierror"
+	"github.com/crossplane-contrib/provider-gcp/pkg/dryrun"
+	"github.com/crossplane-contrib/provider-gcp/pkg/expectation"
+	"github.com/crossplane-contrib/provider-gcp/pkg/preflight"
+	"github.com/crossplane-contrib/provider-gcp/pkg/projectstate"
+	"github.com/crossplane-contrib/provider-gcp/pkg/tracing"
+)
+
+// Options configure the controllers of GCP managed resources.
+type Options struct {
+	controller.Options
+
+	// BatchObserveTTL is how long a listing of Buckets or Topics is used
+	// before their project is listed again, if batch observation is enabled.
+	BatchObserveTTL time.Duration
+
+	// ProjectStateBackoff is how long GCP is not called for resources whose
+	// project was found to be unusable, if the project state check is
+	// enabled.
+	ProjectStateBackoff time.Duration
+
+	// DiscoveryInterval is how often the project of a ProviderConfig is
+	// listed again to discover new resources, if discovery is enabled.
+	DiscoveryInterval time.Duration
+}
+
+// Connecter wraps the supplied ExternalConnecter of the supplied kind, from
+// the outside in, with tracing, dry-run mode, the expectation cache, the
+// project state check, the permission check and explanations of GCP errors.
+// Each wrapper but the last is only added if it is enabled.
+func Connecter(mgr ctrl.Manager, o Options, gk string, c managed.ExternalConnecter) managed.ExternalConnecter {
+	return tracing.WithTracing(gk, dryrun.WithDryRun(o.Options, gk, expectation.WithExpectations(mgr, o.Options, projectstate.WithProjectStateCheck(mgr, o.Options, o.ProjectStateBackoff, gk, preflight.WithPermissionCheck(mgr, o.Options, gk, apierror.WithExplanations(c))))))
+}
@@ -18,16 +18,19 @@ package storage
 
 import (
 	"context"
+	"fmt"
 
 	"cloud.google.com/go/storage"
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/imdario/mergo"
+	"google.golang.org/api/iterator"
+	corev1 "k8s.io/api/core/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/connection"
-	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	"github.com/crossplane/crossplane-runtime/pkg/errors"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
@@ -37,8 +40,11 @@ import (
 
 	"github.com/crossplane-contrib/provider-gcp/apis/storage/v1alpha3"
 	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-gcp/pkg/batch"
 	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/setup"
 	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+	"github.com/crossplane-contrib/provider-gcp/pkg/tracing"
 )
 
 // Error strings.
@@ -50,10 +56,13 @@ const (
 	errCreate    = "cannot create GCP bucket"
 	errUpdate    = "cannot update GCP bucket"
 	errDelete    = "cannot delete GCP bucket"
+
+	errPerimeterBlocked   = "request was blocked by a VPC Service Controls perimeter; allow the identity of the provider to reach storage.googleapis.com with an ingress rule or access level of the perimeter"
+	errPerimeterBlockedID = " (vpcServiceControlsUniqueIdentifier: %s)"
 )
 
 // SetupBucket adds a controller that reconciles Buckets.
-func SetupBucket(mgr ctrl.Manager, o controller.Options) error {
+func SetupBucket(mgr ctrl.Manager, o setup.Options) error {
 	name := managed.ControllerName(v1alpha3.BucketGroupKind)
 
 	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
@@ -61,9 +70,14 @@ func SetupBucket(mgr ctrl.Manager, o controller.Options) error {
 		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
 	}
 
+	var buckets *batch.Lister[*storage.BucketAttrs]
+	if o.Features.Enabled(features.EnableAlphaBatchObserve) {
+		buckets = batch.NewLister[*storage.BucketAttrs](o.BatchObserveTTL)
+	}
+
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(v1alpha3.BucketGroupVersionKind),
-		managed.WithExternalConnecter(&connecter{client: mgr.GetClient()}),
+		managed.WithExternalConnecter(setup.Connecter(mgr, o, v1alpha3.BucketGroupKind, &connecter{client: mgr.GetClient(), buckets: buckets})),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
@@ -73,7 +87,7 @@ func SetupBucket(mgr ctrl.Manager, o controller.Options) error {
 		Named(name).
 		WithOptions(o.ForControllerRuntime()).
 		For(&v1alpha3.Bucket{}).
-		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+		Complete(tracing.NewReconciler(name, ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter)))
 }
 
 // A BucketClient produces a BucketHandler for the named bucket.
@@ -88,7 +102,7 @@ type GCSBucketClient struct {
 
 // Bucket produces a BucketHandler for the named bucket.
 func (sbc *GCSBucketClient) Bucket(name string) BucketHandler {
-	return sbc.c.Bucket(name)
+	return &gcsBucketHandle{BucketHandle: sbc.c.Bucket(name)}
 }
 
 // A BucketHandler handles requests to interact with buckets.
@@ -97,10 +111,23 @@ type BucketHandler interface {
 	Create(context.Context, string, *storage.BucketAttrs) error
 	Update(context.Context, storage.BucketAttrsToUpdate) (*storage.BucketAttrs, error)
 	Delete(context.Context) error
+	If(storage.BucketConditions) BucketHandler
+}
+
+// A gcsBucketHandle wraps the GCS storage.BucketHandle as a BucketHandler.
+type gcsBucketHandle struct {
+	*storage.BucketHandle
+}
+
+// If returns a BucketHandler whose requests are made subject to the supplied
+// preconditions.
+func (h *gcsBucketHandle) If(conds storage.BucketConditions) BucketHandler {
+	return &gcsBucketHandle{BucketHandle: h.BucketHandle.If(conds)}
 }
 
 type connecter struct {
-	client client.Client
+	client  client.Client
+	buckets *batch.Lister[*storage.BucketAttrs]
 }
 
 // Connect sets up iam client using credentials from the provider
@@ -115,13 +142,51 @@ func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.E
 		return nil, err
 	}
 
-	return &external{handle: &GCSBucketClient{c: s}, projectID: projectID, client: c.client}, errors.Wrap(err, errNewClient)
+	return &external{handle: &GCSBucketClient{c: s}, projectID: projectID, client: c.client, buckets: c.buckets, list: listBuckets(s, projectID)}, errors.Wrap(err, errNewClient)
+}
+
+// listBuckets returns a ListFn that lists every bucket of the supplied
+// project, keyed by name.
+func listBuckets(c *storage.Client, project string) batch.ListFn[*storage.BucketAttrs] {
+	return func(ctx context.Context) (map[string]*storage.BucketAttrs, error) {
+		buckets := map[string]*storage.BucketAttrs{}
+		it := c.Buckets(ctx, project)
+		for {
+			a, err := it.Next()
+			if errors.Is(err, iterator.Done) {
+				return buckets, nil
+			}
+			if err != nil {
+				return nil, err
+			}
+			buckets[a.Name] = a
+		}
+	}
 }
 
 type external struct {
 	handle    BucketClient
 	projectID string
 	client    client.Client
+
+	// buckets serves bucket attributes from a listing of the project using
+	// list, if set.
+	buckets *batch.Lister[*storage.BucketAttrs]
+	list    batch.ListFn[*storage.BucketAttrs]
+}
+
+func (e *external) attrs(ctx context.Context, name string) (*storage.BucketAttrs, error) {
+	get := e.handle.Bucket(name).Attrs
+	if e.buckets == nil {
+		return get(ctx)
+	}
+	return e.buckets.Get(ctx, e.projectID, name, e.list, get)
+}
+
+func (e *external) changed(name string) {
+	if e.buckets != nil {
+		e.buckets.Changed(e.projectID, name)
+	}
 }
 
 func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -130,14 +195,14 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{}, errors.New(errNotBucket)
 	}
 
-	a, err := e.handle.Bucket(meta.GetExternalName(cr)).Attrs(ctx)
+	a, err := e.attrs(ctx, meta.GetExternalName(cr))
 	// NOTE(negz): The storage client appears to intercept the typical GCP API
 	// error that we check for with gcp.IsErrorNotFound and return this error
 	// instead, but only when getting bucket attributes.
 	if errors.Is(err, storage.ErrBucketNotExist) {
 		return managed.ExternalObservation{ResourceExists: false}, nil
 	}
-	if err != nil {
+	if err := perimeter(cr, err); err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(err, errAttrs)
 	}
 
@@ -157,18 +222,32 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 
 	return managed.ExternalObservation{
 		ResourceExists:   true,
-		ResourceUpToDate: cmp.Equal(v1alpha3.NewBucketUpdatableAttrs(a), &cr.Spec.BucketUpdatableAttrs),
+		ResourceUpToDate: isUpToDate(cr.Spec.BucketUpdatableAttrs, a),
 	}, nil
 }
 
+// isUpToDate returns true if the supplied bucket attributes match the desired
+// updatable attributes. CORS entries are compared in their GCS representation
+// so that the order of origins, methods and headers within an entry, and the
+// field used to express max age, do not register as drift.
+func isUpToDate(spec v1alpha3.BucketUpdatableAttrs, a *storage.BucketAttrs) bool {
+	if !cmp.Equal(v1alpha3.CopyToCORSList(spec.CORS), a.CORS, cmpopts.EquateEmpty(), cmpopts.SortSlices(func(x, y string) bool { return x < y })) {
+		return false
+	}
+	observed := v1alpha3.NewBucketUpdatableAttrs(a)
+	observed.CORS, spec.CORS = nil, nil
+	return cmp.Equal(observed, &spec)
+}
+
 func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
 	cr, ok := mg.(*v1alpha3.Bucket)
 	if !ok {
 		return managed.ExternalCreation{}, errors.New(errNotBucket)
 	}
 
+	e.changed(meta.GetExternalName(cr))
 	err := e.handle.Bucket(meta.GetExternalName(cr)).Create(ctx, e.projectID, v1alpha3.CopyBucketSpecAttrs(&cr.Spec.BucketSpecAttrs))
-	return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	return managed.ExternalCreation{}, errors.Wrap(perimeter(cr, err), errCreate)
 }
 
 func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
@@ -177,14 +256,22 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalUpdate{}, errors.New(errNotBucket)
 	}
 
-	current, err := e.handle.Bucket(meta.GetExternalName(cr)).Attrs(ctx)
-	if err != nil {
-		return managed.ExternalUpdate{}, errors.Wrap(err, errAttrs)
-	}
-	ua := v1alpha3.CopyToBucketUpdateAttrs(cr.Spec.BucketUpdatableAttrs, current.Labels)
-	_, err = e.handle.Bucket(meta.GetExternalName(cr)).Update(ctx, ua)
+	e.changed(meta.GetExternalName(cr))
 
-	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+	// The update is conditioned on the metageneration that was read, so that
+	// labels added by somebody else in the meantime are not dropped.
+	err := gcp.RetryOnConflict(func() error {
+		current, err := e.handle.Bucket(meta.GetExternalName(cr)).Attrs(ctx)
+		if err != nil {
+			return errors.Wrap(err, errAttrs)
+		}
+		ua := v1alpha3.CopyToBucketUpdateAttrs(cr.Spec.BucketUpdatableAttrs, current.Labels)
+		_, err = e.handle.Bucket(meta.GetExternalName(cr)).
+			If(storage.BucketConditions{MetagenerationMatch: current.MetaGeneration}).
+			Update(ctx, ua)
+		return errors.Wrap(err, errUpdate)
+	})
+	return managed.ExternalUpdate{}, perimeter(cr, err)
 }
 
 func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
@@ -193,6 +280,27 @@ func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
 		return errors.New(errNotBucket)
 	}
 
+	e.changed(meta.GetExternalName(cr))
 	err := e.handle.Bucket(meta.GetExternalName(cr)).Delete(ctx)
-	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDelete)
+	return errors.Wrap(perimeter(cr, resource.Ignore(gcp.IsErrorNotFound, err)), errDelete)
+}
+
+// perimeter explains the supplied error, and sets the PerimeterAllowed
+// condition of the supplied bucket to false, if the request that caused it
+// was blocked by a VPC Service Controls perimeter. GCS reports these as a
+// generic 403, which is easily mistaken for missing IAM permissions. The
+// condition is set to true again once a request succeeds.
+func perimeter(cr *v1alpha3.Bucket, err error) error {
+	if gcp.IsErrorVPCServiceControls(err) {
+		msg := errPerimeterBlocked
+		if id := gcp.VPCServiceControlsID(err); id != "" {
+			msg += fmt.Sprintf(errPerimeterBlockedID, id)
+		}
+		cr.SetConditions(v1alpha3.PerimeterBlocked(msg))
+		return errors.Wrap(err, msg)
+	}
+	if c := cr.GetCondition(v1alpha3.TypePerimeterAllowed); err == nil && c.Status == corev1.ConditionFalse {
+		cr.SetConditions(v1alpha3.PerimeterAllowed())
+	}
+	return err
 }
@@ -18,12 +18,18 @@ package storage
 
 import (
 	"context"
+	"fmt"
+	"net/http"
 	"testing"
+	"time"
 
 	"cloud.google.com/go/storage"
 	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/googleapi"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/errors"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
@@ -45,6 +51,7 @@ type MockBucketHandler struct {
 	MockCreate func(context.Context, string, *storage.BucketAttrs) error
 	MockUpdate func(context.Context, storage.BucketAttrsToUpdate) (*storage.BucketAttrs, error)
 	MockDelete func(context.Context) error
+	MockIf     func(storage.BucketConditions) BucketHandler
 }
 
 func (m *MockBucketHandler) Attrs(ctx context.Context) (*storage.BucketAttrs, error) {
@@ -63,6 +70,39 @@ func (m *MockBucketHandler) Delete(ctx context.Context) error {
 	return m.MockDelete(ctx)
 }
 
+func (m *MockBucketHandler) If(conds storage.BucketConditions) BucketHandler {
+	if m.MockIf == nil {
+		return m
+	}
+	return m.MockIf(conds)
+}
+
+var maxAge = int64(60)
+
+var errPerimeter = &googleapi.Error{
+	Code:    http.StatusForbidden,
+	Message: "Request is prohibited by organization's policy. vpcServiceControlsUniqueIdentifier: Ab1cD2",
+	Errors:  []googleapi.ErrorItem{{Reason: "vpcServiceControls"}},
+}
+
+type bucketModifier func(*v1alpha3.Bucket)
+
+func withCORS(c ...v1alpha3.CORS) bucketModifier {
+	return func(b *v1alpha3.Bucket) { b.Spec.CORS = c }
+}
+
+func withConditions(c ...xpv1.Condition) bucketModifier {
+	return func(b *v1alpha3.Bucket) { b.SetConditions(c...) }
+}
+
+func bucket(m ...bucketModifier) *v1alpha3.Bucket {
+	cr := &v1alpha3.Bucket{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
 func TestObserve(t *testing.T) {
 	errBoom := errors.New("boom")
 
@@ -125,6 +165,20 @@ func TestObserve(t *testing.T) {
 				err: errors.Wrap(errBoom, errAttrs),
 			},
 		},
+		"PerimeterBlocked": {
+			reason: "Errors caused by a VPC Service Controls perimeter should be explained",
+			fields: fields{
+				handle: &MockBucketClient{&MockBucketHandler{
+					MockAttrs: func(context.Context) (*storage.BucketAttrs, error) { return nil, errPerimeter },
+				}},
+			},
+			args: args{
+				mg: &v1alpha3.Bucket{},
+			},
+			want: want{
+				err: errors.Wrap(errors.Wrap(errPerimeter, errPerimeterBlocked+fmt.Sprintf(errPerimeterBlockedID, "Ab1cD2")), errAttrs),
+			},
+		},
 		"UpdateError": {
 			reason: "Observing a bucket successfully should return an ExternalObservation and nil error",
 			fields: fields{
@@ -163,6 +217,54 @@ func TestObserve(t *testing.T) {
 				err: nil,
 			},
 		},
+		"CORSUpToDate": {
+			reason: "CORS entries that differ only in ordering and max age representation should be considered up to date",
+			fields: fields{
+				handle: &MockBucketClient{&MockBucketHandler{
+					MockAttrs: func(context.Context) (*storage.BucketAttrs, error) {
+						return &storage.BucketAttrs{CORS: []storage.CORS{{
+							MaxAge:  time.Hour,
+							Methods: []string{"POST", "GET"},
+							Origins: []string{"https://example.com"},
+						}}}, nil
+					},
+				}},
+			},
+			args: args{
+				mg: bucket(withCORS(v1alpha3.CORS{
+					MaxAge:  metav1.Duration{Duration: time.Hour},
+					Methods: []string{"GET", "POST"},
+					Origins: []string{"https://example.com"},
+				})),
+			},
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"CORSDrift": {
+			reason: "A CORS entry that differs from the desired one should be reported as drift",
+			fields: fields{
+				handle: &MockBucketClient{&MockBucketHandler{
+					MockAttrs: func(context.Context) (*storage.BucketAttrs, error) {
+						return &storage.BucketAttrs{CORS: []storage.CORS{{
+							MaxAge:  time.Hour,
+							Methods: []string{"GET"},
+							Origins: []string{"https://example.com"},
+						}}}, nil
+					},
+				}},
+			},
+			args: args{
+				mg: bucket(withCORS(v1alpha3.CORS{
+					MaxAgeSeconds: &maxAge,
+					Methods:       []string{"GET"},
+					Origins:       []string{"https://example.com"},
+				})),
+			},
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -318,6 +420,35 @@ func TestUpdate(t *testing.T) {
 				err: errors.Wrap(errBoom, errUpdate),
 			},
 		},
+		"StaleMetageneration": {
+			reason: "Updates rejected because the bucket changed since it was read should be retried",
+			fields: fields{
+				handle: func() BucketClient {
+					metageneration := int64(0)
+					h := &MockBucketHandler{
+						MockAttrs: func(context.Context) (*storage.BucketAttrs, error) {
+							metageneration++
+							return &storage.BucketAttrs{MetaGeneration: metageneration}, nil
+						},
+					}
+					h.MockIf = func(conds storage.BucketConditions) BucketHandler {
+						return &MockBucketHandler{
+							MockUpdate: func(context.Context, storage.BucketAttrsToUpdate) (*storage.BucketAttrs, error) {
+								if conds.MetagenerationMatch < 2 {
+									return nil, &googleapi.Error{Code: http.StatusPreconditionFailed}
+								}
+								return nil, nil
+							},
+						}
+					}
+					return &MockBucketClient{h}
+				}(),
+			},
+			args: args{
+				mg: &v1alpha3.Bucket{},
+			},
+			want: want{},
+		},
 		"Success": {
 			reason: "Updating a bucket successfully should return an empty ExternalUpdate and nil error",
 			fields: fields{
@@ -410,3 +541,44 @@ func TestDelete(t *testing.T) {
 		})
 	}
 }
+
+func TestPerimeter(t *testing.T) {
+	blocked := v1alpha3.PerimeterBlocked(errPerimeterBlocked + fmt.Sprintf(errPerimeterBlockedID, "Ab1cD2"))
+	cases := map[string]struct {
+		reason string
+		cr     *v1alpha3.Bucket
+		err    error
+		want   *v1alpha3.Bucket
+	}{
+		"Blocked": {
+			reason: "A request blocked by a perimeter should be reported using the PerimeterAllowed condition",
+			cr:     bucket(),
+			err:    errPerimeter,
+			want:   bucket(withConditions(blocked)),
+		},
+		"OtherError": {
+			reason: "Other errors should not change the PerimeterAllowed condition",
+			cr:     bucket(withConditions(blocked)),
+			err:    errors.New("boom"),
+			want:   bucket(withConditions(blocked)),
+		},
+		"Unblocked": {
+			reason: "A successful request should report a previously blocked bucket is no longer blocked",
+			cr:     bucket(withConditions(blocked)),
+			want:   bucket(withConditions(v1alpha3.PerimeterAllowed())),
+		},
+		"NeverBlocked": {
+			reason: "A successful request should not add a PerimeterAllowed condition",
+			cr:     bucket(),
+			want:   bucket(),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_ = perimeter(tc.cr, tc.err)
+			if diff := cmp.Diff(tc.want, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("\n%s\nperimeter(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,227 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/storage/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/storage/v1alpha1"
+	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/bucketobject"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/setup"
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+	"github.com/crossplane-contrib/provider-gcp/pkg/tracing"
+)
+
+const (
+	errNotBucketObject        = "managed resource is not a GCP BucketObject"
+	errGetBucketObject        = "cannot get BucketObject"
+	errUploadBucketObject     = "cannot upload BucketObject"
+	errPatchBucketObject      = "cannot update BucketObject metadata"
+	errDeleteBucketObject     = "cannot delete BucketObject"
+	errKubeUpdateBucketObject = "cannot update BucketObject custom resource"
+	errGetContentSecret       = "cannot get content Secret"
+	errGetContentConfigMap    = "cannot get content ConfigMap"
+	errContentKeyNotFound     = "key %q not found"
+)
+
+// SetupBucketObject adds a controller that reconciles BucketObjects.
+func SetupBucketObject(mgr ctrl.Manager, o setup.Options) error {
+	name := managed.ControllerName(v1alpha1.BucketObjectGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.BucketObjectGroupVersionKind),
+		managed.WithExternalConnecter(setup.Connecter(mgr, o, v1alpha1.BucketObjectGroupKind, &bucketObjectConnecter{client: mgr.GetClient()})),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.BucketObject{}).
+		Complete(tracing.NewReconciler(name, ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter)))
+}
+
+type bucketObjectConnecter struct {
+	client client.Client
+}
+
+// Connect sets up storage client using credentials from the provider
+func (c *bucketObjectConnecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	_, opts, err := gcp.GetConnectionInfo(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := storage.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &bucketObjectExternal{kube: c.client, objects: s.Objects}, nil
+}
+
+type bucketObjectExternal struct {
+	kube    client.Client
+	objects *storage.ObjectsService
+}
+
+func (e *bucketObjectExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.BucketObject)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotBucketObject)
+	}
+	observed, err := e.objects.Get(gcp.StringValue(cr.Spec.ForProvider.Bucket), meta.GetExternalName(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetBucketObject)
+	}
+	content, err := e.content(ctx, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	bucketobject.LateInitialize(&cr.Spec.ForProvider, *observed)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errKubeUpdateBucketObject)
+		}
+	}
+	cr.Status.AtProvider = bucketobject.GenerateObservation(*observed)
+	cr.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: bucketobject.IsUpToDate(cr.Spec.ForProvider, content, *observed),
+	}, nil
+}
+
+func (e *bucketObjectExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.BucketObject)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotBucketObject)
+	}
+	cr.SetConditions(xpv1.Creating())
+	return managed.ExternalCreation{}, e.upload(ctx, cr)
+}
+
+func (e *bucketObjectExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.BucketObject)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotBucketObject)
+	}
+	bucket, name := gcp.StringValue(cr.Spec.ForProvider.Bucket), meta.GetExternalName(cr)
+	observed, err := e.objects.Get(bucket, name).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetBucketObject)
+	}
+	content, err := e.content(ctx, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	// Content can only be replaced by uploading a new generation of the
+	// object, while metadata can be patched in place.
+	if !bucketobject.IsContentUpToDate(content, *observed) {
+		return managed.ExternalUpdate{}, e.upload(ctx, cr)
+	}
+	obj := bucketobject.GenerateObject(name, cr.Spec.ForProvider)
+	// Send an empty Cache-Control so that removing it from spec clears it.
+	obj.ForceSendFields = []string{"CacheControl"}
+	_, err = e.objects.Patch(bucket, name, obj).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errPatchBucketObject)
+}
+
+func (e *bucketObjectExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.BucketObject)
+	if !ok {
+		return errors.New(errNotBucketObject)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	err := e.objects.Delete(gcp.StringValue(cr.Spec.ForProvider.Bucket), meta.GetExternalName(cr)).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteBucketObject)
+}
+
+// upload writes the content and metadata of the object.
+func (e *bucketObjectExternal) upload(ctx context.Context, cr *v1alpha1.BucketObject) error {
+	content, err := e.content(ctx, cr.Spec.ForProvider)
+	if err != nil {
+		return err
+	}
+	obj := bucketobject.GenerateObject(meta.GetExternalName(cr), cr.Spec.ForProvider)
+	opts := []googleapi.MediaOption{}
+	if obj.ContentType != "" {
+		opts = append(opts, googleapi.ContentType(obj.ContentType))
+	}
+	_, err = e.objects.Insert(obj.Bucket, obj).Media(bytes.NewReader(content), opts...).Context(ctx).Do()
+	return errors.Wrap(err, errUploadBucketObject)
+}
+
+// content returns the desired content of the object, reading it from a
+// Secret or ConfigMap if it is not given inline.
+func (e *bucketObjectExternal) content(ctx context.Context, p v1alpha1.BucketObjectParameters) ([]byte, error) {
+	switch {
+	case p.ContentSecretRef != nil:
+		ref := p.ContentSecretRef
+		s := &corev1.Secret{}
+		if err := e.kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s); err != nil {
+			return nil, errors.Wrap(err, errGetContentSecret)
+		}
+		v, ok := s.Data[ref.Key]
+		if !ok {
+			return nil, errors.Wrap(errors.Errorf(errContentKeyNotFound, ref.Key), errGetContentSecret)
+		}
+		return v, nil
+	case p.ContentConfigMapRef != nil:
+		ref := p.ContentConfigMapRef
+		cm := &corev1.ConfigMap{}
+		if err := e.kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, cm); err != nil {
+			return nil, errors.Wrap(err, errGetContentConfigMap)
+		}
+		if v, ok := cm.Data[ref.Key]; ok {
+			return []byte(v), nil
+		}
+		if v, ok := cm.BinaryData[ref.Key]; ok {
+			return v, nil
+		}
+		return nil, errors.Wrap(errors.Errorf(errContentKeyNotFound, ref.Key), errGetContentConfigMap)
+	default:
+		return []byte(gcp.StringValue(p.Content)), nil
+	}
+}
@@ -0,0 +1,175 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/option"
+	storagev1 "google.golang.org/api/storage/v1"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/storage/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	boContent = "{\"issuer\":\"https://example.com\"}"
+	// boMD5 is the base64 encoded MD5 hash of boContent.
+	boMD5 = "vLzTGLGOT3DcbLO13PTAEg=="
+)
+
+func bucketObject(m ...func(*v1alpha1.BucketObject)) *v1alpha1.BucketObject {
+	bo := &v1alpha1.BucketObject{}
+	meta.SetExternalName(bo, ".well-known/openid-configuration")
+	bo.Spec.ForProvider = v1alpha1.BucketObjectParameters{
+		Bucket: gcp.StringPtr("oidc"),
+		ContentConfigMapRef: &v1alpha1.ConfigMapKeySelector{
+			Name:      "oidc",
+			Namespace: "crossplane-system",
+			Key:       "openid-configuration",
+		},
+		ContentType: gcp.StringPtr("application/json"),
+	}
+	for _, f := range m {
+		f(bo)
+	}
+	return bo
+}
+
+func observedObject() *storagev1.Object {
+	return &storagev1.Object{
+		Name:        ".well-known/openid-configuration",
+		Bucket:      "oidc",
+		ContentType: "application/json",
+		Md5Hash:     boMD5,
+	}
+}
+
+func configMapClient(data map[string]string) client.Client {
+	return &test.MockClient{
+		MockGet: func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+			if diff := cmp.Diff(client.ObjectKey{Namespace: "crossplane-system", Name: "oidc"}, key); diff != "" {
+				return errors.New(diff)
+			}
+			obj.(*corev1.ConfigMap).Data = data
+			return nil
+		},
+	}
+}
+
+func TestBucketObjectObserve(t *testing.T) {
+	type want struct {
+		eo  managed.ExternalObservation
+		err error
+	}
+	cases := map[string]struct {
+		kube client.Client
+		mg   *v1alpha1.BucketObject
+		want want
+	}{
+		"UpToDate": {
+			kube: configMapClient(map[string]string{"openid-configuration": boContent}),
+			mg:   bucketObject(),
+			want: want{eo: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+		},
+		"ContentChanged": {
+			kube: configMapClient(map[string]string{"openid-configuration": "{}"}),
+			mg:   bucketObject(),
+			want: want{eo: managed.ExternalObservation{ResourceExists: true}},
+		},
+		"KeyNotFound": {
+			kube: configMapClient(map[string]string{}),
+			mg:   bucketObject(),
+			want: want{err: errors.Wrap(errors.Errorf(errContentKeyNotFound, "openid-configuration"), errGetContentConfigMap)},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff("/b/oidc/o/.well-known/openid-configuration", r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(observedObject())
+			}))
+			defer server.Close()
+			s, _ := storagev1.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := bucketObjectExternal{kube: tc.kube, objects: s.Objects}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.eo, got); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestBucketObjectUpdate(t *testing.T) {
+	cases := map[string]struct {
+		content    string
+		wantMethod string
+	}{
+		"ContentChanged": {
+			content:    "{}",
+			wantMethod: http.MethodPost,
+		},
+		"MetadataChanged": {
+			content:    boContent,
+			wantMethod: http.MethodPatch,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var gotMethod string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if r.Method != http.MethodGet {
+					gotMethod = r.Method
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(observedObject())
+			}))
+			defer server.Close()
+			s, _ := storagev1.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := bucketObjectExternal{kube: configMapClient(map[string]string{"openid-configuration": tc.content}), objects: s.Objects}
+			mg := bucketObject(func(bo *v1alpha1.BucketObject) {
+				bo.Spec.ForProvider.CacheControl = gcp.StringPtr("public, max-age=3600")
+			})
+			if _, err := e.Update(context.Background(), mg); err != nil {
+				t.Fatalf("Update(...): unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantMethod, gotMethod); diff != "" {
+				t.Errorf("Update(...): -want method, +got method:\n%s", diff)
+			}
+		})
+	}
+}
@@ -25,7 +25,6 @@ import (
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/connection"
-	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	"github.com/crossplane/crossplane-runtime/pkg/errors"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
@@ -37,7 +36,9 @@ import (
 	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
 	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
 	"github.com/crossplane-contrib/provider-gcp/pkg/clients/bucketpolicy"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/setup"
 	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+	"github.com/crossplane-contrib/provider-gcp/pkg/tracing"
 )
 
 const (
@@ -48,7 +49,7 @@ const (
 )
 
 // SetupBucketPolicy adds a controller that reconciles BucketPolicys.
-func SetupBucketPolicy(mgr ctrl.Manager, o controller.Options) error {
+func SetupBucketPolicy(mgr ctrl.Manager, o setup.Options) error {
 	name := managed.ControllerName(v1alpha1.BucketPolicyGroupKind)
 
 	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
@@ -58,7 +59,7 @@ func SetupBucketPolicy(mgr ctrl.Manager, o controller.Options) error {
 
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(v1alpha1.BucketPolicyGroupVersionKind),
-		managed.WithExternalConnecter(&bucketPolicyConnecter{client: mgr.GetClient()}),
+		managed.WithExternalConnecter(setup.Connecter(mgr, o, v1alpha1.BucketPolicyGroupKind, &bucketPolicyConnecter{client: mgr.GetClient()})),
 		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
@@ -69,7 +70,7 @@ func SetupBucketPolicy(mgr ctrl.Manager, o controller.Options) error {
 		Named(name).
 		WithOptions(o.ForControllerRuntime()).
 		For(&v1alpha1.BucketPolicy{}).
-		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+		Complete(tracing.NewReconciler(name, ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter)))
 }
 
 type bucketPolicyConnecter struct {
@@ -144,26 +145,27 @@ func (e *bucketPolicyExternal) Update(ctx context.Context, mg resource.Managed)
 	if !ok {
 		return managed.ExternalUpdate{}, errors.New(errNotBucketPolicy)
 	}
-	instance, err := e.bucketpolicy.GetIamPolicy(gcp.StringValue(cr.Spec.ForProvider.Bucket)).OptionsRequestedPolicyVersion(iamv1alpha1.PolicyVersion).Context(ctx).Do()
-	if err != nil {
-		return managed.ExternalUpdate{}, errors.Wrap(err, errGetPolicy)
-	}
-
-	u, err := bucketpolicy.IsUpToDate(&cr.Spec.ForProvider, instance)
-	if err != nil {
-		return managed.ExternalUpdate{}, errors.Wrap(err, errCheckUpToDate)
-	}
-	if u {
-		return managed.ExternalUpdate{}, nil
-	}
-
-	bucketpolicy.GenerateBucketPolicyInstance(cr.Spec.ForProvider, instance)
-	if _, err := e.bucketpolicy.SetIamPolicy(gcp.StringValue(cr.Spec.ForProvider.Bucket), instance).
-		Context(ctx).Do(); err != nil {
-		return managed.ExternalUpdate{}, errors.Wrap(err, errSetPolicy)
-	}
-
-	return managed.ExternalUpdate{}, nil
+	// The policy read here carries an etag, so the write is rejected if
+	// somebody else changed the policy in between. Read it again and retry.
+	err := gcp.RetryOnConflict(func() error {
+		instance, err := e.bucketpolicy.GetIamPolicy(gcp.StringValue(cr.Spec.ForProvider.Bucket)).OptionsRequestedPolicyVersion(iamv1alpha1.PolicyVersion).Context(ctx).Do()
+		if err != nil {
+			return errors.Wrap(err, errGetPolicy)
+		}
+
+		u, err := bucketpolicy.IsUpToDate(&cr.Spec.ForProvider, instance)
+		if err != nil {
+			return errors.Wrap(err, errCheckUpToDate)
+		}
+		if u {
+			return nil
+		}
+
+		bucketpolicy.GenerateBucketPolicyInstance(cr.Spec.ForProvider, instance)
+		_, err = e.bucketpolicy.SetIamPolicy(gcp.StringValue(cr.Spec.ForProvider.Bucket), instance).Context(ctx).Do()
+		return errors.Wrap(err, errSetPolicy)
+	})
+	return managed.ExternalUpdate{}, err
 }
 
 func (e *bucketPolicyExternal) Delete(ctx context.Context, mg resource.Managed) error {
@@ -171,9 +173,6 @@ func (e *bucketPolicyExternal) Delete(ctx context.Context, mg resource.Managed)
 	if !ok {
 		return errors.New(errNotBucketPolicy)
 	}
-	if _, err := e.bucketpolicy.SetIamPolicy(gcp.StringValue(cr.Spec.ForProvider.Bucket), &storage.Policy{}).
-		Context(ctx).Do(); err != nil {
-		return errors.Wrap(err, errSetPolicy)
-	}
-	return nil
+	_, err := e.bucketpolicy.SetIamPolicy(gcp.StringValue(cr.Spec.ForProvider.Bucket), &storage.Policy{}).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errSetPolicy)
 }
@@ -696,6 +696,25 @@ func TestBucketPolicyDelete(t *testing.T) {
 					bpWithExternalNameAnnotation(bpMetadataName)),
 			},
 		},
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNotFound)
+				if err := json.NewEncoder(w).Encode(&storagev1.Policy{}); err != nil {
+					t.Error(err)
+				}
+			}),
+			args: args{
+				ctx: context.Background(),
+				mg: BucketPolicy(
+					bpWithName(bpMetadataName),
+					bpWithExternalNameAnnotation(bpMetadataName)),
+			},
+			want: want{
+				mg: BucketPolicy(
+					bpWithName(bpMetadataName),
+					bpWithExternalNameAnnotation(bpMetadataName)),
+			},
+		},
 		"CreateFailed": {
 			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 				w.WriteHeader(http.StatusInternalServerError)
@@ -25,7 +25,6 @@ import (
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/connection"
-	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	"github.com/crossplane/crossplane-runtime/pkg/errors"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
@@ -37,7 +36,9 @@ import (
 	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
 	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
 	"github.com/crossplane-contrib/provider-gcp/pkg/clients/bucketpolicy"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/setup"
 	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+	"github.com/crossplane-contrib/provider-gcp/pkg/tracing"
 )
 
 const (
@@ -45,7 +46,7 @@ const (
 )
 
 // SetupBucketPolicyMember adds a controller that reconciles BucketPolicyMembers.
-func SetupBucketPolicyMember(mgr ctrl.Manager, o controller.Options) error {
+func SetupBucketPolicyMember(mgr ctrl.Manager, o setup.Options) error {
 	name := managed.ControllerName(v1alpha1.BucketPolicyMemberGroupKind)
 
 	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
@@ -55,7 +56,7 @@ func SetupBucketPolicyMember(mgr ctrl.Manager, o controller.Options) error {
 
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(v1alpha1.BucketPolicyMemberGroupVersionKind),
-		managed.WithExternalConnecter(&bucketPolicyMemberConnecter{client: mgr.GetClient()}),
+		managed.WithExternalConnecter(setup.Connecter(mgr, o, v1alpha1.BucketPolicyMemberGroupKind, &bucketPolicyMemberConnecter{client: mgr.GetClient()})),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
@@ -65,7 +66,7 @@ func SetupBucketPolicyMember(mgr ctrl.Manager, o controller.Options) error {
 		Named(name).
 		WithOptions(o.ForControllerRuntime()).
 		For(&v1alpha1.BucketPolicyMember{}).
-		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+		Complete(tracing.NewReconciler(name, ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter)))
 }
 
 type bucketPolicyMemberConnecter struct {
@@ -118,22 +119,20 @@ func (e *bucketPolicyMemberExternal) Create(ctx context.Context, mg resource.Man
 	if !ok {
 		return managed.ExternalCreation{}, errors.New(errNotBucketPolicyMember)
 	}
-	instance, err := e.bucketpolicy.GetIamPolicy(gcp.StringValue(cr.Spec.ForProvider.Bucket)).OptionsRequestedPolicyVersion(iamv1alpha1.PolicyVersion).Context(ctx).Do()
-	if err != nil {
-		return managed.ExternalCreation{}, errors.Wrap(err, errGetPolicy)
-	}
-
-	changed := bucketpolicy.BindRoleToMember(cr.Spec.ForProvider, instance)
-	if !changed {
-		return managed.ExternalCreation{}, nil
-	}
-
-	if _, err := e.bucketpolicy.SetIamPolicy(gcp.StringValue(cr.Spec.ForProvider.Bucket), instance).
-		Context(ctx).Do(); err != nil {
-		return managed.ExternalCreation{}, errors.Wrap(err, errSetPolicy)
-	}
-
-	return managed.ExternalCreation{}, nil
+	// Other members of the same policy may be bound concurrently, in which
+	// case the etag of the policy read here is stale. Read it again and retry.
+	err := gcp.RetryOnConflict(func() error {
+		instance, err := e.bucketpolicy.GetIamPolicy(gcp.StringValue(cr.Spec.ForProvider.Bucket)).OptionsRequestedPolicyVersion(iamv1alpha1.PolicyVersion).Context(ctx).Do()
+		if err != nil {
+			return errors.Wrap(err, errGetPolicy)
+		}
+		if !bucketpolicy.BindRoleToMember(cr.Spec.ForProvider, instance) {
+			return nil
+		}
+		_, err = e.bucketpolicy.SetIamPolicy(gcp.StringValue(cr.Spec.ForProvider.Bucket), instance).Context(ctx).Do()
+		return errors.Wrap(err, errSetPolicy)
+	})
+	return managed.ExternalCreation{}, err
 }
 
 func (e *bucketPolicyMemberExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
@@ -146,19 +145,18 @@ func (e *bucketPolicyMemberExternal) Delete(ctx context.Context, mg resource.Man
 	if !ok {
 		return errors.New(errNotBucketPolicyMember)
 	}
-	instance, err := e.bucketpolicy.GetIamPolicy(gcp.StringValue(cr.Spec.ForProvider.Bucket)).OptionsRequestedPolicyVersion(iamv1alpha1.PolicyVersion).Context(ctx).Do()
-	if err != nil {
-		return errors.Wrap(err, errGetPolicy)
-	}
-
-	changed := bucketpolicy.UnbindRoleFromMember(cr.Spec.ForProvider, instance)
-	if !changed {
-		return nil
-	}
-	if _, err := e.bucketpolicy.SetIamPolicy(gcp.StringValue(cr.Spec.ForProvider.Bucket), instance).
-		Context(ctx).Do(); err != nil {
+	return gcp.RetryOnConflict(func() error {
+		instance, err := e.bucketpolicy.GetIamPolicy(gcp.StringValue(cr.Spec.ForProvider.Bucket)).OptionsRequestedPolicyVersion(iamv1alpha1.PolicyVersion).Context(ctx).Do()
+		if gcp.IsErrorNotFound(err) {
+			return nil
+		}
+		if err != nil {
+			return errors.Wrap(err, errGetPolicy)
+		}
+		if !bucketpolicy.UnbindRoleFromMember(cr.Spec.ForProvider, instance) {
+			return nil
+		}
+		_, err = e.bucketpolicy.SetIamPolicy(gcp.StringValue(cr.Spec.ForProvider.Bucket), instance).Context(ctx).Do()
 		return errors.Wrap(err, errSetPolicy)
-	}
-
-	return nil
+	})
 }
@@ -572,6 +572,28 @@ func TestBucketPolicyMemberDelete(t *testing.T) {
 				err: errors.Wrap(gError(http.StatusInternalServerError, "{}\n"), errGetPolicy),
 			},
 		},
+		"BucketNotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusNotFound)
+				if err := json.NewEncoder(w).Encode(&storagev1.Policy{}); err != nil {
+					t.Error(err)
+				}
+			}),
+			args: args{
+				ctx: context.Background(),
+				mg: BucketPolicyMember(
+					bpmWithName(bpmMetadataName),
+					bpmWithExternalNameAnnotation(bpmMetadataName)),
+			},
+			want: want{
+				mg: BucketPolicyMember(
+					bpmWithName(bpmMetadataName),
+					bpmWithExternalNameAnnotation(bpmMetadataName)),
+			},
+		},
 		"DeleteFailedWhileSetting": {
 			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 				switch r.Method {
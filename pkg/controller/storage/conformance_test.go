@@ -0,0 +1,67 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"testing"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/storage/v1alpha1"
+	"github.com/crossplane-contrib/provider-gcp/apis/storage/v1alpha3"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/conformance"
+)
+
+func TestConformance(t *testing.T) {
+	conformance.Run(t, []string{v1alpha1.Group, v1alpha3.Group}, map[string]conformance.Fixture{
+		v1alpha3.BucketGroupKind: {
+			Managed:   func() resource.Managed { return &v1alpha3.Bucket{} },
+			Connecter: func(kube client.Client) managed.ExternalConnecter { return &connecter{client: kube} },
+		},
+		v1alpha1.BucketObjectGroupKind: {
+			Managed:   func() resource.Managed { return &v1alpha1.BucketObject{} },
+			Connecter: func(kube client.Client) managed.ExternalConnecter { return &bucketObjectConnecter{client: kube} },
+		},
+		v1alpha1.BucketPolicyGroupKind: {
+			Managed: func() resource.Managed {
+				return &v1alpha1.BucketPolicy{Spec: v1alpha1.BucketPolicySpec{ForProvider: v1alpha1.BucketPolicyParameters{
+					Bucket: gcp.StringPtr("bucket"),
+				}}}
+			},
+			Connecter: func(kube client.Client) managed.ExternalConnecter { return &bucketPolicyConnecter{client: kube} },
+			Identity:  "The policy is the IAM policy of the bucket.",
+			Found:     `{"bindings":[{"role":"roles/viewer","members":["user:alice@example.com"]}]}`,
+		},
+		v1alpha1.BucketPolicyMemberGroupKind: {
+			Managed: func() resource.Managed {
+				return &v1alpha1.BucketPolicyMember{Spec: v1alpha1.BucketPolicyMemberSpec{ForProvider: v1alpha1.BucketPolicyMemberParameters{
+					Bucket: gcp.StringPtr("bucket"),
+					Role:   "roles/viewer",
+					Member: gcp.StringPtr("user:alice@example.com"),
+				}}}
+			},
+			Connecter: func(kube client.Client) managed.ExternalConnecter { return &bucketPolicyMemberConnecter{client: kube} },
+			Identity:  "The member is a binding of the IAM policy of the bucket.",
+			Found:     `{"bindings":[{"role":"roles/viewer","members":["user:alice@example.com"]}]}`,
+			NotFound:  `{}`,
+		},
+	})
+}
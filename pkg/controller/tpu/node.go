@@ -0,0 +1,205 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tpu
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	tpu "google.golang.org/api/tpu/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/tpu/v1alpha1"
+	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/tpunode"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/setup"
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+	"github.com/crossplane-contrib/provider-gcp/pkg/tracing"
+)
+
+const (
+	errNewClient      = "cannot create new Cloud TPU client"
+	errNotNode        = "managed resource is not of type Node"
+	errGetNode        = "cannot get Node"
+	errCreateNode     = "cannot create Node"
+	errUpdateNode     = "cannot update Node"
+	errDeleteNode     = "cannot delete Node"
+	errKubeUpdateNode = "cannot update Node custom resource"
+)
+
+// SetupNode adds a controller that reconciles Nodes.
+func SetupNode(mgr ctrl.Manager, o setup.Options) error {
+	name := managed.ControllerName(v1alpha1.NodeGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
+	}
+
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.NodeGroupVersionKind),
+		managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient()), gcp.NewLocationDefaulter(mgr.GetClient(), gcp.LocationScopeZone, nodeLocation)),
+		managed.WithExternalConnecter(setup.Connecter(mgr, o, v1alpha1.NodeGroupKind, &nodeConnector{client: mgr.GetClient(), record: recorder})),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(recorder),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.Node{}).
+		Complete(tracing.NewReconciler(name, ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter)))
+}
+
+// nodeLocation returns the zone of the supplied Node so that it can be
+// defaulted from its ProviderConfig.
+func nodeLocation(mg resource.Managed) *string {
+	cr, ok := mg.(*v1alpha1.Node)
+	if !ok {
+		return nil
+	}
+	return &cr.Spec.ForProvider.Location
+}
+
+type nodeConnector struct {
+	client client.Client
+	record event.Recorder
+}
+
+// Connect returns an ExternalClient with necessary information to talk to GCP API.
+func (c *nodeConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetConnectionInfo(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := tpu.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &nodeExternal{projectID: projectID, client: c.client, tpu: s, record: c.record}, nil
+}
+
+type nodeExternal struct {
+	projectID string
+	client    client.Client
+	tpu       *tpu.Service
+	record    event.Recorder
+}
+
+// Observe makes observation about the external resource.
+func (e *nodeExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Node)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotNode)
+	}
+	name := tpunode.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider.Location, meta.GetExternalName(cr))
+	n, err := e.tpu.Projects.Locations.Nodes.Get(name).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetNode)
+	}
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	tpunode.LateInitialize(&cr.Spec.ForProvider, *n)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
+		if err := e.client.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errKubeUpdateNode)
+		}
+	}
+	cr.Status.AtProvider = tpunode.GenerateObservation(*n)
+	switch n.State {
+	case v1alpha1.NodeStateCreating:
+		cr.SetConditions(xpv1.Creating())
+	case v1alpha1.NodeStateReady:
+		cr.SetConditions(xpv1.Available())
+	case v1alpha1.NodeStateDeleting:
+		cr.SetConditions(xpv1.Deleting())
+	default:
+		cr.SetConditions(xpv1.Unavailable())
+	}
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: tpunode.IsUpToDate(cr.Spec.ForProvider, *n),
+	}, nil
+}
+
+// Create initiates creation of external resource.
+func (e *nodeExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Node)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotNode)
+	}
+	cr.SetConditions(xpv1.Creating())
+	op, err := e.tpu.Projects.Locations.Nodes.Create(tpunode.GetParent(e.projectID, cr.Spec.ForProvider.Location), tpunode.GenerateNode("", cr.Spec.ForProvider)).
+		NodeId(meta.GetExternalName(cr)).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateNode)
+	}
+	gcp.RecordOperation(e.record, cr, "create", op.Name)
+	return managed.ExternalCreation{}, nil
+}
+
+// Update initiates an update to the external resource. Only the
+// description, labels, metadata and tags of a node can be updated.
+func (e *nodeExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Node)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotNode)
+	}
+	name := tpunode.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider.Location, meta.GetExternalName(cr))
+	n, err := e.tpu.Projects.Locations.Nodes.Get(name).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetNode)
+	}
+	op, err := e.tpu.Projects.Locations.Nodes.Patch(name, tpunode.GenerateNode(name, cr.Spec.ForProvider)).
+		UpdateMask(tpunode.GenerateUpdateMask(cr.Spec.ForProvider, *n)).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateNode)
+	}
+	gcp.RecordOperation(e.record, cr, "update", op.Name)
+	return managed.ExternalUpdate{}, nil
+}
+
+// Delete initiates an deletion of the external resource.
+func (e *nodeExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Node)
+	if !ok {
+		return errors.New(errNotNode)
+	}
+	name := tpunode.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider.Location, meta.GetExternalName(cr))
+	op, err := e.tpu.Projects.Locations.Nodes.Delete(name).Context(ctx).Do()
+	if err != nil {
+		return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteNode)
+	}
+	gcp.RecordOperation(e.record, cr, "delete", op.Name)
+	return nil
+}
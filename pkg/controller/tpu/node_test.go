@@ -0,0 +1,211 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tpu
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+	tpu "google.golang.org/api/tpu/v2"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/tpu/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	projectID = "fooproject"
+	nodeName  = "trainer"
+	nodePath  = "/v2/projects/fooproject/locations/us-west4-a/nodes/trainer"
+)
+
+func gError(code int, message string) *googleapi.Error {
+	return &googleapi.Error{
+		Code:    code,
+		Body:    "{}\n",
+		Message: message,
+	}
+}
+
+func newNode(m ...func(*v1alpha1.Node)) *v1alpha1.Node {
+	n := &v1alpha1.Node{}
+	meta.SetExternalName(n, nodeName)
+	n.Spec.ForProvider = v1alpha1.NodeParameters{
+		Location:        "us-west4-a",
+		AcceleratorType: "v5litepod-8",
+		RuntimeVersion:  "tpu-ubuntu2204-base",
+		NetworkConfig: &v1alpha1.NetworkConfig{
+			Network:    gcp.StringPtr("projects/fooproject/global/networks/default"),
+			Subnetwork: gcp.StringPtr("projects/fooproject/regions/us-west4/subnetworks/default"),
+		},
+	}
+	for _, f := range m {
+		f(n)
+	}
+	return n
+}
+
+func observedNode(state string) *tpu.Node {
+	return &tpu.Node{
+		Name:            "projects/fooproject/locations/us-west4-a/nodes/trainer",
+		AcceleratorType: "v5litepod-8",
+		RuntimeVersion:  "tpu-ubuntu2204-base",
+		State:           state,
+		NetworkConfig: &tpu.NetworkConfig{
+			Network:    "projects/fooproject/global/networks/default",
+			Subnetwork: "projects/fooproject/regions/us-west4/subnetworks/default",
+		},
+	}
+}
+
+func TestNodeObserve(t *testing.T) {
+	type want struct {
+		eo   managed.ExternalObservation
+		cond xpv1.Condition
+		err  error
+	}
+	cases := map[string]struct {
+		handler http.Handler
+		mg      *v1alpha1.Node
+		want    want
+	}{
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+			mg: newNode(),
+		},
+		"GetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+			mg:   newNode(),
+			want: want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetNode)},
+		},
+		"Creating": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(nodePath, r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(observedNode(v1alpha1.NodeStateCreating))
+			}),
+			mg: newNode(),
+			want: want{
+				eo:   managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				cond: xpv1.Creating(),
+			},
+		},
+		"NeedsUpdate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(observedNode(v1alpha1.NodeStateReady))
+			}),
+			mg: newNode(func(n *v1alpha1.Node) {
+				n.Spec.ForProvider.Labels = map[string]string{"team": "ml"}
+			}),
+			want: want{
+				eo:   managed.ExternalObservation{ResourceExists: true},
+				cond: xpv1.Available(),
+			},
+		},
+		"Preempted": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(observedNode(v1alpha1.NodeStatePreempted))
+			}),
+			mg: newNode(),
+			want: want{
+				eo:   managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				cond: xpv1.Unavailable(),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := tpu.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := nodeExternal{projectID: projectID, tpu: s}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.eo, got); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if tc.want.cond.Type == "" {
+				return
+			}
+			if diff := cmp.Diff(tc.want.cond, tc.mg.GetCondition(xpv1.TypeReady), test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want condition, +got condition:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNodeUpdate(t *testing.T) {
+	var gotMask string
+	got := &tpu.Node{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer func() { _ = r.Body.Close() }()
+		w.WriteHeader(http.StatusOK)
+		if r.Method == http.MethodGet {
+			_ = json.NewEncoder(w).Encode(observedNode(v1alpha1.NodeStateReady))
+			return
+		}
+		gotMask = r.URL.Query().Get("updateMask")
+		b, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(b, got)
+		_ = json.NewEncoder(w).Encode(&tpu.Operation{})
+	}))
+	defer server.Close()
+
+	s, _ := tpu.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	e := nodeExternal{projectID: projectID, tpu: s}
+	mg := newNode(func(n *v1alpha1.Node) {
+		n.Spec.ForProvider.Labels = map[string]string{"team": "ml"}
+	})
+	if _, err := e.Update(context.Background(), mg); err != nil {
+		t.Fatalf("Update(...): unexpected error: %v", err)
+	}
+	if diff := cmp.Diff("labels", gotMask); diff != "" {
+		t.Errorf("Update(...): -want mask, +got mask:\n%s", diff)
+	}
+	if diff := cmp.Diff(map[string]string{"team": "ml"}, got.Labels); diff != "" {
+		t.Errorf("Update(...): -want labels, +got labels:\n%s", diff)
+	}
+}
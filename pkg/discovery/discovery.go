@@ -0,0 +1,345 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package discovery creates managed resources that observe the external
+// resources already present in the project of a ProviderConfig, so that they
+// can be inventoried and gradually adopted without hand-writing manifests.
+package discovery
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"google.golang.org/api/option"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/setup"
+	"github.com/crossplane-contrib/provider-gcp/pkg/dryrun"
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+)
+
+const (
+	// AnnotationKeyDiscover may be set on a ProviderConfig to a comma
+	// separated list of kinds, e.g. "Cluster.container.gcp.crossplane.io",
+	// whose external resources in its project should be discovered.
+	AnnotationKeyDiscover = "gcp.crossplane.io/discover"
+
+	// LabelKeyDiscoveredBy is set on every discovered managed resource to
+	// the name of the ProviderConfig it was discovered with.
+	LabelKeyDiscoveredBy = "gcp.crossplane.io/discovered-by"
+)
+
+const (
+	errGetProviderConfig = "cannot get ProviderConfig"
+	errGetConnectionInfo = "cannot get connection info for %s"
+	errList              = "cannot list %s"
+	errListManaged       = "cannot list %s managed resources"
+	errCreate            = "cannot create %s %q"
+
+	reasonUnknownKind event.Reason = "UnknownDiscoveryKind"
+	reasonDiscovered  event.Reason = "DiscoveredResources"
+
+	// maxNamePrefix leaves room for the hash suffix of generated names, and
+	// keeps them short enough to be used as label values.
+	maxNamePrefix = 54
+)
+
+// defaultInterval is how often the project of an annotated ProviderConfig is
+// listed again unless the Reconciler is configured otherwise.
+const defaultInterval = 10 * time.Minute
+
+var invalidNameChars = regexp.MustCompile(`[^a-z0-9-]+`)
+
+// A Discovered external resource.
+type Discovered struct {
+	// ID uniquely identifies the external resource within its kind, e.g. its
+	// fully qualified name.
+	ID string
+
+	// Managed resource that observes the external resource. Its external
+	// name and any spec fields it cannot be observed without are set.
+	Managed resource.Managed
+}
+
+// A ListFn lists every external resource of a kind in a project.
+type ListFn func(ctx context.Context, project string, opts ...option.ClientOption) ([]Discovered, error)
+
+// A Kind of external resource that can be discovered.
+type Kind struct {
+	// New returns an empty managed resource of the kind.
+	New func() resource.Managed
+
+	// NewList returns an empty list of managed resources of the kind.
+	NewList func() resource.ManagedList
+
+	// List the external resources of the kind.
+	List ListFn
+}
+
+// A ConnectFn returns the project and client options to use when calling GCP
+// on behalf of the supplied managed resource.
+type ConnectFn func(ctx context.Context, c client.Client, mg resource.Managed) (string, []option.ClientOption, error)
+
+// Name returns the name of the managed resource that observes the supplied
+// discovered external resource. Names are derived from the ID of the external
+// resource so that it is discovered into the same managed resource every time.
+func Name(providerConfig, kind string, d Discovered) string {
+	prefix := invalidNameChars.ReplaceAllString(strings.ToLower(meta.GetExternalName(d.Managed)), "-")
+	if len(prefix) > maxNamePrefix {
+		prefix = prefix[:maxNamePrefix]
+	}
+	prefix = strings.Trim(prefix, "-")
+	if prefix == "" {
+		prefix = strings.ToLower(strings.SplitN(kind, ".", 2)[0])
+	}
+	h := sha256.Sum256([]byte(providerConfig + "/" + kind + "/" + d.ID))
+	return prefix + "-" + hex.EncodeToString(h[:4])
+}
+
+// Kinds returns the kinds listed by the supplied annotation value that are
+// known, and those that are not.
+func Kinds(value string, known map[string]Kind) (kinds, unknown []string) {
+	for _, k := range strings.Split(value, ",") {
+		k = strings.TrimSpace(k)
+		switch _, ok := known[k]; {
+		case k == "":
+		case ok:
+			kinds = append(kinds, k)
+		default:
+			unknown = append(unknown, k)
+		}
+	}
+	sort.Strings(kinds)
+	return kinds, unknown
+}
+
+// A ReconcilerOption configures a Reconciler.
+type ReconcilerOption func(*Reconciler)
+
+// WithLogger specifies how the Reconciler should log messages.
+func WithLogger(l logging.Logger) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.log = l
+	}
+}
+
+// WithRecorder specifies how the Reconciler should record events.
+func WithRecorder(er event.Recorder) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.record = er
+	}
+}
+
+// WithInterval specifies how often the Reconciler lists the project of an
+// annotated ProviderConfig again.
+func WithInterval(d time.Duration) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.interval = d
+	}
+}
+
+// WithKinds specifies the kinds the Reconciler can discover.
+func WithKinds(k map[string]Kind) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.kinds = k
+	}
+}
+
+// WithConnectFn specifies how the Reconciler gets the project and client
+// options of a ProviderConfig.
+func WithConnectFn(fn ConnectFn) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.connect = fn
+	}
+}
+
+// A Reconciler discovers the external resources in the project of each
+// ProviderConfig annotated with AnnotationKeyDiscover. A managed resource is
+// created for every discovered external resource that is not already
+// observed by a managed resource using the same ProviderConfig. It is
+// annotated to be reconciled in dry-run mode and its deletion policy is
+// Orphan, so that it only ever observes the external resource. Managed
+// resources are never deleted by the Reconciler, including when their
+// external resource no longer exists.
+type Reconciler struct {
+	client   client.Client
+	kinds    map[string]Kind
+	connect  ConnectFn
+	interval time.Duration
+	log      logging.Logger
+	record   event.Recorder
+}
+
+// NewReconciler returns a Reconciler that discovers external resources.
+func NewReconciler(c client.Client, o ...ReconcilerOption) *Reconciler {
+	r := &Reconciler{
+		client:   c,
+		kinds:    DefaultKinds(),
+		connect:  gcp.GetProviderConfigConnectionInfo,
+		interval: defaultInterval,
+		log:      logging.NewNopLogger(),
+		record:   event.NewNopRecorder(),
+	}
+	for _, ro := range o {
+		ro(r)
+	}
+	return r
+}
+
+// Reconcile discovers the external resources of a ProviderConfig's project.
+func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	log := r.log.WithValues("request", req)
+
+	pc := &v1beta1.ProviderConfig{}
+	if err := r.client.Get(ctx, req.NamespacedName, pc); err != nil {
+		return reconcile.Result{}, errors.Wrap(resource.IgnoreNotFound(err), errGetProviderConfig)
+	}
+	value, ok := pc.GetAnnotations()[AnnotationKeyDiscover]
+	if !ok || meta.WasDeleted(pc) {
+		return reconcile.Result{}, nil
+	}
+
+	// Like managed resources, a ProviderConfig may be paused with the
+	// crossplane.io/paused annotation. Removing the annotation triggers
+	// another reconcile, which resumes discovery.
+	if meta.IsPaused(pc) {
+		log.Debug("Discovery is paused via the pause annotation", "annotation", meta.AnnotationKeyReconciliationPaused)
+		return reconcile.Result{}, nil
+	}
+
+	kinds, unknown := Kinds(value, r.kinds)
+	if len(unknown) > 0 {
+		r.record.Event(pc, event.Warning(reasonUnknownKind, errors.Errorf("cannot discover unknown kinds: %s", strings.Join(unknown, ", "))))
+	}
+	for _, k := range kinds {
+		created, err := r.discover(ctx, pc, k)
+		if err != nil {
+			log.Debug("Cannot discover external resources", "kind", k, "error", err)
+			return reconcile.Result{}, err
+		}
+		if created > 0 {
+			log.Debug("Discovered external resources", "kind", k, "created", created)
+			r.record.Event(pc, event.Normal(reasonDiscovered, "Created managed resources for discovered external resources", "kind", k))
+		}
+	}
+	return reconcile.Result{RequeueAfter: r.interval}, nil
+}
+
+// discover creates a managed resource for every external resource of the
+// supplied kind that does not already have one, and returns how many were
+// created. An external resource already has a managed resource if one was
+// discovered for it before, or if a managed resource of the kind with the
+// same external name uses the same ProviderConfig.
+func (r *Reconciler) discover(ctx context.Context, pc *v1beta1.ProviderConfig, kind string) (int, error) {
+	k := r.kinds[kind]
+	ref := &xpv1.Reference{Name: pc.GetName()}
+
+	// The connection info of a ProviderConfig is looked up on behalf of a
+	// managed resource that references it. The managed resource does not
+	// exist, so its usage of the ProviderConfig is not tracked.
+	mg := k.New()
+	mg.SetProviderConfigReference(ref)
+	project, opts, err := r.connect(ctx, r.client, mg)
+	if err != nil {
+		return 0, errors.Wrapf(err, errGetConnectionInfo, kind)
+	}
+	found, err := k.List(ctx, project, opts...)
+	if err != nil {
+		return 0, errors.Wrapf(err, errList, kind)
+	}
+
+	l := k.NewList()
+	if err := r.client.List(ctx, l); err != nil {
+		return 0, errors.Wrapf(err, errListManaged, kind)
+	}
+	observed := map[string]bool{}
+	for _, mg := range l.GetItems() {
+		if ref := mg.GetProviderConfigReference(); ref != nil && ref.Name == pc.GetName() {
+			observed[meta.GetExternalName(mg)] = true
+		}
+	}
+
+	created := 0
+	for _, d := range found {
+		if observed[meta.GetExternalName(d.Managed)] {
+			// A managed resource, e.g. one that was written by hand,
+			// already observes the external resource.
+			continue
+		}
+		name := Name(pc.GetName(), kind, d)
+		if err := r.client.Get(ctx, types.NamespacedName{Name: name}, k.New()); !kerrors.IsNotFound(err) {
+			// The external resource was discovered before, or we cannot
+			// tell whether it was. Either way we leave it be.
+			continue
+		}
+		mg := d.Managed
+		mg.SetName(name)
+		meta.AddAnnotations(mg, map[string]string{dryrun.AnnotationKeyDryRun: "true"})
+		meta.AddLabels(mg, map[string]string{LabelKeyDiscoveredBy: pc.GetName()})
+		mg.SetDeletionPolicy(xpv1.DeletionOrphan)
+		mg.SetProviderConfigReference(ref)
+		if err := r.client.Create(ctx, mg); resource.Ignore(kerrors.IsAlreadyExists, err) != nil {
+			return created, errors.Wrapf(err, errCreate, kind, name)
+		}
+		created++
+	}
+	return created, nil
+}
+
+// Setup adds a controller that discovers the external resources in the
+// project of each ProviderConfig annotated with AnnotationKeyDiscover, unless
+// the ProviderConfig is paused. It does nothing unless the discovery feature
+// is enabled.
+func Setup(mgr ctrl.Manager, o setup.Options) error {
+	if o.Features == nil || !o.Features.Enabled(features.EnableAlphaDiscovery) {
+		return nil
+	}
+	name := "discovery/" + strings.ToLower(v1beta1.ProviderConfigGroupKind)
+
+	r := NewReconciler(mgr.GetClient(),
+		WithInterval(o.DiscoveryInterval),
+		WithLogger(o.Logger.WithValues("controller", name)),
+		WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))
+
+	// Updates to the usage of a ProviderConfig are not of interest; only a
+	// change to its annotations or spec triggers an early discovery.
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1beta1.ProviderConfig{}, builder.WithPredicates(predicate.Or(predicate.AnnotationChangedPredicate{}, predicate.GenerationChangedPredicate{}))).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
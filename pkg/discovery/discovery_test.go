@@ -0,0 +1,373 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package discovery
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	container "google.golang.org/api/container/v1"
+	"google.golang.org/api/option"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/pubsub/v1alpha1"
+	"github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
+	"github.com/crossplane-contrib/provider-gcp/pkg/dryrun"
+)
+
+const (
+	providerConfigName = "default"
+	projectID          = "my-project"
+)
+
+var errBoom = errors.New("boom")
+
+func discoveredTopic(name string) Discovered {
+	cr := &v1alpha1.Topic{}
+	meta.SetExternalName(cr, name)
+	return Discovered{ID: "projects/" + projectID + "/topics/" + name, Managed: cr}
+}
+
+func observedTopic(name, providerConfig string) *v1alpha1.Topic {
+	cr := &v1alpha1.Topic{}
+	meta.SetExternalName(cr, name)
+	cr.SetProviderConfigReference(&xpv1.Reference{Name: providerConfig})
+	return cr
+}
+
+func TestName(t *testing.T) {
+	long := strings.Repeat("a", 100)
+
+	cases := map[string]struct {
+		d          Discovered
+		wantPrefix string
+	}{
+		"Simple": {
+			d:          discoveredTopic("my-topic"),
+			wantPrefix: "my-topic-",
+		},
+		"InvalidCharacters": {
+			d:          discoveredTopic("My_Topic.v1"),
+			wantPrefix: "my-topic-v1-",
+		},
+		"TooLong": {
+			d:          discoveredTopic(long),
+			wantPrefix: long[:maxNamePrefix] + "-",
+		},
+		"NoValidCharacters": {
+			d:          discoveredTopic("___"),
+			wantPrefix: "topic-",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := Name(providerConfigName, v1alpha1.TopicGroupKind, tc.d)
+			if !strings.HasPrefix(got, tc.wantPrefix) || len(got) != len(tc.wantPrefix)+8 {
+				t.Errorf("Name(...): want %q followed by a hash, got %q", tc.wantPrefix, got)
+			}
+			if again := Name(providerConfigName, v1alpha1.TopicGroupKind, tc.d); again != got {
+				t.Errorf("Name(...): want the same name every time, got %q and %q", got, again)
+			}
+			if other := Name("other", v1alpha1.TopicGroupKind, tc.d); other == got {
+				t.Errorf("Name(...): want a different name for another ProviderConfig, got %q", other)
+			}
+		})
+	}
+}
+
+func TestKinds(t *testing.T) {
+	known := map[string]Kind{
+		v1alpha1.TopicGroupKind:               {},
+		"Cluster.container.gcp.crossplane.io": {},
+	}
+	kinds, unknown := Kinds(" Topic.pubsub.gcp.crossplane.io,Bucket.storage.gcp.crossplane.io,,Cluster.container.gcp.crossplane.io", known)
+	if diff := cmp.Diff([]string{"Cluster.container.gcp.crossplane.io", v1alpha1.TopicGroupKind}, kinds); diff != "" {
+		t.Errorf("Kinds(...): -want kinds, +got kinds:\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"Bucket.storage.gcp.crossplane.io"}, unknown); diff != "" {
+		t.Errorf("Kinds(...): -want unknown, +got unknown:\n%s", diff)
+	}
+}
+
+func providerConfig(annotations map[string]string) *v1beta1.ProviderConfig {
+	return &v1beta1.ProviderConfig{ObjectMeta: metav1.ObjectMeta{Name: providerConfigName, Annotations: annotations}}
+}
+
+func TestReconcile(t *testing.T) {
+	existing := discoveredTopic("existing")
+	existingName := Name(providerConfigName, v1alpha1.TopicGroupKind, existing)
+
+	type args struct {
+		pc   *v1beta1.ProviderConfig
+		list ListFn
+	}
+	type want struct {
+		result  reconcile.Result
+		err     error
+		created []string
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"NotAnnotated": {
+			reason: "Nothing should be discovered for a ProviderConfig that is not annotated.",
+			args: args{
+				pc: providerConfig(nil),
+			},
+			want: want{},
+		},
+		"Paused": {
+			reason: "Nothing should be discovered for a ProviderConfig that is paused.",
+			args: args{
+				pc: providerConfig(map[string]string{
+					AnnotationKeyDiscover:                  v1alpha1.TopicGroupKind,
+					meta.AnnotationKeyReconciliationPaused: "true",
+				}),
+				list: func(_ context.Context, _ string, _ ...option.ClientOption) ([]Discovered, error) {
+					return nil, errBoom
+				},
+			},
+			want: want{},
+		},
+		"ListError": {
+			reason: "Errors listing external resources should be returned.",
+			args: args{
+				pc: providerConfig(map[string]string{AnnotationKeyDiscover: v1alpha1.TopicGroupKind}),
+				list: func(_ context.Context, _ string, _ ...option.ClientOption) ([]Discovered, error) {
+					return nil, errBoom
+				},
+			},
+			want: want{
+				err: errors.Wrapf(errBoom, errList, v1alpha1.TopicGroupKind),
+			},
+		},
+		"UnknownKind": {
+			reason: "Unknown kinds should be skipped.",
+			args: args{
+				pc: providerConfig(map[string]string{AnnotationKeyDiscover: "Bucket.storage.gcp.crossplane.io"}),
+			},
+			want: want{
+				result: reconcile.Result{RequeueAfter: defaultInterval},
+			},
+		},
+		"Discovered": {
+			reason: "A managed resource should be created for every external resource that was not discovered before.",
+			args: args{
+				pc: providerConfig(map[string]string{AnnotationKeyDiscover: v1alpha1.TopicGroupKind}),
+				list: func(_ context.Context, project string, _ ...option.ClientOption) ([]Discovered, error) {
+					if project != projectID {
+						return nil, errors.Errorf("unexpected project %q", project)
+					}
+					return []Discovered{existing, discoveredTopic("new")}, nil
+				},
+			},
+			want: want{
+				result:  reconcile.Result{RequeueAfter: defaultInterval},
+				created: []string{Name(providerConfigName, v1alpha1.TopicGroupKind, discoveredTopic("new"))},
+			},
+		},
+		"AlreadyObserved": {
+			reason: "No managed resource should be created for an external resource that a managed resource using the same ProviderConfig already observes.",
+			args: args{
+				pc: providerConfig(map[string]string{AnnotationKeyDiscover: v1alpha1.TopicGroupKind}),
+				list: func(_ context.Context, _ string, _ ...option.ClientOption) ([]Discovered, error) {
+					return []Discovered{discoveredTopic("handwritten"), discoveredTopic("elsewhere")}, nil
+				},
+			},
+			want: want{
+				result:  reconcile.Result{RequeueAfter: defaultInterval},
+				created: []string{Name(providerConfigName, v1alpha1.TopicGroupKind, discoveredTopic("elsewhere"))},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var created []string
+			c := &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+					switch o := obj.(type) {
+					case *v1beta1.ProviderConfig:
+						tc.args.pc.DeepCopyInto(o)
+						return nil
+					case *v1alpha1.Topic:
+						if key.Name == existingName {
+							return nil
+						}
+					}
+					return kerrors.NewNotFound(schema.GroupResource{}, key.Name)
+				},
+				MockList: func(_ context.Context, obj client.ObjectList, _ ...client.ListOption) error {
+					// A managed resource written by hand, and one that uses
+					// another ProviderConfig.
+					l := obj.(*v1alpha1.TopicList)
+					l.Items = []v1alpha1.Topic{*observedTopic("handwritten", providerConfigName), *observedTopic("elsewhere", "other")}
+					return nil
+				},
+				MockCreate: func(_ context.Context, obj client.Object, _ ...client.CreateOption) error {
+					mg := obj.(resource.Managed)
+					if !dryrun.Enabled(mg) {
+						t.Errorf("Create(...): want %s to be reconciled in dry-run mode", mg.GetName())
+					}
+					if mg.GetDeletionPolicy() != xpv1.DeletionOrphan {
+						t.Errorf("Create(...): want deletion policy of %s to be Orphan, got %s", mg.GetName(), mg.GetDeletionPolicy())
+					}
+					if mg.GetProviderConfigReference().Name != providerConfigName || mg.GetLabels()[LabelKeyDiscoveredBy] != providerConfigName {
+						t.Errorf("Create(...): want %s to reference and be labelled with ProviderConfig %s", mg.GetName(), providerConfigName)
+					}
+					created = append(created, mg.GetName())
+					return nil
+				},
+			}
+			kinds := map[string]Kind{
+				v1alpha1.TopicGroupKind: {
+					New:     func() resource.Managed { return &v1alpha1.Topic{} },
+					NewList: func() resource.ManagedList { return &v1alpha1.TopicList{} },
+					List:    tc.args.list,
+				},
+			}
+			connect := func(_ context.Context, _ client.Client, mg resource.Managed) (string, []option.ClientOption, error) {
+				if mg.GetProviderConfigReference().Name != providerConfigName {
+					return "", nil, errors.New("unexpected ProviderConfig")
+				}
+				return projectID, nil, nil
+			}
+			r := NewReconciler(c, WithKinds(kinds), WithConnectFn(connect))
+			got, err := r.Reconcile(context.Background(), reconcile.Request{NamespacedName: client.ObjectKey{Name: providerConfigName}})
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nReconcile(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.result, got); diff != "" {
+				t.Errorf("\n%s\nReconcile(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.created, created); diff != "" {
+				t.Errorf("\n%s\nReconcile(...): -want created, +got created:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestReconcileDefaultConnect(t *testing.T) {
+	pc := providerConfig(map[string]string{AnnotationKeyDiscover: v1alpha1.TopicGroupKind})
+	pc.Spec = v1beta1.ProviderConfigSpec{
+		ProjectID: projectID,
+		Credentials: v1beta1.ProviderCredentials{
+			Source: xpv1.CredentialsSourceSecret,
+			CommonCredentialSelectors: xpv1.CommonCredentialSelectors{
+				SecretRef: &xpv1.SecretKeySelector{
+					SecretReference: xpv1.SecretReference{Name: "creds", Namespace: "crossplane-system"},
+					Key:             "token",
+				},
+			},
+		},
+	}
+	c := &test.MockClient{
+		MockGet: func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+			switch o := obj.(type) {
+			case *v1beta1.ProviderConfig:
+				pc.DeepCopyInto(o)
+				return nil
+			case *corev1.Secret:
+				o.Data = map[string][]byte{"token": []byte("an-access-token")}
+				return nil
+			}
+			return kerrors.NewNotFound(schema.GroupResource{}, key.Name)
+		},
+		MockList: test.NewMockListFn(nil),
+		MockCreate: func(_ context.Context, obj client.Object, _ ...client.CreateOption) error {
+			// The usage of the ProviderConfig by the managed resource that
+			// discovery connects on behalf of cannot be tracked, since that
+			// managed resource does not exist.
+			t.Errorf("Create(...): unexpected create of %T", obj)
+			return errBoom
+		},
+	}
+	listed := ""
+	kinds := map[string]Kind{
+		v1alpha1.TopicGroupKind: {
+			New:     func() resource.Managed { return &v1alpha1.Topic{} },
+			NewList: func() resource.ManagedList { return &v1alpha1.TopicList{} },
+			List: func(_ context.Context, project string, opts ...option.ClientOption) ([]Discovered, error) {
+				if len(opts) == 0 {
+					return nil, errors.New("no client options")
+				}
+				listed = project
+				return nil, nil
+			},
+		},
+	}
+
+	r := NewReconciler(c, WithKinds(kinds))
+	got, err := r.Reconcile(context.Background(), reconcile.Request{NamespacedName: client.ObjectKey{Name: providerConfigName}})
+	if diff := cmp.Diff(nil, err, test.EquateErrors()); diff != "" {
+		t.Errorf("Reconcile(...): -want error, +got error:\n%s", diff)
+	}
+	if diff := cmp.Diff(reconcile.Result{RequeueAfter: defaultInterval}, got); diff != "" {
+		t.Errorf("Reconcile(...): -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(projectID, listed); diff != "" {
+		t.Errorf("List(...): -want project, +got project:\n%s", diff)
+	}
+}
+
+func TestListClusters(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.Body.Close()
+		if diff := cmp.Diff("/v1/projects/"+projectID+"/locations/-/clusters", r.URL.Path); diff != "" {
+			t.Errorf("r: -want, +got:\n%s", diff)
+		}
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(&container.ListClustersResponse{Clusters: []*container.Cluster{
+			{Name: "my-cluster", Location: "us-central1"},
+		}})
+	}))
+	defer server.Close()
+
+	found, err := ListClusters(context.Background(), projectID, option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	if err != nil {
+		t.Fatalf("ListClusters(...): %s", err)
+	}
+	if len(found) != 1 {
+		t.Fatalf("ListClusters(...): want 1 cluster, got %d", len(found))
+	}
+	if diff := cmp.Diff("projects/my-project/locations/us-central1/clusters/my-cluster", found[0].ID); diff != "" {
+		t.Errorf("ListClusters(...): -want ID, +got ID:\n%s", diff)
+	}
+	if diff := cmp.Diff("my-cluster", meta.GetExternalName(found[0].Managed)); diff != "" {
+		t.Errorf("ListClusters(...): -want external name, +got external name:\n%s", diff)
+	}
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package discovery
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	container "google.golang.org/api/container/v1"
+	"google.golang.org/api/option"
+	pubsub "google.golang.org/api/pubsub/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/container/v1beta2"
+	"github.com/crossplane-contrib/provider-gcp/apis/pubsub/v1alpha1"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/cluster"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/topic"
+)
+
+// DefaultKinds returns the kinds that can be discovered, keyed by their group
+// kind.
+func DefaultKinds() map[string]Kind {
+	return map[string]Kind{
+		v1beta2.ClusterGroupKind: {
+			New:     func() resource.Managed { return &v1beta2.Cluster{} },
+			NewList: func() resource.ManagedList { return &v1beta2.ClusterList{} },
+			List:    ListClusters,
+		},
+		v1alpha1.TopicGroupKind: {
+			New:     func() resource.Managed { return &v1alpha1.Topic{} },
+			NewList: func() resource.ManagedList { return &v1alpha1.TopicList{} },
+			List:    ListTopics,
+		},
+	}
+}
+
+// ListClusters lists the GKE clusters in every location of a project. The
+// remainder of their spec is late-initialized once they are observed.
+func ListClusters(ctx context.Context, project string, opts ...option.ClientOption) ([]Discovered, error) {
+	s, err := container.NewService(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+	rsp, err := s.Projects.Locations.Clusters.List(fmt.Sprintf(cluster.ParentFormat, project, "-")).Context(ctx).Do()
+	if err != nil {
+		return nil, err
+	}
+	found := make([]Discovered, 0, len(rsp.Clusters))
+	for _, c := range rsp.Clusters {
+		cr := &v1beta2.Cluster{}
+		cr.Spec.ForProvider.Location = c.Location
+		meta.SetExternalName(cr, c.Name)
+		found = append(found, Discovered{
+			ID:      cluster.GetFullyQualifiedName(project, cr.Spec.ForProvider, c.Name),
+			Managed: cr,
+		})
+	}
+	return found, nil
+}
+
+// ListTopics lists the Pub/Sub topics of a project.
+func ListTopics(ctx context.Context, project string, opts ...option.ClientOption) ([]Discovered, error) {
+	s, err := pubsub.NewService(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+	topics, err := topic.List(ctx, s, project)
+	if err != nil {
+		return nil, err
+	}
+	found := make([]Discovered, 0, len(topics))
+	for name := range topics {
+		cr := &v1alpha1.Topic{}
+		meta.SetExternalName(cr, strings.TrimPrefix(name, topic.GetFullyQualifiedName(project, "")))
+		found = append(found, Discovered{ID: name, Managed: cr})
+	}
+	return found, nil
+}
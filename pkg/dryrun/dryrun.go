@@ -0,0 +1,212 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package dryrun lets managed resource reconcilers observe external
+// resources in GCP without ever creating, updating or deleting them, so that
+// changes can be rehearsed with production credentials.
+package dryrun
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+)
+
+// AnnotationKeyDryRun may be set to "true" on a managed resource to reconcile
+// only that resource in dry-run mode.
+const AnnotationKeyDryRun = "gcp.crossplane.io/dry-run"
+
+// TypeDryRun resources are reconciled in dry-run mode. Changes that would
+// have been made to them in GCP are reported but never executed.
+const TypeDryRun xpv1.ConditionType = "DryRun"
+
+// Reasons a resource is or is not reconciled in dry-run mode.
+const (
+	ReasonUpToDate    xpv1.ConditionReason = "UpToDate"
+	ReasonWouldCreate xpv1.ConditionReason = "WouldCreate"
+	ReasonWouldUpdate xpv1.ConditionReason = "WouldUpdate"
+	ReasonWouldDelete xpv1.ConditionReason = "WouldDelete"
+	ReasonDisabled    xpv1.ConditionReason = "Disabled"
+)
+
+func dryRun(r xpv1.ConditionReason, msg string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeDryRun,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             r,
+		Message:            msg,
+	}
+}
+
+// UpToDate returns a condition that indicates a resource reconciled in
+// dry-run mode needs no changes in GCP.
+func UpToDate() xpv1.Condition {
+	return dryRun(ReasonUpToDate, "")
+}
+
+// WouldCreate returns a condition that indicates the external resource would
+// have been created if dry-run mode was disabled.
+func WouldCreate() xpv1.Condition {
+	return dryRun(ReasonWouldCreate, "The external resource does not exist and would be created")
+}
+
+// WouldUpdate returns a condition that indicates the external resource would
+// have been updated if dry-run mode was disabled.
+func WouldUpdate() xpv1.Condition {
+	return dryRun(ReasonWouldUpdate, "The external resource is not up to date and would be updated")
+}
+
+// WouldDelete returns a condition that indicates the external resource would
+// have been deleted if dry-run mode was disabled.
+func WouldDelete() xpv1.Condition {
+	return dryRun(ReasonWouldDelete, "The external resource exists and would be deleted")
+}
+
+// Disabled returns a condition that indicates a resource that was previously
+// reconciled in dry-run mode no longer is.
+func Disabled() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeDryRun,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonDisabled,
+	}
+}
+
+// Enabled returns true if the supplied managed resource is annotated to be
+// reconciled in dry-run mode.
+func Enabled(mg resource.Managed) bool {
+	return mg.GetAnnotations()[AnnotationKeyDryRun] == "true"
+}
+
+// NewExternalConnecter returns an ExternalConnecter whose clients never
+// create, update or delete external resources while in dry-run mode. Every
+// resource is reconciled in dry-run mode if all is true, otherwise only those
+// annotated to be.
+func NewExternalConnecter(c managed.ExternalConnecter, all bool, log logging.Logger) managed.ExternalConnecter {
+	return &connecter{connecter: c, all: all, log: log}
+}
+
+type connecter struct {
+	connecter managed.ExternalConnecter
+	all       bool
+	log       logging.Logger
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	ec, err := c.connecter.Connect(ctx, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: ec, all: c.all, log: c.log}, nil
+}
+
+type external struct {
+	client managed.ExternalClient
+	all    bool
+	log    logging.Logger
+}
+
+func (e *external) enabled(mg resource.Managed) bool {
+	return e.all || Enabled(mg)
+}
+
+// Observe reports any change that would be made to the external resource
+// using the DryRun condition. The observation is then amended to claim the
+// resource exists and is up to date, so that the managed reconciler neither
+// tries to make the change nor records that it started creating a resource.
+// A managed resource that is being deleted is reported not to exist, so that
+// it is finalized while its external resource is left untouched.
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	obs, err := e.client.Observe(ctx, mg)
+	if err != nil {
+		return obs, err
+	}
+	if !e.enabled(mg) {
+		if c := mg.GetCondition(TypeDryRun); c.Status == corev1.ConditionTrue {
+			mg.SetConditions(Disabled())
+		}
+		return obs, nil
+	}
+	log := e.log.WithValues("name", mg.GetName(), "external-name", meta.GetExternalName(mg))
+	switch {
+	case meta.WasDeleted(mg):
+		if obs.ResourceExists {
+			log.Info("Dry run: skipping delete of external resource")
+			mg.SetConditions(WouldDelete())
+		}
+		obs.ResourceExists = false
+		return obs, nil
+	case !obs.ResourceExists:
+		log.Info("Dry run: skipping create of external resource")
+		mg.SetConditions(WouldCreate())
+	case !obs.ResourceUpToDate:
+		log.Info("Dry run: skipping update of external resource")
+		mg.SetConditions(WouldUpdate())
+	default:
+		mg.SetConditions(UpToDate())
+	}
+	obs.ResourceExists = true
+	obs.ResourceUpToDate = true
+	return obs, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	if e.enabled(mg) {
+		e.log.Info("Dry run: skipping create of external resource", "name", mg.GetName())
+		return managed.ExternalCreation{}, nil
+	}
+	return e.client.Create(ctx, mg)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	if e.enabled(mg) {
+		e.log.Info("Dry run: skipping update of external resource", "name", mg.GetName(), "external-name", meta.GetExternalName(mg))
+		return managed.ExternalUpdate{}, nil
+	}
+	return e.client.Update(ctx, mg)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	if e.enabled(mg) {
+		e.log.Info("Dry run: skipping delete of external resource", "name", mg.GetName(), "external-name", meta.GetExternalName(mg))
+		return nil
+	}
+	return e.client.Delete(ctx, mg)
+}
+
+// WithDryRun wraps the supplied ExternalConnecter so that resources annotated
+// with AnnotationKeyDryRun are reconciled in dry-run mode. Every resource is
+// reconciled in dry-run mode if the dry-run feature is enabled.
+func WithDryRun(o controller.Options, gk string, c managed.ExternalConnecter) managed.ExternalConnecter {
+	all := o.Features != nil && o.Features.Enabled(features.EnableAlphaDryRun)
+	log := o.Logger
+	if log == nil {
+		log = logging.NewNopLogger()
+	}
+	return NewExternalConnecter(c, all, log.WithValues("controller", managed.ControllerName(gk)))
+}
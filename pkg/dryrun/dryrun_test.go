@@ -0,0 +1,271 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dryrun
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/pubsub/v1alpha1"
+)
+
+var errBoom = errors.New("boom")
+
+type topicModifier func(*v1alpha1.Topic)
+
+func withAnnotation(v string) topicModifier {
+	return func(cr *v1alpha1.Topic) {
+		cr.SetAnnotations(map[string]string{AnnotationKeyDryRun: v})
+	}
+}
+
+func withDeletionTimestamp() topicModifier {
+	return func(cr *v1alpha1.Topic) {
+		now := metav1.Now()
+		cr.SetDeletionTimestamp(&now)
+	}
+}
+
+func withConditions(c ...xpv1.Condition) topicModifier {
+	return func(cr *v1alpha1.Topic) { cr.SetConditions(c...) }
+}
+
+func topic(m ...topicModifier) *v1alpha1.Topic {
+	cr := &v1alpha1.Topic{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+// observing returns an ExternalClient that observes the supplied observation
+// and fails every call that would change the external resource.
+func observing(obs managed.ExternalObservation) *managed.ExternalClientFns {
+	return &managed.ExternalClientFns{
+		ObserveFn: func(_ context.Context, _ resource.Managed) (managed.ExternalObservation, error) {
+			return obs, nil
+		},
+		CreateFn: func(_ context.Context, _ resource.Managed) (managed.ExternalCreation, error) {
+			return managed.ExternalCreation{}, errBoom
+		},
+		UpdateFn: func(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
+			return managed.ExternalUpdate{}, errBoom
+		},
+		DeleteFn: func(_ context.Context, _ resource.Managed) error {
+			return errBoom
+		},
+	}
+}
+
+func connecterFor(ec managed.ExternalClient, all bool) managed.ExternalConnecter {
+	return NewExternalConnecter(managed.ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+		return ec, nil
+	}), all, logging.NewNopLogger())
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.Topic
+		obs managed.ExternalObservation
+	}
+	cases := map[string]struct {
+		all bool
+		obs managed.ExternalObservation
+		cr  *v1alpha1.Topic
+		want
+	}{
+		"NotDryRun": {
+			obs: managed.ExternalObservation{},
+			cr:  topic(),
+			want: want{
+				cr:  topic(),
+				obs: managed.ExternalObservation{},
+			},
+		},
+		"AnnotatedFalse": {
+			obs: managed.ExternalObservation{},
+			cr:  topic(withAnnotation("false")),
+			want: want{
+				cr:  topic(withAnnotation("false")),
+				obs: managed.ExternalObservation{},
+			},
+		},
+		"NoLongerDryRun": {
+			obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			cr:  topic(withConditions(UpToDate())),
+			want: want{
+				cr:  topic(withConditions(Disabled())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"WouldCreate": {
+			all: true,
+			obs: managed.ExternalObservation{},
+			cr:  topic(),
+			want: want{
+				cr:  topic(withConditions(WouldCreate())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"AnnotatedWouldUpdate": {
+			obs: managed.ExternalObservation{ResourceExists: true},
+			cr:  topic(withAnnotation("true")),
+			want: want{
+				cr:  topic(withAnnotation("true"), withConditions(WouldUpdate())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"UpToDate": {
+			all: true,
+			obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			cr:  topic(),
+			want: want{
+				cr:  topic(withConditions(UpToDate())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"WouldDelete": {
+			all: true,
+			obs: managed.ExternalObservation{ResourceExists: true},
+			cr:  topic(withDeletionTimestamp()),
+			want: want{
+				cr:  topic(withDeletionTimestamp(), withConditions(WouldDelete())),
+				obs: managed.ExternalObservation{},
+			},
+		},
+		"Deleted": {
+			all: true,
+			obs: managed.ExternalObservation{},
+			cr:  topic(withDeletionTimestamp()),
+			want: want{
+				cr:  topic(withDeletionTimestamp()),
+				obs: managed.ExternalObservation{},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ec, err := connecterFor(observing(tc.obs), tc.all).Connect(context.Background(), tc.cr)
+			if err != nil {
+				t.Fatalf("Connect(...): unexpected error: %v", err)
+			}
+			obs, err := ec.Observe(context.Background(), tc.cr)
+			if err != nil {
+				t.Fatalf("Observe(...): unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions(), cmpopts.IgnoreFields(metav1.ObjectMeta{}, "DeletionTimestamp")); diff != "" {
+				t.Errorf("Observe(...): -want resource, +got resource:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestChanges(t *testing.T) {
+	cases := map[string]struct {
+		all  bool
+		cr   *v1alpha1.Topic
+		want error
+	}{
+		"DryRun": {
+			all: true,
+			cr:  topic(),
+		},
+		"Annotated": {
+			cr: topic(withAnnotation("true")),
+		},
+		"NotDryRun": {
+			cr:   topic(),
+			want: errBoom,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ec, err := connecterFor(observing(managed.ExternalObservation{}), tc.all).Connect(context.Background(), tc.cr)
+			if err != nil {
+				t.Fatalf("Connect(...): unexpected error: %v", err)
+			}
+			if _, err := ec.Create(context.Background(), tc.cr); !errors.Is(err, tc.want) {
+				t.Errorf("Create(...): want error %v, got %v", tc.want, err)
+			}
+			if _, err := ec.Update(context.Background(), tc.cr); !errors.Is(err, tc.want) {
+				t.Errorf("Update(...): want error %v, got %v", tc.want, err)
+			}
+			if err := ec.Delete(context.Background(), tc.cr); !errors.Is(err, tc.want) {
+				t.Errorf("Delete(...): want error %v, got %v", tc.want, err)
+			}
+		})
+	}
+}
+
+func TestReconcileDeleted(t *testing.T) {
+	s := runtime.NewScheme()
+	if err := v1alpha1.SchemeBuilder.AddToScheme(s); err != nil {
+		t.Fatalf("AddToScheme(...): unexpected error: %v", err)
+	}
+	finalized := false
+	m := &fake.Manager{
+		Client: &test.MockClient{
+			MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+				cr := obj.(*v1alpha1.Topic)
+				withDeletionTimestamp()(cr)
+				cr.SetDeletionPolicy(xpv1.DeletionDelete)
+				return nil
+			}),
+			MockStatusUpdate: test.NewMockSubResourceUpdateFn(nil),
+		},
+		Scheme: s,
+	}
+	r := managed.NewReconciler(m, resource.ManagedKind(v1alpha1.TopicGroupVersionKind),
+		managed.WithLogger(logging.NewNopLogger()),
+		managed.WithInitializers(),
+		managed.WithReferenceResolver(managed.ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+		managed.WithExternalConnecter(connecterFor(observing(managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}), true)),
+		managed.WithConnectionPublishers(),
+		managed.WithFinalizer(resource.FinalizerFns{RemoveFinalizerFn: func(_ context.Context, _ resource.Object) error {
+			finalized = true
+			return nil
+		}}),
+	)
+	got, err := r.Reconcile(context.Background(), reconcile.Request{})
+	if err != nil {
+		t.Fatalf("Reconcile(...): unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(reconcile.Result{}, got); diff != "" {
+		t.Errorf("Reconcile(...): -want result, +got result:\n%s", diff)
+	}
+	if !finalized {
+		t.Errorf("Reconcile(...): a deleted managed resource should be finalized without deleting its external resource")
+	}
+}
@@ -0,0 +1,196 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package e2e provisions and destroys a small matrix of managed resources in
+// a sandbox project, using a provider that is already running, to smoke test
+// changes that unit tests can not cover.
+package e2e
+
+import (
+	"fmt"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	computev1beta1 "github.com/crossplane-contrib/provider-gcp/apis/compute/v1beta1"
+	containerv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/container/v1beta1"
+	containerv1beta2 "github.com/crossplane-contrib/provider-gcp/apis/container/v1beta2"
+	databasev1beta1 "github.com/crossplane-contrib/provider-gcp/apis/database/v1beta1"
+	pubsubv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/pubsub/v1alpha1"
+	storagev1alpha3 "github.com/crossplane-contrib/provider-gcp/apis/storage/v1alpha3"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+// LabelKeyRun is set on every managed resource created by a run to the ID of
+// the run, so that resources left behind by an interrupted run can be found.
+const LabelKeyRun = "e2e.gcp.crossplane.io/run"
+
+const errUnknownCaseFmt = "unknown case %q"
+
+// A Case is a set of managed resources that are created in order, and
+// deleted in reverse order.
+type Case struct {
+	Name      string
+	Resources []resource.Managed
+}
+
+// MatrixOptions configure the resources of the matrix.
+type MatrixOptions struct {
+	// RunID is appended to the name of every resource, so that concurrent
+	// and consecutive runs do not collide.
+	RunID string
+
+	// ProviderConfig every resource is reconciled with.
+	ProviderConfig string
+
+	// Zone the resources are created in. Regional resources are created in
+	// the region of the zone.
+	Zone string
+}
+
+func (o MatrixOptions) region() string {
+	return o.Zone[:strings.LastIndex(o.Zone, "-")]
+}
+
+func (o MatrixOptions) name(kind string) string {
+	return fmt.Sprintf("xp-e2e-%s-%s", kind, o.RunID)
+}
+
+func (o MatrixOptions) spec() xpv1.ResourceSpec {
+	return xpv1.ResourceSpec{
+		ProviderConfigReference: &xpv1.Reference{Name: o.ProviderConfig},
+		DeletionPolicy:          xpv1.DeletionDelete,
+	}
+}
+
+func (o MatrixOptions) meta(kind string) metav1.ObjectMeta {
+	om := metav1.ObjectMeta{
+		Name:   o.name(kind),
+		Labels: map[string]string{LabelKeyRun: o.RunID},
+	}
+	meta.SetExternalName(&om, o.name(kind))
+	return om
+}
+
+// Matrix returns the cases of a run: a GKE cluster with one node pool in its
+// own network, a Cloud SQL instance, a bucket and a topic. They are as small
+// and cheap as the APIs allow.
+func Matrix(o MatrixOptions) []Case {
+	return []Case{
+		{Name: "GKE", Resources: gke(o)},
+		{Name: "CloudSQL", Resources: []resource.Managed{cloudSQL(o)}},
+		{Name: "Bucket", Resources: []resource.Managed{bucket(o)}},
+		{Name: "Topic", Resources: []resource.Managed{topic(o)}},
+	}
+}
+
+// Select returns the cases of the supplied matrix with the supplied names,
+// in the order of the matrix.
+func Select(matrix []Case, names ...string) ([]Case, error) {
+	want := map[string]bool{}
+	for _, n := range names {
+		want[n] = true
+	}
+	selected := []Case{}
+	for _, c := range matrix {
+		if want[c.Name] {
+			selected = append(selected, c)
+			delete(want, c.Name)
+		}
+	}
+	for n := range want {
+		return nil, errors.Errorf(errUnknownCaseFmt, n)
+	}
+	return selected, nil
+}
+
+func gke(o MatrixOptions) []resource.Managed {
+	network := &computev1beta1.Network{ObjectMeta: o.meta("network")}
+	network.Spec.ResourceSpec = o.spec()
+	network.Spec.ForProvider = computev1beta1.NetworkParameters{
+		AutoCreateSubnetworks: gcp.BoolPtr(false),
+	}
+
+	subnetwork := &computev1beta1.Subnetwork{ObjectMeta: o.meta("subnetwork")}
+	subnetwork.Spec.ResourceSpec = o.spec()
+	subnetwork.Spec.ForProvider = computev1beta1.SubnetworkParameters{
+		Region:      o.region(),
+		IPCidrRange: "10.0.0.0/24",
+		NetworkRef:  &xpv1.Reference{Name: network.GetName()},
+		SecondaryIPRanges: []*computev1beta1.SubnetworkSecondaryRange{
+			{RangeName: "pods", IPCidrRange: "10.4.0.0/14"},
+			{RangeName: "services", IPCidrRange: "10.8.0.0/20"},
+		},
+	}
+
+	cluster := &containerv1beta2.Cluster{ObjectMeta: o.meta("cluster")}
+	cluster.Spec.ResourceSpec = o.spec()
+	cluster.Spec.ForProvider = containerv1beta2.ClusterParameters{
+		Location:      o.Zone,
+		NetworkRef:    &xpv1.Reference{Name: network.GetName()},
+		SubnetworkRef: &xpv1.Reference{Name: subnetwork.GetName()},
+		IPAllocationPolicy: &containerv1beta2.IPAllocationPolicy{
+			UseIPAliases:               gcp.BoolPtr(true),
+			ClusterSecondaryRangeName:  gcp.StringPtr("pods"),
+			ServicesSecondaryRangeName: gcp.StringPtr("services"),
+		},
+	}
+
+	pool := &containerv1beta1.NodePool{ObjectMeta: o.meta("nodepool")}
+	pool.Spec.ResourceSpec = o.spec()
+	pool.Spec.ForProvider = containerv1beta1.NodePoolParameters{
+		ClusterRef:       &xpv1.Reference{Name: cluster.GetName()},
+		InitialNodeCount: gcp.Int64Ptr(1),
+		Config: &containerv1beta1.NodeConfig{
+			MachineType: gcp.StringPtr("e2-small"),
+			DiskSizeGb:  gcp.Int64Ptr(20),
+		},
+	}
+
+	return []resource.Managed{network, subnetwork, cluster, pool}
+}
+
+func cloudSQL(o MatrixOptions) resource.Managed {
+	i := &databasev1beta1.CloudSQLInstance{ObjectMeta: o.meta("cloudsql")}
+	i.Spec.ResourceSpec = o.spec()
+	i.Spec.ForProvider = databasev1beta1.CloudSQLInstanceParameters{
+		Region:          o.region(),
+		DatabaseVersion: gcp.StringPtr("POSTGRES_14"),
+		Settings: databasev1beta1.Settings{
+			Tier:           "db-f1-micro",
+			DataDiskSizeGb: gcp.Int64Ptr(10),
+		},
+	}
+	return i
+}
+
+func bucket(o MatrixOptions) resource.Managed {
+	b := &storagev1alpha3.Bucket{ObjectMeta: o.meta("bucket")}
+	b.Spec.ResourceSpec = o.spec()
+	b.Spec.Location = strings.ToUpper(o.region())
+	return b
+}
+
+func topic(o MatrixOptions) resource.Managed {
+	t := &pubsubv1alpha1.Topic{ObjectMeta: o.meta("topic")}
+	t.Spec.ResourceSpec = o.spec()
+	return t
+}
@@ -0,0 +1,191 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package e2e
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+const (
+	errCreateFmt     = "cannot create %s"
+	errDeleteFmt     = "cannot delete %s"
+	errNotReadyFmt   = "%s did not become ready within %s"
+	errNotDeletedFmt = "%s was not deleted within %s"
+	errLastSyncFmt   = "%s: last reconcile failed: %s"
+)
+
+// A Result is the outcome of running a Case.
+type Result struct {
+	Case     string
+	Duration time.Duration
+
+	// Err is the first error that occurred while the resources of the case
+	// were created, or when they were deleted.
+	Err error
+
+	// Leaked resources could not be deleted, and may have left external
+	// resources behind in the project.
+	Leaked []string
+}
+
+// A Runner runs cases against the provider that reconciles the managed
+// resources of the API server it is connected to.
+type Runner struct {
+	kube          client.Client
+	log           logging.Logger
+	readyTimeout  time.Duration
+	deleteTimeout time.Duration
+	poll          time.Duration
+}
+
+// A RunnerOption configures a Runner.
+type RunnerOption func(*Runner)
+
+// WithLogger configures the logger a Runner uses.
+func WithLogger(l logging.Logger) RunnerOption {
+	return func(r *Runner) {
+		r.log = l
+	}
+}
+
+// WithTimeouts configures how long a Runner waits for a managed resource to
+// become ready, and to be deleted.
+func WithTimeouts(ready, delete time.Duration) RunnerOption {
+	return func(r *Runner) {
+		r.readyTimeout = ready
+		r.deleteTimeout = delete
+	}
+}
+
+// WithPollInterval configures how often a Runner checks whether a managed
+// resource is ready or deleted.
+func WithPollInterval(d time.Duration) RunnerOption {
+	return func(r *Runner) {
+		r.poll = d
+	}
+}
+
+// NewRunner returns a Runner that uses the supplied client.
+func NewRunner(kube client.Client, o ...RunnerOption) *Runner {
+	r := &Runner{
+		kube:          kube,
+		log:           logging.NewNopLogger(),
+		readyTimeout:  30 * time.Minute,
+		deleteTimeout: 30 * time.Minute,
+		poll:          10 * time.Second,
+	}
+	for _, fn := range o {
+		fn(r)
+	}
+	return r
+}
+
+// Run creates the resources of the supplied case one after another, waiting
+// for each to become ready, then deletes them in reverse order. Resources
+// that were created are deleted even if the case fails or the supplied
+// context is cancelled, so that nothing is left behind in the project.
+func (r *Runner) Run(ctx context.Context, c Case) Result {
+	started := time.Now()
+	created := make([]resource.Managed, 0, len(c.Resources))
+	err := func() error {
+		for _, mg := range c.Resources {
+			r.log.Info("Creating", "case", c.Name, "resource", describe(mg))
+			if err := r.kube.Create(ctx, mg); err != nil {
+				return errors.Wrapf(err, errCreateFmt, describe(mg))
+			}
+			created = append(created, mg)
+			if err := r.waitReady(ctx, mg); err != nil {
+				return err
+			}
+		}
+		return nil
+	}()
+
+	// The context of the run may have been cancelled, but the resources must
+	// still be cleaned up.
+	cleanup, cancel := context.WithTimeout(context.Background(), r.deleteTimeout*time.Duration(len(created)+1))
+	defer cancel()
+	var leaked []string
+	for i := len(created) - 1; i >= 0; i-- {
+		if derr := r.delete(cleanup, created[i]); derr != nil {
+			r.log.Info("Cannot clean up", "case", c.Name, "resource", describe(created[i]), "error", derr)
+			leaked = append(leaked, describe(created[i]))
+			if err == nil {
+				err = derr
+			}
+		}
+	}
+	return Result{Case: c.Name, Duration: time.Since(started), Err: err, Leaked: leaked}
+}
+
+// waitReady waits until the supplied managed resource is ready and synced.
+func (r *Runner) waitReady(ctx context.Context, mg resource.Managed) error {
+	ctx, cancel := context.WithTimeout(ctx, r.readyTimeout)
+	defer cancel()
+	var synced xpv1.Condition
+	err := wait.PollImmediateUntilWithContext(ctx, r.poll, func(ctx context.Context) (bool, error) {
+		if err := r.kube.Get(ctx, client.ObjectKeyFromObject(mg), mg); err != nil {
+			return false, resource.IgnoreNotFound(err)
+		}
+		synced = mg.GetCondition(xpv1.TypeSynced)
+		return synced.Status == corev1.ConditionTrue && mg.GetCondition(xpv1.TypeReady).Status == corev1.ConditionTrue, nil
+	})
+	if !errors.Is(err, wait.ErrWaitTimeout) {
+		return err
+	}
+	if synced.Status == corev1.ConditionFalse {
+		return errors.Errorf(errLastSyncFmt, fmt.Sprintf(errNotReadyFmt, describe(mg), r.readyTimeout), synced.Message)
+	}
+	return errors.Errorf(errNotReadyFmt, describe(mg), r.readyTimeout)
+}
+
+// delete deletes the supplied managed resource and waits until it is gone,
+// which is only once its external resource was deleted.
+func (r *Runner) delete(ctx context.Context, mg resource.Managed) error {
+	r.log.Info("Deleting", "resource", describe(mg))
+	if err := r.kube.Delete(ctx, mg); resource.IgnoreNotFound(err) != nil {
+		return errors.Wrapf(err, errDeleteFmt, describe(mg))
+	}
+	ctx, cancel := context.WithTimeout(ctx, r.deleteTimeout)
+	defer cancel()
+	err := wait.PollImmediateUntilWithContext(ctx, r.poll, func(ctx context.Context) (bool, error) {
+		err := r.kube.Get(ctx, client.ObjectKeyFromObject(mg), mg)
+		return kerrors.IsNotFound(err), resource.IgnoreNotFound(err)
+	})
+	if errors.Is(err, wait.ErrWaitTimeout) {
+		return errors.Errorf(errNotDeletedFmt, describe(mg), r.deleteTimeout)
+	}
+	return err
+}
+
+// describe returns the kind and name of the supplied managed resource.
+func describe(mg resource.Managed) string {
+	return fmt.Sprintf("%s %s", reflect.TypeOf(mg).Elem().Name(), mg.GetName())
+}
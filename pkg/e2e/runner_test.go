@@ -0,0 +1,180 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package e2e
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	pubsubv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/pubsub/v1alpha1"
+)
+
+func newTopic(name string) *pubsubv1alpha1.Topic {
+	t := &pubsubv1alpha1.Topic{}
+	t.SetName(name)
+	return t
+}
+
+// fakeProvider is an API server whose managed resources become ready as soon
+// as they are created, and are gone as soon as they are deleted, unless they
+// are supplied to fail.
+type fakeProvider struct {
+	failCreate string
+	notReady   string
+	existing   map[string]bool
+	created    []string
+	deleted    []string
+}
+
+func (p *fakeProvider) client() client.Client {
+	p.existing = map[string]bool{}
+	return &test.MockClient{
+		MockCreate: func(_ context.Context, obj client.Object, _ ...client.CreateOption) error {
+			if obj.GetName() == p.failCreate {
+				return errBoom
+			}
+			p.existing[obj.GetName()] = true
+			p.created = append(p.created, obj.GetName())
+			return nil
+		},
+		MockGet: func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+			if !p.existing[key.Name] {
+				return kerrors.NewNotFound(schema.GroupResource{}, key.Name)
+			}
+			mg := obj.(resource.Managed)
+			if key.Name == p.notReady {
+				mg.SetConditions(xpv1.ReconcileError(errBoom))
+				return nil
+			}
+			mg.SetConditions(xpv1.Available(), xpv1.ReconcileSuccess())
+			return nil
+		},
+		MockDelete: func(_ context.Context, obj client.Object, _ ...client.DeleteOption) error {
+			delete(p.existing, obj.GetName())
+			p.deleted = append(p.deleted, obj.GetName())
+			return nil
+		},
+	}
+}
+
+var errBoom = errors.New("boom")
+
+func TestRun(t *testing.T) {
+	type want struct {
+		err     error
+		created []string
+		deleted []string
+	}
+	cases := map[string]struct {
+		reason   string
+		provider *fakeProvider
+		want     want
+	}{
+		"Passed": {
+			reason:   "Every resource should be created in order and deleted in reverse order.",
+			provider: &fakeProvider{},
+			want: want{
+				created: []string{"a", "b", "c"},
+				deleted: []string{"c", "b", "a"},
+			},
+		},
+		"CreateFailed": {
+			reason:   "The resources created before a creation failed should be deleted.",
+			provider: &fakeProvider{failCreate: "b"},
+			want: want{
+				err:     errors.Wrap(errBoom, "cannot create Topic b"),
+				created: []string{"a"},
+				deleted: []string{"a"},
+			},
+		},
+		"NotReady": {
+			reason:   "A resource that does not become ready should fail the case with its last reconcile error, and be deleted.",
+			provider: &fakeProvider{notReady: "b"},
+			want: want{
+				err:     errors.New("Topic b did not become ready within 10ms: last reconcile failed: boom"),
+				created: []string{"a", "b"},
+				deleted: []string{"b", "a"},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := NewRunner(tc.provider.client(), WithTimeouts(10*time.Millisecond, time.Second), WithPollInterval(time.Millisecond))
+			res := r.Run(context.Background(), Case{Name: name, Resources: []resource.Managed{newTopic("a"), newTopic("b"), newTopic("c")}})
+			if diff := cmp.Diff(tc.want.err, res.Err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nRun(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.created, tc.provider.created); diff != "" {
+				t.Errorf("\n%s\nRun(...): -want created, +got created:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.deleted, tc.provider.deleted); diff != "" {
+				t.Errorf("\n%s\nRun(...): -want deleted, +got deleted:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestSelect(t *testing.T) {
+	matrix := Matrix(MatrixOptions{RunID: "abc", ProviderConfig: "sandbox", Zone: "europe-west1-b"})
+	selected, err := Select(matrix, "Topic", "GKE")
+	if err != nil {
+		t.Fatalf("Select(...): %s", err)
+	}
+	names := []string{}
+	for _, c := range selected {
+		names = append(names, c.Name)
+	}
+	if diff := cmp.Diff([]string{"GKE", "Topic"}, names); diff != "" {
+		t.Errorf("Select(...): -want, +got:\n%s", diff)
+	}
+	if _, err := Select(matrix, "Spanner"); err == nil {
+		t.Errorf("Select(...): expected an error for an unknown case")
+	}
+}
+
+func TestWriteJUnit(t *testing.T) {
+	b := &bytes.Buffer{}
+	err := WriteJUnit(b, "suite", []Result{
+		{Case: "Topic", Duration: 1500 * time.Millisecond},
+		{Case: "GKE", Duration: time.Second, Err: errBoom, Leaked: []string{"Cluster c"}},
+	})
+	if err != nil {
+		t.Fatalf("WriteJUnit(...): %s", err)
+	}
+	for _, want := range []string{
+		`<testsuite name="suite" tests="2" failures="1" time="2.500">`,
+		`<testcase name="Topic" classname="suite" time="1.500"></testcase>`,
+		`<failure message="boom">boom&#xA;leaked: Cluster c</failure>`,
+	} {
+		if !strings.Contains(b.String(), want) {
+			t.Errorf("WriteJUnit(...): want output to contain %q, got:\n%s", want, b.String())
+		}
+	}
+}
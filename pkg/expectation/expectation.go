@@ -0,0 +1,257 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package expectation contains a cache of recent observations that lets
+// managed resource reconcilers skip reading an external resource from GCP
+// when nothing has changed since it was last observed.
+package expectation
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+)
+
+const errTrackUsage = "cannot track ProviderConfig usage"
+
+var (
+	sharedOnce sync.Once
+	shared     *Cache
+)
+
+// annotationPrefix is the prefix of the annotations that control how the
+// provider reconciles a managed resource, e.g. to dry-run it or to confirm
+// that it may be replaced.
+const annotationPrefix = "gcp.crossplane.io/"
+
+// Fingerprint returns a hash of the supplied managed resource's generation,
+// external name, provider annotations and last observed state. The hash
+// changes whenever the desired state is edited, an annotation that controls
+// how the provider reconciles the resource is set, changed or removed, or the
+// observed state, including any etag it carries, is changed locally.
+// Annotations do not change the generation, so they are hashed themselves.
+func Fingerprint(mg resource.Managed) string {
+	h := sha256.New()
+	_, _ = h.Write([]byte(strconv.FormatInt(mg.GetGeneration(), 10)))
+	_, _ = h.Write([]byte{0})
+	_, _ = h.Write([]byte(meta.GetExternalName(mg)))
+	_, _ = h.Write([]byte{0})
+	keys := make([]string, 0, len(mg.GetAnnotations()))
+	for k := range mg.GetAnnotations() {
+		if strings.HasPrefix(k, annotationPrefix) {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		_, _ = h.Write([]byte(k + "=" + mg.GetAnnotations()[k]))
+		_, _ = h.Write([]byte{0})
+	}
+	if u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(mg); err == nil {
+		ap, _, _ := unstructured.NestedFieldNoCopy(u, "status", "atProvider")
+		b, _ := json.Marshal(ap)
+		_, _ = h.Write(b)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+type entry struct {
+	fingerprint string
+	observed    time.Time
+	observation managed.ExternalObservation
+}
+
+// A Cache records the last observation of each managed resource that was
+// found to exist and be up to date. An observation is served from the cache
+// until the TTL elapses or the resource's fingerprint changes.
+type Cache struct {
+	ttl time.Duration
+	now func() time.Time
+
+	mu      sync.Mutex
+	entries map[types.UID]entry
+}
+
+// A CacheOption configures a Cache.
+type CacheOption func(*Cache)
+
+// WithClock configures the function a Cache uses to tell the time.
+func WithClock(now func() time.Time) CacheOption {
+	return func(c *Cache) {
+		c.now = now
+	}
+}
+
+// NewCache returns a Cache whose observations expire after the supplied TTL.
+func NewCache(ttl time.Duration, o ...CacheOption) *Cache {
+	c := &Cache{ttl: ttl, now: time.Now, entries: map[types.UID]entry{}}
+	for _, fn := range o {
+		fn(c)
+	}
+	return c
+}
+
+// Lookup returns the cached observation of the supplied managed resource, if
+// one exists and is still valid.
+func (c *Cache) Lookup(mg resource.Managed) (managed.ExternalObservation, bool) {
+	if meta.WasDeleted(mg) {
+		return managed.ExternalObservation{}, false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.entries[mg.GetUID()]
+	if !ok {
+		return managed.ExternalObservation{}, false
+	}
+	if c.now().Sub(e.observed) >= c.ttl || e.fingerprint != Fingerprint(mg) {
+		delete(c.entries, mg.GetUID())
+		return managed.ExternalObservation{}, false
+	}
+	return e.observation, true
+}
+
+// Observed records an observation of the supplied managed resource. Only
+// observations of resources that exist, are up to date and did not need to
+// be late initialized are cached; any other observation evicts the resource.
+func (c *Cache) Observed(mg resource.Managed, obs managed.ExternalObservation) {
+	if !obs.ResourceExists || !obs.ResourceUpToDate || obs.ResourceLateInitialized || meta.WasDeleted(mg) {
+		c.Forget(mg)
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[mg.GetUID()] = entry{fingerprint: Fingerprint(mg), observed: c.now(), observation: obs}
+}
+
+// Forget evicts the supplied managed resource from the cache.
+func (c *Cache) Forget(mg resource.Managed) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, mg.GetUID())
+}
+
+// NewExternalConnecter returns an ExternalConnecter that serves observations
+// from the supplied Cache when it can. The wrapped ExternalConnecter is only
+// called, and thus credentials only loaded, when GCP must be contacted. The
+// usage of its ProviderConfig is tracked by the supplied Tracker when an
+// observation is served from the Cache, like it would be when connecting.
+func NewExternalConnecter(c managed.ExternalConnecter, cache *Cache, t resource.Tracker) managed.ExternalConnecter {
+	return &connecter{connecter: c, cache: cache, tracker: t}
+}
+
+type connecter struct {
+	connecter managed.ExternalConnecter
+	cache     *Cache
+	tracker   resource.Tracker
+}
+
+func (c *connecter) Connect(_ context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+	return &external{connecter: c.connecter, cache: c.cache, tracker: c.tracker}, nil
+}
+
+type external struct {
+	connecter managed.ExternalConnecter
+	cache     *Cache
+	tracker   resource.Tracker
+	client    managed.ExternalClient
+}
+
+func (e *external) connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	if e.client != nil {
+		return e.client, nil
+	}
+	c, err := e.connecter.Connect(ctx, mg)
+	if err != nil {
+		return nil, err
+	}
+	e.client = c
+	return c, nil
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	if obs, ok := e.cache.Lookup(mg); ok {
+		return obs, errors.Wrap(e.tracker.Track(ctx, mg), errTrackUsage)
+	}
+	c, err := e.connect(ctx, mg)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	obs, err := c.Observe(ctx, mg)
+	if err != nil {
+		e.cache.Forget(mg)
+		return obs, err
+	}
+	e.cache.Observed(mg, obs)
+	return obs, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	e.cache.Forget(mg)
+	c, err := e.connect(ctx, mg)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	return c.Create(ctx, mg)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	e.cache.Forget(mg)
+	c, err := e.connect(ctx, mg)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	return c.Update(ctx, mg)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	e.cache.Forget(mg)
+	c, err := e.connect(ctx, mg)
+	if err != nil {
+		return err
+	}
+	return c.Delete(ctx, mg)
+}
+
+// WithExpectations wraps the supplied ExternalConnecter so that it is backed
+// by a Cache shared by all controllers, if the expectation cache feature is
+// enabled. Cached observations expire after the poll interval.
+func WithExpectations(mgr ctrl.Manager, o controller.Options, c managed.ExternalConnecter) managed.ExternalConnecter {
+	if o.Features == nil || !o.Features.Enabled(features.EnableAlphaExpectationCache) {
+		return c
+	}
+	sharedOnce.Do(func() { shared = NewCache(o.PollInterval) })
+	return NewExternalConnecter(c, shared, resource.NewProviderConfigUsageTracker(mgr.GetClient(), &v1beta1.ProviderConfigUsage{}))
+}
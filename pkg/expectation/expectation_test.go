@@ -0,0 +1,202 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package expectation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/pubsub/v1alpha1"
+)
+
+var (
+	ttl = time.Minute
+	t0  = time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	upToDate = managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  true,
+		ConnectionDetails: managed.ConnectionDetails{"key": []byte("value")},
+	}
+)
+
+func topic(m ...func(*v1alpha1.Topic)) *v1alpha1.Topic {
+	cr := &v1alpha1.Topic{ObjectMeta: metav1.ObjectMeta{UID: "uid", Generation: 1}}
+	meta.SetExternalName(cr, "topic")
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func TestCache(t *testing.T) {
+	type want struct {
+		obs managed.ExternalObservation
+		ok  bool
+	}
+	cases := map[string]struct {
+		observed managed.ExternalObservation
+		elapsed  time.Duration
+		mg       resource.Managed
+		want     want
+	}{
+		"Hit": {
+			observed: upToDate,
+			elapsed:  ttl / 2,
+			mg:       topic(),
+			want:     want{obs: upToDate, ok: true},
+		},
+		"Expired": {
+			observed: upToDate,
+			elapsed:  ttl,
+			mg:       topic(),
+		},
+		"GenerationChanged": {
+			observed: upToDate,
+			mg:       topic(func(cr *v1alpha1.Topic) { cr.SetGeneration(2) }),
+		},
+		"ObservationChanged": {
+			observed: upToDate,
+			mg:       topic(func(cr *v1alpha1.Topic) { cr.Status.AtProvider.Name = "projects/foo/topics/topic" }),
+		},
+		"DryRunAnnotated": {
+			observed: upToDate,
+			mg: topic(func(cr *v1alpha1.Topic) {
+				meta.AddAnnotations(cr, map[string]string{"gcp.crossplane.io/dry-run": "true"})
+			}),
+		},
+		"ReplaceConfirmed": {
+			observed: upToDate,
+			mg: topic(func(cr *v1alpha1.Topic) {
+				meta.AddAnnotations(cr, map[string]string{"gcp.crossplane.io/confirm-replace": "topic"})
+			}),
+		},
+		"OtherAnnotationChanged": {
+			observed: upToDate,
+			elapsed:  ttl / 2,
+			mg: topic(func(cr *v1alpha1.Topic) {
+				meta.AddAnnotations(cr, map[string]string{"example.org/owner": "team-a"})
+			}),
+			want: want{obs: upToDate, ok: true},
+		},
+		"Deleted": {
+			observed: upToDate,
+			mg:       topic(func(cr *v1alpha1.Topic) { cr.SetDeletionTimestamp(&metav1.Time{Time: t0}) }),
+		},
+		"NotUpToDateIsNotCached": {
+			observed: managed.ExternalObservation{ResourceExists: true},
+			mg:       topic(),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			now := t0
+			c := NewCache(ttl, WithClock(func() time.Time { return now }))
+			c.Observed(topic(), tc.observed)
+			now = now.Add(tc.elapsed)
+			obs, ok := c.Lookup(tc.mg)
+			if diff := cmp.Diff(tc.want, want{obs: obs, ok: ok}, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("Lookup(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestExternal(t *testing.T) {
+	var connects, observes int
+	c := managed.ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+		connects++
+		return managed.ExternalClientFns{
+			ObserveFn: func(_ context.Context, _ resource.Managed) (managed.ExternalObservation, error) {
+				observes++
+				return upToDate, nil
+			},
+			UpdateFn: func(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
+				return managed.ExternalUpdate{}, nil
+			},
+		}, nil
+	})
+	var tracks int
+	tr := resource.TrackerFn(func(_ context.Context, _ resource.Managed) error {
+		tracks++
+		return nil
+	})
+	ec := NewExternalConnecter(c, NewCache(ttl), tr)
+	cr := topic()
+	observe := func() {
+		e, err := ec.Connect(context.Background(), cr)
+		if err != nil {
+			t.Fatalf("Connect(...): unexpected error: %v", err)
+		}
+		obs, err := e.Observe(context.Background(), cr)
+		if err != nil {
+			t.Fatalf("Observe(...): unexpected error: %v", err)
+		}
+		if diff := cmp.Diff(upToDate, obs); diff != "" {
+			t.Errorf("Observe(...): -want, +got:\n%s", diff)
+		}
+	}
+
+	observe()
+	observe()
+	if connects != 1 || observes != 1 {
+		t.Errorf("second Observe(...): want 1 connect and 1 observe, got %d and %d", connects, observes)
+	}
+	if tracks != 1 {
+		t.Errorf("second Observe(...): want ProviderConfig usage to be tracked once, got %d", tracks)
+	}
+
+	e, _ := ec.Connect(context.Background(), cr)
+	if _, err := e.Update(context.Background(), cr); err != nil {
+		t.Fatalf("Update(...): unexpected error: %v", err)
+	}
+	observe()
+	if observes != 2 {
+		t.Errorf("Observe(...) after Update(...): want 2 observes, got %d", observes)
+	}
+}
+
+func TestExternalTrackError(t *testing.T) {
+	errBoom := errors.New("boom")
+	c := managed.ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+		return managed.ExternalClientFns{
+			ObserveFn: func(_ context.Context, _ resource.Managed) (managed.ExternalObservation, error) {
+				return upToDate, nil
+			},
+		}, nil
+	})
+	tr := resource.TrackerFn(func(_ context.Context, _ resource.Managed) error { return errBoom })
+	cache := NewCache(ttl)
+	cr := topic()
+	cache.Observed(cr, upToDate)
+
+	e, _ := NewExternalConnecter(c, cache, tr).Connect(context.Background(), cr)
+	_, err := e.Observe(context.Background(), cr)
+	if diff := cmp.Diff(errors.Wrap(errBoom, errTrackUsage), err, test.EquateErrors()); diff != "" {
+		t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+	}
+}
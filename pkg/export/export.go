@@ -0,0 +1,152 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package export renders the canonical external configuration of a managed
+// resource, i.e. the GCP API request body the provider sends to create it.
+// It is built on the same Generate functions the controllers use, so that
+// what auditors compare against a change ticket is exactly what is sent.
+package export
+
+import (
+	"fmt"
+	"io"
+
+	compute "google.golang.org/api/compute/v1"
+	container "google.golang.org/api/container/v1"
+	sqladmin "google.golang.org/api/sqladmin/v1beta4"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	computev1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	computev1beta1 "github.com/crossplane-contrib/provider-gcp/apis/compute/v1beta1"
+	containerv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/container/v1beta1"
+	containerv1beta2 "github.com/crossplane-contrib/provider-gcp/apis/container/v1beta2"
+	databasev1beta1 "github.com/crossplane-contrib/provider-gcp/apis/database/v1beta1"
+	privatecav1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/privateca/v1alpha1"
+	pubsubv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/pubsub/v1alpha1"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/address"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/capool"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/cloudsql"
+	gke "github.com/crossplane-contrib/provider-gcp/pkg/clients/cluster"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/firewall"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/globaladdress"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/network"
+	np "github.com/crossplane-contrib/provider-gcp/pkg/clients/nodepool"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/subnetwork"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/topic"
+)
+
+const (
+	errUnsupported  = "exporting this kind is not supported"
+	errReadManifest = "cannot read manifest"
+	errDecode       = "cannot decode manifest"
+	errNotManaged   = "manifest is not a managed resource"
+)
+
+// Configuration is the canonical external configuration of a managed
+// resource.
+type Configuration struct {
+	// Name is the fully qualified name of the external resource.
+	Name string `json:"name"`
+
+	// Body is the API request body the provider sends to create the external
+	// resource.
+	Body interface{} `json:"body"`
+}
+
+// Export returns the canonical external configuration of the supplied managed
+// resource in the supplied project. Like the provider, it uses the name of
+// the managed resource if it has no external name.
+func Export(projectID string, mg resource.Managed) (*Configuration, error) { // nolint:gocyclo
+	name := meta.GetExternalName(mg)
+	if name == "" {
+		name = mg.GetName()
+	}
+
+	switch cr := mg.(type) {
+	case *computev1beta1.Network:
+		n := &compute.Network{}
+		network.GenerateNetwork(name, cr.Spec.ForProvider, n)
+		return &Configuration{Name: fmt.Sprintf("projects/%s/global/networks/%s", projectID, name), Body: n}, nil
+	case *computev1beta1.Subnetwork:
+		s := &compute.Subnetwork{}
+		subnetwork.GenerateSubnetwork(name, cr.Spec.ForProvider, s)
+		return &Configuration{Name: fmt.Sprintf("projects/%s/regions/%s/subnetworks/%s", projectID, cr.Spec.ForProvider.Region, name), Body: s}, nil
+	case *computev1beta1.Address:
+		a := &compute.Address{}
+		address.GenerateAddress(name, cr.Spec.ForProvider, a)
+		return &Configuration{Name: fmt.Sprintf("projects/%s/regions/%s/addresses/%s", projectID, cr.Spec.ForProvider.Region, name), Body: a}, nil
+	case *computev1beta1.GlobalAddress:
+		a := &compute.Address{}
+		globaladdress.GenerateGlobalAddress(name, cr.Spec.ForProvider, a)
+		return &Configuration{Name: fmt.Sprintf("projects/%s/global/addresses/%s", projectID, name), Body: a}, nil
+	case *computev1alpha1.Firewall:
+		f := &compute.Firewall{}
+		firewall.GenerateFirewall(name, cr.Spec.ForProvider, f)
+		return &Configuration{Name: fmt.Sprintf("projects/%s/global/firewalls/%s", projectID, name), Body: f}, nil
+	case *containerv1beta2.Cluster:
+		c := &container.Cluster{}
+		gke.GenerateCluster(name, cr.Spec.ForProvider, c)
+		return &Configuration{Name: gke.GetFullyQualifiedName(projectID, cr.Spec.ForProvider, name), Body: c}, nil
+	case *containerv1beta1.NodePool:
+		p := &container.NodePool{}
+		np.GenerateNodePool(name, cr.Spec.ForProvider, p)
+		return &Configuration{Name: np.GetFullyQualifiedName(cr.Spec.ForProvider, name), Body: p}, nil
+	case *databasev1beta1.CloudSQLInstance:
+		i := &sqladmin.DatabaseInstance{}
+		cloudsql.GenerateDatabaseInstance(name, cr.Spec.ForProvider, i)
+		return &Configuration{Name: fmt.Sprintf("projects/%s/instances/%s", projectID, name), Body: i}, nil
+	case *pubsubv1alpha1.Topic:
+		return &Configuration{Name: topic.GetFullyQualifiedName(projectID, name), Body: topic.GenerateTopic(name, cr.Spec.ForProvider)}, nil
+	case *privatecav1alpha1.CaPool:
+		return &Configuration{Name: capool.GetFullyQualifiedName(projectID, cr.Spec.ForProvider.Location, name), Body: capool.GenerateCaPool("", cr.Spec.ForProvider)}, nil
+	}
+	return nil, errors.New(errUnsupported)
+}
+
+// Read returns the managed resources of the supplied scheme in the supplied
+// stream of YAML or JSON manifests. Documents that are empty are skipped.
+func Read(r io.Reader, s *runtime.Scheme) ([]resource.Managed, error) {
+	d := serializer.NewCodecFactory(s).UniversalDeserializer()
+	y := utilyaml.NewYAMLOrJSONDecoder(r, 4096)
+	var mgs []resource.Managed
+	for {
+		raw := runtime.RawExtension{}
+		if err := y.Decode(&raw); err != nil {
+			if errors.Is(err, io.EOF) {
+				return mgs, nil
+			}
+			return nil, errors.Wrap(err, errReadManifest)
+		}
+		if len(raw.Raw) == 0 {
+			continue
+		}
+		o, _, err := d.Decode(raw.Raw, nil, nil)
+		if err != nil {
+			return nil, errors.Wrap(err, errDecode)
+		}
+		mg, ok := o.(resource.Managed)
+		if !ok {
+			return nil, errors.New(errNotManaged)
+		}
+		mgs = append(mgs, mg)
+	}
+}
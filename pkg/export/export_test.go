@@ -0,0 +1,116 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package export
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis"
+)
+
+const manifests = `
+---
+apiVersion: compute.gcp.crossplane.io/v1beta1
+kind: Network
+metadata:
+  name: example
+spec:
+  forProvider:
+    autoCreateSubnetworks: false
+    routingConfig:
+      routingMode: REGIONAL
+---
+apiVersion: pubsub.gcp.crossplane.io/v1alpha1
+kind: Topic
+metadata:
+  name: example
+  annotations:
+    crossplane.io/external-name: orders
+spec:
+  forProvider:
+    labels:
+      team: payments
+`
+
+func TestExport(t *testing.T) {
+	s := runtime.NewScheme()
+	if err := apis.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+
+	type want struct {
+		json []string
+		err  error
+	}
+	cases := map[string]struct {
+		manifests string
+		want      want
+	}{
+		"Supported": {
+			manifests: manifests,
+			want: want{json: []string{
+				`{"name":"projects/p/global/networks/example","body":{"autoCreateSubnetworks":false,"name":"example","routingConfig":{"routingMode":"REGIONAL"}}}`,
+				`{"name":"projects/p/topics/orders","body":{"labels":{"team":"payments"},"name":"orders"}}`,
+			}},
+		},
+		"Unsupported": {
+			manifests: `{"apiVersion":"pubsub.gcp.crossplane.io/v1alpha1","kind":"Subscription","metadata":{"name":"example"}}`,
+			want:      want{err: errors.New(errUnsupported)},
+		},
+		"NotManaged": {
+			manifests: `{"apiVersion":"gcp.crossplane.io/v1beta1","kind":"ProviderConfig","metadata":{"name":"example"}}`,
+			want:      want{err: errors.New(errNotManaged)},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got []string
+			err := func() error {
+				mgs, err := Read(strings.NewReader(tc.manifests), s)
+				if err != nil {
+					return err
+				}
+				for _, mg := range mgs {
+					c, err := Export("p", mg)
+					if err != nil {
+						return err
+					}
+					b, err := json.Marshal(c)
+					if err != nil {
+						return err
+					}
+					got = append(got, string(b))
+				}
+				return nil
+			}()
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Export(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.json, got); diff != "" {
+				t.Errorf("Export(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -24,4 +24,51 @@ const (
 	// External Secret Stores. See the below design for more details.
 	// https://github.com/crossplane/crossplane/blob/390ddd/design/design-doc-external-secret-stores.md
 	EnableAlphaExternalSecretStores feature.Flag = "EnableAlphaExternalSecretStores"
+
+	// EnableAlphaExpectationCache enables alpha support for serving
+	// observations of unchanged resources from a cache rather than reading
+	// them from GCP again before the poll interval has elapsed.
+	EnableAlphaExpectationCache feature.Flag = "EnableAlphaExpectationCache"
+
+	// EnableAlphaQuotaPreflight enables alpha support for checking regional
+	// Compute Engine quota before a GKE node pool is created, so that a
+	// shortfall is reported up front rather than failing mid-provision.
+	EnableAlphaQuotaPreflight feature.Flag = "EnableAlphaQuotaPreflight"
+
+	// EnableAlphaPermissionPreflight enables alpha support for testing the
+	// IAM permissions of the provider credentials before a resource kind is
+	// reconciled, so that every missing permission is reported at once.
+	EnableAlphaPermissionPreflight feature.Flag = "EnableAlphaPermissionPreflight"
+
+	// EnableAlphaDryRun enables alpha support for reconciling every managed
+	// resource in dry-run mode, in which changes that would be made in GCP
+	// are logged and reported as conditions but never executed.
+	EnableAlphaDryRun feature.Flag = "EnableAlphaDryRun"
+
+	// EnableAlphaLocationPreflight enables alpha support for checking the
+	// locations of GKE clusters and node pools against the resource
+	// locations organization policy of their project before creating them.
+	EnableAlphaLocationPreflight feature.Flag = "EnableAlphaLocationPreflight"
+
+	// EnableAlphaBatchObserve enables alpha support for observing Buckets
+	// and Topics from a periodic listing of their project rather than
+	// reading each of them from GCP.
+	EnableAlphaBatchObserve feature.Flag = "EnableAlphaBatchObserve"
+
+	// EnableAlphaProjectStateCheck enables alpha support for detecting
+	// resources whose project is pending deletion or has billing disabled,
+	// reporting them with a condition, backing off from calling GCP for
+	// them, and letting the deletion of resources whose project is pending
+	// deletion complete without deleting the unreachable external resource.
+	EnableAlphaProjectStateCheck feature.Flag = "EnableAlphaProjectStateCheck"
+
+	// EnableAlphaDiscovery enables alpha support for creating managed
+	// resources that observe the existing external resources in the project
+	// of each ProviderConfig annotated to discover them.
+	EnableAlphaDiscovery feature.Flag = "EnableAlphaDiscovery"
+
+	// EnableBetaGKEAPI enables using the beta GKE API to manage Clusters and
+	// NodePools, which is required to configure fields that the GA API does
+	// not support.
+	EnableBetaGKEAPI feature.Flag = "EnableBetaGKEAPI"
 )
@@ -0,0 +1,101 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package immutable detects changes to the fields of managed resources that
+// GCP only accepts when an external resource is created. Updates that include
+// such changes are otherwise rejected, or worse, silently ignored, leaving the
+// resource out of sync forever.
+package immutable
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+)
+
+// ReasonImmutableFieldChanged is the reason of the event recorded when an
+// external resource cannot be updated because immutable fields changed.
+const ReasonImmutableFieldChanged event.Reason = "ImmutableFieldChanged"
+
+const errChangedFmt = "cannot update immutable fields of spec.forProvider: %s; revert them, or delete and create the resource again to change them"
+
+// A Field that can only be set when an external resource is created.
+type Field struct {
+	// Path of the field, relative to spec.forProvider.
+	Path string
+
+	// Desired value of the field. A nil pointer means the field is not set,
+	// in which case GCP chooses its value and it never changes.
+	Desired interface{}
+
+	// Observed value of the field. A nil pointer is the zero value of the
+	// type it points to.
+	Observed interface{}
+
+	// Options used to compare the desired and observed values, in addition
+	// to treating nil and empty slices and maps as equal.
+	Options []cmp.Option
+}
+
+// indirect returns the value the supplied pointer points to, or the zero
+// value of its type if it is nil. Any other value is returned as is.
+func indirect(v interface{}) (interface{}, bool) {
+	rv := reflect.ValueOf(v)
+	if !rv.IsValid() {
+		return nil, false
+	}
+	if rv.Kind() != reflect.Ptr {
+		return v, true
+	}
+	if rv.IsNil() {
+		return reflect.Zero(rv.Type().Elem()).Interface(), false
+	}
+	return rv.Elem().Interface(), true
+}
+
+// Changed returns the paths of the supplied fields whose desired value is set
+// and differs from their observed value.
+func Changed(fields ...Field) []string {
+	changed := []string{}
+	for _, f := range fields {
+		desired, set := indirect(f.Desired)
+		if !set {
+			continue
+		}
+		observed, _ := indirect(f.Observed)
+		if !cmp.Equal(desired, observed, append([]cmp.Option{cmpopts.EquateEmpty()}, f.Options...)...) {
+			changed = append(changed, f.Path)
+		}
+	}
+	return changed
+}
+
+// Error returns an error that explains the supplied immutable fields cannot
+// be updated.
+func Error(changed []string) error {
+	return errors.Errorf(errChangedFmt, strings.Join(changed, ", "))
+}
+
+// Changing returns the event recorded when an external resource cannot be
+// updated because the supplied immutable fields changed.
+func Changing(changed []string) event.Event {
+	return event.Warning(ReasonImmutableFieldChanged, Error(changed))
+}
@@ -0,0 +1,71 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package immutable
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestChanged(t *testing.T) {
+	enabled := true
+	cidr := "10.96.0.0/14"
+	cases := map[string]struct {
+		reason string
+		fields []Field
+		want   []string
+	}{
+		"Unset": {
+			reason: "A field that is not set should never be reported as changed.",
+			fields: []Field{{Path: "clusterIpv4Cidr", Desired: (*string)(nil), Observed: "10.4.0.0/14"}},
+			want:   []string{},
+		},
+		"Unchanged": {
+			reason: "A field whose desired and observed values are equal should not be reported as changed.",
+			fields: []Field{{Path: "clusterIpv4Cidr", Desired: &cidr, Observed: "10.96.0.0/14"}},
+			want:   []string{},
+		},
+		"ObservedNil": {
+			reason: "A nil observed pointer should be treated as the zero value.",
+			fields: []Field{{Path: "confidentialNodes.enabled", Desired: &enabled, Observed: (*bool)(nil)}},
+			want:   []string{"confidentialNodes.enabled"},
+		},
+		"Changed": {
+			reason: "Every field whose desired value differs from its observed value should be reported.",
+			fields: []Field{
+				{Path: "clusterIpv4Cidr", Desired: &cidr, Observed: "10.4.0.0/14"},
+				{Path: "enableTpu", Desired: &enabled, Observed: false},
+			},
+			want: []string{"clusterIpv4Cidr", "enableTpu"},
+		},
+		"Options": {
+			reason: "The supplied options should be used to compare a field.",
+			fields: []Field{{Path: "network", Desired: "Default", Observed: "default", Options: []cmp.Option{cmp.Comparer(strings.EqualFold)}}},
+			want:   []string{},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := Changed(tc.fields...)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nChanged(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,167 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package migration rewrites persisted custom resources in the storage
+// version of their CustomResourceDefinition, so that older versions can be
+// dropped from the CRD's status.storedVersions and, eventually, its spec.
+package migration
+
+import (
+	"context"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+const (
+	errListCRDs            = "cannot list CustomResourceDefinitions"
+	errNoStorageVersion    = "CustomResourceDefinition has no storage version"
+	errListObjects         = "cannot list custom resources"
+	errRewriteObject       = "cannot rewrite custom resource in storage version"
+	errUpdateStoredVersion = "cannot update stored versions of CustomResourceDefinition"
+)
+
+var crdListGVK = schema.GroupVersionKind{Group: "apiextensions.k8s.io", Version: "v1", Kind: "CustomResourceDefinitionList"}
+
+// A Migrator migrates the custom resources of an API group to the storage
+// version of their CustomResourceDefinitions.
+type Migrator struct {
+	kube   client.Client
+	log    logging.Logger
+	dryRun bool
+}
+
+// An Option configures a Migrator.
+type Option func(*Migrator)
+
+// WithLogger configures the logger a Migrator uses.
+func WithLogger(l logging.Logger) Option {
+	return func(m *Migrator) {
+		m.log = l
+	}
+}
+
+// WithDryRun configures a Migrator to report what it would migrate without
+// writing anything.
+func WithDryRun(dryRun bool) Option {
+	return func(m *Migrator) {
+		m.dryRun = dryRun
+	}
+}
+
+// NewMigrator returns a Migrator that uses the supplied client.
+func NewMigrator(kube client.Client, o ...Option) *Migrator {
+	m := &Migrator{kube: kube, log: logging.NewNopLogger()}
+	for _, fn := range o {
+		fn(m)
+	}
+	return m
+}
+
+// Migrate rewrites every custom resource of the supplied API group whose
+// CustomResourceDefinition records more than one stored version, then
+// records the storage version as the only stored version.
+func (m *Migrator) Migrate(ctx context.Context, group string) error {
+	crds := &unstructured.UnstructuredList{}
+	crds.SetGroupVersionKind(crdListGVK)
+	if err := m.kube.List(ctx, crds); err != nil {
+		return errors.Wrap(err, errListCRDs)
+	}
+	for i := range crds.Items {
+		crd := &crds.Items[i]
+		if g, _, _ := unstructured.NestedString(crd.Object, "spec", "group"); g != group {
+			continue
+		}
+		if err := m.migrateCRD(ctx, crd); err != nil {
+			return errors.Wrapf(err, "cannot migrate %s", crd.GetName())
+		}
+	}
+	return nil
+}
+
+func (m *Migrator) migrateCRD(ctx context.Context, crd *unstructured.Unstructured) error {
+	storage := StorageVersion(crd)
+	if storage == "" {
+		return errors.New(errNoStorageVersion)
+	}
+	stored, _, _ := unstructured.NestedStringSlice(crd.Object, "status", "storedVersions")
+	if len(stored) == 1 && stored[0] == storage {
+		m.log.Debug("Nothing to migrate", "crd", crd.GetName(), "storageVersion", storage)
+		return nil
+	}
+
+	group, _, _ := unstructured.NestedString(crd.Object, "spec", "group")
+	kind, _, _ := unstructured.NestedString(crd.Object, "spec", "names", "kind")
+	l := &unstructured.UnstructuredList{}
+	l.SetGroupVersionKind(schema.GroupVersionKind{Group: group, Version: storage, Kind: kind + "List"})
+	if err := m.kube.List(ctx, l); err != nil {
+		return errors.Wrap(err, errListObjects)
+	}
+
+	m.log.Info("Migrating custom resources", "crd", crd.GetName(), "storedVersions", stored, "storageVersion", storage, "count", len(l.Items))
+	if m.dryRun {
+		return nil
+	}
+
+	for i := range l.Items {
+		// Writing an object back unchanged makes the API server persist it in
+		// the current storage version.
+		o := &l.Items[i]
+		err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+			err := m.kube.Update(ctx, o)
+			if kerrors.IsConflict(err) {
+				// Somebody else wrote the object in the meantime, which is
+				// fine; all that matters is that it is written once more.
+				if gErr := m.kube.Get(ctx, client.ObjectKeyFromObject(o), o); gErr != nil {
+					return gErr
+				}
+			}
+			return err
+		})
+		if resource.Ignore(kerrors.IsNotFound, err) != nil {
+			return errors.Wrapf(err, "%s: %s", errRewriteObject, o.GetName())
+		}
+	}
+
+	if err := unstructured.SetNestedStringSlice(crd.Object, []string{storage}, "status", "storedVersions"); err != nil {
+		return errors.Wrap(err, errUpdateStoredVersion)
+	}
+	return errors.Wrap(m.kube.Status().Update(ctx, crd), errUpdateStoredVersion)
+}
+
+// StorageVersion returns the version that the supplied CustomResourceDefinition
+// persists its custom resources in, or an empty string if it has none.
+func StorageVersion(crd *unstructured.Unstructured) string {
+	versions, _, _ := unstructured.NestedSlice(crd.Object, "spec", "versions")
+	for _, v := range versions {
+		v, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if s, _, _ := unstructured.NestedBool(v, "storage"); s {
+			name, _, _ := unstructured.NestedString(v, "name")
+			return name
+		}
+	}
+	return ""
+}
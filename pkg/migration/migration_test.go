@@ -0,0 +1,142 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package migration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+const group = "container.gcp.crossplane.io"
+
+func crd(name, kind string, storedVersions ...interface{}) unstructured.Unstructured {
+	return unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apiextensions.k8s.io/v1",
+		"kind":       "CustomResourceDefinition",
+		"metadata":   map[string]interface{}{"name": name},
+		"spec": map[string]interface{}{
+			"group": group,
+			"names": map[string]interface{}{"kind": kind},
+			"versions": []interface{}{
+				map[string]interface{}{"name": "v1beta1", "served": true, "storage": false},
+				map[string]interface{}{"name": "v1beta2", "served": true, "storage": true},
+			},
+		},
+		"status": map[string]interface{}{"storedVersions": storedVersions},
+	}}
+}
+
+func object(name string) unstructured.Unstructured {
+	o := unstructured.Unstructured{}
+	o.SetAPIVersion(group + "/v1beta2")
+	o.SetKind("Cluster")
+	o.SetName(name)
+	return o
+}
+
+func TestMigrate(t *testing.T) {
+	errBoom := errors.New("boom")
+	conflict := kerrors.NewConflict(schema.GroupResource{Group: group, Resource: "clusters"}, "b", errBoom)
+
+	type want struct {
+		err            error
+		updated        []string
+		storedVersions []string
+	}
+	cases := map[string]struct {
+		crds    []unstructured.Unstructured
+		objects []unstructured.Unstructured
+		update  func(calls int) error
+		want    want
+	}{
+		"AlreadyMigrated": {
+			crds: []unstructured.Unstructured{crd("clusters."+group, "Cluster", "v1beta2")},
+		},
+		"Migrated": {
+			crds:    []unstructured.Unstructured{crd("clusters."+group, "Cluster", "v1beta1", "v1beta2")},
+			objects: []unstructured.Unstructured{object("a"), object("b")},
+			want: want{
+				updated:        []string{"a", "b"},
+				storedVersions: []string{"v1beta2"},
+			},
+		},
+		"ConflictRetried": {
+			crds:    []unstructured.Unstructured{crd("clusters."+group, "Cluster", "v1beta1", "v1beta2")},
+			objects: []unstructured.Unstructured{object("b")},
+			update: func(calls int) error {
+				if calls == 1 {
+					return conflict
+				}
+				return nil
+			},
+			want: want{
+				updated:        []string{"b", "b"},
+				storedVersions: []string{"v1beta2"},
+			},
+		},
+		"UpdateFailed": {
+			crds:    []unstructured.Unstructured{crd("clusters."+group, "Cluster", "v1beta1", "v1beta2")},
+			objects: []unstructured.Unstructured{object("a")},
+			update:  func(int) error { return errBoom },
+			want: want{
+				err:     errors.Wrap(errors.Wrapf(errBoom, "%s: %s", errRewriteObject, "a"), "cannot migrate clusters."+group),
+				updated: []string{"a"},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := want{}
+			kube := &test.MockClient{
+				MockList: func(_ context.Context, obj client.ObjectList, _ ...client.ListOption) error {
+					l := obj.(*unstructured.UnstructuredList)
+					if l.GetKind() == crdListGVK.Kind {
+						l.Items = tc.crds
+						return nil
+					}
+					l.Items = tc.objects
+					return nil
+				},
+				MockGet: test.NewMockGetFn(nil),
+				MockUpdate: func(_ context.Context, obj client.Object, _ ...client.UpdateOption) error {
+					got.updated = append(got.updated, obj.GetName())
+					if tc.update == nil {
+						return nil
+					}
+					return tc.update(len(got.updated))
+				},
+				MockStatusUpdate: func(_ context.Context, obj client.Object, _ ...client.SubResourceUpdateOption) error {
+					got.storedVersions, _, _ = unstructured.NestedStringSlice(obj.(*unstructured.Unstructured).Object, "status", "storedVersions")
+					return nil
+				},
+			}
+			got.err = NewMigrator(kube).Migrate(context.Background(), group)
+			if diff := cmp.Diff(tc.want, got, test.EquateErrors(), cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("Migrate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package operation persists the names of the long-running GCP operations
+// that create external resources, so that a creation that was interrupted by
+// a restart of the provider can be resumed rather than started again. It also
+// limits how long the operations on an external resource may run.
+package operation
+
+import (
+	"context"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// AnnotationKeyCreateOperation is set to the name of the GCP operation that
+// is creating the external resource of a managed resource, until it is done.
+const AnnotationKeyCreateOperation = "gcp.crossplane.io/create-operation"
+
+// StatusDone is the status of a GKE or Cloud SQL operation that is done,
+// whether or not it succeeded.
+const StatusDone = "DONE"
+
+const (
+	errPersist = "cannot persist create operation"
+	errForget  = "cannot forget create operation"
+	errResume  = "cannot resume interrupted creation"
+)
+
+// CreateOperation returns the name of the GCP operation that is creating the
+// external resource of the supplied managed resource, if any.
+func CreateOperation(mg resource.Managed) string {
+	return mg.GetAnnotations()[AnnotationKeyCreateOperation]
+}
+
+// PersistCreateOperation records that the named GCP operation is creating the
+// external resource of the supplied managed resource. It is persisted at once
+// rather than once the managed reconciler records the creation, so that it
+// survives a restart of the provider in between.
+func PersistCreateOperation(ctx context.Context, c client.Client, mg resource.Managed, name string) error {
+	meta.AddAnnotations(mg, map[string]string{AnnotationKeyCreateOperation: name})
+	return errors.Wrap(managed.NewRetryingCriticalAnnotationUpdater(c).UpdateCriticalAnnotations(ctx, mg), errPersist)
+}
+
+// ForgetCreateOperation records that the GCP operation that was creating the
+// external resource of the supplied managed resource is done.
+func ForgetCreateOperation(ctx context.Context, c client.Client, mg resource.Managed) error {
+	meta.RemoveAnnotations(mg, AnnotationKeyCreateOperation)
+	return errors.Wrap(c.Update(ctx, mg), errForget)
+}
+
+// A CreateResumer resumes the creation of managed resources whose create
+// operation was started, but whose creation was never recorded by the managed
+// reconciler, typically because the provider restarted in between.
+type CreateResumer struct {
+	client client.Client
+}
+
+// NewCreateResumer returns a CreateResumer.
+func NewCreateResumer(c client.Client) *CreateResumer {
+	return &CreateResumer{client: c}
+}
+
+// Initialize records that the creation of the supplied managed resource
+// succeeded if it is incomplete but its create operation was persisted.
+// Otherwise the managed reconciler would refuse to reconcile it until its
+// external-create-pending annotation was removed by hand. The half-created
+// external resource is then observed, and adopted, rather than created again.
+func (r *CreateResumer) Initialize(ctx context.Context, mg resource.Managed) error {
+	if !meta.ExternalCreateIncomplete(mg) || CreateOperation(mg) == "" {
+		return nil
+	}
+	meta.SetExternalCreateSucceeded(mg, time.Now())
+	return errors.Wrap(managed.NewRetryingCriticalAnnotationUpdater(r.client).UpdateCriticalAnnotations(ctx, mg), errResume)
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package operation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/pubsub/v1alpha1"
+)
+
+var errBoom = errors.New("boom")
+
+func topic(annotations map[string]string) *v1alpha1.Topic {
+	cr := &v1alpha1.Topic{}
+	cr.SetAnnotations(annotations)
+	return cr
+}
+
+func TestCreateResumer(t *testing.T) {
+	pending := time.Now().Add(-2 * time.Minute).Format(time.RFC3339)
+	succeeded := time.Now().Add(-time.Minute).Format(time.RFC3339)
+
+	type want struct {
+		err     error
+		updated bool
+	}
+
+	cases := map[string]struct {
+		reason string
+		mg     resource.Managed
+		update error
+		want   want
+	}{
+		"CreateComplete": {
+			reason: "A resource whose creation is complete should be left alone.",
+			mg: topic(map[string]string{
+				meta.AnnotationKeyExternalCreatePending:   pending,
+				meta.AnnotationKeyExternalCreateSucceeded: succeeded,
+				AnnotationKeyCreateOperation:              "op",
+			}),
+		},
+		"NoOperation": {
+			reason: "An incomplete creation without a persisted operation may not have started, so it should not be updated.",
+			mg: topic(map[string]string{
+				meta.AnnotationKeyExternalCreatePending: pending,
+			}),
+		},
+		"Resumed": {
+			reason: "An incomplete creation whose operation was persisted should be recorded as succeeded.",
+			mg: topic(map[string]string{
+				meta.AnnotationKeyExternalCreatePending: pending,
+				AnnotationKeyCreateOperation:            "op",
+			}),
+			want: want{
+				updated: true,
+			},
+		},
+		"UpdateError": {
+			reason: "Errors recording that a creation succeeded should be returned.",
+			mg: topic(map[string]string{
+				meta.AnnotationKeyExternalCreatePending: pending,
+				AnnotationKeyCreateOperation:            "op",
+			}),
+			update: errBoom,
+			want: want{
+				err:     errors.Wrap(errors.Wrap(errBoom, "cannot update critical annotations"), errResume),
+				updated: true,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			updated := false
+			c := &test.MockClient{
+				MockGet: test.NewMockGetFn(nil),
+				MockUpdate: func(_ context.Context, obj client.Object, _ ...client.UpdateOption) error {
+					updated = true
+					if meta.ExternalCreateIncomplete(obj.(resource.Managed)) {
+						t.Errorf("\n%s\nUpdate(...): want creation to be complete", tc.reason)
+					}
+					return tc.update
+				},
+			}
+			err := NewCreateResumer(c).Initialize(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nInitialize(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.updated, updated); diff != "" {
+				t.Errorf("\n%s\nInitialize(...): -want updated, +got updated:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
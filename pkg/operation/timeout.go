@@ -0,0 +1,231 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package operation
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
+)
+
+// A Verb is the kind of long-running operation that is running on an
+// external resource.
+type Verb string
+
+// Verbs of the operations whose duration is limited.
+const (
+	VerbNone   Verb = ""
+	VerbCreate Verb = "create"
+	VerbUpdate Verb = "update"
+	VerbDelete Verb = "delete"
+)
+
+// ReasonTimedOut indicates that an operation on an external resource did not
+// complete within its timeout.
+const ReasonTimedOut xpv1.ConditionReason = "TimedOut"
+
+const (
+	errTimedOutFmt  = "%s operation did not complete within %s"
+	errParseFmt     = "cannot parse %s timeout"
+	errTrackStarted = "cannot track when operation started"
+	errNotObject    = "managed resource is not a Kubernetes object"
+)
+
+// AnnotationKeyStarted returns the annotation that is set to when the
+// operation of the supplied verb was first observed running on an external
+// resource, until it is no longer running.
+func AnnotationKeyStarted(v Verb) string {
+	return fmt.Sprintf("gcp.crossplane.io/%s-started", v)
+}
+
+// TimedOut returns a condition indicating that the operation of the supplied
+// verb did not complete within the supplied timeout.
+func TimedOut(v Verb, timeout time.Duration) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               xpv1.TypeReady,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonTimedOut,
+		Message:            errors.Errorf(errTimedOutFmt, v, timeout).Error(),
+	}
+}
+
+// Timeouts are how long the operations of each verb may run. An operation
+// whose timeout is zero may run for as long as it needs.
+type Timeouts struct {
+	Create time.Duration
+	Update time.Duration
+	Delete time.Duration
+}
+
+// DefaultTimeouts are how long operations may run unless overridden. They are
+// unlimited, so that only users who set timeouts see operations time out.
+var DefaultTimeouts = Timeouts{}
+
+// Override returns the Timeouts, overridden by the supplied ones that are
+// set. The Timeouts are returned with every valid override applied, along
+// with an error if any of the overrides could not be parsed.
+func (t Timeouts) Override(o *v1beta1.Timeouts) (Timeouts, error) {
+	if o == nil {
+		return t, nil
+	}
+	var err error
+	for _, d := range []struct {
+		v  Verb
+		o  *string
+		to *time.Duration
+	}{
+		{v: VerbCreate, o: o.Create, to: &t.Create},
+		{v: VerbUpdate, o: o.Update, to: &t.Update},
+		{v: VerbDelete, o: o.Delete, to: &t.Delete},
+	} {
+		if e := parse(d.o, d.to); e != nil && err == nil {
+			err = errors.Wrapf(e, errParseFmt, d.v)
+		}
+	}
+	return t, err
+}
+
+// parse sets the supplied duration to the parsed one, if it is set. The
+// durations are validated when the managed resource is admitted, so an
+// error is only expected for resources that were admitted before they were.
+func parse(d *string, to *time.Duration) error {
+	if d == nil {
+		return nil
+	}
+	t, err := time.ParseDuration(*d)
+	if err != nil {
+		return err
+	}
+	if t <= 0 {
+		return errors.Errorf("%s is not a positive duration", *d)
+	}
+	*to = t
+	return nil
+}
+
+// Check enforces the DefaultTimeouts, overridden by the supplied ones, on
+// the operation of the supplied verb that is running on the external resource
+// of the supplied managed resource. An override that cannot be parsed is
+// returned as an error, unless the managed resource is being deleted.
+func Check(ctx context.Context, c client.Client, mg resource.Managed, o *v1beta1.Timeouts, v Verb) error {
+	t, err := DefaultTimeouts.Override(o)
+	if err != nil && !meta.WasDeleted(mg) {
+		return err
+	}
+	return t.Check(ctx, c, mg, v)
+}
+
+func (t Timeouts) of(v Verb) time.Duration {
+	switch v {
+	case VerbCreate:
+		return t.Create
+	case VerbUpdate:
+		return t.Update
+	case VerbDelete:
+		return t.Delete
+	case VerbNone:
+	}
+	return 0
+}
+
+// Check enforces the timeout of the operation of the supplied verb that is
+// running on the external resource of the supplied managed resource, if any.
+// The operation is timed from when it is first observed running, which is
+// persisted as an annotation so that the timeout spans reconciles and
+// restarts of the provider. Check sets a TimedOut condition and returns an
+// error once the operation has been running for longer than its timeout. No
+// error is returned for a managed resource that is being deleted, so that it
+// can still be deleted and its finalizer removed.
+func (t Timeouts) Check(ctx context.Context, c client.Client, mg resource.Managed, v Verb) error {
+	started, err := track(ctx, c, mg, v)
+	if err != nil || v == VerbNone {
+		return err
+	}
+	timeout := t.of(v)
+	if timeout == 0 || time.Since(started) < timeout {
+		return nil
+	}
+	mg.SetConditions(TimedOut(v, timeout))
+	if meta.WasDeleted(mg) {
+		return nil
+	}
+	return errors.Errorf(errTimedOutFmt, v, timeout)
+}
+
+// track returns when the operation of the supplied verb was first observed
+// running, and forgets when the operations of any other verb were.
+func track(ctx context.Context, c client.Client, mg resource.Managed, v Verb) (time.Time, error) {
+	started := time.Now()
+	changed := map[string]interface{}{}
+	for _, verb := range []Verb{VerbCreate, VerbUpdate, VerbDelete} {
+		key := AnnotationKeyStarted(verb)
+		s, ok := mg.GetAnnotations()[key]
+		if verb != v {
+			if ok {
+				meta.RemoveAnnotations(mg, key)
+				changed[key] = nil
+			}
+			continue
+		}
+		if t, err := time.Parse(time.RFC3339, s); ok && err == nil {
+			started = t
+			continue
+		}
+		ts := started.UTC().Format(time.RFC3339)
+		meta.AddAnnotations(mg, map[string]string{key: ts})
+		changed[key] = ts
+	}
+	if len(changed) == 0 {
+		return started, nil
+	}
+	return started, errors.Wrap(patchAnnotations(ctx, c, mg, changed), errTrackStarted)
+}
+
+// patchAnnotations persists only the supplied annotations of the supplied
+// managed resource, where a nil value removes an annotation. Check is called
+// while the external resource is observed, so the managed resource must not
+// be overwritten with the one that is stored: the patch is applied to a copy
+// and only its new resource version is kept.
+func patchAnnotations(ctx context.Context, c client.Client, mg resource.Managed, a map[string]interface{}) error {
+	data, err := json.Marshal(map[string]interface{}{"metadata": map[string]interface{}{"annotations": a}})
+	if err != nil {
+		return err
+	}
+	cp, ok := mg.DeepCopyObject().(client.Object)
+	if !ok {
+		return errors.New(errNotObject)
+	}
+	if err := c.Patch(ctx, cp, client.RawPatch(types.MergePatchType, data)); err != nil {
+		return err
+	}
+	mg.SetResourceVersion(cp.GetResourceVersion())
+	return nil
+}
@@ -0,0 +1,253 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package operation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/pubsub/v1alpha1"
+	"github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
+)
+
+func TestOverride(t *testing.T) {
+	thirty := "30m"
+	invalid := "soon"
+	zero := "0s"
+	def := Timeouts{Create: time.Hour, Update: time.Hour, Delete: time.Hour}
+
+	type want struct {
+		t   Timeouts
+		err bool
+	}
+
+	cases := map[string]struct {
+		reason string
+		o      *v1beta1.Timeouts
+		want   want
+	}{
+		"NotSet": {
+			reason: "The Timeouts should be returned as they are when no overrides are set.",
+			want:   want{t: def},
+		},
+		"Overridden": {
+			reason: "The Timeouts that are set should be overridden.",
+			o:      &v1beta1.Timeouts{Create: &thirty},
+			want:   want{t: Timeouts{Create: 30 * time.Minute, Update: time.Hour, Delete: time.Hour}},
+		},
+		"Invalid": {
+			reason: "An override that cannot be parsed should be returned as an error, and the valid ones applied.",
+			o:      &v1beta1.Timeouts{Create: &thirty, Delete: &invalid},
+			want:   want{t: Timeouts{Create: 30 * time.Minute, Update: time.Hour, Delete: time.Hour}, err: true},
+		},
+		"NotPositive": {
+			reason: "An override that is not a positive duration should be returned as an error.",
+			o:      &v1beta1.Timeouts{Update: &zero},
+			want:   want{t: def, err: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := def.Override(tc.o)
+			if diff := cmp.Diff(tc.want.err, err != nil); diff != "" {
+				t.Errorf("\n%s\nOverride(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.t, got); diff != "" {
+				t.Errorf("\n%s\nOverride(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestCheck(t *testing.T) {
+	recent := time.Now().Add(-time.Minute).UTC().Format(time.RFC3339)
+	old := time.Now().Add(-2 * time.Hour).UTC().Format(time.RFC3339)
+
+	type want struct {
+		err         error
+		updated     bool
+		annotations []string
+		reason      xpv1.ConditionReason
+	}
+
+	cases := map[string]struct {
+		reason   string
+		mg       map[string]string
+		deleted  bool
+		timeouts *Timeouts
+		verb     Verb
+		update   error
+		want     want
+	}{
+		"NothingRunning": {
+			reason: "No annotations should be written while no operation is running.",
+			verb:   VerbNone,
+		},
+		"Started": {
+			reason: "The start of an operation should be persisted.",
+			verb:   VerbCreate,
+			want: want{
+				updated:     true,
+				annotations: []string{AnnotationKeyStarted(VerbCreate)},
+			},
+		},
+		"Running": {
+			reason: "An operation that is within its timeout should be left alone.",
+			mg:     map[string]string{AnnotationKeyStarted(VerbUpdate): recent},
+			verb:   VerbUpdate,
+			want: want{
+				annotations: []string{AnnotationKeyStarted(VerbUpdate)},
+			},
+		},
+		"Done": {
+			reason: "The start of an operation that is no longer running should be forgotten.",
+			mg:     map[string]string{AnnotationKeyStarted(VerbUpdate): recent},
+			verb:   VerbNone,
+			want: want{
+				updated: true,
+			},
+		},
+		"TimedOut": {
+			reason: "An operation that exceeded its timeout should be reported.",
+			mg:     map[string]string{AnnotationKeyStarted(VerbDelete): old},
+			verb:   VerbDelete,
+			want: want{
+				err:         errors.Errorf(errTimedOutFmt, VerbDelete, time.Hour),
+				annotations: []string{AnnotationKeyStarted(VerbDelete)},
+				reason:      ReasonTimedOut,
+			},
+		},
+		"Unlimited": {
+			reason:   "An operation whose timeout is not set should never time out.",
+			mg:       map[string]string{AnnotationKeyStarted(VerbDelete): old},
+			timeouts: &Timeouts{},
+			verb:     VerbDelete,
+			want: want{
+				annotations: []string{AnnotationKeyStarted(VerbDelete)},
+			},
+		},
+		"TimedOutWhileDeleting": {
+			reason:  "An operation that exceeded its timeout should be reported without an error while the managed resource is being deleted.",
+			mg:      map[string]string{AnnotationKeyStarted(VerbCreate): old},
+			deleted: true,
+			verb:    VerbCreate,
+			want: want{
+				annotations: []string{AnnotationKeyStarted(VerbCreate)},
+				reason:      ReasonTimedOut,
+			},
+		},
+		"PatchError": {
+			reason: "Errors persisting the start of an operation should be returned.",
+			verb:   VerbCreate,
+			update: errBoom,
+			want: want{
+				err:         errors.Wrap(errBoom, errTrackStarted),
+				updated:     true,
+				annotations: []string{AnnotationKeyStarted(VerbCreate)},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			updated := false
+			c := &test.MockClient{
+				MockPatch: func(_ context.Context, obj client.Object, _ client.Patch, _ ...client.PatchOption) error {
+					updated = true
+					// The API server responds with the stored managed
+					// resource, which lacks what was just observed.
+					*obj.(*v1alpha1.Topic) = v1alpha1.Topic{}
+					obj.SetResourceVersion("2")
+					return tc.update
+				},
+			}
+			mg := topic(tc.mg)
+			mg.Status.AtProvider.Name = "projects/cool-project/topics/cool-topic"
+			if tc.deleted {
+				mg.SetDeletionTimestamp(&metav1.Time{Time: time.Now()})
+			}
+			timeouts := Timeouts{Create: time.Hour, Update: time.Hour, Delete: time.Hour}
+			if tc.timeouts != nil {
+				timeouts = *tc.timeouts
+			}
+			err := timeouts.Check(context.Background(), c, mg, tc.verb)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nCheck(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.updated, updated); diff != "" {
+				t.Errorf("\n%s\nCheck(...): -want updated, +got updated:\n%s", tc.reason, diff)
+			}
+			var got []string
+			for k := range mg.GetAnnotations() {
+				got = append(got, k)
+			}
+			if diff := cmp.Diff(tc.want.annotations, got); diff != "" {
+				t.Errorf("\n%s\nCheck(...): -want annotations, +got annotations:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.reason, mg.GetCondition(xpv1.TypeReady).Reason); diff != "" {
+				t.Errorf("\n%s\nCheck(...): -want reason, +got reason:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff("projects/cool-project/topics/cool-topic", mg.Status.AtProvider.Name); diff != "" {
+				t.Errorf("\n%s\nCheck(...): -want observation, +got observation:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestCheckOverrides(t *testing.T) {
+	invalid := "soon"
+	old := time.Now().Add(-2 * time.Hour).UTC().Format(time.RFC3339)
+
+	cases := map[string]struct {
+		reason  string
+		deleted bool
+		want    error
+	}{
+		"Invalid": {
+			reason: "An override that cannot be parsed should be returned as an error.",
+			want:   errors.Wrapf(errors.New(`time: invalid duration "soon"`), errParseFmt, VerbCreate),
+		},
+		"InvalidWhileDeleting": {
+			reason:  "An override that cannot be parsed should not stop a managed resource from being deleted.",
+			deleted: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &test.MockClient{MockPatch: test.NewMockPatchFn(nil)}
+			mg := topic(map[string]string{AnnotationKeyStarted(VerbCreate): old})
+			if tc.deleted {
+				mg.SetDeletionTimestamp(&metav1.Time{Time: time.Now()})
+			}
+			err := Check(context.Background(), c, mg, &v1beta1.Timeouts{Create: &invalid}, VerbCreate)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nCheck(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
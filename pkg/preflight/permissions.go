@@ -0,0 +1,141 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package preflight
+
+import (
+	apigeev1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/apigee/v1alpha1"
+	batchv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/batch/v1alpha1"
+	bigqueryv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/bigquery/v1alpha1"
+	cachev1beta1 "github.com/crossplane-contrib/provider-gcp/apis/cache/v1beta1"
+	computev1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	computev1beta1 "github.com/crossplane-contrib/provider-gcp/apis/compute/v1beta1"
+	containerv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/container/v1beta1"
+	containerv1beta2 "github.com/crossplane-contrib/provider-gcp/apis/container/v1beta2"
+	databasev1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/database/v1alpha1"
+	databasev1beta1 "github.com/crossplane-contrib/provider-gcp/apis/database/v1beta1"
+	datastreamv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/datastream/v1alpha1"
+	dnsv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/dns/v1alpha1"
+	healthcarev1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/healthcare/v1alpha1"
+	iamv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/iam/v1alpha1"
+	identityplatformv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/identityplatform/v1alpha1"
+	idsv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/ids/v1alpha1"
+	kmsv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/kms/v1alpha1"
+	networksecurityv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/networksecurity/v1alpha1"
+	networkservicesv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/networkservices/v1alpha1"
+	osconfigv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/osconfig/v1alpha1"
+	privatecav1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/privateca/v1alpha1"
+	pubsubv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/pubsub/v1alpha1"
+	recaptchaenterprisev1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/recaptchaenterprise/v1alpha1"
+	registryv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/registry/v1alpha1"
+	securitycenterv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/securitycenter/v1alpha1"
+	servicenetworkingv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/servicenetworking/v1beta1"
+	storagev1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/storage/v1alpha1"
+	storagev1alpha3 "github.com/crossplane-contrib/provider-gcp/apis/storage/v1alpha3"
+	tpuv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/tpu/v1alpha1"
+)
+
+// crud returns the create, get, update and delete permissions of a GCP
+// resource type, e.g. "compute.firewalls".
+func crud(resourceType string) []string {
+	return []string{resourceType + ".create", resourceType + ".get", resourceType + ".update", resourceType + ".delete"}
+}
+
+// permissions are the project level IAM permissions each controller calls
+// GCP with, keyed by the group kind of the resource it reconciles.
+var permissions = map[string][]string{
+	apigeev1alpha1.EnvGroupGroupKind:                     append(crud("apigee.envgroups"), "apigee.operations.get"),
+	apigeev1alpha1.EnvironmentGroupKind:                  append(crud("apigee.environments"), "apigee.operations.get"),
+	apigeev1alpha1.InstanceGroupKind:                     append(crud("apigee.instances"), "apigee.operations.get"),
+	apigeev1alpha1.OrganizationGroupKind:                 append(crud("apigee.organizations"), "apigee.operations.get"),
+	batchv1alpha1.JobGroupKind:                           {"batch.jobs.create", "batch.jobs.get", "batch.jobs.delete"},
+	bigqueryv1alpha1.DatasetGroupKind:                    crud("bigquery.datasets"),
+	bigqueryv1alpha1.DatasetIAMMemberGroupKind:           {"bigquery.datasets.get", "bigquery.datasets.update"},
+	bigqueryv1alpha1.RowAccessPolicyGroupKind:            {"bigquery.jobs.create", "bigquery.rowAccessPolicies.create", "bigquery.rowAccessPolicies.list", "bigquery.rowAccessPolicies.getIamPolicy", "bigquery.rowAccessPolicies.setIamPolicy", "bigquery.rowAccessPolicies.update", "bigquery.rowAccessPolicies.delete"},
+	bigqueryv1alpha1.TableIAMMemberGroupKind:             {"bigquery.tables.getIamPolicy", "bigquery.tables.setIamPolicy"},
+	cachev1beta1.CloudMemorystoreInstanceGroupKind:       crud("redis.instances"),
+	computev1alpha1.AutoscalerGroupKind:                  crud("compute.autoscalers"),
+	computev1alpha1.FirewallGroupKind:                    crud("compute.firewalls"),
+	computev1alpha1.ForwardingRuleGroupKind:              crud("compute.forwardingRules"),
+	computev1alpha1.ImageImportGroupKind:                 {"compute.images.create", "compute.images.get", "compute.images.delete", "storage.objects.get"},
+	computev1alpha1.InstanceTemplateGroupKind:            {"compute.instanceTemplates.create", "compute.instanceTemplates.get", "compute.instanceTemplates.delete", "compute.instances.get"},
+	computev1alpha1.MachineImageGroupKind:                {"compute.machineImages.create", "compute.machineImages.get", "compute.machineImages.delete", "compute.instances.useReadOnly"},
+	computev1alpha1.PacketMirroringGroupKind:             crud("compute.packetMirrorings"),
+	computev1alpha1.ProjectSSHKeyGroupKind:               {"compute.projects.get", "compute.projects.setCommonInstanceMetadata"},
+	computev1alpha1.PublicAdvertisedPrefixGroupKind:      crud("compute.publicAdvertisedPrefixes"),
+	computev1alpha1.PublicDelegatedPrefixGroupKind:       crud("compute.publicDelegatedPrefixes"),
+	computev1alpha1.RouterGroupKind:                      crud("compute.routers"),
+	computev1alpha1.ServiceAttachmentGroupKind:           crud("compute.serviceAttachments"),
+	computev1alpha1.InterconnectAttachmentGroupKind:      crud("compute.interconnectAttachments"),
+	computev1alpha1.TargetSSLProxyGroupKind:              crud("compute.targetSslProxies"),
+	computev1alpha1.TargetTCPProxyGroupKind:              crud("compute.targetTcpProxies"),
+	computev1alpha1.UnmanagedInstanceGroupGroupKind:      append(crud("compute.instanceGroups"), "compute.instances.use"),
+	computev1beta1.AddressGroupKind:                      {"compute.addresses.create", "compute.addresses.get", "compute.addresses.delete"},
+	computev1beta1.GlobalAddressGroupKind:                {"compute.globalAddresses.create", "compute.globalAddresses.get", "compute.globalAddresses.delete"},
+	computev1beta1.NetworkGroupKind:                      crud("compute.networks"),
+	computev1beta1.SubnetworkGroupKind:                   crud("compute.subnetworks"),
+	containerv1beta1.NodePoolGroupKind:                   {"container.clusters.get", "container.clusters.update", "container.operations.get"},
+	containerv1beta2.ClusterGroupKind:                    append(crud("container.clusters"), "container.operations.get"),
+	databasev1alpha1.CloudSQLUserGroupKind:               {"cloudsql.instances.get", "cloudsql.users.list", "cloudsql.users.create", "cloudsql.users.delete"},
+	databasev1beta1.CloudSQLInstanceGroupKind:            crud("cloudsql.instances"),
+	datastreamv1alpha1.ConnectionProfileGroupKind:        crud("datastream.connectionProfiles"),
+	datastreamv1alpha1.StreamGroupKind:                   crud("datastream.streams"),
+	dnsv1alpha1.PolicyGroupKind:                          crud("dns.policies"),
+	dnsv1alpha1.ResourceRecordSetGroupKind:               crud("dns.resourceRecordSets"),
+	healthcarev1alpha1.DatasetGroupKind:                  crud("healthcare.datasets"),
+	healthcarev1alpha1.DICOMStoreGroupKind:               crud("healthcare.dicomStores"),
+	healthcarev1alpha1.FHIRStoreGroupKind:                crud("healthcare.fhirStores"),
+	healthcarev1alpha1.HL7V2StoreGroupKind:               crud("healthcare.hl7V2Stores"),
+	iamv1alpha1.ServiceAccountGroupKind:                  append(crud("iam.serviceAccounts"), "iam.serviceAccountKeys.list"),
+	iamv1alpha1.ServiceAccountKeyGroupKind:               {"iam.serviceAccountKeys.create", "iam.serviceAccountKeys.get", "iam.serviceAccountKeys.delete"},
+	iamv1alpha1.ServiceAccountPolicyGroupKind:            {"iam.serviceAccounts.getIamPolicy", "iam.serviceAccounts.setIamPolicy"},
+	identityplatformv1alpha1.ConfigGroupKind:             {"firebaseauth.configs.create", "firebaseauth.configs.get", "firebaseauth.configs.update"},
+	identityplatformv1alpha1.TenantGroupKind:             crud("identitytoolkit.tenants"),
+	idsv1alpha1.EndpointGroupKind:                        {"ids.endpoints.create", "ids.endpoints.get", "ids.endpoints.delete"},
+	kmsv1alpha1.CryptoKeyGroupKind:                       {"cloudkms.cryptoKeys.create", "cloudkms.cryptoKeys.get", "cloudkms.cryptoKeys.update"},
+	kmsv1alpha1.CryptoKeyPolicyGroupKind:                 {"cloudkms.cryptoKeys.getIamPolicy", "cloudkms.cryptoKeys.setIamPolicy"},
+	kmsv1alpha1.KeyRingGroupKind:                         {"cloudkms.keyRings.create", "cloudkms.keyRings.get"},
+	networksecurityv1alpha1.AuthorizationPolicyGroupKind: crud("networksecurity.authorizationPolicies"),
+	networksecurityv1alpha1.ClientTLSPolicyGroupKind:     crud("networksecurity.clientTlsPolicies"),
+	networksecurityv1alpha1.ServerTLSPolicyGroupKind:     crud("networksecurity.serverTlsPolicies"),
+	networkservicesv1alpha1.GatewayGroupKind:             crud("networkservices.gateways"),
+	networkservicesv1alpha1.GRPCRouteGroupKind:           crud("networkservices.grpcRoutes"),
+	networkservicesv1alpha1.HTTPRouteGroupKind:           crud("networkservices.httpRoutes"),
+	networkservicesv1alpha1.MeshGroupKind:                crud("networkservices.meshes"),
+	osconfigv1alpha1.GuestPolicyGroupKind:                crud("osconfig.guestPolicies"),
+	osconfigv1alpha1.PatchDeploymentGroupKind:            crud("osconfig.patchDeployments"),
+	privatecav1alpha1.CaPoolGroupKind:                    append(crud("privateca.caPools"), "privateca.operations.get"),
+	privatecav1alpha1.CertificateAuthorityGroupKind:      append(crud("privateca.certificateAuthorities"), "privateca.operations.get"),
+	privatecav1alpha1.CertificateTemplateGroupKind:       append(crud("privateca.certificateTemplates"), "privateca.operations.get"),
+	pubsubv1alpha1.SubscriptionGroupKind:                 crud("pubsub.subscriptions"),
+	pubsubv1alpha1.TopicGroupKind:                        crud("pubsub.topics"),
+	recaptchaenterprisev1alpha1.KeyGroupKind:             crud("recaptchaenterprise.keys"),
+	registryv1alpha1.ContainerRegistryGroupKind:          {"storage.buckets.create", "storage.buckets.get"},
+	securitycenterv1alpha1.MuteConfigGroupKind:           crud("securitycenter.muteconfigs"),
+	securitycenterv1alpha1.NotificationConfigGroupKind:   crud("securitycenter.notificationconfig"),
+	servicenetworkingv1beta1.ConnectionGroupKind:         {"servicenetworking.services.addPeering", "compute.networks.get", "compute.networks.removePeering"},
+	storagev1alpha1.BucketObjectGroupKind:                crud("storage.objects"),
+	storagev1alpha1.BucketPolicyGroupKind:                {"storage.buckets.getIamPolicy", "storage.buckets.setIamPolicy"},
+	storagev1alpha1.BucketPolicyMemberGroupKind:          {"storage.buckets.getIamPolicy", "storage.buckets.setIamPolicy"},
+	storagev1alpha3.BucketGroupKind:                      crud("storage.buckets"),
+	tpuv1alpha1.NodeGroupKind:                            crud("tpu.nodes"),
+}
+
+// Permissions returns the IAM permissions the controller of the supplied
+// group kind needs, or nothing if they are not known.
+func Permissions(gk string) []string {
+	return permissions[gk]
+}
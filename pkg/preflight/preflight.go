@@ -0,0 +1,264 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package preflight checks that the credentials a managed resource is
+// reconciled with hold the IAM permissions its controller needs, so that
+// every missing permission is reported at once rather than one failed call
+// at a time.
+package preflight
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	crm "google.golang.org/api/cloudresourcemanager/v1"
+	"google.golang.org/api/option"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+)
+
+const (
+	errNewClient        = "cannot create new Resource Manager client"
+	errTestPermissions  = "cannot test IAM permissions"
+	errCheckPermissions = "cannot check IAM permissions"
+	errMissingFmt       = "provider credentials are missing IAM permissions on project %s: %s"
+
+	reasonMissingPermissions event.Reason = "MissingPermissions"
+	reasonCannotCheck        event.Reason = "CannotCheckPermissions"
+)
+
+// TypePermissionsGranted resources were found to be reconciled with
+// credentials that hold the IAM permissions their controller needs.
+const TypePermissionsGranted xpv1.ConditionType = "PermissionsGranted"
+
+// Reasons the credentials of a resource do or do not hold the IAM
+// permissions its controller needs.
+const (
+	ReasonPermissionsGranted xpv1.ConditionReason = "PermissionsGranted"
+	ReasonMissingPermissions xpv1.ConditionReason = "MissingPermissions"
+)
+
+// PermissionsGranted returns a condition that indicates the credentials hold
+// every IAM permission the controller needs.
+func PermissionsGranted() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypePermissionsGranted,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonPermissionsGranted,
+	}
+}
+
+// MissingPermissions returns a condition that indicates the credentials lack
+// the supplied IAM permissions.
+func MissingPermissions(missing []string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypePermissionsGranted,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonMissingPermissions,
+		Message:            "missing IAM permissions: " + strings.Join(missing, ", "),
+	}
+}
+
+// A TestFn returns the subset of the supplied permissions that are granted
+// on the supplied project.
+type TestFn func(ctx context.Context, projectID string, opts []option.ClientOption, permissions []string) ([]string, error)
+
+// TestProjectPermissions tests the supplied permissions on a project using
+// the Resource Manager API.
+func TestProjectPermissions(ctx context.Context, projectID string, opts []option.ClientOption, permissions []string) ([]string, error) {
+	s, err := crm.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	rsp, err := s.Projects.TestIamPermissions(projectID, &crm.TestIamPermissionsRequest{Permissions: permissions}).Context(ctx).Do()
+	if err != nil {
+		return nil, errors.Wrap(err, errTestPermissions)
+	}
+	return rsp.Permissions, nil
+}
+
+// A ConnectionInfoFn returns the project and client options a managed
+// resource is reconciled with.
+type ConnectionInfoFn func(ctx context.Context, c client.Client, mg resource.Managed) (projectID string, opts []option.ClientOption, err error)
+
+type key struct {
+	groupKind      string
+	providerConfig string
+}
+
+type result struct {
+	projectID string
+	missing   []string
+	checked   time.Time
+}
+
+// A Checker tests the IAM permissions of each resource kind once per
+// ProviderConfig and remembers the result until the recheck interval
+// elapses, so that newly granted permissions are eventually noticed.
+type Checker struct {
+	kube    client.Client
+	info    ConnectionInfoFn
+	test    TestFn
+	recheck time.Duration
+	now     func() time.Time
+
+	mu      sync.Mutex
+	results map[key]result
+}
+
+// A CheckerOption configures a Checker.
+type CheckerOption func(*Checker)
+
+// WithTestFn configures the function a Checker uses to test permissions.
+func WithTestFn(fn TestFn) CheckerOption {
+	return func(c *Checker) {
+		c.test = fn
+	}
+}
+
+// WithConnectionInfoFn configures the function a Checker uses to read the
+// credentials of a managed resource.
+func WithConnectionInfoFn(fn ConnectionInfoFn) CheckerOption {
+	return func(c *Checker) {
+		c.info = fn
+	}
+}
+
+// WithClock configures the function a Checker uses to tell the time.
+func WithClock(now func() time.Time) CheckerOption {
+	return func(c *Checker) {
+		c.now = now
+	}
+}
+
+// NewChecker returns a Checker that reads credentials using the supplied
+// client and tests permissions again after the supplied interval.
+func NewChecker(kube client.Client, recheck time.Duration, o ...CheckerOption) *Checker {
+	c := &Checker{kube: kube, info: gcp.GetConnectionInfo, test: TestProjectPermissions, recheck: recheck, now: time.Now, results: map[key]result{}}
+	for _, fn := range o {
+		fn(c)
+	}
+	return c
+}
+
+// Missing returns the permissions of the supplied group kind that the
+// credentials of the supplied managed resource lack, the project they were
+// tested on, and whether they were tested just now rather than remembered.
+func (c *Checker) Missing(ctx context.Context, gk string, mg resource.Managed) (missing []string, projectID string, fresh bool, err error) {
+	k := key{groupKind: gk}
+	if ref := mg.GetProviderConfigReference(); ref != nil {
+		k.providerConfig = ref.Name
+	}
+
+	c.mu.Lock()
+	r, ok := c.results[k]
+	c.mu.Unlock()
+	if ok && c.now().Sub(r.checked) < c.recheck {
+		return r.missing, r.projectID, false, nil
+	}
+
+	projectID, opts, err := c.info(ctx, c.kube, mg)
+	if err != nil {
+		return nil, "", false, err
+	}
+	required := Permissions(gk)
+	granted, err := c.test(ctx, projectID, opts, required)
+	if err != nil {
+		return nil, "", false, err
+	}
+	has := make(map[string]bool, len(granted))
+	for _, p := range granted {
+		has[p] = true
+	}
+	for _, p := range required {
+		if !has[p] {
+			missing = append(missing, p)
+		}
+	}
+	sort.Strings(missing)
+
+	c.mu.Lock()
+	c.results[k] = result{projectID: projectID, missing: missing, checked: c.now()}
+	c.mu.Unlock()
+	return missing, projectID, true, nil
+}
+
+// NewExternalConnecter returns an ExternalConnecter that checks the IAM
+// permissions of the supplied group kind before it connects. The check only
+// reports: a resource is still reconciled when permissions appear to be
+// missing, because permissions may also be granted on the resource itself
+// rather than on its project.
+func NewExternalConnecter(c managed.ExternalConnecter, checker *Checker, gk string, r event.Recorder) managed.ExternalConnecter {
+	return &connecter{connecter: c, checker: checker, groupKind: gk, record: r}
+}
+
+type connecter struct {
+	connecter managed.ExternalConnecter
+	checker   *Checker
+	groupKind string
+	record    event.Recorder
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	missing, projectID, fresh, err := c.checker.Missing(ctx, c.groupKind, mg)
+	switch {
+	case err != nil:
+		c.record.Event(mg, event.Warning(reasonCannotCheck, errors.Wrap(err, errCheckPermissions)))
+	case len(missing) > 0:
+		mg.SetConditions(MissingPermissions(missing))
+		if fresh {
+			c.record.Event(mg, event.Warning(reasonMissingPermissions, errors.Errorf(errMissingFmt, projectID, strings.Join(missing, ", "))))
+		}
+	default:
+		mg.SetConditions(PermissionsGranted())
+	}
+	return c.connecter.Connect(ctx, mg)
+}
+
+var (
+	sharedOnce sync.Once
+	shared     *Checker
+)
+
+// WithPermissionCheck wraps the supplied ExternalConnecter so that it checks
+// the IAM permissions of the supplied group kind, if the permission
+// pre-flight feature is enabled and permissions are known for the kind.
+// Otherwise it returns the supplied ExternalConnecter.
+func WithPermissionCheck(mgr ctrl.Manager, o controller.Options, gk string, c managed.ExternalConnecter) managed.ExternalConnecter {
+	if o.Features == nil || !o.Features.Enabled(features.EnableAlphaPermissionPreflight) || len(Permissions(gk)) == 0 {
+		return c
+	}
+	sharedOnce.Do(func() { shared = NewChecker(mgr.GetClient(), o.PollInterval) })
+	r := event.NewAPIRecorder(mgr.GetEventRecorderFor(managed.ControllerName(gk)))
+	return NewExternalConnecter(c, shared, gk, r)
+}
@@ -0,0 +1,190 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package preflight
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/option"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/pubsub/v1alpha1"
+)
+
+var (
+	recheck = time.Minute
+	t0      = time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+)
+
+func topic() *v1alpha1.Topic {
+	cr := &v1alpha1.Topic{}
+	cr.SetProviderConfigReference(&xpv1.Reference{Name: "default"})
+	return cr
+}
+
+func info(_ context.Context, _ client.Client, _ resource.Managed) (string, []option.ClientOption, error) {
+	return "fooproject", nil, nil
+}
+
+// granting returns a TestFn that grants the supplied permissions and counts
+// how often it is called.
+func granting(calls *int, granted ...string) TestFn {
+	return func(_ context.Context, projectID string, _ []option.ClientOption, _ []string) ([]string, error) {
+		*calls++
+		if projectID != "fooproject" {
+			return nil, errors.New("unexpected project " + projectID)
+		}
+		return granted, nil
+	}
+}
+
+func TestCheckerMissing(t *testing.T) {
+	type want struct {
+		missing []string
+		fresh   bool
+		calls   int
+	}
+	cases := map[string]struct {
+		granted []string
+		elapsed time.Duration
+		want    want
+	}{
+		"AllGranted": {
+			granted: Permissions(v1alpha1.TopicGroupKind),
+			elapsed: recheck / 2,
+			want:    want{calls: 1},
+		},
+		"SomeMissing": {
+			granted: []string{"pubsub.topics.get", "pubsub.topics.create"},
+			elapsed: recheck / 2,
+			want:    want{missing: []string{"pubsub.topics.delete", "pubsub.topics.update"}, calls: 1},
+		},
+		"Rechecked": {
+			granted: []string{"pubsub.topics.get"},
+			elapsed: recheck,
+			want:    want{missing: []string{"pubsub.topics.create", "pubsub.topics.delete", "pubsub.topics.update"}, fresh: true, calls: 2},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			now := t0
+			calls := 0
+			c := NewChecker(nil, recheck,
+				WithConnectionInfoFn(info),
+				WithTestFn(granting(&calls, tc.granted...)),
+				WithClock(func() time.Time { return now }))
+
+			if _, _, fresh, err := c.Missing(context.Background(), v1alpha1.TopicGroupKind, topic()); err != nil || !fresh {
+				t.Fatalf("Missing(...): want fresh result without error, got fresh %t and error %v", fresh, err)
+			}
+
+			now = now.Add(tc.elapsed)
+			missing, projectID, fresh, err := c.Missing(context.Background(), v1alpha1.TopicGroupKind, topic())
+			if err != nil {
+				t.Fatalf("Missing(...): unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want.missing, missing); diff != "" {
+				t.Errorf("Missing(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff("fooproject", projectID); diff != "" {
+				t.Errorf("Missing(...): -want project, +got project:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.fresh, fresh); diff != "" {
+				t.Errorf("Missing(...): -want fresh, +got fresh:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.calls, calls); diff != "" {
+				t.Errorf("Missing(...): -want calls, +got calls:\n%s", diff)
+			}
+		})
+	}
+}
+
+type recorder struct {
+	events []event.Event
+}
+
+func (r *recorder) Event(_ runtime.Object, e event.Event) { r.events = append(r.events, e) }
+
+func (r *recorder) WithAnnotations(_ ...string) event.Recorder { return r }
+
+func TestConnect(t *testing.T) {
+	type want struct {
+		reason xpv1.ConditionReason
+		events int
+	}
+	cases := map[string]struct {
+		granted []string
+		want    want
+	}{
+		"Granted": {
+			granted: Permissions(v1alpha1.TopicGroupKind),
+			want:    want{reason: ReasonPermissionsGranted},
+		},
+		"Missing": {
+			granted: []string{"pubsub.topics.get"},
+			want:    want{reason: ReasonMissingPermissions, events: 1},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			calls := 0
+			checker := NewChecker(nil, recheck, WithConnectionInfoFn(info), WithTestFn(granting(&calls, tc.granted...)))
+			r := &recorder{}
+			inner := managed.ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+				return &managed.NopClient{}, nil
+			})
+			c := NewExternalConnecter(inner, checker, v1alpha1.TopicGroupKind, r)
+
+			// Only the first of two connects tests the permissions and
+			// records an event, but both set the condition.
+			for i := 0; i < 2; i++ {
+				mg := topic()
+				if _, err := c.Connect(context.Background(), mg); err != nil {
+					t.Fatalf("Connect(...): unexpected error: %v", err)
+				}
+				if diff := cmp.Diff(tc.want.reason, mg.GetCondition(TypePermissionsGranted).Reason); diff != "" {
+					t.Errorf("Connect(...): -want reason, +got reason:\n%s", diff)
+				}
+			}
+			if diff := cmp.Diff(tc.want.events, len(r.events)); diff != "" {
+				t.Errorf("Connect(...): -want events, +got events:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPermissionsKnown(t *testing.T) {
+	for gk, p := range permissions {
+		if len(p) == 0 {
+			t.Errorf("Permissions(%q): want permissions, got none", gk)
+		}
+	}
+	if diff := cmp.Diff([]string(nil), Permissions("Unknown.example.org"), test.EquateErrors()); diff != "" {
+		t.Errorf("Permissions(...): -want, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,169 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package priority lets requests to reconcile some kinds of managed resource
+// jump the queue of requests waiting for the global reconcile rate, so that
+// slow, stateful resources are not queued behind many cheap ones after the
+// provider restarts.
+package priority
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/client-go/util/workqueue"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+)
+
+const (
+	errParseWeight   = "cannot parse priority weight of %s"
+	errInvalidWeight = "priority weight of %s must be at least 1, got %d"
+)
+
+// DefaultWeight is the weight shared by every kind that is not otherwise
+// weighted.
+const DefaultWeight = 1
+
+// ParseWeights parses the supplied weights, keyed by group kind.
+func ParseWeights(in map[string]string) (map[string]int, error) {
+	w := make(map[string]int, len(in))
+	for gk, s := range in {
+		i, err := strconv.Atoi(s)
+		if err != nil {
+			return nil, errors.Wrapf(err, errParseWeight, gk)
+		}
+		if i < 1 {
+			return nil, errors.Errorf(errInvalidWeight, gk, i)
+		}
+		w[gk] = i
+	}
+	return w, nil
+}
+
+// burst is how far ahead of the global rate the heaviest kinds may be
+// reconciled. Like the burst of crossplane-runtime's global rate limiter, it
+// is ten seconds worth of requests.
+const burst = 10 * time.Second
+
+// A RateLimiter limits all controllers to a global reconcile rate, while
+// letting requests of heavier kinds of managed resource through sooner when
+// requests queue up. It is a generic cell rate algorithm: every request that
+// is let through pushes the theoretical arrival time of the next request back
+// by one interval of the global rate, and a request is let through once it is
+// no further ahead of that time than the tolerance of its kind. The heaviest
+// kinds tolerate the burst, and lighter kinds proportionally less. A kind is
+// therefore reconciled at the full global rate while no other kind is, and
+// the provider never reconciles faster than the global rate overall.
+type RateLimiter struct {
+	interval time.Duration
+
+	// tolerances are keyed by controller name rather than group kind,
+	// because the controller name prefixes every item that is rate limited.
+	tolerances map[string]time.Duration
+	other      time.Duration
+	now        func() time.Time
+
+	mu sync.Mutex
+
+	// tat is the theoretical arrival time of the next request, i.e. the
+	// time at which it would be let through if every request before it was
+	// let through at exactly the global rate.
+	tat time.Time
+}
+
+// NewRateLimiter returns a rate limiter that limits all controllers to the
+// supplied requests per second, and that prioritises kinds using the supplied
+// weights, keyed by group kind. It returns crossplane-runtime's global rate
+// limiter if no kind is weighted.
+func NewRateLimiter(rps int, weights map[string]int) workqueue.RateLimiter {
+	if len(weights) == 0 {
+		return ratelimiter.NewGlobal(rps)
+	}
+	heaviest := DefaultWeight
+	for _, w := range weights {
+		if w > heaviest {
+			heaviest = w
+		}
+	}
+	l := &RateLimiter{
+		interval:   time.Second / time.Duration(rps),
+		tolerances: make(map[string]time.Duration, len(weights)),
+		other:      tolerance(DefaultWeight, heaviest),
+		now:        time.Now,
+	}
+	for gk, w := range weights {
+		l.tolerances[managed.ControllerName(gk)] = tolerance(w, heaviest)
+	}
+	return l
+}
+
+// tolerance returns the supplied weight's share of the burst, relative to the
+// supplied heaviest weight.
+func tolerance(weight, heaviest int) time.Duration {
+	return burst * time.Duration(weight) / time.Duration(heaviest)
+}
+
+// tolerance returns the tolerance of the kind of the supplied item. Items are
+// the name of a controller followed by the namespaced name of a request, as
+// passed by the crossplane-runtime rate limiting Reconciler.
+func (l *RateLimiter) tolerance(item interface{}) time.Duration {
+	s, ok := item.(string)
+	if !ok {
+		return l.other
+	}
+	// Managed resources are cluster scoped, so the namespaced name of the
+	// request that follows the controller name is "/<name>".
+	i := strings.LastIndex(s, "/")
+	if i < 0 {
+		return l.other
+	}
+	if t, ok := l.tolerances[s[:i]]; ok {
+		return t
+	}
+	return l.other
+}
+
+// When returns how long the supplied item should wait. The item is let
+// through once it has waited, so it is counted against the global rate now.
+func (l *RateLimiter) When(item interface{}) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := l.now()
+	at := l.tat.Add(-l.tolerance(item))
+	if at.Before(now) {
+		at = now
+	}
+	if l.tat.Before(at) {
+		l.tat = at
+	}
+	l.tat = l.tat.Add(l.interval)
+	return at.Sub(now)
+}
+
+// Forget does nothing. Like a token bucket, the RateLimiter does not track
+// items.
+func (l *RateLimiter) Forget(_ interface{}) {}
+
+// NumRequeues always returns zero. Like a token bucket, the RateLimiter does
+// not track items.
+func (l *RateLimiter) NumRequeues(_ interface{}) int {
+	return 0
+}
@@ -0,0 +1,182 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package priority
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/client-go/util/workqueue"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	databasev1beta1 "github.com/crossplane-contrib/provider-gcp/apis/database/v1beta1"
+)
+
+func TestParseWeights(t *testing.T) {
+	cases := map[string]struct {
+		in   map[string]string
+		want map[string]int
+		err  error
+	}{
+		"NoWeights": {
+			want: map[string]int{},
+		},
+		"Weighted": {
+			in:   map[string]string{databasev1beta1.CloudSQLInstanceGroupKind: "5"},
+			want: map[string]int{databasev1beta1.CloudSQLInstanceGroupKind: 5},
+		},
+		"NotANumber": {
+			in:  map[string]string{databasev1beta1.CloudSQLInstanceGroupKind: "high"},
+			err: errors.Wrapf(errors.New(`strconv.Atoi: parsing "high": invalid syntax`), errParseWeight, databasev1beta1.CloudSQLInstanceGroupKind),
+		},
+		"TooLow": {
+			in:  map[string]string{databasev1beta1.CloudSQLInstanceGroupKind: "0"},
+			err: errors.Errorf(errInvalidWeight, databasev1beta1.CloudSQLInstanceGroupKind, 0),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := ParseWeights(tc.in)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("ParseWeights(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("ParseWeights(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNewRateLimiter(t *testing.T) {
+	got := NewRateLimiter(10, nil)
+	if diff := cmp.Diff(ratelimiter.NewGlobal(10).Limiter.Limit(), got.(*workqueue.BucketRateLimiter).Limiter.Limit()); diff != "" {
+		t.Errorf("NewRateLimiter(...): without weights: -want limit, +got limit:\n%s", diff)
+	}
+}
+
+func TestTolerance(t *testing.T) {
+	l := NewRateLimiter(10, map[string]int{databasev1beta1.CloudSQLInstanceGroupKind: 4}).(*RateLimiter)
+
+	cases := map[string]struct {
+		item interface{}
+		want time.Duration
+	}{
+		"Weighted": {
+			item: "managed/cloudsqlinstance.database.gcp.crossplane.io/my-db",
+			want: 10 * time.Second,
+		},
+		"Unweighted": {
+			item: "managed/topic.pubsub.gcp.crossplane.io/my-topic",
+			want: 2500 * time.Millisecond,
+		},
+		"UnknownItem": {
+			item: 42,
+			want: 2500 * time.Millisecond,
+		},
+		"NoController": {
+			item: "my-db",
+			want: 2500 * time.Millisecond,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := l.tolerance(tc.item)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("tolerance(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestWhen(t *testing.T) {
+	const (
+		weighted   = "managed/cloudsqlinstance.database.gcp.crossplane.io/my-db"
+		unweighted = "managed/topic.pubsub.gcp.crossplane.io/my-topic"
+	)
+	now := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	type want struct {
+		last time.Duration
+		next time.Duration
+	}
+	cases := map[string]struct {
+		reason string
+		items  []string
+		next   string
+		want   want
+	}{
+		"UnweightedKindGetsFullRate": {
+			reason: "An unweighted kind should be reconciled at the full global rate while weighted kinds are idle: after its burst of 25 requests, each request should wait one more interval of 100ms.",
+			items:  repeat(unweighted, 50),
+			next:   unweighted,
+			want: want{
+				last: 2400 * time.Millisecond,
+				next: 2500 * time.Millisecond,
+			},
+		},
+		"WeightedKindJumpsQueue": {
+			reason: "A weighted kind should be let through ahead of the requests of an unweighted kind that are waiting.",
+			items:  repeat(unweighted, 50),
+			next:   weighted,
+			want: want{
+				last: 2400 * time.Millisecond,
+				next: 0,
+			},
+		},
+		"GlobalRate": {
+			reason: "Requests that jump the queue should still count against the global rate.",
+			items:  append(repeat(weighted, 150), unweighted),
+			next:   unweighted,
+			want: want{
+				last: 12500 * time.Millisecond,
+				next: 12600 * time.Millisecond,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			l := NewRateLimiter(10, map[string]int{databasev1beta1.CloudSQLInstanceGroupKind: 4}).(*RateLimiter)
+			l.now = func() time.Time { return now }
+
+			var last time.Duration
+			for _, item := range tc.items {
+				last = l.When(item)
+			}
+			if diff := cmp.Diff(tc.want.last, last); diff != "" {
+				t.Errorf("\n%s\nWhen(...): -want wait of last item, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.next, l.When(tc.next)); diff != "" {
+				t.Errorf("\n%s\nWhen(...): -want wait of next item, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func repeat(item string, n int) []string {
+	items := make([]string, n)
+	for i := range items {
+		items[i] = item
+	}
+	return items
+}
@@ -0,0 +1,402 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package projectstate stops managed resource reconcilers from calling GCP
+// while the project of a resource is pending deletion or has billing
+// disabled. Such resources report why with a condition rather than failing
+// every reconcile with a bare 400 or 403. Resources whose project is pending
+// deletion may be deleted without deleting their external resource, which is
+// deleted along with its project.
+package projectstate
+
+import (
+	"context"
+	"regexp"
+	"sync"
+	"time"
+
+	cloudbilling "google.golang.org/api/cloudbilling/v1"
+	crm "google.golang.org/api/cloudresourcemanager/v1"
+	"google.golang.org/api/option"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/fieldpath"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	billingv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/billing/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+)
+
+const (
+	errUnusableFmt      = "%s; GCP will not be called for this resource again until %s"
+	errNewRMClient      = "cannot create new Resource Manager client"
+	errGetProject       = "cannot get project"
+	errNewBillingClient = "cannot create new Cloud Billing client"
+	errGetBillingInfo   = "cannot get billing info of project"
+
+	msgPendingDeletion = "the project of this resource is pending deletion"
+	msgBillingDisabled = "billing is disabled for the project of this resource"
+
+	// Lifecycle states of a project that was scheduled for deletion.
+	lifecycleDeleteRequested  = "DELETE_REQUESTED"
+	lifecycleDeleteInProgress = "DELETE_IN_PROGRESS"
+)
+
+// TypeProjectUsable resources are reconciled in a project that GCP accepts
+// requests for.
+const TypeProjectUsable xpv1.ConditionType = "ProjectUsable"
+
+// Reasons the project of a resource is or is not usable.
+const (
+	ReasonProjectUsable          xpv1.ConditionReason = "ProjectUsable"
+	ReasonProjectPendingDeletion xpv1.ConditionReason = "ProjectPendingDeletion"
+	ReasonBillingDisabled        xpv1.ConditionReason = "BillingDisabled"
+)
+
+var messages = map[xpv1.ConditionReason]string{
+	ReasonProjectPendingDeletion: msgPendingDeletion,
+	ReasonBillingDisabled:        msgBillingDisabled,
+}
+
+// ProjectUsable returns a condition that indicates GCP accepts requests for
+// the project of a resource again.
+func ProjectUsable() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeProjectUsable,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonProjectUsable,
+	}
+}
+
+// ProjectUnusable returns a condition that indicates GCP rejects requests for
+// the project of a resource for the supplied reason.
+func ProjectUnusable(r xpv1.ConditionReason, msg string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeProjectUsable,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             r,
+		Message:            msg,
+	}
+}
+
+// A ConnectionInfoFn returns the project and client options a managed
+// resource is reconciled with.
+type ConnectionInfoFn func(ctx context.Context, c client.Client, mg resource.Managed) (projectID string, opts []option.ClientOption, err error)
+
+// A StateFn looks up the state of the supplied project. It returns the reason
+// GCP rejects requests for the project, or an empty reason if GCP accepts
+// them.
+type StateFn func(ctx context.Context, projectID string, opts []option.ClientOption) (xpv1.ConditionReason, error)
+
+// GetProjectState looks up the lifecycle state of a project using the
+// Resource Manager API, and whether billing is enabled for it using the Cloud
+// Billing API. The supplied credentials need the resourcemanager.projects.get
+// and billing.resourceAssociations.list permissions on the project.
+func GetProjectState(ctx context.Context, projectID string, opts []option.ClientOption) (xpv1.ConditionReason, error) {
+	rm, err := crm.NewService(ctx, opts...)
+	if err != nil {
+		return "", errors.Wrap(err, errNewRMClient)
+	}
+	p, err := rm.Projects.Get(projectID).Context(ctx).Do()
+	if err != nil {
+		return "", errors.Wrap(err, errGetProject)
+	}
+	if p.LifecycleState == lifecycleDeleteRequested || p.LifecycleState == lifecycleDeleteInProgress {
+		return ReasonProjectPendingDeletion, nil
+	}
+	cb, err := cloudbilling.NewService(ctx, opts...)
+	if err != nil {
+		return "", errors.Wrap(err, errNewBillingClient)
+	}
+	b, err := cb.Projects.GetBillingInfo("projects/" + projectID).Context(ctx).Do()
+	if err != nil {
+		return "", errors.Wrap(err, errGetBillingInfo)
+	}
+	if !b.BillingEnabled {
+		return ReasonBillingDisabled, nil
+	}
+	return "", nil
+}
+
+var qualifiedName = regexp.MustCompile(`^projects/([^/]+)/`)
+
+// Project returns the project the supplied managed resource lives in. That is
+// the project set by its spec.forProvider.project field, if any, else the
+// project its external name is qualified with, e.g. projects/p/topics/t,
+// else the supplied project of its ProviderConfig.
+func Project(mg resource.Managed, providerConfigProject string) string {
+	if p, err := fieldpath.PaveObject(mg); err == nil {
+		if id, err := p.GetString("spec.forProvider.project"); err == nil && id != "" {
+			return id
+		}
+	}
+	if m := qualifiedName.FindStringSubmatch(meta.GetExternalName(mg)); m != nil {
+		return m[1]
+	}
+	return providerConfigProject
+}
+
+type state struct {
+	reason  xpv1.ConditionReason
+	message string
+	until   time.Time
+}
+
+// A Tracker remembers which projects were recently found to be unusable. A
+// project that is pending deletion or has billing disabled is unusable for
+// every resource in it, so the first resource to find out spares all the
+// others their calls to GCP.
+type Tracker struct {
+	kube    client.Client
+	info    ConnectionInfoFn
+	lookup  StateFn
+	backoff time.Duration
+	now     func() time.Time
+
+	mu     sync.Mutex
+	states map[string]state
+}
+
+// A TrackerOption configures a Tracker.
+type TrackerOption func(*Tracker)
+
+// WithClock configures the function a Tracker uses to tell the time.
+func WithClock(now func() time.Time) TrackerOption {
+	return func(t *Tracker) {
+		t.now = now
+	}
+}
+
+// WithConnectionInfoFn configures the function a Tracker uses to read the
+// project and credentials of a managed resource.
+func WithConnectionInfoFn(fn ConnectionInfoFn) TrackerOption {
+	return func(t *Tracker) {
+		t.info = fn
+	}
+}
+
+// WithStateFn configures the function a Tracker uses to look up the state of
+// a project.
+func WithStateFn(fn StateFn) TrackerOption {
+	return func(t *Tracker) {
+		t.lookup = fn
+	}
+}
+
+// NewTracker returns a Tracker that reads credentials using the supplied
+// client, and that remembers the state of a project for the supplied backoff
+// after it was looked up.
+func NewTracker(kube client.Client, backoff time.Duration, o ...TrackerOption) *Tracker {
+	t := &Tracker{kube: kube, info: gcp.GetConnectionInfo, lookup: GetProjectState, backoff: backoff, now: time.Now, states: map[string]state{}}
+	for _, fn := range o {
+		fn(t)
+	}
+	return t
+}
+
+// cached returns the state of the supplied project, if it was looked up less
+// than the backoff ago.
+func (t *Tracker) cached(project string) (state, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	s, ok := t.states[project]
+	if !ok {
+		return state{}, false
+	}
+	if !t.now().Before(s.until) {
+		delete(t.states, project)
+		return state{}, false
+	}
+	return s, true
+}
+
+// unusable returns the state of the supplied project if it is known to be
+// unusable.
+func (t *Tracker) unusable(project string) (state, bool) {
+	s, ok := t.cached(project)
+	return s, ok && s.reason != ""
+}
+
+// check returns the state of the supplied project if the supplied error could
+// have been caused by the project being unusable, and a lookup confirms that
+// it is. GCP reports such projects with a 400 or 403 error, but does not do
+// so consistently enough to tell them apart from other invalid or forbidden
+// requests. A project whose state cannot be looked up is assumed usable, and
+// is looked up again on the next such error.
+func (t *Tracker) check(ctx context.Context, project string, opts []option.ClientOption, err error) (state, bool) {
+	if c := gcp.ClassifyError(err); c != gcp.ErrorClassInvalid && c != gcp.ErrorClassPermissionDenied {
+		return state{}, false
+	}
+	s, ok := t.cached(project)
+	if !ok {
+		r, err := t.lookup(ctx, project, opts)
+		if err != nil {
+			return state{}, false
+		}
+		s = state{reason: r, message: messages[r], until: t.now().Add(t.backoff)}
+		t.mu.Lock()
+		t.states[project] = s
+		t.mu.Unlock()
+	}
+	return s, s.reason != ""
+}
+
+// NewExternalConnecter returns an ExternalConnecter that does not call GCP
+// for resources whose project the supplied Tracker knows to be unusable. The
+// wrapped ExternalConnecter is only called when GCP must be contacted.
+func NewExternalConnecter(c managed.ExternalConnecter, t *Tracker) managed.ExternalConnecter {
+	return &connecter{connecter: c, tracker: t}
+}
+
+type connecter struct {
+	connecter managed.ExternalConnecter
+	tracker   *Tracker
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	project, opts, err := c.tracker.info(ctx, c.tracker.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &external{connecter: c.connecter, tracker: c.tracker, project: Project(mg, project), opts: opts}, nil
+}
+
+type external struct {
+	connecter managed.ExternalConnecter
+	tracker   *Tracker
+	client    managed.ExternalClient
+
+	// The project of the resource, and the client options used to look up
+	// its state.
+	project string
+	opts    []option.ClientOption
+}
+
+func (e *external) connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	if e.client != nil {
+		return e.client, nil
+	}
+	c, err := e.connecter.Connect(ctx, mg)
+	if err != nil {
+		return nil, err
+	}
+	e.client = c
+	return c, nil
+}
+
+// unusable reports that the project of the supplied resource is unusable. A
+// resource that is being deleted is reported not to exist if its project is
+// pending deletion, so that the managed reconciler removes its finalizer and
+// leaves the external resource to be deleted along with its project. Any
+// other resource fails to be observed until the backoff elapses.
+func unusable(mg resource.Managed, s state) (managed.ExternalObservation, error) {
+	mg.SetConditions(ProjectUnusable(s.reason, s.message))
+	if meta.WasDeleted(mg) && s.reason == ReasonProjectPendingDeletion {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	return managed.ExternalObservation{}, errors.Errorf(errUnusableFmt, s.message, s.until.Format(time.RFC3339))
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	if s, ok := e.tracker.unusable(e.project); ok {
+		return unusable(mg, s)
+	}
+	c, err := e.connect(ctx, mg)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	obs, err := c.Observe(ctx, mg)
+	if err != nil {
+		if s, ok := e.tracker.check(ctx, e.project, e.opts, err); ok {
+			return unusable(mg, s)
+		}
+		return obs, err
+	}
+	if c := mg.GetCondition(TypeProjectUsable); c.Status == corev1.ConditionFalse {
+		mg.SetConditions(ProjectUsable())
+	}
+	return obs, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	c, err := e.connect(ctx, mg)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	cre, err := c.Create(ctx, mg)
+	if s, ok := e.tracker.check(ctx, e.project, e.opts, err); ok {
+		mg.SetConditions(ProjectUnusable(s.reason, s.message))
+	}
+	return cre, err
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	c, err := e.connect(ctx, mg)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	upd, err := c.Update(ctx, mg)
+	if s, ok := e.tracker.check(ctx, e.project, e.opts, err); ok {
+		mg.SetConditions(ProjectUnusable(s.reason, s.message))
+	}
+	return upd, err
+}
+
+// Delete returns the error of a deletion that was rejected because the
+// project is unusable. If the project is pending deletion the next Observe
+// then reports the resource not to exist, which lets its deletion complete.
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	c, err := e.connect(ctx, mg)
+	if err != nil {
+		return err
+	}
+	err = c.Delete(ctx, mg)
+	if s, ok := e.tracker.check(ctx, e.project, e.opts, err); ok {
+		mg.SetConditions(ProjectUnusable(s.reason, s.message))
+	}
+	return err
+}
+
+var (
+	sharedOnce sync.Once
+	shared     *Tracker
+)
+
+// WithProjectStateCheck wraps the supplied ExternalConnecter of the supplied
+// kind so that it is backed by a Tracker shared by all controllers, if the
+// project state check feature is enabled. Otherwise it returns the supplied
+// ExternalConnecter. The shared Tracker uses the backoff supplied by the
+// first controller to be set up. ProjectBillingInfos are never checked, since
+// linking a billing account is how a project with billing disabled becomes
+// usable again.
+func WithProjectStateCheck(mgr ctrl.Manager, o controller.Options, backoff time.Duration, gk string, c managed.ExternalConnecter) managed.ExternalConnecter {
+	if o.Features == nil || !o.Features.Enabled(features.EnableAlphaProjectStateCheck) || gk == billingv1alpha1.ProjectBillingInfoGroupKind {
+		return c
+	}
+	sharedOnce.Do(func() { shared = NewTracker(mgr.GetClient(), backoff) })
+	return NewExternalConnecter(c, shared)
+}
@@ -0,0 +1,404 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projectstate
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	cloudbilling "google.golang.org/api/cloudbilling/v1"
+	crm "google.golang.org/api/cloudresourcemanager/v1"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	billingv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/billing/v1alpha1"
+	"github.com/crossplane-contrib/provider-gcp/apis/pubsub/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	providerConfigProject = "pc-project"
+	otherProject          = "other-project"
+)
+
+var (
+	backoff = 15 * time.Minute
+	t0      = time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	errForbidden = &googleapi.Error{
+		Code:    http.StatusForbidden,
+		Message: "The caller does not have permission",
+	}
+	errNotFound = &googleapi.Error{
+		Code:    http.StatusNotFound,
+		Message: "Resource not found",
+	}
+)
+
+func topic(m ...func(*v1alpha1.Topic)) *v1alpha1.Topic {
+	cr := &v1alpha1.Topic{ObjectMeta: metav1.ObjectMeta{UID: "uid"}}
+	cr.SetProviderConfigReference(&xpv1.Reference{Name: "default"})
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func withDeletionTimestamp(cr *v1alpha1.Topic) {
+	cr.SetDeletionTimestamp(&metav1.Time{Time: t0})
+}
+
+func inProject(p string) func(*v1alpha1.Topic) {
+	return func(cr *v1alpha1.Topic) {
+		meta.SetExternalName(cr, "projects/"+p+"/topics/t")
+	}
+}
+
+func TestExternal(t *testing.T) {
+	type args struct {
+		// err is returned by the first call to GCP.
+		err error
+		// state and lookupErr are returned by lookups of the project state.
+		state     xpv1.ConditionReason
+		lookupErr error
+		elapsed   time.Duration
+		mg        *v1alpha1.Topic
+		// next is observed after mg, if set. Otherwise mg is observed again.
+		next *v1alpha1.Topic
+	}
+	type want struct {
+		obs     managed.ExternalObservation
+		err     error
+		reason  xpv1.ConditionReason
+		status  corev1.ConditionStatus
+		observe int
+		lookups int
+	}
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"PendingDeletion": {
+			reason: "GCP should not be called again for a resource whose project is confirmed to be pending deletion.",
+			args: args{
+				err:   errForbidden,
+				state: ReasonProjectPendingDeletion,
+				mg:    topic(),
+			},
+			want: want{
+				err:     errors.Errorf(errUnusableFmt, msgPendingDeletion, t0.Add(backoff).Format(time.RFC3339)),
+				reason:  ReasonProjectPendingDeletion,
+				status:  corev1.ConditionFalse,
+				observe: 1,
+				lookups: 1,
+			},
+		},
+		"BillingDisabled": {
+			reason: "GCP should not be called again for a resource whose project is confirmed to have billing disabled.",
+			args: args{
+				err:   errForbidden,
+				state: ReasonBillingDisabled,
+				mg:    topic(),
+			},
+			want: want{
+				err:     errors.Errorf(errUnusableFmt, msgBillingDisabled, t0.Add(backoff).Format(time.RFC3339)),
+				reason:  ReasonBillingDisabled,
+				status:  corev1.ConditionFalse,
+				observe: 1,
+				lookups: 1,
+			},
+		},
+		"DeletedResourceIsOrphaned": {
+			reason: "A deleted resource whose project is pending deletion should be reported not to exist.",
+			args: args{
+				err:   errForbidden,
+				state: ReasonProjectPendingDeletion,
+				mg:    topic(withDeletionTimestamp),
+			},
+			want: want{
+				reason:  ReasonProjectPendingDeletion,
+				status:  corev1.ConditionFalse,
+				observe: 1,
+				lookups: 1,
+			},
+		},
+		"DeletedResourceWithBillingDisabledIsNotOrphaned": {
+			reason: "A deleted resource whose project only has billing disabled should not be reported not to exist, since its external resource would be orphaned.",
+			args: args{
+				err:   errForbidden,
+				state: ReasonBillingDisabled,
+				mg:    topic(withDeletionTimestamp),
+			},
+			want: want{
+				err:     errors.Errorf(errUnusableFmt, msgBillingDisabled, t0.Add(backoff).Format(time.RFC3339)),
+				reason:  ReasonBillingDisabled,
+				status:  corev1.ConditionFalse,
+				observe: 1,
+				lookups: 1,
+			},
+		},
+		"BackoffElapsed": {
+			reason: "GCP should be called again once the backoff elapses.",
+			args: args{
+				err:     errForbidden,
+				state:   ReasonProjectPendingDeletion,
+				elapsed: backoff,
+				mg:      topic(),
+			},
+			want: want{
+				obs:     managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				reason:  ReasonProjectUsable,
+				status:  corev1.ConditionTrue,
+				observe: 2,
+				lookups: 1,
+			},
+		},
+		"OtherProjectIsUnaffected": {
+			reason: "A resource in another project should be observed, even if it uses the same ProviderConfig.",
+			args: args{
+				err:   errForbidden,
+				state: ReasonProjectPendingDeletion,
+				mg:    topic(),
+				next:  topic(inProject(otherProject)),
+			},
+			want: want{
+				obs:     managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				status:  corev1.ConditionUnknown,
+				observe: 2,
+				lookups: 1,
+			},
+		},
+		"ProjectIsUsable": {
+			reason: "A forbidden request in a project that a lookup finds usable should not stop GCP from being called.",
+			args: args{
+				err: errForbidden,
+				mg:  topic(),
+			},
+			want: want{
+				obs:     managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				status:  corev1.ConditionUnknown,
+				observe: 2,
+				lookups: 1,
+			},
+		},
+		"LookupFails": {
+			reason: "A project whose state cannot be looked up should be assumed usable.",
+			args: args{
+				err:       errForbidden,
+				lookupErr: errors.New("boom"),
+				mg:        topic(),
+			},
+			want: want{
+				obs:     managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				status:  corev1.ConditionUnknown,
+				observe: 2,
+				lookups: 1,
+			},
+		},
+		"OtherErrorsAreNotLookedUp": {
+			reason: "Errors that an unusable project does not cause should not cause a lookup.",
+			args: args{
+				err:   errNotFound,
+				state: ReasonProjectPendingDeletion,
+				mg:    topic(),
+			},
+			want: want{
+				obs:     managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				status:  corev1.ConditionUnknown,
+				observe: 2,
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			now := t0
+			observes, lookups := 0, 0
+			c := managed.ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+				return managed.ExternalClientFns{
+					ObserveFn: func(_ context.Context, _ resource.Managed) (managed.ExternalObservation, error) {
+						observes++
+						if observes == 1 {
+							return managed.ExternalObservation{}, tc.args.err
+						}
+						return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+					},
+				}, nil
+			})
+			tr := NewTracker(nil, backoff,
+				WithClock(func() time.Time { return now }),
+				WithConnectionInfoFn(func(_ context.Context, _ client.Client, _ resource.Managed) (string, []option.ClientOption, error) {
+					return providerConfigProject, nil, nil
+				}),
+				WithStateFn(func(_ context.Context, project string, _ []option.ClientOption) (xpv1.ConditionReason, error) {
+					lookups++
+					if project != providerConfigProject {
+						return "", errors.Errorf("unexpected project %q", project)
+					}
+					return tc.args.state, tc.args.lookupErr
+				}))
+			ec := NewExternalConnecter(c, tr)
+
+			// The first observation finds the project unusable, if it is.
+			// The second is served by the Tracker until the backoff elapses.
+			e, _ := ec.Connect(context.Background(), tc.args.mg)
+			_, _ = e.Observe(context.Background(), tc.args.mg)
+			now = now.Add(tc.args.elapsed)
+			next := tc.args.mg
+			if tc.args.next != nil {
+				next = tc.args.next
+			}
+			e, _ = ec.Connect(context.Background(), next)
+			obs, err := e.Observe(context.Background(), next)
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.observe, observes); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want calls to GCP, +got calls to GCP:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.lookups, lookups); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want lookups, +got lookups:\n%s", tc.reason, diff)
+			}
+			got := next.GetCondition(TypeProjectUsable)
+			if diff := cmp.Diff(tc.want.reason, got.Reason); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want reason, +got reason:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.status, got.Status); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want status, +got status:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestProject(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		mg     resource.Managed
+		want   string
+	}{
+		"ProviderConfig": {
+			reason: "The project of the ProviderConfig should be used for a resource that does not set its own.",
+			mg:     topic(func(cr *v1alpha1.Topic) { meta.SetExternalName(cr, "t") }),
+			want:   providerConfigProject,
+		},
+		"ExternalName": {
+			reason: "The project an external name is qualified with should be used.",
+			mg:     topic(inProject(otherProject)),
+			want:   otherProject,
+		},
+		"ForProvider": {
+			reason: "The project set by spec.forProvider.project should be used.",
+			mg: &billingv1alpha1.ProjectBillingInfo{Spec: billingv1alpha1.ProjectBillingInfoSpec{
+				ForProvider: billingv1alpha1.ProjectBillingInfoParameters{Project: gcp.StringPtr(otherProject)},
+			}},
+			want: otherProject,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := Project(tc.mg, providerConfigProject)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nProject(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestGetProjectState(t *testing.T) {
+	type want struct {
+		reason xpv1.ConditionReason
+		err    error
+	}
+	cases := map[string]struct {
+		reason         string
+		lifecycleState string
+		billingEnabled bool
+		status         int
+		want           want
+	}{
+		"Usable": {
+			reason:         "An active project with billing enabled should be usable.",
+			lifecycleState: "ACTIVE",
+			billingEnabled: true,
+			want:           want{},
+		},
+		"PendingDeletion": {
+			reason:         "A project whose deletion was requested should be pending deletion.",
+			lifecycleState: lifecycleDeleteRequested,
+			want:           want{reason: ReasonProjectPendingDeletion},
+		},
+		"BillingDisabled": {
+			reason:         "An active project with billing disabled should have billing disabled.",
+			lifecycleState: "ACTIVE",
+			want:           want{reason: ReasonBillingDisabled},
+		},
+		"GetProjectError": {
+			reason: "Errors getting the project should be returned.",
+			status: http.StatusForbidden,
+			want:   want{err: errors.Wrap(&googleapi.Error{Code: http.StatusForbidden, Body: "{}"}, errGetProject)},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer func() { _ = r.Body.Close() }()
+				w.Header().Set("Content-Type", "application/json")
+				if tc.status != 0 {
+					w.WriteHeader(tc.status)
+					_, _ = w.Write([]byte(`{}`))
+					return
+				}
+				switch r.URL.Path {
+				case "/v1/projects/" + providerConfigProject:
+					_ = json.NewEncoder(w).Encode(&crm.Project{ProjectId: providerConfigProject, LifecycleState: tc.lifecycleState})
+				case "/v1/projects/" + providerConfigProject + "/billingInfo":
+					_ = json.NewEncoder(w).Encode(&cloudbilling.ProjectBillingInfo{BillingEnabled: tc.billingEnabled})
+				default:
+					t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer srv.Close()
+
+			got, err := GetProjectState(context.Background(), providerConfigProject, []option.ClientOption{option.WithEndpoint(srv.URL), option.WithoutAuthentication()})
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nGetProjectState(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.reason, got); diff != "" {
+				t.Errorf("\n%s\nGetProjectState(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
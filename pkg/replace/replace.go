@@ -0,0 +1,126 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package replace lets managed resource reconcilers replace external
+// resources whose immutable fields were changed, by deleting them so that
+// they are created again with the desired configuration.
+package replace
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// AnnotationKeyConfirm must be set to the current metadata.generation of a
+// managed resource to confirm that its external resource may be replaced.
+// Tying the confirmation to a generation ensures it can not accidentally
+// apply to a later change.
+const AnnotationKeyConfirm = "gcp.crossplane.io/confirm-replace"
+
+// AnnotationKeyDeleting is set to the metadata.generation of a managed
+// resource once the deletion of its external resource was started in order to
+// replace it, so that the deletion is not started again while it is pending.
+// It may be removed to start the deletion again, e.g. if it failed.
+const AnnotationKeyDeleting = "gcp.crossplane.io/replace-deleting"
+
+// Update policies of managed resources.
+const (
+	// PolicyUpdate applies changes to immutable fields as ordinary updates.
+	PolicyUpdate = "Update"
+
+	// PolicyReplaceOnImmutableChange replaces the external resource when its
+	// immutable fields change.
+	PolicyReplaceOnImmutableChange = "ReplaceOnImmutableChange"
+)
+
+// ReasonReplace is the reason of the event recorded when an external
+// resource is deleted in order to be replaced.
+const ReasonReplace event.Reason = "ReplaceExternalResource"
+
+const (
+	errNotConfirmedFmt = "immutable fields changed (%s): annotate the resource with %s=%d to confirm the external resource may be deleted and created again"
+	errOrphanFmt       = "immutable fields changed (%s): the external resource cannot be replaced because the deletion policy of the resource is Orphan"
+	errPersistDeleting = "cannot persist that the external resource is being deleted to replace it"
+)
+
+// Confirmed returns true if the supplied managed resource is annotated to
+// confirm that the external resource of its current generation may be
+// replaced.
+func Confirmed(mg resource.Managed) bool {
+	return mg.GetAnnotations()[AnnotationKeyConfirm] == strconv.FormatInt(mg.GetGeneration(), 10)
+}
+
+// Check returns true if the supplied managed resource, whose supplied
+// immutable fields changed, should have its external resource replaced. It
+// returns false if nothing changed or the update policy does not ask for
+// replacement, in which case the change should be applied as an ordinary
+// update. It returns an error if replacement has not been confirmed, or if
+// the external resource must not be deleted because the deletion policy of
+// the managed resource is Orphan.
+func Check(mg resource.Managed, policy string, changed []string) (bool, error) {
+	if policy != PolicyReplaceOnImmutableChange || len(changed) == 0 {
+		return false, nil
+	}
+	if mg.GetDeletionPolicy() == xpv1.DeletionOrphan {
+		return false, errors.Errorf(errOrphanFmt, strings.Join(changed, ", "))
+	}
+	if !Confirmed(mg) {
+		return false, errors.Errorf(errNotConfirmedFmt, strings.Join(changed, ", "), AnnotationKeyConfirm, mg.GetGeneration())
+	}
+	return true, nil
+}
+
+// Replacing returns the event recorded when the external resource of a
+// managed resource is deleted so that it is created again.
+func Replacing(changed []string) event.Event {
+	return event.Normal(ReasonReplace, "Deleting the external resource to replace it, immutable fields changed: "+strings.Join(changed, ", "))
+}
+
+// Pending returns true if the deletion of the external resource of the
+// supplied managed resource was started in order to replace it, and the
+// managed resource was not changed since.
+func Pending(mg resource.Managed) bool {
+	return mg.GetAnnotations()[AnnotationKeyDeleting] == strconv.FormatInt(mg.GetGeneration(), 10)
+}
+
+// A DeleteFn deletes the external resource of a managed resource.
+type DeleteFn func(ctx context.Context, mg resource.Managed) error
+
+// Delete starts the deletion of the external resource of the supplied managed
+// resource, whose supplied immutable fields changed, so that it is created
+// again. It does nothing if the deletion is already pending. Once the
+// deletion was started that is persisted using the supplied client.
+func Delete(ctx context.Context, c client.Client, r event.Recorder, mg resource.Managed, changed []string, del DeleteFn) error {
+	if Pending(mg) {
+		return nil
+	}
+	r.Event(mg, Replacing(changed))
+	if err := del(ctx, mg); err != nil {
+		return err
+	}
+	meta.AddAnnotations(mg, map[string]string{AnnotationKeyDeleting: strconv.FormatInt(mg.GetGeneration(), 10)})
+	return errors.Wrap(managed.NewRetryingCriticalAnnotationUpdater(c).UpdateCriticalAnnotations(ctx, mg), errPersistDeleting)
+}
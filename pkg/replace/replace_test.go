@@ -0,0 +1,256 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package replace
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/pubsub/v1alpha1"
+)
+
+func topic(confirm string) *v1alpha1.Topic {
+	cr := &v1alpha1.Topic{}
+	cr.SetGeneration(3)
+	if confirm != "" {
+		cr.SetAnnotations(map[string]string{AnnotationKeyConfirm: confirm})
+	}
+	return cr
+}
+
+func TestCheck(t *testing.T) {
+	type args struct {
+		cr      *v1alpha1.Topic
+		policy  string
+		changed []string
+	}
+	type want struct {
+		replace bool
+		err     error
+	}
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"NothingChanged": {
+			reason: "Nothing should be replaced if no immutable field changed.",
+			args: args{
+				cr:     topic("3"),
+				policy: PolicyReplaceOnImmutableChange,
+			},
+		},
+		"UpdatePolicy": {
+			reason: "Changes should be applied as updates unless the policy asks for replacement.",
+			args: args{
+				cr:      topic("3"),
+				policy:  PolicyUpdate,
+				changed: []string{"kmsKeyName"},
+			},
+		},
+		"NotConfirmed": {
+			reason: "An error should be returned if replacement was not confirmed.",
+			args: args{
+				cr:      topic(""),
+				policy:  PolicyReplaceOnImmutableChange,
+				changed: []string{"kmsKeyName"},
+			},
+			want: want{
+				err: errors.Errorf(errNotConfirmedFmt, "kmsKeyName", AnnotationKeyConfirm, 3),
+			},
+		},
+		"ConfirmedEarlierGeneration": {
+			reason: "A confirmation of an earlier generation should not apply to the current one.",
+			args: args{
+				cr:      topic("2"),
+				policy:  PolicyReplaceOnImmutableChange,
+				changed: []string{"kmsKeyName"},
+			},
+			want: want{
+				err: errors.Errorf(errNotConfirmedFmt, "kmsKeyName", AnnotationKeyConfirm, 3),
+			},
+		},
+		"Orphan": {
+			reason: "An error should be returned if the external resource must not be deleted.",
+			args: args{
+				cr: func() *v1alpha1.Topic {
+					cr := topic("3")
+					cr.SetDeletionPolicy(xpv1.DeletionOrphan)
+					return cr
+				}(),
+				policy:  PolicyReplaceOnImmutableChange,
+				changed: []string{"kmsKeyName"},
+			},
+			want: want{
+				err: errors.Errorf(errOrphanFmt, "kmsKeyName"),
+			},
+		},
+		"Confirmed": {
+			reason: "The external resource should be replaced if replacement was confirmed.",
+			args: args{
+				cr:      topic("3"),
+				policy:  PolicyReplaceOnImmutableChange,
+				changed: []string{"kmsKeyName"},
+			},
+			want: want{
+				replace: true,
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := Check(tc.args.cr, tc.args.policy, tc.args.changed)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nCheck(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.replace, got); diff != "" {
+				t.Errorf("\n%s\nCheck(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type args struct {
+		kube client.Client
+		cr   *v1alpha1.Topic
+		err  error
+	}
+	type want struct {
+		deleted bool
+		events  []event.Event
+		err     error
+	}
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"Pending": {
+			reason: "The external resource should not be deleted again while its deletion is pending.",
+			args: args{
+				cr: func() *v1alpha1.Topic {
+					cr := topic("3")
+					cr.SetAnnotations(map[string]string{AnnotationKeyDeleting: "3"})
+					return cr
+				}(),
+			},
+		},
+		"PendingEarlierGeneration": {
+			reason: "The external resource should be deleted if it was changed since its deletion was started.",
+			args: args{
+				kube: &test.MockClient{
+					MockGet:    test.NewMockGetFn(nil),
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				cr: func() *v1alpha1.Topic {
+					cr := topic("3")
+					cr.SetAnnotations(map[string]string{AnnotationKeyDeleting: "2"})
+					return cr
+				}(),
+			},
+			want: want{
+				deleted: true,
+				events:  []event.Event{Replacing([]string{"kmsKeyName"})},
+			},
+		},
+		"DeleteError": {
+			reason: "Errors deleting the external resource should be returned, and the deletion should not be persisted.",
+			args: args{
+				cr:  topic("3"),
+				err: errBoom,
+			},
+			want: want{
+				deleted: true,
+				events:  []event.Event{Replacing([]string{"kmsKeyName"})},
+				err:     errBoom,
+			},
+		},
+		"PersistError": {
+			reason: "Errors persisting that the deletion was started should be returned.",
+			args: args{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(errBoom),
+				},
+				cr: topic("3"),
+			},
+			want: want{
+				deleted: true,
+				events:  []event.Event{Replacing([]string{"kmsKeyName"})},
+				err:     errors.Wrap(errors.Wrap(errBoom, "cannot update critical annotations"), errPersistDeleting),
+			},
+		},
+		"Deleted": {
+			reason: "The external resource should be deleted and that should be persisted.",
+			args: args{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil),
+					MockUpdate: test.NewMockUpdateFn(nil, func(obj client.Object) error {
+						if diff := cmp.Diff("3", obj.GetAnnotations()[AnnotationKeyDeleting]); diff != "" {
+							t.Errorf("Update(...): -want deleting annotation, +got deleting annotation:\n%s", diff)
+						}
+						return nil
+					}),
+				},
+				cr: topic("3"),
+			},
+			want: want{
+				deleted: true,
+				events:  []event.Event{Replacing([]string{"kmsKeyName"})},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := &recorder{}
+			deleted := false
+			del := func(_ context.Context, _ resource.Managed) error {
+				deleted = true
+				return tc.args.err
+			}
+			err := Delete(context.Background(), tc.args.kube, r, tc.args.cr, []string{"kmsKeyName"}, del)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nDelete(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.deleted, deleted); diff != "" {
+				t.Errorf("\n%s\nDelete(...): -want deleted, +got deleted:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.events, r.events); diff != "" {
+				t.Errorf("\n%s\nDelete(...): -want events, +got events:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+type recorder struct {
+	event.Recorder
+	events []event.Event
+}
+
+func (r *recorder) Event(_ runtime.Object, e event.Event) { r.events = append(r.events, e) }
@@ -0,0 +1,228 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package tracing instruments managed resource reconcilers and the GCP API
+// calls they make with OpenTelemetry spans, so that slow reconciles can be
+// traced end-to-end.
+package tracing
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.12.0"
+	"go.opentelemetry.io/otel/trace"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	xpresource "github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/pkg/version"
+)
+
+const (
+	instrumentationName = "github.com/crossplane-contrib/provider-gcp"
+	serviceName         = "provider-gcp"
+
+	errNewExporter = "cannot create OTLP trace exporter"
+)
+
+// Attributes recorded on the spans of managed resource reconciles.
+const (
+	AttributeKeyKind         = attribute.Key("crossplane.kind")
+	AttributeKeyName         = attribute.Key("crossplane.name")
+	AttributeKeyExternalName = attribute.Key("crossplane.external_name")
+)
+
+var enabled int32
+
+// Enabled returns true if spans are being exported.
+func Enabled() bool {
+	return atomic.LoadInt32(&enabled) == 1
+}
+
+// Setup exports spans to the OTLP gRPC collector at the supplied endpoint. It
+// must be called before any controllers are set up. The returned function
+// flushes any buffered spans and stops exporting them.
+func Setup(ctx context.Context, endpoint string, insecure bool) (func(context.Context) error, error) {
+	o := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(endpoint)}
+	if insecure {
+		o = append(o, otlptracegrpc.WithInsecure())
+	}
+	exp, err := otlptracegrpc.New(ctx, o...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewExporter)
+	}
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exp),
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.SchemaURL,
+			semconv.ServiceNameKey.String(serviceName),
+			semconv.ServiceVersionKey.String(version.Version),
+		)),
+	)
+	otel.SetTracerProvider(tp)
+	atomic.StoreInt32(&enabled, 1)
+	return func(ctx context.Context) error {
+		atomic.StoreInt32(&enabled, 0)
+		return tp.Shutdown(ctx)
+	}, nil
+}
+
+func tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName, trace.WithInstrumentationVersion(version.Version))
+}
+
+// end records the supplied error, if any, and ends the supplied span.
+func end(s trace.Span, err error) {
+	if err != nil {
+		s.RecordError(err)
+		s.SetStatus(codes.Error, err.Error())
+	}
+	s.End()
+}
+
+// NewReconciler wraps the supplied Reconciler so that each reconcile is
+// recorded as a span. Spans recorded by the supplied Reconciler are children
+// of this span. The supplied Reconciler is returned unchanged if tracing is
+// not enabled.
+func NewReconciler(name string, r reconcile.Reconciler) reconcile.Reconciler {
+	if !Enabled() {
+		return r
+	}
+	return &reconciler{name: name, inner: r}
+}
+
+type reconciler struct {
+	name  string
+	inner reconcile.Reconciler
+}
+
+func (r *reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	ctx, s := tracer().Start(ctx, "Reconcile", trace.WithAttributes(
+		attribute.String("controller", r.name),
+		AttributeKeyName.String(req.Name),
+	))
+	res, err := r.inner.Reconcile(ctx, req)
+	s.SetAttributes(attribute.Bool("requeue", res.Requeue || res.RequeueAfter > 0))
+	end(s, err)
+	return res, err
+}
+
+// WithTracing wraps the supplied ExternalConnecter so that connecting to GCP
+// and each observe, create, update and delete of an external resource of the
+// supplied kind is recorded as a span. The supplied ExternalConnecter is
+// returned unchanged if tracing is not enabled.
+func WithTracing(gk string, c managed.ExternalConnecter) managed.ExternalConnecter {
+	if !Enabled() {
+		return c
+	}
+	return &connecter{kind: gk, connecter: c}
+}
+
+type connecter struct {
+	kind      string
+	connecter managed.ExternalConnecter
+}
+
+func (c *connecter) Connect(ctx context.Context, mg xpresource.Managed) (managed.ExternalClient, error) {
+	ctx, s := start(ctx, "Connect", c.kind, mg)
+	ec, err := c.connecter.Connect(ctx, mg)
+	end(s, err)
+	if err != nil {
+		return nil, err
+	}
+	return &external{kind: c.kind, client: ec}, nil
+}
+
+func start(ctx context.Context, op, kind string, mg xpresource.Managed) (context.Context, trace.Span) {
+	return tracer().Start(ctx, op, trace.WithAttributes(
+		AttributeKeyKind.String(kind),
+		AttributeKeyName.String(mg.GetName()),
+		AttributeKeyExternalName.String(meta.GetExternalName(mg)),
+	))
+}
+
+type external struct {
+	kind   string
+	client managed.ExternalClient
+}
+
+func (e *external) Observe(ctx context.Context, mg xpresource.Managed) (managed.ExternalObservation, error) {
+	ctx, s := start(ctx, "Observe", e.kind, mg)
+	obs, err := e.client.Observe(ctx, mg)
+	s.SetAttributes(
+		attribute.Bool("resource_exists", obs.ResourceExists),
+		attribute.Bool("resource_up_to_date", obs.ResourceUpToDate),
+	)
+	end(s, err)
+	return obs, err
+}
+
+func (e *external) Create(ctx context.Context, mg xpresource.Managed) (managed.ExternalCreation, error) {
+	ctx, s := start(ctx, "Create", e.kind, mg)
+	cre, err := e.client.Create(ctx, mg)
+	end(s, err)
+	return cre, err
+}
+
+func (e *external) Update(ctx context.Context, mg xpresource.Managed) (managed.ExternalUpdate, error) {
+	ctx, s := start(ctx, "Update", e.kind, mg)
+	upd, err := e.client.Update(ctx, mg)
+	end(s, err)
+	return upd, err
+}
+
+func (e *external) Delete(ctx context.Context, mg xpresource.Managed) error {
+	ctx, s := start(ctx, "Delete", e.kind, mg)
+	err := e.client.Delete(ctx, mg)
+	end(s, err)
+	return err
+}
+
+// NewTransport wraps the supplied RoundTripper so that each GCP API call is
+// recorded as a span. Spans are children of any span in the request context.
+func NewTransport(base http.RoundTripper) http.RoundTripper {
+	return &transport{base: base}
+}
+
+type transport struct {
+	base http.RoundTripper
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx, s := tracer().Start(req.Context(), "HTTP "+req.Method,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(semconv.HTTPClientAttributesFromHTTPRequest(req)...),
+	)
+	rsp, err := t.base.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		end(s, err)
+		return rsp, err
+	}
+	s.SetAttributes(semconv.HTTPAttributesFromHTTPStatusCode(rsp.StatusCode)...)
+	s.SetStatus(semconv.SpanStatusFromHTTPStatusCodeAndSpanKind(rsp.StatusCode, trace.SpanKindClient))
+	s.End()
+	return rsp, nil
+}
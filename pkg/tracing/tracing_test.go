@@ -0,0 +1,155 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracing
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/pubsub/v1alpha1"
+)
+
+var errBoom = errors.New("boom")
+
+// record returns a recorder of every span ended while the test runs.
+func record(t *testing.T) *tracetest.SpanRecorder {
+	t.Helper()
+	sr := tracetest.NewSpanRecorder()
+	tp := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(sr)))
+	t.Cleanup(func() { otel.SetTracerProvider(tp) })
+	return sr
+}
+
+type span struct {
+	Name   string
+	Parent string
+	Status codes.Code
+}
+
+// spans returns the name, parent name and status of each ended span.
+func spans(sr *tracetest.SpanRecorder) []span {
+	ended := sr.Ended()
+	names := map[string]string{}
+	for _, s := range ended {
+		names[s.SpanContext().SpanID().String()] = s.Name()
+	}
+	out := make([]span, len(ended))
+	for i, s := range ended {
+		out[i] = span{Name: s.Name(), Parent: names[s.Parent().SpanID().String()], Status: s.Status().Code}
+	}
+	return out
+}
+
+func TestDisabled(t *testing.T) {
+	c := managed.ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+		return nil, nil
+	})
+	if _, ok := WithTracing(v1alpha1.TopicGroupKind, c).(*connecter); ok {
+		t.Errorf("WithTracing(...): want unwrapped ExternalConnecter when tracing is disabled")
+	}
+	r := reconcile.Func(func(_ context.Context, _ reconcile.Request) (reconcile.Result, error) {
+		return reconcile.Result{}, nil
+	})
+	if _, ok := NewReconciler("topic", r).(*reconciler); ok {
+		t.Errorf("NewReconciler(...): want unwrapped Reconciler when tracing is disabled")
+	}
+}
+
+func TestSpans(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+	hc := &http.Client{Transport: NewTransport(http.DefaultTransport)}
+
+	call := func(ctx context.Context, method string) error {
+		req, err := http.NewRequestWithContext(ctx, method, srv.URL, nil)
+		if err != nil {
+			return err
+		}
+		rsp, err := hc.Do(req)
+		if err != nil {
+			return err
+		}
+		_ = rsp.Body.Close()
+		if rsp.StatusCode != http.StatusOK {
+			return errBoom
+		}
+		return nil
+	}
+
+	ec := &managed.ExternalClientFns{
+		ObserveFn: func(ctx context.Context, _ resource.Managed) (managed.ExternalObservation, error) {
+			return managed.ExternalObservation{ResourceExists: true}, call(ctx, http.MethodGet)
+		},
+		DeleteFn: func(ctx context.Context, _ resource.Managed) error {
+			return call(ctx, http.MethodDelete)
+		},
+	}
+	c := &connecter{kind: v1alpha1.TopicGroupKind, connecter: managed.ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+		return ec, nil
+	})}
+
+	cr := &v1alpha1.Topic{}
+	cr.SetName("cool-topic")
+	meta.SetExternalName(cr, "cool-topic")
+
+	r := &reconciler{name: "topic", inner: reconcile.Func(func(ctx context.Context, _ reconcile.Request) (reconcile.Result, error) {
+		e, err := c.Connect(ctx, cr)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+		if _, err := e.Observe(ctx, cr); err != nil {
+			return reconcile.Result{}, err
+		}
+		return reconcile.Result{}, e.Delete(ctx, cr)
+	})}
+
+	sr := record(t)
+	if _, err := r.Reconcile(context.Background(), reconcile.Request{}); !errors.Is(err, errBoom) {
+		t.Errorf("r.Reconcile(...): want error %v, got %v", errBoom, err)
+	}
+
+	want := []span{
+		{Name: "Connect", Parent: "Reconcile", Status: codes.Unset},
+		{Name: "HTTP GET", Parent: "Observe", Status: codes.Unset},
+		{Name: "Observe", Parent: "Reconcile", Status: codes.Unset},
+		{Name: "HTTP DELETE", Parent: "Delete", Status: codes.Error},
+		{Name: "Delete", Parent: "Reconcile", Status: codes.Error},
+		{Name: "Reconcile", Status: codes.Error},
+	}
+	if diff := cmp.Diff(want, spans(sr)); diff != "" {
+		t.Errorf("r.Reconcile(...): -want spans, +got spans:\n%s", diff)
+	}
+}